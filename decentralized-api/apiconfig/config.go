@@ -23,6 +23,9 @@ type Config struct {
 	LastUsedVersion     string                `koanf:"last_used_version" json:"last_used_version"`
 	ValidationParams    ValidationParamsCache `koanf:"validation_params" json:"validation_params"`
 	BandwidthParams     BandwidthParamsCache  `koanf:"bandwidth_params" json:"bandwidth_params"`
+	ModelAliases        map[string]string     `koanf:"model_aliases" json:"model_aliases"` // alias -> governance model id
+	Bridge              BridgeConfig          `koanf:"bridge" json:"bridge"`
+	NodeAffinity        NodeAffinityConfig    `koanf:"node_affinity" json:"node_affinity"`
 }
 
 type NatsServerConfig struct {
@@ -37,6 +40,40 @@ type TxBatchingConfig struct {
 	FlushTimeoutSeconds int  `koanf:"flush_timeout_seconds" json:"flush_timeout_seconds"`
 }
 
+// NodeAffinityConfig controls routing the turns of a conversation to the ML node that served the previous turn,
+// so vLLM can reuse its prefix cache
+type NodeAffinityConfig struct {
+	Disabled   bool `koanf:"disabled" json:"disabled"`
+	TtlSeconds int  `koanf:"ttl_seconds" json:"ttl_seconds"`
+}
+
+// BridgeConfig lists the EVM chains whose bridge deposits are watched and submitted automatically
+type BridgeConfig struct {
+	Chains []EvmChainConfig `koanf:"chains" json:"chains"`
+}
+
+type EvmChainConfig struct {
+	// ChainId is the origin chain name the bridge addresses are registered under, e.g. "ethereum"
+	ChainId string `koanf:"chain_id" json:"chain_id"`
+	RpcUrl  string `koanf:"rpc_url" json:"rpc_url"`
+	// Confirmations defaults to DefaultBridgeConfirmations when unset; an explicit 0 is kept for dev chains
+	Confirmations       *uint64 `koanf:"confirmations" json:"confirmations,omitempty"`
+	PollIntervalSeconds int     `koanf:"poll_interval_seconds" json:"poll_interval_seconds"`
+	// StartBlock is where a watcher without a saved cursor starts; the latest confirmed block when 0
+	StartBlock       uint64 `koanf:"start_block" json:"start_block"`
+	MaxBlocksPerPoll uint64 `koanf:"max_blocks_per_poll" json:"max_blocks_per_poll"`
+}
+
+const DefaultBridgeConfirmations uint64 = 12
+
+// RequiredConfirmations returns how many blocks a deposit must be buried under before it is submitted
+func (c EvmChainConfig) RequiredConfirmations() uint64 {
+	if c.Confirmations == nil {
+		return DefaultBridgeConfirmations
+	}
+	return *c.Confirmations
+}
+
 type UpgradePlan struct {
 	Name        string            `koanf:"name" json:"name"`
 	Height      int64             `koanf:"height" json:"height"`
@@ -151,6 +151,30 @@ func (cm *ConfigManager) GetTxBatchingConfig() TxBatchingConfig {
 	return cfg
 }
 
+func (cm *ConfigManager) GetNodeAffinityConfig() NodeAffinityConfig {
+	cfg := cm.currentConfig.NodeAffinity
+	if cfg.TtlSeconds == 0 {
+		cfg.TtlSeconds = 600
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetBridgeConfig() BridgeConfig {
+	chains := make([]EvmChainConfig, 0, len(cm.currentConfig.Bridge.Chains))
+	for _, chain := range cm.currentConfig.Bridge.Chains {
+		confirmations := chain.RequiredConfirmations()
+		chain.Confirmations = &confirmations
+		if chain.PollIntervalSeconds == 0 {
+			chain.PollIntervalSeconds = 15
+		}
+		if chain.MaxBlocksPerPoll == 0 {
+			chain.MaxBlocksPerPoll = 100
+		}
+		chains = append(chains, chain)
+	}
+	return BridgeConfig{Chains: chains}
+}
+
 func (cm *ConfigManager) GetNodes() []InferenceNodeConfig {
 	nodes := make([]InferenceNodeConfig, len(cm.currentConfig.Nodes))
 	copy(nodes, cm.currentConfig.Nodes)
@@ -283,6 +307,21 @@ func (cm *ConfigManager) GetBandwidthParams() BandwidthParamsCache {
 	return cm.currentConfig.BandwidthParams
 }
 
+func (cm *ConfigManager) SetModelAliases(aliases map[string]string) error {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cm.currentConfig.ModelAliases = aliases
+	logging.Debug("Setting model aliases", types.Config, "aliases", aliases)
+	return nil
+}
+
+// GetModelAliases returns the alias -> governance model id table cached from the chain
+func (cm *ConfigManager) GetModelAliases() map[string]string {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	return cm.currentConfig.ModelAliases
+}
+
 func (cm *ConfigManager) GetHeight() int64 {
 	return cm.currentConfig.CurrentHeight
 }
@@ -59,6 +59,27 @@ func TestConfigLoadEnvOverride(t *testing.T) {
 
 }
 
+func TestBridgeConfirmations(t *testing.T) {
+	bridgeYaml := testYaml + `
+bridge:
+  chains:
+    - chain_id: anvil
+      rpc_url: http://localhost:8545
+      confirmations: 0
+    - chain_id: ethereum
+      rpc_url: http://localhost:8546
+`
+	testManager := &apiconfig.ConfigManager{
+		KoanProvider: rawbytes.Provider([]byte(bridgeYaml)),
+	}
+	require.NoError(t, testManager.Load())
+
+	chains := testManager.GetBridgeConfig().Chains
+	require.Len(t, chains, 2)
+	require.Equal(t, uint64(0), chains[0].RequiredConfirmations())
+	require.Equal(t, apiconfig.DefaultBridgeConfirmations, chains[1].RequiredConfirmations())
+}
+
 type CaptureWriterProvider struct {
 	CapturedData string
 }
@@ -123,6 +123,7 @@ type Broker struct {
 	lastEpochPhase       types.EpochPhase
 	statusQueryTrigger   chan statusQuerySignal
 	configManager        *apiconfig.ConfigManager
+	nodeAffinity         *nodeAffinity
 }
 
 // GetParticipantAddress returns the current participant's address if available.
@@ -324,6 +325,7 @@ func NewBroker(chainBridge BrokerChainBridge, phaseTracker *chainphase.ChainPhas
 		reconcileTrigger:     make(chan struct{}, 1),
 		statusQueryTrigger:   make(chan statusQuerySignal, 1),
 		configManager:        configManager,
+		nodeAffinity:         newNodeAffinity(),
 	}
 
 	// Initialize NodeWorkGroup
@@ -468,6 +470,9 @@ func (b *Broker) lockAvailableNode(command LockAvailableNode) {
 		b.mu.RLock()
 		leastBusyNode.State.LockCount++
 		b.mu.RUnlock()
+		if ttl, enabled := b.nodeAffinityTTL(); enabled && command.AffinityKey != "" {
+			b.nodeAffinity.remember(command.AffinityKey, leastBusyNode.Node.Id, ttl)
+		}
 	}
 	logging.Debug("Locked node", types.Nodes, "node", leastBusyNode)
 	if leastBusyNode == nil {
@@ -494,6 +499,10 @@ func (b *Broker) getLeastBusyNode(command LockAvailableNode) *NodeWithState {
 		}
 	}
 
+	if node := b.getAffinityNode(command, skip, epochState.LatestEpoch.EpochIndex, epochState.CurrentPhase); node != nil {
+		return node
+	}
+
 	var leastBusyNode *NodeWithState = nil
 	for _, node := range b.nodes {
 		if _, shouldSkip := skip[node.Node.Id]; shouldSkip {
@@ -513,6 +522,34 @@ func (b *Broker) getLeastBusyNode(command LockAvailableNode) *NodeWithState {
 	return leastBusyNode
 }
 
+// getAffinityNode returns the node that served the previous turn of the conversation if it's still available,
+// otherwise nil so the least busy node is picked
+func (b *Broker) getAffinityNode(command LockAvailableNode, skip map[string]struct{}, currentEpoch uint64, currentPhase types.EpochPhase) *NodeWithState {
+	if command.AffinityKey == "" {
+		return nil
+	}
+	if _, enabled := b.nodeAffinityTTL(); !enabled {
+		return nil
+	}
+	nodeId, found := b.nodeAffinity.get(command.AffinityKey)
+	if !found {
+		return nil
+	}
+	if _, shouldSkip := skip[nodeId]; shouldSkip {
+		return nil
+	}
+	node, found := b.nodes[nodeId]
+	if !found {
+		return nil
+	}
+	if available, reason := b.nodeAvailable(node, command.Model, currentEpoch, currentPhase); !available {
+		logging.Info("Affinity node not available, falling back to the least busy node", types.Nodes, "node_id", nodeId, "reason", reason)
+		return nil
+	}
+	logging.Debug("Routing to affinity node", types.Nodes, "node_id", nodeId)
+	return node
+}
+
 type NodeNotAvailableReason = string
 
 func (b *Broker) nodeAvailable(node *NodeWithState, neededModel string, currentEpoch uint64, currentPhase types.EpochPhase) (bool, NodeNotAvailableReason) {
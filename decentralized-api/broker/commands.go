@@ -15,6 +15,8 @@ type LockAvailableNode struct {
 	Model       string
 	Response    chan *Node
 	SkipNodeIDs []string
+	// AffinityKey identifies the conversation, the node that served its previous turn is preferred while it has capacity
+	AffinityKey string
 }
 
 func (g LockAvailableNode) GetResponseChannelCapacity() int {
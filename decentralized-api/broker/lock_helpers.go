@@ -65,9 +65,12 @@ func NewApplicationActionError(err error) *ActionError {
 // - HTTP 5xx responses trigger status re-check, node skip and retry.
 // - HTTP 4xx responses are returned as-is without retry.
 // - 2xx responses are returned.
+//
+// A non-empty affinityKey prefers the node that served the previous request with the same key.
 func DoWithLockedNodeHTTPRetry(
 	b *Broker,
 	model string,
+	affinityKey string,
 	skipNodeIDs []string,
 	maxAttempts int,
 	doPost func(node *Node) (*http.Response, *ActionError),
@@ -100,7 +103,7 @@ func DoWithLockedNodeHTTPRetry(
 		attempts++
 
 		nodeChan := make(chan *Node, 2)
-		if err := b.QueueMessage(LockAvailableNode{Model: model, Response: nodeChan, SkipNodeIDs: orderedSkip}); err != nil {
+		if err := b.QueueMessage(LockAvailableNode{Model: model, Response: nodeChan, SkipNodeIDs: orderedSkip, AffinityKey: affinityKey}); err != nil {
 			logging.Info("HTTP retry helper: failed to queue LockAvailableNode", types.Inferences,
 				"attempt", attempts,
 				"error", err)
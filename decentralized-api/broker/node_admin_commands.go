@@ -284,6 +284,7 @@ func (command RemoveNode) Execute(b *Broker) {
 		return
 	}
 	delete(b.nodes, command.NodeId)
+	b.nodeAffinity.forgetNode(command.NodeId)
 	logging.Debug("Removed node", types.Nodes, "node_id", command.NodeId)
 	command.Response <- true
 }
@@ -0,0 +1,84 @@
+package broker
+
+import (
+	"time"
+)
+
+const (
+	defaultNodeAffinityTTL = 10 * time.Minute
+	maxNodeAffinityEntries = 10000
+)
+
+// nodeAffinity remembers which ML node served a conversation, so the next turn can be routed to the same node and
+// reuse its vLLM prefix cache. It's only accessed from the broker's command loop.
+type nodeAffinity struct {
+	entries map[string]nodeAffinityEntry
+	now     func() time.Time
+}
+
+type nodeAffinityEntry struct {
+	nodeId    string
+	expiresAt time.Time
+}
+
+func newNodeAffinity() *nodeAffinity {
+	return &nodeAffinity{
+		entries: make(map[string]nodeAffinityEntry),
+		now:     time.Now,
+	}
+}
+
+func (a *nodeAffinity) get(key string) (string, bool) {
+	entry, found := a.entries[key]
+	if !found {
+		return "", false
+	}
+	if !a.now().Before(entry.expiresAt) {
+		delete(a.entries, key)
+		return "", false
+	}
+	return entry.nodeId, true
+}
+
+func (a *nodeAffinity) remember(key string, nodeId string, ttl time.Duration) {
+	now := a.now()
+	if _, found := a.entries[key]; !found && len(a.entries) >= maxNodeAffinityEntries {
+		a.evict(now)
+	}
+	a.entries[key] = nodeAffinityEntry{nodeId: nodeId, expiresAt: now.Add(ttl)}
+}
+
+// evict drops the expired entries, or the one expiring first if none has expired yet
+func (a *nodeAffinity) evict(now time.Time) {
+	var soonestKey string
+	var soonest time.Time
+	for key, entry := range a.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(a.entries, key)
+			continue
+		}
+		if soonestKey == "" || entry.expiresAt.Before(soonest) {
+			soonestKey, soonest = key, entry.expiresAt
+		}
+	}
+	if len(a.entries) >= maxNodeAffinityEntries {
+		delete(a.entries, soonestKey)
+	}
+}
+
+func (a *nodeAffinity) forgetNode(nodeId string) {
+	for key, entry := range a.entries {
+		if entry.nodeId == nodeId {
+			delete(a.entries, key)
+		}
+	}
+}
+
+// nodeAffinityTTL returns how long a conversation sticks to a node, false when affinity routing is disabled
+func (b *Broker) nodeAffinityTTL() (time.Duration, bool) {
+	if b.configManager == nil {
+		return defaultNodeAffinityTTL, true
+	}
+	cfg := b.configManager.GetNodeAffinityConfig()
+	return time.Duration(cfg.TtlSeconds) * time.Second, !cfg.Disabled
+}
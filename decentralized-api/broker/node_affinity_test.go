@@ -0,0 +1,78 @@
+package broker
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"decentralized-api/apiconfig"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeAffinity_Expiry(t *testing.T) {
+	now := time.Unix(1000, 0)
+	affinity := newNodeAffinity()
+	affinity.now = func() time.Time { return now }
+
+	affinity.remember("conversation", "node1", time.Minute)
+	nodeId, found := affinity.get("conversation")
+	require.True(t, found)
+	require.Equal(t, "node1", nodeId)
+
+	now = now.Add(time.Minute)
+	_, found = affinity.get("conversation")
+	require.False(t, found)
+	require.Empty(t, affinity.entries)
+}
+
+func TestNodeAffinity_EvictsWhenFull(t *testing.T) {
+	now := time.Unix(1000, 0)
+	affinity := newNodeAffinity()
+	affinity.now = func() time.Time { return now }
+
+	affinity.remember("first", "node1", time.Second)
+	for i := 1; i < maxNodeAffinityEntries; i++ {
+		affinity.remember("key-"+strconv.Itoa(i), "node1", time.Hour)
+	}
+	affinity.remember("last", "node2", time.Hour)
+	require.Len(t, affinity.entries, maxNodeAffinityEntries)
+	_, found := affinity.get("first")
+	require.False(t, found)
+
+	affinity.forgetNode("node2")
+	_, found = affinity.get("last")
+	require.False(t, found)
+}
+
+func TestLockAvailableNode_PrefersAffinityNode(t *testing.T) {
+	broker := NewTestBroker()
+	for i, id := range []string{"node1", "node2"} {
+		registerNodeAndSetInferenceStatus(t, broker, apiconfig.InferenceNodeConfig{
+			Host:          "localhost",
+			InferencePort: 8080 + i,
+			PoCPort:       5000 + i,
+			Models:        map[string]apiconfig.ModelConfig{"model1": {Args: make([]string, 0)}},
+			Id:            id,
+			MaxConcurrent: 2,
+		})
+	}
+
+	lock := func(affinityKey string) *Node {
+		response := make(chan *Node, 2)
+		queueMessage(t, broker, LockAvailableNode{Model: "model1", Response: response, AffinityKey: affinityKey})
+		return <-response
+	}
+
+	first := lock("conversation")
+	require.NotNil(t, first)
+	// The other node is less busy now, the conversation still goes back to the node that served it
+	second := lock("conversation")
+	require.NotNil(t, second)
+	require.Equal(t, first.Id, second.Id)
+
+	// The affinity node is at capacity, fall back to the least busy node
+	third := lock("conversation")
+	require.NotNil(t, third)
+	require.NotEqual(t, first.Id, third.Id)
+}
@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"bytes"
+	"decentralized-api/apiconfig"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testNodes = `[{"node":{"id":"node1","host":"10.0.0.1","inference_port":5000,"poc_port":8080,"max_concurrent":100,
+"models":{"Qwen/Qwen3-32B-FP8":{"args":["--tensor-parallel-size","4"]}}},
+"state":{"current_status":"INFERENCE","intended_status":"INFERENCE","poc_current_status":"IDLE","admin_state":{"enabled":true}}}]`
+
+func runCli(t *testing.T, handler http.HandlerFunc, args ...string) (string, error) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	root := NewRootCommand()
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetErr(io.Discard)
+	root.SetArgs(append(args, "--admin-url", server.URL, "--no-color"))
+	err := root.Execute()
+	return out.String(), err
+}
+
+func TestNodesList(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/admin/v1/nodes", r.URL.Path)
+		_, _ = io.WriteString(w, testNodes)
+	}
+
+	out, err := runCli(t, handler, "nodes", "list")
+	require.NoError(t, err)
+	require.Contains(t, out, "node1")
+	require.Contains(t, out, "10.0.0.1:5000")
+	require.Contains(t, out, "Qwen/Qwen3-32B-FP8")
+
+	out, err = runCli(t, handler, "nodes", "list", "-o", "json")
+	require.NoError(t, err)
+	var decoded []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &decoded))
+	require.Len(t, decoded, 1)
+}
+
+func TestNodesUpdate_KeepsUnchangedFields(t *testing.T) {
+	var updated apiconfig.InferenceNodeConfig
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = io.WriteString(w, testNodes)
+		case http.MethodPut:
+			require.Equal(t, "/admin/v1/nodes/node1", r.URL.Path)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			_, _ = io.WriteString(w, `{}`)
+		}
+	}
+
+	_, err := runCli(t, handler, "nodes", "update", "node1", "--max-concurrent", "50")
+	require.NoError(t, err)
+	require.Equal(t, 50, updated.MaxConcurrent)
+	require.Equal(t, "10.0.0.1", updated.Host)
+	require.Equal(t, []string{"--tensor-parallel-size", "4"}, updated.Models["Qwen/Qwen3-32B-FP8"].Args)
+}
+
+func TestNodesAdd_RejectsArgsForUnknownModel(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request %s", r.URL.Path)
+	}
+
+	_, err := runCli(t, handler, "nodes", "add", "node2", "--host", "10.0.0.2", "--model", "m1", "--model-args", "m2=--x")
+	require.ErrorContains(t, err, "not a model of the node")
+}
+
+func TestSetupReport_FailsOnFailedCheck(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"overall_status":"FAIL","checks":[
+{"id":"mlnode_node1","status":"PASS","message":"reachable"},
+{"id":"permissions","status":"FAIL","message":"warm key has no grants"}],
+"summary":{"total_checks":2,"passed_checks":1,"failed_checks":1,"issues":["grant permissions to the warm key"]}}`)
+	}
+
+	out, err := runCli(t, handler, "setup-report")
+	require.ErrorContains(t, err, "1 of 2 setup checks failed")
+	require.Contains(t, out, "[PASS]  mlnode_node1: reachable")
+	require.Contains(t, out, "[FAIL]  permissions: warm key has no grants")
+	require.Contains(t, out, "grant permissions to the warm key")
+}
+
+func TestAdminErrorMessage(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = io.WriteString(w, `{"message":"invalid denom: foo"}`)
+	}
+
+	_, err := runCli(t, handler, "price-proposal", "set", "10", "--denom", "foo")
+	require.ErrorContains(t, err, "400 invalid denom: foo")
+}
@@ -0,0 +1,248 @@
+package cli
+
+import (
+	"decentralized-api/apiconfig"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagNodeHost             = "host"
+	flagNodeInferencePort    = "inference-port"
+	flagNodeInferenceSegment = "inference-segment"
+	flagNodePocPort          = "poc-port"
+	flagNodePocSegment       = "poc-segment"
+	flagNodeMaxConcurrent    = "max-concurrent"
+	flagNodeModel            = "model"
+	flagNodeModelArgs        = "model-args"
+)
+
+// nodeView is the subset of broker.NodeResponse rendered in text output
+type nodeView struct {
+	Node struct {
+		Id            string                           `json:"id"`
+		Host          string                           `json:"host"`
+		InferencePort int                              `json:"inference_port"`
+		PoCPort       int                              `json:"poc_port"`
+		MaxConcurrent int                              `json:"max_concurrent"`
+		Models        map[string]apiconfig.ModelConfig `json:"models"`
+	} `json:"node"`
+	State struct {
+		CurrentStatus    string `json:"current_status"`
+		IntendedStatus   string `json:"intended_status"`
+		PocCurrentStatus string `json:"poc_current_status"`
+		FailureReason    string `json:"failure_reason"`
+		AdminState       struct {
+			Enabled bool `json:"enabled"`
+		} `json:"admin_state"`
+	} `json:"state"`
+}
+
+func nodesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nodes",
+		Short: "Manage ML nodes registered with this API node",
+	}
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "list",
+			Short: "List ML nodes and their state",
+			Args:  cobra.NoArgs,
+			RunE:  listNodes,
+		},
+		nodeAddCommand(),
+		nodeUpdateCommand(),
+		nodeActionCommand("enable", "Enable an ML node from the next epoch", http.MethodPost, "/enable"),
+		nodeActionCommand("disable", "Disable an ML node from the next epoch", http.MethodPost, "/disable"),
+		nodeActionCommand("delete", "Remove an ML node", http.MethodDelete, ""),
+	)
+	return cmd
+}
+
+func listNodes(cmd *cobra.Command, args []string) error {
+	s, err := newSession(cmd)
+	if err != nil {
+		return err
+	}
+	var nodes []nodeView
+	raw, err := s.callInto(http.MethodGet, "nodes", nil, &nodes)
+	if err != nil {
+		return err
+	}
+	return s.render(raw, func() {
+		w := tabwriter.NewWriter(s.out, 0, 4, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "ID\tHOST\tSTATUS\tINTENDED\tPOC\tENABLED\tMODELS")
+		for _, n := range nodes {
+			status := n.State.CurrentStatus
+			if n.State.FailureReason != "" {
+				status += " (" + n.State.FailureReason + ")"
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s:%d\t%s\t%s\t%s\t%t\t%s\n",
+				n.Node.Id, n.Node.Host, n.Node.InferencePort, status, n.State.IntendedStatus,
+				n.State.PocCurrentStatus, n.State.AdminState.Enabled, strings.Join(sortedKeys(n.Node.Models), ","))
+		}
+		_ = w.Flush()
+	})
+}
+
+func nodeAddCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add [id]",
+		Short: "Register a new ML node",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := newSession(cmd)
+			if err != nil {
+				return err
+			}
+			node := apiconfig.InferenceNodeConfig{Id: args[0], Models: map[string]apiconfig.ModelConfig{}}
+			if err := applyNodeFlags(cmd, &node); err != nil {
+				return err
+			}
+			raw, err := s.call(http.MethodPost, "nodes", node)
+			if err != nil {
+				return err
+			}
+			return s.render(raw, func() { s.printf("Node %s added\n", node.Id) })
+		},
+	}
+	addNodeFlags(cmd)
+	return cmd
+}
+
+// nodeUpdateCommand changes only the flags given, the admin API replaces the whole node config
+func nodeUpdateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [id]",
+		Short: "Update an ML node, keeping settings that are not passed as flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := newSession(cmd)
+			if err != nil {
+				return err
+			}
+			var nodes []struct {
+				Node apiconfig.InferenceNodeConfig `json:"node"`
+			}
+			if _, err := s.callInto(http.MethodGet, "nodes", nil, &nodes); err != nil {
+				return err
+			}
+			var node *apiconfig.InferenceNodeConfig
+			for i := range nodes {
+				if nodes[i].Node.Id == args[0] {
+					node = &nodes[i].Node
+					break
+				}
+			}
+			if node == nil {
+				return fmt.Errorf("node %s not found", args[0])
+			}
+			if err := applyNodeFlags(cmd, node); err != nil {
+				return err
+			}
+			raw, err := s.call(http.MethodPut, "nodes/"+url.PathEscape(node.Id), node)
+			if err != nil {
+				return err
+			}
+			return s.render(raw, func() { s.printf("Node %s updated\n", node.Id) })
+		},
+	}
+	addNodeFlags(cmd)
+	return cmd
+}
+
+func nodeActionCommand(name string, short string, method string, suffix string) *cobra.Command {
+	return &cobra.Command{
+		Use:   name + " [id]",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := newSession(cmd)
+			if err != nil {
+				return err
+			}
+			raw, err := s.call(method, "nodes/"+url.PathEscape(args[0])+suffix, nil)
+			if err != nil {
+				return err
+			}
+			return s.render(raw, func() { s.printf("Node %s: %s done\n", args[0], name) })
+		},
+	}
+}
+
+func addNodeFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagNodeHost, "", "Host of the ML node")
+	cmd.Flags().Int(flagNodeInferencePort, 0, "Inference port of the ML node")
+	cmd.Flags().String(flagNodeInferenceSegment, "", "Inference url path segment")
+	cmd.Flags().Int(flagNodePocPort, 0, "PoC port of the ML node")
+	cmd.Flags().String(flagNodePocSegment, "", "PoC url path segment")
+	cmd.Flags().Int(flagNodeMaxConcurrent, 0, "Maximum concurrent inferences")
+	cmd.Flags().StringArray(flagNodeModel, nil, "Model served by the node, may be repeated. Replaces the model list on update")
+	cmd.Flags().StringToString(flagNodeModelArgs, nil, "Launch args per model, e.g. --model-args Qwen/Qwen3-32B-FP8=\"--tensor-parallel-size 4\"")
+}
+
+// applyNodeFlags overwrites the node fields whose flags were set
+func applyNodeFlags(cmd *cobra.Command, node *apiconfig.InferenceNodeConfig) error {
+	flags := cmd.Flags()
+	var err error
+	if flags.Changed(flagNodeHost) {
+		node.Host, err = flags.GetString(flagNodeHost)
+	}
+	if err == nil && flags.Changed(flagNodeInferencePort) {
+		node.InferencePort, err = flags.GetInt(flagNodeInferencePort)
+	}
+	if err == nil && flags.Changed(flagNodeInferenceSegment) {
+		node.InferenceSegment, err = flags.GetString(flagNodeInferenceSegment)
+	}
+	if err == nil && flags.Changed(flagNodePocPort) {
+		node.PoCPort, err = flags.GetInt(flagNodePocPort)
+	}
+	if err == nil && flags.Changed(flagNodePocSegment) {
+		node.PoCSegment, err = flags.GetString(flagNodePocSegment)
+	}
+	if err == nil && flags.Changed(flagNodeMaxConcurrent) {
+		node.MaxConcurrent, err = flags.GetInt(flagNodeMaxConcurrent)
+	}
+	if err != nil {
+		return err
+	}
+
+	if flags.Changed(flagNodeModel) {
+		models, err := flags.GetStringArray(flagNodeModel)
+		if err != nil {
+			return err
+		}
+		node.Models = make(map[string]apiconfig.ModelConfig, len(models))
+		for _, model := range models {
+			node.Models[model] = apiconfig.ModelConfig{Args: []string{}}
+		}
+	}
+	if flags.Changed(flagNodeModelArgs) {
+		modelArgs, err := flags.GetStringToString(flagNodeModelArgs)
+		if err != nil {
+			return err
+		}
+		for model, args := range modelArgs {
+			if _, ok := node.Models[model]; !ok {
+				return fmt.Errorf("--%s given for %s which is not a model of the node", flagNodeModelArgs, model)
+			}
+			node.Models[model] = apiconfig.ModelConfig{Args: strings.Fields(args)}
+		}
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
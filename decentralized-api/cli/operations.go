@@ -0,0 +1,174 @@
+package cli
+
+import (
+	"decentralized-api/internal/server/admin"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/spf13/cobra"
+)
+
+func priceProposalCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-proposal",
+		Short: "View or submit this participant's unit of compute price proposal",
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "get",
+		Short: "Show the current unit of compute price proposal",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := newSession(cmd)
+			if err != nil {
+				return err
+			}
+			var response types.QueryGetUnitOfComputePriceProposalResponse
+			raw, err := s.callInto(http.MethodGet, "unit-of-compute-price-proposal", nil, &response)
+			if err != nil {
+				return err
+			}
+			return s.render(raw, func() {
+				if response.Proposal == nil {
+					s.printf("No proposal submitted, default %d ngonka\n", response.Default)
+					return
+				}
+				s.printf("Proposed %d ngonka at height %d (default %d)\n",
+					response.Proposal.Price, response.Proposal.ProposedAtBlockHeight, response.Default)
+			})
+		},
+	})
+
+	set := &cobra.Command{
+		Use:   "set [price]",
+		Short: "Submit a unit of compute price proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := newSession(cmd)
+			if err != nil {
+				return err
+			}
+			var price uint64
+			if _, err := fmt.Sscan(args[0], &price); err != nil {
+				return fmt.Errorf("invalid price %q: %w", args[0], err)
+			}
+			denom, err := cmd.Flags().GetString("denom")
+			if err != nil {
+				return err
+			}
+			raw, err := s.call(http.MethodPost, "unit-of-compute-price-proposal", admin.UnitOfComputePriceProposalDto{Price: price, Denom: denom})
+			if err != nil {
+				return err
+			}
+			return s.render(raw, func() { s.printf("Proposed %d %s per unit of compute\n", price, denom) })
+		},
+	}
+	set.Flags().String("denom", types.NanoCoin, "Denom of the price: ngonka, ugonka, mgonka or gonka")
+	cmd.AddCommand(set)
+	return cmd
+}
+
+func claimRewardCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-reward",
+		Short: "Reward claim operations",
+	}
+	recoverCmd := &cobra.Command{
+		Use:   "recover",
+		Short: "Run missed validations and claim the reward of an epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := newSession(cmd)
+			if err != nil {
+				return err
+			}
+			var request admin.ClaimRewardRecoverRequest
+			if request.EpochIndex, err = cmd.Flags().GetUint64("epoch"); err != nil {
+				return err
+			}
+			if request.ForceClaim, err = cmd.Flags().GetBool("force"); err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("seed") {
+				seed, err := cmd.Flags().GetInt64("seed")
+				if err != nil {
+					return err
+				}
+				request.Seed = &seed
+			}
+			var response admin.ClaimRewardRecoverResponse
+			raw, err := s.callInto(http.MethodPost, "claim-reward/recover", request, &response)
+			if err != nil {
+				return err
+			}
+			return s.render(raw, func() {
+				status := s.colorize(colorGreen, "OK")
+				if !response.Success {
+					status = s.colorize(colorRed, "FAILED")
+				}
+				s.printf("%s epoch %d: %s\n", status, response.EpochIndex, response.Message)
+				s.printf("Missed validations %d, already claimed %t, claim executed %t\n",
+					response.MissedValidations, response.AlreadyClaimed, response.ClaimExecuted)
+			})
+		},
+	}
+	recoverCmd.Flags().Uint64("epoch", 0, "Epoch to recover, defaults to the previous epoch")
+	recoverCmd.Flags().Int64("seed", 0, "Seed to claim with, defaults to the stored or derived seed")
+	recoverCmd.Flags().Bool("force", false, "Claim even if the reward was already claimed")
+	cmd.AddCommand(recoverCmd)
+	return cmd
+}
+
+func exportDbCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-db",
+		Short: "Export the API node database state as JSON",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := newSession(cmd)
+			if err != nil {
+				return err
+			}
+			raw, err := s.call(http.MethodGet, "export/db", nil)
+			if err != nil {
+				return err
+			}
+			file, err := cmd.Flags().GetString("file")
+			if err != nil {
+				return err
+			}
+			if file == "" {
+				s.output = outputJson
+				return s.render(raw, nil)
+			}
+			if err := os.WriteFile(file, raw, 0o600); err != nil {
+				return err
+			}
+			s.printf("Exported %d bytes to %s\n", len(raw), file)
+			return nil
+		},
+	}
+	cmd.Flags().String("file", "", "Write the export to a file instead of stdout")
+	return cmd
+}
+
+func configCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "config",
+		Short: "Print the running configuration as JSON, including secrets",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := newSession(cmd)
+			if err != nil {
+				return err
+			}
+			raw, err := s.call(http.MethodGet, "config", nil)
+			if err != nil {
+				return err
+			}
+			s.output = outputJson
+			return s.render(raw, nil)
+		},
+	}
+}
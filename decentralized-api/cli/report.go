@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"decentralized-api/internal/server/admin"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+func setupReportCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "setup-report",
+		Short: "Run the setup and health checks, exits non-zero when a check fails",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := newSession(cmd)
+			if err != nil {
+				return err
+			}
+			var report admin.SetupReport
+			raw, err := s.callInto(http.MethodGet, "setup/report", nil, &report)
+			if err != nil {
+				return err
+			}
+			if err := s.render(raw, func() { s.printSetupReport(&report) }); err != nil {
+				return err
+			}
+			if report.OverallStatus == admin.FAIL {
+				return fmt.Errorf("%d of %d setup checks failed", report.Summary.FailedChecks, report.Summary.TotalChecks)
+			}
+			return nil
+		},
+	}
+}
+
+func (s *session) printSetupReport(report *admin.SetupReport) {
+	s.printf("Setup report %s, generated %s\n\n", s.statusLabel(report.OverallStatus), report.GeneratedAt.Format("2006-01-02 15:04:05 MST"))
+	for _, check := range report.Checks {
+		s.printf("  %s  %s: %s\n", s.statusLabel(check.Status), check.ID, check.Message)
+	}
+	s.printf("\n%d passed, %d failed, %d unavailable\n",
+		report.Summary.PassedChecks, report.Summary.FailedChecks, report.Summary.UnavailableChecks)
+	printList := func(title string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		s.printf("\n%s:\n", title)
+		for _, item := range items {
+			s.printf("  - %s\n", item)
+		}
+	}
+	printList("Issues", report.Summary.Issues)
+	printList("Recommendations", report.Summary.Recommendations)
+}
+
+func (s *session) statusLabel(status admin.CheckStatus) string {
+	label := fmt.Sprintf("[%s]", status)
+	switch status {
+	case admin.PASS:
+		return s.colorize(colorGreen, label)
+	case admin.FAIL:
+		return s.colorize(colorRed, label)
+	default:
+		return s.colorize(colorYellow, label)
+	}
+}
+
+func epochCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "epoch [epoch|current]",
+		Short: "Show this participant's weight, ML node assignments and earnings for an epoch",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := newSession(cmd)
+			if err != nil {
+				return err
+			}
+			epoch := "current"
+			if len(args) == 1 {
+				epoch = args[0]
+			}
+			var summary admin.EpochSummaryDto
+			raw, err := s.callInto(http.MethodGet, "epochs/"+epoch+"/summary", nil, &summary)
+			if err != nil {
+				return err
+			}
+			return s.render(raw, func() { s.printEpochSummary(&summary) })
+		},
+	}
+}
+
+func (s *session) printEpochSummary(summary *admin.EpochSummaryDto) {
+	s.printf("Epoch %d, participant %s\n", summary.EpochIndex, summary.Address)
+	s.printf("Weight %d of %d (confirmed %d)\n\n", summary.Weight, summary.TotalWeight, summary.ConfirmationWeight)
+
+	if len(summary.Models) == 0 {
+		s.printf("No model assignments\n")
+	} else {
+		w := tabwriter.NewWriter(s.out, 0, 4, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "MODEL\tNODE\tPOC WEIGHT\tTIMESLOTS")
+		for _, model := range summary.Models {
+			for _, node := range model.MlNodes {
+				slots := make([]string, len(node.TimeslotAllocation))
+				for i, allocated := range node.TimeslotAllocation {
+					slots[i] = "-"
+					if allocated {
+						slots[i] = "x"
+					}
+				}
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", model.ModelId, node.NodeId, node.PocWeight, strings.Join(slots, ""))
+			}
+		}
+		_ = w.Flush()
+	}
+
+	p := summary.Performance
+	if p == nil {
+		s.printf("\nEarnings not settled yet\n")
+		return
+	}
+	s.printf("\nInferences %d (validated %d, invalidated %d, missed %d)\n",
+		p.InferenceCount, p.ValidatedInferences, p.InvalidatedInferences, p.MissedRequests)
+	claimed := s.colorize(colorYellow, "unclaimed")
+	if p.Claimed {
+		claimed = s.colorize(colorGreen, "claimed")
+	}
+	s.printf("Earned %d, rewarded %d, burned %d ngonka, %s\n", p.EarnedCoins, p.RewardedCoins, p.BurnedCoins, claimed)
+}
@@ -0,0 +1,69 @@
+// Package cli implements the dapi operator commands. They run against the admin API of a
+// running decentralized-api, so they work from the api container or through a tunnel.
+package cli
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagAdminUrl = "admin-url"
+	flagOutput   = "output"
+	flagNoColor  = "no-color"
+
+	adminUrlEnv     = "DAPI_ADMIN_URL"
+	defaultAdminUrl = "http://localhost:9200"
+
+	outputText = "text"
+	outputJson = "json"
+)
+
+func NewRootCommand() *cobra.Command {
+	adminUrl := os.Getenv(adminUrlEnv)
+	if adminUrl == "" {
+		adminUrl = defaultAdminUrl
+	}
+
+	root := &cobra.Command{
+		Use:           "dapi",
+		Short:         "Day-2 operator commands for a running decentralized-api",
+		SilenceUsage:  true,
+		SilenceErrors: false,
+	}
+	root.PersistentFlags().String(flagAdminUrl, adminUrl, "Admin API url, defaults to $"+adminUrlEnv)
+	root.PersistentFlags().StringP(flagOutput, "o", outputText, "Output format: text or json")
+	root.PersistentFlags().Bool(flagNoColor, os.Getenv("NO_COLOR") != "", "Disable colored output")
+	root.AddCommand(
+		nodesCommand(),
+		setupReportCommand(),
+		epochCommand(),
+		priceProposalCommand(),
+		claimRewardCommand(),
+		exportDbCommand(),
+		configCommand(),
+	)
+	return root
+}
+
+// IsCommand reports whether the first process argument is an operator command
+// rather than a request to start the server
+func IsCommand(name string) bool {
+	for _, cmd := range NewRootCommand().Commands() {
+		if cmd.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Execute runs an operator command and returns the process exit code
+func Execute(args []string) int {
+	root := NewRootCommand()
+	root.SetArgs(args)
+	if err := root.Execute(); err != nil {
+		return 1
+	}
+	return 0
+}
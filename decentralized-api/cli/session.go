@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+)
+
+// session carries the admin client and output settings of one command invocation
+type session struct {
+	ctx      context.Context
+	adminUrl string
+	http     *http.Client
+	output   string
+	color    bool
+	out      io.Writer
+}
+
+func newSession(cmd *cobra.Command) (*session, error) {
+	adminUrl, err := cmd.Flags().GetString(flagAdminUrl)
+	if err != nil {
+		return nil, err
+	}
+	output, err := cmd.Flags().GetString(flagOutput)
+	if err != nil {
+		return nil, err
+	}
+	if output != outputText && output != outputJson {
+		return nil, fmt.Errorf("unknown output format %q, expected text or json", output)
+	}
+	noColor, err := cmd.Flags().GetBool(flagNoColor)
+	if err != nil {
+		return nil, err
+	}
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &session{
+		ctx:      ctx,
+		adminUrl: strings.TrimSuffix(adminUrl, "/"),
+		http:     &http.Client{Timeout: 5 * time.Minute},
+		output:   output,
+		color:    !noColor,
+		out:      cmd.OutOrStdout(),
+	}, nil
+}
+
+// call sends body as JSON to the admin API and returns the raw response body
+func (s *session) call(method string, path string, body any) (json.RawMessage, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(s.ctx, method, s.adminUrl+"/admin/v1/"+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("admin API unreachable at %s: %w", s.adminUrl, err)
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%s %s: %d %s", method, path, resp.StatusCode, errorMessage(raw))
+	}
+	return raw, nil
+}
+
+// callInto is call followed by decoding the response into out
+func (s *session) callInto(method string, path string, body any, out any) (json.RawMessage, error) {
+	raw, err := s.call(method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	if out != nil && len(raw) > 0 {
+		if err := json.Unmarshal(raw, out); err != nil {
+			return raw, fmt.Errorf("decode %s response: %w", path, err)
+		}
+	}
+	return raw, nil
+}
+
+// render prints the raw response for json output, otherwise the text rendering
+func (s *session) render(raw json.RawMessage, text func()) error {
+	if s.output == outputJson {
+		if len(raw) == 0 {
+			raw = json.RawMessage("{}")
+		}
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, raw, "", "  "); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintln(s.out, indented.String())
+		return err
+	}
+	text()
+	return nil
+}
+
+func (s *session) colorize(color string, text string) string {
+	if !s.color {
+		return text
+	}
+	return color + text + colorReset
+}
+
+func (s *session) printf(format string, args ...any) {
+	_, _ = fmt.Fprintf(s.out, format, args...)
+}
+
+// errorMessage extracts the echo error message from a failed admin response
+func errorMessage(raw []byte) string {
+	var body struct {
+		Message any    `json:"message"`
+		Error   string `json:"error"`
+	}
+	if err := json.Unmarshal(raw, &body); err == nil {
+		if body.Message != nil {
+			return fmt.Sprint(body.Message)
+		}
+		if body.Error != "" {
+			return body.Error
+		}
+	}
+	return strings.TrimSpace(string(raw))
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/productscience/inference/x/inference/types"
 )
 
+// EmptyResponseToken is the synthetic logprob token of a response that produced no output
+const EmptyResponseToken = "<EMPTY>"
+
 type CompletionResponse interface {
 	GetModel() (string, error)
 	GetInferenceId() (string, error)
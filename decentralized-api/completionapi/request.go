@@ -13,12 +13,18 @@ type ModifiedRequest struct {
 	OriginalTopLogprobsValue *int
 }
 
-func ModifyRequestBody(requestBytes []byte, defaultSeed int32) (*ModifiedRequest, error) {
+// ModifyRequestBody prepares a user request for the ML node. A non-empty model replaces the requested one,
+// which is how model aliases are resolved to the governance model id.
+func ModifyRequestBody(requestBytes []byte, defaultSeed int32, model string) (*ModifiedRequest, error) {
 	var requestMap map[string]interface{}
 	if err := json.Unmarshal(requestBytes, &requestMap); err != nil {
 		return nil, err
 	}
 
+	if model != "" {
+		requestMap["model"] = model
+	}
+
 	originalLogprobsValue := getOriginalLogprobs(requestMap)
 	if originalLogprobsValue == nil || *originalLogprobsValue == false {
 		requestMap["logprobs"] = true
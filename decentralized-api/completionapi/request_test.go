@@ -84,7 +84,7 @@ const (
 )
 
 func Test(t *testing.T) {
-	r, err := ModifyRequestBody([]byte(jsonBodyNullLogprobs), 7)
+	r, err := ModifyRequestBody([]byte(jsonBodyNullLogprobs), 7, "")
 	if err != nil {
 		panic(err)
 	}
@@ -98,7 +98,7 @@ func Test(t *testing.T) {
 }
 
 func TestStreamOptions_NoOptions(t *testing.T) {
-	r, err := ModifyRequestBody([]byte(jsonBodyStreamNoStreamOptions), 7)
+	r, err := ModifyRequestBody([]byte(jsonBodyStreamNoStreamOptions), 7, "")
 	require.NoError(t, err)
 	require.NotNil(t, r)
 	var requestMap map[string]interface{}
@@ -112,7 +112,7 @@ func TestStreamOptions_NoOptions(t *testing.T) {
 }
 
 func TestStreamOptions_WithOptions(t *testing.T) {
-	r, err := ModifyRequestBody([]byte(jsonBodyStreamWithStreamOptions), 7)
+	r, err := ModifyRequestBody([]byte(jsonBodyStreamWithStreamOptions), 7, "")
 	require.NoError(t, err)
 	require.NotNil(t, r)
 	var requestMap map[string]interface{}
@@ -138,7 +138,7 @@ func TestMaxTokens(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := ModifyRequestBody([]byte(tt.input), 7)
+			r, err := ModifyRequestBody([]byte(tt.input), 7, "")
 			require.NoError(t, err)
 			require.NotNil(t, r)
 
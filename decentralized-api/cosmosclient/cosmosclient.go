@@ -214,6 +214,7 @@ type CosmosMessageClient interface {
 	AssignTrainingTask(transaction *inference.MsgAssignTrainingTask) (*inference.MsgAssignTrainingTaskResponse, error)
 	SubmitUnitOfComputePriceProposal(transaction *inference.MsgSubmitUnitOfComputePriceProposal) error
 	BridgeExchange(transaction *types.MsgBridgeExchange) error
+	AttestBridgeBlock(transaction *types.MsgAttestBridgeBlock) error
 	GetBridgeAddresses(ctx context.Context, chainId string) ([]types.BridgeContractAddress, error)
 	NewInferenceQueryClient() types.QueryClient
 	NewCometQueryClient() cmtservice.ServiceClient
@@ -431,6 +432,12 @@ func (icc *InferenceCosmosClient) BridgeExchange(transaction *types.MsgBridgeExc
 	return err
 }
 
+func (icc *InferenceCosmosClient) AttestBridgeBlock(transaction *types.MsgAttestBridgeBlock) error {
+	transaction.Validator = icc.Address
+	_, err := icc.manager.SendTransactionAsyncNoRetry(transaction)
+	return err
+}
+
 // GetBridgeAddresses retrieves all bridge addresses for a specific chain
 func (icc *InferenceCosmosClient) GetBridgeAddresses(ctx context.Context, chainId string) ([]types.BridgeContractAddress, error) {
 	queryClient := icc.NewInferenceQueryClient()
@@ -158,6 +158,11 @@ func (m *MockCosmosMessageClient) BridgeExchange(transaction *types.MsgBridgeExc
 	return args.Error(0)
 }
 
+func (m *MockCosmosMessageClient) AttestBridgeBlock(transaction *types.MsgAttestBridgeBlock) error {
+	args := m.Called(transaction)
+	return args.Error(0)
+}
+
 func (m *MockCosmosMessageClient) GetBridgeAddresses(ctx context.Context, chainId string) ([]types.BridgeContractAddress, error) {
 	args := m.Called(ctx, chainId)
 	return args.Get(0).([]types.BridgeContractAddress), args.Error(1)
@@ -53,7 +53,21 @@ func (bm *BlsManager) ProcessGroupPublicKeyGeneratedToSign(event *chainevents.JS
 		return nil
 	}
 
+	// The validating epoch is the previous one, unless that epoch's DKG failed and it carries an earlier epoch's key
 	previousEpochID := newEpochID - 1
+	if validatingEpochIDs, ok := event.Result.Events["inference.bls.EventGroupPublicKeyGenerated.validating_epoch_id"]; ok && len(validatingEpochIDs) > 0 {
+		unquotedValidatingEpochID, err := utils.UnquoteEventValue(validatingEpochIDs[0])
+		if err != nil {
+			return fmt.Errorf("failed to unquote validating_epoch_id: %w", err)
+		}
+		validatingEpochID, err := strconv.ParseUint(unquotedValidatingEpochID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse validating_epoch_id: %w", err)
+		}
+		if validatingEpochID != 0 {
+			previousEpochID = validatingEpochID
+		}
+	}
 
 	previousEpochResult, err := bm.GetOrRecoverVerificationResult(previousEpochID)
 	if err != nil {
@@ -32,6 +32,7 @@ type BlsManager struct {
 type VerificationResult struct {
 	EpochID          uint64
 	DkgPhase         types.DKGPhase // The DKG phase when verification was performed
+	DkgAttempt       uint32         // The DKG attempt the shares belong to; a retried DKG deals new shares
 	IsParticipant    bool
 	SlotRange        [2]uint32      // [start_index, end_index]
 	DealerShares     [][]fr.Element // dealer_index -> [slot_shares...]
@@ -36,13 +36,28 @@ func (bm *BlsManager) ProcessVerifyingPhaseStarted(event *chainevents.JSONRPCRes
 		return fmt.Errorf("failed to parse epoch_id: %w", err)
 	}
 
+	// A retried DKG deals again, so only a result of the same attempt can be reused
+	attempt := uint32(0)
+	if attemptStrs, ok := event.Result.Events["inference.bls.EventVerifyingPhaseStarted.attempt"]; ok && len(attemptStrs) > 0 {
+		unquotedAttempt, err := utils.UnquoteEventValue(attemptStrs[0])
+		if err != nil {
+			return fmt.Errorf("failed to unquote attempt: %w", err)
+		}
+		parsedAttempt, err := strconv.ParseUint(unquotedAttempt, 10, 32)
+		if err != nil {
+			return fmt.Errorf("failed to parse attempt: %w", err)
+		}
+		attempt = uint32(parsedAttempt)
+	}
+
 	existingResult := bm.GetVerificationResult(epochID)
-	if existingResult != nil &&
+	if existingResult != nil && existingResult.DkgAttempt == attempt &&
 		(existingResult.DkgPhase == types.DKGPhase_DKG_PHASE_VERIFYING ||
 			existingResult.DkgPhase == types.DKGPhase_DKG_PHASE_COMPLETED ||
 			existingResult.DkgPhase == types.DKGPhase_DKG_PHASE_SIGNED) {
 		logging.Info(verifierLogTag+"Verification already completed for this epoch", inferenceTypes.BLS,
 			"epochID", epochID,
+			"dkgAttempt", attempt,
 			"existingPhase", existingResult.DkgPhase,
 			"isParticipant", existingResult.IsParticipant)
 		return nil
@@ -103,6 +118,7 @@ func (bm *BlsManager) setupAndPerformVerification(epochID uint64, epochData *typ
 	}
 
 	verificationResult.DkgPhase = epochData.DkgPhase
+	verificationResult.DkgAttempt = epochData.DkgAttempt
 
 	switch epochData.DkgPhase {
 	case types.DKGPhase_DKG_PHASE_VERIFYING,
@@ -530,7 +546,7 @@ func (bm *BlsManager) ProcessGroupPublicKeyGeneratedToVerify(event *chainevents.
 	}
 
 	// If we don't have a VERIFYING result, we need to perform verification first
-	if existingResult == nil || existingResult.DkgPhase != types.DKGPhase_DKG_PHASE_VERIFYING {
+	if existingResult == nil || existingResult.DkgPhase != types.DKGPhase_DKG_PHASE_VERIFYING || existingResult.DkgAttempt != epochData.DkgAttempt {
 		logging.Debug(verifierLogTag+"No verification result found, performing verification", inferenceTypes.BLS,
 			"epochID", epochID,
 			"existingPhase", func() string {
@@ -575,6 +591,7 @@ func (bm *BlsManager) ProcessGroupPublicKeyGeneratedToVerify(event *chainevents.
 	completedResult := &VerificationResult{
 		EpochID:          epochID,
 		DkgPhase:         types.DKGPhase_DKG_PHASE_COMPLETED,
+		DkgAttempt:       existingResult.DkgAttempt,
 		IsParticipant:    existingResult.IsParticipant,
 		SlotRange:        existingResult.SlotRange,
 		DealerShares:     existingResult.DealerShares,
@@ -670,6 +687,26 @@ func (bm *BlsManager) parseEpochDataFromJSON(jsonStr string) (*types.EpochBLSDat
 		}
 	}
 
+	if keyCarriedFromEpochStr, ok := epochDataMap["key_carried_from_epoch"].(string); ok {
+		if keyCarriedFromEpoch, err := strconv.ParseUint(keyCarriedFromEpochStr, 10, 64); err == nil {
+			epochDataMap["key_carried_from_epoch"] = keyCarriedFromEpoch
+		}
+	}
+
+	if failedAttempts, ok := epochDataMap["failed_attempts"].([]interface{}); ok {
+		for _, failedAttempt := range failedAttempts {
+			attemptMap, ok := failedAttempt.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if failedAtHeightStr, ok := attemptMap["failed_at_height"].(string); ok {
+				if failedAtHeight, err := strconv.ParseInt(failedAtHeightStr, 10, 64); err == nil {
+					attemptMap["failed_at_height"] = failedAtHeight
+				}
+			}
+		}
+	}
+
 	if dealingDeadlineStr, ok := epochDataMap["dealing_phase_deadline_block"].(string); ok {
 		if dealingDeadline, err := strconv.ParseInt(dealingDeadlineStr, 10, 64); err == nil {
 			epochDataMap["dealing_phase_deadline_block"] = dealingDeadline
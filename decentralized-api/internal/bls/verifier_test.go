@@ -373,3 +373,33 @@ func TestProcessGroupPublicKeyGeneratedEventParsing(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to parse epoch_id")
 }
+
+func TestParseEpochDataFromJSON_RetriedAndCarried(t *testing.T) {
+	blsManager := NewBlsManager(createMockCosmosClient())
+
+	// Proto JSON encodes 64-bit integers as strings, including those nested in failed attempts
+	jsonStr := `{
+		"epoch_id": "30",
+		"i_total_slots": 100,
+		"t_slots_degree": 50,
+		"dkg_phase": "DKG_PHASE_FAILED",
+		"dealing_phase_deadline_block": "120",
+		"verifying_phase_deadline_block": "150",
+		"dkg_attempt": 1,
+		"failed_attempts": [
+			{"attempt": 0, "reason": "dealing", "failed_at_height": "100", "excluded_participants": ["cosmos1a"], "excluded_weight": "0.500000000000000000"}
+		],
+		"key_carried_from_epoch": "29"
+	}`
+
+	epochData, err := blsManager.parseEpochDataFromJSON(jsonStr)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(30), epochData.EpochId)
+	assert.Equal(t, types.DKGPhase_DKG_PHASE_FAILED, epochData.DkgPhase)
+	assert.Equal(t, uint32(1), epochData.DkgAttempt)
+	assert.Equal(t, uint64(29), epochData.KeyCarriedFromEpoch)
+	assert.Len(t, epochData.FailedAttempts, 1)
+	assert.Equal(t, int64(100), epochData.FailedAttempts[0].FailedAtHeight)
+	assert.Equal(t, []string{"cosmos1a"}, epochData.FailedAttempts[0].ExcludedParticipants)
+	assert.Equal(t, "0.500000000000000000", epochData.FailedAttempts[0].ExcludedWeight.String())
+}
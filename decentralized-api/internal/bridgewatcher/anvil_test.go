@@ -0,0 +1,86 @@
+package bridgewatcher
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWatcher_Anvil runs the watcher against a local dev chain, e.g. `anvil` started with default settings:
+//
+//	BRIDGE_WATCHER_ANVIL_URL=http://127.0.0.1:8545 go test ./internal/bridgewatcher -run Anvil
+//
+// It deploys a contract that emits an ERC-20 Transfer from the caller to itself, registers the contract
+// as a bridge address and checks that the call is picked up as a deposit with a valid receipt proof.
+func TestWatcher_Anvil(t *testing.T) {
+	url := os.Getenv("BRIDGE_WATCHER_ANVIL_URL")
+	if url == "" {
+		t.Skip("BRIDGE_WATCHER_ANVIL_URL not set")
+	}
+	ctx := context.Background()
+	evm := NewEvmClient(url)
+
+	var accounts []hexBytes
+	require.NoError(t, evm.call(ctx, &accounts, "eth_accounts"))
+	require.NotEmpty(t, accounts)
+	sender := accounts[0]
+
+	// Runtime code: mstore(0, 500); log3(0, 32, Transfer, caller(), address()); stop()
+	runtime := "6101f4600052" + "30" + "33" + "7f" + hex.EncodeToString(erc20TransferTopic) + "60206000a3" + "00"
+	// Init code copying the runtime code into memory and returning it
+	initCode := "602f80600b6000396000f3" + runtime
+
+	deployReceipt := sendTransaction(t, evm, map[string]string{"from": sender.String(), "data": "0x" + initCode})
+	var contract hexBytes
+	require.NoError(t, contract.UnmarshalJSON([]byte(`"`+deployReceipt["contractAddress"]+`"`)))
+	depositReceipt := sendTransaction(t, evm, map[string]string{"from": sender.String(), "to": contract.String()})
+	var depositBlock hexUint64
+	require.NoError(t, depositBlock.UnmarshalJSON([]byte(`"`+depositReceipt["blockNumber"]+`"`)))
+	require.NoError(t, evm.call(ctx, nil, "evm_mine"))
+
+	chain := newFakeChain(contract.String())
+	watcher := newTestWatcher(t, url, chain, filepath.Join(t.TempDir(), "watcher.db"))
+	confirmations := uint64(1)
+	watcher.config.Confirmations = &confirmations
+	watcher.config.StartBlock = uint64(depositBlock)
+	require.NoError(t, watcher.Poll(ctx))
+
+	block, err := evm.BlockByNumber(ctx, uint64(depositBlock))
+	require.NoError(t, err)
+	require.Len(t, chain.attestations, 1)
+	require.Equal(t, block.ReceiptsRoot.String(), chain.attestations[0].ReceiptsRoot)
+	require.Len(t, watcher.state.Pending, 1)
+	deposit := watcher.state.Pending[0]
+	require.Equal(t, "500", deposit.Amount)
+	require.Equal(t, strings.ToLower(contract.String()), deposit.ContractAddress)
+
+	var ownerPubKey hexBytes
+	require.NoError(t, ownerPubKey.UnmarshalJSON([]byte(`"`+deposit.OwnerPubKey+`"`)))
+	pubKey, err := secp256k1.ParsePubKey(ownerPubKey)
+	require.NoError(t, err)
+	require.Equal(t, sender, hexBytes(ethAddress(pubKey)))
+}
+
+func sendTransaction(t *testing.T, evm *EvmClient, tx map[string]string) map[string]string {
+	ctx := context.Background()
+	var hash string
+	require.NoError(t, evm.call(ctx, &hash, "eth_sendTransaction", tx))
+	var receipt map[string]any
+	require.NoError(t, evm.call(ctx, &receipt, "eth_getTransactionReceipt", hash))
+	require.NotNil(t, receipt, "anvil must mine transactions automatically")
+	require.Equal(t, "0x1", receipt["status"])
+
+	fields := make(map[string]string)
+	for key, value := range receipt {
+		if s, ok := value.(string); ok {
+			fields[key] = s
+		}
+	}
+	return fields
+}
@@ -0,0 +1,146 @@
+package bridgewatcher
+
+import (
+	"bytes"
+	"decentralized-api/cosmosclient"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/productscience/inference/x/inference/bridgeproof"
+)
+
+var (
+	// Transfer(address indexed from, address indexed to, uint256 value), emitted for ERC-20 deposits to a bridge contract
+	erc20TransferTopic = bridgeproof.Keccak256([]byte("Transfer(address,address,uint256)"))
+	// WGNKBurned(address indexed from, uint256 amount, uint256 timestamp), emitted when WGNK is sent back to the bridge contract
+	wgnkBurnedTopic = bridgeproof.Keccak256([]byte("WGNKBurned(address,uint256,uint256)"))
+)
+
+// Deposit is a transfer to a bridge contract found in a confirmed block, together with
+// the receipt proof the chain needs to complete it
+type Deposit struct {
+	BlockNumber     uint64   `json:"block_number"`
+	BlockHash       string   `json:"block_hash"`
+	ReceiptsRoot    string   `json:"receipts_root"`
+	ReceiptIndex    uint64   `json:"receipt_index"`
+	TxHash          string   `json:"tx_hash"`
+	ContractAddress string   `json:"contract_address"`
+	OwnerPubKey     string   `json:"owner_pub_key"`
+	OwnerAddress    string   `json:"owner_address"`
+	Amount          string   `json:"amount"`
+	ReceiptProof    [][]byte `json:"receipt_proof"`
+}
+
+// extractDeposits finds the deposits to bridgeAddresses in a block. The receipts are re-encoded to rebuild the
+// receipts trie, so a block whose receipts do not hash to its receipts root is rejected rather than proven wrong.
+func extractDeposits(block *EvmBlock, receipts []EvmReceipt, bridgeAddresses [][]byte) ([]Deposit, []error, error) {
+	encoded := make([][]byte, 0, len(receipts))
+	for _, receipt := range receipts {
+		encoded = append(encoded, encodeReceipt(receipt))
+	}
+
+	var deposits []Deposit
+	var skipped []error
+	for i, receipt := range receipts {
+		if receipt.Status != 1 {
+			continue
+		}
+		log, found := findDepositLog(receipt.Logs, bridgeAddresses)
+		if !found {
+			continue
+		}
+		if i >= len(block.Transactions) {
+			return nil, nil, fmt.Errorf("block %d has no transaction for receipt %d", block.Number, i)
+		}
+
+		root, proof := bridgeproof.ReceiptProof(encoded, uint64(i))
+		if !bytes.Equal(root, block.ReceiptsRoot) {
+			return nil, nil, fmt.Errorf("block %d receipts hash to %x but the block has receipts root %s", block.Number, root, block.ReceiptsRoot)
+		}
+
+		tx := &block.Transactions[i]
+		pubKey, err := senderPublicKey(tx)
+		if err != nil {
+			skipped = append(skipped, err)
+			continue
+		}
+		// The chain credits the deposit to the address of the key that sent it, so deposits made on
+		// behalf of someone else (e.g. by a contract) cannot be bridged
+		if !bytes.Equal(ethAddress(pubKey), log.Topics[1][12:]) {
+			skipped = append(skipped, fmt.Errorf("deposit in transaction %s was not sent by the depositor %x", tx.Hash, log.Topics[1][12:]))
+			continue
+		}
+		compressed := hex.EncodeToString(pubKey.SerializeCompressed())
+		ownerAddress, err := cosmosclient.PubKeyToAddress(compressed)
+		if err != nil {
+			skipped = append(skipped, err)
+			continue
+		}
+
+		deposits = append(deposits, Deposit{
+			BlockNumber:     uint64(block.Number),
+			BlockHash:       block.Hash.String(),
+			ReceiptsRoot:    block.ReceiptsRoot.String(),
+			ReceiptIndex:    uint64(i),
+			TxHash:          tx.Hash.String(),
+			ContractAddress: log.Address.String(),
+			OwnerPubKey:     "0x" + compressed,
+			OwnerAddress:    ownerAddress,
+			Amount:          new(big.Int).SetBytes(log.Data[:32]).String(),
+			ReceiptProof:    proof,
+		})
+	}
+	return deposits, skipped, nil
+}
+
+// findDepositLog returns the first log of a receipt that deposits to a bridge contract. The chain
+// records one bridge transaction per receipt, so later deposit logs in the same receipt are ignored.
+func findDepositLog(logs []EvmLog, bridgeAddresses [][]byte) (EvmLog, bool) {
+	for _, log := range logs {
+		if len(log.Topics) == 0 || len(log.Data) < 32 || !wordSized(log.Topics) {
+			continue
+		}
+		switch {
+		case bytes.Equal(log.Topics[0], erc20TransferTopic) && len(log.Topics) == 3:
+			if containsAddress(bridgeAddresses, log.Topics[2][12:]) {
+				return log, true
+			}
+		case bytes.Equal(log.Topics[0], wgnkBurnedTopic) && len(log.Topics) == 2:
+			if containsAddress(bridgeAddresses, log.Address) {
+				return log, true
+			}
+		}
+	}
+	return EvmLog{}, false
+}
+
+func wordSized(topics []hexBytes) bool {
+	for _, topic := range topics {
+		if len(topic) != 32 {
+			return false
+		}
+	}
+	return true
+}
+
+func encodeReceipt(receipt EvmReceipt) []byte {
+	logs := make([]bridgeproof.Log, 0, len(receipt.Logs))
+	for _, log := range receipt.Logs {
+		topics := make([][]byte, 0, len(log.Topics))
+		for _, topic := range log.Topics {
+			topics = append(topics, topic)
+		}
+		logs = append(logs, bridgeproof.Log{Address: log.Address, Topics: topics, Data: log.Data})
+	}
+	return bridgeproof.EncodeReceipt(byte(receipt.Type), receipt.Status == 1, uint64(receipt.CumulativeGasUsed), receipt.LogsBloom, logs)
+}
+
+func containsAddress(addresses [][]byte, address []byte) bool {
+	for _, candidate := range addresses {
+		if bytes.Equal(candidate, address) {
+			return true
+		}
+	}
+	return false
+}
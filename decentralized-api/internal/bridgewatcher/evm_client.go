@@ -0,0 +1,288 @@
+package bridgewatcher
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// methodNotFoundCode is the JSON-RPC error code returned by nodes that do not implement a method
+const methodNotFoundCode = -32601
+
+// EvmClient is a minimal Ethereum JSON-RPC client covering the calls needed to follow a chain
+type EvmClient struct {
+	url        string
+	httpClient *http.Client
+	requestId  atomic.Int64
+}
+
+func NewEvmClient(url string) *EvmClient {
+	return &EvmClient{
+		url:        url,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type rpcRequest struct {
+	JsonRpc string `json:"jsonrpc"`
+	Id      int64  `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *RpcError       `json:"error"`
+}
+
+// RpcError is an error returned by the JSON-RPC node
+type RpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *RpcError) Error() string {
+	return fmt.Sprintf("json-rpc error %d: %s", e.Code, e.Message)
+}
+
+// EvmBlock is a block as returned by eth_getBlockByNumber with full transactions
+type EvmBlock struct {
+	Number       hexUint64        `json:"number"`
+	Hash         hexBytes         `json:"hash"`
+	ParentHash   hexBytes         `json:"parentHash"`
+	ReceiptsRoot hexBytes         `json:"receiptsRoot"`
+	Transactions []EvmTransaction `json:"transactions"`
+}
+
+// EvmTransaction holds the transaction fields needed to recover the sender's public key
+type EvmTransaction struct {
+	Hash                 hexBytes         `json:"hash"`
+	Type                 hexUint64        `json:"type"`
+	TransactionIndex     hexUint64        `json:"transactionIndex"`
+	From                 hexBytes         `json:"from"`
+	ChainId              *hexBig          `json:"chainId"`
+	Nonce                hexUint64        `json:"nonce"`
+	GasPrice             *hexBig          `json:"gasPrice"`
+	MaxPriorityFeePerGas *hexBig          `json:"maxPriorityFeePerGas"`
+	MaxFeePerGas         *hexBig          `json:"maxFeePerGas"`
+	Gas                  hexUint64        `json:"gas"`
+	To                   hexBytes         `json:"to"`
+	Value                *hexBig          `json:"value"`
+	Input                hexBytes         `json:"input"`
+	AccessList           []EvmAccessTuple `json:"accessList"`
+	V                    *hexBig          `json:"v"`
+	R                    *hexBig          `json:"r"`
+	S                    *hexBig          `json:"s"`
+}
+
+type EvmAccessTuple struct {
+	Address     hexBytes   `json:"address"`
+	StorageKeys []hexBytes `json:"storageKeys"`
+}
+
+// EvmReceipt holds the receipt fields that make up its consensus encoding
+type EvmReceipt struct {
+	Type              hexUint64 `json:"type"`
+	Status            hexUint64 `json:"status"`
+	CumulativeGasUsed hexUint64 `json:"cumulativeGasUsed"`
+	LogsBloom         hexBytes  `json:"logsBloom"`
+	Logs              []EvmLog  `json:"logs"`
+	TransactionHash   hexBytes  `json:"transactionHash"`
+	TransactionIndex  hexUint64 `json:"transactionIndex"`
+}
+
+type EvmLog struct {
+	Address hexBytes   `json:"address"`
+	Topics  []hexBytes `json:"topics"`
+	Data    hexBytes   `json:"data"`
+}
+
+func (c *EvmClient) call(ctx context.Context, result any, method string, params ...any) error {
+	if params == nil {
+		params = []any{}
+	}
+	body, err := json.Marshal(rpcRequest{JsonRpc: "2.0", Id: c.requestId.Add(1), Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", method, err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%s: %w", method, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: unexpected status %d: %s", method, resp.StatusCode, string(respBody))
+	}
+
+	var rpcResp rpcResponse
+	if err := json.Unmarshal(respBody, &rpcResp); err != nil {
+		return fmt.Errorf("%s: invalid response: %w", method, err)
+	}
+	if rpcResp.Error != nil {
+		return rpcResp.Error
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(rpcResp.Result, result)
+}
+
+// BlockNumber returns the number of the latest block
+func (c *EvmClient) BlockNumber(ctx context.Context) (uint64, error) {
+	var number hexUint64
+	if err := c.call(ctx, &number, "eth_blockNumber"); err != nil {
+		return 0, err
+	}
+	return uint64(number), nil
+}
+
+// BlockByNumber returns the block at number with its full transactions
+func (c *EvmClient) BlockByNumber(ctx context.Context, number uint64) (*EvmBlock, error) {
+	var block *EvmBlock
+	if err := c.call(ctx, &block, "eth_getBlockByNumber", encodeQuantity(number), true); err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block %d not found", number)
+	}
+	return block, nil
+}
+
+// BlockReceipts returns all receipts of the block ordered by transaction index. Nodes without
+// eth_getBlockReceipts are queried one transaction at a time.
+func (c *EvmClient) BlockReceipts(ctx context.Context, block *EvmBlock) ([]EvmReceipt, error) {
+	var receipts []EvmReceipt
+	err := c.call(ctx, &receipts, "eth_getBlockReceipts", encodeQuantity(uint64(block.Number)))
+	var rpcErr *RpcError
+	if errors.As(err, &rpcErr) && rpcErr.Code == methodNotFoundCode {
+		receipts = make([]EvmReceipt, 0, len(block.Transactions))
+		for _, tx := range block.Transactions {
+			var receipt *EvmReceipt
+			if err := c.call(ctx, &receipt, "eth_getTransactionReceipt", tx.Hash.String()); err != nil {
+				return nil, err
+			}
+			if receipt == nil {
+				return nil, fmt.Errorf("receipt of transaction %s not found", tx.Hash)
+			}
+			receipts = append(receipts, *receipt)
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(receipts) != len(block.Transactions) {
+		return nil, fmt.Errorf("block %d has %d transactions but %d receipts", block.Number, len(block.Transactions), len(receipts))
+	}
+	for i, receipt := range receipts {
+		if uint64(receipt.TransactionIndex) != uint64(i) {
+			return nil, fmt.Errorf("block %d receipt %d has transaction index %d", block.Number, i, receipt.TransactionIndex)
+		}
+	}
+	return receipts, nil
+}
+
+func encodeQuantity(v uint64) string {
+	return "0x" + strconv.FormatUint(v, 16)
+}
+
+// hexBytes is a 0x-prefixed hex encoded byte string
+type hexBytes []byte
+
+func (b *hexBytes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*b = nil
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	decoded, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return fmt.Errorf("invalid hex string %q: %w", s, err)
+	}
+	*b = decoded
+	return nil
+}
+
+func (b hexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.String())
+}
+
+func (b hexBytes) String() string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+// hexUint64 is a 0x-prefixed hex encoded quantity
+type hexUint64 uint64
+
+func (u *hexUint64) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = 0
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := strconv.ParseUint(strings.TrimPrefix(s, "0x"), 16, 64)
+	if err != nil {
+		return fmt.Errorf("invalid quantity %q: %w", s, err)
+	}
+	*u = hexUint64(v)
+	return nil
+}
+
+func (u hexUint64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(encodeQuantity(uint64(u)))
+}
+
+// hexBig is a 0x-prefixed hex encoded quantity that may exceed 64 bits
+type hexBig big.Int
+
+func (b *hexBig) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	digits := strings.TrimPrefix(s, "0x")
+	if digits == "" {
+		digits = "0"
+	}
+	if _, ok := (*big.Int)(b).SetString(digits, 16); !ok {
+		return fmt.Errorf("invalid quantity %q", s)
+	}
+	return nil
+}
+
+func (b *hexBig) MarshalJSON() ([]byte, error) {
+	return json.Marshal("0x" + b.Int().Text(16))
+}
+
+// Int returns the value as a big.Int, treating a missing value as zero
+func (b *hexBig) Int() *big.Int {
+	if b == nil {
+		return new(big.Int)
+	}
+	return (*big.Int)(b)
+}
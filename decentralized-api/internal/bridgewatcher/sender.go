@@ -0,0 +1,108 @@
+package bridgewatcher
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/productscience/inference/x/inference/bridgeproof"
+)
+
+const (
+	legacyTxType     = 0
+	accessListTxType = 1
+	dynamicFeeTxType = 2
+)
+
+// senderPublicKey recovers the public key that signed tx. The chain needs the depositor's public key
+// to tie the Ethereum sender to a Gonka address, and it is only available from the signature.
+func senderPublicKey(tx *EvmTransaction) (*secp256k1.PublicKey, error) {
+	if tx.V == nil || tx.R == nil || tx.S == nil {
+		return nil, fmt.Errorf("transaction %s has no signature", tx.Hash)
+	}
+	hash, recoveryId, err := signingHash(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	signature := make([]byte, 65)
+	signature[0] = 27 + recoveryId
+	tx.R.Int().FillBytes(signature[1:33])
+	tx.S.Int().FillBytes(signature[33:65])
+	pubKey, _, err := ecdsa.RecoverCompact(signature, hash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to recover sender of transaction %s: %w", tx.Hash, err)
+	}
+	return pubKey, nil
+}
+
+// signingHash returns the hash signed by the sender of tx together with the signature's recovery id
+func signingHash(tx *EvmTransaction) ([]byte, byte, error) {
+	v := tx.V.Int()
+	if !v.IsUint64() {
+		return nil, 0, fmt.Errorf("transaction %s has invalid v", tx.Hash)
+	}
+
+	switch uint64(tx.Type) {
+	case legacyTxType:
+		fields := [][]byte{
+			encodeUint(uint64(tx.Nonce)), encodeBig(tx.GasPrice.Int()), encodeUint(uint64(tx.Gas)),
+			bridgeproof.EncodeBytes(tx.To), encodeBig(tx.Value.Int()), bridgeproof.EncodeBytes(tx.Input),
+		}
+		if v.Uint64() == 27 || v.Uint64() == 28 {
+			return bridgeproof.Keccak256(bridgeproof.EncodeList(fields...)), byte(v.Uint64() - 27), nil
+		}
+		if v.Uint64() < 35 {
+			return nil, 0, fmt.Errorf("transaction %s has invalid v %d", tx.Hash, v.Uint64())
+		}
+		// EIP-155: v = chainId*2 + 35 + recoveryId
+		chainId := (v.Uint64() - 35) / 2
+		fields = append(fields, encodeUint(chainId), encodeUint(0), encodeUint(0))
+		return bridgeproof.Keccak256(bridgeproof.EncodeList(fields...)), byte((v.Uint64() - 35) % 2), nil
+	case accessListTxType:
+		payload := bridgeproof.EncodeList(
+			encodeBig(tx.ChainId.Int()), encodeUint(uint64(tx.Nonce)), encodeBig(tx.GasPrice.Int()), encodeUint(uint64(tx.Gas)),
+			bridgeproof.EncodeBytes(tx.To), encodeBig(tx.Value.Int()), bridgeproof.EncodeBytes(tx.Input), encodeAccessList(tx.AccessList),
+		)
+		return typedSigningHash(accessListTxType, payload), byte(v.Uint64()), nil
+	case dynamicFeeTxType:
+		payload := bridgeproof.EncodeList(
+			encodeBig(tx.ChainId.Int()), encodeUint(uint64(tx.Nonce)), encodeBig(tx.MaxPriorityFeePerGas.Int()), encodeBig(tx.MaxFeePerGas.Int()),
+			encodeUint(uint64(tx.Gas)), bridgeproof.EncodeBytes(tx.To), encodeBig(tx.Value.Int()), bridgeproof.EncodeBytes(tx.Input),
+			encodeAccessList(tx.AccessList),
+		)
+		return typedSigningHash(dynamicFeeTxType, payload), byte(v.Uint64()), nil
+	}
+	return nil, 0, fmt.Errorf("transaction %s has unsupported type %d", tx.Hash, tx.Type)
+}
+
+func typedSigningHash(txType byte, payload []byte) []byte {
+	return bridgeproof.Keccak256(append([]byte{txType}, payload...))
+}
+
+func encodeAccessList(accessList []EvmAccessTuple) []byte {
+	tuples := make([][]byte, 0, len(accessList))
+	for _, tuple := range accessList {
+		keys := make([][]byte, 0, len(tuple.StorageKeys))
+		for _, key := range tuple.StorageKeys {
+			keys = append(keys, bridgeproof.EncodeBytes(key))
+		}
+		tuples = append(tuples, bridgeproof.EncodeList(bridgeproof.EncodeBytes(tuple.Address), bridgeproof.EncodeList(keys...)))
+	}
+	return bridgeproof.EncodeList(tuples...)
+}
+
+func encodeUint(v uint64) []byte {
+	return encodeBig(new(big.Int).SetUint64(v))
+}
+
+// encodeBig encodes an integer as the minimal big-endian byte string, with zero as the empty string
+func encodeBig(v *big.Int) []byte {
+	return bridgeproof.EncodeBytes(v.Bytes())
+}
+
+// ethAddress returns the Ethereum address of a public key
+func ethAddress(pubKey *secp256k1.PublicKey) []byte {
+	return bridgeproof.Keccak256(pubKey.SerializeUncompressed()[1:])[12:]
+}
@@ -0,0 +1,92 @@
+package bridgewatcher
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+func bigFromString(t *testing.T, s string) *hexBig {
+	v, ok := new(big.Int).SetString(s, 10)
+	require.True(t, ok)
+	return (*hexBig)(v)
+}
+
+func mustHex(t *testing.T, s string) []byte {
+	decoded, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return decoded
+}
+
+// The signed transaction from the EIP-155 specification
+func TestSenderPublicKey_EIP155(t *testing.T) {
+	tx := &EvmTransaction{
+		Type:     legacyTxType,
+		Nonce:    9,
+		GasPrice: bigFromString(t, "20000000000"),
+		Gas:      21000,
+		To:       mustHex(t, "3535353535353535353535353535353535353535"),
+		Value:    bigFromString(t, "1000000000000000000"),
+		V:        bigFromString(t, "37"),
+		R:        bigFromString(t, "18515461264373351373200002665853028612451056578545711640558177340181847433846"),
+		S:        bigFromString(t, "46948507304638947509940763649030358759909902576025900602547168820602576006531"),
+	}
+
+	hash, recoveryId, err := signingHash(tx)
+	require.NoError(t, err)
+	require.Equal(t, "daf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53", hex.EncodeToString(hash))
+	require.Equal(t, byte(0), recoveryId)
+
+	pubKey, err := senderPublicKey(tx)
+	require.NoError(t, err)
+	privKey := secp256k1.PrivKeyFromBytes(mustHex(t, "4646464646464646464646464646464646464646464646464646464646464646"))
+	require.True(t, pubKey.IsEqual(privKey.PubKey()))
+	require.Equal(t, "9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f", hex.EncodeToString(ethAddress(pubKey)))
+}
+
+// signTx fills in the signature of a typed transaction for privKey
+func signTx(t *testing.T, tx *EvmTransaction, privKey *secp256k1.PrivateKey) {
+	tx.V, tx.R, tx.S = (*hexBig)(big.NewInt(0)), (*hexBig)(big.NewInt(0)), (*hexBig)(big.NewInt(0))
+	hash, _, err := signingHash(tx)
+	require.NoError(t, err)
+	signature := ecdsa.SignCompact(privKey, hash, false)
+	tx.V = (*hexBig)(big.NewInt(int64(signature[0] - 27)))
+	tx.R = (*hexBig)(new(big.Int).SetBytes(signature[1:33]))
+	tx.S = (*hexBig)(new(big.Int).SetBytes(signature[33:65]))
+}
+
+func TestSenderPublicKey_TypedTransactions(t *testing.T) {
+	privKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	for _, txType := range []hexUint64{accessListTxType, dynamicFeeTxType} {
+		tx := &EvmTransaction{
+			Type:                 txType,
+			ChainId:              bigFromString(t, "31337"),
+			Nonce:                3,
+			GasPrice:             bigFromString(t, "1000000000"),
+			MaxPriorityFeePerGas: bigFromString(t, "1000000000"),
+			MaxFeePerGas:         bigFromString(t, "3000000000"),
+			Gas:                  60000,
+			To:                   mustHex(t, "b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1"),
+			Value:                bigFromString(t, "0"),
+			Input:                mustHex(t, "a9059cbb"),
+			AccessList: []EvmAccessTuple{{
+				Address:     mustHex(t, "b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1"),
+				StorageKeys: []hexBytes{make([]byte, 32)},
+			}},
+		}
+		signTx(t, tx, privKey)
+
+		pubKey, err := senderPublicKey(tx)
+		require.NoError(t, err)
+		require.True(t, pubKey.IsEqual(privKey.PubKey()))
+	}
+
+	_, err = senderPublicKey(&EvmTransaction{Type: 3, V: bigFromString(t, "0"), R: bigFromString(t, "1"), S: bigFromString(t, "1")})
+	require.ErrorContains(t, err, "unsupported type")
+}
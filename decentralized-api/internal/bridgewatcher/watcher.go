@@ -0,0 +1,386 @@
+package bridgewatcher
+
+import (
+	"context"
+	"database/sql"
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	// recentBlocksKept is how many processed blocks are remembered to detect and unwind reorgs
+	recentBlocksKept = 64
+	// pendingExpiryBlocks is how long a deposit waits for its block to be attested before it is dropped
+	pendingExpiryBlocks = 7200
+	// resubmitAfter is how long to wait for a submitted deposit to complete before sending it again
+	resubmitAfter = 5 * time.Minute
+)
+
+// ChainClient is the part of the Gonka chain client the watcher uses
+type ChainClient interface {
+	GetAccountAddress() string
+	GetBridgeAddresses(ctx context.Context, chainId string) ([]types.BridgeContractAddress, error)
+	AttestBridgeBlock(transaction *types.MsgAttestBridgeBlock) error
+	BridgeExchange(transaction *types.MsgBridgeExchange) error
+	NewInferenceQueryClient() types.QueryClient
+}
+
+type blockRef struct {
+	Number uint64 `json:"number"`
+	Hash   string `json:"hash"`
+}
+
+// pendingDeposit is a deposit waiting for its block to be attested or for its bridge transaction to complete
+type pendingDeposit struct {
+	Deposit
+	SubmittedAt time.Time `json:"submitted_at,omitempty"`
+}
+
+// watcherState is persisted after every processed block so a restart neither skips nor repeats deposits
+type watcherState struct {
+	// Next is the next block to process
+	Next uint64 `json:"next"`
+	// Recent holds the last processed blocks, oldest first
+	Recent  []blockRef       `json:"recent"`
+	Pending []pendingDeposit `json:"pending"`
+}
+
+// Watcher follows an EVM chain and bridges deposits made to the bridge contracts registered on Gonka.
+// Blocks are only processed once they have enough confirmations. For every block with deposits the
+// watcher attests the block's receipts root, and once a majority of validators has attested the same
+// root it submits each deposit with a receipt proof.
+type Watcher struct {
+	config apiconfig.EvmChainConfig
+	evm    *EvmClient
+	chain  ChainClient
+	db     *sql.DB
+	state  *watcherState
+}
+
+func NewWatcher(config apiconfig.EvmChainConfig, chain ChainClient, db *sql.DB) *Watcher {
+	return &Watcher{
+		config: config,
+		evm:    NewEvmClient(config.RpcUrl),
+		chain:  chain,
+		db:     db,
+	}
+}
+
+// Start polls the chain until ctx is cancelled
+func (w *Watcher) Start(ctx context.Context) {
+	interval := time.Duration(w.config.PollIntervalSeconds) * time.Second
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	logging.Info("Bridge watcher started", types.Messages,
+		"chain", w.config.ChainId, "rpcUrl", w.config.RpcUrl, "confirmations", w.config.RequiredConfirmations(), "pollInterval", interval)
+
+	for {
+		if err := w.Poll(ctx); err != nil {
+			logging.Error("Bridge watcher: poll failed", types.Messages, "chain", w.config.ChainId, "error", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			logging.Info("Bridge watcher stopped", types.Messages, "chain", w.config.ChainId)
+			return
+		}
+	}
+}
+
+// Poll processes the confirmed blocks that appeared since the last poll and submits pending deposits
+func (w *Watcher) Poll(ctx context.Context) error {
+	if err := w.loadState(ctx); err != nil {
+		return err
+	}
+	head, err := w.evm.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	confirmations := w.config.RequiredConfirmations()
+	if head < confirmations {
+		return nil
+	}
+	confirmed := head - confirmations
+	if w.state.Next == 0 && len(w.state.Recent) == 0 {
+		w.state.Next = confirmed
+		if w.config.StartBlock > 0 {
+			w.state.Next = w.config.StartBlock
+		}
+		logging.Info("Bridge watcher: starting from block", types.Messages, "chain", w.config.ChainId, "block", w.state.Next)
+	}
+
+	if w.state.Next <= confirmed {
+		bridgeAddresses, err := w.bridgeAddresses(ctx)
+		if err != nil {
+			return err
+		}
+		for processed := uint64(0); w.state.Next <= confirmed && processed < w.config.MaxBlocksPerPoll; processed++ {
+			if err := w.processNextBlock(ctx, bridgeAddresses); err != nil {
+				return err
+			}
+		}
+	}
+
+	return w.submitPending(ctx)
+}
+
+// Next returns the number of the next block the watcher will process
+func (w *Watcher) Next() uint64 {
+	if w.state == nil {
+		return 0
+	}
+	return w.state.Next
+}
+
+func (w *Watcher) processNextBlock(ctx context.Context, bridgeAddresses [][]byte) error {
+	block, err := w.evm.BlockByNumber(ctx, w.state.Next)
+	if err != nil {
+		return err
+	}
+	if len(w.state.Recent) > 0 {
+		last := w.state.Recent[len(w.state.Recent)-1]
+		if last.Number+1 == uint64(block.Number) && last.Hash != block.ParentHash.String() {
+			return w.rewind(ctx)
+		}
+	}
+
+	if len(bridgeAddresses) > 0 && len(block.Transactions) > 0 {
+		receipts, err := w.evm.BlockReceipts(ctx, block)
+		if err != nil {
+			return err
+		}
+		deposits, skipped, err := extractDeposits(block, receipts, bridgeAddresses)
+		if err != nil {
+			return err
+		}
+		for _, reason := range skipped {
+			logging.Warn("Bridge watcher: skipping deposit", types.Messages, "chain", w.config.ChainId, "block", block.Number, "reason", reason)
+		}
+		if len(deposits) > 0 {
+			logging.Info("Bridge watcher: found deposits", types.Messages,
+				"chain", w.config.ChainId, "block", block.Number, "receiptsRoot", block.ReceiptsRoot, "deposits", len(deposits))
+			w.attest(block.ReceiptsRoot.String(), uint64(block.Number))
+			for _, deposit := range deposits {
+				w.state.Pending = append(w.state.Pending, pendingDeposit{Deposit: deposit})
+			}
+		}
+	}
+
+	w.state.Recent = append(w.state.Recent, blockRef{Number: uint64(block.Number), Hash: block.Hash.String()})
+	if len(w.state.Recent) > recentBlocksKept {
+		w.state.Recent = w.state.Recent[len(w.state.Recent)-recentBlocksKept:]
+	}
+	w.state.Next = uint64(block.Number) + 1
+	return w.saveState(ctx)
+}
+
+// rewind walks back over processed blocks that are no longer canonical and forgets their deposits
+func (w *Watcher) rewind(ctx context.Context) error {
+	for len(w.state.Recent) > 0 {
+		last := w.state.Recent[len(w.state.Recent)-1]
+		canonical, err := w.evm.BlockByNumber(ctx, last.Number)
+		if err != nil {
+			return err
+		}
+		if canonical.Hash.String() == last.Hash {
+			break
+		}
+		w.state.Recent = w.state.Recent[:len(w.state.Recent)-1]
+		w.state.Next = last.Number
+	}
+	if len(w.state.Recent) == 0 {
+		logging.Error("Bridge watcher: reorg is deeper than the remembered blocks", types.Messages,
+			"chain", w.config.ChainId, "resumingFrom", w.state.Next)
+	}
+
+	kept := w.state.Pending[:0]
+	for _, deposit := range w.state.Pending {
+		if deposit.BlockNumber < w.state.Next {
+			kept = append(kept, deposit)
+			continue
+		}
+		logging.Warn("Bridge watcher: dropping deposit from orphaned block", types.Messages,
+			"chain", w.config.ChainId, "block", deposit.BlockNumber, "blockHash", deposit.BlockHash, "txHash", deposit.TxHash)
+	}
+	w.state.Pending = kept
+
+	logging.Warn("Bridge watcher: reorg detected", types.Messages, "chain", w.config.ChainId, "resumingFrom", w.state.Next)
+	return w.saveState(ctx)
+}
+
+// submitPending submits the deposits whose receipts root has been attested and forgets the completed ones
+func (w *Watcher) submitPending(ctx context.Context) error {
+	if len(w.state.Pending) == 0 {
+		return nil
+	}
+	queryClient := w.chain.NewInferenceQueryClient()
+	attestedRoots := make(map[uint64]string)
+	kept := make([]pendingDeposit, 0, len(w.state.Pending))
+	for _, deposit := range w.state.Pending {
+		attestedRoot, seen := attestedRoots[deposit.BlockNumber]
+		if !seen {
+			var err error
+			attestedRoot, err = w.checkAttestation(ctx, queryClient, deposit.Deposit)
+			if err != nil {
+				return err
+			}
+			attestedRoots[deposit.BlockNumber] = attestedRoot
+		}
+
+		if attestedRoot == "" {
+			if w.state.Next > deposit.BlockNumber+pendingExpiryBlocks {
+				logging.Error("Bridge watcher: dropping deposit whose block was never attested", types.Messages,
+					"chain", w.config.ChainId, "block", deposit.BlockNumber, "txHash", deposit.TxHash)
+				continue
+			}
+			kept = append(kept, deposit)
+			continue
+		}
+		if attestedRoot != deposit.ReceiptsRoot {
+			logging.Error("Bridge watcher: dropping deposit, block was attested with a different receipts root", types.Messages,
+				"chain", w.config.ChainId, "block", deposit.BlockNumber, "receiptsRoot", deposit.ReceiptsRoot, "attestedRoot", attestedRoot)
+			continue
+		}
+
+		resp, err := queryClient.BridgeTransaction(ctx, &types.QueryGetBridgeTransactionRequest{
+			OriginChain:  w.config.ChainId,
+			BlockNumber:  strconv.FormatUint(deposit.BlockNumber, 10),
+			ReceiptIndex: strconv.FormatUint(deposit.ReceiptIndex, 10),
+		})
+		if err != nil {
+			return err
+		}
+		if isCompleted(resp.BridgeTransactions) {
+			logging.Info("Bridge watcher: deposit completed", types.Messages,
+				"chain", w.config.ChainId, "block", deposit.BlockNumber, "receiptIndex", deposit.ReceiptIndex, "owner", deposit.OwnerAddress)
+			continue
+		}
+
+		if deposit.SubmittedAt.IsZero() || time.Since(deposit.SubmittedAt) > resubmitAfter {
+			if err := w.chain.BridgeExchange(deposit.message(w.config.ChainId)); err != nil {
+				logging.Error("Bridge watcher: failed to submit deposit", types.Messages,
+					"chain", w.config.ChainId, "block", deposit.BlockNumber, "receiptIndex", deposit.ReceiptIndex, "error", err)
+			} else {
+				deposit.SubmittedAt = time.Now()
+			}
+		}
+		kept = append(kept, deposit)
+	}
+
+	w.state.Pending = kept
+	return w.saveState(ctx)
+}
+
+// checkAttestation returns the attested receipts root of the deposit's block, or "" if there is none yet.
+// If this validator's own vote for the block is missing, e.g. because the transaction failed, it is sent again.
+func (w *Watcher) checkAttestation(ctx context.Context, queryClient types.QueryClient, deposit Deposit) (string, error) {
+	resp, err := queryClient.BridgeBlockAttestations(ctx, &types.QueryBridgeBlockAttestationsRequest{
+		OriginChain: w.config.ChainId,
+		BlockNumber: strconv.FormatUint(deposit.BlockNumber, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+	voted := false
+	for _, attestation := range resp.Attestations {
+		if attestation.Attested {
+			return attestation.ReceiptsRoot, nil
+		}
+		if attestation.ReceiptsRoot == deposit.ReceiptsRoot {
+			for _, validator := range attestation.Validators {
+				voted = voted || validator == w.chain.GetAccountAddress()
+			}
+		}
+	}
+	if !voted {
+		w.attest(deposit.ReceiptsRoot, deposit.BlockNumber)
+	}
+	return "", nil
+}
+
+func (w *Watcher) attest(receiptsRoot string, blockNumber uint64) {
+	err := w.chain.AttestBridgeBlock(&types.MsgAttestBridgeBlock{
+		Validator:    w.chain.GetAccountAddress(),
+		OriginChain:  w.config.ChainId,
+		BlockNumber:  strconv.FormatUint(blockNumber, 10),
+		ReceiptsRoot: receiptsRoot,
+	})
+	if err != nil {
+		logging.Error("Bridge watcher: failed to attest block", types.Messages,
+			"chain", w.config.ChainId, "block", blockNumber, "receiptsRoot", receiptsRoot, "error", err)
+	}
+}
+
+func (w *Watcher) bridgeAddresses(ctx context.Context) ([][]byte, error) {
+	registered, err := w.chain.GetBridgeAddresses(ctx, w.config.ChainId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bridge addresses: %w", err)
+	}
+	addresses := make([][]byte, 0, len(registered))
+	for _, address := range registered {
+		decoded, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(address.Address), "0x"))
+		if err != nil || len(decoded) != 20 {
+			logging.Warn("Bridge watcher: ignoring invalid bridge address", types.Messages, "chain", w.config.ChainId, "address", address.Address)
+			continue
+		}
+		addresses = append(addresses, decoded)
+	}
+	return addresses, nil
+}
+
+func (w *Watcher) stateKey() string {
+	return "bridge_watcher/" + w.config.ChainId
+}
+
+func (w *Watcher) loadState(ctx context.Context) error {
+	if w.state != nil {
+		return nil
+	}
+	state := &watcherState{}
+	if _, err := apiconfig.KVGetJSON(ctx, w.db, w.stateKey(), state); err != nil {
+		return fmt.Errorf("failed to load bridge watcher state: %w", err)
+	}
+	w.state = state
+	return nil
+}
+
+func (w *Watcher) saveState(ctx context.Context) error {
+	if err := apiconfig.KVSetJSON(ctx, w.db, w.stateKey(), w.state); err != nil {
+		return fmt.Errorf("failed to save bridge watcher state: %w", err)
+	}
+	return nil
+}
+
+func (d Deposit) message(originChain string) *types.MsgBridgeExchange {
+	return &types.MsgBridgeExchange{
+		OriginChain:     originChain,
+		ContractAddress: d.ContractAddress,
+		OwnerAddress:    d.OwnerAddress,
+		OwnerPubKey:     d.OwnerPubKey,
+		Amount:          d.Amount,
+		BlockNumber:     strconv.FormatUint(d.BlockNumber, 10),
+		ReceiptIndex:    strconv.FormatUint(d.ReceiptIndex, 10),
+		ReceiptsRoot:    d.ReceiptsRoot,
+		ReceiptProof:    d.ReceiptProof,
+	}
+}
+
+// isCompleted reports whether the chain accepted a deposit. Held deposits are released by the chain
+// itself once the bridge allows it, so there is nothing left for the watcher to submit.
+func isCompleted(transactions []types.BridgeTransaction) bool {
+	for _, transaction := range transactions {
+		if transaction.Status == types.BridgeTransactionStatus_BRIDGE_COMPLETED ||
+			transaction.Status == types.BridgeTransactionStatus_BRIDGE_HELD {
+			return true
+		}
+	}
+	return false
+}
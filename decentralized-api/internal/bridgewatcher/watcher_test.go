@@ -0,0 +1,284 @@
+package bridgewatcher
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/productscience/inference/x/inference/bridgeproof"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// fakeEvm serves a chain of blocks over JSON-RPC
+type fakeEvm struct {
+	mu       sync.Mutex
+	blocks   []*EvmBlock
+	receipts map[uint64][]EvmReceipt
+}
+
+func newFakeEvm(t *testing.T) (*fakeEvm, string) {
+	evm := &fakeEvm{receipts: make(map[uint64][]EvmReceipt)}
+	evm.mine(0, nil, nil)
+	server := httptest.NewServer(http.HandlerFunc(evm.serve))
+	t.Cleanup(server.Close)
+	return evm, server.URL
+}
+
+// mine appends a block, tagging its hash with fork so that re-mined blocks differ from the originals
+func (e *fakeEvm) mine(fork byte, txs []EvmTransaction, receipts []EvmReceipt) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	number := uint64(len(e.blocks))
+	hashInput := binary.BigEndian.AppendUint64([]byte{fork}, number)
+	block := &EvmBlock{Number: hexUint64(number), Hash: bridgeproof.Keccak256(hashInput), ParentHash: make([]byte, 32), Transactions: txs}
+	if number > 0 {
+		block.ParentHash = e.blocks[number-1].Hash
+	}
+	encoded := make([][]byte, 0, len(receipts))
+	for i := range receipts {
+		receipts[i].TransactionIndex = hexUint64(i)
+		encoded = append(encoded, encodeReceipt(receipts[i]))
+	}
+	block.ReceiptsRoot, _ = bridgeproof.ReceiptProof(encoded, 0)
+	e.blocks = append(e.blocks, block)
+	e.receipts[number] = receipts
+}
+
+// reorg drops every block from number on
+func (e *fakeEvm) reorg(number uint64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.blocks = e.blocks[:number]
+}
+
+func (e *fakeEvm) serve(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var req struct {
+		Id     int64             `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	blockParam := func() uint64 {
+		var quantity hexUint64
+		_ = json.Unmarshal(req.Params[0], &quantity)
+		return uint64(quantity)
+	}
+
+	var result any
+	switch req.Method {
+	case "eth_blockNumber":
+		result = hexUint64(len(e.blocks) - 1)
+	case "eth_getBlockByNumber":
+		if number := blockParam(); number < uint64(len(e.blocks)) {
+			result = e.blocks[number]
+		}
+	case "eth_getBlockReceipts":
+		result = e.receipts[blockParam()]
+	default:
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.Id, "error": RpcError{Code: methodNotFoundCode, Message: "method not found"}})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.Id, "result": result})
+}
+
+// fakeChain records the messages sent to Gonka and answers the queries the watcher makes
+type fakeChain struct {
+	types.QueryClient
+	bridgeAddresses []types.BridgeContractAddress
+	attestations    []*types.MsgAttestBridgeBlock
+	exchanges       []*types.MsgBridgeExchange
+	votes           map[string]string
+	attested        map[string]string
+	completed       map[string]bool
+}
+
+func newFakeChain(bridgeAddress string) *fakeChain {
+	return &fakeChain{
+		bridgeAddresses: []types.BridgeContractAddress{{ChainId: "ethereum", Address: bridgeAddress}},
+		votes:           make(map[string]string),
+		attested:        make(map[string]string),
+		completed:       make(map[string]bool),
+	}
+}
+
+func (c *fakeChain) GetAccountAddress() string { return "gonka1validator" }
+
+func (c *fakeChain) GetBridgeAddresses(ctx context.Context, chainId string) ([]types.BridgeContractAddress, error) {
+	return c.bridgeAddresses, nil
+}
+
+func (c *fakeChain) AttestBridgeBlock(msg *types.MsgAttestBridgeBlock) error {
+	c.attestations = append(c.attestations, msg)
+	c.votes[msg.BlockNumber] = msg.ReceiptsRoot
+	return nil
+}
+
+func (c *fakeChain) BridgeExchange(msg *types.MsgBridgeExchange) error {
+	c.exchanges = append(c.exchanges, msg)
+	return nil
+}
+
+func (c *fakeChain) NewInferenceQueryClient() types.QueryClient { return c }
+
+func (c *fakeChain) BridgeBlockAttestations(ctx context.Context, req *types.QueryBridgeBlockAttestationsRequest, opts ...grpc.CallOption) (*types.QueryBridgeBlockAttestationsResponse, error) {
+	resp := &types.QueryBridgeBlockAttestationsResponse{}
+	if root, found := c.attested[req.BlockNumber]; found {
+		resp.Attestations = append(resp.Attestations, types.BridgeBlockAttestation{BlockNumber: req.BlockNumber, ReceiptsRoot: root, Attested: true})
+	} else if root, found := c.votes[req.BlockNumber]; found {
+		resp.Attestations = append(resp.Attestations, types.BridgeBlockAttestation{BlockNumber: req.BlockNumber, ReceiptsRoot: root, Validators: []string{c.GetAccountAddress()}})
+	}
+	return resp, nil
+}
+
+func (c *fakeChain) BridgeTransaction(ctx context.Context, req *types.QueryGetBridgeTransactionRequest, opts ...grpc.CallOption) (*types.QueryGetBridgeTransactionResponse, error) {
+	resp := &types.QueryGetBridgeTransactionResponse{}
+	if c.completed[req.BlockNumber+"/"+req.ReceiptIndex] {
+		resp.BridgeTransactions = append(resp.BridgeTransactions, types.BridgeTransaction{Status: types.BridgeTransactionStatus_BRIDGE_COMPLETED})
+	}
+	return resp, nil
+}
+
+var bridgeContract = []byte{0xb1, 0xb1, 0xb1, 0xb1, 0xb1, 0xb1, 0xb1, 0xb1, 0xb1, 0xb1, 0xb1, 0xb1, 0xb1, 0xb1, 0xb1, 0xb1, 0xb1, 0xb1, 0xb1, 0xb1}
+
+// depositTx returns a signed transaction by owner that burns amount of WGNK at the bridge contract, with its receipt
+func depositTx(t *testing.T, owner *secp256k1.PrivateKey, nonce uint64, amount int64) (EvmTransaction, EvmReceipt) {
+	tx := EvmTransaction{
+		Type:                 dynamicFeeTxType,
+		ChainId:              bigFromString(t, "1"),
+		Nonce:                hexUint64(nonce),
+		MaxPriorityFeePerGas: bigFromString(t, "1"),
+		MaxFeePerGas:         bigFromString(t, "2"),
+		Gas:                  60000,
+		To:                   bridgeContract,
+		Value:                bigFromString(t, "0"),
+	}
+	signTx(t, &tx, owner)
+	tx.Hash = bridgeproof.Keccak256(binary.BigEndian.AppendUint64(nil, nonce))
+
+	ownerTopic := append(make([]byte, 12), ethAddress(owner.PubKey())...)
+	receipt := EvmReceipt{
+		Type:              dynamicFeeTxType,
+		Status:            1,
+		CumulativeGasUsed: 50000,
+		LogsBloom:         make([]byte, 256),
+		Logs: []EvmLog{{
+			Address: bridgeContract,
+			Topics:  []hexBytes{wgnkBurnedTopic, ownerTopic},
+			Data:    append(big.NewInt(amount).FillBytes(make([]byte, 32)), make([]byte, 32)...),
+		}},
+	}
+	return tx, receipt
+}
+
+func newTestWatcher(t *testing.T, url string, chain ChainClient, dbPath string) *Watcher {
+	db := apiconfig.NewSQLiteDb(apiconfig.SqliteConfig{Path: dbPath})
+	require.NoError(t, db.BootstrapLocal(context.Background()))
+	t.Cleanup(func() { _ = db.GetDb().Close() })
+	confirmations := uint64(2)
+	config := apiconfig.EvmChainConfig{ChainId: "ethereum", RpcUrl: url, Confirmations: &confirmations, StartBlock: 1, MaxBlocksPerPoll: 100}
+	return NewWatcher(config, chain, db.GetDb())
+}
+
+func TestWatcher_SubmitsProvenDeposits(t *testing.T) {
+	ctx := context.Background()
+	evm, url := newFakeEvm(t)
+	chain := newFakeChain("0xB1B1B1B1B1B1B1B1B1B1B1B1B1B1B1B1B1B1B1B1")
+	owner, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	dbPath := filepath.Join(t.TempDir(), "watcher.db")
+	watcher := newTestWatcher(t, url, chain, dbPath)
+
+	// Block 1 holds a plain transfer and a deposit
+	deposit, depositReceipt := depositTx(t, owner, 1, 500)
+	other, otherReceipt := depositTx(t, owner, 0, 0)
+	otherReceipt.Logs = nil
+	evm.mine(0, []EvmTransaction{other, deposit}, []EvmReceipt{otherReceipt, depositReceipt})
+	evm.mine(0, nil, nil)
+
+	// Not confirmed yet
+	require.NoError(t, watcher.Poll(ctx))
+	require.Empty(t, chain.attestations)
+	require.Equal(t, uint64(1), watcher.Next())
+
+	evm.mine(0, nil, nil)
+	require.NoError(t, watcher.Poll(ctx))
+	require.Equal(t, uint64(2), watcher.Next())
+	require.Len(t, chain.attestations, 1)
+	receiptsRoot := evm.blocks[1].ReceiptsRoot.String()
+	require.Equal(t, receiptsRoot, chain.attestations[0].ReceiptsRoot)
+	require.Equal(t, "1", chain.attestations[0].BlockNumber)
+	// Nothing is submitted until the receipts root is attested
+	require.Empty(t, chain.exchanges)
+
+	chain.attested["1"] = receiptsRoot
+	require.NoError(t, watcher.Poll(ctx))
+	require.Len(t, chain.exchanges, 1)
+	msg := chain.exchanges[0]
+	require.Equal(t, "500", msg.Amount)
+	require.Equal(t, "1", msg.ReceiptIndex)
+	require.Equal(t, "0x"+strings.Repeat("b1", 20), msg.ContractAddress)
+	require.Equal(t, hexBytes(owner.PubKey().SerializeCompressed()).String(), msg.OwnerPubKey)
+	encoded, err := bridgeproof.VerifyProof(evm.blocks[1].ReceiptsRoot, bridgeproof.ReceiptKey(1), msg.ReceiptProof)
+	require.NoError(t, err)
+	proven, err := bridgeproof.DecodeReceipt(encoded)
+	require.NoError(t, err)
+	require.True(t, proven.Succeeded)
+
+	// A restarted watcher resumes from the persisted cursor and does not resubmit before the retry window
+	restarted := newTestWatcher(t, url, chain, dbPath)
+	require.NoError(t, restarted.Poll(ctx))
+	require.Equal(t, uint64(2), restarted.Next())
+	require.Len(t, chain.attestations, 1)
+	require.Len(t, chain.exchanges, 1)
+
+	chain.completed["1/1"] = true
+	require.NoError(t, restarted.Poll(ctx))
+	require.Empty(t, restarted.state.Pending)
+}
+
+func TestWatcher_Reorg(t *testing.T) {
+	ctx := context.Background()
+	evm, url := newFakeEvm(t)
+	chain := newFakeChain("0xb1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1")
+	owner, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	watcher := newTestWatcher(t, url, chain, filepath.Join(t.TempDir(), "watcher.db"))
+
+	evm.mine(0, nil, nil)
+	deposit, receipt := depositTx(t, owner, 0, 700)
+	evm.mine(0, []EvmTransaction{deposit}, []EvmReceipt{receipt})
+	evm.mine(0, nil, nil)
+	evm.mine(0, nil, nil)
+	require.NoError(t, watcher.Poll(ctx))
+	require.Equal(t, uint64(3), watcher.Next())
+	require.Len(t, watcher.state.Pending, 1)
+
+	// Block 2 is replaced by a block without the deposit
+	evm.reorg(2)
+	for i := 0; i < 4; i++ {
+		evm.mine(1, nil, nil)
+	}
+	require.NoError(t, watcher.Poll(ctx))
+	require.Empty(t, watcher.state.Pending)
+	require.Equal(t, uint64(4), watcher.Next())
+	for _, ref := range watcher.state.Recent {
+		require.Equal(t, evm.blocks[ref.Number].Hash.String(), ref.Hash, "block %s", strconv.FormatUint(ref.Number, 10))
+	}
+}
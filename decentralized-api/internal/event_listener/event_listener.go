@@ -84,6 +84,7 @@ func NewEventListener(
 		&BlsTransactionEventHandler{},
 		&InferenceFinishedEventHandler{},
 		&InferenceValidationEventHandler{},
+		&InferenceAppealEventHandler{},
 		&SubmitProposalEventHandler{},
 		&TrainingTaskAssignedEventHandler{},
 	}
@@ -452,6 +453,23 @@ func (e *InferenceValidationEventHandler) Handle(event *chainevents.JSONRPCRespo
 	return nil
 }
 
+type InferenceAppealEventHandler struct{}
+
+func (e *InferenceAppealEventHandler) GetName() string {
+	return "inference_appeal"
+}
+
+func (e *InferenceAppealEventHandler) CanHandle(event *chainevents.JSONRPCResponse) bool {
+	return len(event.Result.Events["inference_appeal_opened.inference_id"]) > 0
+}
+
+func (e *InferenceAppealEventHandler) Handle(event *chainevents.JSONRPCResponse, el *EventListener) error {
+	if el.isNodeSynced() {
+		el.validator.ValidateAppeal(event.Result.Events, el.transactionRecorder)
+	}
+	return nil
+}
+
 type SubmitProposalEventHandler struct{}
 
 func (e *SubmitProposalEventHandler) GetName() string {
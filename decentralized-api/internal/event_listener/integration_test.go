@@ -19,6 +19,7 @@ import (
 	"decentralized-api/chainphase"
 
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/productscience/inference/api/inference/inference"
 	"github.com/productscience/inference/x/inference/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -84,6 +85,14 @@ func (m MockOrchestratorChainBridge) GetPocParams() (*types.PocParams, error) {
 	}, nil
 }
 
+func (m MockOrchestratorChainBridge) GetParticipantInferenceUrl(address string) (string, error) {
+	return "", nil
+}
+
+func (m MockOrchestratorChainBridge) SubmitPocValidation(msg *inference.MsgSubmitPocValidation) error {
+	return nil
+}
+
 type MockBrokerChainBridge struct {
 	mock.Mock
 }
@@ -185,6 +194,14 @@ func (m *MockQueryClient) Params(ctx context.Context, req *types.QueryParamsRequ
 	return args.Get(0).(*types.QueryParamsResponse), args.Error(1)
 }
 
+func (m *MockQueryClient) ModelAliasAll(ctx context.Context, req *types.QueryAllModelAliasRequest, opts ...grpc.CallOption) (*types.QueryAllModelAliasResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.QueryAllModelAliasResponse), args.Error(1)
+}
+
 // Test setup helpers
 
 type IntegrationTestSetup struct {
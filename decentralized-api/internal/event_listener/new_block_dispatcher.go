@@ -29,6 +29,7 @@ import (
 type ChainStateClient interface {
 	EpochInfo(ctx context.Context, req *types.QueryEpochInfoRequest, opts ...grpc.CallOption) (*types.QueryEpochInfoResponse, error)
 	Params(ctx context.Context, req *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error)
+	ModelAliasAll(ctx context.Context, req *types.QueryAllModelAliasRequest, opts ...grpc.CallOption) (*types.QueryAllModelAliasResponse, error)
 }
 
 // StatusFunc defines the function signature for getting node sync status
@@ -159,6 +160,22 @@ func NewOnNewBlockDispatcherFromCosmosClient(
 	return dispatcher
 }
 
+// updateModelAliases caches the chain's model aliases so request handlers can resolve them without querying the chain
+func (d *OnNewBlockDispatcher) updateModelAliases(ctx context.Context) {
+	resp, err := d.queryClient.ModelAliasAll(ctx, &types.QueryAllModelAliasRequest{})
+	if err != nil {
+		logging.Warn("Failed to get model aliases", types.Config, "error", err)
+		return
+	}
+	aliases := make(map[string]string, len(resp.Aliases))
+	for _, alias := range resp.Aliases {
+		aliases[alias.Alias] = alias.ModelId
+	}
+	if err := d.configManager.SetModelAliases(aliases); err != nil {
+		logging.Warn("Failed to update model aliases", types.Config, "error", err)
+	}
+}
+
 // ProcessNewBlock is the main entry point for processing new block events
 func (d *OnNewBlockDispatcher) ProcessNewBlock(ctx context.Context, blockInfo chainphase.BlockInfo) error {
 	logging.Debug("Processing new block", types.Stages,
@@ -216,6 +233,8 @@ func (d *OnNewBlockDispatcher) ProcessNewBlock(ctx context.Context, blockInfo ch
 				}
 			}
 		}
+
+		d.updateModelAliases(ctx)
 	}
 
 	// Let's check in prod how often this happens
@@ -0,0 +1,160 @@
+package poc
+
+import (
+	"decentralized-api/logging"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+var ErrBatchNotFound = errors.New("poc batch not found")
+
+// CommittedLeaf is a leaf of a committed PoC batch with the proof that it belongs to the batch Merkle root
+type CommittedLeaf struct {
+	BatchId   string   `json:"batch_id"`
+	LeafIndex uint32   `json:"leaf_index"`
+	Nonce     int64    `json:"nonce"`
+	Dist      float64  `json:"dist"`
+	Proof     [][]byte `json:"proof"`
+}
+
+type storedBatch struct {
+	Nonces []int64   `json:"nonces"`
+	Dist   []float64 `json:"dist"`
+}
+
+// BatchStore keeps the full PoC batches this participant committed to on-chain, so validators can fetch sampled
+// leaves with their proofs. Only the latest retainedStages PoC stages are kept.
+// Directory structure: {baseDir}/{pocStageStartBlockHeight}/{hex(batchId)}.json
+type BatchStore struct {
+	baseDir        string
+	retainedStages int
+}
+
+func NewBatchStore(baseDir string, retainedStages int) *BatchStore {
+	return &BatchStore{baseDir: baseDir, retainedStages: retainedStages}
+}
+
+func (s *BatchStore) batchPath(pocStageStartBlockHeight int64, batchId string) string {
+	return filepath.Join(s.baseDir, strconv.FormatInt(pocStageStartBlockHeight, 10), hex.EncodeToString([]byte(batchId))+".json")
+}
+
+// Store saves a batch, sorted by nonce without duplicates, and returns the Merkle root to commit to with the nonce
+// progression it binds. Atomic write: temp file + rename.
+func (s *BatchStore) Store(pocStageStartBlockHeight int64, batchId string, nonces []int64, dist []float64) ([]byte, types.PoCNonceProgression, error) {
+	if len(nonces) != len(dist) {
+		return nil, types.PoCNonceProgression{}, fmt.Errorf("nonces and dist must have the same length: %d != %d", len(nonces), len(dist))
+	}
+	nonces, dist = sortedUniqueLeaves(nonces, dist)
+	root, progression, err := types.PoCBatchMerkleRoot(nonces, dist)
+	if err != nil {
+		return nil, types.PoCNonceProgression{}, err
+	}
+
+	stageDir := filepath.Join(s.baseDir, strconv.FormatInt(pocStageStartBlockHeight, 10))
+	if _, err := os.Stat(stageDir); os.IsNotExist(err) {
+		if err := os.MkdirAll(stageDir, 0755); err != nil {
+			return nil, types.PoCNonceProgression{}, fmt.Errorf("create stage dir: %w", err)
+		}
+		s.pruneStages()
+	}
+
+	data, err := json.Marshal(storedBatch{Nonces: nonces, Dist: dist})
+	if err != nil {
+		return nil, types.PoCNonceProgression{}, fmt.Errorf("marshal batch: %w", err)
+	}
+	targetPath := s.batchPath(pocStageStartBlockHeight, batchId)
+	tempPath := targetPath + ".tmp"
+	if err := os.WriteFile(tempPath, data, 0644); err != nil {
+		return nil, types.PoCNonceProgression{}, fmt.Errorf("write temp file: %w", err)
+	}
+	if err := os.Rename(tempPath, targetPath); err != nil {
+		os.Remove(tempPath)
+		return nil, types.PoCNonceProgression{}, fmt.Errorf("rename to target: %w", err)
+	}
+	return root, progression, nil
+}
+
+// Leaves returns the leaves at the given indexes of a stored batch, with their Merkle proofs
+func (s *BatchStore) Leaves(pocStageStartBlockHeight int64, batchId string, indexes []uint32) ([]CommittedLeaf, error) {
+	data, err := os.ReadFile(s.batchPath(pocStageStartBlockHeight, batchId))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrBatchNotFound
+		}
+		return nil, fmt.Errorf("read batch: %w", err)
+	}
+	var batch storedBatch
+	if err := json.Unmarshal(data, &batch); err != nil {
+		return nil, fmt.Errorf("unmarshal batch: %w", err)
+	}
+
+	leaves := make([]CommittedLeaf, 0, len(indexes))
+	for _, index := range indexes {
+		proof, err := types.PoCBatchMerkleProof(batch.Nonces, batch.Dist, int(index))
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, CommittedLeaf{
+			BatchId:   batchId,
+			LeafIndex: index,
+			Nonce:     batch.Nonces[index],
+			Dist:      batch.Dist[index],
+			Proof:     proof,
+		})
+	}
+	return leaves, nil
+}
+
+// sortedUniqueLeaves orders leaves by nonce, as committed batches require, keeping the first of duplicate nonces
+func sortedUniqueLeaves(nonces []int64, dist []float64) ([]int64, []float64) {
+	order := make([]int, len(nonces))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return nonces[order[a]] < nonces[order[b]] })
+
+	sortedNonces := make([]int64, 0, len(nonces))
+	sortedDist := make([]float64, 0, len(dist))
+	for _, i := range order {
+		if n := len(sortedNonces); n > 0 && sortedNonces[n-1] == nonces[i] {
+			continue
+		}
+		sortedNonces = append(sortedNonces, nonces[i])
+		sortedDist = append(sortedDist, dist[i])
+	}
+	return sortedNonces, sortedDist
+}
+
+// pruneStages removes all but the latest retainedStages stage directories
+func (s *BatchStore) pruneStages() {
+	entries, err := os.ReadDir(s.baseDir)
+	if err != nil {
+		logging.Warn("Failed to list PoC batch stages", types.PoC, "baseDir", s.baseDir, "error", err)
+		return
+	}
+	var stages []int64
+	for _, entry := range entries {
+		stage, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		stages = append(stages, stage)
+	}
+	if len(stages) <= s.retainedStages {
+		return
+	}
+	sort.Slice(stages, func(i, j int) bool { return stages[i] < stages[j] })
+	for _, stage := range stages[:len(stages)-s.retainedStages] {
+		if err := os.RemoveAll(filepath.Join(s.baseDir, strconv.FormatInt(stage, 10))); err != nil {
+			logging.Warn("Failed to prune PoC batch stage", types.PoC, "stage", stage, "error", err)
+		}
+	}
+}
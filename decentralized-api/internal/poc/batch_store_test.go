@@ -0,0 +1,65 @@
+package poc
+
+import (
+	"os"
+	"testing"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchStore_LeavesVerifyAgainstRoot(t *testing.T) {
+	store := NewBatchStore(t.TempDir(), 2)
+	nonces := []int64{11, 4, 27, 8, 15}
+	dist := []float64{0.1, 0.2, 0.3, 0.4, 0.5}
+
+	root, progression, err := store.Store(100, "batch-1", nonces, dist)
+	require.NoError(t, err)
+	require.Equal(t, types.PoCNonceProgression{Count: 5, First: 4, Last: 27, Stride: 1}, progression)
+
+	// Leaves are committed sorted by nonce
+	sortedNonces := []int64{4, 8, 11, 15, 27}
+	sortedDist := []float64{0.2, 0.4, 0.1, 0.5, 0.3}
+	leaves, err := store.Leaves(100, "batch-1", []uint32{4, 0, 2})
+	require.NoError(t, err)
+	require.Len(t, leaves, 3)
+	for _, leaf := range leaves {
+		require.Equal(t, sortedNonces[leaf.LeafIndex], leaf.Nonce)
+		require.Equal(t, sortedDist[leaf.LeafIndex], leaf.Dist)
+		require.True(t, types.VerifyPoCBatchLeaf(root, progression, leaf.LeafIndex, leaf.Nonce, leaf.Dist, leaf.Proof))
+	}
+
+	_, err = store.Leaves(100, "batch-1", []uint32{5})
+	require.Error(t, err)
+	_, err = store.Leaves(100, "../batch-1", []uint32{0})
+	require.ErrorIs(t, err, ErrBatchNotFound)
+}
+
+func TestBatchStore_PrunesOldStages(t *testing.T) {
+	baseDir := t.TempDir()
+	store := NewBatchStore(baseDir, 2)
+	for _, stage := range []int64{100, 200, 300} {
+		_, _, err := store.Store(stage, "batch", []int64{1}, []float64{0.1})
+		require.NoError(t, err)
+	}
+
+	_, err := store.Leaves(100, "batch", []uint32{0})
+	require.ErrorIs(t, err, ErrBatchNotFound)
+	_, err = store.Leaves(200, "batch", []uint32{0})
+	require.NoError(t, err)
+	entries, err := os.ReadDir(baseDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
+
+func TestBatchStore_DropsDuplicateNonces(t *testing.T) {
+	store := NewBatchStore(t.TempDir(), 2)
+	_, progression, err := store.Store(100, "batch", []int64{6, 2, 6, 10}, []float64{0.1, 0.2, 0.3, 0.4})
+	require.NoError(t, err)
+	require.Equal(t, types.PoCNonceProgression{Count: 3, First: 2, Last: 10, Stride: 4}, progression)
+
+	leaves, err := store.Leaves(100, "batch", []uint32{1})
+	require.NoError(t, err)
+	require.Equal(t, int64(6), leaves[0].Nonce)
+	require.Equal(t, 0.1, leaves[0].Dist)
+}
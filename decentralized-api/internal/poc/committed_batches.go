@@ -0,0 +1,261 @@
+package poc
+
+import (
+	"context"
+	"decentralized-api/logging"
+	"decentralized-api/mlnodeclient"
+	apiutils "decentralized-api/utils"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/productscience/inference/api/inference/inference"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+var committedLeavesClient = apiutils.NewHttpClient(30 * time.Second)
+
+// ErrInvalidCommittedLeaves is returned when the served leaves contradict the commitment they were verified against
+var ErrInvalidCommittedLeaves = errors.New("committed leaves are duplicate or out of order")
+
+// CommittedLeavesResponse is served by participants for the sampled leaves of their committed batches
+type CommittedLeavesResponse struct {
+	Leaves []CommittedLeaf `json:"leaves"`
+}
+
+// CommittedLeavesPath returns the public API path serving leaves of a committed batch
+func CommittedLeavesPath(pocStageStartBlockHeight int64, batchId string) string {
+	return fmt.Sprintf("v1/poc-batches/%d/%s/leaves", pocStageStartBlockHeight, url.PathEscape(batchId))
+}
+
+// FormatLeafIndexes encodes leaf indexes for the indexes query parameter
+func FormatLeafIndexes(indexes []uint32) string {
+	parts := make([]string, len(indexes))
+	for i, index := range indexes {
+		parts[i] = strconv.FormatUint(uint64(index), 10)
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParseLeafIndexes decodes the indexes query parameter
+func ParseLeafIndexes(value string) ([]uint32, error) {
+	if value == "" {
+		return nil, fmt.Errorf("indexes required")
+	}
+	parts := strings.Split(value, ",")
+	indexes := make([]uint32, len(parts))
+	for i, part := range parts {
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid leaf index %q", part)
+		}
+		indexes[i] = uint32(index)
+	}
+	return indexes, nil
+}
+
+// sampleCommittedLeafIndexes samples leaves across the committed batches of a participant as if they were one
+// joined batch, and groups the sampled leaf indexes by batch
+func sampleCommittedLeafIndexes(batches []types.PoCBatch, validatorPubKey string, samplingBlockHash string, blockHeight int64, nSamples int64) map[string][]uint32 {
+	total := int64(0)
+	for _, batch := range batches {
+		total += int64(batch.NonceCount)
+	}
+
+	positions := mlnodeclient.SampleLeafIndexes(validatorPubKey, samplingBlockHash, blockHeight, nSamples, total)
+	sort.Ints(positions)
+
+	sampled := make(map[string][]uint32)
+	batchStart := int64(0)
+	batchIdx := 0
+	for _, position := range positions {
+		for int64(position) >= batchStart+int64(batches[batchIdx].NonceCount) {
+			batchStart += int64(batches[batchIdx].NonceCount)
+			batchIdx++
+		}
+		batchId := batches[batchIdx].BatchId
+		sampled[batchId] = append(sampled[batchId], uint32(int64(position)-batchStart))
+	}
+	return sampled
+}
+
+// fetchCommittedLeaves fetches sampled leaves of a committed batch from the participant's API node and checks
+// every one of them against the batch root and nonce progression recorded on-chain. Leaf nonces must strictly
+// increase with the leaf index, a duplicate or out-of-order leaf fails the batch.
+func fetchCommittedLeaves(ctx context.Context, inferenceUrl string, batch types.PoCBatch, indexes []uint32) ([]CommittedLeaf, error) {
+	baseUrl, err := url.JoinPath(inferenceUrl, CommittedLeavesPath(batch.PocStageStartBlockHeight, batch.BatchId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build leaves URL: %w", err)
+	}
+	requestUrl := baseUrl + "?indexes=" + FormatLeafIndexes(indexes)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := committedLeavesClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("participant returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var leavesResp CommittedLeavesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&leavesResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if len(leavesResp.Leaves) != len(indexes) {
+		return nil, fmt.Errorf("participant returned %d leaves, %d were requested", len(leavesResp.Leaves), len(indexes))
+	}
+	for i, leaf := range leavesResp.Leaves {
+		if leaf.LeafIndex != indexes[i] || leaf.BatchId != batch.BatchId {
+			return nil, fmt.Errorf("participant returned leaf %d of batch %s instead of leaf %d", leaf.LeafIndex, leaf.BatchId, indexes[i])
+		}
+		if !types.VerifyPoCBatchLeaf(batch.MerkleRoot, batch.NonceProgression(), leaf.LeafIndex, leaf.Nonce, leaf.Dist, leaf.Proof) {
+			return nil, fmt.Errorf("leaf %d of batch %s doesn't match the committed root", leaf.LeafIndex, batch.BatchId)
+		}
+	}
+	if err := checkLeavesIncreasing(leavesResp.Leaves); err != nil {
+		return nil, fmt.Errorf("%w: batch %s: %v", ErrInvalidCommittedLeaves, batch.BatchId, err)
+	}
+	return leavesResp.Leaves, nil
+}
+
+// checkLeavesIncreasing checks that the nonces of leaves sorted by index strictly increase. The same leaf
+// sampled twice is fine.
+func checkLeavesIncreasing(leaves []CommittedLeaf) error {
+	for i := 1; i < len(leaves); i++ {
+		prev, cur := leaves[i-1], leaves[i]
+		if cur.LeafIndex == prev.LeafIndex {
+			if cur.Nonce != prev.Nonce {
+				return fmt.Errorf("leaf %d served with nonces %d and %d", cur.LeafIndex, prev.Nonce, cur.Nonce)
+			}
+			continue
+		}
+		if cur.Nonce <= prev.Nonce {
+			return fmt.Errorf("leaf %d has nonce %d, not above nonce %d of leaf %d", cur.LeafIndex, cur.Nonce, prev.Nonce, prev.LeafIndex)
+		}
+	}
+	return nil
+}
+
+type committedLeafKey struct {
+	participant              string
+	pocStageStartBlockHeight int64
+	nonce                    int64
+}
+
+// CommittedLeafRegistry remembers the committed leaves a validator sent for validation, so the ones the ML node
+// finds invalid can be disputed on-chain with their proofs. Only the latest PoC stage is kept.
+type CommittedLeafRegistry struct {
+	mu     sync.Mutex
+	stage  int64
+	leaves map[committedLeafKey]CommittedLeaf
+}
+
+func NewCommittedLeafRegistry() *CommittedLeafRegistry {
+	return &CommittedLeafRegistry{leaves: make(map[committedLeafKey]CommittedLeaf)}
+}
+
+func (r *CommittedLeafRegistry) Add(participant string, pocStageStartBlockHeight int64, leaves []CommittedLeaf) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if pocStageStartBlockHeight != r.stage {
+		r.stage = pocStageStartBlockHeight
+		r.leaves = make(map[committedLeafKey]CommittedLeaf)
+	}
+	for _, leaf := range leaves {
+		r.leaves[committedLeafKey{participant: participant, pocStageStartBlockHeight: pocStageStartBlockHeight, nonce: leaf.Nonce}] = leaf
+	}
+}
+
+// Disputes returns the committed leaves of a validated batch that the ML node counted as invalid. The chain only
+// accepts disputes of leaves whose committed distance misses the target, so leaves that only the recomputed
+// distance fails are left to the sampled validation.
+func (r *CommittedLeafRegistry) Disputes(participant string, batch mlnodeclient.ValidatedBatch) []*inference.PoCLeafDispute {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var disputes []*inference.PoCLeafDispute
+	for _, i := range batch.InvalidNonceIndexes() {
+		leaf, found := r.leaves[committedLeafKey{participant: participant, pocStageStartBlockHeight: batch.BlockHeight, nonce: batch.Nonces[i]}]
+		if !found || leaf.Dist < batch.RTarget {
+			continue
+		}
+		disputes = append(disputes, &inference.PoCLeafDispute{
+			BatchId:      leaf.BatchId,
+			LeafIndex:    leaf.LeafIndex,
+			Nonce:        leaf.Nonce,
+			ReceivedDist: leaf.Dist,
+			Dist:         batch.Dist[i],
+			Proof:        leaf.Proof,
+		})
+		if len(disputes) == types.MaxPoCLeafDisputes {
+			logging.Warn("Too many invalid committed leaves, disputing only the first ones", types.PoC,
+				"participant", participant, "maxDisputes", types.MaxPoCLeafDisputes)
+			break
+		}
+	}
+	return disputes
+}
+
+// fetchSampledCommittedLeaves samples leaves across a participant's committed batches and fetches them, with
+// proofs, from the participant's API node
+func (o *NodePoCOrchestratorImpl) fetchSampledCommittedLeaves(participant string, batches []types.PoCBatch, nSamples int64, samplingBlockHash string, blockHeight int64) ([]CommittedLeaf, error) {
+	inferenceUrl, err := o.chainBridge.GetParticipantInferenceUrl(participant)
+	if err != nil {
+		return nil, err
+	}
+	if inferenceUrl == "" {
+		return nil, fmt.Errorf("participant has no inference URL")
+	}
+
+	sampled := sampleCommittedLeafIndexes(batches, o.pubKey, samplingBlockHash, blockHeight, nSamples)
+	var leaves []CommittedLeaf
+	for _, batch := range batches {
+		indexes := sampled[batch.BatchId]
+		if len(indexes) == 0 {
+			continue
+		}
+		var batchLeaves []CommittedLeaf
+		for attempt := range POC_FETCH_LEAVES_RETRIES {
+			batchLeaves, err = fetchCommittedLeaves(context.Background(), inferenceUrl, batch, indexes)
+			if err == nil || errors.Is(err, ErrInvalidCommittedLeaves) {
+				break
+			}
+			logging.Warn("Failed to fetch committed PoC leaves", types.PoC,
+				"participant", participant, "batchId", batch.BatchId, "attempt", attempt, "error", err)
+		}
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, batchLeaves...)
+	}
+	return leaves, nil
+}
+
+// reportInvalidCommitment records a participant that couldn't back its committed batches as fraudulent
+func (o *NodePoCOrchestratorImpl) reportInvalidCommitment(participant string, pocStageStartBlockHeight int64, nSamples int64) {
+	msg := &inference.MsgSubmitPocValidation{
+		ParticipantAddress:       participant,
+		PocStageStartBlockHeight: pocStageStartBlockHeight,
+		NInvalid:                 nSamples,
+		ProbabilityHonest:        0,
+		FraudDetected:            true,
+	}
+	if err := o.chainBridge.SubmitPocValidation(msg); err != nil {
+		logging.Error("Failed to report invalid PoC batch commitment", types.PoC,
+			"participant", participant, "pocStageStartBlockHeight", pocStageStartBlockHeight, "error", err)
+	}
+}
@@ -0,0 +1,122 @@
+package poc
+
+import (
+	"context"
+	"decentralized-api/mlnodeclient"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSampleCommittedLeafIndexes(t *testing.T) {
+	batches := []types.PoCBatch{
+		{BatchId: "a", NonceCount: 3},
+		{BatchId: "b", NonceCount: 5},
+	}
+
+	// Asking for more samples than leaves samples everything
+	sampled := sampleCommittedLeafIndexes(batches, "validator", "hash", 100, 20)
+	require.Equal(t, []uint32{0, 1, 2}, sampled["a"])
+	require.Equal(t, []uint32{0, 1, 2, 3, 4}, sampled["b"])
+
+	sampled = sampleCommittedLeafIndexes(batches, "validator", "hash", 100, 4)
+	require.Len(t, append(sampled["a"], sampled["b"]...), 4)
+	require.Equal(t, sampled, sampleCommittedLeafIndexes(batches, "validator", "hash", 100, 4))
+}
+
+func TestFetchCommittedLeaves(t *testing.T) {
+	store := NewBatchStore(t.TempDir(), 1)
+	nonces := []int64{5, 6, 7, 8}
+	dist := []float64{0.1, 0.2, 0.3, 0.4}
+	root, progression, err := store.Store(100, "batch", nonces, dist)
+	require.NoError(t, err)
+	batch := types.PoCBatch{
+		PocStageStartBlockHeight: 100,
+		BatchId:                  "batch",
+		MerkleRoot:               root,
+		NonceCount:               progression.Count,
+		FirstNonce:               progression.First,
+		LastNonce:                progression.Last,
+		NonceStride:              progression.Stride,
+	}
+
+	tamper := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/"+CommittedLeavesPath(100, "batch"), r.URL.Path)
+		indexes, err := ParseLeafIndexes(r.URL.Query().Get("indexes"))
+		require.NoError(t, err)
+		leaves, err := store.Leaves(100, "batch", indexes)
+		require.NoError(t, err)
+		if tamper {
+			leaves[0].Dist = 0.01
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(CommittedLeavesResponse{Leaves: leaves}))
+	}))
+	defer server.Close()
+
+	leaves, err := fetchCommittedLeaves(context.Background(), server.URL, batch, []uint32{1, 3})
+	require.NoError(t, err)
+	require.Equal(t, int64(6), leaves[0].Nonce)
+	require.Equal(t, int64(8), leaves[1].Nonce)
+
+	// Leaves that don't match the committed root are rejected
+	tamper = true
+	_, err = fetchCommittedLeaves(context.Background(), server.URL, batch, []uint32{1})
+	require.Error(t, err)
+}
+
+func TestCheckLeavesIncreasing(t *testing.T) {
+	require.NoError(t, checkLeavesIncreasing([]CommittedLeaf{{LeafIndex: 0, Nonce: 2}, {LeafIndex: 0, Nonce: 2}, {LeafIndex: 3, Nonce: 9}}))
+	require.Error(t, checkLeavesIncreasing([]CommittedLeaf{{LeafIndex: 1, Nonce: 4}, {LeafIndex: 2, Nonce: 4}}))
+	require.Error(t, checkLeavesIncreasing([]CommittedLeaf{{LeafIndex: 1, Nonce: 4}, {LeafIndex: 5, Nonce: 3}}))
+	require.Error(t, checkLeavesIncreasing([]CommittedLeaf{{LeafIndex: 1, Nonce: 4}, {LeafIndex: 1, Nonce: 5}}))
+}
+
+func TestAppendCommittedLeaves_FailsOnNonceClaimedTwice(t *testing.T) {
+	uniqueNonces := map[int64]struct{}{1: {}}
+	batch, err := appendCommittedLeaves(mlnodeclient.ProofBatch{}, []CommittedLeaf{
+		{BatchId: "a", LeafIndex: 0, Nonce: 4, Dist: 0.1},
+		{BatchId: "a", LeafIndex: 0, Nonce: 4, Dist: 0.1},
+		{BatchId: "b", LeafIndex: 2, Nonce: 7, Dist: 0.2},
+	}, uniqueNonces)
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 7}, batch.Nonces)
+
+	// The same nonce in another batch, or in a batch submitted in full, fails the participant
+	_, err = appendCommittedLeaves(mlnodeclient.ProofBatch{}, []CommittedLeaf{{BatchId: "c", LeafIndex: 0, Nonce: 7}}, uniqueNonces)
+	require.ErrorIs(t, err, ErrInvalidCommittedLeaves)
+	_, err = appendCommittedLeaves(mlnodeclient.ProofBatch{}, []CommittedLeaf{{BatchId: "c", LeafIndex: 0, Nonce: 1}}, uniqueNonces)
+	require.ErrorIs(t, err, ErrInvalidCommittedLeaves)
+}
+
+func TestCommittedLeafRegistry_Disputes(t *testing.T) {
+	registry := NewCommittedLeafRegistry()
+	registry.Add("participant", 100, []CommittedLeaf{
+		{BatchId: "batch", LeafIndex: 0, Nonce: 5, Dist: 0.1},
+		{BatchId: "batch", LeafIndex: 1, Nonce: 6, Dist: 0.9},
+	})
+
+	validated := mlnodeclient.ValidatedBatch{
+		ProofBatch: mlnodeclient.ProofBatch{
+			BlockHeight: 100,
+			Nonces:      []int64{5, 6, 7},
+			Dist:        []float64{0.8, 0.2, 0.9},
+		},
+		ReceivedDist: []float64{0.1, 0.9, 0.1},
+		RTarget:      0.5,
+	}
+	disputes := registry.Disputes("participant", validated)
+	// Only the committed distance above the target is disputed, not the recomputed one
+	require.Len(t, disputes, 1)
+	require.Equal(t, int64(6), disputes[0].Nonce)
+	require.Equal(t, 0.9, disputes[0].ReceivedDist)
+	require.Equal(t, 0.2, disputes[0].Dist)
+
+	// A new stage drops leaves of the previous one
+	registry.Add("participant", 200, nil)
+	require.Empty(t, registry.Disputes("participant", validated))
+}
@@ -7,13 +7,16 @@ import (
 	cosmos_client "decentralized-api/cosmosclient"
 	"decentralized-api/logging"
 	"decentralized-api/mlnodeclient"
+	"fmt"
 
+	"github.com/productscience/inference/api/inference/inference"
 	"github.com/productscience/inference/x/inference/types"
 )
 
 const (
 	POC_VALIDATE_BATCH_RETRIES     = 5
 	POC_VALIDATE_SAMPLES_PER_BATCH = 200
+	POC_FETCH_LEAVES_RETRIES       = 3
 )
 
 type NodePoCOrchestrator interface {
@@ -21,17 +24,20 @@ type NodePoCOrchestrator interface {
 }
 
 type NodePoCOrchestratorImpl struct {
-	pubKey       string
-	nodeBroker   *broker.Broker
-	callbackUrl  string
-	chainBridge  OrchestratorChainBridge
-	phaseTracker *chainphase.ChainPhaseTracker
+	pubKey          string
+	nodeBroker      *broker.Broker
+	callbackUrl     string
+	chainBridge     OrchestratorChainBridge
+	phaseTracker    *chainphase.ChainPhaseTracker
+	committedLeaves *CommittedLeafRegistry
 }
 
 type OrchestratorChainBridge interface {
 	PoCBatchesForStage(startPoCBlockHeight int64) (*types.QueryPocBatchesForStageResponse, error)
 	GetBlockHash(height int64) (string, error)
 	GetPocParams() (*types.PocParams, error)
+	GetParticipantInferenceUrl(address string) (string, error)
+	SubmitPocValidation(msg *inference.MsgSubmitPocValidation) error
 }
 
 type OrchestratorChainBridgeImpl struct {
@@ -58,6 +64,19 @@ func (b *OrchestratorChainBridgeImpl) GetPocParams() (*types.PocParams, error) {
 	return pocParams, nil
 }
 
+func (b *OrchestratorChainBridgeImpl) GetParticipantInferenceUrl(address string) (string, error) {
+	response, err := b.cosmosClient.NewInferenceQueryClient().Participant(b.cosmosClient.GetContext(), &types.QueryGetParticipantRequest{Index: address})
+	if err != nil {
+		logging.Error("Failed to query participant", types.PoC, "participant", address, "error", err)
+		return "", err
+	}
+	return response.Participant.InferenceUrl, nil
+}
+
+func (b *OrchestratorChainBridgeImpl) SubmitPocValidation(msg *inference.MsgSubmitPocValidation) error {
+	return b.cosmosClient.SubmitPoCValidation(msg)
+}
+
 func (b *OrchestratorChainBridgeImpl) GetBlockHash(height int64) (string, error) {
 	client, err := cosmos_client.NewRpcClient(b.chainNodeUrl)
 	if err != nil {
@@ -72,7 +91,7 @@ func (b *OrchestratorChainBridgeImpl) GetBlockHash(height int64) (string, error)
 	return block.Block.Hash().String(), err
 }
 
-func NewNodePoCOrchestratorForCosmosChain(pubKey string, nodeBroker *broker.Broker, callbackUrl string, chainNodeUrl string, cosmosClient cosmos_client.CosmosMessageClient, phaseTracker *chainphase.ChainPhaseTracker) NodePoCOrchestrator {
+func NewNodePoCOrchestratorForCosmosChain(pubKey string, nodeBroker *broker.Broker, callbackUrl string, chainNodeUrl string, cosmosClient cosmos_client.CosmosMessageClient, phaseTracker *chainphase.ChainPhaseTracker, committedLeaves *CommittedLeafRegistry) NodePoCOrchestrator {
 	return &NodePoCOrchestratorImpl{
 		pubKey:      pubKey,
 		nodeBroker:  nodeBroker,
@@ -81,17 +100,19 @@ func NewNodePoCOrchestratorForCosmosChain(pubKey string, nodeBroker *broker.Brok
 			cosmosClient: cosmosClient,
 			chainNodeUrl: chainNodeUrl,
 		},
-		phaseTracker: phaseTracker,
+		phaseTracker:    phaseTracker,
+		committedLeaves: committedLeaves,
 	}
 }
 
 func NewNodePoCOrchestrator(pubKey string, nodeBroker *broker.Broker, callbackUrl string, chainBridge OrchestratorChainBridge, phaseTracker *chainphase.ChainPhaseTracker) NodePoCOrchestrator {
 	return &NodePoCOrchestratorImpl{
-		pubKey:       pubKey,
-		nodeBroker:   nodeBroker,
-		callbackUrl:  callbackUrl,
-		chainBridge:  chainBridge,
-		phaseTracker: phaseTracker,
+		pubKey:          pubKey,
+		nodeBroker:      nodeBroker,
+		callbackUrl:     callbackUrl,
+		chainBridge:     chainBridge,
+		phaseTracker:    phaseTracker,
+		committedLeaves: NewCommittedLeafRegistry(),
 	}
 }
 
@@ -196,8 +217,13 @@ func (o *NodePoCOrchestratorImpl) ValidateReceivedBatches(pocStageStartBlockHeig
 		}
 
 		uniqueNonces := make(map[int64]struct{})
+		var committedBatches []types.PoCBatch
 
 		for _, b := range participantBatches.PocBatch {
+			if b.IsCommitment() {
+				committedBatches = append(committedBatches, b)
+				continue
+			}
 			if len(b.Nonces) != len(b.Dist) {
 				logging.Error("ValidateReceivedBatches. Nonces length mismatch. Skipping the batch", types.PoC,
 					"participant", participantBatches.Participant,
@@ -222,6 +248,23 @@ func (o *NodePoCOrchestratorImpl) ValidateReceivedBatches(pocStageStartBlockHeig
 
 		batchToValidate := joinedBatch.SampleNoncesToValidate(o.pubKey, samplesPerBatch, samplingBlockHash)
 
+		// Committed batches are sampled the same way, and the sampled leaves fetched from the participant
+		if len(committedBatches) > 0 {
+			leaves, err := o.fetchSampledCommittedLeaves(participantBatches.Participant, committedBatches, samplesPerBatch, samplingBlockHash, startOfPoCBlockHeight)
+			if err == nil {
+				batchToValidate, err = appendCommittedLeaves(batchToValidate, leaves, uniqueNonces)
+			}
+			if err != nil {
+				logging.Warn("ValidateReceivedBatches. Participant can't back its committed batches", types.PoC,
+					"pocStageStartBlockHeight", pocStageStartBlockHeight,
+					"participant", participantBatches.Participant,
+					"error", err)
+				o.reportInvalidCommitment(participantBatches.Participant, pocStageStartBlockHeight, samplesPerBatch)
+				continue
+			}
+			o.committedLeaves.Add(participantBatches.Participant, startOfPoCBlockHeight, leaves)
+		}
+
 		validationSucceeded := false
 		for attempt := range POC_VALIDATE_BATCH_RETRIES {
 			node := nodes[attemptCounter%len(nodes)]
@@ -265,6 +308,30 @@ func (o *NodePoCOrchestratorImpl) ValidateReceivedBatches(pocStageStartBlockHeig
 		"failedValidations", failedValidations)
 }
 
+// appendCommittedLeaves adds sampled committed leaves to the batch sent for validation. Committed nonces must be
+// unique across the participant's batches, so a nonce sampled twice from different leaves fails the batch.
+func appendCommittedLeaves(batch mlnodeclient.ProofBatch, leaves []CommittedLeaf, uniqueNonces map[int64]struct{}) (mlnodeclient.ProofBatch, error) {
+	type leafKey struct {
+		batchId   string
+		leafIndex uint32
+	}
+	sampled := make(map[leafKey]struct{}, len(leaves))
+	for _, leaf := range leaves {
+		key := leafKey{batchId: leaf.BatchId, leafIndex: leaf.LeafIndex}
+		if _, exists := sampled[key]; exists {
+			continue
+		}
+		sampled[key] = struct{}{}
+		if _, exists := uniqueNonces[leaf.Nonce]; exists {
+			return batch, fmt.Errorf("%w: nonce %d of leaf %d in batch %s is claimed twice", ErrInvalidCommittedLeaves, leaf.Nonce, leaf.LeafIndex, leaf.BatchId)
+		}
+		uniqueNonces[leaf.Nonce] = struct{}{}
+		batch.Nonces = append(batch.Nonces, leaf.Nonce)
+		batch.Dist = append(batch.Dist, leaf.Dist)
+	}
+	return batch, nil
+}
+
 func filterNodes(nodes []broker.NodeResponse) []broker.NodeResponse {
 	filtered := make([]broker.NodeResponse, 0, len(nodes))
 	for _, node := range nodes {
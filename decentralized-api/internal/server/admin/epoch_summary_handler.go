@@ -0,0 +1,106 @@
+package admin
+
+import (
+	"decentralized-api/logging"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// EpochSummaryDto is this participant's view of an epoch: weight, ML node assignments and earnings
+type EpochSummaryDto struct {
+	EpochIndex         uint64                         `json:"epoch_index"`
+	Address            string                         `json:"address"`
+	Weight             int64                          `json:"weight"`
+	ConfirmationWeight int64                          `json:"confirmation_weight"`
+	TotalWeight        int64                          `json:"total_weight"`
+	Models             []ModelAssignmentDto           `json:"models"`
+	Performance        *types.EpochPerformanceSummary `json:"performance,omitempty"`
+}
+
+type ModelAssignmentDto struct {
+	ModelId string              `json:"model_id"`
+	Weight  int64               `json:"weight"`
+	MlNodes []*types.MLNodeInfo `json:"ml_nodes"`
+}
+
+// getEpochSummary handles GET /admin/v1/epochs/:epoch/summary, epoch may be "current"
+func (s *Server) getEpochSummary(c echo.Context) error {
+	ctx := c.Request().Context()
+	queryClient := s.recorder.NewInferenceQueryClient()
+
+	var epochIndex uint64
+	if epochParam := c.Param("epoch"); epochParam == "current" {
+		currentEpoch, err := queryClient.GetCurrentEpoch(ctx, &types.QueryGetCurrentEpochRequest{})
+		if err != nil {
+			logging.Error("Failed to get current epoch", types.Participants, "error", err)
+			return err
+		}
+		epochIndex = currentEpoch.Epoch
+	} else {
+		parsed, err := strconv.ParseUint(epochParam, 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid epoch: "+epochParam)
+		}
+		epochIndex = parsed
+	}
+
+	address := s.recorder.GetAccountAddress()
+	parent, err := queryClient.EpochGroupData(ctx, &types.QueryGetEpochGroupDataRequest{EpochIndex: epochIndex})
+	if err != nil {
+		logging.Error("Failed to get epoch group data", types.Participants, "epoch", epochIndex, "error", err)
+		return err
+	}
+
+	summary := EpochSummaryDto{
+		EpochIndex:  epochIndex,
+		Address:     address,
+		TotalWeight: parent.EpochGroupData.TotalWeight,
+		Models:      []ModelAssignmentDto{},
+	}
+	if weight := findValidationWeight(parent.EpochGroupData.ValidationWeights, address); weight != nil {
+		summary.Weight = weight.Weight
+		summary.ConfirmationWeight = weight.ConfirmationWeight
+	}
+
+	for _, modelId := range parent.EpochGroupData.SubGroupModels {
+		subGroup, err := queryClient.EpochGroupData(ctx, &types.QueryGetEpochGroupDataRequest{EpochIndex: epochIndex, ModelId: modelId})
+		if err != nil {
+			logging.Warn("Failed to get model epoch group data", types.Participants, "epoch", epochIndex, "model", modelId, "error", err)
+			continue
+		}
+		weight := findValidationWeight(subGroup.EpochGroupData.ValidationWeights, address)
+		if weight == nil {
+			continue
+		}
+		summary.Models = append(summary.Models, ModelAssignmentDto{
+			ModelId: modelId,
+			Weight:  weight.Weight,
+			MlNodes: weight.MlNodes,
+		})
+	}
+
+	performance, err := queryClient.EpochPerformanceSummaryByParticipant(ctx, &types.QueryEpochPerformanceSummaryByParticipantRequest{
+		EpochIndex:    epochIndex,
+		ParticipantId: address,
+	})
+	if err != nil {
+		// Summaries are only written once the epoch settles
+		logging.Debug("Epoch performance summary not available", types.Participants, "epoch", epochIndex, "error", err)
+	} else {
+		summary.Performance = &performance.EpochPerformanceSummary
+	}
+
+	return c.JSON(http.StatusOK, summary)
+}
+
+func findValidationWeight(weights []*types.ValidationWeight, address string) *types.ValidationWeight {
+	for _, weight := range weights {
+		if weight.MemberAddress == address {
+			return weight
+		}
+	}
+	return nil
+}
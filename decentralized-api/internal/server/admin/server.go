@@ -90,9 +90,15 @@ func NewServer(
 	// Manual validation recovery and claim endpoint
 	g.POST("claim-reward/recover", s.postClaimRewardRecover)
 
+	// Payload fetch outcomes, including failures that led to unavailability invalidations
+	g.GET("validation/payload-fetch-stats", s.getPayloadFetchStats)
+
 	// EXPERIMENTAL: Setup and health report endpoint for participant onboarding
 	g.GET("setup/report", s.getSetupReport)
 
+	// Participant epoch weight, ML node assignments and earnings
+	g.GET("epochs/:epoch/summary", s.getEpochSummary)
+
 	// Bridge
 	g.POST("bridge/block", s.postBridgeBlock)
 
@@ -156,3 +156,10 @@ func (s *Server) postClaimRewardRecover(ctx echo.Context) error {
 		ClaimExecuted:     claimExecuted,
 	})
 }
+
+func (s *Server) getPayloadFetchStats(ctx echo.Context) error {
+	if s.validator == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "Validator is not running")
+	}
+	return ctx.JSON(http.StatusOK, s.validator.PayloadFetchStats())
+}
@@ -43,6 +43,22 @@ func (s *Server) postGeneratedBatches(ctx echo.Context) error {
 		NodeId:                   nodeId,
 	}
 
+	// With commitments enabled only the Merkle root goes on-chain, validators fetch sampled leaves from us
+	if s.batchCommitmentsEnabled() {
+		root, progression, err := s.batchStore.Store(body.BlockHeight, msg.BatchId, body.Nonces, body.Dist)
+		if err != nil {
+			logging.Error("ProofBatch-callback. Failed to store batch, submitting it in full", types.PoC, "error", err)
+		} else {
+			msg.MerkleRoot = root
+			msg.NonceCount = progression.Count
+			msg.FirstNonce = progression.First
+			msg.LastNonce = progression.Last
+			msg.NonceStride = progression.Stride
+			msg.Nonces = nil
+			msg.Dist = nil
+		}
+	}
+
 	if err := s.recorder.SubmitPocBatch(msg); err != nil {
 		logging.Error("ProofBatch-callback. Failed to submit MsgSubmitPocBatch", types.PoC, "error", err)
 		return err
@@ -96,6 +112,7 @@ func (s *Server) postValidatedBatches(ctx echo.Context) error {
 	//  when making a decision about participant's PoC submissions
 	//  Will be fixed in future versions
 	emptyArrays(msg)
+	msg.Disputes = s.committedLeaves.Disputes(address, body)
 
 	if err := s.recorder.SubmitPoCValidation(msg); err != nil {
 		logging.Error("ValidateReceivedBatches-callback. Failed to submit MsgSubmitValidatedPocBatch", types.PoC,
@@ -112,3 +129,12 @@ func emptyArrays(msg *inference.MsgSubmitPocValidation) {
 	msg.ReceivedDist = make([]float64, 0)
 	msg.Nonces = make([]int64, 0)
 }
+
+func (s *Server) batchCommitmentsEnabled() bool {
+	response, err := s.recorder.NewInferenceQueryClient().Params(s.recorder.GetContext(), &types.QueryParamsRequest{})
+	if err != nil {
+		logging.Warn("ProofBatch-callback. Failed to query params, submitting batch in full", types.PoC, "error", err)
+		return false
+	}
+	return response.Params.PocParams.GetBatchCommitmentsEnabled()
+}
@@ -3,19 +3,22 @@ package mlnode
 import (
 	"decentralized-api/broker"
 	cosmos_client "decentralized-api/cosmosclient"
+	"decentralized-api/internal/poc"
 	"decentralized-api/internal/server/middleware"
 
 	"github.com/labstack/echo/v4"
 )
 
 type Server struct {
-	e        *echo.Echo
-	recorder cosmos_client.CosmosMessageClient
-	broker   *broker.Broker
+	e               *echo.Echo
+	recorder        cosmos_client.CosmosMessageClient
+	broker          *broker.Broker
+	batchStore      *poc.BatchStore
+	committedLeaves *poc.CommittedLeafRegistry
 }
 
 // TODO breacking changes: url path, support on mlnode side
-func NewServer(recorder cosmos_client.CosmosMessageClient, broker *broker.Broker) *Server {
+func NewServer(recorder cosmos_client.CosmosMessageClient, broker *broker.Broker, batchStore *poc.BatchStore, committedLeaves *poc.CommittedLeafRegistry) *Server {
 	e := echo.New()
 
 	e.HTTPErrorHandler = middleware.TransparentErrorHandler
@@ -24,9 +27,11 @@ func NewServer(recorder cosmos_client.CosmosMessageClient, broker *broker.Broker
 	g := e.Group("/mlnode/v1/")
 
 	s := &Server{
-		e:        e,
-		recorder: recorder,
-		broker:   broker,
+		e:               e,
+		recorder:        recorder,
+		broker:          broker,
+		batchStore:      batchStore,
+		committedLeaves: committedLeaves,
 	}
 
 	// keep old paths too for backward compatibility
@@ -11,6 +11,8 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
 	"github.com/labstack/echo/v4"
 	blsTypes "github.com/productscience/inference/x/bls/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // getBLSEpochByID handles requests for BLS epoch data
@@ -116,3 +118,33 @@ func (s *Server) getBLSSignatureByRequestID(c echo.Context) error {
 		"uncompressed_signature_128": uncompressedSig, // base64-encoded in JSON
 	})
 }
+
+// getBLSKeyChainProof handles requests for the chain of validated group keys from a trusted epoch. The optional
+// target_epoch query parameter selects the last epoch, otherwise the proof ends at the latest validated epoch.
+// Verifiers check the proof offline with the keychain package and need not trust this node.
+func (s *Server) getBLSKeyChainProof(c echo.Context) error {
+	trustedEpochID, err := strconv.ParseUint(c.Param("trusted_epoch"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid trusted epoch ID")
+	}
+	var targetEpochID uint64
+	if target := c.QueryParam("target_epoch"); target != "" {
+		targetEpochID, err = strconv.ParseUint(target, 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid target epoch ID")
+		}
+	}
+
+	blsQueryClient := s.recorder.NewBLSQueryClient()
+	res, err := blsQueryClient.EpochKeyChainProof(c.Request().Context(), &blsTypes.QueryEpochKeyChainProofRequest{
+		TrustedEpochId: trustedEpochID,
+		TargetEpochId:  targetEpochID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return echo.NewHTTPError(http.StatusNotFound, "Failed to build key chain proof: "+err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to query key chain proof: "+err.Error())
+	}
+	return c.JSON(http.StatusOK, res.Proof)
+}
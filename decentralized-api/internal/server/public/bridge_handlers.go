@@ -147,6 +147,22 @@ func (q *BridgeQueue) processBlocks() {
 			"receiptsRoot", block.ReceiptsRoot,
 			"receiptsCount", len(block.Receipts))
 
+		// Vote for the receipts root of blocks with deposits so they can be completed with receipt proofs
+		if len(block.Receipts) > 0 {
+			err := q.recorder.AttestBridgeBlock(&types.MsgAttestBridgeBlock{
+				Validator:    q.recorder.GetAccountAddress(),
+				OriginChain:  block.OriginChain,
+				BlockNumber:  block.BlockNumber,
+				ReceiptsRoot: block.ReceiptsRoot,
+			})
+			if err != nil {
+				slog.Error("Error attesting bridge block",
+					"error", err,
+					"blockNumber", block.BlockNumber,
+					"receiptsRoot", block.ReceiptsRoot)
+			}
+		}
+
 		// Process each receipt in the block
 		for _, receipt := range block.Receipts {
 			// Process the receipt with block information
@@ -31,6 +31,7 @@ type OpenAiRequest struct {
 }
 
 type Message struct {
+	Role    string `json:"role"`
 	Content string `json:"content"` // The content of the message
 }
 
@@ -40,7 +41,8 @@ type ExecutorDestination struct {
 }
 
 type ModelsResponse struct {
-	Models []types.Model `json:"models"`
+	Models  []types.Model      `json:"models"`
+	Aliases []types.ModelAlias `json:"aliases,omitempty"`
 }
 
 type ActiveParticipantWithProof struct {
@@ -1,6 +1,8 @@
 package public
 
 import (
+	"context"
+	"decentralized-api/logging"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -17,6 +19,14 @@ func (s *Server) getModels(ctx echo.Context) error {
 		return err
 	}
 
+	// Lifecycle changes apply to governance models immediately, so they are overlaid on the epoch snapshots.
+	governanceModels := make(map[string]types.Model)
+	if modelsResponse, err := queryClient.ModelsAll(context, &types.QueryModelsAllRequest{}); err == nil {
+		for _, model := range modelsResponse.Model {
+			governanceModels[model.Id] = model
+		}
+	}
+
 	var activeModels []types.Model
 	parentEpochData := currentEpoch.GetEpochGroupData()
 
@@ -33,12 +43,19 @@ func (s *Server) getModels(ctx echo.Context) error {
 		}
 
 		if modelEpochData.EpochGroupData.ModelSnapshot != nil {
-			activeModels = append(activeModels, *modelEpochData.EpochGroupData.ModelSnapshot)
+			model := *modelEpochData.EpochGroupData.ModelSnapshot
+			if governanceModel, found := governanceModels[model.Id]; found {
+				model.Status = governanceModel.Status
+				model.SunsetEpoch = governanceModel.SunsetEpoch
+				model.SuccessorId = governanceModel.SuccessorId
+			}
+			activeModels = append(activeModels, model)
 		}
 	}
 
 	return ctx.JSON(http.StatusOK, &ModelsResponse{
-		Models: activeModels,
+		Models:  activeModels,
+		Aliases: s.queryModelAliases(context),
 	})
 }
 
@@ -52,6 +69,18 @@ func (s *Server) getGovernanceModels(ctx echo.Context) error {
 	}
 
 	return ctx.JSON(http.StatusOK, &ModelsResponse{
-		Models: modelsResponse.Model,
+		Models:  modelsResponse.Model,
+		Aliases: s.queryModelAliases(context),
 	})
 }
+
+// queryModelAliases lists the model aliases. Aliases are optional in the models response, so a failed query only
+// leaves them out.
+func (s *Server) queryModelAliases(ctx context.Context) []types.ModelAlias {
+	aliases, err := s.recorder.NewInferenceQueryClient().ModelAliasAll(ctx, &types.QueryAllModelAliasRequest{})
+	if err != nil {
+		logging.Warn("Failed to get model aliases", types.Server, "error", err)
+		return nil
+	}
+	return aliases.Aliases
+}
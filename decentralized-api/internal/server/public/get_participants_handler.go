@@ -53,6 +53,36 @@ func (s *Server) getInferenceParticipantByAddress(c echo.Context) error {
 	return c.JSON(http.StatusOK, response)
 }
 
+// getParticipantStatusExplanation returns the inputs behind a participant's status and reputation.
+// The optional "epoch" query parameter selects a past epoch; the current epoch is used when omitted.
+func (s *Server) getParticipantStatusExplanation(c echo.Context) error {
+	address := c.Param("address")
+	if address == "" {
+		return ErrAddressRequired
+	}
+
+	var epochIndex uint64
+	if epochParam := c.QueryParam("epoch"); epochParam != "" {
+		parsed, err := strconv.ParseUint(epochParam, 10, 64)
+		if err != nil {
+			return ErrInvalidEpochId
+		}
+		epochIndex = parsed
+	}
+
+	queryClient := s.recorder.NewInferenceQueryClient()
+	response, err := queryClient.ParticipantStatusExplanation(c.Request().Context(), &types.QueryParticipantStatusExplanationRequest{
+		Address:    address,
+		EpochIndex: epochIndex,
+	})
+	if err != nil {
+		logging.Error("Failed to get participant status explanation", types.Server, "address", address, "epoch", epochIndex, "error", err)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
 func (s *Server) getParticipantsByEpoch(c echo.Context) error {
 	epoch, err := s.resolveEpochFromContext(c)
 	if err != nil {
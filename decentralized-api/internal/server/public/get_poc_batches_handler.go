@@ -1,11 +1,14 @@
 package public
 
 import (
+	"decentralized-api/internal/poc"
 	"decentralized-api/logging"
+	"errors"
 	"fmt"
 	"github.com/labstack/echo/v4"
 	"github.com/productscience/inference/x/inference/types"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
@@ -38,3 +41,34 @@ func (s *Server) getPoCBatches(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, response)
 }
+
+// maxServedLeaves bounds a single leaves request, validators sample far fewer
+const maxServedLeaves = 10000
+
+func (s *Server) getPoCBatchLeaves(c echo.Context) error {
+	stage, err := strconv.ParseInt(c.Param("stage"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid poc stage")
+	}
+	batchId, err := url.PathUnescape(c.Param("batch_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid batch id")
+	}
+	indexes, err := poc.ParseLeafIndexes(c.QueryParam("indexes"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if len(indexes) > maxServedLeaves {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("too many indexes, max %d", maxServedLeaves))
+	}
+
+	leaves, err := s.pocBatchStore.Leaves(stage, batchId, indexes)
+	if errors.Is(err, poc.ErrBatchNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	if err != nil {
+		logging.Warn("Failed to serve PoC batch leaves", types.PoC, "stage", stage, "batchId", batchId, "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return c.JSON(http.StatusOK, poc.CommittedLeavesResponse{Leaves: leaves})
+}
@@ -3,11 +3,13 @@ package public
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"decentralized-api/apiconfig"
 	"decentralized-api/broker"
 	"decentralized-api/completionapi"
 	"decentralized-api/logging"
 	"decentralized-api/utils"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -77,11 +79,11 @@ func emptyButParseableResponsePayload(inferenceId, model string, promptTokens ui
 	// This must have TopLogprobs != nil AND len(TopLogprobs) > 0 to pass GetEnforcedTokens().
 	choice.Logprobs.Content = []completionapi.Logprob{
 		{
-			Token:   "<EMPTY>",
+			Token:   completionapi.EmptyResponseToken,
 			Logprob: 0,
 			Bytes:   []int{},
 			TopLogprobs: []completionapi.TopLogprobs{
-				{Token: "<EMPTY>", Logprob: 0, Bytes: []int{}},
+				{Token: completionapi.EmptyResponseToken, Logprob: 0, Bytes: []int{}},
 			},
 		},
 	}
@@ -221,14 +223,30 @@ func (s *Server) postChat(ctx echo.Context) error {
 	}
 
 	if chatRequest.InferenceId != "" && chatRequest.Seed != "" {
+		// The transfer agent already resolved any alias, use its model id so both sides agree if the alias moves
+		if modelId := ctx.Request().Header.Get(utils.XModelIdHeader); modelId != "" {
+			chatRequest.OpenAiRequest.Model = modelId
+		}
 		logging.Info("Executor request", types.Inferences, "inferenceId", chatRequest.InferenceId, "seed", chatRequest.Seed)
 		return s.handleExecutorRequest(ctx, chatRequest, ctx.Response().Writer)
 	} else {
+		s.resolveModelAlias(chatRequest)
 		logging.Info("Transfer request", types.Inferences, "requesterAddress", chatRequest.RequesterAddress)
 		return s.handleTransferRequest(ctx, chatRequest)
 	}
 }
 
+// resolveModelAlias replaces a model alias in the request with the governance model id it points to, using the
+// alias table cached from the chain. Unknown names are left as they are and rejected later by the model checks.
+func (s *Server) resolveModelAlias(chatRequest *ChatRequest) {
+	modelId, found := s.configManager.GetModelAliases()[chatRequest.OpenAiRequest.Model]
+	if !found {
+		return
+	}
+	logging.Info("Resolved model alias", types.Inferences, "alias", chatRequest.OpenAiRequest.Model, "model", modelId)
+	chatRequest.OpenAiRequest.Model = modelId
+}
+
 func (s *Server) enforceDeveloperAccessGate(ctx context.Context, requesterAddress string) error {
 	queryClient := s.recorder.NewInferenceQueryClient()
 	paramsResp, err := queryClient.Params(ctx, &types.QueryParamsRequest{})
@@ -366,6 +384,10 @@ func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) e
 	req.Header.Set(utils.XRequesterAddressHeader, request.RequesterAddress)
 	req.Header.Set(utils.XTASignatureHeader, inferenceRequest.TransferSignature)
 	req.Header.Set(utils.XPromptHashHeader, inferenceRequest.PromptHash)
+	req.Header.Set(utils.XModelIdHeader, request.OpenAiRequest.Model)
+	if sessionId := request.Request.Header.Get(utils.XSessionIdHeader); sessionId != "" {
+		req.Header.Set(utils.XSessionIdHeader, sessionId)
+	}
 	req.Header.Set("Content-Type", request.Request.Header.Get("Content-Type"))
 
 	resp, err := http.DefaultClient.Do(req)
@@ -378,7 +400,7 @@ func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) e
 	logging.Info("Proxying response from executor", types.Inferences,
 		"inferenceId", inferenceUUID,
 		"executor", executor.Address)
-	proxyResponse(resp, ctx.Response().Writer, false, nil, inferenceUUID)
+	proxyResponse(ctx.Request().Context(), resp, ctx.Response().Writer, false, nil, inferenceUUID)
 	return nil
 }
 
@@ -425,7 +447,7 @@ func (s *Server) getPromptTokenCount(text string, model string) (int, error) {
 		TokenCount int `json:"count"`
 	}
 
-	response, err := broker.DoWithLockedNodeHTTPRetry(s.nodeBroker, model, nil, 1, func(node *broker.Node) (*http.Response, *broker.ActionError) {
+	response, err := broker.DoWithLockedNodeHTTPRetry(s.nodeBroker, model, "", nil, 1, func(node *broker.Node) (*http.Response, *broker.ActionError) {
 		tokenizeUrl, err := url.JoinPath(node.InferenceUrlWithVersion(s.configManager.GetCurrentNodeVersion()), "/tokenize")
 		if err != nil {
 			return nil, broker.NewApplicationActionError(err)
@@ -468,6 +490,50 @@ func (s *Server) getPromptTokenCount(text string, model string) (int, error) {
 	return result.TokenCount, nil
 }
 
+// nodeAffinityKey identifies the conversation a request belongs to, so its turns go to the same ML node and reuse the
+// prefix cache. An explicit session id wins, otherwise the messages up to the first user message are hashed, as
+// every later turn of a multi-turn chat resends them unchanged.
+func nodeAffinityKey(sessionId string, request *OpenAiRequest) string {
+	hash := sha256.New()
+	hash.Write([]byte(request.Model))
+	hash.Write([]byte{0})
+	if sessionId != "" {
+		hash.Write([]byte("session"))
+		hash.Write([]byte{0})
+		hash.Write([]byte(sessionId))
+		return hex.EncodeToString(hash.Sum(nil))
+	}
+	if len(request.Messages) == 0 {
+		return ""
+	}
+	for _, message := range request.Messages {
+		hash.Write([]byte(message.Role))
+		hash.Write([]byte{0})
+		hash.Write([]byte(message.Content))
+		hash.Write([]byte{0})
+		if message.Role == "user" || message.Role == "" {
+			break
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// promptTokenCountOrDefault tokenizes the prompt of the request, falling back to 1 so a synthetic response still
+// carries a non-empty usage
+func (s *Server) promptTokenCountOrDefault(requestBody []byte, model string) uint64 {
+	promptText, err := s.extractPromptTextFromRequest(requestBody)
+	if err != nil {
+		logging.Warn("Failed to extract prompt text for tokenization", types.Inferences, "error", err)
+		return 1
+	}
+	promptTokens, err := s.getPromptTokenCount(promptText, model)
+	if err != nil || promptTokens <= 0 {
+		logging.Warn("Failed to get prompt token count", types.Inferences, "error", err)
+		return 1
+	}
+	return uint64(promptTokens)
+}
+
 func (s *Server) extractPromptTextFromRequest(requestBytes []byte) (string, error) {
 	var openAiRequest OpenAiRequest
 	err := json.Unmarshal(requestBytes, &openAiRequest)
@@ -495,7 +561,7 @@ func (s *Server) handleExecutorRequest(ctx echo.Context, request *ChatRequest, w
 		return echo.ErrBadRequest
 	}
 
-	modifiedRequestBody, err := completionapi.ModifyRequestBody(request.Body, int32(seed))
+	modifiedRequestBody, err := completionapi.ModifyRequestBody(request.Body, int32(seed), request.OpenAiRequest.Model)
 	if err != nil {
 		logging.Warn("Unable to modify request body", types.Inferences, "error", err)
 		return err
@@ -512,9 +578,10 @@ func (s *Server) handleExecutorRequest(ctx echo.Context, request *ChatRequest, w
 		return echo.NewHTTPError(http.StatusBadRequest, "Prompt hash mismatch")
 	}
 
+	affinityKey := nodeAffinityKey(request.Request.Header.Get(utils.XSessionIdHeader), &request.OpenAiRequest)
 	logging.Info("Attempting to lock node for inference", types.Inferences,
 		"inferenceId", inferenceId, "nodeVersion", s.configManager.GetCurrentNodeVersion())
-	resp, err := broker.DoWithLockedNodeHTTPRetry(s.nodeBroker, request.OpenAiRequest.Model, nil, 3, func(node *broker.Node) (*http.Response, *broker.ActionError) {
+	resp, err := broker.DoWithLockedNodeHTTPRetry(s.nodeBroker, request.OpenAiRequest.Model, affinityKey, nil, 3, func(node *broker.Node) (*http.Response, *broker.ActionError) {
 		logging.Info("Successfully acquired node lock for inference", types.Inferences,
 			"inferenceId", inferenceId, "node", node.Id, "url", node.InferenceUrlWithVersion(s.configManager.GetCurrentNodeVersion()))
 
@@ -556,7 +623,7 @@ func (s *Server) handleExecutorRequest(ctx echo.Context, request *ChatRequest, w
 				logging.Error("Failed to create synthetic response payload", types.Inferences, "inferenceId", inferenceId)
 				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create synthetic response payload")
 			}
-			if txErr := s.sendInferenceTransaction(request.InferenceId, synthetic, request.Body, s.recorder.GetAccountAddress(), request, promptPayload); txErr != nil {
+			if txErr := s.sendInferenceTransaction(request.InferenceId, synthetic, request.Body, s.recorder.GetAccountAddress(), request, promptPayload, inference.InferenceCompletionStatus_COMPLETION_STATUS_COMPLETE); txErr != nil {
 				logging.Error("Failed to record FinishInference after inference node payload error", types.Inferences,
 					"inferenceId", inferenceId, "error", txErr)
 			}
@@ -567,17 +634,29 @@ func (s *Server) handleExecutorRequest(ctx echo.Context, request *ChatRequest, w
 
 	responseProcessor := completionapi.NewExecutorResponseProcessor(request.InferenceId)
 	logging.Debug("Proxying response from inference node", types.Inferences, "inferenceId", request.InferenceId)
-	proxyResponse(resp, w, true, responseProcessor, inferenceId)
+	completionStatus := proxyResponse(ctx.Request().Context(), resp, w, true, responseProcessor, inferenceId).completionStatus()
 
 	logging.Debug("Processing response from inference node", types.Inferences, "inferenceId", request.InferenceId)
 	completionResponse, err := responseProcessor.GetResponse()
 
+	if (err != nil || completionResponse == nil) && completionStatus != inference.InferenceCompletionStatus_COMPLETION_STATUS_COMPLETE {
+		// The stream broke off before any output. Finish with an empty output anyway, so the inference is settled
+		// for the prompt only instead of expiring.
+		logging.Warn("Recording FinishInference with empty response for a truncated stream", types.Inferences,
+			"inferenceId", inferenceId, "completionStatus", completionStatus.String())
+		promptTokens := s.promptTokenCountOrDefault(request.Body, request.OpenAiRequest.Model)
+		completionResponse, err = emptyButParseableResponsePayload(inferenceId, request.OpenAiRequest.Model, promptTokens), nil
+	}
 	if err != nil || completionResponse == nil {
 		logging.Error("Failed to parse response data into CompletionResponse", types.Inferences, "error", err)
 		return err
 	}
+	if completionStatus != inference.InferenceCompletionStatus_COMPLETION_STATUS_COMPLETE {
+		logging.Info("Finishing truncated inference with the tokens produced so far", types.Inferences,
+			"inferenceId", inferenceId, "completionStatus", completionStatus.String())
+	}
 
-	err = s.sendInferenceTransaction(request.InferenceId, completionResponse, request.Body, s.recorder.GetAccountAddress(), request, promptPayload)
+	err = s.sendInferenceTransaction(request.InferenceId, completionResponse, request.Body, s.recorder.GetAccountAddress(), request, promptPayload, completionStatus)
 	if err != nil {
 		// Not http.Error, because we assume we already returned everything to the client during proxyResponse execution
 		logging.Error("Failed to send inference transaction", types.Inferences, "error", err)
@@ -738,7 +817,7 @@ func (s *Server) calculateSignature(payload string, timestamp int64, transferAdd
 	return signature, nil
 }
 
-func (s *Server) sendInferenceTransaction(inferenceId string, response completionapi.CompletionResponse, requestBody []byte, executorAddress string, request *ChatRequest, promptPayload []byte) error {
+func (s *Server) sendInferenceTransaction(inferenceId string, response completionapi.CompletionResponse, requestBody []byte, executorAddress string, request *ChatRequest, promptPayload []byte, completionStatus inference.InferenceCompletionStatus) error {
 	responseHash, err := response.GetHash()
 	if err != nil || responseHash == "" {
 		logging.Error("Failed to get responseHash from response", types.Inferences, "error", err)
@@ -809,6 +888,7 @@ func (s *Server) sendInferenceTransaction(inferenceId string, response completio
 			Model:                model,
 			PromptHash:           promptHash,
 			OriginalPromptHash:   originalPromptHash,
+			CompletionStatus:     completionStatus,
 		}
 
 		// Store payloads before broadcasting transaction
@@ -863,7 +943,7 @@ func getModifiedPromptHash(requestBytes []byte) (string, []byte, error) {
 }
 
 func createInferenceStartRequest(s *Server, request *ChatRequest, seed int32, inferenceId string, executor *ExecutorDestination, nodeVersion string, promptTokenCount int) (*inference.MsgStartInference, error) {
-	modifiedRequest, err := completionapi.ModifyRequestBody(request.Body, seed)
+	modifiedRequest, err := completionapi.ModifyRequestBody(request.Body, seed, request.OpenAiRequest.Model)
 	if err != nil {
 		return nil, err
 	}
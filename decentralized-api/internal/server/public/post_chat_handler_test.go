@@ -164,3 +164,26 @@ func TestEmptyButParseableResponsePayload_EnforcedTokensEmptySlice(t *testing.T)
 	// With our synthetic logprobs, enforced tokens should be present and parseable.
 	require.NotEmpty(t, enforcedTokens.Tokens)
 }
+
+func TestNodeAffinityKey(t *testing.T) {
+	firstTurn := &OpenAiRequest{Model: "m", Messages: []Message{
+		{Role: "system", Content: "You are helpful"},
+		{Role: "user", Content: "Hi"},
+	}}
+	secondTurn := &OpenAiRequest{Model: "m", Messages: []Message{
+		{Role: "system", Content: "You are helpful"},
+		{Role: "user", Content: "Hi"},
+		{Role: "assistant", Content: "Hello"},
+		{Role: "user", Content: "How are you?"},
+	}}
+	otherConversation := &OpenAiRequest{Model: "m", Messages: []Message{
+		{Role: "system", Content: "You are helpful"},
+		{Role: "user", Content: "Bye"},
+	}}
+
+	require.NotEmpty(t, nodeAffinityKey("", firstTurn))
+	require.Equal(t, nodeAffinityKey("", firstTurn), nodeAffinityKey("", secondTurn))
+	require.NotEqual(t, nodeAffinityKey("", firstTurn), nodeAffinityKey("", otherConversation))
+	require.Equal(t, nodeAffinityKey("session", firstTurn), nodeAffinityKey("session", otherConversation))
+	require.Empty(t, nodeAffinityKey("", &OpenAiRequest{Model: "m"}))
+}
@@ -2,9 +2,11 @@ package public
 
 import (
 	"bufio"
+	"context"
 	"decentralized-api/completionapi"
 	"decentralized-api/logging"
 	"fmt"
+	"github.com/productscience/inference/api/inference/inference"
 	"github.com/productscience/inference/x/inference/types"
 	"io"
 	"net"
@@ -12,13 +14,36 @@ import (
 	"strings"
 )
 
+// proxyOutcome tells how proxying a response ended, so a truncated stream can still be finished on-chain
+type proxyOutcome int
+
+const (
+	proxyCompleted proxyOutcome = iota
+	// The client disconnected before the whole response was written
+	proxyClientCancelled
+	// The ML node response broke off or couldn't be processed
+	proxyUpstreamFailed
+)
+
+func (o proxyOutcome) completionStatus() inference.InferenceCompletionStatus {
+	switch o {
+	case proxyClientCancelled:
+		return inference.InferenceCompletionStatus_COMPLETION_STATUS_CLIENT_CANCELLED
+	case proxyUpstreamFailed:
+		return inference.InferenceCompletionStatus_COMPLETION_STATUS_EXECUTOR_FAILED
+	default:
+		return inference.InferenceCompletionStatus_COMPLETION_STATUS_COMPLETE
+	}
+}
+
 func proxyResponse(
+	clientCtx context.Context,
 	resp *http.Response,
 	w http.ResponseWriter,
 	excludeContentLength bool,
 	responseProcessor completionapi.ResponseProcessor,
 	inferenceId string,
-) {
+) proxyOutcome {
 	// Make sure to copy response headers to the client
 	for key, values := range resp.Header {
 		// Skip Content-Length, because we're modifying body
@@ -34,20 +59,31 @@ func proxyResponse(
 	contentType := resp.Header.Get("Content-Type")
 	if strings.HasPrefix(contentType, "text/event-stream") {
 		logging.Debug("Proxying text/event-stream response", types.Inferences, "status_code", resp.StatusCode, "content_type", contentType, "inference_id", inferenceId)
-		proxyTextStreamResponse(resp, w, responseProcessor, inferenceId)
-	} else {
-		logging.Debug("Proxying JSON response", types.Inferences, "status_code", resp.StatusCode, "content_type", contentType, "inference_id", inferenceId)
-		proxyJsonResponse(resp, w, responseProcessor, inferenceId)
+		return proxyTextStreamResponse(clientCtx, resp, w, responseProcessor, inferenceId)
 	}
+	logging.Debug("Proxying JSON response", types.Inferences, "status_code", resp.StatusCode, "content_type", contentType, "inference_id", inferenceId)
+	return proxyJsonResponse(resp, w, responseProcessor, inferenceId)
 }
 
-func proxyTextStreamResponse(resp *http.Response, w http.ResponseWriter, responseProcessor completionapi.ResponseProcessor, inferenceId string) {
+func proxyTextStreamResponse(clientCtx context.Context, resp *http.Response, w http.ResponseWriter, responseProcessor completionapi.ResponseProcessor, inferenceId string) proxyOutcome {
 	w.WriteHeader(resp.StatusCode)
 
 	// Stream the response from the completion server to the client
+	doneReceived := false
 	scanner := bufio.NewScanner(resp.Body)
 	for scanner.Scan() {
 		line := scanner.Text()
+		if strings.HasPrefix(strings.TrimSpace(strings.TrimPrefix(line, completionapi.DataPrefix)), "[DONE]") {
+			doneReceived = true
+		}
+
+		// Writes to a disconnected client can succeed for a while, the request context is cancelled right away.
+		// Stop reading so the ML node stops generating tokens nobody will receive.
+		if clientCtx.Err() != nil {
+			logging.Warn("Client disconnected during streaming", types.Inferences, "inferenceId", inferenceId)
+			resp.Body.Close()
+			return proxyClientCancelled
+		}
 
 		// DEBUG LOG
 		logging.Debug("Chunk", types.Inferences, "inferenceId", inferenceId, "line", line)
@@ -61,7 +97,7 @@ func proxyTextStreamResponse(resp *http.Response, w http.ResponseWriter, respons
 					"inferenceId", inferenceId, "error", err, "line", line,
 				)
 				http.Error(w, err.Error(), http.StatusInternalServerError)
-				return
+				return proxyUpstreamFailed
 			}
 		}
 
@@ -73,26 +109,32 @@ func proxyTextStreamResponse(resp *http.Response, w http.ResponseWriter, respons
 			if opErr, ok := err.(*net.OpError); ok {
 				logging.Warn("Stream cancelled during streaming", types.Inferences, "inferenceId", inferenceId, "error", opErr)
 				resp.Body.Close()
-				return
+				return proxyClientCancelled
 			}
 
 			logging.Error("Error while streaming response", types.Inferences, "inferenceId", inferenceId, "error", err)
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
+			resp.Body.Close()
+			return proxyClientCancelled
 		}
 	}
 
 	if err := scanner.Err(); err != nil {
 		logging.Error("Error after streaming response", types.Inferences, "inferenceId", inferenceId, "error", err)
+		return proxyUpstreamFailed
 	}
+	if !doneReceived {
+		logging.Warn("Stream ended without [DONE]", types.Inferences, "inferenceId", inferenceId)
+		return proxyUpstreamFailed
+	}
+	return proxyCompleted
 }
 
-func proxyJsonResponse(resp *http.Response, w http.ResponseWriter, responseProcessor completionapi.ResponseProcessor, inferenceId string) {
+func proxyJsonResponse(resp *http.Response, w http.ResponseWriter, responseProcessor completionapi.ResponseProcessor, inferenceId string) proxyOutcome {
 	var bodyBytes, err = io.ReadAll(resp.Body)
 	if err != nil {
 		logging.Error("Failed to read inference node response body", types.Inferences, "inferenceId", inferenceId, "error", err)
 		http.Error(w, fmt.Sprintf("Failed to read inference node response body. inferenceId = %s", inferenceId), http.StatusInternalServerError)
-		return
+		return proxyUpstreamFailed
 	}
 
 	if responseProcessor != nil {
@@ -100,10 +142,14 @@ func proxyJsonResponse(resp *http.Response, w http.ResponseWriter, responseProce
 		if err != nil {
 			logging.Error("Failed to process inference node response", types.Inferences, "inferenceId", inferenceId, "error", err)
 			http.Error(w, fmt.Sprintf("Failed to process inference node response. inferenceId = %s", inferenceId), http.StatusInternalServerError)
-			return
+			return proxyUpstreamFailed
 		}
 	}
 
 	w.WriteHeader(resp.StatusCode)
-	w.Write(bodyBytes)
+	if _, err := w.Write(bodyBytes); err != nil {
+		logging.Warn("Client disconnected before the response was written", types.Inferences, "inferenceId", inferenceId, "error", err)
+		return proxyClientCancelled
+	}
+	return proxyCompleted
 }
@@ -0,0 +1,55 @@
+package public
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"decentralized-api/completionapi"
+
+	"github.com/productscience/inference/api/inference/inference"
+	"github.com/stretchr/testify/require"
+)
+
+const streamChunk = `data: {"id":"x","model":"m","choices":[{"index":0,"delta":{"content":"a"},"logprobs":{"content":[{"token":"a","logprob":-0.1,"top_logprobs":[{"token":"a","logprob":-0.1}]}]}}]}`
+
+func streamedResponse(lines ...string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/event-stream"}},
+		Body:       io.NopCloser(strings.NewReader(strings.Join(lines, "\n") + "\n")),
+	}
+}
+
+func TestProxyResponse_CompletedStream(t *testing.T) {
+	processor := completionapi.NewExecutorResponseProcessor("inf")
+	outcome := proxyResponse(context.Background(), streamedResponse(streamChunk, streamChunk, "data: [DONE]"), httptest.NewRecorder(), true, processor, "inf")
+	require.Equal(t, proxyCompleted, outcome)
+	require.Equal(t, inference.InferenceCompletionStatus_COMPLETION_STATUS_COMPLETE, outcome.completionStatus())
+}
+
+func TestProxyResponse_StreamBrokenOff(t *testing.T) {
+	processor := completionapi.NewExecutorResponseProcessor("inf")
+	outcome := proxyResponse(context.Background(), streamedResponse(streamChunk, streamChunk), httptest.NewRecorder(), true, processor, "inf")
+	require.Equal(t, inference.InferenceCompletionStatus_COMPLETION_STATUS_EXECUTOR_FAILED, outcome.completionStatus())
+
+	response, err := processor.GetResponse()
+	require.NoError(t, err)
+	usage, err := response.GetUsage()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), usage.CompletionTokens)
+}
+
+func TestProxyResponse_ClientCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	processor := completionapi.NewExecutorResponseProcessor("inf")
+	outcome := proxyResponse(ctx, streamedResponse(streamChunk, "data: [DONE]"), httptest.NewRecorder(), true, processor, "inf")
+	require.Equal(t, inference.InferenceCompletionStatus_COMPLETION_STATUS_CLIENT_CANCELLED, outcome.completionStatus())
+
+	_, err := processor.GetResponse()
+	require.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"decentralized-api/chainphase"
 	"decentralized-api/cosmosclient"
 	"decentralized-api/internal"
+	"decentralized-api/internal/poc"
 	"decentralized-api/internal/server/middleware"
 	"decentralized-api/payloadstorage"
 	"decentralized-api/training"
@@ -24,6 +25,7 @@ type Server struct {
 	bandwidthLimiter    *internal.BandwidthLimiter
 	identityCache       *identityCache
 	payloadStorage      payloadstorage.PayloadStorage
+	pocBatchStore       *poc.BatchStore
 	phaseTracker        *chainphase.ChainPhaseTracker
 	epochGroupDataCache *internal.EpochGroupDataCache
 }
@@ -36,7 +38,8 @@ func NewServer(
 	trainingExecutor *training.Executor,
 	blockQueue *BridgeQueue,
 	phaseTracker *chainphase.ChainPhaseTracker,
-	payloadStorage payloadstorage.PayloadStorage) *Server {
+	payloadStorage payloadstorage.PayloadStorage,
+	pocBatchStore *poc.BatchStore) *Server {
 	e := echo.New()
 	e.HTTPErrorHandler = middleware.TransparentErrorHandler
 
@@ -52,6 +55,7 @@ func NewServer(
 		blockQueue:          blockQueue,
 		identityCache:       newIdentityCache(),
 		payloadStorage:      payloadStorage,
+		pocBatchStore:       pocBatchStore,
 		phaseTracker:        phaseTracker,
 		epochGroupDataCache: internal.NewEpochGroupDataCache(recorder),
 	}
@@ -69,6 +73,7 @@ func NewServer(
 	g.GET("inference/payloads", s.getInferencePayloads)
 
 	g.GET("participants/:address", s.getInferenceParticipantByAddress)
+	g.GET("participants/:address/status-explanation", s.getParticipantStatusExplanation)
 	g.GET("participants", s.getAllParticipants)
 	g.POST("participants", s.submitNewParticipantHandler)
 
@@ -85,6 +90,7 @@ func NewServer(
 	g.GET("governance/pricing", s.getGovernancePricing)
 	g.GET("governance/models", s.getGovernanceModels)
 	g.GET("poc-batches/:epoch", s.getPoCBatches)
+	g.GET("poc-batches/:stage/:batch_id/leaves", s.getPoCBatchLeaves)
 
 	g.GET("debug/pubkey-to-addr/:pubkey", s.debugPubKeyToAddr)
 	g.GET("debug/verify/:height", s.debugVerify)
@@ -102,6 +108,7 @@ func NewServer(
 	blsGroup.GET("epoch/:id", s.getBLSEpochByID)
 	blsGroup.GET("epochs/:id", s.getBLSEpochByID)
 	blsGroup.GET("signatures/:request_id", s.getBLSSignatureByRequestID)
+	blsGroup.GET("key-chain-proof/:trusted_epoch", s.getBLSKeyChainProof)
 
 	// Restrictions public API (query-only)
 	g.GET("restrictions/status", s.getRestrictionsStatus)
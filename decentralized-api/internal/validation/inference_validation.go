@@ -18,7 +18,9 @@ import (
 	"math/rand"
 	"net/http"
 	"net/url"
+	"slices"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -40,6 +42,7 @@ type InferenceValidator struct {
 	nodeBroker    *broker.Broker
 	configManager *apiconfig.ConfigManager
 	phaseTracker  *chainphase.ChainPhaseTracker
+	fetcher       *payloadFetcher
 }
 
 func NewInferenceValidator(
@@ -47,12 +50,31 @@ func NewInferenceValidator(
 	configManager *apiconfig.ConfigManager,
 	recorder cosmosclient.CosmosMessageClient,
 	phaseTracker *chainphase.ChainPhaseTracker) *InferenceValidator {
-	return &InferenceValidator{
+	validator := &InferenceValidator{
 		nodeBroker:    nodeBroker,
 		configManager: configManager,
 		recorder:      recorder,
 		phaseTracker:  phaseTracker,
 	}
+	validator.fetcher = newPayloadFetcher(func(ctx context.Context, inf types.Inference) ([]byte, []byte, error) {
+		return RetrievePayloadsFromExecutor(ctx, inf.InferenceId, inf.ExecutedBy, inf.EpochId, recorder)
+	}, validator.isEpochStale)
+	return validator
+}
+
+// PayloadFetchStats reports payload fetch outcomes, including the failures that led to unavailability invalidations
+func (s *InferenceValidator) PayloadFetchStats() PayloadFetchStats {
+	if s.fetcher == nil {
+		return PayloadFetchStats{}
+	}
+	return s.fetcher.Stats()
+}
+
+func (s *InferenceValidator) fetchPayloads(ctx context.Context, inf types.Inference) ([]byte, []byte, error) {
+	if s.fetcher == nil {
+		return RetrievePayloadsFromExecutor(ctx, inf.InferenceId, inf.ExecutedBy, inf.EpochId, s.recorder)
+	}
+	return s.fetcher.Fetch(ctx, inf)
 }
 
 func (s *InferenceValidator) VerifyInvalidation(events map[string][]string, recorder cosmosclient.InferenceCosmosClient) {
@@ -81,6 +103,66 @@ func (s *InferenceValidator) VerifyInvalidation(events map[string][]string, reco
 
 }
 
+// ValidateAppeal re-executes appealed inferences this node was sampled for and votes on the appeal.
+// Payloads the executor can no longer serve count as a failed re-execution.
+func (s *InferenceValidator) ValidateAppeal(events map[string][]string, recorder cosmosclient.InferenceCosmosClient) {
+	inferenceIds := events["inference_appeal_opened.inference_id"]
+	validatorLists := events["inference_appeal_opened.validators"]
+	address := recorder.GetAccountAddress()
+
+	for i, inferenceId := range inferenceIds {
+		if i >= len(validatorLists) || !slices.Contains(strings.Split(validatorLists[i], ","), address) {
+			continue
+		}
+
+		r, err := recorder.NewInferenceQueryClient().Inference(recorder.GetContext(), &types.QueryGetInferenceRequest{Index: inferenceId})
+		if err != nil {
+			logging.Warn("Failed to query Inference for appeal.", types.Validation, "inferenceId", inferenceId, "error", err)
+			continue
+		}
+
+		logging.Info("Re-executing appealed inference", types.Validation, "inferenceId", inferenceId)
+		go s.validateAppealAndSendVote(r.Inference, recorder)
+	}
+}
+
+func (s *InferenceValidator) validateAppealAndSendVote(inf types.Inference, recorder cosmosclient.InferenceCosmosClient) {
+	value := 0.0
+	promptPayload, responsePayload, err := s.retrievePayloadsWithRetry(inf)
+	switch {
+	case errors.Is(err, ErrPayloadUnavailable) || errors.Is(err, ErrHashMismatch):
+		logging.Warn("Appealed inference payloads unusable, voting to uphold", types.Validation, "inferenceId", inf.InferenceId, "error", err)
+	case err != nil:
+		logging.Error("Failed to retrieve payloads for appeal", types.Validation, "inferenceId", inf.InferenceId, "error", err)
+		return
+	default:
+		valResult, err := broker.LockNode(s.nodeBroker, inf.Model, func(node *broker.Node) (ValidationResult, error) {
+			return s.validateWithPayloads(inf, node, promptPayload, responsePayload)
+		})
+		if err != nil {
+			logging.Error("Failed to re-execute appealed inference", types.Validation, "inferenceId", inf.InferenceId, "error", err)
+			return
+		}
+		msgValidation, err := ToMsgValidation(valResult)
+		if err != nil {
+			logging.Error("Failed to convert appeal result.", types.Validation, "inferenceId", inf.InferenceId, "error", err)
+			return
+		}
+		value = msgValidation.Value
+	}
+
+	vote := &inference.MsgSubmitAppealVote{
+		Creator:     recorder.GetAccountAddress(),
+		InferenceId: inf.InferenceId,
+		Value:       value,
+	}
+	if _, err := recorder.SendTransactionAsyncWithRetry(vote); err != nil {
+		logging.Error("Failed to submit appeal vote.", types.Validation, "inferenceId", inf.InferenceId, "error", err)
+		return
+	}
+	logging.Info("Submitted appeal vote", types.Validation, "inferenceId", inf.InferenceId, "value", value)
+}
+
 // shouldValidateInference determines if the current participant should validate a specific inference
 // This function extracts the core validation decision logic for reuse in recovery scenarios
 func (s *InferenceValidator) shouldValidateInference(
@@ -529,6 +611,7 @@ func (s *InferenceValidator) SampleInferenceToValidate(ids []string, transaction
 		}
 	}
 
+	// Validations run concurrently, the payload fetcher orders their fetches so the oldest epochs go first
 	logInferencesToValidate(toValidateIds)
 	for _, inf := range toValidateIds {
 		go func() {
@@ -574,6 +657,9 @@ func (s *InferenceValidator) validateInferenceAndSendValMessage(inf types.Infere
 	if err != nil {
 		if errors.Is(err, ErrPayloadUnavailable) {
 			// Post-upgrade inference: executor unavailable after 20 min of retries
+			if s.fetcher != nil {
+				s.fetcher.recordUnavailable(inf.ExecutedBy)
+			}
 			s.checkAndInvalidateUnavailable(inf, transactionRecorder, revalidation)
 			return
 		}
@@ -723,8 +809,7 @@ func (s *InferenceValidator) retrievePayloadsWithRetry(inf types.Inference) ([]b
 			return nil, nil, ErrEpochStale
 		}
 
-		promptPayload, responsePayload, err := RetrievePayloadsFromExecutor(
-			ctx, inf.InferenceId, inf.ExecutedBy, inf.EpochId, s.recorder)
+		promptPayload, responsePayload, err := s.fetchPayloads(ctx, inf)
 
 		if err == nil {
 			logging.Debug("Successfully retrieved payloads from executor", types.Validation,
@@ -738,6 +823,12 @@ func (s *InferenceValidator) retrievePayloadsWithRetry(inf types.Inference) ([]b
 				"inferenceId", inf.InferenceId, "attempt", attempt)
 			return nil, nil, ErrHashMismatch
 		}
+		// The epoch went stale while the fetch was queued behind more urgent ones
+		if errors.Is(err, ErrEpochStale) {
+			logging.Info("Epoch stale, stopping payload retrieval", types.Validation,
+				"inferenceId", inf.InferenceId, "inferenceEpoch", inf.EpochId)
+			return nil, nil, ErrEpochStale
+		}
 
 		lastErr = err
 		logging.Warn("Payload retrieval failed, will retry", types.Validation,
@@ -862,6 +953,21 @@ func (s *InferenceValidator) validateWithPayloads(inference types.Inference, inf
 		return nil, errors.New("Inference is not finished. id = " + inference.InferenceId)
 	}
 
+	truncated := inference.CompletionStatus != types.InferenceCompletionStatus_COMPLETION_STATUS_COMPLETE
+	if inference.ProducedNoOutput() && producedNoTokens(responsePayload) {
+		// The stream broke off before the first token and the executor recorded an empty output,
+		// there is nothing to re-execute. The chain gives no work or reputation credit for it.
+		logging.Info("Truncated inference has no output, treating validation as passed", types.Validation,
+			"inferenceId", inference.InferenceId, "completionStatus", inference.CompletionStatus.String())
+		return &SimilarityValidationResult{
+			BaseValidationResult: BaseValidationResult{
+				InferenceId:   inference.InferenceId,
+				ResponseBytes: []byte{},
+			},
+			Value: 1.0,
+		}, nil
+	}
+
 	var requestMap map[string]interface{}
 	if err := json.Unmarshal(promptPayload, &requestMap); err != nil {
 		return &InvalidInferenceResult{inference.InferenceId, "Failed to unmarshal promptPayload.", err}, nil
@@ -882,6 +988,10 @@ func (s *InferenceValidator) validateWithPayloads(inference types.Inference, inf
 	requestMap["stream"] = false
 	requestMap["skip_special_tokens"] = false
 	delete(requestMap, "stream_options")
+	if truncated {
+		// The client cancelled or the executor failed mid-stream, so only the produced prefix is validated
+		limitMaxTokens(requestMap, len(enforcedTokens.Tokens))
+	}
 
 	requestBody, err := json.Marshal(requestMap)
 	if err != nil {
@@ -947,6 +1057,50 @@ func (s *InferenceValidator) validateWithPayloads(inference types.Inference, inf
 	return compareLogits(originalLogits, validationLogits, baseResult), nil
 }
 
+// producedNoTokens reports whether a stored response payload carries no generated tokens: it is either empty or
+// only has the synthetic EmptyResponseToken logprob. A claimed empty output is only trusted when this holds.
+func producedNoTokens(responsePayload []byte) bool {
+	if len(bytes.TrimSpace(responsePayload)) == 0 {
+		return true
+	}
+	response, err := completionapi.NewCompletionResponseFromLinesFromResponsePayload(responsePayload)
+	if err != nil {
+		return false
+	}
+	var choices []completionapi.Choice
+	switch r := response.(type) {
+	case *completionapi.JsonCompletionResponse:
+		choices = r.Resp.Choices
+	case *completionapi.StreamedCompletionResponse:
+		for _, data := range r.Resp.Data {
+			choices = append(choices, data.Choices...)
+		}
+	default:
+		return false
+	}
+	for _, choice := range choices {
+		if choice.Message != nil && choice.Message.Content != "" {
+			return false
+		}
+		if choice.Delta != nil && choice.Delta.Content != nil && *choice.Delta.Content != "" {
+			return false
+		}
+		for _, logprob := range choice.Logprobs.Content {
+			if logprob.Token != completionapi.EmptyResponseToken {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func limitMaxTokens(requestMap map[string]interface{}, maxTokens int) {
+	requestMap["max_tokens"] = maxTokens
+	if _, ok := requestMap["max_completion_tokens"]; ok {
+		requestMap["max_completion_tokens"] = maxTokens
+	}
+}
+
 func unmarshalResponse(inference *types.Inference) (completionapi.CompletionResponse, error) {
 	return unmarshalResponsePayload([]byte(inference.ResponsePayload))
 }
@@ -0,0 +1,302 @@
+package validation
+
+import (
+	"container/heap"
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// ErrExecutorRateLimited indicates the executor answered the payload request with 429 or 503
+var ErrExecutorRateLimited = errors.New("executor rate limited payload retrieval")
+
+const (
+	maxConcurrentFetchesPerExecutor = 4
+	minExecutorBackoff              = 5 * time.Second
+	maxExecutorBackoff              = 2 * time.Minute
+	payloadCacheMaxBytes            = 64 << 20
+)
+
+type fetchPayloadsFunc func(ctx context.Context, inf types.Inference) ([]byte, []byte, error)
+
+// payloadFetcher queues payload retrievals per executor so a burst of validations doesn't hit an executor all at
+// once. Each executor serves at most maxConcurrentFetchesPerExecutor fetches, queued fetches go in order of their
+// validation deadline (the oldest epoch first), and an executor that rate limits is backed off exponentially.
+// Retrieved payloads are cached, so revalidations and appeals don't fetch them again.
+type payloadFetcher struct {
+	fetch          fetchPayloadsFunc
+	isStale        func(epochId uint64) bool
+	maxPerExecutor int
+	now            func() time.Time
+
+	mu        sync.Mutex
+	executors map[string]*executorFetchQueue
+	sequence  uint64
+	cache     *payloadCache
+	stats     PayloadFetchStats
+}
+
+// PayloadFetchStats counts payload fetch outcomes since startup
+type PayloadFetchStats struct {
+	Fetched      uint64 `json:"fetched"`
+	CacheHits    uint64 `json:"cache_hits"`
+	Failures     uint64 `json:"failures"`
+	RateLimited  uint64 `json:"rate_limited"`
+	HashMismatch uint64 `json:"hash_mismatch"`
+	Stale        uint64 `json:"stale"`
+	// Unavailable counts the retrievals that ran out of retries and led to an unavailability invalidation
+	Unavailable           uint64            `json:"unavailable"`
+	UnavailableByExecutor map[string]uint64 `json:"unavailable_by_executor"`
+	Queued                int               `json:"queued"`
+	InFlight              int               `json:"in_flight"`
+}
+
+type executorFetchQueue struct {
+	active       int
+	waiting      fetchWaitQueue
+	backoff      time.Duration
+	backoffUntil time.Time
+	// wake is closed and replaced whenever a slot frees up or the queue order changes
+	wake chan struct{}
+}
+
+type fetchWaiter struct {
+	epochId  uint64
+	sequence uint64
+	index    int
+}
+
+// fetchWaitQueue is a heap ordered by epoch, then by arrival
+type fetchWaitQueue []*fetchWaiter
+
+func (q fetchWaitQueue) Len() int { return len(q) }
+func (q fetchWaitQueue) Less(i, j int) bool {
+	if q[i].epochId != q[j].epochId {
+		return q[i].epochId < q[j].epochId
+	}
+	return q[i].sequence < q[j].sequence
+}
+func (q fetchWaitQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+func (q *fetchWaitQueue) Push(x any) {
+	waiter := x.(*fetchWaiter)
+	waiter.index = len(*q)
+	*q = append(*q, waiter)
+}
+func (q *fetchWaitQueue) Pop() any {
+	old := *q
+	n := len(old)
+	waiter := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return waiter
+}
+
+func newPayloadFetcher(fetch fetchPayloadsFunc, isStale func(epochId uint64) bool) *payloadFetcher {
+	return &payloadFetcher{
+		fetch:          fetch,
+		isStale:        isStale,
+		maxPerExecutor: maxConcurrentFetchesPerExecutor,
+		now:            time.Now,
+		executors:      make(map[string]*executorFetchQueue),
+		cache:          newPayloadCache(payloadCacheMaxBytes),
+		stats:          PayloadFetchStats{UnavailableByExecutor: make(map[string]uint64)},
+	}
+}
+
+// Fetch makes a single retrieval attempt once the executor has a free slot. It returns ErrEpochStale if the
+// inference became too old to validate while queued.
+func (f *payloadFetcher) Fetch(ctx context.Context, inf types.Inference) ([]byte, []byte, error) {
+	if promptPayload, responsePayload, found := f.cachedPayloads(inf.InferenceId); found {
+		return promptPayload, responsePayload, nil
+	}
+	if err := f.acquire(ctx, inf); err != nil {
+		return nil, nil, err
+	}
+	promptPayload, responsePayload, err := f.fetch(ctx, inf)
+	f.release(inf, promptPayload, responsePayload, err)
+	return promptPayload, responsePayload, err
+}
+
+func (f *payloadFetcher) cachedPayloads(inferenceId string) ([]byte, []byte, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	promptPayload, responsePayload, found := f.cache.get(inferenceId)
+	if found {
+		f.stats.CacheHits++
+	}
+	return promptPayload, responsePayload, found
+}
+
+func (f *payloadFetcher) acquire(ctx context.Context, inf types.Inference) error {
+	f.mu.Lock()
+	queue := f.executorQueue(inf.ExecutedBy)
+	f.sequence++
+	waiter := &fetchWaiter{epochId: inf.EpochId, sequence: f.sequence}
+	heap.Push(&queue.waiting, waiter)
+	queue.notify()
+
+	for {
+		if f.isStale != nil && f.isStale(inf.EpochId) {
+			f.leave(queue, waiter)
+			f.stats.Stale++
+			f.mu.Unlock()
+			return ErrEpochStale
+		}
+		backoff := queue.backoffUntil.Sub(f.now())
+		if backoff <= 0 && queue.active < f.maxPerExecutor && queue.waiting[0] == waiter {
+			heap.Remove(&queue.waiting, waiter.index)
+			queue.active++
+			queue.notify()
+			f.mu.Unlock()
+			return nil
+		}
+		wake := queue.wake
+		f.mu.Unlock()
+
+		var timer <-chan time.Time
+		if backoff > 0 {
+			timer = time.After(backoff)
+		}
+		select {
+		case <-wake:
+		case <-timer:
+		case <-ctx.Done():
+			f.mu.Lock()
+			f.leave(queue, waiter)
+			f.mu.Unlock()
+			return ctx.Err()
+		}
+		f.mu.Lock()
+	}
+}
+
+func (f *payloadFetcher) leave(queue *executorFetchQueue, waiter *fetchWaiter) {
+	heap.Remove(&queue.waiting, waiter.index)
+	queue.notify()
+}
+
+func (f *payloadFetcher) release(inf types.Inference, promptPayload, responsePayload []byte, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	queue := f.executorQueue(inf.ExecutedBy)
+	queue.active--
+
+	switch {
+	case err == nil:
+		f.stats.Fetched++
+		f.cache.put(inf.InferenceId, promptPayload, responsePayload)
+		queue.backoff = 0
+	case errors.Is(err, ErrExecutorRateLimited):
+		f.stats.Failures++
+		f.stats.RateLimited++
+		queue.backoff = min(max(queue.backoff*2, minExecutorBackoff), maxExecutorBackoff)
+		queue.backoffUntil = f.now().Add(queue.backoff)
+	case errors.Is(err, ErrHashMismatch):
+		f.stats.HashMismatch++
+	default:
+		f.stats.Failures++
+	}
+	queue.notify()
+}
+
+// recordUnavailable counts a retrieval that ran out of retries and is about to be invalidated as unavailable
+func (f *payloadFetcher) recordUnavailable(executorAddress string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.stats.Unavailable++
+	f.stats.UnavailableByExecutor[executorAddress]++
+}
+
+func (f *payloadFetcher) Stats() PayloadFetchStats {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	stats := f.stats
+	stats.UnavailableByExecutor = make(map[string]uint64, len(f.stats.UnavailableByExecutor))
+	for address, count := range f.stats.UnavailableByExecutor {
+		stats.UnavailableByExecutor[address] = count
+	}
+	for _, queue := range f.executors {
+		stats.Queued += len(queue.waiting)
+		stats.InFlight += queue.active
+	}
+	return stats
+}
+
+func (f *payloadFetcher) executorQueue(executorAddress string) *executorFetchQueue {
+	queue, found := f.executors[executorAddress]
+	if !found {
+		queue = &executorFetchQueue{wake: make(chan struct{})}
+		f.executors[executorAddress] = queue
+	}
+	return queue
+}
+
+func (q *executorFetchQueue) notify() {
+	close(q.wake)
+	q.wake = make(chan struct{})
+}
+
+// payloadCache keeps recently retrieved payloads up to a total size, evicting the least recently used
+type payloadCache struct {
+	maxBytes int
+	bytes    int
+	order    *list.List
+	entries  map[string]*list.Element
+}
+
+type payloadCacheEntry struct {
+	inferenceId     string
+	promptPayload   []byte
+	responsePayload []byte
+}
+
+func newPayloadCache(maxBytes int) *payloadCache {
+	return &payloadCache{
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+func (c *payloadCache) get(inferenceId string) ([]byte, []byte, bool) {
+	element, found := c.entries[inferenceId]
+	if !found {
+		return nil, nil, false
+	}
+	c.order.MoveToFront(element)
+	entry := element.Value.(*payloadCacheEntry)
+	return entry.promptPayload, entry.responsePayload, true
+}
+
+func (c *payloadCache) put(inferenceId string, promptPayload, responsePayload []byte) {
+	size := len(promptPayload) + len(responsePayload)
+	if size > c.maxBytes {
+		return
+	}
+	if element, found := c.entries[inferenceId]; found {
+		c.remove(element)
+	}
+	for c.bytes+size > c.maxBytes {
+		c.remove(c.order.Back())
+	}
+	c.entries[inferenceId] = c.order.PushFront(&payloadCacheEntry{
+		inferenceId:     inferenceId,
+		promptPayload:   promptPayload,
+		responsePayload: responsePayload,
+	})
+	c.bytes += size
+}
+
+func (c *payloadCache) remove(element *list.Element) {
+	entry := c.order.Remove(element).(*payloadCacheEntry)
+	delete(c.entries, entry.inferenceId)
+	c.bytes -= len(entry.promptPayload) + len(entry.responsePayload)
+}
@@ -0,0 +1,211 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testInference(id, executor string, epochId uint64) types.Inference {
+	return types.Inference{InferenceId: id, ExecutedBy: executor, EpochId: epochId}
+}
+
+func waitForQueued(t *testing.T, f *payloadFetcher, queued int) {
+	require.Eventually(t, func() bool {
+		return f.Stats().Queued == queued
+	}, time.Second, time.Millisecond)
+}
+
+func TestPayloadFetcher_LimitsConcurrencyPerExecutor(t *testing.T) {
+	release := make(chan struct{})
+	var mu sync.Mutex
+	active := map[string]int{}
+	maxActive := map[string]int{}
+	f := newPayloadFetcher(func(ctx context.Context, inf types.Inference) ([]byte, []byte, error) {
+		mu.Lock()
+		active[inf.ExecutedBy]++
+		maxActive[inf.ExecutedBy] = max(maxActive[inf.ExecutedBy], active[inf.ExecutedBy])
+		mu.Unlock()
+		<-release
+		mu.Lock()
+		active[inf.ExecutedBy]--
+		mu.Unlock()
+		return []byte("prompt"), []byte("response"), nil
+	}, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := f.Fetch(context.Background(), testInference(fmt.Sprintf("a-%d", i), "executor-a", 1))
+			require.NoError(t, err)
+		}()
+	}
+	waitForQueued(t, f, 10-maxConcurrentFetchesPerExecutor)
+
+	// A busy executor doesn't hold up fetches from another one
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _, err := f.Fetch(context.Background(), testInference("b-1", "executor-b", 1))
+		require.NoError(t, err)
+	}()
+	require.Eventually(t, func() bool { return f.Stats().InFlight == maxConcurrentFetchesPerExecutor+1 }, time.Second, time.Millisecond)
+
+	close(release)
+	wg.Wait()
+	<-done
+	require.Equal(t, maxConcurrentFetchesPerExecutor, maxActive["executor-a"])
+	require.Equal(t, uint64(11), f.Stats().Fetched)
+}
+
+func TestPayloadFetcher_ServesOldestEpochFirst(t *testing.T) {
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var order []string
+	f := newPayloadFetcher(func(ctx context.Context, inf types.Inference) ([]byte, []byte, error) {
+		mu.Lock()
+		order = append(order, inf.InferenceId)
+		mu.Unlock()
+		if inf.InferenceId == "blocker" {
+			<-release
+		}
+		return nil, nil, nil
+	}, nil)
+	f.maxPerExecutor = 1
+
+	var wg sync.WaitGroup
+	fetch := func(inf types.Inference) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := f.Fetch(context.Background(), inf)
+			require.NoError(t, err)
+		}()
+	}
+	fetch(testInference("blocker", "executor", 1))
+	require.Eventually(t, func() bool { return f.Stats().InFlight == 1 }, time.Second, time.Millisecond)
+	fetch(testInference("epoch-5", "executor", 5))
+	waitForQueued(t, f, 1)
+	fetch(testInference("epoch-3-first", "executor", 3))
+	waitForQueued(t, f, 2)
+	fetch(testInference("epoch-3-second", "executor", 3))
+	waitForQueued(t, f, 3)
+	fetch(testInference("epoch-4", "executor", 4))
+	waitForQueued(t, f, 4)
+
+	close(release)
+	wg.Wait()
+	require.Equal(t, []string{"blocker", "epoch-3-first", "epoch-3-second", "epoch-4", "epoch-5"}, order)
+}
+
+func TestPayloadFetcher_CachesPayloads(t *testing.T) {
+	calls := 0
+	f := newPayloadFetcher(func(ctx context.Context, inf types.Inference) ([]byte, []byte, error) {
+		calls++
+		if calls == 1 {
+			return nil, nil, fmt.Errorf("connection refused")
+		}
+		return []byte("prompt"), []byte("response"), nil
+	}, nil)
+	inf := testInference("inference", "executor", 1)
+
+	_, _, err := f.Fetch(context.Background(), inf)
+	require.Error(t, err)
+	for i := 0; i < 2; i++ {
+		prompt, response, err := f.Fetch(context.Background(), inf)
+		require.NoError(t, err)
+		require.Equal(t, "prompt", string(prompt))
+		require.Equal(t, "response", string(response))
+	}
+	require.Equal(t, 2, calls)
+
+	stats := f.Stats()
+	require.Equal(t, uint64(1), stats.Failures)
+	require.Equal(t, uint64(1), stats.Fetched)
+	require.Equal(t, uint64(1), stats.CacheHits)
+}
+
+func TestPayloadFetcher_BacksOffRateLimitedExecutor(t *testing.T) {
+	now := time.Unix(1000, 0)
+	rateLimited := true
+	f := newPayloadFetcher(func(ctx context.Context, inf types.Inference) ([]byte, []byte, error) {
+		if rateLimited {
+			return nil, nil, fmt.Errorf("%w: status 429", ErrExecutorRateLimited)
+		}
+		return []byte("prompt"), []byte("response"), nil
+	}, nil)
+	f.now = func() time.Time { return now }
+
+	_, _, err := f.Fetch(context.Background(), testInference("first", "executor", 1))
+	require.ErrorIs(t, err, ErrExecutorRateLimited)
+	require.Equal(t, now.Add(minExecutorBackoff), f.executors["executor"].backoffUntil)
+
+	// Other executors are unaffected by the backoff
+	rateLimited = false
+	_, _, err = f.Fetch(context.Background(), testInference("other", "other-executor", 1))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, _, err = f.Fetch(ctx, testInference("second", "executor", 1))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 0, f.Stats().Queued)
+
+	// Repeated rate limiting doubles the backoff
+	now = now.Add(minExecutorBackoff)
+	rateLimited = true
+	_, _, err = f.Fetch(context.Background(), testInference("second", "executor", 1))
+	require.ErrorIs(t, err, ErrExecutorRateLimited)
+	require.Equal(t, now.Add(2*minExecutorBackoff), f.executors["executor"].backoffUntil)
+
+	// A success resets it
+	now = now.Add(2 * minExecutorBackoff)
+	rateLimited = false
+	_, _, err = f.Fetch(context.Background(), testInference("second", "executor", 1))
+	require.NoError(t, err)
+	require.Zero(t, f.executors["executor"].backoff)
+	require.Equal(t, uint64(2), f.Stats().RateLimited)
+}
+
+func TestPayloadFetcher_AbortsStaleFetches(t *testing.T) {
+	f := newPayloadFetcher(func(ctx context.Context, inf types.Inference) ([]byte, []byte, error) {
+		t.Fatal("stale inference should not be fetched")
+		return nil, nil, nil
+	}, func(epochId uint64) bool { return epochId < 3 })
+
+	_, _, err := f.Fetch(context.Background(), testInference("inference", "executor", 1))
+	require.ErrorIs(t, err, ErrEpochStale)
+
+	f.recordUnavailable("executor")
+	stats := f.Stats()
+	require.Equal(t, uint64(1), stats.Stale)
+	require.Equal(t, 0, stats.Queued)
+	require.Equal(t, uint64(1), stats.Unavailable)
+	require.Equal(t, map[string]uint64{"executor": 1}, stats.UnavailableByExecutor)
+}
+
+func TestPayloadCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := newPayloadCache(10)
+	c.put("a", []byte("aa"), []byte("aa"))
+	c.put("b", []byte("bb"), []byte("bb"))
+	_, _, found := c.get("a")
+	require.True(t, found)
+
+	c.put("c", []byte("cc"), []byte("cc"))
+	_, _, found = c.get("b")
+	require.False(t, found)
+	_, _, found = c.get("a")
+	require.True(t, found)
+	require.Equal(t, 8, c.bytes)
+
+	c.put("too-large", make([]byte, 8), make([]byte, 8))
+	_, _, found = c.get("too-large")
+	require.False(t, found)
+}
@@ -103,6 +103,9 @@ func RetrievePayloadsFromExecutor(
 	if resp.StatusCode == http.StatusNotFound {
 		return nil, nil, fmt.Errorf("payload not found on executor")
 	}
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		return nil, nil, fmt.Errorf("%w: status %d", ErrExecutorRateLimited, resp.StatusCode)
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return nil, nil, fmt.Errorf("executor returned status %d: %s", resp.StatusCode, string(body))
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+
+	"github.com/productscience/inference/x/inference/types"
 )
 
 const (
@@ -66,3 +68,68 @@ func TestValidationQuant(t *testing.T) {
 	val := compareLogits(inferenceResponse.Choices[0].Logprobs.Content, validationResponse.Choices[0].Logprobs.Content, baseResult)
 	t.Logf("Validation result: %v", val)
 }
+
+func TestValidateWithPayloads_TruncatedWithoutOutputPasses(t *testing.T) {
+	validator := &InferenceValidator{}
+	inf := types.Inference{
+		InferenceId:      "truncated",
+		Status:           types.InferenceStatus_FINISHED,
+		CompletionStatus: types.InferenceCompletionStatus_COMPLETION_STATUS_CLIENT_CANCELLED,
+	}
+
+	result, err := validator.validateWithPayloads(inf, nil, []byte(`{}`), []byte(`{}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsSuccessful() {
+		t.Fatalf("Expected truncated inference without output to pass validation")
+	}
+}
+
+func TestProducedNoTokens(t *testing.T) {
+	empty := `{"id":"i","choices":[{"index":0,"message":{"role":"assistant","content":""},"logprobs":{"content":[{"token":"<EMPTY>","logprob":0,"bytes":[],"top_logprobs":[{"token":"<EMPTY>","logprob":0,"bytes":[]}]}]},"finish_reason":"error"}],"usage":{"prompt_tokens":3}}`
+	withContent := `{"id":"i","choices":[{"index":0,"message":{"role":"assistant","content":"Hello"},"logprobs":{"content":[{"token":"Hello","logprob":-0.1,"top_logprobs":[{"token":"Hello","logprob":-0.1}]}]}}]}`
+	streamedRoleOnly := `{"events":["data: {\"id\":\"i\",\"choices\":[{\"index\":0,\"delta\":{\"role\":\"assistant\"}}]}"]}`
+	streamedToken := `{"events":["data: {\"id\":\"i\",\"choices\":[{\"index\":0,\"delta\":{\"content\":\"Hi\"},\"logprobs\":{\"content\":[{\"token\":\"Hi\",\"logprob\":-0.2}]}}]}"]}`
+
+	cases := map[string]bool{
+		"":               true,
+		empty:            true,
+		streamedRoleOnly: true,
+		withContent:      false,
+		streamedToken:    false,
+		"not json":       false,
+	}
+	for payload, expected := range cases {
+		if got := producedNoTokens([]byte(payload)); got != expected {
+			t.Errorf("producedNoTokens(%q) = %v, expected %v", payload, got, expected)
+		}
+	}
+}
+
+func TestValidateWithPayloads_TruncatedClaimWithTokensIsValidated(t *testing.T) {
+	validator := &InferenceValidator{}
+	inf := types.Inference{
+		InferenceId:      "truncated",
+		Status:           types.InferenceStatus_FINISHED,
+		CompletionStatus: types.InferenceCompletionStatus_COMPLETION_STATUS_CLIENT_CANCELLED,
+	}
+
+	// Without a usable prompt the normal validation path reports the inference as invalid instead of passing it
+	response := `{"id":"i","choices":[{"index":0,"message":{"role":"assistant","content":"Hello"}}]}`
+	result, err := validator.validateWithPayloads(inf, nil, []byte(`not json`), []byte(response))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsSuccessful() {
+		t.Fatalf("Expected a truncated inference with produced tokens to go through validation")
+	}
+}
+
+func TestLimitMaxTokens(t *testing.T) {
+	requestMap := map[string]interface{}{"max_completion_tokens": 500}
+	limitMaxTokens(requestMap, 12)
+	if requestMap["max_tokens"] != 12 || requestMap["max_completion_tokens"] != 12 {
+		t.Fatalf("Expected max tokens to be limited to the produced tokens, got %v", requestMap)
+	}
+}
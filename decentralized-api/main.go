@@ -5,8 +5,10 @@ import (
 	"decentralized-api/apiconfig"
 	"decentralized-api/broker"
 	"decentralized-api/chainphase"
+	"decentralized-api/cli"
 	"decentralized-api/cosmosclient"
 	"decentralized-api/internal/bls"
+	"decentralized-api/internal/bridgewatcher"
 	"decentralized-api/internal/event_listener"
 	"decentralized-api/internal/modelmanager"
 	"decentralized-api/internal/nats/server"
@@ -54,6 +56,9 @@ func main() {
 	if len(os.Args) >= 2 && os.Args[1] == "pre-upgrade" {
 		os.Exit(1)
 	}
+	if len(os.Args) >= 2 && cli.IsCommand(os.Args[1]) {
+		os.Exit(cli.Execute(os.Args[1:]))
+	}
 
 	config, err := apiconfig.LoadDefaultConfigManager()
 	if err != nil {
@@ -125,6 +130,10 @@ func main() {
 		"address", participantInfo.GetAddress(),
 		"pubkey", participantInfo.GetPubKey())
 
+	// Committed PoC batches are kept locally so validators can fetch sampled leaves
+	pocBatchStore := poc.NewBatchStore("/root/.dapi/data/poc-batches", 3)
+	committedLeaves := poc.NewCommittedLeafRegistry()
+
 	nodePocOrchestrator := poc.NewNodePoCOrchestratorForCosmosChain(
 		participantInfo.GetPubKey(),
 		nodeBroker,
@@ -132,6 +141,7 @@ func main() {
 		config.GetChainNodeConfig().Url,
 		recorder,
 		chainPhaseTracker,
+		committedLeaves,
 	)
 	logging.Info("node PocOrchestrator orchestrator initialized", types.PoC, "nodePocOrchestrator", nodePocOrchestrator)
 
@@ -168,6 +178,10 @@ func main() {
 
 	// Bridge external block queue
 	blockQueue := pserver.NewBlockQueue(recorder)
+	for _, chainConfig := range config.GetBridgeConfig().Chains {
+		watcher := bridgewatcher.NewWatcher(chainConfig, recorder, config.SqlDb().GetDb())
+		go watcher.Start(ctx)
+	}
 
 	// Shared payload storage for both public and admin servers
 	// Uses PostgreSQL if PGHOST is set and accessible, otherwise file-based
@@ -178,12 +192,12 @@ func main() {
 		3*time.Minute, // cache TTL
 	)
 
-	publicServer := pserver.NewServer(nodeBroker, config, recorder, trainingExecutor, blockQueue, chainPhaseTracker, payloadStore)
+	publicServer := pserver.NewServer(nodeBroker, config, recorder, trainingExecutor, blockQueue, chainPhaseTracker, payloadStore, pocBatchStore)
 	publicServer.Start(addr)
 
 	addr = fmt.Sprintf(":%v", config.GetApiConfig().MLServerPort)
 	logging.Info("start ml server on addr", types.Server, "addr", addr)
-	mlServer := mlserver.NewServer(recorder, nodeBroker)
+	mlServer := mlserver.NewServer(recorder, nodeBroker, pocBatchStore, committedLeaves)
 	mlServer.Start(addr)
 
 	addr = fmt.Sprintf(":%v", config.GetApiConfig().AdminServerPort)
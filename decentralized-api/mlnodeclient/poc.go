@@ -224,6 +224,33 @@ func (pb ProofBatch) SampleNoncesToValidate(
 	}
 }
 
+// SampleLeafIndexes picks the positions a validator checks out of totalLeaves committed leaves, with the same
+// deterministic sampling as SampleNoncesToValidate
+func SampleLeafIndexes(
+	validatorPublicKey string,
+	samplingBlockHash string,
+	blockHeight int64,
+	nLeaves int64,
+	totalLeaves int64,
+) []int {
+	return deterministicSampleIndices(validatorPublicKey, samplingBlockHash, blockHeight, nLeaves, totalLeaves)
+}
+
+// InvalidNonceIndexes returns the positions of the nonces the ML node counted as invalid: the received distance
+// or the recomputed one misses the target
+func (vb ValidatedBatch) InvalidNonceIndexes() []int {
+	var invalid []int
+	for i := range vb.Nonces {
+		if i >= len(vb.Dist) || i >= len(vb.ReceivedDist) {
+			break
+		}
+		if vb.ReceivedDist[i] >= vb.RTarget || vb.Dist[i] > vb.RTarget {
+			invalid = append(invalid, i)
+		}
+	}
+	return invalid
+}
+
 func deterministicSampleIndices(
 	validatorPublicKey string,
 	blockHash string,
@@ -11,4 +11,6 @@ const (
 	XPromptHashHeader       = "X-Prompt-Hash"
 	XValidatorAddressHeader = "X-Validator-Address"
 	XEpochIdHeader          = "X-Epoch-Id"
+	XSessionIdHeader        = "X-Session-Id"
+	XModelIdHeader          = "X-Model-Id"
 )
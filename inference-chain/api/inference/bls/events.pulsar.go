@@ -72,6 +72,7 @@ var (
 	fd_EventKeyGenerationInitiated_i_total_slots  protoreflect.FieldDescriptor
 	fd_EventKeyGenerationInitiated_t_slots_degree protoreflect.FieldDescriptor
 	fd_EventKeyGenerationInitiated_participants   protoreflect.FieldDescriptor
+	fd_EventKeyGenerationInitiated_attempt        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -81,6 +82,7 @@ func init() {
 	fd_EventKeyGenerationInitiated_i_total_slots = md_EventKeyGenerationInitiated.Fields().ByName("i_total_slots")
 	fd_EventKeyGenerationInitiated_t_slots_degree = md_EventKeyGenerationInitiated.Fields().ByName("t_slots_degree")
 	fd_EventKeyGenerationInitiated_participants = md_EventKeyGenerationInitiated.Fields().ByName("participants")
+	fd_EventKeyGenerationInitiated_attempt = md_EventKeyGenerationInitiated.Fields().ByName("attempt")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventKeyGenerationInitiated)(nil)
@@ -172,6 +174,12 @@ func (x *fastReflection_EventKeyGenerationInitiated) Range(f func(protoreflect.F
 			return
 		}
 	}
+	if x.Attempt != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Attempt)
+		if !f(fd_EventKeyGenerationInitiated_attempt, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -195,6 +203,8 @@ func (x *fastReflection_EventKeyGenerationInitiated) Has(fd protoreflect.FieldDe
 		return x.TSlotsDegree != uint32(0)
 	case "inference.bls.EventKeyGenerationInitiated.participants":
 		return len(x.Participants) != 0
+	case "inference.bls.EventKeyGenerationInitiated.attempt":
+		return x.Attempt != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventKeyGenerationInitiated"))
@@ -219,6 +229,8 @@ func (x *fastReflection_EventKeyGenerationInitiated) Clear(fd protoreflect.Field
 		x.TSlotsDegree = uint32(0)
 	case "inference.bls.EventKeyGenerationInitiated.participants":
 		x.Participants = nil
+	case "inference.bls.EventKeyGenerationInitiated.attempt":
+		x.Attempt = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventKeyGenerationInitiated"))
@@ -250,6 +262,9 @@ func (x *fastReflection_EventKeyGenerationInitiated) Get(descriptor protoreflect
 		}
 		listValue := &_EventKeyGenerationInitiated_4_list{list: &x.Participants}
 		return protoreflect.ValueOfList(listValue)
+	case "inference.bls.EventKeyGenerationInitiated.attempt":
+		value := x.Attempt
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventKeyGenerationInitiated"))
@@ -280,6 +295,8 @@ func (x *fastReflection_EventKeyGenerationInitiated) Set(fd protoreflect.FieldDe
 		lv := value.List()
 		clv := lv.(*_EventKeyGenerationInitiated_4_list)
 		x.Participants = *clv.list
+	case "inference.bls.EventKeyGenerationInitiated.attempt":
+		x.Attempt = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventKeyGenerationInitiated"))
@@ -312,6 +329,8 @@ func (x *fastReflection_EventKeyGenerationInitiated) Mutable(fd protoreflect.Fie
 		panic(fmt.Errorf("field i_total_slots of message inference.bls.EventKeyGenerationInitiated is not mutable"))
 	case "inference.bls.EventKeyGenerationInitiated.t_slots_degree":
 		panic(fmt.Errorf("field t_slots_degree of message inference.bls.EventKeyGenerationInitiated is not mutable"))
+	case "inference.bls.EventKeyGenerationInitiated.attempt":
+		panic(fmt.Errorf("field attempt of message inference.bls.EventKeyGenerationInitiated is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventKeyGenerationInitiated"))
@@ -334,6 +353,8 @@ func (x *fastReflection_EventKeyGenerationInitiated) NewField(fd protoreflect.Fi
 	case "inference.bls.EventKeyGenerationInitiated.participants":
 		list := []*BLSParticipantInfo{}
 		return protoreflect.ValueOfList(&_EventKeyGenerationInitiated_4_list{list: &list})
+	case "inference.bls.EventKeyGenerationInitiated.attempt":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventKeyGenerationInitiated"))
@@ -418,6 +439,9 @@ func (x *fastReflection_EventKeyGenerationInitiated) ProtoMethods() *protoiface.
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Attempt != 0 {
+			n += 1 + runtime.Sov(uint64(x.Attempt))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -447,6 +471,11 @@ func (x *fastReflection_EventKeyGenerationInitiated) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Attempt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attempt))
+			i--
+			dAtA[i] = 0x28
+		}
 		if len(x.Participants) > 0 {
 			for iNdEx := len(x.Participants) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Participants[iNdEx])
@@ -618,6 +647,25 @@ func (x *fastReflection_EventKeyGenerationInitiated) ProtoMethods() *protoiface.
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+				}
+				x.Attempt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attempt |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1126,6 +1174,7 @@ var (
 	fd_EventVerifyingPhaseStarted_epoch_id                       protoreflect.FieldDescriptor
 	fd_EventVerifyingPhaseStarted_verifying_phase_deadline_block protoreflect.FieldDescriptor
 	fd_EventVerifyingPhaseStarted_epoch_data                     protoreflect.FieldDescriptor
+	fd_EventVerifyingPhaseStarted_attempt                        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1134,6 +1183,7 @@ func init() {
 	fd_EventVerifyingPhaseStarted_epoch_id = md_EventVerifyingPhaseStarted.Fields().ByName("epoch_id")
 	fd_EventVerifyingPhaseStarted_verifying_phase_deadline_block = md_EventVerifyingPhaseStarted.Fields().ByName("verifying_phase_deadline_block")
 	fd_EventVerifyingPhaseStarted_epoch_data = md_EventVerifyingPhaseStarted.Fields().ByName("epoch_data")
+	fd_EventVerifyingPhaseStarted_attempt = md_EventVerifyingPhaseStarted.Fields().ByName("attempt")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventVerifyingPhaseStarted)(nil)
@@ -1219,6 +1269,12 @@ func (x *fastReflection_EventVerifyingPhaseStarted) Range(f func(protoreflect.Fi
 			return
 		}
 	}
+	if x.Attempt != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Attempt)
+		if !f(fd_EventVerifyingPhaseStarted_attempt, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1240,6 +1296,8 @@ func (x *fastReflection_EventVerifyingPhaseStarted) Has(fd protoreflect.FieldDes
 		return x.VerifyingPhaseDeadlineBlock != uint64(0)
 	case "inference.bls.EventVerifyingPhaseStarted.epoch_data":
 		return x.EpochData != nil
+	case "inference.bls.EventVerifyingPhaseStarted.attempt":
+		return x.Attempt != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventVerifyingPhaseStarted"))
@@ -1262,6 +1320,8 @@ func (x *fastReflection_EventVerifyingPhaseStarted) Clear(fd protoreflect.FieldD
 		x.VerifyingPhaseDeadlineBlock = uint64(0)
 	case "inference.bls.EventVerifyingPhaseStarted.epoch_data":
 		x.EpochData = nil
+	case "inference.bls.EventVerifyingPhaseStarted.attempt":
+		x.Attempt = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventVerifyingPhaseStarted"))
@@ -1287,6 +1347,9 @@ func (x *fastReflection_EventVerifyingPhaseStarted) Get(descriptor protoreflect.
 	case "inference.bls.EventVerifyingPhaseStarted.epoch_data":
 		value := x.EpochData
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.bls.EventVerifyingPhaseStarted.attempt":
+		value := x.Attempt
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventVerifyingPhaseStarted"))
@@ -1313,6 +1376,8 @@ func (x *fastReflection_EventVerifyingPhaseStarted) Set(fd protoreflect.FieldDes
 		x.VerifyingPhaseDeadlineBlock = value.Uint()
 	case "inference.bls.EventVerifyingPhaseStarted.epoch_data":
 		x.EpochData = value.Message().Interface().(*EpochBLSData)
+	case "inference.bls.EventVerifyingPhaseStarted.attempt":
+		x.Attempt = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventVerifyingPhaseStarted"))
@@ -1342,6 +1407,8 @@ func (x *fastReflection_EventVerifyingPhaseStarted) Mutable(fd protoreflect.Fiel
 		panic(fmt.Errorf("field epoch_id of message inference.bls.EventVerifyingPhaseStarted is not mutable"))
 	case "inference.bls.EventVerifyingPhaseStarted.verifying_phase_deadline_block":
 		panic(fmt.Errorf("field verifying_phase_deadline_block of message inference.bls.EventVerifyingPhaseStarted is not mutable"))
+	case "inference.bls.EventVerifyingPhaseStarted.attempt":
+		panic(fmt.Errorf("field attempt of message inference.bls.EventVerifyingPhaseStarted is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventVerifyingPhaseStarted"))
@@ -1362,6 +1429,8 @@ func (x *fastReflection_EventVerifyingPhaseStarted) NewField(fd protoreflect.Fie
 	case "inference.bls.EventVerifyingPhaseStarted.epoch_data":
 		m := new(EpochBLSData)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.bls.EventVerifyingPhaseStarted.attempt":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventVerifyingPhaseStarted"))
@@ -1441,6 +1510,9 @@ func (x *fastReflection_EventVerifyingPhaseStarted) ProtoMethods() *protoiface.M
 			l = options.Size(x.EpochData)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Attempt != 0 {
+			n += 1 + runtime.Sov(uint64(x.Attempt))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1470,6 +1542,11 @@ func (x *fastReflection_EventVerifyingPhaseStarted) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Attempt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attempt))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.EpochData != nil {
 			encoded, err := options.Marshal(x.EpochData)
 			if err != nil {
@@ -1617,6 +1694,25 @@ func (x *fastReflection_EventVerifyingPhaseStarted) ProtoMethods() *protoiface.M
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+				}
+				x.Attempt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attempt |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2668,13 +2764,14 @@ func (x *fastReflection_EventVerificationVectorSubmitted) ProtoMethods() *protoi
 }
 
 var (
-	md_EventGroupPublicKeyGenerated                  protoreflect.MessageDescriptor
-	fd_EventGroupPublicKeyGenerated_epoch_id         protoreflect.FieldDescriptor
-	fd_EventGroupPublicKeyGenerated_group_public_key protoreflect.FieldDescriptor
-	fd_EventGroupPublicKeyGenerated_i_total_slots    protoreflect.FieldDescriptor
-	fd_EventGroupPublicKeyGenerated_t_slots_degree   protoreflect.FieldDescriptor
-	fd_EventGroupPublicKeyGenerated_epoch_data       protoreflect.FieldDescriptor
-	fd_EventGroupPublicKeyGenerated_chain_id         protoreflect.FieldDescriptor
+	md_EventGroupPublicKeyGenerated                     protoreflect.MessageDescriptor
+	fd_EventGroupPublicKeyGenerated_epoch_id            protoreflect.FieldDescriptor
+	fd_EventGroupPublicKeyGenerated_group_public_key    protoreflect.FieldDescriptor
+	fd_EventGroupPublicKeyGenerated_i_total_slots       protoreflect.FieldDescriptor
+	fd_EventGroupPublicKeyGenerated_t_slots_degree      protoreflect.FieldDescriptor
+	fd_EventGroupPublicKeyGenerated_epoch_data          protoreflect.FieldDescriptor
+	fd_EventGroupPublicKeyGenerated_chain_id            protoreflect.FieldDescriptor
+	fd_EventGroupPublicKeyGenerated_validating_epoch_id protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2686,6 +2783,7 @@ func init() {
 	fd_EventGroupPublicKeyGenerated_t_slots_degree = md_EventGroupPublicKeyGenerated.Fields().ByName("t_slots_degree")
 	fd_EventGroupPublicKeyGenerated_epoch_data = md_EventGroupPublicKeyGenerated.Fields().ByName("epoch_data")
 	fd_EventGroupPublicKeyGenerated_chain_id = md_EventGroupPublicKeyGenerated.Fields().ByName("chain_id")
+	fd_EventGroupPublicKeyGenerated_validating_epoch_id = md_EventGroupPublicKeyGenerated.Fields().ByName("validating_epoch_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventGroupPublicKeyGenerated)(nil)
@@ -2789,6 +2887,12 @@ func (x *fastReflection_EventGroupPublicKeyGenerated) Range(f func(protoreflect.
 			return
 		}
 	}
+	if x.ValidatingEpochId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidatingEpochId)
+		if !f(fd_EventGroupPublicKeyGenerated_validating_epoch_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2816,6 +2920,8 @@ func (x *fastReflection_EventGroupPublicKeyGenerated) Has(fd protoreflect.FieldD
 		return x.EpochData != nil
 	case "inference.bls.EventGroupPublicKeyGenerated.chain_id":
 		return x.ChainId != ""
+	case "inference.bls.EventGroupPublicKeyGenerated.validating_epoch_id":
+		return x.ValidatingEpochId != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventGroupPublicKeyGenerated"))
@@ -2844,6 +2950,8 @@ func (x *fastReflection_EventGroupPublicKeyGenerated) Clear(fd protoreflect.Fiel
 		x.EpochData = nil
 	case "inference.bls.EventGroupPublicKeyGenerated.chain_id":
 		x.ChainId = ""
+	case "inference.bls.EventGroupPublicKeyGenerated.validating_epoch_id":
+		x.ValidatingEpochId = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventGroupPublicKeyGenerated"))
@@ -2878,6 +2986,9 @@ func (x *fastReflection_EventGroupPublicKeyGenerated) Get(descriptor protoreflec
 	case "inference.bls.EventGroupPublicKeyGenerated.chain_id":
 		value := x.ChainId
 		return protoreflect.ValueOfString(value)
+	case "inference.bls.EventGroupPublicKeyGenerated.validating_epoch_id":
+		value := x.ValidatingEpochId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventGroupPublicKeyGenerated"))
@@ -2910,6 +3021,8 @@ func (x *fastReflection_EventGroupPublicKeyGenerated) Set(fd protoreflect.FieldD
 		x.EpochData = value.Message().Interface().(*EpochBLSData)
 	case "inference.bls.EventGroupPublicKeyGenerated.chain_id":
 		x.ChainId = value.Interface().(string)
+	case "inference.bls.EventGroupPublicKeyGenerated.validating_epoch_id":
+		x.ValidatingEpochId = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventGroupPublicKeyGenerated"))
@@ -2945,6 +3058,8 @@ func (x *fastReflection_EventGroupPublicKeyGenerated) Mutable(fd protoreflect.Fi
 		panic(fmt.Errorf("field t_slots_degree of message inference.bls.EventGroupPublicKeyGenerated is not mutable"))
 	case "inference.bls.EventGroupPublicKeyGenerated.chain_id":
 		panic(fmt.Errorf("field chain_id of message inference.bls.EventGroupPublicKeyGenerated is not mutable"))
+	case "inference.bls.EventGroupPublicKeyGenerated.validating_epoch_id":
+		panic(fmt.Errorf("field validating_epoch_id of message inference.bls.EventGroupPublicKeyGenerated is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventGroupPublicKeyGenerated"))
@@ -2971,6 +3086,8 @@ func (x *fastReflection_EventGroupPublicKeyGenerated) NewField(fd protoreflect.F
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "inference.bls.EventGroupPublicKeyGenerated.chain_id":
 		return protoreflect.ValueOfString("")
+	case "inference.bls.EventGroupPublicKeyGenerated.validating_epoch_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventGroupPublicKeyGenerated"))
@@ -3061,6 +3178,9 @@ func (x *fastReflection_EventGroupPublicKeyGenerated) ProtoMethods() *protoiface
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ValidatingEpochId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatingEpochId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3090,6 +3210,11 @@ func (x *fastReflection_EventGroupPublicKeyGenerated) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ValidatingEpochId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatingEpochId))
+			i--
+			dAtA[i] = 0x38
+		}
 		if len(x.ChainId) > 0 {
 			i -= len(x.ChainId)
 			copy(dAtA[i:], x.ChainId)
@@ -3341,6 +3466,25 @@ func (x *fastReflection_EventGroupPublicKeyGenerated) ProtoMethods() *protoiface
 				}
 				x.ChainId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatingEpochId", wireType)
+				}
+				x.ValidatingEpochId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatingEpochId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6082,205 +6226,2603 @@ func (x *fastReflection_EventThresholdSigningFailed) ProtoMethods() *protoiface.
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: inference/bls/events.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// EventKeyGenerationInitiated is emitted when DKG is initiated for an epoch
-type EventKeyGenerationInitiated struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.List = (*_EventDKGAttemptFailed_4_list)(nil)
 
-	// epoch_id uniquely identifies this DKG round
-	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
-	// i_total_slots is the total number of slots in the DKG
-	ITotalSlots uint32 `protobuf:"varint,2,opt,name=i_total_slots,json=iTotalSlots,proto3" json:"i_total_slots,omitempty"`
-	// t_slots_degree is the polynomial degree t for the threshold scheme
-	TSlotsDegree uint32 `protobuf:"varint,3,opt,name=t_slots_degree,json=tSlotsDegree,proto3" json:"t_slots_degree,omitempty"`
-	// participants contains information about all participants in this DKG round
-	Participants []*BLSParticipantInfo `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
+type _EventDKGAttemptFailed_4_list struct {
+	list *[]string
 }
 
-func (x *EventKeyGenerationInitiated) Reset() {
-	*x = EventKeyGenerationInitiated{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_bls_events_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_EventDKGAttemptFailed_4_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *EventKeyGenerationInitiated) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*EventKeyGenerationInitiated) ProtoMessage() {}
-
-// Deprecated: Use EventKeyGenerationInitiated.ProtoReflect.Descriptor instead.
-func (*EventKeyGenerationInitiated) Descriptor() ([]byte, []int) {
-	return file_inference_bls_events_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *EventKeyGenerationInitiated) GetEpochId() uint64 {
-	if x != nil {
-		return x.EpochId
-	}
-	return 0
+func (x *_EventDKGAttemptFailed_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *EventKeyGenerationInitiated) GetITotalSlots() uint32 {
-	if x != nil {
-		return x.ITotalSlots
-	}
-	return 0
+func (x *_EventDKGAttemptFailed_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x *EventKeyGenerationInitiated) GetTSlotsDegree() uint32 {
-	if x != nil {
-		return x.TSlotsDegree
-	}
-	return 0
+func (x *_EventDKGAttemptFailed_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *EventKeyGenerationInitiated) GetParticipants() []*BLSParticipantInfo {
-	if x != nil {
-		return x.Participants
-	}
-	return nil
+func (x *_EventDKGAttemptFailed_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventDKGAttemptFailed at list field ExcludedParticipants as it is not of Message kind"))
 }
 
-// EventDealerPartSubmitted is emitted when a participant submits their dealer part
-type EventDealerPartSubmitted struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// epoch_id identifies the DKG round this dealer part belongs to
-	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
-	// dealer_address is the address of the dealer who submitted their part
-	DealerAddress string `protobuf:"bytes,2,opt,name=dealer_address,json=dealerAddress,proto3" json:"dealer_address,omitempty"`
+func (x *_EventDKGAttemptFailed_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-func (x *EventDealerPartSubmitted) Reset() {
-	*x = EventDealerPartSubmitted{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_bls_events_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *_EventDKGAttemptFailed_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *EventDealerPartSubmitted) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_EventDKGAttemptFailed_4_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (*EventDealerPartSubmitted) ProtoMessage() {}
-
-// Deprecated: Use EventDealerPartSubmitted.ProtoReflect.Descriptor instead.
-func (*EventDealerPartSubmitted) Descriptor() ([]byte, []int) {
-	return file_inference_bls_events_proto_rawDescGZIP(), []int{1}
-}
+var (
+	md_EventDKGAttemptFailed                        protoreflect.MessageDescriptor
+	fd_EventDKGAttemptFailed_epoch_id               protoreflect.FieldDescriptor
+	fd_EventDKGAttemptFailed_attempt                protoreflect.FieldDescriptor
+	fd_EventDKGAttemptFailed_reason                 protoreflect.FieldDescriptor
+	fd_EventDKGAttemptFailed_excluded_participants  protoreflect.FieldDescriptor
+	fd_EventDKGAttemptFailed_remaining_participants protoreflect.FieldDescriptor
+	fd_EventDKGAttemptFailed_retrying               protoreflect.FieldDescriptor
+)
 
-func (x *EventDealerPartSubmitted) GetEpochId() uint64 {
-	if x != nil {
-		return x.EpochId
-	}
-	return 0
+func init() {
+	file_inference_bls_events_proto_init()
+	md_EventDKGAttemptFailed = File_inference_bls_events_proto.Messages().ByName("EventDKGAttemptFailed")
+	fd_EventDKGAttemptFailed_epoch_id = md_EventDKGAttemptFailed.Fields().ByName("epoch_id")
+	fd_EventDKGAttemptFailed_attempt = md_EventDKGAttemptFailed.Fields().ByName("attempt")
+	fd_EventDKGAttemptFailed_reason = md_EventDKGAttemptFailed.Fields().ByName("reason")
+	fd_EventDKGAttemptFailed_excluded_participants = md_EventDKGAttemptFailed.Fields().ByName("excluded_participants")
+	fd_EventDKGAttemptFailed_remaining_participants = md_EventDKGAttemptFailed.Fields().ByName("remaining_participants")
+	fd_EventDKGAttemptFailed_retrying = md_EventDKGAttemptFailed.Fields().ByName("retrying")
 }
 
-func (x *EventDealerPartSubmitted) GetDealerAddress() string {
-	if x != nil {
-		return x.DealerAddress
-	}
-	return ""
-}
+var _ protoreflect.Message = (*fastReflection_EventDKGAttemptFailed)(nil)
 
-// EventVerifyingPhaseStarted is emitted when the DKG transitions to the verification phase
-type EventVerifyingPhaseStarted struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_EventDKGAttemptFailed EventDKGAttemptFailed
 
-	// epoch_id identifies the DKG round entering verification phase
-	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
-	// verifying_phase_deadline_block is the block height deadline for the verification phase
-	VerifyingPhaseDeadlineBlock uint64 `protobuf:"varint,2,opt,name=verifying_phase_deadline_block,json=verifyingPhaseDeadlineBlock,proto3" json:"verifying_phase_deadline_block,omitempty"`
-	// epoch_data contains the complete epoch BLS data at the time of transition
-	EpochData *EpochBLSData `protobuf:"bytes,3,opt,name=epoch_data,json=epochData,proto3" json:"epoch_data,omitempty"`
+func (x *EventDKGAttemptFailed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventDKGAttemptFailed)(x)
 }
 
-func (x *EventVerifyingPhaseStarted) Reset() {
-	*x = EventVerifyingPhaseStarted{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_bls_events_proto_msgTypes[2]
+func (x *EventDKGAttemptFailed) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_bls_events_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *EventVerifyingPhaseStarted) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_EventDKGAttemptFailed_messageType fastReflection_EventDKGAttemptFailed_messageType
+var _ protoreflect.MessageType = fastReflection_EventDKGAttemptFailed_messageType{}
 
-func (*EventVerifyingPhaseStarted) ProtoMessage() {}
+type fastReflection_EventDKGAttemptFailed_messageType struct{}
 
-// Deprecated: Use EventVerifyingPhaseStarted.ProtoReflect.Descriptor instead.
-func (*EventVerifyingPhaseStarted) Descriptor() ([]byte, []int) {
-	return file_inference_bls_events_proto_rawDescGZIP(), []int{2}
+func (x fastReflection_EventDKGAttemptFailed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventDKGAttemptFailed)(nil)
 }
-
-func (x *EventVerifyingPhaseStarted) GetEpochId() uint64 {
-	if x != nil {
-		return x.EpochId
-	}
-	return 0
+func (x fastReflection_EventDKGAttemptFailed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventDKGAttemptFailed)
 }
-
-func (x *EventVerifyingPhaseStarted) GetVerifyingPhaseDeadlineBlock() uint64 {
-	if x != nil {
-		return x.VerifyingPhaseDeadlineBlock
-	}
-	return 0
+func (x fastReflection_EventDKGAttemptFailed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDKGAttemptFailed
 }
 
-func (x *EventVerifyingPhaseStarted) GetEpochData() *EpochBLSData {
-	if x != nil {
-		return x.EpochData
-	}
-	return nil
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventDKGAttemptFailed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDKGAttemptFailed
 }
 
-// EventDKGFailed is emitted when a DKG round fails
-type EventDKGFailed struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventDKGAttemptFailed) Type() protoreflect.MessageType {
+	return _fastReflection_EventDKGAttemptFailed_messageType
+}
 
-	// epoch_id identifies the DKG round that failed
-	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
-	// reason describes why the DKG failed
-	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	// epoch_data contains the complete epoch BLS data at the time of failure
-	EpochData *EpochBLSData `protobuf:"bytes,3,opt,name=epoch_data,json=epochData,proto3" json:"epoch_data,omitempty"`
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventDKGAttemptFailed) New() protoreflect.Message {
+	return new(fastReflection_EventDKGAttemptFailed)
 }
 
-func (x *EventDKGFailed) Reset() {
-	*x = EventDKGFailed{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_bls_events_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventDKGAttemptFailed) Interface() protoreflect.ProtoMessage {
+	return (*EventDKGAttemptFailed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventDKGAttemptFailed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EpochId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochId)
+		if !f(fd_EventDKGAttemptFailed_epoch_id, value) {
+			return
+		}
+	}
+	if x.Attempt != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Attempt)
+		if !f(fd_EventDKGAttemptFailed_attempt, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventDKGAttemptFailed_reason, value) {
+			return
+		}
+	}
+	if len(x.ExcludedParticipants) != 0 {
+		value := protoreflect.ValueOfList(&_EventDKGAttemptFailed_4_list{list: &x.ExcludedParticipants})
+		if !f(fd_EventDKGAttemptFailed_excluded_participants, value) {
+			return
+		}
+	}
+	if x.RemainingParticipants != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RemainingParticipants)
+		if !f(fd_EventDKGAttemptFailed_remaining_participants, value) {
+			return
+		}
+	}
+	if x.Retrying != false {
+		value := protoreflect.ValueOfBool(x.Retrying)
+		if !f(fd_EventDKGAttemptFailed_retrying, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventDKGAttemptFailed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.bls.EventDKGAttemptFailed.epoch_id":
+		return x.EpochId != uint64(0)
+	case "inference.bls.EventDKGAttemptFailed.attempt":
+		return x.Attempt != uint32(0)
+	case "inference.bls.EventDKGAttemptFailed.reason":
+		return x.Reason != ""
+	case "inference.bls.EventDKGAttemptFailed.excluded_participants":
+		return len(x.ExcludedParticipants) != 0
+	case "inference.bls.EventDKGAttemptFailed.remaining_participants":
+		return x.RemainingParticipants != uint32(0)
+	case "inference.bls.EventDKGAttemptFailed.retrying":
+		return x.Retrying != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventDKGAttemptFailed"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventDKGAttemptFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDKGAttemptFailed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.bls.EventDKGAttemptFailed.epoch_id":
+		x.EpochId = uint64(0)
+	case "inference.bls.EventDKGAttemptFailed.attempt":
+		x.Attempt = uint32(0)
+	case "inference.bls.EventDKGAttemptFailed.reason":
+		x.Reason = ""
+	case "inference.bls.EventDKGAttemptFailed.excluded_participants":
+		x.ExcludedParticipants = nil
+	case "inference.bls.EventDKGAttemptFailed.remaining_participants":
+		x.RemainingParticipants = uint32(0)
+	case "inference.bls.EventDKGAttemptFailed.retrying":
+		x.Retrying = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventDKGAttemptFailed"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventDKGAttemptFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventDKGAttemptFailed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.bls.EventDKGAttemptFailed.epoch_id":
+		value := x.EpochId
+		return protoreflect.ValueOfUint64(value)
+	case "inference.bls.EventDKGAttemptFailed.attempt":
+		value := x.Attempt
+		return protoreflect.ValueOfUint32(value)
+	case "inference.bls.EventDKGAttemptFailed.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "inference.bls.EventDKGAttemptFailed.excluded_participants":
+		if len(x.ExcludedParticipants) == 0 {
+			return protoreflect.ValueOfList(&_EventDKGAttemptFailed_4_list{})
+		}
+		listValue := &_EventDKGAttemptFailed_4_list{list: &x.ExcludedParticipants}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.bls.EventDKGAttemptFailed.remaining_participants":
+		value := x.RemainingParticipants
+		return protoreflect.ValueOfUint32(value)
+	case "inference.bls.EventDKGAttemptFailed.retrying":
+		value := x.Retrying
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventDKGAttemptFailed"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventDKGAttemptFailed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDKGAttemptFailed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.bls.EventDKGAttemptFailed.epoch_id":
+		x.EpochId = value.Uint()
+	case "inference.bls.EventDKGAttemptFailed.attempt":
+		x.Attempt = uint32(value.Uint())
+	case "inference.bls.EventDKGAttemptFailed.reason":
+		x.Reason = value.Interface().(string)
+	case "inference.bls.EventDKGAttemptFailed.excluded_participants":
+		lv := value.List()
+		clv := lv.(*_EventDKGAttemptFailed_4_list)
+		x.ExcludedParticipants = *clv.list
+	case "inference.bls.EventDKGAttemptFailed.remaining_participants":
+		x.RemainingParticipants = uint32(value.Uint())
+	case "inference.bls.EventDKGAttemptFailed.retrying":
+		x.Retrying = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventDKGAttemptFailed"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventDKGAttemptFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDKGAttemptFailed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.EventDKGAttemptFailed.excluded_participants":
+		if x.ExcludedParticipants == nil {
+			x.ExcludedParticipants = []string{}
+		}
+		value := &_EventDKGAttemptFailed_4_list{list: &x.ExcludedParticipants}
+		return protoreflect.ValueOfList(value)
+	case "inference.bls.EventDKGAttemptFailed.epoch_id":
+		panic(fmt.Errorf("field epoch_id of message inference.bls.EventDKGAttemptFailed is not mutable"))
+	case "inference.bls.EventDKGAttemptFailed.attempt":
+		panic(fmt.Errorf("field attempt of message inference.bls.EventDKGAttemptFailed is not mutable"))
+	case "inference.bls.EventDKGAttemptFailed.reason":
+		panic(fmt.Errorf("field reason of message inference.bls.EventDKGAttemptFailed is not mutable"))
+	case "inference.bls.EventDKGAttemptFailed.remaining_participants":
+		panic(fmt.Errorf("field remaining_participants of message inference.bls.EventDKGAttemptFailed is not mutable"))
+	case "inference.bls.EventDKGAttemptFailed.retrying":
+		panic(fmt.Errorf("field retrying of message inference.bls.EventDKGAttemptFailed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventDKGAttemptFailed"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventDKGAttemptFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventDKGAttemptFailed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.EventDKGAttemptFailed.epoch_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.bls.EventDKGAttemptFailed.attempt":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "inference.bls.EventDKGAttemptFailed.reason":
+		return protoreflect.ValueOfString("")
+	case "inference.bls.EventDKGAttemptFailed.excluded_participants":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventDKGAttemptFailed_4_list{list: &list})
+	case "inference.bls.EventDKGAttemptFailed.remaining_participants":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "inference.bls.EventDKGAttemptFailed.retrying":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventDKGAttemptFailed"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventDKGAttemptFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventDKGAttemptFailed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.bls.EventDKGAttemptFailed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventDKGAttemptFailed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDKGAttemptFailed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventDKGAttemptFailed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventDKGAttemptFailed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventDKGAttemptFailed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EpochId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochId))
+		}
+		if x.Attempt != 0 {
+			n += 1 + runtime.Sov(uint64(x.Attempt))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ExcludedParticipants) > 0 {
+			for _, s := range x.ExcludedParticipants {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RemainingParticipants != 0 {
+			n += 1 + runtime.Sov(uint64(x.RemainingParticipants))
+		}
+		if x.Retrying {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventDKGAttemptFailed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Retrying {
+			i--
+			if x.Retrying {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.RemainingParticipants != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RemainingParticipants))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.ExcludedParticipants) > 0 {
+			for iNdEx := len(x.ExcludedParticipants) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ExcludedParticipants[iNdEx])
+				copy(dAtA[i:], x.ExcludedParticipants[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExcludedParticipants[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Attempt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attempt))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.EpochId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventDKGAttemptFailed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDKGAttemptFailed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDKGAttemptFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+				}
+				x.EpochId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+				}
+				x.Attempt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attempt |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExcludedParticipants", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExcludedParticipants = append(x.ExcludedParticipants, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingParticipants", wireType)
+				}
+				x.RemainingParticipants = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RemainingParticipants |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Retrying", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Retrying = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventGroupPublicKeyCarriedOver                  protoreflect.MessageDescriptor
+	fd_EventGroupPublicKeyCarriedOver_epoch_id         protoreflect.FieldDescriptor
+	fd_EventGroupPublicKeyCarriedOver_source_epoch_id  protoreflect.FieldDescriptor
+	fd_EventGroupPublicKeyCarriedOver_group_public_key protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_bls_events_proto_init()
+	md_EventGroupPublicKeyCarriedOver = File_inference_bls_events_proto.Messages().ByName("EventGroupPublicKeyCarriedOver")
+	fd_EventGroupPublicKeyCarriedOver_epoch_id = md_EventGroupPublicKeyCarriedOver.Fields().ByName("epoch_id")
+	fd_EventGroupPublicKeyCarriedOver_source_epoch_id = md_EventGroupPublicKeyCarriedOver.Fields().ByName("source_epoch_id")
+	fd_EventGroupPublicKeyCarriedOver_group_public_key = md_EventGroupPublicKeyCarriedOver.Fields().ByName("group_public_key")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventGroupPublicKeyCarriedOver)(nil)
+
+type fastReflection_EventGroupPublicKeyCarriedOver EventGroupPublicKeyCarriedOver
+
+func (x *EventGroupPublicKeyCarriedOver) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventGroupPublicKeyCarriedOver)(x)
+}
+
+func (x *EventGroupPublicKeyCarriedOver) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_bls_events_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventGroupPublicKeyCarriedOver_messageType fastReflection_EventGroupPublicKeyCarriedOver_messageType
+var _ protoreflect.MessageType = fastReflection_EventGroupPublicKeyCarriedOver_messageType{}
+
+type fastReflection_EventGroupPublicKeyCarriedOver_messageType struct{}
+
+func (x fastReflection_EventGroupPublicKeyCarriedOver_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventGroupPublicKeyCarriedOver)(nil)
+}
+func (x fastReflection_EventGroupPublicKeyCarriedOver_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventGroupPublicKeyCarriedOver)
+}
+func (x fastReflection_EventGroupPublicKeyCarriedOver_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventGroupPublicKeyCarriedOver
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventGroupPublicKeyCarriedOver
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) Type() protoreflect.MessageType {
+	return _fastReflection_EventGroupPublicKeyCarriedOver_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) New() protoreflect.Message {
+	return new(fastReflection_EventGroupPublicKeyCarriedOver)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) Interface() protoreflect.ProtoMessage {
+	return (*EventGroupPublicKeyCarriedOver)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EpochId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochId)
+		if !f(fd_EventGroupPublicKeyCarriedOver_epoch_id, value) {
+			return
+		}
+	}
+	if x.SourceEpochId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SourceEpochId)
+		if !f(fd_EventGroupPublicKeyCarriedOver_source_epoch_id, value) {
+			return
+		}
+	}
+	if len(x.GroupPublicKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.GroupPublicKey)
+		if !f(fd_EventGroupPublicKeyCarriedOver_group_public_key, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.bls.EventGroupPublicKeyCarriedOver.epoch_id":
+		return x.EpochId != uint64(0)
+	case "inference.bls.EventGroupPublicKeyCarriedOver.source_epoch_id":
+		return x.SourceEpochId != uint64(0)
+	case "inference.bls.EventGroupPublicKeyCarriedOver.group_public_key":
+		return len(x.GroupPublicKey) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventGroupPublicKeyCarriedOver"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventGroupPublicKeyCarriedOver does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.bls.EventGroupPublicKeyCarriedOver.epoch_id":
+		x.EpochId = uint64(0)
+	case "inference.bls.EventGroupPublicKeyCarriedOver.source_epoch_id":
+		x.SourceEpochId = uint64(0)
+	case "inference.bls.EventGroupPublicKeyCarriedOver.group_public_key":
+		x.GroupPublicKey = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventGroupPublicKeyCarriedOver"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventGroupPublicKeyCarriedOver does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.bls.EventGroupPublicKeyCarriedOver.epoch_id":
+		value := x.EpochId
+		return protoreflect.ValueOfUint64(value)
+	case "inference.bls.EventGroupPublicKeyCarriedOver.source_epoch_id":
+		value := x.SourceEpochId
+		return protoreflect.ValueOfUint64(value)
+	case "inference.bls.EventGroupPublicKeyCarriedOver.group_public_key":
+		value := x.GroupPublicKey
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventGroupPublicKeyCarriedOver"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventGroupPublicKeyCarriedOver does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.bls.EventGroupPublicKeyCarriedOver.epoch_id":
+		x.EpochId = value.Uint()
+	case "inference.bls.EventGroupPublicKeyCarriedOver.source_epoch_id":
+		x.SourceEpochId = value.Uint()
+	case "inference.bls.EventGroupPublicKeyCarriedOver.group_public_key":
+		x.GroupPublicKey = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventGroupPublicKeyCarriedOver"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventGroupPublicKeyCarriedOver does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.EventGroupPublicKeyCarriedOver.epoch_id":
+		panic(fmt.Errorf("field epoch_id of message inference.bls.EventGroupPublicKeyCarriedOver is not mutable"))
+	case "inference.bls.EventGroupPublicKeyCarriedOver.source_epoch_id":
+		panic(fmt.Errorf("field source_epoch_id of message inference.bls.EventGroupPublicKeyCarriedOver is not mutable"))
+	case "inference.bls.EventGroupPublicKeyCarriedOver.group_public_key":
+		panic(fmt.Errorf("field group_public_key of message inference.bls.EventGroupPublicKeyCarriedOver is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventGroupPublicKeyCarriedOver"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventGroupPublicKeyCarriedOver does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.EventGroupPublicKeyCarriedOver.epoch_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.bls.EventGroupPublicKeyCarriedOver.source_epoch_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.bls.EventGroupPublicKeyCarriedOver.group_public_key":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventGroupPublicKeyCarriedOver"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventGroupPublicKeyCarriedOver does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.bls.EventGroupPublicKeyCarriedOver", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventGroupPublicKeyCarriedOver) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventGroupPublicKeyCarriedOver)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EpochId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochId))
+		}
+		if x.SourceEpochId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SourceEpochId))
+		}
+		l = len(x.GroupPublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventGroupPublicKeyCarriedOver)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.GroupPublicKey) > 0 {
+			i -= len(x.GroupPublicKey)
+			copy(dAtA[i:], x.GroupPublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupPublicKey)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.SourceEpochId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SourceEpochId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.EpochId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventGroupPublicKeyCarriedOver)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventGroupPublicKeyCarriedOver: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventGroupPublicKeyCarriedOver: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+				}
+				x.EpochId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceEpochId", wireType)
+				}
+				x.SourceEpochId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SourceEpochId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPublicKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroupPublicKey = append(x.GroupPublicKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.GroupPublicKey == nil {
+					x.GroupPublicKey = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventSchemaSignatureRequested             protoreflect.MessageDescriptor
+	fd_EventSchemaSignatureRequested_request_id  protoreflect.FieldDescriptor
+	fd_EventSchemaSignatureRequested_schema_name protoreflect.FieldDescriptor
+	fd_EventSchemaSignatureRequested_requester   protoreflect.FieldDescriptor
+	fd_EventSchemaSignatureRequested_sequence    protoreflect.FieldDescriptor
+	fd_EventSchemaSignatureRequested_retry       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_bls_events_proto_init()
+	md_EventSchemaSignatureRequested = File_inference_bls_events_proto.Messages().ByName("EventSchemaSignatureRequested")
+	fd_EventSchemaSignatureRequested_request_id = md_EventSchemaSignatureRequested.Fields().ByName("request_id")
+	fd_EventSchemaSignatureRequested_schema_name = md_EventSchemaSignatureRequested.Fields().ByName("schema_name")
+	fd_EventSchemaSignatureRequested_requester = md_EventSchemaSignatureRequested.Fields().ByName("requester")
+	fd_EventSchemaSignatureRequested_sequence = md_EventSchemaSignatureRequested.Fields().ByName("sequence")
+	fd_EventSchemaSignatureRequested_retry = md_EventSchemaSignatureRequested.Fields().ByName("retry")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSchemaSignatureRequested)(nil)
+
+type fastReflection_EventSchemaSignatureRequested EventSchemaSignatureRequested
+
+func (x *EventSchemaSignatureRequested) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSchemaSignatureRequested)(x)
+}
+
+func (x *EventSchemaSignatureRequested) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_bls_events_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSchemaSignatureRequested_messageType fastReflection_EventSchemaSignatureRequested_messageType
+var _ protoreflect.MessageType = fastReflection_EventSchemaSignatureRequested_messageType{}
+
+type fastReflection_EventSchemaSignatureRequested_messageType struct{}
+
+func (x fastReflection_EventSchemaSignatureRequested_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSchemaSignatureRequested)(nil)
+}
+func (x fastReflection_EventSchemaSignatureRequested_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSchemaSignatureRequested)
+}
+func (x fastReflection_EventSchemaSignatureRequested_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSchemaSignatureRequested
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSchemaSignatureRequested) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSchemaSignatureRequested
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSchemaSignatureRequested) Type() protoreflect.MessageType {
+	return _fastReflection_EventSchemaSignatureRequested_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSchemaSignatureRequested) New() protoreflect.Message {
+	return new(fastReflection_EventSchemaSignatureRequested)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSchemaSignatureRequested) Interface() protoreflect.ProtoMessage {
+	return (*EventSchemaSignatureRequested)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSchemaSignatureRequested) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.RequestId) != 0 {
+		value := protoreflect.ValueOfBytes(x.RequestId)
+		if !f(fd_EventSchemaSignatureRequested_request_id, value) {
+			return
+		}
+	}
+	if x.SchemaName != "" {
+		value := protoreflect.ValueOfString(x.SchemaName)
+		if !f(fd_EventSchemaSignatureRequested_schema_name, value) {
+			return
+		}
+	}
+	if x.Requester != "" {
+		value := protoreflect.ValueOfString(x.Requester)
+		if !f(fd_EventSchemaSignatureRequested_requester, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_EventSchemaSignatureRequested_sequence, value) {
+			return
+		}
+	}
+	if x.Retry != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Retry)
+		if !f(fd_EventSchemaSignatureRequested_retry, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSchemaSignatureRequested) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.bls.EventSchemaSignatureRequested.request_id":
+		return len(x.RequestId) != 0
+	case "inference.bls.EventSchemaSignatureRequested.schema_name":
+		return x.SchemaName != ""
+	case "inference.bls.EventSchemaSignatureRequested.requester":
+		return x.Requester != ""
+	case "inference.bls.EventSchemaSignatureRequested.sequence":
+		return x.Sequence != uint64(0)
+	case "inference.bls.EventSchemaSignatureRequested.retry":
+		return x.Retry != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventSchemaSignatureRequested"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventSchemaSignatureRequested does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSchemaSignatureRequested) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.bls.EventSchemaSignatureRequested.request_id":
+		x.RequestId = nil
+	case "inference.bls.EventSchemaSignatureRequested.schema_name":
+		x.SchemaName = ""
+	case "inference.bls.EventSchemaSignatureRequested.requester":
+		x.Requester = ""
+	case "inference.bls.EventSchemaSignatureRequested.sequence":
+		x.Sequence = uint64(0)
+	case "inference.bls.EventSchemaSignatureRequested.retry":
+		x.Retry = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventSchemaSignatureRequested"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventSchemaSignatureRequested does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSchemaSignatureRequested) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.bls.EventSchemaSignatureRequested.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfBytes(value)
+	case "inference.bls.EventSchemaSignatureRequested.schema_name":
+		value := x.SchemaName
+		return protoreflect.ValueOfString(value)
+	case "inference.bls.EventSchemaSignatureRequested.requester":
+		value := x.Requester
+		return protoreflect.ValueOfString(value)
+	case "inference.bls.EventSchemaSignatureRequested.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "inference.bls.EventSchemaSignatureRequested.retry":
+		value := x.Retry
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventSchemaSignatureRequested"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventSchemaSignatureRequested does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSchemaSignatureRequested) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.bls.EventSchemaSignatureRequested.request_id":
+		x.RequestId = value.Bytes()
+	case "inference.bls.EventSchemaSignatureRequested.schema_name":
+		x.SchemaName = value.Interface().(string)
+	case "inference.bls.EventSchemaSignatureRequested.requester":
+		x.Requester = value.Interface().(string)
+	case "inference.bls.EventSchemaSignatureRequested.sequence":
+		x.Sequence = value.Uint()
+	case "inference.bls.EventSchemaSignatureRequested.retry":
+		x.Retry = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventSchemaSignatureRequested"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventSchemaSignatureRequested does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSchemaSignatureRequested) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.EventSchemaSignatureRequested.request_id":
+		panic(fmt.Errorf("field request_id of message inference.bls.EventSchemaSignatureRequested is not mutable"))
+	case "inference.bls.EventSchemaSignatureRequested.schema_name":
+		panic(fmt.Errorf("field schema_name of message inference.bls.EventSchemaSignatureRequested is not mutable"))
+	case "inference.bls.EventSchemaSignatureRequested.requester":
+		panic(fmt.Errorf("field requester of message inference.bls.EventSchemaSignatureRequested is not mutable"))
+	case "inference.bls.EventSchemaSignatureRequested.sequence":
+		panic(fmt.Errorf("field sequence of message inference.bls.EventSchemaSignatureRequested is not mutable"))
+	case "inference.bls.EventSchemaSignatureRequested.retry":
+		panic(fmt.Errorf("field retry of message inference.bls.EventSchemaSignatureRequested is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventSchemaSignatureRequested"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventSchemaSignatureRequested does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSchemaSignatureRequested) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.EventSchemaSignatureRequested.request_id":
+		return protoreflect.ValueOfBytes(nil)
+	case "inference.bls.EventSchemaSignatureRequested.schema_name":
+		return protoreflect.ValueOfString("")
+	case "inference.bls.EventSchemaSignatureRequested.requester":
+		return protoreflect.ValueOfString("")
+	case "inference.bls.EventSchemaSignatureRequested.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.bls.EventSchemaSignatureRequested.retry":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventSchemaSignatureRequested"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventSchemaSignatureRequested does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSchemaSignatureRequested) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.bls.EventSchemaSignatureRequested", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSchemaSignatureRequested) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSchemaSignatureRequested) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSchemaSignatureRequested) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSchemaSignatureRequested) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSchemaSignatureRequested)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.RequestId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SchemaName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Requester)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.Retry != 0 {
+			n += 1 + runtime.Sov(uint64(x.Retry))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSchemaSignatureRequested)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Retry != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Retry))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Requester) > 0 {
+			i -= len(x.Requester)
+			copy(dAtA[i:], x.Requester)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Requester)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SchemaName) > 0 {
+			i -= len(x.SchemaName)
+			copy(dAtA[i:], x.SchemaName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SchemaName)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RequestId) > 0 {
+			i -= len(x.RequestId)
+			copy(dAtA[i:], x.RequestId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequestId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSchemaSignatureRequested)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSchemaSignatureRequested: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSchemaSignatureRequested: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequestId = append(x.RequestId[:0], dAtA[iNdEx:postIndex]...)
+				if x.RequestId == nil {
+					x.RequestId = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SchemaName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SchemaName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Requester = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+				}
+				x.Retry = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Retry |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventSigningSchemaUpdated             protoreflect.MessageDescriptor
+	fd_EventSigningSchemaUpdated_schema_name protoreflect.FieldDescriptor
+	fd_EventSigningSchemaUpdated_enabled     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_bls_events_proto_init()
+	md_EventSigningSchemaUpdated = File_inference_bls_events_proto.Messages().ByName("EventSigningSchemaUpdated")
+	fd_EventSigningSchemaUpdated_schema_name = md_EventSigningSchemaUpdated.Fields().ByName("schema_name")
+	fd_EventSigningSchemaUpdated_enabled = md_EventSigningSchemaUpdated.Fields().ByName("enabled")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSigningSchemaUpdated)(nil)
+
+type fastReflection_EventSigningSchemaUpdated EventSigningSchemaUpdated
+
+func (x *EventSigningSchemaUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSigningSchemaUpdated)(x)
+}
+
+func (x *EventSigningSchemaUpdated) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_bls_events_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSigningSchemaUpdated_messageType fastReflection_EventSigningSchemaUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_EventSigningSchemaUpdated_messageType{}
+
+type fastReflection_EventSigningSchemaUpdated_messageType struct{}
+
+func (x fastReflection_EventSigningSchemaUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSigningSchemaUpdated)(nil)
+}
+func (x fastReflection_EventSigningSchemaUpdated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSigningSchemaUpdated)
+}
+func (x fastReflection_EventSigningSchemaUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSigningSchemaUpdated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSigningSchemaUpdated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSigningSchemaUpdated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSigningSchemaUpdated) Type() protoreflect.MessageType {
+	return _fastReflection_EventSigningSchemaUpdated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSigningSchemaUpdated) New() protoreflect.Message {
+	return new(fastReflection_EventSigningSchemaUpdated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSigningSchemaUpdated) Interface() protoreflect.ProtoMessage {
+	return (*EventSigningSchemaUpdated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSigningSchemaUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SchemaName != "" {
+		value := protoreflect.ValueOfString(x.SchemaName)
+		if !f(fd_EventSigningSchemaUpdated_schema_name, value) {
+			return
+		}
+	}
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_EventSigningSchemaUpdated_enabled, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSigningSchemaUpdated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.bls.EventSigningSchemaUpdated.schema_name":
+		return x.SchemaName != ""
+	case "inference.bls.EventSigningSchemaUpdated.enabled":
+		return x.Enabled != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventSigningSchemaUpdated"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventSigningSchemaUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSigningSchemaUpdated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.bls.EventSigningSchemaUpdated.schema_name":
+		x.SchemaName = ""
+	case "inference.bls.EventSigningSchemaUpdated.enabled":
+		x.Enabled = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventSigningSchemaUpdated"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventSigningSchemaUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSigningSchemaUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.bls.EventSigningSchemaUpdated.schema_name":
+		value := x.SchemaName
+		return protoreflect.ValueOfString(value)
+	case "inference.bls.EventSigningSchemaUpdated.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventSigningSchemaUpdated"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventSigningSchemaUpdated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSigningSchemaUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.bls.EventSigningSchemaUpdated.schema_name":
+		x.SchemaName = value.Interface().(string)
+	case "inference.bls.EventSigningSchemaUpdated.enabled":
+		x.Enabled = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventSigningSchemaUpdated"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventSigningSchemaUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSigningSchemaUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.EventSigningSchemaUpdated.schema_name":
+		panic(fmt.Errorf("field schema_name of message inference.bls.EventSigningSchemaUpdated is not mutable"))
+	case "inference.bls.EventSigningSchemaUpdated.enabled":
+		panic(fmt.Errorf("field enabled of message inference.bls.EventSigningSchemaUpdated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventSigningSchemaUpdated"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventSigningSchemaUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSigningSchemaUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.EventSigningSchemaUpdated.schema_name":
+		return protoreflect.ValueOfString("")
+	case "inference.bls.EventSigningSchemaUpdated.enabled":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EventSigningSchemaUpdated"))
+		}
+		panic(fmt.Errorf("message inference.bls.EventSigningSchemaUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSigningSchemaUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.bls.EventSigningSchemaUpdated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSigningSchemaUpdated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSigningSchemaUpdated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSigningSchemaUpdated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSigningSchemaUpdated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSigningSchemaUpdated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SchemaName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Enabled {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSigningSchemaUpdated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.SchemaName) > 0 {
+			i -= len(x.SchemaName)
+			copy(dAtA[i:], x.SchemaName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SchemaName)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSigningSchemaUpdated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSigningSchemaUpdated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSigningSchemaUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SchemaName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SchemaName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/bls/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventKeyGenerationInitiated is emitted when DKG is initiated for an epoch
+type EventKeyGenerationInitiated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// epoch_id uniquely identifies this DKG round
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// i_total_slots is the total number of slots in the DKG
+	ITotalSlots uint32 `protobuf:"varint,2,opt,name=i_total_slots,json=iTotalSlots,proto3" json:"i_total_slots,omitempty"`
+	// t_slots_degree is the polynomial degree t for the threshold scheme
+	TSlotsDegree uint32 `protobuf:"varint,3,opt,name=t_slots_degree,json=tSlotsDegree,proto3" json:"t_slots_degree,omitempty"`
+	// participants contains information about all participants in this DKG round
+	Participants []*BLSParticipantInfo `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
+	// attempt is the DKG attempt for the epoch; dealing restarts with a higher attempt after a failed one
+	Attempt uint32 `protobuf:"varint,5,opt,name=attempt,proto3" json:"attempt,omitempty"`
+}
+
+func (x *EventKeyGenerationInitiated) Reset() {
+	*x = EventKeyGenerationInitiated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_bls_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventKeyGenerationInitiated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventKeyGenerationInitiated) ProtoMessage() {}
+
+// Deprecated: Use EventKeyGenerationInitiated.ProtoReflect.Descriptor instead.
+func (*EventKeyGenerationInitiated) Descriptor() ([]byte, []int) {
+	return file_inference_bls_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventKeyGenerationInitiated) GetEpochId() uint64 {
+	if x != nil {
+		return x.EpochId
+	}
+	return 0
+}
+
+func (x *EventKeyGenerationInitiated) GetITotalSlots() uint32 {
+	if x != nil {
+		return x.ITotalSlots
+	}
+	return 0
+}
+
+func (x *EventKeyGenerationInitiated) GetTSlotsDegree() uint32 {
+	if x != nil {
+		return x.TSlotsDegree
+	}
+	return 0
+}
+
+func (x *EventKeyGenerationInitiated) GetParticipants() []*BLSParticipantInfo {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *EventKeyGenerationInitiated) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+// EventDealerPartSubmitted is emitted when a participant submits their dealer part
+type EventDealerPartSubmitted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// epoch_id identifies the DKG round this dealer part belongs to
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// dealer_address is the address of the dealer who submitted their part
+	DealerAddress string `protobuf:"bytes,2,opt,name=dealer_address,json=dealerAddress,proto3" json:"dealer_address,omitempty"`
+}
+
+func (x *EventDealerPartSubmitted) Reset() {
+	*x = EventDealerPartSubmitted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_bls_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventDealerPartSubmitted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDealerPartSubmitted) ProtoMessage() {}
+
+// Deprecated: Use EventDealerPartSubmitted.ProtoReflect.Descriptor instead.
+func (*EventDealerPartSubmitted) Descriptor() ([]byte, []int) {
+	return file_inference_bls_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventDealerPartSubmitted) GetEpochId() uint64 {
+	if x != nil {
+		return x.EpochId
+	}
+	return 0
+}
+
+func (x *EventDealerPartSubmitted) GetDealerAddress() string {
+	if x != nil {
+		return x.DealerAddress
+	}
+	return ""
+}
+
+// EventVerifyingPhaseStarted is emitted when the DKG transitions to the verification phase
+type EventVerifyingPhaseStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// epoch_id identifies the DKG round entering verification phase
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// verifying_phase_deadline_block is the block height deadline for the verification phase
+	VerifyingPhaseDeadlineBlock uint64 `protobuf:"varint,2,opt,name=verifying_phase_deadline_block,json=verifyingPhaseDeadlineBlock,proto3" json:"verifying_phase_deadline_block,omitempty"`
+	// epoch_data contains the complete epoch BLS data at the time of transition
+	EpochData *EpochBLSData `protobuf:"bytes,3,opt,name=epoch_data,json=epochData,proto3" json:"epoch_data,omitempty"`
+	// attempt is the DKG attempt entering verification
+	Attempt uint32 `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+}
+
+func (x *EventVerifyingPhaseStarted) Reset() {
+	*x = EventVerifyingPhaseStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_bls_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventVerifyingPhaseStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventVerifyingPhaseStarted) ProtoMessage() {}
+
+// Deprecated: Use EventVerifyingPhaseStarted.ProtoReflect.Descriptor instead.
+func (*EventVerifyingPhaseStarted) Descriptor() ([]byte, []int) {
+	return file_inference_bls_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventVerifyingPhaseStarted) GetEpochId() uint64 {
+	if x != nil {
+		return x.EpochId
+	}
+	return 0
+}
+
+func (x *EventVerifyingPhaseStarted) GetVerifyingPhaseDeadlineBlock() uint64 {
+	if x != nil {
+		return x.VerifyingPhaseDeadlineBlock
+	}
+	return 0
+}
+
+func (x *EventVerifyingPhaseStarted) GetEpochData() *EpochBLSData {
+	if x != nil {
+		return x.EpochData
+	}
+	return nil
+}
+
+func (x *EventVerifyingPhaseStarted) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+// EventDKGFailed is emitted when a DKG round fails
+type EventDKGFailed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// epoch_id identifies the DKG round that failed
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// reason describes why the DKG failed
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// epoch_data contains the complete epoch BLS data at the time of failure
+	EpochData *EpochBLSData `protobuf:"bytes,3,opt,name=epoch_data,json=epochData,proto3" json:"epoch_data,omitempty"`
+}
+
+func (x *EventDKGFailed) Reset() {
+	*x = EventDKGFailed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_bls_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
 func (x *EventDKGFailed) String() string {
@@ -6379,6 +8921,9 @@ type EventGroupPublicKeyGenerated struct {
 	EpochData *EpochBLSData `protobuf:"bytes,5,opt,name=epoch_data,json=epochData,proto3" json:"epoch_data,omitempty"`
 	// chain_id is the chain ID for EIP-155 compatibility
 	ChainId string `protobuf:"bytes,6,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// validating_epoch_id is the epoch whose group signs the new key for group key validation. It is the previous
+	// epoch, or the epoch that one carries its key from when its own DKG failed.
+	ValidatingEpochId uint64 `protobuf:"varint,7,opt,name=validating_epoch_id,json=validatingEpochId,proto3" json:"validating_epoch_id,omitempty"`
 }
 
 func (x *EventGroupPublicKeyGenerated) Reset() {
@@ -6443,6 +8988,13 @@ func (x *EventGroupPublicKeyGenerated) GetChainId() string {
 	return ""
 }
 
+func (x *EventGroupPublicKeyGenerated) GetValidatingEpochId() uint64 {
+	if x != nil {
+		return x.ValidatingEpochId
+	}
+	return 0
+}
+
 // EventGroupKeyValidated is emitted when group key validation completes successfully
 type EventGroupKeyValidated struct {
 	state         protoimpl.MessageState
@@ -6727,6 +9279,262 @@ func (x *EventThresholdSigningFailed) GetReason() string {
 	return ""
 }
 
+// EventDKGAttemptFailed is emitted for every DKG attempt that fails, whether or not the epoch retries
+type EventDKGAttemptFailed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// epoch_id identifies the DKG round
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// attempt is the attempt that failed
+	Attempt uint32 `protobuf:"varint,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// reason describes why the attempt failed
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// excluded_participants are the participants that did not perform in the attempt
+	ExcludedParticipants []string `protobuf:"bytes,4,rep,name=excluded_participants,json=excludedParticipants,proto3" json:"excluded_participants,omitempty"`
+	// remaining_participants is the number of participants that performed
+	RemainingParticipants uint32 `protobuf:"varint,5,opt,name=remaining_participants,json=remainingParticipants,proto3" json:"remaining_participants,omitempty"`
+	// retrying tells whether dealing restarts with the remaining participants
+	Retrying bool `protobuf:"varint,6,opt,name=retrying,proto3" json:"retrying,omitempty"`
+}
+
+func (x *EventDKGAttemptFailed) Reset() {
+	*x = EventDKGAttemptFailed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_bls_events_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventDKGAttemptFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDKGAttemptFailed) ProtoMessage() {}
+
+// Deprecated: Use EventDKGAttemptFailed.ProtoReflect.Descriptor instead.
+func (*EventDKGAttemptFailed) Descriptor() ([]byte, []int) {
+	return file_inference_bls_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EventDKGAttemptFailed) GetEpochId() uint64 {
+	if x != nil {
+		return x.EpochId
+	}
+	return 0
+}
+
+func (x *EventDKGAttemptFailed) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *EventDKGAttemptFailed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EventDKGAttemptFailed) GetExcludedParticipants() []string {
+	if x != nil {
+		return x.ExcludedParticipants
+	}
+	return nil
+}
+
+func (x *EventDKGAttemptFailed) GetRemainingParticipants() uint32 {
+	if x != nil {
+		return x.RemainingParticipants
+	}
+	return 0
+}
+
+func (x *EventDKGAttemptFailed) GetRetrying() bool {
+	if x != nil {
+		return x.Retrying
+	}
+	return false
+}
+
+// EventGroupPublicKeyCarriedOver is emitted when an epoch whose DKG failed falls back to an earlier epoch's key
+type EventGroupPublicKeyCarriedOver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// epoch_id identifies the epoch whose DKG failed
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// source_epoch_id is the epoch that generated the carried key
+	SourceEpochId uint64 `protobuf:"varint,2,opt,name=source_epoch_id,json=sourceEpochId,proto3" json:"source_epoch_id,omitempty"`
+	// group_public_key is the carried group public key (compressed G2 format, 96 bytes)
+	GroupPublicKey []byte `protobuf:"bytes,3,opt,name=group_public_key,json=groupPublicKey,proto3" json:"group_public_key,omitempty"`
+}
+
+func (x *EventGroupPublicKeyCarriedOver) Reset() {
+	*x = EventGroupPublicKeyCarriedOver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_bls_events_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventGroupPublicKeyCarriedOver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventGroupPublicKeyCarriedOver) ProtoMessage() {}
+
+// Deprecated: Use EventGroupPublicKeyCarriedOver.ProtoReflect.Descriptor instead.
+func (*EventGroupPublicKeyCarriedOver) Descriptor() ([]byte, []int) {
+	return file_inference_bls_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EventGroupPublicKeyCarriedOver) GetEpochId() uint64 {
+	if x != nil {
+		return x.EpochId
+	}
+	return 0
+}
+
+func (x *EventGroupPublicKeyCarriedOver) GetSourceEpochId() uint64 {
+	if x != nil {
+		return x.SourceEpochId
+	}
+	return 0
+}
+
+func (x *EventGroupPublicKeyCarriedOver) GetGroupPublicKey() []byte {
+	if x != nil {
+		return x.GroupPublicKey
+	}
+	return nil
+}
+
+// EventSchemaSignatureRequested is emitted when a threshold signature is requested under a signing schema
+type EventSchemaSignatureRequested struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id identifies the threshold signing request
+	RequestId []byte `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// schema_name identifies the signing schema
+	SchemaName string `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	// requester is the address that paid for the signature
+	Requester string `protobuf:"bytes,3,opt,name=requester,proto3" json:"requester,omitempty"`
+	// sequence is the sequence number of the schema signing request
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// retry is the retry number of this signing round (0 for the first round)
+	Retry uint32 `protobuf:"varint,5,opt,name=retry,proto3" json:"retry,omitempty"`
+}
+
+func (x *EventSchemaSignatureRequested) Reset() {
+	*x = EventSchemaSignatureRequested{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_bls_events_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventSchemaSignatureRequested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSchemaSignatureRequested) ProtoMessage() {}
+
+// Deprecated: Use EventSchemaSignatureRequested.ProtoReflect.Descriptor instead.
+func (*EventSchemaSignatureRequested) Descriptor() ([]byte, []int) {
+	return file_inference_bls_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EventSchemaSignatureRequested) GetRequestId() []byte {
+	if x != nil {
+		return x.RequestId
+	}
+	return nil
+}
+
+func (x *EventSchemaSignatureRequested) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *EventSchemaSignatureRequested) GetRequester() string {
+	if x != nil {
+		return x.Requester
+	}
+	return ""
+}
+
+func (x *EventSchemaSignatureRequested) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *EventSchemaSignatureRequested) GetRetry() uint32 {
+	if x != nil {
+		return x.Retry
+	}
+	return 0
+}
+
+// EventSigningSchemaUpdated is emitted when governance adds or replaces a signing schema
+type EventSigningSchemaUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// schema_name identifies the signing schema
+	SchemaName string `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	// enabled tells whether new requests are accepted
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *EventSigningSchemaUpdated) Reset() {
+	*x = EventSigningSchemaUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_bls_events_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventSigningSchemaUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSigningSchemaUpdated) ProtoMessage() {}
+
+// Deprecated: Use EventSigningSchemaUpdated.ProtoReflect.Descriptor instead.
+func (*EventSigningSchemaUpdated) Descriptor() ([]byte, []int) {
+	return file_inference_bls_events_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EventSigningSchemaUpdated) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *EventSigningSchemaUpdated) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
 var File_inference_bls_events_proto protoreflect.FileDescriptor
 
 var file_inference_bls_events_proto_rawDesc = []byte{
@@ -6738,7 +9546,7 @@ var file_inference_bls_events_proto_rawDesc = []byte{
 	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x19, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x62, 0x6c, 0x73, 0x2f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf, 0x01, 0x0a, 0x1b, 0x45,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe9, 0x01, 0x0a, 0x1b, 0x45,
 	0x76, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x70,
 	0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x70,
@@ -6751,118 +9559,168 @@ var file_inference_bls_events_proto_rawDesc = []byte{
 	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
 	0x65, 0x2e, 0x62, 0x6c, 0x73, 0x2e, 0x42, 0x4c, 0x53, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
 	0x70, 0x61, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x76, 0x0a, 0x18,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x61, 0x6c, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0e, 0x64, 0x65, 0x61, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x64, 0x65, 0x61, 0x6c, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x1a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x50, 0x68, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x43,
-	0x0a, 0x1e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x68, 0x61, 0x73,
-	0x65, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e,
-	0x67, 0x50, 0x68, 0x61, 0x73, 0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x12, 0x40, 0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x2e, 0x62, 0x6c, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x4c, 0x53,
-	0x44, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x44, 0x61, 0x74, 0x61, 0x22, 0x85, 0x01, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44,
-	0x4b, 0x47, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0a, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x6c, 0x73, 0x2e,
-	0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x4c, 0x53, 0x44, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x09, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x22, 0x88, 0x01,
-	0x0a, 0x20, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x22, 0x76, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44,
+	0x65, 0x61, 0x6c, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74,
 	0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x49, 0x0a,
-	0x13, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x1c, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x22,
-	0x0a, 0x0d, 0x69, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x6c, 0x6f,
-	0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x5f, 0x64, 0x65,
-	0x67, 0x72, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x53, 0x6c, 0x6f,
-	0x74, 0x73, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x6c, 0x73, 0x2e, 0x45, 0x70, 0x6f,
-	0x63, 0x68, 0x42, 0x4c, 0x53, 0x44, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x09, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x3f, 0x0a,
+	0x0e, 0x64, 0x65, 0x61, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x0d, 0x64, 0x65, 0x61, 0x6c, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd8,
+	0x01, 0x0a, 0x1a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e,
+	0x67, 0x50, 0x68, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x1e, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x1b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x40, 0x0a,
+	0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x6c,
+	0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x4c, 0x53, 0x44, 0x61, 0x74, 0x61, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x0e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x44, 0x4b, 0x47, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x40, 0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x62, 0x6c, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x4c, 0x53, 0x44, 0x61, 0x74, 0x61,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x61, 0x74,
+	0x61, 0x22, 0x88, 0x01, 0x0a, 0x20, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49,
+	0x64, 0x12, 0x49, 0x0a, 0x13, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xba, 0x02, 0x0a,
+	0x1c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x6c,
+	0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
+	0x73, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x74, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x12, 0x40, 0x0a, 0x0a,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x6c, 0x73,
+	0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x4c, 0x53, 0x44, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x09, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x16, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x59,
+	0x0a, 0x1d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12,
 	0x20, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49,
-	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x66, 0x69, 0x6e, 0x61,
-	0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x59, 0x0a, 0x1d, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6e,
-	0x65, 0x77, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xe3, 0x01, 0x0a, 0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
-	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x61, 0x64, 0x6c,
-	0x69, 0x6e, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x1e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69,
-	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a,
-	0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x61, 0x6c,
-	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x12, 0x2f, 0x0a, 0x13, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x70,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6c, 0x6f, 0x74,
-	0x73, 0x22, 0x7e, 0x0a, 0x1b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xe3, 0x01, 0x0a, 0x1e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x32, 0x0a, 0x15, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0xc3, 0x01, 0x0a, 0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x7e, 0x0a, 0x1b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x86, 0x02, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44,
+	0x4b, 0x47, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x15,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x16, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x22, 0x8d,
+	0x01, 0x0a, 0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x64, 0x4f, 0x76, 0x65,
+	0x72, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0xc9,
+	0x01, 0x0a, 0x1d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
 	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
-	0x28, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x42, 0x95, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x2e, 0x62, 0x6c, 0x73, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x2f, 0x62, 0x6c, 0x73, 0xa2, 0x02, 0x03, 0x49, 0x42, 0x58, 0xaa, 0x02, 0x0d, 0x49,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42, 0x6c, 0x73, 0xca, 0x02, 0x0d, 0x49,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x42, 0x6c, 0x73, 0xe2, 0x02, 0x19, 0x49,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x42, 0x6c, 0x73, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x49, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x42, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x36, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x22, 0x56, 0x0a, 0x19, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x42, 0x95, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x6c, 0x73, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x2f, 0x62, 0x6c, 0x73, 0xa2, 0x02, 0x03, 0x49, 0x42, 0x58, 0xaa, 0x02, 0x0d,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42, 0x6c, 0x73, 0xca, 0x02, 0x0d,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x42, 0x6c, 0x73, 0xe2, 0x02, 0x19,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x42, 0x6c, 0x73, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x49, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x42, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -6877,7 +9735,7 @@ func file_inference_bls_events_proto_rawDescGZIP() []byte {
 	return file_inference_bls_events_proto_rawDescData
 }
 
-var file_inference_bls_events_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_inference_bls_events_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_inference_bls_events_proto_goTypes = []interface{}{
 	(*EventKeyGenerationInitiated)(nil),      // 0: inference.bls.EventKeyGenerationInitiated
 	(*EventDealerPartSubmitted)(nil),         // 1: inference.bls.EventDealerPartSubmitted
@@ -6890,14 +9748,18 @@ var file_inference_bls_events_proto_goTypes = []interface{}{
 	(*EventThresholdSigningRequested)(nil),   // 8: inference.bls.EventThresholdSigningRequested
 	(*EventThresholdSigningCompleted)(nil),   // 9: inference.bls.EventThresholdSigningCompleted
 	(*EventThresholdSigningFailed)(nil),      // 10: inference.bls.EventThresholdSigningFailed
-	(*BLSParticipantInfo)(nil),               // 11: inference.bls.BLSParticipantInfo
-	(*EpochBLSData)(nil),                     // 12: inference.bls.EpochBLSData
+	(*EventDKGAttemptFailed)(nil),            // 11: inference.bls.EventDKGAttemptFailed
+	(*EventGroupPublicKeyCarriedOver)(nil),   // 12: inference.bls.EventGroupPublicKeyCarriedOver
+	(*EventSchemaSignatureRequested)(nil),    // 13: inference.bls.EventSchemaSignatureRequested
+	(*EventSigningSchemaUpdated)(nil),        // 14: inference.bls.EventSigningSchemaUpdated
+	(*BLSParticipantInfo)(nil),               // 15: inference.bls.BLSParticipantInfo
+	(*EpochBLSData)(nil),                     // 16: inference.bls.EpochBLSData
 }
 var file_inference_bls_events_proto_depIdxs = []int32{
-	11, // 0: inference.bls.EventKeyGenerationInitiated.participants:type_name -> inference.bls.BLSParticipantInfo
-	12, // 1: inference.bls.EventVerifyingPhaseStarted.epoch_data:type_name -> inference.bls.EpochBLSData
-	12, // 2: inference.bls.EventDKGFailed.epoch_data:type_name -> inference.bls.EpochBLSData
-	12, // 3: inference.bls.EventGroupPublicKeyGenerated.epoch_data:type_name -> inference.bls.EpochBLSData
+	15, // 0: inference.bls.EventKeyGenerationInitiated.participants:type_name -> inference.bls.BLSParticipantInfo
+	16, // 1: inference.bls.EventVerifyingPhaseStarted.epoch_data:type_name -> inference.bls.EpochBLSData
+	16, // 2: inference.bls.EventDKGFailed.epoch_data:type_name -> inference.bls.EpochBLSData
+	16, // 3: inference.bls.EventGroupPublicKeyGenerated.epoch_data:type_name -> inference.bls.EpochBLSData
 	4,  // [4:4] is the sub-list for method output_type
 	4,  // [4:4] is the sub-list for method input_type
 	4,  // [4:4] is the sub-list for extension type_name
@@ -7044,6 +9906,54 @@ func file_inference_bls_events_proto_init() {
 				return nil
 			}
 		}
+		file_inference_bls_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventDKGAttemptFailed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_bls_events_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventGroupPublicKeyCarriedOver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_bls_events_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSchemaSignatureRequested); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_bls_events_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSigningSchemaUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -7051,7 +9961,7 @@ func file_inference_bls_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_inference_bls_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
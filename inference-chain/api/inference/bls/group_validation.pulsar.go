@@ -832,6 +832,1460 @@ func (x *fastReflection_GroupKeyValidationState) ProtoMethods() *protoiface.Meth
 	}
 }
 
+var (
+	md_EpochKeyLink                                   protoreflect.MessageDescriptor
+	fd_EpochKeyLink_epoch_id                          protoreflect.FieldDescriptor
+	fd_EpochKeyLink_previous_epoch_id                 protoreflect.FieldDescriptor
+	fd_EpochKeyLink_group_public_key                  protoreflect.FieldDescriptor
+	fd_EpochKeyLink_group_public_key_uncompressed     protoreflect.FieldDescriptor
+	fd_EpochKeyLink_validation_signature              protoreflect.FieldDescriptor
+	fd_EpochKeyLink_validation_signature_uncompressed protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_bls_group_validation_proto_init()
+	md_EpochKeyLink = File_inference_bls_group_validation_proto.Messages().ByName("EpochKeyLink")
+	fd_EpochKeyLink_epoch_id = md_EpochKeyLink.Fields().ByName("epoch_id")
+	fd_EpochKeyLink_previous_epoch_id = md_EpochKeyLink.Fields().ByName("previous_epoch_id")
+	fd_EpochKeyLink_group_public_key = md_EpochKeyLink.Fields().ByName("group_public_key")
+	fd_EpochKeyLink_group_public_key_uncompressed = md_EpochKeyLink.Fields().ByName("group_public_key_uncompressed")
+	fd_EpochKeyLink_validation_signature = md_EpochKeyLink.Fields().ByName("validation_signature")
+	fd_EpochKeyLink_validation_signature_uncompressed = md_EpochKeyLink.Fields().ByName("validation_signature_uncompressed")
+}
+
+var _ protoreflect.Message = (*fastReflection_EpochKeyLink)(nil)
+
+type fastReflection_EpochKeyLink EpochKeyLink
+
+func (x *EpochKeyLink) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EpochKeyLink)(x)
+}
+
+func (x *EpochKeyLink) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_bls_group_validation_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EpochKeyLink_messageType fastReflection_EpochKeyLink_messageType
+var _ protoreflect.MessageType = fastReflection_EpochKeyLink_messageType{}
+
+type fastReflection_EpochKeyLink_messageType struct{}
+
+func (x fastReflection_EpochKeyLink_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EpochKeyLink)(nil)
+}
+func (x fastReflection_EpochKeyLink_messageType) New() protoreflect.Message {
+	return new(fastReflection_EpochKeyLink)
+}
+func (x fastReflection_EpochKeyLink_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochKeyLink
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EpochKeyLink) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochKeyLink
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EpochKeyLink) Type() protoreflect.MessageType {
+	return _fastReflection_EpochKeyLink_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EpochKeyLink) New() protoreflect.Message {
+	return new(fastReflection_EpochKeyLink)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EpochKeyLink) Interface() protoreflect.ProtoMessage {
+	return (*EpochKeyLink)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EpochKeyLink) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EpochId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochId)
+		if !f(fd_EpochKeyLink_epoch_id, value) {
+			return
+		}
+	}
+	if x.PreviousEpochId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PreviousEpochId)
+		if !f(fd_EpochKeyLink_previous_epoch_id, value) {
+			return
+		}
+	}
+	if len(x.GroupPublicKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.GroupPublicKey)
+		if !f(fd_EpochKeyLink_group_public_key, value) {
+			return
+		}
+	}
+	if len(x.GroupPublicKeyUncompressed) != 0 {
+		value := protoreflect.ValueOfBytes(x.GroupPublicKeyUncompressed)
+		if !f(fd_EpochKeyLink_group_public_key_uncompressed, value) {
+			return
+		}
+	}
+	if len(x.ValidationSignature) != 0 {
+		value := protoreflect.ValueOfBytes(x.ValidationSignature)
+		if !f(fd_EpochKeyLink_validation_signature, value) {
+			return
+		}
+	}
+	if len(x.ValidationSignatureUncompressed) != 0 {
+		value := protoreflect.ValueOfBytes(x.ValidationSignatureUncompressed)
+		if !f(fd_EpochKeyLink_validation_signature_uncompressed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EpochKeyLink) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.bls.EpochKeyLink.epoch_id":
+		return x.EpochId != uint64(0)
+	case "inference.bls.EpochKeyLink.previous_epoch_id":
+		return x.PreviousEpochId != uint64(0)
+	case "inference.bls.EpochKeyLink.group_public_key":
+		return len(x.GroupPublicKey) != 0
+	case "inference.bls.EpochKeyLink.group_public_key_uncompressed":
+		return len(x.GroupPublicKeyUncompressed) != 0
+	case "inference.bls.EpochKeyLink.validation_signature":
+		return len(x.ValidationSignature) != 0
+	case "inference.bls.EpochKeyLink.validation_signature_uncompressed":
+		return len(x.ValidationSignatureUncompressed) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochKeyLink"))
+		}
+		panic(fmt.Errorf("message inference.bls.EpochKeyLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochKeyLink) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.bls.EpochKeyLink.epoch_id":
+		x.EpochId = uint64(0)
+	case "inference.bls.EpochKeyLink.previous_epoch_id":
+		x.PreviousEpochId = uint64(0)
+	case "inference.bls.EpochKeyLink.group_public_key":
+		x.GroupPublicKey = nil
+	case "inference.bls.EpochKeyLink.group_public_key_uncompressed":
+		x.GroupPublicKeyUncompressed = nil
+	case "inference.bls.EpochKeyLink.validation_signature":
+		x.ValidationSignature = nil
+	case "inference.bls.EpochKeyLink.validation_signature_uncompressed":
+		x.ValidationSignatureUncompressed = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochKeyLink"))
+		}
+		panic(fmt.Errorf("message inference.bls.EpochKeyLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EpochKeyLink) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.bls.EpochKeyLink.epoch_id":
+		value := x.EpochId
+		return protoreflect.ValueOfUint64(value)
+	case "inference.bls.EpochKeyLink.previous_epoch_id":
+		value := x.PreviousEpochId
+		return protoreflect.ValueOfUint64(value)
+	case "inference.bls.EpochKeyLink.group_public_key":
+		value := x.GroupPublicKey
+		return protoreflect.ValueOfBytes(value)
+	case "inference.bls.EpochKeyLink.group_public_key_uncompressed":
+		value := x.GroupPublicKeyUncompressed
+		return protoreflect.ValueOfBytes(value)
+	case "inference.bls.EpochKeyLink.validation_signature":
+		value := x.ValidationSignature
+		return protoreflect.ValueOfBytes(value)
+	case "inference.bls.EpochKeyLink.validation_signature_uncompressed":
+		value := x.ValidationSignatureUncompressed
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochKeyLink"))
+		}
+		panic(fmt.Errorf("message inference.bls.EpochKeyLink does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochKeyLink) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.bls.EpochKeyLink.epoch_id":
+		x.EpochId = value.Uint()
+	case "inference.bls.EpochKeyLink.previous_epoch_id":
+		x.PreviousEpochId = value.Uint()
+	case "inference.bls.EpochKeyLink.group_public_key":
+		x.GroupPublicKey = value.Bytes()
+	case "inference.bls.EpochKeyLink.group_public_key_uncompressed":
+		x.GroupPublicKeyUncompressed = value.Bytes()
+	case "inference.bls.EpochKeyLink.validation_signature":
+		x.ValidationSignature = value.Bytes()
+	case "inference.bls.EpochKeyLink.validation_signature_uncompressed":
+		x.ValidationSignatureUncompressed = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochKeyLink"))
+		}
+		panic(fmt.Errorf("message inference.bls.EpochKeyLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochKeyLink) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.EpochKeyLink.epoch_id":
+		panic(fmt.Errorf("field epoch_id of message inference.bls.EpochKeyLink is not mutable"))
+	case "inference.bls.EpochKeyLink.previous_epoch_id":
+		panic(fmt.Errorf("field previous_epoch_id of message inference.bls.EpochKeyLink is not mutable"))
+	case "inference.bls.EpochKeyLink.group_public_key":
+		panic(fmt.Errorf("field group_public_key of message inference.bls.EpochKeyLink is not mutable"))
+	case "inference.bls.EpochKeyLink.group_public_key_uncompressed":
+		panic(fmt.Errorf("field group_public_key_uncompressed of message inference.bls.EpochKeyLink is not mutable"))
+	case "inference.bls.EpochKeyLink.validation_signature":
+		panic(fmt.Errorf("field validation_signature of message inference.bls.EpochKeyLink is not mutable"))
+	case "inference.bls.EpochKeyLink.validation_signature_uncompressed":
+		panic(fmt.Errorf("field validation_signature_uncompressed of message inference.bls.EpochKeyLink is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochKeyLink"))
+		}
+		panic(fmt.Errorf("message inference.bls.EpochKeyLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EpochKeyLink) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.EpochKeyLink.epoch_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.bls.EpochKeyLink.previous_epoch_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.bls.EpochKeyLink.group_public_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "inference.bls.EpochKeyLink.group_public_key_uncompressed":
+		return protoreflect.ValueOfBytes(nil)
+	case "inference.bls.EpochKeyLink.validation_signature":
+		return protoreflect.ValueOfBytes(nil)
+	case "inference.bls.EpochKeyLink.validation_signature_uncompressed":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochKeyLink"))
+		}
+		panic(fmt.Errorf("message inference.bls.EpochKeyLink does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EpochKeyLink) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.bls.EpochKeyLink", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EpochKeyLink) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochKeyLink) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EpochKeyLink) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EpochKeyLink) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EpochKeyLink)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EpochId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochId))
+		}
+		if x.PreviousEpochId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PreviousEpochId))
+		}
+		l = len(x.GroupPublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GroupPublicKeyUncompressed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidationSignature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidationSignatureUncompressed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EpochKeyLink)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ValidationSignatureUncompressed) > 0 {
+			i -= len(x.ValidationSignatureUncompressed)
+			copy(dAtA[i:], x.ValidationSignatureUncompressed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidationSignatureUncompressed)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.ValidationSignature) > 0 {
+			i -= len(x.ValidationSignature)
+			copy(dAtA[i:], x.ValidationSignature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidationSignature)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.GroupPublicKeyUncompressed) > 0 {
+			i -= len(x.GroupPublicKeyUncompressed)
+			copy(dAtA[i:], x.GroupPublicKeyUncompressed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupPublicKeyUncompressed)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.GroupPublicKey) > 0 {
+			i -= len(x.GroupPublicKey)
+			copy(dAtA[i:], x.GroupPublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupPublicKey)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.PreviousEpochId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousEpochId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.EpochId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EpochKeyLink)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochKeyLink: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochKeyLink: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+				}
+				x.EpochId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousEpochId", wireType)
+				}
+				x.PreviousEpochId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PreviousEpochId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPublicKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroupPublicKey = append(x.GroupPublicKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.GroupPublicKey == nil {
+					x.GroupPublicKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPublicKeyUncompressed", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroupPublicKeyUncompressed = append(x.GroupPublicKeyUncompressed[:0], dAtA[iNdEx:postIndex]...)
+				if x.GroupPublicKeyUncompressed == nil {
+					x.GroupPublicKeyUncompressed = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidationSignature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidationSignature = append(x.ValidationSignature[:0], dAtA[iNdEx:postIndex]...)
+				if x.ValidationSignature == nil {
+					x.ValidationSignature = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidationSignatureUncompressed", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidationSignatureUncompressed = append(x.ValidationSignatureUncompressed[:0], dAtA[iNdEx:postIndex]...)
+				if x.ValidationSignatureUncompressed == nil {
+					x.ValidationSignatureUncompressed = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EpochKeyChainProof_5_list)(nil)
+
+type _EpochKeyChainProof_5_list struct {
+	list *[]*EpochKeyLink
+}
+
+func (x *_EpochKeyChainProof_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EpochKeyChainProof_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EpochKeyChainProof_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochKeyLink)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EpochKeyChainProof_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochKeyLink)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EpochKeyChainProof_5_list) AppendMutable() protoreflect.Value {
+	v := new(EpochKeyLink)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EpochKeyChainProof_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EpochKeyChainProof_5_list) NewElement() protoreflect.Value {
+	v := new(EpochKeyLink)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EpochKeyChainProof_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EpochKeyChainProof                          protoreflect.MessageDescriptor
+	fd_EpochKeyChainProof_chain_id                 protoreflect.FieldDescriptor
+	fd_EpochKeyChainProof_chain_id_hash            protoreflect.FieldDescriptor
+	fd_EpochKeyChainProof_trusted_epoch_id         protoreflect.FieldDescriptor
+	fd_EpochKeyChainProof_trusted_group_public_key protoreflect.FieldDescriptor
+	fd_EpochKeyChainProof_links                    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_bls_group_validation_proto_init()
+	md_EpochKeyChainProof = File_inference_bls_group_validation_proto.Messages().ByName("EpochKeyChainProof")
+	fd_EpochKeyChainProof_chain_id = md_EpochKeyChainProof.Fields().ByName("chain_id")
+	fd_EpochKeyChainProof_chain_id_hash = md_EpochKeyChainProof.Fields().ByName("chain_id_hash")
+	fd_EpochKeyChainProof_trusted_epoch_id = md_EpochKeyChainProof.Fields().ByName("trusted_epoch_id")
+	fd_EpochKeyChainProof_trusted_group_public_key = md_EpochKeyChainProof.Fields().ByName("trusted_group_public_key")
+	fd_EpochKeyChainProof_links = md_EpochKeyChainProof.Fields().ByName("links")
+}
+
+var _ protoreflect.Message = (*fastReflection_EpochKeyChainProof)(nil)
+
+type fastReflection_EpochKeyChainProof EpochKeyChainProof
+
+func (x *EpochKeyChainProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EpochKeyChainProof)(x)
+}
+
+func (x *EpochKeyChainProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_bls_group_validation_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EpochKeyChainProof_messageType fastReflection_EpochKeyChainProof_messageType
+var _ protoreflect.MessageType = fastReflection_EpochKeyChainProof_messageType{}
+
+type fastReflection_EpochKeyChainProof_messageType struct{}
+
+func (x fastReflection_EpochKeyChainProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EpochKeyChainProof)(nil)
+}
+func (x fastReflection_EpochKeyChainProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_EpochKeyChainProof)
+}
+func (x fastReflection_EpochKeyChainProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochKeyChainProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EpochKeyChainProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochKeyChainProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EpochKeyChainProof) Type() protoreflect.MessageType {
+	return _fastReflection_EpochKeyChainProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EpochKeyChainProof) New() protoreflect.Message {
+	return new(fastReflection_EpochKeyChainProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EpochKeyChainProof) Interface() protoreflect.ProtoMessage {
+	return (*EpochKeyChainProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EpochKeyChainProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_EpochKeyChainProof_chain_id, value) {
+			return
+		}
+	}
+	if len(x.ChainIdHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.ChainIdHash)
+		if !f(fd_EpochKeyChainProof_chain_id_hash, value) {
+			return
+		}
+	}
+	if x.TrustedEpochId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TrustedEpochId)
+		if !f(fd_EpochKeyChainProof_trusted_epoch_id, value) {
+			return
+		}
+	}
+	if len(x.TrustedGroupPublicKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.TrustedGroupPublicKey)
+		if !f(fd_EpochKeyChainProof_trusted_group_public_key, value) {
+			return
+		}
+	}
+	if len(x.Links) != 0 {
+		value := protoreflect.ValueOfList(&_EpochKeyChainProof_5_list{list: &x.Links})
+		if !f(fd_EpochKeyChainProof_links, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EpochKeyChainProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.bls.EpochKeyChainProof.chain_id":
+		return x.ChainId != ""
+	case "inference.bls.EpochKeyChainProof.chain_id_hash":
+		return len(x.ChainIdHash) != 0
+	case "inference.bls.EpochKeyChainProof.trusted_epoch_id":
+		return x.TrustedEpochId != uint64(0)
+	case "inference.bls.EpochKeyChainProof.trusted_group_public_key":
+		return len(x.TrustedGroupPublicKey) != 0
+	case "inference.bls.EpochKeyChainProof.links":
+		return len(x.Links) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochKeyChainProof"))
+		}
+		panic(fmt.Errorf("message inference.bls.EpochKeyChainProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochKeyChainProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.bls.EpochKeyChainProof.chain_id":
+		x.ChainId = ""
+	case "inference.bls.EpochKeyChainProof.chain_id_hash":
+		x.ChainIdHash = nil
+	case "inference.bls.EpochKeyChainProof.trusted_epoch_id":
+		x.TrustedEpochId = uint64(0)
+	case "inference.bls.EpochKeyChainProof.trusted_group_public_key":
+		x.TrustedGroupPublicKey = nil
+	case "inference.bls.EpochKeyChainProof.links":
+		x.Links = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochKeyChainProof"))
+		}
+		panic(fmt.Errorf("message inference.bls.EpochKeyChainProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EpochKeyChainProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.bls.EpochKeyChainProof.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "inference.bls.EpochKeyChainProof.chain_id_hash":
+		value := x.ChainIdHash
+		return protoreflect.ValueOfBytes(value)
+	case "inference.bls.EpochKeyChainProof.trusted_epoch_id":
+		value := x.TrustedEpochId
+		return protoreflect.ValueOfUint64(value)
+	case "inference.bls.EpochKeyChainProof.trusted_group_public_key":
+		value := x.TrustedGroupPublicKey
+		return protoreflect.ValueOfBytes(value)
+	case "inference.bls.EpochKeyChainProof.links":
+		if len(x.Links) == 0 {
+			return protoreflect.ValueOfList(&_EpochKeyChainProof_5_list{})
+		}
+		listValue := &_EpochKeyChainProof_5_list{list: &x.Links}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochKeyChainProof"))
+		}
+		panic(fmt.Errorf("message inference.bls.EpochKeyChainProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochKeyChainProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.bls.EpochKeyChainProof.chain_id":
+		x.ChainId = value.Interface().(string)
+	case "inference.bls.EpochKeyChainProof.chain_id_hash":
+		x.ChainIdHash = value.Bytes()
+	case "inference.bls.EpochKeyChainProof.trusted_epoch_id":
+		x.TrustedEpochId = value.Uint()
+	case "inference.bls.EpochKeyChainProof.trusted_group_public_key":
+		x.TrustedGroupPublicKey = value.Bytes()
+	case "inference.bls.EpochKeyChainProof.links":
+		lv := value.List()
+		clv := lv.(*_EpochKeyChainProof_5_list)
+		x.Links = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochKeyChainProof"))
+		}
+		panic(fmt.Errorf("message inference.bls.EpochKeyChainProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochKeyChainProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.EpochKeyChainProof.links":
+		if x.Links == nil {
+			x.Links = []*EpochKeyLink{}
+		}
+		value := &_EpochKeyChainProof_5_list{list: &x.Links}
+		return protoreflect.ValueOfList(value)
+	case "inference.bls.EpochKeyChainProof.chain_id":
+		panic(fmt.Errorf("field chain_id of message inference.bls.EpochKeyChainProof is not mutable"))
+	case "inference.bls.EpochKeyChainProof.chain_id_hash":
+		panic(fmt.Errorf("field chain_id_hash of message inference.bls.EpochKeyChainProof is not mutable"))
+	case "inference.bls.EpochKeyChainProof.trusted_epoch_id":
+		panic(fmt.Errorf("field trusted_epoch_id of message inference.bls.EpochKeyChainProof is not mutable"))
+	case "inference.bls.EpochKeyChainProof.trusted_group_public_key":
+		panic(fmt.Errorf("field trusted_group_public_key of message inference.bls.EpochKeyChainProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochKeyChainProof"))
+		}
+		panic(fmt.Errorf("message inference.bls.EpochKeyChainProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EpochKeyChainProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.EpochKeyChainProof.chain_id":
+		return protoreflect.ValueOfString("")
+	case "inference.bls.EpochKeyChainProof.chain_id_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "inference.bls.EpochKeyChainProof.trusted_epoch_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.bls.EpochKeyChainProof.trusted_group_public_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "inference.bls.EpochKeyChainProof.links":
+		list := []*EpochKeyLink{}
+		return protoreflect.ValueOfList(&_EpochKeyChainProof_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochKeyChainProof"))
+		}
+		panic(fmt.Errorf("message inference.bls.EpochKeyChainProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EpochKeyChainProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.bls.EpochKeyChainProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EpochKeyChainProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochKeyChainProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EpochKeyChainProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EpochKeyChainProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EpochKeyChainProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChainIdHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TrustedEpochId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TrustedEpochId))
+		}
+		l = len(x.TrustedGroupPublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Links) > 0 {
+			for _, e := range x.Links {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EpochKeyChainProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Links) > 0 {
+			for iNdEx := len(x.Links) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Links[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.TrustedGroupPublicKey) > 0 {
+			i -= len(x.TrustedGroupPublicKey)
+			copy(dAtA[i:], x.TrustedGroupPublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TrustedGroupPublicKey)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.TrustedEpochId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TrustedEpochId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ChainIdHash) > 0 {
+			i -= len(x.ChainIdHash)
+			copy(dAtA[i:], x.ChainIdHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainIdHash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EpochKeyChainProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochKeyChainProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochKeyChainProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainIdHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainIdHash = append(x.ChainIdHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.ChainIdHash == nil {
+					x.ChainIdHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrustedEpochId", wireType)
+				}
+				x.TrustedEpochId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TrustedEpochId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrustedGroupPublicKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TrustedGroupPublicKey = append(x.TrustedGroupPublicKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.TrustedGroupPublicKey == nil {
+					x.TrustedGroupPublicKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Links = append(x.Links, &EpochKeyLink{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Links[len(x.Links)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -989,6 +2443,163 @@ func (x *GroupKeyValidationState) GetSlotsCovered() uint32 {
 	return 0
 }
 
+// EpochKeyLink carries the group public key of an epoch together with the signature of the previous
+// epoch's group over it. Uncompressed encodings are in the EIP-2537 layout for contract verifiers.
+type EpochKeyLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// epoch_id is the epoch whose group public key this link proves
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// previous_epoch_id is the epoch whose group signed the key
+	PreviousEpochId uint64 `protobuf:"varint,2,opt,name=previous_epoch_id,json=previousEpochId,proto3" json:"previous_epoch_id,omitempty"`
+	// group_public_key is the compressed G2 group public key (96 bytes)
+	GroupPublicKey []byte `protobuf:"bytes,3,opt,name=group_public_key,json=groupPublicKey,proto3" json:"group_public_key,omitempty"`
+	// group_public_key_uncompressed is X.c0, X.c1, Y.c0, Y.c1 as 64-byte big-endian limbs (256 bytes)
+	GroupPublicKeyUncompressed []byte `protobuf:"bytes,4,opt,name=group_public_key_uncompressed,json=groupPublicKeyUncompressed,proto3" json:"group_public_key_uncompressed,omitempty"`
+	// validation_signature is the compressed G1 signature of the previous epoch's group (48 bytes) over
+	// keccak256(previous_epoch_id [8] || chain_id_hash [32] || group_public_key_uncompressed [256])
+	ValidationSignature []byte `protobuf:"bytes,5,opt,name=validation_signature,json=validationSignature,proto3" json:"validation_signature,omitempty"`
+	// validation_signature_uncompressed is X, Y as 64-byte big-endian limbs (128 bytes)
+	ValidationSignatureUncompressed []byte `protobuf:"bytes,6,opt,name=validation_signature_uncompressed,json=validationSignatureUncompressed,proto3" json:"validation_signature_uncompressed,omitempty"`
+}
+
+func (x *EpochKeyLink) Reset() {
+	*x = EpochKeyLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_bls_group_validation_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochKeyLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochKeyLink) ProtoMessage() {}
+
+// Deprecated: Use EpochKeyLink.ProtoReflect.Descriptor instead.
+func (*EpochKeyLink) Descriptor() ([]byte, []int) {
+	return file_inference_bls_group_validation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EpochKeyLink) GetEpochId() uint64 {
+	if x != nil {
+		return x.EpochId
+	}
+	return 0
+}
+
+func (x *EpochKeyLink) GetPreviousEpochId() uint64 {
+	if x != nil {
+		return x.PreviousEpochId
+	}
+	return 0
+}
+
+func (x *EpochKeyLink) GetGroupPublicKey() []byte {
+	if x != nil {
+		return x.GroupPublicKey
+	}
+	return nil
+}
+
+func (x *EpochKeyLink) GetGroupPublicKeyUncompressed() []byte {
+	if x != nil {
+		return x.GroupPublicKeyUncompressed
+	}
+	return nil
+}
+
+func (x *EpochKeyLink) GetValidationSignature() []byte {
+	if x != nil {
+		return x.ValidationSignature
+	}
+	return nil
+}
+
+func (x *EpochKeyLink) GetValidationSignatureUncompressed() []byte {
+	if x != nil {
+		return x.ValidationSignatureUncompressed
+	}
+	return nil
+}
+
+// EpochKeyChainProof links the group public key of a trusted epoch to the keys of the epochs after it
+type EpochKeyChainProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// chain_id is the chain the keys belong to
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// chain_id_hash is sha256(chain_id), the bytes32 chain identifier included in signed messages
+	ChainIdHash []byte `protobuf:"bytes,2,opt,name=chain_id_hash,json=chainIdHash,proto3" json:"chain_id_hash,omitempty"`
+	// trusted_epoch_id is the epoch the proof starts from
+	TrustedEpochId uint64 `protobuf:"varint,3,opt,name=trusted_epoch_id,json=trustedEpochId,proto3" json:"trusted_epoch_id,omitempty"`
+	// trusted_group_public_key is the compressed group public key of the trusted epoch
+	TrustedGroupPublicKey []byte `protobuf:"bytes,4,opt,name=trusted_group_public_key,json=trustedGroupPublicKey,proto3" json:"trusted_group_public_key,omitempty"`
+	// links are the following epochs in order, each signed by the group of the epoch before it
+	Links []*EpochKeyLink `protobuf:"bytes,5,rep,name=links,proto3" json:"links,omitempty"`
+}
+
+func (x *EpochKeyChainProof) Reset() {
+	*x = EpochKeyChainProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_bls_group_validation_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochKeyChainProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochKeyChainProof) ProtoMessage() {}
+
+// Deprecated: Use EpochKeyChainProof.ProtoReflect.Descriptor instead.
+func (*EpochKeyChainProof) Descriptor() ([]byte, []int) {
+	return file_inference_bls_group_validation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EpochKeyChainProof) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *EpochKeyChainProof) GetChainIdHash() []byte {
+	if x != nil {
+		return x.ChainIdHash
+	}
+	return nil
+}
+
+func (x *EpochKeyChainProof) GetTrustedEpochId() uint64 {
+	if x != nil {
+		return x.TrustedEpochId
+	}
+	return 0
+}
+
+func (x *EpochKeyChainProof) GetTrustedGroupPublicKey() []byte {
+	if x != nil {
+		return x.TrustedGroupPublicKey
+	}
+	return nil
+}
+
+func (x *EpochKeyChainProof) GetLinks() []*EpochKeyLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
 var File_inference_bls_group_validation_proto protoreflect.FileDescriptor
 
 var file_inference_bls_group_validation_proto_rawDesc = []byte{
@@ -1022,29 +2633,64 @@ var file_inference_bls_group_validation_proto_rawDesc = []byte{
 	0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6c, 0x6f, 0x74, 0x73,
 	0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
-	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x2a, 0xaf, 0x01, 0x0a,
-	0x18, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x31, 0x47, 0x52, 0x4f,
-	0x55, 0x50, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54,
-	0x49, 0x4e, 0x47, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x53, 0x10, 0x00,
-	0x12, 0x29, 0x0a, 0x25, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x56, 0x41,
-	0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x31, 0x0a, 0x2d, 0x47,
-	0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x42, 0x9e,
-	0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x2e, 0x62, 0x6c, 0x73, 0x42, 0x14, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x62, 0x6c, 0x73, 0xa2, 0x02, 0x03, 0x49,
-	0x42, 0x58, 0xaa, 0x02, 0x0d, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42,
-	0x6c, 0x73, 0xca, 0x02, 0x0d, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x42,
-	0x6c, 0x73, 0xe2, 0x02, 0x19, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x42,
-	0x6c, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x0e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x42, 0x6c, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x22, 0xc1, 0x02, 0x0a,
+	0x0c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x41,
+	0x0a, 0x1d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
+	0x65, 0x79, 0x5f, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x55, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x12, 0x31, 0x0a, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x4a, 0x0a, 0x21, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x75, 0x6e, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x1f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x55, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x22, 0xef, 0x01, 0x0a, 0x12, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64,
+	0x12, 0x37, 0x0a, 0x18, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x15, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x6c, 0x69, 0x6e,
+	0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x6c, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4b, 0x65,
+	0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6e,
+	0x6b, 0x73, 0x2a, 0xaf, 0x01, 0x0a, 0x18, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x35, 0x0a, 0x31, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
+	0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54,
+	0x55, 0x52, 0x45, 0x53, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f,
+	0x4b, 0x45, 0x59, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x31, 0x0a, 0x2d, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x02, 0x42, 0x9e, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x6c, 0x73, 0x42, 0x14, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x62,
+	0x6c, 0x73, 0xa2, 0x02, 0x03, 0x49, 0x42, 0x58, 0xaa, 0x02, 0x0d, 0x49, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42, 0x6c, 0x73, 0xca, 0x02, 0x0d, 0x49, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5c, 0x42, 0x6c, 0x73, 0xe2, 0x02, 0x19, 0x49, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5c, 0x42, 0x6c, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x3a, 0x3a, 0x42, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1060,20 +2706,23 @@ func file_inference_bls_group_validation_proto_rawDescGZIP() []byte {
 }
 
 var file_inference_bls_group_validation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inference_bls_group_validation_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_inference_bls_group_validation_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_inference_bls_group_validation_proto_goTypes = []interface{}{
 	(GroupKeyValidationStatus)(0),   // 0: inference.bls.GroupKeyValidationStatus
 	(*GroupKeyValidationState)(nil), // 1: inference.bls.GroupKeyValidationState
-	(*PartialSignature)(nil),        // 2: inference.bls.PartialSignature
+	(*EpochKeyLink)(nil),            // 2: inference.bls.EpochKeyLink
+	(*EpochKeyChainProof)(nil),      // 3: inference.bls.EpochKeyChainProof
+	(*PartialSignature)(nil),        // 4: inference.bls.PartialSignature
 }
 var file_inference_bls_group_validation_proto_depIdxs = []int32{
 	0, // 0: inference.bls.GroupKeyValidationState.status:type_name -> inference.bls.GroupKeyValidationStatus
-	2, // 1: inference.bls.GroupKeyValidationState.partial_signatures:type_name -> inference.bls.PartialSignature
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 1: inference.bls.GroupKeyValidationState.partial_signatures:type_name -> inference.bls.PartialSignature
+	2, // 2: inference.bls.EpochKeyChainProof.links:type_name -> inference.bls.EpochKeyLink
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_inference_bls_group_validation_proto_init() }
@@ -1095,6 +2744,30 @@ func file_inference_bls_group_validation_proto_init() {
 				return nil
 			}
 		}
+		file_inference_bls_group_validation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochKeyLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_bls_group_validation_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochKeyChainProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1102,7 +2775,7 @@ func file_inference_bls_group_validation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_inference_bls_group_validation_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,6 +22,8 @@ var (
 	fd_Params_dealing_phase_duration_blocks      protoreflect.FieldDescriptor
 	fd_Params_verification_phase_duration_blocks protoreflect.FieldDescriptor
 	fd_Params_signing_deadline_blocks            protoreflect.FieldDescriptor
+	fd_Params_max_dkg_retries                    protoreflect.FieldDescriptor
+	fd_Params_dkg_retry_min_weight_percent       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -32,6 +34,8 @@ func init() {
 	fd_Params_dealing_phase_duration_blocks = md_Params.Fields().ByName("dealing_phase_duration_blocks")
 	fd_Params_verification_phase_duration_blocks = md_Params.Fields().ByName("verification_phase_duration_blocks")
 	fd_Params_signing_deadline_blocks = md_Params.Fields().ByName("signing_deadline_blocks")
+	fd_Params_max_dkg_retries = md_Params.Fields().ByName("max_dkg_retries")
+	fd_Params_dkg_retry_min_weight_percent = md_Params.Fields().ByName("dkg_retry_min_weight_percent")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -129,6 +133,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxDkgRetries != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxDkgRetries)
+		if !f(fd_Params_max_dkg_retries, value) {
+			return
+		}
+	}
+	if x.DkgRetryMinWeightPercent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DkgRetryMinWeightPercent)
+		if !f(fd_Params_dkg_retry_min_weight_percent, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -154,6 +170,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.VerificationPhaseDurationBlocks != int64(0)
 	case "inference.bls.Params.signing_deadline_blocks":
 		return x.SigningDeadlineBlocks != int64(0)
+	case "inference.bls.Params.max_dkg_retries":
+		return x.MaxDkgRetries != uint32(0)
+	case "inference.bls.Params.dkg_retry_min_weight_percent":
+		return x.DkgRetryMinWeightPercent != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.Params"))
@@ -180,6 +200,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.VerificationPhaseDurationBlocks = int64(0)
 	case "inference.bls.Params.signing_deadline_blocks":
 		x.SigningDeadlineBlocks = int64(0)
+	case "inference.bls.Params.max_dkg_retries":
+		x.MaxDkgRetries = uint32(0)
+	case "inference.bls.Params.dkg_retry_min_weight_percent":
+		x.DkgRetryMinWeightPercent = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.Params"))
@@ -211,6 +235,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "inference.bls.Params.signing_deadline_blocks":
 		value := x.SigningDeadlineBlocks
 		return protoreflect.ValueOfInt64(value)
+	case "inference.bls.Params.max_dkg_retries":
+		value := x.MaxDkgRetries
+		return protoreflect.ValueOfUint32(value)
+	case "inference.bls.Params.dkg_retry_min_weight_percent":
+		value := x.DkgRetryMinWeightPercent
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.Params"))
@@ -241,6 +271,10 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.VerificationPhaseDurationBlocks = value.Int()
 	case "inference.bls.Params.signing_deadline_blocks":
 		x.SigningDeadlineBlocks = value.Int()
+	case "inference.bls.Params.max_dkg_retries":
+		x.MaxDkgRetries = uint32(value.Uint())
+	case "inference.bls.Params.dkg_retry_min_weight_percent":
+		x.DkgRetryMinWeightPercent = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.Params"))
@@ -271,6 +305,10 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field verification_phase_duration_blocks of message inference.bls.Params is not mutable"))
 	case "inference.bls.Params.signing_deadline_blocks":
 		panic(fmt.Errorf("field signing_deadline_blocks of message inference.bls.Params is not mutable"))
+	case "inference.bls.Params.max_dkg_retries":
+		panic(fmt.Errorf("field max_dkg_retries of message inference.bls.Params is not mutable"))
+	case "inference.bls.Params.dkg_retry_min_weight_percent":
+		panic(fmt.Errorf("field dkg_retry_min_weight_percent of message inference.bls.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.Params"))
@@ -294,6 +332,10 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfInt64(int64(0))
 	case "inference.bls.Params.signing_deadline_blocks":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.bls.Params.max_dkg_retries":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "inference.bls.Params.dkg_retry_min_weight_percent":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.Params"))
@@ -378,6 +420,12 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.SigningDeadlineBlocks != 0 {
 			n += 1 + runtime.Sov(uint64(x.SigningDeadlineBlocks))
 		}
+		if x.MaxDkgRetries != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxDkgRetries))
+		}
+		if x.DkgRetryMinWeightPercent != 0 {
+			n += 1 + runtime.Sov(uint64(x.DkgRetryMinWeightPercent))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -407,6 +455,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DkgRetryMinWeightPercent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgRetryMinWeightPercent))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.MaxDkgRetries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxDkgRetries))
+			i--
+			dAtA[i] = 0x30
+		}
 		if x.SigningDeadlineBlocks != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigningDeadlineBlocks))
 			i--
@@ -576,6 +634,44 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDkgRetries", wireType)
+				}
+				x.MaxDkgRetries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxDkgRetries |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgRetryMinWeightPercent", wireType)
+				}
+				x.DkgRetryMinWeightPercent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DkgRetryMinWeightPercent |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1306,6 +1402,10 @@ type Params struct {
 	VerificationPhaseDurationBlocks int64 `protobuf:"varint,4,opt,name=verification_phase_duration_blocks,json=verificationPhaseDurationBlocks,proto3" json:"verification_phase_duration_blocks,omitempty"`
 	// Duration in blocks for threshold signing deadline (e.g., 10 blocks for PoC)
 	SigningDeadlineBlocks int64 `protobuf:"varint,5,opt,name=signing_deadline_blocks,json=signingDeadlineBlocks,proto3" json:"signing_deadline_blocks,omitempty"`
+	// Number of times a failed DKG restarts dealing without the participants that did not perform (e.g., 2)
+	MaxDkgRetries uint32 `protobuf:"varint,6,opt,name=max_dkg_retries,json=maxDkgRetries,proto3" json:"max_dkg_retries,omitempty"`
+	// Minimum share of the original participant weight, in percent, the remaining participants must hold for a retry (e.g., 34)
+	DkgRetryMinWeightPercent uint32 `protobuf:"varint,7,opt,name=dkg_retry_min_weight_percent,json=dkgRetryMinWeightPercent,proto3" json:"dkg_retry_min_weight_percent,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1363,6 +1463,20 @@ func (x *Params) GetSigningDeadlineBlocks() int64 {
 	return 0
 }
 
+func (x *Params) GetMaxDkgRetries() uint32 {
+	if x != nil {
+		return x.MaxDkgRetries
+	}
+	return 0
+}
+
+func (x *Params) GetDkgRetryMinWeightPercent() uint32 {
+	if x != nil {
+		return x.DkgRetryMinWeightPercent
+	}
+	return 0
+}
+
 // PartialSignature represents a partial signature from a single participant in threshold signing
 type PartialSignature struct {
 	state         protoimpl.MessageState
@@ -1428,7 +1542,7 @@ var file_inference_bls_params_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xc8, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x5f,
+	0xb0, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x5f,
 	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0d, 0x52, 0x0b, 0x69, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x31,
 	0x0a, 0x15, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65,
@@ -1446,29 +1560,36 @@ var file_inference_bls_params_proto_rawDesc = []byte{
 	0x73, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x61,
 	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01,
 	0x28, 0x03, 0x52, 0x15, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x61, 0x64, 0x6c,
-	0x69, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x3a, 0x1f, 0xe8, 0xa0, 0x1f, 0x01, 0x8a,
-	0xe7, 0xb0, 0x2a, 0x16, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x78, 0x2f,
-	0x62, 0x6c, 0x73, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
-	0x49, 0x0a, 0x13, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6c,
-	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d,
-	0x52, 0x0b, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x95, 0x01, 0x0a, 0x11,
-	0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x6c,
-	0x73, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x62, 0x6c, 0x73,
-	0xa2, 0x02, 0x03, 0x49, 0x42, 0x58, 0xaa, 0x02, 0x0d, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x2e, 0x42, 0x6c, 0x73, 0xca, 0x02, 0x0d, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x5c, 0x42, 0x6c, 0x73, 0xe2, 0x02, 0x19, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x5c, 0x42, 0x6c, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x0e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a,
-	0x42, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78,
+	0x5f, 0x64, 0x6b, 0x67, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x44, 0x6b, 0x67, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x3e, 0x0a, 0x1c, 0x64, 0x6b, 0x67, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6d,
+	0x69, 0x6e, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x64, 0x6b, 0x67, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x4d, 0x69, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x3a, 0x1f, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x78, 0x2f, 0x62, 0x6c, 0x73, 0x2f, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x49, 0x0a, 0x13, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x6e,
+	0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x42, 0x95, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x6c, 0x73, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2f, 0x62, 0x6c, 0x73, 0xa2, 0x02, 0x03, 0x49, 0x42, 0x58, 0xaa, 0x02,
+	0x0d, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42, 0x6c, 0x73, 0xca, 0x02,
+	0x0d, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x42, 0x6c, 0x73, 0xe2, 0x02,
+	0x19, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x42, 0x6c, 0x73, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x42, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -19,10 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName         = "/inference.bls.Query/Params"
-	Query_EpochBLSData_FullMethodName   = "/inference.bls.Query/EpochBLSData"
-	Query_SigningStatus_FullMethodName  = "/inference.bls.Query/SigningStatus"
-	Query_SigningHistory_FullMethodName = "/inference.bls.Query/SigningHistory"
+	Query_Params_FullMethodName             = "/inference.bls.Query/Params"
+	Query_EpochBLSData_FullMethodName       = "/inference.bls.Query/EpochBLSData"
+	Query_SigningStatus_FullMethodName      = "/inference.bls.Query/SigningStatus"
+	Query_SigningHistory_FullMethodName     = "/inference.bls.Query/SigningHistory"
+	Query_EpochKeyChainProof_FullMethodName = "/inference.bls.Query/EpochKeyChainProof"
+	Query_SigningSchemas_FullMethodName     = "/inference.bls.Query/SigningSchemas"
+	Query_SchemaSignatures_FullMethodName   = "/inference.bls.Query/SchemaSignatures"
 )
 
 // QueryClient is the client API for Query service.
@@ -37,6 +40,12 @@ type QueryClient interface {
 	SigningStatus(ctx context.Context, in *QuerySigningStatusRequest, opts ...grpc.CallOption) (*QuerySigningStatusResponse, error)
 	// SigningHistory queries threshold signing requests with filtering and pagination
 	SigningHistory(ctx context.Context, in *QuerySigningHistoryRequest, opts ...grpc.CallOption) (*QuerySigningHistoryResponse, error)
+	// EpochKeyChainProof queries the chain of validated group keys from a trusted epoch
+	EpochKeyChainProof(ctx context.Context, in *QueryEpochKeyChainProofRequest, opts ...grpc.CallOption) (*QueryEpochKeyChainProofResponse, error)
+	// SigningSchemas queries the signing schemas whitelisted by governance
+	SigningSchemas(ctx context.Context, in *QuerySigningSchemasRequest, opts ...grpc.CallOption) (*QuerySigningSchemasResponse, error)
+	// SchemaSignatures queries the completed signatures of a signing schema with pagination
+	SchemaSignatures(ctx context.Context, in *QuerySchemaSignaturesRequest, opts ...grpc.CallOption) (*QuerySchemaSignaturesResponse, error)
 }
 
 type queryClient struct {
@@ -83,6 +92,33 @@ func (c *queryClient) SigningHistory(ctx context.Context, in *QuerySigningHistor
 	return out, nil
 }
 
+func (c *queryClient) EpochKeyChainProof(ctx context.Context, in *QueryEpochKeyChainProofRequest, opts ...grpc.CallOption) (*QueryEpochKeyChainProofResponse, error) {
+	out := new(QueryEpochKeyChainProofResponse)
+	err := c.cc.Invoke(ctx, Query_EpochKeyChainProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningSchemas(ctx context.Context, in *QuerySigningSchemasRequest, opts ...grpc.CallOption) (*QuerySigningSchemasResponse, error) {
+	out := new(QuerySigningSchemasResponse)
+	err := c.cc.Invoke(ctx, Query_SigningSchemas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SchemaSignatures(ctx context.Context, in *QuerySchemaSignaturesRequest, opts ...grpc.CallOption) (*QuerySchemaSignaturesResponse, error) {
+	out := new(QuerySchemaSignaturesResponse)
+	err := c.cc.Invoke(ctx, Query_SchemaSignatures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -95,6 +131,12 @@ type QueryServer interface {
 	SigningStatus(context.Context, *QuerySigningStatusRequest) (*QuerySigningStatusResponse, error)
 	// SigningHistory queries threshold signing requests with filtering and pagination
 	SigningHistory(context.Context, *QuerySigningHistoryRequest) (*QuerySigningHistoryResponse, error)
+	// EpochKeyChainProof queries the chain of validated group keys from a trusted epoch
+	EpochKeyChainProof(context.Context, *QueryEpochKeyChainProofRequest) (*QueryEpochKeyChainProofResponse, error)
+	// SigningSchemas queries the signing schemas whitelisted by governance
+	SigningSchemas(context.Context, *QuerySigningSchemasRequest) (*QuerySigningSchemasResponse, error)
+	// SchemaSignatures queries the completed signatures of a signing schema with pagination
+	SchemaSignatures(context.Context, *QuerySchemaSignaturesRequest) (*QuerySchemaSignaturesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -114,6 +156,15 @@ func (UnimplementedQueryServer) SigningStatus(context.Context, *QuerySigningStat
 func (UnimplementedQueryServer) SigningHistory(context.Context, *QuerySigningHistoryRequest) (*QuerySigningHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningHistory not implemented")
 }
+func (UnimplementedQueryServer) EpochKeyChainProof(context.Context, *QueryEpochKeyChainProofRequest) (*QueryEpochKeyChainProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochKeyChainProof not implemented")
+}
+func (UnimplementedQueryServer) SigningSchemas(context.Context, *QuerySigningSchemasRequest) (*QuerySigningSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningSchemas not implemented")
+}
+func (UnimplementedQueryServer) SchemaSignatures(context.Context, *QuerySchemaSignaturesRequest) (*QuerySchemaSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchemaSignatures not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -199,6 +250,60 @@ func _Query_SigningHistory_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochKeyChainProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochKeyChainProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochKeyChainProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EpochKeyChainProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochKeyChainProof(ctx, req.(*QueryEpochKeyChainProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SigningSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningSchemas(ctx, req.(*QuerySigningSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SchemaSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySchemaSignaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SchemaSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SchemaSignatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SchemaSignatures(ctx, req.(*QuerySchemaSignaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,6 +327,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningHistory",
 			Handler:    _Query_SigningHistory_Handler,
 		},
+		{
+			MethodName: "EpochKeyChainProof",
+			Handler:    _Query_EpochKeyChainProof_Handler,
+		},
+		{
+			MethodName: "SigningSchemas",
+			Handler:    _Query_SigningSchemas_Handler,
+		},
+		{
+			MethodName: "SchemaSignatures",
+			Handler:    _Query_SchemaSignatures_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/bls/query.proto",
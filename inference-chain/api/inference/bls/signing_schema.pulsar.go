@@ -0,0 +1,3149 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package bls
+
+import (
+	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_SchemaField      protoreflect.MessageDescriptor
+	fd_SchemaField_name protoreflect.FieldDescriptor
+	fd_SchemaField_type protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_bls_signing_schema_proto_init()
+	md_SchemaField = File_inference_bls_signing_schema_proto.Messages().ByName("SchemaField")
+	fd_SchemaField_name = md_SchemaField.Fields().ByName("name")
+	fd_SchemaField_type = md_SchemaField.Fields().ByName("type")
+}
+
+var _ protoreflect.Message = (*fastReflection_SchemaField)(nil)
+
+type fastReflection_SchemaField SchemaField
+
+func (x *SchemaField) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SchemaField)(x)
+}
+
+func (x *SchemaField) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_bls_signing_schema_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SchemaField_messageType fastReflection_SchemaField_messageType
+var _ protoreflect.MessageType = fastReflection_SchemaField_messageType{}
+
+type fastReflection_SchemaField_messageType struct{}
+
+func (x fastReflection_SchemaField_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SchemaField)(nil)
+}
+func (x fastReflection_SchemaField_messageType) New() protoreflect.Message {
+	return new(fastReflection_SchemaField)
+}
+func (x fastReflection_SchemaField_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SchemaField
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SchemaField) Descriptor() protoreflect.MessageDescriptor {
+	return md_SchemaField
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SchemaField) Type() protoreflect.MessageType {
+	return _fastReflection_SchemaField_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SchemaField) New() protoreflect.Message {
+	return new(fastReflection_SchemaField)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SchemaField) Interface() protoreflect.ProtoMessage {
+	return (*SchemaField)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SchemaField) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_SchemaField_name, value) {
+			return
+		}
+	}
+	if x.Type_ != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Type_))
+		if !f(fd_SchemaField_type, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SchemaField) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.bls.SchemaField.name":
+		return x.Name != ""
+	case "inference.bls.SchemaField.type":
+		return x.Type_ != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SchemaField"))
+		}
+		panic(fmt.Errorf("message inference.bls.SchemaField does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchemaField) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.bls.SchemaField.name":
+		x.Name = ""
+	case "inference.bls.SchemaField.type":
+		x.Type_ = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SchemaField"))
+		}
+		panic(fmt.Errorf("message inference.bls.SchemaField does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SchemaField) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.bls.SchemaField.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "inference.bls.SchemaField.type":
+		value := x.Type_
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SchemaField"))
+		}
+		panic(fmt.Errorf("message inference.bls.SchemaField does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchemaField) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.bls.SchemaField.name":
+		x.Name = value.Interface().(string)
+	case "inference.bls.SchemaField.type":
+		x.Type_ = (SchemaFieldType)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SchemaField"))
+		}
+		panic(fmt.Errorf("message inference.bls.SchemaField does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchemaField) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.SchemaField.name":
+		panic(fmt.Errorf("field name of message inference.bls.SchemaField is not mutable"))
+	case "inference.bls.SchemaField.type":
+		panic(fmt.Errorf("field type of message inference.bls.SchemaField is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SchemaField"))
+		}
+		panic(fmt.Errorf("message inference.bls.SchemaField does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SchemaField) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.SchemaField.name":
+		return protoreflect.ValueOfString("")
+	case "inference.bls.SchemaField.type":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SchemaField"))
+		}
+		panic(fmt.Errorf("message inference.bls.SchemaField does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SchemaField) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.bls.SchemaField", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SchemaField) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchemaField) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SchemaField) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SchemaField) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SchemaField)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Type_ != 0 {
+			n += 1 + runtime.Sov(uint64(x.Type_))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SchemaField)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Type_ != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Type_))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SchemaField)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchemaField: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchemaField: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				x.Type_ = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Type_ |= SchemaFieldType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SigningSchema_3_list)(nil)
+
+type _SigningSchema_3_list struct {
+	list *[]*SchemaField
+}
+
+func (x *_SigningSchema_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SigningSchema_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SigningSchema_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SchemaField)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SigningSchema_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SchemaField)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SigningSchema_3_list) AppendMutable() protoreflect.Value {
+	v := new(SchemaField)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SigningSchema_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SigningSchema_3_list) NewElement() protoreflect.Value {
+	v := new(SchemaField)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SigningSchema_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SigningSchema                         protoreflect.MessageDescriptor
+	fd_SigningSchema_name                    protoreflect.FieldDescriptor
+	fd_SigningSchema_description             protoreflect.FieldDescriptor
+	fd_SigningSchema_fields                  protoreflect.FieldDescriptor
+	fd_SigningSchema_fee                     protoreflect.FieldDescriptor
+	fd_SigningSchema_default_deadline_blocks protoreflect.FieldDescriptor
+	fd_SigningSchema_max_deadline_blocks     protoreflect.FieldDescriptor
+	fd_SigningSchema_max_retries             protoreflect.FieldDescriptor
+	fd_SigningSchema_enabled                 protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_bls_signing_schema_proto_init()
+	md_SigningSchema = File_inference_bls_signing_schema_proto.Messages().ByName("SigningSchema")
+	fd_SigningSchema_name = md_SigningSchema.Fields().ByName("name")
+	fd_SigningSchema_description = md_SigningSchema.Fields().ByName("description")
+	fd_SigningSchema_fields = md_SigningSchema.Fields().ByName("fields")
+	fd_SigningSchema_fee = md_SigningSchema.Fields().ByName("fee")
+	fd_SigningSchema_default_deadline_blocks = md_SigningSchema.Fields().ByName("default_deadline_blocks")
+	fd_SigningSchema_max_deadline_blocks = md_SigningSchema.Fields().ByName("max_deadline_blocks")
+	fd_SigningSchema_max_retries = md_SigningSchema.Fields().ByName("max_retries")
+	fd_SigningSchema_enabled = md_SigningSchema.Fields().ByName("enabled")
+}
+
+var _ protoreflect.Message = (*fastReflection_SigningSchema)(nil)
+
+type fastReflection_SigningSchema SigningSchema
+
+func (x *SigningSchema) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SigningSchema)(x)
+}
+
+func (x *SigningSchema) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_bls_signing_schema_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SigningSchema_messageType fastReflection_SigningSchema_messageType
+var _ protoreflect.MessageType = fastReflection_SigningSchema_messageType{}
+
+type fastReflection_SigningSchema_messageType struct{}
+
+func (x fastReflection_SigningSchema_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SigningSchema)(nil)
+}
+func (x fastReflection_SigningSchema_messageType) New() protoreflect.Message {
+	return new(fastReflection_SigningSchema)
+}
+func (x fastReflection_SigningSchema_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningSchema
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SigningSchema) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningSchema
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SigningSchema) Type() protoreflect.MessageType {
+	return _fastReflection_SigningSchema_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SigningSchema) New() protoreflect.Message {
+	return new(fastReflection_SigningSchema)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SigningSchema) Interface() protoreflect.ProtoMessage {
+	return (*SigningSchema)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SigningSchema) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_SigningSchema_name, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_SigningSchema_description, value) {
+			return
+		}
+	}
+	if len(x.Fields) != 0 {
+		value := protoreflect.ValueOfList(&_SigningSchema_3_list{list: &x.Fields})
+		if !f(fd_SigningSchema_fields, value) {
+			return
+		}
+	}
+	if x.Fee != nil {
+		value := protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+		if !f(fd_SigningSchema_fee, value) {
+			return
+		}
+	}
+	if x.DefaultDeadlineBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DefaultDeadlineBlocks)
+		if !f(fd_SigningSchema_default_deadline_blocks, value) {
+			return
+		}
+	}
+	if x.MaxDeadlineBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxDeadlineBlocks)
+		if !f(fd_SigningSchema_max_deadline_blocks, value) {
+			return
+		}
+	}
+	if x.MaxRetries != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxRetries)
+		if !f(fd_SigningSchema_max_retries, value) {
+			return
+		}
+	}
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_SigningSchema_enabled, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SigningSchema) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.bls.SigningSchema.name":
+		return x.Name != ""
+	case "inference.bls.SigningSchema.description":
+		return x.Description != ""
+	case "inference.bls.SigningSchema.fields":
+		return len(x.Fields) != 0
+	case "inference.bls.SigningSchema.fee":
+		return x.Fee != nil
+	case "inference.bls.SigningSchema.default_deadline_blocks":
+		return x.DefaultDeadlineBlocks != int64(0)
+	case "inference.bls.SigningSchema.max_deadline_blocks":
+		return x.MaxDeadlineBlocks != int64(0)
+	case "inference.bls.SigningSchema.max_retries":
+		return x.MaxRetries != uint32(0)
+	case "inference.bls.SigningSchema.enabled":
+		return x.Enabled != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SigningSchema"))
+		}
+		panic(fmt.Errorf("message inference.bls.SigningSchema does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningSchema) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.bls.SigningSchema.name":
+		x.Name = ""
+	case "inference.bls.SigningSchema.description":
+		x.Description = ""
+	case "inference.bls.SigningSchema.fields":
+		x.Fields = nil
+	case "inference.bls.SigningSchema.fee":
+		x.Fee = nil
+	case "inference.bls.SigningSchema.default_deadline_blocks":
+		x.DefaultDeadlineBlocks = int64(0)
+	case "inference.bls.SigningSchema.max_deadline_blocks":
+		x.MaxDeadlineBlocks = int64(0)
+	case "inference.bls.SigningSchema.max_retries":
+		x.MaxRetries = uint32(0)
+	case "inference.bls.SigningSchema.enabled":
+		x.Enabled = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SigningSchema"))
+		}
+		panic(fmt.Errorf("message inference.bls.SigningSchema does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SigningSchema) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.bls.SigningSchema.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "inference.bls.SigningSchema.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "inference.bls.SigningSchema.fields":
+		if len(x.Fields) == 0 {
+			return protoreflect.ValueOfList(&_SigningSchema_3_list{})
+		}
+		listValue := &_SigningSchema_3_list{list: &x.Fields}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.bls.SigningSchema.fee":
+		value := x.Fee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.bls.SigningSchema.default_deadline_blocks":
+		value := x.DefaultDeadlineBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "inference.bls.SigningSchema.max_deadline_blocks":
+		value := x.MaxDeadlineBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "inference.bls.SigningSchema.max_retries":
+		value := x.MaxRetries
+		return protoreflect.ValueOfUint32(value)
+	case "inference.bls.SigningSchema.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SigningSchema"))
+		}
+		panic(fmt.Errorf("message inference.bls.SigningSchema does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningSchema) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.bls.SigningSchema.name":
+		x.Name = value.Interface().(string)
+	case "inference.bls.SigningSchema.description":
+		x.Description = value.Interface().(string)
+	case "inference.bls.SigningSchema.fields":
+		lv := value.List()
+		clv := lv.(*_SigningSchema_3_list)
+		x.Fields = *clv.list
+	case "inference.bls.SigningSchema.fee":
+		x.Fee = value.Message().Interface().(*v1beta1.Coin)
+	case "inference.bls.SigningSchema.default_deadline_blocks":
+		x.DefaultDeadlineBlocks = value.Int()
+	case "inference.bls.SigningSchema.max_deadline_blocks":
+		x.MaxDeadlineBlocks = value.Int()
+	case "inference.bls.SigningSchema.max_retries":
+		x.MaxRetries = uint32(value.Uint())
+	case "inference.bls.SigningSchema.enabled":
+		x.Enabled = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SigningSchema"))
+		}
+		panic(fmt.Errorf("message inference.bls.SigningSchema does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningSchema) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.SigningSchema.fields":
+		if x.Fields == nil {
+			x.Fields = []*SchemaField{}
+		}
+		value := &_SigningSchema_3_list{list: &x.Fields}
+		return protoreflect.ValueOfList(value)
+	case "inference.bls.SigningSchema.fee":
+		if x.Fee == nil {
+			x.Fee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+	case "inference.bls.SigningSchema.name":
+		panic(fmt.Errorf("field name of message inference.bls.SigningSchema is not mutable"))
+	case "inference.bls.SigningSchema.description":
+		panic(fmt.Errorf("field description of message inference.bls.SigningSchema is not mutable"))
+	case "inference.bls.SigningSchema.default_deadline_blocks":
+		panic(fmt.Errorf("field default_deadline_blocks of message inference.bls.SigningSchema is not mutable"))
+	case "inference.bls.SigningSchema.max_deadline_blocks":
+		panic(fmt.Errorf("field max_deadline_blocks of message inference.bls.SigningSchema is not mutable"))
+	case "inference.bls.SigningSchema.max_retries":
+		panic(fmt.Errorf("field max_retries of message inference.bls.SigningSchema is not mutable"))
+	case "inference.bls.SigningSchema.enabled":
+		panic(fmt.Errorf("field enabled of message inference.bls.SigningSchema is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SigningSchema"))
+		}
+		panic(fmt.Errorf("message inference.bls.SigningSchema does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SigningSchema) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.SigningSchema.name":
+		return protoreflect.ValueOfString("")
+	case "inference.bls.SigningSchema.description":
+		return protoreflect.ValueOfString("")
+	case "inference.bls.SigningSchema.fields":
+		list := []*SchemaField{}
+		return protoreflect.ValueOfList(&_SigningSchema_3_list{list: &list})
+	case "inference.bls.SigningSchema.fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.bls.SigningSchema.default_deadline_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.bls.SigningSchema.max_deadline_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.bls.SigningSchema.max_retries":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "inference.bls.SigningSchema.enabled":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SigningSchema"))
+		}
+		panic(fmt.Errorf("message inference.bls.SigningSchema does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SigningSchema) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.bls.SigningSchema", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SigningSchema) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningSchema) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SigningSchema) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SigningSchema) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SigningSchema)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Fields) > 0 {
+			for _, e := range x.Fields {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Fee != nil {
+			l = options.Size(x.Fee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DefaultDeadlineBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.DefaultDeadlineBlocks))
+		}
+		if x.MaxDeadlineBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxDeadlineBlocks))
+		}
+		if x.MaxRetries != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRetries))
+		}
+		if x.Enabled {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SigningSchema)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MaxRetries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRetries))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.MaxDeadlineBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxDeadlineBlocks))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.DefaultDeadlineBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DefaultDeadlineBlocks))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Fee != nil {
+			encoded, err := options.Marshal(x.Fee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Fields) > 0 {
+			for iNdEx := len(x.Fields) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Fields[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SigningSchema)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningSchema: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fields = append(x.Fields, &SchemaField{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fields[len(x.Fields)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Fee == nil {
+					x.Fee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultDeadlineBlocks", wireType)
+				}
+				x.DefaultDeadlineBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DefaultDeadlineBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDeadlineBlocks", wireType)
+				}
+				x.MaxDeadlineBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxDeadlineBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+				}
+				x.MaxRetries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRetries |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SchemaSigningRequest_5_list)(nil)
+
+type _SchemaSigningRequest_5_list struct {
+	list *[][]byte
+}
+
+func (x *_SchemaSigningRequest_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SchemaSigningRequest_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_SchemaSigningRequest_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SchemaSigningRequest_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SchemaSigningRequest_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SchemaSigningRequest at list field Data as it is not of Message kind"))
+}
+
+func (x *_SchemaSigningRequest_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SchemaSigningRequest_5_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_SchemaSigningRequest_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SchemaSigningRequest                        protoreflect.MessageDescriptor
+	fd_SchemaSigningRequest_request_id             protoreflect.FieldDescriptor
+	fd_SchemaSigningRequest_schema_name            protoreflect.FieldDescriptor
+	fd_SchemaSigningRequest_requester              protoreflect.FieldDescriptor
+	fd_SchemaSigningRequest_sequence               protoreflect.FieldDescriptor
+	fd_SchemaSigningRequest_data                   protoreflect.FieldDescriptor
+	fd_SchemaSigningRequest_fee_paid               protoreflect.FieldDescriptor
+	fd_SchemaSigningRequest_deadline_blocks        protoreflect.FieldDescriptor
+	fd_SchemaSigningRequest_max_retries            protoreflect.FieldDescriptor
+	fd_SchemaSigningRequest_retries_used           protoreflect.FieldDescriptor
+	fd_SchemaSigningRequest_status                 protoreflect.FieldDescriptor
+	fd_SchemaSigningRequest_epoch_id               protoreflect.FieldDescriptor
+	fd_SchemaSigningRequest_final_signature        protoreflect.FieldDescriptor
+	fd_SchemaSigningRequest_created_block_height   protoreflect.FieldDescriptor
+	fd_SchemaSigningRequest_completed_block_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_bls_signing_schema_proto_init()
+	md_SchemaSigningRequest = File_inference_bls_signing_schema_proto.Messages().ByName("SchemaSigningRequest")
+	fd_SchemaSigningRequest_request_id = md_SchemaSigningRequest.Fields().ByName("request_id")
+	fd_SchemaSigningRequest_schema_name = md_SchemaSigningRequest.Fields().ByName("schema_name")
+	fd_SchemaSigningRequest_requester = md_SchemaSigningRequest.Fields().ByName("requester")
+	fd_SchemaSigningRequest_sequence = md_SchemaSigningRequest.Fields().ByName("sequence")
+	fd_SchemaSigningRequest_data = md_SchemaSigningRequest.Fields().ByName("data")
+	fd_SchemaSigningRequest_fee_paid = md_SchemaSigningRequest.Fields().ByName("fee_paid")
+	fd_SchemaSigningRequest_deadline_blocks = md_SchemaSigningRequest.Fields().ByName("deadline_blocks")
+	fd_SchemaSigningRequest_max_retries = md_SchemaSigningRequest.Fields().ByName("max_retries")
+	fd_SchemaSigningRequest_retries_used = md_SchemaSigningRequest.Fields().ByName("retries_used")
+	fd_SchemaSigningRequest_status = md_SchemaSigningRequest.Fields().ByName("status")
+	fd_SchemaSigningRequest_epoch_id = md_SchemaSigningRequest.Fields().ByName("epoch_id")
+	fd_SchemaSigningRequest_final_signature = md_SchemaSigningRequest.Fields().ByName("final_signature")
+	fd_SchemaSigningRequest_created_block_height = md_SchemaSigningRequest.Fields().ByName("created_block_height")
+	fd_SchemaSigningRequest_completed_block_height = md_SchemaSigningRequest.Fields().ByName("completed_block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_SchemaSigningRequest)(nil)
+
+type fastReflection_SchemaSigningRequest SchemaSigningRequest
+
+func (x *SchemaSigningRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SchemaSigningRequest)(x)
+}
+
+func (x *SchemaSigningRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_bls_signing_schema_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SchemaSigningRequest_messageType fastReflection_SchemaSigningRequest_messageType
+var _ protoreflect.MessageType = fastReflection_SchemaSigningRequest_messageType{}
+
+type fastReflection_SchemaSigningRequest_messageType struct{}
+
+func (x fastReflection_SchemaSigningRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SchemaSigningRequest)(nil)
+}
+func (x fastReflection_SchemaSigningRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_SchemaSigningRequest)
+}
+func (x fastReflection_SchemaSigningRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SchemaSigningRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SchemaSigningRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_SchemaSigningRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SchemaSigningRequest) Type() protoreflect.MessageType {
+	return _fastReflection_SchemaSigningRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SchemaSigningRequest) New() protoreflect.Message {
+	return new(fastReflection_SchemaSigningRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SchemaSigningRequest) Interface() protoreflect.ProtoMessage {
+	return (*SchemaSigningRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SchemaSigningRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.RequestId) != 0 {
+		value := protoreflect.ValueOfBytes(x.RequestId)
+		if !f(fd_SchemaSigningRequest_request_id, value) {
+			return
+		}
+	}
+	if x.SchemaName != "" {
+		value := protoreflect.ValueOfString(x.SchemaName)
+		if !f(fd_SchemaSigningRequest_schema_name, value) {
+			return
+		}
+	}
+	if x.Requester != "" {
+		value := protoreflect.ValueOfString(x.Requester)
+		if !f(fd_SchemaSigningRequest_requester, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_SchemaSigningRequest_sequence, value) {
+			return
+		}
+	}
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfList(&_SchemaSigningRequest_5_list{list: &x.Data})
+		if !f(fd_SchemaSigningRequest_data, value) {
+			return
+		}
+	}
+	if x.FeePaid != nil {
+		value := protoreflect.ValueOfMessage(x.FeePaid.ProtoReflect())
+		if !f(fd_SchemaSigningRequest_fee_paid, value) {
+			return
+		}
+	}
+	if x.DeadlineBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DeadlineBlocks)
+		if !f(fd_SchemaSigningRequest_deadline_blocks, value) {
+			return
+		}
+	}
+	if x.MaxRetries != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxRetries)
+		if !f(fd_SchemaSigningRequest_max_retries, value) {
+			return
+		}
+	}
+	if x.RetriesUsed != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RetriesUsed)
+		if !f(fd_SchemaSigningRequest_retries_used, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_SchemaSigningRequest_status, value) {
+			return
+		}
+	}
+	if x.EpochId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochId)
+		if !f(fd_SchemaSigningRequest_epoch_id, value) {
+			return
+		}
+	}
+	if len(x.FinalSignature) != 0 {
+		value := protoreflect.ValueOfBytes(x.FinalSignature)
+		if !f(fd_SchemaSigningRequest_final_signature, value) {
+			return
+		}
+	}
+	if x.CreatedBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedBlockHeight)
+		if !f(fd_SchemaSigningRequest_created_block_height, value) {
+			return
+		}
+	}
+	if x.CompletedBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CompletedBlockHeight)
+		if !f(fd_SchemaSigningRequest_completed_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SchemaSigningRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.bls.SchemaSigningRequest.request_id":
+		return len(x.RequestId) != 0
+	case "inference.bls.SchemaSigningRequest.schema_name":
+		return x.SchemaName != ""
+	case "inference.bls.SchemaSigningRequest.requester":
+		return x.Requester != ""
+	case "inference.bls.SchemaSigningRequest.sequence":
+		return x.Sequence != uint64(0)
+	case "inference.bls.SchemaSigningRequest.data":
+		return len(x.Data) != 0
+	case "inference.bls.SchemaSigningRequest.fee_paid":
+		return x.FeePaid != nil
+	case "inference.bls.SchemaSigningRequest.deadline_blocks":
+		return x.DeadlineBlocks != int64(0)
+	case "inference.bls.SchemaSigningRequest.max_retries":
+		return x.MaxRetries != uint32(0)
+	case "inference.bls.SchemaSigningRequest.retries_used":
+		return x.RetriesUsed != uint32(0)
+	case "inference.bls.SchemaSigningRequest.status":
+		return x.Status != 0
+	case "inference.bls.SchemaSigningRequest.epoch_id":
+		return x.EpochId != uint64(0)
+	case "inference.bls.SchemaSigningRequest.final_signature":
+		return len(x.FinalSignature) != 0
+	case "inference.bls.SchemaSigningRequest.created_block_height":
+		return x.CreatedBlockHeight != int64(0)
+	case "inference.bls.SchemaSigningRequest.completed_block_height":
+		return x.CompletedBlockHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SchemaSigningRequest"))
+		}
+		panic(fmt.Errorf("message inference.bls.SchemaSigningRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchemaSigningRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.bls.SchemaSigningRequest.request_id":
+		x.RequestId = nil
+	case "inference.bls.SchemaSigningRequest.schema_name":
+		x.SchemaName = ""
+	case "inference.bls.SchemaSigningRequest.requester":
+		x.Requester = ""
+	case "inference.bls.SchemaSigningRequest.sequence":
+		x.Sequence = uint64(0)
+	case "inference.bls.SchemaSigningRequest.data":
+		x.Data = nil
+	case "inference.bls.SchemaSigningRequest.fee_paid":
+		x.FeePaid = nil
+	case "inference.bls.SchemaSigningRequest.deadline_blocks":
+		x.DeadlineBlocks = int64(0)
+	case "inference.bls.SchemaSigningRequest.max_retries":
+		x.MaxRetries = uint32(0)
+	case "inference.bls.SchemaSigningRequest.retries_used":
+		x.RetriesUsed = uint32(0)
+	case "inference.bls.SchemaSigningRequest.status":
+		x.Status = 0
+	case "inference.bls.SchemaSigningRequest.epoch_id":
+		x.EpochId = uint64(0)
+	case "inference.bls.SchemaSigningRequest.final_signature":
+		x.FinalSignature = nil
+	case "inference.bls.SchemaSigningRequest.created_block_height":
+		x.CreatedBlockHeight = int64(0)
+	case "inference.bls.SchemaSigningRequest.completed_block_height":
+		x.CompletedBlockHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SchemaSigningRequest"))
+		}
+		panic(fmt.Errorf("message inference.bls.SchemaSigningRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SchemaSigningRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.bls.SchemaSigningRequest.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfBytes(value)
+	case "inference.bls.SchemaSigningRequest.schema_name":
+		value := x.SchemaName
+		return protoreflect.ValueOfString(value)
+	case "inference.bls.SchemaSigningRequest.requester":
+		value := x.Requester
+		return protoreflect.ValueOfString(value)
+	case "inference.bls.SchemaSigningRequest.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "inference.bls.SchemaSigningRequest.data":
+		if len(x.Data) == 0 {
+			return protoreflect.ValueOfList(&_SchemaSigningRequest_5_list{})
+		}
+		listValue := &_SchemaSigningRequest_5_list{list: &x.Data}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.bls.SchemaSigningRequest.fee_paid":
+		value := x.FeePaid
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.bls.SchemaSigningRequest.deadline_blocks":
+		value := x.DeadlineBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "inference.bls.SchemaSigningRequest.max_retries":
+		value := x.MaxRetries
+		return protoreflect.ValueOfUint32(value)
+	case "inference.bls.SchemaSigningRequest.retries_used":
+		value := x.RetriesUsed
+		return protoreflect.ValueOfUint32(value)
+	case "inference.bls.SchemaSigningRequest.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "inference.bls.SchemaSigningRequest.epoch_id":
+		value := x.EpochId
+		return protoreflect.ValueOfUint64(value)
+	case "inference.bls.SchemaSigningRequest.final_signature":
+		value := x.FinalSignature
+		return protoreflect.ValueOfBytes(value)
+	case "inference.bls.SchemaSigningRequest.created_block_height":
+		value := x.CreatedBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.bls.SchemaSigningRequest.completed_block_height":
+		value := x.CompletedBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SchemaSigningRequest"))
+		}
+		panic(fmt.Errorf("message inference.bls.SchemaSigningRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchemaSigningRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.bls.SchemaSigningRequest.request_id":
+		x.RequestId = value.Bytes()
+	case "inference.bls.SchemaSigningRequest.schema_name":
+		x.SchemaName = value.Interface().(string)
+	case "inference.bls.SchemaSigningRequest.requester":
+		x.Requester = value.Interface().(string)
+	case "inference.bls.SchemaSigningRequest.sequence":
+		x.Sequence = value.Uint()
+	case "inference.bls.SchemaSigningRequest.data":
+		lv := value.List()
+		clv := lv.(*_SchemaSigningRequest_5_list)
+		x.Data = *clv.list
+	case "inference.bls.SchemaSigningRequest.fee_paid":
+		x.FeePaid = value.Message().Interface().(*v1beta1.Coin)
+	case "inference.bls.SchemaSigningRequest.deadline_blocks":
+		x.DeadlineBlocks = value.Int()
+	case "inference.bls.SchemaSigningRequest.max_retries":
+		x.MaxRetries = uint32(value.Uint())
+	case "inference.bls.SchemaSigningRequest.retries_used":
+		x.RetriesUsed = uint32(value.Uint())
+	case "inference.bls.SchemaSigningRequest.status":
+		x.Status = (ThresholdSigningStatus)(value.Enum())
+	case "inference.bls.SchemaSigningRequest.epoch_id":
+		x.EpochId = value.Uint()
+	case "inference.bls.SchemaSigningRequest.final_signature":
+		x.FinalSignature = value.Bytes()
+	case "inference.bls.SchemaSigningRequest.created_block_height":
+		x.CreatedBlockHeight = value.Int()
+	case "inference.bls.SchemaSigningRequest.completed_block_height":
+		x.CompletedBlockHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SchemaSigningRequest"))
+		}
+		panic(fmt.Errorf("message inference.bls.SchemaSigningRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchemaSigningRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.SchemaSigningRequest.data":
+		if x.Data == nil {
+			x.Data = [][]byte{}
+		}
+		value := &_SchemaSigningRequest_5_list{list: &x.Data}
+		return protoreflect.ValueOfList(value)
+	case "inference.bls.SchemaSigningRequest.fee_paid":
+		if x.FeePaid == nil {
+			x.FeePaid = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.FeePaid.ProtoReflect())
+	case "inference.bls.SchemaSigningRequest.request_id":
+		panic(fmt.Errorf("field request_id of message inference.bls.SchemaSigningRequest is not mutable"))
+	case "inference.bls.SchemaSigningRequest.schema_name":
+		panic(fmt.Errorf("field schema_name of message inference.bls.SchemaSigningRequest is not mutable"))
+	case "inference.bls.SchemaSigningRequest.requester":
+		panic(fmt.Errorf("field requester of message inference.bls.SchemaSigningRequest is not mutable"))
+	case "inference.bls.SchemaSigningRequest.sequence":
+		panic(fmt.Errorf("field sequence of message inference.bls.SchemaSigningRequest is not mutable"))
+	case "inference.bls.SchemaSigningRequest.deadline_blocks":
+		panic(fmt.Errorf("field deadline_blocks of message inference.bls.SchemaSigningRequest is not mutable"))
+	case "inference.bls.SchemaSigningRequest.max_retries":
+		panic(fmt.Errorf("field max_retries of message inference.bls.SchemaSigningRequest is not mutable"))
+	case "inference.bls.SchemaSigningRequest.retries_used":
+		panic(fmt.Errorf("field retries_used of message inference.bls.SchemaSigningRequest is not mutable"))
+	case "inference.bls.SchemaSigningRequest.status":
+		panic(fmt.Errorf("field status of message inference.bls.SchemaSigningRequest is not mutable"))
+	case "inference.bls.SchemaSigningRequest.epoch_id":
+		panic(fmt.Errorf("field epoch_id of message inference.bls.SchemaSigningRequest is not mutable"))
+	case "inference.bls.SchemaSigningRequest.final_signature":
+		panic(fmt.Errorf("field final_signature of message inference.bls.SchemaSigningRequest is not mutable"))
+	case "inference.bls.SchemaSigningRequest.created_block_height":
+		panic(fmt.Errorf("field created_block_height of message inference.bls.SchemaSigningRequest is not mutable"))
+	case "inference.bls.SchemaSigningRequest.completed_block_height":
+		panic(fmt.Errorf("field completed_block_height of message inference.bls.SchemaSigningRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SchemaSigningRequest"))
+		}
+		panic(fmt.Errorf("message inference.bls.SchemaSigningRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SchemaSigningRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.SchemaSigningRequest.request_id":
+		return protoreflect.ValueOfBytes(nil)
+	case "inference.bls.SchemaSigningRequest.schema_name":
+		return protoreflect.ValueOfString("")
+	case "inference.bls.SchemaSigningRequest.requester":
+		return protoreflect.ValueOfString("")
+	case "inference.bls.SchemaSigningRequest.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.bls.SchemaSigningRequest.data":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_SchemaSigningRequest_5_list{list: &list})
+	case "inference.bls.SchemaSigningRequest.fee_paid":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.bls.SchemaSigningRequest.deadline_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.bls.SchemaSigningRequest.max_retries":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "inference.bls.SchemaSigningRequest.retries_used":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "inference.bls.SchemaSigningRequest.status":
+		return protoreflect.ValueOfEnum(0)
+	case "inference.bls.SchemaSigningRequest.epoch_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.bls.SchemaSigningRequest.final_signature":
+		return protoreflect.ValueOfBytes(nil)
+	case "inference.bls.SchemaSigningRequest.created_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.bls.SchemaSigningRequest.completed_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.SchemaSigningRequest"))
+		}
+		panic(fmt.Errorf("message inference.bls.SchemaSigningRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SchemaSigningRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.bls.SchemaSigningRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SchemaSigningRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchemaSigningRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SchemaSigningRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SchemaSigningRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SchemaSigningRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.RequestId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SchemaName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Requester)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if len(x.Data) > 0 {
+			for _, b := range x.Data {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FeePaid != nil {
+			l = options.Size(x.FeePaid)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DeadlineBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeadlineBlocks))
+		}
+		if x.MaxRetries != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRetries))
+		}
+		if x.RetriesUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.RetriesUsed))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.EpochId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochId))
+		}
+		l = len(x.FinalSignature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreatedBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedBlockHeight))
+		}
+		if x.CompletedBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CompletedBlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SchemaSigningRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CompletedBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CompletedBlockHeight))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.CreatedBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedBlockHeight))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.FinalSignature) > 0 {
+			i -= len(x.FinalSignature)
+			copy(dAtA[i:], x.FinalSignature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FinalSignature)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.EpochId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochId))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.RetriesUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetriesUsed))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.MaxRetries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRetries))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.DeadlineBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeadlineBlocks))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.FeePaid != nil {
+			encoded, err := options.Marshal(x.FeePaid)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Data) > 0 {
+			for iNdEx := len(x.Data) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Data[iNdEx])
+				copy(dAtA[i:], x.Data[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Requester) > 0 {
+			i -= len(x.Requester)
+			copy(dAtA[i:], x.Requester)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Requester)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SchemaName) > 0 {
+			i -= len(x.SchemaName)
+			copy(dAtA[i:], x.SchemaName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SchemaName)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RequestId) > 0 {
+			i -= len(x.RequestId)
+			copy(dAtA[i:], x.RequestId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequestId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SchemaSigningRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchemaSigningRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchemaSigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequestId = append(x.RequestId[:0], dAtA[iNdEx:postIndex]...)
+				if x.RequestId == nil {
+					x.RequestId = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SchemaName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SchemaName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Requester = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data, make([]byte, postIndex-iNdEx))
+				copy(x.Data[len(x.Data)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeePaid", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeePaid == nil {
+					x.FeePaid = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeePaid); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeadlineBlocks", wireType)
+				}
+				x.DeadlineBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeadlineBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+				}
+				x.MaxRetries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRetries |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetriesUsed", wireType)
+				}
+				x.RetriesUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RetriesUsed |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= ThresholdSigningStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+				}
+				x.EpochId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalSignature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FinalSignature = append(x.FinalSignature[:0], dAtA[iNdEx:postIndex]...)
+				if x.FinalSignature == nil {
+					x.FinalSignature = []byte{}
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedBlockHeight", wireType)
+				}
+				x.CreatedBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletedBlockHeight", wireType)
+				}
+				x.CompletedBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CompletedBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/bls/signing_schema.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SchemaFieldType defines how a 32-byte payload field of a signing schema is interpreted
+type SchemaFieldType int32
+
+const (
+	// BYTES32 is an opaque 32-byte value
+	SchemaFieldType_SCHEMA_FIELD_TYPE_BYTES32 SchemaFieldType = 0
+	// UINT256 is a big-endian unsigned integer
+	SchemaFieldType_SCHEMA_FIELD_TYPE_UINT256 SchemaFieldType = 1
+	// ADDRESS is a 20-byte address left-padded with zeros
+	SchemaFieldType_SCHEMA_FIELD_TYPE_ADDRESS SchemaFieldType = 2
+)
+
+// Enum value maps for SchemaFieldType.
+var (
+	SchemaFieldType_name = map[int32]string{
+		0: "SCHEMA_FIELD_TYPE_BYTES32",
+		1: "SCHEMA_FIELD_TYPE_UINT256",
+		2: "SCHEMA_FIELD_TYPE_ADDRESS",
+	}
+	SchemaFieldType_value = map[string]int32{
+		"SCHEMA_FIELD_TYPE_BYTES32": 0,
+		"SCHEMA_FIELD_TYPE_UINT256": 1,
+		"SCHEMA_FIELD_TYPE_ADDRESS": 2,
+	}
+)
+
+func (x SchemaFieldType) Enum() *SchemaFieldType {
+	p := new(SchemaFieldType)
+	*p = x
+	return p
+}
+
+func (x SchemaFieldType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchemaFieldType) Descriptor() protoreflect.EnumDescriptor {
+	return file_inference_bls_signing_schema_proto_enumTypes[0].Descriptor()
+}
+
+func (SchemaFieldType) Type() protoreflect.EnumType {
+	return &file_inference_bls_signing_schema_proto_enumTypes[0]
+}
+
+func (x SchemaFieldType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchemaFieldType.Descriptor instead.
+func (SchemaFieldType) EnumDescriptor() ([]byte, []int) {
+	return file_inference_bls_signing_schema_proto_rawDescGZIP(), []int{0}
+}
+
+// SchemaField is one 32-byte field of a signing schema payload
+type SchemaField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name identifies the field for requesters and verifiers
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type defines how the field is validated
+	Type_ SchemaFieldType `protobuf:"varint,2,opt,name=type,proto3,enum=inference.bls.SchemaFieldType" json:"type,omitempty"`
+}
+
+func (x *SchemaField) Reset() {
+	*x = SchemaField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_bls_signing_schema_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaField) ProtoMessage() {}
+
+// Deprecated: Use SchemaField.ProtoReflect.Descriptor instead.
+func (*SchemaField) Descriptor() ([]byte, []int) {
+	return file_inference_bls_signing_schema_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SchemaField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SchemaField) GetType_() SchemaFieldType {
+	if x != nil {
+		return x.Type_
+	}
+	return SchemaFieldType_SCHEMA_FIELD_TYPE_BYTES32
+}
+
+// SigningSchema is a governance-whitelisted payload layout that anyone can request a threshold signature for.
+// The signed data is keccak256("SIGNING_SCHEMA:" || name) followed by one 32-byte chunk per field.
+type SigningSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name uniquely identifies the schema (lowercase letters, digits and underscores)
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description explains what a signature under this schema attests
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// fields is the ordered payload layout
+	Fields []*SchemaField `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// fee is charged to the requester of every signature and sent to the fee collector
+	Fee *v1beta1.Coin `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	// default_deadline_blocks is used when a request does not set a deadline (0 means the module signing_deadline_blocks)
+	DefaultDeadlineBlocks int64 `protobuf:"varint,5,opt,name=default_deadline_blocks,json=defaultDeadlineBlocks,proto3" json:"default_deadline_blocks,omitempty"`
+	// max_deadline_blocks caps the deadline a request can ask for (0 means no cap)
+	MaxDeadlineBlocks int64 `protobuf:"varint,6,opt,name=max_deadline_blocks,json=maxDeadlineBlocks,proto3" json:"max_deadline_blocks,omitempty"`
+	// max_retries caps how many times an expired request can be re-issued
+	MaxRetries uint32 `protobuf:"varint,7,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// enabled allows new requests; disabled schemas keep their signature history
+	Enabled bool `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *SigningSchema) Reset() {
+	*x = SigningSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_bls_signing_schema_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigningSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningSchema) ProtoMessage() {}
+
+// Deprecated: Use SigningSchema.ProtoReflect.Descriptor instead.
+func (*SigningSchema) Descriptor() ([]byte, []int) {
+	return file_inference_bls_signing_schema_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SigningSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SigningSchema) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SigningSchema) GetFields() []*SchemaField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *SigningSchema) GetFee() *v1beta1.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *SigningSchema) GetDefaultDeadlineBlocks() int64 {
+	if x != nil {
+		return x.DefaultDeadlineBlocks
+	}
+	return 0
+}
+
+func (x *SigningSchema) GetMaxDeadlineBlocks() int64 {
+	if x != nil {
+		return x.MaxDeadlineBlocks
+	}
+	return 0
+}
+
+func (x *SigningSchema) GetMaxRetries() uint32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *SigningSchema) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// SchemaSigningRequest tracks a threshold signature requested under a signing schema
+type SchemaSigningRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id is the threshold signing request ID, keccak256(schema domain || sequence)
+	RequestId []byte `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// schema_name is the schema the payload follows
+	SchemaName string `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	// requester is the address that paid for the signature
+	Requester string `protobuf:"bytes,3,opt,name=requester,proto3" json:"requester,omitempty"`
+	// sequence numbers schema signing requests across all schemas
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// data is the payload, one 32-byte chunk per schema field
+	Data [][]byte `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	// fee_paid is the fee charged when the request was made
+	FeePaid *v1beta1.Coin `protobuf:"bytes,6,opt,name=fee_paid,json=feePaid,proto3" json:"fee_paid,omitempty"`
+	// deadline_blocks is the number of blocks each signing round can take
+	DeadlineBlocks int64 `protobuf:"varint,7,opt,name=deadline_blocks,json=deadlineBlocks,proto3" json:"deadline_blocks,omitempty"`
+	// max_retries is how many times the request is re-issued after expiring
+	MaxRetries uint32 `protobuf:"varint,8,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// retries_used is how many times the request has been re-issued
+	RetriesUsed uint32 `protobuf:"varint,9,opt,name=retries_used,json=retriesUsed,proto3" json:"retries_used,omitempty"`
+	// status mirrors the status of the current threshold signing round
+	Status ThresholdSigningStatus `protobuf:"varint,10,opt,name=status,proto3,enum=inference.bls.ThresholdSigningStatus" json:"status,omitempty"`
+	// epoch_id is the epoch whose group key signs the current round
+	EpochId uint64 `protobuf:"varint,11,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// final_signature is the aggregated BLS signature once completed
+	FinalSignature []byte `protobuf:"bytes,12,opt,name=final_signature,json=finalSignature,proto3" json:"final_signature,omitempty"`
+	// created_block_height is the block height when the request was made
+	CreatedBlockHeight int64 `protobuf:"varint,13,opt,name=created_block_height,json=createdBlockHeight,proto3" json:"created_block_height,omitempty"`
+	// completed_block_height is the block height when the request completed, failed or ran out of retries
+	CompletedBlockHeight int64 `protobuf:"varint,14,opt,name=completed_block_height,json=completedBlockHeight,proto3" json:"completed_block_height,omitempty"`
+}
+
+func (x *SchemaSigningRequest) Reset() {
+	*x = SchemaSigningRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_bls_signing_schema_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaSigningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaSigningRequest) ProtoMessage() {}
+
+// Deprecated: Use SchemaSigningRequest.ProtoReflect.Descriptor instead.
+func (*SchemaSigningRequest) Descriptor() ([]byte, []int) {
+	return file_inference_bls_signing_schema_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SchemaSigningRequest) GetRequestId() []byte {
+	if x != nil {
+		return x.RequestId
+	}
+	return nil
+}
+
+func (x *SchemaSigningRequest) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *SchemaSigningRequest) GetRequester() string {
+	if x != nil {
+		return x.Requester
+	}
+	return ""
+}
+
+func (x *SchemaSigningRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SchemaSigningRequest) GetData() [][]byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SchemaSigningRequest) GetFeePaid() *v1beta1.Coin {
+	if x != nil {
+		return x.FeePaid
+	}
+	return nil
+}
+
+func (x *SchemaSigningRequest) GetDeadlineBlocks() int64 {
+	if x != nil {
+		return x.DeadlineBlocks
+	}
+	return 0
+}
+
+func (x *SchemaSigningRequest) GetMaxRetries() uint32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *SchemaSigningRequest) GetRetriesUsed() uint32 {
+	if x != nil {
+		return x.RetriesUsed
+	}
+	return 0
+}
+
+func (x *SchemaSigningRequest) GetStatus() ThresholdSigningStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_UNDEFINED
+}
+
+func (x *SchemaSigningRequest) GetEpochId() uint64 {
+	if x != nil {
+		return x.EpochId
+	}
+	return 0
+}
+
+func (x *SchemaSigningRequest) GetFinalSignature() []byte {
+	if x != nil {
+		return x.FinalSignature
+	}
+	return nil
+}
+
+func (x *SchemaSigningRequest) GetCreatedBlockHeight() int64 {
+	if x != nil {
+		return x.CreatedBlockHeight
+	}
+	return 0
+}
+
+func (x *SchemaSigningRequest) GetCompletedBlockHeight() int64 {
+	if x != nil {
+		return x.CompletedBlockHeight
+	}
+	return 0
+}
+
+var File_inference_bls_signing_schema_proto protoreflect.FileDescriptor
+
+var file_inference_bls_signing_schema_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x62, 0x6c, 0x73, 0x2f,
+	0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x62, 0x6c, 0x73, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62,
+	0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x62, 0x6c, 0x73, 0x2f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x55, 0x0a, 0x0b, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x62, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xda, 0x02, 0x0a, 0x0d, 0x53,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x62,
+	0x6c, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x03,
+	0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x03, 0x66, 0x65, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x44, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xb8, 0x04, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3a,
+	0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x07, 0x66, 0x65, 0x65, 0x50, 0x61, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x2e, 0x62, 0x6c, 0x73, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x34, 0x0a, 0x16,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x2a, 0x6e, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f,
+	0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53,
+	0x33, 0x32, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x46,
+	0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x49, 0x4e, 0x54, 0x32, 0x35,
+	0x36, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53,
+	0x10, 0x02, 0x42, 0x9c, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x6c, 0x73, 0x42, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x62, 0x6c, 0x73, 0xa2, 0x02,
+	0x03, 0x49, 0x42, 0x58, 0xaa, 0x02, 0x0d, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x42, 0x6c, 0x73, 0xca, 0x02, 0x0d, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5c, 0x42, 0x6c, 0x73, 0xe2, 0x02, 0x19, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5c, 0x42, 0x6c, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x42, 0x6c,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_inference_bls_signing_schema_proto_rawDescOnce sync.Once
+	file_inference_bls_signing_schema_proto_rawDescData = file_inference_bls_signing_schema_proto_rawDesc
+)
+
+func file_inference_bls_signing_schema_proto_rawDescGZIP() []byte {
+	file_inference_bls_signing_schema_proto_rawDescOnce.Do(func() {
+		file_inference_bls_signing_schema_proto_rawDescData = protoimpl.X.CompressGZIP(file_inference_bls_signing_schema_proto_rawDescData)
+	})
+	return file_inference_bls_signing_schema_proto_rawDescData
+}
+
+var file_inference_bls_signing_schema_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_inference_bls_signing_schema_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_inference_bls_signing_schema_proto_goTypes = []interface{}{
+	(SchemaFieldType)(0),         // 0: inference.bls.SchemaFieldType
+	(*SchemaField)(nil),          // 1: inference.bls.SchemaField
+	(*SigningSchema)(nil),        // 2: inference.bls.SigningSchema
+	(*SchemaSigningRequest)(nil), // 3: inference.bls.SchemaSigningRequest
+	(*v1beta1.Coin)(nil),         // 4: cosmos.base.v1beta1.Coin
+	(ThresholdSigningStatus)(0),  // 5: inference.bls.ThresholdSigningStatus
+}
+var file_inference_bls_signing_schema_proto_depIdxs = []int32{
+	0, // 0: inference.bls.SchemaField.type:type_name -> inference.bls.SchemaFieldType
+	1, // 1: inference.bls.SigningSchema.fields:type_name -> inference.bls.SchemaField
+	4, // 2: inference.bls.SigningSchema.fee:type_name -> cosmos.base.v1beta1.Coin
+	4, // 3: inference.bls.SchemaSigningRequest.fee_paid:type_name -> cosmos.base.v1beta1.Coin
+	5, // 4: inference.bls.SchemaSigningRequest.status:type_name -> inference.bls.ThresholdSigningStatus
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_inference_bls_signing_schema_proto_init() }
+func file_inference_bls_signing_schema_proto_init() {
+	if File_inference_bls_signing_schema_proto != nil {
+		return
+	}
+	file_inference_bls_threshold_signing_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_inference_bls_signing_schema_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_bls_signing_schema_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigningSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_bls_signing_schema_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaSigningRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_inference_bls_signing_schema_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_inference_bls_signing_schema_proto_goTypes,
+		DependencyIndexes: file_inference_bls_signing_schema_proto_depIdxs,
+		EnumInfos:         file_inference_bls_signing_schema_proto_enumTypes,
+		MessageInfos:      file_inference_bls_signing_schema_proto_msgTypes,
+	}.Build()
+	File_inference_bls_signing_schema_proto = out.File
+	file_inference_bls_signing_schema_proto_rawDesc = nil
+	file_inference_bls_signing_schema_proto_goTypes = nil
+	file_inference_bls_signing_schema_proto_depIdxs = nil
+}
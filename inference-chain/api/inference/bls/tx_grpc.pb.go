@@ -25,6 +25,8 @@ const (
 	Msg_SubmitGroupKeyValidationSignature_FullMethodName = "/inference.bls.Msg/SubmitGroupKeyValidationSignature"
 	Msg_SubmitPartialSignature_FullMethodName            = "/inference.bls.Msg/SubmitPartialSignature"
 	Msg_RequestThresholdSignature_FullMethodName         = "/inference.bls.Msg/RequestThresholdSignature"
+	Msg_SetSigningSchema_FullMethodName                  = "/inference.bls.Msg/SetSigningSchema"
+	Msg_RequestSchemaSignature_FullMethodName            = "/inference.bls.Msg/RequestSchemaSignature"
 )
 
 // MsgClient is the client API for Msg service.
@@ -44,6 +46,10 @@ type MsgClient interface {
 	SubmitPartialSignature(ctx context.Context, in *MsgSubmitPartialSignature, opts ...grpc.CallOption) (*MsgSubmitPartialSignatureResponse, error)
 	// RequestThresholdSignature allows external users to request a threshold signature
 	RequestThresholdSignature(ctx context.Context, in *MsgRequestThresholdSignature, opts ...grpc.CallOption) (*MsgRequestThresholdSignatureResponse, error)
+	// SetSigningSchema defines a (governance) operation for adding or replacing a signing schema
+	SetSigningSchema(ctx context.Context, in *MsgSetSigningSchema, opts ...grpc.CallOption) (*MsgSetSigningSchemaResponse, error)
+	// RequestSchemaSignature allows users to request a threshold signature over a payload of a whitelisted schema
+	RequestSchemaSignature(ctx context.Context, in *MsgRequestSchemaSignature, opts ...grpc.CallOption) (*MsgRequestSchemaSignatureResponse, error)
 }
 
 type msgClient struct {
@@ -108,6 +114,24 @@ func (c *msgClient) RequestThresholdSignature(ctx context.Context, in *MsgReques
 	return out, nil
 }
 
+func (c *msgClient) SetSigningSchema(ctx context.Context, in *MsgSetSigningSchema, opts ...grpc.CallOption) (*MsgSetSigningSchemaResponse, error) {
+	out := new(MsgSetSigningSchemaResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSigningSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestSchemaSignature(ctx context.Context, in *MsgRequestSchemaSignature, opts ...grpc.CallOption) (*MsgRequestSchemaSignatureResponse, error) {
+	out := new(MsgRequestSchemaSignatureResponse)
+	err := c.cc.Invoke(ctx, Msg_RequestSchemaSignature_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -125,6 +149,10 @@ type MsgServer interface {
 	SubmitPartialSignature(context.Context, *MsgSubmitPartialSignature) (*MsgSubmitPartialSignatureResponse, error)
 	// RequestThresholdSignature allows external users to request a threshold signature
 	RequestThresholdSignature(context.Context, *MsgRequestThresholdSignature) (*MsgRequestThresholdSignatureResponse, error)
+	// SetSigningSchema defines a (governance) operation for adding or replacing a signing schema
+	SetSigningSchema(context.Context, *MsgSetSigningSchema) (*MsgSetSigningSchemaResponse, error)
+	// RequestSchemaSignature allows users to request a threshold signature over a payload of a whitelisted schema
+	RequestSchemaSignature(context.Context, *MsgRequestSchemaSignature) (*MsgRequestSchemaSignatureResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -150,6 +178,12 @@ func (UnimplementedMsgServer) SubmitPartialSignature(context.Context, *MsgSubmit
 func (UnimplementedMsgServer) RequestThresholdSignature(context.Context, *MsgRequestThresholdSignature) (*MsgRequestThresholdSignatureResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestThresholdSignature not implemented")
 }
+func (UnimplementedMsgServer) SetSigningSchema(context.Context, *MsgSetSigningSchema) (*MsgSetSigningSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSigningSchema not implemented")
+}
+func (UnimplementedMsgServer) RequestSchemaSignature(context.Context, *MsgRequestSchemaSignature) (*MsgRequestSchemaSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSchemaSignature not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -271,6 +305,42 @@ func _Msg_RequestThresholdSignature_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetSigningSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSigningSchema)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSigningSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSigningSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSigningSchema(ctx, req.(*MsgSetSigningSchema))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestSchemaSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestSchemaSignature)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestSchemaSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RequestSchemaSignature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestSchemaSignature(ctx, req.(*MsgRequestSchemaSignature))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -302,6 +372,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestThresholdSignature",
 			Handler:    _Msg_RequestThresholdSignature_Handler,
 		},
+		{
+			MethodName: "SetSigningSchema",
+			Handler:    _Msg_SetSigningSchema_Handler,
+		},
+		{
+			MethodName: "RequestSchemaSignature",
+			Handler:    _Msg_RequestSchemaSignature_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/bls/tx.proto",
@@ -2541,6 +2541,57 @@ func (x *_EpochBLSData_11_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_EpochBLSData_14_list)(nil)
+
+type _EpochBLSData_14_list struct {
+	list *[]*DKGAttemptRecord
+}
+
+func (x *_EpochBLSData_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EpochBLSData_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EpochBLSData_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DKGAttemptRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EpochBLSData_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DKGAttemptRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EpochBLSData_14_list) AppendMutable() protoreflect.Value {
+	v := new(DKGAttemptRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EpochBLSData_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EpochBLSData_14_list) NewElement() protoreflect.Value {
+	v := new(DKGAttemptRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EpochBLSData_14_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_EpochBLSData                                protoreflect.MessageDescriptor
 	fd_EpochBLSData_epoch_id                       protoreflect.FieldDescriptor
@@ -2555,6 +2606,9 @@ var (
 	fd_EpochBLSData_verification_submissions       protoreflect.FieldDescriptor
 	fd_EpochBLSData_valid_dealers                  protoreflect.FieldDescriptor
 	fd_EpochBLSData_validation_signature           protoreflect.FieldDescriptor
+	fd_EpochBLSData_dkg_attempt                    protoreflect.FieldDescriptor
+	fd_EpochBLSData_failed_attempts                protoreflect.FieldDescriptor
+	fd_EpochBLSData_key_carried_from_epoch         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2572,6 +2626,9 @@ func init() {
 	fd_EpochBLSData_verification_submissions = md_EpochBLSData.Fields().ByName("verification_submissions")
 	fd_EpochBLSData_valid_dealers = md_EpochBLSData.Fields().ByName("valid_dealers")
 	fd_EpochBLSData_validation_signature = md_EpochBLSData.Fields().ByName("validation_signature")
+	fd_EpochBLSData_dkg_attempt = md_EpochBLSData.Fields().ByName("dkg_attempt")
+	fd_EpochBLSData_failed_attempts = md_EpochBLSData.Fields().ByName("failed_attempts")
+	fd_EpochBLSData_key_carried_from_epoch = md_EpochBLSData.Fields().ByName("key_carried_from_epoch")
 }
 
 var _ protoreflect.Message = (*fastReflection_EpochBLSData)(nil)
@@ -2711,6 +2768,24 @@ func (x *fastReflection_EpochBLSData) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.DkgAttempt != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DkgAttempt)
+		if !f(fd_EpochBLSData_dkg_attempt, value) {
+			return
+		}
+	}
+	if len(x.FailedAttempts) != 0 {
+		value := protoreflect.ValueOfList(&_EpochBLSData_14_list{list: &x.FailedAttempts})
+		if !f(fd_EpochBLSData_failed_attempts, value) {
+			return
+		}
+	}
+	if x.KeyCarriedFromEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.KeyCarriedFromEpoch)
+		if !f(fd_EpochBLSData_key_carried_from_epoch, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2750,6 +2825,12 @@ func (x *fastReflection_EpochBLSData) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.ValidDealers) != 0
 	case "inference.bls.EpochBLSData.validation_signature":
 		return len(x.ValidationSignature) != 0
+	case "inference.bls.EpochBLSData.dkg_attempt":
+		return x.DkgAttempt != uint32(0)
+	case "inference.bls.EpochBLSData.failed_attempts":
+		return len(x.FailedAttempts) != 0
+	case "inference.bls.EpochBLSData.key_carried_from_epoch":
+		return x.KeyCarriedFromEpoch != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochBLSData"))
@@ -2790,6 +2871,12 @@ func (x *fastReflection_EpochBLSData) Clear(fd protoreflect.FieldDescriptor) {
 		x.ValidDealers = nil
 	case "inference.bls.EpochBLSData.validation_signature":
 		x.ValidationSignature = nil
+	case "inference.bls.EpochBLSData.dkg_attempt":
+		x.DkgAttempt = uint32(0)
+	case "inference.bls.EpochBLSData.failed_attempts":
+		x.FailedAttempts = nil
+	case "inference.bls.EpochBLSData.key_carried_from_epoch":
+		x.KeyCarriedFromEpoch = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochBLSData"))
@@ -2854,6 +2941,18 @@ func (x *fastReflection_EpochBLSData) Get(descriptor protoreflect.FieldDescripto
 	case "inference.bls.EpochBLSData.validation_signature":
 		value := x.ValidationSignature
 		return protoreflect.ValueOfBytes(value)
+	case "inference.bls.EpochBLSData.dkg_attempt":
+		value := x.DkgAttempt
+		return protoreflect.ValueOfUint32(value)
+	case "inference.bls.EpochBLSData.failed_attempts":
+		if len(x.FailedAttempts) == 0 {
+			return protoreflect.ValueOfList(&_EpochBLSData_14_list{})
+		}
+		listValue := &_EpochBLSData_14_list{list: &x.FailedAttempts}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.bls.EpochBLSData.key_carried_from_epoch":
+		value := x.KeyCarriedFromEpoch
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochBLSData"))
@@ -2906,6 +3005,14 @@ func (x *fastReflection_EpochBLSData) Set(fd protoreflect.FieldDescriptor, value
 		x.ValidDealers = *clv.list
 	case "inference.bls.EpochBLSData.validation_signature":
 		x.ValidationSignature = value.Bytes()
+	case "inference.bls.EpochBLSData.dkg_attempt":
+		x.DkgAttempt = uint32(value.Uint())
+	case "inference.bls.EpochBLSData.failed_attempts":
+		lv := value.List()
+		clv := lv.(*_EpochBLSData_14_list)
+		x.FailedAttempts = *clv.list
+	case "inference.bls.EpochBLSData.key_carried_from_epoch":
+		x.KeyCarriedFromEpoch = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochBLSData"))
@@ -2950,6 +3057,12 @@ func (x *fastReflection_EpochBLSData) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_EpochBLSData_11_list{list: &x.ValidDealers}
 		return protoreflect.ValueOfList(value)
+	case "inference.bls.EpochBLSData.failed_attempts":
+		if x.FailedAttempts == nil {
+			x.FailedAttempts = []*DKGAttemptRecord{}
+		}
+		value := &_EpochBLSData_14_list{list: &x.FailedAttempts}
+		return protoreflect.ValueOfList(value)
 	case "inference.bls.EpochBLSData.epoch_id":
 		panic(fmt.Errorf("field epoch_id of message inference.bls.EpochBLSData is not mutable"))
 	case "inference.bls.EpochBLSData.i_total_slots":
@@ -2966,6 +3079,10 @@ func (x *fastReflection_EpochBLSData) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field group_public_key of message inference.bls.EpochBLSData is not mutable"))
 	case "inference.bls.EpochBLSData.validation_signature":
 		panic(fmt.Errorf("field validation_signature of message inference.bls.EpochBLSData is not mutable"))
+	case "inference.bls.EpochBLSData.dkg_attempt":
+		panic(fmt.Errorf("field dkg_attempt of message inference.bls.EpochBLSData is not mutable"))
+	case "inference.bls.EpochBLSData.key_carried_from_epoch":
+		panic(fmt.Errorf("field key_carried_from_epoch of message inference.bls.EpochBLSData is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochBLSData"))
@@ -3007,6 +3124,13 @@ func (x *fastReflection_EpochBLSData) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfList(&_EpochBLSData_11_list{list: &list})
 	case "inference.bls.EpochBLSData.validation_signature":
 		return protoreflect.ValueOfBytes(nil)
+	case "inference.bls.EpochBLSData.dkg_attempt":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "inference.bls.EpochBLSData.failed_attempts":
+		list := []*DKGAttemptRecord{}
+		return protoreflect.ValueOfList(&_EpochBLSData_14_list{list: &list})
+	case "inference.bls.EpochBLSData.key_carried_from_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.EpochBLSData"))
@@ -3123,6 +3247,18 @@ func (x *fastReflection_EpochBLSData) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.DkgAttempt != 0 {
+			n += 1 + runtime.Sov(uint64(x.DkgAttempt))
+		}
+		if len(x.FailedAttempts) > 0 {
+			for _, e := range x.FailedAttempts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.KeyCarriedFromEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.KeyCarriedFromEpoch))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3152,6 +3288,32 @@ func (x *fastReflection_EpochBLSData) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.KeyCarriedFromEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.KeyCarriedFromEpoch))
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.FailedAttempts) > 0 {
+			for iNdEx := len(x.FailedAttempts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FailedAttempts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if x.DkgAttempt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgAttempt))
+			i--
+			dAtA[i] = 0x68
+		}
 		if len(x.ValidationSignature) > 0 {
 			i -= len(x.ValidationSignature)
 			copy(dAtA[i:], x.ValidationSignature)
@@ -3660,6 +3822,78 @@ func (x *fastReflection_EpochBLSData) ProtoMethods() *protoiface.Methods {
 					x.ValidationSignature = []byte{}
 				}
 				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgAttempt", wireType)
+				}
+				x.DkgAttempt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DkgAttempt |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FailedAttempts = append(x.FailedAttempts, &DKGAttemptRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FailedAttempts[len(x.FailedAttempts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyCarriedFromEpoch", wireType)
+				}
+				x.KeyCarriedFromEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.KeyCarriedFromEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3695,127 +3929,831 @@ func (x *fastReflection_EpochBLSData) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: inference/bls/types.proto
+var _ protoreflect.List = (*_DKGAttemptRecord_4_list)(nil)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+type _DKGAttemptRecord_4_list struct {
+	list *[]string
+}
 
-// DKGPhase defines the different phases of the Distributed Key Generation process
-type DKGPhase int32
+func (x *_DKGAttemptRecord_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-const (
-	// UNDEFINED represents an uninitialized or unknown phase
-	DKGPhase_DKG_PHASE_UNDEFINED DKGPhase = 0
-	// DEALING represents the phase where participants submit their dealing parts
-	DKGPhase_DKG_PHASE_DEALING DKGPhase = 1
-	// VERIFYING represents the phase where participants verify dealing parts
-	DKGPhase_DKG_PHASE_VERIFYING DKGPhase = 2
-	// COMPLETED represents the phase where DKG has successfully completed
-	DKGPhase_DKG_PHASE_COMPLETED DKGPhase = 3
-	// FAILED represents the phase where DKG has failed
-	DKGPhase_DKG_PHASE_FAILED DKGPhase = 4
-	// SIGNED represents the phase where DKG has completed and been validated by previous epoch
-	DKGPhase_DKG_PHASE_SIGNED DKGPhase = 5
-)
+func (x *_DKGAttemptRecord_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
 
-// Enum value maps for DKGPhase.
-var (
-	DKGPhase_name = map[int32]string{
-		0: "DKG_PHASE_UNDEFINED",
-		1: "DKG_PHASE_DEALING",
-		2: "DKG_PHASE_VERIFYING",
-		3: "DKG_PHASE_COMPLETED",
-		4: "DKG_PHASE_FAILED",
-		5: "DKG_PHASE_SIGNED",
-	}
-	DKGPhase_value = map[string]int32{
-		"DKG_PHASE_UNDEFINED": 0,
-		"DKG_PHASE_DEALING":   1,
-		"DKG_PHASE_VERIFYING": 2,
-		"DKG_PHASE_COMPLETED": 3,
-		"DKG_PHASE_FAILED":    4,
-		"DKG_PHASE_SIGNED":    5,
-	}
-)
+func (x *_DKGAttemptRecord_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
 
-func (x DKGPhase) Enum() *DKGPhase {
-	p := new(DKGPhase)
-	*p = x
-	return p
+func (x *_DKGAttemptRecord_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x DKGPhase) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *_DKGAttemptRecord_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DKGAttemptRecord at list field ExcludedParticipants as it is not of Message kind"))
 }
 
-func (DKGPhase) Descriptor() protoreflect.EnumDescriptor {
-	return file_inference_bls_types_proto_enumTypes[0].Descriptor()
+func (x *_DKGAttemptRecord_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-func (DKGPhase) Type() protoreflect.EnumType {
-	return &file_inference_bls_types_proto_enumTypes[0]
+func (x *_DKGAttemptRecord_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x DKGPhase) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *_DKGAttemptRecord_4_list) IsValid() bool {
+	return x.list != nil
 }
 
-// Deprecated: Use DKGPhase.Descriptor instead.
-func (DKGPhase) EnumDescriptor() ([]byte, []int) {
-	return file_inference_bls_types_proto_rawDescGZIP(), []int{0}
+var (
+	md_DKGAttemptRecord                       protoreflect.MessageDescriptor
+	fd_DKGAttemptRecord_attempt               protoreflect.FieldDescriptor
+	fd_DKGAttemptRecord_reason                protoreflect.FieldDescriptor
+	fd_DKGAttemptRecord_failed_at_height      protoreflect.FieldDescriptor
+	fd_DKGAttemptRecord_excluded_participants protoreflect.FieldDescriptor
+	fd_DKGAttemptRecord_excluded_weight       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_bls_types_proto_init()
+	md_DKGAttemptRecord = File_inference_bls_types_proto.Messages().ByName("DKGAttemptRecord")
+	fd_DKGAttemptRecord_attempt = md_DKGAttemptRecord.Fields().ByName("attempt")
+	fd_DKGAttemptRecord_reason = md_DKGAttemptRecord.Fields().ByName("reason")
+	fd_DKGAttemptRecord_failed_at_height = md_DKGAttemptRecord.Fields().ByName("failed_at_height")
+	fd_DKGAttemptRecord_excluded_participants = md_DKGAttemptRecord.Fields().ByName("excluded_participants")
+	fd_DKGAttemptRecord_excluded_weight = md_DKGAttemptRecord.Fields().ByName("excluded_weight")
 }
 
-// BLSParticipantInfo contains information about a participant in the DKG process
-type BLSParticipantInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.Message = (*fastReflection_DKGAttemptRecord)(nil)
 
-	// address is the participant's address
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// percentage_weight is the participant's weight in the validation set (as string for sdk.Dec compatibility)
-	PercentageWeight string `protobuf:"bytes,2,opt,name=percentage_weight,json=percentageWeight,proto3" json:"percentage_weight,omitempty"`
-	// secp256k1_public_key is the participant's secp256k1 public key for encryption
-	Secp256K1PublicKey []byte `protobuf:"bytes,3,opt,name=secp256k1_public_key,json=secp256k1PublicKey,proto3" json:"secp256k1_public_key,omitempty"`
-	// slot_start_index is the first slot index assigned to this participant
-	SlotStartIndex uint32 `protobuf:"varint,4,opt,name=slot_start_index,json=slotStartIndex,proto3" json:"slot_start_index,omitempty"`
-	// slot_end_index is the last slot index assigned to this participant (inclusive)
-	SlotEndIndex uint32 `protobuf:"varint,5,opt,name=slot_end_index,json=slotEndIndex,proto3" json:"slot_end_index,omitempty"`
+type fastReflection_DKGAttemptRecord DKGAttemptRecord
+
+func (x *DKGAttemptRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DKGAttemptRecord)(x)
 }
 
-func (x *BLSParticipantInfo) Reset() {
-	*x = BLSParticipantInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_bls_types_proto_msgTypes[0]
+func (x *DKGAttemptRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_bls_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *BLSParticipantInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_DKGAttemptRecord_messageType fastReflection_DKGAttemptRecord_messageType
+var _ protoreflect.MessageType = fastReflection_DKGAttemptRecord_messageType{}
 
-func (*BLSParticipantInfo) ProtoMessage() {}
+type fastReflection_DKGAttemptRecord_messageType struct{}
 
-// Deprecated: Use BLSParticipantInfo.ProtoReflect.Descriptor instead.
-func (*BLSParticipantInfo) Descriptor() ([]byte, []int) {
-	return file_inference_bls_types_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_DKGAttemptRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DKGAttemptRecord)(nil)
 }
-
-func (x *BLSParticipantInfo) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+func (x fastReflection_DKGAttemptRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_DKGAttemptRecord)
+}
+func (x fastReflection_DKGAttemptRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGAttemptRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DKGAttemptRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGAttemptRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DKGAttemptRecord) Type() protoreflect.MessageType {
+	return _fastReflection_DKGAttemptRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DKGAttemptRecord) New() protoreflect.Message {
+	return new(fastReflection_DKGAttemptRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DKGAttemptRecord) Interface() protoreflect.ProtoMessage {
+	return (*DKGAttemptRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DKGAttemptRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Attempt != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Attempt)
+		if !f(fd_DKGAttemptRecord_attempt, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_DKGAttemptRecord_reason, value) {
+			return
+		}
+	}
+	if x.FailedAtHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FailedAtHeight)
+		if !f(fd_DKGAttemptRecord_failed_at_height, value) {
+			return
+		}
+	}
+	if len(x.ExcludedParticipants) != 0 {
+		value := protoreflect.ValueOfList(&_DKGAttemptRecord_4_list{list: &x.ExcludedParticipants})
+		if !f(fd_DKGAttemptRecord_excluded_participants, value) {
+			return
+		}
+	}
+	if x.ExcludedWeight != "" {
+		value := protoreflect.ValueOfString(x.ExcludedWeight)
+		if !f(fd_DKGAttemptRecord_excluded_weight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DKGAttemptRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.bls.DKGAttemptRecord.attempt":
+		return x.Attempt != uint32(0)
+	case "inference.bls.DKGAttemptRecord.reason":
+		return x.Reason != ""
+	case "inference.bls.DKGAttemptRecord.failed_at_height":
+		return x.FailedAtHeight != int64(0)
+	case "inference.bls.DKGAttemptRecord.excluded_participants":
+		return len(x.ExcludedParticipants) != 0
+	case "inference.bls.DKGAttemptRecord.excluded_weight":
+		return x.ExcludedWeight != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.DKGAttemptRecord"))
+		}
+		panic(fmt.Errorf("message inference.bls.DKGAttemptRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGAttemptRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.bls.DKGAttemptRecord.attempt":
+		x.Attempt = uint32(0)
+	case "inference.bls.DKGAttemptRecord.reason":
+		x.Reason = ""
+	case "inference.bls.DKGAttemptRecord.failed_at_height":
+		x.FailedAtHeight = int64(0)
+	case "inference.bls.DKGAttemptRecord.excluded_participants":
+		x.ExcludedParticipants = nil
+	case "inference.bls.DKGAttemptRecord.excluded_weight":
+		x.ExcludedWeight = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.DKGAttemptRecord"))
+		}
+		panic(fmt.Errorf("message inference.bls.DKGAttemptRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DKGAttemptRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.bls.DKGAttemptRecord.attempt":
+		value := x.Attempt
+		return protoreflect.ValueOfUint32(value)
+	case "inference.bls.DKGAttemptRecord.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "inference.bls.DKGAttemptRecord.failed_at_height":
+		value := x.FailedAtHeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.bls.DKGAttemptRecord.excluded_participants":
+		if len(x.ExcludedParticipants) == 0 {
+			return protoreflect.ValueOfList(&_DKGAttemptRecord_4_list{})
+		}
+		listValue := &_DKGAttemptRecord_4_list{list: &x.ExcludedParticipants}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.bls.DKGAttemptRecord.excluded_weight":
+		value := x.ExcludedWeight
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.DKGAttemptRecord"))
+		}
+		panic(fmt.Errorf("message inference.bls.DKGAttemptRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGAttemptRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.bls.DKGAttemptRecord.attempt":
+		x.Attempt = uint32(value.Uint())
+	case "inference.bls.DKGAttemptRecord.reason":
+		x.Reason = value.Interface().(string)
+	case "inference.bls.DKGAttemptRecord.failed_at_height":
+		x.FailedAtHeight = value.Int()
+	case "inference.bls.DKGAttemptRecord.excluded_participants":
+		lv := value.List()
+		clv := lv.(*_DKGAttemptRecord_4_list)
+		x.ExcludedParticipants = *clv.list
+	case "inference.bls.DKGAttemptRecord.excluded_weight":
+		x.ExcludedWeight = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.DKGAttemptRecord"))
+		}
+		panic(fmt.Errorf("message inference.bls.DKGAttemptRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGAttemptRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.DKGAttemptRecord.excluded_participants":
+		if x.ExcludedParticipants == nil {
+			x.ExcludedParticipants = []string{}
+		}
+		value := &_DKGAttemptRecord_4_list{list: &x.ExcludedParticipants}
+		return protoreflect.ValueOfList(value)
+	case "inference.bls.DKGAttemptRecord.attempt":
+		panic(fmt.Errorf("field attempt of message inference.bls.DKGAttemptRecord is not mutable"))
+	case "inference.bls.DKGAttemptRecord.reason":
+		panic(fmt.Errorf("field reason of message inference.bls.DKGAttemptRecord is not mutable"))
+	case "inference.bls.DKGAttemptRecord.failed_at_height":
+		panic(fmt.Errorf("field failed_at_height of message inference.bls.DKGAttemptRecord is not mutable"))
+	case "inference.bls.DKGAttemptRecord.excluded_weight":
+		panic(fmt.Errorf("field excluded_weight of message inference.bls.DKGAttemptRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.DKGAttemptRecord"))
+		}
+		panic(fmt.Errorf("message inference.bls.DKGAttemptRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DKGAttemptRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.bls.DKGAttemptRecord.attempt":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "inference.bls.DKGAttemptRecord.reason":
+		return protoreflect.ValueOfString("")
+	case "inference.bls.DKGAttemptRecord.failed_at_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.bls.DKGAttemptRecord.excluded_participants":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DKGAttemptRecord_4_list{list: &list})
+	case "inference.bls.DKGAttemptRecord.excluded_weight":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.bls.DKGAttemptRecord"))
+		}
+		panic(fmt.Errorf("message inference.bls.DKGAttemptRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DKGAttemptRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.bls.DKGAttemptRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DKGAttemptRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGAttemptRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DKGAttemptRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DKGAttemptRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DKGAttemptRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Attempt != 0 {
+			n += 1 + runtime.Sov(uint64(x.Attempt))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FailedAtHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.FailedAtHeight))
+		}
+		if len(x.ExcludedParticipants) > 0 {
+			for _, s := range x.ExcludedParticipants {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.ExcludedWeight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DKGAttemptRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ExcludedWeight) > 0 {
+			i -= len(x.ExcludedWeight)
+			copy(dAtA[i:], x.ExcludedWeight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExcludedWeight)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ExcludedParticipants) > 0 {
+			for iNdEx := len(x.ExcludedParticipants) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ExcludedParticipants[iNdEx])
+				copy(dAtA[i:], x.ExcludedParticipants[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExcludedParticipants[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.FailedAtHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FailedAtHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Attempt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attempt))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DKGAttemptRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGAttemptRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGAttemptRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+				}
+				x.Attempt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attempt |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedAtHeight", wireType)
+				}
+				x.FailedAtHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FailedAtHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExcludedParticipants", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExcludedParticipants = append(x.ExcludedParticipants, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExcludedWeight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExcludedWeight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/bls/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DKGPhase defines the different phases of the Distributed Key Generation process
+type DKGPhase int32
+
+const (
+	// UNDEFINED represents an uninitialized or unknown phase
+	DKGPhase_DKG_PHASE_UNDEFINED DKGPhase = 0
+	// DEALING represents the phase where participants submit their dealing parts
+	DKGPhase_DKG_PHASE_DEALING DKGPhase = 1
+	// VERIFYING represents the phase where participants verify dealing parts
+	DKGPhase_DKG_PHASE_VERIFYING DKGPhase = 2
+	// COMPLETED represents the phase where DKG has successfully completed
+	DKGPhase_DKG_PHASE_COMPLETED DKGPhase = 3
+	// FAILED represents the phase where DKG has failed
+	DKGPhase_DKG_PHASE_FAILED DKGPhase = 4
+	// SIGNED represents the phase where DKG has completed and been validated by previous epoch
+	DKGPhase_DKG_PHASE_SIGNED DKGPhase = 5
+)
+
+// Enum value maps for DKGPhase.
+var (
+	DKGPhase_name = map[int32]string{
+		0: "DKG_PHASE_UNDEFINED",
+		1: "DKG_PHASE_DEALING",
+		2: "DKG_PHASE_VERIFYING",
+		3: "DKG_PHASE_COMPLETED",
+		4: "DKG_PHASE_FAILED",
+		5: "DKG_PHASE_SIGNED",
+	}
+	DKGPhase_value = map[string]int32{
+		"DKG_PHASE_UNDEFINED": 0,
+		"DKG_PHASE_DEALING":   1,
+		"DKG_PHASE_VERIFYING": 2,
+		"DKG_PHASE_COMPLETED": 3,
+		"DKG_PHASE_FAILED":    4,
+		"DKG_PHASE_SIGNED":    5,
+	}
+)
+
+func (x DKGPhase) Enum() *DKGPhase {
+	p := new(DKGPhase)
+	*p = x
+	return p
+}
+
+func (x DKGPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DKGPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_inference_bls_types_proto_enumTypes[0].Descriptor()
+}
+
+func (DKGPhase) Type() protoreflect.EnumType {
+	return &file_inference_bls_types_proto_enumTypes[0]
+}
+
+func (x DKGPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DKGPhase.Descriptor instead.
+func (DKGPhase) EnumDescriptor() ([]byte, []int) {
+	return file_inference_bls_types_proto_rawDescGZIP(), []int{0}
+}
+
+// BLSParticipantInfo contains information about a participant in the DKG process
+type BLSParticipantInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the participant's address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// percentage_weight is the participant's weight in the validation set (as string for sdk.Dec compatibility)
+	PercentageWeight string `protobuf:"bytes,2,opt,name=percentage_weight,json=percentageWeight,proto3" json:"percentage_weight,omitempty"`
+	// secp256k1_public_key is the participant's secp256k1 public key for encryption
+	Secp256K1PublicKey []byte `protobuf:"bytes,3,opt,name=secp256k1_public_key,json=secp256k1PublicKey,proto3" json:"secp256k1_public_key,omitempty"`
+	// slot_start_index is the first slot index assigned to this participant
+	SlotStartIndex uint32 `protobuf:"varint,4,opt,name=slot_start_index,json=slotStartIndex,proto3" json:"slot_start_index,omitempty"`
+	// slot_end_index is the last slot index assigned to this participant (inclusive)
+	SlotEndIndex uint32 `protobuf:"varint,5,opt,name=slot_end_index,json=slotEndIndex,proto3" json:"slot_end_index,omitempty"`
+}
+
+func (x *BLSParticipantInfo) Reset() {
+	*x = BLSParticipantInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_bls_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BLSParticipantInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BLSParticipantInfo) ProtoMessage() {}
+
+// Deprecated: Use BLSParticipantInfo.ProtoReflect.Descriptor instead.
+func (*BLSParticipantInfo) Descriptor() ([]byte, []int) {
+	return file_inference_bls_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BLSParticipantInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
 }
 
 func (x *BLSParticipantInfo) GetPercentageWeight() string {
@@ -4019,6 +4957,13 @@ type EpochBLSData struct {
 	// For Epoch N+1, stores the signature from Epoch N validators confirming the new group public key
 	// Format: 48-byte G1 compressed signature
 	ValidationSignature []byte `protobuf:"bytes,12,opt,name=validation_signature,json=validationSignature,proto3" json:"validation_signature,omitempty"`
+	// dkg_attempt counts the dealing rounds restarted for this epoch after a failed attempt (0 for the first round)
+	DkgAttempt uint32 `protobuf:"varint,13,opt,name=dkg_attempt,json=dkgAttempt,proto3" json:"dkg_attempt,omitempty"`
+	// failed_attempts records every failed DKG attempt of this epoch in order
+	FailedAttempts []*DKGAttemptRecord `protobuf:"bytes,14,rep,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	// key_carried_from_epoch is set when DKG failed for good and the epoch uses the group public key of an earlier epoch.
+	// group_public_key then holds that key and threshold signing is done by that epoch's participants.
+	KeyCarriedFromEpoch uint64 `protobuf:"varint,15,opt,name=key_carried_from_epoch,json=keyCarriedFromEpoch,proto3" json:"key_carried_from_epoch,omitempty"`
 }
 
 func (x *EpochBLSData) Reset() {
@@ -4125,6 +5070,100 @@ func (x *EpochBLSData) GetValidationSignature() []byte {
 	return nil
 }
 
+func (x *EpochBLSData) GetDkgAttempt() uint32 {
+	if x != nil {
+		return x.DkgAttempt
+	}
+	return 0
+}
+
+func (x *EpochBLSData) GetFailedAttempts() []*DKGAttemptRecord {
+	if x != nil {
+		return x.FailedAttempts
+	}
+	return nil
+}
+
+func (x *EpochBLSData) GetKeyCarriedFromEpoch() uint64 {
+	if x != nil {
+		return x.KeyCarriedFromEpoch
+	}
+	return 0
+}
+
+// DKGAttemptRecord describes a failed DKG attempt and the participants excluded from the next one
+type DKGAttemptRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// attempt is the dkg_attempt of the failed attempt
+	Attempt uint32 `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// reason describes why the attempt failed
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// failed_at_height is the block height at which the attempt failed
+	FailedAtHeight int64 `protobuf:"varint,3,opt,name=failed_at_height,json=failedAtHeight,proto3" json:"failed_at_height,omitempty"`
+	// excluded_participants are the participants that did not perform in the attempt
+	ExcludedParticipants []string `protobuf:"bytes,4,rep,name=excluded_participants,json=excludedParticipants,proto3" json:"excluded_participants,omitempty"`
+	// excluded_weight is the total percentage weight of the excluded participants
+	ExcludedWeight string `protobuf:"bytes,5,opt,name=excluded_weight,json=excludedWeight,proto3" json:"excluded_weight,omitempty"`
+}
+
+func (x *DKGAttemptRecord) Reset() {
+	*x = DKGAttemptRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_bls_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DKGAttemptRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DKGAttemptRecord) ProtoMessage() {}
+
+// Deprecated: Use DKGAttemptRecord.ProtoReflect.Descriptor instead.
+func (*DKGAttemptRecord) Descriptor() ([]byte, []int) {
+	return file_inference_bls_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DKGAttemptRecord) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *DKGAttemptRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DKGAttemptRecord) GetFailedAtHeight() int64 {
+	if x != nil {
+		return x.FailedAtHeight
+	}
+	return 0
+}
+
+func (x *DKGAttemptRecord) GetExcludedParticipants() []string {
+	if x != nil {
+		return x.ExcludedParticipants
+	}
+	return nil
+}
+
+func (x *DKGAttemptRecord) GetExcludedWeight() string {
+	if x != nil {
+		return x.ExcludedWeight
+	}
+	return ""
+}
+
 var File_inference_bls_types_proto protoreflect.FileDescriptor
 
 var file_inference_bls_types_proto_rawDesc = []byte{
@@ -4173,7 +5212,7 @@ var file_inference_bls_types_proto_rawDesc = []byte{
 	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x61,
 	0x6c, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x61, 0x6c, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69,
-	0x74, 0x79, 0x22, 0xab, 0x05, 0x0a, 0x0c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x4c, 0x53, 0x44,
+	0x74, 0x79, 0x22, 0xd1, 0x06, 0x0a, 0x0c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x4c, 0x53, 0x44,
 	0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x22,
 	0x0a, 0x0d, 0x69, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18,
@@ -4216,26 +5255,54 @@ var file_inference_bls_types_proto_rawDesc = []byte{
 	0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e,
 	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x76, 0x61, 0x6c,
 	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x2a, 0x98, 0x01, 0x0a, 0x08, 0x44, 0x4b, 0x47, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x17, 0x0a,
-	0x13, 0x44, 0x4b, 0x47, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46,
-	0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x4b, 0x47, 0x5f, 0x50, 0x48,
-	0x41, 0x53, 0x45, 0x5f, 0x44, 0x45, 0x41, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x17, 0x0a,
-	0x13, 0x44, 0x4b, 0x47, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46,
-	0x59, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x4b, 0x47, 0x5f, 0x50, 0x48,
-	0x41, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12,
-	0x14, 0x0a, 0x10, 0x44, 0x4b, 0x47, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x41, 0x49,
-	0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x4b, 0x47, 0x5f, 0x50, 0x48, 0x41,
-	0x53, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x05, 0x42, 0x94, 0x01, 0x0a, 0x11,
-	0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x6c,
-	0x73, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x62, 0x6c, 0x73, 0xa2,
-	0x02, 0x03, 0x49, 0x42, 0x58, 0xaa, 0x02, 0x0d, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x2e, 0x42, 0x6c, 0x73, 0xca, 0x02, 0x0d, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x5c, 0x42, 0x6c, 0x73, 0xe2, 0x02, 0x19, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x5c, 0x42, 0x6c, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x42,
-	0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6b, 0x67, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x6b, 0x67, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x12, 0x4e, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x6c, 0x73, 0x2e, 0x44, 0x4b, 0x47, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x12, 0x33, 0x0a, 0x16, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x64,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x13, 0x6b, 0x65, 0x79, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x99, 0x02, 0x0a, 0x10, 0x44, 0x4b, 0x47, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x28, 0x0a,
+	0x10, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4d, 0x0a, 0x15, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x14, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x5a, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x64, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x2a, 0x98, 0x01, 0x0a, 0x08, 0x44, 0x4b, 0x47, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12,
+	0x17, 0x0a, 0x13, 0x44, 0x4b, 0x47, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x55, 0x4e, 0x44,
+	0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x4b, 0x47, 0x5f,
+	0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x44, 0x45, 0x41, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x17, 0x0a, 0x13, 0x44, 0x4b, 0x47, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x56, 0x45, 0x52,
+	0x49, 0x46, 0x59, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x4b, 0x47, 0x5f,
+	0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x4b, 0x47, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x4b, 0x47, 0x5f, 0x50,
+	0x48, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x05, 0x42, 0x94, 0x01,
+	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x62, 0x6c, 0x73, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x62, 0x6c,
+	0x73, 0xa2, 0x02, 0x03, 0x49, 0x42, 0x58, 0xaa, 0x02, 0x0d, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x2e, 0x42, 0x6c, 0x73, 0xca, 0x02, 0x0d, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5c, 0x42, 0x6c, 0x73, 0xe2, 0x02, 0x19, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5c, 0x42, 0x6c, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a,
+	0x3a, 0x42, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4251,7 +5318,7 @@ func file_inference_bls_types_proto_rawDescGZIP() []byte {
 }
 
 var file_inference_bls_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inference_bls_types_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_inference_bls_types_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_inference_bls_types_proto_goTypes = []interface{}{
 	(DKGPhase)(0),                         // 0: inference.bls.DKGPhase
 	(*BLSParticipantInfo)(nil),            // 1: inference.bls.BLSParticipantInfo
@@ -4259,6 +5326,7 @@ var file_inference_bls_types_proto_goTypes = []interface{}{
 	(*DealerPartStorage)(nil),             // 3: inference.bls.DealerPartStorage
 	(*VerificationVectorSubmission)(nil),  // 4: inference.bls.VerificationVectorSubmission
 	(*EpochBLSData)(nil),                  // 5: inference.bls.EpochBLSData
+	(*DKGAttemptRecord)(nil),              // 6: inference.bls.DKGAttemptRecord
 }
 var file_inference_bls_types_proto_depIdxs = []int32{
 	2, // 0: inference.bls.DealerPartStorage.participant_shares:type_name -> inference.bls.EncryptedSharesForParticipant
@@ -4266,11 +5334,12 @@ var file_inference_bls_types_proto_depIdxs = []int32{
 	0, // 2: inference.bls.EpochBLSData.dkg_phase:type_name -> inference.bls.DKGPhase
 	3, // 3: inference.bls.EpochBLSData.dealer_parts:type_name -> inference.bls.DealerPartStorage
 	4, // 4: inference.bls.EpochBLSData.verification_submissions:type_name -> inference.bls.VerificationVectorSubmission
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	6, // 5: inference.bls.EpochBLSData.failed_attempts:type_name -> inference.bls.DKGAttemptRecord
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_inference_bls_types_proto_init() }
@@ -4339,6 +5408,18 @@ func file_inference_bls_types_proto_init() {
 				return nil
 			}
 		}
+		file_inference_bls_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DKGAttemptRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4346,7 +5427,7 @@ func file_inference_bls_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_inference_bls_types_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
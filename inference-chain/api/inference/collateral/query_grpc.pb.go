@@ -24,6 +24,9 @@ const (
 	Query_AllCollaterals_FullMethodName          = "/inference.collateral.Query/AllCollaterals"
 	Query_UnbondingCollateral_FullMethodName     = "/inference.collateral.Query/UnbondingCollateral"
 	Query_AllUnbondingCollaterals_FullMethodName = "/inference.collateral.Query/AllUnbondingCollaterals"
+	Query_CompensationPool_FullMethodName        = "/inference.collateral.Query/CompensationPool"
+	Query_SlashDistributions_FullMethodName      = "/inference.collateral.Query/SlashDistributions"
+	Query_VestingPledge_FullMethodName           = "/inference.collateral.Query/VestingPledge"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +43,12 @@ type QueryClient interface {
 	UnbondingCollateral(ctx context.Context, in *QueryUnbondingCollateralRequest, opts ...grpc.CallOption) (*QueryUnbondingCollateralResponse, error)
 	// Queries all unbonding collateral.
 	AllUnbondingCollaterals(ctx context.Context, in *QueryAllUnbondingCollateralsRequest, opts ...grpc.CallOption) (*QueryAllUnbondingCollateralsResponse, error)
+	// Queries the balance of the compensation pool funded by slashed collateral.
+	CompensationPool(ctx context.Context, in *QueryCompensationPoolRequest, opts ...grpc.CallOption) (*QueryCompensationPoolResponse, error)
+	// Queries the cumulative slashed amounts per reason and destination.
+	SlashDistributions(ctx context.Context, in *QuerySlashDistributionsRequest, opts ...grpc.CallOption) (*QuerySlashDistributionsResponse, error)
+	// Queries a participant's vesting collateral pledge and the collateral it counts for.
+	VestingPledge(ctx context.Context, in *QueryVestingPledgeRequest, opts ...grpc.CallOption) (*QueryVestingPledgeResponse, error)
 }
 
 type queryClient struct {
@@ -95,6 +104,33 @@ func (c *queryClient) AllUnbondingCollaterals(ctx context.Context, in *QueryAllU
 	return out, nil
 }
 
+func (c *queryClient) CompensationPool(ctx context.Context, in *QueryCompensationPoolRequest, opts ...grpc.CallOption) (*QueryCompensationPoolResponse, error) {
+	out := new(QueryCompensationPoolResponse)
+	err := c.cc.Invoke(ctx, Query_CompensationPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashDistributions(ctx context.Context, in *QuerySlashDistributionsRequest, opts ...grpc.CallOption) (*QuerySlashDistributionsResponse, error) {
+	out := new(QuerySlashDistributionsResponse)
+	err := c.cc.Invoke(ctx, Query_SlashDistributions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingPledge(ctx context.Context, in *QueryVestingPledgeRequest, opts ...grpc.CallOption) (*QueryVestingPledgeResponse, error) {
+	out := new(QueryVestingPledgeResponse)
+	err := c.cc.Invoke(ctx, Query_VestingPledge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -109,6 +145,12 @@ type QueryServer interface {
 	UnbondingCollateral(context.Context, *QueryUnbondingCollateralRequest) (*QueryUnbondingCollateralResponse, error)
 	// Queries all unbonding collateral.
 	AllUnbondingCollaterals(context.Context, *QueryAllUnbondingCollateralsRequest) (*QueryAllUnbondingCollateralsResponse, error)
+	// Queries the balance of the compensation pool funded by slashed collateral.
+	CompensationPool(context.Context, *QueryCompensationPoolRequest) (*QueryCompensationPoolResponse, error)
+	// Queries the cumulative slashed amounts per reason and destination.
+	SlashDistributions(context.Context, *QuerySlashDistributionsRequest) (*QuerySlashDistributionsResponse, error)
+	// Queries a participant's vesting collateral pledge and the collateral it counts for.
+	VestingPledge(context.Context, *QueryVestingPledgeRequest) (*QueryVestingPledgeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -131,6 +173,15 @@ func (UnimplementedQueryServer) UnbondingCollateral(context.Context, *QueryUnbon
 func (UnimplementedQueryServer) AllUnbondingCollaterals(context.Context, *QueryAllUnbondingCollateralsRequest) (*QueryAllUnbondingCollateralsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllUnbondingCollaterals not implemented")
 }
+func (UnimplementedQueryServer) CompensationPool(context.Context, *QueryCompensationPoolRequest) (*QueryCompensationPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompensationPool not implemented")
+}
+func (UnimplementedQueryServer) SlashDistributions(context.Context, *QuerySlashDistributionsRequest) (*QuerySlashDistributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashDistributions not implemented")
+}
+func (UnimplementedQueryServer) VestingPledge(context.Context, *QueryVestingPledgeRequest) (*QueryVestingPledgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingPledge not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -234,6 +285,60 @@ func _Query_AllUnbondingCollaterals_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CompensationPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCompensationPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CompensationPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CompensationPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CompensationPool(ctx, req.(*QueryCompensationPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashDistributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashDistributions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashDistributions(ctx, req.(*QuerySlashDistributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingPledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingPledgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingPledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingPledge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingPledge(ctx, req.(*QueryVestingPledgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +366,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllUnbondingCollaterals",
 			Handler:    _Query_AllUnbondingCollaterals_Handler,
 		},
+		{
+			MethodName: "CompensationPool",
+			Handler:    _Query_CompensationPool_Handler,
+		},
+		{
+			MethodName: "SlashDistributions",
+			Handler:    _Query_SlashDistributions_Handler,
+		},
+		{
+			MethodName: "VestingPledge",
+			Handler:    _Query_VestingPledge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/collateral/query.proto",
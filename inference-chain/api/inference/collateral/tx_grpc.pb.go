@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName       = "/inference.collateral.Msg/UpdateParams"
-	Msg_DepositCollateral_FullMethodName  = "/inference.collateral.Msg/DepositCollateral"
-	Msg_WithdrawCollateral_FullMethodName = "/inference.collateral.Msg/WithdrawCollateral"
+	Msg_UpdateParams_FullMethodName              = "/inference.collateral.Msg/UpdateParams"
+	Msg_DepositCollateral_FullMethodName         = "/inference.collateral.Msg/DepositCollateral"
+	Msg_WithdrawCollateral_FullMethodName        = "/inference.collateral.Msg/WithdrawCollateral"
+	Msg_PledgeVestingCollateral_FullMethodName   = "/inference.collateral.Msg/PledgeVestingCollateral"
+	Msg_UnpledgeVestingCollateral_FullMethodName = "/inference.collateral.Msg/UnpledgeVestingCollateral"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +37,10 @@ type MsgClient interface {
 	DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error)
 	// WithdrawCollateral initiates withdrawal of collateral (subject to unbonding period)
 	WithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateral, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error)
+	// PledgeVestingCollateral pledges the participant's unvested rewards as collateral
+	PledgeVestingCollateral(ctx context.Context, in *MsgPledgeVestingCollateral, opts ...grpc.CallOption) (*MsgPledgeVestingCollateralResponse, error)
+	// UnpledgeVestingCollateral releases the pledge (subject to unbonding period)
+	UnpledgeVestingCollateral(ctx context.Context, in *MsgUnpledgeVestingCollateral, opts ...grpc.CallOption) (*MsgUnpledgeVestingCollateralResponse, error)
 }
 
 type msgClient struct {
@@ -72,6 +78,24 @@ func (c *msgClient) WithdrawCollateral(ctx context.Context, in *MsgWithdrawColla
 	return out, nil
 }
 
+func (c *msgClient) PledgeVestingCollateral(ctx context.Context, in *MsgPledgeVestingCollateral, opts ...grpc.CallOption) (*MsgPledgeVestingCollateralResponse, error) {
+	out := new(MsgPledgeVestingCollateralResponse)
+	err := c.cc.Invoke(ctx, Msg_PledgeVestingCollateral_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpledgeVestingCollateral(ctx context.Context, in *MsgUnpledgeVestingCollateral, opts ...grpc.CallOption) (*MsgUnpledgeVestingCollateralResponse, error) {
+	out := new(MsgUnpledgeVestingCollateralResponse)
+	err := c.cc.Invoke(ctx, Msg_UnpledgeVestingCollateral_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -83,6 +107,10 @@ type MsgServer interface {
 	DepositCollateral(context.Context, *MsgDepositCollateral) (*MsgDepositCollateralResponse, error)
 	// WithdrawCollateral initiates withdrawal of collateral (subject to unbonding period)
 	WithdrawCollateral(context.Context, *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error)
+	// PledgeVestingCollateral pledges the participant's unvested rewards as collateral
+	PledgeVestingCollateral(context.Context, *MsgPledgeVestingCollateral) (*MsgPledgeVestingCollateralResponse, error)
+	// UnpledgeVestingCollateral releases the pledge (subject to unbonding period)
+	UnpledgeVestingCollateral(context.Context, *MsgUnpledgeVestingCollateral) (*MsgUnpledgeVestingCollateralResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -99,6 +127,12 @@ func (UnimplementedMsgServer) DepositCollateral(context.Context, *MsgDepositColl
 func (UnimplementedMsgServer) WithdrawCollateral(context.Context, *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCollateral not implemented")
 }
+func (UnimplementedMsgServer) PledgeVestingCollateral(context.Context, *MsgPledgeVestingCollateral) (*MsgPledgeVestingCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PledgeVestingCollateral not implemented")
+}
+func (UnimplementedMsgServer) UnpledgeVestingCollateral(context.Context, *MsgUnpledgeVestingCollateral) (*MsgUnpledgeVestingCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpledgeVestingCollateral not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -166,6 +200,42 @@ func _Msg_WithdrawCollateral_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PledgeVestingCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPledgeVestingCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PledgeVestingCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PledgeVestingCollateral_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PledgeVestingCollateral(ctx, req.(*MsgPledgeVestingCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpledgeVestingCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpledgeVestingCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpledgeVestingCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnpledgeVestingCollateral_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpledgeVestingCollateral(ctx, req.(*MsgUnpledgeVestingCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -185,6 +255,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawCollateral",
 			Handler:    _Msg_WithdrawCollateral_Handler,
 		},
+		{
+			MethodName: "PledgeVestingCollateral",
+			Handler:    _Msg_PledgeVestingCollateral_Handler,
+		},
+		{
+			MethodName: "UnpledgeVestingCollateral",
+			Handler:    _Msg_UnpledgeVestingCollateral_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/collateral/tx.proto",
@@ -24,6 +24,7 @@ const (
 	Query_TransferHistory_FullMethodName     = "/inference.genesistransfer.Query/TransferHistory"
 	Query_AllowedAccounts_FullMethodName     = "/inference.genesistransfer.Query/AllowedAccounts"
 	Query_TransferEligibility_FullMethodName = "/inference.genesistransfer.Query/TransferEligibility"
+	Query_OwnershipSplits_FullMethodName     = "/inference.genesistransfer.Query/OwnershipSplits"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +41,8 @@ type QueryClient interface {
 	AllowedAccounts(ctx context.Context, in *QueryAllowedAccountsRequest, opts ...grpc.CallOption) (*QueryAllowedAccountsResponse, error)
 	// TransferEligibility validates whether a specific account can be transferred.
 	TransferEligibility(ctx context.Context, in *QueryTransferEligibilityRequest, opts ...grpc.CallOption) (*QueryTransferEligibilityResponse, error)
+	// OwnershipSplits queries the pending and executed ownership splits of a genesis account.
+	OwnershipSplits(ctx context.Context, in *QueryOwnershipSplitsRequest, opts ...grpc.CallOption) (*QueryOwnershipSplitsResponse, error)
 }
 
 type queryClient struct {
@@ -95,6 +98,15 @@ func (c *queryClient) TransferEligibility(ctx context.Context, in *QueryTransfer
 	return out, nil
 }
 
+func (c *queryClient) OwnershipSplits(ctx context.Context, in *QueryOwnershipSplitsRequest, opts ...grpc.CallOption) (*QueryOwnershipSplitsResponse, error) {
+	out := new(QueryOwnershipSplitsResponse)
+	err := c.cc.Invoke(ctx, Query_OwnershipSplits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -109,6 +121,8 @@ type QueryServer interface {
 	AllowedAccounts(context.Context, *QueryAllowedAccountsRequest) (*QueryAllowedAccountsResponse, error)
 	// TransferEligibility validates whether a specific account can be transferred.
 	TransferEligibility(context.Context, *QueryTransferEligibilityRequest) (*QueryTransferEligibilityResponse, error)
+	// OwnershipSplits queries the pending and executed ownership splits of a genesis account.
+	OwnershipSplits(context.Context, *QueryOwnershipSplitsRequest) (*QueryOwnershipSplitsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -131,6 +145,9 @@ func (UnimplementedQueryServer) AllowedAccounts(context.Context, *QueryAllowedAc
 func (UnimplementedQueryServer) TransferEligibility(context.Context, *QueryTransferEligibilityRequest) (*QueryTransferEligibilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferEligibility not implemented")
 }
+func (UnimplementedQueryServer) OwnershipSplits(context.Context, *QueryOwnershipSplitsRequest) (*QueryOwnershipSplitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnershipSplits not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -234,6 +251,24 @@ func _Query_TransferEligibility_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OwnershipSplits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnershipSplitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OwnershipSplits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OwnershipSplits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnershipSplits(ctx, req.(*QueryOwnershipSplitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +296,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TransferEligibility",
 			Handler:    _Query_TransferEligibility_Handler,
 		},
+		{
+			MethodName: "OwnershipSplits",
+			Handler:    _Query_OwnershipSplits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/genesistransfer/query.proto",
@@ -19,8 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName      = "/inference.genesistransfer.Msg/UpdateParams"
-	Msg_TransferOwnership_FullMethodName = "/inference.genesistransfer.Msg/TransferOwnership"
+	Msg_UpdateParams_FullMethodName          = "/inference.genesistransfer.Msg/UpdateParams"
+	Msg_TransferOwnership_FullMethodName     = "/inference.genesistransfer.Msg/TransferOwnership"
+	Msg_ProposeOwnershipSplit_FullMethodName = "/inference.genesistransfer.Msg/ProposeOwnershipSplit"
+	Msg_AcceptOwnershipSplit_FullMethodName  = "/inference.genesistransfer.Msg/AcceptOwnershipSplit"
+	Msg_CancelOwnershipSplit_FullMethodName  = "/inference.genesistransfer.Msg/CancelOwnershipSplit"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,6 +36,13 @@ type MsgClient interface {
 	// TransferOwnership transfers complete ownership of a genesis account including
 	// all liquid balances and vesting schedules to the recipient account
 	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
+	// ProposeOwnershipSplit proposes splitting part or all of a genesis account across several recipients,
+	// preserving its vesting schedule proportionally. The split executes once every recipient accepts it.
+	ProposeOwnershipSplit(ctx context.Context, in *MsgProposeOwnershipSplit, opts ...grpc.CallOption) (*MsgProposeOwnershipSplitResponse, error)
+	// AcceptOwnershipSplit accepts a pending ownership split on behalf of one of its recipients
+	AcceptOwnershipSplit(ctx context.Context, in *MsgAcceptOwnershipSplit, opts ...grpc.CallOption) (*MsgAcceptOwnershipSplitResponse, error)
+	// CancelOwnershipSplit cancels the pending ownership split of a genesis account
+	CancelOwnershipSplit(ctx context.Context, in *MsgCancelOwnershipSplit, opts ...grpc.CallOption) (*MsgCancelOwnershipSplitResponse, error)
 }
 
 type msgClient struct {
@@ -61,6 +71,33 @@ func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwners
 	return out, nil
 }
 
+func (c *msgClient) ProposeOwnershipSplit(ctx context.Context, in *MsgProposeOwnershipSplit, opts ...grpc.CallOption) (*MsgProposeOwnershipSplitResponse, error) {
+	out := new(MsgProposeOwnershipSplitResponse)
+	err := c.cc.Invoke(ctx, Msg_ProposeOwnershipSplit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptOwnershipSplit(ctx context.Context, in *MsgAcceptOwnershipSplit, opts ...grpc.CallOption) (*MsgAcceptOwnershipSplitResponse, error) {
+	out := new(MsgAcceptOwnershipSplitResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptOwnershipSplit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelOwnershipSplit(ctx context.Context, in *MsgCancelOwnershipSplit, opts ...grpc.CallOption) (*MsgCancelOwnershipSplitResponse, error) {
+	out := new(MsgCancelOwnershipSplitResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelOwnershipSplit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -71,6 +108,13 @@ type MsgServer interface {
 	// TransferOwnership transfers complete ownership of a genesis account including
 	// all liquid balances and vesting schedules to the recipient account
 	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
+	// ProposeOwnershipSplit proposes splitting part or all of a genesis account across several recipients,
+	// preserving its vesting schedule proportionally. The split executes once every recipient accepts it.
+	ProposeOwnershipSplit(context.Context, *MsgProposeOwnershipSplit) (*MsgProposeOwnershipSplitResponse, error)
+	// AcceptOwnershipSplit accepts a pending ownership split on behalf of one of its recipients
+	AcceptOwnershipSplit(context.Context, *MsgAcceptOwnershipSplit) (*MsgAcceptOwnershipSplitResponse, error)
+	// CancelOwnershipSplit cancels the pending ownership split of a genesis account
+	CancelOwnershipSplit(context.Context, *MsgCancelOwnershipSplit) (*MsgCancelOwnershipSplitResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -84,6 +128,15 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
 }
+func (UnimplementedMsgServer) ProposeOwnershipSplit(context.Context, *MsgProposeOwnershipSplit) (*MsgProposeOwnershipSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeOwnershipSplit not implemented")
+}
+func (UnimplementedMsgServer) AcceptOwnershipSplit(context.Context, *MsgAcceptOwnershipSplit) (*MsgAcceptOwnershipSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwnershipSplit not implemented")
+}
+func (UnimplementedMsgServer) CancelOwnershipSplit(context.Context, *MsgCancelOwnershipSplit) (*MsgCancelOwnershipSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOwnershipSplit not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -133,6 +186,60 @@ func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ProposeOwnershipSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeOwnershipSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeOwnershipSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ProposeOwnershipSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeOwnershipSplit(ctx, req.(*MsgProposeOwnershipSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptOwnershipSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOwnershipSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOwnershipSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptOwnershipSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOwnershipSplit(ctx, req.(*MsgAcceptOwnershipSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelOwnershipSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOwnershipSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOwnershipSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelOwnershipSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOwnershipSplit(ctx, req.(*MsgCancelOwnershipSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -148,6 +255,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TransferOwnership",
 			Handler:    _Msg_TransferOwnership_Handler,
 		},
+		{
+			MethodName: "ProposeOwnershipSplit",
+			Handler:    _Msg_ProposeOwnershipSplit_Handler,
+		},
+		{
+			MethodName: "AcceptOwnershipSplit",
+			Handler:    _Msg_AcceptOwnershipSplit_Handler,
+		},
+		{
+			MethodName: "CancelOwnershipSplit",
+			Handler:    _Msg_CancelOwnershipSplit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/genesistransfer/tx.proto",
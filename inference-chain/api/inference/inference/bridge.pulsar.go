@@ -4,6 +4,7 @@ package inference
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -2840,30 +2841,84 @@ func (x *fastReflection_BridgeTransaction) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_BridgeBlockAttestation_5_list)(nil)
+
+type _BridgeBlockAttestation_5_list struct {
+	list *[]string
+}
+
+func (x *_BridgeBlockAttestation_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BridgeBlockAttestation_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_BridgeBlockAttestation_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BridgeBlockAttestation_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BridgeBlockAttestation_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message BridgeBlockAttestation at list field Validators as it is not of Message kind"))
+}
+
+func (x *_BridgeBlockAttestation_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BridgeBlockAttestation_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_BridgeBlockAttestation_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_BridgeWrappedTokenContract                        protoreflect.MessageDescriptor
-	fd_BridgeWrappedTokenContract_chainId                protoreflect.FieldDescriptor
-	fd_BridgeWrappedTokenContract_contractAddress        protoreflect.FieldDescriptor
-	fd_BridgeWrappedTokenContract_wrappedContractAddress protoreflect.FieldDescriptor
+	md_BridgeBlockAttestation                      protoreflect.MessageDescriptor
+	fd_BridgeBlockAttestation_chainId              protoreflect.FieldDescriptor
+	fd_BridgeBlockAttestation_blockNumber          protoreflect.FieldDescriptor
+	fd_BridgeBlockAttestation_receiptsRoot         protoreflect.FieldDescriptor
+	fd_BridgeBlockAttestation_epochIndex           protoreflect.FieldDescriptor
+	fd_BridgeBlockAttestation_validators           protoreflect.FieldDescriptor
+	fd_BridgeBlockAttestation_totalValidationPower protoreflect.FieldDescriptor
+	fd_BridgeBlockAttestation_attested             protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_inference_inference_bridge_proto_init()
-	md_BridgeWrappedTokenContract = File_inference_inference_bridge_proto.Messages().ByName("BridgeWrappedTokenContract")
-	fd_BridgeWrappedTokenContract_chainId = md_BridgeWrappedTokenContract.Fields().ByName("chainId")
-	fd_BridgeWrappedTokenContract_contractAddress = md_BridgeWrappedTokenContract.Fields().ByName("contractAddress")
-	fd_BridgeWrappedTokenContract_wrappedContractAddress = md_BridgeWrappedTokenContract.Fields().ByName("wrappedContractAddress")
+	md_BridgeBlockAttestation = File_inference_inference_bridge_proto.Messages().ByName("BridgeBlockAttestation")
+	fd_BridgeBlockAttestation_chainId = md_BridgeBlockAttestation.Fields().ByName("chainId")
+	fd_BridgeBlockAttestation_blockNumber = md_BridgeBlockAttestation.Fields().ByName("blockNumber")
+	fd_BridgeBlockAttestation_receiptsRoot = md_BridgeBlockAttestation.Fields().ByName("receiptsRoot")
+	fd_BridgeBlockAttestation_epochIndex = md_BridgeBlockAttestation.Fields().ByName("epochIndex")
+	fd_BridgeBlockAttestation_validators = md_BridgeBlockAttestation.Fields().ByName("validators")
+	fd_BridgeBlockAttestation_totalValidationPower = md_BridgeBlockAttestation.Fields().ByName("totalValidationPower")
+	fd_BridgeBlockAttestation_attested = md_BridgeBlockAttestation.Fields().ByName("attested")
 }
 
-var _ protoreflect.Message = (*fastReflection_BridgeWrappedTokenContract)(nil)
+var _ protoreflect.Message = (*fastReflection_BridgeBlockAttestation)(nil)
 
-type fastReflection_BridgeWrappedTokenContract BridgeWrappedTokenContract
+type fastReflection_BridgeBlockAttestation BridgeBlockAttestation
 
-func (x *BridgeWrappedTokenContract) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_BridgeWrappedTokenContract)(x)
+func (x *BridgeBlockAttestation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BridgeBlockAttestation)(x)
 }
 
-func (x *BridgeWrappedTokenContract) slowProtoReflect() protoreflect.Message {
+func (x *BridgeBlockAttestation) slowProtoReflect() protoreflect.Message {
 	mi := &file_inference_inference_bridge_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2875,43 +2930,43 @@ func (x *BridgeWrappedTokenContract) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_BridgeWrappedTokenContract_messageType fastReflection_BridgeWrappedTokenContract_messageType
-var _ protoreflect.MessageType = fastReflection_BridgeWrappedTokenContract_messageType{}
+var _fastReflection_BridgeBlockAttestation_messageType fastReflection_BridgeBlockAttestation_messageType
+var _ protoreflect.MessageType = fastReflection_BridgeBlockAttestation_messageType{}
 
-type fastReflection_BridgeWrappedTokenContract_messageType struct{}
+type fastReflection_BridgeBlockAttestation_messageType struct{}
 
-func (x fastReflection_BridgeWrappedTokenContract_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_BridgeWrappedTokenContract)(nil)
+func (x fastReflection_BridgeBlockAttestation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BridgeBlockAttestation)(nil)
 }
-func (x fastReflection_BridgeWrappedTokenContract_messageType) New() protoreflect.Message {
-	return new(fastReflection_BridgeWrappedTokenContract)
+func (x fastReflection_BridgeBlockAttestation_messageType) New() protoreflect.Message {
+	return new(fastReflection_BridgeBlockAttestation)
 }
-func (x fastReflection_BridgeWrappedTokenContract_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_BridgeWrappedTokenContract
+func (x fastReflection_BridgeBlockAttestation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeBlockAttestation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_BridgeWrappedTokenContract) Descriptor() protoreflect.MessageDescriptor {
-	return md_BridgeWrappedTokenContract
+func (x *fastReflection_BridgeBlockAttestation) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeBlockAttestation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_BridgeWrappedTokenContract) Type() protoreflect.MessageType {
-	return _fastReflection_BridgeWrappedTokenContract_messageType
+func (x *fastReflection_BridgeBlockAttestation) Type() protoreflect.MessageType {
+	return _fastReflection_BridgeBlockAttestation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_BridgeWrappedTokenContract) New() protoreflect.Message {
-	return new(fastReflection_BridgeWrappedTokenContract)
+func (x *fastReflection_BridgeBlockAttestation) New() protoreflect.Message {
+	return new(fastReflection_BridgeBlockAttestation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_BridgeWrappedTokenContract) Interface() protoreflect.ProtoMessage {
-	return (*BridgeWrappedTokenContract)(x)
+func (x *fastReflection_BridgeBlockAttestation) Interface() protoreflect.ProtoMessage {
+	return (*BridgeBlockAttestation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2919,22 +2974,46 @@ func (x *fastReflection_BridgeWrappedTokenContract) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_BridgeWrappedTokenContract) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_BridgeBlockAttestation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ChainId != "" {
 		value := protoreflect.ValueOfString(x.ChainId)
-		if !f(fd_BridgeWrappedTokenContract_chainId, value) {
+		if !f(fd_BridgeBlockAttestation_chainId, value) {
 			return
 		}
 	}
-	if x.ContractAddress != "" {
-		value := protoreflect.ValueOfString(x.ContractAddress)
-		if !f(fd_BridgeWrappedTokenContract_contractAddress, value) {
+	if x.BlockNumber != "" {
+		value := protoreflect.ValueOfString(x.BlockNumber)
+		if !f(fd_BridgeBlockAttestation_blockNumber, value) {
 			return
 		}
 	}
-	if x.WrappedContractAddress != "" {
-		value := protoreflect.ValueOfString(x.WrappedContractAddress)
-		if !f(fd_BridgeWrappedTokenContract_wrappedContractAddress, value) {
+	if x.ReceiptsRoot != "" {
+		value := protoreflect.ValueOfString(x.ReceiptsRoot)
+		if !f(fd_BridgeBlockAttestation_receiptsRoot, value) {
+			return
+		}
+	}
+	if x.EpochIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochIndex)
+		if !f(fd_BridgeBlockAttestation_epochIndex, value) {
+			return
+		}
+	}
+	if len(x.Validators) != 0 {
+		value := protoreflect.ValueOfList(&_BridgeBlockAttestation_5_list{list: &x.Validators})
+		if !f(fd_BridgeBlockAttestation_validators, value) {
+			return
+		}
+	}
+	if x.TotalValidationPower != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalValidationPower)
+		if !f(fd_BridgeBlockAttestation_totalValidationPower, value) {
+			return
+		}
+	}
+	if x.Attested != false {
+		value := protoreflect.ValueOfBool(x.Attested)
+		if !f(fd_BridgeBlockAttestation_attested, value) {
 			return
 		}
 	}
@@ -2951,19 +3030,27 @@ func (x *fastReflection_BridgeWrappedTokenContract) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_BridgeWrappedTokenContract) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BridgeBlockAttestation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "inference.inference.BridgeWrappedTokenContract.chainId":
+	case "inference.inference.BridgeBlockAttestation.chainId":
 		return x.ChainId != ""
-	case "inference.inference.BridgeWrappedTokenContract.contractAddress":
-		return x.ContractAddress != ""
-	case "inference.inference.BridgeWrappedTokenContract.wrappedContractAddress":
-		return x.WrappedContractAddress != ""
+	case "inference.inference.BridgeBlockAttestation.blockNumber":
+		return x.BlockNumber != ""
+	case "inference.inference.BridgeBlockAttestation.receiptsRoot":
+		return x.ReceiptsRoot != ""
+	case "inference.inference.BridgeBlockAttestation.epochIndex":
+		return x.EpochIndex != uint64(0)
+	case "inference.inference.BridgeBlockAttestation.validators":
+		return len(x.Validators) != 0
+	case "inference.inference.BridgeBlockAttestation.totalValidationPower":
+		return x.TotalValidationPower != int64(0)
+	case "inference.inference.BridgeBlockAttestation.attested":
+		return x.Attested != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWrappedTokenContract"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeBlockAttestation"))
 		}
-		panic(fmt.Errorf("message inference.inference.BridgeWrappedTokenContract does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.inference.BridgeBlockAttestation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2973,19 +3060,27 @@ func (x *fastReflection_BridgeWrappedTokenContract) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BridgeWrappedTokenContract) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BridgeBlockAttestation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "inference.inference.BridgeWrappedTokenContract.chainId":
+	case "inference.inference.BridgeBlockAttestation.chainId":
 		x.ChainId = ""
-	case "inference.inference.BridgeWrappedTokenContract.contractAddress":
-		x.ContractAddress = ""
-	case "inference.inference.BridgeWrappedTokenContract.wrappedContractAddress":
-		x.WrappedContractAddress = ""
+	case "inference.inference.BridgeBlockAttestation.blockNumber":
+		x.BlockNumber = ""
+	case "inference.inference.BridgeBlockAttestation.receiptsRoot":
+		x.ReceiptsRoot = ""
+	case "inference.inference.BridgeBlockAttestation.epochIndex":
+		x.EpochIndex = uint64(0)
+	case "inference.inference.BridgeBlockAttestation.validators":
+		x.Validators = nil
+	case "inference.inference.BridgeBlockAttestation.totalValidationPower":
+		x.TotalValidationPower = int64(0)
+	case "inference.inference.BridgeBlockAttestation.attested":
+		x.Attested = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWrappedTokenContract"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeBlockAttestation"))
 		}
-		panic(fmt.Errorf("message inference.inference.BridgeWrappedTokenContract does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.inference.BridgeBlockAttestation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2995,22 +3090,37 @@ func (x *fastReflection_BridgeWrappedTokenContract) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_BridgeWrappedTokenContract) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BridgeBlockAttestation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "inference.inference.BridgeWrappedTokenContract.chainId":
+	case "inference.inference.BridgeBlockAttestation.chainId":
 		value := x.ChainId
 		return protoreflect.ValueOfString(value)
-	case "inference.inference.BridgeWrappedTokenContract.contractAddress":
-		value := x.ContractAddress
+	case "inference.inference.BridgeBlockAttestation.blockNumber":
+		value := x.BlockNumber
 		return protoreflect.ValueOfString(value)
-	case "inference.inference.BridgeWrappedTokenContract.wrappedContractAddress":
-		value := x.WrappedContractAddress
+	case "inference.inference.BridgeBlockAttestation.receiptsRoot":
+		value := x.ReceiptsRoot
 		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeBlockAttestation.epochIndex":
+		value := x.EpochIndex
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.BridgeBlockAttestation.validators":
+		if len(x.Validators) == 0 {
+			return protoreflect.ValueOfList(&_BridgeBlockAttestation_5_list{})
+		}
+		listValue := &_BridgeBlockAttestation_5_list{list: &x.Validators}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.BridgeBlockAttestation.totalValidationPower":
+		value := x.TotalValidationPower
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.BridgeBlockAttestation.attested":
+		value := x.Attested
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWrappedTokenContract"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeBlockAttestation"))
 		}
-		panic(fmt.Errorf("message inference.inference.BridgeWrappedTokenContract does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message inference.inference.BridgeBlockAttestation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3024,19 +3134,29 @@ func (x *fastReflection_BridgeWrappedTokenContract) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BridgeWrappedTokenContract) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BridgeBlockAttestation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "inference.inference.BridgeWrappedTokenContract.chainId":
+	case "inference.inference.BridgeBlockAttestation.chainId":
 		x.ChainId = value.Interface().(string)
-	case "inference.inference.BridgeWrappedTokenContract.contractAddress":
-		x.ContractAddress = value.Interface().(string)
-	case "inference.inference.BridgeWrappedTokenContract.wrappedContractAddress":
-		x.WrappedContractAddress = value.Interface().(string)
+	case "inference.inference.BridgeBlockAttestation.blockNumber":
+		x.BlockNumber = value.Interface().(string)
+	case "inference.inference.BridgeBlockAttestation.receiptsRoot":
+		x.ReceiptsRoot = value.Interface().(string)
+	case "inference.inference.BridgeBlockAttestation.epochIndex":
+		x.EpochIndex = value.Uint()
+	case "inference.inference.BridgeBlockAttestation.validators":
+		lv := value.List()
+		clv := lv.(*_BridgeBlockAttestation_5_list)
+		x.Validators = *clv.list
+	case "inference.inference.BridgeBlockAttestation.totalValidationPower":
+		x.TotalValidationPower = value.Int()
+	case "inference.inference.BridgeBlockAttestation.attested":
+		x.Attested = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWrappedTokenContract"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeBlockAttestation"))
 		}
-		panic(fmt.Errorf("message inference.inference.BridgeWrappedTokenContract does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.inference.BridgeBlockAttestation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3050,48 +3170,69 @@ func (x *fastReflection_BridgeWrappedTokenContract) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BridgeWrappedTokenContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BridgeBlockAttestation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "inference.inference.BridgeWrappedTokenContract.chainId":
-		panic(fmt.Errorf("field chainId of message inference.inference.BridgeWrappedTokenContract is not mutable"))
-	case "inference.inference.BridgeWrappedTokenContract.contractAddress":
-		panic(fmt.Errorf("field contractAddress of message inference.inference.BridgeWrappedTokenContract is not mutable"))
-	case "inference.inference.BridgeWrappedTokenContract.wrappedContractAddress":
-		panic(fmt.Errorf("field wrappedContractAddress of message inference.inference.BridgeWrappedTokenContract is not mutable"))
+	case "inference.inference.BridgeBlockAttestation.validators":
+		if x.Validators == nil {
+			x.Validators = []string{}
+		}
+		value := &_BridgeBlockAttestation_5_list{list: &x.Validators}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.BridgeBlockAttestation.chainId":
+		panic(fmt.Errorf("field chainId of message inference.inference.BridgeBlockAttestation is not mutable"))
+	case "inference.inference.BridgeBlockAttestation.blockNumber":
+		panic(fmt.Errorf("field blockNumber of message inference.inference.BridgeBlockAttestation is not mutable"))
+	case "inference.inference.BridgeBlockAttestation.receiptsRoot":
+		panic(fmt.Errorf("field receiptsRoot of message inference.inference.BridgeBlockAttestation is not mutable"))
+	case "inference.inference.BridgeBlockAttestation.epochIndex":
+		panic(fmt.Errorf("field epochIndex of message inference.inference.BridgeBlockAttestation is not mutable"))
+	case "inference.inference.BridgeBlockAttestation.totalValidationPower":
+		panic(fmt.Errorf("field totalValidationPower of message inference.inference.BridgeBlockAttestation is not mutable"))
+	case "inference.inference.BridgeBlockAttestation.attested":
+		panic(fmt.Errorf("field attested of message inference.inference.BridgeBlockAttestation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWrappedTokenContract"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeBlockAttestation"))
 		}
-		panic(fmt.Errorf("message inference.inference.BridgeWrappedTokenContract does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.inference.BridgeBlockAttestation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_BridgeWrappedTokenContract) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BridgeBlockAttestation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "inference.inference.BridgeWrappedTokenContract.chainId":
+	case "inference.inference.BridgeBlockAttestation.chainId":
 		return protoreflect.ValueOfString("")
-	case "inference.inference.BridgeWrappedTokenContract.contractAddress":
+	case "inference.inference.BridgeBlockAttestation.blockNumber":
 		return protoreflect.ValueOfString("")
-	case "inference.inference.BridgeWrappedTokenContract.wrappedContractAddress":
+	case "inference.inference.BridgeBlockAttestation.receiptsRoot":
 		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeBlockAttestation.epochIndex":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.BridgeBlockAttestation.validators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_BridgeBlockAttestation_5_list{list: &list})
+	case "inference.inference.BridgeBlockAttestation.totalValidationPower":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.BridgeBlockAttestation.attested":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWrappedTokenContract"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeBlockAttestation"))
 		}
-		panic(fmt.Errorf("message inference.inference.BridgeWrappedTokenContract does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.inference.BridgeBlockAttestation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_BridgeWrappedTokenContract) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BridgeBlockAttestation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in inference.inference.BridgeWrappedTokenContract", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.BridgeBlockAttestation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3099,7 +3240,7 @@ func (x *fastReflection_BridgeWrappedTokenContract) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_BridgeWrappedTokenContract) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BridgeBlockAttestation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3110,7 +3251,7 @@ func (x *fastReflection_BridgeWrappedTokenContract) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BridgeWrappedTokenContract) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BridgeBlockAttestation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3122,7 +3263,7 @@ func (x *fastReflection_BridgeWrappedTokenContract) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_BridgeWrappedTokenContract) IsValid() bool {
+func (x *fastReflection_BridgeBlockAttestation) IsValid() bool {
 	return x != nil
 }
 
@@ -3132,9 +3273,9 @@ func (x *fastReflection_BridgeWrappedTokenContract) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_BridgeWrappedTokenContract) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BridgeBlockAttestation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*BridgeWrappedTokenContract)
+		x := input.Message.Interface().(*BridgeBlockAttestation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3150,14 +3291,29 @@ func (x *fastReflection_BridgeWrappedTokenContract) ProtoMethods() *protoiface.M
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ContractAddress)
+		l = len(x.BlockNumber)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.WrappedContractAddress)
+		l = len(x.ReceiptsRoot)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.EpochIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochIndex))
+		}
+		if len(x.Validators) > 0 {
+			for _, s := range x.Validators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TotalValidationPower != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalValidationPower))
+		}
+		if x.Attested {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3168,7 +3324,7 @@ func (x *fastReflection_BridgeWrappedTokenContract) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*BridgeWrappedTokenContract)
+		x := input.Message.Interface().(*BridgeBlockAttestation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3187,17 +3343,46 @@ func (x *fastReflection_BridgeWrappedTokenContract) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.WrappedContractAddress) > 0 {
-			i -= len(x.WrappedContractAddress)
-			copy(dAtA[i:], x.WrappedContractAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WrappedContractAddress)))
+		if x.Attested {
+			i--
+			if x.Attested {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.TotalValidationPower != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalValidationPower))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Validators) > 0 {
+			for iNdEx := len(x.Validators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Validators[iNdEx])
+				copy(dAtA[i:], x.Validators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validators[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.EpochIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochIndex))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ReceiptsRoot) > 0 {
+			i -= len(x.ReceiptsRoot)
+			copy(dAtA[i:], x.ReceiptsRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReceiptsRoot)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.ContractAddress) > 0 {
-			i -= len(x.ContractAddress)
-			copy(dAtA[i:], x.ContractAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+		if len(x.BlockNumber) > 0 {
+			i -= len(x.BlockNumber)
+			copy(dAtA[i:], x.BlockNumber)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockNumber)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -3219,7 +3404,7 @@ func (x *fastReflection_BridgeWrappedTokenContract) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*BridgeWrappedTokenContract)
+		x := input.Message.Interface().(*BridgeBlockAttestation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3251,10 +3436,10 @@ func (x *fastReflection_BridgeWrappedTokenContract) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeWrappedTokenContract: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeBlockAttestation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeWrappedTokenContract: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeBlockAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3291,7 +3476,7 @@ func (x *fastReflection_BridgeWrappedTokenContract) ProtoMethods() *protoiface.M
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3319,11 +3504,11 @@ func (x *fastReflection_BridgeWrappedTokenContract) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				x.BlockNumber = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WrappedContractAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3351,16 +3536,106 @@ func (x *fastReflection_BridgeWrappedTokenContract) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.WrappedContractAddress = string(dAtA[iNdEx:postIndex])
+				x.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.EpochIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validators = append(x.Validators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalValidationPower", wireType)
+				}
+				x.TotalValidationPower = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalValidationPower |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attested", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Attested = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
@@ -3388,184 +3663,3215 @@ func (x *fastReflection_BridgeWrappedTokenContract) ProtoMethods() *protoiface.M
 	}
 }
 
-var _ protoreflect.List = (*_Bridge_1_list)(nil)
+var (
+	md_BridgeWrappedTokenContract                        protoreflect.MessageDescriptor
+	fd_BridgeWrappedTokenContract_chainId                protoreflect.FieldDescriptor
+	fd_BridgeWrappedTokenContract_contractAddress        protoreflect.FieldDescriptor
+	fd_BridgeWrappedTokenContract_wrappedContractAddress protoreflect.FieldDescriptor
+)
 
-type _Bridge_1_list struct {
-	list *[]*BridgeContractAddress
+func init() {
+	file_inference_inference_bridge_proto_init()
+	md_BridgeWrappedTokenContract = File_inference_inference_bridge_proto.Messages().ByName("BridgeWrappedTokenContract")
+	fd_BridgeWrappedTokenContract_chainId = md_BridgeWrappedTokenContract.Fields().ByName("chainId")
+	fd_BridgeWrappedTokenContract_contractAddress = md_BridgeWrappedTokenContract.Fields().ByName("contractAddress")
+	fd_BridgeWrappedTokenContract_wrappedContractAddress = md_BridgeWrappedTokenContract.Fields().ByName("wrappedContractAddress")
 }
 
-func (x *_Bridge_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_BridgeWrappedTokenContract)(nil)
 
-func (x *_Bridge_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
+type fastReflection_BridgeWrappedTokenContract BridgeWrappedTokenContract
 
-func (x *_Bridge_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*BridgeContractAddress)
-	(*x.list)[i] = concreteValue
+func (x *BridgeWrappedTokenContract) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BridgeWrappedTokenContract)(x)
 }
 
-func (x *_Bridge_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*BridgeContractAddress)
-	*x.list = append(*x.list, concreteValue)
+func (x *BridgeWrappedTokenContract) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_bridge_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_Bridge_1_list) AppendMutable() protoreflect.Value {
-	v := new(BridgeContractAddress)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
+var _fastReflection_BridgeWrappedTokenContract_messageType fastReflection_BridgeWrappedTokenContract_messageType
+var _ protoreflect.MessageType = fastReflection_BridgeWrappedTokenContract_messageType{}
 
-func (x *_Bridge_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
+type fastReflection_BridgeWrappedTokenContract_messageType struct{}
 
-func (x *_Bridge_1_list) NewElement() protoreflect.Value {
-	v := new(BridgeContractAddress)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x fastReflection_BridgeWrappedTokenContract_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BridgeWrappedTokenContract)(nil)
 }
-
-func (x *_Bridge_1_list) IsValid() bool {
-	return x.list != nil
+func (x fastReflection_BridgeWrappedTokenContract_messageType) New() protoreflect.Message {
+	return new(fastReflection_BridgeWrappedTokenContract)
 }
-
-var _ protoreflect.List = (*_Bridge_2_list)(nil)
-
-type _Bridge_2_list struct {
-	list *[]*BridgeTokenMetadata
+func (x fastReflection_BridgeWrappedTokenContract_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeWrappedTokenContract
 }
 
-func (x *_Bridge_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BridgeWrappedTokenContract) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeWrappedTokenContract
 }
 
-func (x *_Bridge_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BridgeWrappedTokenContract) Type() protoreflect.MessageType {
+	return _fastReflection_BridgeWrappedTokenContract_messageType
 }
 
-func (x *_Bridge_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*BridgeTokenMetadata)
-	(*x.list)[i] = concreteValue
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BridgeWrappedTokenContract) New() protoreflect.Message {
+	return new(fastReflection_BridgeWrappedTokenContract)
 }
 
-func (x *_Bridge_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*BridgeTokenMetadata)
-	*x.list = append(*x.list, concreteValue)
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BridgeWrappedTokenContract) Interface() protoreflect.ProtoMessage {
+	return (*BridgeWrappedTokenContract)(x)
 }
 
-func (x *_Bridge_2_list) AppendMutable() protoreflect.Value {
-	v := new(BridgeTokenMetadata)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BridgeWrappedTokenContract) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_BridgeWrappedTokenContract_chainId, value) {
+			return
+		}
+	}
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_BridgeWrappedTokenContract_contractAddress, value) {
+			return
+		}
+	}
+	if x.WrappedContractAddress != "" {
+		value := protoreflect.ValueOfString(x.WrappedContractAddress)
+		if !f(fd_BridgeWrappedTokenContract_wrappedContractAddress, value) {
+			return
+		}
+	}
 }
 
-func (x *_Bridge_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BridgeWrappedTokenContract) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.BridgeWrappedTokenContract.chainId":
+		return x.ChainId != ""
+	case "inference.inference.BridgeWrappedTokenContract.contractAddress":
+		return x.ContractAddress != ""
+	case "inference.inference.BridgeWrappedTokenContract.wrappedContractAddress":
+		return x.WrappedContractAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWrappedTokenContract"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeWrappedTokenContract does not contain field %s", fd.FullName()))
 	}
-	*x.list = (*x.list)[:n]
 }
 
-func (x *_Bridge_2_list) NewElement() protoreflect.Value {
-	v := new(BridgeTokenMetadata)
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeWrappedTokenContract) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.BridgeWrappedTokenContract.chainId":
+		x.ChainId = ""
+	case "inference.inference.BridgeWrappedTokenContract.contractAddress":
+		x.ContractAddress = ""
+	case "inference.inference.BridgeWrappedTokenContract.wrappedContractAddress":
+		x.WrappedContractAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWrappedTokenContract"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeWrappedTokenContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BridgeWrappedTokenContract) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.BridgeWrappedTokenContract.chainId":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeWrappedTokenContract.contractAddress":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeWrappedTokenContract.wrappedContractAddress":
+		value := x.WrappedContractAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWrappedTokenContract"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeWrappedTokenContract does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeWrappedTokenContract) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.BridgeWrappedTokenContract.chainId":
+		x.ChainId = value.Interface().(string)
+	case "inference.inference.BridgeWrappedTokenContract.contractAddress":
+		x.ContractAddress = value.Interface().(string)
+	case "inference.inference.BridgeWrappedTokenContract.wrappedContractAddress":
+		x.WrappedContractAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWrappedTokenContract"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeWrappedTokenContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeWrappedTokenContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.BridgeWrappedTokenContract.chainId":
+		panic(fmt.Errorf("field chainId of message inference.inference.BridgeWrappedTokenContract is not mutable"))
+	case "inference.inference.BridgeWrappedTokenContract.contractAddress":
+		panic(fmt.Errorf("field contractAddress of message inference.inference.BridgeWrappedTokenContract is not mutable"))
+	case "inference.inference.BridgeWrappedTokenContract.wrappedContractAddress":
+		panic(fmt.Errorf("field wrappedContractAddress of message inference.inference.BridgeWrappedTokenContract is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWrappedTokenContract"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeWrappedTokenContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BridgeWrappedTokenContract) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.BridgeWrappedTokenContract.chainId":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeWrappedTokenContract.contractAddress":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeWrappedTokenContract.wrappedContractAddress":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeWrappedTokenContract"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeWrappedTokenContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BridgeWrappedTokenContract) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.BridgeWrappedTokenContract", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BridgeWrappedTokenContract) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeWrappedTokenContract) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BridgeWrappedTokenContract) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BridgeWrappedTokenContract) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BridgeWrappedTokenContract)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.WrappedContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeWrappedTokenContract)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.WrappedContractAddress) > 0 {
+			i -= len(x.WrappedContractAddress)
+			copy(dAtA[i:], x.WrappedContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WrappedContractAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeWrappedTokenContract)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeWrappedTokenContract: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeWrappedTokenContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WrappedContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WrappedContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Bridge_1_list)(nil)
+
+type _Bridge_1_list struct {
+	list *[]*BridgeContractAddress
+}
+
+func (x *_Bridge_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Bridge_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Bridge_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BridgeContractAddress)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Bridge_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BridgeContractAddress)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Bridge_1_list) AppendMutable() protoreflect.Value {
+	v := new(BridgeContractAddress)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Bridge_1_list) NewElement() protoreflect.Value {
+	v := new(BridgeContractAddress)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Bridge_2_list)(nil)
+
+type _Bridge_2_list struct {
+	list *[]*BridgeTokenMetadata
+}
+
+func (x *_Bridge_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Bridge_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Bridge_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BridgeTokenMetadata)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Bridge_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BridgeTokenMetadata)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Bridge_2_list) AppendMutable() protoreflect.Value {
+	v := new(BridgeTokenMetadata)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Bridge_2_list) NewElement() protoreflect.Value {
+	v := new(BridgeTokenMetadata)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Bridge_3_list)(nil)
+
+type _Bridge_3_list struct {
+	list *[]*BridgeTokenReference
+}
+
+func (x *_Bridge_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Bridge_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Bridge_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BridgeTokenReference)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Bridge_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BridgeTokenReference)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Bridge_3_list) AppendMutable() protoreflect.Value {
+	v := new(BridgeTokenReference)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Bridge_3_list) NewElement() protoreflect.Value {
+	v := new(BridgeTokenReference)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Bridge_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Bridge                       protoreflect.MessageDescriptor
+	fd_Bridge_contract_addresses    protoreflect.FieldDescriptor
+	fd_Bridge_token_metadata        protoreflect.FieldDescriptor
+	fd_Bridge_trade_approved_tokens protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_bridge_proto_init()
+	md_Bridge = File_inference_inference_bridge_proto.Messages().ByName("Bridge")
+	fd_Bridge_contract_addresses = md_Bridge.Fields().ByName("contract_addresses")
+	fd_Bridge_token_metadata = md_Bridge.Fields().ByName("token_metadata")
+	fd_Bridge_trade_approved_tokens = md_Bridge.Fields().ByName("trade_approved_tokens")
+}
+
+var _ protoreflect.Message = (*fastReflection_Bridge)(nil)
+
+type fastReflection_Bridge Bridge
+
+func (x *Bridge) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Bridge)(x)
+}
+
+func (x *Bridge) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_bridge_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Bridge_messageType fastReflection_Bridge_messageType
+var _ protoreflect.MessageType = fastReflection_Bridge_messageType{}
+
+type fastReflection_Bridge_messageType struct{}
+
+func (x fastReflection_Bridge_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Bridge)(nil)
+}
+func (x fastReflection_Bridge_messageType) New() protoreflect.Message {
+	return new(fastReflection_Bridge)
+}
+func (x fastReflection_Bridge_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Bridge
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Bridge) Descriptor() protoreflect.MessageDescriptor {
+	return md_Bridge
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Bridge) Type() protoreflect.MessageType {
+	return _fastReflection_Bridge_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Bridge) New() protoreflect.Message {
+	return new(fastReflection_Bridge)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Bridge) Interface() protoreflect.ProtoMessage {
+	return (*Bridge)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Bridge) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ContractAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_Bridge_1_list{list: &x.ContractAddresses})
+		if !f(fd_Bridge_contract_addresses, value) {
+			return
+		}
+	}
+	if len(x.TokenMetadata) != 0 {
+		value := protoreflect.ValueOfList(&_Bridge_2_list{list: &x.TokenMetadata})
+		if !f(fd_Bridge_token_metadata, value) {
+			return
+		}
+	}
+	if len(x.TradeApprovedTokens) != 0 {
+		value := protoreflect.ValueOfList(&_Bridge_3_list{list: &x.TradeApprovedTokens})
+		if !f(fd_Bridge_trade_approved_tokens, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Bridge) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.Bridge.contract_addresses":
+		return len(x.ContractAddresses) != 0
+	case "inference.inference.Bridge.token_metadata":
+		return len(x.TokenMetadata) != 0
+	case "inference.inference.Bridge.trade_approved_tokens":
+		return len(x.TradeApprovedTokens) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Bridge"))
+		}
+		panic(fmt.Errorf("message inference.inference.Bridge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Bridge) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.Bridge.contract_addresses":
+		x.ContractAddresses = nil
+	case "inference.inference.Bridge.token_metadata":
+		x.TokenMetadata = nil
+	case "inference.inference.Bridge.trade_approved_tokens":
+		x.TradeApprovedTokens = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Bridge"))
+		}
+		panic(fmt.Errorf("message inference.inference.Bridge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Bridge) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.Bridge.contract_addresses":
+		if len(x.ContractAddresses) == 0 {
+			return protoreflect.ValueOfList(&_Bridge_1_list{})
+		}
+		listValue := &_Bridge_1_list{list: &x.ContractAddresses}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.Bridge.token_metadata":
+		if len(x.TokenMetadata) == 0 {
+			return protoreflect.ValueOfList(&_Bridge_2_list{})
+		}
+		listValue := &_Bridge_2_list{list: &x.TokenMetadata}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.Bridge.trade_approved_tokens":
+		if len(x.TradeApprovedTokens) == 0 {
+			return protoreflect.ValueOfList(&_Bridge_3_list{})
+		}
+		listValue := &_Bridge_3_list{list: &x.TradeApprovedTokens}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Bridge"))
+		}
+		panic(fmt.Errorf("message inference.inference.Bridge does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Bridge) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.Bridge.contract_addresses":
+		lv := value.List()
+		clv := lv.(*_Bridge_1_list)
+		x.ContractAddresses = *clv.list
+	case "inference.inference.Bridge.token_metadata":
+		lv := value.List()
+		clv := lv.(*_Bridge_2_list)
+		x.TokenMetadata = *clv.list
+	case "inference.inference.Bridge.trade_approved_tokens":
+		lv := value.List()
+		clv := lv.(*_Bridge_3_list)
+		x.TradeApprovedTokens = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Bridge"))
+		}
+		panic(fmt.Errorf("message inference.inference.Bridge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Bridge) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.Bridge.contract_addresses":
+		if x.ContractAddresses == nil {
+			x.ContractAddresses = []*BridgeContractAddress{}
+		}
+		value := &_Bridge_1_list{list: &x.ContractAddresses}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.Bridge.token_metadata":
+		if x.TokenMetadata == nil {
+			x.TokenMetadata = []*BridgeTokenMetadata{}
+		}
+		value := &_Bridge_2_list{list: &x.TokenMetadata}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.Bridge.trade_approved_tokens":
+		if x.TradeApprovedTokens == nil {
+			x.TradeApprovedTokens = []*BridgeTokenReference{}
+		}
+		value := &_Bridge_3_list{list: &x.TradeApprovedTokens}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Bridge"))
+		}
+		panic(fmt.Errorf("message inference.inference.Bridge does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Bridge) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.Bridge.contract_addresses":
+		list := []*BridgeContractAddress{}
+		return protoreflect.ValueOfList(&_Bridge_1_list{list: &list})
+	case "inference.inference.Bridge.token_metadata":
+		list := []*BridgeTokenMetadata{}
+		return protoreflect.ValueOfList(&_Bridge_2_list{list: &list})
+	case "inference.inference.Bridge.trade_approved_tokens":
+		list := []*BridgeTokenReference{}
+		return protoreflect.ValueOfList(&_Bridge_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Bridge"))
+		}
+		panic(fmt.Errorf("message inference.inference.Bridge does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Bridge) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.Bridge", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Bridge) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Bridge) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Bridge) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Bridge) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Bridge)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.ContractAddresses) > 0 {
+			for _, e := range x.ContractAddresses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TokenMetadata) > 0 {
+			for _, e := range x.TokenMetadata {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TradeApprovedTokens) > 0 {
+			for _, e := range x.TradeApprovedTokens {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Bridge)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TradeApprovedTokens) > 0 {
+			for iNdEx := len(x.TradeApprovedTokens) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TradeApprovedTokens[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.TokenMetadata) > 0 {
+			for iNdEx := len(x.TokenMetadata) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TokenMetadata[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.ContractAddresses) > 0 {
+			for iNdEx := len(x.ContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ContractAddresses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Bridge)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Bridge: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Bridge: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddresses = append(x.ContractAddresses, &BridgeContractAddress{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ContractAddresses[len(x.ContractAddresses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenMetadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenMetadata = append(x.TokenMetadata, &BridgeTokenMetadata{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenMetadata[len(x.TokenMetadata)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeApprovedTokens", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TradeApprovedTokens = append(x.TradeApprovedTokens, &BridgeTokenReference{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TradeApprovedTokens[len(x.TradeApprovedTokens)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BridgeRateLimit                      protoreflect.MessageDescriptor
+	fd_BridgeRateLimit_chainId              protoreflect.FieldDescriptor
+	fd_BridgeRateLimit_contractAddress      protoreflect.FieldDescriptor
+	fd_BridgeRateLimit_windowBlocks         protoreflect.FieldDescriptor
+	fd_BridgeRateLimit_maxWindowVolume      protoreflect.FieldDescriptor
+	fd_BridgeRateLimit_maxTransactionAmount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_bridge_proto_init()
+	md_BridgeRateLimit = File_inference_inference_bridge_proto.Messages().ByName("BridgeRateLimit")
+	fd_BridgeRateLimit_chainId = md_BridgeRateLimit.Fields().ByName("chainId")
+	fd_BridgeRateLimit_contractAddress = md_BridgeRateLimit.Fields().ByName("contractAddress")
+	fd_BridgeRateLimit_windowBlocks = md_BridgeRateLimit.Fields().ByName("windowBlocks")
+	fd_BridgeRateLimit_maxWindowVolume = md_BridgeRateLimit.Fields().ByName("maxWindowVolume")
+	fd_BridgeRateLimit_maxTransactionAmount = md_BridgeRateLimit.Fields().ByName("maxTransactionAmount")
+}
+
+var _ protoreflect.Message = (*fastReflection_BridgeRateLimit)(nil)
+
+type fastReflection_BridgeRateLimit BridgeRateLimit
+
+func (x *BridgeRateLimit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BridgeRateLimit)(x)
+}
+
+func (x *BridgeRateLimit) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_bridge_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BridgeRateLimit_messageType fastReflection_BridgeRateLimit_messageType
+var _ protoreflect.MessageType = fastReflection_BridgeRateLimit_messageType{}
+
+type fastReflection_BridgeRateLimit_messageType struct{}
+
+func (x fastReflection_BridgeRateLimit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BridgeRateLimit)(nil)
+}
+func (x fastReflection_BridgeRateLimit_messageType) New() protoreflect.Message {
+	return new(fastReflection_BridgeRateLimit)
+}
+func (x fastReflection_BridgeRateLimit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeRateLimit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BridgeRateLimit) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeRateLimit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BridgeRateLimit) Type() protoreflect.MessageType {
+	return _fastReflection_BridgeRateLimit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BridgeRateLimit) New() protoreflect.Message {
+	return new(fastReflection_BridgeRateLimit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BridgeRateLimit) Interface() protoreflect.ProtoMessage {
+	return (*BridgeRateLimit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BridgeRateLimit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_BridgeRateLimit_chainId, value) {
+			return
+		}
+	}
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_BridgeRateLimit_contractAddress, value) {
+			return
+		}
+	}
+	if x.WindowBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WindowBlocks)
+		if !f(fd_BridgeRateLimit_windowBlocks, value) {
+			return
+		}
+	}
+	if x.MaxWindowVolume != "" {
+		value := protoreflect.ValueOfString(x.MaxWindowVolume)
+		if !f(fd_BridgeRateLimit_maxWindowVolume, value) {
+			return
+		}
+	}
+	if x.MaxTransactionAmount != "" {
+		value := protoreflect.ValueOfString(x.MaxTransactionAmount)
+		if !f(fd_BridgeRateLimit_maxTransactionAmount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BridgeRateLimit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.BridgeRateLimit.chainId":
+		return x.ChainId != ""
+	case "inference.inference.BridgeRateLimit.contractAddress":
+		return x.ContractAddress != ""
+	case "inference.inference.BridgeRateLimit.windowBlocks":
+		return x.WindowBlocks != uint64(0)
+	case "inference.inference.BridgeRateLimit.maxWindowVolume":
+		return x.MaxWindowVolume != ""
+	case "inference.inference.BridgeRateLimit.maxTransactionAmount":
+		return x.MaxTransactionAmount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeRateLimit"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeRateLimit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.BridgeRateLimit.chainId":
+		x.ChainId = ""
+	case "inference.inference.BridgeRateLimit.contractAddress":
+		x.ContractAddress = ""
+	case "inference.inference.BridgeRateLimit.windowBlocks":
+		x.WindowBlocks = uint64(0)
+	case "inference.inference.BridgeRateLimit.maxWindowVolume":
+		x.MaxWindowVolume = ""
+	case "inference.inference.BridgeRateLimit.maxTransactionAmount":
+		x.MaxTransactionAmount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeRateLimit"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BridgeRateLimit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.BridgeRateLimit.chainId":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeRateLimit.contractAddress":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeRateLimit.windowBlocks":
+		value := x.WindowBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.BridgeRateLimit.maxWindowVolume":
+		value := x.MaxWindowVolume
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeRateLimit.maxTransactionAmount":
+		value := x.MaxTransactionAmount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeRateLimit"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeRateLimit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeRateLimit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.BridgeRateLimit.chainId":
+		x.ChainId = value.Interface().(string)
+	case "inference.inference.BridgeRateLimit.contractAddress":
+		x.ContractAddress = value.Interface().(string)
+	case "inference.inference.BridgeRateLimit.windowBlocks":
+		x.WindowBlocks = value.Uint()
+	case "inference.inference.BridgeRateLimit.maxWindowVolume":
+		x.MaxWindowVolume = value.Interface().(string)
+	case "inference.inference.BridgeRateLimit.maxTransactionAmount":
+		x.MaxTransactionAmount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeRateLimit"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeRateLimit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.BridgeRateLimit.chainId":
+		panic(fmt.Errorf("field chainId of message inference.inference.BridgeRateLimit is not mutable"))
+	case "inference.inference.BridgeRateLimit.contractAddress":
+		panic(fmt.Errorf("field contractAddress of message inference.inference.BridgeRateLimit is not mutable"))
+	case "inference.inference.BridgeRateLimit.windowBlocks":
+		panic(fmt.Errorf("field windowBlocks of message inference.inference.BridgeRateLimit is not mutable"))
+	case "inference.inference.BridgeRateLimit.maxWindowVolume":
+		panic(fmt.Errorf("field maxWindowVolume of message inference.inference.BridgeRateLimit is not mutable"))
+	case "inference.inference.BridgeRateLimit.maxTransactionAmount":
+		panic(fmt.Errorf("field maxTransactionAmount of message inference.inference.BridgeRateLimit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeRateLimit"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BridgeRateLimit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.BridgeRateLimit.chainId":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeRateLimit.contractAddress":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeRateLimit.windowBlocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.BridgeRateLimit.maxWindowVolume":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeRateLimit.maxTransactionAmount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeRateLimit"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeRateLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BridgeRateLimit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.BridgeRateLimit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BridgeRateLimit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeRateLimit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BridgeRateLimit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BridgeRateLimit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BridgeRateLimit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.WindowBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowBlocks))
+		}
+		l = len(x.MaxWindowVolume)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxTransactionAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeRateLimit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxTransactionAmount) > 0 {
+			i -= len(x.MaxTransactionAmount)
+			copy(dAtA[i:], x.MaxTransactionAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxTransactionAmount)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.MaxWindowVolume) > 0 {
+			i -= len(x.MaxWindowVolume)
+			copy(dAtA[i:], x.MaxWindowVolume)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxWindowVolume)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.WindowBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowBlocks))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeRateLimit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeRateLimit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+				}
+				x.WindowBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxWindowVolume", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxWindowVolume = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTransactionAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxTransactionAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BridgeVolumeEntry             protoreflect.MessageDescriptor
+	fd_BridgeVolumeEntry_blockHeight protoreflect.FieldDescriptor
+	fd_BridgeVolumeEntry_amount      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_bridge_proto_init()
+	md_BridgeVolumeEntry = File_inference_inference_bridge_proto.Messages().ByName("BridgeVolumeEntry")
+	fd_BridgeVolumeEntry_blockHeight = md_BridgeVolumeEntry.Fields().ByName("blockHeight")
+	fd_BridgeVolumeEntry_amount = md_BridgeVolumeEntry.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_BridgeVolumeEntry)(nil)
+
+type fastReflection_BridgeVolumeEntry BridgeVolumeEntry
+
+func (x *BridgeVolumeEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BridgeVolumeEntry)(x)
+}
+
+func (x *BridgeVolumeEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_bridge_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BridgeVolumeEntry_messageType fastReflection_BridgeVolumeEntry_messageType
+var _ protoreflect.MessageType = fastReflection_BridgeVolumeEntry_messageType{}
+
+type fastReflection_BridgeVolumeEntry_messageType struct{}
+
+func (x fastReflection_BridgeVolumeEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BridgeVolumeEntry)(nil)
+}
+func (x fastReflection_BridgeVolumeEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_BridgeVolumeEntry)
+}
+func (x fastReflection_BridgeVolumeEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeVolumeEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BridgeVolumeEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeVolumeEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BridgeVolumeEntry) Type() protoreflect.MessageType {
+	return _fastReflection_BridgeVolumeEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BridgeVolumeEntry) New() protoreflect.Message {
+	return new(fastReflection_BridgeVolumeEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BridgeVolumeEntry) Interface() protoreflect.ProtoMessage {
+	return (*BridgeVolumeEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BridgeVolumeEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_BridgeVolumeEntry_blockHeight, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_BridgeVolumeEntry_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BridgeVolumeEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.BridgeVolumeEntry.blockHeight":
+		return x.BlockHeight != int64(0)
+	case "inference.inference.BridgeVolumeEntry.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeVolumeEntry"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeVolumeEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeVolumeEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.BridgeVolumeEntry.blockHeight":
+		x.BlockHeight = int64(0)
+	case "inference.inference.BridgeVolumeEntry.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeVolumeEntry"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeVolumeEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BridgeVolumeEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.BridgeVolumeEntry.blockHeight":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.BridgeVolumeEntry.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeVolumeEntry"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeVolumeEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeVolumeEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.BridgeVolumeEntry.blockHeight":
+		x.BlockHeight = value.Int()
+	case "inference.inference.BridgeVolumeEntry.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeVolumeEntry"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeVolumeEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeVolumeEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.BridgeVolumeEntry.blockHeight":
+		panic(fmt.Errorf("field blockHeight of message inference.inference.BridgeVolumeEntry is not mutable"))
+	case "inference.inference.BridgeVolumeEntry.amount":
+		panic(fmt.Errorf("field amount of message inference.inference.BridgeVolumeEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeVolumeEntry"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeVolumeEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BridgeVolumeEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.BridgeVolumeEntry.blockHeight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.BridgeVolumeEntry.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeVolumeEntry"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeVolumeEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BridgeVolumeEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.BridgeVolumeEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BridgeVolumeEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeVolumeEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BridgeVolumeEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BridgeVolumeEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BridgeVolumeEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeVolumeEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeVolumeEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeVolumeEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeVolumeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_BridgeVolumeUsage_4_list)(nil)
+
+type _BridgeVolumeUsage_4_list struct {
+	list *[]*BridgeVolumeEntry
+}
+
+func (x *_BridgeVolumeUsage_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BridgeVolumeUsage_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BridgeVolumeUsage_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BridgeVolumeEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BridgeVolumeUsage_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BridgeVolumeEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BridgeVolumeUsage_4_list) AppendMutable() protoreflect.Value {
+	v := new(BridgeVolumeEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BridgeVolumeUsage_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BridgeVolumeUsage_4_list) NewElement() protoreflect.Value {
+	v := new(BridgeVolumeEntry)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Bridge_2_list) IsValid() bool {
-	return x.list != nil
+func (x *_BridgeVolumeUsage_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_BridgeVolumeUsage                 protoreflect.MessageDescriptor
+	fd_BridgeVolumeUsage_chainId         protoreflect.FieldDescriptor
+	fd_BridgeVolumeUsage_contractAddress protoreflect.FieldDescriptor
+	fd_BridgeVolumeUsage_direction       protoreflect.FieldDescriptor
+	fd_BridgeVolumeUsage_entries         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_bridge_proto_init()
+	md_BridgeVolumeUsage = File_inference_inference_bridge_proto.Messages().ByName("BridgeVolumeUsage")
+	fd_BridgeVolumeUsage_chainId = md_BridgeVolumeUsage.Fields().ByName("chainId")
+	fd_BridgeVolumeUsage_contractAddress = md_BridgeVolumeUsage.Fields().ByName("contractAddress")
+	fd_BridgeVolumeUsage_direction = md_BridgeVolumeUsage.Fields().ByName("direction")
+	fd_BridgeVolumeUsage_entries = md_BridgeVolumeUsage.Fields().ByName("entries")
+}
+
+var _ protoreflect.Message = (*fastReflection_BridgeVolumeUsage)(nil)
+
+type fastReflection_BridgeVolumeUsage BridgeVolumeUsage
+
+func (x *BridgeVolumeUsage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BridgeVolumeUsage)(x)
+}
+
+func (x *BridgeVolumeUsage) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_bridge_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BridgeVolumeUsage_messageType fastReflection_BridgeVolumeUsage_messageType
+var _ protoreflect.MessageType = fastReflection_BridgeVolumeUsage_messageType{}
+
+type fastReflection_BridgeVolumeUsage_messageType struct{}
+
+func (x fastReflection_BridgeVolumeUsage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BridgeVolumeUsage)(nil)
+}
+func (x fastReflection_BridgeVolumeUsage_messageType) New() protoreflect.Message {
+	return new(fastReflection_BridgeVolumeUsage)
+}
+func (x fastReflection_BridgeVolumeUsage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeVolumeUsage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BridgeVolumeUsage) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeVolumeUsage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BridgeVolumeUsage) Type() protoreflect.MessageType {
+	return _fastReflection_BridgeVolumeUsage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BridgeVolumeUsage) New() protoreflect.Message {
+	return new(fastReflection_BridgeVolumeUsage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BridgeVolumeUsage) Interface() protoreflect.ProtoMessage {
+	return (*BridgeVolumeUsage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BridgeVolumeUsage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_BridgeVolumeUsage_chainId, value) {
+			return
+		}
+	}
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_BridgeVolumeUsage_contractAddress, value) {
+			return
+		}
+	}
+	if x.Direction != "" {
+		value := protoreflect.ValueOfString(x.Direction)
+		if !f(fd_BridgeVolumeUsage_direction, value) {
+			return
+		}
+	}
+	if len(x.Entries) != 0 {
+		value := protoreflect.ValueOfList(&_BridgeVolumeUsage_4_list{list: &x.Entries})
+		if !f(fd_BridgeVolumeUsage_entries, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BridgeVolumeUsage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.BridgeVolumeUsage.chainId":
+		return x.ChainId != ""
+	case "inference.inference.BridgeVolumeUsage.contractAddress":
+		return x.ContractAddress != ""
+	case "inference.inference.BridgeVolumeUsage.direction":
+		return x.Direction != ""
+	case "inference.inference.BridgeVolumeUsage.entries":
+		return len(x.Entries) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeVolumeUsage"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeVolumeUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeVolumeUsage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.BridgeVolumeUsage.chainId":
+		x.ChainId = ""
+	case "inference.inference.BridgeVolumeUsage.contractAddress":
+		x.ContractAddress = ""
+	case "inference.inference.BridgeVolumeUsage.direction":
+		x.Direction = ""
+	case "inference.inference.BridgeVolumeUsage.entries":
+		x.Entries = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeVolumeUsage"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeVolumeUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BridgeVolumeUsage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.BridgeVolumeUsage.chainId":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeVolumeUsage.contractAddress":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeVolumeUsage.direction":
+		value := x.Direction
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeVolumeUsage.entries":
+		if len(x.Entries) == 0 {
+			return protoreflect.ValueOfList(&_BridgeVolumeUsage_4_list{})
+		}
+		listValue := &_BridgeVolumeUsage_4_list{list: &x.Entries}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeVolumeUsage"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeVolumeUsage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeVolumeUsage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.BridgeVolumeUsage.chainId":
+		x.ChainId = value.Interface().(string)
+	case "inference.inference.BridgeVolumeUsage.contractAddress":
+		x.ContractAddress = value.Interface().(string)
+	case "inference.inference.BridgeVolumeUsage.direction":
+		x.Direction = value.Interface().(string)
+	case "inference.inference.BridgeVolumeUsage.entries":
+		lv := value.List()
+		clv := lv.(*_BridgeVolumeUsage_4_list)
+		x.Entries = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeVolumeUsage"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeVolumeUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeVolumeUsage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.BridgeVolumeUsage.entries":
+		if x.Entries == nil {
+			x.Entries = []*BridgeVolumeEntry{}
+		}
+		value := &_BridgeVolumeUsage_4_list{list: &x.Entries}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.BridgeVolumeUsage.chainId":
+		panic(fmt.Errorf("field chainId of message inference.inference.BridgeVolumeUsage is not mutable"))
+	case "inference.inference.BridgeVolumeUsage.contractAddress":
+		panic(fmt.Errorf("field contractAddress of message inference.inference.BridgeVolumeUsage is not mutable"))
+	case "inference.inference.BridgeVolumeUsage.direction":
+		panic(fmt.Errorf("field direction of message inference.inference.BridgeVolumeUsage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeVolumeUsage"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeVolumeUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BridgeVolumeUsage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.BridgeVolumeUsage.chainId":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeVolumeUsage.contractAddress":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeVolumeUsage.direction":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeVolumeUsage.entries":
+		list := []*BridgeVolumeEntry{}
+		return protoreflect.ValueOfList(&_BridgeVolumeUsage_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeVolumeUsage"))
+		}
+		panic(fmt.Errorf("message inference.inference.BridgeVolumeUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BridgeVolumeUsage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.BridgeVolumeUsage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BridgeVolumeUsage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeVolumeUsage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BridgeVolumeUsage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BridgeVolumeUsage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BridgeVolumeUsage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Direction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Entries) > 0 {
+			for _, e := range x.Entries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeVolumeUsage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Entries) > 0 {
+			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Direction) > 0 {
+			i -= len(x.Direction)
+			copy(dAtA[i:], x.Direction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Direction)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeVolumeUsage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeVolumeUsage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeVolumeUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Direction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Entries = append(x.Entries, &BridgeVolumeEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
-var _ protoreflect.List = (*_Bridge_3_list)(nil)
+var _ protoreflect.List = (*_BridgeChainStatus_6_list)(nil)
 
-type _Bridge_3_list struct {
-	list *[]*BridgeTokenReference
+type _BridgeChainStatus_6_list struct {
+	list *[]int64
 }
 
-func (x *_Bridge_3_list) Len() int {
+func (x *_BridgeChainStatus_6_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Bridge_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x *_BridgeChainStatus_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
 }
 
-func (x *_Bridge_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*BridgeTokenReference)
+func (x *_BridgeChainStatus_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Bridge_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*BridgeTokenReference)
+func (x *_BridgeChainStatus_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Bridge_3_list) AppendMutable() protoreflect.Value {
-	v := new(BridgeTokenReference)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_BridgeChainStatus_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message BridgeChainStatus at list field AnomalyHeights as it is not of Message kind"))
 }
 
-func (x *_Bridge_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
+func (x *_BridgeChainStatus_6_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Bridge_3_list) NewElement() protoreflect.Value {
-	v := new(BridgeTokenReference)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_BridgeChainStatus_6_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
 }
 
-func (x *_Bridge_3_list) IsValid() bool {
+func (x *_BridgeChainStatus_6_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Bridge                       protoreflect.MessageDescriptor
-	fd_Bridge_contract_addresses    protoreflect.FieldDescriptor
-	fd_Bridge_token_metadata        protoreflect.FieldDescriptor
-	fd_Bridge_trade_approved_tokens protoreflect.FieldDescriptor
+	md_BridgeChainStatus                protoreflect.MessageDescriptor
+	fd_BridgeChainStatus_chainId        protoreflect.FieldDescriptor
+	fd_BridgeChainStatus_paused         protoreflect.FieldDescriptor
+	fd_BridgeChainStatus_pauseReason    protoreflect.FieldDescriptor
+	fd_BridgeChainStatus_pausedAtHeight protoreflect.FieldDescriptor
+	fd_BridgeChainStatus_autoPaused     protoreflect.FieldDescriptor
+	fd_BridgeChainStatus_anomalyHeights protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_inference_inference_bridge_proto_init()
-	md_Bridge = File_inference_inference_bridge_proto.Messages().ByName("Bridge")
-	fd_Bridge_contract_addresses = md_Bridge.Fields().ByName("contract_addresses")
-	fd_Bridge_token_metadata = md_Bridge.Fields().ByName("token_metadata")
-	fd_Bridge_trade_approved_tokens = md_Bridge.Fields().ByName("trade_approved_tokens")
+	md_BridgeChainStatus = File_inference_inference_bridge_proto.Messages().ByName("BridgeChainStatus")
+	fd_BridgeChainStatus_chainId = md_BridgeChainStatus.Fields().ByName("chainId")
+	fd_BridgeChainStatus_paused = md_BridgeChainStatus.Fields().ByName("paused")
+	fd_BridgeChainStatus_pauseReason = md_BridgeChainStatus.Fields().ByName("pauseReason")
+	fd_BridgeChainStatus_pausedAtHeight = md_BridgeChainStatus.Fields().ByName("pausedAtHeight")
+	fd_BridgeChainStatus_autoPaused = md_BridgeChainStatus.Fields().ByName("autoPaused")
+	fd_BridgeChainStatus_anomalyHeights = md_BridgeChainStatus.Fields().ByName("anomalyHeights")
 }
 
-var _ protoreflect.Message = (*fastReflection_Bridge)(nil)
+var _ protoreflect.Message = (*fastReflection_BridgeChainStatus)(nil)
 
-type fastReflection_Bridge Bridge
+type fastReflection_BridgeChainStatus BridgeChainStatus
 
-func (x *Bridge) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Bridge)(x)
+func (x *BridgeChainStatus) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BridgeChainStatus)(x)
 }
 
-func (x *Bridge) slowProtoReflect() protoreflect.Message {
-	mi := &file_inference_inference_bridge_proto_msgTypes[5]
+func (x *BridgeChainStatus) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_bridge_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3576,43 +6882,43 @@ func (x *Bridge) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Bridge_messageType fastReflection_Bridge_messageType
-var _ protoreflect.MessageType = fastReflection_Bridge_messageType{}
+var _fastReflection_BridgeChainStatus_messageType fastReflection_BridgeChainStatus_messageType
+var _ protoreflect.MessageType = fastReflection_BridgeChainStatus_messageType{}
 
-type fastReflection_Bridge_messageType struct{}
+type fastReflection_BridgeChainStatus_messageType struct{}
 
-func (x fastReflection_Bridge_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Bridge)(nil)
+func (x fastReflection_BridgeChainStatus_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BridgeChainStatus)(nil)
 }
-func (x fastReflection_Bridge_messageType) New() protoreflect.Message {
-	return new(fastReflection_Bridge)
+func (x fastReflection_BridgeChainStatus_messageType) New() protoreflect.Message {
+	return new(fastReflection_BridgeChainStatus)
 }
-func (x fastReflection_Bridge_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Bridge
+func (x fastReflection_BridgeChainStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeChainStatus
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Bridge) Descriptor() protoreflect.MessageDescriptor {
-	return md_Bridge
+func (x *fastReflection_BridgeChainStatus) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeChainStatus
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Bridge) Type() protoreflect.MessageType {
-	return _fastReflection_Bridge_messageType
+func (x *fastReflection_BridgeChainStatus) Type() protoreflect.MessageType {
+	return _fastReflection_BridgeChainStatus_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Bridge) New() protoreflect.Message {
-	return new(fastReflection_Bridge)
+func (x *fastReflection_BridgeChainStatus) New() protoreflect.Message {
+	return new(fastReflection_BridgeChainStatus)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Bridge) Interface() protoreflect.ProtoMessage {
-	return (*Bridge)(x)
+func (x *fastReflection_BridgeChainStatus) Interface() protoreflect.ProtoMessage {
+	return (*BridgeChainStatus)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3620,22 +6926,40 @@ func (x *fastReflection_Bridge) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Bridge) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ContractAddresses) != 0 {
-		value := protoreflect.ValueOfList(&_Bridge_1_list{list: &x.ContractAddresses})
-		if !f(fd_Bridge_contract_addresses, value) {
+func (x *fastReflection_BridgeChainStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_BridgeChainStatus_chainId, value) {
+			return
+		}
+	}
+	if x.Paused != false {
+		value := protoreflect.ValueOfBool(x.Paused)
+		if !f(fd_BridgeChainStatus_paused, value) {
+			return
+		}
+	}
+	if x.PauseReason != "" {
+		value := protoreflect.ValueOfString(x.PauseReason)
+		if !f(fd_BridgeChainStatus_pauseReason, value) {
 			return
 		}
 	}
-	if len(x.TokenMetadata) != 0 {
-		value := protoreflect.ValueOfList(&_Bridge_2_list{list: &x.TokenMetadata})
-		if !f(fd_Bridge_token_metadata, value) {
+	if x.PausedAtHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PausedAtHeight)
+		if !f(fd_BridgeChainStatus_pausedAtHeight, value) {
 			return
 		}
 	}
-	if len(x.TradeApprovedTokens) != 0 {
-		value := protoreflect.ValueOfList(&_Bridge_3_list{list: &x.TradeApprovedTokens})
-		if !f(fd_Bridge_trade_approved_tokens, value) {
+	if x.AutoPaused != false {
+		value := protoreflect.ValueOfBool(x.AutoPaused)
+		if !f(fd_BridgeChainStatus_autoPaused, value) {
+			return
+		}
+	}
+	if len(x.AnomalyHeights) != 0 {
+		value := protoreflect.ValueOfList(&_BridgeChainStatus_6_list{list: &x.AnomalyHeights})
+		if !f(fd_BridgeChainStatus_anomalyHeights, value) {
 			return
 		}
 	}
@@ -3652,19 +6976,25 @@ func (x *fastReflection_Bridge) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Bridge) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BridgeChainStatus) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "inference.inference.Bridge.contract_addresses":
-		return len(x.ContractAddresses) != 0
-	case "inference.inference.Bridge.token_metadata":
-		return len(x.TokenMetadata) != 0
-	case "inference.inference.Bridge.trade_approved_tokens":
-		return len(x.TradeApprovedTokens) != 0
+	case "inference.inference.BridgeChainStatus.chainId":
+		return x.ChainId != ""
+	case "inference.inference.BridgeChainStatus.paused":
+		return x.Paused != false
+	case "inference.inference.BridgeChainStatus.pauseReason":
+		return x.PauseReason != ""
+	case "inference.inference.BridgeChainStatus.pausedAtHeight":
+		return x.PausedAtHeight != int64(0)
+	case "inference.inference.BridgeChainStatus.autoPaused":
+		return x.AutoPaused != false
+	case "inference.inference.BridgeChainStatus.anomalyHeights":
+		return len(x.AnomalyHeights) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Bridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeChainStatus"))
 		}
-		panic(fmt.Errorf("message inference.inference.Bridge does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.inference.BridgeChainStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3674,19 +7004,25 @@ func (x *fastReflection_Bridge) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Bridge) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BridgeChainStatus) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "inference.inference.Bridge.contract_addresses":
-		x.ContractAddresses = nil
-	case "inference.inference.Bridge.token_metadata":
-		x.TokenMetadata = nil
-	case "inference.inference.Bridge.trade_approved_tokens":
-		x.TradeApprovedTokens = nil
+	case "inference.inference.BridgeChainStatus.chainId":
+		x.ChainId = ""
+	case "inference.inference.BridgeChainStatus.paused":
+		x.Paused = false
+	case "inference.inference.BridgeChainStatus.pauseReason":
+		x.PauseReason = ""
+	case "inference.inference.BridgeChainStatus.pausedAtHeight":
+		x.PausedAtHeight = int64(0)
+	case "inference.inference.BridgeChainStatus.autoPaused":
+		x.AutoPaused = false
+	case "inference.inference.BridgeChainStatus.anomalyHeights":
+		x.AnomalyHeights = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Bridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeChainStatus"))
 		}
-		panic(fmt.Errorf("message inference.inference.Bridge does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.inference.BridgeChainStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3696,31 +7032,34 @@ func (x *fastReflection_Bridge) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Bridge) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BridgeChainStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "inference.inference.Bridge.contract_addresses":
-		if len(x.ContractAddresses) == 0 {
-			return protoreflect.ValueOfList(&_Bridge_1_list{})
-		}
-		listValue := &_Bridge_1_list{list: &x.ContractAddresses}
-		return protoreflect.ValueOfList(listValue)
-	case "inference.inference.Bridge.token_metadata":
-		if len(x.TokenMetadata) == 0 {
-			return protoreflect.ValueOfList(&_Bridge_2_list{})
-		}
-		listValue := &_Bridge_2_list{list: &x.TokenMetadata}
-		return protoreflect.ValueOfList(listValue)
-	case "inference.inference.Bridge.trade_approved_tokens":
-		if len(x.TradeApprovedTokens) == 0 {
-			return protoreflect.ValueOfList(&_Bridge_3_list{})
-		}
-		listValue := &_Bridge_3_list{list: &x.TradeApprovedTokens}
+	case "inference.inference.BridgeChainStatus.chainId":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeChainStatus.paused":
+		value := x.Paused
+		return protoreflect.ValueOfBool(value)
+	case "inference.inference.BridgeChainStatus.pauseReason":
+		value := x.PauseReason
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.BridgeChainStatus.pausedAtHeight":
+		value := x.PausedAtHeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.BridgeChainStatus.autoPaused":
+		value := x.AutoPaused
+		return protoreflect.ValueOfBool(value)
+	case "inference.inference.BridgeChainStatus.anomalyHeights":
+		if len(x.AnomalyHeights) == 0 {
+			return protoreflect.ValueOfList(&_BridgeChainStatus_6_list{})
+		}
+		listValue := &_BridgeChainStatus_6_list{list: &x.AnomalyHeights}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Bridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeChainStatus"))
 		}
-		panic(fmt.Errorf("message inference.inference.Bridge does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message inference.inference.BridgeChainStatus does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3734,25 +7073,27 @@ func (x *fastReflection_Bridge) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Bridge) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BridgeChainStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "inference.inference.Bridge.contract_addresses":
-		lv := value.List()
-		clv := lv.(*_Bridge_1_list)
-		x.ContractAddresses = *clv.list
-	case "inference.inference.Bridge.token_metadata":
-		lv := value.List()
-		clv := lv.(*_Bridge_2_list)
-		x.TokenMetadata = *clv.list
-	case "inference.inference.Bridge.trade_approved_tokens":
+	case "inference.inference.BridgeChainStatus.chainId":
+		x.ChainId = value.Interface().(string)
+	case "inference.inference.BridgeChainStatus.paused":
+		x.Paused = value.Bool()
+	case "inference.inference.BridgeChainStatus.pauseReason":
+		x.PauseReason = value.Interface().(string)
+	case "inference.inference.BridgeChainStatus.pausedAtHeight":
+		x.PausedAtHeight = value.Int()
+	case "inference.inference.BridgeChainStatus.autoPaused":
+		x.AutoPaused = value.Bool()
+	case "inference.inference.BridgeChainStatus.anomalyHeights":
 		lv := value.List()
-		clv := lv.(*_Bridge_3_list)
-		x.TradeApprovedTokens = *clv.list
+		clv := lv.(*_BridgeChainStatus_6_list)
+		x.AnomalyHeights = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Bridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeChainStatus"))
 		}
-		panic(fmt.Errorf("message inference.inference.Bridge does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.inference.BridgeChainStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3766,63 +7107,65 @@ func (x *fastReflection_Bridge) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Bridge) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BridgeChainStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "inference.inference.Bridge.contract_addresses":
-		if x.ContractAddresses == nil {
-			x.ContractAddresses = []*BridgeContractAddress{}
-		}
-		value := &_Bridge_1_list{list: &x.ContractAddresses}
-		return protoreflect.ValueOfList(value)
-	case "inference.inference.Bridge.token_metadata":
-		if x.TokenMetadata == nil {
-			x.TokenMetadata = []*BridgeTokenMetadata{}
-		}
-		value := &_Bridge_2_list{list: &x.TokenMetadata}
-		return protoreflect.ValueOfList(value)
-	case "inference.inference.Bridge.trade_approved_tokens":
-		if x.TradeApprovedTokens == nil {
-			x.TradeApprovedTokens = []*BridgeTokenReference{}
+	case "inference.inference.BridgeChainStatus.anomalyHeights":
+		if x.AnomalyHeights == nil {
+			x.AnomalyHeights = []int64{}
 		}
-		value := &_Bridge_3_list{list: &x.TradeApprovedTokens}
+		value := &_BridgeChainStatus_6_list{list: &x.AnomalyHeights}
 		return protoreflect.ValueOfList(value)
+	case "inference.inference.BridgeChainStatus.chainId":
+		panic(fmt.Errorf("field chainId of message inference.inference.BridgeChainStatus is not mutable"))
+	case "inference.inference.BridgeChainStatus.paused":
+		panic(fmt.Errorf("field paused of message inference.inference.BridgeChainStatus is not mutable"))
+	case "inference.inference.BridgeChainStatus.pauseReason":
+		panic(fmt.Errorf("field pauseReason of message inference.inference.BridgeChainStatus is not mutable"))
+	case "inference.inference.BridgeChainStatus.pausedAtHeight":
+		panic(fmt.Errorf("field pausedAtHeight of message inference.inference.BridgeChainStatus is not mutable"))
+	case "inference.inference.BridgeChainStatus.autoPaused":
+		panic(fmt.Errorf("field autoPaused of message inference.inference.BridgeChainStatus is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Bridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeChainStatus"))
 		}
-		panic(fmt.Errorf("message inference.inference.Bridge does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.inference.BridgeChainStatus does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Bridge) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BridgeChainStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "inference.inference.Bridge.contract_addresses":
-		list := []*BridgeContractAddress{}
-		return protoreflect.ValueOfList(&_Bridge_1_list{list: &list})
-	case "inference.inference.Bridge.token_metadata":
-		list := []*BridgeTokenMetadata{}
-		return protoreflect.ValueOfList(&_Bridge_2_list{list: &list})
-	case "inference.inference.Bridge.trade_approved_tokens":
-		list := []*BridgeTokenReference{}
-		return protoreflect.ValueOfList(&_Bridge_3_list{list: &list})
+	case "inference.inference.BridgeChainStatus.chainId":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeChainStatus.paused":
+		return protoreflect.ValueOfBool(false)
+	case "inference.inference.BridgeChainStatus.pauseReason":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.BridgeChainStatus.pausedAtHeight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.BridgeChainStatus.autoPaused":
+		return protoreflect.ValueOfBool(false)
+	case "inference.inference.BridgeChainStatus.anomalyHeights":
+		list := []int64{}
+		return protoreflect.ValueOfList(&_BridgeChainStatus_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Bridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.BridgeChainStatus"))
 		}
-		panic(fmt.Errorf("message inference.inference.Bridge does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.inference.BridgeChainStatus does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Bridge) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BridgeChainStatus) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in inference.inference.Bridge", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.BridgeChainStatus", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3830,7 +7173,7 @@ func (x *fastReflection_Bridge) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Bridge) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BridgeChainStatus) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3841,7 +7184,7 @@ func (x *fastReflection_Bridge) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Bridge) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BridgeChainStatus) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3853,7 +7196,7 @@ func (x *fastReflection_Bridge) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Bridge) IsValid() bool {
+func (x *fastReflection_BridgeChainStatus) IsValid() bool {
 	return x != nil
 }
 
@@ -3863,9 +7206,9 @@ func (x *fastReflection_Bridge) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Bridge) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BridgeChainStatus) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Bridge)
+		x := input.Message.Interface().(*BridgeChainStatus)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3877,23 +7220,29 @@ func (x *fastReflection_Bridge) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.ContractAddresses) > 0 {
-			for _, e := range x.ContractAddresses {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.TokenMetadata) > 0 {
-			for _, e := range x.TokenMetadata {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Paused {
+			n += 2
 		}
-		if len(x.TradeApprovedTokens) > 0 {
-			for _, e := range x.TradeApprovedTokens {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		l = len(x.PauseReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PausedAtHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.PausedAtHeight))
+		}
+		if x.AutoPaused {
+			n += 2
+		}
+		if len(x.AnomalyHeights) > 0 {
+			l = 0
+			for _, e := range x.AnomalyHeights {
+				l += runtime.Sov(uint64(e))
 			}
+			n += 1 + runtime.Sov(uint64(l)) + l
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3905,7 +7254,7 @@ func (x *fastReflection_Bridge) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Bridge)
+		x := input.Message.Interface().(*BridgeChainStatus)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3924,53 +7273,65 @@ func (x *fastReflection_Bridge) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.TradeApprovedTokens) > 0 {
-			for iNdEx := len(x.TradeApprovedTokens) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TradeApprovedTokens[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
+		if len(x.AnomalyHeights) > 0 {
+			var pksize2 int
+			for _, num := range x.AnomalyHeights {
+				pksize2 += runtime.Sov(uint64(num))
 			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.AnomalyHeights {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x32
 		}
-		if len(x.TokenMetadata) > 0 {
-			for iNdEx := len(x.TokenMetadata) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TokenMetadata[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
+		if x.AutoPaused {
+			i--
+			if x.AutoPaused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
+			i--
+			dAtA[i] = 0x28
 		}
-		if len(x.ContractAddresses) > 0 {
-			for iNdEx := len(x.ContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ContractAddresses[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.PausedAtHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PausedAtHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.PauseReason) > 0 {
+			i -= len(x.PauseReason)
+			copy(dAtA[i:], x.PauseReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PauseReason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Paused {
+			i--
+			if x.Paused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3983,7 +7344,7 @@ func (x *fastReflection_Bridge) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Bridge)
+		x := input.Message.Interface().(*BridgeChainStatus)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4015,17 +7376,17 @@ func (x *fastReflection_Bridge) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Bridge: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeChainStatus: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Bridge: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeChainStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4035,31 +7396,81 @@ func (x *fastReflection_Bridge) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ContractAddresses = append(x.ContractAddresses, &BridgeContractAddress{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ContractAddresses[len(x.ContractAddresses)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Paused = bool(v != 0)
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenMetadata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PauseReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PauseReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedAtHeight", wireType)
 				}
-				var msglen int
+				x.PausedAtHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4069,31 +7480,16 @@ func (x *fastReflection_Bridge) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.PausedAtHeight |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.TokenMetadata = append(x.TokenMetadata, &BridgeTokenMetadata{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenMetadata[len(x.TokenMetadata)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeApprovedTokens", wireType)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoPaused", wireType)
 				}
-				var msglen int
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4103,26 +7499,88 @@ func (x *fastReflection_Bridge) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.TradeApprovedTokens = append(x.TradeApprovedTokens, &BridgeTokenReference{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TradeApprovedTokens[len(x.TradeApprovedTokens)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.AutoPaused = bool(v != 0)
+			case 6:
+				if wireType == 0 {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.AnomalyHeights = append(x.AnomalyHeights, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.AnomalyHeights) == 0 {
+						x.AnomalyHeights = make([]int64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v int64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= int64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.AnomalyHeights = append(x.AnomalyHeights, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnomalyHeights", wireType)
 				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4142,468 +7600,808 @@ func (x *fastReflection_Bridge) ProtoMethods() *protoiface.Methods {
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/inference/bridge.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BridgeTransactionStatus int32
+
+const (
+	BridgeTransactionStatus_BRIDGE_PENDING   BridgeTransactionStatus = 0
+	BridgeTransactionStatus_BRIDGE_COMPLETED BridgeTransactionStatus = 1
+	// BRIDGE_HELD is a verified deposit whose release is held back by a rate limit or a paused chain.
+	// It is released automatically once the chain is unpaused and the limits allow it.
+	BridgeTransactionStatus_BRIDGE_HELD BridgeTransactionStatus = 2
+)
+
+// Enum value maps for BridgeTransactionStatus.
+var (
+	BridgeTransactionStatus_name = map[int32]string{
+		0: "BRIDGE_PENDING",
+		1: "BRIDGE_COMPLETED",
+		2: "BRIDGE_HELD",
+	}
+	BridgeTransactionStatus_value = map[string]int32{
+		"BRIDGE_PENDING":   0,
+		"BRIDGE_COMPLETED": 1,
+		"BRIDGE_HELD":      2,
+	}
+)
+
+func (x BridgeTransactionStatus) Enum() *BridgeTransactionStatus {
+	p := new(BridgeTransactionStatus)
+	*p = x
+	return p
+}
+
+func (x BridgeTransactionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BridgeTransactionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_inference_inference_bridge_proto_enumTypes[0].Descriptor()
+}
+
+func (BridgeTransactionStatus) Type() protoreflect.EnumType {
+	return &file_inference_inference_bridge_proto_enumTypes[0]
+}
+
+func (x BridgeTransactionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BridgeTransactionStatus.Descriptor instead.
+func (BridgeTransactionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{0}
+}
+
+type BridgeContractAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *BridgeContractAddress) Reset() {
+	*x = BridgeContractAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_bridge_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgeContractAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeContractAddress) ProtoMessage() {}
+
+// Deprecated: Use BridgeContractAddress.ProtoReflect.Descriptor instead.
+func (*BridgeContractAddress) Descriptor() ([]byte, []int) {
+	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BridgeContractAddress) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BridgeContractAddress) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *BridgeContractAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// TokenMetadata is used for pre-configuring known tokens before they are bridged
+type BridgeTokenMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId         string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`                 // e.g. "ethereum"
+	ContractAddress string `protobuf:"bytes,2,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"` // original chain contract address
+	Name            string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                       // token name
+	Symbol          string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`                   // token symbol
+	Decimals        uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`              // token decimals
+}
+
+func (x *BridgeTokenMetadata) Reset() {
+	*x = BridgeTokenMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_bridge_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgeTokenMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeTokenMetadata) ProtoMessage() {}
+
+// Deprecated: Use BridgeTokenMetadata.ProtoReflect.Descriptor instead.
+func (*BridgeTokenMetadata) Descriptor() ([]byte, []int) {
+	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BridgeTokenMetadata) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *BridgeTokenMetadata) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *BridgeTokenMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BridgeTokenMetadata) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *BridgeTokenMetadata) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+// BridgeTokenReference defines a reference to a token on an external chain
+// Used for various purposes: trade approval, reverse index lookups, etc.
+type BridgeTokenReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId         string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`                 // e.g. "ethereum" - external chain identifier
+	ContractAddress string `protobuf:"bytes,2,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"` // external chain contract address
+}
+
+func (x *BridgeTokenReference) Reset() {
+	*x = BridgeTokenReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_bridge_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+}
+
+func (x *BridgeTokenReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeTokenReference) ProtoMessage() {}
+
+// Deprecated: Use BridgeTokenReference.ProtoReflect.Descriptor instead.
+func (*BridgeTokenReference) Descriptor() ([]byte, []int) {
+	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BridgeTokenReference) GetChainId() string {
+	if x != nil {
+		return x.ChainId
 	}
+	return ""
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: inference/inference/bridge.proto
+func (x *BridgeTokenReference) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+type BridgeTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type BridgeTransactionStatus int32
+	Id                   string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChainId              string                  `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	ContractAddress      string                  `protobuf:"bytes,3,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	OwnerAddress         string                  `protobuf:"bytes,4,opt,name=ownerAddress,proto3" json:"ownerAddress,omitempty"`
+	Amount               string                  `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status               BridgeTransactionStatus `protobuf:"varint,6,opt,name=status,proto3,enum=inference.inference.BridgeTransactionStatus" json:"status,omitempty"`
+	BlockNumber          string                  `protobuf:"bytes,7,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`   // block number where the transaction occurred on the origin chain
+	ReceiptIndex         string                  `protobuf:"bytes,8,opt,name=receiptIndex,proto3" json:"receiptIndex,omitempty"` // index of the transaction receipt in the block
+	ReceiptsRoot         string                  `protobuf:"bytes,9,opt,name=receiptsRoot,proto3" json:"receiptsRoot,omitempty"` // merkle root of receipts trie for transaction verification
+	EpochIndex           uint64                  `protobuf:"varint,10,opt,name=epochIndex,proto3" json:"epochIndex,omitempty"`
+	Validators           []string                `protobuf:"bytes,11,rep,name=validators,proto3" json:"validators,omitempty"`                      // list of validator addresses that validated
+	TotalValidationPower int64                   `protobuf:"varint,12,opt,name=totalValidationPower,proto3" json:"totalValidationPower,omitempty"` // sum of all validator powers that validated
+}
 
-const (
-	BridgeTransactionStatus_BRIDGE_PENDING   BridgeTransactionStatus = 0
-	BridgeTransactionStatus_BRIDGE_COMPLETED BridgeTransactionStatus = 1
-)
+func (x *BridgeTransaction) Reset() {
+	*x = BridgeTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_bridge_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-// Enum value maps for BridgeTransactionStatus.
-var (
-	BridgeTransactionStatus_name = map[int32]string{
-		0: "BRIDGE_PENDING",
-		1: "BRIDGE_COMPLETED",
+func (x *BridgeTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeTransaction) ProtoMessage() {}
+
+// Deprecated: Use BridgeTransaction.ProtoReflect.Descriptor instead.
+func (*BridgeTransaction) Descriptor() ([]byte, []int) {
+	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BridgeTransaction) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	BridgeTransactionStatus_value = map[string]int32{
-		"BRIDGE_PENDING":   0,
-		"BRIDGE_COMPLETED": 1,
+	return ""
+}
+
+func (x *BridgeTransaction) GetChainId() string {
+	if x != nil {
+		return x.ChainId
 	}
-)
+	return ""
+}
 
-func (x BridgeTransactionStatus) Enum() *BridgeTransactionStatus {
-	p := new(BridgeTransactionStatus)
-	*p = x
-	return p
+func (x *BridgeTransaction) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
 }
 
-func (x BridgeTransactionStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *BridgeTransaction) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
 }
 
-func (BridgeTransactionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_inference_inference_bridge_proto_enumTypes[0].Descriptor()
+func (x *BridgeTransaction) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
 }
 
-func (BridgeTransactionStatus) Type() protoreflect.EnumType {
-	return &file_inference_inference_bridge_proto_enumTypes[0]
+func (x *BridgeTransaction) GetStatus() BridgeTransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BridgeTransactionStatus_BRIDGE_PENDING
 }
 
-func (x BridgeTransactionStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *BridgeTransaction) GetBlockNumber() string {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return ""
 }
 
-// Deprecated: Use BridgeTransactionStatus.Descriptor instead.
-func (BridgeTransactionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{0}
+func (x *BridgeTransaction) GetReceiptIndex() string {
+	if x != nil {
+		return x.ReceiptIndex
+	}
+	return ""
 }
 
-type BridgeContractAddress struct {
+func (x *BridgeTransaction) GetReceiptsRoot() string {
+	if x != nil {
+		return x.ReceiptsRoot
+	}
+	return ""
+}
+
+func (x *BridgeTransaction) GetEpochIndex() uint64 {
+	if x != nil {
+		return x.EpochIndex
+	}
+	return 0
+}
+
+func (x *BridgeTransaction) GetValidators() []string {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *BridgeTransaction) GetTotalValidationPower() int64 {
+	if x != nil {
+		return x.TotalValidationPower
+	}
+	return 0
+}
+
+// BridgeBlockAttestation collects validator votes for the receipts root of an origin chain block.
+// Once validators holding more than half of the epoch power agree on a root, it is attested and
+// receipt proofs against it can complete deposits from that block.
+type BridgeBlockAttestation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ChainId string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
-	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	ChainId              string   `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	BlockNumber          string   `protobuf:"bytes,2,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	ReceiptsRoot         string   `protobuf:"bytes,3,opt,name=receiptsRoot,proto3" json:"receiptsRoot,omitempty"`
+	EpochIndex           uint64   `protobuf:"varint,4,opt,name=epochIndex,proto3" json:"epochIndex,omitempty"`
+	Validators           []string `protobuf:"bytes,5,rep,name=validators,proto3" json:"validators,omitempty"`
+	TotalValidationPower int64    `protobuf:"varint,6,opt,name=totalValidationPower,proto3" json:"totalValidationPower,omitempty"`
+	Attested             bool     `protobuf:"varint,7,opt,name=attested,proto3" json:"attested,omitempty"`
 }
 
-func (x *BridgeContractAddress) Reset() {
-	*x = BridgeContractAddress{}
+func (x *BridgeBlockAttestation) Reset() {
+	*x = BridgeBlockAttestation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_bridge_proto_msgTypes[0]
+		mi := &file_inference_inference_bridge_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BridgeContractAddress) String() string {
+func (x *BridgeBlockAttestation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeContractAddress) ProtoMessage() {}
+func (*BridgeBlockAttestation) ProtoMessage() {}
 
-// Deprecated: Use BridgeContractAddress.ProtoReflect.Descriptor instead.
-func (*BridgeContractAddress) Descriptor() ([]byte, []int) {
-	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use BridgeBlockAttestation.ProtoReflect.Descriptor instead.
+func (*BridgeBlockAttestation) Descriptor() ([]byte, []int) {
+	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *BridgeContractAddress) GetId() string {
+func (x *BridgeBlockAttestation) GetChainId() string {
 	if x != nil {
-		return x.Id
+		return x.ChainId
 	}
 	return ""
 }
 
-func (x *BridgeContractAddress) GetChainId() string {
+func (x *BridgeBlockAttestation) GetBlockNumber() string {
 	if x != nil {
-		return x.ChainId
+		return x.BlockNumber
 	}
 	return ""
 }
 
-func (x *BridgeContractAddress) GetAddress() string {
+func (x *BridgeBlockAttestation) GetReceiptsRoot() string {
 	if x != nil {
-		return x.Address
+		return x.ReceiptsRoot
 	}
 	return ""
 }
 
-// TokenMetadata is used for pre-configuring known tokens before they are bridged
-type BridgeTokenMetadata struct {
+func (x *BridgeBlockAttestation) GetEpochIndex() uint64 {
+	if x != nil {
+		return x.EpochIndex
+	}
+	return 0
+}
+
+func (x *BridgeBlockAttestation) GetValidators() []string {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *BridgeBlockAttestation) GetTotalValidationPower() int64 {
+	if x != nil {
+		return x.TotalValidationPower
+	}
+	return 0
+}
+
+func (x *BridgeBlockAttestation) GetAttested() bool {
+	if x != nil {
+		return x.Attested
+	}
+	return false
+}
+
+// Stores mapping between external chain tokens and their CW20 counterparts
+type BridgeWrappedTokenContract struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId         string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`                 // e.g. "ethereum"
-	ContractAddress string `protobuf:"bytes,2,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"` // original chain contract address
-	Name            string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                       // token name
-	Symbol          string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`                   // token symbol
-	Decimals        uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`              // token decimals
+	ChainId                string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	ContractAddress        string `protobuf:"bytes,2,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	WrappedContractAddress string `protobuf:"bytes,3,opt,name=wrappedContractAddress,proto3" json:"wrappedContractAddress,omitempty"`
 }
 
-func (x *BridgeTokenMetadata) Reset() {
-	*x = BridgeTokenMetadata{}
+func (x *BridgeWrappedTokenContract) Reset() {
+	*x = BridgeWrappedTokenContract{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_bridge_proto_msgTypes[1]
+		mi := &file_inference_inference_bridge_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BridgeTokenMetadata) String() string {
+func (x *BridgeWrappedTokenContract) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeTokenMetadata) ProtoMessage() {}
+func (*BridgeWrappedTokenContract) ProtoMessage() {}
 
-// Deprecated: Use BridgeTokenMetadata.ProtoReflect.Descriptor instead.
-func (*BridgeTokenMetadata) Descriptor() ([]byte, []int) {
-	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use BridgeWrappedTokenContract.ProtoReflect.Descriptor instead.
+func (*BridgeWrappedTokenContract) Descriptor() ([]byte, []int) {
+	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *BridgeTokenMetadata) GetChainId() string {
+func (x *BridgeWrappedTokenContract) GetChainId() string {
 	if x != nil {
 		return x.ChainId
 	}
 	return ""
 }
 
-func (x *BridgeTokenMetadata) GetContractAddress() string {
+func (x *BridgeWrappedTokenContract) GetContractAddress() string {
 	if x != nil {
 		return x.ContractAddress
 	}
 	return ""
 }
 
-func (x *BridgeTokenMetadata) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *BridgeTokenMetadata) GetSymbol() string {
+func (x *BridgeWrappedTokenContract) GetWrappedContractAddress() string {
 	if x != nil {
-		return x.Symbol
+		return x.WrappedContractAddress
 	}
 	return ""
 }
 
-func (x *BridgeTokenMetadata) GetDecimals() uint32 {
-	if x != nil {
-		return x.Decimals
-	}
-	return 0
-}
-
-// BridgeTokenReference defines a reference to a token on an external chain
-// Used for various purposes: trade approval, reverse index lookups, etc.
-type BridgeTokenReference struct {
+// Bridge groups all bridge-related entities under a single parent structure
+type Bridge struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId         string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`                 // e.g. "ethereum" - external chain identifier
-	ContractAddress string `protobuf:"bytes,2,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"` // external chain contract address
+	ContractAddresses   []*BridgeContractAddress `protobuf:"bytes,1,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty"`
+	TokenMetadata       []*BridgeTokenMetadata   `protobuf:"bytes,2,rep,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata,omitempty"`
+	TradeApprovedTokens []*BridgeTokenReference  `protobuf:"bytes,3,rep,name=trade_approved_tokens,json=tradeApprovedTokens,proto3" json:"trade_approved_tokens,omitempty"`
 }
 
-func (x *BridgeTokenReference) Reset() {
-	*x = BridgeTokenReference{}
+func (x *Bridge) Reset() {
+	*x = Bridge{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_bridge_proto_msgTypes[2]
+		mi := &file_inference_inference_bridge_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BridgeTokenReference) String() string {
+func (x *Bridge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeTokenReference) ProtoMessage() {}
+func (*Bridge) ProtoMessage() {}
 
-// Deprecated: Use BridgeTokenReference.ProtoReflect.Descriptor instead.
-func (*BridgeTokenReference) Descriptor() ([]byte, []int) {
-	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Bridge.ProtoReflect.Descriptor instead.
+func (*Bridge) Descriptor() ([]byte, []int) {
+	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *BridgeTokenReference) GetChainId() string {
+func (x *Bridge) GetContractAddresses() []*BridgeContractAddress {
 	if x != nil {
-		return x.ChainId
+		return x.ContractAddresses
 	}
-	return ""
+	return nil
 }
 
-func (x *BridgeTokenReference) GetContractAddress() string {
+func (x *Bridge) GetTokenMetadata() []*BridgeTokenMetadata {
 	if x != nil {
-		return x.ContractAddress
+		return x.TokenMetadata
 	}
-	return ""
+	return nil
 }
 
-type BridgeTransaction struct {
+func (x *Bridge) GetTradeApprovedTokens() []*BridgeTokenReference {
+	if x != nil {
+		return x.TradeApprovedTokens
+	}
+	return nil
+}
+
+// BridgeRateLimit caps the volume of a token moving across the bridge for one origin chain. Releases and
+// mints on Gonka (inbound) and withdrawals to the origin chain (outbound) are limited separately.
+type BridgeRateLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                   string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ChainId              string                  `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
-	ContractAddress      string                  `protobuf:"bytes,3,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
-	OwnerAddress         string                  `protobuf:"bytes,4,opt,name=ownerAddress,proto3" json:"ownerAddress,omitempty"`
-	Amount               string                  `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status               BridgeTransactionStatus `protobuf:"varint,6,opt,name=status,proto3,enum=inference.inference.BridgeTransactionStatus" json:"status,omitempty"`
-	BlockNumber          string                  `protobuf:"bytes,7,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`   // block number where the transaction occurred on the origin chain
-	ReceiptIndex         string                  `protobuf:"bytes,8,opt,name=receiptIndex,proto3" json:"receiptIndex,omitempty"` // index of the transaction receipt in the block
-	ReceiptsRoot         string                  `protobuf:"bytes,9,opt,name=receiptsRoot,proto3" json:"receiptsRoot,omitempty"` // merkle root of receipts trie for transaction verification
-	EpochIndex           uint64                  `protobuf:"varint,10,opt,name=epochIndex,proto3" json:"epochIndex,omitempty"`
-	Validators           []string                `protobuf:"bytes,11,rep,name=validators,proto3" json:"validators,omitempty"`                      // list of validator addresses that validated
-	TotalValidationPower int64                   `protobuf:"varint,12,opt,name=totalValidationPower,proto3" json:"totalValidationPower,omitempty"` // sum of all validator powers that validated
+	ChainId              string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	ContractAddress      string `protobuf:"bytes,2,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`           // token contract on the origin chain, the bridge contract for native GNK
+	WindowBlocks         uint64 `protobuf:"varint,3,opt,name=windowBlocks,proto3" json:"windowBlocks,omitempty"`                // length of the rolling window in blocks
+	MaxWindowVolume      string `protobuf:"bytes,4,opt,name=maxWindowVolume,proto3" json:"maxWindowVolume,omitempty"`           // most that may move in one direction within the window, empty for no cap
+	MaxTransactionAmount string `protobuf:"bytes,5,opt,name=maxTransactionAmount,proto3" json:"maxTransactionAmount,omitempty"` // most a single transfer may move, empty for no cap
 }
 
-func (x *BridgeTransaction) Reset() {
-	*x = BridgeTransaction{}
+func (x *BridgeRateLimit) Reset() {
+	*x = BridgeRateLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_bridge_proto_msgTypes[3]
+		mi := &file_inference_inference_bridge_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BridgeTransaction) String() string {
+func (x *BridgeRateLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeTransaction) ProtoMessage() {}
-
-// Deprecated: Use BridgeTransaction.ProtoReflect.Descriptor instead.
-func (*BridgeTransaction) Descriptor() ([]byte, []int) {
-	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{3}
-}
+func (*BridgeRateLimit) ProtoMessage() {}
 
-func (x *BridgeTransaction) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use BridgeRateLimit.ProtoReflect.Descriptor instead.
+func (*BridgeRateLimit) Descriptor() ([]byte, []int) {
+	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *BridgeTransaction) GetChainId() string {
+func (x *BridgeRateLimit) GetChainId() string {
 	if x != nil {
 		return x.ChainId
 	}
 	return ""
 }
 
-func (x *BridgeTransaction) GetContractAddress() string {
+func (x *BridgeRateLimit) GetContractAddress() string {
 	if x != nil {
 		return x.ContractAddress
 	}
 	return ""
 }
 
-func (x *BridgeTransaction) GetOwnerAddress() string {
+func (x *BridgeRateLimit) GetWindowBlocks() uint64 {
 	if x != nil {
-		return x.OwnerAddress
+		return x.WindowBlocks
 	}
-	return ""
+	return 0
 }
 
-func (x *BridgeTransaction) GetAmount() string {
+func (x *BridgeRateLimit) GetMaxWindowVolume() string {
 	if x != nil {
-		return x.Amount
+		return x.MaxWindowVolume
 	}
 	return ""
 }
 
-func (x *BridgeTransaction) GetStatus() BridgeTransactionStatus {
+func (x *BridgeRateLimit) GetMaxTransactionAmount() string {
 	if x != nil {
-		return x.Status
+		return x.MaxTransactionAmount
 	}
-	return BridgeTransactionStatus_BRIDGE_PENDING
+	return ""
 }
 
-func (x *BridgeTransaction) GetBlockNumber() string {
-	if x != nil {
-		return x.BlockNumber
-	}
-	return ""
+type BridgeVolumeEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight int64  `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	Amount      string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *BridgeTransaction) GetReceiptIndex() string {
-	if x != nil {
-		return x.ReceiptIndex
+func (x *BridgeVolumeEntry) Reset() {
+	*x = BridgeVolumeEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_bridge_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *BridgeTransaction) GetReceiptsRoot() string {
-	if x != nil {
-		return x.ReceiptsRoot
-	}
-	return ""
+func (x *BridgeVolumeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *BridgeTransaction) GetEpochIndex() uint64 {
-	if x != nil {
-		return x.EpochIndex
-	}
-	return 0
+func (*BridgeVolumeEntry) ProtoMessage() {}
+
+// Deprecated: Use BridgeVolumeEntry.ProtoReflect.Descriptor instead.
+func (*BridgeVolumeEntry) Descriptor() ([]byte, []int) {
+	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *BridgeTransaction) GetValidators() []string {
+func (x *BridgeVolumeEntry) GetBlockHeight() int64 {
 	if x != nil {
-		return x.Validators
+		return x.BlockHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *BridgeTransaction) GetTotalValidationPower() int64 {
+func (x *BridgeVolumeEntry) GetAmount() string {
 	if x != nil {
-		return x.TotalValidationPower
+		return x.Amount
 	}
-	return 0
+	return ""
 }
 
-// Stores mapping between external chain tokens and their CW20 counterparts
-type BridgeWrappedTokenContract struct {
+// BridgeVolumeUsage holds the transfers of one direction that fall inside the rolling window of a rate limit
+type BridgeVolumeUsage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId                string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
-	ContractAddress        string `protobuf:"bytes,2,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
-	WrappedContractAddress string `protobuf:"bytes,3,opt,name=wrappedContractAddress,proto3" json:"wrappedContractAddress,omitempty"`
+	ChainId         string               `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	ContractAddress string               `protobuf:"bytes,2,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Direction       string               `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"` // "inbound" or "outbound"
+	Entries         []*BridgeVolumeEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *BridgeWrappedTokenContract) Reset() {
-	*x = BridgeWrappedTokenContract{}
+func (x *BridgeVolumeUsage) Reset() {
+	*x = BridgeVolumeUsage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_bridge_proto_msgTypes[4]
+		mi := &file_inference_inference_bridge_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BridgeWrappedTokenContract) String() string {
+func (x *BridgeVolumeUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeWrappedTokenContract) ProtoMessage() {}
+func (*BridgeVolumeUsage) ProtoMessage() {}
 
-// Deprecated: Use BridgeWrappedTokenContract.ProtoReflect.Descriptor instead.
-func (*BridgeWrappedTokenContract) Descriptor() ([]byte, []int) {
-	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use BridgeVolumeUsage.ProtoReflect.Descriptor instead.
+func (*BridgeVolumeUsage) Descriptor() ([]byte, []int) {
+	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *BridgeWrappedTokenContract) GetChainId() string {
+func (x *BridgeVolumeUsage) GetChainId() string {
 	if x != nil {
 		return x.ChainId
 	}
 	return ""
 }
 
-func (x *BridgeWrappedTokenContract) GetContractAddress() string {
+func (x *BridgeVolumeUsage) GetContractAddress() string {
 	if x != nil {
 		return x.ContractAddress
 	}
 	return ""
 }
 
-func (x *BridgeWrappedTokenContract) GetWrappedContractAddress() string {
+func (x *BridgeVolumeUsage) GetDirection() string {
 	if x != nil {
-		return x.WrappedContractAddress
+		return x.Direction
 	}
 	return ""
 }
 
-// Bridge groups all bridge-related entities under a single parent structure
-type Bridge struct {
+func (x *BridgeVolumeUsage) GetEntries() []*BridgeVolumeEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// BridgeChainStatus is the circuit breaker of an origin chain. While paused no deposit from the chain is
+// released and no withdrawal or mint towards it is accepted.
+type BridgeChainStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContractAddresses   []*BridgeContractAddress `protobuf:"bytes,1,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty"`
-	TokenMetadata       []*BridgeTokenMetadata   `protobuf:"bytes,2,rep,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata,omitempty"`
-	TradeApprovedTokens []*BridgeTokenReference  `protobuf:"bytes,3,rep,name=trade_approved_tokens,json=tradeApprovedTokens,proto3" json:"trade_approved_tokens,omitempty"`
+	ChainId        string  `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Paused         bool    `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	PauseReason    string  `protobuf:"bytes,3,opt,name=pauseReason,proto3" json:"pauseReason,omitempty"`
+	PausedAtHeight int64   `protobuf:"varint,4,opt,name=pausedAtHeight,proto3" json:"pausedAtHeight,omitempty"`
+	AutoPaused     bool    `protobuf:"varint,5,opt,name=autoPaused,proto3" json:"autoPaused,omitempty"`                // paused by the anomaly detector rather than by governance
+	AnomalyHeights []int64 `protobuf:"varint,6,rep,packed,name=anomalyHeights,proto3" json:"anomalyHeights,omitempty"` // heights at which deposits were held for exceeding a rate limit
 }
 
-func (x *Bridge) Reset() {
-	*x = Bridge{}
+func (x *BridgeChainStatus) Reset() {
+	*x = BridgeChainStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_bridge_proto_msgTypes[5]
+		mi := &file_inference_inference_bridge_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Bridge) String() string {
+func (x *BridgeChainStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Bridge) ProtoMessage() {}
+func (*BridgeChainStatus) ProtoMessage() {}
 
-// Deprecated: Use Bridge.ProtoReflect.Descriptor instead.
-func (*Bridge) Descriptor() ([]byte, []int) {
-	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use BridgeChainStatus.ProtoReflect.Descriptor instead.
+func (*BridgeChainStatus) Descriptor() ([]byte, []int) {
+	return file_inference_inference_bridge_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Bridge) GetContractAddresses() []*BridgeContractAddress {
+func (x *BridgeChainStatus) GetChainId() string {
 	if x != nil {
-		return x.ContractAddresses
+		return x.ChainId
 	}
-	return nil
+	return ""
 }
 
-func (x *Bridge) GetTokenMetadata() []*BridgeTokenMetadata {
+func (x *BridgeChainStatus) GetPaused() bool {
 	if x != nil {
-		return x.TokenMetadata
+		return x.Paused
 	}
-	return nil
+	return false
 }
 
-func (x *Bridge) GetTradeApprovedTokens() []*BridgeTokenReference {
+func (x *BridgeChainStatus) GetPauseReason() string {
 	if x != nil {
-		return x.TradeApprovedTokens
+		return x.PauseReason
+	}
+	return ""
+}
+
+func (x *BridgeChainStatus) GetPausedAtHeight() int64 {
+	if x != nil {
+		return x.PausedAtHeight
+	}
+	return 0
+}
+
+func (x *BridgeChainStatus) GetAutoPaused() bool {
+	if x != nil {
+		return x.AutoPaused
+	}
+	return false
+}
+
+func (x *BridgeChainStatus) GetAnomalyHeights() []int64 {
+	if x != nil {
+		return x.AnomalyHeights
 	}
 	return nil
 }
@@ -4614,101 +8412,164 @@ var file_inference_inference_bridge_proto_rawDesc = []byte{
 	0x0a, 0x20, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
 	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x13, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x5b, 0x0a, 0x15, 0x42, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5b, 0x0a,
+	0x15, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x13, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x22, 0x5a,
+	0x0a, 0x14, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xc7, 0x03, 0x0a, 0x11, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
 	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x13, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
-	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
-	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x22, 0x5a, 0x0a, 0x14, 0x42, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0xc7, 0x03, 0x0a, 0x11, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22,
-	0x0a, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x69, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x77,
-	0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x98,
-	0x01, 0x0a, 0x1a, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x36, 0x0a, 0x16, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x16, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x93, 0x02, 0x0a, 0x06, 0x42, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x11, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
-	0x4f, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x5d, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x29, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x2a,
-	0x43, 0x0a, 0x17, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x52,
-	0x49, 0x44, 0x47, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x14,
-	0x0a, 0x10, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54,
-	0x45, 0x44, 0x10, 0x01, 0x42, 0xb9, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x42, 0x0b, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2c, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x32, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x22, 0x88, 0x02, 0x0a, 0x16, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x1e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x32, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x22,
+	0x98, 0x01, 0x0a, 0x1a, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x16, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x93, 0x02, 0x0a, 0x06, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x11, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x4f, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x5d, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x22, 0xd7, 0x01, 0x0a, 0x0f, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x28,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x6d, 0x61, 0x78, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x11, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x11, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x46, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x11, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x41, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
+	0x75, 0x74, 0x6f, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x61, 0x75, 0x74, 0x6f, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x61,
+	0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x2a, 0x54, 0x0a, 0x17, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
+	0x0a, 0x0e, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45, 0x5f, 0x43, 0x4f, 0x4d,
+	0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x52, 0x49, 0x44,
+	0x47, 0x45, 0x5f, 0x48, 0x45, 0x4c, 0x44, 0x10, 0x02, 0x42, 0xb9, 0x01, 0x0a, 0x17, 0x63, 0x6f,
+	0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0b, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58,
+	0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4724,26 +8585,32 @@ func file_inference_inference_bridge_proto_rawDescGZIP() []byte {
 }
 
 var file_inference_inference_bridge_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inference_inference_bridge_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_inference_inference_bridge_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_inference_inference_bridge_proto_goTypes = []interface{}{
 	(BridgeTransactionStatus)(0),       // 0: inference.inference.BridgeTransactionStatus
 	(*BridgeContractAddress)(nil),      // 1: inference.inference.BridgeContractAddress
 	(*BridgeTokenMetadata)(nil),        // 2: inference.inference.BridgeTokenMetadata
 	(*BridgeTokenReference)(nil),       // 3: inference.inference.BridgeTokenReference
 	(*BridgeTransaction)(nil),          // 4: inference.inference.BridgeTransaction
-	(*BridgeWrappedTokenContract)(nil), // 5: inference.inference.BridgeWrappedTokenContract
-	(*Bridge)(nil),                     // 6: inference.inference.Bridge
+	(*BridgeBlockAttestation)(nil),     // 5: inference.inference.BridgeBlockAttestation
+	(*BridgeWrappedTokenContract)(nil), // 6: inference.inference.BridgeWrappedTokenContract
+	(*Bridge)(nil),                     // 7: inference.inference.Bridge
+	(*BridgeRateLimit)(nil),            // 8: inference.inference.BridgeRateLimit
+	(*BridgeVolumeEntry)(nil),          // 9: inference.inference.BridgeVolumeEntry
+	(*BridgeVolumeUsage)(nil),          // 10: inference.inference.BridgeVolumeUsage
+	(*BridgeChainStatus)(nil),          // 11: inference.inference.BridgeChainStatus
 }
 var file_inference_inference_bridge_proto_depIdxs = []int32{
 	0, // 0: inference.inference.BridgeTransaction.status:type_name -> inference.inference.BridgeTransactionStatus
 	1, // 1: inference.inference.Bridge.contract_addresses:type_name -> inference.inference.BridgeContractAddress
 	2, // 2: inference.inference.Bridge.token_metadata:type_name -> inference.inference.BridgeTokenMetadata
 	3, // 3: inference.inference.Bridge.trade_approved_tokens:type_name -> inference.inference.BridgeTokenReference
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	9, // 4: inference.inference.BridgeVolumeUsage.entries:type_name -> inference.inference.BridgeVolumeEntry
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_inference_inference_bridge_proto_init() }
@@ -4801,7 +8668,7 @@ func file_inference_inference_bridge_proto_init() {
 			}
 		}
 		file_inference_inference_bridge_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BridgeWrappedTokenContract); i {
+			switch v := v.(*BridgeBlockAttestation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4813,6 +8680,18 @@ func file_inference_inference_bridge_proto_init() {
 			}
 		}
 		file_inference_inference_bridge_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeWrappedTokenContract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_bridge_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Bridge); i {
 			case 0:
 				return &v.state
@@ -4824,6 +8703,54 @@ func file_inference_inference_bridge_proto_init() {
 				return nil
 			}
 		}
+		file_inference_inference_bridge_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeRateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_bridge_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeVolumeEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_bridge_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeVolumeUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_bridge_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeChainStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4831,7 +8758,7 @@ func file_inference_inference_bridge_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_inference_inference_bridge_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -609,6 +609,9 @@ var (
 	fd_Inference_original_prompt              protoreflect.FieldDescriptor
 	fd_Inference_per_token_price              protoreflect.FieldDescriptor
 	fd_Inference_original_prompt_hash         protoreflect.FieldDescriptor
+	fd_Inference_invalidated_by               protoreflect.FieldDescriptor
+	fd_Inference_invalidated_at_block_height  protoreflect.FieldDescriptor
+	fd_Inference_completion_status            protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -647,6 +650,9 @@ func init() {
 	fd_Inference_original_prompt = md_Inference.Fields().ByName("original_prompt")
 	fd_Inference_per_token_price = md_Inference.Fields().ByName("per_token_price")
 	fd_Inference_original_prompt_hash = md_Inference.Fields().ByName("original_prompt_hash")
+	fd_Inference_invalidated_by = md_Inference.Fields().ByName("invalidated_by")
+	fd_Inference_invalidated_at_block_height = md_Inference.Fields().ByName("invalidated_at_block_height")
+	fd_Inference_completion_status = md_Inference.Fields().ByName("completion_status")
 }
 
 var _ protoreflect.Message = (*fastReflection_Inference)(nil)
@@ -912,6 +918,24 @@ func (x *fastReflection_Inference) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if x.InvalidatedBy != "" {
+		value := protoreflect.ValueOfString(x.InvalidatedBy)
+		if !f(fd_Inference_invalidated_by, value) {
+			return
+		}
+	}
+	if x.InvalidatedAtBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InvalidatedAtBlockHeight)
+		if !f(fd_Inference_invalidated_at_block_height, value) {
+			return
+		}
+	}
+	if x.CompletionStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.CompletionStatus))
+		if !f(fd_Inference_completion_status, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -993,6 +1017,12 @@ func (x *fastReflection_Inference) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.PerTokenPrice != uint64(0)
 	case "inference.inference.Inference.original_prompt_hash":
 		return x.OriginalPromptHash != ""
+	case "inference.inference.Inference.invalidated_by":
+		return x.InvalidatedBy != ""
+	case "inference.inference.Inference.invalidated_at_block_height":
+		return x.InvalidatedAtBlockHeight != int64(0)
+	case "inference.inference.Inference.completion_status":
+		return x.CompletionStatus != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1075,6 +1105,12 @@ func (x *fastReflection_Inference) Clear(fd protoreflect.FieldDescriptor) {
 		x.PerTokenPrice = uint64(0)
 	case "inference.inference.Inference.original_prompt_hash":
 		x.OriginalPromptHash = ""
+	case "inference.inference.Inference.invalidated_by":
+		x.InvalidatedBy = ""
+	case "inference.inference.Inference.invalidated_at_block_height":
+		x.InvalidatedAtBlockHeight = int64(0)
+	case "inference.inference.Inference.completion_status":
+		x.CompletionStatus = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1193,6 +1229,15 @@ func (x *fastReflection_Inference) Get(descriptor protoreflect.FieldDescriptor)
 	case "inference.inference.Inference.original_prompt_hash":
 		value := x.OriginalPromptHash
 		return protoreflect.ValueOfString(value)
+	case "inference.inference.Inference.invalidated_by":
+		value := x.InvalidatedBy
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.Inference.invalidated_at_block_height":
+		value := x.InvalidatedAtBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.Inference.completion_status":
+		value := x.CompletionStatus
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1281,6 +1326,12 @@ func (x *fastReflection_Inference) Set(fd protoreflect.FieldDescriptor, value pr
 		x.PerTokenPrice = value.Uint()
 	case "inference.inference.Inference.original_prompt_hash":
 		x.OriginalPromptHash = value.Interface().(string)
+	case "inference.inference.Inference.invalidated_by":
+		x.InvalidatedBy = value.Interface().(string)
+	case "inference.inference.Inference.invalidated_at_block_height":
+		x.InvalidatedAtBlockHeight = value.Int()
+	case "inference.inference.Inference.completion_status":
+		x.CompletionStatus = (InferenceCompletionStatus)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1374,6 +1425,12 @@ func (x *fastReflection_Inference) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field per_token_price of message inference.inference.Inference is not mutable"))
 	case "inference.inference.Inference.original_prompt_hash":
 		panic(fmt.Errorf("field original_prompt_hash of message inference.inference.Inference is not mutable"))
+	case "inference.inference.Inference.invalidated_by":
+		panic(fmt.Errorf("field invalidated_by of message inference.inference.Inference is not mutable"))
+	case "inference.inference.Inference.invalidated_at_block_height":
+		panic(fmt.Errorf("field invalidated_at_block_height of message inference.inference.Inference is not mutable"))
+	case "inference.inference.Inference.completion_status":
+		panic(fmt.Errorf("field completion_status of message inference.inference.Inference is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1455,6 +1512,12 @@ func (x *fastReflection_Inference) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "inference.inference.Inference.original_prompt_hash":
 		return protoreflect.ValueOfString("")
+	case "inference.inference.Inference.invalidated_by":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.Inference.invalidated_at_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.Inference.completion_status":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1643,6 +1706,16 @@ func (x *fastReflection_Inference) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.InvalidatedBy)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.InvalidatedAtBlockHeight != 0 {
+			n += 2 + runtime.Sov(uint64(x.InvalidatedAtBlockHeight))
+		}
+		if x.CompletionStatus != 0 {
+			n += 2 + runtime.Sov(uint64(x.CompletionStatus))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1672,6 +1745,29 @@ func (x *fastReflection_Inference) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.CompletionStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CompletionStatus))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa0
+		}
+		if x.InvalidatedAtBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InvalidatedAtBlockHeight))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x98
+		}
+		if len(x.InvalidatedBy) > 0 {
+			i -= len(x.InvalidatedBy)
+			copy(dAtA[i:], x.InvalidatedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InvalidatedBy)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
 		if len(x.OriginalPromptHash) > 0 {
 			i -= len(x.OriginalPromptHash)
 			copy(dAtA[i:], x.OriginalPromptHash)
@@ -2832,6 +2928,76 @@ func (x *fastReflection_Inference) ProtoMethods() *protoiface.Methods {
 				}
 				x.OriginalPromptHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 34:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InvalidatedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InvalidatedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 35:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InvalidatedAtBlockHeight", wireType)
+				}
+				x.InvalidatedAtBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InvalidatedAtBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 36:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletionStatus", wireType)
+				}
+				x.CompletionStatus = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CompletionStatus |= InferenceCompletionStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2938,6 +3104,58 @@ func (InferenceStatus) EnumDescriptor() ([]byte, []int) {
 	return file_inference_inference_inference_proto_rawDescGZIP(), []int{0}
 }
 
+// How the execution of a finished inference ended. Truncated inferences are billed for the tokens actually produced.
+type InferenceCompletionStatus int32
+
+const (
+	InferenceCompletionStatus_COMPLETION_STATUS_COMPLETE InferenceCompletionStatus = 0
+	// The client disconnected mid-stream and the executor stopped generating
+	InferenceCompletionStatus_COMPLETION_STATUS_CLIENT_CANCELLED InferenceCompletionStatus = 1
+	// The ML node failed mid-stream, the output is what was produced before the failure
+	InferenceCompletionStatus_COMPLETION_STATUS_EXECUTOR_FAILED InferenceCompletionStatus = 2
+)
+
+// Enum value maps for InferenceCompletionStatus.
+var (
+	InferenceCompletionStatus_name = map[int32]string{
+		0: "COMPLETION_STATUS_COMPLETE",
+		1: "COMPLETION_STATUS_CLIENT_CANCELLED",
+		2: "COMPLETION_STATUS_EXECUTOR_FAILED",
+	}
+	InferenceCompletionStatus_value = map[string]int32{
+		"COMPLETION_STATUS_COMPLETE":         0,
+		"COMPLETION_STATUS_CLIENT_CANCELLED": 1,
+		"COMPLETION_STATUS_EXECUTOR_FAILED":  2,
+	}
+)
+
+func (x InferenceCompletionStatus) Enum() *InferenceCompletionStatus {
+	p := new(InferenceCompletionStatus)
+	*p = x
+	return p
+}
+
+func (x InferenceCompletionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InferenceCompletionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_inference_inference_inference_proto_enumTypes[1].Descriptor()
+}
+
+func (InferenceCompletionStatus) Type() protoreflect.EnumType {
+	return &file_inference_inference_inference_proto_enumTypes[1]
+}
+
+func (x InferenceCompletionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InferenceCompletionStatus.Descriptor instead.
+func (InferenceCompletionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_inference_inference_inference_proto_rawDescGZIP(), []int{1}
+}
+
 type ProposalDetails struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3027,9 +3245,12 @@ type Inference struct {
 	TransferSignature        string           `protobuf:"bytes,29,opt,name=transfer_signature,json=transferSignature,proto3" json:"transfer_signature,omitempty"`
 	ExecutionSignature       string           `protobuf:"bytes,30,opt,name=execution_signature,json=executionSignature,proto3" json:"execution_signature,omitempty"`
 	// Deprecated: Do not use.
-	OriginalPrompt     string `protobuf:"bytes,31,opt,name=original_prompt,json=originalPrompt,proto3" json:"original_prompt,omitempty"`               // Phase 3: will be removed in Phase 6
-	PerTokenPrice      uint64 `protobuf:"varint,32,opt,name=per_token_price,json=perTokenPrice,proto3" json:"per_token_price,omitempty"`               // Locked-in per-token price when inference started (for dynamic pricing)
-	OriginalPromptHash string `protobuf:"bytes,33,opt,name=original_prompt_hash,json=originalPromptHash,proto3" json:"original_prompt_hash,omitempty"` // Phase 3: for dev signature verification
+	OriginalPrompt           string                    `protobuf:"bytes,31,opt,name=original_prompt,json=originalPrompt,proto3" json:"original_prompt,omitempty"`               // Phase 3: will be removed in Phase 6
+	PerTokenPrice            uint64                    `protobuf:"varint,32,opt,name=per_token_price,json=perTokenPrice,proto3" json:"per_token_price,omitempty"`               // Locked-in per-token price when inference started (for dynamic pricing)
+	OriginalPromptHash       string                    `protobuf:"bytes,33,opt,name=original_prompt_hash,json=originalPromptHash,proto3" json:"original_prompt_hash,omitempty"` // Phase 3: for dev signature verification
+	InvalidatedBy            string                    `protobuf:"bytes,34,opt,name=invalidated_by,json=invalidatedBy,proto3" json:"invalidated_by,omitempty"`                  // validator whose invalidation was accepted, used for appeals
+	InvalidatedAtBlockHeight int64                     `protobuf:"varint,35,opt,name=invalidated_at_block_height,json=invalidatedAtBlockHeight,proto3" json:"invalidated_at_block_height,omitempty"`
+	CompletionStatus         InferenceCompletionStatus `protobuf:"varint,36,opt,name=completion_status,json=completionStatus,proto3,enum=inference.inference.InferenceCompletionStatus" json:"completion_status,omitempty"`
 }
 
 func (x *Inference) Reset() {
@@ -3286,6 +3507,27 @@ func (x *Inference) GetOriginalPromptHash() string {
 	return ""
 }
 
+func (x *Inference) GetInvalidatedBy() string {
+	if x != nil {
+		return x.InvalidatedBy
+	}
+	return ""
+}
+
+func (x *Inference) GetInvalidatedAtBlockHeight() int64 {
+	if x != nil {
+		return x.InvalidatedAtBlockHeight
+	}
+	return 0
+}
+
+func (x *Inference) GetCompletionStatus() InferenceCompletionStatus {
+	if x != nil {
+		return x.CompletionStatus
+	}
+	return InferenceCompletionStatus_COMPLETION_STATUS_COMPLETE
+}
+
 var File_inference_inference_inference_proto protoreflect.FileDescriptor
 
 var file_inference_inference_inference_proto_rawDesc = []byte{
@@ -3302,7 +3544,7 @@ var file_inference_inference_inference_proto_rawDesc = []byte{
 	0x12, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
 	0x79, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x61, 0x64,
 	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xf5, 0x0a, 0x0a, 0x09, 0x49,
+	0x69, 0x63, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xb8, 0x0c, 0x0a, 0x09, 0x49,
 	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
 	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21,
 	0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
@@ -3390,13 +3632,34 @@ var file_inference_inference_inference_proto_rawDesc = []byte{
 	0x12, 0x30, 0x0a, 0x14, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f,
 	0x6d, 0x70, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
 	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x48, 0x61,
-	0x73, 0x68, 0x2a, 0x65, 0x0a, 0x0f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x01,
-	0x12, 0x0d, 0x0a, 0x09, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12,
-	0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03,
-	0x12, 0x0a, 0x0a, 0x06, 0x56, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07,
-	0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x42, 0xbc, 0x01, 0x0a, 0x17, 0x63, 0x6f,
+	0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3d, 0x0a, 0x1b, 0x69, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x5b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x24, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x65, 0x0a, 0x0f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x41, 0x52,
+	0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12,
+	0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x8a, 0x01, 0x0a,
+	0x19, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f,
+	0x4d, 0x50, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x43, 0x4f,
+	0x4d, 0x50, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x4f, 0x52,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x42, 0xbc, 0x01, 0x0a, 0x17, 0x63, 0x6f,
 	0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65,
 	0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
 	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
@@ -3423,21 +3686,23 @@ func file_inference_inference_inference_proto_rawDescGZIP() []byte {
 	return file_inference_inference_inference_proto_rawDescData
 }
 
-var file_inference_inference_inference_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_inference_inference_inference_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_inference_inference_inference_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_inference_inference_inference_proto_goTypes = []interface{}{
-	(InferenceStatus)(0),    // 0: inference.inference.InferenceStatus
-	(*ProposalDetails)(nil), // 1: inference.inference.ProposalDetails
-	(*Inference)(nil),       // 2: inference.inference.Inference
+	(InferenceStatus)(0),           // 0: inference.inference.InferenceStatus
+	(InferenceCompletionStatus)(0), // 1: inference.inference.InferenceCompletionStatus
+	(*ProposalDetails)(nil),        // 2: inference.inference.ProposalDetails
+	(*Inference)(nil),              // 3: inference.inference.Inference
 }
 var file_inference_inference_inference_proto_depIdxs = []int32{
 	0, // 0: inference.inference.Inference.status:type_name -> inference.inference.InferenceStatus
-	1, // 1: inference.inference.Inference.proposal_details:type_name -> inference.inference.ProposalDetails
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 1: inference.inference.Inference.proposal_details:type_name -> inference.inference.ProposalDetails
+	1, // 2: inference.inference.Inference.completion_status:type_name -> inference.inference.InferenceCompletionStatus
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_inference_inference_inference_proto_init() }
@@ -3476,7 +3741,7 @@ func file_inference_inference_inference_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_inference_inference_inference_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
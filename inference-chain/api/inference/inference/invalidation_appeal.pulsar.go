@@ -0,0 +1,2785 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package inference
+
+import (
+	binary "encoding/binary"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	math "math"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_AppealVote           protoreflect.MessageDescriptor
+	fd_AppealVote_validator protoreflect.FieldDescriptor
+	fd_AppealVote_value     protoreflect.FieldDescriptor
+	fd_AppealVote_passed    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_invalidation_appeal_proto_init()
+	md_AppealVote = File_inference_inference_invalidation_appeal_proto.Messages().ByName("AppealVote")
+	fd_AppealVote_validator = md_AppealVote.Fields().ByName("validator")
+	fd_AppealVote_value = md_AppealVote.Fields().ByName("value")
+	fd_AppealVote_passed = md_AppealVote.Fields().ByName("passed")
+}
+
+var _ protoreflect.Message = (*fastReflection_AppealVote)(nil)
+
+type fastReflection_AppealVote AppealVote
+
+func (x *AppealVote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AppealVote)(x)
+}
+
+func (x *AppealVote) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_invalidation_appeal_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AppealVote_messageType fastReflection_AppealVote_messageType
+var _ protoreflect.MessageType = fastReflection_AppealVote_messageType{}
+
+type fastReflection_AppealVote_messageType struct{}
+
+func (x fastReflection_AppealVote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AppealVote)(nil)
+}
+func (x fastReflection_AppealVote_messageType) New() protoreflect.Message {
+	return new(fastReflection_AppealVote)
+}
+func (x fastReflection_AppealVote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AppealVote
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AppealVote) Descriptor() protoreflect.MessageDescriptor {
+	return md_AppealVote
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AppealVote) Type() protoreflect.MessageType {
+	return _fastReflection_AppealVote_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AppealVote) New() protoreflect.Message {
+	return new(fastReflection_AppealVote)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AppealVote) Interface() protoreflect.ProtoMessage {
+	return (*AppealVote)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AppealVote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_AppealVote_validator, value) {
+			return
+		}
+	}
+	if x.Value != float64(0) || math.Signbit(x.Value) {
+		value := protoreflect.ValueOfFloat64(x.Value)
+		if !f(fd_AppealVote_value, value) {
+			return
+		}
+	}
+	if x.Passed != false {
+		value := protoreflect.ValueOfBool(x.Passed)
+		if !f(fd_AppealVote_passed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AppealVote) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.AppealVote.validator":
+		return x.Validator != ""
+	case "inference.inference.AppealVote.value":
+		return x.Value != float64(0) || math.Signbit(x.Value)
+	case "inference.inference.AppealVote.passed":
+		return x.Passed != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.AppealVote"))
+		}
+		panic(fmt.Errorf("message inference.inference.AppealVote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppealVote) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.AppealVote.validator":
+		x.Validator = ""
+	case "inference.inference.AppealVote.value":
+		x.Value = float64(0)
+	case "inference.inference.AppealVote.passed":
+		x.Passed = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.AppealVote"))
+		}
+		panic(fmt.Errorf("message inference.inference.AppealVote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AppealVote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.AppealVote.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.AppealVote.value":
+		value := x.Value
+		return protoreflect.ValueOfFloat64(value)
+	case "inference.inference.AppealVote.passed":
+		value := x.Passed
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.AppealVote"))
+		}
+		panic(fmt.Errorf("message inference.inference.AppealVote does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppealVote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.AppealVote.validator":
+		x.Validator = value.Interface().(string)
+	case "inference.inference.AppealVote.value":
+		x.Value = value.Float()
+	case "inference.inference.AppealVote.passed":
+		x.Passed = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.AppealVote"))
+		}
+		panic(fmt.Errorf("message inference.inference.AppealVote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppealVote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.AppealVote.validator":
+		panic(fmt.Errorf("field validator of message inference.inference.AppealVote is not mutable"))
+	case "inference.inference.AppealVote.value":
+		panic(fmt.Errorf("field value of message inference.inference.AppealVote is not mutable"))
+	case "inference.inference.AppealVote.passed":
+		panic(fmt.Errorf("field passed of message inference.inference.AppealVote is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.AppealVote"))
+		}
+		panic(fmt.Errorf("message inference.inference.AppealVote does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AppealVote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.AppealVote.validator":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.AppealVote.value":
+		return protoreflect.ValueOfFloat64(float64(0))
+	case "inference.inference.AppealVote.passed":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.AppealVote"))
+		}
+		panic(fmt.Errorf("message inference.inference.AppealVote does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AppealVote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.AppealVote", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AppealVote) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AppealVote) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AppealVote) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AppealVote) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AppealVote)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Value != 0 || math.Signbit(x.Value) {
+			n += 9
+		}
+		if x.Passed {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AppealVote)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Passed {
+			i--
+			if x.Passed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Value != 0 || math.Signbit(x.Value) {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.Value))))
+			i--
+			dAtA[i] = 0x11
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AppealVote)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AppealVote: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AppealVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 1 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				x.Value = float64(math.Float64frombits(v))
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Passed = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_InvalidationAppeal_7_list)(nil)
+
+type _InvalidationAppeal_7_list struct {
+	list *[]string
+}
+
+func (x *_InvalidationAppeal_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_InvalidationAppeal_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_InvalidationAppeal_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_InvalidationAppeal_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_InvalidationAppeal_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message InvalidationAppeal at list field AssignedValidators as it is not of Message kind"))
+}
+
+func (x *_InvalidationAppeal_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_InvalidationAppeal_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_InvalidationAppeal_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_InvalidationAppeal_8_list)(nil)
+
+type _InvalidationAppeal_8_list struct {
+	list *[]*AppealVote
+}
+
+func (x *_InvalidationAppeal_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_InvalidationAppeal_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_InvalidationAppeal_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AppealVote)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_InvalidationAppeal_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AppealVote)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_InvalidationAppeal_8_list) AppendMutable() protoreflect.Value {
+	v := new(AppealVote)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InvalidationAppeal_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_InvalidationAppeal_8_list) NewElement() protoreflect.Value {
+	v := new(AppealVote)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InvalidationAppeal_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_InvalidationAppeal                          protoreflect.MessageDescriptor
+	fd_InvalidationAppeal_inference_id             protoreflect.FieldDescriptor
+	fd_InvalidationAppeal_executor                 protoreflect.FieldDescriptor
+	fd_InvalidationAppeal_invalidator              protoreflect.FieldDescriptor
+	fd_InvalidationAppeal_bond                     protoreflect.FieldDescriptor
+	fd_InvalidationAppeal_opened_at_block_height   protoreflect.FieldDescriptor
+	fd_InvalidationAppeal_deadline_block_height    protoreflect.FieldDescriptor
+	fd_InvalidationAppeal_assigned_validators      protoreflect.FieldDescriptor
+	fd_InvalidationAppeal_votes                    protoreflect.FieldDescriptor
+	fd_InvalidationAppeal_status                   protoreflect.FieldDescriptor
+	fd_InvalidationAppeal_resolved_at_block_height protoreflect.FieldDescriptor
+	fd_InvalidationAppeal_epoch_index              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_invalidation_appeal_proto_init()
+	md_InvalidationAppeal = File_inference_inference_invalidation_appeal_proto.Messages().ByName("InvalidationAppeal")
+	fd_InvalidationAppeal_inference_id = md_InvalidationAppeal.Fields().ByName("inference_id")
+	fd_InvalidationAppeal_executor = md_InvalidationAppeal.Fields().ByName("executor")
+	fd_InvalidationAppeal_invalidator = md_InvalidationAppeal.Fields().ByName("invalidator")
+	fd_InvalidationAppeal_bond = md_InvalidationAppeal.Fields().ByName("bond")
+	fd_InvalidationAppeal_opened_at_block_height = md_InvalidationAppeal.Fields().ByName("opened_at_block_height")
+	fd_InvalidationAppeal_deadline_block_height = md_InvalidationAppeal.Fields().ByName("deadline_block_height")
+	fd_InvalidationAppeal_assigned_validators = md_InvalidationAppeal.Fields().ByName("assigned_validators")
+	fd_InvalidationAppeal_votes = md_InvalidationAppeal.Fields().ByName("votes")
+	fd_InvalidationAppeal_status = md_InvalidationAppeal.Fields().ByName("status")
+	fd_InvalidationAppeal_resolved_at_block_height = md_InvalidationAppeal.Fields().ByName("resolved_at_block_height")
+	fd_InvalidationAppeal_epoch_index = md_InvalidationAppeal.Fields().ByName("epoch_index")
+}
+
+var _ protoreflect.Message = (*fastReflection_InvalidationAppeal)(nil)
+
+type fastReflection_InvalidationAppeal InvalidationAppeal
+
+func (x *InvalidationAppeal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InvalidationAppeal)(x)
+}
+
+func (x *InvalidationAppeal) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_invalidation_appeal_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InvalidationAppeal_messageType fastReflection_InvalidationAppeal_messageType
+var _ protoreflect.MessageType = fastReflection_InvalidationAppeal_messageType{}
+
+type fastReflection_InvalidationAppeal_messageType struct{}
+
+func (x fastReflection_InvalidationAppeal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InvalidationAppeal)(nil)
+}
+func (x fastReflection_InvalidationAppeal_messageType) New() protoreflect.Message {
+	return new(fastReflection_InvalidationAppeal)
+}
+func (x fastReflection_InvalidationAppeal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InvalidationAppeal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InvalidationAppeal) Descriptor() protoreflect.MessageDescriptor {
+	return md_InvalidationAppeal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InvalidationAppeal) Type() protoreflect.MessageType {
+	return _fastReflection_InvalidationAppeal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InvalidationAppeal) New() protoreflect.Message {
+	return new(fastReflection_InvalidationAppeal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InvalidationAppeal) Interface() protoreflect.ProtoMessage {
+	return (*InvalidationAppeal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InvalidationAppeal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InferenceId != "" {
+		value := protoreflect.ValueOfString(x.InferenceId)
+		if !f(fd_InvalidationAppeal_inference_id, value) {
+			return
+		}
+	}
+	if x.Executor != "" {
+		value := protoreflect.ValueOfString(x.Executor)
+		if !f(fd_InvalidationAppeal_executor, value) {
+			return
+		}
+	}
+	if x.Invalidator != "" {
+		value := protoreflect.ValueOfString(x.Invalidator)
+		if !f(fd_InvalidationAppeal_invalidator, value) {
+			return
+		}
+	}
+	if x.Bond != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Bond)
+		if !f(fd_InvalidationAppeal_bond, value) {
+			return
+		}
+	}
+	if x.OpenedAtBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OpenedAtBlockHeight)
+		if !f(fd_InvalidationAppeal_opened_at_block_height, value) {
+			return
+		}
+	}
+	if x.DeadlineBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DeadlineBlockHeight)
+		if !f(fd_InvalidationAppeal_deadline_block_height, value) {
+			return
+		}
+	}
+	if len(x.AssignedValidators) != 0 {
+		value := protoreflect.ValueOfList(&_InvalidationAppeal_7_list{list: &x.AssignedValidators})
+		if !f(fd_InvalidationAppeal_assigned_validators, value) {
+			return
+		}
+	}
+	if len(x.Votes) != 0 {
+		value := protoreflect.ValueOfList(&_InvalidationAppeal_8_list{list: &x.Votes})
+		if !f(fd_InvalidationAppeal_votes, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_InvalidationAppeal_status, value) {
+			return
+		}
+	}
+	if x.ResolvedAtBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ResolvedAtBlockHeight)
+		if !f(fd_InvalidationAppeal_resolved_at_block_height, value) {
+			return
+		}
+	}
+	if x.EpochIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochIndex)
+		if !f(fd_InvalidationAppeal_epoch_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InvalidationAppeal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.InvalidationAppeal.inference_id":
+		return x.InferenceId != ""
+	case "inference.inference.InvalidationAppeal.executor":
+		return x.Executor != ""
+	case "inference.inference.InvalidationAppeal.invalidator":
+		return x.Invalidator != ""
+	case "inference.inference.InvalidationAppeal.bond":
+		return x.Bond != int64(0)
+	case "inference.inference.InvalidationAppeal.opened_at_block_height":
+		return x.OpenedAtBlockHeight != int64(0)
+	case "inference.inference.InvalidationAppeal.deadline_block_height":
+		return x.DeadlineBlockHeight != int64(0)
+	case "inference.inference.InvalidationAppeal.assigned_validators":
+		return len(x.AssignedValidators) != 0
+	case "inference.inference.InvalidationAppeal.votes":
+		return len(x.Votes) != 0
+	case "inference.inference.InvalidationAppeal.status":
+		return x.Status != 0
+	case "inference.inference.InvalidationAppeal.resolved_at_block_height":
+		return x.ResolvedAtBlockHeight != int64(0)
+	case "inference.inference.InvalidationAppeal.epoch_index":
+		return x.EpochIndex != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InvalidationAppeal"))
+		}
+		panic(fmt.Errorf("message inference.inference.InvalidationAppeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvalidationAppeal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.InvalidationAppeal.inference_id":
+		x.InferenceId = ""
+	case "inference.inference.InvalidationAppeal.executor":
+		x.Executor = ""
+	case "inference.inference.InvalidationAppeal.invalidator":
+		x.Invalidator = ""
+	case "inference.inference.InvalidationAppeal.bond":
+		x.Bond = int64(0)
+	case "inference.inference.InvalidationAppeal.opened_at_block_height":
+		x.OpenedAtBlockHeight = int64(0)
+	case "inference.inference.InvalidationAppeal.deadline_block_height":
+		x.DeadlineBlockHeight = int64(0)
+	case "inference.inference.InvalidationAppeal.assigned_validators":
+		x.AssignedValidators = nil
+	case "inference.inference.InvalidationAppeal.votes":
+		x.Votes = nil
+	case "inference.inference.InvalidationAppeal.status":
+		x.Status = 0
+	case "inference.inference.InvalidationAppeal.resolved_at_block_height":
+		x.ResolvedAtBlockHeight = int64(0)
+	case "inference.inference.InvalidationAppeal.epoch_index":
+		x.EpochIndex = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InvalidationAppeal"))
+		}
+		panic(fmt.Errorf("message inference.inference.InvalidationAppeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InvalidationAppeal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.InvalidationAppeal.inference_id":
+		value := x.InferenceId
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.InvalidationAppeal.executor":
+		value := x.Executor
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.InvalidationAppeal.invalidator":
+		value := x.Invalidator
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.InvalidationAppeal.bond":
+		value := x.Bond
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.InvalidationAppeal.opened_at_block_height":
+		value := x.OpenedAtBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.InvalidationAppeal.deadline_block_height":
+		value := x.DeadlineBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.InvalidationAppeal.assigned_validators":
+		if len(x.AssignedValidators) == 0 {
+			return protoreflect.ValueOfList(&_InvalidationAppeal_7_list{})
+		}
+		listValue := &_InvalidationAppeal_7_list{list: &x.AssignedValidators}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.InvalidationAppeal.votes":
+		if len(x.Votes) == 0 {
+			return protoreflect.ValueOfList(&_InvalidationAppeal_8_list{})
+		}
+		listValue := &_InvalidationAppeal_8_list{list: &x.Votes}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.InvalidationAppeal.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "inference.inference.InvalidationAppeal.resolved_at_block_height":
+		value := x.ResolvedAtBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.InvalidationAppeal.epoch_index":
+		value := x.EpochIndex
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InvalidationAppeal"))
+		}
+		panic(fmt.Errorf("message inference.inference.InvalidationAppeal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvalidationAppeal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.InvalidationAppeal.inference_id":
+		x.InferenceId = value.Interface().(string)
+	case "inference.inference.InvalidationAppeal.executor":
+		x.Executor = value.Interface().(string)
+	case "inference.inference.InvalidationAppeal.invalidator":
+		x.Invalidator = value.Interface().(string)
+	case "inference.inference.InvalidationAppeal.bond":
+		x.Bond = value.Int()
+	case "inference.inference.InvalidationAppeal.opened_at_block_height":
+		x.OpenedAtBlockHeight = value.Int()
+	case "inference.inference.InvalidationAppeal.deadline_block_height":
+		x.DeadlineBlockHeight = value.Int()
+	case "inference.inference.InvalidationAppeal.assigned_validators":
+		lv := value.List()
+		clv := lv.(*_InvalidationAppeal_7_list)
+		x.AssignedValidators = *clv.list
+	case "inference.inference.InvalidationAppeal.votes":
+		lv := value.List()
+		clv := lv.(*_InvalidationAppeal_8_list)
+		x.Votes = *clv.list
+	case "inference.inference.InvalidationAppeal.status":
+		x.Status = (AppealStatus)(value.Enum())
+	case "inference.inference.InvalidationAppeal.resolved_at_block_height":
+		x.ResolvedAtBlockHeight = value.Int()
+	case "inference.inference.InvalidationAppeal.epoch_index":
+		x.EpochIndex = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InvalidationAppeal"))
+		}
+		panic(fmt.Errorf("message inference.inference.InvalidationAppeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvalidationAppeal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.InvalidationAppeal.assigned_validators":
+		if x.AssignedValidators == nil {
+			x.AssignedValidators = []string{}
+		}
+		value := &_InvalidationAppeal_7_list{list: &x.AssignedValidators}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.InvalidationAppeal.votes":
+		if x.Votes == nil {
+			x.Votes = []*AppealVote{}
+		}
+		value := &_InvalidationAppeal_8_list{list: &x.Votes}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.InvalidationAppeal.inference_id":
+		panic(fmt.Errorf("field inference_id of message inference.inference.InvalidationAppeal is not mutable"))
+	case "inference.inference.InvalidationAppeal.executor":
+		panic(fmt.Errorf("field executor of message inference.inference.InvalidationAppeal is not mutable"))
+	case "inference.inference.InvalidationAppeal.invalidator":
+		panic(fmt.Errorf("field invalidator of message inference.inference.InvalidationAppeal is not mutable"))
+	case "inference.inference.InvalidationAppeal.bond":
+		panic(fmt.Errorf("field bond of message inference.inference.InvalidationAppeal is not mutable"))
+	case "inference.inference.InvalidationAppeal.opened_at_block_height":
+		panic(fmt.Errorf("field opened_at_block_height of message inference.inference.InvalidationAppeal is not mutable"))
+	case "inference.inference.InvalidationAppeal.deadline_block_height":
+		panic(fmt.Errorf("field deadline_block_height of message inference.inference.InvalidationAppeal is not mutable"))
+	case "inference.inference.InvalidationAppeal.status":
+		panic(fmt.Errorf("field status of message inference.inference.InvalidationAppeal is not mutable"))
+	case "inference.inference.InvalidationAppeal.resolved_at_block_height":
+		panic(fmt.Errorf("field resolved_at_block_height of message inference.inference.InvalidationAppeal is not mutable"))
+	case "inference.inference.InvalidationAppeal.epoch_index":
+		panic(fmt.Errorf("field epoch_index of message inference.inference.InvalidationAppeal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InvalidationAppeal"))
+		}
+		panic(fmt.Errorf("message inference.inference.InvalidationAppeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InvalidationAppeal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.InvalidationAppeal.inference_id":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.InvalidationAppeal.executor":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.InvalidationAppeal.invalidator":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.InvalidationAppeal.bond":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.InvalidationAppeal.opened_at_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.InvalidationAppeal.deadline_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.InvalidationAppeal.assigned_validators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_InvalidationAppeal_7_list{list: &list})
+	case "inference.inference.InvalidationAppeal.votes":
+		list := []*AppealVote{}
+		return protoreflect.ValueOfList(&_InvalidationAppeal_8_list{list: &list})
+	case "inference.inference.InvalidationAppeal.status":
+		return protoreflect.ValueOfEnum(0)
+	case "inference.inference.InvalidationAppeal.resolved_at_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.InvalidationAppeal.epoch_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InvalidationAppeal"))
+		}
+		panic(fmt.Errorf("message inference.inference.InvalidationAppeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InvalidationAppeal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.InvalidationAppeal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InvalidationAppeal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvalidationAppeal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InvalidationAppeal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InvalidationAppeal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InvalidationAppeal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.InferenceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Executor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Invalidator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Bond != 0 {
+			n += 1 + runtime.Sov(uint64(x.Bond))
+		}
+		if x.OpenedAtBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.OpenedAtBlockHeight))
+		}
+		if x.DeadlineBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeadlineBlockHeight))
+		}
+		if len(x.AssignedValidators) > 0 {
+			for _, s := range x.AssignedValidators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Votes) > 0 {
+			for _, e := range x.Votes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.ResolvedAtBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolvedAtBlockHeight))
+		}
+		if x.EpochIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochIndex))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InvalidationAppeal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EpochIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochIndex))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.ResolvedAtBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolvedAtBlockHeight))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.Votes) > 0 {
+			for iNdEx := len(x.Votes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Votes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.AssignedValidators) > 0 {
+			for iNdEx := len(x.AssignedValidators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AssignedValidators[iNdEx])
+				copy(dAtA[i:], x.AssignedValidators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AssignedValidators[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.DeadlineBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeadlineBlockHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.OpenedAtBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OpenedAtBlockHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Bond != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Bond))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Invalidator) > 0 {
+			i -= len(x.Invalidator)
+			copy(dAtA[i:], x.Invalidator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Invalidator)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Executor) > 0 {
+			i -= len(x.Executor)
+			copy(dAtA[i:], x.Executor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executor)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.InferenceId) > 0 {
+			i -= len(x.InferenceId)
+			copy(dAtA[i:], x.InferenceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InferenceId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InvalidationAppeal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InvalidationAppeal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InvalidationAppeal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InferenceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Executor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Invalidator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Invalidator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+				}
+				x.Bond = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Bond |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OpenedAtBlockHeight", wireType)
+				}
+				x.OpenedAtBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OpenedAtBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeadlineBlockHeight", wireType)
+				}
+				x.DeadlineBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeadlineBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssignedValidators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AssignedValidators = append(x.AssignedValidators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Votes = append(x.Votes, &AppealVote{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Votes[len(x.Votes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= AppealStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolvedAtBlockHeight", wireType)
+				}
+				x.ResolvedAtBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolvedAtBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+				}
+				x.EpochIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_InvalidationEffects                         protoreflect.MessageDescriptor
+	fd_InvalidationEffects_inference_id            protoreflect.FieldDescriptor
+	fd_InvalidationEffects_refunded_amount         protoreflect.FieldDescriptor
+	fd_InvalidationEffects_executor_invalidated    protoreflect.FieldDescriptor
+	fd_InvalidationEffects_epochs_completed_before protoreflect.FieldDescriptor
+	fd_InvalidationEffects_epoch_index             protoreflect.FieldDescriptor
+	fd_InvalidationEffects_compensated_amount      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_invalidation_appeal_proto_init()
+	md_InvalidationEffects = File_inference_inference_invalidation_appeal_proto.Messages().ByName("InvalidationEffects")
+	fd_InvalidationEffects_inference_id = md_InvalidationEffects.Fields().ByName("inference_id")
+	fd_InvalidationEffects_refunded_amount = md_InvalidationEffects.Fields().ByName("refunded_amount")
+	fd_InvalidationEffects_executor_invalidated = md_InvalidationEffects.Fields().ByName("executor_invalidated")
+	fd_InvalidationEffects_epochs_completed_before = md_InvalidationEffects.Fields().ByName("epochs_completed_before")
+	fd_InvalidationEffects_epoch_index = md_InvalidationEffects.Fields().ByName("epoch_index")
+	fd_InvalidationEffects_compensated_amount = md_InvalidationEffects.Fields().ByName("compensated_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_InvalidationEffects)(nil)
+
+type fastReflection_InvalidationEffects InvalidationEffects
+
+func (x *InvalidationEffects) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InvalidationEffects)(x)
+}
+
+func (x *InvalidationEffects) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_invalidation_appeal_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InvalidationEffects_messageType fastReflection_InvalidationEffects_messageType
+var _ protoreflect.MessageType = fastReflection_InvalidationEffects_messageType{}
+
+type fastReflection_InvalidationEffects_messageType struct{}
+
+func (x fastReflection_InvalidationEffects_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InvalidationEffects)(nil)
+}
+func (x fastReflection_InvalidationEffects_messageType) New() protoreflect.Message {
+	return new(fastReflection_InvalidationEffects)
+}
+func (x fastReflection_InvalidationEffects_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InvalidationEffects
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InvalidationEffects) Descriptor() protoreflect.MessageDescriptor {
+	return md_InvalidationEffects
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InvalidationEffects) Type() protoreflect.MessageType {
+	return _fastReflection_InvalidationEffects_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InvalidationEffects) New() protoreflect.Message {
+	return new(fastReflection_InvalidationEffects)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InvalidationEffects) Interface() protoreflect.ProtoMessage {
+	return (*InvalidationEffects)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InvalidationEffects) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InferenceId != "" {
+		value := protoreflect.ValueOfString(x.InferenceId)
+		if !f(fd_InvalidationEffects_inference_id, value) {
+			return
+		}
+	}
+	if x.RefundedAmount != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RefundedAmount)
+		if !f(fd_InvalidationEffects_refunded_amount, value) {
+			return
+		}
+	}
+	if x.ExecutorInvalidated != false {
+		value := protoreflect.ValueOfBool(x.ExecutorInvalidated)
+		if !f(fd_InvalidationEffects_executor_invalidated, value) {
+			return
+		}
+	}
+	if x.EpochsCompletedBefore != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.EpochsCompletedBefore)
+		if !f(fd_InvalidationEffects_epochs_completed_before, value) {
+			return
+		}
+	}
+	if x.EpochIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochIndex)
+		if !f(fd_InvalidationEffects_epoch_index, value) {
+			return
+		}
+	}
+	if x.CompensatedAmount != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CompensatedAmount)
+		if !f(fd_InvalidationEffects_compensated_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InvalidationEffects) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.InvalidationEffects.inference_id":
+		return x.InferenceId != ""
+	case "inference.inference.InvalidationEffects.refunded_amount":
+		return x.RefundedAmount != int64(0)
+	case "inference.inference.InvalidationEffects.executor_invalidated":
+		return x.ExecutorInvalidated != false
+	case "inference.inference.InvalidationEffects.epochs_completed_before":
+		return x.EpochsCompletedBefore != uint32(0)
+	case "inference.inference.InvalidationEffects.epoch_index":
+		return x.EpochIndex != uint64(0)
+	case "inference.inference.InvalidationEffects.compensated_amount":
+		return x.CompensatedAmount != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InvalidationEffects"))
+		}
+		panic(fmt.Errorf("message inference.inference.InvalidationEffects does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvalidationEffects) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.InvalidationEffects.inference_id":
+		x.InferenceId = ""
+	case "inference.inference.InvalidationEffects.refunded_amount":
+		x.RefundedAmount = int64(0)
+	case "inference.inference.InvalidationEffects.executor_invalidated":
+		x.ExecutorInvalidated = false
+	case "inference.inference.InvalidationEffects.epochs_completed_before":
+		x.EpochsCompletedBefore = uint32(0)
+	case "inference.inference.InvalidationEffects.epoch_index":
+		x.EpochIndex = uint64(0)
+	case "inference.inference.InvalidationEffects.compensated_amount":
+		x.CompensatedAmount = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InvalidationEffects"))
+		}
+		panic(fmt.Errorf("message inference.inference.InvalidationEffects does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InvalidationEffects) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.InvalidationEffects.inference_id":
+		value := x.InferenceId
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.InvalidationEffects.refunded_amount":
+		value := x.RefundedAmount
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.InvalidationEffects.executor_invalidated":
+		value := x.ExecutorInvalidated
+		return protoreflect.ValueOfBool(value)
+	case "inference.inference.InvalidationEffects.epochs_completed_before":
+		value := x.EpochsCompletedBefore
+		return protoreflect.ValueOfUint32(value)
+	case "inference.inference.InvalidationEffects.epoch_index":
+		value := x.EpochIndex
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.InvalidationEffects.compensated_amount":
+		value := x.CompensatedAmount
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InvalidationEffects"))
+		}
+		panic(fmt.Errorf("message inference.inference.InvalidationEffects does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvalidationEffects) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.InvalidationEffects.inference_id":
+		x.InferenceId = value.Interface().(string)
+	case "inference.inference.InvalidationEffects.refunded_amount":
+		x.RefundedAmount = value.Int()
+	case "inference.inference.InvalidationEffects.executor_invalidated":
+		x.ExecutorInvalidated = value.Bool()
+	case "inference.inference.InvalidationEffects.epochs_completed_before":
+		x.EpochsCompletedBefore = uint32(value.Uint())
+	case "inference.inference.InvalidationEffects.epoch_index":
+		x.EpochIndex = value.Uint()
+	case "inference.inference.InvalidationEffects.compensated_amount":
+		x.CompensatedAmount = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InvalidationEffects"))
+		}
+		panic(fmt.Errorf("message inference.inference.InvalidationEffects does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvalidationEffects) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.InvalidationEffects.inference_id":
+		panic(fmt.Errorf("field inference_id of message inference.inference.InvalidationEffects is not mutable"))
+	case "inference.inference.InvalidationEffects.refunded_amount":
+		panic(fmt.Errorf("field refunded_amount of message inference.inference.InvalidationEffects is not mutable"))
+	case "inference.inference.InvalidationEffects.executor_invalidated":
+		panic(fmt.Errorf("field executor_invalidated of message inference.inference.InvalidationEffects is not mutable"))
+	case "inference.inference.InvalidationEffects.epochs_completed_before":
+		panic(fmt.Errorf("field epochs_completed_before of message inference.inference.InvalidationEffects is not mutable"))
+	case "inference.inference.InvalidationEffects.epoch_index":
+		panic(fmt.Errorf("field epoch_index of message inference.inference.InvalidationEffects is not mutable"))
+	case "inference.inference.InvalidationEffects.compensated_amount":
+		panic(fmt.Errorf("field compensated_amount of message inference.inference.InvalidationEffects is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InvalidationEffects"))
+		}
+		panic(fmt.Errorf("message inference.inference.InvalidationEffects does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InvalidationEffects) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.InvalidationEffects.inference_id":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.InvalidationEffects.refunded_amount":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.InvalidationEffects.executor_invalidated":
+		return protoreflect.ValueOfBool(false)
+	case "inference.inference.InvalidationEffects.epochs_completed_before":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "inference.inference.InvalidationEffects.epoch_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.InvalidationEffects.compensated_amount":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InvalidationEffects"))
+		}
+		panic(fmt.Errorf("message inference.inference.InvalidationEffects does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InvalidationEffects) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.InvalidationEffects", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InvalidationEffects) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvalidationEffects) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InvalidationEffects) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InvalidationEffects) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InvalidationEffects)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.InferenceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RefundedAmount != 0 {
+			n += 1 + runtime.Sov(uint64(x.RefundedAmount))
+		}
+		if x.ExecutorInvalidated {
+			n += 2
+		}
+		if x.EpochsCompletedBefore != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochsCompletedBefore))
+		}
+		if x.EpochIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochIndex))
+		}
+		if x.CompensatedAmount != 0 {
+			n += 1 + runtime.Sov(uint64(x.CompensatedAmount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InvalidationEffects)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CompensatedAmount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CompensatedAmount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.EpochIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochIndex))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.EpochsCompletedBefore != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochsCompletedBefore))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ExecutorInvalidated {
+			i--
+			if x.ExecutorInvalidated {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.RefundedAmount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RefundedAmount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.InferenceId) > 0 {
+			i -= len(x.InferenceId)
+			copy(dAtA[i:], x.InferenceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InferenceId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InvalidationEffects)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InvalidationEffects: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InvalidationEffects: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InferenceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundedAmount", wireType)
+				}
+				x.RefundedAmount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RefundedAmount |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutorInvalidated", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ExecutorInvalidated = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochsCompletedBefore", wireType)
+				}
+				x.EpochsCompletedBefore = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochsCompletedBefore |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+				}
+				x.EpochIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompensatedAmount", wireType)
+				}
+				x.CompensatedAmount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CompensatedAmount |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/inference/invalidation_appeal.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AppealStatus int32
+
+const (
+	AppealStatus_APPEAL_STATUS_PENDING  AppealStatus = 0
+	AppealStatus_APPEAL_STATUS_UPHELD   AppealStatus = 1 // invalidation confirmed, bond burned
+	AppealStatus_APPEAL_STATUS_REVERSED AppealStatus = 2 // invalidation reversed, bond returned and invalidator penalized
+	AppealStatus_APPEAL_STATUS_EXPIRED  AppealStatus = 3 // no appeal validator voted, invalidation stands and bond is returned
+)
+
+// Enum value maps for AppealStatus.
+var (
+	AppealStatus_name = map[int32]string{
+		0: "APPEAL_STATUS_PENDING",
+		1: "APPEAL_STATUS_UPHELD",
+		2: "APPEAL_STATUS_REVERSED",
+		3: "APPEAL_STATUS_EXPIRED",
+	}
+	AppealStatus_value = map[string]int32{
+		"APPEAL_STATUS_PENDING":  0,
+		"APPEAL_STATUS_UPHELD":   1,
+		"APPEAL_STATUS_REVERSED": 2,
+		"APPEAL_STATUS_EXPIRED":  3,
+	}
+)
+
+func (x AppealStatus) Enum() *AppealStatus {
+	p := new(AppealStatus)
+	*p = x
+	return p
+}
+
+func (x AppealStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppealStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_inference_inference_invalidation_appeal_proto_enumTypes[0].Descriptor()
+}
+
+func (AppealStatus) Type() protoreflect.EnumType {
+	return &file_inference_inference_invalidation_appeal_proto_enumTypes[0]
+}
+
+func (x AppealStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppealStatus.Descriptor instead.
+func (AppealStatus) EnumDescriptor() ([]byte, []int) {
+	return file_inference_inference_invalidation_appeal_proto_rawDescGZIP(), []int{0}
+}
+
+type AppealVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator string  `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Value     float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Passed    bool    `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
+}
+
+func (x *AppealVote) Reset() {
+	*x = AppealVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_invalidation_appeal_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppealVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealVote) ProtoMessage() {}
+
+// Deprecated: Use AppealVote.ProtoReflect.Descriptor instead.
+func (*AppealVote) Descriptor() ([]byte, []int) {
+	return file_inference_inference_invalidation_appeal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AppealVote) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *AppealVote) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AppealVote) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+// InvalidationAppeal is an executor's dispute of an invalidated inference.
+// Assigned validators are sampled by validation weight and re-execute the inference.
+type InvalidationAppeal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InferenceId           string        `protobuf:"bytes,1,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	Executor              string        `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+	Invalidator           string        `protobuf:"bytes,3,opt,name=invalidator,proto3" json:"invalidator,omitempty"`
+	Bond                  int64         `protobuf:"varint,4,opt,name=bond,proto3" json:"bond,omitempty"`
+	OpenedAtBlockHeight   int64         `protobuf:"varint,5,opt,name=opened_at_block_height,json=openedAtBlockHeight,proto3" json:"opened_at_block_height,omitempty"`
+	DeadlineBlockHeight   int64         `protobuf:"varint,6,opt,name=deadline_block_height,json=deadlineBlockHeight,proto3" json:"deadline_block_height,omitempty"`
+	AssignedValidators    []string      `protobuf:"bytes,7,rep,name=assigned_validators,json=assignedValidators,proto3" json:"assigned_validators,omitempty"`
+	Votes                 []*AppealVote `protobuf:"bytes,8,rep,name=votes,proto3" json:"votes,omitempty"`
+	Status                AppealStatus  `protobuf:"varint,9,opt,name=status,proto3,enum=inference.inference.AppealStatus" json:"status,omitempty"`
+	ResolvedAtBlockHeight int64         `protobuf:"varint,10,opt,name=resolved_at_block_height,json=resolvedAtBlockHeight,proto3" json:"resolved_at_block_height,omitempty"`
+	EpochIndex            uint64        `protobuf:"varint,11,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+}
+
+func (x *InvalidationAppeal) Reset() {
+	*x = InvalidationAppeal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_invalidation_appeal_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidationAppeal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidationAppeal) ProtoMessage() {}
+
+// Deprecated: Use InvalidationAppeal.ProtoReflect.Descriptor instead.
+func (*InvalidationAppeal) Descriptor() ([]byte, []int) {
+	return file_inference_inference_invalidation_appeal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InvalidationAppeal) GetInferenceId() string {
+	if x != nil {
+		return x.InferenceId
+	}
+	return ""
+}
+
+func (x *InvalidationAppeal) GetExecutor() string {
+	if x != nil {
+		return x.Executor
+	}
+	return ""
+}
+
+func (x *InvalidationAppeal) GetInvalidator() string {
+	if x != nil {
+		return x.Invalidator
+	}
+	return ""
+}
+
+func (x *InvalidationAppeal) GetBond() int64 {
+	if x != nil {
+		return x.Bond
+	}
+	return 0
+}
+
+func (x *InvalidationAppeal) GetOpenedAtBlockHeight() int64 {
+	if x != nil {
+		return x.OpenedAtBlockHeight
+	}
+	return 0
+}
+
+func (x *InvalidationAppeal) GetDeadlineBlockHeight() int64 {
+	if x != nil {
+		return x.DeadlineBlockHeight
+	}
+	return 0
+}
+
+func (x *InvalidationAppeal) GetAssignedValidators() []string {
+	if x != nil {
+		return x.AssignedValidators
+	}
+	return nil
+}
+
+func (x *InvalidationAppeal) GetVotes() []*AppealVote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *InvalidationAppeal) GetStatus() AppealStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AppealStatus_APPEAL_STATUS_PENDING
+}
+
+func (x *InvalidationAppeal) GetResolvedAtBlockHeight() int64 {
+	if x != nil {
+		return x.ResolvedAtBlockHeight
+	}
+	return 0
+}
+
+func (x *InvalidationAppeal) GetEpochIndex() uint64 {
+	if x != nil {
+		return x.EpochIndex
+	}
+	return 0
+}
+
+// InvalidationEffects records the side effects of an invalidation, so a reversed appeal can undo them
+type InvalidationEffects struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InferenceId string `protobuf:"bytes,1,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	// the executor's ActualCost was deducted and refunded to the requester
+	RefundedAmount int64 `protobuf:"varint,2,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	// the invalidation moved the executor to INVALID
+	ExecutorInvalidated   bool   `protobuf:"varint,3,opt,name=executor_invalidated,json=executorInvalidated,proto3" json:"executor_invalidated,omitempty"`
+	EpochsCompletedBefore uint32 `protobuf:"varint,4,opt,name=epochs_completed_before,json=epochsCompletedBefore,proto3" json:"epochs_completed_before,omitempty"`
+	EpochIndex            uint64 `protobuf:"varint,5,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	// paid to the requester from the collateral compensation pool on top of the refund
+	CompensatedAmount int64 `protobuf:"varint,6,opt,name=compensated_amount,json=compensatedAmount,proto3" json:"compensated_amount,omitempty"`
+}
+
+func (x *InvalidationEffects) Reset() {
+	*x = InvalidationEffects{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_invalidation_appeal_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidationEffects) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidationEffects) ProtoMessage() {}
+
+// Deprecated: Use InvalidationEffects.ProtoReflect.Descriptor instead.
+func (*InvalidationEffects) Descriptor() ([]byte, []int) {
+	return file_inference_inference_invalidation_appeal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InvalidationEffects) GetInferenceId() string {
+	if x != nil {
+		return x.InferenceId
+	}
+	return ""
+}
+
+func (x *InvalidationEffects) GetRefundedAmount() int64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
+}
+
+func (x *InvalidationEffects) GetExecutorInvalidated() bool {
+	if x != nil {
+		return x.ExecutorInvalidated
+	}
+	return false
+}
+
+func (x *InvalidationEffects) GetEpochsCompletedBefore() uint32 {
+	if x != nil {
+		return x.EpochsCompletedBefore
+	}
+	return 0
+}
+
+func (x *InvalidationEffects) GetEpochIndex() uint64 {
+	if x != nil {
+		return x.EpochIndex
+	}
+	return 0
+}
+
+func (x *InvalidationEffects) GetCompensatedAmount() int64 {
+	if x != nil {
+		return x.CompensatedAmount
+	}
+	return 0
+}
+
+var File_inference_inference_invalidation_appeal_proto protoreflect.FileDescriptor
+
+var file_inference_inference_invalidation_appeal_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x13, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x22, 0x58, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x22, 0xef,
+	0x03, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x70, 0x70, 0x65, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x6f, 0x70,
+	0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6f, 0x70, 0x65, 0x6e,
+	0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x32, 0x0a, 0x15, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x12, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c,
+	0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x9c, 0x02, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x14, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x6f,
+	0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x2a,
+	0x7a, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x19, 0x0a, 0x15, 0x41, 0x50, 0x50, 0x45, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x50,
+	0x50, 0x45, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x50, 0x48, 0x45,
+	0x4c, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x50, 0x50, 0x45, 0x41, 0x4c, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x19, 0x0a, 0x15, 0x41, 0x50, 0x50, 0x45, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x42, 0xc5, 0x01, 0x0a, 0x17,
+	0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x17, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02,
+	0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_inference_inference_invalidation_appeal_proto_rawDescOnce sync.Once
+	file_inference_inference_invalidation_appeal_proto_rawDescData = file_inference_inference_invalidation_appeal_proto_rawDesc
+)
+
+func file_inference_inference_invalidation_appeal_proto_rawDescGZIP() []byte {
+	file_inference_inference_invalidation_appeal_proto_rawDescOnce.Do(func() {
+		file_inference_inference_invalidation_appeal_proto_rawDescData = protoimpl.X.CompressGZIP(file_inference_inference_invalidation_appeal_proto_rawDescData)
+	})
+	return file_inference_inference_invalidation_appeal_proto_rawDescData
+}
+
+var file_inference_inference_invalidation_appeal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_inference_inference_invalidation_appeal_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_inference_inference_invalidation_appeal_proto_goTypes = []interface{}{
+	(AppealStatus)(0),           // 0: inference.inference.AppealStatus
+	(*AppealVote)(nil),          // 1: inference.inference.AppealVote
+	(*InvalidationAppeal)(nil),  // 2: inference.inference.InvalidationAppeal
+	(*InvalidationEffects)(nil), // 3: inference.inference.InvalidationEffects
+}
+var file_inference_inference_invalidation_appeal_proto_depIdxs = []int32{
+	1, // 0: inference.inference.InvalidationAppeal.votes:type_name -> inference.inference.AppealVote
+	0, // 1: inference.inference.InvalidationAppeal.status:type_name -> inference.inference.AppealStatus
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_inference_inference_invalidation_appeal_proto_init() }
+func file_inference_inference_invalidation_appeal_proto_init() {
+	if File_inference_inference_invalidation_appeal_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_inference_inference_invalidation_appeal_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppealVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_invalidation_appeal_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidationAppeal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_invalidation_appeal_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidationEffects); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_inference_inference_invalidation_appeal_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_inference_inference_invalidation_appeal_proto_goTypes,
+		DependencyIndexes: file_inference_inference_invalidation_appeal_proto_depIdxs,
+		EnumInfos:         file_inference_inference_invalidation_appeal_proto_enumTypes,
+		MessageInfos:      file_inference_inference_invalidation_appeal_proto_msgTypes,
+	}.Build()
+	File_inference_inference_invalidation_appeal_proto = out.File
+	file_inference_inference_invalidation_appeal_proto_rawDesc = nil
+	file_inference_inference_invalidation_appeal_proto_goTypes = nil
+	file_inference_inference_invalidation_appeal_proto_depIdxs = nil
+}
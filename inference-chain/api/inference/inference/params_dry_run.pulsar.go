@@ -0,0 +1,2393 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package inference
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_ParamChange          protoreflect.MessageDescriptor
+	fd_ParamChange_field    protoreflect.FieldDescriptor
+	fd_ParamChange_current  protoreflect.FieldDescriptor
+	fd_ParamChange_proposed protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_params_dry_run_proto_init()
+	md_ParamChange = File_inference_inference_params_dry_run_proto.Messages().ByName("ParamChange")
+	fd_ParamChange_field = md_ParamChange.Fields().ByName("field")
+	fd_ParamChange_current = md_ParamChange.Fields().ByName("current")
+	fd_ParamChange_proposed = md_ParamChange.Fields().ByName("proposed")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParamChange)(nil)
+
+type fastReflection_ParamChange ParamChange
+
+func (x *ParamChange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParamChange)(x)
+}
+
+func (x *ParamChange) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_params_dry_run_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParamChange_messageType fastReflection_ParamChange_messageType
+var _ protoreflect.MessageType = fastReflection_ParamChange_messageType{}
+
+type fastReflection_ParamChange_messageType struct{}
+
+func (x fastReflection_ParamChange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParamChange)(nil)
+}
+func (x fastReflection_ParamChange_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParamChange)
+}
+func (x fastReflection_ParamChange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamChange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParamChange) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamChange
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParamChange) Type() protoreflect.MessageType {
+	return _fastReflection_ParamChange_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParamChange) New() protoreflect.Message {
+	return new(fastReflection_ParamChange)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParamChange) Interface() protoreflect.ProtoMessage {
+	return (*ParamChange)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParamChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Field != "" {
+		value := protoreflect.ValueOfString(x.Field)
+		if !f(fd_ParamChange_field, value) {
+			return
+		}
+	}
+	if x.Current != "" {
+		value := protoreflect.ValueOfString(x.Current)
+		if !f(fd_ParamChange_current, value) {
+			return
+		}
+	}
+	if x.Proposed != "" {
+		value := protoreflect.ValueOfString(x.Proposed)
+		if !f(fd_ParamChange_proposed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParamChange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.ParamChange.field":
+		return x.Field != ""
+	case "inference.inference.ParamChange.current":
+		return x.Current != ""
+	case "inference.inference.ParamChange.proposed":
+		return x.Proposed != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParamChange"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamChange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.ParamChange.field":
+		x.Field = ""
+	case "inference.inference.ParamChange.current":
+		x.Current = ""
+	case "inference.inference.ParamChange.proposed":
+		x.Proposed = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParamChange"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParamChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.ParamChange.field":
+		value := x.Field
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.ParamChange.current":
+		value := x.Current
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.ParamChange.proposed":
+		value := x.Proposed
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParamChange"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParamChange does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.ParamChange.field":
+		x.Field = value.Interface().(string)
+	case "inference.inference.ParamChange.current":
+		x.Current = value.Interface().(string)
+	case "inference.inference.ParamChange.proposed":
+		x.Proposed = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParamChange"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ParamChange.field":
+		panic(fmt.Errorf("field field of message inference.inference.ParamChange is not mutable"))
+	case "inference.inference.ParamChange.current":
+		panic(fmt.Errorf("field current of message inference.inference.ParamChange is not mutable"))
+	case "inference.inference.ParamChange.proposed":
+		panic(fmt.Errorf("field proposed of message inference.inference.ParamChange is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParamChange"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParamChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ParamChange.field":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.ParamChange.current":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.ParamChange.proposed":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParamChange"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParamChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.ParamChange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParamChange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamChange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParamChange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParamChange) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParamChange)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Field)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Current)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Proposed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParamChange)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Proposed) > 0 {
+			i -= len(x.Proposed)
+			copy(dAtA[i:], x.Proposed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposed)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Current) > 0 {
+			i -= len(x.Current)
+			copy(dAtA[i:], x.Current)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Current)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Field) > 0 {
+			i -= len(x.Field)
+			copy(dAtA[i:], x.Field)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Field)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParamChange)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamChange: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Field = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Current = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposed", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposed = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ParticipantTransitionDelta                 protoreflect.MessageDescriptor
+	fd_ParticipantTransitionDelta_address         protoreflect.FieldDescriptor
+	fd_ParticipantTransitionDelta_current_weight  protoreflect.FieldDescriptor
+	fd_ParticipantTransitionDelta_proposed_weight protoreflect.FieldDescriptor
+	fd_ParticipantTransitionDelta_current_reward  protoreflect.FieldDescriptor
+	fd_ParticipantTransitionDelta_proposed_reward protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_params_dry_run_proto_init()
+	md_ParticipantTransitionDelta = File_inference_inference_params_dry_run_proto.Messages().ByName("ParticipantTransitionDelta")
+	fd_ParticipantTransitionDelta_address = md_ParticipantTransitionDelta.Fields().ByName("address")
+	fd_ParticipantTransitionDelta_current_weight = md_ParticipantTransitionDelta.Fields().ByName("current_weight")
+	fd_ParticipantTransitionDelta_proposed_weight = md_ParticipantTransitionDelta.Fields().ByName("proposed_weight")
+	fd_ParticipantTransitionDelta_current_reward = md_ParticipantTransitionDelta.Fields().ByName("current_reward")
+	fd_ParticipantTransitionDelta_proposed_reward = md_ParticipantTransitionDelta.Fields().ByName("proposed_reward")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParticipantTransitionDelta)(nil)
+
+type fastReflection_ParticipantTransitionDelta ParticipantTransitionDelta
+
+func (x *ParticipantTransitionDelta) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantTransitionDelta)(x)
+}
+
+func (x *ParticipantTransitionDelta) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_params_dry_run_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParticipantTransitionDelta_messageType fastReflection_ParticipantTransitionDelta_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantTransitionDelta_messageType{}
+
+type fastReflection_ParticipantTransitionDelta_messageType struct{}
+
+func (x fastReflection_ParticipantTransitionDelta_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantTransitionDelta)(nil)
+}
+func (x fastReflection_ParticipantTransitionDelta_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantTransitionDelta)
+}
+func (x fastReflection_ParticipantTransitionDelta_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantTransitionDelta
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParticipantTransitionDelta) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantTransitionDelta
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParticipantTransitionDelta) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantTransitionDelta_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParticipantTransitionDelta) New() protoreflect.Message {
+	return new(fastReflection_ParticipantTransitionDelta)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParticipantTransitionDelta) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantTransitionDelta)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParticipantTransitionDelta) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ParticipantTransitionDelta_address, value) {
+			return
+		}
+	}
+	if x.CurrentWeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CurrentWeight)
+		if !f(fd_ParticipantTransitionDelta_current_weight, value) {
+			return
+		}
+	}
+	if x.ProposedWeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ProposedWeight)
+		if !f(fd_ParticipantTransitionDelta_proposed_weight, value) {
+			return
+		}
+	}
+	if x.CurrentReward != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CurrentReward)
+		if !f(fd_ParticipantTransitionDelta_current_reward, value) {
+			return
+		}
+	}
+	if x.ProposedReward != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposedReward)
+		if !f(fd_ParticipantTransitionDelta_proposed_reward, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParticipantTransitionDelta) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantTransitionDelta.address":
+		return x.Address != ""
+	case "inference.inference.ParticipantTransitionDelta.current_weight":
+		return x.CurrentWeight != int64(0)
+	case "inference.inference.ParticipantTransitionDelta.proposed_weight":
+		return x.ProposedWeight != int64(0)
+	case "inference.inference.ParticipantTransitionDelta.current_reward":
+		return x.CurrentReward != uint64(0)
+	case "inference.inference.ParticipantTransitionDelta.proposed_reward":
+		return x.ProposedReward != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantTransitionDelta"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantTransitionDelta does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantTransitionDelta) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantTransitionDelta.address":
+		x.Address = ""
+	case "inference.inference.ParticipantTransitionDelta.current_weight":
+		x.CurrentWeight = int64(0)
+	case "inference.inference.ParticipantTransitionDelta.proposed_weight":
+		x.ProposedWeight = int64(0)
+	case "inference.inference.ParticipantTransitionDelta.current_reward":
+		x.CurrentReward = uint64(0)
+	case "inference.inference.ParticipantTransitionDelta.proposed_reward":
+		x.ProposedReward = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantTransitionDelta"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantTransitionDelta does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParticipantTransitionDelta) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.ParticipantTransitionDelta.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.ParticipantTransitionDelta.current_weight":
+		value := x.CurrentWeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.ParticipantTransitionDelta.proposed_weight":
+		value := x.ProposedWeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.ParticipantTransitionDelta.current_reward":
+		value := x.CurrentReward
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ParticipantTransitionDelta.proposed_reward":
+		value := x.ProposedReward
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantTransitionDelta"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantTransitionDelta does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantTransitionDelta) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantTransitionDelta.address":
+		x.Address = value.Interface().(string)
+	case "inference.inference.ParticipantTransitionDelta.current_weight":
+		x.CurrentWeight = value.Int()
+	case "inference.inference.ParticipantTransitionDelta.proposed_weight":
+		x.ProposedWeight = value.Int()
+	case "inference.inference.ParticipantTransitionDelta.current_reward":
+		x.CurrentReward = value.Uint()
+	case "inference.inference.ParticipantTransitionDelta.proposed_reward":
+		x.ProposedReward = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantTransitionDelta"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantTransitionDelta does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantTransitionDelta) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantTransitionDelta.address":
+		panic(fmt.Errorf("field address of message inference.inference.ParticipantTransitionDelta is not mutable"))
+	case "inference.inference.ParticipantTransitionDelta.current_weight":
+		panic(fmt.Errorf("field current_weight of message inference.inference.ParticipantTransitionDelta is not mutable"))
+	case "inference.inference.ParticipantTransitionDelta.proposed_weight":
+		panic(fmt.Errorf("field proposed_weight of message inference.inference.ParticipantTransitionDelta is not mutable"))
+	case "inference.inference.ParticipantTransitionDelta.current_reward":
+		panic(fmt.Errorf("field current_reward of message inference.inference.ParticipantTransitionDelta is not mutable"))
+	case "inference.inference.ParticipantTransitionDelta.proposed_reward":
+		panic(fmt.Errorf("field proposed_reward of message inference.inference.ParticipantTransitionDelta is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantTransitionDelta"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantTransitionDelta does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParticipantTransitionDelta) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantTransitionDelta.address":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.ParticipantTransitionDelta.current_weight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.ParticipantTransitionDelta.proposed_weight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.ParticipantTransitionDelta.current_reward":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ParticipantTransitionDelta.proposed_reward":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantTransitionDelta"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantTransitionDelta does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParticipantTransitionDelta) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.ParticipantTransitionDelta", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParticipantTransitionDelta) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantTransitionDelta) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParticipantTransitionDelta) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParticipantTransitionDelta) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParticipantTransitionDelta)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CurrentWeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CurrentWeight))
+		}
+		if x.ProposedWeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposedWeight))
+		}
+		if x.CurrentReward != 0 {
+			n += 1 + runtime.Sov(uint64(x.CurrentReward))
+		}
+		if x.ProposedReward != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposedReward))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantTransitionDelta)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ProposedReward != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposedReward))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.CurrentReward != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CurrentReward))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ProposedWeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposedWeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.CurrentWeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CurrentWeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantTransitionDelta)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantTransitionDelta: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantTransitionDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentWeight", wireType)
+				}
+				x.CurrentWeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CurrentWeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposedWeight", wireType)
+				}
+				x.ProposedWeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposedWeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentReward", wireType)
+				}
+				x.CurrentReward = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CurrentReward |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposedReward", wireType)
+				}
+				x.ProposedReward = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposedReward |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EpochTransitionDryRun_8_list)(nil)
+
+type _EpochTransitionDryRun_8_list struct {
+	list *[]*ParticipantTransitionDelta
+}
+
+func (x *_EpochTransitionDryRun_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EpochTransitionDryRun_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EpochTransitionDryRun_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantTransitionDelta)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EpochTransitionDryRun_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantTransitionDelta)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EpochTransitionDryRun_8_list) AppendMutable() protoreflect.Value {
+	v := new(ParticipantTransitionDelta)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EpochTransitionDryRun_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EpochTransitionDryRun_8_list) NewElement() protoreflect.Value {
+	v := new(ParticipantTransitionDelta)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EpochTransitionDryRun_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EpochTransitionDryRun                                protoreflect.MessageDescriptor
+	fd_EpochTransitionDryRun_upcoming_epoch_index           protoreflect.FieldDescriptor
+	fd_EpochTransitionDryRun_current_reward_amount          protoreflect.FieldDescriptor
+	fd_EpochTransitionDryRun_proposed_reward_amount         protoreflect.FieldDescriptor
+	fd_EpochTransitionDryRun_current_total_weight           protoreflect.FieldDescriptor
+	fd_EpochTransitionDryRun_proposed_total_weight          protoreflect.FieldDescriptor
+	fd_EpochTransitionDryRun_current_unit_of_compute_price  protoreflect.FieldDescriptor
+	fd_EpochTransitionDryRun_proposed_unit_of_compute_price protoreflect.FieldDescriptor
+	fd_EpochTransitionDryRun_participants                   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_params_dry_run_proto_init()
+	md_EpochTransitionDryRun = File_inference_inference_params_dry_run_proto.Messages().ByName("EpochTransitionDryRun")
+	fd_EpochTransitionDryRun_upcoming_epoch_index = md_EpochTransitionDryRun.Fields().ByName("upcoming_epoch_index")
+	fd_EpochTransitionDryRun_current_reward_amount = md_EpochTransitionDryRun.Fields().ByName("current_reward_amount")
+	fd_EpochTransitionDryRun_proposed_reward_amount = md_EpochTransitionDryRun.Fields().ByName("proposed_reward_amount")
+	fd_EpochTransitionDryRun_current_total_weight = md_EpochTransitionDryRun.Fields().ByName("current_total_weight")
+	fd_EpochTransitionDryRun_proposed_total_weight = md_EpochTransitionDryRun.Fields().ByName("proposed_total_weight")
+	fd_EpochTransitionDryRun_current_unit_of_compute_price = md_EpochTransitionDryRun.Fields().ByName("current_unit_of_compute_price")
+	fd_EpochTransitionDryRun_proposed_unit_of_compute_price = md_EpochTransitionDryRun.Fields().ByName("proposed_unit_of_compute_price")
+	fd_EpochTransitionDryRun_participants = md_EpochTransitionDryRun.Fields().ByName("participants")
+}
+
+var _ protoreflect.Message = (*fastReflection_EpochTransitionDryRun)(nil)
+
+type fastReflection_EpochTransitionDryRun EpochTransitionDryRun
+
+func (x *EpochTransitionDryRun) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EpochTransitionDryRun)(x)
+}
+
+func (x *EpochTransitionDryRun) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_params_dry_run_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EpochTransitionDryRun_messageType fastReflection_EpochTransitionDryRun_messageType
+var _ protoreflect.MessageType = fastReflection_EpochTransitionDryRun_messageType{}
+
+type fastReflection_EpochTransitionDryRun_messageType struct{}
+
+func (x fastReflection_EpochTransitionDryRun_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EpochTransitionDryRun)(nil)
+}
+func (x fastReflection_EpochTransitionDryRun_messageType) New() protoreflect.Message {
+	return new(fastReflection_EpochTransitionDryRun)
+}
+func (x fastReflection_EpochTransitionDryRun_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochTransitionDryRun
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EpochTransitionDryRun) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochTransitionDryRun
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EpochTransitionDryRun) Type() protoreflect.MessageType {
+	return _fastReflection_EpochTransitionDryRun_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EpochTransitionDryRun) New() protoreflect.Message {
+	return new(fastReflection_EpochTransitionDryRun)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EpochTransitionDryRun) Interface() protoreflect.ProtoMessage {
+	return (*EpochTransitionDryRun)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EpochTransitionDryRun) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.UpcomingEpochIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UpcomingEpochIndex)
+		if !f(fd_EpochTransitionDryRun_upcoming_epoch_index, value) {
+			return
+		}
+	}
+	if x.CurrentRewardAmount != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CurrentRewardAmount)
+		if !f(fd_EpochTransitionDryRun_current_reward_amount, value) {
+			return
+		}
+	}
+	if x.ProposedRewardAmount != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ProposedRewardAmount)
+		if !f(fd_EpochTransitionDryRun_proposed_reward_amount, value) {
+			return
+		}
+	}
+	if x.CurrentTotalWeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CurrentTotalWeight)
+		if !f(fd_EpochTransitionDryRun_current_total_weight, value) {
+			return
+		}
+	}
+	if x.ProposedTotalWeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ProposedTotalWeight)
+		if !f(fd_EpochTransitionDryRun_proposed_total_weight, value) {
+			return
+		}
+	}
+	if x.CurrentUnitOfComputePrice != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CurrentUnitOfComputePrice)
+		if !f(fd_EpochTransitionDryRun_current_unit_of_compute_price, value) {
+			return
+		}
+	}
+	if x.ProposedUnitOfComputePrice != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposedUnitOfComputePrice)
+		if !f(fd_EpochTransitionDryRun_proposed_unit_of_compute_price, value) {
+			return
+		}
+	}
+	if len(x.Participants) != 0 {
+		value := protoreflect.ValueOfList(&_EpochTransitionDryRun_8_list{list: &x.Participants})
+		if !f(fd_EpochTransitionDryRun_participants, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EpochTransitionDryRun) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.EpochTransitionDryRun.upcoming_epoch_index":
+		return x.UpcomingEpochIndex != uint64(0)
+	case "inference.inference.EpochTransitionDryRun.current_reward_amount":
+		return x.CurrentRewardAmount != int64(0)
+	case "inference.inference.EpochTransitionDryRun.proposed_reward_amount":
+		return x.ProposedRewardAmount != int64(0)
+	case "inference.inference.EpochTransitionDryRun.current_total_weight":
+		return x.CurrentTotalWeight != int64(0)
+	case "inference.inference.EpochTransitionDryRun.proposed_total_weight":
+		return x.ProposedTotalWeight != int64(0)
+	case "inference.inference.EpochTransitionDryRun.current_unit_of_compute_price":
+		return x.CurrentUnitOfComputePrice != uint64(0)
+	case "inference.inference.EpochTransitionDryRun.proposed_unit_of_compute_price":
+		return x.ProposedUnitOfComputePrice != uint64(0)
+	case "inference.inference.EpochTransitionDryRun.participants":
+		return len(x.Participants) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.EpochTransitionDryRun"))
+		}
+		panic(fmt.Errorf("message inference.inference.EpochTransitionDryRun does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochTransitionDryRun) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.EpochTransitionDryRun.upcoming_epoch_index":
+		x.UpcomingEpochIndex = uint64(0)
+	case "inference.inference.EpochTransitionDryRun.current_reward_amount":
+		x.CurrentRewardAmount = int64(0)
+	case "inference.inference.EpochTransitionDryRun.proposed_reward_amount":
+		x.ProposedRewardAmount = int64(0)
+	case "inference.inference.EpochTransitionDryRun.current_total_weight":
+		x.CurrentTotalWeight = int64(0)
+	case "inference.inference.EpochTransitionDryRun.proposed_total_weight":
+		x.ProposedTotalWeight = int64(0)
+	case "inference.inference.EpochTransitionDryRun.current_unit_of_compute_price":
+		x.CurrentUnitOfComputePrice = uint64(0)
+	case "inference.inference.EpochTransitionDryRun.proposed_unit_of_compute_price":
+		x.ProposedUnitOfComputePrice = uint64(0)
+	case "inference.inference.EpochTransitionDryRun.participants":
+		x.Participants = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.EpochTransitionDryRun"))
+		}
+		panic(fmt.Errorf("message inference.inference.EpochTransitionDryRun does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EpochTransitionDryRun) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.EpochTransitionDryRun.upcoming_epoch_index":
+		value := x.UpcomingEpochIndex
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.EpochTransitionDryRun.current_reward_amount":
+		value := x.CurrentRewardAmount
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.EpochTransitionDryRun.proposed_reward_amount":
+		value := x.ProposedRewardAmount
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.EpochTransitionDryRun.current_total_weight":
+		value := x.CurrentTotalWeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.EpochTransitionDryRun.proposed_total_weight":
+		value := x.ProposedTotalWeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.EpochTransitionDryRun.current_unit_of_compute_price":
+		value := x.CurrentUnitOfComputePrice
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.EpochTransitionDryRun.proposed_unit_of_compute_price":
+		value := x.ProposedUnitOfComputePrice
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.EpochTransitionDryRun.participants":
+		if len(x.Participants) == 0 {
+			return protoreflect.ValueOfList(&_EpochTransitionDryRun_8_list{})
+		}
+		listValue := &_EpochTransitionDryRun_8_list{list: &x.Participants}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.EpochTransitionDryRun"))
+		}
+		panic(fmt.Errorf("message inference.inference.EpochTransitionDryRun does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochTransitionDryRun) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.EpochTransitionDryRun.upcoming_epoch_index":
+		x.UpcomingEpochIndex = value.Uint()
+	case "inference.inference.EpochTransitionDryRun.current_reward_amount":
+		x.CurrentRewardAmount = value.Int()
+	case "inference.inference.EpochTransitionDryRun.proposed_reward_amount":
+		x.ProposedRewardAmount = value.Int()
+	case "inference.inference.EpochTransitionDryRun.current_total_weight":
+		x.CurrentTotalWeight = value.Int()
+	case "inference.inference.EpochTransitionDryRun.proposed_total_weight":
+		x.ProposedTotalWeight = value.Int()
+	case "inference.inference.EpochTransitionDryRun.current_unit_of_compute_price":
+		x.CurrentUnitOfComputePrice = value.Uint()
+	case "inference.inference.EpochTransitionDryRun.proposed_unit_of_compute_price":
+		x.ProposedUnitOfComputePrice = value.Uint()
+	case "inference.inference.EpochTransitionDryRun.participants":
+		lv := value.List()
+		clv := lv.(*_EpochTransitionDryRun_8_list)
+		x.Participants = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.EpochTransitionDryRun"))
+		}
+		panic(fmt.Errorf("message inference.inference.EpochTransitionDryRun does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochTransitionDryRun) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.EpochTransitionDryRun.participants":
+		if x.Participants == nil {
+			x.Participants = []*ParticipantTransitionDelta{}
+		}
+		value := &_EpochTransitionDryRun_8_list{list: &x.Participants}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.EpochTransitionDryRun.upcoming_epoch_index":
+		panic(fmt.Errorf("field upcoming_epoch_index of message inference.inference.EpochTransitionDryRun is not mutable"))
+	case "inference.inference.EpochTransitionDryRun.current_reward_amount":
+		panic(fmt.Errorf("field current_reward_amount of message inference.inference.EpochTransitionDryRun is not mutable"))
+	case "inference.inference.EpochTransitionDryRun.proposed_reward_amount":
+		panic(fmt.Errorf("field proposed_reward_amount of message inference.inference.EpochTransitionDryRun is not mutable"))
+	case "inference.inference.EpochTransitionDryRun.current_total_weight":
+		panic(fmt.Errorf("field current_total_weight of message inference.inference.EpochTransitionDryRun is not mutable"))
+	case "inference.inference.EpochTransitionDryRun.proposed_total_weight":
+		panic(fmt.Errorf("field proposed_total_weight of message inference.inference.EpochTransitionDryRun is not mutable"))
+	case "inference.inference.EpochTransitionDryRun.current_unit_of_compute_price":
+		panic(fmt.Errorf("field current_unit_of_compute_price of message inference.inference.EpochTransitionDryRun is not mutable"))
+	case "inference.inference.EpochTransitionDryRun.proposed_unit_of_compute_price":
+		panic(fmt.Errorf("field proposed_unit_of_compute_price of message inference.inference.EpochTransitionDryRun is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.EpochTransitionDryRun"))
+		}
+		panic(fmt.Errorf("message inference.inference.EpochTransitionDryRun does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EpochTransitionDryRun) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.EpochTransitionDryRun.upcoming_epoch_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.EpochTransitionDryRun.current_reward_amount":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.EpochTransitionDryRun.proposed_reward_amount":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.EpochTransitionDryRun.current_total_weight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.EpochTransitionDryRun.proposed_total_weight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.EpochTransitionDryRun.current_unit_of_compute_price":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.EpochTransitionDryRun.proposed_unit_of_compute_price":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.EpochTransitionDryRun.participants":
+		list := []*ParticipantTransitionDelta{}
+		return protoreflect.ValueOfList(&_EpochTransitionDryRun_8_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.EpochTransitionDryRun"))
+		}
+		panic(fmt.Errorf("message inference.inference.EpochTransitionDryRun does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EpochTransitionDryRun) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.EpochTransitionDryRun", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EpochTransitionDryRun) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochTransitionDryRun) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EpochTransitionDryRun) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EpochTransitionDryRun) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EpochTransitionDryRun)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.UpcomingEpochIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpcomingEpochIndex))
+		}
+		if x.CurrentRewardAmount != 0 {
+			n += 1 + runtime.Sov(uint64(x.CurrentRewardAmount))
+		}
+		if x.ProposedRewardAmount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposedRewardAmount))
+		}
+		if x.CurrentTotalWeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CurrentTotalWeight))
+		}
+		if x.ProposedTotalWeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposedTotalWeight))
+		}
+		if x.CurrentUnitOfComputePrice != 0 {
+			n += 1 + runtime.Sov(uint64(x.CurrentUnitOfComputePrice))
+		}
+		if x.ProposedUnitOfComputePrice != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposedUnitOfComputePrice))
+		}
+		if len(x.Participants) > 0 {
+			for _, e := range x.Participants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EpochTransitionDryRun)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Participants) > 0 {
+			for iNdEx := len(x.Participants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Participants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.ProposedUnitOfComputePrice != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposedUnitOfComputePrice))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.CurrentUnitOfComputePrice != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CurrentUnitOfComputePrice))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ProposedTotalWeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposedTotalWeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.CurrentTotalWeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CurrentTotalWeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ProposedRewardAmount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposedRewardAmount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.CurrentRewardAmount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CurrentRewardAmount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.UpcomingEpochIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpcomingEpochIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EpochTransitionDryRun)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochTransitionDryRun: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochTransitionDryRun: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpcomingEpochIndex", wireType)
+				}
+				x.UpcomingEpochIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpcomingEpochIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentRewardAmount", wireType)
+				}
+				x.CurrentRewardAmount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CurrentRewardAmount |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposedRewardAmount", wireType)
+				}
+				x.ProposedRewardAmount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposedRewardAmount |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentTotalWeight", wireType)
+				}
+				x.CurrentTotalWeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CurrentTotalWeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposedTotalWeight", wireType)
+				}
+				x.ProposedTotalWeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposedTotalWeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentUnitOfComputePrice", wireType)
+				}
+				x.CurrentUnitOfComputePrice = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CurrentUnitOfComputePrice |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposedUnitOfComputePrice", wireType)
+				}
+				x.ProposedUnitOfComputePrice = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposedUnitOfComputePrice |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Participants = append(x.Participants, &ParticipantTransitionDelta{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Participants[len(x.Participants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/inference/params_dry_run.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ParamChange is a single params field that differs between the current and the proposed params.
+type ParamChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field    string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // dotted proto field path, e.g. validation_params.false_positive_rate
+	Current  string `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	Proposed string `protobuf:"bytes,3,opt,name=proposed,proto3" json:"proposed,omitempty"`
+}
+
+func (x *ParamChange) Reset() {
+	*x = ParamChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_params_dry_run_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParamChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParamChange) ProtoMessage() {}
+
+// Deprecated: Use ParamChange.ProtoReflect.Descriptor instead.
+func (*ParamChange) Descriptor() ([]byte, []int) {
+	return file_inference_inference_params_dry_run_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ParamChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ParamChange) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *ParamChange) GetProposed() string {
+	if x != nil {
+		return x.Proposed
+	}
+	return ""
+}
+
+// ParticipantTransitionDelta compares a participant's outcome of the simulated epoch transition
+// under the current and the proposed params.
+type ParticipantTransitionDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address        string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	CurrentWeight  int64  `protobuf:"varint,2,opt,name=current_weight,json=currentWeight,proto3" json:"current_weight,omitempty"` // weight in the upcoming epoch
+	ProposedWeight int64  `protobuf:"varint,3,opt,name=proposed_weight,json=proposedWeight,proto3" json:"proposed_weight,omitempty"`
+	CurrentReward  uint64 `protobuf:"varint,4,opt,name=current_reward,json=currentReward,proto3" json:"current_reward,omitempty"` // reward coins settled for the ending epoch
+	ProposedReward uint64 `protobuf:"varint,5,opt,name=proposed_reward,json=proposedReward,proto3" json:"proposed_reward,omitempty"`
+}
+
+func (x *ParticipantTransitionDelta) Reset() {
+	*x = ParticipantTransitionDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_params_dry_run_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParticipantTransitionDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantTransitionDelta) ProtoMessage() {}
+
+// Deprecated: Use ParticipantTransitionDelta.ProtoReflect.Descriptor instead.
+func (*ParticipantTransitionDelta) Descriptor() ([]byte, []int) {
+	return file_inference_inference_params_dry_run_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParticipantTransitionDelta) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ParticipantTransitionDelta) GetCurrentWeight() int64 {
+	if x != nil {
+		return x.CurrentWeight
+	}
+	return 0
+}
+
+func (x *ParticipantTransitionDelta) GetProposedWeight() int64 {
+	if x != nil {
+		return x.ProposedWeight
+	}
+	return 0
+}
+
+func (x *ParticipantTransitionDelta) GetCurrentReward() uint64 {
+	if x != nil {
+		return x.CurrentReward
+	}
+	return 0
+}
+
+func (x *ParticipantTransitionDelta) GetProposedReward() uint64 {
+	if x != nil {
+		return x.ProposedReward
+	}
+	return 0
+}
+
+// EpochTransitionDryRun is the outcome of running the next epoch transition on a cached context,
+// once with the current params and once with the proposed params.
+type EpochTransitionDryRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpcomingEpochIndex         uint64                        `protobuf:"varint,1,opt,name=upcoming_epoch_index,json=upcomingEpochIndex,proto3" json:"upcoming_epoch_index,omitempty"`
+	CurrentRewardAmount        int64                         `protobuf:"varint,2,opt,name=current_reward_amount,json=currentRewardAmount,proto3" json:"current_reward_amount,omitempty"` // reward coins minted at settlement
+	ProposedRewardAmount       int64                         `protobuf:"varint,3,opt,name=proposed_reward_amount,json=proposedRewardAmount,proto3" json:"proposed_reward_amount,omitempty"`
+	CurrentTotalWeight         int64                         `protobuf:"varint,4,opt,name=current_total_weight,json=currentTotalWeight,proto3" json:"current_total_weight,omitempty"`
+	ProposedTotalWeight        int64                         `protobuf:"varint,5,opt,name=proposed_total_weight,json=proposedTotalWeight,proto3" json:"proposed_total_weight,omitempty"`
+	CurrentUnitOfComputePrice  uint64                        `protobuf:"varint,6,opt,name=current_unit_of_compute_price,json=currentUnitOfComputePrice,proto3" json:"current_unit_of_compute_price,omitempty"`
+	ProposedUnitOfComputePrice uint64                        `protobuf:"varint,7,opt,name=proposed_unit_of_compute_price,json=proposedUnitOfComputePrice,proto3" json:"proposed_unit_of_compute_price,omitempty"`
+	Participants               []*ParticipantTransitionDelta `protobuf:"bytes,8,rep,name=participants,proto3" json:"participants,omitempty"`
+}
+
+func (x *EpochTransitionDryRun) Reset() {
+	*x = EpochTransitionDryRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_params_dry_run_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochTransitionDryRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochTransitionDryRun) ProtoMessage() {}
+
+// Deprecated: Use EpochTransitionDryRun.ProtoReflect.Descriptor instead.
+func (*EpochTransitionDryRun) Descriptor() ([]byte, []int) {
+	return file_inference_inference_params_dry_run_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EpochTransitionDryRun) GetUpcomingEpochIndex() uint64 {
+	if x != nil {
+		return x.UpcomingEpochIndex
+	}
+	return 0
+}
+
+func (x *EpochTransitionDryRun) GetCurrentRewardAmount() int64 {
+	if x != nil {
+		return x.CurrentRewardAmount
+	}
+	return 0
+}
+
+func (x *EpochTransitionDryRun) GetProposedRewardAmount() int64 {
+	if x != nil {
+		return x.ProposedRewardAmount
+	}
+	return 0
+}
+
+func (x *EpochTransitionDryRun) GetCurrentTotalWeight() int64 {
+	if x != nil {
+		return x.CurrentTotalWeight
+	}
+	return 0
+}
+
+func (x *EpochTransitionDryRun) GetProposedTotalWeight() int64 {
+	if x != nil {
+		return x.ProposedTotalWeight
+	}
+	return 0
+}
+
+func (x *EpochTransitionDryRun) GetCurrentUnitOfComputePrice() uint64 {
+	if x != nil {
+		return x.CurrentUnitOfComputePrice
+	}
+	return 0
+}
+
+func (x *EpochTransitionDryRun) GetProposedUnitOfComputePrice() uint64 {
+	if x != nil {
+		return x.ProposedUnitOfComputePrice
+	}
+	return 0
+}
+
+func (x *EpochTransitionDryRun) GetParticipants() []*ParticipantTransitionDelta {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+var File_inference_inference_params_dry_run_proto protoreflect.FileDescriptor
+
+var file_inference_inference_params_dry_run_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22,
+	0x59, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x22, 0xd6, 0x01, 0x0a, 0x1a, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x22, 0xf4, 0x03, 0x0a, 0x15, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x30, 0x0a,
+	0x14, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x75, 0x70, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x32, 0x0a, 0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x14, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x40, 0x0a, 0x1d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x6f, 0x66, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55,
+	0x6e, 0x69, 0x74, 0x4f, 0x66, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x42, 0x0a, 0x1e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x75, 0x6e,
+	0x69, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x4f, 0x66, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x0c, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x42, 0xbf, 0x01, 0x0a, 0x17, 0x63,
+	0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x11, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x44, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_inference_inference_params_dry_run_proto_rawDescOnce sync.Once
+	file_inference_inference_params_dry_run_proto_rawDescData = file_inference_inference_params_dry_run_proto_rawDesc
+)
+
+func file_inference_inference_params_dry_run_proto_rawDescGZIP() []byte {
+	file_inference_inference_params_dry_run_proto_rawDescOnce.Do(func() {
+		file_inference_inference_params_dry_run_proto_rawDescData = protoimpl.X.CompressGZIP(file_inference_inference_params_dry_run_proto_rawDescData)
+	})
+	return file_inference_inference_params_dry_run_proto_rawDescData
+}
+
+var file_inference_inference_params_dry_run_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_inference_inference_params_dry_run_proto_goTypes = []interface{}{
+	(*ParamChange)(nil),                // 0: inference.inference.ParamChange
+	(*ParticipantTransitionDelta)(nil), // 1: inference.inference.ParticipantTransitionDelta
+	(*EpochTransitionDryRun)(nil),      // 2: inference.inference.EpochTransitionDryRun
+}
+var file_inference_inference_params_dry_run_proto_depIdxs = []int32{
+	1, // 0: inference.inference.EpochTransitionDryRun.participants:type_name -> inference.inference.ParticipantTransitionDelta
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_inference_inference_params_dry_run_proto_init() }
+func file_inference_inference_params_dry_run_proto_init() {
+	if File_inference_inference_params_dry_run_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_inference_inference_params_dry_run_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParamChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_params_dry_run_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantTransitionDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_params_dry_run_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochTransitionDryRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_inference_inference_params_dry_run_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_inference_inference_params_dry_run_proto_goTypes,
+		DependencyIndexes: file_inference_inference_params_dry_run_proto_depIdxs,
+		MessageInfos:      file_inference_inference_params_dry_run_proto_msgTypes,
+	}.Build()
+	File_inference_inference_params_dry_run_proto = out.File
+	file_inference_inference_params_dry_run_proto_rawDesc = nil
+	file_inference_inference_params_dry_run_proto_goTypes = nil
+	file_inference_inference_params_dry_run_proto_depIdxs = nil
+}
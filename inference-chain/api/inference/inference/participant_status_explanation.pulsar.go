@@ -0,0 +1,3378 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package inference
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_SprtExplanation           protoreflect.MessageDescriptor
+	fd_SprtExplanation_llr       protoreflect.FieldDescriptor
+	fd_SprtExplanation_threshold protoreflect.FieldDescriptor
+	fd_SprtExplanation_p0        protoreflect.FieldDescriptor
+	fd_SprtExplanation_p1        protoreflect.FieldDescriptor
+	fd_SprtExplanation_decision  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_participant_status_explanation_proto_init()
+	md_SprtExplanation = File_inference_inference_participant_status_explanation_proto.Messages().ByName("SprtExplanation")
+	fd_SprtExplanation_llr = md_SprtExplanation.Fields().ByName("llr")
+	fd_SprtExplanation_threshold = md_SprtExplanation.Fields().ByName("threshold")
+	fd_SprtExplanation_p0 = md_SprtExplanation.Fields().ByName("p0")
+	fd_SprtExplanation_p1 = md_SprtExplanation.Fields().ByName("p1")
+	fd_SprtExplanation_decision = md_SprtExplanation.Fields().ByName("decision")
+}
+
+var _ protoreflect.Message = (*fastReflection_SprtExplanation)(nil)
+
+type fastReflection_SprtExplanation SprtExplanation
+
+func (x *SprtExplanation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SprtExplanation)(x)
+}
+
+func (x *SprtExplanation) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_participant_status_explanation_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SprtExplanation_messageType fastReflection_SprtExplanation_messageType
+var _ protoreflect.MessageType = fastReflection_SprtExplanation_messageType{}
+
+type fastReflection_SprtExplanation_messageType struct{}
+
+func (x fastReflection_SprtExplanation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SprtExplanation)(nil)
+}
+func (x fastReflection_SprtExplanation_messageType) New() protoreflect.Message {
+	return new(fastReflection_SprtExplanation)
+}
+func (x fastReflection_SprtExplanation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SprtExplanation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SprtExplanation) Descriptor() protoreflect.MessageDescriptor {
+	return md_SprtExplanation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SprtExplanation) Type() protoreflect.MessageType {
+	return _fastReflection_SprtExplanation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SprtExplanation) New() protoreflect.Message {
+	return new(fastReflection_SprtExplanation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SprtExplanation) Interface() protoreflect.ProtoMessage {
+	return (*SprtExplanation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SprtExplanation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Llr != nil {
+		value := protoreflect.ValueOfMessage(x.Llr.ProtoReflect())
+		if !f(fd_SprtExplanation_llr, value) {
+			return
+		}
+	}
+	if x.Threshold != nil {
+		value := protoreflect.ValueOfMessage(x.Threshold.ProtoReflect())
+		if !f(fd_SprtExplanation_threshold, value) {
+			return
+		}
+	}
+	if x.P0 != nil {
+		value := protoreflect.ValueOfMessage(x.P0.ProtoReflect())
+		if !f(fd_SprtExplanation_p0, value) {
+			return
+		}
+	}
+	if x.P1 != nil {
+		value := protoreflect.ValueOfMessage(x.P1.ProtoReflect())
+		if !f(fd_SprtExplanation_p1, value) {
+			return
+		}
+	}
+	if x.Decision != "" {
+		value := protoreflect.ValueOfString(x.Decision)
+		if !f(fd_SprtExplanation_decision, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SprtExplanation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.SprtExplanation.llr":
+		return x.Llr != nil
+	case "inference.inference.SprtExplanation.threshold":
+		return x.Threshold != nil
+	case "inference.inference.SprtExplanation.p0":
+		return x.P0 != nil
+	case "inference.inference.SprtExplanation.p1":
+		return x.P1 != nil
+	case "inference.inference.SprtExplanation.decision":
+		return x.Decision != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.SprtExplanation"))
+		}
+		panic(fmt.Errorf("message inference.inference.SprtExplanation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SprtExplanation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.SprtExplanation.llr":
+		x.Llr = nil
+	case "inference.inference.SprtExplanation.threshold":
+		x.Threshold = nil
+	case "inference.inference.SprtExplanation.p0":
+		x.P0 = nil
+	case "inference.inference.SprtExplanation.p1":
+		x.P1 = nil
+	case "inference.inference.SprtExplanation.decision":
+		x.Decision = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.SprtExplanation"))
+		}
+		panic(fmt.Errorf("message inference.inference.SprtExplanation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SprtExplanation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.SprtExplanation.llr":
+		value := x.Llr
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.SprtExplanation.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.SprtExplanation.p0":
+		value := x.P0
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.SprtExplanation.p1":
+		value := x.P1
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.SprtExplanation.decision":
+		value := x.Decision
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.SprtExplanation"))
+		}
+		panic(fmt.Errorf("message inference.inference.SprtExplanation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SprtExplanation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.SprtExplanation.llr":
+		x.Llr = value.Message().Interface().(*Decimal)
+	case "inference.inference.SprtExplanation.threshold":
+		x.Threshold = value.Message().Interface().(*Decimal)
+	case "inference.inference.SprtExplanation.p0":
+		x.P0 = value.Message().Interface().(*Decimal)
+	case "inference.inference.SprtExplanation.p1":
+		x.P1 = value.Message().Interface().(*Decimal)
+	case "inference.inference.SprtExplanation.decision":
+		x.Decision = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.SprtExplanation"))
+		}
+		panic(fmt.Errorf("message inference.inference.SprtExplanation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SprtExplanation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.SprtExplanation.llr":
+		if x.Llr == nil {
+			x.Llr = new(Decimal)
+		}
+		return protoreflect.ValueOfMessage(x.Llr.ProtoReflect())
+	case "inference.inference.SprtExplanation.threshold":
+		if x.Threshold == nil {
+			x.Threshold = new(Decimal)
+		}
+		return protoreflect.ValueOfMessage(x.Threshold.ProtoReflect())
+	case "inference.inference.SprtExplanation.p0":
+		if x.P0 == nil {
+			x.P0 = new(Decimal)
+		}
+		return protoreflect.ValueOfMessage(x.P0.ProtoReflect())
+	case "inference.inference.SprtExplanation.p1":
+		if x.P1 == nil {
+			x.P1 = new(Decimal)
+		}
+		return protoreflect.ValueOfMessage(x.P1.ProtoReflect())
+	case "inference.inference.SprtExplanation.decision":
+		panic(fmt.Errorf("field decision of message inference.inference.SprtExplanation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.SprtExplanation"))
+		}
+		panic(fmt.Errorf("message inference.inference.SprtExplanation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SprtExplanation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.SprtExplanation.llr":
+		m := new(Decimal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.SprtExplanation.threshold":
+		m := new(Decimal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.SprtExplanation.p0":
+		m := new(Decimal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.SprtExplanation.p1":
+		m := new(Decimal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.SprtExplanation.decision":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.SprtExplanation"))
+		}
+		panic(fmt.Errorf("message inference.inference.SprtExplanation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SprtExplanation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.SprtExplanation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SprtExplanation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SprtExplanation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SprtExplanation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SprtExplanation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SprtExplanation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Llr != nil {
+			l = options.Size(x.Llr)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Threshold != nil {
+			l = options.Size(x.Threshold)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.P0 != nil {
+			l = options.Size(x.P0)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.P1 != nil {
+			l = options.Size(x.P1)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Decision)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SprtExplanation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Decision) > 0 {
+			i -= len(x.Decision)
+			copy(dAtA[i:], x.Decision)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Decision)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.P1 != nil {
+			encoded, err := options.Marshal(x.P1)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.P0 != nil {
+			encoded, err := options.Marshal(x.P0)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Threshold != nil {
+			encoded, err := options.Marshal(x.Threshold)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Llr != nil {
+			encoded, err := options.Marshal(x.Llr)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SprtExplanation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SprtExplanation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SprtExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Llr", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Llr == nil {
+					x.Llr = &Decimal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Llr); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Threshold == nil {
+					x.Threshold = &Decimal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Threshold); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field P0", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.P0 == nil {
+					x.P0 = &Decimal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.P0); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field P1", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.P1 == nil {
+					x.P1 = &Decimal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.P1); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Decision = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ParticipantStatusExplanation                                  protoreflect.MessageDescriptor
+	fd_ParticipantStatusExplanation_participant                      protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_epoch_index                      protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_live                             protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_status                           protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_status_reason                    protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_inference_count                  protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_missed_requests                  protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_validated_inferences             protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_invalidated_inferences           protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_consecutive_invalid_inferences   protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_consecutive_failure_probability  protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_quick_failure_threshold          protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_invalidation_sprt                protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_downtime_sprt                    protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_miss_percentage                  protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_miss_percentage_cutoff           protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_miss_percentage_exceeded         protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_downtime_p_value                 protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_binom_test_p0                    protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_downtime_test_passed             protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_confirmation_poc_ratio           protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_confirmation_poc_alpha_threshold protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_confirmation_poc_passed          protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_reputation                       protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_epochs_completed                 protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_exclusion_reason                 protoreflect.FieldDescriptor
+	fd_ParticipantStatusExplanation_exclusion_block_height           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_participant_status_explanation_proto_init()
+	md_ParticipantStatusExplanation = File_inference_inference_participant_status_explanation_proto.Messages().ByName("ParticipantStatusExplanation")
+	fd_ParticipantStatusExplanation_participant = md_ParticipantStatusExplanation.Fields().ByName("participant")
+	fd_ParticipantStatusExplanation_epoch_index = md_ParticipantStatusExplanation.Fields().ByName("epoch_index")
+	fd_ParticipantStatusExplanation_live = md_ParticipantStatusExplanation.Fields().ByName("live")
+	fd_ParticipantStatusExplanation_status = md_ParticipantStatusExplanation.Fields().ByName("status")
+	fd_ParticipantStatusExplanation_status_reason = md_ParticipantStatusExplanation.Fields().ByName("status_reason")
+	fd_ParticipantStatusExplanation_inference_count = md_ParticipantStatusExplanation.Fields().ByName("inference_count")
+	fd_ParticipantStatusExplanation_missed_requests = md_ParticipantStatusExplanation.Fields().ByName("missed_requests")
+	fd_ParticipantStatusExplanation_validated_inferences = md_ParticipantStatusExplanation.Fields().ByName("validated_inferences")
+	fd_ParticipantStatusExplanation_invalidated_inferences = md_ParticipantStatusExplanation.Fields().ByName("invalidated_inferences")
+	fd_ParticipantStatusExplanation_consecutive_invalid_inferences = md_ParticipantStatusExplanation.Fields().ByName("consecutive_invalid_inferences")
+	fd_ParticipantStatusExplanation_consecutive_failure_probability = md_ParticipantStatusExplanation.Fields().ByName("consecutive_failure_probability")
+	fd_ParticipantStatusExplanation_quick_failure_threshold = md_ParticipantStatusExplanation.Fields().ByName("quick_failure_threshold")
+	fd_ParticipantStatusExplanation_invalidation_sprt = md_ParticipantStatusExplanation.Fields().ByName("invalidation_sprt")
+	fd_ParticipantStatusExplanation_downtime_sprt = md_ParticipantStatusExplanation.Fields().ByName("downtime_sprt")
+	fd_ParticipantStatusExplanation_miss_percentage = md_ParticipantStatusExplanation.Fields().ByName("miss_percentage")
+	fd_ParticipantStatusExplanation_miss_percentage_cutoff = md_ParticipantStatusExplanation.Fields().ByName("miss_percentage_cutoff")
+	fd_ParticipantStatusExplanation_miss_percentage_exceeded = md_ParticipantStatusExplanation.Fields().ByName("miss_percentage_exceeded")
+	fd_ParticipantStatusExplanation_downtime_p_value = md_ParticipantStatusExplanation.Fields().ByName("downtime_p_value")
+	fd_ParticipantStatusExplanation_binom_test_p0 = md_ParticipantStatusExplanation.Fields().ByName("binom_test_p0")
+	fd_ParticipantStatusExplanation_downtime_test_passed = md_ParticipantStatusExplanation.Fields().ByName("downtime_test_passed")
+	fd_ParticipantStatusExplanation_confirmation_poc_ratio = md_ParticipantStatusExplanation.Fields().ByName("confirmation_poc_ratio")
+	fd_ParticipantStatusExplanation_confirmation_poc_alpha_threshold = md_ParticipantStatusExplanation.Fields().ByName("confirmation_poc_alpha_threshold")
+	fd_ParticipantStatusExplanation_confirmation_poc_passed = md_ParticipantStatusExplanation.Fields().ByName("confirmation_poc_passed")
+	fd_ParticipantStatusExplanation_reputation = md_ParticipantStatusExplanation.Fields().ByName("reputation")
+	fd_ParticipantStatusExplanation_epochs_completed = md_ParticipantStatusExplanation.Fields().ByName("epochs_completed")
+	fd_ParticipantStatusExplanation_exclusion_reason = md_ParticipantStatusExplanation.Fields().ByName("exclusion_reason")
+	fd_ParticipantStatusExplanation_exclusion_block_height = md_ParticipantStatusExplanation.Fields().ByName("exclusion_block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParticipantStatusExplanation)(nil)
+
+type fastReflection_ParticipantStatusExplanation ParticipantStatusExplanation
+
+func (x *ParticipantStatusExplanation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantStatusExplanation)(x)
+}
+
+func (x *ParticipantStatusExplanation) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_participant_status_explanation_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParticipantStatusExplanation_messageType fastReflection_ParticipantStatusExplanation_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantStatusExplanation_messageType{}
+
+type fastReflection_ParticipantStatusExplanation_messageType struct{}
+
+func (x fastReflection_ParticipantStatusExplanation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantStatusExplanation)(nil)
+}
+func (x fastReflection_ParticipantStatusExplanation_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantStatusExplanation)
+}
+func (x fastReflection_ParticipantStatusExplanation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantStatusExplanation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParticipantStatusExplanation) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantStatusExplanation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParticipantStatusExplanation) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantStatusExplanation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParticipantStatusExplanation) New() protoreflect.Message {
+	return new(fastReflection_ParticipantStatusExplanation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParticipantStatusExplanation) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantStatusExplanation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParticipantStatusExplanation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Participant != "" {
+		value := protoreflect.ValueOfString(x.Participant)
+		if !f(fd_ParticipantStatusExplanation_participant, value) {
+			return
+		}
+	}
+	if x.EpochIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochIndex)
+		if !f(fd_ParticipantStatusExplanation_epoch_index, value) {
+			return
+		}
+	}
+	if x.Live != false {
+		value := protoreflect.ValueOfBool(x.Live)
+		if !f(fd_ParticipantStatusExplanation_live, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_ParticipantStatusExplanation_status, value) {
+			return
+		}
+	}
+	if x.StatusReason != "" {
+		value := protoreflect.ValueOfString(x.StatusReason)
+		if !f(fd_ParticipantStatusExplanation_status_reason, value) {
+			return
+		}
+	}
+	if x.InferenceCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InferenceCount)
+		if !f(fd_ParticipantStatusExplanation_inference_count, value) {
+			return
+		}
+	}
+	if x.MissedRequests != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissedRequests)
+		if !f(fd_ParticipantStatusExplanation_missed_requests, value) {
+			return
+		}
+	}
+	if x.ValidatedInferences != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidatedInferences)
+		if !f(fd_ParticipantStatusExplanation_validated_inferences, value) {
+			return
+		}
+	}
+	if x.InvalidatedInferences != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InvalidatedInferences)
+		if !f(fd_ParticipantStatusExplanation_invalidated_inferences, value) {
+			return
+		}
+	}
+	if x.ConsecutiveInvalidInferences != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ConsecutiveInvalidInferences)
+		if !f(fd_ParticipantStatusExplanation_consecutive_invalid_inferences, value) {
+			return
+		}
+	}
+	if x.ConsecutiveFailureProbability != nil {
+		value := protoreflect.ValueOfMessage(x.ConsecutiveFailureProbability.ProtoReflect())
+		if !f(fd_ParticipantStatusExplanation_consecutive_failure_probability, value) {
+			return
+		}
+	}
+	if x.QuickFailureThreshold != nil {
+		value := protoreflect.ValueOfMessage(x.QuickFailureThreshold.ProtoReflect())
+		if !f(fd_ParticipantStatusExplanation_quick_failure_threshold, value) {
+			return
+		}
+	}
+	if x.InvalidationSprt != nil {
+		value := protoreflect.ValueOfMessage(x.InvalidationSprt.ProtoReflect())
+		if !f(fd_ParticipantStatusExplanation_invalidation_sprt, value) {
+			return
+		}
+	}
+	if x.DowntimeSprt != nil {
+		value := protoreflect.ValueOfMessage(x.DowntimeSprt.ProtoReflect())
+		if !f(fd_ParticipantStatusExplanation_downtime_sprt, value) {
+			return
+		}
+	}
+	if x.MissPercentage != nil {
+		value := protoreflect.ValueOfMessage(x.MissPercentage.ProtoReflect())
+		if !f(fd_ParticipantStatusExplanation_miss_percentage, value) {
+			return
+		}
+	}
+	if x.MissPercentageCutoff != nil {
+		value := protoreflect.ValueOfMessage(x.MissPercentageCutoff.ProtoReflect())
+		if !f(fd_ParticipantStatusExplanation_miss_percentage_cutoff, value) {
+			return
+		}
+	}
+	if x.MissPercentageExceeded != false {
+		value := protoreflect.ValueOfBool(x.MissPercentageExceeded)
+		if !f(fd_ParticipantStatusExplanation_miss_percentage_exceeded, value) {
+			return
+		}
+	}
+	if x.DowntimePValue != nil {
+		value := protoreflect.ValueOfMessage(x.DowntimePValue.ProtoReflect())
+		if !f(fd_ParticipantStatusExplanation_downtime_p_value, value) {
+			return
+		}
+	}
+	if x.BinomTestP0 != nil {
+		value := protoreflect.ValueOfMessage(x.BinomTestP0.ProtoReflect())
+		if !f(fd_ParticipantStatusExplanation_binom_test_p0, value) {
+			return
+		}
+	}
+	if x.DowntimeTestPassed != false {
+		value := protoreflect.ValueOfBool(x.DowntimeTestPassed)
+		if !f(fd_ParticipantStatusExplanation_downtime_test_passed, value) {
+			return
+		}
+	}
+	if x.ConfirmationPocRatio != nil {
+		value := protoreflect.ValueOfMessage(x.ConfirmationPocRatio.ProtoReflect())
+		if !f(fd_ParticipantStatusExplanation_confirmation_poc_ratio, value) {
+			return
+		}
+	}
+	if x.ConfirmationPocAlphaThreshold != nil {
+		value := protoreflect.ValueOfMessage(x.ConfirmationPocAlphaThreshold.ProtoReflect())
+		if !f(fd_ParticipantStatusExplanation_confirmation_poc_alpha_threshold, value) {
+			return
+		}
+	}
+	if x.ConfirmationPocPassed != false {
+		value := protoreflect.ValueOfBool(x.ConfirmationPocPassed)
+		if !f(fd_ParticipantStatusExplanation_confirmation_poc_passed, value) {
+			return
+		}
+	}
+	if x.Reputation != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Reputation)
+		if !f(fd_ParticipantStatusExplanation_reputation, value) {
+			return
+		}
+	}
+	if x.EpochsCompleted != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.EpochsCompleted)
+		if !f(fd_ParticipantStatusExplanation_epochs_completed, value) {
+			return
+		}
+	}
+	if x.ExclusionReason != "" {
+		value := protoreflect.ValueOfString(x.ExclusionReason)
+		if !f(fd_ParticipantStatusExplanation_exclusion_reason, value) {
+			return
+		}
+	}
+	if x.ExclusionBlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExclusionBlockHeight)
+		if !f(fd_ParticipantStatusExplanation_exclusion_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParticipantStatusExplanation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantStatusExplanation.participant":
+		return x.Participant != ""
+	case "inference.inference.ParticipantStatusExplanation.epoch_index":
+		return x.EpochIndex != uint64(0)
+	case "inference.inference.ParticipantStatusExplanation.live":
+		return x.Live != false
+	case "inference.inference.ParticipantStatusExplanation.status":
+		return x.Status != 0
+	case "inference.inference.ParticipantStatusExplanation.status_reason":
+		return x.StatusReason != ""
+	case "inference.inference.ParticipantStatusExplanation.inference_count":
+		return x.InferenceCount != uint64(0)
+	case "inference.inference.ParticipantStatusExplanation.missed_requests":
+		return x.MissedRequests != uint64(0)
+	case "inference.inference.ParticipantStatusExplanation.validated_inferences":
+		return x.ValidatedInferences != uint64(0)
+	case "inference.inference.ParticipantStatusExplanation.invalidated_inferences":
+		return x.InvalidatedInferences != uint64(0)
+	case "inference.inference.ParticipantStatusExplanation.consecutive_invalid_inferences":
+		return x.ConsecutiveInvalidInferences != int64(0)
+	case "inference.inference.ParticipantStatusExplanation.consecutive_failure_probability":
+		return x.ConsecutiveFailureProbability != nil
+	case "inference.inference.ParticipantStatusExplanation.quick_failure_threshold":
+		return x.QuickFailureThreshold != nil
+	case "inference.inference.ParticipantStatusExplanation.invalidation_sprt":
+		return x.InvalidationSprt != nil
+	case "inference.inference.ParticipantStatusExplanation.downtime_sprt":
+		return x.DowntimeSprt != nil
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage":
+		return x.MissPercentage != nil
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage_cutoff":
+		return x.MissPercentageCutoff != nil
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage_exceeded":
+		return x.MissPercentageExceeded != false
+	case "inference.inference.ParticipantStatusExplanation.downtime_p_value":
+		return x.DowntimePValue != nil
+	case "inference.inference.ParticipantStatusExplanation.binom_test_p0":
+		return x.BinomTestP0 != nil
+	case "inference.inference.ParticipantStatusExplanation.downtime_test_passed":
+		return x.DowntimeTestPassed != false
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_ratio":
+		return x.ConfirmationPocRatio != nil
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_alpha_threshold":
+		return x.ConfirmationPocAlphaThreshold != nil
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_passed":
+		return x.ConfirmationPocPassed != false
+	case "inference.inference.ParticipantStatusExplanation.reputation":
+		return x.Reputation != int64(0)
+	case "inference.inference.ParticipantStatusExplanation.epochs_completed":
+		return x.EpochsCompleted != uint32(0)
+	case "inference.inference.ParticipantStatusExplanation.exclusion_reason":
+		return x.ExclusionReason != ""
+	case "inference.inference.ParticipantStatusExplanation.exclusion_block_height":
+		return x.ExclusionBlockHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantStatusExplanation"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantStatusExplanation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantStatusExplanation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantStatusExplanation.participant":
+		x.Participant = ""
+	case "inference.inference.ParticipantStatusExplanation.epoch_index":
+		x.EpochIndex = uint64(0)
+	case "inference.inference.ParticipantStatusExplanation.live":
+		x.Live = false
+	case "inference.inference.ParticipantStatusExplanation.status":
+		x.Status = 0
+	case "inference.inference.ParticipantStatusExplanation.status_reason":
+		x.StatusReason = ""
+	case "inference.inference.ParticipantStatusExplanation.inference_count":
+		x.InferenceCount = uint64(0)
+	case "inference.inference.ParticipantStatusExplanation.missed_requests":
+		x.MissedRequests = uint64(0)
+	case "inference.inference.ParticipantStatusExplanation.validated_inferences":
+		x.ValidatedInferences = uint64(0)
+	case "inference.inference.ParticipantStatusExplanation.invalidated_inferences":
+		x.InvalidatedInferences = uint64(0)
+	case "inference.inference.ParticipantStatusExplanation.consecutive_invalid_inferences":
+		x.ConsecutiveInvalidInferences = int64(0)
+	case "inference.inference.ParticipantStatusExplanation.consecutive_failure_probability":
+		x.ConsecutiveFailureProbability = nil
+	case "inference.inference.ParticipantStatusExplanation.quick_failure_threshold":
+		x.QuickFailureThreshold = nil
+	case "inference.inference.ParticipantStatusExplanation.invalidation_sprt":
+		x.InvalidationSprt = nil
+	case "inference.inference.ParticipantStatusExplanation.downtime_sprt":
+		x.DowntimeSprt = nil
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage":
+		x.MissPercentage = nil
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage_cutoff":
+		x.MissPercentageCutoff = nil
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage_exceeded":
+		x.MissPercentageExceeded = false
+	case "inference.inference.ParticipantStatusExplanation.downtime_p_value":
+		x.DowntimePValue = nil
+	case "inference.inference.ParticipantStatusExplanation.binom_test_p0":
+		x.BinomTestP0 = nil
+	case "inference.inference.ParticipantStatusExplanation.downtime_test_passed":
+		x.DowntimeTestPassed = false
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_ratio":
+		x.ConfirmationPocRatio = nil
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_alpha_threshold":
+		x.ConfirmationPocAlphaThreshold = nil
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_passed":
+		x.ConfirmationPocPassed = false
+	case "inference.inference.ParticipantStatusExplanation.reputation":
+		x.Reputation = int64(0)
+	case "inference.inference.ParticipantStatusExplanation.epochs_completed":
+		x.EpochsCompleted = uint32(0)
+	case "inference.inference.ParticipantStatusExplanation.exclusion_reason":
+		x.ExclusionReason = ""
+	case "inference.inference.ParticipantStatusExplanation.exclusion_block_height":
+		x.ExclusionBlockHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantStatusExplanation"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantStatusExplanation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParticipantStatusExplanation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.ParticipantStatusExplanation.participant":
+		value := x.Participant
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.ParticipantStatusExplanation.epoch_index":
+		value := x.EpochIndex
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ParticipantStatusExplanation.live":
+		value := x.Live
+		return protoreflect.ValueOfBool(value)
+	case "inference.inference.ParticipantStatusExplanation.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "inference.inference.ParticipantStatusExplanation.status_reason":
+		value := x.StatusReason
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.ParticipantStatusExplanation.inference_count":
+		value := x.InferenceCount
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ParticipantStatusExplanation.missed_requests":
+		value := x.MissedRequests
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ParticipantStatusExplanation.validated_inferences":
+		value := x.ValidatedInferences
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ParticipantStatusExplanation.invalidated_inferences":
+		value := x.InvalidatedInferences
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ParticipantStatusExplanation.consecutive_invalid_inferences":
+		value := x.ConsecutiveInvalidInferences
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.ParticipantStatusExplanation.consecutive_failure_probability":
+		value := x.ConsecutiveFailureProbability
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.quick_failure_threshold":
+		value := x.QuickFailureThreshold
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.invalidation_sprt":
+		value := x.InvalidationSprt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.downtime_sprt":
+		value := x.DowntimeSprt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage":
+		value := x.MissPercentage
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage_cutoff":
+		value := x.MissPercentageCutoff
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage_exceeded":
+		value := x.MissPercentageExceeded
+		return protoreflect.ValueOfBool(value)
+	case "inference.inference.ParticipantStatusExplanation.downtime_p_value":
+		value := x.DowntimePValue
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.binom_test_p0":
+		value := x.BinomTestP0
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.downtime_test_passed":
+		value := x.DowntimeTestPassed
+		return protoreflect.ValueOfBool(value)
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_ratio":
+		value := x.ConfirmationPocRatio
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_alpha_threshold":
+		value := x.ConfirmationPocAlphaThreshold
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_passed":
+		value := x.ConfirmationPocPassed
+		return protoreflect.ValueOfBool(value)
+	case "inference.inference.ParticipantStatusExplanation.reputation":
+		value := x.Reputation
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.ParticipantStatusExplanation.epochs_completed":
+		value := x.EpochsCompleted
+		return protoreflect.ValueOfUint32(value)
+	case "inference.inference.ParticipantStatusExplanation.exclusion_reason":
+		value := x.ExclusionReason
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.ParticipantStatusExplanation.exclusion_block_height":
+		value := x.ExclusionBlockHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantStatusExplanation"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantStatusExplanation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantStatusExplanation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantStatusExplanation.participant":
+		x.Participant = value.Interface().(string)
+	case "inference.inference.ParticipantStatusExplanation.epoch_index":
+		x.EpochIndex = value.Uint()
+	case "inference.inference.ParticipantStatusExplanation.live":
+		x.Live = value.Bool()
+	case "inference.inference.ParticipantStatusExplanation.status":
+		x.Status = (ParticipantStatus)(value.Enum())
+	case "inference.inference.ParticipantStatusExplanation.status_reason":
+		x.StatusReason = value.Interface().(string)
+	case "inference.inference.ParticipantStatusExplanation.inference_count":
+		x.InferenceCount = value.Uint()
+	case "inference.inference.ParticipantStatusExplanation.missed_requests":
+		x.MissedRequests = value.Uint()
+	case "inference.inference.ParticipantStatusExplanation.validated_inferences":
+		x.ValidatedInferences = value.Uint()
+	case "inference.inference.ParticipantStatusExplanation.invalidated_inferences":
+		x.InvalidatedInferences = value.Uint()
+	case "inference.inference.ParticipantStatusExplanation.consecutive_invalid_inferences":
+		x.ConsecutiveInvalidInferences = value.Int()
+	case "inference.inference.ParticipantStatusExplanation.consecutive_failure_probability":
+		x.ConsecutiveFailureProbability = value.Message().Interface().(*Decimal)
+	case "inference.inference.ParticipantStatusExplanation.quick_failure_threshold":
+		x.QuickFailureThreshold = value.Message().Interface().(*Decimal)
+	case "inference.inference.ParticipantStatusExplanation.invalidation_sprt":
+		x.InvalidationSprt = value.Message().Interface().(*SprtExplanation)
+	case "inference.inference.ParticipantStatusExplanation.downtime_sprt":
+		x.DowntimeSprt = value.Message().Interface().(*SprtExplanation)
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage":
+		x.MissPercentage = value.Message().Interface().(*Decimal)
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage_cutoff":
+		x.MissPercentageCutoff = value.Message().Interface().(*Decimal)
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage_exceeded":
+		x.MissPercentageExceeded = value.Bool()
+	case "inference.inference.ParticipantStatusExplanation.downtime_p_value":
+		x.DowntimePValue = value.Message().Interface().(*Decimal)
+	case "inference.inference.ParticipantStatusExplanation.binom_test_p0":
+		x.BinomTestP0 = value.Message().Interface().(*Decimal)
+	case "inference.inference.ParticipantStatusExplanation.downtime_test_passed":
+		x.DowntimeTestPassed = value.Bool()
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_ratio":
+		x.ConfirmationPocRatio = value.Message().Interface().(*Decimal)
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_alpha_threshold":
+		x.ConfirmationPocAlphaThreshold = value.Message().Interface().(*Decimal)
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_passed":
+		x.ConfirmationPocPassed = value.Bool()
+	case "inference.inference.ParticipantStatusExplanation.reputation":
+		x.Reputation = value.Int()
+	case "inference.inference.ParticipantStatusExplanation.epochs_completed":
+		x.EpochsCompleted = uint32(value.Uint())
+	case "inference.inference.ParticipantStatusExplanation.exclusion_reason":
+		x.ExclusionReason = value.Interface().(string)
+	case "inference.inference.ParticipantStatusExplanation.exclusion_block_height":
+		x.ExclusionBlockHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantStatusExplanation"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantStatusExplanation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantStatusExplanation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantStatusExplanation.consecutive_failure_probability":
+		if x.ConsecutiveFailureProbability == nil {
+			x.ConsecutiveFailureProbability = new(Decimal)
+		}
+		return protoreflect.ValueOfMessage(x.ConsecutiveFailureProbability.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.quick_failure_threshold":
+		if x.QuickFailureThreshold == nil {
+			x.QuickFailureThreshold = new(Decimal)
+		}
+		return protoreflect.ValueOfMessage(x.QuickFailureThreshold.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.invalidation_sprt":
+		if x.InvalidationSprt == nil {
+			x.InvalidationSprt = new(SprtExplanation)
+		}
+		return protoreflect.ValueOfMessage(x.InvalidationSprt.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.downtime_sprt":
+		if x.DowntimeSprt == nil {
+			x.DowntimeSprt = new(SprtExplanation)
+		}
+		return protoreflect.ValueOfMessage(x.DowntimeSprt.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage":
+		if x.MissPercentage == nil {
+			x.MissPercentage = new(Decimal)
+		}
+		return protoreflect.ValueOfMessage(x.MissPercentage.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage_cutoff":
+		if x.MissPercentageCutoff == nil {
+			x.MissPercentageCutoff = new(Decimal)
+		}
+		return protoreflect.ValueOfMessage(x.MissPercentageCutoff.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.downtime_p_value":
+		if x.DowntimePValue == nil {
+			x.DowntimePValue = new(Decimal)
+		}
+		return protoreflect.ValueOfMessage(x.DowntimePValue.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.binom_test_p0":
+		if x.BinomTestP0 == nil {
+			x.BinomTestP0 = new(Decimal)
+		}
+		return protoreflect.ValueOfMessage(x.BinomTestP0.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_ratio":
+		if x.ConfirmationPocRatio == nil {
+			x.ConfirmationPocRatio = new(Decimal)
+		}
+		return protoreflect.ValueOfMessage(x.ConfirmationPocRatio.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_alpha_threshold":
+		if x.ConfirmationPocAlphaThreshold == nil {
+			x.ConfirmationPocAlphaThreshold = new(Decimal)
+		}
+		return protoreflect.ValueOfMessage(x.ConfirmationPocAlphaThreshold.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.participant":
+		panic(fmt.Errorf("field participant of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.epoch_index":
+		panic(fmt.Errorf("field epoch_index of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.live":
+		panic(fmt.Errorf("field live of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.status":
+		panic(fmt.Errorf("field status of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.status_reason":
+		panic(fmt.Errorf("field status_reason of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.inference_count":
+		panic(fmt.Errorf("field inference_count of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.missed_requests":
+		panic(fmt.Errorf("field missed_requests of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.validated_inferences":
+		panic(fmt.Errorf("field validated_inferences of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.invalidated_inferences":
+		panic(fmt.Errorf("field invalidated_inferences of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.consecutive_invalid_inferences":
+		panic(fmt.Errorf("field consecutive_invalid_inferences of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage_exceeded":
+		panic(fmt.Errorf("field miss_percentage_exceeded of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.downtime_test_passed":
+		panic(fmt.Errorf("field downtime_test_passed of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_passed":
+		panic(fmt.Errorf("field confirmation_poc_passed of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.reputation":
+		panic(fmt.Errorf("field reputation of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.epochs_completed":
+		panic(fmt.Errorf("field epochs_completed of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.exclusion_reason":
+		panic(fmt.Errorf("field exclusion_reason of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	case "inference.inference.ParticipantStatusExplanation.exclusion_block_height":
+		panic(fmt.Errorf("field exclusion_block_height of message inference.inference.ParticipantStatusExplanation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantStatusExplanation"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantStatusExplanation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParticipantStatusExplanation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantStatusExplanation.participant":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.ParticipantStatusExplanation.epoch_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ParticipantStatusExplanation.live":
+		return protoreflect.ValueOfBool(false)
+	case "inference.inference.ParticipantStatusExplanation.status":
+		return protoreflect.ValueOfEnum(0)
+	case "inference.inference.ParticipantStatusExplanation.status_reason":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.ParticipantStatusExplanation.inference_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ParticipantStatusExplanation.missed_requests":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ParticipantStatusExplanation.validated_inferences":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ParticipantStatusExplanation.invalidated_inferences":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ParticipantStatusExplanation.consecutive_invalid_inferences":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.ParticipantStatusExplanation.consecutive_failure_probability":
+		m := new(Decimal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.quick_failure_threshold":
+		m := new(Decimal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.invalidation_sprt":
+		m := new(SprtExplanation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.downtime_sprt":
+		m := new(SprtExplanation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage":
+		m := new(Decimal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage_cutoff":
+		m := new(Decimal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.miss_percentage_exceeded":
+		return protoreflect.ValueOfBool(false)
+	case "inference.inference.ParticipantStatusExplanation.downtime_p_value":
+		m := new(Decimal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.binom_test_p0":
+		m := new(Decimal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.downtime_test_passed":
+		return protoreflect.ValueOfBool(false)
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_ratio":
+		m := new(Decimal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_alpha_threshold":
+		m := new(Decimal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.ParticipantStatusExplanation.confirmation_poc_passed":
+		return protoreflect.ValueOfBool(false)
+	case "inference.inference.ParticipantStatusExplanation.reputation":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.ParticipantStatusExplanation.epochs_completed":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "inference.inference.ParticipantStatusExplanation.exclusion_reason":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.ParticipantStatusExplanation.exclusion_block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantStatusExplanation"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantStatusExplanation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParticipantStatusExplanation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.ParticipantStatusExplanation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParticipantStatusExplanation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantStatusExplanation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParticipantStatusExplanation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParticipantStatusExplanation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParticipantStatusExplanation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Participant)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EpochIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochIndex))
+		}
+		if x.Live {
+			n += 2
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.StatusReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InferenceCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.InferenceCount))
+		}
+		if x.MissedRequests != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissedRequests))
+		}
+		if x.ValidatedInferences != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatedInferences))
+		}
+		if x.InvalidatedInferences != 0 {
+			n += 1 + runtime.Sov(uint64(x.InvalidatedInferences))
+		}
+		if x.ConsecutiveInvalidInferences != 0 {
+			n += 1 + runtime.Sov(uint64(x.ConsecutiveInvalidInferences))
+		}
+		if x.ConsecutiveFailureProbability != nil {
+			l = options.Size(x.ConsecutiveFailureProbability)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.QuickFailureThreshold != nil {
+			l = options.Size(x.QuickFailureThreshold)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InvalidationSprt != nil {
+			l = options.Size(x.InvalidationSprt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DowntimeSprt != nil {
+			l = options.Size(x.DowntimeSprt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MissPercentage != nil {
+			l = options.Size(x.MissPercentage)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MissPercentageCutoff != nil {
+			l = options.Size(x.MissPercentageCutoff)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MissPercentageExceeded {
+			n += 3
+		}
+		if x.DowntimePValue != nil {
+			l = options.Size(x.DowntimePValue)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.BinomTestP0 != nil {
+			l = options.Size(x.BinomTestP0)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.DowntimeTestPassed {
+			n += 3
+		}
+		if x.ConfirmationPocRatio != nil {
+			l = options.Size(x.ConfirmationPocRatio)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ConfirmationPocAlphaThreshold != nil {
+			l = options.Size(x.ConfirmationPocAlphaThreshold)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ConfirmationPocPassed {
+			n += 3
+		}
+		if x.Reputation != 0 {
+			n += 2 + runtime.Sov(uint64(x.Reputation))
+		}
+		if x.EpochsCompleted != 0 {
+			n += 2 + runtime.Sov(uint64(x.EpochsCompleted))
+		}
+		l = len(x.ExclusionReason)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExclusionBlockHeight != 0 {
+			n += 2 + runtime.Sov(uint64(x.ExclusionBlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantStatusExplanation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExclusionBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExclusionBlockHeight))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd8
+		}
+		if len(x.ExclusionReason) > 0 {
+			i -= len(x.ExclusionReason)
+			copy(dAtA[i:], x.ExclusionReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExclusionReason)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+		if x.EpochsCompleted != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochsCompleted))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc8
+		}
+		if x.Reputation != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reputation))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc0
+		}
+		if x.ConfirmationPocPassed {
+			i--
+			if x.ConfirmationPocPassed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
+		}
+		if x.ConfirmationPocAlphaThreshold != nil {
+			encoded, err := options.Marshal(x.ConfirmationPocAlphaThreshold)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if x.ConfirmationPocRatio != nil {
+			encoded, err := options.Marshal(x.ConfirmationPocRatio)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if x.DowntimeTestPassed {
+			i--
+			if x.DowntimeTestPassed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if x.BinomTestP0 != nil {
+			encoded, err := options.Marshal(x.BinomTestP0)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if x.DowntimePValue != nil {
+			encoded, err := options.Marshal(x.DowntimePValue)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if x.MissPercentageExceeded {
+			i--
+			if x.MissPercentageExceeded {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.MissPercentageCutoff != nil {
+			encoded, err := options.Marshal(x.MissPercentageCutoff)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.MissPercentage != nil {
+			encoded, err := options.Marshal(x.MissPercentage)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.DowntimeSprt != nil {
+			encoded, err := options.Marshal(x.DowntimeSprt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.InvalidationSprt != nil {
+			encoded, err := options.Marshal(x.InvalidationSprt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.QuickFailureThreshold != nil {
+			encoded, err := options.Marshal(x.QuickFailureThreshold)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.ConsecutiveFailureProbability != nil {
+			encoded, err := options.Marshal(x.ConsecutiveFailureProbability)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.ConsecutiveInvalidInferences != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConsecutiveInvalidInferences))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.InvalidatedInferences != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InvalidatedInferences))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.ValidatedInferences != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatedInferences))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MissedRequests != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedRequests))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.InferenceCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InferenceCount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.StatusReason) > 0 {
+			i -= len(x.StatusReason)
+			copy(dAtA[i:], x.StatusReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StatusReason)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Live {
+			i--
+			if x.Live {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.EpochIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochIndex))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Participant) > 0 {
+			i -= len(x.Participant)
+			copy(dAtA[i:], x.Participant)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Participant)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantStatusExplanation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantStatusExplanation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantStatusExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Participant = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+				}
+				x.EpochIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Live", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Live = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= ParticipantStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StatusReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StatusReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InferenceCount", wireType)
+				}
+				x.InferenceCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InferenceCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedRequests", wireType)
+				}
+				x.MissedRequests = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissedRequests |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatedInferences", wireType)
+				}
+				x.ValidatedInferences = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatedInferences |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InvalidatedInferences", wireType)
+				}
+				x.InvalidatedInferences = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InvalidatedInferences |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveInvalidInferences", wireType)
+				}
+				x.ConsecutiveInvalidInferences = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ConsecutiveInvalidInferences |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailureProbability", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ConsecutiveFailureProbability == nil {
+					x.ConsecutiveFailureProbability = &Decimal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ConsecutiveFailureProbability); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuickFailureThreshold", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.QuickFailureThreshold == nil {
+					x.QuickFailureThreshold = &Decimal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QuickFailureThreshold); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InvalidationSprt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.InvalidationSprt == nil {
+					x.InvalidationSprt = &SprtExplanation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InvalidationSprt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeSprt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DowntimeSprt == nil {
+					x.DowntimeSprt = &SprtExplanation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DowntimeSprt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissPercentage", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MissPercentage == nil {
+					x.MissPercentage = &Decimal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MissPercentage); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissPercentageCutoff", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MissPercentageCutoff == nil {
+					x.MissPercentageCutoff = &Decimal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MissPercentageCutoff); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissPercentageExceeded", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.MissPercentageExceeded = bool(v != 0)
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimePValue", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DowntimePValue == nil {
+					x.DowntimePValue = &Decimal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DowntimePValue); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BinomTestP0", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BinomTestP0 == nil {
+					x.BinomTestP0 = &Decimal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BinomTestP0); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeTestPassed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DowntimeTestPassed = bool(v != 0)
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConfirmationPocRatio", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ConfirmationPocRatio == nil {
+					x.ConfirmationPocRatio = &Decimal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ConfirmationPocRatio); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConfirmationPocAlphaThreshold", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ConfirmationPocAlphaThreshold == nil {
+					x.ConfirmationPocAlphaThreshold = &Decimal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ConfirmationPocAlphaThreshold); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConfirmationPocPassed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ConfirmationPocPassed = bool(v != 0)
+			case 24:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
+				}
+				x.Reputation = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reputation |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 25:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochsCompleted", wireType)
+				}
+				x.EpochsCompleted = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochsCompleted |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 26:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExclusionReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExclusionReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 27:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExclusionBlockHeight", wireType)
+				}
+				x.ExclusionBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExclusionBlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/inference/participant_status_explanation.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SprtExplanation shows the state of a sequential probability ratio test.
+// The test fails once llr >= threshold and passes once llr <= -threshold.
+type SprtExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Llr       *Decimal `protobuf:"bytes,1,opt,name=llr,proto3" json:"llr,omitempty"`
+	Threshold *Decimal `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	P0        *Decimal `protobuf:"bytes,3,opt,name=p0,proto3" json:"p0,omitempty"`             // expected failure rate of a good participant
+	P1        *Decimal `protobuf:"bytes,4,opt,name=p1,proto3" json:"p1,omitempty"`             // failure rate of a bad participant
+	Decision  string   `protobuf:"bytes,5,opt,name=decision,proto3" json:"decision,omitempty"` // undetermined, pass, fail or error
+}
+
+func (x *SprtExplanation) Reset() {
+	*x = SprtExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_participant_status_explanation_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SprtExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SprtExplanation) ProtoMessage() {}
+
+// Deprecated: Use SprtExplanation.ProtoReflect.Descriptor instead.
+func (*SprtExplanation) Descriptor() ([]byte, []int) {
+	return file_inference_inference_participant_status_explanation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SprtExplanation) GetLlr() *Decimal {
+	if x != nil {
+		return x.Llr
+	}
+	return nil
+}
+
+func (x *SprtExplanation) GetThreshold() *Decimal {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+func (x *SprtExplanation) GetP0() *Decimal {
+	if x != nil {
+		return x.P0
+	}
+	return nil
+}
+
+func (x *SprtExplanation) GetP1() *Decimal {
+	if x != nil {
+		return x.P1
+	}
+	return nil
+}
+
+func (x *SprtExplanation) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+// ParticipantStatusExplanation exposes the inputs and intermediate results behind a participant's
+// status and reputation for one epoch.
+type ParticipantStatusExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	EpochIndex  uint64 `protobuf:"varint,2,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	// live is true when computed from the participant's current epoch stats. Past epochs are computed
+	// from the stored epoch performance summary, which has no SPRT or confirmation PoC state.
+	Live                          bool              `protobuf:"varint,3,opt,name=live,proto3" json:"live,omitempty"`
+	Status                        ParticipantStatus `protobuf:"varint,4,opt,name=status,proto3,enum=inference.inference.ParticipantStatus" json:"status,omitempty"`
+	StatusReason                  string            `protobuf:"bytes,5,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"` // rule that produced the status, empty if none fired
+	InferenceCount                uint64            `protobuf:"varint,6,opt,name=inference_count,json=inferenceCount,proto3" json:"inference_count,omitempty"`
+	MissedRequests                uint64            `protobuf:"varint,7,opt,name=missed_requests,json=missedRequests,proto3" json:"missed_requests,omitempty"`
+	ValidatedInferences           uint64            `protobuf:"varint,8,opt,name=validated_inferences,json=validatedInferences,proto3" json:"validated_inferences,omitempty"`
+	InvalidatedInferences         uint64            `protobuf:"varint,9,opt,name=invalidated_inferences,json=invalidatedInferences,proto3" json:"invalidated_inferences,omitempty"`
+	ConsecutiveInvalidInferences  int64             `protobuf:"varint,10,opt,name=consecutive_invalid_inferences,json=consecutiveInvalidInferences,proto3" json:"consecutive_invalid_inferences,omitempty"`
+	ConsecutiveFailureProbability *Decimal          `protobuf:"bytes,11,opt,name=consecutive_failure_probability,json=consecutiveFailureProbability,proto3" json:"consecutive_failure_probability,omitempty"`
+	QuickFailureThreshold         *Decimal          `protobuf:"bytes,12,opt,name=quick_failure_threshold,json=quickFailureThreshold,proto3" json:"quick_failure_threshold,omitempty"`
+	InvalidationSprt              *SprtExplanation  `protobuf:"bytes,13,opt,name=invalidation_sprt,json=invalidationSprt,proto3" json:"invalidation_sprt,omitempty"`
+	DowntimeSprt                  *SprtExplanation  `protobuf:"bytes,14,opt,name=downtime_sprt,json=downtimeSprt,proto3" json:"downtime_sprt,omitempty"`
+	MissPercentage                *Decimal          `protobuf:"bytes,15,opt,name=miss_percentage,json=missPercentage,proto3" json:"miss_percentage,omitempty"`
+	MissPercentageCutoff          *Decimal          `protobuf:"bytes,16,opt,name=miss_percentage_cutoff,json=missPercentageCutoff,proto3" json:"miss_percentage_cutoff,omitempty"`
+	MissPercentageExceeded        bool              `protobuf:"varint,17,opt,name=miss_percentage_exceeded,json=missPercentageExceeded,proto3" json:"miss_percentage_exceeded,omitempty"`
+	DowntimePValue                *Decimal          `protobuf:"bytes,18,opt,name=downtime_p_value,json=downtimePValue,proto3" json:"downtime_p_value,omitempty"` // unset when there are too many requests to compute it cheaply
+	BinomTestP0                   *Decimal          `protobuf:"bytes,19,opt,name=binom_test_p0,json=binomTestP0,proto3" json:"binom_test_p0,omitempty"`
+	DowntimeTestPassed            bool              `protobuf:"varint,20,opt,name=downtime_test_passed,json=downtimeTestPassed,proto3" json:"downtime_test_passed,omitempty"`
+	ConfirmationPocRatio          *Decimal          `protobuf:"bytes,21,opt,name=confirmation_poc_ratio,json=confirmationPocRatio,proto3" json:"confirmation_poc_ratio,omitempty"`
+	ConfirmationPocAlphaThreshold *Decimal          `protobuf:"bytes,22,opt,name=confirmation_poc_alpha_threshold,json=confirmationPocAlphaThreshold,proto3" json:"confirmation_poc_alpha_threshold,omitempty"`
+	ConfirmationPocPassed         bool              `protobuf:"varint,23,opt,name=confirmation_poc_passed,json=confirmationPocPassed,proto3" json:"confirmation_poc_passed,omitempty"`
+	Reputation                    int64             `protobuf:"varint,24,opt,name=reputation,proto3" json:"reputation,omitempty"`
+	EpochsCompleted               uint32            `protobuf:"varint,25,opt,name=epochs_completed,json=epochsCompleted,proto3" json:"epochs_completed,omitempty"`
+	ExclusionReason               string            `protobuf:"bytes,26,opt,name=exclusion_reason,json=exclusionReason,proto3" json:"exclusion_reason,omitempty"`
+	ExclusionBlockHeight          uint64            `protobuf:"varint,27,opt,name=exclusion_block_height,json=exclusionBlockHeight,proto3" json:"exclusion_block_height,omitempty"`
+}
+
+func (x *ParticipantStatusExplanation) Reset() {
+	*x = ParticipantStatusExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_participant_status_explanation_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParticipantStatusExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantStatusExplanation) ProtoMessage() {}
+
+// Deprecated: Use ParticipantStatusExplanation.ProtoReflect.Descriptor instead.
+func (*ParticipantStatusExplanation) Descriptor() ([]byte, []int) {
+	return file_inference_inference_participant_status_explanation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParticipantStatusExplanation) GetParticipant() string {
+	if x != nil {
+		return x.Participant
+	}
+	return ""
+}
+
+func (x *ParticipantStatusExplanation) GetEpochIndex() uint64 {
+	if x != nil {
+		return x.EpochIndex
+	}
+	return 0
+}
+
+func (x *ParticipantStatusExplanation) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+func (x *ParticipantStatusExplanation) GetStatus() ParticipantStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ParticipantStatus_UNSPECIFIED
+}
+
+func (x *ParticipantStatusExplanation) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *ParticipantStatusExplanation) GetInferenceCount() uint64 {
+	if x != nil {
+		return x.InferenceCount
+	}
+	return 0
+}
+
+func (x *ParticipantStatusExplanation) GetMissedRequests() uint64 {
+	if x != nil {
+		return x.MissedRequests
+	}
+	return 0
+}
+
+func (x *ParticipantStatusExplanation) GetValidatedInferences() uint64 {
+	if x != nil {
+		return x.ValidatedInferences
+	}
+	return 0
+}
+
+func (x *ParticipantStatusExplanation) GetInvalidatedInferences() uint64 {
+	if x != nil {
+		return x.InvalidatedInferences
+	}
+	return 0
+}
+
+func (x *ParticipantStatusExplanation) GetConsecutiveInvalidInferences() int64 {
+	if x != nil {
+		return x.ConsecutiveInvalidInferences
+	}
+	return 0
+}
+
+func (x *ParticipantStatusExplanation) GetConsecutiveFailureProbability() *Decimal {
+	if x != nil {
+		return x.ConsecutiveFailureProbability
+	}
+	return nil
+}
+
+func (x *ParticipantStatusExplanation) GetQuickFailureThreshold() *Decimal {
+	if x != nil {
+		return x.QuickFailureThreshold
+	}
+	return nil
+}
+
+func (x *ParticipantStatusExplanation) GetInvalidationSprt() *SprtExplanation {
+	if x != nil {
+		return x.InvalidationSprt
+	}
+	return nil
+}
+
+func (x *ParticipantStatusExplanation) GetDowntimeSprt() *SprtExplanation {
+	if x != nil {
+		return x.DowntimeSprt
+	}
+	return nil
+}
+
+func (x *ParticipantStatusExplanation) GetMissPercentage() *Decimal {
+	if x != nil {
+		return x.MissPercentage
+	}
+	return nil
+}
+
+func (x *ParticipantStatusExplanation) GetMissPercentageCutoff() *Decimal {
+	if x != nil {
+		return x.MissPercentageCutoff
+	}
+	return nil
+}
+
+func (x *ParticipantStatusExplanation) GetMissPercentageExceeded() bool {
+	if x != nil {
+		return x.MissPercentageExceeded
+	}
+	return false
+}
+
+func (x *ParticipantStatusExplanation) GetDowntimePValue() *Decimal {
+	if x != nil {
+		return x.DowntimePValue
+	}
+	return nil
+}
+
+func (x *ParticipantStatusExplanation) GetBinomTestP0() *Decimal {
+	if x != nil {
+		return x.BinomTestP0
+	}
+	return nil
+}
+
+func (x *ParticipantStatusExplanation) GetDowntimeTestPassed() bool {
+	if x != nil {
+		return x.DowntimeTestPassed
+	}
+	return false
+}
+
+func (x *ParticipantStatusExplanation) GetConfirmationPocRatio() *Decimal {
+	if x != nil {
+		return x.ConfirmationPocRatio
+	}
+	return nil
+}
+
+func (x *ParticipantStatusExplanation) GetConfirmationPocAlphaThreshold() *Decimal {
+	if x != nil {
+		return x.ConfirmationPocAlphaThreshold
+	}
+	return nil
+}
+
+func (x *ParticipantStatusExplanation) GetConfirmationPocPassed() bool {
+	if x != nil {
+		return x.ConfirmationPocPassed
+	}
+	return false
+}
+
+func (x *ParticipantStatusExplanation) GetReputation() int64 {
+	if x != nil {
+		return x.Reputation
+	}
+	return 0
+}
+
+func (x *ParticipantStatusExplanation) GetEpochsCompleted() uint32 {
+	if x != nil {
+		return x.EpochsCompleted
+	}
+	return 0
+}
+
+func (x *ParticipantStatusExplanation) GetExclusionReason() string {
+	if x != nil {
+		return x.ExclusionReason
+	}
+	return ""
+}
+
+func (x *ParticipantStatusExplanation) GetExclusionBlockHeight() uint64 {
+	if x != nil {
+		return x.ExclusionBlockHeight
+	}
+	return 0
+}
+
+var File_inference_inference_participant_status_explanation_proto protoreflect.FileDescriptor
+
+var file_inference_inference_participant_status_explanation_proto_rawDesc = []byte{
+	0x0a, 0x38, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a,
+	0x20, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x25, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x01, 0x0a, 0x0f, 0x53, 0x70, 0x72,
+	0x74, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x03,
+	0x6c, 0x6c, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x03, 0x6c, 0x6c, 0x72, 0x12, 0x3a, 0x0a, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x02, 0x70, 0x30, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x52, 0x02, 0x70, 0x30, 0x12, 0x2c, 0x0a, 0x02, 0x70, 0x31, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x52, 0x02, 0x70, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0xe6, 0x0c, 0x0a, 0x1c, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12,
+	0x31, 0x0a, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x35, 0x0a, 0x16, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x15, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1e, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x1c, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x49, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x64, 0x0a, 0x1f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x1d, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x54, 0x0a, 0x17, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x52, 0x15, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x51, 0x0a, 0x11, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x70, 0x72, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x70, 0x72,
+	0x74, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x69, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x72, 0x74, 0x12, 0x49,
+	0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x70, 0x72, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x70, 0x72, 0x74,
+	0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x6f, 0x77,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x70, 0x72, 0x74, 0x12, 0x45, 0x0a, 0x0f, 0x6d, 0x69, 0x73,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x52, 0x0a, 0x16, 0x6d, 0x69, 0x73, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x5f, 0x63, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x14,
+	0x6d, 0x69, 0x73, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x43, 0x75,
+	0x74, 0x6f, 0x66, 0x66, 0x12, 0x38, 0x0a, 0x18, 0x6d, 0x69, 0x73, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x6d, 0x69, 0x73, 0x73, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x46,
+	0x0a, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x50, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x62, 0x69, 0x6e, 0x6f, 0x6d, 0x5f,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x30, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x0b, 0x62, 0x69, 0x6e,
+	0x6f, 0x6d, 0x54, 0x65, 0x73, 0x74, 0x50, 0x30, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x6f, 0x77, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x54, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x16, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x63, 0x5f, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x63, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x65,
+	0x0a, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x6f, 0x63, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x1d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x63, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x63, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x63, 0x50, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
+	0x10, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0xcf, 0x01, 0x0a, 0x17, 0x63, 0x6f,
+	0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x21, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_inference_inference_participant_status_explanation_proto_rawDescOnce sync.Once
+	file_inference_inference_participant_status_explanation_proto_rawDescData = file_inference_inference_participant_status_explanation_proto_rawDesc
+)
+
+func file_inference_inference_participant_status_explanation_proto_rawDescGZIP() []byte {
+	file_inference_inference_participant_status_explanation_proto_rawDescOnce.Do(func() {
+		file_inference_inference_participant_status_explanation_proto_rawDescData = protoimpl.X.CompressGZIP(file_inference_inference_participant_status_explanation_proto_rawDescData)
+	})
+	return file_inference_inference_participant_status_explanation_proto_rawDescData
+}
+
+var file_inference_inference_participant_status_explanation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_inference_inference_participant_status_explanation_proto_goTypes = []interface{}{
+	(*SprtExplanation)(nil),              // 0: inference.inference.SprtExplanation
+	(*ParticipantStatusExplanation)(nil), // 1: inference.inference.ParticipantStatusExplanation
+	(*Decimal)(nil),                      // 2: inference.inference.Decimal
+	(ParticipantStatus)(0),               // 3: inference.inference.ParticipantStatus
+}
+var file_inference_inference_participant_status_explanation_proto_depIdxs = []int32{
+	2,  // 0: inference.inference.SprtExplanation.llr:type_name -> inference.inference.Decimal
+	2,  // 1: inference.inference.SprtExplanation.threshold:type_name -> inference.inference.Decimal
+	2,  // 2: inference.inference.SprtExplanation.p0:type_name -> inference.inference.Decimal
+	2,  // 3: inference.inference.SprtExplanation.p1:type_name -> inference.inference.Decimal
+	3,  // 4: inference.inference.ParticipantStatusExplanation.status:type_name -> inference.inference.ParticipantStatus
+	2,  // 5: inference.inference.ParticipantStatusExplanation.consecutive_failure_probability:type_name -> inference.inference.Decimal
+	2,  // 6: inference.inference.ParticipantStatusExplanation.quick_failure_threshold:type_name -> inference.inference.Decimal
+	0,  // 7: inference.inference.ParticipantStatusExplanation.invalidation_sprt:type_name -> inference.inference.SprtExplanation
+	0,  // 8: inference.inference.ParticipantStatusExplanation.downtime_sprt:type_name -> inference.inference.SprtExplanation
+	2,  // 9: inference.inference.ParticipantStatusExplanation.miss_percentage:type_name -> inference.inference.Decimal
+	2,  // 10: inference.inference.ParticipantStatusExplanation.miss_percentage_cutoff:type_name -> inference.inference.Decimal
+	2,  // 11: inference.inference.ParticipantStatusExplanation.downtime_p_value:type_name -> inference.inference.Decimal
+	2,  // 12: inference.inference.ParticipantStatusExplanation.binom_test_p0:type_name -> inference.inference.Decimal
+	2,  // 13: inference.inference.ParticipantStatusExplanation.confirmation_poc_ratio:type_name -> inference.inference.Decimal
+	2,  // 14: inference.inference.ParticipantStatusExplanation.confirmation_poc_alpha_threshold:type_name -> inference.inference.Decimal
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_inference_inference_participant_status_explanation_proto_init() }
+func file_inference_inference_participant_status_explanation_proto_init() {
+	if File_inference_inference_participant_status_explanation_proto != nil {
+		return
+	}
+	file_inference_inference_params_proto_init()
+	file_inference_inference_participant_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_inference_inference_participant_status_explanation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SprtExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_participant_status_explanation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantStatusExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_inference_inference_participant_status_explanation_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_inference_inference_participant_status_explanation_proto_goTypes,
+		DependencyIndexes: file_inference_inference_participant_status_explanation_proto_depIdxs,
+		MessageInfos:      file_inference_inference_participant_status_explanation_proto_msgTypes,
+	}.Build()
+	File_inference_inference_participant_status_explanation_proto = out.File
+	file_inference_inference_participant_status_explanation_proto_rawDesc = nil
+	file_inference_inference_participant_status_explanation_proto_goTypes = nil
+	file_inference_inference_participant_status_explanation_proto_depIdxs = nil
+}
@@ -5,6 +5,7 @@ import (
 	binary "encoding/binary"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -115,6 +116,11 @@ var (
 	fd_PoCBatch_dist                         protoreflect.FieldDescriptor
 	fd_PoCBatch_batch_id                     protoreflect.FieldDescriptor
 	fd_PoCBatch_node_id                      protoreflect.FieldDescriptor
+	fd_PoCBatch_merkle_root                  protoreflect.FieldDescriptor
+	fd_PoCBatch_nonce_count                  protoreflect.FieldDescriptor
+	fd_PoCBatch_first_nonce                  protoreflect.FieldDescriptor
+	fd_PoCBatch_last_nonce                   protoreflect.FieldDescriptor
+	fd_PoCBatch_nonce_stride                 protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -127,6 +133,11 @@ func init() {
 	fd_PoCBatch_dist = md_PoCBatch.Fields().ByName("dist")
 	fd_PoCBatch_batch_id = md_PoCBatch.Fields().ByName("batch_id")
 	fd_PoCBatch_node_id = md_PoCBatch.Fields().ByName("node_id")
+	fd_PoCBatch_merkle_root = md_PoCBatch.Fields().ByName("merkle_root")
+	fd_PoCBatch_nonce_count = md_PoCBatch.Fields().ByName("nonce_count")
+	fd_PoCBatch_first_nonce = md_PoCBatch.Fields().ByName("first_nonce")
+	fd_PoCBatch_last_nonce = md_PoCBatch.Fields().ByName("last_nonce")
+	fd_PoCBatch_nonce_stride = md_PoCBatch.Fields().ByName("nonce_stride")
 }
 
 var _ protoreflect.Message = (*fastReflection_PoCBatch)(nil)
@@ -236,6 +247,36 @@ func (x *fastReflection_PoCBatch) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if len(x.MerkleRoot) != 0 {
+		value := protoreflect.ValueOfBytes(x.MerkleRoot)
+		if !f(fd_PoCBatch_merkle_root, value) {
+			return
+		}
+	}
+	if x.NonceCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.NonceCount)
+		if !f(fd_PoCBatch_nonce_count, value) {
+			return
+		}
+	}
+	if x.FirstNonce != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FirstNonce)
+		if !f(fd_PoCBatch_first_nonce, value) {
+			return
+		}
+	}
+	if x.LastNonce != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastNonce)
+		if !f(fd_PoCBatch_last_nonce, value) {
+			return
+		}
+	}
+	if x.NonceStride != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NonceStride)
+		if !f(fd_PoCBatch_nonce_stride, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -265,6 +306,16 @@ func (x *fastReflection_PoCBatch) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.BatchId != ""
 	case "inference.inference.PoCBatch.node_id":
 		return x.NodeId != ""
+	case "inference.inference.PoCBatch.merkle_root":
+		return len(x.MerkleRoot) != 0
+	case "inference.inference.PoCBatch.nonce_count":
+		return x.NonceCount != uint32(0)
+	case "inference.inference.PoCBatch.first_nonce":
+		return x.FirstNonce != int64(0)
+	case "inference.inference.PoCBatch.last_nonce":
+		return x.LastNonce != int64(0)
+	case "inference.inference.PoCBatch.nonce_stride":
+		return x.NonceStride != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCBatch"))
@@ -295,6 +346,16 @@ func (x *fastReflection_PoCBatch) Clear(fd protoreflect.FieldDescriptor) {
 		x.BatchId = ""
 	case "inference.inference.PoCBatch.node_id":
 		x.NodeId = ""
+	case "inference.inference.PoCBatch.merkle_root":
+		x.MerkleRoot = nil
+	case "inference.inference.PoCBatch.nonce_count":
+		x.NonceCount = uint32(0)
+	case "inference.inference.PoCBatch.first_nonce":
+		x.FirstNonce = int64(0)
+	case "inference.inference.PoCBatch.last_nonce":
+		x.LastNonce = int64(0)
+	case "inference.inference.PoCBatch.nonce_stride":
+		x.NonceStride = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCBatch"))
@@ -338,6 +399,21 @@ func (x *fastReflection_PoCBatch) Get(descriptor protoreflect.FieldDescriptor) p
 	case "inference.inference.PoCBatch.node_id":
 		value := x.NodeId
 		return protoreflect.ValueOfString(value)
+	case "inference.inference.PoCBatch.merkle_root":
+		value := x.MerkleRoot
+		return protoreflect.ValueOfBytes(value)
+	case "inference.inference.PoCBatch.nonce_count":
+		value := x.NonceCount
+		return protoreflect.ValueOfUint32(value)
+	case "inference.inference.PoCBatch.first_nonce":
+		value := x.FirstNonce
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.PoCBatch.last_nonce":
+		value := x.LastNonce
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.PoCBatch.nonce_stride":
+		value := x.NonceStride
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCBatch"))
@@ -376,6 +452,16 @@ func (x *fastReflection_PoCBatch) Set(fd protoreflect.FieldDescriptor, value pro
 		x.BatchId = value.Interface().(string)
 	case "inference.inference.PoCBatch.node_id":
 		x.NodeId = value.Interface().(string)
+	case "inference.inference.PoCBatch.merkle_root":
+		x.MerkleRoot = value.Bytes()
+	case "inference.inference.PoCBatch.nonce_count":
+		x.NonceCount = uint32(value.Uint())
+	case "inference.inference.PoCBatch.first_nonce":
+		x.FirstNonce = value.Int()
+	case "inference.inference.PoCBatch.last_nonce":
+		x.LastNonce = value.Int()
+	case "inference.inference.PoCBatch.nonce_stride":
+		x.NonceStride = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCBatch"))
@@ -418,6 +504,16 @@ func (x *fastReflection_PoCBatch) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field batch_id of message inference.inference.PoCBatch is not mutable"))
 	case "inference.inference.PoCBatch.node_id":
 		panic(fmt.Errorf("field node_id of message inference.inference.PoCBatch is not mutable"))
+	case "inference.inference.PoCBatch.merkle_root":
+		panic(fmt.Errorf("field merkle_root of message inference.inference.PoCBatch is not mutable"))
+	case "inference.inference.PoCBatch.nonce_count":
+		panic(fmt.Errorf("field nonce_count of message inference.inference.PoCBatch is not mutable"))
+	case "inference.inference.PoCBatch.first_nonce":
+		panic(fmt.Errorf("field first_nonce of message inference.inference.PoCBatch is not mutable"))
+	case "inference.inference.PoCBatch.last_nonce":
+		panic(fmt.Errorf("field last_nonce of message inference.inference.PoCBatch is not mutable"))
+	case "inference.inference.PoCBatch.nonce_stride":
+		panic(fmt.Errorf("field nonce_stride of message inference.inference.PoCBatch is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCBatch"))
@@ -447,6 +543,16 @@ func (x *fastReflection_PoCBatch) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfString("")
 	case "inference.inference.PoCBatch.node_id":
 		return protoreflect.ValueOfString("")
+	case "inference.inference.PoCBatch.merkle_root":
+		return protoreflect.ValueOfBytes(nil)
+	case "inference.inference.PoCBatch.nonce_count":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "inference.inference.PoCBatch.first_nonce":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.PoCBatch.last_nonce":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.PoCBatch.nonce_stride":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCBatch"))
@@ -544,6 +650,22 @@ func (x *fastReflection_PoCBatch) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.MerkleRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NonceCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.NonceCount))
+		}
+		if x.FirstNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.FirstNonce))
+		}
+		if x.LastNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastNonce))
+		}
+		if x.NonceStride != 0 {
+			n += 1 + runtime.Sov(uint64(x.NonceStride))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -573,6 +695,33 @@ func (x *fastReflection_PoCBatch) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.NonceStride != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NonceStride))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.LastNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastNonce))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.FirstNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FirstNonce))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.NonceCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NonceCount))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.MerkleRoot) > 0 {
+			i -= len(x.MerkleRoot)
+			copy(dAtA[i:], x.MerkleRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MerkleRoot)))
+			i--
+			dAtA[i] = 0x42
+		}
 		if len(x.NodeId) > 0 {
 			i -= len(x.NodeId)
 			copy(dAtA[i:], x.NodeId)
@@ -884,7 +1033,779 @@ func (x *fastReflection_PoCBatch) ProtoMethods() *protoiface.Methods {
 				} else {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
 				}
-			case 6:
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NodeId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MerkleRoot = append(x.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+				if x.MerkleRoot == nil {
+					x.MerkleRoot = []byte{}
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NonceCount", wireType)
+				}
+				x.NonceCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NonceCount |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FirstNonce", wireType)
+				}
+				x.FirstNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FirstNonce |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastNonce", wireType)
+				}
+				x.LastNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastNonce |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NonceStride", wireType)
+				}
+				x.NonceStride = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NonceStride |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PoCLeafDispute_6_list)(nil)
+
+type _PoCLeafDispute_6_list struct {
+	list *[][]byte
+}
+
+func (x *_PoCLeafDispute_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoCLeafDispute_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_PoCLeafDispute_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoCLeafDispute_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoCLeafDispute_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PoCLeafDispute at list field Proof as it is not of Message kind"))
+}
+
+func (x *_PoCLeafDispute_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoCLeafDispute_6_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_PoCLeafDispute_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PoCLeafDispute               protoreflect.MessageDescriptor
+	fd_PoCLeafDispute_batch_id      protoreflect.FieldDescriptor
+	fd_PoCLeafDispute_leaf_index    protoreflect.FieldDescriptor
+	fd_PoCLeafDispute_nonce         protoreflect.FieldDescriptor
+	fd_PoCLeafDispute_received_dist protoreflect.FieldDescriptor
+	fd_PoCLeafDispute_dist          protoreflect.FieldDescriptor
+	fd_PoCLeafDispute_proof         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_pocbatch_proto_init()
+	md_PoCLeafDispute = File_inference_inference_pocbatch_proto.Messages().ByName("PoCLeafDispute")
+	fd_PoCLeafDispute_batch_id = md_PoCLeafDispute.Fields().ByName("batch_id")
+	fd_PoCLeafDispute_leaf_index = md_PoCLeafDispute.Fields().ByName("leaf_index")
+	fd_PoCLeafDispute_nonce = md_PoCLeafDispute.Fields().ByName("nonce")
+	fd_PoCLeafDispute_received_dist = md_PoCLeafDispute.Fields().ByName("received_dist")
+	fd_PoCLeafDispute_dist = md_PoCLeafDispute.Fields().ByName("dist")
+	fd_PoCLeafDispute_proof = md_PoCLeafDispute.Fields().ByName("proof")
+}
+
+var _ protoreflect.Message = (*fastReflection_PoCLeafDispute)(nil)
+
+type fastReflection_PoCLeafDispute PoCLeafDispute
+
+func (x *PoCLeafDispute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PoCLeafDispute)(x)
+}
+
+func (x *PoCLeafDispute) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_pocbatch_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PoCLeafDispute_messageType fastReflection_PoCLeafDispute_messageType
+var _ protoreflect.MessageType = fastReflection_PoCLeafDispute_messageType{}
+
+type fastReflection_PoCLeafDispute_messageType struct{}
+
+func (x fastReflection_PoCLeafDispute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PoCLeafDispute)(nil)
+}
+func (x fastReflection_PoCLeafDispute_messageType) New() protoreflect.Message {
+	return new(fastReflection_PoCLeafDispute)
+}
+func (x fastReflection_PoCLeafDispute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoCLeafDispute
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PoCLeafDispute) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoCLeafDispute
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PoCLeafDispute) Type() protoreflect.MessageType {
+	return _fastReflection_PoCLeafDispute_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PoCLeafDispute) New() protoreflect.Message {
+	return new(fastReflection_PoCLeafDispute)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PoCLeafDispute) Interface() protoreflect.ProtoMessage {
+	return (*PoCLeafDispute)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PoCLeafDispute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BatchId != "" {
+		value := protoreflect.ValueOfString(x.BatchId)
+		if !f(fd_PoCLeafDispute_batch_id, value) {
+			return
+		}
+	}
+	if x.LeafIndex != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.LeafIndex)
+		if !f(fd_PoCLeafDispute_leaf_index, value) {
+			return
+		}
+	}
+	if x.Nonce != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Nonce)
+		if !f(fd_PoCLeafDispute_nonce, value) {
+			return
+		}
+	}
+	if x.ReceivedDist != float64(0) || math.Signbit(x.ReceivedDist) {
+		value := protoreflect.ValueOfFloat64(x.ReceivedDist)
+		if !f(fd_PoCLeafDispute_received_dist, value) {
+			return
+		}
+	}
+	if x.Dist != float64(0) || math.Signbit(x.Dist) {
+		value := protoreflect.ValueOfFloat64(x.Dist)
+		if !f(fd_PoCLeafDispute_dist, value) {
+			return
+		}
+	}
+	if len(x.Proof) != 0 {
+		value := protoreflect.ValueOfList(&_PoCLeafDispute_6_list{list: &x.Proof})
+		if !f(fd_PoCLeafDispute_proof, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PoCLeafDispute) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.PoCLeafDispute.batch_id":
+		return x.BatchId != ""
+	case "inference.inference.PoCLeafDispute.leaf_index":
+		return x.LeafIndex != uint32(0)
+	case "inference.inference.PoCLeafDispute.nonce":
+		return x.Nonce != int64(0)
+	case "inference.inference.PoCLeafDispute.received_dist":
+		return x.ReceivedDist != float64(0) || math.Signbit(x.ReceivedDist)
+	case "inference.inference.PoCLeafDispute.dist":
+		return x.Dist != float64(0) || math.Signbit(x.Dist)
+	case "inference.inference.PoCLeafDispute.proof":
+		return len(x.Proof) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCLeafDispute"))
+		}
+		panic(fmt.Errorf("message inference.inference.PoCLeafDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoCLeafDispute) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.PoCLeafDispute.batch_id":
+		x.BatchId = ""
+	case "inference.inference.PoCLeafDispute.leaf_index":
+		x.LeafIndex = uint32(0)
+	case "inference.inference.PoCLeafDispute.nonce":
+		x.Nonce = int64(0)
+	case "inference.inference.PoCLeafDispute.received_dist":
+		x.ReceivedDist = float64(0)
+	case "inference.inference.PoCLeafDispute.dist":
+		x.Dist = float64(0)
+	case "inference.inference.PoCLeafDispute.proof":
+		x.Proof = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCLeafDispute"))
+		}
+		panic(fmt.Errorf("message inference.inference.PoCLeafDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PoCLeafDispute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.PoCLeafDispute.batch_id":
+		value := x.BatchId
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.PoCLeafDispute.leaf_index":
+		value := x.LeafIndex
+		return protoreflect.ValueOfUint32(value)
+	case "inference.inference.PoCLeafDispute.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.PoCLeafDispute.received_dist":
+		value := x.ReceivedDist
+		return protoreflect.ValueOfFloat64(value)
+	case "inference.inference.PoCLeafDispute.dist":
+		value := x.Dist
+		return protoreflect.ValueOfFloat64(value)
+	case "inference.inference.PoCLeafDispute.proof":
+		if len(x.Proof) == 0 {
+			return protoreflect.ValueOfList(&_PoCLeafDispute_6_list{})
+		}
+		listValue := &_PoCLeafDispute_6_list{list: &x.Proof}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCLeafDispute"))
+		}
+		panic(fmt.Errorf("message inference.inference.PoCLeafDispute does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoCLeafDispute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.PoCLeafDispute.batch_id":
+		x.BatchId = value.Interface().(string)
+	case "inference.inference.PoCLeafDispute.leaf_index":
+		x.LeafIndex = uint32(value.Uint())
+	case "inference.inference.PoCLeafDispute.nonce":
+		x.Nonce = value.Int()
+	case "inference.inference.PoCLeafDispute.received_dist":
+		x.ReceivedDist = value.Float()
+	case "inference.inference.PoCLeafDispute.dist":
+		x.Dist = value.Float()
+	case "inference.inference.PoCLeafDispute.proof":
+		lv := value.List()
+		clv := lv.(*_PoCLeafDispute_6_list)
+		x.Proof = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCLeafDispute"))
+		}
+		panic(fmt.Errorf("message inference.inference.PoCLeafDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoCLeafDispute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.PoCLeafDispute.proof":
+		if x.Proof == nil {
+			x.Proof = [][]byte{}
+		}
+		value := &_PoCLeafDispute_6_list{list: &x.Proof}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.PoCLeafDispute.batch_id":
+		panic(fmt.Errorf("field batch_id of message inference.inference.PoCLeafDispute is not mutable"))
+	case "inference.inference.PoCLeafDispute.leaf_index":
+		panic(fmt.Errorf("field leaf_index of message inference.inference.PoCLeafDispute is not mutable"))
+	case "inference.inference.PoCLeafDispute.nonce":
+		panic(fmt.Errorf("field nonce of message inference.inference.PoCLeafDispute is not mutable"))
+	case "inference.inference.PoCLeafDispute.received_dist":
+		panic(fmt.Errorf("field received_dist of message inference.inference.PoCLeafDispute is not mutable"))
+	case "inference.inference.PoCLeafDispute.dist":
+		panic(fmt.Errorf("field dist of message inference.inference.PoCLeafDispute is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCLeafDispute"))
+		}
+		panic(fmt.Errorf("message inference.inference.PoCLeafDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PoCLeafDispute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.PoCLeafDispute.batch_id":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.PoCLeafDispute.leaf_index":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "inference.inference.PoCLeafDispute.nonce":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.PoCLeafDispute.received_dist":
+		return protoreflect.ValueOfFloat64(float64(0))
+	case "inference.inference.PoCLeafDispute.dist":
+		return protoreflect.ValueOfFloat64(float64(0))
+	case "inference.inference.PoCLeafDispute.proof":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_PoCLeafDispute_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCLeafDispute"))
+		}
+		panic(fmt.Errorf("message inference.inference.PoCLeafDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PoCLeafDispute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.PoCLeafDispute", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PoCLeafDispute) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoCLeafDispute) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PoCLeafDispute) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PoCLeafDispute) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PoCLeafDispute)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BatchId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LeafIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.LeafIndex))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.ReceivedDist != 0 || math.Signbit(x.ReceivedDist) {
+			n += 9
+		}
+		if x.Dist != 0 || math.Signbit(x.Dist) {
+			n += 9
+		}
+		if len(x.Proof) > 0 {
+			for _, b := range x.Proof {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PoCLeafDispute)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Proof) > 0 {
+			for iNdEx := len(x.Proof) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Proof[iNdEx])
+				copy(dAtA[i:], x.Proof[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proof[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.Dist != 0 || math.Signbit(x.Dist) {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.Dist))))
+			i--
+			dAtA[i] = 0x29
+		}
+		if x.ReceivedDist != 0 || math.Signbit(x.ReceivedDist) {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.ReceivedDist))))
+			i--
+			dAtA[i] = 0x21
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.LeafIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeafIndex))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.BatchId) > 0 {
+			i -= len(x.BatchId)
+			copy(dAtA[i:], x.BatchId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PoCLeafDispute)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoCLeafDispute: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoCLeafDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
 				}
@@ -916,11 +1837,71 @@ func (x *fastReflection_PoCBatch) ProtoMethods() *protoiface.Methods {
 				}
 				x.BatchId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
+				}
+				x.LeafIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LeafIndex |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 1 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceivedDist", wireType)
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				x.ReceivedDist = float64(math.Float64frombits(v))
+			case 5:
+				if wireType != 1 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				x.Dist = float64(math.Float64frombits(v))
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -930,23 +1911,23 @@ func (x *fastReflection_PoCBatch) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NodeId = string(dAtA[iNdEx:postIndex])
+				x.Proof = append(x.Proof, make([]byte, postIndex-iNdEx))
+				copy(x.Proof[len(x.Proof)-1], dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1121,6 +2102,57 @@ func (x *_PoCValidation_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_PoCValidation_13_list)(nil)
+
+type _PoCValidation_13_list struct {
+	list *[]*PoCLeafDispute
+}
+
+func (x *_PoCValidation_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoCValidation_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PoCValidation_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoCLeafDispute)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoCValidation_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoCLeafDispute)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoCValidation_13_list) AppendMutable() protoreflect.Value {
+	v := new(PoCLeafDispute)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoCValidation_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoCValidation_13_list) NewElement() protoreflect.Value {
+	v := new(PoCLeafDispute)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoCValidation_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_PoCValidation                               protoreflect.MessageDescriptor
 	fd_PoCValidation_participant_address           protoreflect.FieldDescriptor
@@ -1135,6 +2167,7 @@ var (
 	fd_PoCValidation_n_invalid                     protoreflect.FieldDescriptor
 	fd_PoCValidation_probability_honest            protoreflect.FieldDescriptor
 	fd_PoCValidation_fraud_detected                protoreflect.FieldDescriptor
+	fd_PoCValidation_disputes                      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1152,6 +2185,7 @@ func init() {
 	fd_PoCValidation_n_invalid = md_PoCValidation.Fields().ByName("n_invalid")
 	fd_PoCValidation_probability_honest = md_PoCValidation.Fields().ByName("probability_honest")
 	fd_PoCValidation_fraud_detected = md_PoCValidation.Fields().ByName("fraud_detected")
+	fd_PoCValidation_disputes = md_PoCValidation.Fields().ByName("disputes")
 }
 
 var _ protoreflect.Message = (*fastReflection_PoCValidation)(nil)
@@ -1163,7 +2197,7 @@ func (x *PoCValidation) ProtoReflect() protoreflect.Message {
 }
 
 func (x *PoCValidation) slowProtoReflect() protoreflect.Message {
-	mi := &file_inference_inference_pocbatch_proto_msgTypes[1]
+	mi := &file_inference_inference_pocbatch_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1291,6 +2325,12 @@ func (x *fastReflection_PoCValidation) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if len(x.Disputes) != 0 {
+		value := protoreflect.ValueOfList(&_PoCValidation_13_list{list: &x.Disputes})
+		if !f(fd_PoCValidation_disputes, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1330,6 +2370,8 @@ func (x *fastReflection_PoCValidation) Has(fd protoreflect.FieldDescriptor) bool
 		return x.ProbabilityHonest != float64(0) || math.Signbit(x.ProbabilityHonest)
 	case "inference.inference.PoCValidation.fraud_detected":
 		return x.FraudDetected != false
+	case "inference.inference.PoCValidation.disputes":
+		return len(x.Disputes) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCValidation"))
@@ -1370,6 +2412,8 @@ func (x *fastReflection_PoCValidation) Clear(fd protoreflect.FieldDescriptor) {
 		x.ProbabilityHonest = float64(0)
 	case "inference.inference.PoCValidation.fraud_detected":
 		x.FraudDetected = false
+	case "inference.inference.PoCValidation.disputes":
+		x.Disputes = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCValidation"))
@@ -1431,6 +2475,12 @@ func (x *fastReflection_PoCValidation) Get(descriptor protoreflect.FieldDescript
 	case "inference.inference.PoCValidation.fraud_detected":
 		value := x.FraudDetected
 		return protoreflect.ValueOfBool(value)
+	case "inference.inference.PoCValidation.disputes":
+		if len(x.Disputes) == 0 {
+			return protoreflect.ValueOfList(&_PoCValidation_13_list{})
+		}
+		listValue := &_PoCValidation_13_list{list: &x.Disputes}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCValidation"))
@@ -1481,6 +2531,10 @@ func (x *fastReflection_PoCValidation) Set(fd protoreflect.FieldDescriptor, valu
 		x.ProbabilityHonest = value.Float()
 	case "inference.inference.PoCValidation.fraud_detected":
 		x.FraudDetected = value.Bool()
+	case "inference.inference.PoCValidation.disputes":
+		lv := value.List()
+		clv := lv.(*_PoCValidation_13_list)
+		x.Disputes = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCValidation"))
@@ -1519,6 +2573,12 @@ func (x *fastReflection_PoCValidation) Mutable(fd protoreflect.FieldDescriptor)
 		}
 		value := &_PoCValidation_7_list{list: &x.ReceivedDist}
 		return protoreflect.ValueOfList(value)
+	case "inference.inference.PoCValidation.disputes":
+		if x.Disputes == nil {
+			x.Disputes = []*PoCLeafDispute{}
+		}
+		value := &_PoCValidation_13_list{list: &x.Disputes}
+		return protoreflect.ValueOfList(value)
 	case "inference.inference.PoCValidation.participant_address":
 		panic(fmt.Errorf("field participant_address of message inference.inference.PoCValidation is not mutable"))
 	case "inference.inference.PoCValidation.validator_participant_address":
@@ -1577,6 +2637,9 @@ func (x *fastReflection_PoCValidation) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfFloat64(float64(0))
 	case "inference.inference.PoCValidation.fraud_detected":
 		return protoreflect.ValueOfBool(false)
+	case "inference.inference.PoCValidation.disputes":
+		list := []*PoCLeafDispute{}
+		return protoreflect.ValueOfList(&_PoCValidation_13_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PoCValidation"))
@@ -1688,6 +2751,12 @@ func (x *fastReflection_PoCValidation) ProtoMethods() *protoiface.Methods {
 		if x.FraudDetected {
 			n += 2
 		}
+		if len(x.Disputes) > 0 {
+			for _, e := range x.Disputes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1717,6 +2786,22 @@ func (x *fastReflection_PoCValidation) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Disputes) > 0 {
+			for iNdEx := len(x.Disputes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Disputes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
 		if x.FraudDetected {
 			i--
 			if x.FraudDetected {
@@ -2222,6 +3307,40 @@ func (x *fastReflection_PoCValidation) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.FraudDetected = bool(v != 0)
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Disputes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Disputes = append(x.Disputes, &PoCLeafDispute{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Disputes[len(x.Disputes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2282,6 +3401,15 @@ type PoCBatch struct {
 	Dist                     []float64 `protobuf:"fixed64,5,rep,packed,name=dist,proto3" json:"dist,omitempty"`
 	BatchId                  string    `protobuf:"bytes,6,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
 	NodeId                   string    `protobuf:"bytes,7,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// merkle_root and nonce_count replace nonces and dist for batches submitted as commitments, the full batch
+	// is served by the participant's API node
+	MerkleRoot []byte `protobuf:"bytes,8,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	NonceCount uint32 `protobuf:"varint,9,opt,name=nonce_count,json=nonceCount,proto3" json:"nonce_count,omitempty"`
+	// The committed leaves are sorted by nonce, strictly increasing from first_nonce to last_nonce and all congruent
+	// to first_nonce modulo nonce_stride (0 for a single leaf). The root binds all three.
+	FirstNonce  int64 `protobuf:"varint,10,opt,name=first_nonce,json=firstNonce,proto3" json:"first_nonce,omitempty"`
+	LastNonce   int64 `protobuf:"varint,11,opt,name=last_nonce,json=lastNonce,proto3" json:"last_nonce,omitempty"`
+	NonceStride int64 `protobuf:"varint,12,opt,name=nonce_stride,json=nonceStride,proto3" json:"nonce_stride,omitempty"`
 }
 
 func (x *PoCBatch) Reset() {
@@ -2353,30 +3481,144 @@ func (x *PoCBatch) GetNodeId() string {
 	return ""
 }
 
+func (x *PoCBatch) GetMerkleRoot() []byte {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return nil
+}
+
+func (x *PoCBatch) GetNonceCount() uint32 {
+	if x != nil {
+		return x.NonceCount
+	}
+	return 0
+}
+
+func (x *PoCBatch) GetFirstNonce() int64 {
+	if x != nil {
+		return x.FirstNonce
+	}
+	return 0
+}
+
+func (x *PoCBatch) GetLastNonce() int64 {
+	if x != nil {
+		return x.LastNonce
+	}
+	return 0
+}
+
+func (x *PoCBatch) GetNonceStride() int64 {
+	if x != nil {
+		return x.NonceStride
+	}
+	return 0
+}
+
+// PoCLeafDispute is a leaf of a committed batch that failed validation, with the proof that the participant
+// committed to it
+type PoCLeafDispute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchId   string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	LeafIndex uint32 `protobuf:"varint,2,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	Nonce     int64  `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// received_dist is the distance the participant committed to, dist the one the validator computed
+	ReceivedDist float64  `protobuf:"fixed64,4,opt,name=received_dist,json=receivedDist,proto3" json:"received_dist,omitempty"`
+	Dist         float64  `protobuf:"fixed64,5,opt,name=dist,proto3" json:"dist,omitempty"`
+	Proof        [][]byte `protobuf:"bytes,6,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *PoCLeafDispute) Reset() {
+	*x = PoCLeafDispute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_pocbatch_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoCLeafDispute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoCLeafDispute) ProtoMessage() {}
+
+// Deprecated: Use PoCLeafDispute.ProtoReflect.Descriptor instead.
+func (*PoCLeafDispute) Descriptor() ([]byte, []int) {
+	return file_inference_inference_pocbatch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PoCLeafDispute) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *PoCLeafDispute) GetLeafIndex() uint32 {
+	if x != nil {
+		return x.LeafIndex
+	}
+	return 0
+}
+
+func (x *PoCLeafDispute) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *PoCLeafDispute) GetReceivedDist() float64 {
+	if x != nil {
+		return x.ReceivedDist
+	}
+	return 0
+}
+
+func (x *PoCLeafDispute) GetDist() float64 {
+	if x != nil {
+		return x.Dist
+	}
+	return 0
+}
+
+func (x *PoCLeafDispute) GetProof() [][]byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
 // ignite scaffold message SubmitPocValidation participant_address poc_stage_start_block_height:int nonces:array.int dist:array.int received_dist:array.int r_target:int fraud_threshold:int n_invalid:int probability_honest:int fraud_detected:bool
 type PoCValidation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ParticipantAddress          string    `protobuf:"bytes,1,opt,name=participant_address,json=participantAddress,proto3" json:"participant_address,omitempty"`
-	ValidatorParticipantAddress string    `protobuf:"bytes,2,opt,name=validator_participant_address,json=validatorParticipantAddress,proto3" json:"validator_participant_address,omitempty"`
-	PocStageStartBlockHeight    int64     `protobuf:"varint,3,opt,name=poc_stage_start_block_height,json=pocStageStartBlockHeight,proto3" json:"poc_stage_start_block_height,omitempty"`
-	ValidatedAtBlockHeight      int64     `protobuf:"varint,4,opt,name=validated_at_block_height,json=validatedAtBlockHeight,proto3" json:"validated_at_block_height,omitempty"`
-	Nonces                      []int64   `protobuf:"varint,5,rep,packed,name=nonces,proto3" json:"nonces,omitempty"`
-	Dist                        []float64 `protobuf:"fixed64,6,rep,packed,name=dist,proto3" json:"dist,omitempty"`
-	ReceivedDist                []float64 `protobuf:"fixed64,7,rep,packed,name=received_dist,json=receivedDist,proto3" json:"received_dist,omitempty"`
-	RTarget                     float64   `protobuf:"fixed64,8,opt,name=r_target,json=rTarget,proto3" json:"r_target,omitempty"`
-	FraudThreshold              float64   `protobuf:"fixed64,9,opt,name=fraud_threshold,json=fraudThreshold,proto3" json:"fraud_threshold,omitempty"`
-	NInvalid                    int64     `protobuf:"varint,10,opt,name=n_invalid,json=nInvalid,proto3" json:"n_invalid,omitempty"`
-	ProbabilityHonest           float64   `protobuf:"fixed64,11,opt,name=probability_honest,json=probabilityHonest,proto3" json:"probability_honest,omitempty"`
-	FraudDetected               bool      `protobuf:"varint,12,opt,name=fraud_detected,json=fraudDetected,proto3" json:"fraud_detected,omitempty"`
+	ParticipantAddress          string            `protobuf:"bytes,1,opt,name=participant_address,json=participantAddress,proto3" json:"participant_address,omitempty"`
+	ValidatorParticipantAddress string            `protobuf:"bytes,2,opt,name=validator_participant_address,json=validatorParticipantAddress,proto3" json:"validator_participant_address,omitempty"`
+	PocStageStartBlockHeight    int64             `protobuf:"varint,3,opt,name=poc_stage_start_block_height,json=pocStageStartBlockHeight,proto3" json:"poc_stage_start_block_height,omitempty"`
+	ValidatedAtBlockHeight      int64             `protobuf:"varint,4,opt,name=validated_at_block_height,json=validatedAtBlockHeight,proto3" json:"validated_at_block_height,omitempty"`
+	Nonces                      []int64           `protobuf:"varint,5,rep,packed,name=nonces,proto3" json:"nonces,omitempty"`
+	Dist                        []float64         `protobuf:"fixed64,6,rep,packed,name=dist,proto3" json:"dist,omitempty"`
+	ReceivedDist                []float64         `protobuf:"fixed64,7,rep,packed,name=received_dist,json=receivedDist,proto3" json:"received_dist,omitempty"`
+	RTarget                     float64           `protobuf:"fixed64,8,opt,name=r_target,json=rTarget,proto3" json:"r_target,omitempty"`
+	FraudThreshold              float64           `protobuf:"fixed64,9,opt,name=fraud_threshold,json=fraudThreshold,proto3" json:"fraud_threshold,omitempty"`
+	NInvalid                    int64             `protobuf:"varint,10,opt,name=n_invalid,json=nInvalid,proto3" json:"n_invalid,omitempty"`
+	ProbabilityHonest           float64           `protobuf:"fixed64,11,opt,name=probability_honest,json=probabilityHonest,proto3" json:"probability_honest,omitempty"`
+	FraudDetected               bool              `protobuf:"varint,12,opt,name=fraud_detected,json=fraudDetected,proto3" json:"fraud_detected,omitempty"`
+	Disputes                    []*PoCLeafDispute `protobuf:"bytes,13,rep,name=disputes,proto3" json:"disputes,omitempty"`
 }
 
 func (x *PoCValidation) Reset() {
 	*x = PoCValidation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_pocbatch_proto_msgTypes[1]
+		mi := &file_inference_inference_pocbatch_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2390,7 +3632,7 @@ func (*PoCValidation) ProtoMessage() {}
 
 // Deprecated: Use PoCValidation.ProtoReflect.Descriptor instead.
 func (*PoCValidation) Descriptor() ([]byte, []int) {
-	return file_inference_inference_pocbatch_proto_rawDescGZIP(), []int{1}
+	return file_inference_inference_pocbatch_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *PoCValidation) GetParticipantAddress() string {
@@ -2477,75 +3719,110 @@ func (x *PoCValidation) GetFraudDetected() bool {
 	return false
 }
 
+func (x *PoCValidation) GetDisputes() []*PoCLeafDispute {
+	if x != nil {
+		return x.Disputes
+	}
+	return nil
+}
+
 var File_inference_inference_pocbatch_proto protoreflect.FileDescriptor
 
 var file_inference_inference_pocbatch_proto_rawDesc = []byte{
 	0x0a, 0x22, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
 	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x70, 0x6f, 0x63, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
-	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x94, 0x02, 0x0a, 0x08, 0x50, 0x6f,
-	0x43, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x1c, 0x70, 0x6f, 0x63, 0x5f, 0x73,
-	0x74, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x70,
-	0x6f, 0x63, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03,
-	0x52, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x74,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x01, 0x52, 0x04, 0x64, 0x69, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
-	0x22, 0x87, 0x04, 0x0a, 0x0d, 0x50, 0x6f, 0x43, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x1d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x1c, 0x70, 0x6f, 0x63, 0x5f, 0x73,
-	0x74, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x70,
-	0x6f, 0x63, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69,
-	0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x01, 0x52, 0x04, 0x64, 0x69, 0x73, 0x74, 0x12, 0x23,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xb9, 0x03, 0x0a, 0x08, 0x50, 0x6f, 0x43, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2f, 0x0a, 0x13,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a,
+	0x1c, 0x70, 0x6f, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x18, 0x70, 0x6f, 0x63, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a,
+	0x18, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x15, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x01, 0x52, 0x04, 0x64, 0x69,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x72, 0x69, 0x64, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x0e,
+	0x50, 0x6f, 0x43, 0x4c, 0x65, 0x61, 0x66, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x61,
+	0x66, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c,
+	0x65, 0x61, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x23,
 	0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x44,
-	0x69, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x27,
-	0x0a, 0x0f, 0x66, 0x72, 0x61, 0x75, 0x64, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
-	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x66, 0x72, 0x61, 0x75, 0x64, 0x54, 0x68,
-	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x5f, 0x69, 0x6e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x49, 0x6e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x5f, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x11, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x6f, 0x6e,
-	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72, 0x61, 0x75, 0x64, 0x5f, 0x64, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x72, 0x61,
-	0x75, 0x64, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0xbb, 0x01, 0x0a, 0x17, 0x63,
-	0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0d, 0x50, 0x6f, 0x63, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03,
-	0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
-	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2,
-	0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x44,
+	0x69, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x04, 0x64, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xce, 0x04,
+	0x0a, 0x0d, 0x50, 0x6f, 0x43, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2f, 0x0a, 0x13, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x42, 0x0a, 0x1d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x1c, 0x70, 0x6f, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x70, 0x6f, 0x63, 0x53,
+	0x74, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x74, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x01, 0x52, 0x04, 0x64, 0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x44, 0x69, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x66,
+	0x72, 0x61, 0x75, 0x64, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x66, 0x72, 0x61, 0x75, 0x64, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x5f, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70,
+	0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x6f, 0x6e, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72, 0x61, 0x75, 0x64, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x72, 0x61, 0x75, 0x64, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x50, 0x6f, 0x43, 0x4c, 0x65, 0x61, 0x66, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x73, 0x42, 0xbb,
+	0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0d, 0x50, 0x6f, 0x63, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2560,17 +3837,19 @@ func file_inference_inference_pocbatch_proto_rawDescGZIP() []byte {
 	return file_inference_inference_pocbatch_proto_rawDescData
 }
 
-var file_inference_inference_pocbatch_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_inference_inference_pocbatch_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_inference_inference_pocbatch_proto_goTypes = []interface{}{
-	(*PoCBatch)(nil),      // 0: inference.inference.PoCBatch
-	(*PoCValidation)(nil), // 1: inference.inference.PoCValidation
+	(*PoCBatch)(nil),       // 0: inference.inference.PoCBatch
+	(*PoCLeafDispute)(nil), // 1: inference.inference.PoCLeafDispute
+	(*PoCValidation)(nil),  // 2: inference.inference.PoCValidation
 }
 var file_inference_inference_pocbatch_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: inference.inference.PoCValidation.disputes:type_name -> inference.inference.PoCLeafDispute
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_inference_inference_pocbatch_proto_init() }
@@ -2592,6 +3871,18 @@ func file_inference_inference_pocbatch_proto_init() {
 			}
 		}
 		file_inference_inference_pocbatch_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoCLeafDispute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_pocbatch_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PoCValidation); i {
 			case 0:
 				return &v.state
@@ -2610,7 +3901,7 @@ func file_inference_inference_pocbatch_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_inference_inference_pocbatch_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -70,6 +70,8 @@ const (
 	Query_PartialUpgradeAll_FullMethodName                         = "/inference.inference.Query/PartialUpgradeAll"
 	Query_BridgeTransaction_FullMethodName                         = "/inference.inference.Query/BridgeTransaction"
 	Query_BridgeTransactions_FullMethodName                        = "/inference.inference.Query/BridgeTransactions"
+	Query_BridgeBlockAttestations_FullMethodName                   = "/inference.inference.Query/BridgeBlockAttestations"
+	Query_BridgeCapacity_FullMethodName                            = "/inference.inference.Query/BridgeCapacity"
 	Query_BridgeAddressesByChain_FullMethodName                    = "/inference.inference.Query/BridgeAddressesByChain"
 	Query_LiquidityPool_FullMethodName                             = "/inference.inference.Query/LiquidityPool"
 	Query_WrappedTokenBalances_FullMethodName                      = "/inference.inference.Query/WrappedTokenBalances"
@@ -92,6 +94,13 @@ const (
 	Query_ParticipantAllowList_FullMethodName                      = "/inference.inference.Query/ParticipantAllowList"
 	Query_ExcludedParticipants_FullMethodName                      = "/inference.inference.Query/ExcludedParticipants"
 	Query_ActiveConfirmationPoCEvent_FullMethodName                = "/inference.inference.Query/ActiveConfirmationPoCEvent"
+	Query_InvalidationAppeal_FullMethodName                        = "/inference.inference.Query/InvalidationAppeal"
+	Query_InvalidationAppealAll_FullMethodName                     = "/inference.inference.Query/InvalidationAppealAll"
+	Query_ParticipantStatusExplanation_FullMethodName              = "/inference.inference.Query/ParticipantStatusExplanation"
+	Query_ResolveModel_FullMethodName                              = "/inference.inference.Query/ResolveModel"
+	Query_ModelAliasAll_FullMethodName                             = "/inference.inference.Query/ModelAliasAll"
+	Query_TokenomicsProjection_FullMethodName                      = "/inference.inference.Query/TokenomicsProjection"
+	Query_ParamsDryRun_FullMethodName                              = "/inference.inference.Query/ParamsDryRun"
 )
 
 // QueryClient is the client API for Query service.
@@ -184,6 +193,10 @@ type QueryClient interface {
 	BridgeTransaction(ctx context.Context, in *QueryGetBridgeTransactionRequest, opts ...grpc.CallOption) (*QueryGetBridgeTransactionResponse, error)
 	// Queries all bridge transactions
 	BridgeTransactions(ctx context.Context, in *QueryAllBridgeTransactionsRequest, opts ...grpc.CallOption) (*QueryAllBridgeTransactionsResponse, error)
+	// Queries the receipts root attestations for an origin chain block
+	BridgeBlockAttestations(ctx context.Context, in *QueryBridgeBlockAttestationsRequest, opts ...grpc.CallOption) (*QueryBridgeBlockAttestationsResponse, error)
+	// Queries the circuit breaker, remaining rate limit capacity and held deposits of an origin chain
+	BridgeCapacity(ctx context.Context, in *QueryBridgeCapacityRequest, opts ...grpc.CallOption) (*QueryBridgeCapacityResponse, error)
 	// Queries bridge addresses by chain
 	BridgeAddressesByChain(ctx context.Context, in *QueryBridgeAddressesByChainRequest, opts ...grpc.CallOption) (*QueryBridgeAddressesByChainResponse, error)
 	// Queries the singleton liquidity pool
@@ -225,6 +238,21 @@ type QueryClient interface {
 	ExcludedParticipants(ctx context.Context, in *QueryExcludedParticipantsRequest, opts ...grpc.CallOption) (*QueryExcludedParticipantsResponse, error)
 	// Queries the currently active confirmation PoC event.
 	ActiveConfirmationPoCEvent(ctx context.Context, in *QueryActiveConfirmationPoCEventRequest, opts ...grpc.CallOption) (*QueryActiveConfirmationPoCEventResponse, error)
+	// Queries an appeal of an invalidated inference.
+	InvalidationAppeal(ctx context.Context, in *QueryGetInvalidationAppealRequest, opts ...grpc.CallOption) (*QueryGetInvalidationAppealResponse, error)
+	InvalidationAppealAll(ctx context.Context, in *QueryAllInvalidationAppealRequest, opts ...grpc.CallOption) (*QueryAllInvalidationAppealResponse, error)
+	// Explains how a participant's status and reputation were derived for an epoch.
+	ParticipantStatusExplanation(ctx context.Context, in *QueryParticipantStatusExplanationRequest, opts ...grpc.CallOption) (*QueryParticipantStatusExplanationResponse, error)
+	// Resolves a model id or alias to the concrete governance model.
+	ResolveModel(ctx context.Context, in *QueryResolveModelRequest, opts ...grpc.CallOption) (*QueryResolveModelResponse, error)
+	// Queries all model aliases.
+	ModelAliasAll(ctx context.Context, in *QueryAllModelAliasRequest, opts ...grpc.CallOption) (*QueryAllModelAliasResponse, error)
+	// Projects reward issuance, per-participant rewards and the supply curve for the next epochs,
+	// optionally under hypothetical params and compared with an alternative params set.
+	TokenomicsProjection(ctx context.Context, in *QueryTokenomicsProjectionRequest, opts ...grpc.CallOption) (*QueryTokenomicsProjectionResponse, error)
+	// Validates proposed params, diffs them against the current params and simulates the next
+	// epoch transition under both, without changing state.
+	ParamsDryRun(ctx context.Context, in *QueryParamsDryRunRequest, opts ...grpc.CallOption) (*QueryParamsDryRunResponse, error)
 }
 
 type queryClient struct {
@@ -694,6 +722,24 @@ func (c *queryClient) BridgeTransactions(ctx context.Context, in *QueryAllBridge
 	return out, nil
 }
 
+func (c *queryClient) BridgeBlockAttestations(ctx context.Context, in *QueryBridgeBlockAttestationsRequest, opts ...grpc.CallOption) (*QueryBridgeBlockAttestationsResponse, error) {
+	out := new(QueryBridgeBlockAttestationsResponse)
+	err := c.cc.Invoke(ctx, Query_BridgeBlockAttestations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeCapacity(ctx context.Context, in *QueryBridgeCapacityRequest, opts ...grpc.CallOption) (*QueryBridgeCapacityResponse, error) {
+	out := new(QueryBridgeCapacityResponse)
+	err := c.cc.Invoke(ctx, Query_BridgeCapacity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BridgeAddressesByChain(ctx context.Context, in *QueryBridgeAddressesByChainRequest, opts ...grpc.CallOption) (*QueryBridgeAddressesByChainResponse, error) {
 	out := new(QueryBridgeAddressesByChainResponse)
 	err := c.cc.Invoke(ctx, Query_BridgeAddressesByChain_FullMethodName, in, out, opts...)
@@ -892,6 +938,69 @@ func (c *queryClient) ActiveConfirmationPoCEvent(ctx context.Context, in *QueryA
 	return out, nil
 }
 
+func (c *queryClient) InvalidationAppeal(ctx context.Context, in *QueryGetInvalidationAppealRequest, opts ...grpc.CallOption) (*QueryGetInvalidationAppealResponse, error) {
+	out := new(QueryGetInvalidationAppealResponse)
+	err := c.cc.Invoke(ctx, Query_InvalidationAppeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InvalidationAppealAll(ctx context.Context, in *QueryAllInvalidationAppealRequest, opts ...grpc.CallOption) (*QueryAllInvalidationAppealResponse, error) {
+	out := new(QueryAllInvalidationAppealResponse)
+	err := c.cc.Invoke(ctx, Query_InvalidationAppealAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipantStatusExplanation(ctx context.Context, in *QueryParticipantStatusExplanationRequest, opts ...grpc.CallOption) (*QueryParticipantStatusExplanationResponse, error) {
+	out := new(QueryParticipantStatusExplanationResponse)
+	err := c.cc.Invoke(ctx, Query_ParticipantStatusExplanation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResolveModel(ctx context.Context, in *QueryResolveModelRequest, opts ...grpc.CallOption) (*QueryResolveModelResponse, error) {
+	out := new(QueryResolveModelResponse)
+	err := c.cc.Invoke(ctx, Query_ResolveModel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModelAliasAll(ctx context.Context, in *QueryAllModelAliasRequest, opts ...grpc.CallOption) (*QueryAllModelAliasResponse, error) {
+	out := new(QueryAllModelAliasResponse)
+	err := c.cc.Invoke(ctx, Query_ModelAliasAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenomicsProjection(ctx context.Context, in *QueryTokenomicsProjectionRequest, opts ...grpc.CallOption) (*QueryTokenomicsProjectionResponse, error) {
+	out := new(QueryTokenomicsProjectionResponse)
+	err := c.cc.Invoke(ctx, Query_TokenomicsProjection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsDryRun(ctx context.Context, in *QueryParamsDryRunRequest, opts ...grpc.CallOption) (*QueryParamsDryRunResponse, error) {
+	out := new(QueryParamsDryRunResponse)
+	err := c.cc.Invoke(ctx, Query_ParamsDryRun_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -982,6 +1091,10 @@ type QueryServer interface {
 	BridgeTransaction(context.Context, *QueryGetBridgeTransactionRequest) (*QueryGetBridgeTransactionResponse, error)
 	// Queries all bridge transactions
 	BridgeTransactions(context.Context, *QueryAllBridgeTransactionsRequest) (*QueryAllBridgeTransactionsResponse, error)
+	// Queries the receipts root attestations for an origin chain block
+	BridgeBlockAttestations(context.Context, *QueryBridgeBlockAttestationsRequest) (*QueryBridgeBlockAttestationsResponse, error)
+	// Queries the circuit breaker, remaining rate limit capacity and held deposits of an origin chain
+	BridgeCapacity(context.Context, *QueryBridgeCapacityRequest) (*QueryBridgeCapacityResponse, error)
 	// Queries bridge addresses by chain
 	BridgeAddressesByChain(context.Context, *QueryBridgeAddressesByChainRequest) (*QueryBridgeAddressesByChainResponse, error)
 	// Queries the singleton liquidity pool
@@ -1023,6 +1136,21 @@ type QueryServer interface {
 	ExcludedParticipants(context.Context, *QueryExcludedParticipantsRequest) (*QueryExcludedParticipantsResponse, error)
 	// Queries the currently active confirmation PoC event.
 	ActiveConfirmationPoCEvent(context.Context, *QueryActiveConfirmationPoCEventRequest) (*QueryActiveConfirmationPoCEventResponse, error)
+	// Queries an appeal of an invalidated inference.
+	InvalidationAppeal(context.Context, *QueryGetInvalidationAppealRequest) (*QueryGetInvalidationAppealResponse, error)
+	InvalidationAppealAll(context.Context, *QueryAllInvalidationAppealRequest) (*QueryAllInvalidationAppealResponse, error)
+	// Explains how a participant's status and reputation were derived for an epoch.
+	ParticipantStatusExplanation(context.Context, *QueryParticipantStatusExplanationRequest) (*QueryParticipantStatusExplanationResponse, error)
+	// Resolves a model id or alias to the concrete governance model.
+	ResolveModel(context.Context, *QueryResolveModelRequest) (*QueryResolveModelResponse, error)
+	// Queries all model aliases.
+	ModelAliasAll(context.Context, *QueryAllModelAliasRequest) (*QueryAllModelAliasResponse, error)
+	// Projects reward issuance, per-participant rewards and the supply curve for the next epochs,
+	// optionally under hypothetical params and compared with an alternative params set.
+	TokenomicsProjection(context.Context, *QueryTokenomicsProjectionRequest) (*QueryTokenomicsProjectionResponse, error)
+	// Validates proposed params, diffs them against the current params and simulates the next
+	// epoch transition under both, without changing state.
+	ParamsDryRun(context.Context, *QueryParamsDryRunRequest) (*QueryParamsDryRunResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -1183,6 +1311,12 @@ func (UnimplementedQueryServer) BridgeTransaction(context.Context, *QueryGetBrid
 func (UnimplementedQueryServer) BridgeTransactions(context.Context, *QueryAllBridgeTransactionsRequest) (*QueryAllBridgeTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BridgeTransactions not implemented")
 }
+func (UnimplementedQueryServer) BridgeBlockAttestations(context.Context, *QueryBridgeBlockAttestationsRequest) (*QueryBridgeBlockAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeBlockAttestations not implemented")
+}
+func (UnimplementedQueryServer) BridgeCapacity(context.Context, *QueryBridgeCapacityRequest) (*QueryBridgeCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeCapacity not implemented")
+}
 func (UnimplementedQueryServer) BridgeAddressesByChain(context.Context, *QueryBridgeAddressesByChainRequest) (*QueryBridgeAddressesByChainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BridgeAddressesByChain not implemented")
 }
@@ -1249,6 +1383,27 @@ func (UnimplementedQueryServer) ExcludedParticipants(context.Context, *QueryExcl
 func (UnimplementedQueryServer) ActiveConfirmationPoCEvent(context.Context, *QueryActiveConfirmationPoCEventRequest) (*QueryActiveConfirmationPoCEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActiveConfirmationPoCEvent not implemented")
 }
+func (UnimplementedQueryServer) InvalidationAppeal(context.Context, *QueryGetInvalidationAppealRequest) (*QueryGetInvalidationAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidationAppeal not implemented")
+}
+func (UnimplementedQueryServer) InvalidationAppealAll(context.Context, *QueryAllInvalidationAppealRequest) (*QueryAllInvalidationAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidationAppealAll not implemented")
+}
+func (UnimplementedQueryServer) ParticipantStatusExplanation(context.Context, *QueryParticipantStatusExplanationRequest) (*QueryParticipantStatusExplanationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantStatusExplanation not implemented")
+}
+func (UnimplementedQueryServer) ResolveModel(context.Context, *QueryResolveModelRequest) (*QueryResolveModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveModel not implemented")
+}
+func (UnimplementedQueryServer) ModelAliasAll(context.Context, *QueryAllModelAliasRequest) (*QueryAllModelAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModelAliasAll not implemented")
+}
+func (UnimplementedQueryServer) TokenomicsProjection(context.Context, *QueryTokenomicsProjectionRequest) (*QueryTokenomicsProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenomicsProjection not implemented")
+}
+func (UnimplementedQueryServer) ParamsDryRun(context.Context, *QueryParamsDryRunRequest) (*QueryParamsDryRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsDryRun not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -2180,6 +2335,42 @@ func _Query_BridgeTransactions_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BridgeBlockAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeBlockAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeBlockAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BridgeBlockAttestations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeBlockAttestations(ctx, req.(*QueryBridgeBlockAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BridgeCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeCapacity(ctx, req.(*QueryBridgeCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BridgeAddressesByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBridgeAddressesByChainRequest)
 	if err := dec(in); err != nil {
@@ -2576,6 +2767,132 @@ func _Query_ActiveConfirmationPoCEvent_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InvalidationAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetInvalidationAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InvalidationAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InvalidationAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InvalidationAppeal(ctx, req.(*QueryGetInvalidationAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InvalidationAppealAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllInvalidationAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InvalidationAppealAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InvalidationAppealAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InvalidationAppealAll(ctx, req.(*QueryAllInvalidationAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipantStatusExplanation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantStatusExplanationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipantStatusExplanation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParticipantStatusExplanation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantStatusExplanation(ctx, req.(*QueryParticipantStatusExplanationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResolveModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResolveModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveModel(ctx, req.(*QueryResolveModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModelAliasAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllModelAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModelAliasAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ModelAliasAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModelAliasAll(ctx, req.(*QueryAllModelAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenomicsProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenomicsProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenomicsProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenomicsProjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenomicsProjection(ctx, req.(*QueryTokenomicsProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsDryRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsDryRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParamsDryRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsDryRun(ctx, req.(*QueryParamsDryRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2787,6 +3104,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BridgeTransactions",
 			Handler:    _Query_BridgeTransactions_Handler,
 		},
+		{
+			MethodName: "BridgeBlockAttestations",
+			Handler:    _Query_BridgeBlockAttestations_Handler,
+		},
+		{
+			MethodName: "BridgeCapacity",
+			Handler:    _Query_BridgeCapacity_Handler,
+		},
 		{
 			MethodName: "BridgeAddressesByChain",
 			Handler:    _Query_BridgeAddressesByChain_Handler,
@@ -2875,6 +3200,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ActiveConfirmationPoCEvent",
 			Handler:    _Query_ActiveConfirmationPoCEvent_Handler,
 		},
+		{
+			MethodName: "InvalidationAppeal",
+			Handler:    _Query_InvalidationAppeal_Handler,
+		},
+		{
+			MethodName: "InvalidationAppealAll",
+			Handler:    _Query_InvalidationAppealAll_Handler,
+		},
+		{
+			MethodName: "ParticipantStatusExplanation",
+			Handler:    _Query_ParticipantStatusExplanation_Handler,
+		},
+		{
+			MethodName: "ResolveModel",
+			Handler:    _Query_ResolveModel_Handler,
+		},
+		{
+			MethodName: "ModelAliasAll",
+			Handler:    _Query_ModelAliasAll_Handler,
+		},
+		{
+			MethodName: "TokenomicsProjection",
+			Handler:    _Query_TokenomicsProjection_Handler,
+		},
+		{
+			MethodName: "ParamsDryRun",
+			Handler:    _Query_ParamsDryRun_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/inference/query.proto",
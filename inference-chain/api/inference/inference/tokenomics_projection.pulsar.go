@@ -0,0 +1,2633 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package inference
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_EpochRewardProjection                    protoreflect.MessageDescriptor
+	fd_EpochRewardProjection_epoch_index        protoreflect.FieldDescriptor
+	fd_EpochRewardProjection_reward_amount      protoreflect.FieldDescriptor
+	fd_EpochRewardProjection_distributed_amount protoreflect.FieldDescriptor
+	fd_EpochRewardProjection_governance_amount  protoreflect.FieldDescriptor
+	fd_EpochRewardProjection_total_subsidy_paid protoreflect.FieldDescriptor
+	fd_EpochRewardProjection_subsidy_percentage protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_tokenomics_projection_proto_init()
+	md_EpochRewardProjection = File_inference_inference_tokenomics_projection_proto.Messages().ByName("EpochRewardProjection")
+	fd_EpochRewardProjection_epoch_index = md_EpochRewardProjection.Fields().ByName("epoch_index")
+	fd_EpochRewardProjection_reward_amount = md_EpochRewardProjection.Fields().ByName("reward_amount")
+	fd_EpochRewardProjection_distributed_amount = md_EpochRewardProjection.Fields().ByName("distributed_amount")
+	fd_EpochRewardProjection_governance_amount = md_EpochRewardProjection.Fields().ByName("governance_amount")
+	fd_EpochRewardProjection_total_subsidy_paid = md_EpochRewardProjection.Fields().ByName("total_subsidy_paid")
+	fd_EpochRewardProjection_subsidy_percentage = md_EpochRewardProjection.Fields().ByName("subsidy_percentage")
+}
+
+var _ protoreflect.Message = (*fastReflection_EpochRewardProjection)(nil)
+
+type fastReflection_EpochRewardProjection EpochRewardProjection
+
+func (x *EpochRewardProjection) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EpochRewardProjection)(x)
+}
+
+func (x *EpochRewardProjection) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_tokenomics_projection_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EpochRewardProjection_messageType fastReflection_EpochRewardProjection_messageType
+var _ protoreflect.MessageType = fastReflection_EpochRewardProjection_messageType{}
+
+type fastReflection_EpochRewardProjection_messageType struct{}
+
+func (x fastReflection_EpochRewardProjection_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EpochRewardProjection)(nil)
+}
+func (x fastReflection_EpochRewardProjection_messageType) New() protoreflect.Message {
+	return new(fastReflection_EpochRewardProjection)
+}
+func (x fastReflection_EpochRewardProjection_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochRewardProjection
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EpochRewardProjection) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochRewardProjection
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EpochRewardProjection) Type() protoreflect.MessageType {
+	return _fastReflection_EpochRewardProjection_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EpochRewardProjection) New() protoreflect.Message {
+	return new(fastReflection_EpochRewardProjection)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EpochRewardProjection) Interface() protoreflect.ProtoMessage {
+	return (*EpochRewardProjection)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EpochRewardProjection) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EpochIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochIndex)
+		if !f(fd_EpochRewardProjection_epoch_index, value) {
+			return
+		}
+	}
+	if x.RewardAmount != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RewardAmount)
+		if !f(fd_EpochRewardProjection_reward_amount, value) {
+			return
+		}
+	}
+	if x.DistributedAmount != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DistributedAmount)
+		if !f(fd_EpochRewardProjection_distributed_amount, value) {
+			return
+		}
+	}
+	if x.GovernanceAmount != int64(0) {
+		value := protoreflect.ValueOfInt64(x.GovernanceAmount)
+		if !f(fd_EpochRewardProjection_governance_amount, value) {
+			return
+		}
+	}
+	if x.TotalSubsidyPaid != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalSubsidyPaid)
+		if !f(fd_EpochRewardProjection_total_subsidy_paid, value) {
+			return
+		}
+	}
+	if x.SubsidyPercentage != nil {
+		value := protoreflect.ValueOfMessage(x.SubsidyPercentage.ProtoReflect())
+		if !f(fd_EpochRewardProjection_subsidy_percentage, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EpochRewardProjection) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.EpochRewardProjection.epoch_index":
+		return x.EpochIndex != uint64(0)
+	case "inference.inference.EpochRewardProjection.reward_amount":
+		return x.RewardAmount != int64(0)
+	case "inference.inference.EpochRewardProjection.distributed_amount":
+		return x.DistributedAmount != int64(0)
+	case "inference.inference.EpochRewardProjection.governance_amount":
+		return x.GovernanceAmount != int64(0)
+	case "inference.inference.EpochRewardProjection.total_subsidy_paid":
+		return x.TotalSubsidyPaid != int64(0)
+	case "inference.inference.EpochRewardProjection.subsidy_percentage":
+		return x.SubsidyPercentage != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.EpochRewardProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.EpochRewardProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochRewardProjection) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.EpochRewardProjection.epoch_index":
+		x.EpochIndex = uint64(0)
+	case "inference.inference.EpochRewardProjection.reward_amount":
+		x.RewardAmount = int64(0)
+	case "inference.inference.EpochRewardProjection.distributed_amount":
+		x.DistributedAmount = int64(0)
+	case "inference.inference.EpochRewardProjection.governance_amount":
+		x.GovernanceAmount = int64(0)
+	case "inference.inference.EpochRewardProjection.total_subsidy_paid":
+		x.TotalSubsidyPaid = int64(0)
+	case "inference.inference.EpochRewardProjection.subsidy_percentage":
+		x.SubsidyPercentage = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.EpochRewardProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.EpochRewardProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EpochRewardProjection) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.EpochRewardProjection.epoch_index":
+		value := x.EpochIndex
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.EpochRewardProjection.reward_amount":
+		value := x.RewardAmount
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.EpochRewardProjection.distributed_amount":
+		value := x.DistributedAmount
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.EpochRewardProjection.governance_amount":
+		value := x.GovernanceAmount
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.EpochRewardProjection.total_subsidy_paid":
+		value := x.TotalSubsidyPaid
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.EpochRewardProjection.subsidy_percentage":
+		value := x.SubsidyPercentage
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.EpochRewardProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.EpochRewardProjection does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochRewardProjection) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.EpochRewardProjection.epoch_index":
+		x.EpochIndex = value.Uint()
+	case "inference.inference.EpochRewardProjection.reward_amount":
+		x.RewardAmount = value.Int()
+	case "inference.inference.EpochRewardProjection.distributed_amount":
+		x.DistributedAmount = value.Int()
+	case "inference.inference.EpochRewardProjection.governance_amount":
+		x.GovernanceAmount = value.Int()
+	case "inference.inference.EpochRewardProjection.total_subsidy_paid":
+		x.TotalSubsidyPaid = value.Int()
+	case "inference.inference.EpochRewardProjection.subsidy_percentage":
+		x.SubsidyPercentage = value.Message().Interface().(*Decimal)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.EpochRewardProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.EpochRewardProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochRewardProjection) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.EpochRewardProjection.subsidy_percentage":
+		if x.SubsidyPercentage == nil {
+			x.SubsidyPercentage = new(Decimal)
+		}
+		return protoreflect.ValueOfMessage(x.SubsidyPercentage.ProtoReflect())
+	case "inference.inference.EpochRewardProjection.epoch_index":
+		panic(fmt.Errorf("field epoch_index of message inference.inference.EpochRewardProjection is not mutable"))
+	case "inference.inference.EpochRewardProjection.reward_amount":
+		panic(fmt.Errorf("field reward_amount of message inference.inference.EpochRewardProjection is not mutable"))
+	case "inference.inference.EpochRewardProjection.distributed_amount":
+		panic(fmt.Errorf("field distributed_amount of message inference.inference.EpochRewardProjection is not mutable"))
+	case "inference.inference.EpochRewardProjection.governance_amount":
+		panic(fmt.Errorf("field governance_amount of message inference.inference.EpochRewardProjection is not mutable"))
+	case "inference.inference.EpochRewardProjection.total_subsidy_paid":
+		panic(fmt.Errorf("field total_subsidy_paid of message inference.inference.EpochRewardProjection is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.EpochRewardProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.EpochRewardProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EpochRewardProjection) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.EpochRewardProjection.epoch_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.EpochRewardProjection.reward_amount":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.EpochRewardProjection.distributed_amount":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.EpochRewardProjection.governance_amount":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.EpochRewardProjection.total_subsidy_paid":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.EpochRewardProjection.subsidy_percentage":
+		m := new(Decimal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.EpochRewardProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.EpochRewardProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EpochRewardProjection) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.EpochRewardProjection", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EpochRewardProjection) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochRewardProjection) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EpochRewardProjection) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EpochRewardProjection) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EpochRewardProjection)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EpochIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochIndex))
+		}
+		if x.RewardAmount != 0 {
+			n += 1 + runtime.Sov(uint64(x.RewardAmount))
+		}
+		if x.DistributedAmount != 0 {
+			n += 1 + runtime.Sov(uint64(x.DistributedAmount))
+		}
+		if x.GovernanceAmount != 0 {
+			n += 1 + runtime.Sov(uint64(x.GovernanceAmount))
+		}
+		if x.TotalSubsidyPaid != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalSubsidyPaid))
+		}
+		if x.SubsidyPercentage != nil {
+			l = options.Size(x.SubsidyPercentage)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EpochRewardProjection)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SubsidyPercentage != nil {
+			encoded, err := options.Marshal(x.SubsidyPercentage)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.TotalSubsidyPaid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalSubsidyPaid))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.GovernanceAmount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GovernanceAmount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.DistributedAmount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DistributedAmount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.RewardAmount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RewardAmount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.EpochIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EpochRewardProjection)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochRewardProjection: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochRewardProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+				}
+				x.EpochIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+				}
+				x.RewardAmount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RewardAmount |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DistributedAmount", wireType)
+				}
+				x.DistributedAmount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DistributedAmount |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GovernanceAmount", wireType)
+				}
+				x.GovernanceAmount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GovernanceAmount |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalSubsidyPaid", wireType)
+				}
+				x.TotalSubsidyPaid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalSubsidyPaid |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubsidyPercentage", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SubsidyPercentage == nil {
+					x.SubsidyPercentage = &Decimal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubsidyPercentage); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ParticipantRewardProjection                    protoreflect.MessageDescriptor
+	fd_ParticipantRewardProjection_address            protoreflect.FieldDescriptor
+	fd_ParticipantRewardProjection_weight             protoreflect.FieldDescriptor
+	fd_ParticipantRewardProjection_first_epoch_reward protoreflect.FieldDescriptor
+	fd_ParticipantRewardProjection_total_reward       protoreflect.FieldDescriptor
+	fd_ParticipantRewardProjection_reward_share       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_tokenomics_projection_proto_init()
+	md_ParticipantRewardProjection = File_inference_inference_tokenomics_projection_proto.Messages().ByName("ParticipantRewardProjection")
+	fd_ParticipantRewardProjection_address = md_ParticipantRewardProjection.Fields().ByName("address")
+	fd_ParticipantRewardProjection_weight = md_ParticipantRewardProjection.Fields().ByName("weight")
+	fd_ParticipantRewardProjection_first_epoch_reward = md_ParticipantRewardProjection.Fields().ByName("first_epoch_reward")
+	fd_ParticipantRewardProjection_total_reward = md_ParticipantRewardProjection.Fields().ByName("total_reward")
+	fd_ParticipantRewardProjection_reward_share = md_ParticipantRewardProjection.Fields().ByName("reward_share")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParticipantRewardProjection)(nil)
+
+type fastReflection_ParticipantRewardProjection ParticipantRewardProjection
+
+func (x *ParticipantRewardProjection) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantRewardProjection)(x)
+}
+
+func (x *ParticipantRewardProjection) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_tokenomics_projection_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParticipantRewardProjection_messageType fastReflection_ParticipantRewardProjection_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantRewardProjection_messageType{}
+
+type fastReflection_ParticipantRewardProjection_messageType struct{}
+
+func (x fastReflection_ParticipantRewardProjection_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantRewardProjection)(nil)
+}
+func (x fastReflection_ParticipantRewardProjection_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantRewardProjection)
+}
+func (x fastReflection_ParticipantRewardProjection_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantRewardProjection
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParticipantRewardProjection) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantRewardProjection
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParticipantRewardProjection) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantRewardProjection_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParticipantRewardProjection) New() protoreflect.Message {
+	return new(fastReflection_ParticipantRewardProjection)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParticipantRewardProjection) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantRewardProjection)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParticipantRewardProjection) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ParticipantRewardProjection_address, value) {
+			return
+		}
+	}
+	if x.Weight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Weight)
+		if !f(fd_ParticipantRewardProjection_weight, value) {
+			return
+		}
+	}
+	if x.FirstEpochReward != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FirstEpochReward)
+		if !f(fd_ParticipantRewardProjection_first_epoch_reward, value) {
+			return
+		}
+	}
+	if x.TotalReward != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalReward)
+		if !f(fd_ParticipantRewardProjection_total_reward, value) {
+			return
+		}
+	}
+	if x.RewardShare != nil {
+		value := protoreflect.ValueOfMessage(x.RewardShare.ProtoReflect())
+		if !f(fd_ParticipantRewardProjection_reward_share, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParticipantRewardProjection) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantRewardProjection.address":
+		return x.Address != ""
+	case "inference.inference.ParticipantRewardProjection.weight":
+		return x.Weight != int64(0)
+	case "inference.inference.ParticipantRewardProjection.first_epoch_reward":
+		return x.FirstEpochReward != uint64(0)
+	case "inference.inference.ParticipantRewardProjection.total_reward":
+		return x.TotalReward != uint64(0)
+	case "inference.inference.ParticipantRewardProjection.reward_share":
+		return x.RewardShare != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantRewardProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantRewardProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantRewardProjection) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantRewardProjection.address":
+		x.Address = ""
+	case "inference.inference.ParticipantRewardProjection.weight":
+		x.Weight = int64(0)
+	case "inference.inference.ParticipantRewardProjection.first_epoch_reward":
+		x.FirstEpochReward = uint64(0)
+	case "inference.inference.ParticipantRewardProjection.total_reward":
+		x.TotalReward = uint64(0)
+	case "inference.inference.ParticipantRewardProjection.reward_share":
+		x.RewardShare = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantRewardProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantRewardProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParticipantRewardProjection) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.ParticipantRewardProjection.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.ParticipantRewardProjection.weight":
+		value := x.Weight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.ParticipantRewardProjection.first_epoch_reward":
+		value := x.FirstEpochReward
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ParticipantRewardProjection.total_reward":
+		value := x.TotalReward
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ParticipantRewardProjection.reward_share":
+		value := x.RewardShare
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantRewardProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantRewardProjection does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantRewardProjection) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantRewardProjection.address":
+		x.Address = value.Interface().(string)
+	case "inference.inference.ParticipantRewardProjection.weight":
+		x.Weight = value.Int()
+	case "inference.inference.ParticipantRewardProjection.first_epoch_reward":
+		x.FirstEpochReward = value.Uint()
+	case "inference.inference.ParticipantRewardProjection.total_reward":
+		x.TotalReward = value.Uint()
+	case "inference.inference.ParticipantRewardProjection.reward_share":
+		x.RewardShare = value.Message().Interface().(*Decimal)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantRewardProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantRewardProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantRewardProjection) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantRewardProjection.reward_share":
+		if x.RewardShare == nil {
+			x.RewardShare = new(Decimal)
+		}
+		return protoreflect.ValueOfMessage(x.RewardShare.ProtoReflect())
+	case "inference.inference.ParticipantRewardProjection.address":
+		panic(fmt.Errorf("field address of message inference.inference.ParticipantRewardProjection is not mutable"))
+	case "inference.inference.ParticipantRewardProjection.weight":
+		panic(fmt.Errorf("field weight of message inference.inference.ParticipantRewardProjection is not mutable"))
+	case "inference.inference.ParticipantRewardProjection.first_epoch_reward":
+		panic(fmt.Errorf("field first_epoch_reward of message inference.inference.ParticipantRewardProjection is not mutable"))
+	case "inference.inference.ParticipantRewardProjection.total_reward":
+		panic(fmt.Errorf("field total_reward of message inference.inference.ParticipantRewardProjection is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantRewardProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantRewardProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParticipantRewardProjection) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantRewardProjection.address":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.ParticipantRewardProjection.weight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.ParticipantRewardProjection.first_epoch_reward":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ParticipantRewardProjection.total_reward":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ParticipantRewardProjection.reward_share":
+		m := new(Decimal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantRewardProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantRewardProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParticipantRewardProjection) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.ParticipantRewardProjection", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParticipantRewardProjection) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantRewardProjection) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParticipantRewardProjection) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParticipantRewardProjection) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParticipantRewardProjection)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Weight != 0 {
+			n += 1 + runtime.Sov(uint64(x.Weight))
+		}
+		if x.FirstEpochReward != 0 {
+			n += 1 + runtime.Sov(uint64(x.FirstEpochReward))
+		}
+		if x.TotalReward != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalReward))
+		}
+		if x.RewardShare != nil {
+			l = options.Size(x.RewardShare)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantRewardProjection)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RewardShare != nil {
+			encoded, err := options.Marshal(x.RewardShare)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.TotalReward != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalReward))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.FirstEpochReward != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FirstEpochReward))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Weight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Weight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantRewardProjection)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantRewardProjection: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantRewardProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				}
+				x.Weight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Weight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FirstEpochReward", wireType)
+				}
+				x.FirstEpochReward = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FirstEpochReward |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalReward", wireType)
+				}
+				x.TotalReward = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalReward |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardShare", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RewardShare == nil {
+					x.RewardShare = &Decimal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardShare); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TokenomicsProjection_6_list)(nil)
+
+type _TokenomicsProjection_6_list struct {
+	list *[]*EpochRewardProjection
+}
+
+func (x *_TokenomicsProjection_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TokenomicsProjection_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TokenomicsProjection_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochRewardProjection)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TokenomicsProjection_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochRewardProjection)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TokenomicsProjection_6_list) AppendMutable() protoreflect.Value {
+	v := new(EpochRewardProjection)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TokenomicsProjection_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TokenomicsProjection_6_list) NewElement() protoreflect.Value {
+	v := new(EpochRewardProjection)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TokenomicsProjection_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TokenomicsProjection_7_list)(nil)
+
+type _TokenomicsProjection_7_list struct {
+	list *[]*ParticipantRewardProjection
+}
+
+func (x *_TokenomicsProjection_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TokenomicsProjection_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TokenomicsProjection_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantRewardProjection)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TokenomicsProjection_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantRewardProjection)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TokenomicsProjection_7_list) AppendMutable() protoreflect.Value {
+	v := new(ParticipantRewardProjection)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TokenomicsProjection_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TokenomicsProjection_7_list) NewElement() protoreflect.Value {
+	v := new(ParticipantRewardProjection)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TokenomicsProjection_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TokenomicsProjection                        protoreflect.MessageDescriptor
+	fd_TokenomicsProjection_use_bitcoin_rewards    protoreflect.FieldDescriptor
+	fd_TokenomicsProjection_total_subsidy_supply   protoreflect.FieldDescriptor
+	fd_TokenomicsProjection_starting_subsidy_paid  protoreflect.FieldDescriptor
+	fd_TokenomicsProjection_total_issuance         protoreflect.FieldDescriptor
+	fd_TokenomicsProjection_supply_exhausted_epoch protoreflect.FieldDescriptor
+	fd_TokenomicsProjection_epochs                 protoreflect.FieldDescriptor
+	fd_TokenomicsProjection_participants           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_tokenomics_projection_proto_init()
+	md_TokenomicsProjection = File_inference_inference_tokenomics_projection_proto.Messages().ByName("TokenomicsProjection")
+	fd_TokenomicsProjection_use_bitcoin_rewards = md_TokenomicsProjection.Fields().ByName("use_bitcoin_rewards")
+	fd_TokenomicsProjection_total_subsidy_supply = md_TokenomicsProjection.Fields().ByName("total_subsidy_supply")
+	fd_TokenomicsProjection_starting_subsidy_paid = md_TokenomicsProjection.Fields().ByName("starting_subsidy_paid")
+	fd_TokenomicsProjection_total_issuance = md_TokenomicsProjection.Fields().ByName("total_issuance")
+	fd_TokenomicsProjection_supply_exhausted_epoch = md_TokenomicsProjection.Fields().ByName("supply_exhausted_epoch")
+	fd_TokenomicsProjection_epochs = md_TokenomicsProjection.Fields().ByName("epochs")
+	fd_TokenomicsProjection_participants = md_TokenomicsProjection.Fields().ByName("participants")
+}
+
+var _ protoreflect.Message = (*fastReflection_TokenomicsProjection)(nil)
+
+type fastReflection_TokenomicsProjection TokenomicsProjection
+
+func (x *TokenomicsProjection) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TokenomicsProjection)(x)
+}
+
+func (x *TokenomicsProjection) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_tokenomics_projection_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TokenomicsProjection_messageType fastReflection_TokenomicsProjection_messageType
+var _ protoreflect.MessageType = fastReflection_TokenomicsProjection_messageType{}
+
+type fastReflection_TokenomicsProjection_messageType struct{}
+
+func (x fastReflection_TokenomicsProjection_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TokenomicsProjection)(nil)
+}
+func (x fastReflection_TokenomicsProjection_messageType) New() protoreflect.Message {
+	return new(fastReflection_TokenomicsProjection)
+}
+func (x fastReflection_TokenomicsProjection_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenomicsProjection
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TokenomicsProjection) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenomicsProjection
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TokenomicsProjection) Type() protoreflect.MessageType {
+	return _fastReflection_TokenomicsProjection_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TokenomicsProjection) New() protoreflect.Message {
+	return new(fastReflection_TokenomicsProjection)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TokenomicsProjection) Interface() protoreflect.ProtoMessage {
+	return (*TokenomicsProjection)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TokenomicsProjection) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.UseBitcoinRewards != false {
+		value := protoreflect.ValueOfBool(x.UseBitcoinRewards)
+		if !f(fd_TokenomicsProjection_use_bitcoin_rewards, value) {
+			return
+		}
+	}
+	if x.TotalSubsidySupply != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalSubsidySupply)
+		if !f(fd_TokenomicsProjection_total_subsidy_supply, value) {
+			return
+		}
+	}
+	if x.StartingSubsidyPaid != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartingSubsidyPaid)
+		if !f(fd_TokenomicsProjection_starting_subsidy_paid, value) {
+			return
+		}
+	}
+	if x.TotalIssuance != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalIssuance)
+		if !f(fd_TokenomicsProjection_total_issuance, value) {
+			return
+		}
+	}
+	if x.SupplyExhaustedEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SupplyExhaustedEpoch)
+		if !f(fd_TokenomicsProjection_supply_exhausted_epoch, value) {
+			return
+		}
+	}
+	if len(x.Epochs) != 0 {
+		value := protoreflect.ValueOfList(&_TokenomicsProjection_6_list{list: &x.Epochs})
+		if !f(fd_TokenomicsProjection_epochs, value) {
+			return
+		}
+	}
+	if len(x.Participants) != 0 {
+		value := protoreflect.ValueOfList(&_TokenomicsProjection_7_list{list: &x.Participants})
+		if !f(fd_TokenomicsProjection_participants, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TokenomicsProjection) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.TokenomicsProjection.use_bitcoin_rewards":
+		return x.UseBitcoinRewards != false
+	case "inference.inference.TokenomicsProjection.total_subsidy_supply":
+		return x.TotalSubsidySupply != int64(0)
+	case "inference.inference.TokenomicsProjection.starting_subsidy_paid":
+		return x.StartingSubsidyPaid != int64(0)
+	case "inference.inference.TokenomicsProjection.total_issuance":
+		return x.TotalIssuance != int64(0)
+	case "inference.inference.TokenomicsProjection.supply_exhausted_epoch":
+		return x.SupplyExhaustedEpoch != uint64(0)
+	case "inference.inference.TokenomicsProjection.epochs":
+		return len(x.Epochs) != 0
+	case "inference.inference.TokenomicsProjection.participants":
+		return len(x.Participants) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TokenomicsProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.TokenomicsProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenomicsProjection) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.TokenomicsProjection.use_bitcoin_rewards":
+		x.UseBitcoinRewards = false
+	case "inference.inference.TokenomicsProjection.total_subsidy_supply":
+		x.TotalSubsidySupply = int64(0)
+	case "inference.inference.TokenomicsProjection.starting_subsidy_paid":
+		x.StartingSubsidyPaid = int64(0)
+	case "inference.inference.TokenomicsProjection.total_issuance":
+		x.TotalIssuance = int64(0)
+	case "inference.inference.TokenomicsProjection.supply_exhausted_epoch":
+		x.SupplyExhaustedEpoch = uint64(0)
+	case "inference.inference.TokenomicsProjection.epochs":
+		x.Epochs = nil
+	case "inference.inference.TokenomicsProjection.participants":
+		x.Participants = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TokenomicsProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.TokenomicsProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TokenomicsProjection) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.TokenomicsProjection.use_bitcoin_rewards":
+		value := x.UseBitcoinRewards
+		return protoreflect.ValueOfBool(value)
+	case "inference.inference.TokenomicsProjection.total_subsidy_supply":
+		value := x.TotalSubsidySupply
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.TokenomicsProjection.starting_subsidy_paid":
+		value := x.StartingSubsidyPaid
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.TokenomicsProjection.total_issuance":
+		value := x.TotalIssuance
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.TokenomicsProjection.supply_exhausted_epoch":
+		value := x.SupplyExhaustedEpoch
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.TokenomicsProjection.epochs":
+		if len(x.Epochs) == 0 {
+			return protoreflect.ValueOfList(&_TokenomicsProjection_6_list{})
+		}
+		listValue := &_TokenomicsProjection_6_list{list: &x.Epochs}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.TokenomicsProjection.participants":
+		if len(x.Participants) == 0 {
+			return protoreflect.ValueOfList(&_TokenomicsProjection_7_list{})
+		}
+		listValue := &_TokenomicsProjection_7_list{list: &x.Participants}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TokenomicsProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.TokenomicsProjection does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenomicsProjection) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.TokenomicsProjection.use_bitcoin_rewards":
+		x.UseBitcoinRewards = value.Bool()
+	case "inference.inference.TokenomicsProjection.total_subsidy_supply":
+		x.TotalSubsidySupply = value.Int()
+	case "inference.inference.TokenomicsProjection.starting_subsidy_paid":
+		x.StartingSubsidyPaid = value.Int()
+	case "inference.inference.TokenomicsProjection.total_issuance":
+		x.TotalIssuance = value.Int()
+	case "inference.inference.TokenomicsProjection.supply_exhausted_epoch":
+		x.SupplyExhaustedEpoch = value.Uint()
+	case "inference.inference.TokenomicsProjection.epochs":
+		lv := value.List()
+		clv := lv.(*_TokenomicsProjection_6_list)
+		x.Epochs = *clv.list
+	case "inference.inference.TokenomicsProjection.participants":
+		lv := value.List()
+		clv := lv.(*_TokenomicsProjection_7_list)
+		x.Participants = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TokenomicsProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.TokenomicsProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenomicsProjection) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.TokenomicsProjection.epochs":
+		if x.Epochs == nil {
+			x.Epochs = []*EpochRewardProjection{}
+		}
+		value := &_TokenomicsProjection_6_list{list: &x.Epochs}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.TokenomicsProjection.participants":
+		if x.Participants == nil {
+			x.Participants = []*ParticipantRewardProjection{}
+		}
+		value := &_TokenomicsProjection_7_list{list: &x.Participants}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.TokenomicsProjection.use_bitcoin_rewards":
+		panic(fmt.Errorf("field use_bitcoin_rewards of message inference.inference.TokenomicsProjection is not mutable"))
+	case "inference.inference.TokenomicsProjection.total_subsidy_supply":
+		panic(fmt.Errorf("field total_subsidy_supply of message inference.inference.TokenomicsProjection is not mutable"))
+	case "inference.inference.TokenomicsProjection.starting_subsidy_paid":
+		panic(fmt.Errorf("field starting_subsidy_paid of message inference.inference.TokenomicsProjection is not mutable"))
+	case "inference.inference.TokenomicsProjection.total_issuance":
+		panic(fmt.Errorf("field total_issuance of message inference.inference.TokenomicsProjection is not mutable"))
+	case "inference.inference.TokenomicsProjection.supply_exhausted_epoch":
+		panic(fmt.Errorf("field supply_exhausted_epoch of message inference.inference.TokenomicsProjection is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TokenomicsProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.TokenomicsProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TokenomicsProjection) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.TokenomicsProjection.use_bitcoin_rewards":
+		return protoreflect.ValueOfBool(false)
+	case "inference.inference.TokenomicsProjection.total_subsidy_supply":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.TokenomicsProjection.starting_subsidy_paid":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.TokenomicsProjection.total_issuance":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.TokenomicsProjection.supply_exhausted_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.TokenomicsProjection.epochs":
+		list := []*EpochRewardProjection{}
+		return protoreflect.ValueOfList(&_TokenomicsProjection_6_list{list: &list})
+	case "inference.inference.TokenomicsProjection.participants":
+		list := []*ParticipantRewardProjection{}
+		return protoreflect.ValueOfList(&_TokenomicsProjection_7_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TokenomicsProjection"))
+		}
+		panic(fmt.Errorf("message inference.inference.TokenomicsProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TokenomicsProjection) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.TokenomicsProjection", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TokenomicsProjection) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenomicsProjection) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TokenomicsProjection) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TokenomicsProjection) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TokenomicsProjection)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.UseBitcoinRewards {
+			n += 2
+		}
+		if x.TotalSubsidySupply != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalSubsidySupply))
+		}
+		if x.StartingSubsidyPaid != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartingSubsidyPaid))
+		}
+		if x.TotalIssuance != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalIssuance))
+		}
+		if x.SupplyExhaustedEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.SupplyExhaustedEpoch))
+		}
+		if len(x.Epochs) > 0 {
+			for _, e := range x.Epochs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Participants) > 0 {
+			for _, e := range x.Participants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TokenomicsProjection)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Participants) > 0 {
+			for iNdEx := len(x.Participants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Participants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Epochs) > 0 {
+			for iNdEx := len(x.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Epochs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.SupplyExhaustedEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SupplyExhaustedEpoch))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TotalIssuance != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalIssuance))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.StartingSubsidyPaid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartingSubsidyPaid))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.TotalSubsidySupply != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalSubsidySupply))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.UseBitcoinRewards {
+			i--
+			if x.UseBitcoinRewards {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TokenomicsProjection)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenomicsProjection: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenomicsProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UseBitcoinRewards", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.UseBitcoinRewards = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalSubsidySupply", wireType)
+				}
+				x.TotalSubsidySupply = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalSubsidySupply |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartingSubsidyPaid", wireType)
+				}
+				x.StartingSubsidyPaid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartingSubsidyPaid |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalIssuance", wireType)
+				}
+				x.TotalIssuance = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalIssuance |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SupplyExhaustedEpoch", wireType)
+				}
+				x.SupplyExhaustedEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SupplyExhaustedEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epochs = append(x.Epochs, &EpochRewardProjection{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Epochs[len(x.Epochs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Participants = append(x.Participants, &ParticipantRewardProjection{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Participants[len(x.Participants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/inference/tokenomics_projection.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EpochRewardProjection is the projected settlement of one future epoch.
+type EpochRewardProjection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochIndex        uint64   `protobuf:"varint,1,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	RewardAmount      int64    `protobuf:"varint,2,opt,name=reward_amount,json=rewardAmount,proto3" json:"reward_amount,omitempty"`                // newly minted reward coins
+	DistributedAmount int64    `protobuf:"varint,3,opt,name=distributed_amount,json=distributedAmount,proto3" json:"distributed_amount,omitempty"` // reward coins paid to participants
+	GovernanceAmount  int64    `protobuf:"varint,4,opt,name=governance_amount,json=governanceAmount,proto3" json:"governance_amount,omitempty"`    // reward coins sent to governance (bitcoin rewards only)
+	TotalSubsidyPaid  int64    `protobuf:"varint,5,opt,name=total_subsidy_paid,json=totalSubsidyPaid,proto3" json:"total_subsidy_paid,omitempty"`  // cumulative subsidy after this epoch, the supply curve
+	SubsidyPercentage *Decimal `protobuf:"bytes,6,opt,name=subsidy_percentage,json=subsidyPercentage,proto3" json:"subsidy_percentage,omitempty"`  // subsidy percentage used (legacy rewards only)
+}
+
+func (x *EpochRewardProjection) Reset() {
+	*x = EpochRewardProjection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_tokenomics_projection_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochRewardProjection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochRewardProjection) ProtoMessage() {}
+
+// Deprecated: Use EpochRewardProjection.ProtoReflect.Descriptor instead.
+func (*EpochRewardProjection) Descriptor() ([]byte, []int) {
+	return file_inference_inference_tokenomics_projection_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EpochRewardProjection) GetEpochIndex() uint64 {
+	if x != nil {
+		return x.EpochIndex
+	}
+	return 0
+}
+
+func (x *EpochRewardProjection) GetRewardAmount() int64 {
+	if x != nil {
+		return x.RewardAmount
+	}
+	return 0
+}
+
+func (x *EpochRewardProjection) GetDistributedAmount() int64 {
+	if x != nil {
+		return x.DistributedAmount
+	}
+	return 0
+}
+
+func (x *EpochRewardProjection) GetGovernanceAmount() int64 {
+	if x != nil {
+		return x.GovernanceAmount
+	}
+	return 0
+}
+
+func (x *EpochRewardProjection) GetTotalSubsidyPaid() int64 {
+	if x != nil {
+		return x.TotalSubsidyPaid
+	}
+	return 0
+}
+
+func (x *EpochRewardProjection) GetSubsidyPercentage() *Decimal {
+	if x != nil {
+		return x.SubsidyPercentage
+	}
+	return nil
+}
+
+// ParticipantRewardProjection sums the projected reward coins of one participant.
+type ParticipantRewardProjection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address          string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight           int64    `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"` // PoC weight in the epoch the projection starts from
+	FirstEpochReward uint64   `protobuf:"varint,3,opt,name=first_epoch_reward,json=firstEpochReward,proto3" json:"first_epoch_reward,omitempty"`
+	TotalReward      uint64   `protobuf:"varint,4,opt,name=total_reward,json=totalReward,proto3" json:"total_reward,omitempty"`
+	RewardShare      *Decimal `protobuf:"bytes,5,opt,name=reward_share,json=rewardShare,proto3" json:"reward_share,omitempty"` // share of the rewards distributed over the projection
+}
+
+func (x *ParticipantRewardProjection) Reset() {
+	*x = ParticipantRewardProjection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_tokenomics_projection_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParticipantRewardProjection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantRewardProjection) ProtoMessage() {}
+
+// Deprecated: Use ParticipantRewardProjection.ProtoReflect.Descriptor instead.
+func (*ParticipantRewardProjection) Descriptor() ([]byte, []int) {
+	return file_inference_inference_tokenomics_projection_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParticipantRewardProjection) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ParticipantRewardProjection) GetWeight() int64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ParticipantRewardProjection) GetFirstEpochReward() uint64 {
+	if x != nil {
+		return x.FirstEpochReward
+	}
+	return 0
+}
+
+func (x *ParticipantRewardProjection) GetTotalReward() uint64 {
+	if x != nil {
+		return x.TotalReward
+	}
+	return 0
+}
+
+func (x *ParticipantRewardProjection) GetRewardShare() *Decimal {
+	if x != nil {
+		return x.RewardShare
+	}
+	return nil
+}
+
+// TokenomicsProjection projects reward issuance for a params set, assuming the current participants,
+// weights and (for legacy rewards) per-epoch work stay as they are and nobody is punished for downtime.
+// Top miner payouts come from a pre-funded pool and are not part of the issuance.
+type TokenomicsProjection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UseBitcoinRewards   bool  `protobuf:"varint,1,opt,name=use_bitcoin_rewards,json=useBitcoinRewards,proto3" json:"use_bitcoin_rewards,omitempty"`
+	TotalSubsidySupply  int64 `protobuf:"varint,2,opt,name=total_subsidy_supply,json=totalSubsidySupply,proto3" json:"total_subsidy_supply,omitempty"`
+	StartingSubsidyPaid int64 `protobuf:"varint,3,opt,name=starting_subsidy_paid,json=startingSubsidyPaid,proto3" json:"starting_subsidy_paid,omitempty"`
+	TotalIssuance       int64 `protobuf:"varint,4,opt,name=total_issuance,json=totalIssuance,proto3" json:"total_issuance,omitempty"`
+	// first projected epoch in which the subsidy supply is exhausted, 0 if not within the projection
+	SupplyExhaustedEpoch uint64                         `protobuf:"varint,5,opt,name=supply_exhausted_epoch,json=supplyExhaustedEpoch,proto3" json:"supply_exhausted_epoch,omitempty"`
+	Epochs               []*EpochRewardProjection       `protobuf:"bytes,6,rep,name=epochs,proto3" json:"epochs,omitempty"`
+	Participants         []*ParticipantRewardProjection `protobuf:"bytes,7,rep,name=participants,proto3" json:"participants,omitempty"`
+}
+
+func (x *TokenomicsProjection) Reset() {
+	*x = TokenomicsProjection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_tokenomics_projection_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenomicsProjection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenomicsProjection) ProtoMessage() {}
+
+// Deprecated: Use TokenomicsProjection.ProtoReflect.Descriptor instead.
+func (*TokenomicsProjection) Descriptor() ([]byte, []int) {
+	return file_inference_inference_tokenomics_projection_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TokenomicsProjection) GetUseBitcoinRewards() bool {
+	if x != nil {
+		return x.UseBitcoinRewards
+	}
+	return false
+}
+
+func (x *TokenomicsProjection) GetTotalSubsidySupply() int64 {
+	if x != nil {
+		return x.TotalSubsidySupply
+	}
+	return 0
+}
+
+func (x *TokenomicsProjection) GetStartingSubsidyPaid() int64 {
+	if x != nil {
+		return x.StartingSubsidyPaid
+	}
+	return 0
+}
+
+func (x *TokenomicsProjection) GetTotalIssuance() int64 {
+	if x != nil {
+		return x.TotalIssuance
+	}
+	return 0
+}
+
+func (x *TokenomicsProjection) GetSupplyExhaustedEpoch() uint64 {
+	if x != nil {
+		return x.SupplyExhaustedEpoch
+	}
+	return 0
+}
+
+func (x *TokenomicsProjection) GetEpochs() []*EpochRewardProjection {
+	if x != nil {
+		return x.Epochs
+	}
+	return nil
+}
+
+func (x *TokenomicsProjection) GetParticipants() []*ParticipantRewardProjection {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+var File_inference_inference_tokenomics_projection_proto protoreflect.FileDescriptor
+
+var file_inference_inference_tokenomics_projection_proto_rawDesc = []byte{
+	0x0a, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x13, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x20, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4, 0x02, 0x0a, 0x15, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x67, 0x6f, 0x76, 0x65, 0x72,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75,
+	0x62, 0x73, 0x69, 0x64, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x69, 0x64, 0x79, 0x50, 0x61,
+	0x69, 0x64, 0x12, 0x4b, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x73, 0x69, 0x64, 0x79, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x11, 0x73, 0x75,
+	0x62, 0x73, 0x69, 0x64, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22,
+	0xe1, 0x01, 0x0a, 0x1b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x12, 0x3f, 0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x22, 0xa3, 0x03, 0x0a, 0x14, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69,
+	0x63, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13,
+	0x75, 0x73, 0x65, 0x5f, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x75, 0x73, 0x65, 0x42, 0x69,
+	0x74, 0x63, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x69, 0x64, 0x79, 0x5f, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x75, 0x62, 0x73, 0x69, 0x64, 0x79, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x32,
+	0x0a, 0x15, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x69,
+	0x64, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x69, 0x64, 0x79, 0x50, 0x61,
+	0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x73, 0x73, 0x75,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x5f, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x45, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x42, 0x0a, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x73, 0x12, 0x54, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x42, 0xc7, 0x01, 0x0a, 0x17, 0x63, 0x6f,
+	0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x19, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63,
+	0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02,
+	0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_inference_inference_tokenomics_projection_proto_rawDescOnce sync.Once
+	file_inference_inference_tokenomics_projection_proto_rawDescData = file_inference_inference_tokenomics_projection_proto_rawDesc
+)
+
+func file_inference_inference_tokenomics_projection_proto_rawDescGZIP() []byte {
+	file_inference_inference_tokenomics_projection_proto_rawDescOnce.Do(func() {
+		file_inference_inference_tokenomics_projection_proto_rawDescData = protoimpl.X.CompressGZIP(file_inference_inference_tokenomics_projection_proto_rawDescData)
+	})
+	return file_inference_inference_tokenomics_projection_proto_rawDescData
+}
+
+var file_inference_inference_tokenomics_projection_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_inference_inference_tokenomics_projection_proto_goTypes = []interface{}{
+	(*EpochRewardProjection)(nil),       // 0: inference.inference.EpochRewardProjection
+	(*ParticipantRewardProjection)(nil), // 1: inference.inference.ParticipantRewardProjection
+	(*TokenomicsProjection)(nil),        // 2: inference.inference.TokenomicsProjection
+	(*Decimal)(nil),                     // 3: inference.inference.Decimal
+}
+var file_inference_inference_tokenomics_projection_proto_depIdxs = []int32{
+	3, // 0: inference.inference.EpochRewardProjection.subsidy_percentage:type_name -> inference.inference.Decimal
+	3, // 1: inference.inference.ParticipantRewardProjection.reward_share:type_name -> inference.inference.Decimal
+	0, // 2: inference.inference.TokenomicsProjection.epochs:type_name -> inference.inference.EpochRewardProjection
+	1, // 3: inference.inference.TokenomicsProjection.participants:type_name -> inference.inference.ParticipantRewardProjection
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_inference_inference_tokenomics_projection_proto_init() }
+func file_inference_inference_tokenomics_projection_proto_init() {
+	if File_inference_inference_tokenomics_projection_proto != nil {
+		return
+	}
+	file_inference_inference_params_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_inference_inference_tokenomics_projection_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochRewardProjection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_tokenomics_projection_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantRewardProjection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_tokenomics_projection_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenomicsProjection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_inference_inference_tokenomics_projection_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_inference_inference_tokenomics_projection_proto_goTypes,
+		DependencyIndexes: file_inference_inference_tokenomics_projection_proto_depIdxs,
+		MessageInfos:      file_inference_inference_tokenomics_projection_proto_msgTypes,
+	}.Build()
+	File_inference_inference_tokenomics_projection_proto = out.File
+	file_inference_inference_tokenomics_projection_proto_rawDesc = nil
+	file_inference_inference_tokenomics_projection_proto_goTypes = nil
+	file_inference_inference_tokenomics_projection_proto_depIdxs = nil
+}
@@ -127,6 +127,8 @@ var (
 	fd_TrainingTask_config                              protoreflect.FieldDescriptor
 	fd_TrainingTask_assignees                           protoreflect.FieldDescriptor
 	fd_TrainingTask_epoch                               protoreflect.FieldDescriptor
+	fd_TrainingTask_budget                              protoreflect.FieldDescriptor
+	fd_TrainingTask_cancelled_at_block_height           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -143,6 +145,8 @@ func init() {
 	fd_TrainingTask_config = md_TrainingTask.Fields().ByName("config")
 	fd_TrainingTask_assignees = md_TrainingTask.Fields().ByName("assignees")
 	fd_TrainingTask_epoch = md_TrainingTask.Fields().ByName("epoch")
+	fd_TrainingTask_budget = md_TrainingTask.Fields().ByName("budget")
+	fd_TrainingTask_cancelled_at_block_height = md_TrainingTask.Fields().ByName("cancelled_at_block_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_TrainingTask)(nil)
@@ -276,6 +280,18 @@ func (x *fastReflection_TrainingTask) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.Budget != nil {
+		value := protoreflect.ValueOfMessage(x.Budget.ProtoReflect())
+		if !f(fd_TrainingTask_budget, value) {
+			return
+		}
+	}
+	if x.CancelledAtBlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CancelledAtBlockHeight)
+		if !f(fd_TrainingTask_cancelled_at_block_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -313,6 +329,10 @@ func (x *fastReflection_TrainingTask) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Assignees) != 0
 	case "inference.inference.TrainingTask.epoch":
 		return x.Epoch != nil
+	case "inference.inference.TrainingTask.budget":
+		return x.Budget != nil
+	case "inference.inference.TrainingTask.cancelled_at_block_height":
+		return x.CancelledAtBlockHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingTask"))
@@ -351,6 +371,10 @@ func (x *fastReflection_TrainingTask) Clear(fd protoreflect.FieldDescriptor) {
 		x.Assignees = nil
 	case "inference.inference.TrainingTask.epoch":
 		x.Epoch = nil
+	case "inference.inference.TrainingTask.budget":
+		x.Budget = nil
+	case "inference.inference.TrainingTask.cancelled_at_block_height":
+		x.CancelledAtBlockHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingTask"))
@@ -406,6 +430,12 @@ func (x *fastReflection_TrainingTask) Get(descriptor protoreflect.FieldDescripto
 	case "inference.inference.TrainingTask.epoch":
 		value := x.Epoch
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.TrainingTask.budget":
+		value := x.Budget
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.TrainingTask.cancelled_at_block_height":
+		value := x.CancelledAtBlockHeight
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingTask"))
@@ -452,6 +482,10 @@ func (x *fastReflection_TrainingTask) Set(fd protoreflect.FieldDescriptor, value
 		x.Assignees = *clv.list
 	case "inference.inference.TrainingTask.epoch":
 		x.Epoch = value.Message().Interface().(*EpochInfo)
+	case "inference.inference.TrainingTask.budget":
+		x.Budget = value.Message().Interface().(*TrainingBudget)
+	case "inference.inference.TrainingTask.cancelled_at_block_height":
+		x.CancelledAtBlockHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingTask"))
@@ -494,6 +528,11 @@ func (x *fastReflection_TrainingTask) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Epoch = new(EpochInfo)
 		}
 		return protoreflect.ValueOfMessage(x.Epoch.ProtoReflect())
+	case "inference.inference.TrainingTask.budget":
+		if x.Budget == nil {
+			x.Budget = new(TrainingBudget)
+		}
+		return protoreflect.ValueOfMessage(x.Budget.ProtoReflect())
 	case "inference.inference.TrainingTask.id":
 		panic(fmt.Errorf("field id of message inference.inference.TrainingTask is not mutable"))
 	case "inference.inference.TrainingTask.requested_by":
@@ -508,6 +547,8 @@ func (x *fastReflection_TrainingTask) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field assigned_at_block_height of message inference.inference.TrainingTask is not mutable"))
 	case "inference.inference.TrainingTask.finished_at_block_height":
 		panic(fmt.Errorf("field finished_at_block_height of message inference.inference.TrainingTask is not mutable"))
+	case "inference.inference.TrainingTask.cancelled_at_block_height":
+		panic(fmt.Errorf("field cancelled_at_block_height of message inference.inference.TrainingTask is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingTask"))
@@ -547,6 +588,11 @@ func (x *fastReflection_TrainingTask) NewField(fd protoreflect.FieldDescriptor)
 	case "inference.inference.TrainingTask.epoch":
 		m := new(EpochInfo)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.TrainingTask.budget":
+		m := new(TrainingBudget)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.TrainingTask.cancelled_at_block_height":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingTask"))
@@ -659,6 +705,13 @@ func (x *fastReflection_TrainingTask) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Epoch)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Budget != nil {
+			l = options.Size(x.Budget)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CancelledAtBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CancelledAtBlockHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -688,6 +741,25 @@ func (x *fastReflection_TrainingTask) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.CancelledAtBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CancelledAtBlockHeight))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.Budget != nil {
+			encoded, err := options.Marshal(x.Budget)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
 		if x.Epoch != nil {
 			encoded, err := options.Marshal(x.Epoch)
 			if err != nil {
@@ -933,35 +1005,769 @@ func (x *fastReflection_TrainingTask) ProtoMethods() *protoiface.Methods {
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Assigner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClaimedByAssignerAtBlockHeight", wireType)
+				}
+				x.ClaimedByAssignerAtBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ClaimedByAssignerAtBlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssignedAtBlockHeight", wireType)
+				}
+				x.AssignedAtBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AssignedAtBlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinishedAtBlockHeight", wireType)
+				}
+				x.FinishedAtBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FinishedAtBlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HardwareResources", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HardwareResources = append(x.HardwareResources, &TrainingHardwareResources{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HardwareResources[len(x.HardwareResources)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Config == nil {
+					x.Config = &TrainingConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Config); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Assignees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Assignees = append(x.Assignees, &TrainingTaskAssignee{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Assignees[len(x.Assignees)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Epoch == nil {
+					x.Epoch = &EpochInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Epoch); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Budget == nil {
+					x.Budget = &TrainingBudget{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Budget); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CancelledAtBlockHeight", wireType)
+				}
+				x.CancelledAtBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CancelledAtBlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TrainingBudget                           protoreflect.MessageDescriptor
+	fd_TrainingBudget_total                     protoreflect.FieldDescriptor
+	fd_TrainingBudget_payment_per_node_epoch    protoreflect.FieldDescriptor
+	fd_TrainingBudget_paid                      protoreflect.FieldDescriptor
+	fd_TrainingBudget_refunded                  protoreflect.FieldDescriptor
+	fd_TrainingBudget_next_outer_step_to_settle protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_training_task_proto_init()
+	md_TrainingBudget = File_inference_inference_training_task_proto.Messages().ByName("TrainingBudget")
+	fd_TrainingBudget_total = md_TrainingBudget.Fields().ByName("total")
+	fd_TrainingBudget_payment_per_node_epoch = md_TrainingBudget.Fields().ByName("payment_per_node_epoch")
+	fd_TrainingBudget_paid = md_TrainingBudget.Fields().ByName("paid")
+	fd_TrainingBudget_refunded = md_TrainingBudget.Fields().ByName("refunded")
+	fd_TrainingBudget_next_outer_step_to_settle = md_TrainingBudget.Fields().ByName("next_outer_step_to_settle")
+}
+
+var _ protoreflect.Message = (*fastReflection_TrainingBudget)(nil)
+
+type fastReflection_TrainingBudget TrainingBudget
+
+func (x *TrainingBudget) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TrainingBudget)(x)
+}
+
+func (x *TrainingBudget) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_training_task_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TrainingBudget_messageType fastReflection_TrainingBudget_messageType
+var _ protoreflect.MessageType = fastReflection_TrainingBudget_messageType{}
+
+type fastReflection_TrainingBudget_messageType struct{}
+
+func (x fastReflection_TrainingBudget_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TrainingBudget)(nil)
+}
+func (x fastReflection_TrainingBudget_messageType) New() protoreflect.Message {
+	return new(fastReflection_TrainingBudget)
+}
+func (x fastReflection_TrainingBudget_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TrainingBudget
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TrainingBudget) Descriptor() protoreflect.MessageDescriptor {
+	return md_TrainingBudget
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TrainingBudget) Type() protoreflect.MessageType {
+	return _fastReflection_TrainingBudget_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TrainingBudget) New() protoreflect.Message {
+	return new(fastReflection_TrainingBudget)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TrainingBudget) Interface() protoreflect.ProtoMessage {
+	return (*TrainingBudget)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TrainingBudget) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Total != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Total)
+		if !f(fd_TrainingBudget_total, value) {
+			return
+		}
+	}
+	if x.PaymentPerNodeEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PaymentPerNodeEpoch)
+		if !f(fd_TrainingBudget_payment_per_node_epoch, value) {
+			return
+		}
+	}
+	if x.Paid != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Paid)
+		if !f(fd_TrainingBudget_paid, value) {
+			return
+		}
+	}
+	if x.Refunded != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Refunded)
+		if !f(fd_TrainingBudget_refunded, value) {
+			return
+		}
+	}
+	if x.NextOuterStepToSettle != int32(0) {
+		value := protoreflect.ValueOfInt32(x.NextOuterStepToSettle)
+		if !f(fd_TrainingBudget_next_outer_step_to_settle, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TrainingBudget) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.TrainingBudget.total":
+		return x.Total != uint64(0)
+	case "inference.inference.TrainingBudget.payment_per_node_epoch":
+		return x.PaymentPerNodeEpoch != uint64(0)
+	case "inference.inference.TrainingBudget.paid":
+		return x.Paid != uint64(0)
+	case "inference.inference.TrainingBudget.refunded":
+		return x.Refunded != uint64(0)
+	case "inference.inference.TrainingBudget.next_outer_step_to_settle":
+		return x.NextOuterStepToSettle != int32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingBudget"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingBudget does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrainingBudget) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.TrainingBudget.total":
+		x.Total = uint64(0)
+	case "inference.inference.TrainingBudget.payment_per_node_epoch":
+		x.PaymentPerNodeEpoch = uint64(0)
+	case "inference.inference.TrainingBudget.paid":
+		x.Paid = uint64(0)
+	case "inference.inference.TrainingBudget.refunded":
+		x.Refunded = uint64(0)
+	case "inference.inference.TrainingBudget.next_outer_step_to_settle":
+		x.NextOuterStepToSettle = int32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingBudget"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingBudget does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TrainingBudget) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.TrainingBudget.total":
+		value := x.Total
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.TrainingBudget.payment_per_node_epoch":
+		value := x.PaymentPerNodeEpoch
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.TrainingBudget.paid":
+		value := x.Paid
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.TrainingBudget.refunded":
+		value := x.Refunded
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.TrainingBudget.next_outer_step_to_settle":
+		value := x.NextOuterStepToSettle
+		return protoreflect.ValueOfInt32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingBudget"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingBudget does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrainingBudget) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.TrainingBudget.total":
+		x.Total = value.Uint()
+	case "inference.inference.TrainingBudget.payment_per_node_epoch":
+		x.PaymentPerNodeEpoch = value.Uint()
+	case "inference.inference.TrainingBudget.paid":
+		x.Paid = value.Uint()
+	case "inference.inference.TrainingBudget.refunded":
+		x.Refunded = value.Uint()
+	case "inference.inference.TrainingBudget.next_outer_step_to_settle":
+		x.NextOuterStepToSettle = int32(value.Int())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingBudget"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingBudget does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrainingBudget) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.TrainingBudget.total":
+		panic(fmt.Errorf("field total of message inference.inference.TrainingBudget is not mutable"))
+	case "inference.inference.TrainingBudget.payment_per_node_epoch":
+		panic(fmt.Errorf("field payment_per_node_epoch of message inference.inference.TrainingBudget is not mutable"))
+	case "inference.inference.TrainingBudget.paid":
+		panic(fmt.Errorf("field paid of message inference.inference.TrainingBudget is not mutable"))
+	case "inference.inference.TrainingBudget.refunded":
+		panic(fmt.Errorf("field refunded of message inference.inference.TrainingBudget is not mutable"))
+	case "inference.inference.TrainingBudget.next_outer_step_to_settle":
+		panic(fmt.Errorf("field next_outer_step_to_settle of message inference.inference.TrainingBudget is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingBudget"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingBudget does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TrainingBudget) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.TrainingBudget.total":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.TrainingBudget.payment_per_node_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.TrainingBudget.paid":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.TrainingBudget.refunded":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.TrainingBudget.next_outer_step_to_settle":
+		return protoreflect.ValueOfInt32(int32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.TrainingBudget"))
+		}
+		panic(fmt.Errorf("message inference.inference.TrainingBudget does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TrainingBudget) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.TrainingBudget", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TrainingBudget) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrainingBudget) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TrainingBudget) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TrainingBudget) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TrainingBudget)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Total != 0 {
+			n += 1 + runtime.Sov(uint64(x.Total))
+		}
+		if x.PaymentPerNodeEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.PaymentPerNodeEpoch))
+		}
+		if x.Paid != 0 {
+			n += 1 + runtime.Sov(uint64(x.Paid))
+		}
+		if x.Refunded != 0 {
+			n += 1 + runtime.Sov(uint64(x.Refunded))
+		}
+		if x.NextOuterStepToSettle != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextOuterStepToSettle))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TrainingBudget)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NextOuterStepToSettle != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextOuterStepToSettle))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Refunded != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Refunded))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Paid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Paid))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PaymentPerNodeEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PaymentPerNodeEpoch))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Total != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Total))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TrainingBudget)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Assigner = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClaimedByAssignerAtBlockHeight", wireType)
-				}
-				x.ClaimedByAssignerAtBlockHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ClaimedByAssignerAtBlockHeight |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			case 6:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrainingBudget: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrainingBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssignedAtBlockHeight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 				}
-				x.AssignedAtBlockHeight = 0
+				x.Total = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -971,35 +1777,16 @@ func (x *fastReflection_TrainingTask) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.AssignedAtBlockHeight |= uint64(b&0x7F) << shift
+					x.Total |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 7:
+			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinishedAtBlockHeight", wireType)
-				}
-				x.FinishedAtBlockHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.FinishedAtBlockHeight |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HardwareResources", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaymentPerNodeEpoch", wireType)
 				}
-				var msglen int
+				x.PaymentPerNodeEpoch = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1009,31 +1796,16 @@ func (x *fastReflection_TrainingTask) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.PaymentPerNodeEpoch |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.HardwareResources = append(x.HardwareResources, &TrainingHardwareResources{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HardwareResources[len(x.HardwareResources)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 9:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
 				}
-				var msglen int
+				x.Paid = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1043,33 +1815,16 @@ func (x *fastReflection_TrainingTask) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Paid |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Config == nil {
-					x.Config = &TrainingConfig{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Config); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 10:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Assignees", wireType)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 				}
-				var msglen int
+				x.Refunded = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1079,31 +1834,16 @@ func (x *fastReflection_TrainingTask) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Refunded |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Assignees = append(x.Assignees, &TrainingTaskAssignee{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Assignees[len(x.Assignees)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 11:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextOuterStepToSettle", wireType)
 				}
-				var msglen int
+				x.NextOuterStepToSettle = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1113,28 +1853,11 @@ func (x *fastReflection_TrainingTask) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.NextOuterStepToSettle |= int32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Epoch == nil {
-					x.Epoch = &EpochInfo{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Epoch); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1192,7 +1915,7 @@ func (x *TrainingHardwareResources) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TrainingHardwareResources) slowProtoReflect() protoreflect.Message {
-	mi := &file_inference_inference_training_task_proto_msgTypes[1]
+	mi := &file_inference_inference_training_task_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1660,7 +2383,7 @@ func (x *TrainingConfig) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TrainingConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_inference_inference_training_task_proto_msgTypes[2]
+	mi := &file_inference_inference_training_task_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2143,7 +2866,7 @@ func (x *TrainingDatasets) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TrainingDatasets) slowProtoReflect() protoreflect.Message {
-	mi := &file_inference_inference_training_task_proto_msgTypes[3]
+	mi := &file_inference_inference_training_task_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2673,7 +3396,7 @@ func (x *TrainingTaskAssignee) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TrainingTaskAssignee) slowProtoReflect() protoreflect.Message {
-	mi := &file_inference_inference_training_task_proto_msgTypes[4]
+	mi := &file_inference_inference_training_task_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3175,7 +3898,7 @@ func (x *EpochInfo) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EpochInfo) slowProtoReflect() protoreflect.Message {
-	mi := &file_inference_inference_training_task_proto_msgTypes[5]
+	mi := &file_inference_inference_training_task_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3732,6 +4455,8 @@ type TrainingTask struct {
 	Config                         *TrainingConfig              `protobuf:"bytes,9,opt,name=config,proto3" json:"config,omitempty"`
 	Assignees                      []*TrainingTaskAssignee      `protobuf:"bytes,10,rep,name=assignees,proto3" json:"assignees,omitempty"`
 	Epoch                          *EpochInfo                   `protobuf:"bytes,11,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Budget                         *TrainingBudget              `protobuf:"bytes,12,opt,name=budget,proto3" json:"budget,omitempty"`
+	CancelledAtBlockHeight         uint64                       `protobuf:"varint,13,opt,name=cancelled_at_block_height,json=cancelledAtBlockHeight,proto3" json:"cancelled_at_block_height,omitempty"`
 }
 
 func (x *TrainingTask) Reset() {
@@ -3831,6 +4556,91 @@ func (x *TrainingTask) GetEpoch() *EpochInfo {
 	return nil
 }
 
+func (x *TrainingTask) GetBudget() *TrainingBudget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *TrainingTask) GetCancelledAtBlockHeight() uint64 {
+	if x != nil {
+		return x.CancelledAtBlockHeight
+	}
+	return 0
+}
+
+// TrainingBudget is the escrow of a training task. The requester locks total when creating the task. Each
+// ranked node of an outer step whose participant committed a checkpoint hash for that step earns
+// payment_per_node_epoch, and whatever is left is refunded when the task finishes or is cancelled.
+type TrainingBudget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total               uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PaymentPerNodeEpoch uint64 `protobuf:"varint,2,opt,name=payment_per_node_epoch,json=paymentPerNodeEpoch,proto3" json:"payment_per_node_epoch,omitempty"`
+	Paid                uint64 `protobuf:"varint,3,opt,name=paid,proto3" json:"paid,omitempty"`
+	Refunded            uint64 `protobuf:"varint,4,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	// next_outer_step_to_settle is the first outer step not paid for yet
+	NextOuterStepToSettle int32 `protobuf:"varint,5,opt,name=next_outer_step_to_settle,json=nextOuterStepToSettle,proto3" json:"next_outer_step_to_settle,omitempty"`
+}
+
+func (x *TrainingBudget) Reset() {
+	*x = TrainingBudget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_training_task_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrainingBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainingBudget) ProtoMessage() {}
+
+// Deprecated: Use TrainingBudget.ProtoReflect.Descriptor instead.
+func (*TrainingBudget) Descriptor() ([]byte, []int) {
+	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TrainingBudget) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TrainingBudget) GetPaymentPerNodeEpoch() uint64 {
+	if x != nil {
+		return x.PaymentPerNodeEpoch
+	}
+	return 0
+}
+
+func (x *TrainingBudget) GetPaid() uint64 {
+	if x != nil {
+		return x.Paid
+	}
+	return 0
+}
+
+func (x *TrainingBudget) GetRefunded() uint64 {
+	if x != nil {
+		return x.Refunded
+	}
+	return 0
+}
+
+func (x *TrainingBudget) GetNextOuterStepToSettle() int32 {
+	if x != nil {
+		return x.NextOuterStepToSettle
+	}
+	return 0
+}
+
 type TrainingHardwareResources struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3843,7 +4653,7 @@ type TrainingHardwareResources struct {
 func (x *TrainingHardwareResources) Reset() {
 	*x = TrainingHardwareResources{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_training_task_proto_msgTypes[1]
+		mi := &file_inference_inference_training_task_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3857,7 +4667,7 @@ func (*TrainingHardwareResources) ProtoMessage() {}
 
 // Deprecated: Use TrainingHardwareResources.ProtoReflect.Descriptor instead.
 func (*TrainingHardwareResources) Descriptor() ([]byte, []int) {
-	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{1}
+	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *TrainingHardwareResources) GetType_() string {
@@ -3886,7 +4696,7 @@ type TrainingConfig struct {
 func (x *TrainingConfig) Reset() {
 	*x = TrainingConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_training_task_proto_msgTypes[2]
+		mi := &file_inference_inference_training_task_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3900,7 +4710,7 @@ func (*TrainingConfig) ProtoMessage() {}
 
 // Deprecated: Use TrainingConfig.ProtoReflect.Descriptor instead.
 func (*TrainingConfig) Descriptor() ([]byte, []int) {
-	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{2}
+	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TrainingConfig) GetDatasets() *TrainingDatasets {
@@ -3929,7 +4739,7 @@ type TrainingDatasets struct {
 func (x *TrainingDatasets) Reset() {
 	*x = TrainingDatasets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_training_task_proto_msgTypes[3]
+		mi := &file_inference_inference_training_task_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3943,7 +4753,7 @@ func (*TrainingDatasets) ProtoMessage() {}
 
 // Deprecated: Use TrainingDatasets.ProtoReflect.Descriptor instead.
 func (*TrainingDatasets) Descriptor() ([]byte, []int) {
-	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{3}
+	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TrainingDatasets) GetTrain() string {
@@ -3972,7 +4782,7 @@ type TrainingTaskAssignee struct {
 func (x *TrainingTaskAssignee) Reset() {
 	*x = TrainingTaskAssignee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_training_task_proto_msgTypes[4]
+		mi := &file_inference_inference_training_task_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3986,7 +4796,7 @@ func (*TrainingTaskAssignee) ProtoMessage() {}
 
 // Deprecated: Use TrainingTaskAssignee.ProtoReflect.Descriptor instead.
 func (*TrainingTaskAssignee) Descriptor() ([]byte, []int) {
-	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{4}
+	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TrainingTaskAssignee) GetParticipant() string {
@@ -4017,7 +4827,7 @@ type EpochInfo struct {
 func (x *EpochInfo) Reset() {
 	*x = EpochInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_inference_training_task_proto_msgTypes[5]
+		mi := &file_inference_inference_training_task_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4031,7 +4841,7 @@ func (*EpochInfo) ProtoMessage() {}
 
 // Deprecated: Use EpochInfo.ProtoReflect.Descriptor instead.
 func (*EpochInfo) Descriptor() ([]byte, []int) {
-	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{5}
+	return file_inference_inference_training_task_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EpochInfo) GetLastEpoch() int32 {
@@ -4068,8 +4878,8 @@ var file_inference_inference_training_task_proto_rawDesc = []byte{
 	0x0a, 0x27, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
 	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74,
 	0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x69, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xee,
-	0x04, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xe6,
+	0x05, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
 	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
@@ -4107,7 +4917,27 @@ var file_inference_inference_training_task_proto_rawDesc = []byte{
 	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x70, 0x6f,
 	0x63, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
 	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x45,
-	0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22,
+	0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x3b, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x19,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x16, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xc5, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x33, 0x0a, 0x16, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x19, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x75,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x65, 0x70, 0x54, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x22,
 	0x45, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x64, 0x77,
 	0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
 	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
@@ -4170,26 +5000,28 @@ func file_inference_inference_training_task_proto_rawDescGZIP() []byte {
 	return file_inference_inference_training_task_proto_rawDescData
 }
 
-var file_inference_inference_training_task_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_inference_inference_training_task_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_inference_inference_training_task_proto_goTypes = []interface{}{
 	(*TrainingTask)(nil),              // 0: inference.inference.TrainingTask
-	(*TrainingHardwareResources)(nil), // 1: inference.inference.TrainingHardwareResources
-	(*TrainingConfig)(nil),            // 2: inference.inference.TrainingConfig
-	(*TrainingDatasets)(nil),          // 3: inference.inference.TrainingDatasets
-	(*TrainingTaskAssignee)(nil),      // 4: inference.inference.TrainingTaskAssignee
-	(*EpochInfo)(nil),                 // 5: inference.inference.EpochInfo
+	(*TrainingBudget)(nil),            // 1: inference.inference.TrainingBudget
+	(*TrainingHardwareResources)(nil), // 2: inference.inference.TrainingHardwareResources
+	(*TrainingConfig)(nil),            // 3: inference.inference.TrainingConfig
+	(*TrainingDatasets)(nil),          // 4: inference.inference.TrainingDatasets
+	(*TrainingTaskAssignee)(nil),      // 5: inference.inference.TrainingTaskAssignee
+	(*EpochInfo)(nil),                 // 6: inference.inference.EpochInfo
 }
 var file_inference_inference_training_task_proto_depIdxs = []int32{
-	1, // 0: inference.inference.TrainingTask.hardware_resources:type_name -> inference.inference.TrainingHardwareResources
-	2, // 1: inference.inference.TrainingTask.config:type_name -> inference.inference.TrainingConfig
-	4, // 2: inference.inference.TrainingTask.assignees:type_name -> inference.inference.TrainingTaskAssignee
-	5, // 3: inference.inference.TrainingTask.epoch:type_name -> inference.inference.EpochInfo
-	3, // 4: inference.inference.TrainingConfig.datasets:type_name -> inference.inference.TrainingDatasets
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	2, // 0: inference.inference.TrainingTask.hardware_resources:type_name -> inference.inference.TrainingHardwareResources
+	3, // 1: inference.inference.TrainingTask.config:type_name -> inference.inference.TrainingConfig
+	5, // 2: inference.inference.TrainingTask.assignees:type_name -> inference.inference.TrainingTaskAssignee
+	6, // 3: inference.inference.TrainingTask.epoch:type_name -> inference.inference.EpochInfo
+	1, // 4: inference.inference.TrainingTask.budget:type_name -> inference.inference.TrainingBudget
+	4, // 5: inference.inference.TrainingConfig.datasets:type_name -> inference.inference.TrainingDatasets
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_inference_inference_training_task_proto_init() }
@@ -4211,7 +5043,7 @@ func file_inference_inference_training_task_proto_init() {
 			}
 		}
 		file_inference_inference_training_task_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrainingHardwareResources); i {
+			switch v := v.(*TrainingBudget); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4223,7 +5055,7 @@ func file_inference_inference_training_task_proto_init() {
 			}
 		}
 		file_inference_inference_training_task_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrainingConfig); i {
+			switch v := v.(*TrainingHardwareResources); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4235,7 +5067,7 @@ func file_inference_inference_training_task_proto_init() {
 			}
 		}
 		file_inference_inference_training_task_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrainingDatasets); i {
+			switch v := v.(*TrainingConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4247,7 +5079,7 @@ func file_inference_inference_training_task_proto_init() {
 			}
 		}
 		file_inference_inference_training_task_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrainingTaskAssignee); i {
+			switch v := v.(*TrainingDatasets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4259,6 +5091,18 @@ func file_inference_inference_training_task_proto_init() {
 			}
 		}
 		file_inference_inference_training_task_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrainingTaskAssignee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_training_task_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EpochInfo); i {
 			case 0:
 				return &v.state
@@ -4277,7 +5121,7 @@ func file_inference_inference_training_task_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_inference_inference_training_task_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
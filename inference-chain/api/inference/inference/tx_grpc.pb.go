@@ -38,6 +38,9 @@ const (
 	Msg_CreatePartialUpgrade_FullMethodName             = "/inference.inference.Msg/CreatePartialUpgrade"
 	Msg_ClaimTrainingTaskForAssignment_FullMethodName   = "/inference.inference.Msg/ClaimTrainingTaskForAssignment"
 	Msg_AssignTrainingTask_FullMethodName               = "/inference.inference.Msg/AssignTrainingTask"
+	Msg_SettleTrainingPayments_FullMethodName           = "/inference.inference.Msg/SettleTrainingPayments"
+	Msg_FinishTrainingTask_FullMethodName               = "/inference.inference.Msg/FinishTrainingTask"
+	Msg_CancelTrainingTask_FullMethodName               = "/inference.inference.Msg/CancelTrainingTask"
 	Msg_SubmitTrainingKvRecord_FullMethodName           = "/inference.inference.Msg/SubmitTrainingKvRecord"
 	Msg_JoinTraining_FullMethodName                     = "/inference.inference.Msg/JoinTraining"
 	Msg_TrainingHeartbeat_FullMethodName                = "/inference.inference.Msg/TrainingHeartbeat"
@@ -51,6 +54,8 @@ const (
 	Msg_ApproveBridgeTokenForTrading_FullMethodName     = "/inference.inference.Msg/ApproveBridgeTokenForTrading"
 	Msg_RequestBridgeWithdrawal_FullMethodName          = "/inference.inference.Msg/RequestBridgeWithdrawal"
 	Msg_RequestBridgeMint_FullMethodName                = "/inference.inference.Msg/RequestBridgeMint"
+	Msg_SetBridgeRateLimit_FullMethodName               = "/inference.inference.Msg/SetBridgeRateLimit"
+	Msg_SetBridgePaused_FullMethodName                  = "/inference.inference.Msg/SetBridgePaused"
 	Msg_RegisterWrappedTokenContract_FullMethodName     = "/inference.inference.Msg/RegisterWrappedTokenContract"
 	Msg_MigrateAllWrappedTokens_FullMethodName          = "/inference.inference.Msg/MigrateAllWrappedTokens"
 	Msg_AddUserToTrainingAllowList_FullMethodName       = "/inference.inference.Msg/AddUserToTrainingAllowList"
@@ -58,6 +63,11 @@ const (
 	Msg_SetTrainingAllowList_FullMethodName             = "/inference.inference.Msg/SetTrainingAllowList"
 	Msg_AddParticipantsToAllowList_FullMethodName       = "/inference.inference.Msg/AddParticipantsToAllowList"
 	Msg_RemoveParticipantsFromAllowList_FullMethodName  = "/inference.inference.Msg/RemoveParticipantsFromAllowList"
+	Msg_AppealInvalidation_FullMethodName               = "/inference.inference.Msg/AppealInvalidation"
+	Msg_SubmitAppealVote_FullMethodName                 = "/inference.inference.Msg/SubmitAppealVote"
+	Msg_SetModelStatus_FullMethodName                   = "/inference.inference.Msg/SetModelStatus"
+	Msg_SetModelAlias_FullMethodName                    = "/inference.inference.Msg/SetModelAlias"
+	Msg_AttestBridgeBlock_FullMethodName                = "/inference.inference.Msg/AttestBridgeBlock"
 )
 
 // MsgClient is the client API for Msg service.
@@ -85,6 +95,9 @@ type MsgClient interface {
 	CreatePartialUpgrade(ctx context.Context, in *MsgCreatePartialUpgrade, opts ...grpc.CallOption) (*MsgCreatePartialUpgradeResponse, error)
 	ClaimTrainingTaskForAssignment(ctx context.Context, in *MsgClaimTrainingTaskForAssignment, opts ...grpc.CallOption) (*MsgClaimTrainingTaskForAssignmentResponse, error)
 	AssignTrainingTask(ctx context.Context, in *MsgAssignTrainingTask, opts ...grpc.CallOption) (*MsgAssignTrainingTaskResponse, error)
+	SettleTrainingPayments(ctx context.Context, in *MsgSettleTrainingPayments, opts ...grpc.CallOption) (*MsgSettleTrainingPaymentsResponse, error)
+	FinishTrainingTask(ctx context.Context, in *MsgFinishTrainingTask, opts ...grpc.CallOption) (*MsgFinishTrainingTaskResponse, error)
+	CancelTrainingTask(ctx context.Context, in *MsgCancelTrainingTask, opts ...grpc.CallOption) (*MsgCancelTrainingTaskResponse, error)
 	SubmitTrainingKvRecord(ctx context.Context, in *MsgSubmitTrainingKvRecord, opts ...grpc.CallOption) (*MsgSubmitTrainingKvRecordResponse, error)
 	JoinTraining(ctx context.Context, in *MsgJoinTraining, opts ...grpc.CallOption) (*MsgJoinTrainingResponse, error)
 	TrainingHeartbeat(ctx context.Context, in *MsgTrainingHeartbeat, opts ...grpc.CallOption) (*MsgTrainingHeartbeatResponse, error)
@@ -98,6 +111,8 @@ type MsgClient interface {
 	ApproveBridgeTokenForTrading(ctx context.Context, in *MsgApproveBridgeTokenForTrading, opts ...grpc.CallOption) (*MsgApproveBridgeTokenForTradingResponse, error)
 	RequestBridgeWithdrawal(ctx context.Context, in *MsgRequestBridgeWithdrawal, opts ...grpc.CallOption) (*MsgRequestBridgeWithdrawalResponse, error)
 	RequestBridgeMint(ctx context.Context, in *MsgRequestBridgeMint, opts ...grpc.CallOption) (*MsgRequestBridgeMintResponse, error)
+	SetBridgeRateLimit(ctx context.Context, in *MsgSetBridgeRateLimit, opts ...grpc.CallOption) (*MsgSetBridgeRateLimitResponse, error)
+	SetBridgePaused(ctx context.Context, in *MsgSetBridgePaused, opts ...grpc.CallOption) (*MsgSetBridgePausedResponse, error)
 	RegisterWrappedTokenContract(ctx context.Context, in *MsgRegisterWrappedTokenContract, opts ...grpc.CallOption) (*MsgRegisterWrappedTokenContractResponse, error)
 	MigrateAllWrappedTokens(ctx context.Context, in *MsgMigrateAllWrappedTokens, opts ...grpc.CallOption) (*MsgMigrateAllWrappedTokensResponse, error)
 	AddUserToTrainingAllowList(ctx context.Context, in *MsgAddUserToTrainingAllowList, opts ...grpc.CallOption) (*MsgAddUserToTrainingAllowListResponse, error)
@@ -105,6 +120,11 @@ type MsgClient interface {
 	SetTrainingAllowList(ctx context.Context, in *MsgSetTrainingAllowList, opts ...grpc.CallOption) (*MsgSetTrainingAllowListResponse, error)
 	AddParticipantsToAllowList(ctx context.Context, in *MsgAddParticipantsToAllowList, opts ...grpc.CallOption) (*MsgAddParticipantsToAllowListResponse, error)
 	RemoveParticipantsFromAllowList(ctx context.Context, in *MsgRemoveParticipantsFromAllowList, opts ...grpc.CallOption) (*MsgRemoveParticipantsFromAllowListResponse, error)
+	AppealInvalidation(ctx context.Context, in *MsgAppealInvalidation, opts ...grpc.CallOption) (*MsgAppealInvalidationResponse, error)
+	SubmitAppealVote(ctx context.Context, in *MsgSubmitAppealVote, opts ...grpc.CallOption) (*MsgSubmitAppealVoteResponse, error)
+	SetModelStatus(ctx context.Context, in *MsgSetModelStatus, opts ...grpc.CallOption) (*MsgSetModelStatusResponse, error)
+	SetModelAlias(ctx context.Context, in *MsgSetModelAlias, opts ...grpc.CallOption) (*MsgSetModelAliasResponse, error)
+	AttestBridgeBlock(ctx context.Context, in *MsgAttestBridgeBlock, opts ...grpc.CallOption) (*MsgAttestBridgeBlockResponse, error)
 }
 
 type msgClient struct {
@@ -286,6 +306,33 @@ func (c *msgClient) AssignTrainingTask(ctx context.Context, in *MsgAssignTrainin
 	return out, nil
 }
 
+func (c *msgClient) SettleTrainingPayments(ctx context.Context, in *MsgSettleTrainingPayments, opts ...grpc.CallOption) (*MsgSettleTrainingPaymentsResponse, error) {
+	out := new(MsgSettleTrainingPaymentsResponse)
+	err := c.cc.Invoke(ctx, Msg_SettleTrainingPayments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinishTrainingTask(ctx context.Context, in *MsgFinishTrainingTask, opts ...grpc.CallOption) (*MsgFinishTrainingTaskResponse, error) {
+	out := new(MsgFinishTrainingTaskResponse)
+	err := c.cc.Invoke(ctx, Msg_FinishTrainingTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelTrainingTask(ctx context.Context, in *MsgCancelTrainingTask, opts ...grpc.CallOption) (*MsgCancelTrainingTaskResponse, error) {
+	out := new(MsgCancelTrainingTaskResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelTrainingTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitTrainingKvRecord(ctx context.Context, in *MsgSubmitTrainingKvRecord, opts ...grpc.CallOption) (*MsgSubmitTrainingKvRecordResponse, error) {
 	out := new(MsgSubmitTrainingKvRecordResponse)
 	err := c.cc.Invoke(ctx, Msg_SubmitTrainingKvRecord_FullMethodName, in, out, opts...)
@@ -403,6 +450,24 @@ func (c *msgClient) RequestBridgeMint(ctx context.Context, in *MsgRequestBridgeM
 	return out, nil
 }
 
+func (c *msgClient) SetBridgeRateLimit(ctx context.Context, in *MsgSetBridgeRateLimit, opts ...grpc.CallOption) (*MsgSetBridgeRateLimitResponse, error) {
+	out := new(MsgSetBridgeRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetBridgeRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBridgePaused(ctx context.Context, in *MsgSetBridgePaused, opts ...grpc.CallOption) (*MsgSetBridgePausedResponse, error) {
+	out := new(MsgSetBridgePausedResponse)
+	err := c.cc.Invoke(ctx, Msg_SetBridgePaused_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RegisterWrappedTokenContract(ctx context.Context, in *MsgRegisterWrappedTokenContract, opts ...grpc.CallOption) (*MsgRegisterWrappedTokenContractResponse, error) {
 	out := new(MsgRegisterWrappedTokenContractResponse)
 	err := c.cc.Invoke(ctx, Msg_RegisterWrappedTokenContract_FullMethodName, in, out, opts...)
@@ -466,6 +531,51 @@ func (c *msgClient) RemoveParticipantsFromAllowList(ctx context.Context, in *Msg
 	return out, nil
 }
 
+func (c *msgClient) AppealInvalidation(ctx context.Context, in *MsgAppealInvalidation, opts ...grpc.CallOption) (*MsgAppealInvalidationResponse, error) {
+	out := new(MsgAppealInvalidationResponse)
+	err := c.cc.Invoke(ctx, Msg_AppealInvalidation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitAppealVote(ctx context.Context, in *MsgSubmitAppealVote, opts ...grpc.CallOption) (*MsgSubmitAppealVoteResponse, error) {
+	out := new(MsgSubmitAppealVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitAppealVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetModelStatus(ctx context.Context, in *MsgSetModelStatus, opts ...grpc.CallOption) (*MsgSetModelStatusResponse, error) {
+	out := new(MsgSetModelStatusResponse)
+	err := c.cc.Invoke(ctx, Msg_SetModelStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetModelAlias(ctx context.Context, in *MsgSetModelAlias, opts ...grpc.CallOption) (*MsgSetModelAliasResponse, error) {
+	out := new(MsgSetModelAliasResponse)
+	err := c.cc.Invoke(ctx, Msg_SetModelAlias_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestBridgeBlock(ctx context.Context, in *MsgAttestBridgeBlock, opts ...grpc.CallOption) (*MsgAttestBridgeBlockResponse, error) {
+	out := new(MsgAttestBridgeBlockResponse)
+	err := c.cc.Invoke(ctx, Msg_AttestBridgeBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -491,6 +601,9 @@ type MsgServer interface {
 	CreatePartialUpgrade(context.Context, *MsgCreatePartialUpgrade) (*MsgCreatePartialUpgradeResponse, error)
 	ClaimTrainingTaskForAssignment(context.Context, *MsgClaimTrainingTaskForAssignment) (*MsgClaimTrainingTaskForAssignmentResponse, error)
 	AssignTrainingTask(context.Context, *MsgAssignTrainingTask) (*MsgAssignTrainingTaskResponse, error)
+	SettleTrainingPayments(context.Context, *MsgSettleTrainingPayments) (*MsgSettleTrainingPaymentsResponse, error)
+	FinishTrainingTask(context.Context, *MsgFinishTrainingTask) (*MsgFinishTrainingTaskResponse, error)
+	CancelTrainingTask(context.Context, *MsgCancelTrainingTask) (*MsgCancelTrainingTaskResponse, error)
 	SubmitTrainingKvRecord(context.Context, *MsgSubmitTrainingKvRecord) (*MsgSubmitTrainingKvRecordResponse, error)
 	JoinTraining(context.Context, *MsgJoinTraining) (*MsgJoinTrainingResponse, error)
 	TrainingHeartbeat(context.Context, *MsgTrainingHeartbeat) (*MsgTrainingHeartbeatResponse, error)
@@ -504,6 +617,8 @@ type MsgServer interface {
 	ApproveBridgeTokenForTrading(context.Context, *MsgApproveBridgeTokenForTrading) (*MsgApproveBridgeTokenForTradingResponse, error)
 	RequestBridgeWithdrawal(context.Context, *MsgRequestBridgeWithdrawal) (*MsgRequestBridgeWithdrawalResponse, error)
 	RequestBridgeMint(context.Context, *MsgRequestBridgeMint) (*MsgRequestBridgeMintResponse, error)
+	SetBridgeRateLimit(context.Context, *MsgSetBridgeRateLimit) (*MsgSetBridgeRateLimitResponse, error)
+	SetBridgePaused(context.Context, *MsgSetBridgePaused) (*MsgSetBridgePausedResponse, error)
 	RegisterWrappedTokenContract(context.Context, *MsgRegisterWrappedTokenContract) (*MsgRegisterWrappedTokenContractResponse, error)
 	MigrateAllWrappedTokens(context.Context, *MsgMigrateAllWrappedTokens) (*MsgMigrateAllWrappedTokensResponse, error)
 	AddUserToTrainingAllowList(context.Context, *MsgAddUserToTrainingAllowList) (*MsgAddUserToTrainingAllowListResponse, error)
@@ -511,6 +626,11 @@ type MsgServer interface {
 	SetTrainingAllowList(context.Context, *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error)
 	AddParticipantsToAllowList(context.Context, *MsgAddParticipantsToAllowList) (*MsgAddParticipantsToAllowListResponse, error)
 	RemoveParticipantsFromAllowList(context.Context, *MsgRemoveParticipantsFromAllowList) (*MsgRemoveParticipantsFromAllowListResponse, error)
+	AppealInvalidation(context.Context, *MsgAppealInvalidation) (*MsgAppealInvalidationResponse, error)
+	SubmitAppealVote(context.Context, *MsgSubmitAppealVote) (*MsgSubmitAppealVoteResponse, error)
+	SetModelStatus(context.Context, *MsgSetModelStatus) (*MsgSetModelStatusResponse, error)
+	SetModelAlias(context.Context, *MsgSetModelAlias) (*MsgSetModelAliasResponse, error)
+	AttestBridgeBlock(context.Context, *MsgAttestBridgeBlock) (*MsgAttestBridgeBlockResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -575,6 +695,15 @@ func (UnimplementedMsgServer) ClaimTrainingTaskForAssignment(context.Context, *M
 func (UnimplementedMsgServer) AssignTrainingTask(context.Context, *MsgAssignTrainingTask) (*MsgAssignTrainingTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssignTrainingTask not implemented")
 }
+func (UnimplementedMsgServer) SettleTrainingPayments(context.Context, *MsgSettleTrainingPayments) (*MsgSettleTrainingPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleTrainingPayments not implemented")
+}
+func (UnimplementedMsgServer) FinishTrainingTask(context.Context, *MsgFinishTrainingTask) (*MsgFinishTrainingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishTrainingTask not implemented")
+}
+func (UnimplementedMsgServer) CancelTrainingTask(context.Context, *MsgCancelTrainingTask) (*MsgCancelTrainingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTrainingTask not implemented")
+}
 func (UnimplementedMsgServer) SubmitTrainingKvRecord(context.Context, *MsgSubmitTrainingKvRecord) (*MsgSubmitTrainingKvRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitTrainingKvRecord not implemented")
 }
@@ -614,6 +743,12 @@ func (UnimplementedMsgServer) RequestBridgeWithdrawal(context.Context, *MsgReque
 func (UnimplementedMsgServer) RequestBridgeMint(context.Context, *MsgRequestBridgeMint) (*MsgRequestBridgeMintResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestBridgeMint not implemented")
 }
+func (UnimplementedMsgServer) SetBridgeRateLimit(context.Context, *MsgSetBridgeRateLimit) (*MsgSetBridgeRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBridgeRateLimit not implemented")
+}
+func (UnimplementedMsgServer) SetBridgePaused(context.Context, *MsgSetBridgePaused) (*MsgSetBridgePausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBridgePaused not implemented")
+}
 func (UnimplementedMsgServer) RegisterWrappedTokenContract(context.Context, *MsgRegisterWrappedTokenContract) (*MsgRegisterWrappedTokenContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterWrappedTokenContract not implemented")
 }
@@ -635,6 +770,21 @@ func (UnimplementedMsgServer) AddParticipantsToAllowList(context.Context, *MsgAd
 func (UnimplementedMsgServer) RemoveParticipantsFromAllowList(context.Context, *MsgRemoveParticipantsFromAllowList) (*MsgRemoveParticipantsFromAllowListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveParticipantsFromAllowList not implemented")
 }
+func (UnimplementedMsgServer) AppealInvalidation(context.Context, *MsgAppealInvalidation) (*MsgAppealInvalidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealInvalidation not implemented")
+}
+func (UnimplementedMsgServer) SubmitAppealVote(context.Context, *MsgSubmitAppealVote) (*MsgSubmitAppealVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAppealVote not implemented")
+}
+func (UnimplementedMsgServer) SetModelStatus(context.Context, *MsgSetModelStatus) (*MsgSetModelStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetModelStatus not implemented")
+}
+func (UnimplementedMsgServer) SetModelAlias(context.Context, *MsgSetModelAlias) (*MsgSetModelAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetModelAlias not implemented")
+}
+func (UnimplementedMsgServer) AttestBridgeBlock(context.Context, *MsgAttestBridgeBlock) (*MsgAttestBridgeBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestBridgeBlock not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -990,6 +1140,60 @@ func _Msg_AssignTrainingTask_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SettleTrainingPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettleTrainingPayments)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SettleTrainingPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SettleTrainingPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettleTrainingPayments(ctx, req.(*MsgSettleTrainingPayments))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinishTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinishTrainingTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinishTrainingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FinishTrainingTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinishTrainingTask(ctx, req.(*MsgFinishTrainingTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTrainingTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTrainingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelTrainingTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTrainingTask(ctx, req.(*MsgCancelTrainingTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitTrainingKvRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitTrainingKvRecord)
 	if err := dec(in); err != nil {
@@ -1224,6 +1428,42 @@ func _Msg_RequestBridgeMint_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetBridgeRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBridgeRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBridgeRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetBridgeRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBridgeRateLimit(ctx, req.(*MsgSetBridgeRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBridgePaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBridgePaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBridgePaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetBridgePaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBridgePaused(ctx, req.(*MsgSetBridgePaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RegisterWrappedTokenContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRegisterWrappedTokenContract)
 	if err := dec(in); err != nil {
@@ -1350,6 +1590,96 @@ func _Msg_RemoveParticipantsFromAllowList_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AppealInvalidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppealInvalidation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppealInvalidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AppealInvalidation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppealInvalidation(ctx, req.(*MsgAppealInvalidation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitAppealVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitAppealVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitAppealVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitAppealVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitAppealVote(ctx, req.(*MsgSubmitAppealVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetModelStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetModelStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetModelStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetModelStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetModelStatus(ctx, req.(*MsgSetModelStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetModelAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetModelAlias)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetModelAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetModelAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetModelAlias(ctx, req.(*MsgSetModelAlias))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestBridgeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestBridgeBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestBridgeBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AttestBridgeBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestBridgeBlock(ctx, req.(*MsgAttestBridgeBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1433,6 +1763,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AssignTrainingTask",
 			Handler:    _Msg_AssignTrainingTask_Handler,
 		},
+		{
+			MethodName: "SettleTrainingPayments",
+			Handler:    _Msg_SettleTrainingPayments_Handler,
+		},
+		{
+			MethodName: "FinishTrainingTask",
+			Handler:    _Msg_FinishTrainingTask_Handler,
+		},
+		{
+			MethodName: "CancelTrainingTask",
+			Handler:    _Msg_CancelTrainingTask_Handler,
+		},
 		{
 			MethodName: "SubmitTrainingKvRecord",
 			Handler:    _Msg_SubmitTrainingKvRecord_Handler,
@@ -1485,6 +1827,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestBridgeMint",
 			Handler:    _Msg_RequestBridgeMint_Handler,
 		},
+		{
+			MethodName: "SetBridgeRateLimit",
+			Handler:    _Msg_SetBridgeRateLimit_Handler,
+		},
+		{
+			MethodName: "SetBridgePaused",
+			Handler:    _Msg_SetBridgePaused_Handler,
+		},
 		{
 			MethodName: "RegisterWrappedTokenContract",
 			Handler:    _Msg_RegisterWrappedTokenContract_Handler,
@@ -1513,6 +1863,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveParticipantsFromAllowList",
 			Handler:    _Msg_RemoveParticipantsFromAllowList_Handler,
 		},
+		{
+			MethodName: "AppealInvalidation",
+			Handler:    _Msg_AppealInvalidation_Handler,
+		},
+		{
+			MethodName: "SubmitAppealVote",
+			Handler:    _Msg_SubmitAppealVote_Handler,
+		},
+		{
+			MethodName: "SetModelStatus",
+			Handler:    _Msg_SetModelStatus_Handler,
+		},
+		{
+			MethodName: "SetModelAlias",
+			Handler:    _Msg_SetModelAlias_Handler,
+		},
+		{
+			MethodName: "AttestBridgeBlock",
+			Handler:    _Msg_AttestBridgeBlock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/inference/tx.proto",
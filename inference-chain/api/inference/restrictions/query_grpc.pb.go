@@ -23,6 +23,7 @@ const (
 	Query_TransferRestrictionStatus_FullMethodName = "/inference.restrictions.Query/TransferRestrictionStatus"
 	Query_TransferExemptions_FullMethodName        = "/inference.restrictions.Query/TransferExemptions"
 	Query_ExemptionUsage_FullMethodName            = "/inference.restrictions.Query/ExemptionUsage"
+	Query_UnlockAllowance_FullMethodName           = "/inference.restrictions.Query/UnlockAllowance"
 )
 
 // QueryClient is the client API for Query service.
@@ -37,6 +38,8 @@ type QueryClient interface {
 	TransferExemptions(ctx context.Context, in *QueryTransferExemptionsRequest, opts ...grpc.CallOption) (*QueryTransferExemptionsResponse, error)
 	// ExemptionUsage queries usage statistics for emergency exemptions
 	ExemptionUsage(ctx context.Context, in *QueryExemptionUsageRequest, opts ...grpc.CallOption) (*QueryExemptionUsageResponse, error)
+	// UnlockAllowance queries the graduated unlock allowance of an account
+	UnlockAllowance(ctx context.Context, in *QueryUnlockAllowanceRequest, opts ...grpc.CallOption) (*QueryUnlockAllowanceResponse, error)
 }
 
 type queryClient struct {
@@ -83,6 +86,15 @@ func (c *queryClient) ExemptionUsage(ctx context.Context, in *QueryExemptionUsag
 	return out, nil
 }
 
+func (c *queryClient) UnlockAllowance(ctx context.Context, in *QueryUnlockAllowanceRequest, opts ...grpc.CallOption) (*QueryUnlockAllowanceResponse, error) {
+	out := new(QueryUnlockAllowanceResponse)
+	err := c.cc.Invoke(ctx, Query_UnlockAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -95,6 +107,8 @@ type QueryServer interface {
 	TransferExemptions(context.Context, *QueryTransferExemptionsRequest) (*QueryTransferExemptionsResponse, error)
 	// ExemptionUsage queries usage statistics for emergency exemptions
 	ExemptionUsage(context.Context, *QueryExemptionUsageRequest) (*QueryExemptionUsageResponse, error)
+	// UnlockAllowance queries the graduated unlock allowance of an account
+	UnlockAllowance(context.Context, *QueryUnlockAllowanceRequest) (*QueryUnlockAllowanceResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -114,6 +128,9 @@ func (UnimplementedQueryServer) TransferExemptions(context.Context, *QueryTransf
 func (UnimplementedQueryServer) ExemptionUsage(context.Context, *QueryExemptionUsageRequest) (*QueryExemptionUsageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExemptionUsage not implemented")
 }
+func (UnimplementedQueryServer) UnlockAllowance(context.Context, *QueryUnlockAllowanceRequest) (*QueryUnlockAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockAllowance not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -199,6 +216,24 @@ func _Query_ExemptionUsage_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UnlockAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnlockAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnlockAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnlockAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnlockAllowance(ctx, req.(*QueryUnlockAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,6 +257,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExemptionUsage",
 			Handler:    _Query_ExemptionUsage_Handler,
 		},
+		{
+			MethodName: "UnlockAllowance",
+			Handler:    _Query_UnlockAllowance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/restrictions/query.proto",
@@ -22,6 +22,7 @@ const (
 	Query_Params_FullMethodName             = "/inference.streamvesting.Query/Params"
 	Query_VestingSchedule_FullMethodName    = "/inference.streamvesting.Query/VestingSchedule"
 	Query_TotalVestingAmount_FullMethodName = "/inference.streamvesting.Query/TotalVestingAmount"
+	Query_ProjectedUnlocks_FullMethodName   = "/inference.streamvesting.Query/ProjectedUnlocks"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +35,9 @@ type QueryClient interface {
 	VestingSchedule(ctx context.Context, in *QueryVestingScheduleRequest, opts ...grpc.CallOption) (*QueryVestingScheduleResponse, error)
 	// TotalVestingAmount queries the total vesting amount for a participant.
 	TotalVestingAmount(ctx context.Context, in *QueryTotalVestingAmountRequest, opts ...grpc.CallOption) (*QueryTotalVestingAmountResponse, error)
+	// ProjectedUnlocks projects the date of each of a participant's upcoming unlocks from the observed epoch length,
+	// with a per-source breakdown.
+	ProjectedUnlocks(ctx context.Context, in *QueryProjectedUnlocksRequest, opts ...grpc.CallOption) (*QueryProjectedUnlocksResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +75,15 @@ func (c *queryClient) TotalVestingAmount(ctx context.Context, in *QueryTotalVest
 	return out, nil
 }
 
+func (c *queryClient) ProjectedUnlocks(ctx context.Context, in *QueryProjectedUnlocksRequest, opts ...grpc.CallOption) (*QueryProjectedUnlocksResponse, error) {
+	out := new(QueryProjectedUnlocksResponse)
+	err := c.cc.Invoke(ctx, Query_ProjectedUnlocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +94,9 @@ type QueryServer interface {
 	VestingSchedule(context.Context, *QueryVestingScheduleRequest) (*QueryVestingScheduleResponse, error)
 	// TotalVestingAmount queries the total vesting amount for a participant.
 	TotalVestingAmount(context.Context, *QueryTotalVestingAmountRequest) (*QueryTotalVestingAmountResponse, error)
+	// ProjectedUnlocks projects the date of each of a participant's upcoming unlocks from the observed epoch length,
+	// with a per-source breakdown.
+	ProjectedUnlocks(context.Context, *QueryProjectedUnlocksRequest) (*QueryProjectedUnlocksResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +113,9 @@ func (UnimplementedQueryServer) VestingSchedule(context.Context, *QueryVestingSc
 func (UnimplementedQueryServer) TotalVestingAmount(context.Context, *QueryTotalVestingAmountRequest) (*QueryTotalVestingAmountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalVestingAmount not implemented")
 }
+func (UnimplementedQueryServer) ProjectedUnlocks(context.Context, *QueryProjectedUnlocksRequest) (*QueryProjectedUnlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedUnlocks not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +183,24 @@ func _Query_TotalVestingAmount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedUnlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedUnlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedUnlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProjectedUnlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedUnlocks(ctx, req.(*QueryProjectedUnlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +220,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TotalVestingAmount",
 			Handler:    _Query_TotalVestingAmount_Handler,
 		},
+		{
+			MethodName: "ProjectedUnlocks",
+			Handler:    _Query_ProjectedUnlocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/streamvesting/query.proto",
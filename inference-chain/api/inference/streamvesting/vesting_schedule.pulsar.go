@@ -15,77 +15,79 @@ import (
 	sync "sync"
 )
 
-var _ protoreflect.List = (*_EpochCoins_1_list)(nil)
+var _ protoreflect.List = (*_SourceCoins_2_list)(nil)
 
-type _EpochCoins_1_list struct {
+type _SourceCoins_2_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_EpochCoins_1_list) Len() int {
+func (x *_SourceCoins_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_EpochCoins_1_list) Get(i int) protoreflect.Value {
+func (x *_SourceCoins_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_EpochCoins_1_list) Set(i int, value protoreflect.Value) {
+func (x *_SourceCoins_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_EpochCoins_1_list) Append(value protoreflect.Value) {
+func (x *_SourceCoins_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_EpochCoins_1_list) AppendMutable() protoreflect.Value {
+func (x *_SourceCoins_2_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_EpochCoins_1_list) Truncate(n int) {
+func (x *_SourceCoins_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_EpochCoins_1_list) NewElement() protoreflect.Value {
+func (x *_SourceCoins_2_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_EpochCoins_1_list) IsValid() bool {
+func (x *_SourceCoins_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_EpochCoins       protoreflect.MessageDescriptor
-	fd_EpochCoins_coins protoreflect.FieldDescriptor
+	md_SourceCoins        protoreflect.MessageDescriptor
+	fd_SourceCoins_source protoreflect.FieldDescriptor
+	fd_SourceCoins_coins  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_inference_streamvesting_vesting_schedule_proto_init()
-	md_EpochCoins = File_inference_streamvesting_vesting_schedule_proto.Messages().ByName("EpochCoins")
-	fd_EpochCoins_coins = md_EpochCoins.Fields().ByName("coins")
+	md_SourceCoins = File_inference_streamvesting_vesting_schedule_proto.Messages().ByName("SourceCoins")
+	fd_SourceCoins_source = md_SourceCoins.Fields().ByName("source")
+	fd_SourceCoins_coins = md_SourceCoins.Fields().ByName("coins")
 }
 
-var _ protoreflect.Message = (*fastReflection_EpochCoins)(nil)
+var _ protoreflect.Message = (*fastReflection_SourceCoins)(nil)
 
-type fastReflection_EpochCoins EpochCoins
+type fastReflection_SourceCoins SourceCoins
 
-func (x *EpochCoins) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EpochCoins)(x)
+func (x *SourceCoins) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SourceCoins)(x)
 }
 
-func (x *EpochCoins) slowProtoReflect() protoreflect.Message {
+func (x *SourceCoins) slowProtoReflect() protoreflect.Message {
 	mi := &file_inference_streamvesting_vesting_schedule_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -97,43 +99,43 @@ func (x *EpochCoins) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EpochCoins_messageType fastReflection_EpochCoins_messageType
-var _ protoreflect.MessageType = fastReflection_EpochCoins_messageType{}
+var _fastReflection_SourceCoins_messageType fastReflection_SourceCoins_messageType
+var _ protoreflect.MessageType = fastReflection_SourceCoins_messageType{}
 
-type fastReflection_EpochCoins_messageType struct{}
+type fastReflection_SourceCoins_messageType struct{}
 
-func (x fastReflection_EpochCoins_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EpochCoins)(nil)
+func (x fastReflection_SourceCoins_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SourceCoins)(nil)
 }
-func (x fastReflection_EpochCoins_messageType) New() protoreflect.Message {
-	return new(fastReflection_EpochCoins)
+func (x fastReflection_SourceCoins_messageType) New() protoreflect.Message {
+	return new(fastReflection_SourceCoins)
 }
-func (x fastReflection_EpochCoins_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EpochCoins
+func (x fastReflection_SourceCoins_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SourceCoins
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EpochCoins) Descriptor() protoreflect.MessageDescriptor {
-	return md_EpochCoins
+func (x *fastReflection_SourceCoins) Descriptor() protoreflect.MessageDescriptor {
+	return md_SourceCoins
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EpochCoins) Type() protoreflect.MessageType {
-	return _fastReflection_EpochCoins_messageType
+func (x *fastReflection_SourceCoins) Type() protoreflect.MessageType {
+	return _fastReflection_SourceCoins_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EpochCoins) New() protoreflect.Message {
-	return new(fastReflection_EpochCoins)
+func (x *fastReflection_SourceCoins) New() protoreflect.Message {
+	return new(fastReflection_SourceCoins)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EpochCoins) Interface() protoreflect.ProtoMessage {
-	return (*EpochCoins)(x)
+func (x *fastReflection_SourceCoins) Interface() protoreflect.ProtoMessage {
+	return (*SourceCoins)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -141,10 +143,16 @@ func (x *fastReflection_EpochCoins) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EpochCoins) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_SourceCoins) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Source != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Source))
+		if !f(fd_SourceCoins_source, value) {
+			return
+		}
+	}
 	if len(x.Coins) != 0 {
-		value := protoreflect.ValueOfList(&_EpochCoins_1_list{list: &x.Coins})
-		if !f(fd_EpochCoins_coins, value) {
+		value := protoreflect.ValueOfList(&_SourceCoins_2_list{list: &x.Coins})
+		if !f(fd_SourceCoins_coins, value) {
 			return
 		}
 	}
@@ -161,15 +169,17 @@ func (x *fastReflection_EpochCoins) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EpochCoins) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SourceCoins) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "inference.streamvesting.EpochCoins.coins":
+	case "inference.streamvesting.SourceCoins.source":
+		return x.Source != 0
+	case "inference.streamvesting.SourceCoins.coins":
 		return len(x.Coins) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochCoins"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.SourceCoins"))
 		}
-		panic(fmt.Errorf("message inference.streamvesting.EpochCoins does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.streamvesting.SourceCoins does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -179,15 +189,17 @@ func (x *fastReflection_EpochCoins) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EpochCoins) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SourceCoins) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "inference.streamvesting.EpochCoins.coins":
+	case "inference.streamvesting.SourceCoins.source":
+		x.Source = 0
+	case "inference.streamvesting.SourceCoins.coins":
 		x.Coins = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochCoins"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.SourceCoins"))
 		}
-		panic(fmt.Errorf("message inference.streamvesting.EpochCoins does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.streamvesting.SourceCoins does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -197,19 +209,22 @@ func (x *fastReflection_EpochCoins) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EpochCoins) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SourceCoins) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "inference.streamvesting.EpochCoins.coins":
+	case "inference.streamvesting.SourceCoins.source":
+		value := x.Source
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "inference.streamvesting.SourceCoins.coins":
 		if len(x.Coins) == 0 {
-			return protoreflect.ValueOfList(&_EpochCoins_1_list{})
+			return protoreflect.ValueOfList(&_SourceCoins_2_list{})
 		}
-		listValue := &_EpochCoins_1_list{list: &x.Coins}
+		listValue := &_SourceCoins_2_list{list: &x.Coins}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochCoins"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.SourceCoins"))
 		}
-		panic(fmt.Errorf("message inference.streamvesting.EpochCoins does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message inference.streamvesting.SourceCoins does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -223,17 +238,19 @@ func (x *fastReflection_EpochCoins) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EpochCoins) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SourceCoins) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "inference.streamvesting.EpochCoins.coins":
+	case "inference.streamvesting.SourceCoins.source":
+		x.Source = (VestingSource)(value.Enum())
+	case "inference.streamvesting.SourceCoins.coins":
 		lv := value.List()
-		clv := lv.(*_EpochCoins_1_list)
+		clv := lv.(*_SourceCoins_2_list)
 		x.Coins = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochCoins"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.SourceCoins"))
 		}
-		panic(fmt.Errorf("message inference.streamvesting.EpochCoins does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.streamvesting.SourceCoins does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -247,45 +264,49 @@ func (x *fastReflection_EpochCoins) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EpochCoins) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SourceCoins) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "inference.streamvesting.EpochCoins.coins":
+	case "inference.streamvesting.SourceCoins.coins":
 		if x.Coins == nil {
 			x.Coins = []*v1beta1.Coin{}
 		}
-		value := &_EpochCoins_1_list{list: &x.Coins}
+		value := &_SourceCoins_2_list{list: &x.Coins}
 		return protoreflect.ValueOfList(value)
+	case "inference.streamvesting.SourceCoins.source":
+		panic(fmt.Errorf("field source of message inference.streamvesting.SourceCoins is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochCoins"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.SourceCoins"))
 		}
-		panic(fmt.Errorf("message inference.streamvesting.EpochCoins does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.streamvesting.SourceCoins does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EpochCoins) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SourceCoins) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "inference.streamvesting.EpochCoins.coins":
+	case "inference.streamvesting.SourceCoins.source":
+		return protoreflect.ValueOfEnum(0)
+	case "inference.streamvesting.SourceCoins.coins":
 		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_EpochCoins_1_list{list: &list})
+		return protoreflect.ValueOfList(&_SourceCoins_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochCoins"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.SourceCoins"))
 		}
-		panic(fmt.Errorf("message inference.streamvesting.EpochCoins does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.streamvesting.SourceCoins does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EpochCoins) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SourceCoins) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in inference.streamvesting.EpochCoins", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in inference.streamvesting.SourceCoins", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -293,7 +314,7 @@ func (x *fastReflection_EpochCoins) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EpochCoins) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SourceCoins) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -304,7 +325,7 @@ func (x *fastReflection_EpochCoins) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EpochCoins) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SourceCoins) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -316,7 +337,7 @@ func (x *fastReflection_EpochCoins) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EpochCoins) IsValid() bool {
+func (x *fastReflection_SourceCoins) IsValid() bool {
 	return x != nil
 }
 
@@ -326,9 +347,9 @@ func (x *fastReflection_EpochCoins) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EpochCoins) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SourceCoins) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EpochCoins)
+		x := input.Message.Interface().(*SourceCoins)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -340,6 +361,9 @@ func (x *fastReflection_EpochCoins) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.Source != 0 {
+			n += 1 + runtime.Sov(uint64(x.Source))
+		}
 		if len(x.Coins) > 0 {
 			for _, e := range x.Coins {
 				l = options.Size(e)
@@ -356,7 +380,7 @@ func (x *fastReflection_EpochCoins) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EpochCoins)
+		x := input.Message.Interface().(*SourceCoins)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -388,9 +412,14 @@ func (x *fastReflection_EpochCoins) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x12
 			}
 		}
+		if x.Source != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Source))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -402,7 +431,7 @@ func (x *fastReflection_EpochCoins) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EpochCoins)
+		x := input.Message.Interface().(*SourceCoins)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -434,13 +463,32 @@ func (x *fastReflection_EpochCoins) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochCoins: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SourceCoins: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SourceCoins: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				}
+				x.Source = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Source |= VestingSource(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 				}
@@ -509,79 +557,130 @@ func (x *fastReflection_EpochCoins) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_VestingSchedule_2_list)(nil)
+var _ protoreflect.List = (*_EpochCoins_1_list)(nil)
 
-type _VestingSchedule_2_list struct {
-	list *[]*EpochCoins
+type _EpochCoins_1_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_VestingSchedule_2_list) Len() int {
+func (x *_EpochCoins_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_VestingSchedule_2_list) Get(i int) protoreflect.Value {
+func (x *_EpochCoins_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_VestingSchedule_2_list) Set(i int, value protoreflect.Value) {
+func (x *_EpochCoins_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*EpochCoins)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_VestingSchedule_2_list) Append(value protoreflect.Value) {
+func (x *_EpochCoins_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*EpochCoins)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_VestingSchedule_2_list) AppendMutable() protoreflect.Value {
-	v := new(EpochCoins)
+func (x *_EpochCoins_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_VestingSchedule_2_list) Truncate(n int) {
+func (x *_EpochCoins_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_VestingSchedule_2_list) NewElement() protoreflect.Value {
-	v := new(EpochCoins)
+func (x *_EpochCoins_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_VestingSchedule_2_list) IsValid() bool {
+func (x *_EpochCoins_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EpochCoins_2_list)(nil)
+
+type _EpochCoins_2_list struct {
+	list *[]*SourceCoins
+}
+
+func (x *_EpochCoins_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EpochCoins_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EpochCoins_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SourceCoins)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EpochCoins_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SourceCoins)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EpochCoins_2_list) AppendMutable() protoreflect.Value {
+	v := new(SourceCoins)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EpochCoins_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EpochCoins_2_list) NewElement() protoreflect.Value {
+	v := new(SourceCoins)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EpochCoins_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_VestingSchedule                     protoreflect.MessageDescriptor
-	fd_VestingSchedule_participant_address protoreflect.FieldDescriptor
-	fd_VestingSchedule_epoch_amounts       protoreflect.FieldDescriptor
+	md_EpochCoins         protoreflect.MessageDescriptor
+	fd_EpochCoins_coins   protoreflect.FieldDescriptor
+	fd_EpochCoins_sources protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_inference_streamvesting_vesting_schedule_proto_init()
-	md_VestingSchedule = File_inference_streamvesting_vesting_schedule_proto.Messages().ByName("VestingSchedule")
-	fd_VestingSchedule_participant_address = md_VestingSchedule.Fields().ByName("participant_address")
-	fd_VestingSchedule_epoch_amounts = md_VestingSchedule.Fields().ByName("epoch_amounts")
+	md_EpochCoins = File_inference_streamvesting_vesting_schedule_proto.Messages().ByName("EpochCoins")
+	fd_EpochCoins_coins = md_EpochCoins.Fields().ByName("coins")
+	fd_EpochCoins_sources = md_EpochCoins.Fields().ByName("sources")
 }
 
-var _ protoreflect.Message = (*fastReflection_VestingSchedule)(nil)
+var _ protoreflect.Message = (*fastReflection_EpochCoins)(nil)
 
-type fastReflection_VestingSchedule VestingSchedule
+type fastReflection_EpochCoins EpochCoins
 
-func (x *VestingSchedule) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_VestingSchedule)(x)
+func (x *EpochCoins) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EpochCoins)(x)
 }
 
-func (x *VestingSchedule) slowProtoReflect() protoreflect.Message {
+func (x *EpochCoins) slowProtoReflect() protoreflect.Message {
 	mi := &file_inference_streamvesting_vesting_schedule_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -593,43 +692,43 @@ func (x *VestingSchedule) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_VestingSchedule_messageType fastReflection_VestingSchedule_messageType
-var _ protoreflect.MessageType = fastReflection_VestingSchedule_messageType{}
+var _fastReflection_EpochCoins_messageType fastReflection_EpochCoins_messageType
+var _ protoreflect.MessageType = fastReflection_EpochCoins_messageType{}
 
-type fastReflection_VestingSchedule_messageType struct{}
+type fastReflection_EpochCoins_messageType struct{}
 
-func (x fastReflection_VestingSchedule_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_VestingSchedule)(nil)
+func (x fastReflection_EpochCoins_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EpochCoins)(nil)
 }
-func (x fastReflection_VestingSchedule_messageType) New() protoreflect.Message {
-	return new(fastReflection_VestingSchedule)
+func (x fastReflection_EpochCoins_messageType) New() protoreflect.Message {
+	return new(fastReflection_EpochCoins)
 }
-func (x fastReflection_VestingSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_VestingSchedule
+func (x fastReflection_EpochCoins_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochCoins
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_VestingSchedule) Descriptor() protoreflect.MessageDescriptor {
-	return md_VestingSchedule
+func (x *fastReflection_EpochCoins) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochCoins
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_VestingSchedule) Type() protoreflect.MessageType {
-	return _fastReflection_VestingSchedule_messageType
+func (x *fastReflection_EpochCoins) Type() protoreflect.MessageType {
+	return _fastReflection_EpochCoins_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_VestingSchedule) New() protoreflect.Message {
-	return new(fastReflection_VestingSchedule)
+func (x *fastReflection_EpochCoins) New() protoreflect.Message {
+	return new(fastReflection_EpochCoins)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_VestingSchedule) Interface() protoreflect.ProtoMessage {
-	return (*VestingSchedule)(x)
+func (x *fastReflection_EpochCoins) Interface() protoreflect.ProtoMessage {
+	return (*EpochCoins)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -637,16 +736,16 @@ func (x *fastReflection_VestingSchedule) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_VestingSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ParticipantAddress != "" {
-		value := protoreflect.ValueOfString(x.ParticipantAddress)
-		if !f(fd_VestingSchedule_participant_address, value) {
+func (x *fastReflection_EpochCoins) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Coins) != 0 {
+		value := protoreflect.ValueOfList(&_EpochCoins_1_list{list: &x.Coins})
+		if !f(fd_EpochCoins_coins, value) {
 			return
 		}
 	}
-	if len(x.EpochAmounts) != 0 {
-		value := protoreflect.ValueOfList(&_VestingSchedule_2_list{list: &x.EpochAmounts})
-		if !f(fd_VestingSchedule_epoch_amounts, value) {
+	if len(x.Sources) != 0 {
+		value := protoreflect.ValueOfList(&_EpochCoins_2_list{list: &x.Sources})
+		if !f(fd_EpochCoins_sources, value) {
 			return
 		}
 	}
@@ -663,17 +762,17 @@ func (x *fastReflection_VestingSchedule) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_VestingSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EpochCoins) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "inference.streamvesting.VestingSchedule.participant_address":
-		return x.ParticipantAddress != ""
-	case "inference.streamvesting.VestingSchedule.epoch_amounts":
-		return len(x.EpochAmounts) != 0
+	case "inference.streamvesting.EpochCoins.coins":
+		return len(x.Coins) != 0
+	case "inference.streamvesting.EpochCoins.sources":
+		return len(x.Sources) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.VestingSchedule"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochCoins"))
 		}
-		panic(fmt.Errorf("message inference.streamvesting.VestingSchedule does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.streamvesting.EpochCoins does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -683,17 +782,17 @@ func (x *fastReflection_VestingSchedule) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VestingSchedule) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EpochCoins) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "inference.streamvesting.VestingSchedule.participant_address":
-		x.ParticipantAddress = ""
-	case "inference.streamvesting.VestingSchedule.epoch_amounts":
-		x.EpochAmounts = nil
+	case "inference.streamvesting.EpochCoins.coins":
+		x.Coins = nil
+	case "inference.streamvesting.EpochCoins.sources":
+		x.Sources = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.VestingSchedule"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochCoins"))
 		}
-		panic(fmt.Errorf("message inference.streamvesting.VestingSchedule does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.streamvesting.EpochCoins does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -703,22 +802,25 @@ func (x *fastReflection_VestingSchedule) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_VestingSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EpochCoins) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "inference.streamvesting.VestingSchedule.participant_address":
-		value := x.ParticipantAddress
-		return protoreflect.ValueOfString(value)
-	case "inference.streamvesting.VestingSchedule.epoch_amounts":
-		if len(x.EpochAmounts) == 0 {
-			return protoreflect.ValueOfList(&_VestingSchedule_2_list{})
+	case "inference.streamvesting.EpochCoins.coins":
+		if len(x.Coins) == 0 {
+			return protoreflect.ValueOfList(&_EpochCoins_1_list{})
 		}
-		listValue := &_VestingSchedule_2_list{list: &x.EpochAmounts}
+		listValue := &_EpochCoins_1_list{list: &x.Coins}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.streamvesting.EpochCoins.sources":
+		if len(x.Sources) == 0 {
+			return protoreflect.ValueOfList(&_EpochCoins_2_list{})
+		}
+		listValue := &_EpochCoins_2_list{list: &x.Sources}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.VestingSchedule"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochCoins"))
 		}
-		panic(fmt.Errorf("message inference.streamvesting.VestingSchedule does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message inference.streamvesting.EpochCoins does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -732,19 +834,21 @@ func (x *fastReflection_VestingSchedule) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VestingSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EpochCoins) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "inference.streamvesting.VestingSchedule.participant_address":
-		x.ParticipantAddress = value.Interface().(string)
-	case "inference.streamvesting.VestingSchedule.epoch_amounts":
+	case "inference.streamvesting.EpochCoins.coins":
 		lv := value.List()
-		clv := lv.(*_VestingSchedule_2_list)
-		x.EpochAmounts = *clv.list
+		clv := lv.(*_EpochCoins_1_list)
+		x.Coins = *clv.list
+	case "inference.streamvesting.EpochCoins.sources":
+		lv := value.List()
+		clv := lv.(*_EpochCoins_2_list)
+		x.Sources = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.VestingSchedule"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochCoins"))
 		}
-		panic(fmt.Errorf("message inference.streamvesting.VestingSchedule does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.streamvesting.EpochCoins does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -758,49 +862,1185 @@ func (x *fastReflection_VestingSchedule) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VestingSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EpochCoins) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "inference.streamvesting.VestingSchedule.epoch_amounts":
-		if x.EpochAmounts == nil {
-			x.EpochAmounts = []*EpochCoins{}
+	case "inference.streamvesting.EpochCoins.coins":
+		if x.Coins == nil {
+			x.Coins = []*v1beta1.Coin{}
 		}
-		value := &_VestingSchedule_2_list{list: &x.EpochAmounts}
+		value := &_EpochCoins_1_list{list: &x.Coins}
 		return protoreflect.ValueOfList(value)
-	case "inference.streamvesting.VestingSchedule.participant_address":
-		panic(fmt.Errorf("field participant_address of message inference.streamvesting.VestingSchedule is not mutable"))
+	case "inference.streamvesting.EpochCoins.sources":
+		if x.Sources == nil {
+			x.Sources = []*SourceCoins{}
+		}
+		value := &_EpochCoins_2_list{list: &x.Sources}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochCoins"))
+		}
+		panic(fmt.Errorf("message inference.streamvesting.EpochCoins does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EpochCoins) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.streamvesting.EpochCoins.coins":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_EpochCoins_1_list{list: &list})
+	case "inference.streamvesting.EpochCoins.sources":
+		list := []*SourceCoins{}
+		return protoreflect.ValueOfList(&_EpochCoins_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochCoins"))
+		}
+		panic(fmt.Errorf("message inference.streamvesting.EpochCoins does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EpochCoins) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.streamvesting.EpochCoins", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EpochCoins) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochCoins) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EpochCoins) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EpochCoins) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EpochCoins)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Coins) > 0 {
+			for _, e := range x.Coins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Sources) > 0 {
+			for _, e := range x.Sources {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EpochCoins)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Sources) > 0 {
+			for iNdEx := len(x.Sources) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Sources[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Coins) > 0 {
+			for iNdEx := len(x.Coins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Coins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EpochCoins)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochCoins: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Coins = append(x.Coins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Coins[len(x.Coins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sources = append(x.Sources, &SourceCoins{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Sources[len(x.Sources)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VestingSchedule_2_list)(nil)
+
+type _VestingSchedule_2_list struct {
+	list *[]*EpochCoins
+}
+
+func (x *_VestingSchedule_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VestingSchedule_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VestingSchedule_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochCoins)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VestingSchedule_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochCoins)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VestingSchedule_2_list) AppendMutable() protoreflect.Value {
+	v := new(EpochCoins)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VestingSchedule_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VestingSchedule_2_list) NewElement() protoreflect.Value {
+	v := new(EpochCoins)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VestingSchedule_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VestingSchedule                     protoreflect.MessageDescriptor
+	fd_VestingSchedule_participant_address protoreflect.FieldDescriptor
+	fd_VestingSchedule_epoch_amounts       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_streamvesting_vesting_schedule_proto_init()
+	md_VestingSchedule = File_inference_streamvesting_vesting_schedule_proto.Messages().ByName("VestingSchedule")
+	fd_VestingSchedule_participant_address = md_VestingSchedule.Fields().ByName("participant_address")
+	fd_VestingSchedule_epoch_amounts = md_VestingSchedule.Fields().ByName("epoch_amounts")
+}
+
+var _ protoreflect.Message = (*fastReflection_VestingSchedule)(nil)
+
+type fastReflection_VestingSchedule VestingSchedule
+
+func (x *VestingSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VestingSchedule)(x)
+}
+
+func (x *VestingSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_streamvesting_vesting_schedule_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VestingSchedule_messageType fastReflection_VestingSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_VestingSchedule_messageType{}
+
+type fastReflection_VestingSchedule_messageType struct{}
+
+func (x fastReflection_VestingSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VestingSchedule)(nil)
+}
+func (x fastReflection_VestingSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_VestingSchedule)
+}
+func (x fastReflection_VestingSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingSchedule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VestingSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingSchedule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VestingSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_VestingSchedule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VestingSchedule) New() protoreflect.Message {
+	return new(fastReflection_VestingSchedule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VestingSchedule) Interface() protoreflect.ProtoMessage {
+	return (*VestingSchedule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VestingSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ParticipantAddress != "" {
+		value := protoreflect.ValueOfString(x.ParticipantAddress)
+		if !f(fd_VestingSchedule_participant_address, value) {
+			return
+		}
+	}
+	if len(x.EpochAmounts) != 0 {
+		value := protoreflect.ValueOfList(&_VestingSchedule_2_list{list: &x.EpochAmounts})
+		if !f(fd_VestingSchedule_epoch_amounts, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VestingSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.streamvesting.VestingSchedule.participant_address":
+		return x.ParticipantAddress != ""
+	case "inference.streamvesting.VestingSchedule.epoch_amounts":
+		return len(x.EpochAmounts) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message inference.streamvesting.VestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.streamvesting.VestingSchedule.participant_address":
+		x.ParticipantAddress = ""
+	case "inference.streamvesting.VestingSchedule.epoch_amounts":
+		x.EpochAmounts = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message inference.streamvesting.VestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VestingSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.streamvesting.VestingSchedule.participant_address":
+		value := x.ParticipantAddress
+		return protoreflect.ValueOfString(value)
+	case "inference.streamvesting.VestingSchedule.epoch_amounts":
+		if len(x.EpochAmounts) == 0 {
+			return protoreflect.ValueOfList(&_VestingSchedule_2_list{})
+		}
+		listValue := &_VestingSchedule_2_list{list: &x.EpochAmounts}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message inference.streamvesting.VestingSchedule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.streamvesting.VestingSchedule.participant_address":
+		x.ParticipantAddress = value.Interface().(string)
+	case "inference.streamvesting.VestingSchedule.epoch_amounts":
+		lv := value.List()
+		clv := lv.(*_VestingSchedule_2_list)
+		x.EpochAmounts = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message inference.streamvesting.VestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.streamvesting.VestingSchedule.epoch_amounts":
+		if x.EpochAmounts == nil {
+			x.EpochAmounts = []*EpochCoins{}
+		}
+		value := &_VestingSchedule_2_list{list: &x.EpochAmounts}
+		return protoreflect.ValueOfList(value)
+	case "inference.streamvesting.VestingSchedule.participant_address":
+		panic(fmt.Errorf("field participant_address of message inference.streamvesting.VestingSchedule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message inference.streamvesting.VestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VestingSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.streamvesting.VestingSchedule.participant_address":
+		return protoreflect.ValueOfString("")
+	case "inference.streamvesting.VestingSchedule.epoch_amounts":
+		list := []*EpochCoins{}
+		return protoreflect.ValueOfList(&_VestingSchedule_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message inference.streamvesting.VestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VestingSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.streamvesting.VestingSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VestingSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VestingSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VestingSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ParticipantAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.EpochAmounts) > 0 {
+			for _, e := range x.EpochAmounts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VestingSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EpochAmounts) > 0 {
+			for iNdEx := len(x.EpochAmounts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EpochAmounts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.ParticipantAddress) > 0 {
+			i -= len(x.ParticipantAddress)
+			copy(dAtA[i:], x.ParticipantAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ParticipantAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VestingSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ParticipantAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochAmounts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EpochAmounts = append(x.EpochAmounts, &EpochCoins{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EpochAmounts[len(x.EpochAmounts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EpochTiming                    protoreflect.MessageDescriptor
+	fd_EpochTiming_last_epoch         protoreflect.FieldDescriptor
+	fd_EpochTiming_last_unlock_height protoreflect.FieldDescriptor
+	fd_EpochTiming_last_unlock_time   protoreflect.FieldDescriptor
+	fd_EpochTiming_epoch_length       protoreflect.FieldDescriptor
+	fd_EpochTiming_epoch_duration     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_streamvesting_vesting_schedule_proto_init()
+	md_EpochTiming = File_inference_streamvesting_vesting_schedule_proto.Messages().ByName("EpochTiming")
+	fd_EpochTiming_last_epoch = md_EpochTiming.Fields().ByName("last_epoch")
+	fd_EpochTiming_last_unlock_height = md_EpochTiming.Fields().ByName("last_unlock_height")
+	fd_EpochTiming_last_unlock_time = md_EpochTiming.Fields().ByName("last_unlock_time")
+	fd_EpochTiming_epoch_length = md_EpochTiming.Fields().ByName("epoch_length")
+	fd_EpochTiming_epoch_duration = md_EpochTiming.Fields().ByName("epoch_duration")
+}
+
+var _ protoreflect.Message = (*fastReflection_EpochTiming)(nil)
+
+type fastReflection_EpochTiming EpochTiming
+
+func (x *EpochTiming) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EpochTiming)(x)
+}
+
+func (x *EpochTiming) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_streamvesting_vesting_schedule_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EpochTiming_messageType fastReflection_EpochTiming_messageType
+var _ protoreflect.MessageType = fastReflection_EpochTiming_messageType{}
+
+type fastReflection_EpochTiming_messageType struct{}
+
+func (x fastReflection_EpochTiming_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EpochTiming)(nil)
+}
+func (x fastReflection_EpochTiming_messageType) New() protoreflect.Message {
+	return new(fastReflection_EpochTiming)
+}
+func (x fastReflection_EpochTiming_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochTiming
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EpochTiming) Descriptor() protoreflect.MessageDescriptor {
+	return md_EpochTiming
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EpochTiming) Type() protoreflect.MessageType {
+	return _fastReflection_EpochTiming_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EpochTiming) New() protoreflect.Message {
+	return new(fastReflection_EpochTiming)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EpochTiming) Interface() protoreflect.ProtoMessage {
+	return (*EpochTiming)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EpochTiming) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LastEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastEpoch)
+		if !f(fd_EpochTiming_last_epoch, value) {
+			return
+		}
+	}
+	if x.LastUnlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastUnlockHeight)
+		if !f(fd_EpochTiming_last_unlock_height, value) {
+			return
+		}
+	}
+	if x.LastUnlockTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastUnlockTime)
+		if !f(fd_EpochTiming_last_unlock_time, value) {
+			return
+		}
+	}
+	if x.EpochLength != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EpochLength)
+		if !f(fd_EpochTiming_epoch_length, value) {
+			return
+		}
+	}
+	if x.EpochDuration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EpochDuration)
+		if !f(fd_EpochTiming_epoch_duration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EpochTiming) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.streamvesting.EpochTiming.last_epoch":
+		return x.LastEpoch != uint64(0)
+	case "inference.streamvesting.EpochTiming.last_unlock_height":
+		return x.LastUnlockHeight != int64(0)
+	case "inference.streamvesting.EpochTiming.last_unlock_time":
+		return x.LastUnlockTime != int64(0)
+	case "inference.streamvesting.EpochTiming.epoch_length":
+		return x.EpochLength != int64(0)
+	case "inference.streamvesting.EpochTiming.epoch_duration":
+		return x.EpochDuration != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochTiming"))
+		}
+		panic(fmt.Errorf("message inference.streamvesting.EpochTiming does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochTiming) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.streamvesting.EpochTiming.last_epoch":
+		x.LastEpoch = uint64(0)
+	case "inference.streamvesting.EpochTiming.last_unlock_height":
+		x.LastUnlockHeight = int64(0)
+	case "inference.streamvesting.EpochTiming.last_unlock_time":
+		x.LastUnlockTime = int64(0)
+	case "inference.streamvesting.EpochTiming.epoch_length":
+		x.EpochLength = int64(0)
+	case "inference.streamvesting.EpochTiming.epoch_duration":
+		x.EpochDuration = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochTiming"))
+		}
+		panic(fmt.Errorf("message inference.streamvesting.EpochTiming does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EpochTiming) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.streamvesting.EpochTiming.last_epoch":
+		value := x.LastEpoch
+		return protoreflect.ValueOfUint64(value)
+	case "inference.streamvesting.EpochTiming.last_unlock_height":
+		value := x.LastUnlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.streamvesting.EpochTiming.last_unlock_time":
+		value := x.LastUnlockTime
+		return protoreflect.ValueOfInt64(value)
+	case "inference.streamvesting.EpochTiming.epoch_length":
+		value := x.EpochLength
+		return protoreflect.ValueOfInt64(value)
+	case "inference.streamvesting.EpochTiming.epoch_duration":
+		value := x.EpochDuration
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochTiming"))
+		}
+		panic(fmt.Errorf("message inference.streamvesting.EpochTiming does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochTiming) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.streamvesting.EpochTiming.last_epoch":
+		x.LastEpoch = value.Uint()
+	case "inference.streamvesting.EpochTiming.last_unlock_height":
+		x.LastUnlockHeight = value.Int()
+	case "inference.streamvesting.EpochTiming.last_unlock_time":
+		x.LastUnlockTime = value.Int()
+	case "inference.streamvesting.EpochTiming.epoch_length":
+		x.EpochLength = value.Int()
+	case "inference.streamvesting.EpochTiming.epoch_duration":
+		x.EpochDuration = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochTiming"))
+		}
+		panic(fmt.Errorf("message inference.streamvesting.EpochTiming does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EpochTiming) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.streamvesting.EpochTiming.last_epoch":
+		panic(fmt.Errorf("field last_epoch of message inference.streamvesting.EpochTiming is not mutable"))
+	case "inference.streamvesting.EpochTiming.last_unlock_height":
+		panic(fmt.Errorf("field last_unlock_height of message inference.streamvesting.EpochTiming is not mutable"))
+	case "inference.streamvesting.EpochTiming.last_unlock_time":
+		panic(fmt.Errorf("field last_unlock_time of message inference.streamvesting.EpochTiming is not mutable"))
+	case "inference.streamvesting.EpochTiming.epoch_length":
+		panic(fmt.Errorf("field epoch_length of message inference.streamvesting.EpochTiming is not mutable"))
+	case "inference.streamvesting.EpochTiming.epoch_duration":
+		panic(fmt.Errorf("field epoch_duration of message inference.streamvesting.EpochTiming is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.VestingSchedule"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochTiming"))
 		}
-		panic(fmt.Errorf("message inference.streamvesting.VestingSchedule does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.streamvesting.EpochTiming does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_VestingSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EpochTiming) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "inference.streamvesting.VestingSchedule.participant_address":
-		return protoreflect.ValueOfString("")
-	case "inference.streamvesting.VestingSchedule.epoch_amounts":
-		list := []*EpochCoins{}
-		return protoreflect.ValueOfList(&_VestingSchedule_2_list{list: &list})
+	case "inference.streamvesting.EpochTiming.last_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.streamvesting.EpochTiming.last_unlock_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.streamvesting.EpochTiming.last_unlock_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.streamvesting.EpochTiming.epoch_length":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.streamvesting.EpochTiming.epoch_duration":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.VestingSchedule"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.EpochTiming"))
 		}
-		panic(fmt.Errorf("message inference.streamvesting.VestingSchedule does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message inference.streamvesting.EpochTiming does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_VestingSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EpochTiming) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in inference.streamvesting.VestingSchedule", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in inference.streamvesting.EpochTiming", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -808,7 +2048,7 @@ func (x *fastReflection_VestingSchedule) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_VestingSchedule) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EpochTiming) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -819,7 +2059,7 @@ func (x *fastReflection_VestingSchedule) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VestingSchedule) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EpochTiming) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -831,7 +2071,7 @@ func (x *fastReflection_VestingSchedule) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_VestingSchedule) IsValid() bool {
+func (x *fastReflection_EpochTiming) IsValid() bool {
 	return x != nil
 }
 
@@ -841,9 +2081,9 @@ func (x *fastReflection_VestingSchedule) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EpochTiming) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*VestingSchedule)
+		x := input.Message.Interface().(*EpochTiming)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -855,15 +2095,20 @@ func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.ParticipantAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.LastEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastEpoch))
 		}
-		if len(x.EpochAmounts) > 0 {
-			for _, e := range x.EpochAmounts {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.LastUnlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUnlockHeight))
+		}
+		if x.LastUnlockTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUnlockTime))
+		}
+		if x.EpochLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochLength))
+		}
+		if x.EpochDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochDuration))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -875,7 +2120,7 @@ func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*VestingSchedule)
+		x := input.Message.Interface().(*EpochTiming)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -894,28 +2139,30 @@ func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.EpochAmounts) > 0 {
-			for iNdEx := len(x.EpochAmounts) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.EpochAmounts[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
+		if x.EpochDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochDuration))
+			i--
+			dAtA[i] = 0x28
 		}
-		if len(x.ParticipantAddress) > 0 {
-			i -= len(x.ParticipantAddress)
-			copy(dAtA[i:], x.ParticipantAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ParticipantAddress)))
+		if x.EpochLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochLength))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x20
+		}
+		if x.LastUnlockTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUnlockTime))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.LastUnlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUnlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.LastEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastEpoch))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -928,7 +2175,7 @@ func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*VestingSchedule)
+		x := input.Message.Interface().(*EpochTiming)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -960,17 +2207,17 @@ func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochTiming: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EpochTiming: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantAddress", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastEpoch", wireType)
 				}
-				var stringLen uint64
+				x.LastEpoch = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -980,29 +2227,35 @@ func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.LastEpoch |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUnlockHeight", wireType)
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.LastUnlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUnlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				x.ParticipantAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochAmounts", wireType)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUnlockTime", wireType)
 				}
-				var msglen int
+				x.LastUnlockTime = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1012,26 +2265,49 @@ func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.LastUnlockTime |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.EpochLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochLength |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
 				}
-				x.EpochAmounts = append(x.EpochAmounts, &EpochCoins{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EpochAmounts[len(x.EpochAmounts)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.EpochDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochDuration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1080,6 +2356,110 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// VestingSource identifies the reward stream a vesting tranche was funded by
+type VestingSource int32
+
+const (
+	VestingSource_VESTING_SOURCE_UNSPECIFIED VestingSource = 0
+	// Fees paid by requesters for inference work
+	VestingSource_VESTING_SOURCE_WORK VestingSource = 1
+	// Epoch subsidy rewards
+	VestingSource_VESTING_SOURCE_SUBSIDY VestingSource = 2
+	// Top miner payouts from the top reward pool
+	VestingSource_VESTING_SOURCE_TOP_MINER VestingSource = 3
+	// Fixed bitcoin-style epoch rewards
+	VestingSource_VESTING_SOURCE_BITCOIN_REWARD VestingSource = 4
+)
+
+// Enum value maps for VestingSource.
+var (
+	VestingSource_name = map[int32]string{
+		0: "VESTING_SOURCE_UNSPECIFIED",
+		1: "VESTING_SOURCE_WORK",
+		2: "VESTING_SOURCE_SUBSIDY",
+		3: "VESTING_SOURCE_TOP_MINER",
+		4: "VESTING_SOURCE_BITCOIN_REWARD",
+	}
+	VestingSource_value = map[string]int32{
+		"VESTING_SOURCE_UNSPECIFIED":    0,
+		"VESTING_SOURCE_WORK":           1,
+		"VESTING_SOURCE_SUBSIDY":        2,
+		"VESTING_SOURCE_TOP_MINER":      3,
+		"VESTING_SOURCE_BITCOIN_REWARD": 4,
+	}
+)
+
+func (x VestingSource) Enum() *VestingSource {
+	p := new(VestingSource)
+	*p = x
+	return p
+}
+
+func (x VestingSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VestingSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_inference_streamvesting_vesting_schedule_proto_enumTypes[0].Descriptor()
+}
+
+func (VestingSource) Type() protoreflect.EnumType {
+	return &file_inference_streamvesting_vesting_schedule_proto_enumTypes[0]
+}
+
+func (x VestingSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VestingSource.Descriptor instead.
+func (VestingSource) EnumDescriptor() ([]byte, []int) {
+	return file_inference_streamvesting_vesting_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+// SourceCoins is the part of a tranche that came from a single reward source
+type SourceCoins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source VestingSource   `protobuf:"varint,1,opt,name=source,proto3,enum=inference.streamvesting.VestingSource" json:"source,omitempty"`
+	Coins  []*v1beta1.Coin `protobuf:"bytes,2,rep,name=coins,proto3" json:"coins,omitempty"`
+}
+
+func (x *SourceCoins) Reset() {
+	*x = SourceCoins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_streamvesting_vesting_schedule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceCoins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceCoins) ProtoMessage() {}
+
+// Deprecated: Use SourceCoins.ProtoReflect.Descriptor instead.
+func (*SourceCoins) Descriptor() ([]byte, []int) {
+	return file_inference_streamvesting_vesting_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SourceCoins) GetSource() VestingSource {
+	if x != nil {
+		return x.Source
+	}
+	return VestingSource_VESTING_SOURCE_UNSPECIFIED
+}
+
+func (x *SourceCoins) GetCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.Coins
+	}
+	return nil
+}
+
 // EpochCoins represents the coins to be unlocked in a specific epoch
 type EpochCoins struct {
 	state         protoimpl.MessageState
@@ -1087,12 +2467,15 @@ type EpochCoins struct {
 	unknownFields protoimpl.UnknownFields
 
 	Coins []*v1beta1.Coin `protobuf:"bytes,1,rep,name=coins,proto3" json:"coins,omitempty"`
+	// sources breaks coins down by reward source, ordered by source.
+	// Coins not covered by sources (tranches created before sources were tracked) are unspecified.
+	Sources []*SourceCoins `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
 }
 
 func (x *EpochCoins) Reset() {
 	*x = EpochCoins{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_streamvesting_vesting_schedule_proto_msgTypes[0]
+		mi := &file_inference_streamvesting_vesting_schedule_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1106,7 +2489,7 @@ func (*EpochCoins) ProtoMessage() {}
 
 // Deprecated: Use EpochCoins.ProtoReflect.Descriptor instead.
 func (*EpochCoins) Descriptor() ([]byte, []int) {
-	return file_inference_streamvesting_vesting_schedule_proto_rawDescGZIP(), []int{0}
+	return file_inference_streamvesting_vesting_schedule_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *EpochCoins) GetCoins() []*v1beta1.Coin {
@@ -1116,6 +2499,13 @@ func (x *EpochCoins) GetCoins() []*v1beta1.Coin {
 	return nil
 }
 
+func (x *EpochCoins) GetSources() []*SourceCoins {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
 // VestingSchedule defines a participant's vesting schedule
 type VestingSchedule struct {
 	state         protoimpl.MessageState
@@ -1133,7 +2523,7 @@ type VestingSchedule struct {
 func (x *VestingSchedule) Reset() {
 	*x = VestingSchedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_inference_streamvesting_vesting_schedule_proto_msgTypes[1]
+		mi := &file_inference_streamvesting_vesting_schedule_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1147,7 +2537,7 @@ func (*VestingSchedule) ProtoMessage() {}
 
 // Deprecated: Use VestingSchedule.ProtoReflect.Descriptor instead.
 func (*VestingSchedule) Descriptor() ([]byte, []int) {
-	return file_inference_streamvesting_vesting_schedule_proto_rawDescGZIP(), []int{1}
+	return file_inference_streamvesting_vesting_schedule_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *VestingSchedule) GetParticipantAddress() string {
@@ -1164,6 +2554,79 @@ func (x *VestingSchedule) GetEpochAmounts() []*EpochCoins {
 	return nil
 }
 
+// EpochTiming records when the last epoch unlocks ran, used to project future unlock dates
+type EpochTiming struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// last_epoch is the most recently completed epoch
+	LastEpoch uint64 `protobuf:"varint,1,opt,name=last_epoch,json=lastEpoch,proto3" json:"last_epoch,omitempty"`
+	// last_unlock_height is the block height the last unlocks ran at
+	LastUnlockHeight int64 `protobuf:"varint,2,opt,name=last_unlock_height,json=lastUnlockHeight,proto3" json:"last_unlock_height,omitempty"`
+	// last_unlock_time is the block time (unix seconds) the last unlocks ran at
+	LastUnlockTime int64 `protobuf:"varint,3,opt,name=last_unlock_time,json=lastUnlockTime,proto3" json:"last_unlock_time,omitempty"`
+	// epoch_length is the number of blocks between the last two unlocks
+	EpochLength int64 `protobuf:"varint,4,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// epoch_duration is the number of seconds between the last two unlocks
+	EpochDuration int64 `protobuf:"varint,5,opt,name=epoch_duration,json=epochDuration,proto3" json:"epoch_duration,omitempty"`
+}
+
+func (x *EpochTiming) Reset() {
+	*x = EpochTiming{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_streamvesting_vesting_schedule_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochTiming) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochTiming) ProtoMessage() {}
+
+// Deprecated: Use EpochTiming.ProtoReflect.Descriptor instead.
+func (*EpochTiming) Descriptor() ([]byte, []int) {
+	return file_inference_streamvesting_vesting_schedule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EpochTiming) GetLastEpoch() uint64 {
+	if x != nil {
+		return x.LastEpoch
+	}
+	return 0
+}
+
+func (x *EpochTiming) GetLastUnlockHeight() int64 {
+	if x != nil {
+		return x.LastUnlockHeight
+	}
+	return 0
+}
+
+func (x *EpochTiming) GetLastUnlockTime() int64 {
+	if x != nil {
+		return x.LastUnlockTime
+	}
+	return 0
+}
+
+func (x *EpochTiming) GetEpochLength() int64 {
+	if x != nil {
+		return x.EpochLength
+	}
+	return 0
+}
+
+func (x *EpochTiming) GetEpochDuration() int64 {
+	if x != nil {
+		return x.EpochDuration
+	}
+	return 0
+}
+
 var File_inference_streamvesting_vesting_schedule_proto protoreflect.FileDescriptor
 
 var file_inference_streamvesting_vesting_schedule_proto_rawDesc = []byte{
@@ -1176,39 +2639,79 @@ var file_inference_streamvesting_vesting_schedule_proto_rawDesc = []byte{
 	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
 	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x7a, 0x0a, 0x0a, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x6f, 0x69, 0x6e, 0x73,
-	0x12, 0x66, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00,
-	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x9d,
-	0x01, 0x0a, 0x0f, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x53, 0x0a, 0x0d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x42,
-	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xda,
-	0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x14,
-	0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0xa2, 0x02, 0x03, 0x49, 0x53, 0x58, 0xaa, 0x02, 0x17, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0xca, 0x02, 0x17, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0xe2, 0x02, 0x23, 0x49, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x18, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x74, 0x6f, 0x22, 0xbb, 0x01, 0x0a, 0x0b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x66, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
+	0x22, 0xc5, 0x01, 0x0a, 0x0a, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12,
+	0x66, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x49, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x9d, 0x01, 0x0a, 0x0f, 0x56, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x13,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x53, 0x0a,
+	0x0d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xce, 0x01, 0x0a, 0x0b, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0xa5, 0x01, 0x0a, 0x0d, 0x56, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x56,
+	0x45, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x56,
+	0x45, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x57, 0x4f,
+	0x52, 0x4b, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x49, 0x44, 0x59, 0x10, 0x02,
+	0x12, 0x1c, 0x0a, 0x18, 0x56, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x54, 0x4f, 0x50, 0x5f, 0x4d, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x03, 0x12, 0x21,
+	0x0a, 0x1d, 0x56, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x42, 0x49, 0x54, 0x43, 0x4f, 0x49, 0x4e, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x10,
+	0x04, 0x42, 0xda, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x42, 0x14, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0xa2, 0x02, 0x03, 0x49, 0x53, 0x58, 0xaa, 0x02, 0x17, 0x49, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0xca, 0x02, 0x17, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0xe2, 0x02, 0x23,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a,
+	0x3a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1223,20 +2726,27 @@ func file_inference_streamvesting_vesting_schedule_proto_rawDescGZIP() []byte {
 	return file_inference_streamvesting_vesting_schedule_proto_rawDescData
 }
 
-var file_inference_streamvesting_vesting_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_inference_streamvesting_vesting_schedule_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_inference_streamvesting_vesting_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_inference_streamvesting_vesting_schedule_proto_goTypes = []interface{}{
-	(*EpochCoins)(nil),      // 0: inference.streamvesting.EpochCoins
-	(*VestingSchedule)(nil), // 1: inference.streamvesting.VestingSchedule
-	(*v1beta1.Coin)(nil),    // 2: cosmos.base.v1beta1.Coin
+	(VestingSource)(0),      // 0: inference.streamvesting.VestingSource
+	(*SourceCoins)(nil),     // 1: inference.streamvesting.SourceCoins
+	(*EpochCoins)(nil),      // 2: inference.streamvesting.EpochCoins
+	(*VestingSchedule)(nil), // 3: inference.streamvesting.VestingSchedule
+	(*EpochTiming)(nil),     // 4: inference.streamvesting.EpochTiming
+	(*v1beta1.Coin)(nil),    // 5: cosmos.base.v1beta1.Coin
 }
 var file_inference_streamvesting_vesting_schedule_proto_depIdxs = []int32{
-	2, // 0: inference.streamvesting.EpochCoins.coins:type_name -> cosmos.base.v1beta1.Coin
-	0, // 1: inference.streamvesting.VestingSchedule.epoch_amounts:type_name -> inference.streamvesting.EpochCoins
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0, // 0: inference.streamvesting.SourceCoins.source:type_name -> inference.streamvesting.VestingSource
+	5, // 1: inference.streamvesting.SourceCoins.coins:type_name -> cosmos.base.v1beta1.Coin
+	5, // 2: inference.streamvesting.EpochCoins.coins:type_name -> cosmos.base.v1beta1.Coin
+	1, // 3: inference.streamvesting.EpochCoins.sources:type_name -> inference.streamvesting.SourceCoins
+	2, // 4: inference.streamvesting.VestingSchedule.epoch_amounts:type_name -> inference.streamvesting.EpochCoins
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_inference_streamvesting_vesting_schedule_proto_init() }
@@ -1246,7 +2756,7 @@ func file_inference_streamvesting_vesting_schedule_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_inference_streamvesting_vesting_schedule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpochCoins); i {
+			switch v := v.(*SourceCoins); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1258,6 +2768,18 @@ func file_inference_streamvesting_vesting_schedule_proto_init() {
 			}
 		}
 		file_inference_streamvesting_vesting_schedule_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochCoins); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_streamvesting_vesting_schedule_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VestingSchedule); i {
 			case 0:
 				return &v.state
@@ -1269,19 +2791,32 @@ func file_inference_streamvesting_vesting_schedule_proto_init() {
 				return nil
 			}
 		}
+		file_inference_streamvesting_vesting_schedule_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochTiming); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_inference_streamvesting_vesting_schedule_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_inference_streamvesting_vesting_schedule_proto_goTypes,
 		DependencyIndexes: file_inference_streamvesting_vesting_schedule_proto_depIdxs,
+		EnumInfos:         file_inference_streamvesting_vesting_schedule_proto_enumTypes,
 		MessageInfos:      file_inference_streamvesting_vesting_schedule_proto_msgTypes,
 	}.Build()
 	File_inference_streamvesting_vesting_schedule_proto = out.File
@@ -56,6 +56,8 @@ func initRootCmd(
 		txCommand(),
 		keys.Commands(),
 		SignatureCommands(),
+		GatewayCommand(),
+		SimulateCommand(),
 		CreateClientCommand(),
 		RegisterNewParticipantCommand(),
 		DownloadGenesisCommand(),
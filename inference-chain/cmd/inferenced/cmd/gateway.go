@@ -0,0 +1,310 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/productscience/inference/sdk/gonka"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	GatewayListen      = "listen"
+	GatewaySeedUrl     = "seed-url"
+	GatewayApiKey      = "api-key"
+	GatewayMaxAttempts = "max-attempts"
+
+	// maxGatewayBody bounds a single OpenAI request accepted by the gateway
+	maxGatewayBody = 16 << 20
+	// Settled cost is logged once the inference leaves STARTED, polled for about two minutes
+	gatewayCostPollInterval = 10 * time.Second
+	gatewayCostPollAttempts = 12
+)
+
+func GatewayCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway",
+		Short: "Serve a local OpenAI compatible endpoint that signs requests with a developer key",
+		Long: `Listens locally for unmodified OpenAI chat/completions requests, signs each one with the
+developer key from the keyring and sends it to a transfer agent of the current epoch, failing
+over to another TA on overload or errors. Point OpenAI clients at http://<listen>/v1.`,
+		Args: cobra.NoArgs,
+		RunE: runGateway,
+	}
+	cmd.Flags().String(GatewayListen, "127.0.0.1:8080", "Address the gateway listens on")
+	cmd.Flags().StringSlice(GatewaySeedUrl, nil, "Public API url used to discover transfer agents, may be repeated. Example: http://<ip>:<port>")
+	cmd.Flags().String(GatewayApiKey, "", "Bearer token clients must present (optional, no auth when empty)")
+	cmd.Flags().Int(GatewayMaxAttempts, 3, "Number of transfer agents tried per request")
+	cmd.Flags().String(AccountAddress, "", "Address of the developer account that signs requests")
+	flags.AddKeyringFlags(cmd.PersistentFlags())
+	_ = cmd.MarkFlagRequired(GatewaySeedUrl)
+	return cmd
+}
+
+func runGateway(cmd *cobra.Command, args []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	address, err := getAddress(cmd, clientCtx)
+	if err != nil {
+		return err
+	}
+	seedUrls, err := cmd.Flags().GetStringSlice(GatewaySeedUrl)
+	if err != nil {
+		return err
+	}
+	maxAttempts, err := cmd.Flags().GetInt(GatewayMaxAttempts)
+	if err != nil {
+		return err
+	}
+	apiKey, err := cmd.Flags().GetString(GatewayApiKey)
+	if err != nil {
+		return err
+	}
+	listen, err := cmd.Flags().GetString(GatewayListen)
+	if err != nil {
+		return err
+	}
+
+	networkClient, err := gonka.NewClient(gonka.Config{
+		SeedUrls:    seedUrls,
+		Signer:      gonka.NewKeyringSigner(clientCtx.Keyring, address),
+		MaxAttempts: maxAttempts,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	gw := newGateway(ctx, networkClient, apiKey)
+	server := &http.Server{
+		Addr:              listen,
+		Handler:           gw.routes(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	cmd.Printf("Gateway for %s listening on http://%s/v1\n", networkClient.Address(), listen)
+	return server.ListenAndServe()
+}
+
+type gateway struct {
+	ctx              context.Context
+	client           *gonka.Client
+	apiKey           string
+	costPollInterval time.Duration
+	costPollAttempts int
+}
+
+func newGateway(ctx context.Context, networkClient *gonka.Client, apiKey string) *gateway {
+	return &gateway{
+		ctx:              ctx,
+		client:           networkClient,
+		apiKey:           apiKey,
+		costPollInterval: gatewayCostPollInterval,
+		costPollAttempts: gatewayCostPollAttempts,
+	}
+}
+
+func (g *gateway) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/chat/completions", g.chatCompletions)
+	mux.HandleFunc("GET /v1/models", g.models)
+	return g.authorize(mux)
+}
+
+func (g *gateway) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if g.apiKey != "" && r.Header.Get("Authorization") != "Bearer "+g.apiKey {
+			writeOpenAiError(w, http.StatusUnauthorized, "invalid api key")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (g *gateway) chatCompletions(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxGatewayBody))
+	if err != nil {
+		writeOpenAiError(w, http.StatusRequestEntityTooLarge, err.Error())
+		return
+	}
+	var request struct {
+		Model  string `json:"model"`
+		Stream bool   `json:"stream"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		writeOpenAiError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	// The body is forwarded byte for byte, the developer signature covers it as received
+	if request.Stream {
+		g.streamCompletion(w, r, body, request.Model)
+		return
+	}
+	resp, err := g.client.ChatCompletion(r.Context(), body)
+	if err != nil {
+		g.writeNetworkError(w, err, request.Model)
+		return
+	}
+	w.Header().Set("Content-Type", resp.Header.Get("Content-Type"))
+	w.WriteHeader(resp.StatusCode)
+	_, _ = w.Write(resp.Body)
+	g.track(resp.InferenceId(), request.Model, resp.Endpoint)
+}
+
+func (g *gateway) streamCompletion(w http.ResponseWriter, r *http.Request, body []byte, model string) {
+	stream, err := g.client.ChatCompletionStream(r.Context(), body)
+	if err != nil {
+		g.writeNetworkError(w, err, model)
+		return
+	}
+	defer stream.Close()
+
+	flusher, _ := w.(http.Flusher)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	inferenceId := ""
+	for {
+		event, err := stream.Next()
+		if errors.Is(err, io.EOF) && stream.Done() {
+			_, _ = io.WriteString(w, "data: [DONE]\n\n")
+			break
+		}
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			// Headers are already sent, the client gets an error event instead of [DONE]
+			slog.Error("Gateway stream interrupted", "model", model, "ta", stream.Endpoint.Address, "inference_id", inferenceId, "error", err)
+			writeStreamError(w, "stream from "+stream.Endpoint.Address+" ended early: "+err.Error())
+			break
+		}
+		if inferenceId == "" {
+			inferenceId = eventInferenceId(event.Data)
+		}
+		if _, err := fmt.Fprintf(w, "data: %s\n\n", event.Data); err != nil {
+			slog.Warn("Gateway client went away", "model", model, "inference_id", inferenceId, "error", err)
+			break
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+	g.track(inferenceId, model, stream.Endpoint)
+}
+
+func (g *gateway) models(w http.ResponseWriter, r *http.Request) {
+	endpoints, err := g.client.Endpoints(r.Context())
+	if err != nil {
+		g.writeNetworkError(w, err, "")
+		return
+	}
+	seen := map[string]bool{}
+	for _, endpoint := range endpoints {
+		for _, model := range endpoint.Models {
+			seen[model] = true
+		}
+	}
+	ids := make([]string, 0, len(seen))
+	for model := range seen {
+		ids = append(ids, model)
+	}
+	sort.Strings(ids)
+
+	type modelEntry struct {
+		Id      string `json:"id"`
+		Object  string `json:"object"`
+		OwnedBy string `json:"owned_by"`
+	}
+	data := make([]modelEntry, len(ids))
+	for i, id := range ids {
+		data[i] = modelEntry{Id: id, Object: "model", OwnedBy: "gonka"}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"object": "list", "data": data})
+}
+
+// track logs the served inference and, in the background, its settled cost
+func (g *gateway) track(inferenceId string, model string, endpoint gonka.Endpoint) {
+	if inferenceId == "" {
+		slog.Warn("Gateway response without inference id", "model", model, "ta", endpoint.Address)
+		return
+	}
+	slog.Info("Gateway inference served", "inference_id", inferenceId, "model", model, "ta", endpoint.Address)
+	if g.costPollAttempts <= 0 {
+		return
+	}
+	go g.logCost(inferenceId)
+}
+
+func (g *gateway) logCost(inferenceId string) {
+	for i := 0; i < g.costPollAttempts; i++ {
+		select {
+		case <-g.ctx.Done():
+			return
+		case <-time.After(g.costPollInterval):
+		}
+		inference, err := g.client.Inference(g.ctx, inferenceId)
+		if err != nil || inference.Status == types.InferenceStatus_STARTED {
+			continue
+		}
+		slog.Info("Gateway inference cost",
+			"inference_id", inferenceId,
+			"status", inference.Status.String(),
+			"prompt_tokens", inference.PromptTokenCount,
+			"completion_tokens", inference.CompletionTokenCount,
+			"actual_cost", inference.ActualCost,
+			"escrow_amount", inference.EscrowAmount)
+		return
+	}
+	slog.Warn("Gateway inference cost not settled yet", "inference_id", inferenceId)
+}
+
+func (g *gateway) writeNetworkError(w http.ResponseWriter, err error, model string) {
+	slog.Error("Gateway request failed", "model", model, "error", err)
+	var apiErr *gonka.APIError
+	if errors.As(err, &apiErr) {
+		writeOpenAiError(w, apiErr.StatusCode, strings.TrimSpace(apiErr.Body))
+		return
+	}
+	writeOpenAiError(w, http.StatusBadGateway, err.Error())
+}
+
+func writeOpenAiError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{"message": message, "type": "gateway_error", "code": status},
+	})
+}
+
+// writeStreamError reports an error in a stream whose headers are already sent, as an OpenAI error event
+func writeStreamError(w http.ResponseWriter, message string) {
+	data, _ := json.Marshal(map[string]any{
+		"error": map[string]any{"message": message, "type": "gateway_error", "code": http.StatusBadGateway},
+	})
+	_, _ = fmt.Fprintf(w, "data: %s\n\n", data)
+}
+
+func eventInferenceId(data []byte) string {
+	var chunk struct {
+		Id string `json:"id"`
+	}
+	_ = json.Unmarshal(data, &chunk)
+	return chunk.Id
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/productscience/inference/sdk/gonka"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGateway(t *testing.T, apiKey string) (*httptest.Server, *[]string) {
+	var received []string
+	var ta *httptest.Server
+	ta = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/epochs/current/participants":
+			_, _ = io.WriteString(w, `{"active_participants":{"participants":[{"index":"gonka1ta","inference_url":"`+ta.URL+`","models":["m2","m1"]}]}}`)
+		case "/v1/chat/completions":
+			require.NotEmpty(t, r.Header.Get(gonka.AuthorizationHeader))
+			require.Equal(t, "gonka1ta", r.Header.Get(gonka.TransferAddressHeader))
+			body, _ := io.ReadAll(r.Body)
+			received = append(received, string(body))
+			if strings.Contains(string(body), `"stream":true`) {
+				w.Header().Set("Content-Type", "text/event-stream")
+				_, _ = io.WriteString(w, "data: {\"id\":\"inf-1\"}\n\n")
+				if !strings.Contains(string(body), `"model":"truncated"`) {
+					_, _ = io.WriteString(w, "data: [DONE]\n\n")
+				}
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, `{"id":"inf-1"}`)
+		}
+	}))
+	t.Cleanup(ta.Close)
+
+	signer, err := gonka.NewPrivKeySigner(secp256k1.GenPrivKey().Key)
+	require.NoError(t, err)
+	networkClient, err := gonka.NewClient(gonka.Config{SeedUrls: []string{ta.URL}, Signer: signer})
+	require.NoError(t, err)
+	gw := newGateway(context.Background(), networkClient, apiKey)
+	gw.costPollAttempts = 0
+	server := httptest.NewServer(gw.routes())
+	t.Cleanup(server.Close)
+	return server, &received
+}
+
+func TestGateway_ForwardsUnmodifiedRequest(t *testing.T) {
+	server, received := newTestGateway(t, "")
+	body := `{"model":"m1",  "messages":[]}`
+
+	resp, err := http.Post(server.URL+"/v1/chat/completions", "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	responseBody, _ := io.ReadAll(resp.Body)
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.JSONEq(t, `{"id":"inf-1"}`, string(responseBody))
+	require.Equal(t, []string{body}, *received)
+}
+
+func TestGateway_RelaysStream(t *testing.T) {
+	server, _ := newTestGateway(t, "")
+
+	resp, err := http.Post(server.URL+"/v1/chat/completions", "application/json", strings.NewReader(`{"model":"m1","stream":true}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	responseBody, _ := io.ReadAll(resp.Body)
+
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	require.Equal(t, "data: {\"id\":\"inf-1\"}\n\ndata: [DONE]\n\n", string(responseBody))
+}
+
+func TestGateway_ReportsTruncatedStream(t *testing.T) {
+	server, _ := newTestGateway(t, "")
+
+	resp, err := http.Post(server.URL+"/v1/chat/completions", "application/json", strings.NewReader(`{"model":"truncated","stream":true}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	responseBody, _ := io.ReadAll(resp.Body)
+
+	events := strings.Split(strings.TrimSpace(string(responseBody)), "\n\n")
+	require.Len(t, events, 2)
+	require.Equal(t, "data: {\"id\":\"inf-1\"}", events[0])
+	require.Contains(t, events[1], `"type":"gateway_error"`)
+	require.Contains(t, events[1], "ended early")
+	require.NotContains(t, string(responseBody), "[DONE]")
+}
+
+func TestGateway_RequiresApiKey(t *testing.T) {
+	server, received := newTestGateway(t, "secret")
+
+	resp, err := http.Post(server.URL+"/v1/chat/completions", "application/json", strings.NewReader(`{"model":"m1"}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	require.Empty(t, *received)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/models", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var models struct {
+		Data []struct {
+			Id string `json:"id"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&models))
+	require.Len(t, models.Data, 2)
+	require.Equal(t, "m1", models.Data[0].Id)
+}
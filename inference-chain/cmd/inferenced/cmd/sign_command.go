@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/productscience/inference/sdk/gonka"
 	"github.com/productscience/inference/x/inference/calculations"
 	"github.com/spf13/cobra"
 )
@@ -283,10 +284,9 @@ func sendSignedRequest(cmd *cobra.Command, nodeAddress string, payloadBytes []by
 	cmd.Printf("Authorization: %s\n", signature)
 	cmd.Printf("X-Requester-Address: %s\n", requesterAddress.String())
 
-	// TODO use constants from decentralized-api/utils here
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", signature)
-	req.Header.Set("X-Requester-Address", requesterAddress.String())
+	req.Header.Set(gonka.AuthorizationHeader, signature)
+	req.Header.Set(gonka.RequesterAddressHeader, requesterAddress.String())
 
 	httpClient := &http.Client{}
 	resp, err := httpClient.Do(req)
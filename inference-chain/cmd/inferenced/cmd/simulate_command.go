@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/productscience/inference/x/inference/calculations/validationsim"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	SimulateParams             = "params"
+	SimulateEpochs             = "epochs"
+	SimulateInferencesPerEpoch = "inferences-per-epoch"
+	SimulateRuns               = "runs"
+	SimulateSeed               = "seed"
+	SimulateOutput             = "output"
+)
+
+func SimulateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate chain rules against proposed parameters",
+	}
+	cmd.AddCommand(SimulateValidationCommand())
+	return cmd
+}
+
+func SimulateValidationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validation",
+		Short: "Monte Carlo simulation of validation, reputation and slashing parameters",
+		Long: `Simulates honest, faulty and malicious participants serving and validating each other's inferences
+with the chain's own validation, status, reputation and reward functions, and reports false positive
+and false negative invalidation rates, downtime deactivations, expected slashing and rewarded epochs.
+
+Parameters default to the genesis defaults. Pass --params with the output of
+"inferenced query inference params -o json", or a params JSON edited for a proposal.`,
+		Example: `  inferenced simulate validation --params proposed.json --runs 50 --epochs 20
+  inferenced simulate validation --malicious 4 --malicious-invalid-rate 0.1 -o json`,
+		Args: cobra.NoArgs,
+		RunE: runSimulateValidation,
+	}
+	cmd.Flags().String(SimulateParams, "", "JSON file with inference module params, either bare or wrapped in {\"params\": ...}")
+	cmd.Flags().Int(SimulateEpochs, 10, "Epochs per run")
+	cmd.Flags().Int(SimulateInferencesPerEpoch, 100, "Requests routed to each participant per epoch")
+	cmd.Flags().Int(SimulateRuns, 10, "Number of independent runs")
+	cmd.Flags().Int64(SimulateSeed, 1, "Random seed, runs are reproducible for the same seed")
+	cmd.Flags().StringP(SimulateOutput, "o", "text", "Output format (text|json)")
+	for _, behavior := range validationsim.DefaultBehaviors() {
+		cmd.Flags().Int(behavior.Name, behavior.Count, fmt.Sprintf("Number of %s participants", behavior.Name))
+		cmd.Flags().Float64(behavior.Name+"-invalid-rate", behavior.InvalidRate, fmt.Sprintf("Probability that a validation of a %s participant's inference fails", behavior.Name))
+		cmd.Flags().Float64(behavior.Name+"-miss-rate", behavior.MissRate, fmt.Sprintf("Probability that a %s participant misses a request", behavior.Name))
+	}
+	return cmd
+}
+
+func runSimulateValidation(cmd *cobra.Command, _ []string) error {
+	params := types.DefaultParams()
+	if path, _ := cmd.Flags().GetString(SimulateParams); path != "" {
+		loaded, err := loadSimulationParams(path)
+		if err != nil {
+			return err
+		}
+		params = loaded
+	}
+
+	config := validationsim.Config{Params: params}
+	config.Epochs, _ = cmd.Flags().GetInt(SimulateEpochs)
+	config.InferencesPerEpoch, _ = cmd.Flags().GetInt(SimulateInferencesPerEpoch)
+	config.Runs, _ = cmd.Flags().GetInt(SimulateRuns)
+	config.Seed, _ = cmd.Flags().GetInt64(SimulateSeed)
+	for _, behavior := range validationsim.DefaultBehaviors() {
+		behavior.Count, _ = cmd.Flags().GetInt(behavior.Name)
+		behavior.InvalidRate, _ = cmd.Flags().GetFloat64(behavior.Name + "-invalid-rate")
+		behavior.MissRate, _ = cmd.Flags().GetFloat64(behavior.Name + "-miss-rate")
+		config.Behaviors = append(config.Behaviors, behavior)
+	}
+
+	report, err := validationsim.Run(config)
+	if err != nil {
+		return err
+	}
+
+	output, _ := cmd.Flags().GetString(SimulateOutput)
+	switch output {
+	case "json":
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	case "text":
+		return printSimulationReport(cmd.OutOrStdout(), report)
+	default:
+		return fmt.Errorf("unknown output format %q", output)
+	}
+}
+
+// loadSimulationParams reads complete params as printed by the params query, or bare params as used in proposals
+func loadSimulationParams(path string) (types.Params, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return types.Params{}, err
+	}
+	var wrapped struct {
+		Params json.RawMessage `json:"params"`
+	}
+	if err := json.Unmarshal(data, &wrapped); err == nil && len(wrapped.Params) > 0 {
+		data = wrapped.Params
+	}
+
+	var params types.Params
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := unmarshaler.Unmarshal(bytes.NewReader(data), &params); err != nil {
+		return types.Params{}, fmt.Errorf("failed to parse params from %s: %w", path, err)
+	}
+	if err := params.Validate(); err != nil {
+		return types.Params{}, fmt.Errorf("invalid params in %s: %w", path, err)
+	}
+	return params, nil
+}
+
+func printSimulationReport(out io.Writer, report *validationsim.Report) error {
+	fmt.Fprintf(out, "%d runs of %d epochs, %d requests per participant per epoch, seed %d\n\n",
+		report.Runs, report.Epochs, report.InferencesPerEpoch, report.Seed)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CLASS\tCOUNT\tINVALID\tMISS\tVALIDATIONS/INF\tINVALIDATED\tEVER INVALIDATED\tEPOCHS TO INVALID\tDEACTIVATED\tSLASHED\tREWARDED\tREPUTATION")
+	for _, class := range report.Classes {
+		fmt.Fprintf(w, "%s\t%d\t%.3f\t%.3f\t%.2f\t%s\t%s\t%.1f\t%s\t%s\t%s\t%.0f\n",
+			class.Name, class.Count, class.InvalidRate, class.MissRate, class.ValidationsPerInference,
+			percent(class.InvalidatedRate), percent(class.EverInvalidatedRate), class.MeanEpochsToInvalidation,
+			percent(class.DeactivatedRate), percent(class.SlashedCollateral), percent(class.RewardedRate),
+			class.FinalReputation)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "\nFalse positive rate (non-malicious epochs invalidated): %s\n", percent(report.FalsePositiveRate))
+	fmt.Fprintf(out, "False negative rate (malicious epochs not invalidated): %s\n", percent(report.FalseNegativeRate))
+	return nil
+}
+
+func percent(value float64) string {
+	return fmt.Sprintf("%.2f%%", value*100)
+}
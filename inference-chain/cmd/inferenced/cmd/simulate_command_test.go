@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func writeParamsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "params.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadSimulationParams_QueryOutput(t *testing.T) {
+	params := types.DefaultParams()
+	params.ValidationParams.FalsePositiveRate = types.DecimalFromFloat(0.02)
+	marshaler := jsonpb.Marshaler{OrigName: true}
+	paramsJson, err := marshaler.MarshalToString(&params)
+	require.NoError(t, err)
+
+	for _, content := range []string{paramsJson, `{"params":` + paramsJson + `}`} {
+		loaded, err := loadSimulationParams(writeParamsFile(t, content))
+		require.NoError(t, err)
+		require.True(t, loaded.ValidationParams.FalsePositiveRate.ToDecimal().Equal(params.ValidationParams.FalsePositiveRate.ToDecimal()))
+		require.Equal(t, params.ValidationParams.EpochsToMax, loaded.ValidationParams.EpochsToMax)
+	}
+}
+
+func TestLoadSimulationParams_RejectsIncompleteParams(t *testing.T) {
+	_, err := loadSimulationParams(writeParamsFile(t, `{"params":{"validation_params":{"false_positive_rate":{"value":"2","exponent":-2}}}}`))
+	require.Error(t, err)
+}
+
+func TestSimulateValidationCommand_Text(t *testing.T) {
+	cmd := SimulateValidationCommand()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"--runs", "1", "--epochs", "1", "--inferences-per-epoch", "10", "--honest", "3", "--faulty", "0"})
+	require.NoError(t, cmd.Execute())
+	require.True(t, strings.Contains(out.String(), "False positive rate"))
+	require.True(t, strings.Contains(out.String(), "malicious"))
+}
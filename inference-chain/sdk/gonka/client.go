@@ -0,0 +1,240 @@
+package gonka
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMaxAttempts  = 3
+	defaultDiscoveryTTL = time.Minute
+	defaultTimeout      = 5 * time.Minute
+)
+
+// Config configures a Client. Only SeedUrls and Signer are required.
+type Config struct {
+	// SeedUrls are public API urls of participants used to discover transfer agents
+	SeedUrls []string
+	// Signer signs requests with the developer key
+	Signer Signer
+	// HTTPClient defaults to a client with a 5 minute timeout, long enough for streamed completions
+	HTTPClient *http.Client
+	// MaxAttempts is the number of distinct TAs tried per request, defaults to 3
+	MaxAttempts int
+	// DiscoveryTTL is how long discovered endpoints are reused, defaults to one minute
+	DiscoveryTTL time.Duration
+	// Now returns the request timestamp, defaults to time.Now
+	Now func() time.Time
+}
+
+// Client sends signed developer requests to the network
+type Client struct {
+	config Config
+
+	mu           sync.Mutex
+	endpoints    []Endpoint
+	discoveredAt time.Time
+	rand         *rand.Rand
+}
+
+func NewClient(config Config) (*Client, error) {
+	if len(config.SeedUrls) == 0 {
+		return nil, errors.New("at least one seed url is required")
+	}
+	if config.Signer == nil {
+		return nil, errors.New("signer is required")
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{Timeout: defaultTimeout}
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.DiscoveryTTL <= 0 {
+		config.DiscoveryTTL = defaultDiscoveryTTL
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return &Client{
+		config: config,
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}, nil
+}
+
+// Address returns the developer address requests are signed for
+func (c *Client) Address() string {
+	return c.config.Signer.Address()
+}
+
+// Endpoints returns the cached transfer agents, rediscovering them once the TTL expires.
+// Seeds are tried in order until one answers.
+func (c *Client) Endpoints(ctx context.Context) ([]Endpoint, error) {
+	c.mu.Lock()
+	if c.endpoints != nil && c.config.Now().Sub(c.discoveredAt) < c.config.DiscoveryTTL {
+		endpoints := c.endpoints
+		c.mu.Unlock()
+		return endpoints, nil
+	}
+	c.mu.Unlock()
+
+	var lastErr error = ErrNoEndpoints
+	for _, seed := range c.config.SeedUrls {
+		endpoints, err := DiscoverEndpoints(ctx, c.config.HTTPClient, seed)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if len(endpoints) == 0 {
+			continue
+		}
+		c.mu.Lock()
+		c.endpoints = endpoints
+		c.discoveredAt = c.config.Now()
+		c.mu.Unlock()
+		return endpoints, nil
+	}
+	return nil, lastErr
+}
+
+// Response is a completed, non-streamed inference
+type Response struct {
+	Endpoint   Endpoint
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// InferenceId returns the id of the inference, which is the OpenAI response id
+func (r *Response) InferenceId() string {
+	var body struct {
+		Id string `json:"id"`
+	}
+	_ = json.Unmarshal(r.Body, &body)
+	return body.Id
+}
+
+// ChatCompletion sends an OpenAI compatible chat completion request and reads the whole response.
+// The payload is signed as is, so it must not be re-encoded afterwards.
+func (c *Client) ChatCompletion(ctx context.Context, payload []byte) (*Response, error) {
+	resp, endpoint, err := c.postSigned(ctx, chatCompletionsPath, payload)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &Response{Endpoint: endpoint, StatusCode: resp.StatusCode, Header: resp.Header, Body: body}, nil
+}
+
+// ChatCompletionStream sends a request with "stream": true and returns the SSE event stream.
+// Failover only happens before the first byte, a stream interrupted midway is returned as an error from Next.
+func (c *Client) ChatCompletionStream(ctx context.Context, payload []byte) (*Stream, error) {
+	resp, endpoint, err := c.postSigned(ctx, chatCompletionsPath, payload)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s did not stream the response: %s", endpoint.Url, string(body))
+	}
+	return newStream(endpoint, resp), nil
+}
+
+// postSigned tries distinct TAs until one accepts the request. Each attempt is signed for
+// the TA it is sent to with a fresh timestamp.
+func (c *Client) postSigned(ctx context.Context, path string, payload []byte) (*http.Response, Endpoint, error) {
+	return c.send(ctx, func(endpoint Endpoint) (*http.Request, error) {
+		headers, err := SignRequest(c.config.Signer, payload, endpoint.Address, c.config.Now())
+		if err != nil {
+			return nil, err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.Url+path, bytes.NewReader(payload))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		headers.apply(req.Header)
+		return req, nil
+	})
+}
+
+// Inference returns the on-chain record of an inference, including its status
+func (c *Client) Inference(ctx context.Context, inferenceId string) (*Inference, error) {
+	resp, _, err := c.send(ctx, func(endpoint Endpoint) (*http.Request, error) {
+		return http.NewRequestWithContext(ctx, http.MethodGet, endpoint.Url+chatCompletionsPath+"?id="+url.QueryEscape(inferenceId), nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var inference Inference
+	if err := json.NewDecoder(resp.Body).Decode(&inference); err != nil {
+		return nil, err
+	}
+	return &inference, nil
+}
+
+// send tries up to MaxAttempts distinct endpoints in random order until one returns a 2xx response.
+// Connection errors and retryable statuses move on to the next endpoint, other errors are returned as is so a
+// request that a TA may have already processed is never sent twice.
+func (c *Client) send(ctx context.Context, newRequest func(Endpoint) (*http.Request, error)) (*http.Response, Endpoint, error) {
+	endpoints, err := c.Endpoints(ctx)
+	if err != nil {
+		return nil, Endpoint{}, err
+	}
+	order := c.shuffled(len(endpoints))
+
+	var lastErr error = ErrNoEndpoints
+	for i := 0; i < len(order) && i < c.config.MaxAttempts; i++ {
+		endpoint := endpoints[order[i]]
+		req, err := newRequest(endpoint)
+		if err != nil {
+			return nil, endpoint, err
+		}
+		req.Header.Set("User-Agent", userAgent)
+
+		resp, err := c.config.HTTPClient.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, endpoint, ctx.Err()
+			}
+			if !isConnectionError(err) {
+				return nil, endpoint, fmt.Errorf("%s: %w", endpoint.Url, err)
+			}
+			lastErr = fmt.Errorf("%s: %w", endpoint.Url, err)
+			continue
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			apiErr := newAPIError(resp, endpoint.Url)
+			resp.Body.Close()
+			if !apiErr.Retryable() {
+				return nil, endpoint, apiErr
+			}
+			lastErr = apiErr
+			continue
+		}
+		return resp, endpoint, nil
+	}
+	return nil, Endpoint{}, lastErr
+}
+
+func (c *Client) shuffled(n int) []int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rand.Perm(n)
+}
@@ -0,0 +1,197 @@
+package gonka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeNetwork struct {
+	t       *testing.T
+	pubKey  string
+	mu      sync.Mutex
+	servers map[string]*httptest.Server
+	status  map[string]int
+	hits    map[string]int
+}
+
+func newFakeNetwork(t *testing.T, pubKey string, statuses ...int) *fakeNetwork {
+	n := &fakeNetwork{t: t, pubKey: pubKey, servers: map[string]*httptest.Server{}, status: map[string]int{}, hits: map[string]int{}}
+	for i, status := range statuses {
+		address := fmt.Sprintf("gonka1ta%d", i)
+		n.status[address] = status
+		n.servers[address] = httptest.NewServer(n.handler(address))
+		t.Cleanup(n.servers[address].Close)
+	}
+	return n
+}
+
+func (n *fakeNetwork) seed() string {
+	for _, server := range n.servers {
+		return server.URL
+	}
+	return ""
+}
+
+func (n *fakeNetwork) handler(address string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case currentParticipantsPath:
+			var participants []map[string]any
+			for a, server := range n.servers {
+				participants = append(participants, map[string]any{"index": a, "inference_url": server.URL + "/", "models": []string{"m"}})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"active_participants": map[string]any{"participants": participants}})
+		case chatCompletionsPath:
+			n.mu.Lock()
+			n.hits[address]++
+			n.mu.Unlock()
+			if status := n.status[address]; status != http.StatusOK {
+				w.WriteHeader(status)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			timestamp, _ := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+			components := calculations.SignatureComponents{Payload: string(body), Timestamp: timestamp, TransferAddress: r.Header.Get(TransferAddressHeader)}
+			require.Equal(n.t, address, components.TransferAddress)
+			require.NoError(n.t, calculations.ValidateSignature(components, calculations.Developer, n.pubKey, r.Header.Get(AuthorizationHeader)))
+			if strings.Contains(string(body), `"stream":true`) {
+				w.Header().Set("Content-Type", "text/event-stream")
+				_, _ = io.WriteString(w, "data: {\"id\":\"inf-1\",\"n\":1}\n\ndata: {\"id\":\"inf-1\",\"n\":2}\n\ndata: [DONE]\n\n")
+				return
+			}
+			_, _ = io.WriteString(w, `{"id":"inf-1"}`)
+		}
+	}
+}
+
+func newTestClient(t *testing.T, statuses ...int) (*Client, *fakeNetwork) {
+	key := secp256k1.GenPrivKey()
+	signer, err := NewPrivKeySigner(key.Key)
+	require.NoError(t, err)
+	network := newFakeNetwork(t, signer.PubKey(), statuses...)
+	client, err := NewClient(Config{SeedUrls: []string{network.seed()}, Signer: signer})
+	require.NoError(t, err)
+	return client, network
+}
+
+func TestChatCompletion_FailsOverOnOverloadedTA(t *testing.T) {
+	client, network := newTestClient(t, http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK)
+	client.config.MaxAttempts = 3
+
+	resp, err := client.ChatCompletion(context.Background(), []byte(`{"model":"m"}`))
+	require.NoError(t, err)
+	require.Equal(t, "inf-1", resp.InferenceId())
+	require.Equal(t, "gonka1ta2", resp.Endpoint.Address)
+	require.Equal(t, 1, network.hits["gonka1ta2"])
+}
+
+func TestChatCompletion_FailsOverOnGatewayErrors(t *testing.T) {
+	client, network := newTestClient(t, http.StatusBadGateway, http.StatusGatewayTimeout, http.StatusOK)
+	client.config.MaxAttempts = 3
+
+	resp, err := client.ChatCompletion(context.Background(), []byte(`{"model":"m"}`))
+	require.NoError(t, err)
+	require.Equal(t, "gonka1ta2", resp.Endpoint.Address)
+	require.Equal(t, 1, network.hits["gonka1ta2"])
+}
+
+func TestChatCompletion_DoesNotRetryClientErrors(t *testing.T) {
+	client, network := newTestClient(t, http.StatusUnauthorized, http.StatusUnauthorized)
+
+	_, err := client.ChatCompletion(context.Background(), []byte(`{"model":"m"}`))
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	require.Equal(t, 1, network.hits["gonka1ta0"]+network.hits["gonka1ta1"])
+}
+
+func TestChatCompletion_DoesNotRetryServerErrors(t *testing.T) {
+	client, network := newTestClient(t, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError)
+
+	_, err := client.ChatCompletion(context.Background(), []byte(`{"model":"m"}`))
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, 1, network.hits["gonka1ta0"]+network.hits["gonka1ta1"]+network.hits["gonka1ta2"])
+}
+
+func TestChatCompletion_FailsOverOnConnectionError(t *testing.T) {
+	client, network := newTestClient(t, http.StatusOK, http.StatusOK)
+	_, err := client.Endpoints(context.Background())
+	require.NoError(t, err)
+	network.servers["gonka1ta0"].Close()
+
+	for i := 0; i < 5; i++ {
+		resp, err := client.ChatCompletion(context.Background(), []byte(`{"model":"m"}`))
+		require.NoError(t, err)
+		require.Equal(t, "gonka1ta1", resp.Endpoint.Address)
+	}
+}
+
+func TestChatCompletion_GivesUpAfterMaxAttempts(t *testing.T) {
+	client, network := newTestClient(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	client.config.MaxAttempts = 2
+
+	_, err := client.ChatCompletion(context.Background(), []byte(`{"model":"m"}`))
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+	require.Equal(t, 2, network.hits["gonka1ta0"]+network.hits["gonka1ta1"]+network.hits["gonka1ta2"])
+}
+
+func TestChatCompletionStream(t *testing.T) {
+	client, _ := newTestClient(t, http.StatusOK)
+
+	stream, err := client.ChatCompletionStream(context.Background(), []byte(`{"model":"m","stream":true}`))
+	require.NoError(t, err)
+	defer stream.Close()
+
+	var events []string
+	for {
+		event, err := stream.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		events = append(events, string(event.Data))
+	}
+	require.Equal(t, []string{`{"id":"inf-1","n":1}`, `{"id":"inf-1","n":2}`}, events)
+	require.True(t, stream.Done())
+}
+
+func TestStream_EndsWithoutDone(t *testing.T) {
+	stream := newStream(Endpoint{}, &http.Response{Body: io.NopCloser(strings.NewReader("data: {\"n\":1}\n\n"))})
+
+	event, err := stream.Next()
+	require.NoError(t, err)
+	require.Equal(t, `{"n":1}`, string(event.Data))
+	_, err = stream.Next()
+	require.Equal(t, io.EOF, err)
+	require.False(t, stream.Done())
+}
+
+func TestPayloadProof_Verify(t *testing.T) {
+	key := secp256k1.GenPrivKey()
+	signer, err := NewPrivKeySigner(key.Key)
+	require.NoError(t, err)
+
+	proof := &PayloadProof{InferenceId: "inf-1", PromptPayload: []byte("prompt"), ResponsePayload: []byte("response")}
+	proof.ExecutorSignature, err = calculations.Sign(signer, proof.components("gonka1executor"), calculations.Developer)
+	require.NoError(t, err)
+
+	require.NoError(t, proof.Verify("gonka1executor", []string{signer.PubKey()}))
+	require.Error(t, proof.Verify("gonka1other", []string{signer.PubKey()}))
+	proof.ResponsePayload = []byte("tampered")
+	require.Error(t, proof.Verify("gonka1executor", []string{signer.PubKey()}))
+}
@@ -0,0 +1,74 @@
+package gonka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Endpoint is a participant serving the public API, usable as a transfer agent
+type Endpoint struct {
+	Address string
+	Url     string
+	Models  []string
+	Weight  int64
+}
+
+// SupportsModel reports whether the participant serves the model in the current epoch
+func (e Endpoint) SupportsModel(model string) bool {
+	for _, m := range e.Models {
+		if m == model {
+			return true
+		}
+	}
+	return false
+}
+
+type currentParticipantsResponse struct {
+	ActiveParticipants struct {
+		EpochId      uint64 `json:"epoch_id"`
+		Participants []struct {
+			Index        string   `json:"index"`
+			InferenceUrl string   `json:"inference_url"`
+			Models       []string `json:"models"`
+			Weight       int64    `json:"weight"`
+		} `json:"participants"`
+	} `json:"active_participants"`
+}
+
+// DiscoverEndpoints lists the active participants of the current epoch as known to the given public API
+func DiscoverEndpoints(ctx context.Context, httpClient *http.Client, seedUrl string) ([]Endpoint, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(seedUrl, "/")+currentParticipantsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, seedUrl)
+	}
+
+	var response currentParticipantsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode participants from %s: %w", seedUrl, err)
+	}
+	endpoints := make([]Endpoint, 0, len(response.ActiveParticipants.Participants))
+	for _, p := range response.ActiveParticipants.Participants {
+		if p.InferenceUrl == "" {
+			continue
+		}
+		endpoints = append(endpoints, Endpoint{
+			Address: p.Index,
+			Url:     strings.TrimSuffix(p.InferenceUrl, "/"),
+			Models:  p.Models,
+			Weight:  p.Weight,
+		})
+	}
+	return endpoints, nil
+}
@@ -0,0 +1,47 @@
+package gonka
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+)
+
+// ErrNoEndpoints is returned when discovery found no participant to send the request to
+var ErrNoEndpoints = errors.New("no transfer agents available")
+
+// maxErrorBody bounds how much of a failed response is kept in an APIError
+const maxErrorBody = 4096
+
+// APIError is a non-2xx response from a participant
+type APIError struct {
+	Url        string
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s responded %d: %s", e.Url, e.StatusCode, e.Body)
+}
+
+// Retryable reports whether another TA may succeed where this one failed: overload rejections and the gateway
+// errors of a proxy that could not reach the TA. Any other 5xx may have already started the inference.
+func (e *APIError) Retryable() bool {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isConnectionError reports whether err happened before the request reached the TA
+func isConnectionError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func newAPIError(resp *http.Response, url string) *APIError {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	return &APIError{Url: url, StatusCode: resp.StatusCode, Body: string(body)}
+}
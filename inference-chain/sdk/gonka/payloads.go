@@ -0,0 +1,106 @@
+package gonka
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// Inference is the on-chain record of an inference
+type Inference = types.Inference
+
+// VerifyOriginalPrompt checks that the chain recorded the exact payload the developer signed
+func VerifyOriginalPrompt(inference *Inference, payload []byte) error {
+	hash := sha256.Sum256(payload)
+	if inference.OriginalPromptHash != hex.EncodeToString(hash[:]) {
+		return fmt.Errorf("inference %s: original prompt hash mismatch", inference.InferenceId)
+	}
+	return nil
+}
+
+// PayloadProof is the prompt and response of an inference, signed by its executor
+type PayloadProof struct {
+	InferenceId       string `json:"inference_id"`
+	PromptPayload     []byte `json:"prompt_payload"`
+	ResponsePayload   []byte `json:"response_payload"`
+	ExecutorSignature string `json:"executor_signature"`
+}
+
+// Verify checks the executor signature over inferenceId + sha256(prompt) + sha256(response).
+// pubKeys are the base64 keys allowed to sign for the executor, including its warm keys.
+func (p *PayloadProof) Verify(executorAddress string, pubKeys []string) error {
+	return calculations.ValidateSignatureWithGrantees(p.components(executorAddress), calculations.Developer, pubKeys, p.ExecutorSignature)
+}
+
+func (p *PayloadProof) components(executorAddress string) calculations.SignatureComponents {
+	promptHash := sha256.Sum256(p.PromptPayload)
+	responseHash := sha256.Sum256(p.ResponsePayload)
+	return calculations.SignatureComponents{
+		Payload:         p.InferenceId + hex.EncodeToString(promptHash[:]) + hex.EncodeToString(responseHash[:]),
+		TransferAddress: executorAddress,
+	}
+}
+
+// PayloadProof fetches the signed payloads of an inference from its executor.
+// Executors only serve payloads to active participants of the inference epoch,
+// so the client signer must be a participant (or one of its warm keys' granters).
+func (c *Client) PayloadProof(ctx context.Context, inference *Inference) (*PayloadProof, error) {
+	endpoints, err := c.Endpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var executor *Endpoint
+	for i := range endpoints {
+		if endpoints[i].Address == inference.ExecutedBy {
+			executor = &endpoints[i]
+			break
+		}
+	}
+	if executor == nil {
+		return nil, fmt.Errorf("executor %s of inference %s is not an active participant", inference.ExecutedBy, inference.InferenceId)
+	}
+
+	timestamp := c.config.Now().UnixNano()
+	components := calculations.SignatureComponents{
+		Payload:         inference.InferenceId,
+		Timestamp:       timestamp,
+		TransferAddress: c.Address(),
+	}
+	signature, err := calculations.Sign(c.config.Signer, components, calculations.Developer)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, executor.Url+inferencePayloadsPath+"?inference_id="+url.QueryEscape(inference.InferenceId), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(AuthorizationHeader, signature)
+	req.Header.Set(ValidatorAddressHeader, c.Address())
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(EpochIdHeader, strconv.FormatUint(inference.EpochId, 10))
+
+	resp, err := c.config.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, executor.Url)
+	}
+
+	var proof PayloadProof
+	if err := json.NewDecoder(resp.Body).Decode(&proof); err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
@@ -0,0 +1,131 @@
+package gonka
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/productscience/inference/x/inference/calculations"
+)
+
+// Signer signs developer requests on behalf of an account
+type Signer interface {
+	calculations.Signer
+	// Address returns the bech32 address of the signing account
+	Address() string
+}
+
+// KeyringSigner signs with a key held in a cosmos keyring
+type KeyringSigner struct {
+	keyring keyring.Keyring
+	address sdk.AccAddress
+}
+
+func NewKeyringSigner(kr keyring.Keyring, address sdk.AccAddress) *KeyringSigner {
+	return &KeyringSigner{keyring: kr, address: address}
+}
+
+// NewKeyringSignerFromName resolves the signing address from a key name
+func NewKeyringSignerFromName(kr keyring.Keyring, name string) (*KeyringSigner, error) {
+	record, err := kr.Key(name)
+	if err != nil {
+		return nil, err
+	}
+	address, err := record.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+	return NewKeyringSigner(kr, address), nil
+}
+
+func (s *KeyringSigner) SignBytes(data []byte) (string, error) {
+	signature, _, err := s.keyring.SignByAddress(s.address, data, signing.SignMode_SIGN_MODE_DIRECT)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+func (s *KeyringSigner) Address() string {
+	return mustBech32(s.address)
+}
+
+// PrivKeySigner signs with a raw secp256k1 private key
+type PrivKeySigner struct {
+	key *secp256k1.PrivKey
+}
+
+// NewPrivKeySigner accepts the 32 byte secp256k1 private key of the developer account
+func NewPrivKeySigner(key []byte) (*PrivKeySigner, error) {
+	if len(key) != secp256k1.PrivKeySize {
+		return nil, errors.New("secp256k1 private key must be 32 bytes")
+	}
+	return &PrivKeySigner{key: &secp256k1.PrivKey{Key: key}}, nil
+}
+
+func (s *PrivKeySigner) SignBytes(data []byte) (string, error) {
+	signature, err := s.key.Sign(data)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+func (s *PrivKeySigner) Address() string {
+	return mustBech32(sdk.AccAddress(s.key.PubKey().Address()))
+}
+
+// PubKey returns the base64 encoded public key, as registered on-chain
+func (s *PrivKeySigner) PubKey() string {
+	return base64.StdEncoding.EncodeToString(s.key.PubKey().Bytes())
+}
+
+// AuthHeaders are the signed headers of a developer request
+type AuthHeaders struct {
+	Authorization    string
+	RequesterAddress string
+	Timestamp        int64
+	TransferAddress  string
+}
+
+// SignRequest produces the auth headers for a payload sent to the TA with the given address.
+// The signature binds the payload, timestamp and TA, so it must be regenerated per TA and attempt.
+func SignRequest(signer Signer, payload []byte, transferAddress string, timestamp time.Time) (AuthHeaders, error) {
+	components := calculations.SignatureComponents{
+		Payload:         string(payload),
+		Timestamp:       timestamp.UnixNano(),
+		TransferAddress: transferAddress,
+	}
+	signature, err := calculations.Sign(signer, components, calculations.Developer)
+	if err != nil {
+		return AuthHeaders{}, err
+	}
+	return AuthHeaders{
+		Authorization:    signature,
+		RequesterAddress: signer.Address(),
+		Timestamp:        components.Timestamp,
+		TransferAddress:  transferAddress,
+	}, nil
+}
+
+func (h AuthHeaders) apply(header http.Header) {
+	header.Set(AuthorizationHeader, h.Authorization)
+	header.Set(RequesterAddressHeader, h.RequesterAddress)
+	header.Set(TimestampHeader, strconv.FormatInt(h.Timestamp, 10))
+	header.Set(TransferAddressHeader, h.TransferAddress)
+}
+
+func mustBech32(address sdk.AccAddress) string {
+	encoded, err := bech32.ConvertAndEncode(AddressPrefix, address)
+	if err != nil {
+		panic(err)
+	}
+	return encoded
+}
@@ -0,0 +1,81 @@
+package gonka
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// maxEventSize bounds a single SSE line, completions chunks are far smaller
+const maxEventSize = 1 << 20
+
+var doneEvent = []byte("[DONE]")
+
+// Event is one server-sent event of a streamed completion
+type Event struct {
+	// Data is the event payload, an OpenAI chat completion chunk
+	Data []byte
+}
+
+// Stream reads server-sent events of a streamed completion
+type Stream struct {
+	Endpoint Endpoint
+	body     io.ReadCloser
+	scanner  *bufio.Scanner
+	done     bool
+}
+
+func newStream(endpoint Endpoint, resp *http.Response) *Stream {
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEventSize)
+	return &Stream{Endpoint: endpoint, body: resp.Body, scanner: scanner}
+}
+
+// Next returns the next event, io.EOF once the stream reports [DONE] or ends. Done tells the two apart.
+func (s *Stream) Next() (Event, error) {
+	var data []byte
+	hasData := false
+	for s.scanner.Scan() {
+		line := s.scanner.Bytes()
+		if len(line) == 0 {
+			if hasData {
+				return s.event(data)
+			}
+			continue
+		}
+		if payload, ok := bytes.CutPrefix(line, []byte("data:")); ok {
+			payload = bytes.TrimPrefix(payload, []byte(" "))
+			if hasData {
+				data = append(data, '\n')
+			}
+			data = append(data, payload...)
+			hasData = true
+		}
+	}
+	if err := s.scanner.Err(); err != nil {
+		return Event{}, err
+	}
+	if hasData {
+		return s.event(data)
+	}
+	return Event{}, io.EOF
+}
+
+func (s *Stream) event(data []byte) (Event, error) {
+	if bytes.Equal(data, doneEvent) {
+		s.done = true
+		return Event{}, io.EOF
+	}
+	return Event{Data: data}, nil
+}
+
+// Done reports whether the TA ended the stream with [DONE]. A stream that reached io.EOF without it was cut
+// short and the completion may be incomplete.
+func (s *Stream) Done() bool {
+	return s.done
+}
+
+func (s *Stream) Close() error {
+	return s.body.Close()
+}
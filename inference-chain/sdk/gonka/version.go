@@ -0,0 +1,26 @@
+// Package gonka is the Go client for developers sending inference requests to the network.
+//
+// It discovers transfer agents (TAs) from the active participants of the current epoch,
+// signs requests with a developer key, streams responses and fails over to another TA
+// when one is overloaded or unavailable.
+package gonka
+
+// Version of the client SDK, bumped on every change to the exported API
+const Version = "v0.1.0"
+
+// AddressPrefix is the bech32 prefix of network account addresses
+const AddressPrefix = "gonka"
+
+// Request headers understood by the TA public API
+const (
+	AuthorizationHeader     = "Authorization"
+	RequesterAddressHeader  = "X-Requester-Address"
+	TimestampHeader         = "X-Timestamp"
+	TransferAddressHeader   = "X-Transfer-Address"
+	ValidatorAddressHeader  = "X-Validator-Address"
+	EpochIdHeader           = "X-Epoch-Id"
+	userAgent               = "gonka-go/" + Version
+	currentParticipantsPath = "/v1/epochs/current/participants"
+	chatCompletionsPath     = "/v1/chat/completions"
+	inferencePayloadsPath   = "/v1/inference/payloads"
+)
@@ -22,6 +22,11 @@ import (
 )
 
 func BlsKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return BlsKeeperWithBankKeeper(t, nil)
+}
+
+// BlsKeeperWithBankKeeper creates a BLS keeper that charges signing fees through bankEscrowKeeper
+func BlsKeeperWithBankKeeper(t testing.TB, bankEscrowKeeper types.BookkeepingBankKeeper) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -38,6 +43,7 @@ func BlsKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
+		bankEscrowKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -24,14 +24,15 @@ import (
 
 // CollateralMocks holds all the mock keepers for testing
 type CollateralMocks struct {
-	BankKeeper *MockBookkeepingBankKeeper
+	BankKeeper          *MockBookkeepingBankKeeper
+	StreamVestingKeeper *MockCollateralStreamVestingKeeper
 }
 
 func CollateralKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	ctrl := gomock.NewController(t)
 	bankKeeper := NewMockBookkeepingBankKeeper(ctrl)
 	// StakingKeeper and InferenceKeeper can be nil for basic tests
-	k, ctx := CollateralKeeperWithMock(t, bankKeeper)
+	k, ctx := CollateralKeeperWithMock(t, bankKeeper, nil)
 
 	return k, ctx
 }
@@ -39,11 +40,13 @@ func CollateralKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 func CollateralKeeperReturningMocks(t testing.TB) (keeper.Keeper, sdk.Context, CollateralMocks) {
 	ctrl := gomock.NewController(t)
 	bankKeeper := NewMockBookkeepingBankKeeper(ctrl)
+	streamVestingKeeper := NewMockCollateralStreamVestingKeeper(ctrl)
 
-	k, ctx := CollateralKeeperWithMock(t, bankKeeper)
+	k, ctx := CollateralKeeperWithMock(t, bankKeeper, streamVestingKeeper)
 
 	mocks := CollateralMocks{
-		BankKeeper: bankKeeper,
+		BankKeeper:          bankKeeper,
+		StreamVestingKeeper: streamVestingKeeper,
 	}
 
 	return k, ctx, mocks
@@ -52,6 +55,7 @@ func CollateralKeeperReturningMocks(t testing.TB) (keeper.Keeper, sdk.Context, C
 func CollateralKeeperWithMock(
 	t testing.TB,
 	bankKeeper *MockBookkeepingBankKeeper,
+	streamVestingKeeper types.StreamVestingKeeper,
 ) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
@@ -71,6 +75,8 @@ func CollateralKeeperWithMock(
 		authority.String(),
 		nil,
 		bankKeeper,
+		nil,
+		streamVestingKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
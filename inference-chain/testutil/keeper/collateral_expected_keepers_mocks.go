@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: x/collateral/types/expected_keepers.go
+//
+// Generated by this command:
+//
+//	mockgen -source=x/collateral/types/expected_keepers.go -package keeper -destination=testutil/keeper/collateral_expected_keepers_mocks.go -exclude_interfaces=AccountKeeper,BankKeeper,BookkeepingBankKeeper,DistributionKeeper,ParamSubspace -mock_names=StreamVestingKeeper=MockCollateralStreamVestingKeeper
+//
+
+// Package keeper is a generated GoMock package.
+package keeper
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCollateralStreamVestingKeeper is a mock of StreamVestingKeeper interface.
+type MockCollateralStreamVestingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockCollateralStreamVestingKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockCollateralStreamVestingKeeperMockRecorder is the mock recorder for MockCollateralStreamVestingKeeper.
+type MockCollateralStreamVestingKeeperMockRecorder struct {
+	mock *MockCollateralStreamVestingKeeper
+}
+
+// NewMockCollateralStreamVestingKeeper creates a new mock instance.
+func NewMockCollateralStreamVestingKeeper(ctrl *gomock.Controller) *MockCollateralStreamVestingKeeper {
+	mock := &MockCollateralStreamVestingKeeper{ctrl: ctrl}
+	mock.recorder = &MockCollateralStreamVestingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCollateralStreamVestingKeeper) EXPECT() *MockCollateralStreamVestingKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetVestingBalance mocks base method.
+func (m *MockCollateralStreamVestingKeeper) GetVestingBalance(ctx context.Context, participantAddress string) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVestingBalance", ctx, participantAddress)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// GetVestingBalance indicates an expected call of GetVestingBalance.
+func (mr *MockCollateralStreamVestingKeeperMockRecorder) GetVestingBalance(ctx, participantAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVestingBalance", reflect.TypeOf((*MockCollateralStreamVestingKeeper)(nil).GetVestingBalance), ctx, participantAddress)
+}
+
+// SlashVestingSchedule mocks base method.
+func (m *MockCollateralStreamVestingKeeper) SlashVestingSchedule(ctx context.Context, participantAddress string, amount types.Coin, recipientModule, memo string) (types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashVestingSchedule", ctx, participantAddress, amount, recipientModule, memo)
+	ret0, _ := ret[0].(types.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlashVestingSchedule indicates an expected call of SlashVestingSchedule.
+func (mr *MockCollateralStreamVestingKeeperMockRecorder) SlashVestingSchedule(ctx, participantAddress, amount, recipientModule, memo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashVestingSchedule", reflect.TypeOf((*MockCollateralStreamVestingKeeper)(nil).SlashVestingSchedule), ctx, participantAddress, amount, recipientModule, memo)
+}
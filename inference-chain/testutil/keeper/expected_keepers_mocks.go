@@ -23,6 +23,7 @@ import (
 	types2 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	types3 "github.com/productscience/inference/x/bls/types"
 	types4 "github.com/productscience/inference/x/inference/types"
+	types5 "github.com/productscience/inference/x/streamvesting/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -684,6 +685,21 @@ func (mr *MockCollateralKeeperMockRecorder) AdvanceEpoch(ctx, completedEpoch any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceEpoch", reflect.TypeOf((*MockCollateralKeeper)(nil).AdvanceEpoch), ctx, completedEpoch)
 }
 
+// CompensateRequester mocks base method.
+func (m *MockCollateralKeeper) CompensateRequester(ctx context.Context, requester types0.AccAddress, baseAmount types0.Coin, memo string) (types0.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompensateRequester", ctx, requester, baseAmount, memo)
+	ret0, _ := ret[0].(types0.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompensateRequester indicates an expected call of CompensateRequester.
+func (mr *MockCollateralKeeperMockRecorder) CompensateRequester(ctx, requester, baseAmount, memo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompensateRequester", reflect.TypeOf((*MockCollateralKeeper)(nil).CompensateRequester), ctx, requester, baseAmount, memo)
+}
+
 // GetCollateral mocks base method.
 func (m *MockCollateralKeeper) GetCollateral(ctx context.Context, participant types0.AccAddress) (types0.Coin, bool) {
 	m.ctrl.T.Helper()
@@ -699,6 +715,35 @@ func (mr *MockCollateralKeeperMockRecorder) GetCollateral(ctx, participant any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollateral", reflect.TypeOf((*MockCollateralKeeper)(nil).GetCollateral), ctx, participant)
 }
 
+// GetEffectiveCollateral mocks base method.
+func (m *MockCollateralKeeper) GetEffectiveCollateral(ctx context.Context, participant types0.AccAddress) (types0.Coin, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEffectiveCollateral", ctx, participant)
+	ret0, _ := ret[0].(types0.Coin)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetEffectiveCollateral indicates an expected call of GetEffectiveCollateral.
+func (mr *MockCollateralKeeperMockRecorder) GetEffectiveCollateral(ctx, participant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffectiveCollateral", reflect.TypeOf((*MockCollateralKeeper)(nil).GetEffectiveCollateral), ctx, participant)
+}
+
+// ReclaimCompensation mocks base method.
+func (m *MockCollateralKeeper) ReclaimCompensation(ctx context.Context, requester types0.AccAddress, amount types0.Coin, memo string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReclaimCompensation", ctx, requester, amount, memo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReclaimCompensation indicates an expected call of ReclaimCompensation.
+func (mr *MockCollateralKeeperMockRecorder) ReclaimCompensation(ctx, requester, amount, memo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReclaimCompensation", reflect.TypeOf((*MockCollateralKeeper)(nil).ReclaimCompensation), ctx, requester, amount, memo)
+}
+
 // Slash mocks base method.
 func (m *MockCollateralKeeper) Slash(ctx context.Context, participant types0.AccAddress, slashFraction math.LegacyDec, reason string) (types0.Coin, error) {
 	m.ctrl.T.Helper()
@@ -714,6 +759,21 @@ func (mr *MockCollateralKeeperMockRecorder) Slash(ctx, participant, slashFractio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slash", reflect.TypeOf((*MockCollateralKeeper)(nil).Slash), ctx, participant, slashFraction, reason)
 }
 
+// SlashWithBeneficiaries mocks base method.
+func (m *MockCollateralKeeper) SlashWithBeneficiaries(ctx context.Context, participant types0.AccAddress, slashFraction math.LegacyDec, reason string, beneficiaries []types0.AccAddress) (types0.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashWithBeneficiaries", ctx, participant, slashFraction, reason, beneficiaries)
+	ret0, _ := ret[0].(types0.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlashWithBeneficiaries indicates an expected call of SlashWithBeneficiaries.
+func (mr *MockCollateralKeeperMockRecorder) SlashWithBeneficiaries(ctx, participant, slashFraction, reason, beneficiaries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashWithBeneficiaries", reflect.TypeOf((*MockCollateralKeeper)(nil).SlashWithBeneficiaries), ctx, participant, slashFraction, reason, beneficiaries)
+}
+
 // MockStreamVestingKeeper is a mock of StreamVestingKeeper interface.
 type MockStreamVestingKeeper struct {
 	ctrl     *gomock.Controller
@@ -738,18 +798,18 @@ func (m *MockStreamVestingKeeper) EXPECT() *MockStreamVestingKeeperMockRecorder
 	return m.recorder
 }
 
-// AddVestedRewards mocks base method.
-func (m *MockStreamVestingKeeper) AddVestedRewards(ctx context.Context, participantAddress, fundingModule string, amount types0.Coins, vestingEpochs *uint64, memo string) error {
+// AddVestedRewardsFromSource mocks base method.
+func (m *MockStreamVestingKeeper) AddVestedRewardsFromSource(ctx context.Context, participantAddress, fundingModule string, source types5.VestingSource, amount types0.Coins, vestingEpochs *uint64, memo string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddVestedRewards", ctx, participantAddress, fundingModule, amount, vestingEpochs, memo)
+	ret := m.ctrl.Call(m, "AddVestedRewardsFromSource", ctx, participantAddress, fundingModule, source, amount, vestingEpochs, memo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AddVestedRewards indicates an expected call of AddVestedRewards.
-func (mr *MockStreamVestingKeeperMockRecorder) AddVestedRewards(ctx, participantAddress, fundingModule, amount, vestingEpochs, memo any) *gomock.Call {
+// AddVestedRewardsFromSource indicates an expected call of AddVestedRewardsFromSource.
+func (mr *MockStreamVestingKeeperMockRecorder) AddVestedRewardsFromSource(ctx, participantAddress, fundingModule, source, amount, vestingEpochs, memo any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVestedRewards", reflect.TypeOf((*MockStreamVestingKeeper)(nil).AddVestedRewards), ctx, participantAddress, fundingModule, amount, vestingEpochs, memo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVestedRewardsFromSource", reflect.TypeOf((*MockStreamVestingKeeper)(nil).AddVestedRewardsFromSource), ctx, participantAddress, fundingModule, source, amount, vestingEpochs, memo)
 }
 
 // AdvanceEpoch mocks base method.
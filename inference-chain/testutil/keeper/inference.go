@@ -202,6 +202,7 @@ func InferenceKeeperWithMock(
 		runtime.NewKVStoreService(blsStoreKey),
 		PrintlnLogger{},
 		authority.String(),
+		bankMock,
 	)
 
 	k := keeper.NewKeeper(
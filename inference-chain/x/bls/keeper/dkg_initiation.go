@@ -12,13 +12,20 @@ import (
 
 // InitiateKeyGenerationForEpoch initiates DKG for a given epoch with finalized participants
 func (k Keeper) InitiateKeyGenerationForEpoch(ctx sdk.Context, epochID uint64, finalizedParticipants []types.ParticipantWithWeightAndKey) error {
+	var epochBLSData types.EpochBLSData
+	return k.startDealing(ctx, epochID, finalizedParticipants, 0, nil, &epochBLSData)
+}
+
+// startDealing assigns slots to the participants and opens the dealing phase of the given DKG attempt,
+// replacing epochBLSData with the stored state of the new attempt
+func (k Keeper) startDealing(ctx sdk.Context, epochID uint64, participants []types.ParticipantWithWeightAndKey, attempt uint32, failedAttempts []types.DKGAttemptRecord, epochBLSData *types.EpochBLSData) error {
 	// Get module parameters
 	params := k.GetParams(ctx)
 	iTotalSlots := params.ITotalSlots
 	tSlotsDegree := iTotalSlots - params.TSlotsDegreeOffset // Calculate t from offset
 
 	// Perform deterministic slot assignment based on percentage weights
-	blsParticipants, err := k.AssignSlots(ctx, finalizedParticipants, iTotalSlots)
+	blsParticipants, err := k.AssignSlots(ctx, participants, iTotalSlots)
 	if err != nil {
 		return fmt.Errorf("failed to assign slots: %w", err)
 	}
@@ -47,7 +54,7 @@ func (k Keeper) InitiateKeyGenerationForEpoch(ctx sdk.Context, epochID uint64, f
 	}
 
 	// Create EpochBLSData
-	epochBLSData := types.EpochBLSData{
+	*epochBLSData = types.EpochBLSData{
 		EpochId:                     epochID,
 		ITotalSlots:                 iTotalSlots,
 		TSlotsDegree:                tSlotsDegree,
@@ -58,10 +65,12 @@ func (k Keeper) InitiateKeyGenerationForEpoch(ctx sdk.Context, epochID uint64, f
 		GroupPublicKey:              []byte{},
 		DealerParts:                 dealerParts,
 		VerificationSubmissions:     verificationSubmissions,
+		DkgAttempt:                  attempt,
+		FailedAttempts:              failedAttempts,
 	}
 
 	// Store the EpochBLSData
-	k.SetEpochBLSData(ctx, epochBLSData)
+	k.SetEpochBLSData(ctx, *epochBLSData)
 
 	// Set this as the active epoch since only one DKG can be active at a time
 	k.SetActiveEpochID(ctx, epochID)
@@ -72,6 +81,7 @@ func (k Keeper) InitiateKeyGenerationForEpoch(ctx sdk.Context, epochID uint64, f
 		ITotalSlots:  iTotalSlots,
 		TSlotsDegree: tSlotsDegree,
 		Participants: blsParticipants,
+		Attempt:      attempt,
 	}
 
 	ctx.EventManager().EmitTypedEvent(&event)
@@ -79,6 +89,7 @@ func (k Keeper) InitiateKeyGenerationForEpoch(ctx sdk.Context, epochID uint64, f
 	k.Logger().Info(
 		"DKG initiated for epoch",
 		"epoch_id", epochID,
+		"attempt", attempt,
 		"participants", len(blsParticipants),
 		"total_slots", iTotalSlots,
 		"t_degree", tSlotsDegree,
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/bls/types"
+)
+
+// failDKGAttempt handles a DKG attempt that cannot produce a group key. performed[i] tells whether participants[i]
+// did its part in the attempt. While retries remain and the participants that performed still hold enough of the
+// original weight, the others are excluded and dealing starts over with the rest within the same epoch. Otherwise the
+// epoch is marked FAILED and carries the group key of the epoch before it, if there is one.
+func (k Keeper) failDKGAttempt(ctx sdk.Context, epochBLSData *types.EpochBLSData, reason string, performed []bool) error {
+	params := k.GetParams(ctx)
+
+	var remaining []types.ParticipantWithWeightAndKey
+	var excluded []string
+	remainingWeight, excludedWeight := math.LegacyZeroDec(), math.LegacyZeroDec()
+	for i, participant := range epochBLSData.Participants {
+		if i < len(performed) && performed[i] {
+			remaining = append(remaining, types.ParticipantWithWeightAndKey{
+				Address:            participant.Address,
+				PercentageWeight:   participant.PercentageWeight,
+				Secp256k1PublicKey: participant.Secp256K1PublicKey,
+			})
+			remainingWeight = remainingWeight.Add(participant.PercentageWeight)
+		} else {
+			excluded = append(excluded, participant.Address)
+			excludedWeight = excludedWeight.Add(participant.PercentageWeight)
+		}
+	}
+
+	// The weight of the original participant set is what is left plus everything excluded by earlier attempts
+	originalWeight := remainingWeight.Add(excludedWeight)
+	for _, attempt := range epochBLSData.FailedAttempts {
+		originalWeight = originalWeight.Add(attempt.ExcludedWeight)
+	}
+	minRetryWeight := originalWeight.MulInt64(int64(params.DkgRetryMinWeightPercent)).QuoInt64(100)
+
+	retrying := epochBLSData.DkgAttempt < params.MaxDkgRetries &&
+		len(excluded) > 0 &&
+		len(remaining) > 0 &&
+		remainingWeight.GTE(minRetryWeight)
+
+	epochBLSData.FailedAttempts = append(epochBLSData.FailedAttempts, types.DKGAttemptRecord{
+		Attempt:              epochBLSData.DkgAttempt,
+		Reason:               reason,
+		FailedAtHeight:       ctx.BlockHeight(),
+		ExcludedParticipants: excluded,
+		ExcludedWeight:       excludedWeight,
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDKGAttemptFailed{
+		EpochId:               epochBLSData.EpochId,
+		Attempt:               epochBLSData.DkgAttempt,
+		Reason:                reason,
+		ExcludedParticipants:  excluded,
+		RemainingParticipants: uint32(len(remaining)),
+		Retrying:              retrying,
+	}); err != nil {
+		return fmt.Errorf("failed to emit EventDKGAttemptFailed for epoch %d: %w", epochBLSData.EpochId, err)
+	}
+
+	k.LogWarn("DKG attempt failed",
+		"epochId", epochBLSData.EpochId,
+		"attempt", epochBLSData.DkgAttempt,
+		"reason", reason,
+		"excluded", len(excluded),
+		"remaining", len(remaining),
+		"remainingWeight", remainingWeight.String(),
+		"originalWeight", originalWeight.String(),
+		"retrying", retrying)
+
+	if retrying {
+		return k.startDealing(ctx, epochBLSData.EpochId, remaining, epochBLSData.DkgAttempt+1, epochBLSData.FailedAttempts, epochBLSData)
+	}
+
+	epochBLSData.DkgPhase = types.DKGPhase_DKG_PHASE_FAILED
+
+	// Fall back to the key of the previous epoch so that threshold signing keeps working
+	source, carried := k.carriedKeySource(ctx, epochBLSData.EpochId)
+	if carried {
+		epochBLSData.KeyCarriedFromEpoch = source.EpochId
+		epochBLSData.GroupPublicKey = source.GroupPublicKey
+	}
+
+	k.SetEpochBLSData(ctx, *epochBLSData)
+
+	// Clear active epoch since DKG process is complete (failed)
+	k.ClearActiveEpochID(ctx)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDKGFailed{
+		EpochId:   epochBLSData.EpochId,
+		Reason:    reason,
+		EpochData: *epochBLSData,
+	}); err != nil {
+		return fmt.Errorf("failed to emit EventDKGFailed for epoch %d: %w", epochBLSData.EpochId, err)
+	}
+
+	if carried {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventGroupPublicKeyCarriedOver{
+			EpochId:        epochBLSData.EpochId,
+			SourceEpochId:  source.EpochId,
+			GroupPublicKey: source.GroupPublicKey,
+		}); err != nil {
+			return fmt.Errorf("failed to emit EventGroupPublicKeyCarriedOver for epoch %d: %w", epochBLSData.EpochId, err)
+		}
+	}
+
+	k.LogError("DKG marked as FAILED",
+		"epochId", epochBLSData.EpochId,
+		"attempts", len(epochBLSData.FailedAttempts),
+		"reason", reason,
+		"keyCarriedFromEpoch", epochBLSData.KeyCarriedFromEpoch)
+
+	return nil
+}
+
+// carriedKeySource returns the epoch whose DKG produced the group key the epoch before epochID uses,
+// or false when that epoch has no usable key
+func (k Keeper) carriedKeySource(ctx sdk.Context, epochID uint64) (types.EpochBLSData, bool) {
+	if epochID <= 1 {
+		return types.EpochBLSData{}, false
+	}
+	source, found := k.GetKeyEpochBLSData(ctx, epochID-1)
+	if !found || len(source.GroupPublicKey) == 0 {
+		return types.EpochBLSData{}, false
+	}
+	if source.DkgPhase != types.DKGPhase_DKG_PHASE_COMPLETED && source.DkgPhase != types.DKGPhase_DKG_PHASE_SIGNED {
+		return types.EpochBLSData{}, false
+	}
+	return source, true
+}
+
+// GetKeyEpochBLSData returns the BLS data of the epoch whose DKG produced the group key of epochID. That is the
+// epoch itself unless its DKG failed and it carries the key of an earlier epoch.
+func (k Keeper) GetKeyEpochBLSData(ctx sdk.Context, epochID uint64) (types.EpochBLSData, bool) {
+	epochBLSData, found := k.GetEpochBLSData(ctx, epochID)
+	if !found || epochBLSData.KeyCarriedFromEpoch == 0 {
+		return epochBLSData, found
+	}
+	return k.GetEpochBLSData(ctx, epochBLSData.KeyCarriedFromEpoch)
+}
+
+// validatingEpochID returns the epoch whose group validates the group key of epochID: the epoch before it,
+// or the epoch that one carries its key from
+func (k Keeper) validatingEpochID(ctx sdk.Context, epochID uint64) uint64 {
+	previous, found := k.GetEpochBLSData(ctx, epochID-1)
+	if found && previous.KeyCarriedFromEpoch != 0 {
+		return previous.KeyCarriedFromEpoch
+	}
+	return epochID - 1
+}
+
+func hasDealerPart(epochBLSData *types.EpochBLSData, participantIndex int) bool {
+	return participantIndex < len(epochBLSData.DealerParts) &&
+		epochBLSData.DealerParts[participantIndex] != nil &&
+		epochBLSData.DealerParts[participantIndex].DealerAddress != ""
+}
+
+func hasVerificationVector(epochBLSData *types.EpochBLSData, participantIndex int) bool {
+	return participantIndex < len(epochBLSData.VerificationSubmissions) &&
+		epochBLSData.VerificationSubmissions[participantIndex] != nil &&
+		len(epochBLSData.VerificationSubmissions[participantIndex].DealerValidity) > 0
+}
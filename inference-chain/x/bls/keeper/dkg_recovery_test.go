@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/bls/types"
+)
+
+func TestDKGRetry_ExcludesNonPerformingAndCarriesKey(t *testing.T) {
+	k, ctx := keepertest.BlsKeeper(t)
+
+	// The previous epoch completed DKG and has a key to fall back to
+	previousKey := createTestG2Commitment()
+	k.SetEpochBLSData(ctx, types.EpochBLSData{EpochId: 29, DkgPhase: types.DKGPhase_DKG_PHASE_SIGNED, GroupPublicKey: previousKey})
+
+	// Half of the slots dealt: dealing fails, but the dealers hold enough weight to retry without the others
+	epochID := uint64(30)
+	epochBLSData := createTestEpochBLSData(epochID, 4)
+	epochBLSData.DealerParts[0].DealerAddress = "participant1"
+	epochBLSData.DealerParts[1].DealerAddress = "participant2"
+	k.SetEpochBLSData(ctx, epochBLSData)
+	k.SetActiveEpochID(ctx, epochID)
+
+	ctx = ctx.WithBlockHeight(epochBLSData.DealingPhaseDeadlineBlock).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.ProcessDKGPhaseTransitions(ctx))
+
+	retried, found := k.GetEpochBLSData(ctx, epochID)
+	require.True(t, found)
+	require.Equal(t, types.DKGPhase_DKG_PHASE_DEALING, retried.DkgPhase)
+	require.Equal(t, uint32(1), retried.DkgAttempt)
+	require.Len(t, retried.Participants, 2)
+	require.Equal(t, "participant1", retried.Participants[0].Address)
+	require.Equal(t, uint32(0), retried.Participants[0].SlotStartIndex)
+	require.Equal(t, retried.ITotalSlots-1, retried.Participants[1].SlotEndIndex)
+	require.Len(t, retried.DealerParts, 2)
+	require.Empty(t, retried.DealerParts[0].DealerAddress)
+	require.Greater(t, retried.DealingPhaseDeadlineBlock, ctx.BlockHeight())
+	require.Len(t, retried.FailedAttempts, 1)
+	require.Equal(t, []string{"participant3", "participant4"}, retried.FailedAttempts[0].ExcludedParticipants)
+	activeEpoch, found := k.GetActiveEpochID(ctx)
+	require.True(t, found)
+	require.Equal(t, epochID, activeEpoch)
+	requireEvent(t, ctx, "inference.bls.EventDKGAttemptFailed")
+	requireEvent(t, ctx, "inference.bls.EventKeyGenerationInitiated")
+
+	// Nobody deals in the retry: with everyone excluded the epoch fails and carries the previous key
+	ctx = ctx.WithBlockHeight(retried.DealingPhaseDeadlineBlock).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.ProcessDKGPhaseTransitions(ctx))
+
+	failed, found := k.GetEpochBLSData(ctx, epochID)
+	require.True(t, found)
+	require.Equal(t, types.DKGPhase_DKG_PHASE_FAILED, failed.DkgPhase)
+	require.Equal(t, uint64(29), failed.KeyCarriedFromEpoch)
+	require.Equal(t, previousKey, failed.GroupPublicKey)
+	require.Len(t, failed.FailedAttempts, 2)
+	_, found = k.GetActiveEpochID(ctx)
+	require.False(t, found)
+	requireEvent(t, ctx, "inference.bls.EventDKGFailed")
+	requireEvent(t, ctx, "inference.bls.EventGroupPublicKeyCarriedOver")
+
+	keyEpoch, found := k.GetKeyEpochBLSData(ctx, epochID)
+	require.True(t, found)
+	require.Equal(t, uint64(29), keyEpoch.EpochId)
+
+	// Threshold signing for the epoch is done by the group the key was carried from
+	require.NoError(t, k.RequestThresholdSignature(ctx, types.SigningData{
+		CurrentEpochId: epochID,
+		ChainId:        make([]byte, 32),
+		RequestId:      make([]byte, 32),
+	}))
+	request, err := k.GetSigningStatus(ctx, make([]byte, 32))
+	require.NoError(t, err)
+	require.Equal(t, uint64(29), request.CurrentEpochId)
+}
+
+func TestDKGRetry_NoValidDealers(t *testing.T) {
+	k, ctx := keepertest.BlsKeeper(t)
+
+	epochID := uint64(31)
+	epochBLSData := createTestEpochBLSData(epochID, 3)
+	epochBLSData.DkgPhase = types.DKGPhase_DKG_PHASE_VERIFYING
+	for i := range epochBLSData.Participants {
+		epochBLSData.DealerParts[i].DealerAddress = epochBLSData.Participants[i].Address
+		epochBLSData.VerificationSubmissions[i].DealerValidity = []bool{false, false, false}
+	}
+	k.SetEpochBLSData(ctx, epochBLSData)
+	k.SetActiveEpochID(ctx, epochID)
+
+	// Every dealer is rejected: nobody is left to retry with and there is no previous key to carry
+	require.NoError(t, k.CompleteDKG(ctx, &epochBLSData))
+	require.Equal(t, types.DKGPhase_DKG_PHASE_FAILED, epochBLSData.DkgPhase)
+	require.Zero(t, epochBLSData.KeyCarriedFromEpoch)
+	require.Empty(t, epochBLSData.GroupPublicKey)
+	require.Len(t, epochBLSData.FailedAttempts, 1)
+	require.Len(t, epochBLSData.FailedAttempts[0].ExcludedParticipants, 3)
+	_, found := k.GetActiveEpochID(ctx)
+	require.False(t, found)
+}
+
+func requireEvent(t *testing.T, ctx sdk.Context, eventType string) {
+	t.Helper()
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return
+		}
+	}
+	require.Failf(t, "event not emitted", "%s", eventType)
+}
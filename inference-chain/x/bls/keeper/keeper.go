@@ -21,6 +21,8 @@ type (
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
+
+		bankEscrowKeeper types.BookkeepingBankKeeper
 	}
 )
 
@@ -33,7 +35,7 @@ func NewKeeper(
 	storeService store.KVStoreService,
 	logger log.Logger,
 	authority string,
-
+	bankEscrowKeeper types.BookkeepingBankKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -44,6 +46,8 @@ func NewKeeper(
 		storeService: storeService,
 		authority:    authority,
 		logger:       logger,
+
+		bankEscrowKeeper: bankEscrowKeeper,
 	}
 }
 
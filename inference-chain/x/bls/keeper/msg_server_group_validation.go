@@ -2,15 +2,13 @@ package keeper
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/binary"
 	"fmt"
 
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/bls/keychain"
 	"github.com/productscience/inference/x/bls/types"
-	"golang.org/x/crypto/sha3"
 )
 
 func (k Keeper) LogInfo(msg string, keyvals ...interface{}) {
@@ -40,7 +38,8 @@ func (ms msgServer) SubmitGroupKeyValidationSignature(goCtx context.Context, msg
 		return nil, fmt.Errorf("epoch 1 does not require group key validation (genesis case)")
 	}
 
-	previousEpochId := msg.NewEpochId - 1
+	// The previous epoch's group validates the key, or the group it carries its key from if its own DKG failed
+	previousEpochId := ms.validatingEpochID(ctx, msg.NewEpochId)
 
 	// Get the new epoch's BLS data to get the group public key being validated
 	newEpochBLSData, found := ms.GetEpochBLSData(ctx, msg.NewEpochId)
@@ -101,11 +100,11 @@ func (ms msgServer) SubmitGroupKeyValidationSignature(goCtx context.Context, msg
 
 	// Check or create GroupKeyValidationState
 	var validationState *types.GroupKeyValidationState
-	validationStateKey := fmt.Sprintf("group_validation_%d", msg.NewEpochId)
+	validationStateKey := types.GroupKeyValidationStateKey(msg.NewEpochId)
 
 	// Try to get existing validation state
 	store := ms.storeService.OpenKVStore(ctx)
-	bz, err := store.Get([]byte(validationStateKey))
+	bz, err := store.Get(validationStateKey)
 	if err != nil {
 		ms.Keeper.LogError("Failed to get validation state", "new_epoch_id", msg.NewEpochId, "error", err.Error())
 		return nil, fmt.Errorf("failed to get validation state: %w", err)
@@ -256,8 +255,7 @@ func (ms msgServer) SubmitGroupKeyValidationSignature(goCtx context.Context, msg
 	}
 
 	// Store updated validation state
-	bz = ms.cdc.MustMarshal(validationState)
-	err = store.Set([]byte(validationStateKey), bz)
+	err = ms.SetGroupKeyValidationState(ctx, *validationState)
 	if err != nil {
 		return nil, fmt.Errorf("failed to store validation state: %w", err)
 	}
@@ -268,54 +266,21 @@ func (ms msgServer) SubmitGroupKeyValidationSignature(goCtx context.Context, msg
 // computeValidationMessageHash computes the message hash for group key validation.
 // Uses Ethereum-compatible abi.encodePacked(previous_epoch_id [8], chain_id [32], new_group_key_uncompressed [256]).
 func (ms msgServer) computeValidationMessageHash(ctx sdk.Context, groupPublicKey []byte, previousEpochId, newEpochId uint64) ([]byte, error) {
-	// Expect 96-byte compressed G2 key; decompress deterministically.
-	if len(groupPublicKey) != 96 {
-		return nil, fmt.Errorf("invalid group public key length: expected 96 bytes, got %d", len(groupPublicKey))
-	}
-	var g2 bls12381.G2Affine
-	if err := g2.Unmarshal(groupPublicKey); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal compressed G2 key: %w", err)
-	}
-
 	// Use GONKA_CHAIN_ID bytes32 (hash of chain-id string), consistent with bridge signing logic
-	gonkaIdHash := sha256.Sum256([]byte(ctx.ChainID()))
-	chainIdBytes := gonkaIdHash[:]
-
-	// Implement Ethereum-compatible abi.encodePacked with uncompressed G2 in 64-byte limbs:
-	// Order: X.c0, X.c1, Y.c0, Y.c1, each 64-byte big-endian (16 zero bytes + 48-byte fp).
-	var encodedData []byte
-	var g2Uncompressed256 []byte
-
-	// Add previous_epoch_id (uint64 -> 8 bytes big endian)
-	previousEpochBytes := make([]byte, 8)
-	binary.BigEndian.PutUint64(previousEpochBytes, previousEpochId)
-	encodedData = append(encodedData, previousEpochBytes...)
-
-	// Add chain_id (32 bytes)
-	encodedData = append(encodedData, chainIdBytes...)
-
-	// Build 256-byte uncompressed encoding: 4 field elements, each 64 bytes
-	appendFp64 := func(e fp.Element) {
-		// 48-byte big-endian field element
-		be48 := e.Bytes()
-		// Left-pad to 64 bytes
-		var limb [64]byte
-		copy(limb[64-48:], be48[:])
-		encodedData = append(encodedData, limb[:]...)
-		g2Uncompressed256 = append(g2Uncompressed256, limb[:]...)
-	}
-
-	// Note: gnark-crypto stores E2 as (A0, A1). We need c0 then c1.
-	// g2.X.A0 = c0, g2.X.A1 = c1; same for Y.
-	appendFp64(g2.X.A0)
-	appendFp64(g2.X.A1)
-	appendFp64(g2.Y.A0)
-	appendFp64(g2.Y.A1)
+	return keychain.ValidationMessageHash(keychain.ChainIdHash(ctx.ChainID()), previousEpochId, groupPublicKey)
+}
 
-	// Compute keccak256 hash (Ethereum-compatible)
-	hash := sha3.NewLegacyKeccak256()
-	hash.Write(encodedData)
-	messageHash := hash.Sum(nil)
+// GetGroupKeyValidationState returns the validation of a new epoch's group key by the previous epoch
+func (k Keeper) GetGroupKeyValidationState(ctx sdk.Context, newEpochID uint64) (types.GroupKeyValidationState, bool) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GroupKeyValidationStateKey(newEpochID))
+	if err != nil || bz == nil {
+		return types.GroupKeyValidationState{}, false
+	}
+	var state types.GroupKeyValidationState
+	k.cdc.MustUnmarshal(bz, &state)
+	return state, true
+}
 
-	return messageHash, nil
+func (k Keeper) SetGroupKeyValidationState(ctx sdk.Context, state types.GroupKeyValidationState) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.GroupKeyValidationStateKey(state.NewEpochId), k.cdc.MustMarshal(&state))
 }
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/bls/types"
+)
+
+// SetSigningSchema adds or replaces a signing schema through governance
+func (ms msgServer) SetSigningSchema(goCtx context.Context, msg *types.MsgSetSigningSchema) (*types.MsgSetSigningSchemaResponse, error) {
+	if ms.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.GetAuthority(), msg.Authority)
+	}
+	if err := msg.Schema.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSchemaPayload, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.SetSigningSchema(ctx, msg.Schema); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSigningSchemaUpdated{
+		SchemaName: msg.Schema.Name,
+		Enabled:    msg.Schema.Enabled,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSigningSchemaResponse{}, nil
+}
+
+// RequestSchemaSignature handles paid threshold signature requests over payloads of whitelisted schemas
+func (ms msgServer) RequestSchemaSignature(goCtx context.Context, msg *types.MsgRequestSchemaSignature) (*types.MsgRequestSchemaSignatureResponse, error) {
+	requester, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid creator address")
+	}
+	if msg.DeadlineBlocks < 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidSchemaPayload, "deadline_blocks cannot be negative")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	request, err := ms.Keeper.RequestSchemaSignature(ctx, requester, msg.SchemaName, msg.Data, msg.DeadlineBlocks, msg.MaxRetries)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestSchemaSignatureResponse{
+		RequestId: request.RequestId,
+		Sequence:  request.Sequence,
+	}, nil
+}
@@ -88,6 +88,7 @@ func (k Keeper) TransitionToVerifyingPhase(ctx sdk.Context, epochBLSData *types.
 			EpochId:                     epochBLSData.EpochId,
 			VerifyingPhaseDeadlineBlock: uint64(epochBLSData.VerifyingPhaseDeadlineBlock),
 			EpochData:                   *epochBLSData,
+			Attempt:                     epochBLSData.DkgAttempt,
 		}); err != nil {
 			return fmt.Errorf("failed to emit EventVerifyingPhaseStarted for epoch %d: %w", epochBLSData.EpochId, err)
 		}
@@ -97,30 +98,15 @@ func (k Keeper) TransitionToVerifyingPhase(ctx sdk.Context, epochBLSData *types.
 			"verifyingDeadline", epochBLSData.VerifyingPhaseDeadlineBlock)
 
 	} else {
-		// Insufficient participation - mark as FAILED
-		epochBLSData.DkgPhase = types.DKGPhase_DKG_PHASE_FAILED
-
-		// Store updated epoch data
-		k.SetEpochBLSData(ctx, *epochBLSData)
-
-		// Clear active epoch since DKG process is complete (failed)
-		k.ClearActiveEpochID(ctx)
-
-		// Emit event for DKG failure
+		// Insufficient participation - retry without the participants that did not deal, or mark as FAILED
 		failureReason := fmt.Sprintf("Insufficient participation in dealing phase: %d slots with dealer parts out of %d total slots (required: >%d)",
 			slotsWithDealerParts, epochBLSData.ITotalSlots, epochBLSData.ITotalSlots/2)
 
-		if err := ctx.EventManager().EmitTypedEvent(&types.EventDKGFailed{
-			EpochId:   epochBLSData.EpochId,
-			Reason:    failureReason,
-			EpochData: *epochBLSData,
-		}); err != nil {
-			return fmt.Errorf("failed to emit EventDKGFailed for epoch %d: %w", epochBLSData.EpochId, err)
+		performed := make([]bool, len(epochBLSData.Participants))
+		for i := range performed {
+			performed[i] = hasDealerPart(epochBLSData, i)
 		}
-
-		k.Logger().Info("DKG marked as FAILED due to insufficient participation",
-			"epochId", epochBLSData.EpochId,
-			"reason", failureReason)
+		return k.failDKGAttempt(ctx, epochBLSData, failureReason, performed)
 	}
 
 	return nil
@@ -173,6 +159,19 @@ func (k Keeper) CompleteDKG(ctx sdk.Context, epochBLSData *types.EpochBLSData) e
 			return fmt.Errorf("failed to determine valid dealers for epoch %d: %w", epochBLSData.EpochId, err)
 		}
 
+		// Without a valid dealer there is no key - retry without the rejected dealers, or mark as FAILED
+		performed := make([]bool, len(epochBLSData.Participants))
+		validDealerCount := 0
+		for i := range performed {
+			performed[i] = validDealers[i] && hasVerificationVector(epochBLSData, i)
+			if validDealers[i] {
+				validDealerCount++
+			}
+		}
+		if validDealerCount == 0 {
+			return k.failDKGAttempt(ctx, epochBLSData, "No dealer was accepted by the verifiers", performed)
+		}
+
 		groupPublicKey, err := k.ComputeGroupPublicKey(epochBLSData, validDealers)
 		if err != nil {
 			return fmt.Errorf("failed to compute group public key for epoch %d: %w", epochBLSData.EpochId, err)
@@ -193,54 +192,32 @@ func (k Keeper) CompleteDKG(ctx sdk.Context, epochBLSData *types.EpochBLSData) e
 
 		// Emit event for successful DKG completion
 		if err := ctx.EventManager().EmitTypedEvent(&types.EventGroupPublicKeyGenerated{
-			EpochId:        epochBLSData.EpochId,
-			GroupPublicKey: groupPublicKey,
-			ITotalSlots:    epochBLSData.ITotalSlots,
-			TSlotsDegree:   epochBLSData.TSlotsDegree,
-			EpochData:      *epochBLSData,
-			ChainId:        ctx.ChainID(),
+			EpochId:           epochBLSData.EpochId,
+			GroupPublicKey:    groupPublicKey,
+			ITotalSlots:       epochBLSData.ITotalSlots,
+			TSlotsDegree:      epochBLSData.TSlotsDegree,
+			EpochData:         *epochBLSData,
+			ChainId:           ctx.ChainID(),
+			ValidatingEpochId: k.validatingEpochID(ctx, epochBLSData.EpochId),
 		}); err != nil {
 			return fmt.Errorf("failed to emit EventGroupPublicKeyGenerated for epoch %d: %w", epochBLSData.EpochId, err)
 		}
 
 		k.Logger().Info("DKG completed successfully",
 			"epochId", epochBLSData.EpochId,
-			"validDealersCount", func() int {
-				count := 0
-				for _, isValid := range validDealers {
-					if isValid {
-						count++
-					}
-				}
-				return count
-			}(),
+			"validDealersCount", validDealerCount,
 			"groupPublicKeySize", len(groupPublicKey))
 
 	} else {
-		// Insufficient verification participation - mark as FAILED
-		epochBLSData.DkgPhase = types.DKGPhase_DKG_PHASE_FAILED
-
-		// Store updated epoch data
-		k.SetEpochBLSData(ctx, *epochBLSData)
-
-		// Clear active epoch since DKG process is complete (failed)
-		k.ClearActiveEpochID(ctx)
-
-		// Emit event for DKG failure
+		// Insufficient verification participation - retry without the participants that did not deal or verify, or mark as FAILED
 		failureReason := fmt.Sprintf("Insufficient participation in verification phase: %d slots with verification vectors out of %d total slots (required: >%d)",
 			slotsWithVerification, epochBLSData.ITotalSlots, epochBLSData.ITotalSlots/2)
 
-		if err := ctx.EventManager().EmitTypedEvent(&types.EventDKGFailed{
-			EpochId:   epochBLSData.EpochId,
-			Reason:    failureReason,
-			EpochData: *epochBLSData,
-		}); err != nil {
-			return fmt.Errorf("failed to emit EventDKGFailed for epoch %d: %w", epochBLSData.EpochId, err)
+		performed := make([]bool, len(epochBLSData.Participants))
+		for i := range performed {
+			performed[i] = hasDealerPart(epochBLSData, i) && hasVerificationVector(epochBLSData, i)
 		}
-
-		k.Logger().Info("DKG marked as FAILED due to insufficient verification participation",
-			"epochId", epochBLSData.EpochId,
-			"reason", failureReason)
+		return k.failDKGAttempt(ctx, epochBLSData, failureReason, performed)
 	}
 
 	return nil
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/productscience/inference/x/bls/keychain"
+	"github.com/productscience/inference/x/bls/types"
+)
+
+// maxKeyChainProofLinks bounds a single proof. Verifiers further behind fetch the rest with the
+// last epoch of the proof as their new trusted epoch.
+const maxKeyChainProofLinks = 100
+
+// EpochKeyChainProof returns the chain of validated group keys from a trusted epoch to the target epoch,
+// or to the latest validated epoch when no target is given
+func (k Keeper) EpochKeyChainProof(ctx context.Context, req *types.QueryEpochKeyChainProofRequest) (*types.QueryEpochKeyChainProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.TrustedEpochId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "trusted_epoch_id cannot be zero")
+	}
+	if req.TargetEpochId != 0 && req.TargetEpochId < req.TrustedEpochId {
+		return nil, status.Error(codes.InvalidArgument, "target_epoch_id cannot be before trusted_epoch_id")
+	}
+	if req.TargetEpochId != 0 && req.TargetEpochId-req.TrustedEpochId > maxKeyChainProofLinks {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("a proof spans at most %d epochs", maxKeyChainProofLinks))
+	}
+
+	proof, err := k.BuildEpochKeyChainProof(sdk.UnwrapSDKContext(ctx), req.TrustedEpochId, req.TargetEpochId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryEpochKeyChainProofResponse{Proof: *proof}, nil
+}
+
+// BuildEpochKeyChainProof links the group key of every epoch after trustedEpochId to the key of the epoch before
+// it through the validation signature that epoch's group produced. Epochs that carry an earlier epoch's key have no
+// key of their own and are skipped. With targetEpochId zero the proof stops at the last epoch whose key was
+// validated, otherwise every epoch up to targetEpochId must be validated.
+func (k Keeper) BuildEpochKeyChainProof(ctx sdk.Context, trustedEpochId, targetEpochId uint64) (*types.EpochKeyChainProof, error) {
+	trusted, found := k.GetEpochBLSData(ctx, trustedEpochId)
+	if !found || len(trusted.GroupPublicKey) == 0 {
+		return nil, fmt.Errorf("epoch %d has no group public key", trustedEpochId)
+	}
+	if trusted.KeyCarriedFromEpoch != 0 {
+		return nil, fmt.Errorf("epoch %d carries the group public key of epoch %d, trust that epoch instead", trustedEpochId, trusted.KeyCarriedFromEpoch)
+	}
+
+	chainId := ctx.ChainID()
+	proof := &types.EpochKeyChainProof{
+		ChainId:               chainId,
+		ChainIdHash:           keychain.ChainIdHash(chainId),
+		TrustedEpochId:        trustedEpochId,
+		TrustedGroupPublicKey: trusted.GroupPublicKey,
+	}
+	previousEpochId := trustedEpochId
+	for epochId := trustedEpochId + 1; len(proof.Links) < maxKeyChainProofLinks; epochId++ {
+		if targetEpochId != 0 && epochId > targetEpochId {
+			break
+		}
+		if data, found := k.GetEpochBLSData(ctx, epochId); found && data.KeyCarriedFromEpoch == previousEpochId {
+			continue
+		}
+		link, err := k.epochKeyLink(ctx, epochId, previousEpochId)
+		if err != nil {
+			if targetEpochId == 0 {
+				break
+			}
+			return nil, err
+		}
+		proof.Links = append(proof.Links, *link)
+		previousEpochId = epochId
+	}
+	return proof, nil
+}
+
+// epochKeyLink returns the link for an epoch whose group key was validated by the group of previousEpochId
+func (k Keeper) epochKeyLink(ctx sdk.Context, epochId, previousEpochId uint64) (*types.EpochKeyLink, error) {
+	data, found := k.GetEpochBLSData(ctx, epochId)
+	if !found || data.DkgPhase != types.DKGPhase_DKG_PHASE_SIGNED || len(data.ValidationSignature) == 0 {
+		return nil, fmt.Errorf("group key of epoch %d is not validated", epochId)
+	}
+	validation, found := k.GetGroupKeyValidationState(ctx, epochId)
+	if !found || validation.PreviousEpochId != previousEpochId {
+		return nil, fmt.Errorf("group key of epoch %d was not validated by epoch %d", epochId, previousEpochId)
+	}
+
+	uncompressedKey, err := keychain.UncompressedG2(data.GroupPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("epoch %d: %w", epochId, err)
+	}
+	uncompressedSignature, err := keychain.UncompressedG1(data.ValidationSignature)
+	if err != nil {
+		return nil, fmt.Errorf("epoch %d: %w", epochId, err)
+	}
+	return &types.EpochKeyLink{
+		EpochId:                         epochId,
+		PreviousEpochId:                 validation.PreviousEpochId,
+		GroupPublicKey:                  data.GroupPublicKey,
+		GroupPublicKeyUncompressed:      uncompressedKey,
+		ValidationSignature:             data.ValidationSignature,
+		ValidationSignatureUncompressed: uncompressedSignature,
+	}, nil
+}
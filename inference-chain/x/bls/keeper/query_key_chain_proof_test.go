@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/bls/keychain"
+	"github.com/productscience/inference/x/bls/types"
+)
+
+func TestEpochKeyChainProof(t *testing.T) {
+	k, ctx := keepertest.BlsKeeper(t)
+	ctx = ctx.WithChainID("gonka-test")
+
+	// Epochs 1-3 have validated keys, epoch 4 finished DKG but is not validated yet
+	secrets := make([]*big.Int, 5)
+	publicKeys := make([][]byte, 5)
+	_, _, _, g2Gen := bls12381.Generators()
+	for epochId := 1; epochId <= 4; epochId++ {
+		var secret fr.Element
+		_, err := secret.SetRandom()
+		require.NoError(t, err)
+		secrets[epochId] = secret.BigInt(new(big.Int))
+		var publicKey bls12381.G2Affine
+		publicKey.ScalarMultiplication(&g2Gen, secrets[epochId])
+		compressed := publicKey.Bytes()
+		publicKeys[epochId] = compressed[:]
+
+		data := types.EpochBLSData{EpochId: uint64(epochId), DkgPhase: types.DKGPhase_DKG_PHASE_COMPLETED, GroupPublicKey: publicKeys[epochId]}
+		if epochId > 1 && epochId < 4 {
+			messageHash, err := keychain.ValidationMessageHash(keychain.ChainIdHash("gonka-test"), uint64(epochId-1), publicKeys[epochId])
+			require.NoError(t, err)
+			message, err := keychain.HashToG1(messageHash)
+			require.NoError(t, err)
+			var signature bls12381.G1Affine
+			signature.ScalarMultiplication(&message, secrets[epochId-1])
+			sigBytes := signature.Bytes()
+
+			data.DkgPhase = types.DKGPhase_DKG_PHASE_SIGNED
+			data.ValidationSignature = sigBytes[:]
+			require.NoError(t, k.SetGroupKeyValidationState(ctx, types.GroupKeyValidationState{
+				NewEpochId:      uint64(epochId),
+				PreviousEpochId: uint64(epochId - 1),
+				Status:          types.GroupKeyValidationStatus_GROUP_KEY_VALIDATION_STATUS_VALIDATED,
+				FinalSignature:  sigBytes[:],
+				MessageHash:     messageHash,
+			}))
+		}
+		k.SetEpochBLSData(ctx, data)
+	}
+
+	resp, err := k.EpochKeyChainProof(ctx, &types.QueryEpochKeyChainProofRequest{TrustedEpochId: 1})
+	require.NoError(t, err)
+	require.Len(t, resp.Proof.Links, 2)
+	require.Len(t, resp.Proof.Links[0].GroupPublicKeyUncompressed, 256)
+	require.Len(t, resp.Proof.Links[0].ValidationSignatureUncompressed, 128)
+
+	epochId, groupPublicKey, err := keychain.Verify(&resp.Proof, "gonka-test", 1, publicKeys[1])
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), epochId)
+	require.Equal(t, publicKeys[3], groupPublicKey)
+
+	resp, err = k.EpochKeyChainProof(ctx, &types.QueryEpochKeyChainProofRequest{TrustedEpochId: 2, TargetEpochId: 3})
+	require.NoError(t, err)
+	require.Len(t, resp.Proof.Links, 1)
+	_, _, err = keychain.Verify(&resp.Proof, "gonka-test", 2, publicKeys[2])
+	require.NoError(t, err)
+
+	// An explicit target must be reachable
+	_, err = k.EpochKeyChainProof(ctx, &types.QueryEpochKeyChainProofRequest{TrustedEpochId: 1, TargetEpochId: 4})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = k.EpochKeyChainProof(ctx, &types.QueryEpochKeyChainProofRequest{TrustedEpochId: 3, TargetEpochId: 2})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = k.EpochKeyChainProof(ctx, &types.QueryEpochKeyChainProofRequest{TrustedEpochId: 9})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// DKG of epoch 4 fails and it carries the key of epoch 3, whose group then validates the key of epoch 5
+	k.SetEpochBLSData(ctx, types.EpochBLSData{EpochId: 4, DkgPhase: types.DKGPhase_DKG_PHASE_FAILED, GroupPublicKey: publicKeys[3], KeyCarriedFromEpoch: 3})
+	var secret fr.Element
+	_, err = secret.SetRandom()
+	require.NoError(t, err)
+	var publicKey bls12381.G2Affine
+	publicKey.ScalarMultiplication(&g2Gen, secret.BigInt(new(big.Int)))
+	compressed := publicKey.Bytes()
+	messageHash, err := keychain.ValidationMessageHash(keychain.ChainIdHash("gonka-test"), 3, compressed[:])
+	require.NoError(t, err)
+	message, err := keychain.HashToG1(messageHash)
+	require.NoError(t, err)
+	var signature bls12381.G1Affine
+	signature.ScalarMultiplication(&message, secrets[3])
+	sigBytes := signature.Bytes()
+	k.SetEpochBLSData(ctx, types.EpochBLSData{EpochId: 5, DkgPhase: types.DKGPhase_DKG_PHASE_SIGNED, GroupPublicKey: compressed[:], ValidationSignature: sigBytes[:]})
+	require.NoError(t, k.SetGroupKeyValidationState(ctx, types.GroupKeyValidationState{NewEpochId: 5, PreviousEpochId: 3, FinalSignature: sigBytes[:]}))
+
+	resp, err = k.EpochKeyChainProof(ctx, &types.QueryEpochKeyChainProofRequest{TrustedEpochId: 1})
+	require.NoError(t, err)
+	require.Len(t, resp.Proof.Links, 3)
+	epochId, groupPublicKey, err = keychain.Verify(&resp.Proof, "gonka-test", 1, publicKeys[1])
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), epochId)
+	require.Equal(t, compressed[:], groupPublicKey)
+	_, err = k.EpochKeyChainProof(ctx, &types.QueryEpochKeyChainProofRequest{TrustedEpochId: 4})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/productscience/inference/x/bls/types"
+)
+
+// SigningSchemas queries the signing schemas whitelisted by governance
+func (k Keeper) SigningSchemas(ctx context.Context, req *types.QuerySigningSchemasRequest) (*types.QuerySigningSchemasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QuerySigningSchemasResponse{
+		Schemas: k.GetAllSigningSchemas(sdk.UnwrapSDKContext(ctx)),
+	}, nil
+}
+
+// SchemaSignatures queries the completed signatures of a signing schema in sequence order with pagination
+func (k Keeper) SchemaSignatures(ctx context.Context, req *types.QuerySchemaSignaturesRequest) (*types.QuerySchemaSignaturesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if _, found := k.GetSigningSchema(sdkCtx, req.SchemaName); !found {
+		return nil, status.Errorf(codes.NotFound, "signing schema not found: %s", req.SchemaName)
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(sdkCtx))
+	indexStore := prefix.NewStore(store, types.SchemaSignatureIndexPrefixForSchema(req.SchemaName))
+
+	var signatures []types.SchemaSigningRequest
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		request, found := k.GetSchemaSigningRequest(sdkCtx, value)
+		if !found {
+			return nil
+		}
+		signatures = append(signatures, request)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to paginate schema signatures: %s", err.Error())
+	}
+
+	return &types.QuerySchemaSignaturesResponse{
+		Signatures: signatures,
+		Pagination: pageRes,
+	}, nil
+}
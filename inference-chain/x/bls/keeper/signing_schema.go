@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/productscience/inference/x/bls/keychain"
+	"github.com/productscience/inference/x/bls/types"
+)
+
+// SetSigningSchema stores a signing schema, replacing the one with the same name
+func (k Keeper) SetSigningSchema(ctx sdk.Context, schema types.SigningSchema) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.SigningSchemaKey(schema.Name), k.cdc.MustMarshal(&schema))
+}
+
+// GetSigningSchema returns the signing schema with the given name
+func (k Keeper) GetSigningSchema(ctx sdk.Context, name string) (types.SigningSchema, bool) {
+	value, err := k.storeService.OpenKVStore(ctx).Get(types.SigningSchemaKey(name))
+	if err != nil || value == nil {
+		return types.SigningSchema{}, false
+	}
+	var schema types.SigningSchema
+	k.cdc.MustUnmarshal(value, &schema)
+	return schema, true
+}
+
+// GetAllSigningSchemas returns every registered signing schema ordered by name
+func (k Keeper) GetAllSigningSchemas(ctx sdk.Context) []types.SigningSchema {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SigningSchemaPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var schemas []types.SigningSchema
+	for ; iterator.Valid(); iterator.Next() {
+		var schema types.SigningSchema
+		k.cdc.MustUnmarshal(iterator.Value(), &schema)
+		schemas = append(schemas, schema)
+	}
+	return schemas
+}
+
+// GetSchemaSigningRequest returns the schema signing request with the given request ID
+func (k Keeper) GetSchemaSigningRequest(ctx sdk.Context, requestID []byte) (types.SchemaSigningRequest, bool) {
+	value, err := k.storeService.OpenKVStore(ctx).Get(types.SchemaSigningRequestKey(requestID))
+	if err != nil || value == nil {
+		return types.SchemaSigningRequest{}, false
+	}
+	var request types.SchemaSigningRequest
+	k.cdc.MustUnmarshal(value, &request)
+	return request, true
+}
+
+func (k Keeper) setSchemaSigningRequest(ctx sdk.Context, request types.SchemaSigningRequest) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.SchemaSigningRequestKey(request.RequestId), k.cdc.MustMarshal(&request))
+}
+
+// nextSchemaSigningSequence returns the sequence number of the next schema signing request
+func (k Keeper) nextSchemaSigningSequence(ctx sdk.Context) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	value, err := store.Get(types.SchemaSigningSequenceKey)
+	if err != nil {
+		return 0, err
+	}
+	sequence := uint64(1)
+	if value != nil {
+		sequence = binary.BigEndian.Uint64(value) + 1
+	}
+	next := make([]byte, 8)
+	binary.BigEndian.PutUint64(next, sequence)
+	return sequence, store.Set(types.SchemaSigningSequenceKey, next)
+}
+
+// RequestSchemaSignature validates an application payload against a whitelisted schema, charges the schema fee
+// and requests a threshold signature over the schema domain followed by the payload fields. deadlineBlocks and
+// maxRetries of zero take the schema defaults.
+func (k Keeper) RequestSchemaSignature(ctx sdk.Context, requester sdk.AccAddress, schemaName string, data [][]byte, deadlineBlocks int64, maxRetries uint32) (*types.SchemaSigningRequest, error) {
+	schema, found := k.GetSigningSchema(ctx, schemaName)
+	if !found {
+		return nil, fmt.Errorf("%w: %s", types.ErrSigningSchemaNotFound, schemaName)
+	}
+	if !schema.Enabled {
+		return nil, fmt.Errorf("%w: %s", types.ErrSigningSchemaDisabled, schemaName)
+	}
+	if err := schema.ValidatePayload(data); err != nil {
+		return nil, fmt.Errorf("%w: %s", types.ErrInvalidSchemaPayload, err.Error())
+	}
+
+	if deadlineBlocks == 0 {
+		deadlineBlocks = schema.DefaultDeadlineBlocks
+	}
+	if deadlineBlocks == 0 {
+		deadlineBlocks = k.GetParams(ctx).SigningDeadlineBlocks
+	}
+	maxDeadlineBlocks := schema.MaxDeadlineBlocks
+	if maxDeadlineBlocks == 0 {
+		maxDeadlineBlocks = types.MaxSigningDeadlineBlocks
+	}
+	if deadlineBlocks > maxDeadlineBlocks {
+		return nil, fmt.Errorf("%w: deadline of %d blocks exceeds the maximum of %d", types.ErrInvalidSchemaPayload, deadlineBlocks, maxDeadlineBlocks)
+	}
+	if maxRetries > schema.MaxRetries {
+		return nil, fmt.Errorf("%w: %d retries exceed the schema maximum of %d", types.ErrInvalidSchemaPayload, maxRetries, schema.MaxRetries)
+	}
+
+	epochID, found := k.GetLatestKeyEpochID(ctx)
+	if !found {
+		return nil, fmt.Errorf("no epoch has a group public key yet")
+	}
+
+	if schema.Fee.IsValid() && schema.Fee.IsPositive() {
+		err := k.bankEscrowKeeper.SendCoinsFromAccountToModule(ctx, requester, authtypes.FeeCollectorName, sdk.NewCoins(schema.Fee), "bls_signing_fee")
+		if err != nil {
+			return nil, fmt.Errorf("failed to pay signing fee: %w", err)
+		}
+	}
+
+	// Request IDs are predictable, so skip sequences whose ID was already taken by a custom request
+	var sequence uint64
+	var requestID []byte
+	for {
+		var err error
+		sequence, err = k.nextSchemaSigningSequence(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get schema signing sequence: %w", err)
+		}
+		requestID = types.SchemaSigningRequestID(schemaName, sequence)
+		if _, err := k.GetSigningStatus(ctx, requestID); err != nil {
+			break
+		}
+	}
+
+	request := types.SchemaSigningRequest{
+		RequestId:          requestID,
+		SchemaName:         schemaName,
+		Requester:          requester.String(),
+		Sequence:           sequence,
+		Data:               data,
+		FeePaid:            schema.Fee,
+		DeadlineBlocks:     deadlineBlocks,
+		MaxRetries:         maxRetries,
+		Status:             types.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_COLLECTING_SIGNATURES,
+		CreatedBlockHeight: ctx.BlockHeight(),
+	}
+	if err := k.issueSchemaSigningRound(ctx, &request, epochID); err != nil {
+		return nil, err
+	}
+
+	k.LogInfo("Schema signature requested",
+		"schema", schemaName, "request_id", fmt.Sprintf("%x", request.RequestId), "requester", request.Requester,
+		"epoch_id", request.EpochId, "deadline_blocks", deadlineBlocks, "max_retries", maxRetries)
+	return &request, nil
+}
+
+// issueSchemaSigningRound requests the threshold signature of a schema signing request from the group of epochID
+// and stores the request. Retries replace the expired threshold signing request of the previous round.
+func (k Keeper) issueSchemaSigningRound(ctx sdk.Context, request *types.SchemaSigningRequest, epochID uint64) error {
+	retry := request.RetriesUsed > 0
+	signingData := types.SigningData{
+		CurrentEpochId: epochID,
+		ChainId:        keychain.ChainIdHash(ctx.ChainID()),
+		RequestId:      request.RequestId,
+		Data:           append([][]byte{types.SchemaDomain(request.SchemaName)}, request.Data...),
+	}
+	if err := k.requestThresholdSignature(ctx, signingData, request.DeadlineBlocks, retry); err != nil {
+		return fmt.Errorf("failed to request threshold signature: %w", err)
+	}
+
+	// Carried keys sign with the epoch that generated them
+	thresholdRequest, err := k.GetSigningStatus(ctx, request.RequestId)
+	if err != nil {
+		return err
+	}
+	request.EpochId = thresholdRequest.CurrentEpochId
+	request.Status = thresholdRequest.Status
+	if err := k.setSchemaSigningRequest(ctx, *request); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventSchemaSignatureRequested{
+		RequestId:  request.RequestId,
+		SchemaName: request.SchemaName,
+		Requester:  request.Requester,
+		Sequence:   request.Sequence,
+		Retry:      request.RetriesUsed,
+	})
+}
+
+// onThresholdSigningFinished updates the schema signing request of a threshold signing request that completed,
+// failed or expired. Expired requests with retries left are issued again with the latest group key.
+func (k Keeper) onThresholdSigningFinished(ctx sdk.Context, thresholdRequest *types.ThresholdSigningRequest) error {
+	request, found := k.GetSchemaSigningRequest(ctx, thresholdRequest.RequestId)
+	if !found {
+		return nil
+	}
+
+	if thresholdRequest.Status == types.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_EXPIRED && request.RetriesUsed < request.MaxRetries {
+		epochID, found := k.GetLatestKeyEpochID(ctx)
+		if !found {
+			epochID = thresholdRequest.CurrentEpochId
+		}
+		retried := request
+		retried.RetriesUsed++
+		err := k.issueSchemaSigningRound(ctx, &retried, epochID)
+		if err == nil {
+			k.LogInfo("Schema signature request retried",
+				"schema", retried.SchemaName, "request_id", fmt.Sprintf("%x", retried.RequestId),
+				"retry", retried.RetriesUsed, "epoch_id", retried.EpochId)
+			return nil
+		}
+		k.LogError("Failed to retry schema signature request, marking it expired",
+			"schema", request.SchemaName, "request_id", fmt.Sprintf("%x", request.RequestId), "error", err)
+	}
+
+	request.Status = thresholdRequest.Status
+	request.FinalSignature = thresholdRequest.FinalSignature
+	request.CompletedBlockHeight = ctx.BlockHeight()
+	if err := k.setSchemaSigningRequest(ctx, request); err != nil {
+		return err
+	}
+
+	if request.Status == types.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_COMPLETED {
+		return k.storeService.OpenKVStore(ctx).Set(types.SchemaSignatureIndexKey(request.SchemaName, request.Sequence), request.RequestId)
+	}
+	return nil
+}
+
+// GetLatestKeyEpochID returns the latest epoch with a group public key, its own or carried
+func (k Keeper) GetLatestKeyEpochID(ctx sdk.Context) (uint64, bool) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.EpochBLSDataPrefix)
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var epochBLSData types.EpochBLSData
+		k.cdc.MustUnmarshal(iterator.Value(), &epochBLSData)
+		if len(epochBLSData.GroupPublicKey) == 0 {
+			continue
+		}
+		if epochBLSData.DkgPhase == types.DKGPhase_DKG_PHASE_COMPLETED ||
+			epochBLSData.DkgPhase == types.DKGPhase_DKG_PHASE_SIGNED ||
+			epochBLSData.KeyCarriedFromEpoch != 0 {
+			return epochBLSData.EpochId, true
+		}
+	}
+	return 0, false
+}
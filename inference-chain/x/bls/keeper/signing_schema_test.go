@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/bls/keeper"
+	"github.com/productscience/inference/x/bls/types"
+)
+
+type recordingBankKeeper struct {
+	payments []sdk.Coins
+}
+
+func (b *recordingBankKeeper) SendCoinsFromAccountToModule(_ context.Context, _ sdk.AccAddress, recipientModule string, amt sdk.Coins, _ string) error {
+	if recipientModule != authtypes.FeeCollectorName {
+		return nil
+	}
+	b.payments = append(b.payments, amt)
+	return nil
+}
+
+func testWithdrawalSchema() types.SigningSchema {
+	return types.SigningSchema{
+		Name: "bridge_withdrawal",
+		Fields: []types.SchemaField{
+			{Name: "recipient", Type: types.SchemaFieldType_SCHEMA_FIELD_TYPE_ADDRESS},
+			{Name: "amount", Type: types.SchemaFieldType_SCHEMA_FIELD_TYPE_UINT256},
+		},
+		Fee:                   sdk.NewCoin("ngonka", math.NewInt(1000)),
+		DefaultDeadlineBlocks: 5,
+		MaxDeadlineBlocks:     20,
+		MaxRetries:            1,
+		Enabled:               true,
+	}
+}
+
+func TestSetSigningSchema(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	_, err := ms.SetSigningSchema(ctx, &types.MsgSetSigningSchema{Authority: "invalid", Schema: testWithdrawalSchema()})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	invalid := testWithdrawalSchema()
+	invalid.Fields = append(invalid.Fields, invalid.Fields[0])
+	_, err = ms.SetSigningSchema(ctx, &types.MsgSetSigningSchema{Authority: authority, Schema: invalid})
+	require.ErrorIs(t, err, types.ErrInvalidSchemaPayload)
+
+	_, err = ms.SetSigningSchema(ctx, &types.MsgSetSigningSchema{Authority: authority, Schema: testWithdrawalSchema()})
+	require.NoError(t, err)
+
+	res, err := k.SigningSchemas(ctx, &types.QuerySigningSchemasRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Schemas, 1)
+	require.Equal(t, "bridge_withdrawal", res.Schemas[0].Name)
+}
+
+func TestRequestSchemaSignature_RetriesThenExpires(t *testing.T) {
+	bank := &recordingBankKeeper{}
+	k, ctx := keepertest.BlsKeeperWithBankKeeper(t, bank)
+	ms := keeper.NewMsgServerImpl(k)
+	requester := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+
+	require.NoError(t, k.SetSigningSchema(ctx, testWithdrawalSchema()))
+	k.SetEpochBLSData(ctx, types.EpochBLSData{EpochId: 7, DkgPhase: types.DKGPhase_DKG_PHASE_SIGNED, GroupPublicKey: createTestG2Commitment()})
+	k.SetEpochBLSData(ctx, types.EpochBLSData{EpochId: 8, DkgPhase: types.DKGPhase_DKG_PHASE_DEALING})
+
+	recipient := make([]byte, 32)
+	copy(recipient[12:], bytes.Repeat([]byte{0xab}, 20))
+	amount := make([]byte, 32)
+	amount[31] = 42
+
+	// Payloads must match the schema layout
+	_, err := ms.RequestSchemaSignature(ctx, &types.MsgRequestSchemaSignature{Creator: requester, SchemaName: "bridge_withdrawal", Data: [][]byte{recipient}})
+	require.ErrorIs(t, err, types.ErrInvalidSchemaPayload)
+	_, err = ms.RequestSchemaSignature(ctx, &types.MsgRequestSchemaSignature{Creator: requester, SchemaName: "bridge_withdrawal", Data: [][]byte{bytes.Repeat([]byte{0xff}, 32), amount}})
+	require.ErrorIs(t, err, types.ErrInvalidSchemaPayload)
+	_, err = ms.RequestSchemaSignature(ctx, &types.MsgRequestSchemaSignature{Creator: requester, SchemaName: "bridge_withdrawal", Data: [][]byte{recipient, amount}, MaxRetries: 2})
+	require.ErrorIs(t, err, types.ErrInvalidSchemaPayload)
+	_, err = ms.RequestSchemaSignature(ctx, &types.MsgRequestSchemaSignature{Creator: requester, SchemaName: "oracle_price", Data: [][]byte{recipient, amount}})
+	require.ErrorIs(t, err, types.ErrSigningSchemaNotFound)
+	require.Empty(t, bank.payments)
+
+	ctx = ctx.WithBlockHeight(100)
+	res, err := ms.RequestSchemaSignature(ctx, &types.MsgRequestSchemaSignature{Creator: requester, SchemaName: "bridge_withdrawal", Data: [][]byte{recipient, amount}, MaxRetries: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Sequence)
+	require.Equal(t, types.SchemaSigningRequestID("bridge_withdrawal", 1), res.RequestId)
+	require.Equal(t, []sdk.Coins{sdk.NewCoins(sdk.NewCoin("ngonka", math.NewInt(1000)))}, bank.payments)
+
+	// The latest epoch with a key signs, over the schema domain followed by the payload
+	thresholdRequest, err := k.GetSigningStatus(ctx, res.RequestId)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), thresholdRequest.CurrentEpochId)
+	require.Equal(t, int64(105), thresholdRequest.DeadlineBlockHeight)
+	require.Equal(t, [][]byte{types.SchemaDomain("bridge_withdrawal"), recipient, amount}, thresholdRequest.Data)
+
+	// The first round expires and is re-issued with a new deadline
+	ctx = ctx.WithBlockHeight(105)
+	require.NoError(t, k.ProcessThresholdSigningDeadlines(ctx))
+	thresholdRequest, err = k.GetSigningStatus(ctx, res.RequestId)
+	require.NoError(t, err)
+	require.Equal(t, types.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_COLLECTING_SIGNATURES, thresholdRequest.Status)
+	require.Equal(t, int64(110), thresholdRequest.DeadlineBlockHeight)
+	schemaRequest, found := k.GetSchemaSigningRequest(ctx, res.RequestId)
+	require.True(t, found)
+	require.Equal(t, uint32(1), schemaRequest.RetriesUsed)
+	require.Equal(t, types.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_COLLECTING_SIGNATURES, schemaRequest.Status)
+
+	// Out of retries, the request expires for good
+	ctx = ctx.WithBlockHeight(110)
+	require.NoError(t, k.ProcessThresholdSigningDeadlines(ctx))
+	schemaRequest, found = k.GetSchemaSigningRequest(ctx, res.RequestId)
+	require.True(t, found)
+	require.Equal(t, types.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_EXPIRED, schemaRequest.Status)
+	require.Equal(t, int64(110), schemaRequest.CompletedBlockHeight)
+
+	// Only completed signatures are indexed
+	signatures, err := k.SchemaSignatures(ctx, &types.QuerySchemaSignaturesRequest{SchemaName: "bridge_withdrawal", Pagination: &query.PageRequest{Limit: 10}})
+	require.NoError(t, err)
+	require.Empty(t, signatures.Signatures)
+
+	// Sequences keep increasing and disabled schemas reject new requests
+	res, err = ms.RequestSchemaSignature(ctx, &types.MsgRequestSchemaSignature{Creator: requester, SchemaName: "bridge_withdrawal", Data: [][]byte{recipient, amount}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Sequence)
+
+	disabled := testWithdrawalSchema()
+	disabled.Enabled = false
+	require.NoError(t, k.SetSigningSchema(ctx, disabled))
+	_, err = ms.RequestSchemaSignature(ctx, &types.MsgRequestSchemaSignature{Creator: requester, SchemaName: "bridge_withdrawal", Data: [][]byte{recipient, amount}})
+	require.ErrorIs(t, err, types.ErrSigningSchemaDisabled)
+}
+
+func TestRequestSchemaSignature_CapsDeadlineWithoutSchemaMaximum(t *testing.T) {
+	bank := &recordingBankKeeper{}
+	k, ctx := keepertest.BlsKeeperWithBankKeeper(t, bank)
+	ms := keeper.NewMsgServerImpl(k)
+	requester := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+
+	schema := testWithdrawalSchema()
+	schema.MaxDeadlineBlocks = 0
+	require.NoError(t, k.SetSigningSchema(ctx, schema))
+	k.SetEpochBLSData(ctx, types.EpochBLSData{EpochId: 7, DkgPhase: types.DKGPhase_DKG_PHASE_SIGNED, GroupPublicKey: createTestG2Commitment()})
+
+	recipient := make([]byte, 32)
+	amount := make([]byte, 32)
+	amount[31] = 42
+
+	_, err := ms.RequestSchemaSignature(ctx, &types.MsgRequestSchemaSignature{Creator: requester, SchemaName: "bridge_withdrawal", Data: [][]byte{recipient, amount}, DeadlineBlocks: 1<<63 - 1})
+	require.ErrorIs(t, err, types.ErrInvalidSchemaPayload)
+	_, err = ms.RequestSchemaSignature(ctx, &types.MsgRequestSchemaSignature{Creator: requester, SchemaName: "bridge_withdrawal", Data: [][]byte{recipient, amount}, DeadlineBlocks: types.MaxSigningDeadlineBlocks + 1})
+	require.ErrorIs(t, err, types.ErrInvalidSchemaPayload)
+	require.Empty(t, bank.payments)
+
+	ctx = ctx.WithBlockHeight(100)
+	res, err := ms.RequestSchemaSignature(ctx, &types.MsgRequestSchemaSignature{Creator: requester, SchemaName: "bridge_withdrawal", Data: [][]byte{recipient, amount}, DeadlineBlocks: types.MaxSigningDeadlineBlocks})
+	require.NoError(t, err)
+	thresholdRequest, err := k.GetSigningStatus(ctx, res.RequestId)
+	require.NoError(t, err)
+	require.Equal(t, 100+types.MaxSigningDeadlineBlocks, thresholdRequest.DeadlineBlockHeight)
+
+	// Schemas cannot allow longer deadlines than the module does
+	schema.MaxDeadlineBlocks = types.MaxSigningDeadlineBlocks + 1
+	require.Error(t, schema.Validate())
+}
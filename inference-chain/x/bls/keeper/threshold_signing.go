@@ -14,12 +14,29 @@ import (
 
 // RequestThresholdSignature is the main entry point for other modules to request BLS threshold signatures
 func (k Keeper) RequestThresholdSignature(ctx sdk.Context, signingData types.SigningData) error {
+	return k.requestThresholdSignature(ctx, signingData, k.GetParams(ctx).SigningDeadlineBlocks, false)
+}
+
+// requestThresholdSignature creates a threshold signing request that expires deadlineBlocks from now.
+// With reissueExpired an expired request with the same request_id is replaced instead of rejected.
+func (k Keeper) requestThresholdSignature(ctx sdk.Context, signingData types.SigningData, deadlineBlocks int64, reissueExpired bool) error {
 	// Validate current epoch has completed DKG
 	epochBLSData, found := k.GetEpochBLSData(ctx, signingData.CurrentEpochId)
 	if !found {
 		return fmt.Errorf("epoch BLS data not found for epoch %d", signingData.CurrentEpochId)
 	}
 
+	// An epoch whose DKG failed signs with the key it carries, so the request goes to the epoch that generated it
+	if epochBLSData.KeyCarriedFromEpoch != 0 {
+		k.LogInfo("Epoch carries an earlier group key, signing with that epoch",
+			"epoch_id", signingData.CurrentEpochId, "key_epoch_id", epochBLSData.KeyCarriedFromEpoch)
+		signingData.CurrentEpochId = epochBLSData.KeyCarriedFromEpoch
+		epochBLSData, found = k.GetEpochBLSData(ctx, signingData.CurrentEpochId)
+		if !found {
+			return fmt.Errorf("epoch BLS data not found for epoch %d", signingData.CurrentEpochId)
+		}
+	}
+
 	// Verify epoch has completed DKG (has group public key)
 	if epochBLSData.DkgPhase != types.DKGPhase_DKG_PHASE_COMPLETED && epochBLSData.DkgPhase != types.DKGPhase_DKG_PHASE_SIGNED {
 		return fmt.Errorf("epoch %d DKG not completed, current phase: %s", signingData.CurrentEpochId, epochBLSData.DkgPhase.String())
@@ -37,7 +54,11 @@ func (k Keeper) RequestThresholdSignature(ctx sdk.Context, signingData types.Sig
 		return fmt.Errorf("failed to check request uniqueness: %w", err)
 	}
 	if existingValue != nil {
-		return fmt.Errorf("request_id already exists: %x", signingData.RequestId)
+		var existing types.ThresholdSigningRequest
+		k.cdc.MustUnmarshal(existingValue, &existing)
+		if !reissueExpired || existing.Status != types.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_EXPIRED {
+			return fmt.Errorf("request_id already exists: %x", signingData.RequestId)
+		}
 	}
 
 	// Encode data using Ethereum-compatible abi.encodePacked format
@@ -49,8 +70,7 @@ func (k Keeper) RequestThresholdSignature(ctx sdk.Context, signingData types.Sig
 	messageHash := hash.Sum(nil)
 
 	// Calculate deadline block height
-	params := k.GetParams(ctx)
-	deadlineBlockHeight := ctx.BlockHeight() + int64(params.SigningDeadlineBlocks)
+	deadlineBlockHeight := ctx.BlockHeight() + deadlineBlocks
 
 	// Create threshold signing request
 	request := &types.ThresholdSigningRequest{
@@ -187,7 +207,10 @@ func (k Keeper) AddPartialSignature(ctx sdk.Context, requestID []byte, slotIndic
 		if err := k.storeThresholdSigningRequest(ctx, request); err != nil {
 			return err
 		}
-		return k.emitThresholdSigningFailed(ctx, requestID, request.CurrentEpochId, "request expired")
+		if err := k.emitThresholdSigningFailed(ctx, requestID, request.CurrentEpochId, "request expired"); err != nil {
+			return err
+		}
+		return k.onThresholdSigningFinished(ctx, request)
 	}
 
 	// Get current epoch BLS data for validation
@@ -232,7 +255,14 @@ func (k Keeper) AddPartialSignature(ctx sdk.Context, requestID []byte, slotIndic
 	}
 
 	// Store updated request
-	return k.storeThresholdSigningRequest(ctx, request)
+	if err := k.storeThresholdSigningRequest(ctx, request); err != nil {
+		return err
+	}
+
+	if request.Status != types.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_COLLECTING_SIGNATURES {
+		return k.onThresholdSigningFinished(ctx, request)
+	}
+	return nil
 }
 
 // validateSlotOwnership checks if the submitter owns the claimed slot indices
@@ -387,9 +417,9 @@ func (k Keeper) ProcessThresholdSigningDeadlines(ctx sdk.Context) error {
 	expirationStore := prefix.NewStore(store, expirationPrefix)
 
 	iterator := expirationStore.Iterator(nil, nil)
-	defer iterator.Close()
 
 	var expiredCount uint32
+	var expiredRequests []*types.ThresholdSigningRequest
 
 	for ; iterator.Valid(); iterator.Next() {
 		// Extract request_id from the key
@@ -429,6 +459,16 @@ func (k Keeper) ProcessThresholdSigningDeadlines(ctx sdk.Context) error {
 			}
 
 			expiredCount++
+			expiredRequests = append(expiredRequests, request)
+		}
+	}
+	iterator.Close()
+
+	// Schema signing requests with retries left are re-issued, which adds entries to the expiration index
+	for _, request := range expiredRequests {
+		if err := k.onThresholdSigningFinished(ctx, request); err != nil {
+			k.Logger().Error("Failed to process expired schema signing request",
+				"request_id", fmt.Sprintf("%x", request.RequestId), "error", err)
 		}
 	}
 
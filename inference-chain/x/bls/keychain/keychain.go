@@ -0,0 +1,143 @@
+// Package keychain builds and verifies the chain of BLS group keys that links epochs together. The group of
+// every epoch signs the group public key of the next one, so a verifier that trusts the key of one epoch can
+// follow the signatures to the current key without trusting the node that served them. The encodings match
+// the ones the chain signs, so the same proofs can be checked by an Ethereum contract using EIP-2537.
+package keychain
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/hash_to_curve"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// GroupPublicKeySize is the size of a compressed G2 group public key
+	GroupPublicKeySize = 96
+	// SignatureSize is the size of a compressed G1 signature
+	SignatureSize = 48
+)
+
+// ChainIdHash returns the bytes32 chain identifier used in signed messages, sha256 of the chain id string
+func ChainIdHash(chainId string) []byte {
+	hash := sha256.Sum256([]byte(chainId))
+	return hash[:]
+}
+
+func appendFp64(dst []byte, e fp.Element) []byte {
+	be48 := e.Bytes()
+	var limb [64]byte
+	copy(limb[64-48:], be48[:])
+	return append(dst, limb[:]...)
+}
+
+// UncompressedG2 returns the 256 byte EIP-2537 encoding of a compressed G2 point:
+// X.c0, X.c1, Y.c0, Y.c1, each a 64 byte big-endian limb
+func UncompressedG2(compressed []byte) ([]byte, error) {
+	if len(compressed) != GroupPublicKeySize {
+		return nil, fmt.Errorf("invalid group public key length: expected %d bytes, got %d", GroupPublicKeySize, len(compressed))
+	}
+	var g2 bls12381.G2Affine
+	if err := g2.Unmarshal(compressed); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal compressed G2 key: %w", err)
+	}
+	// gnark-crypto stores E2 as (A0, A1), that is c0 then c1
+	out := make([]byte, 0, 256)
+	out = appendFp64(out, g2.X.A0)
+	out = appendFp64(out, g2.X.A1)
+	out = appendFp64(out, g2.Y.A0)
+	out = appendFp64(out, g2.Y.A1)
+	return out, nil
+}
+
+// UncompressedG1 returns the 128 byte EIP-2537 encoding of a compressed G1 point: X, Y, each a 64 byte big-endian limb
+func UncompressedG1(compressed []byte) ([]byte, error) {
+	if len(compressed) != SignatureSize {
+		return nil, fmt.Errorf("invalid signature length: expected %d bytes, got %d", SignatureSize, len(compressed))
+	}
+	var g1 bls12381.G1Affine
+	if err := g1.Unmarshal(compressed); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal compressed G1 point: %w", err)
+	}
+	out := make([]byte, 0, 128)
+	out = appendFp64(out, g1.X)
+	out = appendFp64(out, g1.Y)
+	return out, nil
+}
+
+// ValidationMessageHash returns the message the group of previousEpochId signs to validate the group public key
+// of the next epoch: keccak256(abi.encodePacked(previous_epoch_id [8], chain_id [32], new_group_key_uncompressed [256]))
+func ValidationMessageHash(chainIdHash []byte, previousEpochId uint64, groupPublicKey []byte) ([]byte, error) {
+	if len(chainIdHash) != 32 {
+		return nil, fmt.Errorf("invalid chain id hash length: expected 32 bytes, got %d", len(chainIdHash))
+	}
+	uncompressed, err := UncompressedG2(groupPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := make([]byte, 8, 8+32+256)
+	binary.BigEndian.PutUint64(encoded, previousEpochId)
+	encoded = append(encoded, chainIdHash...)
+	encoded = append(encoded, uncompressed...)
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(encoded)
+	return hash.Sum(nil), nil
+}
+
+// HashToG1 maps a 32 byte message hash to G1 the way signatures are made on chain: the hash is read as a field
+// element, mapped with the simplified SWU map and the isogeny of EIP-2537, and the cofactor is cleared
+func HashToG1(hash []byte) (bls12381.G1Affine, error) {
+	var out bls12381.G1Affine
+	if len(hash) != 32 {
+		return out, fmt.Errorf("message hash must be 32 bytes, got %d", len(hash))
+	}
+	var be [48]byte
+	copy(be[48-32:], hash)
+	var u fp.Element
+	u.SetBytes(be[:])
+	p := bls12381.MapToCurve1(&u)
+	hash_to_curve.G1Isogeny(&p.X, &p.Y)
+	out.ClearCofactor(&p)
+	return out, nil
+}
+
+// VerifySignature checks a compressed G1 signature of messageHash against a compressed G2 group public key:
+// e(signature, G2) == e(H(messageHash), groupPublicKey)
+func VerifySignature(groupPublicKey, messageHash, signature []byte) error {
+	var key bls12381.G2Affine
+	if len(groupPublicKey) != GroupPublicKeySize {
+		return fmt.Errorf("invalid group public key length: expected %d bytes, got %d", GroupPublicKeySize, len(groupPublicKey))
+	}
+	if err := key.Unmarshal(groupPublicKey); err != nil {
+		return fmt.Errorf("failed to unmarshal group public key: %w", err)
+	}
+	var sig bls12381.G1Affine
+	if len(signature) != SignatureSize {
+		return fmt.Errorf("invalid signature length: expected %d bytes, got %d", SignatureSize, len(signature))
+	}
+	if err := sig.Unmarshal(signature); err != nil {
+		return fmt.Errorf("failed to unmarshal signature: %w", err)
+	}
+	message, err := HashToG1(messageHash)
+	if err != nil {
+		return err
+	}
+
+	var negMessage bls12381.G1Affine
+	negMessage.Neg(&message)
+	_, _, _, g2Gen := bls12381.Generators()
+	ok, err := bls12381.PairingCheck([]bls12381.G1Affine{sig, negMessage}, []bls12381.G2Affine{g2Gen, key})
+	if err != nil {
+		return fmt.Errorf("failed to compute pairing: %w", err)
+	}
+	if !ok {
+		return fmt.Errorf("signature does not verify against the group public key")
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package keychain
+
+import (
+	"math/big"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/x/bls/types"
+)
+
+type groupKey struct {
+	secret    *big.Int
+	publicKey []byte
+}
+
+func newGroupKey(t *testing.T) groupKey {
+	var secret fr.Element
+	_, err := secret.SetRandom()
+	require.NoError(t, err)
+	s := secret.BigInt(new(big.Int))
+
+	_, _, _, g2Gen := bls12381.Generators()
+	var publicKey bls12381.G2Affine
+	publicKey.ScalarMultiplication(&g2Gen, s)
+	compressed := publicKey.Bytes()
+	return groupKey{secret: s, publicKey: compressed[:]}
+}
+
+func (g groupKey) sign(t *testing.T, messageHash []byte) []byte {
+	message, err := HashToG1(messageHash)
+	require.NoError(t, err)
+	var signature bls12381.G1Affine
+	signature.ScalarMultiplication(&message, g.secret)
+	compressed := signature.Bytes()
+	return compressed[:]
+}
+
+// buildProof links epochs trusted+1 .. trusted+len(keys)-1, each signed by the group of the epoch before it
+func buildProof(t *testing.T, chainId string, trustedEpochId uint64, keys []groupKey) *types.EpochKeyChainProof {
+	proof := &types.EpochKeyChainProof{
+		ChainId:               chainId,
+		ChainIdHash:           ChainIdHash(chainId),
+		TrustedEpochId:        trustedEpochId,
+		TrustedGroupPublicKey: keys[0].publicKey,
+	}
+	for i := 1; i < len(keys); i++ {
+		previousEpochId := trustedEpochId + uint64(i) - 1
+		messageHash, err := ValidationMessageHash(proof.ChainIdHash, previousEpochId, keys[i].publicKey)
+		require.NoError(t, err)
+		signature := keys[i-1].sign(t, messageHash)
+
+		uncompressedKey, err := UncompressedG2(keys[i].publicKey)
+		require.NoError(t, err)
+		uncompressedSignature, err := UncompressedG1(signature)
+		require.NoError(t, err)
+		proof.Links = append(proof.Links, types.EpochKeyLink{
+			EpochId:                         previousEpochId + 1,
+			PreviousEpochId:                 previousEpochId,
+			GroupPublicKey:                  keys[i].publicKey,
+			GroupPublicKeyUncompressed:      uncompressedKey,
+			ValidationSignature:             signature,
+			ValidationSignatureUncompressed: uncompressedSignature,
+		})
+	}
+	return proof
+}
+
+func TestVerify(t *testing.T) {
+	keys := []groupKey{newGroupKey(t), newGroupKey(t), newGroupKey(t), newGroupKey(t)}
+	proof := buildProof(t, "gonka-mainnet", 5, keys)
+
+	epochId, groupPublicKey, err := Verify(proof, "gonka-mainnet", 5, keys[0].publicKey)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), epochId)
+	require.Equal(t, keys[3].publicKey, groupPublicKey)
+
+	// An empty proof confirms the trusted key
+	epochId, groupPublicKey, err = Verify(&types.EpochKeyChainProof{ChainId: "gonka-mainnet", TrustedEpochId: 5}, "gonka-mainnet", 5, keys[0].publicKey)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), epochId)
+	require.Equal(t, keys[0].publicKey, groupPublicKey)
+}
+
+func TestVerify_Rejects(t *testing.T) {
+	keys := []groupKey{newGroupKey(t), newGroupKey(t), newGroupKey(t)}
+	untrusted := newGroupKey(t)
+
+	tests := []struct {
+		name    string
+		chainId string
+		trusted []byte
+		modify  func(proof *types.EpochKeyChainProof)
+		err     string
+	}{
+		{name: "other chain", chainId: "gonka-testnet", trusted: keys[0].publicKey, err: "expected \"gonka-testnet\""},
+		{name: "other trusted key", chainId: "gonka-mainnet", trusted: untrusted.publicKey, err: "different group public key"},
+		{
+			name: "key signed by an untrusted group", chainId: "gonka-mainnet", trusted: keys[0].publicKey,
+			modify: func(proof *types.EpochKeyChainProof) {
+				forged := buildProof(t, "gonka-mainnet", 5, []groupKey{untrusted, keys[1]})
+				proof.Links[0] = forged.Links[0]
+			},
+			err: "does not verify",
+		},
+		{
+			name: "replaced group key", chainId: "gonka-mainnet", trusted: keys[0].publicKey,
+			modify: func(proof *types.EpochKeyChainProof) {
+				proof.Links[1].GroupPublicKey = untrusted.publicKey
+				proof.Links[1].GroupPublicKeyUncompressed = nil
+			},
+			err: "does not verify",
+		},
+		{
+			name: "mismatched uncompressed key", chainId: "gonka-mainnet", trusted: keys[0].publicKey,
+			modify: func(proof *types.EpochKeyChainProof) {
+				proof.Links[0].GroupPublicKeyUncompressed, _ = UncompressedG2(untrusted.publicKey)
+			},
+			err: "does not match the compressed one",
+		},
+		{
+			name: "skipped link", chainId: "gonka-mainnet", trusted: keys[0].publicKey,
+			modify: func(proof *types.EpochKeyChainProof) {
+				proof.Links = proof.Links[1:]
+			},
+			err: "is signed by epoch 6, expected 5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proof := buildProof(t, "gonka-mainnet", 5, keys)
+			if tt.modify != nil {
+				tt.modify(proof)
+			}
+			_, _, err := Verify(proof, tt.chainId, 5, tt.trusted)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
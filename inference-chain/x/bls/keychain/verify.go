@@ -0,0 +1,76 @@
+package keychain
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/productscience/inference/x/bls/types"
+)
+
+// Verify checks a key chain proof for chainId starting at an epoch whose group public key the verifier already
+// trusts. It returns the last epoch of the proof and its group public key, which can be trusted from then on
+// and used to verify threshold signatures of that epoch or as the starting point of a later proof.
+func Verify(proof *types.EpochKeyChainProof, chainId string, trustedEpochId uint64, trustedGroupPublicKey []byte) (uint64, []byte, error) {
+	if proof == nil {
+		return 0, nil, fmt.Errorf("proof is empty")
+	}
+	if proof.ChainId != chainId {
+		return 0, nil, fmt.Errorf("proof is for chain %q, expected %q", proof.ChainId, chainId)
+	}
+	chainIdHash := ChainIdHash(chainId)
+	if len(proof.ChainIdHash) != 0 && !bytes.Equal(proof.ChainIdHash, chainIdHash) {
+		return 0, nil, fmt.Errorf("proof chain id hash %x does not match chain %q", proof.ChainIdHash, chainId)
+	}
+	if proof.TrustedEpochId != trustedEpochId {
+		return 0, nil, fmt.Errorf("proof starts at epoch %d, expected %d", proof.TrustedEpochId, trustedEpochId)
+	}
+	if len(proof.TrustedGroupPublicKey) != 0 && !bytes.Equal(proof.TrustedGroupPublicKey, trustedGroupPublicKey) {
+		return 0, nil, fmt.Errorf("proof starts from a different group public key than the trusted one")
+	}
+
+	epochId, groupPublicKey := trustedEpochId, trustedGroupPublicKey
+	for i, link := range proof.Links {
+		if link.PreviousEpochId != epochId {
+			return 0, nil, fmt.Errorf("link %d for epoch %d is signed by epoch %d, expected %d", i, link.EpochId, link.PreviousEpochId, epochId)
+		}
+		if link.EpochId <= epochId {
+			return 0, nil, fmt.Errorf("link %d for epoch %d does not advance past epoch %d", i, link.EpochId, epochId)
+		}
+		if err := checkUncompressed(link); err != nil {
+			return 0, nil, fmt.Errorf("link %d for epoch %d: %w", i, link.EpochId, err)
+		}
+		messageHash, err := ValidationMessageHash(chainIdHash, link.PreviousEpochId, link.GroupPublicKey)
+		if err != nil {
+			return 0, nil, fmt.Errorf("link %d for epoch %d: %w", i, link.EpochId, err)
+		}
+		if err := VerifySignature(groupPublicKey, messageHash, link.ValidationSignature); err != nil {
+			return 0, nil, fmt.Errorf("link %d for epoch %d: %w", i, link.EpochId, err)
+		}
+		epochId, groupPublicKey = link.EpochId, link.GroupPublicKey
+	}
+	return epochId, groupPublicKey, nil
+}
+
+// checkUncompressed makes sure the uncompressed encodings of a link, which contracts consume, are the
+// same points as the compressed ones the signatures are checked with
+func checkUncompressed(link types.EpochKeyLink) error {
+	if len(link.GroupPublicKeyUncompressed) != 0 {
+		expected, err := UncompressedG2(link.GroupPublicKey)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(expected, link.GroupPublicKeyUncompressed) {
+			return fmt.Errorf("uncompressed group public key does not match the compressed one")
+		}
+	}
+	if len(link.ValidationSignatureUncompressed) != 0 {
+		expected, err := UncompressedG1(link.ValidationSignature)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(expected, link.ValidationSignatureUncompressed) {
+			return fmt.Errorf("uncompressed validation signature does not match the compressed one")
+		}
+	}
+	return nil
+}
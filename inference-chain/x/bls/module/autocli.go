@@ -35,6 +35,29 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "request_id"},
 					},
 				},
+				{
+					RpcMethod: "EpochKeyChainProof",
+					Use:       "key-chain-proof [trusted_epoch_id]",
+					Short:     "Query the chain of validated group keys from a trusted epoch",
+					Long:      "Query the group public keys of the epochs after a trusted epoch, each with the validation signature of the previous epoch's group, in a form external verifiers can check offline",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "trusted_epoch_id"},
+					},
+				},
+				{
+					RpcMethod: "SigningSchemas",
+					Use:       "signing-schemas",
+					Short:     "List the signing schemas whitelisted by governance",
+				},
+				{
+					RpcMethod: "SchemaSignatures",
+					Use:       "schema-signatures [schema_name]",
+					Short:     "Query the completed signatures of a signing schema",
+					Long:      "Query the completed threshold signatures requested under a signing schema, in request order, with pagination",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "schema_name"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -58,6 +81,20 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "data", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "SetSigningSchema",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RequestSchemaSignature",
+					Use:       "request-schema-signature [schema_name] [data...]",
+					Short:     "Request a threshold signature over a payload of a signing schema",
+					Long:      "Request a threshold signature over a payload of a whitelisted signing schema, one 32-byte chunk per schema field. The schema fee is charged to the sender.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "schema_name"},
+						{ProtoField: "data", Varargs: true},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -16,6 +16,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Set the active epoch ID from genesis
 	k.SetActiveEpochID(ctx, genState.ActiveEpochId)
+
+	for _, schema := range genState.SigningSchemas {
+		if err := k.SetSigningSchema(ctx, schema); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -29,6 +35,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.ActiveEpochId = activeEpochID
 	}
 
+	genesis.SigningSchemas = k.GetAllSigningSchemas(ctx)
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -194,8 +194,9 @@ type ModuleInputs struct {
 	Config       *modulev1.Module
 	Logger       log.Logger
 
-	AccountKeeper types.AccountKeeper
-	BankKeeper    types.BankKeeper
+	AccountKeeper    types.AccountKeeper
+	BankKeeper       types.BankKeeper
+	BankEscrowKeeper types.BookkeepingBankKeeper
 }
 
 type ModuleOutputs struct {
@@ -216,6 +217,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.StoreService,
 		in.Logger,
 		authority.String(),
+		in.BankEscrowKeeper,
 	)
 	m := NewAppModule(
 		in.Cdc,
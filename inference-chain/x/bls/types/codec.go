@@ -17,6 +17,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSubmitVerificationVector{},
 		&MsgUpdateParams{},
 		&MsgSubmitGroupKeyValidationSignature{},
+		&MsgSetSigningSchema{},
+		&MsgRequestSchemaSignature{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
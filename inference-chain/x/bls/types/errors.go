@@ -10,4 +10,8 @@ import (
 var (
 	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+
+	ErrSigningSchemaNotFound = sdkerrors.Register(ModuleName, 1102, "signing schema not found")
+	ErrSigningSchemaDisabled = sdkerrors.Register(ModuleName, 1103, "signing schema disabled")
+	ErrInvalidSchemaPayload  = sdkerrors.Register(ModuleName, 1104, "invalid schema signing request")
 )
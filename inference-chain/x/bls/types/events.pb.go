@@ -35,6 +35,8 @@ type EventKeyGenerationInitiated struct {
 	TSlotsDegree uint32 `protobuf:"varint,3,opt,name=t_slots_degree,json=tSlotsDegree,proto3" json:"t_slots_degree,omitempty"`
 	// participants contains information about all participants in this DKG round
 	Participants []BLSParticipantInfo `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants"`
+	// attempt is the DKG attempt for the epoch; dealing restarts with a higher attempt after a failed one
+	Attempt uint32 `protobuf:"varint,5,opt,name=attempt,proto3" json:"attempt,omitempty"`
 }
 
 func (m *EventKeyGenerationInitiated) Reset()         { *m = EventKeyGenerationInitiated{} }
@@ -98,6 +100,13 @@ func (m *EventKeyGenerationInitiated) GetParticipants() []BLSParticipantInfo {
 	return nil
 }
 
+func (m *EventKeyGenerationInitiated) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
 // EventDealerPartSubmitted is emitted when a participant submits their dealer part
 type EventDealerPartSubmitted struct {
 	// epoch_id identifies the DKG round this dealer part belongs to
@@ -161,6 +170,8 @@ type EventVerifyingPhaseStarted struct {
 	VerifyingPhaseDeadlineBlock uint64 `protobuf:"varint,2,opt,name=verifying_phase_deadline_block,json=verifyingPhaseDeadlineBlock,proto3" json:"verifying_phase_deadline_block,omitempty"`
 	// epoch_data contains the complete epoch BLS data at the time of transition
 	EpochData EpochBLSData `protobuf:"bytes,3,opt,name=epoch_data,json=epochData,proto3" json:"epoch_data"`
+	// attempt is the DKG attempt entering verification
+	Attempt uint32 `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
 }
 
 func (m *EventVerifyingPhaseStarted) Reset()         { *m = EventVerifyingPhaseStarted{} }
@@ -217,6 +228,13 @@ func (m *EventVerifyingPhaseStarted) GetEpochData() EpochBLSData {
 	return EpochBLSData{}
 }
 
+func (m *EventVerifyingPhaseStarted) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
 // EventDKGFailed is emitted when a DKG round fails
 type EventDKGFailed struct {
 	// epoch_id identifies the DKG round that failed
@@ -350,6 +368,9 @@ type EventGroupPublicKeyGenerated struct {
 	EpochData EpochBLSData `protobuf:"bytes,5,opt,name=epoch_data,json=epochData,proto3" json:"epoch_data"`
 	// chain_id is the chain ID for EIP-155 compatibility
 	ChainId string `protobuf:"bytes,6,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// validating_epoch_id is the epoch whose group signs the new key for group key validation. It is the previous
+	// epoch, or the epoch that one carries its key from when its own DKG failed.
+	ValidatingEpochId uint64 `protobuf:"varint,7,opt,name=validating_epoch_id,json=validatingEpochId,proto3" json:"validating_epoch_id,omitempty"`
 }
 
 func (m *EventGroupPublicKeyGenerated) Reset()         { *m = EventGroupPublicKeyGenerated{} }
@@ -427,6 +448,13 @@ func (m *EventGroupPublicKeyGenerated) GetChainId() string {
 	return ""
 }
 
+func (m *EventGroupPublicKeyGenerated) GetValidatingEpochId() uint64 {
+	if m != nil {
+		return m.ValidatingEpochId
+	}
+	return 0
+}
+
 // EventGroupKeyValidated is emitted when group key validation completes successfully
 type EventGroupKeyValidated struct {
 	// new_epoch_id identifies the epoch whose group public key was successfully validated
@@ -756,6 +784,298 @@ func (m *EventThresholdSigningFailed) GetReason() string {
 	return ""
 }
 
+// EventDKGAttemptFailed is emitted for every DKG attempt that fails, whether or not the epoch retries
+type EventDKGAttemptFailed struct {
+	// epoch_id identifies the DKG round
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// attempt is the attempt that failed
+	Attempt uint32 `protobuf:"varint,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// reason describes why the attempt failed
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// excluded_participants are the participants that did not perform in the attempt
+	ExcludedParticipants []string `protobuf:"bytes,4,rep,name=excluded_participants,json=excludedParticipants,proto3" json:"excluded_participants,omitempty"`
+	// remaining_participants is the number of participants that performed
+	RemainingParticipants uint32 `protobuf:"varint,5,opt,name=remaining_participants,json=remainingParticipants,proto3" json:"remaining_participants,omitempty"`
+	// retrying tells whether dealing restarts with the remaining participants
+	Retrying bool `protobuf:"varint,6,opt,name=retrying,proto3" json:"retrying,omitempty"`
+}
+
+func (m *EventDKGAttemptFailed) Reset()         { *m = EventDKGAttemptFailed{} }
+func (m *EventDKGAttemptFailed) String() string { return proto.CompactTextString(m) }
+func (*EventDKGAttemptFailed) ProtoMessage()    {}
+func (*EventDKGAttemptFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96b42c6054f2dc42, []int{11}
+}
+func (m *EventDKGAttemptFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDKGAttemptFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDKGAttemptFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDKGAttemptFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDKGAttemptFailed.Merge(m, src)
+}
+func (m *EventDKGAttemptFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDKGAttemptFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDKGAttemptFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDKGAttemptFailed proto.InternalMessageInfo
+
+func (m *EventDKGAttemptFailed) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *EventDKGAttemptFailed) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *EventDKGAttemptFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EventDKGAttemptFailed) GetExcludedParticipants() []string {
+	if m != nil {
+		return m.ExcludedParticipants
+	}
+	return nil
+}
+
+func (m *EventDKGAttemptFailed) GetRemainingParticipants() uint32 {
+	if m != nil {
+		return m.RemainingParticipants
+	}
+	return 0
+}
+
+func (m *EventDKGAttemptFailed) GetRetrying() bool {
+	if m != nil {
+		return m.Retrying
+	}
+	return false
+}
+
+// EventGroupPublicKeyCarriedOver is emitted when an epoch whose DKG failed falls back to an earlier epoch's key
+type EventGroupPublicKeyCarriedOver struct {
+	// epoch_id identifies the epoch whose DKG failed
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// source_epoch_id is the epoch that generated the carried key
+	SourceEpochId uint64 `protobuf:"varint,2,opt,name=source_epoch_id,json=sourceEpochId,proto3" json:"source_epoch_id,omitempty"`
+	// group_public_key is the carried group public key (compressed G2 format, 96 bytes)
+	GroupPublicKey []byte `protobuf:"bytes,3,opt,name=group_public_key,json=groupPublicKey,proto3" json:"group_public_key,omitempty"`
+}
+
+func (m *EventGroupPublicKeyCarriedOver) Reset()         { *m = EventGroupPublicKeyCarriedOver{} }
+func (m *EventGroupPublicKeyCarriedOver) String() string { return proto.CompactTextString(m) }
+func (*EventGroupPublicKeyCarriedOver) ProtoMessage()    {}
+func (*EventGroupPublicKeyCarriedOver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96b42c6054f2dc42, []int{12}
+}
+func (m *EventGroupPublicKeyCarriedOver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGroupPublicKeyCarriedOver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGroupPublicKeyCarriedOver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGroupPublicKeyCarriedOver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGroupPublicKeyCarriedOver.Merge(m, src)
+}
+func (m *EventGroupPublicKeyCarriedOver) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGroupPublicKeyCarriedOver) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGroupPublicKeyCarriedOver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGroupPublicKeyCarriedOver proto.InternalMessageInfo
+
+func (m *EventGroupPublicKeyCarriedOver) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *EventGroupPublicKeyCarriedOver) GetSourceEpochId() uint64 {
+	if m != nil {
+		return m.SourceEpochId
+	}
+	return 0
+}
+
+func (m *EventGroupPublicKeyCarriedOver) GetGroupPublicKey() []byte {
+	if m != nil {
+		return m.GroupPublicKey
+	}
+	return nil
+}
+
+// EventSchemaSignatureRequested is emitted when a threshold signature is requested under a signing schema
+type EventSchemaSignatureRequested struct {
+	// request_id identifies the threshold signing request
+	RequestId []byte `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// schema_name identifies the signing schema
+	SchemaName string `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	// requester is the address that paid for the signature
+	Requester string `protobuf:"bytes,3,opt,name=requester,proto3" json:"requester,omitempty"`
+	// sequence is the sequence number of the schema signing request
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// retry is the retry number of this signing round (0 for the first round)
+	Retry uint32 `protobuf:"varint,5,opt,name=retry,proto3" json:"retry,omitempty"`
+}
+
+func (m *EventSchemaSignatureRequested) Reset()         { *m = EventSchemaSignatureRequested{} }
+func (m *EventSchemaSignatureRequested) String() string { return proto.CompactTextString(m) }
+func (*EventSchemaSignatureRequested) ProtoMessage()    {}
+func (*EventSchemaSignatureRequested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96b42c6054f2dc42, []int{13}
+}
+func (m *EventSchemaSignatureRequested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSchemaSignatureRequested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSchemaSignatureRequested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSchemaSignatureRequested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSchemaSignatureRequested.Merge(m, src)
+}
+func (m *EventSchemaSignatureRequested) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSchemaSignatureRequested) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSchemaSignatureRequested.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSchemaSignatureRequested proto.InternalMessageInfo
+
+func (m *EventSchemaSignatureRequested) GetRequestId() []byte {
+	if m != nil {
+		return m.RequestId
+	}
+	return nil
+}
+
+func (m *EventSchemaSignatureRequested) GetSchemaName() string {
+	if m != nil {
+		return m.SchemaName
+	}
+	return ""
+}
+
+func (m *EventSchemaSignatureRequested) GetRequester() string {
+	if m != nil {
+		return m.Requester
+	}
+	return ""
+}
+
+func (m *EventSchemaSignatureRequested) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *EventSchemaSignatureRequested) GetRetry() uint32 {
+	if m != nil {
+		return m.Retry
+	}
+	return 0
+}
+
+// EventSigningSchemaUpdated is emitted when governance adds or replaces a signing schema
+type EventSigningSchemaUpdated struct {
+	// schema_name identifies the signing schema
+	SchemaName string `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	// enabled tells whether new requests are accepted
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *EventSigningSchemaUpdated) Reset()         { *m = EventSigningSchemaUpdated{} }
+func (m *EventSigningSchemaUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventSigningSchemaUpdated) ProtoMessage()    {}
+func (*EventSigningSchemaUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96b42c6054f2dc42, []int{14}
+}
+func (m *EventSigningSchemaUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSigningSchemaUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSigningSchemaUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSigningSchemaUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSigningSchemaUpdated.Merge(m, src)
+}
+func (m *EventSigningSchemaUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSigningSchemaUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSigningSchemaUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSigningSchemaUpdated proto.InternalMessageInfo
+
+func (m *EventSigningSchemaUpdated) GetSchemaName() string {
+	if m != nil {
+		return m.SchemaName
+	}
+	return ""
+}
+
+func (m *EventSigningSchemaUpdated) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*EventKeyGenerationInitiated)(nil), "inference.bls.EventKeyGenerationInitiated")
 	proto.RegisterType((*EventDealerPartSubmitted)(nil), "inference.bls.EventDealerPartSubmitted")
@@ -768,64 +1088,83 @@ func init() {
 	proto.RegisterType((*EventThresholdSigningRequested)(nil), "inference.bls.EventThresholdSigningRequested")
 	proto.RegisterType((*EventThresholdSigningCompleted)(nil), "inference.bls.EventThresholdSigningCompleted")
 	proto.RegisterType((*EventThresholdSigningFailed)(nil), "inference.bls.EventThresholdSigningFailed")
+	proto.RegisterType((*EventDKGAttemptFailed)(nil), "inference.bls.EventDKGAttemptFailed")
+	proto.RegisterType((*EventGroupPublicKeyCarriedOver)(nil), "inference.bls.EventGroupPublicKeyCarriedOver")
+	proto.RegisterType((*EventSchemaSignatureRequested)(nil), "inference.bls.EventSchemaSignatureRequested")
+	proto.RegisterType((*EventSigningSchemaUpdated)(nil), "inference.bls.EventSigningSchemaUpdated")
 }
 
 func init() { proto.RegisterFile("inference/bls/events.proto", fileDescriptor_96b42c6054f2dc42) }
 
 var fileDescriptor_96b42c6054f2dc42 = []byte{
-	// 823 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x8e, 0x9b, 0xb0, 0x65, 0x27, 0x4e, 0x00, 0xb7, 0x54, 0x69, 0x96, 0x9a, 0xac, 0x85, 0x44,
-	0x2e, 0x24, 0xd2, 0xf2, 0x03, 0x80, 0x34, 0xcb, 0x36, 0x4a, 0x0f, 0x2b, 0xa7, 0x5a, 0x09, 0x2e,
-	0xd6, 0xc4, 0xf3, 0x62, 0x8f, 0xea, 0xcc, 0x98, 0x99, 0x71, 0x4a, 0x2e, 0xdc, 0x90, 0x10, 0x27,
-	0x7e, 0x0c, 0x67, 0x2e, 0x5c, 0x7a, 0xa3, 0xe2, 0xc4, 0x09, 0xa1, 0xcd, 0x1f, 0x41, 0x33, 0x76,
-	0x70, 0xbc, 0xa4, 0xdd, 0xad, 0xb4, 0x97, 0x28, 0xf3, 0xbd, 0xf7, 0xe6, 0xbd, 0xef, 0x7b, 0xef,
-	0x79, 0x50, 0x97, 0xb2, 0x05, 0x08, 0x60, 0x21, 0x0c, 0xe7, 0x89, 0x1c, 0xc2, 0x0a, 0x98, 0x92,
-	0x83, 0x54, 0x70, 0xc5, 0x9d, 0xd6, 0x7f, 0xb6, 0xc1, 0x3c, 0x91, 0xdd, 0x0f, 0xf0, 0x92, 0x32,
-	0x3e, 0x34, 0xbf, 0xb9, 0x47, 0xf7, 0x61, 0xc8, 0xe5, 0x92, 0xcb, 0xc0, 0x9c, 0x86, 0xf9, 0xa1,
-	0x30, 0xdd, 0x8f, 0x78, 0xc4, 0x73, 0x5c, 0xff, 0xdb, 0x06, 0x54, 0xd3, 0xa9, 0x75, 0x0a, 0x45,
-	0x80, 0xf7, 0x87, 0x85, 0x8e, 0x4e, 0x75, 0xfa, 0x29, 0xac, 0xcf, 0x80, 0x81, 0xc0, 0x8a, 0x72,
-	0x36, 0x61, 0x54, 0x51, 0xac, 0x80, 0x38, 0x0f, 0xd1, 0xbb, 0x90, 0xf2, 0x30, 0x0e, 0x28, 0xe9,
-	0x58, 0x3d, 0xab, 0xdf, 0xf0, 0xef, 0x9a, 0xf3, 0x84, 0x38, 0x1e, 0x6a, 0xd1, 0x40, 0x71, 0x85,
-	0x93, 0x40, 0x26, 0x5c, 0xc9, 0xce, 0x9d, 0x9e, 0xd5, 0x6f, 0xf9, 0x4d, 0xfa, 0x4c, 0x63, 0x33,
-	0x0d, 0x39, 0x9f, 0xa0, 0xb6, 0xca, 0xad, 0x01, 0x81, 0x48, 0x00, 0x74, 0xea, 0xc6, 0xc9, 0x56,
-	0xc6, 0x3e, 0x36, 0x98, 0x33, 0x45, 0x76, 0x8a, 0x85, 0xa2, 0x21, 0x4d, 0x31, 0x53, 0xb2, 0xd3,
-	0xe8, 0xd5, 0xfb, 0xcd, 0x93, 0xe3, 0x41, 0x45, 0x89, 0xc1, 0xe8, 0xe9, 0xec, 0xbc, 0xf4, 0x9a,
-	0xb0, 0x05, 0x1f, 0x35, 0x5e, 0xfe, 0xfd, 0x71, 0xcd, 0xaf, 0x04, 0x7b, 0x2b, 0xd4, 0x31, 0x84,
-	0xc6, 0x80, 0x13, 0x10, 0x3a, 0x62, 0x96, 0xcd, 0x97, 0x54, 0x5d, 0xc3, 0xe6, 0x0b, 0xd4, 0x26,
-	0x26, 0x22, 0xc0, 0x84, 0x08, 0x90, 0x39, 0x9d, 0xc3, 0x51, 0xe7, 0xcf, 0x5f, 0x3f, 0xbb, 0x5f,
-	0x68, 0xfc, 0x55, 0x6e, 0x99, 0x29, 0x41, 0x59, 0xe4, 0xb7, 0x72, 0xff, 0x02, 0xf4, 0x7e, 0xb3,
-	0x50, 0xd7, 0x24, 0xbe, 0x00, 0x41, 0x17, 0x6b, 0xca, 0xa2, 0xf3, 0x18, 0x4b, 0x98, 0x29, 0x2c,
-	0xae, 0x49, 0xfd, 0x18, 0xb9, 0xab, 0x6d, 0x4c, 0x90, 0xea, 0xa0, 0x80, 0x00, 0x26, 0x09, 0x65,
-	0x10, 0xcc, 0x13, 0x1e, 0x3e, 0x37, 0xa5, 0x34, 0xfc, 0xa3, 0x55, 0xe5, 0xe6, 0x71, 0xe1, 0x33,
-	0xd2, 0x2e, 0xce, 0x97, 0x08, 0xe5, 0xf7, 0x13, 0xac, 0xb0, 0x51, 0xb9, 0x79, 0x72, 0x74, 0x45,
-	0xc1, 0x53, 0xed, 0x30, 0x7a, 0x3a, 0x1b, 0x63, 0x85, 0x0b, 0xed, 0x0e, 0x4d, 0x90, 0x06, 0xbc,
-	0x1f, 0x2d, 0xd4, 0xce, 0x95, 0x9b, 0x9e, 0x7d, 0x8d, 0x69, 0xf2, 0xe6, 0xa2, 0x1f, 0xa0, 0x03,
-	0x01, 0x58, 0x72, 0x96, 0xeb, 0xe4, 0x17, 0xa7, 0x5b, 0xa8, 0xe3, 0x27, 0x0b, 0xf5, 0x4a, 0x21,
-	0x69, 0x68, 0x26, 0xf2, 0x02, 0x42, 0xc5, 0xc5, 0x8d, 0x3a, 0x39, 0x41, 0xf7, 0x76, 0x06, 0xe2,
-	0xc6, 0xed, 0x74, 0x76, 0x82, 0xb6, 0x3d, 0xfd, 0xf9, 0x0e, 0xfa, 0xc8, 0x94, 0x72, 0x26, 0x78,
-	0x96, 0x9e, 0x67, 0xf3, 0x84, 0x86, 0xe5, 0xa2, 0xbc, 0xb9, 0x8c, 0x3e, 0x7a, 0x3f, 0xd2, 0x51,
-	0x41, 0x6a, 0xc2, 0x82, 0xe7, 0xb0, 0x36, 0x35, 0xd8, 0x7e, 0x3b, 0xaa, 0xdc, 0xf6, 0xff, 0x45,
-	0xaa, 0xdf, 0x64, 0x91, 0x1a, 0x7b, 0x16, 0xa9, 0x2a, 0xfe, 0x3b, 0x6f, 0x2f, 0xbe, 0x26, 0x14,
-	0xc6, 0x98, 0x32, 0x4d, 0xe8, 0xc0, 0x34, 0xf6, 0xae, 0x39, 0x4f, 0x88, 0x17, 0xa2, 0x07, 0xa5,
-	0x16, 0x53, 0x58, 0x5f, 0xe0, 0x84, 0x12, 0xa3, 0x42, 0x0f, 0xd9, 0x0c, 0x5e, 0x04, 0x57, 0x94,
-	0x40, 0x0c, 0x5e, 0x9c, 0x16, 0x62, 0x7c, 0x8a, 0xde, 0x5b, 0x50, 0xa6, 0x09, 0xd2, 0x88, 0x61,
-	0x95, 0x09, 0xd8, 0x6a, 0x61, 0xe0, 0xd9, 0x16, 0xf5, 0xbe, 0x41, 0x8f, 0xf6, 0x25, 0xa1, 0x9c,
-	0x15, 0x23, 0x79, 0x7d, 0xae, 0xd7, 0x4c, 0xa6, 0xb7, 0xb1, 0x90, 0x6b, 0xee, 0x7e, 0x16, 0x0b,
-	0x90, 0x31, 0x4f, 0x88, 0x4e, 0xab, 0x5b, 0x0f, 0xdf, 0x65, 0x20, 0x35, 0x91, 0x47, 0x08, 0x89,
-	0xfc, 0xb0, 0xbd, 0xda, 0xf6, 0x0f, 0x0b, 0x24, 0x6f, 0x69, 0x98, 0x09, 0x01, 0x4c, 0x95, 0xf9,
-	0xf3, 0xd5, 0x6c, 0x17, 0xf8, 0xb6, 0x86, 0x63, 0x64, 0x03, 0x0b, 0x39, 0x01, 0x52, 0xee, 0x81,
-	0xed, 0x37, 0x0b, 0xcc, 0x28, 0x7d, 0x8c, 0xec, 0x25, 0x48, 0x89, 0x23, 0x08, 0x62, 0x2c, 0x63,
-	0xd3, 0x4f, 0xdb, 0x6f, 0x16, 0xd8, 0x13, 0x2c, 0x63, 0xe7, 0x04, 0x7d, 0x58, 0xfd, 0x10, 0x04,
-	0x31, 0xd0, 0x28, 0x56, 0xa6, 0xb3, 0x75, 0xff, 0x1e, 0xd9, 0xfd, 0x02, 0x3c, 0x31, 0x26, 0xef,
-	0xf7, 0xd7, 0xb1, 0x7c, 0xcc, 0x97, 0x69, 0x02, 0xb7, 0xca, 0x72, 0x4f, 0x57, 0xeb, 0xfb, 0xba,
-	0xea, 0x0c, 0x77, 0x56, 0x52, 0xe9, 0xaf, 0x5c, 0x3e, 0xe7, 0xf9, 0x08, 0x3b, 0x15, 0x93, 0x19,
-	0x67, 0xef, 0x87, 0xe2, 0x55, 0xba, 0x4a, 0xa2, 0x18, 0x82, 0x5b, 0x63, 0x50, 0xce, 0x4a, 0x7d,
-	0x77, 0x56, 0x46, 0x93, 0x97, 0x97, 0xae, 0xf5, 0xea, 0xd2, 0xb5, 0xfe, 0xb9, 0x74, 0xad, 0x5f,
-	0x36, 0x6e, 0xed, 0xd5, 0xc6, 0xad, 0xfd, 0xb5, 0x71, 0x6b, 0xdf, 0x0e, 0x23, 0xaa, 0xe2, 0x6c,
-	0x3e, 0x08, 0xf9, 0x72, 0x98, 0x0a, 0x4e, 0xb2, 0x50, 0xc9, 0x90, 0x9a, 0xb7, 0xb5, 0x7c, 0x65,
-	0xbf, 0x2f, 0xdf, 0xd9, 0xf9, 0x81, 0x79, 0x68, 0x3f, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0xc8,
-	0x35, 0xe0, 0x41, 0xf4, 0x07, 0x00, 0x00,
+	// 1070 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x9b, 0x6c, 0x3f, 0x5e, 0xd2, 0x2e, 0xeb, 0x7e, 0x28, 0x6d, 0xd9, 0x6c, 0x6b, 0x21,
+	0xe8, 0x85, 0x44, 0x2a, 0x82, 0x2b, 0x6c, 0xda, 0xd2, 0x8d, 0xba, 0x40, 0xe5, 0x2c, 0x95, 0xe0,
+	0x62, 0x4d, 0xec, 0x57, 0x7b, 0xb4, 0xf6, 0x8c, 0x19, 0x4f, 0xb2, 0xdb, 0x0b, 0x37, 0x10, 0x17,
+	0x24, 0xfe, 0x18, 0x4e, 0x5c, 0xb9, 0x2c, 0xb7, 0x15, 0xa7, 0x3d, 0x21, 0xd4, 0x9e, 0xf8, 0x2f,
+	0x90, 0x67, 0xec, 0x38, 0x6e, 0xd3, 0xb4, 0x82, 0xbd, 0x44, 0x79, 0x5f, 0xf3, 0xe6, 0xfd, 0xde,
+	0xef, 0xbd, 0x31, 0x6c, 0x52, 0x76, 0x86, 0x02, 0x99, 0x8b, 0xed, 0x7e, 0x98, 0xb4, 0x71, 0x88,
+	0x4c, 0x26, 0xad, 0x58, 0x70, 0xc9, 0xcd, 0xa5, 0x91, 0xad, 0xd5, 0x0f, 0x93, 0xcd, 0x07, 0x24,
+	0xa2, 0x8c, 0xb7, 0xd5, 0xaf, 0xf6, 0xd8, 0xdc, 0x70, 0x79, 0x12, 0xf1, 0xc4, 0x51, 0x52, 0x5b,
+	0x0b, 0x99, 0x69, 0xd5, 0xe7, 0x3e, 0xd7, 0xfa, 0xf4, 0x5f, 0x1e, 0x50, 0x4e, 0x27, 0xcf, 0x63,
+	0xcc, 0x02, 0xac, 0x7f, 0x0c, 0xd8, 0x3a, 0x4c, 0xd3, 0x1f, 0xe3, 0xf9, 0x11, 0x32, 0x14, 0x44,
+	0x52, 0xce, 0xba, 0x8c, 0x4a, 0x4a, 0x24, 0x7a, 0xe6, 0x06, 0x2c, 0x60, 0xcc, 0xdd, 0xc0, 0xa1,
+	0x5e, 0xc3, 0xd8, 0x36, 0x76, 0xab, 0xf6, 0xbc, 0x92, 0xbb, 0x9e, 0x69, 0xc1, 0x12, 0x75, 0x24,
+	0x97, 0x24, 0x74, 0x92, 0x90, 0xcb, 0xa4, 0x31, 0xbb, 0x6d, 0xec, 0x2e, 0xd9, 0x35, 0xfa, 0x2c,
+	0xd5, 0xf5, 0x52, 0x95, 0xf9, 0x1e, 0x2c, 0x4b, 0x6d, 0x75, 0x3c, 0xf4, 0x05, 0x62, 0xa3, 0xa2,
+	0x9c, 0xea, 0x52, 0xd9, 0x0f, 0x94, 0xce, 0x3c, 0x86, 0x7a, 0x4c, 0x84, 0xa4, 0x2e, 0x8d, 0x09,
+	0x93, 0x49, 0xa3, 0xba, 0x5d, 0xd9, 0xad, 0xed, 0xed, 0xb4, 0x4a, 0x48, 0xb4, 0x3a, 0x4f, 0x7b,
+	0x27, 0x85, 0x57, 0x97, 0x9d, 0xf1, 0x4e, 0xf5, 0xd5, 0x5f, 0x8f, 0x66, 0xec, 0x52, 0xb0, 0xd9,
+	0x80, 0x79, 0x22, 0x25, 0x46, 0xb1, 0x6c, 0xdc, 0x53, 0xb9, 0x72, 0xd1, 0x1a, 0x42, 0x43, 0x95,
+	0x7a, 0x80, 0x24, 0x44, 0x91, 0x9e, 0xd5, 0x1b, 0xf4, 0x23, 0x2a, 0x6f, 0xa9, 0xf3, 0x53, 0x58,
+	0xf6, 0x54, 0x84, 0x43, 0x3c, 0x4f, 0x60, 0xa2, 0x0b, 0x5d, 0xec, 0x34, 0xfe, 0xfc, 0xf5, 0xc3,
+	0xd5, 0x0c, 0xfd, 0xc7, 0xda, 0xd2, 0x93, 0x82, 0x32, 0xdf, 0x5e, 0xd2, 0xfe, 0x99, 0xd2, 0x7a,
+	0x63, 0xc0, 0xa6, 0x4a, 0x7c, 0x8a, 0x82, 0x9e, 0x9d, 0x53, 0xe6, 0x9f, 0x04, 0x24, 0xc1, 0x9e,
+	0x24, 0xe2, 0x96, 0xd4, 0xfb, 0xd0, 0x1c, 0xe6, 0x31, 0x4e, 0x9c, 0x06, 0x39, 0x1e, 0x12, 0x2f,
+	0xa4, 0x0c, 0x9d, 0x7e, 0xc8, 0xdd, 0xe7, 0xea, 0x2a, 0x55, 0x7b, 0x6b, 0x58, 0x3a, 0xf9, 0x20,
+	0xf3, 0xe9, 0xa4, 0x2e, 0xe6, 0x67, 0x00, 0xfa, 0x7c, 0x8f, 0x48, 0xa2, 0xf0, 0xaf, 0xed, 0x6d,
+	0x5d, 0xc1, 0xf6, 0x30, 0x75, 0xe8, 0x3c, 0xed, 0x1d, 0x10, 0x49, 0x32, 0x54, 0x17, 0x55, 0x50,
+	0xaa, 0x18, 0x87, 0xb4, 0x5a, 0x86, 0xf4, 0x07, 0x03, 0x96, 0x35, 0xa6, 0xc7, 0x47, 0x9f, 0x13,
+	0x1a, 0x4e, 0x2f, 0x67, 0x1d, 0xe6, 0x04, 0x92, 0x84, 0x33, 0x8d, 0xa0, 0x9d, 0x49, 0xff, 0xff,
+	0x86, 0xd6, 0x4f, 0x06, 0x6c, 0x17, 0x10, 0x53, 0x57, 0xb1, 0xf8, 0x14, 0x5d, 0xc9, 0xc5, 0x9d,
+	0x7a, 0xdc, 0x85, 0x95, 0x31, 0x12, 0xdd, 0xb9, 0xd1, 0xe6, 0x58, 0x50, 0xde, 0xed, 0xdf, 0x66,
+	0xe1, 0x5d, 0x75, 0x95, 0x23, 0xc1, 0x07, 0xf1, 0xc9, 0xa0, 0x1f, 0x52, 0xb7, 0x18, 0xae, 0xe9,
+	0xd7, 0xd8, 0x85, 0x77, 0xfc, 0x34, 0xca, 0x89, 0x55, 0x98, 0xf3, 0x1c, 0xcf, 0xd5, 0x1d, 0xea,
+	0xf6, 0xb2, 0x5f, 0x3a, 0xed, 0xfa, 0xf0, 0x55, 0xee, 0x32, 0x7c, 0xd5, 0x09, 0xc3, 0x57, 0x06,
+	0xff, 0xde, 0x7f, 0xa0, 0xc7, 0x06, 0x2c, 0xb8, 0x01, 0xa1, 0x2c, 0x2d, 0x68, 0x4e, 0x35, 0x76,
+	0x5e, 0xc9, 0x5d, 0xcf, 0x6c, 0xc1, 0xca, 0x90, 0x84, 0xd4, 0x23, 0x32, 0x65, 0xf0, 0xa8, 0xec,
+	0x79, 0x55, 0xf6, 0x83, 0xc2, 0x74, 0xa8, 0x01, 0xb0, 0x5c, 0x58, 0x2f, 0xb0, 0x3b, 0xc6, 0xf3,
+	0x53, 0xed, 0x81, 0x9e, 0xb9, 0x0d, 0x75, 0x86, 0x2f, 0x9c, 0x2b, 0xc8, 0x01, 0xc3, 0x17, 0x59,
+	0xac, 0xf9, 0x01, 0xdc, 0x3f, 0xa3, 0x2c, 0x05, 0x84, 0xfa, 0x8c, 0xc8, 0x81, 0xc0, 0x1c, 0x3b,
+	0xa5, 0xee, 0xe5, 0x5a, 0xeb, 0x1b, 0x78, 0x38, 0x29, 0x09, 0xe5, 0x2c, 0xa3, 0xf0, 0xed, 0xb9,
+	0x6e, 0x60, 0xb2, 0x75, 0x69, 0x40, 0x53, 0x9d, 0xfd, 0x2c, 0x10, 0x98, 0x04, 0x3c, 0xf4, 0xd2,
+	0xb4, 0x29, 0x55, 0xf0, 0xbb, 0x01, 0x26, 0x69, 0x21, 0x0f, 0x01, 0x84, 0x16, 0xf2, 0xa3, 0xeb,
+	0xf6, 0x62, 0xa6, 0xd1, 0x14, 0x70, 0x07, 0x42, 0x20, 0x93, 0x45, 0x7e, 0x3d, 0xe4, 0xcb, 0x99,
+	0x3e, 0xbf, 0xc3, 0x0e, 0xd4, 0x91, 0xb9, 0xdc, 0x43, 0xaf, 0x98, 0x9b, 0xba, 0x5d, 0xcb, 0x74,
+	0xaa, 0x33, 0x3b, 0x50, 0x8f, 0x30, 0x49, 0x88, 0x8f, 0x4e, 0x40, 0x92, 0x40, 0xf5, 0xbf, 0x6e,
+	0xd7, 0x32, 0xdd, 0x13, 0x92, 0x04, 0xe6, 0x1e, 0xac, 0x95, 0x57, 0x8a, 0x13, 0x20, 0xf5, 0x03,
+	0xbd, 0x3c, 0x2b, 0xf6, 0x8a, 0x37, 0xbe, 0x4b, 0x9e, 0x28, 0x93, 0xf5, 0xfb, 0x4d, 0x55, 0xee,
+	0xf3, 0x28, 0x0e, 0xf1, 0xad, 0x56, 0x39, 0xa1, 0xab, 0x95, 0x49, 0x5d, 0x35, 0xdb, 0x63, 0x23,
+	0xac, 0xd8, 0xa6, 0xe7, 0x42, 0x53, 0xde, 0x2c, 0x99, 0x14, 0xfd, 0xad, 0xef, 0xb3, 0x97, 0xef,
+	0x6a, 0x11, 0x19, 0x09, 0xde, 0x5a, 0x05, 0x05, 0x57, 0x2a, 0x25, 0xae, 0xfc, 0x38, 0x0b, 0x6b,
+	0xf9, 0xee, 0x7c, 0xac, 0xf7, 0xe9, 0xed, 0x2b, 0x74, 0x6c, 0x15, 0xcf, 0x96, 0x56, 0xf1, 0x4d,
+	0x69, 0xcc, 0x2f, 0x60, 0x0d, 0x5f, 0xba, 0xe1, 0x20, 0xe5, 0xc9, 0xb5, 0x57, 0x76, 0xda, 0x72,
+	0x5b, 0xcd, 0xc3, 0x4e, 0xc6, 0x9f, 0xd7, 0x8f, 0x61, 0x5d, 0x60, 0x44, 0x28, 0x53, 0x4f, 0xd2,
+	0xf8, 0x79, 0xfa, 0xb5, 0x5d, 0x1b, 0x59, 0x4b, 0x61, 0x9b, 0xb0, 0x20, 0x50, 0x8a, 0xf4, 0x89,
+	0x52, 0x3b, 0x62, 0xc1, 0x1e, 0xc9, 0xd6, 0xcf, 0x39, 0x9d, 0xca, 0x1b, 0x73, 0x9f, 0x08, 0x41,
+	0xd1, 0xfb, 0x6a, 0x88, 0x62, 0x1a, 0x22, 0xef, 0xc3, 0xfd, 0x84, 0x0f, 0x84, 0x8b, 0x57, 0xfb,
+	0xb0, 0xa4, 0xd5, 0x87, 0x53, 0x76, 0x6b, 0x65, 0xd2, 0x6e, 0xb5, 0xfe, 0x30, 0xb2, 0x05, 0xd1,
+	0x73, 0x03, 0x8c, 0xc8, 0x88, 0x62, 0x77, 0x9e, 0xe1, 0x47, 0x50, 0x4b, 0x54, 0xa8, 0xc3, 0x48,
+	0x84, 0xd9, 0x8a, 0x00, 0xad, 0xfa, 0x92, 0x44, 0x68, 0x7e, 0x02, 0xb9, 0x37, 0x0a, 0xdd, 0xae,
+	0x29, 0x7d, 0x28, 0x5c, 0x53, 0x14, 0x93, 0x54, 0x60, 0xae, 0xde, 0xe5, 0x55, 0x7b, 0x24, 0x9b,
+	0xab, 0x70, 0x4f, 0x21, 0x9a, 0xf5, 0x41, 0x0b, 0xd6, 0x29, 0x6c, 0xe8, 0x52, 0x34, 0xb7, 0x75,
+	0x45, 0x5f, 0xc7, 0x7a, 0xa7, 0x5e, 0xb9, 0xa7, 0x71, 0xed, 0x9e, 0x0d, 0x98, 0x47, 0x46, 0xfa,
+	0x21, 0x6a, 0x4c, 0x17, 0xec, 0x5c, 0xec, 0x74, 0x5f, 0x5d, 0x34, 0x8d, 0xd7, 0x17, 0x4d, 0xe3,
+	0xef, 0x8b, 0xa6, 0xf1, 0xcb, 0x65, 0x73, 0xe6, 0xf5, 0x65, 0x73, 0xe6, 0xcd, 0x65, 0x73, 0xe6,
+	0xdb, 0xb6, 0x4f, 0x65, 0x30, 0xe8, 0xb7, 0x5c, 0x1e, 0xb5, 0x63, 0xc1, 0xbd, 0x81, 0x2b, 0x13,
+	0x97, 0xaa, 0x8f, 0xcf, 0xe2, 0x33, 0xf4, 0x65, 0xf1, 0x21, 0xda, 0x9f, 0x53, 0x5f, 0xa2, 0x1f,
+	0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xa9, 0xd4, 0x71, 0xc4, 0x15, 0x0b, 0x00, 0x00,
 }
 
 func (m *EventKeyGenerationInitiated) Marshal() (dAtA []byte, err error) {
@@ -848,6 +1187,11 @@ func (m *EventKeyGenerationInitiated) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.Attempt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Participants) > 0 {
 		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -935,6 +1279,11 @@ func (m *EventVerifyingPhaseStarted) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Attempt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size, err := m.EpochData.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1058,6 +1407,11 @@ func (m *EventGroupPublicKeyGenerated) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.ValidatingEpochId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ValidatingEpochId))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
 		copy(dAtA[i:], m.ChainId)
@@ -1313,49 +1667,250 @@ func (m *EventThresholdSigningFailed) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventDKGAttemptFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventKeyGenerationInitiated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventDKGAttemptFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDKGAttemptFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EpochId != 0 {
-		n += 1 + sovEvents(uint64(m.EpochId))
-	}
-	if m.ITotalSlots != 0 {
-		n += 1 + sovEvents(uint64(m.ITotalSlots))
+	if m.Retrying {
+		i--
+		if m.Retrying {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.TSlotsDegree != 0 {
-		n += 1 + sovEvents(uint64(m.TSlotsDegree))
+	if m.RemainingParticipants != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RemainingParticipants))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.Participants) > 0 {
-		for _, e := range m.Participants {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.ExcludedParticipants) > 0 {
+		for iNdEx := len(m.ExcludedParticipants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludedParticipants[iNdEx])
+			copy(dAtA[i:], m.ExcludedParticipants[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ExcludedParticipants[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return n
-}
-
-func (m *EventDealerPartSubmitted) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
 	if m.EpochId != 0 {
-		n += 1 + sovEvents(uint64(m.EpochId))
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGroupPublicKeyCarriedOver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGroupPublicKeyCarriedOver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGroupPublicKeyCarriedOver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupPublicKey) > 0 {
+		i -= len(m.GroupPublicKey)
+		copy(dAtA[i:], m.GroupPublicKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupPublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SourceEpochId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SourceEpochId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSchemaSignatureRequested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSchemaSignatureRequested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSchemaSignatureRequested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retry != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Retry))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SchemaName) > 0 {
+		i -= len(m.SchemaName)
+		copy(dAtA[i:], m.SchemaName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SchemaName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSigningSchemaUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSigningSchemaUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSigningSchemaUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SchemaName) > 0 {
+		i -= len(m.SchemaName)
+		copy(dAtA[i:], m.SchemaName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SchemaName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventKeyGenerationInitiated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovEvents(uint64(m.EpochId))
+	}
+	if m.ITotalSlots != 0 {
+		n += 1 + sovEvents(uint64(m.ITotalSlots))
+	}
+	if m.TSlotsDegree != 0 {
+		n += 1 + sovEvents(uint64(m.TSlotsDegree))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovEvents(uint64(m.Attempt))
+	}
+	return n
+}
+
+func (m *EventDealerPartSubmitted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovEvents(uint64(m.EpochId))
 	}
 	l = len(m.DealerAddress)
 	if l > 0 {
@@ -1378,6 +1933,9 @@ func (m *EventVerifyingPhaseStarted) Size() (n int) {
 	}
 	l = m.EpochData.Size()
 	n += 1 + l + sovEvents(uint64(l))
+	if m.Attempt != 0 {
+		n += 1 + sovEvents(uint64(m.Attempt))
+	}
 	return n
 }
 
@@ -1440,6 +1998,9 @@ func (m *EventGroupPublicKeyGenerated) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ValidatingEpochId != 0 {
+		n += 1 + sovEvents(uint64(m.ValidatingEpochId))
+	}
 	return n
 }
 
@@ -1545,6 +2106,99 @@ func (m *EventThresholdSigningFailed) Size() (n int) {
 	return n
 }
 
+func (m *EventDKGAttemptFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovEvents(uint64(m.EpochId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovEvents(uint64(m.Attempt))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ExcludedParticipants) > 0 {
+		for _, s := range m.ExcludedParticipants {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.RemainingParticipants != 0 {
+		n += 1 + sovEvents(uint64(m.RemainingParticipants))
+	}
+	if m.Retrying {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventGroupPublicKeyCarriedOver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovEvents(uint64(m.EpochId))
+	}
+	if m.SourceEpochId != 0 {
+		n += 1 + sovEvents(uint64(m.SourceEpochId))
+	}
+	l = len(m.GroupPublicKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSchemaSignatureRequested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SchemaName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvents(uint64(m.Sequence))
+	}
+	if m.Retry != 0 {
+		n += 1 + sovEvents(uint64(m.Retry))
+	}
+	return n
+}
+
+func (m *EventSigningSchemaUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SchemaName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1671,6 +2325,25 @@ func (m *EventKeyGenerationInitiated) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1893,6 +2566,25 @@ func (m *EventVerifyingPhaseStarted) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2334,9 +3026,28 @@ func (m *EventGroupPublicKeyGenerated) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatingEpochId", wireType)
+			}
+			m.ValidatingEpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatingEpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
@@ -3040,6 +3751,607 @@ func (m *EventThresholdSigningFailed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventDKGAttemptFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDKGAttemptFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDKGAttemptFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludedParticipants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludedParticipants = append(m.ExcludedParticipants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingParticipants", wireType)
+			}
+			m.RemainingParticipants = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingParticipants |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retrying", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retrying = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGroupPublicKeyCarriedOver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGroupPublicKeyCarriedOver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGroupPublicKeyCarriedOver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceEpochId", wireType)
+			}
+			m.SourceEpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceEpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPublicKey = append(m.GroupPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPublicKey == nil {
+				m.GroupPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSchemaSignatureRequested) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSchemaSignatureRequested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSchemaSignatureRequested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = append(m.RequestId[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestId == nil {
+				m.RequestId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			m.Retry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retry |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSigningSchemaUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSigningSchemaUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSigningSchemaUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
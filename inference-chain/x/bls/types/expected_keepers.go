@@ -18,6 +18,11 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 }
 
+// BookkeepingBankKeeper defines the expected interface for logged bank operations, used to charge signing fees.
+type BookkeepingBankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins, memo string) error
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
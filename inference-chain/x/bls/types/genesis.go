@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -18,6 +20,16 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	schemaNames := make(map[string]bool, len(gs.SigningSchemas))
+	for _, schema := range gs.SigningSchemas {
+		if err := schema.Validate(); err != nil {
+			return fmt.Errorf("invalid signing schema %q: %w", schema.Name, err)
+		}
+		if schemaNames[schema.Name] {
+			return fmt.Errorf("duplicate signing schema %q", schema.Name)
+		}
+		schemaNames[schema.Name] = true
+	}
 
 	return gs.Params.Validate()
 }
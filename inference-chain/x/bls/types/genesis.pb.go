@@ -32,6 +32,8 @@ type GenesisState struct {
 	// Only one epoch can have active DKG at a time
 	// 0 means no active DKG
 	ActiveEpochId uint64 `protobuf:"varint,2,opt,name=active_epoch_id,json=activeEpochId,proto3" json:"active_epoch_id,omitempty"`
+	// signing_schemas are the payload schemas threshold signatures can be requested for
+	SigningSchemas []SigningSchema `protobuf:"bytes,3,rep,name=signing_schemas,json=signingSchemas,proto3" json:"signing_schemas"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -81,6 +83,13 @@ func (m *GenesisState) GetActiveEpochId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetSigningSchemas() []SigningSchema {
+	if m != nil {
+		return m.SigningSchemas
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "inference.bls.GenesisState")
 }
@@ -88,23 +97,26 @@ func init() {
 func init() { proto.RegisterFile("inference/bls/genesis.proto", fileDescriptor_ad7128ff5b7c4871) }
 
 var fileDescriptor_ad7128ff5b7c4871 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
+	// 301 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0xcc, 0x4b, 0x4b,
 	0x2d, 0x4a, 0xcd, 0x4b, 0x4e, 0xd5, 0x4f, 0xca, 0x29, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
 	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x4b, 0xea, 0x25, 0xe5, 0x14, 0x4b,
 	0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x0a, 0x29, 0x91, 0xf4, 0xfc, 0xf4,
 	0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x8a, 0x4a, 0xa1, 0x1a, 0x5a, 0x90, 0x58, 0x94, 0x98, 0x0b,
-	0x35, 0x53, 0xa9, 0x80, 0x8b, 0xc7, 0x1d, 0x62, 0x49, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x05,
-	0x17, 0x1b, 0x44, 0x5e, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x54, 0x0f, 0xc5, 0x52, 0xbd,
-	0x00, 0xb0, 0xa4, 0x13, 0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0x82,
-	0xaa, 0x17, 0x52, 0xe3, 0xe2, 0x4f, 0x4c, 0x2e, 0xc9, 0x2c, 0x4b, 0x8d, 0x4f, 0x2d, 0xc8, 0x4f,
-	0xce, 0x88, 0xcf, 0x4c, 0x91, 0x60, 0x52, 0x60, 0xd4, 0x60, 0x09, 0xe2, 0x85, 0x08, 0xbb, 0x82,
-	0x44, 0x3d, 0x53, 0x9c, 0x3c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x3f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0xa0, 0x28, 0x3f, 0xa5,
-	0x34, 0xb9, 0xa4, 0x38, 0x39, 0x13, 0xec, 0x6e, 0x84, 0x0f, 0x2a, 0xc0, 0x7e, 0x28, 0xa9, 0x2c,
-	0x48, 0x2d, 0x4e, 0x62, 0x03, 0xfb, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x65, 0x09, 0xb9,
-	0x53, 0x36, 0x01, 0x00, 0x00,
+	0x35, 0x53, 0x4a, 0x09, 0x55, 0xae, 0x38, 0x33, 0x3d, 0x2f, 0x33, 0x2f, 0x3d, 0xbe, 0x38, 0x39,
+	0x23, 0x35, 0x37, 0x11, 0xa2, 0x46, 0x69, 0x2f, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0x25, 0xc1, 0x25,
+	0x89, 0x25, 0xa9, 0x42, 0x16, 0x5c, 0x6c, 0x10, 0x43, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d,
+	0x44, 0xf5, 0x50, 0x5c, 0xa6, 0x17, 0x00, 0x96, 0x74, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5,
+	0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0xf5, 0x42, 0x6a, 0x5c, 0xfc, 0x89, 0xc9, 0x25, 0x99, 0x65,
+	0xa9, 0xf1, 0xa9, 0x05, 0xf9, 0xc9, 0x19, 0xf1, 0x99, 0x29, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x2c,
+	0x41, 0xbc, 0x10, 0x61, 0x57, 0x90, 0xa8, 0x67, 0x8a, 0x90, 0x37, 0x17, 0x3f, 0xaa, 0x53, 0x8a,
+	0x25, 0x98, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0x64, 0xd0, 0xac, 0x0a, 0x86, 0xa8, 0x0a, 0x06, 0x2b,
+	0x72, 0x62, 0x01, 0xd9, 0x18, 0xc4, 0x57, 0x8c, 0x2c, 0x58, 0xec, 0xe4, 0x79, 0xe2, 0x91, 0x1c,
+	0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1,
+	0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xfa, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9,
+	0xf9, 0xb9, 0xfa, 0x05, 0x45, 0xf9, 0x29, 0xa5, 0xc9, 0x25, 0xc5, 0xc9, 0x99, 0xe0, 0xd0, 0x40,
+	0x84, 0x4b, 0x05, 0x38, 0x64, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x21, 0x62, 0x0c,
+	0x08, 0x00, 0x00, 0xff, 0xff, 0xf6, 0x29, 0xa9, 0xd6, 0xa8, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +139,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SigningSchemas) > 0 {
+		for iNdEx := len(m.SigningSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.ActiveEpochId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ActiveEpochId))
 		i--
@@ -167,6 +193,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.ActiveEpochId != 0 {
 		n += 1 + sovGenesis(uint64(m.ActiveEpochId))
 	}
+	if len(m.SigningSchemas) > 0 {
+		for _, e := range m.SigningSchemas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -257,6 +289,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningSchemas = append(m.SigningSchemas, SigningSchema{})
+			if err := m.SigningSchemas[len(m.SigningSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -156,9 +156,186 @@ func (m *GroupKeyValidationState) GetSlotsCovered() uint32 {
 	return 0
 }
 
+// EpochKeyLink carries the group public key of an epoch together with the signature of the previous
+// epoch's group over it. Uncompressed encodings are in the EIP-2537 layout for contract verifiers.
+type EpochKeyLink struct {
+	// epoch_id is the epoch whose group public key this link proves
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// previous_epoch_id is the epoch whose group signed the key
+	PreviousEpochId uint64 `protobuf:"varint,2,opt,name=previous_epoch_id,json=previousEpochId,proto3" json:"previous_epoch_id,omitempty"`
+	// group_public_key is the compressed G2 group public key (96 bytes)
+	GroupPublicKey []byte `protobuf:"bytes,3,opt,name=group_public_key,json=groupPublicKey,proto3" json:"group_public_key,omitempty"`
+	// group_public_key_uncompressed is X.c0, X.c1, Y.c0, Y.c1 as 64-byte big-endian limbs (256 bytes)
+	GroupPublicKeyUncompressed []byte `protobuf:"bytes,4,opt,name=group_public_key_uncompressed,json=groupPublicKeyUncompressed,proto3" json:"group_public_key_uncompressed,omitempty"`
+	// validation_signature is the compressed G1 signature of the previous epoch's group (48 bytes) over
+	// keccak256(previous_epoch_id [8] || chain_id_hash [32] || group_public_key_uncompressed [256])
+	ValidationSignature []byte `protobuf:"bytes,5,opt,name=validation_signature,json=validationSignature,proto3" json:"validation_signature,omitempty"`
+	// validation_signature_uncompressed is X, Y as 64-byte big-endian limbs (128 bytes)
+	ValidationSignatureUncompressed []byte `protobuf:"bytes,6,opt,name=validation_signature_uncompressed,json=validationSignatureUncompressed,proto3" json:"validation_signature_uncompressed,omitempty"`
+}
+
+func (m *EpochKeyLink) Reset()         { *m = EpochKeyLink{} }
+func (m *EpochKeyLink) String() string { return proto.CompactTextString(m) }
+func (*EpochKeyLink) ProtoMessage()    {}
+func (*EpochKeyLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb7b6f0307462ce4, []int{1}
+}
+func (m *EpochKeyLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochKeyLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochKeyLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochKeyLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochKeyLink.Merge(m, src)
+}
+func (m *EpochKeyLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochKeyLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochKeyLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochKeyLink proto.InternalMessageInfo
+
+func (m *EpochKeyLink) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *EpochKeyLink) GetPreviousEpochId() uint64 {
+	if m != nil {
+		return m.PreviousEpochId
+	}
+	return 0
+}
+
+func (m *EpochKeyLink) GetGroupPublicKey() []byte {
+	if m != nil {
+		return m.GroupPublicKey
+	}
+	return nil
+}
+
+func (m *EpochKeyLink) GetGroupPublicKeyUncompressed() []byte {
+	if m != nil {
+		return m.GroupPublicKeyUncompressed
+	}
+	return nil
+}
+
+func (m *EpochKeyLink) GetValidationSignature() []byte {
+	if m != nil {
+		return m.ValidationSignature
+	}
+	return nil
+}
+
+func (m *EpochKeyLink) GetValidationSignatureUncompressed() []byte {
+	if m != nil {
+		return m.ValidationSignatureUncompressed
+	}
+	return nil
+}
+
+// EpochKeyChainProof links the group public key of a trusted epoch to the keys of the epochs after it
+type EpochKeyChainProof struct {
+	// chain_id is the chain the keys belong to
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// chain_id_hash is sha256(chain_id), the bytes32 chain identifier included in signed messages
+	ChainIdHash []byte `protobuf:"bytes,2,opt,name=chain_id_hash,json=chainIdHash,proto3" json:"chain_id_hash,omitempty"`
+	// trusted_epoch_id is the epoch the proof starts from
+	TrustedEpochId uint64 `protobuf:"varint,3,opt,name=trusted_epoch_id,json=trustedEpochId,proto3" json:"trusted_epoch_id,omitempty"`
+	// trusted_group_public_key is the compressed group public key of the trusted epoch
+	TrustedGroupPublicKey []byte `protobuf:"bytes,4,opt,name=trusted_group_public_key,json=trustedGroupPublicKey,proto3" json:"trusted_group_public_key,omitempty"`
+	// links are the following epochs in order, each signed by the group of the epoch before it
+	Links []EpochKeyLink `protobuf:"bytes,5,rep,name=links,proto3" json:"links"`
+}
+
+func (m *EpochKeyChainProof) Reset()         { *m = EpochKeyChainProof{} }
+func (m *EpochKeyChainProof) String() string { return proto.CompactTextString(m) }
+func (*EpochKeyChainProof) ProtoMessage()    {}
+func (*EpochKeyChainProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb7b6f0307462ce4, []int{2}
+}
+func (m *EpochKeyChainProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochKeyChainProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochKeyChainProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochKeyChainProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochKeyChainProof.Merge(m, src)
+}
+func (m *EpochKeyChainProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochKeyChainProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochKeyChainProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochKeyChainProof proto.InternalMessageInfo
+
+func (m *EpochKeyChainProof) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *EpochKeyChainProof) GetChainIdHash() []byte {
+	if m != nil {
+		return m.ChainIdHash
+	}
+	return nil
+}
+
+func (m *EpochKeyChainProof) GetTrustedEpochId() uint64 {
+	if m != nil {
+		return m.TrustedEpochId
+	}
+	return 0
+}
+
+func (m *EpochKeyChainProof) GetTrustedGroupPublicKey() []byte {
+	if m != nil {
+		return m.TrustedGroupPublicKey
+	}
+	return nil
+}
+
+func (m *EpochKeyChainProof) GetLinks() []EpochKeyLink {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("inference.bls.GroupKeyValidationStatus", GroupKeyValidationStatus_name, GroupKeyValidationStatus_value)
 	proto.RegisterType((*GroupKeyValidationState)(nil), "inference.bls.GroupKeyValidationState")
+	proto.RegisterType((*EpochKeyLink)(nil), "inference.bls.EpochKeyLink")
+	proto.RegisterType((*EpochKeyChainProof)(nil), "inference.bls.EpochKeyChainProof")
 }
 
 func init() {
@@ -166,37 +343,50 @@ func init() {
 }
 
 var fileDescriptor_eb7b6f0307462ce4 = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0x6a, 0xdb, 0x4c,
-	0x14, 0x86, 0x3d, 0x8e, 0x3f, 0x7f, 0x30, 0xb1, 0x13, 0x7b, 0x28, 0x54, 0x78, 0xa1, 0xa8, 0x7f,
-	0x44, 0x0d, 0x54, 0x22, 0x29, 0x5d, 0x17, 0xc5, 0x56, 0x5d, 0x11, 0x63, 0x07, 0x49, 0x0e, 0xb4,
-	0x9b, 0x61, 0x2c, 0x4d, 0xa4, 0x01, 0x59, 0x23, 0x34, 0x23, 0xa7, 0xb9, 0x8b, 0xde, 0x49, 0x6f,
-	0x23, 0xcb, 0x2c, 0xbb, 0x2a, 0xc5, 0xbe, 0x80, 0xde, 0x42, 0xb1, 0xfc, 0x93, 0x26, 0xb4, 0xa5,
-	0x1b, 0x31, 0x3c, 0xe7, 0x39, 0xe7, 0xe8, 0xc0, 0x0b, 0x9f, 0xb3, 0xf4, 0x92, 0xe6, 0x34, 0x0d,
-	0xa8, 0x39, 0x49, 0x84, 0x19, 0xe5, 0xbc, 0xc8, 0xf0, 0x8c, 0x24, 0x2c, 0x24, 0x92, 0xf1, 0xd4,
-	0xc8, 0x72, 0x2e, 0x39, 0x6a, 0x6e, 0x2d, 0x63, 0x92, 0x88, 0x4e, 0x9b, 0x4c, 0x59, 0xca, 0xcd,
-	0xf2, 0xbb, 0x32, 0x3a, 0x8f, 0x22, 0x1e, 0xf1, 0xf2, 0x69, 0x2e, 0x5f, 0x6b, 0xda, 0xb9, 0x3f,
-	0x3d, 0x23, 0x39, 0x99, 0x8a, 0x55, 0xed, 0xe9, 0x8f, 0x2a, 0x7c, 0xdc, 0x5f, 0xae, 0x3b, 0xa3,
-	0xd7, 0x17, 0xdb, 0x85, 0x9e, 0x24, 0x92, 0x22, 0x0d, 0x36, 0x52, 0x7a, 0x85, 0x69, 0xc6, 0x83,
-	0x18, 0xb3, 0x50, 0x01, 0x1a, 0xd0, 0x6b, 0x2e, 0x4c, 0xe9, 0x95, 0xbd, 0x44, 0x4e, 0x88, 0x8e,
-	0x60, 0x3b, 0xcb, 0xe9, 0x8c, 0xf1, 0x42, 0xdc, 0x69, 0xd5, 0x52, 0xdb, 0xdf, 0x14, 0x36, 0xee,
-	0x5b, 0x58, 0x17, 0x92, 0xc8, 0x42, 0x28, 0x3b, 0x1a, 0xd0, 0xf7, 0x4e, 0x0e, 0x8d, 0x7b, 0xe7,
-	0x18, 0xbf, 0xff, 0x8b, 0x42, 0xb8, 0xeb, 0x36, 0xe4, 0x43, 0x94, 0x91, 0x5c, 0x32, 0x92, 0x60,
-	0xc1, 0xa2, 0x94, 0xc8, 0x22, 0xa7, 0x42, 0xa9, 0x69, 0x3b, 0xfa, 0xee, 0xc9, 0xc1, 0x83, 0x61,
-	0xe7, 0x2b, 0xd1, 0xdb, 0x78, 0xa7, 0xb5, 0x9b, 0x6f, 0x07, 0x15, 0xb7, 0x9d, 0x3d, 0xe0, 0x02,
-	0x1d, 0xc2, 0xfd, 0x4b, 0x96, 0xfe, 0x3a, 0x53, 0xf9, 0x4f, 0x03, 0x7a, 0xc3, 0xdd, 0x2b, 0xf1,
-	0xd6, 0x44, 0x4f, 0x60, 0x63, 0x4a, 0x85, 0x20, 0x11, 0xc5, 0x31, 0x11, 0xb1, 0x52, 0x2f, 0xad,
-	0xdd, 0x35, 0x7b, 0x4f, 0x44, 0x8c, 0x9e, 0xc1, 0xa6, 0x48, 0xb8, 0x14, 0x38, 0xe0, 0x33, 0x9a,
-	0xd3, 0x50, 0xf9, 0x5f, 0x03, 0x7a, 0xd3, 0x6d, 0x94, 0xb0, 0xbb, 0x62, 0x47, 0x5f, 0x00, 0x54,
-	0xfe, 0x74, 0x2b, 0x7a, 0x03, 0x8f, 0xfb, 0xee, 0x68, 0x7c, 0x8e, 0xcf, 0xec, 0x0f, 0xf8, 0xc2,
-	0x1a, 0x38, 0x3d, 0xcb, 0x77, 0x46, 0x43, 0xec, 0xf9, 0x96, 0x3f, 0xf6, 0x70, 0x77, 0x34, 0x18,
-	0xd8, 0x5d, 0xdf, 0x19, 0xf6, 0xb1, 0xe7, 0xf4, 0x87, 0x96, 0x3f, 0x76, 0x6d, 0xaf, 0x55, 0x41,
-	0x2f, 0xe1, 0x8b, 0xbf, 0xb5, 0xad, 0x89, 0xdd, 0x6b, 0x01, 0x74, 0x0c, 0x5f, 0xfd, 0x83, 0xba,
-	0x24, 0xef, 0x2c, 0x67, 0x60, 0xf7, 0x5a, 0xd5, 0x53, 0xe7, 0x66, 0xae, 0x82, 0xdb, 0xb9, 0x0a,
-	0xbe, 0xcf, 0x55, 0xf0, 0x79, 0xa1, 0x56, 0x6e, 0x17, 0x6a, 0xe5, 0xeb, 0x42, 0xad, 0x7c, 0x34,
-	0x23, 0x26, 0xe3, 0x62, 0x62, 0x04, 0x7c, 0x6a, 0x66, 0x39, 0x0f, 0x8b, 0x40, 0x8a, 0x80, 0x95,
-	0x49, 0xbb, 0xcb, 0xdc, 0xa7, 0x32, 0x75, 0xf2, 0x3a, 0xa3, 0x62, 0x52, 0x2f, 0x53, 0xf7, 0xfa,
-	0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x40, 0x95, 0x60, 0x93, 0xf1, 0x02, 0x00, 0x00,
+	// 675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xd1, 0x4e, 0xdb, 0x3c,
+	0x14, 0xc7, 0x9b, 0x52, 0xe0, 0xfb, 0x4c, 0x5b, 0x8a, 0xc7, 0xb4, 0xac, 0xd3, 0x4a, 0xe9, 0x36,
+	0x91, 0x21, 0xad, 0x15, 0x4c, 0x13, 0x97, 0x53, 0x29, 0x5d, 0x97, 0xb5, 0x82, 0x2a, 0x6d, 0x91,
+	0xb6, 0x1b, 0xcb, 0x4d, 0x4c, 0x6b, 0x91, 0xc6, 0x51, 0xec, 0xc0, 0xfa, 0x16, 0x7b, 0x93, 0x3d,
+	0xc3, 0xee, 0xb8, 0xe4, 0x72, 0x57, 0xd3, 0x04, 0x0f, 0xc0, 0x2b, 0x4c, 0x75, 0x12, 0xd2, 0x16,
+	0x36, 0x4d, 0xbb, 0x89, 0xec, 0xff, 0xf9, 0x1f, 0x9f, 0xe4, 0xe7, 0x93, 0x03, 0x9e, 0x53, 0xe7,
+	0x84, 0x78, 0xc4, 0x31, 0x49, 0xa5, 0x6f, 0xf3, 0xca, 0xc0, 0x63, 0xbe, 0x8b, 0xce, 0xb0, 0x4d,
+	0x2d, 0x2c, 0x28, 0x73, 0xca, 0xae, 0xc7, 0x04, 0x83, 0x99, 0x5b, 0x57, 0xb9, 0x6f, 0xf3, 0xfc,
+	0x1a, 0x1e, 0x51, 0x87, 0x55, 0xe4, 0x33, 0x70, 0xe4, 0xd7, 0x07, 0x6c, 0xc0, 0xe4, 0xb2, 0x32,
+	0x59, 0x85, 0x6a, 0x7e, 0xf6, 0x74, 0x17, 0x7b, 0x78, 0xc4, 0x83, 0x58, 0xe9, 0x26, 0x09, 0x1e,
+	0x35, 0x26, 0xe5, 0x9a, 0x64, 0x7c, 0x7c, 0x5b, 0xb0, 0x23, 0xb0, 0x20, 0xb0, 0x08, 0xd2, 0x0e,
+	0x39, 0x47, 0xc4, 0x65, 0xe6, 0x10, 0x51, 0x4b, 0x55, 0x8a, 0x8a, 0x96, 0x32, 0x80, 0x43, 0xce,
+	0xeb, 0x13, 0x49, 0xb7, 0xe0, 0x36, 0x58, 0x73, 0x3d, 0x72, 0x46, 0x99, 0xcf, 0x63, 0x5b, 0x52,
+	0xda, 0x56, 0xa3, 0x40, 0xe4, 0x7d, 0x0b, 0x96, 0xb8, 0xc0, 0xc2, 0xe7, 0xea, 0x42, 0x51, 0xd1,
+	0xb2, 0xbb, 0x5b, 0xe5, 0x99, 0xcf, 0x29, 0xdf, 0xff, 0x16, 0x3e, 0x37, 0xc2, 0x34, 0xd8, 0x05,
+	0xd0, 0xc5, 0x9e, 0xa0, 0xd8, 0x46, 0x9c, 0x0e, 0x1c, 0x2c, 0x7c, 0x8f, 0x70, 0x35, 0x55, 0x5c,
+	0xd0, 0x56, 0x76, 0x37, 0xe6, 0x0e, 0x6b, 0x07, 0xc6, 0x4e, 0xe4, 0xdb, 0x4f, 0x5d, 0xfc, 0xd8,
+	0x48, 0x18, 0x6b, 0xee, 0x9c, 0xce, 0xe1, 0x16, 0x58, 0x3d, 0xa1, 0xce, 0xf4, 0x99, 0xea, 0x62,
+	0x51, 0xd1, 0xd2, 0x46, 0x56, 0xca, 0xb7, 0x4e, 0xb8, 0x09, 0xd2, 0x23, 0xc2, 0x39, 0x1e, 0x10,
+	0x34, 0xc4, 0x7c, 0xa8, 0x2e, 0x49, 0xd7, 0x4a, 0xa8, 0xbd, 0xc7, 0x7c, 0x08, 0x9f, 0x81, 0x0c,
+	0xb7, 0x99, 0xe0, 0xc8, 0x64, 0x67, 0xc4, 0x23, 0x96, 0xba, 0x5c, 0x54, 0xb4, 0x8c, 0x91, 0x96,
+	0x62, 0x2d, 0xd0, 0x4a, 0xdf, 0x92, 0x20, 0x2d, 0x99, 0x34, 0xc9, 0xb8, 0x45, 0x9d, 0x53, 0xf8,
+	0x18, 0xfc, 0x37, 0x87, 0x78, 0x99, 0xfc, 0x03, 0x5f, 0x0d, 0xe4, 0x82, 0xbe, 0x71, 0xfd, 0xbe,
+	0x4d, 0x4d, 0x74, 0x4a, 0xc6, 0x92, 0x74, 0xda, 0xc8, 0x4a, 0xbd, 0x2d, 0xe5, 0x26, 0x19, 0xc3,
+	0x2a, 0x78, 0x3a, 0xef, 0x44, 0xbe, 0x63, 0xb2, 0x91, 0xeb, 0x11, 0xce, 0x89, 0xa5, 0xa6, 0x64,
+	0x5a, 0x7e, 0x36, 0xad, 0x37, 0xe5, 0x80, 0x3b, 0x60, 0x3d, 0x6e, 0xcf, 0x3b, 0xe8, 0x1e, 0xc4,
+	0xb1, 0x98, 0xdf, 0x07, 0xb0, 0x79, 0x5f, 0xca, 0x6c, 0xe5, 0x00, 0xea, 0xc6, 0x3d, 0xf9, 0xd3,
+	0xe5, 0x4b, 0x37, 0x0a, 0x80, 0x11, 0xc3, 0xda, 0x10, 0x53, 0xa7, 0xed, 0x31, 0x76, 0x32, 0x21,
+	0x69, 0x4e, 0x76, 0x11, 0xc9, 0xff, 0x8d, 0x65, 0xb9, 0xd7, 0x2d, 0x58, 0x02, 0x99, 0x28, 0x14,
+	0x5c, 0x5f, 0x32, 0xb8, 0xbe, 0x30, 0x2e, 0xaf, 0x4f, 0x03, 0x39, 0xe1, 0xf9, 0x5c, 0x10, 0x2b,
+	0x86, 0xbd, 0x20, 0x61, 0x67, 0x43, 0x3d, 0x62, 0xbd, 0x07, 0xd4, 0xc8, 0x79, 0x87, 0x79, 0x00,
+	0xef, 0x61, 0x18, 0x6f, 0xcc, 0xa2, 0xdf, 0x03, 0x8b, 0x36, 0x75, 0x4e, 0xb9, 0xba, 0x28, 0xdb,
+	0xf6, 0xc9, 0x5c, 0xdb, 0x4e, 0xf7, 0x45, 0xd8, 0xb2, 0x81, 0x7f, 0xfb, 0xab, 0x02, 0xd4, 0xdf,
+	0xfd, 0x21, 0xf0, 0x0d, 0xd8, 0x69, 0x18, 0x47, 0xbd, 0x36, 0x6a, 0xd6, 0x3f, 0xa2, 0xe3, 0x6a,
+	0x4b, 0x3f, 0xa8, 0x76, 0xf5, 0xa3, 0x43, 0xd4, 0xe9, 0x56, 0xbb, 0xbd, 0x0e, 0xaa, 0x1d, 0xb5,
+	0x5a, 0xf5, 0x5a, 0x57, 0x3f, 0x6c, 0xa0, 0x8e, 0xde, 0x38, 0xac, 0x76, 0x7b, 0x46, 0xbd, 0x93,
+	0x4b, 0xc0, 0x97, 0xe0, 0xc5, 0x9f, 0xd2, 0x42, 0xa5, 0x7e, 0x90, 0x53, 0xe0, 0x0e, 0x78, 0xf5,
+	0x17, 0xd6, 0x89, 0xf2, 0xae, 0xaa, 0xb7, 0xea, 0x07, 0xb9, 0xe4, 0xbe, 0x7e, 0x71, 0x55, 0x50,
+	0x2e, 0xaf, 0x0a, 0xca, 0xcf, 0xab, 0x82, 0xf2, 0xe5, 0xba, 0x90, 0xb8, 0xbc, 0x2e, 0x24, 0xbe,
+	0x5f, 0x17, 0x12, 0x9f, 0x2a, 0x03, 0x2a, 0x86, 0x7e, 0xbf, 0x6c, 0xb2, 0x51, 0xc5, 0xf5, 0x98,
+	0xe5, 0x9b, 0x82, 0x9b, 0x54, 0xce, 0xa7, 0x78, 0x52, 0x7d, 0x96, 0xb3, 0x4a, 0x8c, 0x5d, 0xc2,
+	0xfb, 0x4b, 0x72, 0x56, 0xbd, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xc9, 0xe9, 0xbe, 0x27,
+	0x05, 0x00, 0x00,
 }
 
 func (m *GroupKeyValidationState) Marshal() (dAtA []byte, err error) {
@@ -270,6 +460,130 @@ func (m *GroupKeyValidationState) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochKeyLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochKeyLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochKeyLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidationSignatureUncompressed) > 0 {
+		i -= len(m.ValidationSignatureUncompressed)
+		copy(dAtA[i:], m.ValidationSignatureUncompressed)
+		i = encodeVarintGroupValidation(dAtA, i, uint64(len(m.ValidationSignatureUncompressed)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ValidationSignature) > 0 {
+		i -= len(m.ValidationSignature)
+		copy(dAtA[i:], m.ValidationSignature)
+		i = encodeVarintGroupValidation(dAtA, i, uint64(len(m.ValidationSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.GroupPublicKeyUncompressed) > 0 {
+		i -= len(m.GroupPublicKeyUncompressed)
+		copy(dAtA[i:], m.GroupPublicKeyUncompressed)
+		i = encodeVarintGroupValidation(dAtA, i, uint64(len(m.GroupPublicKeyUncompressed)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GroupPublicKey) > 0 {
+		i -= len(m.GroupPublicKey)
+		copy(dAtA[i:], m.GroupPublicKey)
+		i = encodeVarintGroupValidation(dAtA, i, uint64(len(m.GroupPublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PreviousEpochId != 0 {
+		i = encodeVarintGroupValidation(dAtA, i, uint64(m.PreviousEpochId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintGroupValidation(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochKeyChainProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochKeyChainProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochKeyChainProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Links) > 0 {
+		for iNdEx := len(m.Links) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Links[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGroupValidation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TrustedGroupPublicKey) > 0 {
+		i -= len(m.TrustedGroupPublicKey)
+		copy(dAtA[i:], m.TrustedGroupPublicKey)
+		i = encodeVarintGroupValidation(dAtA, i, uint64(len(m.TrustedGroupPublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TrustedEpochId != 0 {
+		i = encodeVarintGroupValidation(dAtA, i, uint64(m.TrustedEpochId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainIdHash) > 0 {
+		i -= len(m.ChainIdHash)
+		copy(dAtA[i:], m.ChainIdHash)
+		i = encodeVarintGroupValidation(dAtA, i, uint64(len(m.ChainIdHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGroupValidation(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGroupValidation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGroupValidation(v)
 	base := offset
@@ -316,6 +630,67 @@ func (m *GroupKeyValidationState) Size() (n int) {
 	return n
 }
 
+func (m *EpochKeyLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovGroupValidation(uint64(m.EpochId))
+	}
+	if m.PreviousEpochId != 0 {
+		n += 1 + sovGroupValidation(uint64(m.PreviousEpochId))
+	}
+	l = len(m.GroupPublicKey)
+	if l > 0 {
+		n += 1 + l + sovGroupValidation(uint64(l))
+	}
+	l = len(m.GroupPublicKeyUncompressed)
+	if l > 0 {
+		n += 1 + l + sovGroupValidation(uint64(l))
+	}
+	l = len(m.ValidationSignature)
+	if l > 0 {
+		n += 1 + l + sovGroupValidation(uint64(l))
+	}
+	l = len(m.ValidationSignatureUncompressed)
+	if l > 0 {
+		n += 1 + l + sovGroupValidation(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochKeyChainProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGroupValidation(uint64(l))
+	}
+	l = len(m.ChainIdHash)
+	if l > 0 {
+		n += 1 + l + sovGroupValidation(uint64(l))
+	}
+	if m.TrustedEpochId != 0 {
+		n += 1 + sovGroupValidation(uint64(m.TrustedEpochId))
+	}
+	l = len(m.TrustedGroupPublicKey)
+	if l > 0 {
+		n += 1 + l + sovGroupValidation(uint64(l))
+	}
+	if len(m.Links) > 0 {
+		for _, e := range m.Links {
+			l = e.Size()
+			n += 1 + l + sovGroupValidation(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGroupValidation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -550,6 +925,433 @@ func (m *GroupKeyValidationState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EpochKeyLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGroupValidation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochKeyLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochKeyLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroupValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEpochId", wireType)
+			}
+			m.PreviousEpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroupValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousEpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroupValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPublicKey = append(m.GroupPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPublicKey == nil {
+				m.GroupPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPublicKeyUncompressed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroupValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPublicKeyUncompressed = append(m.GroupPublicKeyUncompressed[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPublicKeyUncompressed == nil {
+				m.GroupPublicKeyUncompressed = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroupValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidationSignature = append(m.ValidationSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidationSignature == nil {
+				m.ValidationSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationSignatureUncompressed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroupValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidationSignatureUncompressed = append(m.ValidationSignatureUncompressed[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidationSignatureUncompressed == nil {
+				m.ValidationSignatureUncompressed = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGroupValidation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochKeyChainProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGroupValidation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochKeyChainProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochKeyChainProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroupValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIdHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroupValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainIdHash = append(m.ChainIdHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChainIdHash == nil {
+				m.ChainIdHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedEpochId", wireType)
+			}
+			m.TrustedEpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroupValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrustedEpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedGroupPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroupValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedGroupPublicKey = append(m.TrustedGroupPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrustedGroupPublicKey == nil {
+				m.TrustedGroupPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroupValidation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Links = append(m.Links, EpochKeyLink{})
+			if err := m.Links[len(m.Links)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGroupValidation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGroupValidation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGroupValidation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
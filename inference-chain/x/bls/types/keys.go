@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"fmt"
 )
 
 const (
@@ -20,6 +21,10 @@ var (
 	EpochBLSDataPrefix            = []byte("epoch_bls_data")
 	ThresholdSigningRequestPrefix = []byte("threshold_signing_request")
 	ExpirationIndexPrefix         = []byte("expiration_index")
+	SigningSchemaPrefix           = []byte("signing_schema/")
+	SchemaSigningRequestPrefix    = []byte("schema_signing_request/")
+	SchemaSignatureIndexPrefix    = []byte("schema_signature_index/")
+	SchemaSigningSequenceKey      = []byte("schema_signing_sequence")
 )
 
 func KeyPrefix(p string) []byte {
@@ -34,6 +39,11 @@ func EpochBLSDataKey(epochID uint64) []byte {
 	return key
 }
 
+// GroupKeyValidationStateKey generates the key of the GroupKeyValidationState for a new epoch
+func GroupKeyValidationStateKey(newEpochID uint64) []byte {
+	return []byte(fmt.Sprintf("group_validation_%d", newEpochID))
+}
+
 // ThresholdSigningRequestKey generates a key for storing ThresholdSigningRequest by request ID
 // This results in a variable length key, as we put no constraints on the request_id
 func ThresholdSigningRequestKey(requestID []byte) []byte {
@@ -65,3 +75,24 @@ func ExpirationIndexPrefixForBlock(blockHeight int64) []byte {
 	copy(prefix[len(ExpirationIndexPrefix):], deadlineBytes)
 	return prefix
 }
+
+// SigningSchemaKey generates a key for storing a SigningSchema by name: signing_schema/{name}
+func SigningSchemaKey(name string) []byte {
+	return append(append([]byte{}, SigningSchemaPrefix...), name...)
+}
+
+// SchemaSigningRequestKey generates a key for storing a SchemaSigningRequest by request ID
+func SchemaSigningRequestKey(requestID []byte) []byte {
+	return append(append([]byte{}, SchemaSigningRequestPrefix...), requestID...)
+}
+
+// SchemaSignatureIndexPrefixForSchema generates a prefix to scan the completed signatures of a schema in sequence order
+func SchemaSignatureIndexPrefixForSchema(name string) []byte {
+	key := append(append([]byte{}, SchemaSignatureIndexPrefix...), name...)
+	return append(key, '/')
+}
+
+// SchemaSignatureIndexKey generates a key for the schema signature index: schema_signature_index/{name}/{sequence}
+func SchemaSignatureIndexKey(name string, sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64(SchemaSignatureIndexPrefixForSchema(name), sequence)
+}
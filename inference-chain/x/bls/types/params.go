@@ -15,6 +15,8 @@ var (
 	KeyDealingPhaseDurationBlocks      = []byte("DealingPhaseDurationBlocks")
 	KeyVerificationPhaseDurationBlocks = []byte("VerificationPhaseDurationBlocks")
 	KeySigningDeadlineBlocks           = []byte("SigningDeadlineBlocks")
+	KeyMaxDkgRetries                   = []byte("MaxDkgRetries")
+	KeyDkgRetryMinWeightPercent        = []byte("DkgRetryMinWeightPercent")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -29,6 +31,8 @@ func NewParams(
 	dealingPhaseDurationBlocks int64,
 	verificationPhaseDurationBlocks int64,
 	signingDeadlineBlocks int64,
+	maxDkgRetries uint32,
+	dkgRetryMinWeightPercent uint32,
 ) Params {
 	return Params{
 		ITotalSlots:                     iTotalSlots,
@@ -36,6 +40,8 @@ func NewParams(
 		DealingPhaseDurationBlocks:      dealingPhaseDurationBlocks,
 		VerificationPhaseDurationBlocks: verificationPhaseDurationBlocks,
 		SigningDeadlineBlocks:           signingDeadlineBlocks,
+		MaxDkgRetries:                   maxDkgRetries,
+		DkgRetryMinWeightPercent:        dkgRetryMinWeightPercent,
 	}
 }
 
@@ -47,6 +53,8 @@ func DefaultParams() Params {
 		5,   // dealing_phase_duration_blocks: 5 blocks for PoC
 		3,   // verification_phase_duration_blocks: 3 blocks for PoC
 		10,  // signing_deadline_blocks: 10 blocks for PoC (enough time for controllers to respond)
+		2,   // max_dkg_retries: restart dealing up to 2 times without the participants that did not perform
+		34,  // dkg_retry_min_weight_percent: retry only while more than a third of the original weight remains
 	)
 }
 
@@ -58,6 +66,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDealingPhaseDurationBlocks, &p.DealingPhaseDurationBlocks, validateDealingPhaseDurationBlocks),
 		paramtypes.NewParamSetPair(KeyVerificationPhaseDurationBlocks, &p.VerificationPhaseDurationBlocks, validateVerificationPhaseDurationBlocks),
 		paramtypes.NewParamSetPair(KeySigningDeadlineBlocks, &p.SigningDeadlineBlocks, validateSigningDeadlineBlocks),
+		paramtypes.NewParamSetPair(KeyMaxDkgRetries, &p.MaxDkgRetries, validateMaxDkgRetries),
+		paramtypes.NewParamSetPair(KeyDkgRetryMinWeightPercent, &p.DkgRetryMinWeightPercent, validateDkgRetryMinWeightPercent),
 	}
 }
 
@@ -78,6 +88,12 @@ func (p Params) Validate() error {
 	if err := validateSigningDeadlineBlocks(p.SigningDeadlineBlocks); err != nil {
 		return err
 	}
+	if err := validateMaxDkgRetries(p.MaxDkgRetries); err != nil {
+		return err
+	}
+	if err := validateDkgRetryMinWeightPercent(p.DkgRetryMinWeightPercent); err != nil {
+		return err
+	}
 
 	// Additional cross-parameter validation
 	if p.TSlotsDegreeOffset >= p.ITotalSlots {
@@ -156,3 +172,24 @@ func validateSigningDeadlineBlocks(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxDkgRetries(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateDkgRetryMinWeightPercent(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > 100 {
+		return fmt.Errorf("dkg_retry_min_weight_percent cannot exceed 100")
+	}
+
+	return nil
+}
@@ -37,6 +37,10 @@ type Params struct {
 	VerificationPhaseDurationBlocks int64 `protobuf:"varint,4,opt,name=verification_phase_duration_blocks,json=verificationPhaseDurationBlocks,proto3" json:"verification_phase_duration_blocks,omitempty"`
 	// Duration in blocks for threshold signing deadline (e.g., 10 blocks for PoC)
 	SigningDeadlineBlocks int64 `protobuf:"varint,5,opt,name=signing_deadline_blocks,json=signingDeadlineBlocks,proto3" json:"signing_deadline_blocks,omitempty"`
+	// Number of times a failed DKG restarts dealing without the participants that did not perform (e.g., 2)
+	MaxDkgRetries uint32 `protobuf:"varint,6,opt,name=max_dkg_retries,json=maxDkgRetries,proto3" json:"max_dkg_retries,omitempty"`
+	// Minimum share of the original participant weight, in percent, the remaining participants must hold for a retry (e.g., 34)
+	DkgRetryMinWeightPercent uint32 `protobuf:"varint,7,opt,name=dkg_retry_min_weight_percent,json=dkgRetryMinWeightPercent,proto3" json:"dkg_retry_min_weight_percent,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -107,6 +111,20 @@ func (m *Params) GetSigningDeadlineBlocks() int64 {
 	return 0
 }
 
+func (m *Params) GetMaxDkgRetries() uint32 {
+	if m != nil {
+		return m.MaxDkgRetries
+	}
+	return 0
+}
+
+func (m *Params) GetDkgRetryMinWeightPercent() uint32 {
+	if m != nil {
+		return m.DkgRetryMinWeightPercent
+	}
+	return 0
+}
+
 // PartialSignature represents a partial signature from a single participant in threshold signing
 type PartialSignature struct {
 	// participant_address is the address of the participant who submitted this partial signature
@@ -179,35 +197,39 @@ func init() {
 func init() { proto.RegisterFile("inference/bls/params.proto", fileDescriptor_ef541167904df278) }
 
 var fileDescriptor_ef541167904df278 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xb1, 0x8e, 0x13, 0x31,
-	0x10, 0x86, 0xb3, 0x09, 0x9c, 0x74, 0xe6, 0x22, 0x81, 0xb9, 0x83, 0x25, 0x82, 0x4d, 0x48, 0x75,
-	0x42, 0x22, 0x2b, 0x84, 0x44, 0x41, 0x77, 0x51, 0x9a, 0x88, 0x82, 0x28, 0xa1, 0xa2, 0xb1, 0xbc,
-	0xb6, 0xb3, 0x37, 0x62, 0x63, 0xaf, 0x3c, 0x5e, 0x04, 0xaf, 0x40, 0xc5, 0x13, 0x20, 0x1e, 0x81,
-	0x82, 0x87, 0xb8, 0xf2, 0x44, 0x45, 0x89, 0x92, 0x02, 0x1e, 0x03, 0xd9, 0xde, 0xe3, 0x4e, 0xe8,
-	0x9a, 0xd5, 0xfa, 0xff, 0xfe, 0xf9, 0xbd, 0x33, 0xb3, 0x64, 0x00, 0x7a, 0xad, 0xac, 0xd2, 0x42,
-	0xe5, 0x45, 0x85, 0x79, 0xcd, 0x2d, 0xdf, 0xe0, 0xa4, 0xb6, 0xc6, 0x19, 0xda, 0xff, 0xc7, 0x26,
-	0x45, 0x85, 0x83, 0x3b, 0x7c, 0x03, 0xda, 0xe4, 0xe1, 0x19, 0x1d, 0x83, 0x07, 0xc2, 0xe0, 0xc6,
-	0x20, 0x0b, 0xa7, 0x3c, 0x1e, 0x5a, 0x74, 0x58, 0x9a, 0xd2, 0x44, 0xdd, 0xbf, 0x45, 0x75, 0x7c,
-	0xd6, 0x25, 0x7b, 0x8b, 0x70, 0x07, 0x1d, 0x93, 0x3e, 0x30, 0x67, 0x1c, 0xaf, 0x18, 0x56, 0xc6,
-	0x61, 0x9a, 0x8c, 0x92, 0xe3, 0xfe, 0xf2, 0x16, 0xbc, 0xf1, 0xda, 0xca, 0x4b, 0xf4, 0x19, 0x39,
-	0x72, 0x91, 0x32, 0xa9, 0x4a, 0xab, 0x14, 0x33, 0xeb, 0x35, 0x2a, 0x97, 0x76, 0x83, 0x97, 0xba,
-	0x60, 0x9b, 0x05, 0xf4, 0x3a, 0x10, 0x7a, 0x42, 0x1e, 0x49, 0xc5, 0x2b, 0xd0, 0x25, 0xab, 0x4f,
-	0x39, 0x2a, 0x26, 0x1b, 0xcb, 0x1d, 0x18, 0xcd, 0x8a, 0xca, 0x88, 0x77, 0x98, 0xf6, 0x46, 0xc9,
-	0x71, 0x6f, 0x39, 0x68, 0x4d, 0x0b, 0xef, 0x99, 0xb5, 0x96, 0x69, 0x70, 0xd0, 0x57, 0x64, 0xfc,
-	0x5e, 0x59, 0x58, 0x83, 0x88, 0x85, 0xd7, 0xe7, 0xdc, 0x08, 0x39, 0xc3, 0xab, 0xce, 0xeb, 0xc2,
-	0x5e, 0x90, 0xfb, 0x08, 0xa5, 0xf6, 0xdf, 0x23, 0x15, 0x97, 0x15, 0x68, 0x75, 0x91, 0x70, 0x33,
-	0x24, 0x1c, 0xb5, 0x78, 0xd6, 0xd2, 0x58, 0xf7, 0x72, 0xf8, 0xe7, 0xeb, 0x30, 0xf9, 0xf4, 0xfb,
-	0xdb, 0x93, 0x7b, 0x97, 0x1b, 0xfa, 0x10, 0x76, 0x14, 0xe7, 0x37, 0xfe, 0x92, 0x90, 0xdb, 0x0b,
-	0x6e, 0x1d, 0xf0, 0x6a, 0x05, 0xa5, 0xe6, 0xae, 0xb1, 0x8a, 0xce, 0xc9, 0xdd, 0xda, 0x6b, 0x02,
-	0x6a, 0xae, 0x1d, 0xe3, 0x52, 0x5a, 0x85, 0x71, 0xb4, 0xfb, 0xd3, 0xf4, 0xc7, 0xf7, 0xa7, 0x87,
-	0xed, 0x92, 0x4e, 0x22, 0x59, 0x39, 0x0b, 0xba, 0x5c, 0xd2, 0x2b, 0x45, 0x2d, 0xa1, 0x8f, 0xc9,
-	0x81, 0x9f, 0x3c, 0x03, 0x2d, 0x41, 0x28, 0x4c, 0xbb, 0xa3, 0x9e, 0x5f, 0x8f, 0xd7, 0xe6, 0x51,
-	0xa2, 0x0f, 0xc9, 0x3e, 0x5e, 0x5c, 0x1d, 0xe6, 0x7a, 0xb0, 0xbc, 0x14, 0xa6, 0xf3, 0xb3, 0x6d,
-	0x96, 0x9c, 0x6f, 0xb3, 0xe4, 0xd7, 0x36, 0x4b, 0x3e, 0xef, 0xb2, 0xce, 0xf9, 0x2e, 0xeb, 0xfc,
-	0xdc, 0x65, 0x9d, 0xb7, 0x79, 0x09, 0xee, 0xb4, 0x29, 0x26, 0xc2, 0x6c, 0xf2, 0xda, 0x1a, 0xd9,
-	0x08, 0x87, 0x02, 0x42, 0x8b, 0xff, 0x37, 0xeb, 0x3e, 0xd6, 0x0a, 0x8b, 0xbd, 0xf0, 0xf7, 0x3c,
-	0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x17, 0xb3, 0x19, 0xae, 0x02, 0x00, 0x00,
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x8a, 0x13, 0x4f,
+	0x10, 0xc6, 0x33, 0x9b, 0xff, 0x3f, 0xb2, 0xed, 0x06, 0x75, 0xdc, 0xd5, 0x31, 0xac, 0x93, 0x98,
+	0x83, 0x2c, 0x82, 0x19, 0x44, 0xf0, 0xe0, 0x41, 0xd8, 0x90, 0x4b, 0x10, 0x31, 0x24, 0x82, 0xe0,
+	0xa5, 0xe9, 0x4c, 0x57, 0x3a, 0x45, 0x66, 0xba, 0x87, 0xee, 0x8e, 0x26, 0xaf, 0xe0, 0xc9, 0x27,
+	0x10, 0x1f, 0x61, 0x0f, 0x3e, 0x84, 0xc7, 0xc5, 0x93, 0x47, 0x49, 0x0e, 0xfa, 0x18, 0x32, 0xdd,
+	0x13, 0x77, 0x91, 0xbd, 0x0c, 0xd3, 0xdf, 0xf7, 0xab, 0xea, 0x2e, 0xea, 0x23, 0x2d, 0x94, 0x33,
+	0xd0, 0x20, 0x53, 0x48, 0xa6, 0x99, 0x49, 0x0a, 0xa6, 0x59, 0x6e, 0x7a, 0x85, 0x56, 0x56, 0x85,
+	0xcd, 0xbf, 0x5e, 0x6f, 0x9a, 0x99, 0xd6, 0x2d, 0x96, 0xa3, 0x54, 0x89, 0xfb, 0x7a, 0xa2, 0x75,
+	0x2f, 0x55, 0x26, 0x57, 0x86, 0xba, 0x53, 0xe2, 0x0f, 0x95, 0x75, 0x28, 0x94, 0x50, 0x5e, 0x2f,
+	0xff, 0xbc, 0xda, 0x3d, 0xab, 0x93, 0xc6, 0xc8, 0xdd, 0x11, 0x76, 0x49, 0x13, 0xa9, 0x55, 0x96,
+	0x65, 0xd4, 0x64, 0xca, 0x9a, 0x28, 0xe8, 0x04, 0x27, 0xcd, 0xf1, 0x75, 0x7c, 0x53, 0x6a, 0x93,
+	0x52, 0x0a, 0x9f, 0x90, 0x23, 0xeb, 0x5d, 0xca, 0x41, 0x68, 0x00, 0xaa, 0x66, 0x33, 0x03, 0x36,
+	0xda, 0x73, 0x6c, 0x68, 0x1d, 0x36, 0x70, 0xd6, 0x6b, 0xe7, 0x84, 0xa7, 0xe4, 0x3e, 0x07, 0x96,
+	0xa1, 0x14, 0xb4, 0x98, 0x33, 0x03, 0x94, 0x2f, 0x35, 0xb3, 0xa8, 0x24, 0x9d, 0x66, 0x2a, 0x5d,
+	0x98, 0xa8, 0xde, 0x09, 0x4e, 0xea, 0xe3, 0x56, 0x05, 0x8d, 0x4a, 0x66, 0x50, 0x21, 0x7d, 0x47,
+	0x84, 0x2f, 0x49, 0xf7, 0x3d, 0x68, 0x9c, 0x61, 0xea, 0x0b, 0xaf, 0xee, 0xf3, 0x9f, 0xeb, 0xd3,
+	0xbe, 0x4c, 0x5e, 0xd5, 0xec, 0x19, 0xb9, 0x6b, 0x50, 0xc8, 0xf2, 0x3d, 0x1c, 0x18, 0xcf, 0x50,
+	0xc2, 0xae, 0xc3, 0xff, 0xae, 0xc3, 0x51, 0x65, 0x0f, 0x2a, 0xb7, 0xaa, 0x7b, 0x48, 0x6e, 0xe4,
+	0x6c, 0x45, 0xf9, 0x42, 0x50, 0x0d, 0x56, 0x23, 0x98, 0xa8, 0xe1, 0x86, 0x6e, 0xe6, 0x6c, 0x35,
+	0x58, 0x88, 0xb1, 0x17, 0xc3, 0x17, 0xe4, 0x78, 0xc7, 0xac, 0x69, 0x8e, 0x92, 0x7e, 0x00, 0x14,
+	0x73, 0x4b, 0x0b, 0xd0, 0x29, 0x48, 0x1b, 0x5d, 0x73, 0x45, 0x11, 0xf7, 0x15, 0xeb, 0x57, 0x28,
+	0xdf, 0x3a, 0x60, 0xe4, 0xfd, 0xe7, 0xed, 0xdf, 0x5f, 0xda, 0xc1, 0xc7, 0x5f, 0x67, 0x8f, 0xee,
+	0x5c, 0x24, 0x61, 0xe5, 0xb2, 0xe0, 0xf7, 0xd4, 0xfd, 0x1c, 0x90, 0x9b, 0x23, 0xa6, 0x2d, 0xb2,
+	0x6c, 0x82, 0x42, 0x32, 0xbb, 0xd4, 0x10, 0x0e, 0xc9, 0xed, 0xa2, 0xd4, 0x52, 0x2c, 0x98, 0xb4,
+	0x94, 0x71, 0xae, 0xc1, 0xf8, 0x15, 0xee, 0xf7, 0xa3, 0xef, 0x5f, 0x1f, 0x1f, 0x56, 0x61, 0x38,
+	0xf5, 0xce, 0xc4, 0x6a, 0x94, 0x62, 0x1c, 0x5e, 0x2a, 0xaa, 0x9c, 0xf0, 0x01, 0x39, 0x28, 0x37,
+	0x4c, 0x51, 0x72, 0x4c, 0xc1, 0x44, 0x7b, 0x9d, 0x7a, 0x19, 0x83, 0x52, 0x1b, 0x7a, 0x29, 0x3c,
+	0x26, 0xfb, 0x66, 0x77, 0xb5, 0xdb, 0xdf, 0xc1, 0xf8, 0x42, 0xe8, 0x0f, 0xbf, 0x6d, 0xe2, 0xe0,
+	0x7c, 0x13, 0x07, 0x3f, 0x37, 0x71, 0xf0, 0x69, 0x1b, 0xd7, 0xce, 0xb7, 0x71, 0xed, 0xc7, 0x36,
+	0xae, 0xbd, 0x4b, 0x04, 0xda, 0xf9, 0x72, 0xda, 0x4b, 0x55, 0x9e, 0x14, 0x5a, 0xf1, 0x65, 0x6a,
+	0x4d, 0x8a, 0x6e, 0xc4, 0x7f, 0x87, 0xb5, 0xeb, 0x02, 0xcc, 0xb4, 0xe1, 0x52, 0xfa, 0xf4, 0x4f,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x54, 0x0c, 0x4f, 0x15, 0x16, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -244,6 +266,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigningDeadlineBlocks != that1.SigningDeadlineBlocks {
 		return false
 	}
+	if this.MaxDkgRetries != that1.MaxDkgRetries {
+		return false
+	}
+	if this.DkgRetryMinWeightPercent != that1.DkgRetryMinWeightPercent {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -266,6 +294,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DkgRetryMinWeightPercent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DkgRetryMinWeightPercent))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxDkgRetries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDkgRetries))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigningDeadlineBlocks != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.SigningDeadlineBlocks))
 		i--
@@ -381,6 +419,12 @@ func (m *Params) Size() (n int) {
 	if m.SigningDeadlineBlocks != 0 {
 		n += 1 + sovParams(uint64(m.SigningDeadlineBlocks))
 	}
+	if m.MaxDkgRetries != 0 {
+		n += 1 + sovParams(uint64(m.MaxDkgRetries))
+	}
+	if m.DkgRetryMinWeightPercent != 0 {
+		n += 1 + sovParams(uint64(m.DkgRetryMinWeightPercent))
+	}
 	return n
 }
 
@@ -538,6 +582,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDkgRetries", wireType)
+			}
+			m.MaxDkgRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDkgRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgRetryMinWeightPercent", wireType)
+			}
+			m.DkgRetryMinWeightPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgRetryMinWeightPercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -417,6 +417,300 @@ func (m *QuerySigningHistoryResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryEpochKeyChainProofRequest is request type for the Query/EpochKeyChainProof RPC method.
+type QueryEpochKeyChainProofRequest struct {
+	// trusted_epoch_id is the epoch whose group public key the verifier already trusts
+	TrustedEpochId uint64 `protobuf:"varint,1,opt,name=trusted_epoch_id,json=trustedEpochId,proto3" json:"trusted_epoch_id,omitempty"`
+	// target_epoch_id is the last epoch to prove (optional, 0 means the latest validated epoch)
+	TargetEpochId uint64 `protobuf:"varint,2,opt,name=target_epoch_id,json=targetEpochId,proto3" json:"target_epoch_id,omitempty"`
+}
+
+func (m *QueryEpochKeyChainProofRequest) Reset()         { *m = QueryEpochKeyChainProofRequest{} }
+func (m *QueryEpochKeyChainProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochKeyChainProofRequest) ProtoMessage()    {}
+func (*QueryEpochKeyChainProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b19bd0a6a108cf6a, []int{8}
+}
+func (m *QueryEpochKeyChainProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochKeyChainProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochKeyChainProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochKeyChainProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochKeyChainProofRequest.Merge(m, src)
+}
+func (m *QueryEpochKeyChainProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochKeyChainProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochKeyChainProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochKeyChainProofRequest proto.InternalMessageInfo
+
+func (m *QueryEpochKeyChainProofRequest) GetTrustedEpochId() uint64 {
+	if m != nil {
+		return m.TrustedEpochId
+	}
+	return 0
+}
+
+func (m *QueryEpochKeyChainProofRequest) GetTargetEpochId() uint64 {
+	if m != nil {
+		return m.TargetEpochId
+	}
+	return 0
+}
+
+// QueryEpochKeyChainProofResponse is response type for the Query/EpochKeyChainProof RPC method.
+type QueryEpochKeyChainProofResponse struct {
+	// proof links the trusted epoch to the target epoch
+	Proof EpochKeyChainProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+}
+
+func (m *QueryEpochKeyChainProofResponse) Reset()         { *m = QueryEpochKeyChainProofResponse{} }
+func (m *QueryEpochKeyChainProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochKeyChainProofResponse) ProtoMessage()    {}
+func (*QueryEpochKeyChainProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b19bd0a6a108cf6a, []int{9}
+}
+func (m *QueryEpochKeyChainProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochKeyChainProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochKeyChainProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochKeyChainProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochKeyChainProofResponse.Merge(m, src)
+}
+func (m *QueryEpochKeyChainProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochKeyChainProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochKeyChainProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochKeyChainProofResponse proto.InternalMessageInfo
+
+func (m *QueryEpochKeyChainProofResponse) GetProof() EpochKeyChainProof {
+	if m != nil {
+		return m.Proof
+	}
+	return EpochKeyChainProof{}
+}
+
+// QuerySigningSchemasRequest is request type for the Query/SigningSchemas RPC method.
+type QuerySigningSchemasRequest struct {
+}
+
+func (m *QuerySigningSchemasRequest) Reset()         { *m = QuerySigningSchemasRequest{} }
+func (m *QuerySigningSchemasRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningSchemasRequest) ProtoMessage()    {}
+func (*QuerySigningSchemasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b19bd0a6a108cf6a, []int{10}
+}
+func (m *QuerySigningSchemasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningSchemasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningSchemasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningSchemasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningSchemasRequest.Merge(m, src)
+}
+func (m *QuerySigningSchemasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningSchemasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningSchemasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningSchemasRequest proto.InternalMessageInfo
+
+// QuerySigningSchemasResponse is response type for the Query/SigningSchemas RPC method.
+type QuerySigningSchemasResponse struct {
+	// schemas contains every signing schema ordered by name
+	Schemas []SigningSchema `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas"`
+}
+
+func (m *QuerySigningSchemasResponse) Reset()         { *m = QuerySigningSchemasResponse{} }
+func (m *QuerySigningSchemasResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningSchemasResponse) ProtoMessage()    {}
+func (*QuerySigningSchemasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b19bd0a6a108cf6a, []int{11}
+}
+func (m *QuerySigningSchemasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningSchemasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningSchemasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningSchemasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningSchemasResponse.Merge(m, src)
+}
+func (m *QuerySigningSchemasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningSchemasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningSchemasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningSchemasResponse proto.InternalMessageInfo
+
+func (m *QuerySigningSchemasResponse) GetSchemas() []SigningSchema {
+	if m != nil {
+		return m.Schemas
+	}
+	return nil
+}
+
+// QuerySchemaSignaturesRequest is request type for the Query/SchemaSignatures RPC method.
+type QuerySchemaSignaturesRequest struct {
+	// schema_name identifies the signing schema
+	SchemaName string `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	// pagination defines an optional pagination for the request, ordered by sequence
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySchemaSignaturesRequest) Reset()         { *m = QuerySchemaSignaturesRequest{} }
+func (m *QuerySchemaSignaturesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySchemaSignaturesRequest) ProtoMessage()    {}
+func (*QuerySchemaSignaturesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b19bd0a6a108cf6a, []int{12}
+}
+func (m *QuerySchemaSignaturesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySchemaSignaturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySchemaSignaturesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySchemaSignaturesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySchemaSignaturesRequest.Merge(m, src)
+}
+func (m *QuerySchemaSignaturesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySchemaSignaturesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySchemaSignaturesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySchemaSignaturesRequest proto.InternalMessageInfo
+
+func (m *QuerySchemaSignaturesRequest) GetSchemaName() string {
+	if m != nil {
+		return m.SchemaName
+	}
+	return ""
+}
+
+func (m *QuerySchemaSignaturesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySchemaSignaturesResponse is response type for the Query/SchemaSignatures RPC method.
+type QuerySchemaSignaturesResponse struct {
+	// signatures contains the completed signing requests of the schema
+	Signatures []SchemaSigningRequest `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySchemaSignaturesResponse) Reset()         { *m = QuerySchemaSignaturesResponse{} }
+func (m *QuerySchemaSignaturesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySchemaSignaturesResponse) ProtoMessage()    {}
+func (*QuerySchemaSignaturesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b19bd0a6a108cf6a, []int{13}
+}
+func (m *QuerySchemaSignaturesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySchemaSignaturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySchemaSignaturesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySchemaSignaturesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySchemaSignaturesResponse.Merge(m, src)
+}
+func (m *QuerySchemaSignaturesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySchemaSignaturesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySchemaSignaturesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySchemaSignaturesResponse proto.InternalMessageInfo
+
+func (m *QuerySchemaSignaturesResponse) GetSignatures() []SchemaSigningRequest {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *QuerySchemaSignaturesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "inference.bls.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "inference.bls.QueryParamsResponse")
@@ -426,57 +720,81 @@ func init() {
 	proto.RegisterType((*QuerySigningStatusResponse)(nil), "inference.bls.QuerySigningStatusResponse")
 	proto.RegisterType((*QuerySigningHistoryRequest)(nil), "inference.bls.QuerySigningHistoryRequest")
 	proto.RegisterType((*QuerySigningHistoryResponse)(nil), "inference.bls.QuerySigningHistoryResponse")
+	proto.RegisterType((*QueryEpochKeyChainProofRequest)(nil), "inference.bls.QueryEpochKeyChainProofRequest")
+	proto.RegisterType((*QueryEpochKeyChainProofResponse)(nil), "inference.bls.QueryEpochKeyChainProofResponse")
+	proto.RegisterType((*QuerySigningSchemasRequest)(nil), "inference.bls.QuerySigningSchemasRequest")
+	proto.RegisterType((*QuerySigningSchemasResponse)(nil), "inference.bls.QuerySigningSchemasResponse")
+	proto.RegisterType((*QuerySchemaSignaturesRequest)(nil), "inference.bls.QuerySchemaSignaturesRequest")
+	proto.RegisterType((*QuerySchemaSignaturesResponse)(nil), "inference.bls.QuerySchemaSignaturesResponse")
 }
 
 func init() { proto.RegisterFile("inference/bls/query.proto", fileDescriptor_b19bd0a6a108cf6a) }
 
 var fileDescriptor_b19bd0a6a108cf6a = []byte{
-	// 709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0xbb, 0xc0, 0x8f, 0x9f, 0x8c, 0x50, 0x70, 0xc4, 0xa4, 0x2c, 0x5a, 0x71, 0x23, 0x50,
-	0x89, 0xee, 0x04, 0x08, 0x89, 0xca, 0x45, 0x1b, 0x41, 0x31, 0x26, 0x62, 0xd1, 0x98, 0x98, 0x98,
-	0x66, 0xba, 0x1d, 0xb6, 0x9b, 0xb4, 0x3b, 0xcb, 0xce, 0xd4, 0x48, 0x08, 0x17, 0x5f, 0x81, 0x89,
-	0x77, 0xaf, 0x7a, 0xf4, 0x1d, 0x78, 0xe5, 0x48, 0xe2, 0xc5, 0x78, 0x30, 0x06, 0x4c, 0xbc, 0xfb,
-	0x0a, 0xcc, 0xce, 0x3c, 0x6b, 0xbb, 0xeb, 0x5a, 0xea, 0xa5, 0x99, 0x3e, 0xf3, 0xfc, 0xf9, 0x3c,
-	0x4f, 0xbf, 0x4f, 0x07, 0x4d, 0x79, 0xfe, 0x36, 0x0b, 0x99, 0xef, 0x30, 0x52, 0x6b, 0x0a, 0xb2,
-	0xd3, 0x66, 0xe1, 0xae, 0x1d, 0x84, 0x5c, 0x72, 0x3c, 0xf6, 0xfb, 0xca, 0xae, 0x35, 0x85, 0x79,
-	0x86, 0xb6, 0x3c, 0x9f, 0x13, 0xf5, 0xa9, 0x3d, 0xcc, 0x49, 0x97, 0xbb, 0x5c, 0x1d, 0x49, 0x74,
-	0x02, 0xeb, 0x79, 0x97, 0x73, 0xb7, 0xc9, 0x08, 0x0d, 0x3c, 0x42, 0x7d, 0x9f, 0x4b, 0x2a, 0x3d,
-	0xee, 0x0b, 0xb8, 0x5d, 0x70, 0xb8, 0x68, 0x71, 0x41, 0x6a, 0x54, 0x30, 0x5d, 0x8e, 0xbc, 0x58,
-	0xac, 0x31, 0x49, 0x17, 0x49, 0x40, 0x5d, 0xcf, 0x57, 0xce, 0xe0, 0x6b, 0x26, 0xe1, 0x02, 0x1a,
-	0xd2, 0x56, 0x9c, 0x27, 0x05, 0x2e, 0x77, 0x03, 0x16, 0x5f, 0xcd, 0xa6, 0xae, 0x1a, 0x21, 0x13,
-	0x0d, 0xde, 0xac, 0x57, 0x85, 0xe7, 0xfa, 0x9e, 0xef, 0x6a, 0x37, 0x6b, 0x12, 0xe1, 0x47, 0x51,
-	0xfd, 0x4d, 0x95, 0xb6, 0xc2, 0x76, 0xda, 0x4c, 0x48, 0xeb, 0x21, 0x3a, 0x9b, 0xb0, 0x8a, 0x80,
-	0xfb, 0x82, 0xe1, 0xeb, 0x68, 0x58, 0x97, 0x2f, 0x18, 0x33, 0x46, 0xe9, 0xf4, 0xd2, 0x39, 0x3b,
-	0x31, 0x1d, 0x5b, 0xbb, 0x97, 0x47, 0x0e, 0xbe, 0x5e, 0xcc, 0xbd, 0xff, 0xf1, 0x61, 0xc1, 0xa8,
-	0x80, 0xbf, 0xb5, 0x82, 0x0a, 0x2a, 0xe1, 0x5a, 0xc0, 0x9d, 0x46, 0xf9, 0xc1, 0xd6, 0x1d, 0x2a,
-	0x29, 0x14, 0xc3, 0x53, 0xe8, 0x14, 0x8b, 0xcc, 0x55, 0xaf, 0xae, 0xf2, 0x0e, 0x55, 0xfe, 0x57,
-	0xdf, 0x37, 0xea, 0xd6, 0x73, 0x34, 0x95, 0x11, 0x06, 0x34, 0xb7, 0x10, 0xd2, 0x71, 0x75, 0x2a,
-	0x29, 0x10, 0x4d, 0xa7, 0x88, 0xba, 0x03, 0xcb, 0x43, 0x11, 0x57, 0x65, 0x44, 0x05, 0x45, 0x06,
-	0xeb, 0x26, 0xa4, 0xdf, 0xd2, 0x23, 0xd9, 0x92, 0x54, 0xb6, 0xe3, 0x19, 0xe0, 0x0b, 0x08, 0x85,
-	0xfa, 0x18, 0x83, 0x8d, 0x56, 0x46, 0xc0, 0xb2, 0x51, 0xb7, 0x04, 0x32, 0xb3, 0x62, 0x81, 0xed,
-	0x09, 0x1a, 0x87, 0x39, 0x57, 0x21, 0x04, 0x00, 0xe7, 0x52, 0x80, 0x8f, 0xe3, 0xdf, 0x05, 0xf2,
-	0x40, 0x75, 0x60, 0xcd, 0x8b, 0x84, 0xd5, 0xfa, 0x62, 0x24, 0xab, 0xde, 0xf3, 0x84, 0xe4, 0xe1,
-	0x6e, 0x8c, 0x5c, 0x42, 0x13, 0x4e, 0x3b, 0x0c, 0x99, 0x2f, 0xab, 0xa9, 0x89, 0xe6, 0xc1, 0xbe,
-	0xa6, 0x07, 0x8b, 0xef, 0xa3, 0x31, 0xa1, 0x88, 0xab, 0xdb, 0x5e, 0x53, 0xb2, 0xb0, 0x30, 0x30,
-	0x63, 0x94, 0xf2, 0x4b, 0xb3, 0x27, 0xd0, 0x41, 0x97, 0xa3, 0x3a, 0x76, 0x5d, 0x85, 0xe2, 0x75,
-	0x84, 0x3a, 0xa2, 0x2d, 0x0c, 0x42, 0x9b, 0x5a, 0xe1, 0x76, 0xa4, 0x70, 0x5b, 0x2f, 0x14, 0x28,
-	0xdc, 0xde, 0xa4, 0x2e, 0x03, 0xe2, 0x4a, 0x57, 0xa4, 0xf5, 0xd1, 0x40, 0xd3, 0x99, 0xcd, 0xc1,
-	0x4c, 0x9f, 0xa2, 0x89, 0xd4, 0x4c, 0x23, 0x1d, 0x0e, 0xfe, 0xf3, 0x50, 0xc7, 0x93, 0x43, 0x15,
-	0xf8, 0x6e, 0xa2, 0x81, 0x01, 0xd5, 0xc0, 0xfc, 0x89, 0x0d, 0x68, 0xaa, 0xee, 0x0e, 0x96, 0x7e,
-	0x0e, 0xa1, 0xff, 0x54, 0x07, 0x78, 0x1f, 0x0d, 0xeb, 0x65, 0xc0, 0x97, 0x52, 0x6c, 0x7f, 0x6e,
-	0x9b, 0x69, 0xf5, 0x72, 0xd1, 0x65, 0xac, 0xab, 0xaf, 0x3e, 0x7d, 0x7f, 0x33, 0x30, 0x87, 0x2f,
-	0x93, 0x20, 0xe4, 0xf5, 0xb6, 0x23, 0x85, 0xe3, 0xa9, 0xe5, 0xce, 0xfa, 0x77, 0xc0, 0x6f, 0x0d,
-	0x34, 0xda, 0x2d, 0x7d, 0x3c, 0x9f, 0x55, 0x22, 0x63, 0x19, 0xcd, 0xd2, 0xc9, 0x8e, 0x40, 0xb4,
-	0xaa, 0x88, 0x56, 0xf0, 0x72, 0x6f, 0xa2, 0xce, 0x8a, 0x92, 0xbd, 0x58, 0x94, 0xfb, 0xf8, 0x9d,
-	0x81, 0xc6, 0x12, 0x9a, 0xc2, 0x99, 0x85, 0xb3, 0x16, 0xd3, 0xbc, 0xd2, 0x87, 0x27, 0x30, 0xde,
-	0x56, 0x8c, 0xab, 0xf8, 0x46, 0x6f, 0xc6, 0x58, 0x56, 0x5a, 0xd6, 0x64, 0xaf, 0xb3, 0xf7, 0xfb,
-	0xd1, 0x28, 0xf3, 0x49, 0x41, 0xe2, 0x5e, 0x00, 0xc9, 0x8d, 0x34, 0x17, 0xfa, 0x71, 0x05, 0xd8,
-	0x15, 0x05, 0x4b, 0xf0, 0xb5, 0xfe, 0x60, 0x1b, 0x3a, 0xbc, 0xbc, 0x71, 0x70, 0x54, 0x34, 0x0e,
-	0x8f, 0x8a, 0xc6, 0xb7, 0xa3, 0xa2, 0xf1, 0xfa, 0xb8, 0x98, 0x3b, 0x3c, 0x2e, 0xe6, 0x3e, 0x1f,
-	0x17, 0x73, 0xcf, 0x88, 0xeb, 0xc9, 0x46, 0xbb, 0x66, 0x3b, 0xbc, 0xf5, 0xf7, 0x94, 0x2f, 0x3b,
-	0x2f, 0x47, 0x6d, 0x58, 0xbd, 0x09, 0xcb, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x08, 0xd8,
-	0x20, 0x10, 0x07, 0x00, 0x00,
+	// 1008 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x69, 0x9b, 0xe2, 0xd7, 0xfc, 0x62, 0x28, 0x52, 0xb2, 0x4d, 0x9d, 0xb2, 0xb4,
+	0x69, 0x08, 0xad, 0x57, 0x4d, 0x15, 0x09, 0x28, 0x15, 0xc5, 0x90, 0xd2, 0x00, 0x82, 0xe0, 0x80,
+	0x90, 0x40, 0x68, 0x19, 0xaf, 0x27, 0xeb, 0x15, 0xf6, 0xce, 0x76, 0x67, 0xb6, 0xc2, 0x8a, 0x72,
+	0xe1, 0x82, 0xc4, 0x09, 0x89, 0x3b, 0x57, 0x38, 0x22, 0xc1, 0x15, 0x71, 0xed, 0xb1, 0x12, 0x17,
+	0xc4, 0x01, 0xa1, 0x04, 0x89, 0x7f, 0x03, 0xed, 0xcc, 0x5b, 0xdb, 0xb3, 0xd9, 0x38, 0x2e, 0xea,
+	0x25, 0xda, 0xbc, 0x79, 0x6f, 0xde, 0xe7, 0xbd, 0x79, 0xf3, 0x1d, 0xc3, 0x62, 0x18, 0xed, 0xb2,
+	0x84, 0x45, 0x3e, 0x73, 0x9b, 0x1d, 0xe1, 0xde, 0x4f, 0x59, 0xd2, 0xab, 0xc5, 0x09, 0x97, 0x9c,
+	0xcc, 0xf4, 0x97, 0x6a, 0xcd, 0x8e, 0xb0, 0x9f, 0xa6, 0xdd, 0x30, 0xe2, 0xae, 0xfa, 0xab, 0x3d,
+	0xec, 0xf3, 0x01, 0x0f, 0xb8, 0xfa, 0x74, 0xb3, 0x2f, 0xb4, 0x2e, 0x05, 0x9c, 0x07, 0x1d, 0xe6,
+	0xd2, 0x38, 0x74, 0x69, 0x14, 0x71, 0x49, 0x65, 0xc8, 0x23, 0x81, 0xab, 0x6b, 0x3e, 0x17, 0x5d,
+	0x2e, 0xdc, 0x26, 0x15, 0x4c, 0xa7, 0x73, 0x1f, 0xdc, 0x68, 0x32, 0x49, 0x6f, 0xb8, 0x31, 0x0d,
+	0xc2, 0x48, 0x39, 0xa3, 0xaf, 0x6d, 0xc2, 0xc5, 0x34, 0xa1, 0xdd, 0x7c, 0x9f, 0x02, 0xb8, 0xec,
+	0xc5, 0x2c, 0x5f, 0xba, 0x52, 0x58, 0x6a, 0x27, 0x4c, 0xb4, 0x79, 0xa7, 0xe5, 0x89, 0x30, 0x88,
+	0xc2, 0x28, 0x40, 0xb7, 0xcb, 0xa6, 0x5b, 0x90, 0xf0, 0x34, 0xf6, 0x1e, 0xd0, 0x4e, 0xd8, 0x1a,
+	0x66, 0x70, 0x4c, 0x2f, 0xdc, 0xc2, 0x13, 0x7e, 0x9b, 0x75, 0xa9, 0xf6, 0x71, 0xce, 0x03, 0xf9,
+	0x20, 0xab, 0x64, 0x5b, 0x01, 0x36, 0xd8, 0xfd, 0x94, 0x09, 0xe9, 0xbc, 0x0f, 0xcf, 0x18, 0x56,
+	0x11, 0xf3, 0x48, 0x30, 0xf2, 0x12, 0x4c, 0xe9, 0x42, 0x16, 0xac, 0x4b, 0xd6, 0xea, 0xb9, 0xf5,
+	0x67, 0x6b, 0x46, 0x9f, 0x6b, 0xda, 0xbd, 0x5e, 0x79, 0xf8, 0xd7, 0xf2, 0xc4, 0x8f, 0xff, 0xfe,
+	0xb4, 0x66, 0x35, 0xd0, 0xdf, 0xd9, 0x80, 0x05, 0xb5, 0xe1, 0x66, 0xcc, 0xfd, 0x76, 0xfd, 0xdd,
+	0x9d, 0x37, 0xa9, 0xa4, 0x98, 0x8c, 0x2c, 0xc2, 0x53, 0x2c, 0x33, 0x7b, 0x61, 0x4b, 0xed, 0x7b,
+	0xba, 0x71, 0x56, 0xfd, 0xbf, 0xd5, 0x72, 0x3e, 0x83, 0xc5, 0x92, 0x30, 0xa4, 0xb9, 0x03, 0xa0,
+	0xe3, 0x5a, 0x54, 0x52, 0x24, 0xba, 0x50, 0x20, 0x1a, 0x0e, 0xac, 0x9f, 0xce, 0xb8, 0x1a, 0x15,
+	0x15, 0x94, 0x19, 0x9c, 0x57, 0x70, 0xfb, 0x1d, 0xdd, 0x99, 0x1d, 0x49, 0x65, 0x9a, 0xf7, 0x80,
+	0x5c, 0x04, 0x48, 0xf4, 0x67, 0x0e, 0x36, 0xdd, 0xa8, 0xa0, 0x65, 0xab, 0xe5, 0x08, 0xb0, 0xcb,
+	0x62, 0x91, 0xed, 0x23, 0x98, 0xcb, 0xdb, 0x8d, 0x21, 0x08, 0xb8, 0x52, 0x00, 0xfc, 0x30, 0x3f,
+	0x61, 0xdc, 0x07, 0xb3, 0x23, 0xeb, 0xac, 0x30, 0xac, 0xce, 0x9f, 0x96, 0x99, 0xf5, 0x5e, 0x28,
+	0x24, 0x4f, 0x7a, 0x39, 0xf2, 0x2a, 0xcc, 0xfb, 0x69, 0x92, 0xb0, 0x48, 0x7a, 0x85, 0x8e, 0xce,
+	0xa2, 0x7d, 0x53, 0x37, 0x96, 0xbc, 0x0d, 0x33, 0x42, 0x11, 0x7b, 0xbb, 0x61, 0x47, 0xb2, 0x64,
+	0x61, 0xf2, 0x92, 0xb5, 0x3a, 0xbb, 0x7e, 0xe5, 0x04, 0x3a, 0xac, 0x72, 0x5a, 0xc7, 0xde, 0x55,
+	0xa1, 0xe4, 0x2e, 0xc0, 0x60, 0xfc, 0x17, 0x4e, 0x61, 0x99, 0xfa, 0xae, 0xd4, 0xb2, 0xbb, 0x52,
+	0xd3, 0x57, 0x13, 0xef, 0x4a, 0x6d, 0x9b, 0x06, 0x0c, 0x89, 0x1b, 0x43, 0x91, 0xce, 0x6f, 0x16,
+	0x5c, 0x28, 0x2d, 0x0e, 0x7b, 0xfa, 0x31, 0xcc, 0x17, 0x7a, 0x9a, 0xcd, 0xe1, 0xa9, 0xc7, 0x6e,
+	0xea, 0x9c, 0xd9, 0x54, 0x41, 0xde, 0x32, 0x0a, 0x98, 0x54, 0x05, 0x5c, 0x3d, 0xb1, 0x00, 0x4d,
+	0x65, 0x54, 0x90, 0x40, 0x75, 0x30, 0xae, 0xef, 0xb0, 0xde, 0x1b, 0x6d, 0x1a, 0x46, 0xdb, 0x09,
+	0xe7, 0xbb, 0x43, 0x27, 0x24, 0x93, 0x54, 0x48, 0xd6, 0x3a, 0x72, 0x42, 0x68, 0xcf, 0x4f, 0x68,
+	0x05, 0xe6, 0x24, 0x4d, 0x02, 0x36, 0x74, 0x94, 0x93, 0xca, 0x71, 0x46, 0x9b, 0xd1, 0xcf, 0xf9,
+	0x1c, 0x96, 0x8f, 0xcd, 0x89, 0x8d, 0xbb, 0x0d, 0x67, 0xe2, 0xcc, 0x80, 0x23, 0xf8, 0x5c, 0xd9,
+	0x1d, 0x31, 0x22, 0xb1, 0x51, 0x3a, 0xca, 0x59, 0x2a, 0x4c, 0xba, 0x92, 0x8f, 0xbe, 0x54, 0x7c,
+	0x6a, 0x1e, 0x5a, 0x7f, 0x15, 0x73, 0xbf, 0x0a, 0x67, 0xb5, 0xde, 0xe4, 0x67, 0xb5, 0x54, 0xc8,
+	0x6e, 0xc4, 0x61, 0xe2, 0x3c, 0xc4, 0xf9, 0xda, 0x82, 0x25, 0xbd, 0xbb, 0x32, 0x64, 0xbe, 0x54,
+	0xa6, 0x09, 0xeb, 0x5f, 0xd2, 0x65, 0x38, 0xa7, 0x7d, 0xbd, 0x88, 0x76, 0x99, 0x2a, 0xb0, 0xd2,
+	0x00, 0x6d, 0x7a, 0x8f, 0x76, 0x59, 0x61, 0x38, 0x27, 0xff, 0xf7, 0x70, 0xfe, 0x6c, 0xc1, 0xc5,
+	0x63, 0x48, 0xb0, 0xd2, 0x2d, 0x00, 0xd1, 0xb7, 0x62, 0xb1, 0xcf, 0x17, 0x8b, 0xed, 0x07, 0x1f,
+	0x99, 0xca, 0xa1, 0xe0, 0x27, 0x36, 0x90, 0xeb, 0xdf, 0x54, 0xe0, 0x8c, 0xa2, 0x26, 0xfb, 0x30,
+	0xa5, 0xd5, 0x99, 0x14, 0x8f, 0xff, 0xa8, 0xfc, 0xdb, 0xce, 0x28, 0x17, 0x9d, 0xc6, 0xb9, 0xf6,
+	0xd5, 0xef, 0xff, 0x7c, 0x37, 0xb9, 0x42, 0x2e, 0xbb, 0x71, 0xc2, 0x5b, 0xa9, 0x2f, 0x85, 0x1f,
+	0xaa, 0xa7, 0xa6, 0xec, 0xe1, 0x23, 0xdf, 0x5b, 0x30, 0x3d, 0xac, 0xc5, 0xe4, 0x6a, 0x59, 0x8a,
+	0x92, 0xd7, 0xc1, 0x5e, 0x3d, 0xd9, 0x11, 0x89, 0x6e, 0x29, 0xa2, 0x0d, 0x72, 0x73, 0x34, 0xd1,
+	0xe0, 0xcd, 0x70, 0xf7, 0xf2, 0xab, 0xb5, 0x4f, 0x7e, 0xb0, 0x60, 0xc6, 0x10, 0x39, 0x52, 0x9a,
+	0xb8, 0xec, 0xa5, 0xb0, 0x5f, 0x18, 0xc3, 0x13, 0x19, 0x5f, 0x57, 0x8c, 0xb7, 0xc8, 0xcb, 0xa3,
+	0x19, 0xfb, 0x4f, 0xb5, 0x8a, 0x76, 0xf7, 0x06, 0x0f, 0xd1, 0x7e, 0xd6, 0xca, 0x59, 0x53, 0x21,
+	0xc9, 0x28, 0x00, 0xf3, 0x89, 0xb0, 0xd7, 0xc6, 0x71, 0x45, 0xd8, 0x0d, 0x05, 0xeb, 0x92, 0xeb,
+	0xe3, 0xc1, 0xb6, 0x91, 0xe6, 0x57, 0x0b, 0xc8, 0x51, 0x4d, 0x21, 0xd7, 0x8f, 0x3d, 0xc8, 0x32,
+	0xa5, 0xb4, 0x6b, 0xe3, 0xba, 0x23, 0xec, 0x3d, 0x05, 0x5b, 0x27, 0x77, 0x46, 0xc3, 0x7e, 0xc1,
+	0x7a, 0x9e, 0x9f, 0x45, 0x7b, 0x4a, 0xdc, 0xdc, 0xbd, 0xa2, 0x1c, 0x1b, 0x0d, 0x46, 0x35, 0x1b,
+	0xd9, 0x60, 0x53, 0x0f, 0x47, 0x36, 0xb8, 0x20, 0x8e, 0x8f, 0xdb, 0x60, 0x54, 0x45, 0xf2, 0x8b,
+	0x05, 0xf3, 0x45, 0x19, 0x22, 0x2f, 0x96, 0xe6, 0x2d, 0x97, 0x4d, 0xfb, 0xda, 0x78, 0xce, 0x88,
+	0xb9, 0xa9, 0x30, 0x5f, 0x23, 0xb7, 0x4f, 0xc0, 0xd4, 0x42, 0x3c, 0xd0, 0x31, 0x77, 0x6f, 0x48,
+	0x9b, 0xf7, 0xeb, 0x5b, 0x0f, 0x0f, 0xaa, 0xd6, 0xa3, 0x83, 0xaa, 0xf5, 0xf7, 0x41, 0xd5, 0xfa,
+	0xf6, 0xb0, 0x3a, 0xf1, 0xe8, 0xb0, 0x3a, 0xf1, 0xc7, 0x61, 0x75, 0xe2, 0x13, 0x37, 0x08, 0x65,
+	0x3b, 0x6d, 0xd6, 0x7c, 0xde, 0x3d, 0x3e, 0xc5, 0x97, 0x83, 0x1f, 0xcb, 0xcd, 0x29, 0xf5, 0xe3,
+	0xf5, 0xe6, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x60, 0x67, 0xab, 0x38, 0x03, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -499,6 +817,12 @@ type QueryClient interface {
 	SigningStatus(ctx context.Context, in *QuerySigningStatusRequest, opts ...grpc.CallOption) (*QuerySigningStatusResponse, error)
 	// SigningHistory queries threshold signing requests with filtering and pagination
 	SigningHistory(ctx context.Context, in *QuerySigningHistoryRequest, opts ...grpc.CallOption) (*QuerySigningHistoryResponse, error)
+	// EpochKeyChainProof queries the chain of validated group keys from a trusted epoch
+	EpochKeyChainProof(ctx context.Context, in *QueryEpochKeyChainProofRequest, opts ...grpc.CallOption) (*QueryEpochKeyChainProofResponse, error)
+	// SigningSchemas queries the signing schemas whitelisted by governance
+	SigningSchemas(ctx context.Context, in *QuerySigningSchemasRequest, opts ...grpc.CallOption) (*QuerySigningSchemasResponse, error)
+	// SchemaSignatures queries the completed signatures of a signing schema with pagination
+	SchemaSignatures(ctx context.Context, in *QuerySchemaSignaturesRequest, opts ...grpc.CallOption) (*QuerySchemaSignaturesResponse, error)
 }
 
 type queryClient struct {
@@ -545,6 +869,33 @@ func (c *queryClient) SigningHistory(ctx context.Context, in *QuerySigningHistor
 	return out, nil
 }
 
+func (c *queryClient) EpochKeyChainProof(ctx context.Context, in *QueryEpochKeyChainProofRequest, opts ...grpc.CallOption) (*QueryEpochKeyChainProofResponse, error) {
+	out := new(QueryEpochKeyChainProofResponse)
+	err := c.cc.Invoke(ctx, "/inference.bls.Query/EpochKeyChainProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningSchemas(ctx context.Context, in *QuerySigningSchemasRequest, opts ...grpc.CallOption) (*QuerySigningSchemasResponse, error) {
+	out := new(QuerySigningSchemasResponse)
+	err := c.cc.Invoke(ctx, "/inference.bls.Query/SigningSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SchemaSignatures(ctx context.Context, in *QuerySchemaSignaturesRequest, opts ...grpc.CallOption) (*QuerySchemaSignaturesResponse, error) {
+	out := new(QuerySchemaSignaturesResponse)
+	err := c.cc.Invoke(ctx, "/inference.bls.Query/SchemaSignatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -555,6 +906,12 @@ type QueryServer interface {
 	SigningStatus(context.Context, *QuerySigningStatusRequest) (*QuerySigningStatusResponse, error)
 	// SigningHistory queries threshold signing requests with filtering and pagination
 	SigningHistory(context.Context, *QuerySigningHistoryRequest) (*QuerySigningHistoryResponse, error)
+	// EpochKeyChainProof queries the chain of validated group keys from a trusted epoch
+	EpochKeyChainProof(context.Context, *QueryEpochKeyChainProofRequest) (*QueryEpochKeyChainProofResponse, error)
+	// SigningSchemas queries the signing schemas whitelisted by governance
+	SigningSchemas(context.Context, *QuerySigningSchemasRequest) (*QuerySigningSchemasResponse, error)
+	// SchemaSignatures queries the completed signatures of a signing schema with pagination
+	SchemaSignatures(context.Context, *QuerySchemaSignaturesRequest) (*QuerySchemaSignaturesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -573,6 +930,15 @@ func (*UnimplementedQueryServer) SigningStatus(ctx context.Context, req *QuerySi
 func (*UnimplementedQueryServer) SigningHistory(ctx context.Context, req *QuerySigningHistoryRequest) (*QuerySigningHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningHistory not implemented")
 }
+func (*UnimplementedQueryServer) EpochKeyChainProof(ctx context.Context, req *QueryEpochKeyChainProofRequest) (*QueryEpochKeyChainProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochKeyChainProof not implemented")
+}
+func (*UnimplementedQueryServer) SigningSchemas(ctx context.Context, req *QuerySigningSchemasRequest) (*QuerySigningSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningSchemas not implemented")
+}
+func (*UnimplementedQueryServer) SchemaSignatures(ctx context.Context, req *QuerySchemaSignaturesRequest) (*QuerySchemaSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchemaSignatures not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -650,6 +1016,60 @@ func _Query_SigningHistory_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochKeyChainProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochKeyChainProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochKeyChainProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.bls.Query/EpochKeyChainProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochKeyChainProof(ctx, req.(*QueryEpochKeyChainProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.bls.Query/SigningSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningSchemas(ctx, req.(*QuerySigningSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SchemaSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySchemaSignaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SchemaSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.bls.Query/SchemaSignatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SchemaSignatures(ctx, req.(*QuerySchemaSignaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.bls.Query",
@@ -671,6 +1091,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningHistory",
 			Handler:    _Query_SigningHistory_Handler,
 		},
+		{
+			MethodName: "EpochKeyChainProof",
+			Handler:    _Query_EpochKeyChainProof_Handler,
+		},
+		{
+			MethodName: "SigningSchemas",
+			Handler:    _Query_SigningSchemas_Handler,
+		},
+		{
+			MethodName: "SchemaSignatures",
+			Handler:    _Query_SchemaSignatures_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/bls/query.proto",
@@ -950,24 +1382,241 @@ func (m *QuerySigningHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryEpochKeyChainProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryEpochKeyChainProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochKeyChainProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetEpochId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TargetEpochId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TrustedEpochId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TrustedEpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochKeyChainProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochKeyChainProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochKeyChainProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningSchemasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningSchemasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningSchemasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningSchemasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningSchemasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningSchemasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schemas) > 0 {
+		for iNdEx := len(m.Schemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySchemaSignaturesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySchemaSignaturesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySchemaSignaturesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SchemaName) > 0 {
+		i -= len(m.SchemaName)
+		copy(dAtA[i:], m.SchemaName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SchemaName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySchemaSignaturesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySchemaSignaturesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySchemaSignaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryParamsResponse) Size() (n int) {
@@ -1059,20 +1708,558 @@ func (m *QuerySigningHistoryResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochKeyChainProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TrustedEpochId != 0 {
+		n += 1 + sovQuery(uint64(m.TrustedEpochId))
+	}
+	if m.TargetEpochId != 0 {
+		n += 1 + sovQuery(uint64(m.TargetEpochId))
+	}
+	return n
+}
+
+func (m *QueryEpochKeyChainProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningSchemasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySigningSchemasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schemas) > 0 {
+		for _, e := range m.Schemas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySchemaSignaturesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SchemaName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySchemaSignaturesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochBLSDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochBLSDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochBLSDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochBLSDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochBLSDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochBLSDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = append(m.RequestId[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestId == nil {
+				m.RequestId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SigningRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1095,12 +2282,86 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochId", wireType)
+			}
+			m.CurrentEpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusFilter", wireType)
+			}
+			m.StatusFilter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusFilter |= ThresholdSigningStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1122,7 +2383,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1145,15 +2406,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1180,7 +2441,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SigningRequests = append(m.SigningRequests, ThresholdSigningRequest{})
+			if err := m.SigningRequests[len(m.SigningRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1205,7 +2503,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochBLSDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochKeyChainProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1228,17 +2526,17 @@ func (m *QueryEpochBLSDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochBLSDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochKeyChainProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochBLSDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochKeyChainProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedEpochId", wireType)
 			}
-			m.EpochId = 0
+			m.TrustedEpochId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1248,7 +2546,26 @@ func (m *QueryEpochBLSDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochId |= uint64(b&0x7F) << shift
+				m.TrustedEpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetEpochId", wireType)
+			}
+			m.TargetEpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetEpochId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1274,7 +2591,7 @@ func (m *QueryEpochBLSDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochBLSDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochKeyChainProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1297,15 +2614,15 @@ func (m *QueryEpochBLSDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochBLSDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochKeyChainProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochBLSDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochKeyChainProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1332,7 +2649,7 @@ func (m *QueryEpochBLSDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1357,7 +2674,7 @@ func (m *QueryEpochBLSDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningSchemasRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1380,46 +2697,12 @@ func (m *QuerySigningStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningSchemasRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningSchemasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RequestId = append(m.RequestId[:0], dAtA[iNdEx:postIndex]...)
-			if m.RequestId == nil {
-				m.RequestId = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1441,7 +2724,7 @@ func (m *QuerySigningStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningSchemasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1464,15 +2747,15 @@ func (m *QuerySigningStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningSchemasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningSchemasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schemas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1499,7 +2782,8 @@ func (m *QuerySigningStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SigningRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schemas = append(m.Schemas, SigningSchema{})
+			if err := m.Schemas[len(m.Schemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1524,7 +2808,7 @@ func (m *QuerySigningStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySchemaSignaturesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1547,17 +2831,17 @@ func (m *QuerySigningHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySchemaSignaturesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySchemaSignaturesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaName", wireType)
 			}
-			m.CurrentEpochId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1567,31 +2851,25 @@ func (m *QuerySigningHistoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentEpochId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusFilter", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.StatusFilter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StatusFilter |= ThresholdSigningStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1648,7 +2926,7 @@ func (m *QuerySigningHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySchemaSignaturesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1671,15 +2949,15 @@ func (m *QuerySigningHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySchemaSignaturesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySchemaSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1706,8 +2984,8 @@ func (m *QuerySigningHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SigningRequests = append(m.SigningRequests, ThresholdSigningRequest{})
-			if err := m.SigningRequests[len(m.SigningRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Signatures = append(m.Signatures, SchemaSigningRequest{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
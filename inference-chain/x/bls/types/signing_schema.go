@@ -0,0 +1,96 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"regexp"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// MaxSchemaFields bounds the number of 32-byte fields a signing schema payload can have
+const MaxSchemaFields = 32
+
+// MaxSigningDeadlineBlocks bounds the deadline of a schema signing request, both for schemas that set no
+// max_deadline_blocks and for the schema limits themselves
+const MaxSigningDeadlineBlocks int64 = 100_000
+
+var schemaNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// SchemaDomain returns the 32-byte domain separator that prefixes every payload signed under a schema,
+// keccak256("SIGNING_SCHEMA:" || name). Verifiers check it to know which schema a signature was made for.
+func SchemaDomain(name string) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte("SIGNING_SCHEMA:" + name))
+	return hash.Sum(nil)
+}
+
+// SchemaSigningRequestID derives the request ID of the sequence-th request, keccak256(domain || sequence)
+func SchemaSigningRequestID(name string, sequence uint64) []byte {
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(SchemaDomain(name))
+	hash.Write(sequenceBytes)
+	return hash.Sum(nil)
+}
+
+// Validate performs basic validation of a signing schema
+func (s SigningSchema) Validate() error {
+	if !schemaNameRegexp.MatchString(s.Name) {
+		return fmt.Errorf("schema name %q must be lowercase letters, digits and underscores, starting with a letter", s.Name)
+	}
+	if len(s.Fields) == 0 || len(s.Fields) > MaxSchemaFields {
+		return fmt.Errorf("schema must have between 1 and %d fields", MaxSchemaFields)
+	}
+	names := make(map[string]bool, len(s.Fields))
+	for _, field := range s.Fields {
+		if field.Name == "" {
+			return fmt.Errorf("schema field name cannot be empty")
+		}
+		if names[field.Name] {
+			return fmt.Errorf("duplicate schema field %q", field.Name)
+		}
+		names[field.Name] = true
+		if _, ok := SchemaFieldType_name[int32(field.Type)]; !ok {
+			return fmt.Errorf("schema field %q has unknown type %d", field.Name, field.Type)
+		}
+	}
+	// A schema without a fee leaves the coin empty
+	if s.Fee.Denom != "" || !s.Fee.Amount.IsNil() {
+		if err := s.Fee.Validate(); err != nil {
+			return fmt.Errorf("invalid fee: %w", err)
+		}
+	}
+	if s.DefaultDeadlineBlocks < 0 || s.MaxDeadlineBlocks < 0 {
+		return fmt.Errorf("deadlines cannot be negative")
+	}
+	if s.DefaultDeadlineBlocks > MaxSigningDeadlineBlocks || s.MaxDeadlineBlocks > MaxSigningDeadlineBlocks {
+		return fmt.Errorf("deadlines cannot exceed %d blocks", MaxSigningDeadlineBlocks)
+	}
+	if s.MaxDeadlineBlocks != 0 && s.DefaultDeadlineBlocks > s.MaxDeadlineBlocks {
+		return fmt.Errorf("default_deadline_blocks %d exceeds max_deadline_blocks %d", s.DefaultDeadlineBlocks, s.MaxDeadlineBlocks)
+	}
+	return nil
+}
+
+// ValidatePayload checks that data has one 32-byte chunk per schema field and that every chunk is a valid
+// value of its field type
+func (s SigningSchema) ValidatePayload(data [][]byte) error {
+	if len(data) != len(s.Fields) {
+		return fmt.Errorf("schema %s expects %d fields, got %d", s.Name, len(s.Fields), len(data))
+	}
+	for i, field := range s.Fields {
+		if len(data[i]) != 32 {
+			return fmt.Errorf("field %s must be 32 bytes, got %d", field.Name, len(data[i]))
+		}
+		if field.Type == SchemaFieldType_SCHEMA_FIELD_TYPE_ADDRESS {
+			for _, b := range data[i][:12] {
+				if b != 0 {
+					return fmt.Errorf("field %s must be a 20-byte address left-padded with zeros", field.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1649 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/bls/signing_schema.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SchemaFieldType defines how a 32-byte payload field of a signing schema is interpreted
+type SchemaFieldType int32
+
+const (
+	// BYTES32 is an opaque 32-byte value
+	SchemaFieldType_SCHEMA_FIELD_TYPE_BYTES32 SchemaFieldType = 0
+	// UINT256 is a big-endian unsigned integer
+	SchemaFieldType_SCHEMA_FIELD_TYPE_UINT256 SchemaFieldType = 1
+	// ADDRESS is a 20-byte address left-padded with zeros
+	SchemaFieldType_SCHEMA_FIELD_TYPE_ADDRESS SchemaFieldType = 2
+)
+
+var SchemaFieldType_name = map[int32]string{
+	0: "SCHEMA_FIELD_TYPE_BYTES32",
+	1: "SCHEMA_FIELD_TYPE_UINT256",
+	2: "SCHEMA_FIELD_TYPE_ADDRESS",
+}
+
+var SchemaFieldType_value = map[string]int32{
+	"SCHEMA_FIELD_TYPE_BYTES32": 0,
+	"SCHEMA_FIELD_TYPE_UINT256": 1,
+	"SCHEMA_FIELD_TYPE_ADDRESS": 2,
+}
+
+func (x SchemaFieldType) String() string {
+	return proto.EnumName(SchemaFieldType_name, int32(x))
+}
+
+func (SchemaFieldType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4c17608b0a7dc627, []int{0}
+}
+
+// SchemaField is one 32-byte field of a signing schema payload
+type SchemaField struct {
+	// name identifies the field for requesters and verifiers
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type defines how the field is validated
+	Type SchemaFieldType `protobuf:"varint,2,opt,name=type,proto3,enum=inference.bls.SchemaFieldType" json:"type,omitempty"`
+}
+
+func (m *SchemaField) Reset()         { *m = SchemaField{} }
+func (m *SchemaField) String() string { return proto.CompactTextString(m) }
+func (*SchemaField) ProtoMessage()    {}
+func (*SchemaField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c17608b0a7dc627, []int{0}
+}
+func (m *SchemaField) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchemaField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchemaField.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchemaField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaField.Merge(m, src)
+}
+func (m *SchemaField) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchemaField) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaField proto.InternalMessageInfo
+
+func (m *SchemaField) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SchemaField) GetType() SchemaFieldType {
+	if m != nil {
+		return m.Type
+	}
+	return SchemaFieldType_SCHEMA_FIELD_TYPE_BYTES32
+}
+
+// SigningSchema is a governance-whitelisted payload layout that anyone can request a threshold signature for.
+// The signed data is keccak256("SIGNING_SCHEMA:" || name) followed by one 32-byte chunk per field.
+type SigningSchema struct {
+	// name uniquely identifies the schema (lowercase letters, digits and underscores)
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description explains what a signature under this schema attests
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// fields is the ordered payload layout
+	Fields []SchemaField `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields"`
+	// fee is charged to the requester of every signature and sent to the fee collector
+	Fee types.Coin `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee"`
+	// default_deadline_blocks is used when a request does not set a deadline (0 means the module signing_deadline_blocks)
+	DefaultDeadlineBlocks int64 `protobuf:"varint,5,opt,name=default_deadline_blocks,json=defaultDeadlineBlocks,proto3" json:"default_deadline_blocks,omitempty"`
+	// max_deadline_blocks caps the deadline a request can ask for (0 means no cap)
+	MaxDeadlineBlocks int64 `protobuf:"varint,6,opt,name=max_deadline_blocks,json=maxDeadlineBlocks,proto3" json:"max_deadline_blocks,omitempty"`
+	// max_retries caps how many times an expired request can be re-issued
+	MaxRetries uint32 `protobuf:"varint,7,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// enabled allows new requests; disabled schemas keep their signature history
+	Enabled bool `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *SigningSchema) Reset()         { *m = SigningSchema{} }
+func (m *SigningSchema) String() string { return proto.CompactTextString(m) }
+func (*SigningSchema) ProtoMessage()    {}
+func (*SigningSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c17608b0a7dc627, []int{1}
+}
+func (m *SigningSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningSchema.Merge(m, src)
+}
+func (m *SigningSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningSchema proto.InternalMessageInfo
+
+func (m *SigningSchema) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SigningSchema) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SigningSchema) GetFields() []SchemaField {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *SigningSchema) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *SigningSchema) GetDefaultDeadlineBlocks() int64 {
+	if m != nil {
+		return m.DefaultDeadlineBlocks
+	}
+	return 0
+}
+
+func (m *SigningSchema) GetMaxDeadlineBlocks() int64 {
+	if m != nil {
+		return m.MaxDeadlineBlocks
+	}
+	return 0
+}
+
+func (m *SigningSchema) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *SigningSchema) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// SchemaSigningRequest tracks a threshold signature requested under a signing schema
+type SchemaSigningRequest struct {
+	// request_id is the threshold signing request ID, keccak256(schema domain || sequence)
+	RequestId []byte `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// schema_name is the schema the payload follows
+	SchemaName string `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	// requester is the address that paid for the signature
+	Requester string `protobuf:"bytes,3,opt,name=requester,proto3" json:"requester,omitempty"`
+	// sequence numbers schema signing requests across all schemas
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// data is the payload, one 32-byte chunk per schema field
+	Data [][]byte `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	// fee_paid is the fee charged when the request was made
+	FeePaid types.Coin `protobuf:"bytes,6,opt,name=fee_paid,json=feePaid,proto3" json:"fee_paid"`
+	// deadline_blocks is the number of blocks each signing round can take
+	DeadlineBlocks int64 `protobuf:"varint,7,opt,name=deadline_blocks,json=deadlineBlocks,proto3" json:"deadline_blocks,omitempty"`
+	// max_retries is how many times the request is re-issued after expiring
+	MaxRetries uint32 `protobuf:"varint,8,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// retries_used is how many times the request has been re-issued
+	RetriesUsed uint32 `protobuf:"varint,9,opt,name=retries_used,json=retriesUsed,proto3" json:"retries_used,omitempty"`
+	// status mirrors the status of the current threshold signing round
+	Status ThresholdSigningStatus `protobuf:"varint,10,opt,name=status,proto3,enum=inference.bls.ThresholdSigningStatus" json:"status,omitempty"`
+	// epoch_id is the epoch whose group key signs the current round
+	EpochId uint64 `protobuf:"varint,11,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// final_signature is the aggregated BLS signature once completed
+	FinalSignature []byte `protobuf:"bytes,12,opt,name=final_signature,json=finalSignature,proto3" json:"final_signature,omitempty"`
+	// created_block_height is the block height when the request was made
+	CreatedBlockHeight int64 `protobuf:"varint,13,opt,name=created_block_height,json=createdBlockHeight,proto3" json:"created_block_height,omitempty"`
+	// completed_block_height is the block height when the request completed, failed or ran out of retries
+	CompletedBlockHeight int64 `protobuf:"varint,14,opt,name=completed_block_height,json=completedBlockHeight,proto3" json:"completed_block_height,omitempty"`
+}
+
+func (m *SchemaSigningRequest) Reset()         { *m = SchemaSigningRequest{} }
+func (m *SchemaSigningRequest) String() string { return proto.CompactTextString(m) }
+func (*SchemaSigningRequest) ProtoMessage()    {}
+func (*SchemaSigningRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c17608b0a7dc627, []int{2}
+}
+func (m *SchemaSigningRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchemaSigningRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchemaSigningRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchemaSigningRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaSigningRequest.Merge(m, src)
+}
+func (m *SchemaSigningRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchemaSigningRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaSigningRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaSigningRequest proto.InternalMessageInfo
+
+func (m *SchemaSigningRequest) GetRequestId() []byte {
+	if m != nil {
+		return m.RequestId
+	}
+	return nil
+}
+
+func (m *SchemaSigningRequest) GetSchemaName() string {
+	if m != nil {
+		return m.SchemaName
+	}
+	return ""
+}
+
+func (m *SchemaSigningRequest) GetRequester() string {
+	if m != nil {
+		return m.Requester
+	}
+	return ""
+}
+
+func (m *SchemaSigningRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *SchemaSigningRequest) GetData() [][]byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *SchemaSigningRequest) GetFeePaid() types.Coin {
+	if m != nil {
+		return m.FeePaid
+	}
+	return types.Coin{}
+}
+
+func (m *SchemaSigningRequest) GetDeadlineBlocks() int64 {
+	if m != nil {
+		return m.DeadlineBlocks
+	}
+	return 0
+}
+
+func (m *SchemaSigningRequest) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *SchemaSigningRequest) GetRetriesUsed() uint32 {
+	if m != nil {
+		return m.RetriesUsed
+	}
+	return 0
+}
+
+func (m *SchemaSigningRequest) GetStatus() ThresholdSigningStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_UNDEFINED
+}
+
+func (m *SchemaSigningRequest) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *SchemaSigningRequest) GetFinalSignature() []byte {
+	if m != nil {
+		return m.FinalSignature
+	}
+	return nil
+}
+
+func (m *SchemaSigningRequest) GetCreatedBlockHeight() int64 {
+	if m != nil {
+		return m.CreatedBlockHeight
+	}
+	return 0
+}
+
+func (m *SchemaSigningRequest) GetCompletedBlockHeight() int64 {
+	if m != nil {
+		return m.CompletedBlockHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("inference.bls.SchemaFieldType", SchemaFieldType_name, SchemaFieldType_value)
+	proto.RegisterType((*SchemaField)(nil), "inference.bls.SchemaField")
+	proto.RegisterType((*SigningSchema)(nil), "inference.bls.SigningSchema")
+	proto.RegisterType((*SchemaSigningRequest)(nil), "inference.bls.SchemaSigningRequest")
+}
+
+func init() {
+	proto.RegisterFile("inference/bls/signing_schema.proto", fileDescriptor_4c17608b0a7dc627)
+}
+
+var fileDescriptor_4c17608b0a7dc627 = []byte{
+	// 751 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0xeb, 0x44,
+	0x14, 0x8e, 0x9b, 0xdc, 0xfc, 0x4c, 0x7e, 0x6e, 0xef, 0x10, 0xc0, 0x8d, 0xb8, 0xbe, 0x26, 0xd2,
+	0x15, 0xd6, 0x5d, 0xd8, 0xdc, 0x5c, 0xa8, 0x10, 0x12, 0x8b, 0xa6, 0x49, 0xd5, 0x48, 0x50, 0x55,
+	0x4e, 0xba, 0x28, 0x1b, 0x6b, 0xec, 0x39, 0x49, 0x46, 0xd8, 0x1e, 0xe3, 0x99, 0xa0, 0xf4, 0x2d,
+	0x78, 0x0c, 0x96, 0x2c, 0x79, 0x84, 0x2e, 0xbb, 0x44, 0x2c, 0x10, 0x6a, 0x17, 0xbc, 0x06, 0xf2,
+	0xd8, 0xe9, 0x4f, 0x5a, 0xba, 0xb1, 0xce, 0x9c, 0xef, 0xfb, 0x7c, 0xe6, 0x7c, 0x67, 0x66, 0x50,
+	0x9f, 0xc5, 0x73, 0x48, 0x21, 0x0e, 0xc0, 0xf1, 0x43, 0xe1, 0x08, 0xb6, 0x88, 0x59, 0xbc, 0xf0,
+	0x44, 0xb0, 0x84, 0x88, 0xd8, 0x49, 0xca, 0x25, 0xc7, 0xed, 0x5b, 0x8e, 0xed, 0x87, 0xa2, 0xf7,
+	0x8a, 0x44, 0x2c, 0xe6, 0x8e, 0xfa, 0xe6, 0x8c, 0x9e, 0x11, 0x70, 0x11, 0x71, 0xe1, 0xf8, 0x44,
+	0x80, 0xf3, 0xcb, 0x7b, 0x1f, 0x24, 0x79, 0xef, 0x04, 0x9c, 0xc5, 0x05, 0xde, 0x5d, 0xf0, 0x05,
+	0x57, 0xa1, 0x93, 0x45, 0x45, 0xf6, 0xed, 0xc3, 0xda, 0x72, 0x99, 0x82, 0x58, 0xf2, 0x90, 0x7a,
+	0xc5, 0x2e, 0x72, 0x5a, 0xff, 0x0c, 0x35, 0xa7, 0x6a, 0x3b, 0x47, 0x0c, 0x42, 0x8a, 0x31, 0xaa,
+	0xc4, 0x24, 0x02, 0x5d, 0x33, 0x35, 0xab, 0xe1, 0xaa, 0x18, 0x0f, 0x50, 0x45, 0x5e, 0x24, 0xa0,
+	0xef, 0x98, 0x9a, 0xd5, 0x19, 0x18, 0xf6, 0x83, 0x0d, 0xdb, 0xf7, 0xd4, 0xb3, 0x8b, 0x04, 0x5c,
+	0xc5, 0xed, 0xff, 0xb5, 0x83, 0xda, 0xd3, 0xbc, 0x50, 0x4e, 0x78, 0xf2, 0xcf, 0x26, 0x6a, 0x52,
+	0x10, 0x41, 0xca, 0x12, 0xc9, 0x78, 0xac, 0x0a, 0x34, 0xdc, 0xfb, 0x29, 0xfc, 0x0d, 0xaa, 0xce,
+	0xb3, 0x5f, 0x0b, 0xbd, 0x6c, 0x96, 0xad, 0xe6, 0xa0, 0xf7, 0xff, 0xd5, 0x87, 0x95, 0xcb, 0xbf,
+	0xdf, 0x94, 0xdc, 0x82, 0x8f, 0xf7, 0x51, 0x79, 0x0e, 0xa0, 0x57, 0x4c, 0xcd, 0x6a, 0x0e, 0xf6,
+	0xec, 0xdc, 0x43, 0x3b, 0xf3, 0xd0, 0x2e, 0x3c, 0xb4, 0x0f, 0x39, 0x8b, 0x87, 0x8d, 0x4c, 0xf5,
+	0xdb, 0xbf, 0xbf, 0xbf, 0xd3, 0xdc, 0x4c, 0x80, 0xf7, 0xd1, 0xa7, 0x14, 0xe6, 0x64, 0x15, 0x4a,
+	0x8f, 0x02, 0xa1, 0x21, 0x8b, 0xc1, 0xf3, 0x43, 0x1e, 0xfc, 0x24, 0xf4, 0x17, 0xa6, 0x66, 0x95,
+	0xdd, 0x8f, 0x0b, 0x78, 0x54, 0xa0, 0x43, 0x05, 0x62, 0x1b, 0x7d, 0x14, 0x91, 0xf5, 0x23, 0x4d,
+	0x55, 0x69, 0x5e, 0x45, 0x64, 0xbd, 0xc5, 0x7f, 0x83, 0x9a, 0x19, 0x3f, 0x05, 0x99, 0x32, 0x10,
+	0x7a, 0xcd, 0xd4, 0xac, 0xb6, 0x8b, 0x22, 0xb2, 0x76, 0xf3, 0x0c, 0xd6, 0x51, 0x0d, 0x62, 0xe2,
+	0x87, 0x40, 0xf5, 0xba, 0xa9, 0x59, 0x75, 0x77, 0xb3, 0xec, 0xff, 0x51, 0x41, 0xdd, 0xbc, 0xf1,
+	0xc2, 0x62, 0x17, 0x7e, 0x5e, 0x81, 0x90, 0xf8, 0x35, 0x42, 0x69, 0x1e, 0x7a, 0x8c, 0x2a, 0xa7,
+	0x5b, 0x6e, 0xa3, 0xc8, 0x4c, 0x68, 0x56, 0x32, 0x3f, 0x7a, 0x9e, 0x9a, 0x44, 0x6e, 0x37, 0xca,
+	0x53, 0x27, 0xd9, 0x3c, 0x3e, 0x43, 0x1b, 0x36, 0xa4, 0x7a, 0x59, 0xc1, 0x77, 0x09, 0xdc, 0x43,
+	0x75, 0x91, 0x2d, 0xe2, 0x20, 0xb7, 0xb5, 0xe2, 0xde, 0xae, 0xb3, 0xe9, 0x52, 0x22, 0x89, 0xfe,
+	0xc2, 0x2c, 0x5b, 0x2d, 0x57, 0xc5, 0xf8, 0x5b, 0x54, 0x9f, 0x03, 0x78, 0x09, 0x61, 0x54, 0xd9,
+	0xf0, 0xec, 0x18, 0xf2, 0xe1, 0xd5, 0xe6, 0x00, 0xa7, 0x84, 0x51, 0xfc, 0x05, 0x7a, 0xb9, 0xed,
+	0x64, 0x4d, 0x39, 0xd9, 0xa1, 0xcf, 0xda, 0x58, 0x7f, 0x64, 0xe3, 0xe7, 0xa8, 0x55, 0x80, 0xde,
+	0x4a, 0x00, 0xd5, 0x1b, 0x8a, 0xd1, 0x2c, 0x72, 0x67, 0x02, 0x28, 0xfe, 0x0e, 0x55, 0x85, 0x24,
+	0x72, 0x25, 0x74, 0xa4, 0x8e, 0xf8, 0xdb, 0xad, 0x43, 0x36, 0xdb, 0xdc, 0x9d, 0xcd, 0x89, 0x56,
+	0x64, 0xb7, 0x10, 0xe1, 0x3d, 0x54, 0x87, 0x84, 0x07, 0xcb, 0xcc, 0xf3, 0xa6, 0xf2, 0xa5, 0xa6,
+	0xd6, 0x13, 0xd5, 0xc6, 0x9c, 0xc5, 0x24, 0x54, 0x97, 0x8e, 0xc8, 0x55, 0x0a, 0x7a, 0x4b, 0x4d,
+	0xa5, 0xa3, 0xd2, 0xd3, 0x4d, 0x16, 0x7f, 0x89, 0xba, 0x41, 0x0a, 0x44, 0x02, 0xcd, 0xdb, 0xf5,
+	0x96, 0xc0, 0x16, 0x4b, 0xa9, 0xb7, 0x55, 0xd3, 0xb8, 0xc0, 0x54, 0xcf, 0xc7, 0x0a, 0xc1, 0x5f,
+	0xa1, 0x4f, 0x02, 0x1e, 0x25, 0x21, 0x3c, 0xd2, 0x74, 0x94, 0xa6, 0x7b, 0x8b, 0xde, 0x53, 0xbd,
+	0x8b, 0xd1, 0xcb, 0xad, 0x0b, 0x8b, 0x5f, 0xa3, 0xbd, 0xe9, 0xe1, 0xf1, 0xf8, 0x87, 0x03, 0xef,
+	0x68, 0x32, 0xfe, 0x7e, 0xe4, 0xcd, 0xce, 0x4f, 0xc7, 0xde, 0xf0, 0x7c, 0x36, 0x9e, 0x7e, 0x18,
+	0xec, 0x96, 0x9e, 0x86, 0xcf, 0x26, 0x27, 0xb3, 0xc1, 0xd7, 0xfb, 0xbb, 0xda, 0xd3, 0xf0, 0xc1,
+	0x68, 0xe4, 0x8e, 0xa7, 0xd3, 0xdd, 0x9d, 0xe1, 0xe4, 0xf2, 0xda, 0xd0, 0xae, 0xae, 0x0d, 0xed,
+	0x9f, 0x6b, 0x43, 0xfb, 0xf5, 0xc6, 0x28, 0x5d, 0xdd, 0x18, 0xa5, 0x3f, 0x6f, 0x8c, 0xd2, 0x8f,
+	0xce, 0x82, 0xc9, 0xe5, 0xca, 0xb7, 0x03, 0x1e, 0x39, 0x49, 0xca, 0xe9, 0x2a, 0x90, 0x22, 0x60,
+	0xea, 0xbd, 0xba, 0x7b, 0xb9, 0xd6, 0xf9, 0xdb, 0x75, 0x91, 0x80, 0xf0, 0xab, 0xea, 0xc1, 0xfa,
+	0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe1, 0xed, 0xd8, 0x7c, 0x55, 0x05, 0x00, 0x00,
+}
+
+func (m *SchemaField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaField) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchemaField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		i = encodeVarintSigningSchema(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSigningSchema(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintSigningSchema(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxDeadlineBlocks != 0 {
+		i = encodeVarintSigningSchema(dAtA, i, uint64(m.MaxDeadlineBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DefaultDeadlineBlocks != 0 {
+		i = encodeVarintSigningSchema(dAtA, i, uint64(m.DefaultDeadlineBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSigningSchema(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSigningSchema(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSigningSchema(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSigningSchema(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchemaSigningRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaSigningRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchemaSigningRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletedBlockHeight != 0 {
+		i = encodeVarintSigningSchema(dAtA, i, uint64(m.CompletedBlockHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.CreatedBlockHeight != 0 {
+		i = encodeVarintSigningSchema(dAtA, i, uint64(m.CreatedBlockHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.FinalSignature) > 0 {
+		i -= len(m.FinalSignature)
+		copy(dAtA[i:], m.FinalSignature)
+		i = encodeVarintSigningSchema(dAtA, i, uint64(len(m.FinalSignature)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintSigningSchema(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Status != 0 {
+		i = encodeVarintSigningSchema(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RetriesUsed != 0 {
+		i = encodeVarintSigningSchema(dAtA, i, uint64(m.RetriesUsed))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintSigningSchema(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DeadlineBlocks != 0 {
+		i = encodeVarintSigningSchema(dAtA, i, uint64(m.DeadlineBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.FeePaid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSigningSchema(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Data[iNdEx])
+			copy(dAtA[i:], m.Data[iNdEx])
+			i = encodeVarintSigningSchema(dAtA, i, uint64(len(m.Data[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintSigningSchema(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintSigningSchema(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SchemaName) > 0 {
+		i -= len(m.SchemaName)
+		copy(dAtA[i:], m.SchemaName)
+		i = encodeVarintSigningSchema(dAtA, i, uint64(len(m.SchemaName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintSigningSchema(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSigningSchema(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSigningSchema(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SchemaField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSigningSchema(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovSigningSchema(uint64(m.Type))
+	}
+	return n
+}
+
+func (m *SigningSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSigningSchema(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSigningSchema(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovSigningSchema(uint64(l))
+		}
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovSigningSchema(uint64(l))
+	if m.DefaultDeadlineBlocks != 0 {
+		n += 1 + sovSigningSchema(uint64(m.DefaultDeadlineBlocks))
+	}
+	if m.MaxDeadlineBlocks != 0 {
+		n += 1 + sovSigningSchema(uint64(m.MaxDeadlineBlocks))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovSigningSchema(uint64(m.MaxRetries))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *SchemaSigningRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovSigningSchema(uint64(l))
+	}
+	l = len(m.SchemaName)
+	if l > 0 {
+		n += 1 + l + sovSigningSchema(uint64(l))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovSigningSchema(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovSigningSchema(uint64(m.Sequence))
+	}
+	if len(m.Data) > 0 {
+		for _, b := range m.Data {
+			l = len(b)
+			n += 1 + l + sovSigningSchema(uint64(l))
+		}
+	}
+	l = m.FeePaid.Size()
+	n += 1 + l + sovSigningSchema(uint64(l))
+	if m.DeadlineBlocks != 0 {
+		n += 1 + sovSigningSchema(uint64(m.DeadlineBlocks))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovSigningSchema(uint64(m.MaxRetries))
+	}
+	if m.RetriesUsed != 0 {
+		n += 1 + sovSigningSchema(uint64(m.RetriesUsed))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSigningSchema(uint64(m.Status))
+	}
+	if m.EpochId != 0 {
+		n += 1 + sovSigningSchema(uint64(m.EpochId))
+	}
+	l = len(m.FinalSignature)
+	if l > 0 {
+		n += 1 + l + sovSigningSchema(uint64(l))
+	}
+	if m.CreatedBlockHeight != 0 {
+		n += 1 + sovSigningSchema(uint64(m.CreatedBlockHeight))
+	}
+	if m.CompletedBlockHeight != 0 {
+		n += 1 + sovSigningSchema(uint64(m.CompletedBlockHeight))
+	}
+	return n
+}
+
+func sovSigningSchema(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSigningSchema(x uint64) (n int) {
+	return sovSigningSchema(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SchemaField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigningSchema
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchemaField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchemaField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= SchemaFieldType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigningSchema(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigningSchema
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, SchemaField{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDeadlineBlocks", wireType)
+			}
+			m.DefaultDeadlineBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultDeadlineBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeadlineBlocks", wireType)
+			}
+			m.MaxDeadlineBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeadlineBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigningSchema(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchemaSigningRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigningSchema
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchemaSigningRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchemaSigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = append(m.RequestId[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestId == nil {
+				m.RequestId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
+			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineBlocks", wireType)
+			}
+			m.DeadlineBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesUsed", wireType)
+			}
+			m.RetriesUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesUsed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ThresholdSigningStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalSignature = append(m.FinalSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalSignature == nil {
+				m.FinalSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBlockHeight", wireType)
+			}
+			m.CreatedBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedBlockHeight", wireType)
+			}
+			m.CompletedBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigningSchema(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigningSchema
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSigningSchema(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSigningSchema
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigningSchema
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSigningSchema
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSigningSchema
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSigningSchema
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSigningSchema        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSigningSchema          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSigningSchema = fmt.Errorf("proto: unexpected end of group")
+)
@@ -689,6 +689,238 @@ func (m *MsgRequestThresholdSignatureResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRequestThresholdSignatureResponse proto.InternalMessageInfo
 
+// MsgSetSigningSchema adds a signing schema or replaces the one with the same name
+type MsgSetSigningSchema struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// schema is the signing schema to store
+	Schema SigningSchema `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema"`
+}
+
+func (m *MsgSetSigningSchema) Reset()         { *m = MsgSetSigningSchema{} }
+func (m *MsgSetSigningSchema) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSigningSchema) ProtoMessage()    {}
+func (*MsgSetSigningSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b0e6f51d329716, []int{12}
+}
+func (m *MsgSetSigningSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSigningSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSigningSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSigningSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSigningSchema.Merge(m, src)
+}
+func (m *MsgSetSigningSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSigningSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSigningSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSigningSchema proto.InternalMessageInfo
+
+func (m *MsgSetSigningSchema) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetSigningSchema) GetSchema() SigningSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return SigningSchema{}
+}
+
+// MsgSetSigningSchemaResponse defines the response structure for executing a
+// MsgSetSigningSchema message.
+type MsgSetSigningSchemaResponse struct {
+}
+
+func (m *MsgSetSigningSchemaResponse) Reset()         { *m = MsgSetSigningSchemaResponse{} }
+func (m *MsgSetSigningSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSigningSchemaResponse) ProtoMessage()    {}
+func (*MsgSetSigningSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b0e6f51d329716, []int{13}
+}
+func (m *MsgSetSigningSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSigningSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSigningSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSigningSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSigningSchemaResponse.Merge(m, src)
+}
+func (m *MsgSetSigningSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSigningSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSigningSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSigningSchemaResponse proto.InternalMessageInfo
+
+// MsgRequestSchemaSignature requests a threshold signature over a payload of a whitelisted schema.
+// The schema fee is charged to the creator.
+type MsgRequestSchemaSignature struct {
+	// creator is the address of the user requesting and paying for the signature
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// schema_name identifies the signing schema the payload follows
+	SchemaName string `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	// data is the payload, one 32-byte chunk per schema field
+	Data [][]byte `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// deadline_blocks is the number of blocks each signing round can take (0 means the schema default)
+	DeadlineBlocks int64 `protobuf:"varint,4,opt,name=deadline_blocks,json=deadlineBlocks,proto3" json:"deadline_blocks,omitempty"`
+	// max_retries is how many times the request is re-issued if it expires, up to the schema maximum
+	MaxRetries uint32 `protobuf:"varint,5,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+}
+
+func (m *MsgRequestSchemaSignature) Reset()         { *m = MsgRequestSchemaSignature{} }
+func (m *MsgRequestSchemaSignature) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestSchemaSignature) ProtoMessage()    {}
+func (*MsgRequestSchemaSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b0e6f51d329716, []int{14}
+}
+func (m *MsgRequestSchemaSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestSchemaSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestSchemaSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestSchemaSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestSchemaSignature.Merge(m, src)
+}
+func (m *MsgRequestSchemaSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestSchemaSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestSchemaSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestSchemaSignature proto.InternalMessageInfo
+
+func (m *MsgRequestSchemaSignature) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRequestSchemaSignature) GetSchemaName() string {
+	if m != nil {
+		return m.SchemaName
+	}
+	return ""
+}
+
+func (m *MsgRequestSchemaSignature) GetData() [][]byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *MsgRequestSchemaSignature) GetDeadlineBlocks() int64 {
+	if m != nil {
+		return m.DeadlineBlocks
+	}
+	return 0
+}
+
+func (m *MsgRequestSchemaSignature) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+// MsgRequestSchemaSignatureResponse defines the response structure for executing a
+// MsgRequestSchemaSignature message.
+type MsgRequestSchemaSignatureResponse struct {
+	// request_id identifies the threshold signing request
+	RequestId []byte `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// sequence is the sequence number of the schema signing request
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgRequestSchemaSignatureResponse) Reset()         { *m = MsgRequestSchemaSignatureResponse{} }
+func (m *MsgRequestSchemaSignatureResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestSchemaSignatureResponse) ProtoMessage()    {}
+func (*MsgRequestSchemaSignatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b0e6f51d329716, []int{15}
+}
+func (m *MsgRequestSchemaSignatureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestSchemaSignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestSchemaSignatureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestSchemaSignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestSchemaSignatureResponse.Merge(m, src)
+}
+func (m *MsgRequestSchemaSignatureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestSchemaSignatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestSchemaSignatureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestSchemaSignatureResponse proto.InternalMessageInfo
+
+func (m *MsgRequestSchemaSignatureResponse) GetRequestId() []byte {
+	if m != nil {
+		return m.RequestId
+	}
+	return nil
+}
+
+func (m *MsgRequestSchemaSignatureResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "inference.bls.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.bls.MsgUpdateParamsResponse")
@@ -702,69 +934,85 @@ func init() {
 	proto.RegisterType((*MsgSubmitPartialSignatureResponse)(nil), "inference.bls.MsgSubmitPartialSignatureResponse")
 	proto.RegisterType((*MsgRequestThresholdSignature)(nil), "inference.bls.MsgRequestThresholdSignature")
 	proto.RegisterType((*MsgRequestThresholdSignatureResponse)(nil), "inference.bls.MsgRequestThresholdSignatureResponse")
+	proto.RegisterType((*MsgSetSigningSchema)(nil), "inference.bls.MsgSetSigningSchema")
+	proto.RegisterType((*MsgSetSigningSchemaResponse)(nil), "inference.bls.MsgSetSigningSchemaResponse")
+	proto.RegisterType((*MsgRequestSchemaSignature)(nil), "inference.bls.MsgRequestSchemaSignature")
+	proto.RegisterType((*MsgRequestSchemaSignatureResponse)(nil), "inference.bls.MsgRequestSchemaSignatureResponse")
 }
 
 func init() { proto.RegisterFile("inference/bls/tx.proto", fileDescriptor_06b0e6f51d329716) }
 
 var fileDescriptor_06b0e6f51d329716 = []byte{
-	// 902 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0xe3, 0xec, 0xa6, 0x49, 0x5e, 0xb6, 0x6d, 0x6a, 0x4a, 0xbb, 0x6b, 0xe8, 0x76, 0xe3,
-	0x40, 0x59, 0x92, 0xb0, 0x86, 0x4d, 0x85, 0xd0, 0x02, 0x07, 0x22, 0x02, 0x8a, 0x50, 0xa4, 0xc8,
-	0x81, 0x1c, 0xb8, 0x58, 0xb3, 0xf6, 0xc4, 0x3b, 0xd2, 0xda, 0x63, 0x66, 0xc6, 0x6d, 0x17, 0xa9,
-	0x12, 0xe2, 0x06, 0x27, 0x24, 0xc4, 0xff, 0xc0, 0x31, 0x07, 0x24, 0x0e, 0xfc, 0x03, 0x3d, 0x16,
-	0xb8, 0x70, 0x42, 0x28, 0x39, 0xe4, 0x7f, 0xe0, 0x84, 0x3c, 0xfe, 0x91, 0x66, 0x36, 0xde, 0x84,
-	0x40, 0x2f, 0xbb, 0x9e, 0xf7, 0xbe, 0x6f, 0xe6, 0xfb, 0x3e, 0x9a, 0x19, 0x1b, 0x6e, 0x91, 0x70,
-	0x1f, 0x33, 0x1c, 0xba, 0xd8, 0xea, 0x0f, 0xb9, 0x25, 0x1e, 0x75, 0x22, 0x46, 0x05, 0xd5, 0xaf,
-	0x16, 0xf1, 0x4e, 0x7f, 0xc8, 0x8d, 0x1b, 0x28, 0x20, 0x21, 0xb5, 0xe4, 0x6f, 0xaa, 0x30, 0x6e,
-	0xbb, 0x94, 0x07, 0x94, 0x5b, 0x01, 0xf7, 0xad, 0x07, 0x6f, 0x25, 0x7f, 0x59, 0xa2, 0x91, 0x26,
-	0x1c, 0x39, 0xb2, 0xd2, 0x41, 0x96, 0xba, 0xe9, 0x53, 0x9f, 0xa6, 0xf1, 0xe4, 0x29, 0x8b, 0x1a,
-	0xa7, 0x3d, 0x44, 0x88, 0xa1, 0x20, 0xaf, 0x68, 0x28, 0xfe, 0x46, 0x11, 0xce, 0x52, 0xe6, 0xcf,
-	0x1a, 0x5c, 0xdf, 0xe6, 0xfe, 0x67, 0x91, 0x87, 0x04, 0xde, 0x91, 0x45, 0xfa, 0xdb, 0x30, 0x8f,
-	0x62, 0x31, 0xa0, 0x8c, 0x88, 0x51, 0x5d, 0x6b, 0x69, 0xed, 0xf9, 0x8d, 0xfa, 0x6f, 0x3f, 0xbd,
-	0x71, 0x33, 0x73, 0xf1, 0x81, 0xe7, 0x31, 0xcc, 0xf9, 0xae, 0x60, 0x24, 0xf4, 0xed, 0x13, 0xa9,
-	0xfe, 0x0e, 0x5c, 0x49, 0x97, 0xad, 0x4f, 0xb7, 0xb4, 0xf6, 0x42, 0xf7, 0xc5, 0xce, 0xa9, 0xfe,
-	0x3b, 0xe9, 0xf4, 0x1b, 0xf3, 0x4f, 0xfe, 0xbc, 0x3b, 0xf5, 0xe3, 0xf1, 0xc1, 0x8a, 0x66, 0x67,
-	0xfa, 0x5e, 0xf7, 0xeb, 0xe3, 0x83, 0x95, 0x93, 0x99, 0xbe, 0x3d, 0x3e, 0x58, 0xb9, 0x7b, 0xe2,
-	0xf9, 0x91, 0x74, 0xad, 0xb8, 0x34, 0x1b, 0x70, 0x5b, 0x09, 0xd9, 0x98, 0x47, 0x34, 0xe4, 0xd8,
-	0xfc, 0x65, 0x1a, 0x5e, 0xd8, 0xe6, 0xfe, 0x6e, 0xdc, 0x0f, 0x88, 0xf8, 0x10, 0xa3, 0x21, 0x66,
-	0x3b, 0x88, 0x09, 0xbd, 0x0b, 0xb3, 0x2e, 0xc3, 0x48, 0x50, 0x76, 0x6e, 0x5b, 0xb9, 0x50, 0x6f,
-	0xc0, 0x1c, 0x8e, 0xa8, 0x3b, 0x70, 0x88, 0x27, 0xdb, 0xaa, 0xda, 0xb3, 0x72, 0xbc, 0xe5, 0xe9,
-	0x2d, 0x58, 0x70, 0x69, 0x10, 0x10, 0x11, 0xe0, 0x50, 0xf0, 0x7a, 0xa5, 0x55, 0x69, 0xd7, 0xec,
-	0x67, 0x43, 0xfa, 0x63, 0x58, 0xc2, 0xa1, 0xcb, 0x46, 0x91, 0xc0, 0x9e, 0xc3, 0x07, 0x88, 0x61,
-	0xee, 0xec, 0x53, 0xe6, 0x44, 0x88, 0x09, 0xe2, 0x92, 0x08, 0x25, 0x75, 0xd5, 0x56, 0xa5, 0xbd,
-	0xd0, 0x5d, 0x53, 0x60, 0x6d, 0xe6, 0x75, 0xbb, 0xb2, 0xec, 0x23, 0x2a, 0x5b, 0xc8, 0x8a, 0x36,
-	0xaa, 0x09, 0x43, 0xbb, 0x89, 0x27, 0x89, 0x78, 0xef, 0x7e, 0x82, 0x35, 0xef, 0x24, 0x81, 0xba,
-	0x7c, 0x06, 0x54, 0x95, 0x92, 0x79, 0x07, 0x5e, 0x3a, 0x23, 0x5c, 0xc0, 0xfd, 0x5d, 0x7b, 0x26,
-	0xbf, 0x87, 0x19, 0xd9, 0x27, 0x2e, 0x12, 0x84, 0x86, 0x7b, 0xd8, 0x4d, 0x80, 0xfd, 0xcf, 0x90,
-	0x5f, 0x83, 0xeb, 0x9e, 0x34, 0xe1, 0x3c, 0x40, 0x43, 0xe2, 0x25, 0x5b, 0x32, 0x01, 0x3d, 0x67,
-	0x5f, 0x4b, 0xc3, 0x7b, 0x59, 0xb4, 0xf7, 0x9e, 0xda, 0xec, 0x6a, 0x69, 0xb3, 0xe3, 0xae, 0xcd,
-	0x57, 0x61, 0x79, 0x42, 0xba, 0x68, 0xfe, 0xfb, 0x69, 0x78, 0xa5, 0xd0, 0x7d, 0xcc, 0x68, 0x1c,
-	0x7d, 0x82, 0x47, 0xd2, 0x82, 0x54, 0xef, 0x12, 0x3f, 0x44, 0x22, 0x66, 0xf8, 0x52, 0x14, 0x5a,
-	0x50, 0x0b, 0xf1, 0x43, 0x47, 0x21, 0x01, 0x21, 0x7e, 0xb8, 0x99, 0xc1, 0x58, 0x82, 0x1a, 0x1f,
-	0x52, 0xe1, 0x90, 0xd0, 0x23, 0x2e, 0x4e, 0xb7, 0xdc, 0x55, 0x7b, 0x21, 0x89, 0x6d, 0xa5, 0x21,
-	0x7d, 0x15, 0x6e, 0xc8, 0xdd, 0x85, 0x86, 0x0e, 0xcf, 0xdd, 0xd4, 0xab, 0x2d, 0xad, 0x5d, 0xb3,
-	0x17, 0xb3, 0x44, 0xe1, 0xb2, 0xb7, 0xa9, 0x32, 0xbb, 0x5f, 0xca, 0x6c, 0x42, 0xb3, 0x66, 0x07,
-	0xd6, 0x2e, 0xa2, 0x2b, 0x28, 0xfe, 0xad, 0x41, 0xa3, 0x28, 0xd8, 0x51, 0x4c, 0x5d, 0x0a, 0xdd,
-	0x1d, 0x00, 0x86, 0xbf, 0x88, 0x31, 0x17, 0x39, 0xb8, 0x9a, 0x3d, 0x9f, 0x45, 0x9e, 0x03, 0xb7,
-	0x9e, 0xca, 0xed, 0xf5, 0x52, 0x6e, 0x6a, 0x7b, 0xe6, 0x32, 0x2c, 0x95, 0x26, 0x0b, 0x42, 0xdf,
-	0x4c, 0xc3, 0xcb, 0xdb, 0xdc, 0xb7, 0xd3, 0x0e, 0x3e, 0x1d, 0x30, 0xcc, 0x07, 0x74, 0xe8, 0xfd,
-	0x37, 0x48, 0x6d, 0x58, 0x74, 0x63, 0xc6, 0x70, 0x28, 0xd4, 0x3d, 0x76, 0x2d, 0x8b, 0xe7, 0xfb,
-	0xac, 0x01, 0x73, 0xee, 0x00, 0x91, 0x30, 0x51, 0x54, 0x24, 0x83, 0x59, 0x39, 0xde, 0xf2, 0x14,
-	0xd2, 0x55, 0x95, 0xb4, 0x0e, 0x55, 0x0f, 0x09, 0x54, 0x9f, 0x91, 0x97, 0xa1, 0x7c, 0xee, 0xbd,
-	0xaf, 0xd2, 0x5a, 0x3b, 0x83, 0x56, 0x69, 0xab, 0xe6, 0x3d, 0x79, 0xe4, 0x4a, 0xf3, 0x39, 0xb3,
-	0xee, 0xaf, 0x33, 0x50, 0xd9, 0xe6, 0xbe, 0xbe, 0x07, 0xb5, 0x53, 0xaf, 0xb3, 0xa6, 0x72, 0xb3,
-	0x2a, 0x6f, 0x0d, 0xe3, 0xde, 0xe4, 0x7c, 0x3e, 0xbf, 0xde, 0x87, 0xc5, 0xb1, 0x37, 0x8a, 0x39,
-	0x5e, 0xab, 0x6a, 0x8c, 0x95, 0xf3, 0x35, 0xc5, 0x1a, 0x5f, 0x42, 0xbd, 0xf4, 0x62, 0x2d, 0x9d,
-	0x67, 0x5c, 0x6b, 0x74, 0x2f, 0xae, 0x2d, 0xd6, 0xfe, 0x41, 0x83, 0xa5, 0xf3, 0x2f, 0xb6, 0xf5,
-	0xb2, 0x99, 0x27, 0x14, 0x19, 0xef, 0x5e, 0xa2, 0xa8, 0xf0, 0x25, 0xe0, 0x56, 0xc9, 0x4d, 0xd1,
-	0x2e, 0x9b, 0x56, 0x55, 0x1a, 0x6f, 0x5e, 0x54, 0x59, 0xac, 0xfa, 0x18, 0x1a, 0xe5, 0xa7, 0x6f,
-	0x75, 0x7c, 0xba, 0x52, 0xb1, 0xb1, 0xfe, 0x2f, 0xc4, 0xf9, 0xf2, 0xc6, 0xcc, 0x57, 0xc9, 0x07,
-	0xd2, 0xc6, 0xd6, 0x93, 0xc3, 0xa6, 0xf6, 0xf4, 0xb0, 0xa9, 0xfd, 0x75, 0xd8, 0xd4, 0xbe, 0x3b,
-	0x6a, 0x4e, 0x3d, 0x3d, 0x6a, 0x4e, 0xfd, 0x71, 0xd4, 0x9c, 0xfa, 0xdc, 0xf2, 0x89, 0x18, 0xc4,
-	0xfd, 0x8e, 0x4b, 0x03, 0x2b, 0x62, 0xd4, 0x8b, 0x5d, 0xc1, 0x5d, 0x22, 0xcf, 0x94, 0x7a, 0xba,
-	0xe4, 0xf7, 0x5e, 0xff, 0x8a, 0xfc, 0xe0, 0x5b, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x31,
-	0x77, 0x84, 0xad, 0x0a, 0x00, 0x00,
+	// 1100 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xc6, 0xce, 0xaf, 0x17, 0xa7, 0x4d, 0x4d, 0x69, 0xed, 0xa5, 0x75, 0x9c, 0x0d, 0x14,
+	0x93, 0x04, 0x1b, 0x9c, 0xaa, 0x42, 0x06, 0x84, 0x88, 0x08, 0x28, 0x42, 0x41, 0xd1, 0x1a, 0x72,
+	0xe0, 0xc0, 0x6a, 0xbc, 0x3b, 0x59, 0x8f, 0xf0, 0xee, 0x2c, 0x33, 0xe3, 0x36, 0x41, 0xaa, 0x84,
+	0xb8, 0xd1, 0x13, 0x12, 0xe2, 0x7f, 0x40, 0xe2, 0x92, 0x03, 0x12, 0x07, 0xee, 0xa8, 0xc7, 0x0a,
+	0x2e, 0x9c, 0x10, 0x4a, 0x0e, 0xf9, 0x1f, 0x38, 0xa1, 0x9d, 0xfd, 0x91, 0x7a, 0xec, 0x75, 0x42,
+	0x0a, 0x97, 0xc4, 0xf3, 0xde, 0xf7, 0x66, 0xbe, 0xf7, 0xed, 0x9b, 0xf7, 0x76, 0xe1, 0x06, 0xf1,
+	0xf7, 0x31, 0xc3, 0xbe, 0x8d, 0x1b, 0x9d, 0x1e, 0x6f, 0x88, 0x83, 0x7a, 0xc0, 0xa8, 0xa0, 0xc5,
+	0x85, 0xd4, 0x5e, 0xef, 0xf4, 0xb8, 0x7e, 0x0d, 0x79, 0xc4, 0xa7, 0x0d, 0xf9, 0x37, 0x42, 0xe8,
+	0x37, 0x6d, 0xca, 0x3d, 0xca, 0x1b, 0x1e, 0x77, 0x1b, 0xf7, 0x5f, 0x0f, 0xff, 0xc5, 0x8e, 0x72,
+	0xe4, 0xb0, 0xe4, 0xaa, 0x11, 0x2d, 0x62, 0xd7, 0x75, 0x97, 0xba, 0x34, 0xb2, 0x87, 0xbf, 0x62,
+	0xab, 0x3e, 0xc8, 0x21, 0x40, 0x0c, 0x79, 0x49, 0x84, 0x31, 0xe8, 0xe3, 0xc4, 0xf5, 0x89, 0xef,
+	0x5a, 0xdc, 0xee, 0x62, 0x0f, 0x25, 0x07, 0x2a, 0x39, 0x1c, 0x06, 0x38, 0x0e, 0x37, 0x7e, 0xd6,
+	0xe0, 0xea, 0x0e, 0x77, 0x3f, 0x09, 0x1c, 0x24, 0xf0, 0xae, 0xdc, 0xb8, 0x78, 0x0f, 0xe6, 0x50,
+	0x5f, 0x74, 0x29, 0x23, 0xe2, 0xb0, 0xa4, 0x55, 0xb5, 0xda, 0xdc, 0x66, 0xe9, 0xb7, 0x9f, 0x5e,
+	0xbd, 0x1e, 0x33, 0x7d, 0xd7, 0x71, 0x18, 0xe6, 0xbc, 0x2d, 0x18, 0xf1, 0x5d, 0xf3, 0x0c, 0x5a,
+	0x7c, 0x03, 0xa6, 0x23, 0x6a, 0xa5, 0xc9, 0xaa, 0x56, 0x9b, 0x6f, 0x3e, 0x5f, 0x1f, 0xd0, 0xa8,
+	0x1e, 0x6d, 0xbf, 0x39, 0xf7, 0xf8, 0xcf, 0xa5, 0x89, 0x1f, 0x4e, 0x8f, 0x56, 0x35, 0x33, 0xc6,
+	0xb7, 0x9a, 0x5f, 0x9f, 0x1e, 0xad, 0x9e, 0xed, 0xf4, 0xe8, 0xf4, 0x68, 0x75, 0xe9, 0x8c, 0xf3,
+	0x81, 0x64, 0xad, 0xb0, 0x34, 0xca, 0x70, 0x53, 0x31, 0x99, 0x98, 0x07, 0xd4, 0xe7, 0xd8, 0xf8,
+	0x65, 0x12, 0x9e, 0xdb, 0xe1, 0x6e, 0xbb, 0xdf, 0xf1, 0x88, 0x78, 0x0f, 0xa3, 0x1e, 0x66, 0xbb,
+	0x88, 0x89, 0x62, 0x13, 0x66, 0x6c, 0x86, 0x91, 0xa0, 0xec, 0xdc, 0xb4, 0x12, 0x60, 0xb1, 0x0c,
+	0xb3, 0x38, 0xa0, 0x76, 0xd7, 0x22, 0x8e, 0x4c, 0x2b, 0x6f, 0xce, 0xc8, 0xf5, 0xb6, 0x53, 0xac,
+	0xc2, 0xbc, 0x4d, 0x3d, 0x8f, 0x08, 0x0f, 0xfb, 0x82, 0x97, 0x72, 0xd5, 0x5c, 0xad, 0x60, 0x3e,
+	0x6d, 0x2a, 0x3e, 0x84, 0x65, 0xec, 0xdb, 0xec, 0x30, 0x10, 0xd8, 0xb1, 0x78, 0x17, 0x31, 0xcc,
+	0xad, 0x7d, 0xca, 0xac, 0x00, 0x31, 0x41, 0x6c, 0x12, 0xa0, 0x30, 0x2e, 0x5f, 0xcd, 0xd5, 0xe6,
+	0x9b, 0xeb, 0x8a, 0x58, 0x5b, 0x49, 0x5c, 0x5b, 0x86, 0xbd, 0x4f, 0x65, 0x0a, 0x71, 0xd0, 0x66,
+	0x3e, 0xd4, 0xd0, 0xac, 0xe0, 0x71, 0x20, 0xde, 0xba, 0x1b, 0xca, 0x9a, 0x64, 0x12, 0x8a, 0xba,
+	0x32, 0x42, 0x54, 0x55, 0x25, 0xe3, 0x36, 0xbc, 0x30, 0xc2, 0x9c, 0x8a, 0xfb, 0xbb, 0xf6, 0x94,
+	0x7f, 0x0f, 0x33, 0xb2, 0x4f, 0x6c, 0x24, 0x08, 0xf5, 0xf7, 0xb0, 0x1d, 0x0a, 0xf6, 0x1f, 0x8b,
+	0xfc, 0x32, 0x5c, 0x75, 0x24, 0x09, 0xeb, 0x3e, 0xea, 0x11, 0x27, 0x2c, 0xc9, 0x50, 0xe8, 0x59,
+	0xf3, 0x4a, 0x64, 0xde, 0x8b, 0xad, 0xad, 0xb7, 0xd4, 0x64, 0xd7, 0x32, 0x93, 0x1d, 0x66, 0x6d,
+	0xbc, 0x04, 0x2b, 0x63, 0xdc, 0x69, 0xf2, 0xdf, 0x4d, 0xc2, 0x8b, 0x29, 0xee, 0x03, 0x46, 0xfb,
+	0xc1, 0x87, 0xf8, 0x50, 0x52, 0x90, 0xe8, 0x36, 0x71, 0x7d, 0x24, 0xfa, 0x0c, 0x5f, 0x4a, 0x85,
+	0x2a, 0x14, 0x7c, 0xfc, 0xc0, 0x52, 0x94, 0x00, 0x1f, 0x3f, 0xd8, 0x8a, 0xc5, 0x58, 0x86, 0x02,
+	0xef, 0x51, 0x61, 0x11, 0xdf, 0x21, 0x36, 0x8e, 0x4a, 0x6e, 0xc1, 0x9c, 0x0f, 0x6d, 0xdb, 0x91,
+	0xa9, 0xb8, 0x06, 0xd7, 0x64, 0x75, 0xa1, 0x9e, 0xc5, 0x13, 0x36, 0xa5, 0x7c, 0x55, 0xab, 0x15,
+	0xcc, 0xc5, 0xd8, 0x91, 0xb2, 0x6c, 0x6d, 0xa9, 0x9a, 0xdd, 0xcd, 0xd4, 0x6c, 0x4c, 0xb2, 0x46,
+	0x1d, 0xd6, 0x2f, 0x82, 0x4b, 0x55, 0xfc, 0x5b, 0x83, 0x72, 0x1a, 0xb0, 0xab, 0x90, 0xba, 0x94,
+	0x74, 0xb7, 0x01, 0x18, 0xfe, 0xa2, 0x8f, 0xb9, 0x48, 0x84, 0x2b, 0x98, 0x73, 0xb1, 0xe5, 0x7f,
+	0xd0, 0xad, 0xa5, 0xea, 0xf6, 0x4a, 0xa6, 0x6e, 0x6a, 0x7a, 0xc6, 0x0a, 0x2c, 0x67, 0x3a, 0x53,
+	0x85, 0xbe, 0x99, 0x84, 0x5b, 0x3b, 0xdc, 0x35, 0xa3, 0x0c, 0x3e, 0xee, 0x32, 0xcc, 0xbb, 0xb4,
+	0xe7, 0x3c, 0x9b, 0x48, 0x35, 0x58, 0xb4, 0xfb, 0x8c, 0x61, 0x5f, 0xa8, 0x35, 0x76, 0x25, 0xb6,
+	0x27, 0x75, 0x56, 0x86, 0x59, 0xbb, 0x8b, 0x88, 0x1f, 0x22, 0x72, 0x52, 0x83, 0x19, 0xb9, 0xde,
+	0x76, 0x14, 0xa5, 0xf3, 0xaa, 0xd2, 0x45, 0xc8, 0x3b, 0x48, 0xa0, 0xd2, 0x94, 0x6c, 0x86, 0xf2,
+	0x77, 0xeb, 0x6d, 0x55, 0xad, 0xf5, 0x11, 0x6a, 0x65, 0xa6, 0x6a, 0xdc, 0x91, 0x57, 0x2e, 0xd3,
+	0x9f, 0x6a, 0xf6, 0xab, 0x16, 0x75, 0x7d, 0x2c, 0xda, 0xd1, 0x10, 0x6c, 0xcb, 0x19, 0x78, 0xe9,
+	0x71, 0xf6, 0x0e, 0x4c, 0x47, 0x53, 0x34, 0x1e, 0x67, 0xb7, 0x94, 0x0e, 0x3d, 0x70, 0xca, 0xc0,
+	0x54, 0x8b, 0xc2, 0x5a, 0xf7, 0x86, 0xa7, 0xda, 0xc8, 0x06, 0xac, 0x10, 0x4e, 0x1a, 0xb0, 0x62,
+	0x4e, 0xf3, 0x7c, 0x34, 0x29, 0x6f, 0x4f, 0x2c, 0x48, 0xe4, 0x7c, 0xb6, 0xc2, 0x58, 0x82, 0xf9,
+	0x88, 0xb2, 0xe5, 0x23, 0x0f, 0xcb, 0x74, 0xe7, 0x4c, 0x88, 0x4c, 0x1f, 0x21, 0x0f, 0xa7, 0x4f,
+	0x35, 0x77, 0xf6, 0x54, 0xe3, 0xc6, 0xec, 0xf4, 0x88, 0x8f, 0xad, 0x4e, 0x8f, 0xda, 0x9f, 0x73,
+	0x59, 0x0d, 0x39, 0xd9, 0x98, 0xa5, 0x79, 0x53, 0x5a, 0xc3, 0xdd, 0x3d, 0x74, 0x60, 0x31, 0x2c,
+	0x18, 0xc1, 0xbc, 0x34, 0x55, 0xd5, 0x6a, 0x0b, 0x26, 0x78, 0xe8, 0xc0, 0x8c, 0x2c, 0x17, 0xbb,
+	0x4d, 0xa3, 0xd3, 0x35, 0x3e, 0x93, 0xb7, 0x69, 0xb4, 0x33, 0x51, 0x4c, 0xa9, 0x59, 0x4d, 0xad,
+	0x59, 0x1d, 0x66, 0x79, 0xb8, 0xf0, 0x6d, 0x1c, 0xdf, 0x87, 0x74, 0xdd, 0xfc, 0x71, 0x06, 0x72,
+	0x3b, 0xdc, 0x2d, 0xee, 0x41, 0x61, 0xe0, 0x1d, 0xa9, 0xa2, 0x14, 0x83, 0xf2, 0x2a, 0xa2, 0xdf,
+	0x19, 0xef, 0x4f, 0xa9, 0x75, 0x60, 0x71, 0xe8, 0x35, 0xc5, 0x18, 0x8e, 0x55, 0x31, 0xfa, 0xea,
+	0xf9, 0x98, 0xf4, 0x8c, 0x2f, 0xa1, 0x94, 0x39, 0xad, 0x33, 0xf7, 0x19, 0xc6, 0xea, 0xcd, 0x8b,
+	0x63, 0xd3, 0xb3, 0xbf, 0xd7, 0x60, 0xf9, 0xfc, 0x69, 0xb9, 0x91, 0xb5, 0xf3, 0x98, 0x20, 0xfd,
+	0xcd, 0x4b, 0x04, 0xa5, 0xbc, 0x04, 0xdc, 0xc8, 0x18, 0x3f, 0xb5, 0xac, 0x6d, 0x55, 0xa4, 0xfe,
+	0xda, 0x45, 0x91, 0xe9, 0xa9, 0x0f, 0xa1, 0x9c, 0xdd, 0xd2, 0xd7, 0x86, 0xb7, 0xcb, 0x04, 0xeb,
+	0x1b, 0xff, 0x02, 0x3c, 0x50, 0x6c, 0x6a, 0x77, 0x1c, 0x55, 0x6c, 0x0a, 0x66, 0x64, 0xb1, 0x65,
+	0x74, 0xa7, 0x50, 0xd8, 0x8c, 0xce, 0x54, 0xcb, 0xa4, 0xac, 0x20, 0x47, 0x09, 0x3b, 0xfe, 0x86,
+	0xeb, 0x53, 0x5f, 0x85, 0x9d, 0x77, 0x73, 0xfb, 0xf1, 0x71, 0x45, 0x7b, 0x72, 0x5c, 0xd1, 0xfe,
+	0x3a, 0xae, 0x68, 0xdf, 0x9e, 0x54, 0x26, 0x9e, 0x9c, 0x54, 0x26, 0xfe, 0x38, 0xa9, 0x4c, 0x7c,
+	0xda, 0x70, 0x89, 0xe8, 0xf6, 0x3b, 0x75, 0x9b, 0x7a, 0x8d, 0x80, 0x51, 0xa7, 0x6f, 0x0b, 0x6e,
+	0x13, 0xd9, 0x62, 0xd4, 0x66, 0x23, 0x3f, 0x8f, 0x3a, 0xd3, 0xf2, 0xfb, 0x68, 0xe3, 0x9f, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0xa6, 0xe9, 0x6a, 0x1f, 0x00, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -792,6 +1040,10 @@ type MsgClient interface {
 	SubmitPartialSignature(ctx context.Context, in *MsgSubmitPartialSignature, opts ...grpc.CallOption) (*MsgSubmitPartialSignatureResponse, error)
 	// RequestThresholdSignature allows external users to request a threshold signature
 	RequestThresholdSignature(ctx context.Context, in *MsgRequestThresholdSignature, opts ...grpc.CallOption) (*MsgRequestThresholdSignatureResponse, error)
+	// SetSigningSchema defines a (governance) operation for adding or replacing a signing schema
+	SetSigningSchema(ctx context.Context, in *MsgSetSigningSchema, opts ...grpc.CallOption) (*MsgSetSigningSchemaResponse, error)
+	// RequestSchemaSignature allows users to request a threshold signature over a payload of a whitelisted schema
+	RequestSchemaSignature(ctx context.Context, in *MsgRequestSchemaSignature, opts ...grpc.CallOption) (*MsgRequestSchemaSignatureResponse, error)
 }
 
 type msgClient struct {
@@ -856,6 +1108,24 @@ func (c *msgClient) RequestThresholdSignature(ctx context.Context, in *MsgReques
 	return out, nil
 }
 
+func (c *msgClient) SetSigningSchema(ctx context.Context, in *MsgSetSigningSchema, opts ...grpc.CallOption) (*MsgSetSigningSchemaResponse, error) {
+	out := new(MsgSetSigningSchemaResponse)
+	err := c.cc.Invoke(ctx, "/inference.bls.Msg/SetSigningSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestSchemaSignature(ctx context.Context, in *MsgRequestSchemaSignature, opts ...grpc.CallOption) (*MsgRequestSchemaSignatureResponse, error) {
+	out := new(MsgRequestSchemaSignatureResponse)
+	err := c.cc.Invoke(ctx, "/inference.bls.Msg/RequestSchemaSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -871,6 +1141,10 @@ type MsgServer interface {
 	SubmitPartialSignature(context.Context, *MsgSubmitPartialSignature) (*MsgSubmitPartialSignatureResponse, error)
 	// RequestThresholdSignature allows external users to request a threshold signature
 	RequestThresholdSignature(context.Context, *MsgRequestThresholdSignature) (*MsgRequestThresholdSignatureResponse, error)
+	// SetSigningSchema defines a (governance) operation for adding or replacing a signing schema
+	SetSigningSchema(context.Context, *MsgSetSigningSchema) (*MsgSetSigningSchemaResponse, error)
+	// RequestSchemaSignature allows users to request a threshold signature over a payload of a whitelisted schema
+	RequestSchemaSignature(context.Context, *MsgRequestSchemaSignature) (*MsgRequestSchemaSignatureResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -895,6 +1169,12 @@ func (*UnimplementedMsgServer) SubmitPartialSignature(ctx context.Context, req *
 func (*UnimplementedMsgServer) RequestThresholdSignature(ctx context.Context, req *MsgRequestThresholdSignature) (*MsgRequestThresholdSignatureResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestThresholdSignature not implemented")
 }
+func (*UnimplementedMsgServer) SetSigningSchema(ctx context.Context, req *MsgSetSigningSchema) (*MsgSetSigningSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSigningSchema not implemented")
+}
+func (*UnimplementedMsgServer) RequestSchemaSignature(ctx context.Context, req *MsgRequestSchemaSignature) (*MsgRequestSchemaSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSchemaSignature not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1008,6 +1288,42 @@ func _Msg_RequestThresholdSignature_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetSigningSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSigningSchema)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSigningSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.bls.Msg/SetSigningSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSigningSchema(ctx, req.(*MsgSetSigningSchema))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestSchemaSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestSchemaSignature)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestSchemaSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.bls.Msg/RequestSchemaSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestSchemaSignature(ctx, req.(*MsgRequestSchemaSignature))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.bls.Msg",
@@ -1037,6 +1353,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RequestThresholdSignature",
 			Handler:    _Msg_RequestThresholdSignature_Handler,
 		},
+		{
+			MethodName: "SetSigningSchema",
+			Handler:    _Msg_SetSigningSchema_Handler,
+		},
+		{
+			MethodName: "RequestSchemaSignature",
+			Handler:    _Msg_RequestSchemaSignature_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/bls/tx.proto",
@@ -1506,50 +1830,204 @@ func (m *MsgRequestThresholdSignatureResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetSigningSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetSigningSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSigningSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetSigningSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSigningSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSigningSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitDealerPart) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRequestSchemaSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestSchemaSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestSchemaSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRetries != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DeadlineBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeadlineBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Data[iNdEx])
+			copy(dAtA[i:], m.Data[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Data[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SchemaName) > 0 {
+		i -= len(m.SchemaName)
+		copy(dAtA[i:], m.SchemaName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SchemaName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestSchemaSignatureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestSchemaSignatureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestSchemaSignatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitDealerPart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.EpochId != 0 {
 		n += 1 + sovTx(uint64(m.EpochId))
@@ -1718,6 +2196,75 @@ func (m *MsgRequestThresholdSignatureResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetSigningSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Schema.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetSigningSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestSchemaSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SchemaName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, b := range m.Data {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.DeadlineBlocks != 0 {
+		n += 1 + sovTx(uint64(m.DeadlineBlocks))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovTx(uint64(m.MaxRetries))
+	}
+	return n
+}
+
+func (m *MsgRequestSchemaSignatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3115,6 +3662,458 @@ func (m *MsgRequestThresholdSignatureResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetSigningSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSigningSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSigningSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSigningSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSigningSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSigningSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestSchemaSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestSchemaSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestSchemaSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
+			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineBlocks", wireType)
+			}
+			m.DeadlineBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestSchemaSignatureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestSchemaSignatureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestSchemaSignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = append(m.RequestId[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestId == nil {
+				m.RequestId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
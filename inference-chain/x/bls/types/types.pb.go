@@ -341,6 +341,13 @@ type EpochBLSData struct {
 	// For Epoch N+1, stores the signature from Epoch N validators confirming the new group public key
 	// Format: 48-byte G1 compressed signature
 	ValidationSignature []byte `protobuf:"bytes,12,opt,name=validation_signature,json=validationSignature,proto3" json:"validation_signature,omitempty"`
+	// dkg_attempt counts the dealing rounds restarted for this epoch after a failed attempt (0 for the first round)
+	DkgAttempt uint32 `protobuf:"varint,13,opt,name=dkg_attempt,json=dkgAttempt,proto3" json:"dkg_attempt,omitempty"`
+	// failed_attempts records every failed DKG attempt of this epoch in order
+	FailedAttempts []DKGAttemptRecord `protobuf:"bytes,14,rep,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts"`
+	// key_carried_from_epoch is set when DKG failed for good and the epoch uses the group public key of an earlier epoch.
+	// group_public_key then holds that key and threshold signing is done by that epoch's participants.
+	KeyCarriedFromEpoch uint64 `protobuf:"varint,15,opt,name=key_carried_from_epoch,json=keyCarriedFromEpoch,proto3" json:"key_carried_from_epoch,omitempty"`
 }
 
 func (m *EpochBLSData) Reset()         { *m = EpochBLSData{} }
@@ -460,6 +467,102 @@ func (m *EpochBLSData) GetValidationSignature() []byte {
 	return nil
 }
 
+func (m *EpochBLSData) GetDkgAttempt() uint32 {
+	if m != nil {
+		return m.DkgAttempt
+	}
+	return 0
+}
+
+func (m *EpochBLSData) GetFailedAttempts() []DKGAttemptRecord {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return nil
+}
+
+func (m *EpochBLSData) GetKeyCarriedFromEpoch() uint64 {
+	if m != nil {
+		return m.KeyCarriedFromEpoch
+	}
+	return 0
+}
+
+// DKGAttemptRecord describes a failed DKG attempt and the participants excluded from the next one
+type DKGAttemptRecord struct {
+	// attempt is the dkg_attempt of the failed attempt
+	Attempt uint32 `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// reason describes why the attempt failed
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// failed_at_height is the block height at which the attempt failed
+	FailedAtHeight int64 `protobuf:"varint,3,opt,name=failed_at_height,json=failedAtHeight,proto3" json:"failed_at_height,omitempty"`
+	// excluded_participants are the participants that did not perform in the attempt
+	ExcludedParticipants []string `protobuf:"bytes,4,rep,name=excluded_participants,json=excludedParticipants,proto3" json:"excluded_participants,omitempty"`
+	// excluded_weight is the total percentage weight of the excluded participants
+	ExcludedWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=excluded_weight,json=excludedWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"excluded_weight"`
+}
+
+func (m *DKGAttemptRecord) Reset()         { *m = DKGAttemptRecord{} }
+func (m *DKGAttemptRecord) String() string { return proto.CompactTextString(m) }
+func (*DKGAttemptRecord) ProtoMessage()    {}
+func (*DKGAttemptRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bacf092f2134906, []int{5}
+}
+func (m *DKGAttemptRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DKGAttemptRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DKGAttemptRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DKGAttemptRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DKGAttemptRecord.Merge(m, src)
+}
+func (m *DKGAttemptRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DKGAttemptRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DKGAttemptRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DKGAttemptRecord proto.InternalMessageInfo
+
+func (m *DKGAttemptRecord) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *DKGAttemptRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DKGAttemptRecord) GetFailedAtHeight() int64 {
+	if m != nil {
+		return m.FailedAtHeight
+	}
+	return 0
+}
+
+func (m *DKGAttemptRecord) GetExcludedParticipants() []string {
+	if m != nil {
+		return m.ExcludedParticipants
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("inference.bls.DKGPhase", DKGPhase_name, DKGPhase_value)
 	proto.RegisterType((*BLSParticipantInfo)(nil), "inference.bls.BLSParticipantInfo")
@@ -467,68 +570,81 @@ func init() {
 	proto.RegisterType((*DealerPartStorage)(nil), "inference.bls.DealerPartStorage")
 	proto.RegisterType((*VerificationVectorSubmission)(nil), "inference.bls.VerificationVectorSubmission")
 	proto.RegisterType((*EpochBLSData)(nil), "inference.bls.EpochBLSData")
+	proto.RegisterType((*DKGAttemptRecord)(nil), "inference.bls.DKGAttemptRecord")
 }
 
 func init() { proto.RegisterFile("inference/bls/types.proto", fileDescriptor_9bacf092f2134906) }
 
 var fileDescriptor_9bacf092f2134906 = []byte{
-	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xdd, 0x6e, 0x22, 0x37,
-	0x14, 0x66, 0x02, 0xd9, 0x10, 0x03, 0x59, 0xf0, 0xa6, 0xca, 0x64, 0x77, 0xcb, 0x52, 0xda, 0xaa,
-	0xf4, 0x0f, 0x9a, 0xf4, 0xe7, 0xb6, 0x0a, 0x99, 0x09, 0xa5, 0xa1, 0x29, 0x9a, 0xd9, 0xa6, 0x6a,
-	0x2b, 0xd5, 0x32, 0xb6, 0x33, 0x58, 0xc0, 0x78, 0x34, 0x36, 0xe9, 0xf2, 0x16, 0x7b, 0xd9, 0x77,
-	0xe8, 0x6d, 0x6f, 0xfa, 0x06, 0x7b, 0xb9, 0xea, 0x55, 0xd5, 0x8b, 0x55, 0x95, 0xbc, 0x48, 0x65,
-	0x33, 0x30, 0x40, 0xbb, 0xb9, 0x41, 0x73, 0xbe, 0xef, 0xf3, 0xf1, 0x39, 0xc7, 0x9f, 0x31, 0x38,
-	0xe4, 0xe1, 0x15, 0x8b, 0x59, 0x48, 0x58, 0x6b, 0x30, 0x96, 0x2d, 0x35, 0x8b, 0x98, 0x6c, 0x46,
-	0xb1, 0x50, 0x02, 0x96, 0x96, 0x54, 0x73, 0x30, 0x96, 0x0f, 0x2b, 0x78, 0xc2, 0x43, 0xd1, 0x32,
-	0xbf, 0x73, 0xc5, 0xc3, 0x43, 0x22, 0xe4, 0x44, 0x48, 0x64, 0xa2, 0xd6, 0x3c, 0x48, 0xa8, 0xfd,
-	0x40, 0x04, 0x62, 0x8e, 0xeb, 0xaf, 0x39, 0x5a, 0x7f, 0xbe, 0x05, 0x60, 0xbb, 0xe7, 0xf7, 0x71,
-	0xac, 0x38, 0xe1, 0x11, 0x0e, 0x55, 0x37, 0xbc, 0x12, 0xd0, 0x06, 0x3b, 0x98, 0xd2, 0x98, 0x49,
-	0x69, 0x5b, 0x35, 0xab, 0xb1, 0xeb, 0x2d, 0x42, 0xf8, 0x33, 0xa8, 0x44, 0x2c, 0x26, 0x2c, 0x54,
-	0x38, 0x60, 0xe8, 0x17, 0xc6, 0x83, 0xa1, 0xb2, 0xb7, 0xb4, 0xa6, 0x7d, 0xf4, 0xe2, 0xd5, 0x93,
-	0xcc, 0xdf, 0xaf, 0x9e, 0x3c, 0x9a, 0xef, 0x2b, 0xe9, 0xa8, 0xc9, 0x45, 0x6b, 0x82, 0xd5, 0xb0,
-	0xd9, 0x63, 0x01, 0x26, 0x33, 0x87, 0x91, 0x3f, 0x7f, 0xff, 0x18, 0x24, 0x65, 0x39, 0x8c, 0x78,
-	0xe5, 0x34, 0xd7, 0xf7, 0x26, 0x15, 0xfc, 0x04, 0xec, 0x4b, 0x46, 0xa2, 0xe3, 0xcf, 0xbf, 0x18,
-	0x1d, 0xa1, 0x68, 0x3a, 0x18, 0x73, 0x82, 0x46, 0x6c, 0x66, 0x67, 0x6b, 0x56, 0xa3, 0xe8, 0xc1,
-	0x25, 0xd7, 0x37, 0xd4, 0x39, 0x9b, 0xc1, 0x06, 0x28, 0xcb, 0xb1, 0x50, 0x48, 0x2a, 0x1c, 0x2b,
-	0xc4, 0x43, 0xca, 0x9e, 0xd9, 0xb9, 0x9a, 0xd5, 0x28, 0x79, 0x7b, 0x1a, 0xf7, 0x35, 0xdc, 0xd5,
-	0x28, 0x7c, 0x07, 0x18, 0x04, 0xb1, 0x90, 0x26, 0xba, 0x6d, 0xa3, 0x2b, 0x6a, 0xd4, 0x0d, 0xa9,
-	0x51, 0xd5, 0xbf, 0x06, 0x6f, 0xba, 0x21, 0x89, 0x67, 0x91, 0x62, 0xd4, 0x1f, 0xe2, 0x98, 0xc9,
-	0x33, 0x11, 0xaf, 0x0c, 0x08, 0xbe, 0x0f, 0xca, 0x6c, 0x21, 0x40, 0xd2, 0x28, 0x6c, 0xab, 0x96,
-	0x6d, 0x14, 0xbd, 0xfb, 0x6c, 0x7d, 0x61, 0xfd, 0x0f, 0x0b, 0x54, 0x1c, 0x86, 0xc7, 0xcc, 0x24,
-	0xf0, 0x95, 0x88, 0x71, 0xc0, 0xe0, 0xbb, 0x60, 0x8f, 0x1a, 0x10, 0xad, 0x0f, 0xb9, 0x34, 0x47,
-	0x4f, 0x92, 0x51, 0xd7, 0x40, 0x81, 0x88, 0xc9, 0x84, 0xab, 0x09, 0x0b, 0x95, 0xb4, 0xb7, 0xcc,
-	0x16, 0xab, 0x10, 0xfc, 0x09, 0xc0, 0x28, 0x2d, 0x6c, 0x51, 0x4b, 0xb6, 0x96, 0x6d, 0x14, 0x8e,
-	0x3f, 0x6a, 0xae, 0xb9, 0xa5, 0x79, 0x67, 0x4f, 0x5e, 0x65, 0x25, 0x4f, 0x52, 0x7b, 0x07, 0x3c,
-	0xbe, 0x64, 0x31, 0xbf, 0xe2, 0x04, 0x2b, 0x2e, 0xc2, 0x4b, 0x46, 0x94, 0x88, 0xfd, 0xe9, 0x60,
-	0xc2, 0xa5, 0xe4, 0x22, 0x84, 0xef, 0x81, 0xfb, 0x49, 0x17, 0xd7, 0x78, 0xcc, 0x29, 0x57, 0x33,
-	0x33, 0x85, 0xbc, 0x97, 0x34, 0x77, 0x99, 0xa0, 0xf5, 0xdf, 0xb6, 0x41, 0xd1, 0x8d, 0x04, 0x19,
-	0xb6, 0x7b, 0xbe, 0x83, 0x15, 0x86, 0x87, 0x20, 0xcf, 0x74, 0x8c, 0x38, 0x35, 0x9d, 0xe7, 0xbc,
-	0x1d, 0x13, 0x77, 0x29, 0xac, 0x83, 0x12, 0x47, 0x4a, 0x28, 0x3c, 0x46, 0xfa, 0x50, 0xa4, 0xb1,
-	0x56, 0xc9, 0x2b, 0xf0, 0xa7, 0x1a, 0xf3, 0x35, 0xa4, 0x8f, 0x51, 0xcd, 0x59, 0x44, 0x59, 0x10,
-	0x33, 0x66, 0xcc, 0x51, 0xf2, 0x8a, 0xca, 0xf0, 0x8e, 0xc1, 0xe0, 0x39, 0x28, 0xae, 0xf4, 0x24,
-	0xed, 0x9c, 0x99, 0xca, 0x5b, 0x1b, 0x53, 0xf9, 0xaf, 0xf7, 0xdb, 0x39, 0x6d, 0x63, 0x6f, 0x6d,
-	0x31, 0xfc, 0x0c, 0xec, 0xd2, 0x51, 0x80, 0xa2, 0x21, 0x96, 0xcc, 0x98, 0x66, 0xef, 0xf8, 0x60,
-	0x23, 0x93, 0x73, 0xde, 0xe9, 0x6b, 0xda, 0xcb, 0xd3, 0x51, 0x60, 0xbe, 0xe0, 0x97, 0xe0, 0xb1,
-	0x1e, 0x05, 0x0f, 0x93, 0x95, 0x88, 0x32, 0x4c, 0xc7, 0x3c, 0x64, 0x68, 0x30, 0x16, 0x64, 0x64,
-	0xdf, 0xab, 0x59, 0x8d, 0xac, 0x77, 0x98, 0x68, 0xcc, 0x1a, 0x27, 0x51, 0xb4, 0xb5, 0x00, 0x9e,
-	0x82, 0xea, 0xb5, 0x3e, 0x82, 0xd9, 0x6b, 0x53, 0xec, 0x98, 0x14, 0x8f, 0x96, 0xaa, 0xff, 0x49,
-	0xd2, 0x00, 0xe5, 0x20, 0x16, 0xd3, 0x68, 0xf5, 0x36, 0xe5, 0xcd, 0x6d, 0xda, 0x33, 0x78, 0x7a,
-	0x93, 0x4e, 0x41, 0x31, 0x39, 0x51, 0xdd, 0xbc, 0xb4, 0x77, 0xcd, 0xc8, 0x6a, 0x9b, 0x8d, 0x6e,
-	0xfa, 0xd9, 0x2b, 0xd0, 0x25, 0x24, 0xe1, 0x15, 0xb0, 0xaf, 0x57, 0x6c, 0x83, 0xe4, 0xd2, 0x31,
-	0xd2, 0x06, 0x26, 0xe1, 0x87, 0x1b, 0x09, 0xef, 0x72, 0x99, 0x77, 0xb0, 0x9a, 0x2c, 0xc5, 0x25,
-	0x7c, 0x1b, 0x94, 0x8c, 0xef, 0xd0, 0x7c, 0x73, 0x69, 0x17, 0x8c, 0xf9, 0x8a, 0x06, 0x9c, 0xd7,
-	0x28, 0xe1, 0x11, 0xd8, 0x37, 0x71, 0x52, 0x0a, 0x0f, 0x42, 0xac, 0xa6, 0x31, 0xb3, 0x8b, 0xa6,
-	0xff, 0x07, 0x29, 0xe7, 0x2f, 0xa8, 0x0f, 0x7e, 0xb5, 0x40, 0x7e, 0x71, 0x96, 0xf0, 0x00, 0x3c,
-	0x70, 0xce, 0x3b, 0xa8, 0xff, 0xd5, 0x89, 0xef, 0xa2, 0xef, 0x2e, 0x1c, 0xf7, 0xac, 0x7b, 0xe1,
-	0x3a, 0xe5, 0x0c, 0x7c, 0x03, 0x54, 0x52, 0xc2, 0x71, 0x4f, 0x7a, 0xdd, 0x8b, 0x4e, 0xd9, 0x5a,
-	0xd7, 0x5f, 0xba, 0x5e, 0xf7, 0xec, 0x07, 0x4d, 0x6c, 0xad, 0x13, 0xa7, 0xdf, 0x7e, 0xd3, 0xef,
-	0xb9, 0x4f, 0x5d, 0xa7, 0x9c, 0x85, 0xfb, 0xa0, 0x9c, 0x12, 0x67, 0x27, 0xdd, 0x9e, 0xeb, 0x94,
-	0x73, 0xeb, 0xa8, 0xdf, 0xed, 0xe8, 0x4d, 0xb7, 0xdb, 0xdd, 0x17, 0x37, 0x55, 0xeb, 0xe5, 0x4d,
-	0xd5, 0xfa, 0xe7, 0xa6, 0x6a, 0x3d, 0xbf, 0xad, 0x66, 0x5e, 0xde, 0x56, 0x33, 0x7f, 0xdd, 0x56,
-	0x33, 0x3f, 0xb6, 0x02, 0xae, 0x86, 0xd3, 0x41, 0x93, 0x88, 0x49, 0x2b, 0x8a, 0x05, 0x9d, 0x12,
-	0x25, 0x09, 0x37, 0x8f, 0x48, 0xfa, 0x9c, 0x3c, 0x4b, 0x1f, 0x94, 0xc1, 0x3d, 0xf3, 0xf7, 0xff,
-	0xe9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xc7, 0xd8, 0xd3, 0x6e, 0x06, 0x00, 0x00,
+	// 1078 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdd, 0x72, 0x1b, 0x35,
+	0x14, 0xce, 0xc6, 0x69, 0x9a, 0xc8, 0x3f, 0x71, 0x54, 0xb7, 0xd9, 0xb4, 0xc5, 0x31, 0x06, 0x06,
+	0xf3, 0x53, 0x9b, 0xa4, 0xc0, 0x2d, 0x13, 0x67, 0x9d, 0xd4, 0xc4, 0x0d, 0x9e, 0x75, 0x09, 0x43,
+	0x99, 0x41, 0x23, 0xaf, 0xe4, 0xb5, 0xc6, 0xde, 0xd5, 0x8e, 0x24, 0x87, 0xf8, 0x2d, 0x7a, 0x09,
+	0xef, 0xd1, 0x1b, 0xde, 0xa0, 0x97, 0xa5, 0x57, 0x0c, 0x17, 0x1d, 0x26, 0x79, 0x11, 0x66, 0xb5,
+	0xf2, 0x6f, 0xa0, 0x17, 0xdc, 0x78, 0x7c, 0xbe, 0xef, 0xe8, 0xe8, 0x9c, 0xa3, 0xef, 0x48, 0x0b,
+	0x76, 0x59, 0xd8, 0xa3, 0x82, 0x86, 0x1e, 0xad, 0x75, 0x87, 0xb2, 0xa6, 0xc6, 0x11, 0x95, 0xd5,
+	0x48, 0x70, 0xc5, 0x61, 0x76, 0x4a, 0x55, 0xbb, 0x43, 0x79, 0x7f, 0x1b, 0x07, 0x2c, 0xe4, 0x35,
+	0xfd, 0x9b, 0x78, 0xdc, 0xdf, 0xf5, 0xb8, 0x0c, 0xb8, 0x44, 0xda, 0xaa, 0x25, 0x86, 0xa1, 0x0a,
+	0x3e, 0xf7, 0x79, 0x82, 0xc7, 0xff, 0x12, 0xb4, 0xfc, 0x62, 0x15, 0xc0, 0x7a, 0xab, 0xd3, 0xc6,
+	0x42, 0x31, 0x8f, 0x45, 0x38, 0x54, 0xcd, 0xb0, 0xc7, 0xa1, 0x0d, 0x6e, 0x63, 0x42, 0x04, 0x95,
+	0xd2, 0xb6, 0x4a, 0x56, 0x65, 0xd3, 0x9d, 0x98, 0xf0, 0x67, 0xb0, 0x1d, 0x51, 0xe1, 0xd1, 0x50,
+	0x61, 0x9f, 0xa2, 0x5f, 0x28, 0xf3, 0xfb, 0xca, 0x5e, 0x8d, 0x7d, 0xea, 0xfb, 0xaf, 0xde, 0xee,
+	0xad, 0xfc, 0xf5, 0x76, 0xef, 0x41, 0xb2, 0xaf, 0x24, 0x83, 0x2a, 0xe3, 0xb5, 0x00, 0xab, 0x7e,
+	0xb5, 0x45, 0x7d, 0xec, 0x8d, 0x1d, 0xea, 0xbd, 0x79, 0xf9, 0x08, 0x98, 0xb4, 0x1c, 0xea, 0xb9,
+	0xf9, 0x59, 0xac, 0x1f, 0x74, 0x28, 0xf8, 0x05, 0x28, 0x48, 0xea, 0x45, 0x07, 0x5f, 0x7d, 0x3d,
+	0xd8, 0x47, 0xd1, 0xa8, 0x3b, 0x64, 0x1e, 0x1a, 0xd0, 0xb1, 0x9d, 0x2a, 0x59, 0x95, 0x8c, 0x0b,
+	0xa7, 0x5c, 0x5b, 0x53, 0xa7, 0x74, 0x0c, 0x2b, 0x20, 0x2f, 0x87, 0x5c, 0x21, 0xa9, 0xb0, 0x50,
+	0x88, 0x85, 0x84, 0x5e, 0xda, 0x6b, 0x25, 0xab, 0x92, 0x75, 0x73, 0x31, 0xde, 0x89, 0xe1, 0x66,
+	0x8c, 0xc2, 0x0f, 0x81, 0x46, 0x10, 0x0d, 0x89, 0xf1, 0xbb, 0xa5, 0xfd, 0x32, 0x31, 0xda, 0x08,
+	0x89, 0xf6, 0x2a, 0x7f, 0x0b, 0xde, 0x6b, 0x84, 0x9e, 0x18, 0x47, 0x8a, 0x92, 0x4e, 0x1f, 0x0b,
+	0x2a, 0x8f, 0xb9, 0x98, 0x6b, 0x10, 0xfc, 0x04, 0xe4, 0xe9, 0xc4, 0x01, 0x49, 0xed, 0x61, 0x5b,
+	0xa5, 0x54, 0x25, 0xe3, 0x6e, 0xd1, 0xc5, 0x85, 0xe5, 0xdf, 0x2d, 0xb0, 0xed, 0x50, 0x3c, 0xa4,
+	0x3a, 0x40, 0x47, 0x71, 0x81, 0x7d, 0x0a, 0x3f, 0x02, 0x39, 0xa2, 0x41, 0xb4, 0xd8, 0xe4, 0x6c,
+	0x82, 0x1e, 0x9a, 0x56, 0x97, 0x40, 0xda, 0xe3, 0x41, 0xc0, 0x54, 0x40, 0x43, 0x25, 0xed, 0x55,
+	0xbd, 0xc5, 0x3c, 0x04, 0x7f, 0x02, 0x30, 0x9a, 0x25, 0x36, 0xc9, 0x25, 0x55, 0x4a, 0x55, 0xd2,
+	0x07, 0x9f, 0x57, 0x17, 0xd4, 0x52, 0x7d, 0x67, 0x4d, 0xee, 0xf6, 0x5c, 0x1c, 0x93, 0xfb, 0x09,
+	0x78, 0x78, 0x4e, 0x05, 0xeb, 0x31, 0x0f, 0x2b, 0xc6, 0xc3, 0x73, 0xea, 0x29, 0x2e, 0x3a, 0xa3,
+	0x6e, 0xc0, 0xa4, 0x64, 0x3c, 0x84, 0x1f, 0x83, 0x2d, 0x53, 0xc5, 0x05, 0x1e, 0x32, 0xc2, 0xd4,
+	0x58, 0x77, 0x61, 0xc3, 0x35, 0xc5, 0x9d, 0x1b, 0xb4, 0xfc, 0xc7, 0x3a, 0xc8, 0x34, 0x22, 0xee,
+	0xf5, 0xeb, 0xad, 0x8e, 0x83, 0x15, 0x86, 0xbb, 0x60, 0x83, 0xc6, 0x36, 0x62, 0x44, 0x57, 0xbe,
+	0xe6, 0xde, 0xd6, 0x76, 0x93, 0xc0, 0x32, 0xc8, 0x32, 0xa4, 0xb8, 0xc2, 0x43, 0x14, 0x1f, 0x8a,
+	0xd4, 0xd2, 0xca, 0xba, 0x69, 0xf6, 0x2c, 0xc6, 0x3a, 0x31, 0x14, 0x1f, 0xa3, 0x4a, 0x58, 0x44,
+	0xa8, 0x2f, 0x28, 0xd5, 0xe2, 0xc8, 0xba, 0x19, 0xa5, 0x79, 0x47, 0x63, 0xf0, 0x14, 0x64, 0xe6,
+	0x6a, 0x92, 0xf6, 0x9a, 0xee, 0xca, 0xfb, 0x4b, 0x5d, 0xb9, 0xa9, 0xfd, 0xfa, 0x5a, 0x2c, 0x63,
+	0x77, 0x61, 0x31, 0xfc, 0x12, 0x6c, 0x92, 0x81, 0x8f, 0xa2, 0x3e, 0x96, 0x54, 0x8b, 0x26, 0x77,
+	0xb0, 0xb3, 0x14, 0xc9, 0x39, 0x3d, 0x69, 0xc7, 0xb4, 0xbb, 0x41, 0x06, 0xbe, 0xfe, 0x07, 0xbf,
+	0x01, 0x0f, 0xe3, 0x56, 0xb0, 0xd0, 0xac, 0x44, 0x84, 0x62, 0x32, 0x64, 0x21, 0x45, 0xdd, 0x21,
+	0xf7, 0x06, 0xf6, 0x7a, 0xc9, 0xaa, 0xa4, 0xdc, 0x5d, 0xe3, 0xa3, 0xd7, 0x38, 0xc6, 0xa3, 0x1e,
+	0x3b, 0xc0, 0x23, 0x50, 0xbc, 0x88, 0x8f, 0x60, 0xfc, 0x9f, 0x21, 0x6e, 0xeb, 0x10, 0x0f, 0xa6,
+	0x5e, 0xff, 0x12, 0xa4, 0x02, 0xf2, 0xbe, 0xe0, 0xa3, 0x68, 0x7e, 0x9a, 0x36, 0xf4, 0x34, 0xe5,
+	0x34, 0x3e, 0x9b, 0xa4, 0x23, 0x90, 0x31, 0x27, 0x1a, 0x17, 0x2f, 0xed, 0x4d, 0xdd, 0xb2, 0xd2,
+	0x72, 0xa1, 0xcb, 0x7a, 0x76, 0xd3, 0x64, 0x0a, 0x49, 0xd8, 0x03, 0xf6, 0xc5, 0x9c, 0x6c, 0x90,
+	0x9c, 0x2a, 0x46, 0xda, 0x40, 0x07, 0xfc, 0x6c, 0x29, 0xe0, 0xbb, 0x54, 0xe6, 0xee, 0xcc, 0x07,
+	0x9b, 0xe1, 0x12, 0x7e, 0x00, 0xb2, 0x5a, 0x77, 0x28, 0xd9, 0x5c, 0xda, 0x69, 0x2d, 0xbe, 0x8c,
+	0x06, 0x93, 0x1c, 0x25, 0xdc, 0x07, 0x05, 0x6d, 0x9b, 0x54, 0x98, 0x1f, 0x62, 0x35, 0x12, 0xd4,
+	0xce, 0xe8, 0xfa, 0xef, 0xcc, 0xb8, 0xce, 0x84, 0x82, 0x7b, 0x20, 0x1d, 0x1f, 0x35, 0x56, 0x8a,
+	0x06, 0x91, 0xb2, 0xb3, 0x5a, 0x5a, 0x80, 0x0c, 0xfc, 0xc3, 0x04, 0x81, 0x67, 0x60, 0xab, 0x87,
+	0xd9, 0x90, 0x92, 0x89, 0x8f, 0xb4, 0x73, 0xba, 0xae, 0xbd, 0x9b, 0x8a, 0x30, 0x6b, 0x5c, 0xea,
+	0x71, 0x41, 0x8c, 0xb2, 0x72, 0xc9, 0x6a, 0x43, 0x49, 0xf8, 0x18, 0xdc, 0x1b, 0xd0, 0x31, 0xf2,
+	0xb0, 0x10, 0x8c, 0x12, 0xd4, 0x13, 0x3c, 0x40, 0x7a, 0x1c, 0xec, 0x2d, 0x3d, 0x1b, 0x77, 0x06,
+	0x74, 0x7c, 0x94, 0x90, 0xc7, 0x82, 0x07, 0x7a, 0x92, 0xca, 0xbf, 0xad, 0x82, 0xfc, 0x72, 0x7c,
+	0x7d, 0x6b, 0x9b, 0xb4, 0x2d, 0x9d, 0xf6, 0xc4, 0x84, 0xf7, 0xc0, 0xba, 0xa0, 0x58, 0xf2, 0x30,
+	0xb9, 0xaa, 0x5d, 0x63, 0xc5, 0xda, 0x98, 0xd6, 0x82, 0xfa, 0xc9, 0x65, 0x9e, 0xd2, 0x92, 0x9a,
+	0x66, 0xf9, 0x24, 0xb9, 0x97, 0x9f, 0x82, 0xbb, 0xf4, 0xd2, 0x1b, 0x8e, 0x08, 0x25, 0xe8, 0xc6,
+	0x5c, 0x6d, 0xd6, 0xed, 0x37, 0x2f, 0x1f, 0x15, 0xcc, 0xc5, 0x6e, 0xee, 0xaf, 0x8e, 0x12, 0x2c,
+	0xf4, 0xdd, 0xc2, 0x64, 0x59, 0x7b, 0x7e, 0xa0, 0x9e, 0x83, 0xad, 0x69, 0x38, 0xf3, 0x88, 0xdc,
+	0xfa, 0xbf, 0x8f, 0x48, 0x6e, 0x12, 0x29, 0x79, 0x42, 0x3e, 0xfd, 0xd5, 0x02, 0x1b, 0x93, 0x69,
+	0x84, 0x3b, 0xe0, 0x8e, 0x73, 0x7a, 0x82, 0xda, 0x4f, 0x0e, 0x3b, 0x0d, 0xf4, 0xfd, 0x99, 0xd3,
+	0x38, 0x6e, 0x9e, 0x35, 0x9c, 0xfc, 0x0a, 0xbc, 0x0b, 0xb6, 0x67, 0x84, 0xd3, 0x38, 0x6c, 0x35,
+	0xcf, 0x4e, 0xf2, 0xd6, 0xa2, 0xff, 0x79, 0xc3, 0x6d, 0x1e, 0xff, 0x18, 0x13, 0xab, 0x8b, 0xc4,
+	0xd1, 0x77, 0x4f, 0xdb, 0xad, 0xc6, 0xb3, 0x86, 0x93, 0x4f, 0xc1, 0x82, 0x3e, 0x09, 0x43, 0x1c,
+	0x1f, 0x36, 0x5b, 0x0d, 0x27, 0xbf, 0xb6, 0x88, 0x76, 0x9a, 0x27, 0xf1, 0xa6, 0xb7, 0xea, 0xcd,
+	0x57, 0x57, 0x45, 0xeb, 0xf5, 0x55, 0xd1, 0xfa, 0xfb, 0xaa, 0x68, 0xbd, 0xb8, 0x2e, 0xae, 0xbc,
+	0xbe, 0x2e, 0xae, 0xfc, 0x79, 0x5d, 0x5c, 0x79, 0x5e, 0xf3, 0x99, 0xea, 0x8f, 0xba, 0x55, 0x8f,
+	0x07, 0xb5, 0x48, 0x70, 0x32, 0xf2, 0x94, 0xf4, 0x98, 0xfe, 0x0c, 0x98, 0x7d, 0x10, 0x5c, 0xce,
+	0x3e, 0x09, 0xba, 0xeb, 0xfa, 0x01, 0x7f, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x37, 0x36,
+	0x4e, 0xfa, 0x30, 0x08, 0x00, 0x00,
 }
 
 func (m *BLSParticipantInfo) Marshal() (dAtA []byte, err error) {
@@ -729,6 +845,30 @@ func (m *EpochBLSData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KeyCarriedFromEpoch != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.KeyCarriedFromEpoch))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.FailedAttempts) > 0 {
+		for iNdEx := len(m.FailedAttempts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedAttempts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.DkgAttempt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DkgAttempt))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.ValidationSignature) > 0 {
 		i -= len(m.ValidationSignature)
 		copy(dAtA[i:], m.ValidationSignature)
@@ -831,6 +971,65 @@ func (m *EpochBLSData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DKGAttemptRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGAttemptRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGAttemptRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExcludedWeight.Size()
+		i -= size
+		if _, err := m.ExcludedWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ExcludedParticipants) > 0 {
+		for iNdEx := len(m.ExcludedParticipants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludedParticipants[iNdEx])
+			copy(dAtA[i:], m.ExcludedParticipants[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.ExcludedParticipants[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.FailedAtHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FailedAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -972,6 +1171,45 @@ func (m *EpochBLSData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.DkgAttempt != 0 {
+		n += 1 + sovTypes(uint64(m.DkgAttempt))
+	}
+	if len(m.FailedAttempts) > 0 {
+		for _, e := range m.FailedAttempts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.KeyCarriedFromEpoch != 0 {
+		n += 1 + sovTypes(uint64(m.KeyCarriedFromEpoch))
+	}
+	return n
+}
+
+func (m *DKGAttemptRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attempt != 0 {
+		n += 1 + sovTypes(uint64(m.Attempt))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.FailedAtHeight != 0 {
+		n += 1 + sovTypes(uint64(m.FailedAtHeight))
+	}
+	if len(m.ExcludedParticipants) > 0 {
+		for _, s := range m.ExcludedParticipants {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.ExcludedWeight.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -1902,6 +2140,264 @@ func (m *EpochBLSData) Unmarshal(dAtA []byte) error {
 				m.ValidationSignature = []byte{}
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgAttempt", wireType)
+			}
+			m.DkgAttempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgAttempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedAttempts = append(m.FailedAttempts, DKGAttemptRecord{})
+			if err := m.FailedAttempts[len(m.FailedAttempts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCarriedFromEpoch", wireType)
+			}
+			m.KeyCarriedFromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyCarriedFromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGAttemptRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGAttemptRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGAttemptRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAtHeight", wireType)
+			}
+			m.FailedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludedParticipants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludedParticipants = append(m.ExcludedParticipants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludedWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExcludedWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
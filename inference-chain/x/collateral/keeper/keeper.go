@@ -35,6 +35,8 @@ type (
 
 		bankViewKeeper        types.BankKeeper
 		bookkeepingBankKeeper types.BookkeepingBankKeeper
+		distributionKeeper    types.DistributionKeeper
+		vestingKeeper         types.StreamVestingKeeper
 		params                collections.Item[types.Params]
 		CollateralMap         collections.Map[sdk.AccAddress, sdk.Coin]
 		Schema                collections.Schema
@@ -42,6 +44,12 @@ type (
 		Jailed                collections.KeySet[sdk.AccAddress]
 		// SlashedInEpoch tracks whether a participant has been slashed for a given reason in a given epoch
 		SlashedInEpoch collections.KeySet[collections.Triple[uint64, sdk.AccAddress, string]]
+		// CompensationPoolItem holds slashed collateral reserved for reimbursing requesters
+		CompensationPoolItem collections.Item[sdk.Coin]
+		// SlashDistributionsMap tracks the cumulative slashed amount per (reason, destination)
+		SlashDistributionsMap collections.Map[collections.Pair[string, int32], sdk.Coin]
+		// VestingPledgesMap holds the participants whose unvested rewards count as collateral
+		VestingPledgesMap collections.Map[sdk.AccAddress, types.VestingPledge]
 
 		// UnbondingIM is an IndexedMap with primary key Pair[completionEpoch, participant]
 		UnbondingIM collections.IndexedMap[collections.Pair[uint64, sdk.AccAddress], types.UnbondingCollateral, UnbondingIndexes]
@@ -56,6 +64,8 @@ func NewKeeper(
 
 	bankKeeper types.BankKeeper,
 	bookkeepingBankKeeper types.BookkeepingBankKeeper,
+	distributionKeeper types.DistributionKeeper,
+	vestingKeeper types.StreamVestingKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -79,11 +89,16 @@ func NewKeeper(
 
 		bankViewKeeper:        bankKeeper,
 		bookkeepingBankKeeper: bookkeepingBankKeeper,
+		distributionKeeper:    distributionKeeper,
+		vestingKeeper:         vestingKeeper,
 		params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		CollateralMap:         collections.NewMap(sb, types.CollateralKey, "collateral", sdk.AccAddressKey, codec.CollValue[sdk.Coin](cdc)),
 		CurrentEpoch:          collections.NewItem(sb, types.CurrentEpochKey, "current_epoch", collections.Uint64Value),
 		Jailed:                collections.NewKeySet(sb, types.JailedKey, "jailed", sdk.AccAddressKey),
 		SlashedInEpoch:        collections.NewKeySet(sb, types.SlashedInEpochKey, "slashed_in_epoch", collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, collections.StringKey)),
+		CompensationPoolItem:  collections.NewItem(sb, types.CompensationPoolKey, "compensation_pool", codec.CollValue[sdk.Coin](cdc)),
+		SlashDistributionsMap: collections.NewMap(sb, types.SlashDistributionsKey, "slash_distributions", collections.PairKeyCodec(collections.StringKey, collections.Int32Key), codec.CollValue[sdk.Coin](cdc)),
+		VestingPledgesMap:     collections.NewMap(sb, types.VestingPledgesKey, "vesting_pledges", sdk.AccAddressKey, codec.CollValue[types.VestingPledge](cdc)),
 		UnbondingIM: *collections.NewIndexedMap(
 			sb,
 			types.UnbondingCollPrefix,
@@ -274,6 +289,7 @@ func (k Keeper) AdvanceEpoch(ctx context.Context, completedEpoch uint64) {
 
 	// Process unbonding queue for the epoch that just finished
 	k.ProcessUnbondingQueue(sdkCtx, completedEpoch)
+	k.ReleaseVestingPledges(sdkCtx, completedEpoch)
 
 	// Increment the epoch counter
 	nextEpoch := completedEpoch + 1
@@ -383,10 +399,17 @@ func (k Keeper) GetAllJailed(ctx sdk.Context) []sdk.AccAddress {
 	return result
 }
 
-// Slash penalizes a participant by burning a fraction of their total collateral.
-// This includes both their active collateral and any collateral in the unbonding queue.
-// The slash is applied proportionally to all holdings.
+// Slash penalizes a participant by removing a fraction of their total collateral.
+// This includes their active collateral, any collateral in the unbonding queue and pledged vesting rewards.
+// The slash is applied proportionally to all holdings, and the slashed coins are sent
+// to the destination configured for the reason (burned by default).
 func (k Keeper) Slash(ctx context.Context, participantAddress sdk.AccAddress, slashFraction math.LegacyDec, reason string) (sdk.Coin, error) {
+	return k.SlashWithBeneficiaries(ctx, participantAddress, slashFraction, reason, nil)
+}
+
+// SlashWithBeneficiaries works like Slash, but also takes the validators who caught the offence.
+// They receive the slashed coins when the reason's destination is SLASH_DESTINATION_VALIDATORS.
+func (k Keeper) SlashWithBeneficiaries(ctx context.Context, participantAddress sdk.AccAddress, slashFraction math.LegacyDec, reason string, beneficiaries []sdk.AccAddress) (sdk.Coin, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	if slashFraction.IsNegative() || slashFraction.GT(math.LegacyOneDec()) {
 		return sdk.Coin{}, fmt.Errorf("slash fraction must be between 0 and 1, got %s", slashFraction)
@@ -436,12 +459,18 @@ func (k Keeper) Slash(ctx context.Context, participantAddress sdk.AccAddress, sl
 		}
 	}
 
-	// 3. Burn the total slashed amount from the module account
+	// 3. Slash pledged vesting rewards, taking from the furthest unlocks first
+	vestingSlashed, err := k.slashVestingPledge(ctx, participantAddress, slashFraction, reason)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	totalSlashedAmount = totalSlashedAmount.Add(vestingSlashed)
+
+	// 4. Send the total slashed amount to its configured destination
 	if !totalSlashedAmount.IsZero() {
-		err := k.bookkeepingBankKeeper.BurnCoins(sdkCtx, types.ModuleName, sdk.NewCoins(totalSlashedAmount), "collateral_slashed:"+reason)
+		err := k.distributeSlashedCoins(sdkCtx, participantAddress, totalSlashedAmount, reason, beneficiaries)
 		if err != nil {
-			// This is a critical error, indicating an issue with the module account or supply
-			return sdk.Coin{}, fmt.Errorf("failed to burn slashed coins: %w", err)
+			return sdk.Coin{}, err
 		}
 
 		if key != nil {
@@ -451,7 +480,7 @@ func (k Keeper) Slash(ctx context.Context, participantAddress sdk.AccAddress, sl
 			}
 		}
 
-		// 4. Emit a slash event
+		// 5. Emit a slash event
 		sdkCtx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeSlashCollateral,
@@ -18,6 +18,7 @@ type KeeperTestSuite struct {
 	ctx        sdk.Context
 	k          keeper.Keeper
 	bankKeeper *testkeeper.MockBookkeepingBankKeeper
+	vesting    *testkeeper.MockCollateralStreamVestingKeeper
 	msgServer  types.MsgServer
 }
 
@@ -27,6 +28,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.ctx = ctx
 	s.k = k
 	s.bankKeeper = mocks.BankKeeper
+	s.vesting = mocks.StreamVestingKeeper
 	s.msgServer = keeper.NewMsgServerImpl(s.k)
 }
 
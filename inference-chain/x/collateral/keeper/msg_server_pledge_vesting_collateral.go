@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/collateral/types"
+)
+
+func (k msgServer) PledgeVestingCollateral(goCtx context.Context, msg *types.MsgPledgeVestingCollateral) (*types.MsgPledgeVestingCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	participantAddr, err := sdk.AccAddressFromBech32(msg.Participant)
+	if err != nil {
+		return nil, err
+	}
+
+	pledge, found := k.GetVestingPledge(ctx, participantAddr)
+	if found && pledge.ReleaseEpoch == 0 {
+		return nil, types.ErrVestingPledgeExists.Wrapf("participant %s", msg.Participant)
+	}
+
+	pledgedAmount := k.unvestedBalance(ctx, participantAddr)
+	if !pledgedAmount.IsPositive() {
+		return nil, types.ErrNoVestingBalance.Wrapf("participant %s has no unvested rewards", msg.Participant)
+	}
+
+	// Re-pledging a releasing schedule cancels the release
+	k.SetVestingPledge(ctx, types.VestingPledge{
+		Participant:  msg.Participant,
+		PledgedEpoch: k.GetCurrentEpoch(ctx),
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePledgeVesting,
+			sdk.NewAttribute(types.AttributeKeyParticipant, msg.Participant),
+			sdk.NewAttribute(types.AttributeKeyAmount, pledgedAmount.String()),
+		),
+	})
+
+	k.Logger().Info("vesting collateral pledged",
+		"participant", msg.Participant,
+		"pledged_amount", pledgedAmount.String(),
+	)
+
+	return &types.MsgPledgeVestingCollateralResponse{PledgedAmount: pledgedAmount}, nil
+}
+
+func (k msgServer) UnpledgeVestingCollateral(goCtx context.Context, msg *types.MsgUnpledgeVestingCollateral) (*types.MsgUnpledgeVestingCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	participantAddr, err := sdk.AccAddressFromBech32(msg.Participant)
+	if err != nil {
+		return nil, err
+	}
+
+	pledge, found := k.GetVestingPledge(ctx, participantAddr)
+	if !found || pledge.ReleaseEpoch != 0 {
+		return nil, types.ErrVestingPledgeNotFound.Wrapf("participant %s", msg.Participant)
+	}
+
+	// The pledge stops counting toward weight now, but stays slashable for the unbonding period
+	pledge.ReleaseEpoch = k.GetCurrentEpoch(ctx) + k.GetParams(ctx).UnbondingPeriodEpochs
+	k.SetVestingPledge(ctx, pledge)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnpledgeVesting,
+			sdk.NewAttribute(types.AttributeKeyParticipant, msg.Participant),
+			sdk.NewAttribute(types.AttributeKeyReleaseEpoch, strconv.FormatUint(pledge.ReleaseEpoch, 10)),
+		),
+	})
+
+	k.Logger().Info("vesting collateral unpledged",
+		"participant", msg.Participant,
+		"release_epoch", pledge.ReleaseEpoch,
+	)
+
+	return &types.MsgUnpledgeVestingCollateralResponse{ReleaseEpoch: pledge.ReleaseEpoch}, nil
+}
@@ -99,3 +99,42 @@ func (k Keeper) AllUnbondingCollaterals(c context.Context, req *types.QueryAllUn
 
 	return &types.QueryAllUnbondingCollateralsResponse{Unbondings: allUnbondings, Pagination: pageRes}, nil
 }
+
+func (k Keeper) CompensationPool(c context.Context, req *types.QueryCompensationPoolRequest) (*types.QueryCompensationPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryCompensationPoolResponse{Balance: k.GetCompensationPool(c)}, nil
+}
+
+func (k Keeper) SlashDistributions(c context.Context, req *types.QuerySlashDistributionsRequest) (*types.QuerySlashDistributionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QuerySlashDistributionsResponse{Distributions: k.GetAllSlashDistributions(c)}, nil
+}
+
+func (k Keeper) VestingPledge(c context.Context, req *types.QueryVestingPledgeRequest) (*types.QueryVestingPledgeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	participantAddr, err := sdk.AccAddressFromBech32(req.Participant)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid participant address: %v", err)
+	}
+
+	pledge, found := k.GetVestingPledge(c, participantAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "vesting pledge not found for participant %s", req.Participant)
+	}
+	effective, _ := k.GetEffectiveCollateral(c, participantAddr)
+
+	return &types.QueryVestingPledgeResponse{
+		Pledge:              pledge,
+		PledgedAmount:       k.GetPledgedVestingCollateral(c, participantAddr),
+		EffectiveCollateral: effective,
+	}, nil
+}
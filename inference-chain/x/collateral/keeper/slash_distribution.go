@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/productscience/inference/x/collateral/types"
+	inferencetypes "github.com/productscience/inference/x/inference/types"
+)
+
+// distributeSlashedCoins sends slashed coins, already removed from the participant's balances
+// but still held by the module account, to the destination configured for the slash reason.
+func (k Keeper) distributeSlashedCoins(ctx sdk.Context, participantAddress sdk.AccAddress, amount sdk.Coin, reason string, beneficiaries []sdk.AccAddress) error {
+	destination := k.GetParams(ctx).GetSlashDestination(reason)
+	memo := "collateral_slashed:" + reason
+
+	switch destination {
+	case types.SlashDestination_SLASH_DESTINATION_COMMUNITY_POOL:
+		if k.distributionKeeper == nil {
+			k.Logger().Warn("distribution keeper not available, burning slashed coins", "reason", reason)
+			destination = types.SlashDestination_SLASH_DESTINATION_BURN
+			break
+		}
+		moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+		if err := k.distributionKeeper.FundCommunityPool(ctx, sdk.NewCoins(amount), moduleAddress); err != nil {
+			return fmt.Errorf("failed to fund community pool with slashed coins: %w", err)
+		}
+		k.bookkeepingBankKeeper.LogSubAccountTransaction(ctx, "community_pool", participantAddress.String(), types.SubAccountCollateral, amount, memo)
+	case types.SlashDestination_SLASH_DESTINATION_VALIDATORS:
+		if len(beneficiaries) == 0 {
+			k.Logger().Info("no validators to reward for slash, burning slashed coins", "reason", reason)
+			destination = types.SlashDestination_SLASH_DESTINATION_BURN
+			break
+		}
+		remainder, err := k.splitAmongBeneficiaries(ctx, amount, beneficiaries, memo)
+		if err != nil {
+			return err
+		}
+		// Integer division dust is burned so the module account stays balanced
+		if !remainder.IsZero() {
+			if err := k.bookkeepingBankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(remainder), memo); err != nil {
+				return fmt.Errorf("failed to burn slashed coins remainder: %w", err)
+			}
+		}
+	case types.SlashDestination_SLASH_DESTINATION_COMPENSATION_POOL:
+		if err := k.AddToCompensationPool(ctx, amount); err != nil {
+			return err
+		}
+		k.bookkeepingBankKeeper.LogSubAccountTransaction(ctx, types.ModuleName, participantAddress.String(), types.SubAccountCompensation, amount, memo)
+	}
+
+	if destination == types.SlashDestination_SLASH_DESTINATION_BURN {
+		err := k.bookkeepingBankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount), memo)
+		if err != nil {
+			// This is a critical error, indicating an issue with the module account or supply
+			return fmt.Errorf("failed to burn slashed coins: %w", err)
+		}
+	}
+
+	if err := k.addSlashDistribution(ctx, reason, destination, amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributeSlash,
+			sdk.NewAttribute(types.AttributeKeyParticipant, participantAddress.String()),
+			sdk.NewAttribute(types.AttributeKeySlashAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeySlashReason, reason),
+			sdk.NewAttribute(types.AttributeKeyDestination, destination.String()),
+		),
+	)
+	return nil
+}
+
+// splitAmongBeneficiaries sends an equal share of amount to each beneficiary and returns the undistributed remainder.
+func (k Keeper) splitAmongBeneficiaries(ctx sdk.Context, amount sdk.Coin, beneficiaries []sdk.AccAddress, memo string) (sdk.Coin, error) {
+	share := amount.Amount.QuoRaw(int64(len(beneficiaries)))
+	remainder := amount
+	if share.IsZero() {
+		return remainder, nil
+	}
+	shareCoin := sdk.NewCoin(amount.Denom, share)
+	for _, beneficiary := range beneficiaries {
+		err := k.bookkeepingBankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, beneficiary, sdk.NewCoins(shareCoin), memo)
+		if err != nil {
+			return sdk.Coin{}, fmt.Errorf("failed to send slashed coins to validator %s: %w", beneficiary.String(), err)
+		}
+		remainder = remainder.Sub(shareCoin)
+	}
+	return remainder, nil
+}
+
+func (k Keeper) addSlashDistribution(ctx context.Context, reason string, destination types.SlashDestination, amount sdk.Coin) error {
+	key := collections.Join(reason, int32(destination))
+	total, err := k.SlashDistributionsMap.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		total = sdk.NewCoin(amount.Denom, math.ZeroInt())
+	}
+	return k.SlashDistributionsMap.Set(ctx, key, total.Add(amount))
+}
+
+// GetAllSlashDistributions returns the cumulative slashed amounts per reason and destination
+func (k Keeper) GetAllSlashDistributions(ctx context.Context) []types.SlashDistribution {
+	var list []types.SlashDistribution
+	err := k.SlashDistributionsMap.Walk(ctx, nil, func(key collections.Pair[string, int32], amount sdk.Coin) (bool, error) {
+		list = append(list, types.SlashDistribution{
+			Reason:      key.K1(),
+			Destination: types.SlashDestination(key.K2()),
+			Amount:      amount,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return list
+}
+
+// SetSlashDistribution overwrites the cumulative slashed amount for a reason and destination (for genesis import)
+func (k Keeper) SetSlashDistribution(ctx context.Context, distribution types.SlashDistribution) {
+	err := k.SlashDistributionsMap.Set(ctx, collections.Join(distribution.Reason, int32(distribution.Destination)), distribution.Amount)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetCompensationPool returns the balance of the compensation pool
+func (k Keeper) GetCompensationPool(ctx context.Context) sdk.Coin {
+	pool, err := k.CompensationPoolItem.Get(ctx)
+	if err != nil {
+		return sdk.NewCoin(inferencetypes.BaseCoin, math.ZeroInt())
+	}
+	return pool
+}
+
+// SetCompensationPool overwrites the balance of the compensation pool
+func (k Keeper) SetCompensationPool(ctx context.Context, pool sdk.Coin) {
+	err := k.CompensationPoolItem.Set(ctx, pool)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// AddToCompensationPool adds coins already held by the module account to the compensation pool
+func (k Keeper) AddToCompensationPool(ctx context.Context, amount sdk.Coin) error {
+	pool := k.GetCompensationPool(ctx)
+	if pool.Denom != amount.Denom {
+		return fmt.Errorf("compensation pool denom %s does not match %s", pool.Denom, amount.Denom)
+	}
+	k.SetCompensationPool(ctx, pool.Add(amount))
+	return nil
+}
+
+// CompensateRequester pays a requester from the compensation pool. The payout is the base amount
+// multiplied by the CompensationRatio param, capped by the pool balance. It returns the amount paid.
+func (k Keeper) CompensateRequester(ctx context.Context, requester sdk.AccAddress, baseAmount sdk.Coin, memo string) (sdk.Coin, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pool := k.GetCompensationPool(ctx)
+	ratio := k.GetParams(ctx).GetCompensationRatio()
+
+	payout := sdk.NewCoin(pool.Denom, math.ZeroInt())
+	if baseAmount.Denom != pool.Denom || ratio.IsZero() || pool.IsZero() {
+		return payout, nil
+	}
+	payout.Amount = math.MinInt(math.LegacyNewDecFromInt(baseAmount.Amount).Mul(ratio).TruncateInt(), pool.Amount)
+	if payout.IsZero() {
+		return payout, nil
+	}
+
+	err := k.bookkeepingBankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, requester, sdk.NewCoins(payout), memo)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("failed to pay compensation: %w", err)
+	}
+	k.bookkeepingBankKeeper.LogSubAccountTransaction(ctx, requester.String(), types.ModuleName, types.SubAccountCompensation, payout, memo)
+	k.SetCompensationPool(ctx, pool.Sub(payout))
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompensateRequester,
+			sdk.NewAttribute(types.AttributeKeyRecipient, requester.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, payout.String()),
+			sdk.NewAttribute(types.AttributeKeyMemo, memo),
+		),
+	)
+
+	k.Logger().Info("compensated requester from compensation pool",
+		"requester", requester.String(),
+		"amount", payout.String(),
+		"memo", memo,
+	)
+	return payout, nil
+}
+
+// ReclaimCompensation takes a compensation paid by CompensateRequester back from the requester and returns it to the
+// compensation pool. It fails without side effects if the requester can no longer cover it.
+func (k Keeper) ReclaimCompensation(ctx context.Context, requester sdk.AccAddress, amount sdk.Coin, memo string) error {
+	pool := k.GetCompensationPool(ctx)
+	if pool.Denom != amount.Denom {
+		return fmt.Errorf("compensation pool denom %s does not match %s", pool.Denom, amount.Denom)
+	}
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	err := k.bookkeepingBankKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleName, sdk.NewCoins(amount), memo)
+	if err != nil {
+		return fmt.Errorf("failed to reclaim compensation: %w", err)
+	}
+	k.bookkeepingBankKeeper.LogSubAccountTransaction(ctx, types.ModuleName, requester.String(), types.SubAccountCompensation, amount, memo)
+	k.SetCompensationPool(ctx, pool.Add(amount))
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReclaimCompensation,
+			sdk.NewAttribute(types.AttributeKeyParticipant, requester.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyMemo, memo),
+		),
+	)
+
+	k.Logger().Info("reclaimed requester compensation into compensation pool",
+		"requester", requester.String(),
+		"amount", amount.String(),
+		"memo", memo,
+	)
+	return nil
+}
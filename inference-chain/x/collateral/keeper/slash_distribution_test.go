@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/collateral/types"
+	inftypes "github.com/productscience/inference/x/inference/types"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *KeeperTestSuite) setSlashDestination(reason string, destination types.SlashDestination) {
+	params := s.k.GetParams(s.ctx)
+	params.SlashDestinations = []types.SlashDestinationRule{{Reason: reason, Destination: destination}}
+	params.CompensationRatio = math.LegacyNewDecWithPrec(50, 2) // 50%
+	s.Require().NoError(s.k.SetParams(s.ctx, params))
+}
+
+func (s *KeeperTestSuite) TestSlashing_ToCompensationPool() {
+	participant, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	s.Require().NoError(err)
+	s.k.SetCollateral(s.ctx, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 1000))
+	s.setSlashDestination(inftypes.SlashReasonInvalidation, types.SlashDestination_SLASH_DESTINATION_COMPENSATION_POOL)
+
+	// Nothing is burned, the coins stay in the module account
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.bankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), types.ModuleName, participant.String(), types.SubAccountCompensation, gomock.Any(), gomock.Any()).Times(1)
+
+	slashed, err := s.k.Slash(s.ctx, participant, math.LegacyNewDecWithPrec(10, 2), inftypes.SlashReasonInvalidation)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(100), slashed.Amount)
+	s.Require().Equal(math.NewInt(100), s.k.GetCompensationPool(s.ctx).Amount)
+
+	distributions := s.k.GetAllSlashDistributions(s.ctx)
+	s.Require().Len(distributions, 1)
+	s.Require().Equal(inftypes.SlashReasonInvalidation, distributions[0].Reason)
+	s.Require().Equal(types.SlashDestination_SLASH_DESTINATION_COMPENSATION_POOL, distributions[0].Destination)
+	s.Require().Equal(math.NewInt(100), distributions[0].Amount.Amount)
+
+	// Requester is paid half the inference cost, capped by the pool balance
+	requester, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	s.Require().NoError(err)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, requester, sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 60)), gomock.Any()).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), requester.String(), types.ModuleName, types.SubAccountCompensation, gomock.Any(), gomock.Any()).Times(1)
+	paid, err := s.k.CompensateRequester(s.ctx, requester, sdk.NewInt64Coin(inftypes.BaseCoin, 120), "test")
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(60), paid.Amount)
+	s.Require().Equal(math.NewInt(40), s.k.GetCompensationPool(s.ctx).Amount)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, requester, sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 40)), gomock.Any()).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), requester.String(), types.ModuleName, types.SubAccountCompensation, gomock.Any(), gomock.Any()).Times(1)
+	paid, err = s.k.CompensateRequester(s.ctx, requester, sdk.NewInt64Coin(inftypes.BaseCoin, 120), "test")
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(40), paid.Amount)
+	s.Require().True(s.k.GetCompensationPool(s.ctx).IsZero())
+}
+
+func (s *KeeperTestSuite) TestReclaimCompensation() {
+	requester, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	s.Require().NoError(err)
+	s.k.SetCompensationPool(s.ctx, sdk.NewInt64Coin(inftypes.BaseCoin, 40))
+
+	amount := sdk.NewInt64Coin(inftypes.BaseCoin, 60)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), requester, types.ModuleName, sdk.NewCoins(amount), "test").Return(nil).Times(1)
+	s.bankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), types.ModuleName, requester.String(), types.SubAccountCompensation, amount, "test").Times(1)
+	s.Require().NoError(s.k.ReclaimCompensation(s.ctx, requester, amount, "test"))
+	s.Require().Equal(math.NewInt(100), s.k.GetCompensationPool(s.ctx).Amount)
+
+	// A requester that already spent the compensation leaves the pool untouched
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), requester, types.ModuleName, sdk.NewCoins(amount), "test").Return(fmt.Errorf("insufficient funds")).Times(1)
+	s.Require().Error(s.k.ReclaimCompensation(s.ctx, requester, amount, "test"))
+	s.Require().Equal(math.NewInt(100), s.k.GetCompensationPool(s.ctx).Amount)
+}
+
+func (s *KeeperTestSuite) TestSlashing_ToValidators() {
+	participant, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	s.Require().NoError(err)
+	s.k.SetCollateral(s.ctx, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 1002))
+	s.setSlashDestination(inftypes.SlashReasonInvalidation, types.SlashDestination_SLASH_DESTINATION_VALIDATORS)
+
+	validator1, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	s.Require().NoError(err)
+	validator2, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	s.Require().NoError(err)
+
+	share := sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 250))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, validator1, share, "collateral_slashed:invalidation").Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, validator2, share, "collateral_slashed:invalidation").Return(nil).Times(1)
+	// The remainder that cannot be split evenly is burned
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 1)), gomock.Any()).Return(nil).Times(1)
+
+	slashed, err := s.k.SlashWithBeneficiaries(s.ctx, participant, math.LegacyNewDecWithPrec(50, 2), inftypes.SlashReasonInvalidation, []sdk.AccAddress{validator1, validator2})
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(501), slashed.Amount)
+}
+
+func (s *KeeperTestSuite) TestSlashing_ToValidatorsWithoutBeneficiariesBurns() {
+	participant, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	s.Require().NoError(err)
+	s.k.SetCollateral(s.ctx, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 1000))
+	s.setSlashDestination(inftypes.SlashReasonDowntime, types.SlashDestination_SLASH_DESTINATION_VALIDATORS)
+
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 100)), "collateral_slashed:downtime").Return(nil).Times(1)
+
+	_, err = s.k.Slash(s.ctx, participant, math.LegacyNewDecWithPrec(10, 2), inftypes.SlashReasonDowntime)
+	s.Require().NoError(err)
+
+	distributions := s.k.GetAllSlashDistributions(s.ctx)
+	s.Require().Len(distributions, 1)
+	s.Require().Equal(types.SlashDestination_SLASH_DESTINATION_BURN, distributions[0].Destination)
+}
+
+func (s *KeeperTestSuite) TestParams_SlashDestinationsValidation() {
+	params := types.DefaultParams()
+	params.SlashDestinations = []types.SlashDestinationRule{
+		{Reason: inftypes.SlashReasonInvalidation, Destination: types.SlashDestination_SLASH_DESTINATION_COMMUNITY_POOL},
+		{Reason: inftypes.SlashReasonInvalidation, Destination: types.SlashDestination_SLASH_DESTINATION_BURN},
+	}
+	s.Require().Error(params.Validate())
+
+	params.SlashDestinations = []types.SlashDestinationRule{{Reason: "", Destination: types.SlashDestination_SLASH_DESTINATION_BURN}}
+	s.Require().Error(params.Validate())
+
+	params.SlashDestinations = []types.SlashDestinationRule{{Reason: inftypes.SlashReasonDowntime, Destination: types.SlashDestination(42)}}
+	s.Require().Error(params.Validate())
+
+	params.SlashDestinations = nil
+	params.CompensationRatio = math.LegacyNewDec(-1)
+	s.Require().Error(params.Validate())
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/collateral/types"
+	inferencetypes "github.com/productscience/inference/x/inference/types"
+)
+
+// SetVestingPledge stores a participant's vesting pledge
+func (k Keeper) SetVestingPledge(ctx context.Context, pledge types.VestingPledge) {
+	participantAddr, err := sdk.AccAddressFromBech32(pledge.Participant)
+	if err != nil {
+		panic(err)
+	}
+	if err := k.VestingPledgesMap.Set(ctx, participantAddr, pledge); err != nil {
+		panic(err)
+	}
+}
+
+// GetVestingPledge retrieves a participant's vesting pledge, active or releasing
+func (k Keeper) GetVestingPledge(ctx context.Context, participantAddress sdk.AccAddress) (types.VestingPledge, bool) {
+	pledge, err := k.VestingPledgesMap.Get(ctx, participantAddress)
+	return pledge, err == nil
+}
+
+// RemoveVestingPledge removes a participant's vesting pledge
+func (k Keeper) RemoveVestingPledge(ctx context.Context, participantAddress sdk.AccAddress) {
+	if err := k.VestingPledgesMap.Remove(ctx, participantAddress); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+}
+
+// GetAllVestingPledges returns all vesting pledges (for genesis export)
+func (k Keeper) GetAllVestingPledges(ctx context.Context) []types.VestingPledge {
+	iter, err := k.VestingPledgesMap.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	values, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return values
+}
+
+// GetPledgedVestingCollateral returns the unvested balance covered by a participant's pledge.
+// A releasing pledge is still reported, since it can be slashed until its release epoch completes.
+func (k Keeper) GetPledgedVestingCollateral(ctx context.Context, participantAddress sdk.AccAddress) sdk.Coin {
+	if _, found := k.GetVestingPledge(ctx, participantAddress); !found {
+		return sdk.NewCoin(inferencetypes.BaseCoin, math.ZeroInt())
+	}
+	return k.unvestedBalance(ctx, participantAddress)
+}
+
+func (k Keeper) unvestedBalance(ctx context.Context, participantAddress sdk.AccAddress) sdk.Coin {
+	if k.vestingKeeper == nil {
+		return sdk.NewCoin(inferencetypes.BaseCoin, math.ZeroInt())
+	}
+	return sdk.NewCoin(inferencetypes.BaseCoin, k.vestingKeeper.GetVestingBalance(ctx, participantAddress.String()).AmountOf(inferencetypes.BaseCoin))
+}
+
+// GetEffectiveCollateral returns the collateral that counts toward a participant's weight:
+// the deposited collateral plus the unvested balance of an active vesting pledge.
+func (k Keeper) GetEffectiveCollateral(ctx context.Context, participantAddress sdk.AccAddress) (sdk.Coin, bool) {
+	collateral, found := k.GetCollateral(ctx, participantAddress)
+	if !found {
+		collateral = sdk.NewCoin(inferencetypes.BaseCoin, math.ZeroInt())
+	}
+	pledge, pledged := k.GetVestingPledge(ctx, participantAddress)
+	if !pledged || pledge.ReleaseEpoch != 0 {
+		return collateral, found
+	}
+	vesting := k.GetPledgedVestingCollateral(ctx, participantAddress)
+	return collateral.Add(vesting), found || vesting.IsPositive()
+}
+
+// ReleaseVestingPledges removes the pledges whose release epoch has completed
+func (k Keeper) ReleaseVestingPledges(ctx sdk.Context, completedEpoch uint64) {
+	for _, pledge := range k.GetAllVestingPledges(ctx) {
+		if pledge.ReleaseEpoch == 0 || pledge.ReleaseEpoch > completedEpoch {
+			continue
+		}
+		participantAddr, err := sdk.AccAddressFromBech32(pledge.Participant)
+		if err != nil {
+			k.Logger().Error("failed to parse participant address during pledge release", "participant", pledge.Participant, "error", err)
+			continue
+		}
+		k.RemoveVestingPledge(ctx, participantAddr)
+		k.Logger().Info("released vesting pledge", "participant", pledge.Participant, "release_epoch", pledge.ReleaseEpoch)
+	}
+}
+
+// slashVestingPledge slashes a fraction of the unvested balance covered by the participant's pledge
+// and moves it into the collateral module account, so it is distributed with the rest of the slash.
+func (k Keeper) slashVestingPledge(ctx context.Context, participantAddress sdk.AccAddress, slashFraction math.LegacyDec, reason string) (sdk.Coin, error) {
+	pledged := k.GetPledgedVestingCollateral(ctx, participantAddress)
+	slashAmount := sdk.NewCoin(pledged.Denom, math.LegacyNewDecFromInt(pledged.Amount).Mul(slashFraction).TruncateInt())
+	if slashAmount.IsZero() {
+		return slashAmount, nil
+	}
+	return k.vestingKeeper.SlashVestingSchedule(ctx, participantAddress.String(), slashAmount, types.ModuleName, "collateral_slashed:"+reason)
+}
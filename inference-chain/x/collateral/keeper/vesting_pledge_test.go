@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/mock/gomock"
+
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/collateral/types"
+	inftypes "github.com/productscience/inference/x/inference/types"
+)
+
+func (s *KeeperTestSuite) TestVestingPledge_CountsTowardEffectiveCollateral() {
+	participant, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	s.Require().NoError(err)
+	s.k.SetCurrentEpoch(s.ctx, 1)
+	s.k.SetCollateral(s.ctx, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 100))
+	s.vesting.EXPECT().GetVestingBalance(gomock.Any(), participant.String()).Return(sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 400))).AnyTimes()
+
+	effective, found := s.k.GetEffectiveCollateral(s.ctx, participant)
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(100), effective.Amount)
+
+	resp, err := s.msgServer.PledgeVestingCollateral(s.ctx, &types.MsgPledgeVestingCollateral{Participant: participant.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(400), resp.PledgedAmount.Amount)
+
+	_, err = s.msgServer.PledgeVestingCollateral(s.ctx, &types.MsgPledgeVestingCollateral{Participant: participant.String()})
+	s.Require().ErrorIs(err, types.ErrVestingPledgeExists)
+
+	effective, _ = s.k.GetEffectiveCollateral(s.ctx, participant)
+	s.Require().Equal(math.NewInt(500), effective.Amount)
+
+	// Unpledging removes the weight right away but keeps the pledge slashable until release
+	unpledge, err := s.msgServer.UnpledgeVestingCollateral(s.ctx, &types.MsgUnpledgeVestingCollateral{Participant: participant.String()})
+	s.Require().NoError(err)
+	effective, _ = s.k.GetEffectiveCollateral(s.ctx, participant)
+	s.Require().Equal(math.NewInt(100), effective.Amount)
+	s.Require().Equal(math.NewInt(400), s.k.GetPledgedVestingCollateral(s.ctx, participant).Amount)
+
+	s.k.ReleaseVestingPledges(s.ctx, unpledge.ReleaseEpoch-1)
+	_, found = s.k.GetVestingPledge(s.ctx, participant)
+	s.Require().True(found)
+	s.k.ReleaseVestingPledges(s.ctx, unpledge.ReleaseEpoch)
+	_, found = s.k.GetVestingPledge(s.ctx, participant)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestVestingPledge_RequiresUnvestedBalance() {
+	participant, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	s.Require().NoError(err)
+	s.k.SetCurrentEpoch(s.ctx, 1)
+	s.vesting.EXPECT().GetVestingBalance(gomock.Any(), participant.String()).Return(sdk.NewCoins()).Times(1)
+
+	_, err = s.msgServer.PledgeVestingCollateral(s.ctx, &types.MsgPledgeVestingCollateral{Participant: participant.String()})
+	s.Require().ErrorIs(err, types.ErrNoVestingBalance)
+	_, found := s.k.GetVestingPledge(s.ctx, participant)
+	s.Require().False(found)
+
+	_, err = s.msgServer.UnpledgeVestingCollateral(s.ctx, &types.MsgUnpledgeVestingCollateral{Participant: participant.String()})
+	s.Require().ErrorIs(err, types.ErrVestingPledgeNotFound)
+}
+
+func (s *KeeperTestSuite) TestSlashing_IncludesPledgedVesting() {
+	participant, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	s.Require().NoError(err)
+	s.k.SetCollateral(s.ctx, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 1000))
+	s.k.SetVestingPledge(s.ctx, types.VestingPledge{Participant: participant.String()})
+
+	s.vesting.EXPECT().GetVestingBalance(gomock.Any(), participant.String()).Return(sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 500))).Times(1)
+	s.vesting.EXPECT().SlashVestingSchedule(gomock.Any(), participant.String(), sdk.NewInt64Coin(inftypes.BaseCoin, 50), types.ModuleName, "collateral_slashed:"+inftypes.SlashReasonDowntime).
+		Return(sdk.NewInt64Coin(inftypes.BaseCoin, 50), nil).Times(1)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 150)), gomock.Any()).Return(nil).Times(1)
+
+	slashed, err := s.k.Slash(s.ctx, participant, math.LegacyNewDecWithPrec(10, 2), inftypes.SlashReasonDowntime)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(150), slashed.Amount)
+
+	active, _ := s.k.GetCollateral(s.ctx, participant)
+	s.Require().Equal(math.NewInt(900), active.Amount)
+}
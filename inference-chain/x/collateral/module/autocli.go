@@ -39,6 +39,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "list-unbonding-collaterals",
 					Short:     "List all unbondingCollateral",
 				},
+				{
+					RpcMethod: "CompensationPool",
+					Use:       "compensation-pool",
+					Short:     "Shows the compensation pool balance",
+				},
+				{
+					RpcMethod: "SlashDistributions",
+					Use:       "slash-distributions",
+					Short:     "List cumulative slashed amounts per reason and destination",
+				},
+				{
+					RpcMethod:      "VestingPledge",
+					Use:            "show-vesting-pledge [participant]",
+					Short:          "Shows a participant's vesting collateral pledge",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "participant"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -62,6 +78,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a withdraw-collateral tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
 				},
+				{
+					RpcMethod: "PledgeVestingCollateral",
+					Use:       "pledge-vesting-collateral",
+					Short:     "Pledge unvested rewards as collateral",
+				},
+				{
+					RpcMethod: "UnpledgeVestingCollateral",
+					Use:       "unpledge-vesting-collateral",
+					Short:     "Release the vesting collateral pledge after the unbonding period",
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -36,6 +36,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetJailed(ctx, jailedAddr)
 	}
 
+	if genState.CompensationPool.Denom != "" {
+		k.SetCompensationPool(ctx, genState.CompensationPool)
+	}
+
+	for _, elem := range genState.SlashDistributionList {
+		k.SetSlashDistribution(ctx, elem)
+	}
+
+	for _, elem := range genState.VestingPledgeList {
+		k.SetVestingPledge(ctx, elem)
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -69,6 +81,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.JailedParticipantList[i] = &types.JailedParticipant{Address: addr.String()}
 	}
 
+	genesis.CompensationPool = k.GetCompensationPool(ctx)
+	genesis.SlashDistributionList = k.GetAllSlashDistributions(ctx)
+	genesis.VestingPledgeList = k.GetAllVestingPledges(ctx)
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
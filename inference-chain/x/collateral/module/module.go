@@ -184,9 +184,11 @@ type ModuleInputs struct {
 	Config       *modulev1.Module
 	Logger       log.Logger
 
-	AccountKeeper    types.AccountKeeper
-	BankKeeper       types.BankKeeper
-	BankEscrowKeeper types.BookkeepingBankKeeper
+	AccountKeeper       types.AccountKeeper
+	BankKeeper          types.BankKeeper
+	BankEscrowKeeper    types.BookkeepingBankKeeper
+	DistributionKeeper  types.DistributionKeeper
+	StreamVestingKeeper types.StreamVestingKeeper
 }
 
 type ModuleOutputs struct {
@@ -210,6 +212,8 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority.String(),
 		in.BankKeeper,
 		in.BankEscrowKeeper,
+		in.DistributionKeeper,
+		in.StreamVestingKeeper,
 	)
 	m := NewAppModule(
 		in.Cdc,
@@ -14,6 +14,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgDepositCollateral{},
 		&MsgWithdrawCollateral{},
+		&MsgPledgeVestingCollateral{},
+		&MsgUnpledgeVestingCollateral{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -11,4 +11,7 @@ var (
 	ErrInvalidDenom           = sdkerrors.Register(ModuleName, 1102, "invalid denomination")
 	ErrLatestEpochNotFound    = sdkerrors.Register(ModuleName, 1103, "latest epoch not found")
 	ErrInvalidSigner          = sdkerrors.Register(ModuleName, 1104, "expected gov account as only signer for proposal message")
+	ErrNoVestingBalance       = sdkerrors.Register(ModuleName, 1105, "no unvested balance to pledge")
+	ErrVestingPledgeExists    = sdkerrors.Register(ModuleName, 1106, "vesting schedule already pledged")
+	ErrVestingPledgeNotFound  = sdkerrors.Register(ModuleName, 1107, "no active vesting pledge found")
 )
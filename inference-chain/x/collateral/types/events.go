@@ -2,10 +2,15 @@ package types
 
 // Event types
 const (
-	EventTypeDepositCollateral  = "deposit_collateral"
-	EventTypeWithdrawCollateral = "withdraw_collateral"
-	EventTypeSlashCollateral    = "slash_collateral"
-	EventTypeProcessWithdrawal  = "process_withdrawal"
+	EventTypeDepositCollateral   = "deposit_collateral"
+	EventTypeWithdrawCollateral  = "withdraw_collateral"
+	EventTypeSlashCollateral     = "slash_collateral"
+	EventTypeProcessWithdrawal   = "process_withdrawal"
+	EventTypeDistributeSlash     = "distribute_slash"
+	EventTypeCompensateRequester = "compensate_requester"
+	EventTypeReclaimCompensation = "reclaim_compensation"
+	EventTypePledgeVesting       = "pledge_vesting_collateral"
+	EventTypeUnpledgeVesting     = "unpledge_vesting_collateral"
 )
 
 // Event attribute keys
@@ -16,4 +21,8 @@ const (
 	AttributeKeySlashAmount     = "slash_amount"
 	AttributeKeySlashFraction   = "slash_fraction"
 	AttributeKeySlashReason     = "slash_reason"
+	AttributeKeyDestination     = "destination"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyMemo            = "memo"
+	AttributeKeyReleaseEpoch    = "release_epoch"
 )
@@ -27,6 +27,18 @@ type BookkeepingBankKeeper interface {
 	LogSubAccountTransaction(ctx context.Context, recipient string, sender string, subAccount string, amt sdk.Coin, memo string)
 }
 
+// DistributionKeeper defines the expected interface for the Distribution module.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// StreamVestingKeeper defines the expected interface for the StreamVesting module,
+// used to count and slash unvested rewards pledged as collateral.
+type StreamVestingKeeper interface {
+	GetVestingBalance(ctx context.Context, participantAddress string) sdk.Coins
+	SlashVestingSchedule(ctx context.Context, participantAddress string, amount sdk.Coin, recipientModule string, memo string) (sdk.Coin, error)
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
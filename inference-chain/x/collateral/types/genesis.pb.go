@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -33,6 +34,12 @@ type GenesisState struct {
 	// unbonding_collateral_list defines all the unbonding collateral entries at genesis
 	UnbondingCollateralList []UnbondingCollateral `protobuf:"bytes,3,rep,name=unbonding_collateral_list,json=unbondingCollateralList,proto3" json:"unbonding_collateral_list"`
 	JailedParticipantList   []*JailedParticipant  `protobuf:"bytes,4,rep,name=jailed_participant_list,json=jailedParticipantList,proto3" json:"jailed_participant_list,omitempty"`
+	// compensation_pool is the slashed collateral held for reimbursing requesters
+	CompensationPool types.Coin `protobuf:"bytes,5,opt,name=compensation_pool,json=compensationPool,proto3" json:"compensation_pool"`
+	// slash_distribution_list records the cumulative slashed amounts per reason and destination
+	SlashDistributionList []SlashDistribution `protobuf:"bytes,6,rep,name=slash_distribution_list,json=slashDistributionList,proto3" json:"slash_distribution_list"`
+	// vesting_pledge_list defines the vesting schedules pledged as collateral
+	VestingPledgeList []VestingPledge `protobuf:"bytes,7,rep,name=vesting_pledge_list,json=vestingPledgeList,proto3" json:"vesting_pledge_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,6 +103,27 @@ func (m *GenesisState) GetJailedParticipantList() []*JailedParticipant {
 	return nil
 }
 
+func (m *GenesisState) GetCompensationPool() types.Coin {
+	if m != nil {
+		return m.CompensationPool
+	}
+	return types.Coin{}
+}
+
+func (m *GenesisState) GetSlashDistributionList() []SlashDistribution {
+	if m != nil {
+		return m.SlashDistributionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVestingPledgeList() []VestingPledge {
+	if m != nil {
+		return m.VestingPledgeList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "inference.collateral.GenesisState")
 }
@@ -105,30 +133,39 @@ func init() {
 }
 
 var fileDescriptor_f1879ca2d8ff63f9 = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
-	0x18, 0xc7, 0x5b, 0x37, 0x06, 0x76, 0x5e, 0x2c, 0x1b, 0x9b, 0x43, 0xea, 0x1c, 0x82, 0x53, 0xb0,
-	0x85, 0x09, 0x5e, 0x85, 0x7a, 0x10, 0xc4, 0xc3, 0x98, 0x78, 0xf1, 0x52, 0xd2, 0x2c, 0xd6, 0xcc,
-	0x2e, 0x29, 0x49, 0x0a, 0x7a, 0xf7, 0x01, 0x7c, 0x0c, 0x8f, 0x3e, 0xc6, 0x8e, 0x3b, 0x7a, 0x12,
-	0xd9, 0x0e, 0xbe, 0x86, 0x34, 0x99, 0x6b, 0xd9, 0xc2, 0x2e, 0xe5, 0x23, 0xf9, 0xfd, 0xf3, 0xfb,
-	0xbe, 0x7e, 0x56, 0x07, 0x93, 0x47, 0xc4, 0x10, 0x81, 0xc8, 0x83, 0x34, 0x8e, 0x81, 0x40, 0x0c,
-	0xc4, 0x5e, 0x84, 0x08, 0xe2, 0x98, 0xbb, 0x09, 0xa3, 0x82, 0xda, 0xb5, 0x25, 0xe3, 0xe6, 0x4c,
-	0x6b, 0x17, 0x8c, 0x31, 0xa1, 0x9e, 0xfc, 0x2a, 0xb0, 0x55, 0x8b, 0x68, 0x44, 0x65, 0xe9, 0x65,
-	0xd5, 0xe2, 0xf4, 0x50, 0xab, 0x48, 0x00, 0x03, 0xe3, 0x85, 0xa1, 0x75, 0xa6, 0x45, 0xf2, 0x32,
-	0x08, 0x41, 0x0c, 0x32, 0xbf, 0xc2, 0x8f, 0xb4, 0x78, 0x4a, 0x42, 0x4a, 0x86, 0x98, 0x44, 0x1b,
-	0xbd, 0x23, 0x80, 0x63, 0x34, 0x54, 0x48, 0xe7, 0xad, 0x64, 0xed, 0x5c, 0xab, 0x59, 0xef, 0x04,
-	0x10, 0xc8, 0xbe, 0xb4, 0x2a, 0xaa, 0xb1, 0xa6, 0xd9, 0x36, 0xbb, 0xd5, 0xde, 0xbe, 0xab, 0x9b,
-	0xdd, 0xed, 0x4b, 0xc6, 0xdf, 0x9e, 0x7c, 0x1f, 0x18, 0x1f, 0xbf, 0x9f, 0xa7, 0xe6, 0x60, 0x11,
-	0xb3, 0x91, 0xd5, 0x58, 0x6f, 0x3b, 0x88, 0x31, 0x17, 0xcd, 0xad, 0x76, 0xa9, 0x5b, 0xed, 0x1d,
-	0xeb, 0x5f, 0xbc, 0x5a, 0x96, 0xbe, 0xca, 0xf8, 0xe5, 0xec, 0xf1, 0x41, 0x1d, 0xae, 0x5e, 0xdc,
-	0x62, 0x2e, 0xec, 0x67, 0x6b, 0x6f, 0x39, 0x6e, 0x50, 0x10, 0x4a, 0x51, 0x49, 0x8a, 0x4e, 0xf4,
-	0xa2, 0xfb, 0xff, 0x58, 0xc1, 0xa8, 0x54, 0x8d, 0x74, 0xfd, 0x4a, 0xca, 0x02, 0xab, 0xa1, 0xfe,
-	0x5a, 0x90, 0x00, 0x26, 0x30, 0xc4, 0x09, 0x20, 0x42, 0xa9, 0xca, 0x9b, 0x66, 0xba, 0x91, 0xa1,
-	0x7e, 0x9e, 0x19, 0xd4, 0x47, 0xab, 0x47, 0x99, 0xc0, 0xef, 0x4f, 0x66, 0x8e, 0x39, 0x9d, 0x39,
-	0xe6, 0xcf, 0xcc, 0x31, 0xdf, 0xe7, 0x8e, 0x31, 0x9d, 0x3b, 0xc6, 0xd7, 0xdc, 0x31, 0x1e, 0x2e,
-	0x22, 0x2c, 0x9e, 0xd2, 0xd0, 0x85, 0x74, 0xec, 0x25, 0x8c, 0x0e, 0x53, 0x28, 0x38, 0xc4, 0x72,
-	0xa7, 0xf9, 0x76, 0x5f, 0x8a, 0xfb, 0x15, 0xaf, 0x09, 0xe2, 0x61, 0x45, 0xee, 0xf7, 0xfc, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x76, 0x8d, 0xf4, 0x00, 0xdf, 0x02, 0x00, 0x00,
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0xb6, 0x15, 0x91, 0x71, 0xa0, 0x65, 0x53, 0xbb, 0x0a, 0x85, 0x31, 0x90, 0xd8,
+	0x90, 0x48, 0xb4, 0x21, 0x71, 0x45, 0xea, 0x90, 0x90, 0xd0, 0x0e, 0x51, 0x27, 0x90, 0xe0, 0x12,
+	0x39, 0x8e, 0xc9, 0xde, 0x70, 0xfc, 0xac, 0xd8, 0xa9, 0xe0, 0x5b, 0x70, 0xe0, 0x43, 0x70, 0xe4,
+	0x63, 0xec, 0xd8, 0x23, 0x27, 0x84, 0xda, 0x03, 0x5f, 0x03, 0xc5, 0x4e, 0xdb, 0xd0, 0x46, 0xbd,
+	0x44, 0x96, 0xfd, 0xff, 0xbf, 0xdf, 0xcb, 0xfb, 0xdb, 0xee, 0x11, 0x88, 0x4f, 0x2c, 0x67, 0x82,
+	0xb2, 0x80, 0x22, 0xe7, 0x44, 0xb3, 0x9c, 0xf0, 0x20, 0x65, 0x82, 0x29, 0x50, 0xbe, 0xcc, 0x51,
+	0x63, 0x77, 0x6f, 0xa1, 0xf1, 0x97, 0x9a, 0x41, 0x87, 0x64, 0x20, 0x30, 0x30, 0x5f, 0x2b, 0x1c,
+	0xec, 0xa5, 0x98, 0xa2, 0x59, 0x06, 0xe5, 0xaa, 0xda, 0x7d, 0xd4, 0x88, 0x90, 0x24, 0x27, 0x59,
+	0x45, 0x18, 0x3c, 0x6f, 0x94, 0x2c, 0x97, 0x51, 0x4c, 0x38, 0x29, 0xf9, 0x56, 0xfe, 0xa4, 0x51,
+	0x5e, 0x88, 0x18, 0x45, 0x02, 0x22, 0xdd, 0xc8, 0xbd, 0x26, 0xc0, 0x59, 0xb2, 0x91, 0xab, 0x38,
+	0x51, 0x57, 0x51, 0x02, 0x4a, 0xe7, 0x10, 0x17, 0x1a, 0x50, 0x54, 0xf2, 0x93, 0x46, 0xf9, 0x98,
+	0x29, 0x0d, 0x22, 0x8d, 0x24, 0x67, 0x49, 0x3a, 0x6f, 0xd1, 0xa3, 0xa8, 0x32, 0x54, 0x41, 0x4c,
+	0x14, 0x0b, 0xc6, 0xa7, 0x31, 0xd3, 0xe4, 0x34, 0xa0, 0x08, 0x55, 0xa9, 0xa3, 0xef, 0x3b, 0xee,
+	0xdd, 0x37, 0x76, 0xca, 0x97, 0x9a, 0x68, 0xd6, 0x7d, 0xe5, 0xb6, 0xed, 0x48, 0xfa, 0xce, 0xa1,
+	0x73, 0xbc, 0x7b, 0xf6, 0xc0, 0x6f, 0x9a, 0xba, 0x1f, 0x1a, 0xcd, 0xf0, 0xce, 0xcd, 0xef, 0x87,
+	0xad, 0x1f, 0x7f, 0x7f, 0x3e, 0x73, 0x46, 0x95, 0xad, 0xcb, 0xdc, 0xde, 0xfa, 0xc0, 0x22, 0x0e,
+	0x4a, 0xf7, 0x6f, 0x1d, 0x6e, 0x1d, 0xef, 0x9e, 0x3d, 0x6d, 0xae, 0x78, 0xbe, 0x58, 0x0e, 0xad,
+	0x67, 0xb8, 0x5d, 0x16, 0x1f, 0xed, 0xd3, 0xd5, 0x83, 0x0b, 0x50, 0xba, 0xfb, 0xd9, 0x3d, 0x58,
+	0x0c, 0x3a, 0xaa, 0x01, 0x0d, 0x68, 0xcb, 0x80, 0x4e, 0x9a, 0x41, 0xef, 0xe6, 0xb6, 0x1a, 0xd1,
+	0xa2, 0x7a, 0xc5, 0xfa, 0x91, 0x81, 0x45, 0x6e, 0xcf, 0xe6, 0x15, 0x49, 0x92, 0x6b, 0xa0, 0x20,
+	0x89, 0xd0, 0x16, 0xb5, 0xbd, 0xe9, 0x9f, 0xde, 0x1a, 0x53, 0xb8, 0xf4, 0x8c, 0xf6, 0xaf, 0x57,
+	0xb7, 0x0c, 0xe0, 0xc2, 0xed, 0x50, 0xcc, 0x24, 0x13, 0x8a, 0x94, 0x39, 0x47, 0x12, 0x91, 0xf7,
+	0x77, 0x4c, 0x00, 0x07, 0xbe, 0x8d, 0xd0, 0x2f, 0x23, 0xf4, 0xab, 0x08, 0xfd, 0x73, 0x04, 0x51,
+	0x75, 0x7d, 0xaf, 0xee, 0x0c, 0x11, 0x79, 0x19, 0xc1, 0xfa, 0xdd, 0xb1, 0xed, 0xb6, 0x37, 0xb5,
+	0x7b, 0x59, 0x9a, 0x5e, 0xd7, 0x3c, 0xf3, 0x08, 0xd4, 0xea, 0x81, 0x69, 0xfa, 0x83, 0x7b, 0xff,
+	0xff, 0x3b, 0x67, 0x11, 0xb7, 0x0d, 0xe2, 0x71, 0x33, 0xe2, 0xbd, 0x35, 0x84, 0x46, 0x5f, 0x95,
+	0xef, 0x8c, 0xeb, 0x9b, 0x65, 0xe9, 0x61, 0x78, 0x33, 0xf5, 0x9c, 0xc9, 0xd4, 0x73, 0xfe, 0x4c,
+	0x3d, 0xe7, 0xdb, 0xcc, 0x6b, 0x4d, 0x66, 0x5e, 0xeb, 0xd7, 0xcc, 0x6b, 0x7d, 0x7c, 0x99, 0x82,
+	0xbe, 0x2a, 0x62, 0x9f, 0x62, 0x16, 0xc8, 0x1c, 0x93, 0x82, 0x6a, 0x45, 0xc1, 0xbc, 0x85, 0xe5,
+	0xab, 0xf8, 0x52, 0x7f, 0x17, 0xfa, 0xab, 0x64, 0x2a, 0x6e, 0x9b, 0xfb, 0xfe, 0xe2, 0x5f, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0xb3, 0x5a, 0x8d, 0x13, 0x69, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -151,6 +188,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VestingPledgeList) > 0 {
+		for iNdEx := len(m.VestingPledgeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPledgeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SlashDistributionList) > 0 {
+		for iNdEx := len(m.SlashDistributionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashDistributionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.CompensationPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if len(m.JailedParticipantList) > 0 {
 		for iNdEx := len(m.JailedParticipantList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -243,6 +318,20 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.CompensationPool.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SlashDistributionList) > 0 {
+		for _, e := range m.SlashDistributionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VestingPledgeList) > 0 {
+		for _, e := range m.VestingPledgeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -416,6 +505,107 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompensationPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CompensationPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDistributionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashDistributionList = append(m.SlashDistributionList, SlashDistribution{})
+			if err := m.SlashDistributionList[len(m.SlashDistributionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPledgeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPledgeList = append(m.VestingPledgeList, VestingPledge{})
+			if err := m.VestingPledgeList[len(m.VestingPledgeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -12,6 +12,8 @@ const (
 
 	SubAccountUnbonding = "collateral-unbonding"
 
+	SubAccountCompensation = "collateral-compensation"
+
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
 
@@ -42,4 +44,14 @@ var (
 	// SlashedInEpochKey is a keyset to record that a participant was slashed for a given reason in a given epoch
 	// Key: (epoch:uint64, participant:AccAddress, reason:string)
 	SlashedInEpochKey = collections.NewPrefix(7)
+
+	// CompensationPoolKey stores the slashed collateral held for reimbursing requesters
+	CompensationPoolKey = collections.NewPrefix(8)
+
+	// SlashDistributionsKey stores cumulative slashed amounts
+	// Key: (reason:string, destination:int32)
+	SlashDistributionsKey = collections.NewPrefix(9)
+
+	// VestingPledgesKey stores the vesting schedules pledged as collateral, by participant
+	VestingPledgesKey = collections.NewPrefix(10)
 )
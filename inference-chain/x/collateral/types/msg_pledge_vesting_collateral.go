@@ -0,0 +1,44 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgPledgeVestingCollateral{}
+	_ sdk.Msg = &MsgUnpledgeVestingCollateral{}
+)
+
+// NewMsgPledgeVestingCollateral creates a new MsgPledgeVestingCollateral instance
+func NewMsgPledgeVestingCollateral(participant string) *MsgPledgeVestingCollateral {
+	return &MsgPledgeVestingCollateral{
+		Participant: participant,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (msg *MsgPledgeVestingCollateral) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Participant)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid participant address (%s)", err)
+	}
+	return nil
+}
+
+// NewMsgUnpledgeVestingCollateral creates a new MsgUnpledgeVestingCollateral instance
+func NewMsgUnpledgeVestingCollateral(participant string) *MsgUnpledgeVestingCollateral {
+	return &MsgUnpledgeVestingCollateral{
+		Participant: participant,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (msg *MsgUnpledgeVestingCollateral) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Participant)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid participant address (%s)", err)
+	}
+	return nil
+}
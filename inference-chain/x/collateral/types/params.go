@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -11,11 +12,14 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 // Default parameter values
 var (
 	DefaultUnbondingPeriodEpochs = uint64(1) // 1 epoch
+	DefaultCompensationRatio     = math.LegacyZeroDec()
 )
 
 // Parameter store keys
 var (
 	KeyUnbondingPeriodEpochs = []byte("UnbondingPeriodEpochs")
+	KeySlashDestinations     = []byte("SlashDestinations")
+	KeyCompensationRatio     = []byte("CompensationRatio")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -26,9 +30,13 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 func NewParams(
 	unbondingPeriodEpochs uint64,
+	slashDestinations []SlashDestinationRule,
+	compensationRatio math.LegacyDec,
 ) Params {
 	return Params{
 		UnbondingPeriodEpochs: unbondingPeriodEpochs,
+		SlashDestinations:     slashDestinations,
+		CompensationRatio:     compensationRatio,
 	}
 }
 
@@ -36,6 +44,8 @@ func NewParams(
 func DefaultParams() Params {
 	return NewParams(
 		DefaultUnbondingPeriodEpochs,
+		nil,
+		DefaultCompensationRatio,
 	)
 }
 
@@ -43,6 +53,8 @@ func DefaultParams() Params {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyUnbondingPeriodEpochs, &p.UnbondingPeriodEpochs, validateUnbondingPeriodEpochs),
+		paramtypes.NewParamSetPair(KeySlashDestinations, &p.SlashDestinations, validateSlashDestinations),
+		paramtypes.NewParamSetPair(KeyCompensationRatio, &p.CompensationRatio, validateCompensationRatio),
 	}
 }
 
@@ -51,9 +63,34 @@ func (p Params) Validate() error {
 	if err := validateUnbondingPeriodEpochs(p.UnbondingPeriodEpochs); err != nil {
 		return err
 	}
+	if err := validateSlashDestinations(p.SlashDestinations); err != nil {
+		return err
+	}
+	if err := validateCompensationRatio(p.CompensationRatio); err != nil {
+		return err
+	}
 	return nil
 }
 
+// GetSlashDestination returns the destination configured for the given slash reason.
+// Reasons without an explicit rule are burned.
+func (p Params) GetSlashDestination(reason string) SlashDestination {
+	for _, rule := range p.SlashDestinations {
+		if rule.Reason == reason {
+			return rule.Destination
+		}
+	}
+	return SlashDestination_SLASH_DESTINATION_BURN
+}
+
+// GetCompensationRatio returns the compensation ratio, treating an unset value as zero.
+func (p Params) GetCompensationRatio() math.LegacyDec {
+	if p.CompensationRatio.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return p.CompensationRatio
+}
+
 // validateUnbondingPeriodEpochs validates the UnbondingPeriodEpochs param
 func validateUnbondingPeriodEpochs(v interface{}) error {
 	unbondingPeriodEpochs, ok := v.(uint64)
@@ -67,3 +104,45 @@ func validateUnbondingPeriodEpochs(v interface{}) error {
 
 	return nil
 }
+
+// validateSlashDestinations validates the SlashDestinations param
+func validateSlashDestinations(v interface{}) error {
+	rules, ok := v.([]SlashDestinationRule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		if rule.Reason == "" {
+			return fmt.Errorf("slash destination reason cannot be empty")
+		}
+		if _, found := seen[rule.Reason]; found {
+			return fmt.Errorf("duplicate slash destination for reason %s", rule.Reason)
+		}
+		seen[rule.Reason] = struct{}{}
+		if _, valid := SlashDestination_name[int32(rule.Destination)]; !valid {
+			return fmt.Errorf("invalid slash destination %d for reason %s", rule.Destination, rule.Reason)
+		}
+	}
+
+	return nil
+}
+
+// validateCompensationRatio validates the CompensationRatio param
+func validateCompensationRatio(v interface{}) error {
+	ratio, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	// An unset ratio is treated as zero
+	if ratio.IsNil() {
+		return nil
+	}
+	if ratio.IsNegative() {
+		return fmt.Errorf("compensation ratio cannot be negative: %s", ratio)
+	}
+
+	return nil
+}
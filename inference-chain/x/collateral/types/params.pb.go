@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -24,17 +26,111 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SlashDestination defines where slashed collateral is sent.
+type SlashDestination int32
+
+const (
+	// BURN removes the slashed coins from the supply
+	SlashDestination_SLASH_DESTINATION_BURN SlashDestination = 0
+	// COMMUNITY_POOL funds the distribution module community pool
+	SlashDestination_SLASH_DESTINATION_COMMUNITY_POOL SlashDestination = 1
+	// VALIDATORS splits the slashed coins evenly between the validators who caught the offence
+	SlashDestination_SLASH_DESTINATION_VALIDATORS SlashDestination = 2
+	// COMPENSATION_POOL keeps the slashed coins in the module to reimburse harmed requesters
+	SlashDestination_SLASH_DESTINATION_COMPENSATION_POOL SlashDestination = 3
+)
+
+var SlashDestination_name = map[int32]string{
+	0: "SLASH_DESTINATION_BURN",
+	1: "SLASH_DESTINATION_COMMUNITY_POOL",
+	2: "SLASH_DESTINATION_VALIDATORS",
+	3: "SLASH_DESTINATION_COMPENSATION_POOL",
+}
+
+var SlashDestination_value = map[string]int32{
+	"SLASH_DESTINATION_BURN":              0,
+	"SLASH_DESTINATION_COMMUNITY_POOL":    1,
+	"SLASH_DESTINATION_VALIDATORS":        2,
+	"SLASH_DESTINATION_COMPENSATION_POOL": 3,
+}
+
+func (x SlashDestination) String() string {
+	return proto.EnumName(SlashDestination_name, int32(x))
+}
+
+func (SlashDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3c54d7995682b333, []int{0}
+}
+
+// SlashDestinationRule maps a slash reason to the destination of the slashed coins.
+type SlashDestinationRule struct {
+	Reason      string           `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Destination SlashDestination `protobuf:"varint,2,opt,name=destination,proto3,enum=inference.collateral.SlashDestination" json:"destination,omitempty"`
+}
+
+func (m *SlashDestinationRule) Reset()         { *m = SlashDestinationRule{} }
+func (m *SlashDestinationRule) String() string { return proto.CompactTextString(m) }
+func (*SlashDestinationRule) ProtoMessage()    {}
+func (*SlashDestinationRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c54d7995682b333, []int{0}
+}
+func (m *SlashDestinationRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashDestinationRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashDestinationRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashDestinationRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashDestinationRule.Merge(m, src)
+}
+func (m *SlashDestinationRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashDestinationRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashDestinationRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashDestinationRule proto.InternalMessageInfo
+
+func (m *SlashDestinationRule) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SlashDestinationRule) GetDestination() SlashDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return SlashDestination_SLASH_DESTINATION_BURN
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// UnbondingPeriodEpochs is the number of epochs a withdrawal must wait before being released
 	UnbondingPeriodEpochs uint64 `protobuf:"varint,1,opt,name=unbonding_period_epochs,json=unbondingPeriodEpochs,proto3" json:"unbonding_period_epochs,omitempty"`
+	// SlashDestinations selects the destination per slash reason. Reasons without a rule are burned.
+	SlashDestinations []SlashDestinationRule `protobuf:"bytes,2,rep,name=slash_destinations,json=slashDestinations,proto3" json:"slash_destinations"`
+	// CompensationRatio is the share of an invalidated inference's cost paid to the requester
+	// from the compensation pool, on top of the escrow refund.
+	CompensationRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=compensation_ratio,json=compensationRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"compensation_ratio"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c54d7995682b333, []int{0}
+	return fileDescriptor_3c54d7995682b333, []int{1}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -70,30 +166,84 @@ func (m *Params) GetUnbondingPeriodEpochs() uint64 {
 	return 0
 }
 
+func (m *Params) GetSlashDestinations() []SlashDestinationRule {
+	if m != nil {
+		return m.SlashDestinations
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("inference.collateral.SlashDestination", SlashDestination_name, SlashDestination_value)
+	proto.RegisterType((*SlashDestinationRule)(nil), "inference.collateral.SlashDestinationRule")
 	proto.RegisterType((*Params)(nil), "inference.collateral.Params")
 }
 
 func init() { proto.RegisterFile("inference/collateral/params.proto", fileDescriptor_3c54d7995682b333) }
 
 var fileDescriptor_3c54d7995682b333 = []byte{
-	// 224 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0xcc, 0x4b, 0x4b,
-	0x2d, 0x4a, 0xcd, 0x4b, 0x4e, 0xd5, 0x4f, 0xce, 0xcf, 0xc9, 0x49, 0x2c, 0x49, 0x2d, 0x4a, 0xcc,
-	0xd1, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81,
-	0x2b, 0xd1, 0x43, 0x28, 0x91, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x85,
-	0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0xca, 0xe0, 0x62,
-	0x0b, 0x00, 0x1b, 0x27, 0x64, 0xc6, 0x25, 0x5e, 0x9a, 0x97, 0x94, 0x9f, 0x97, 0x92, 0x99, 0x97,
-	0x1e, 0x5f, 0x90, 0x5a, 0x94, 0x99, 0x9f, 0x12, 0x9f, 0x5a, 0x90, 0x9f, 0x9c, 0x51, 0x2c, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0x12, 0x24, 0x0a, 0x97, 0x0e, 0x00, 0xcb, 0xba, 0x82, 0x25, 0xad, 0xd4,
-	0x5e, 0x2c, 0x90, 0x67, 0xec, 0x7a, 0xbe, 0x41, 0x4b, 0x16, 0xe1, 0xd8, 0x0a, 0x64, 0xe7, 0x42,
-	0xcc, 0x77, 0x0a, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xb3, 0xf4,
-	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x82, 0xa2, 0xfc, 0x94, 0xd2, 0xe4,
-	0x92, 0xe2, 0xe4, 0x4c, 0xb0, 0x41, 0x38, 0x8c, 0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03,
-	0x7b, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x22, 0x46, 0x9f, 0x1c, 0x26, 0x01, 0x00, 0x00,
+	// 498 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x8b, 0xd3, 0x40,
+	0x18, 0x4d, 0xba, 0xa5, 0xe0, 0x2c, 0x48, 0x3b, 0xd4, 0xb5, 0x56, 0x4d, 0xe3, 0x2a, 0x6b, 0x29,
+	0x98, 0xe0, 0x0a, 0x7b, 0xd8, 0x5b, 0x6b, 0x0a, 0x5b, 0xe8, 0x26, 0x21, 0xe9, 0x0a, 0x7a, 0x89,
+	0xd3, 0xc9, 0x98, 0x06, 0x93, 0x99, 0x90, 0x49, 0xc0, 0xbd, 0xf8, 0x03, 0x3c, 0xf9, 0x0f, 0xf4,
+	0xee, 0xc5, 0x83, 0x3f, 0x62, 0x8f, 0x8b, 0x27, 0xf1, 0xb0, 0x48, 0x7b, 0xd0, 0x9f, 0x21, 0x99,
+	0x94, 0x6d, 0xd9, 0xae, 0xe0, 0x65, 0x92, 0xef, 0x7b, 0x6f, 0x5e, 0xde, 0xf7, 0xf2, 0x81, 0x07,
+	0x21, 0x7d, 0x43, 0x52, 0x42, 0x31, 0xd1, 0x31, 0x8b, 0x22, 0x94, 0x91, 0x14, 0x45, 0x7a, 0x82,
+	0x52, 0x14, 0x73, 0x2d, 0x49, 0x59, 0xc6, 0x60, 0xf3, 0x92, 0xa2, 0xad, 0x28, 0xed, 0x06, 0x8a,
+	0x43, 0xca, 0x74, 0x71, 0x96, 0xc4, 0xf6, 0x1d, 0xcc, 0x78, 0xcc, 0xb8, 0x27, 0x2a, 0xbd, 0x2c,
+	0x96, 0x50, 0x33, 0x60, 0x01, 0x2b, 0xfb, 0xc5, 0x5b, 0xd9, 0xdd, 0x7d, 0x0f, 0x9a, 0x6e, 0x84,
+	0xf8, 0xcc, 0x20, 0x3c, 0x0b, 0x29, 0xca, 0x42, 0x46, 0x9d, 0x3c, 0x22, 0x70, 0x07, 0xd4, 0x52,
+	0x82, 0x38, 0xa3, 0x2d, 0x59, 0x95, 0xbb, 0x37, 0x9c, 0x65, 0x05, 0x8f, 0xc0, 0xb6, 0xbf, 0xa2,
+	0xb6, 0x2a, 0xaa, 0xdc, 0xbd, 0xb9, 0xbf, 0xa7, 0x5d, 0xe7, 0x4f, 0xdb, 0x10, 0x5e, 0xbf, 0x7a,
+	0x58, 0xfd, 0xf3, 0xb9, 0x23, 0xef, 0x7e, 0xa9, 0x80, 0x9a, 0x2d, 0x46, 0x85, 0x07, 0xe0, 0x76,
+	0x4e, 0xa7, 0x8c, 0xfa, 0x21, 0x0d, 0xbc, 0x84, 0xa4, 0x21, 0xf3, 0x3d, 0x92, 0x30, 0x3c, 0xe3,
+	0xc2, 0x43, 0xd5, 0xb9, 0x75, 0x09, 0xdb, 0x02, 0x1d, 0x0a, 0x10, 0x7a, 0x00, 0xf2, 0xe2, 0x4b,
+	0xde, 0x9a, 0x3a, 0x6f, 0x55, 0xd4, 0xad, 0xee, 0xf6, 0x7e, 0xef, 0x3f, 0x9d, 0xe5, 0x11, 0x19,
+	0x54, 0xcf, 0x2e, 0x3a, 0x92, 0xd3, 0xe0, 0x57, 0x30, 0x0e, 0x5f, 0x03, 0x88, 0x59, 0x9c, 0x10,
+	0xca, 0x45, 0xc3, 0x4b, 0x8b, 0x47, 0x6b, 0xab, 0xc8, 0x65, 0xf0, 0xb4, 0xb8, 0xf4, 0xf3, 0xa2,
+	0x73, 0xb7, 0xcc, 0x9a, 0xfb, 0x6f, 0xb5, 0x90, 0xe9, 0x31, 0xca, 0x66, 0xda, 0x98, 0x04, 0x08,
+	0x9f, 0x1a, 0x04, 0x7f, 0xff, 0xf6, 0x04, 0x2c, 0x7f, 0x85, 0x41, 0xb0, 0xd3, 0x58, 0x17, 0x73,
+	0x8a, 0xf3, 0x70, 0xaf, 0xc8, 0xe2, 0xc3, 0xef, 0xaf, 0xbd, 0xfb, 0xab, 0x5d, 0x78, 0xb7, 0xbe,
+	0x0d, 0x65, 0x44, 0xbd, 0x4f, 0x32, 0xa8, 0x5f, 0xf5, 0x0e, 0xdb, 0x60, 0xc7, 0x1d, 0xf7, 0xdd,
+	0x23, 0xcf, 0x18, 0xba, 0x93, 0x91, 0xd9, 0x9f, 0x8c, 0x2c, 0xd3, 0x1b, 0x9c, 0x38, 0x66, 0x5d,
+	0x82, 0x8f, 0x80, 0xba, 0x89, 0x3d, 0xb7, 0x8e, 0x8f, 0x4f, 0xcc, 0xd1, 0xe4, 0xa5, 0x67, 0x5b,
+	0xd6, 0xb8, 0x2e, 0x43, 0x15, 0xdc, 0xdb, 0x64, 0xbd, 0xe8, 0x8f, 0x47, 0x46, 0x7f, 0x62, 0x39,
+	0x6e, 0xbd, 0x02, 0x1f, 0x83, 0x87, 0xd7, 0xea, 0xd8, 0x43, 0xd3, 0x2d, 0x0b, 0x21, 0xb5, 0x35,
+	0xb0, 0xcf, 0xe6, 0x8a, 0x7c, 0x3e, 0x57, 0xe4, 0x5f, 0x73, 0x45, 0xfe, 0xb8, 0x50, 0xa4, 0xf3,
+	0x85, 0x22, 0xfd, 0x58, 0x28, 0xd2, 0xab, 0x83, 0x20, 0xcc, 0x66, 0xf9, 0x54, 0xc3, 0x2c, 0xd6,
+	0x93, 0x94, 0xf9, 0x39, 0xce, 0x38, 0x0e, 0xc5, 0xa8, 0xff, 0x18, 0x3a, 0x3b, 0x4d, 0x08, 0x9f,
+	0xd6, 0xc4, 0xa2, 0x3e, 0xfb, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x76, 0xd2, 0xf4, 0xcc, 0x27, 0x03,
+	0x00, 0x00,
 }
 
+func (this *SlashDestinationRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashDestinationRule)
+	if !ok {
+		that2, ok := that.(SlashDestinationRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Destination != that1.Destination {
+		return false
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -116,8 +266,54 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.UnbondingPeriodEpochs != that1.UnbondingPeriodEpochs {
 		return false
 	}
+	if len(this.SlashDestinations) != len(that1.SlashDestinations) {
+		return false
+	}
+	for i := range this.SlashDestinations {
+		if !this.SlashDestinations[i].Equal(&that1.SlashDestinations[i]) {
+			return false
+		}
+	}
+	if !this.CompensationRatio.Equal(that1.CompensationRatio) {
+		return false
+	}
 	return true
 }
+func (m *SlashDestinationRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashDestinationRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashDestinationRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -138,6 +334,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.CompensationRatio.Size()
+		i -= size
+		if _, err := m.CompensationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SlashDestinations) > 0 {
+		for iNdEx := len(m.SlashDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashDestinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.UnbondingPeriodEpochs != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.UnbondingPeriodEpochs))
 		i--
@@ -157,6 +377,22 @@ func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *SlashDestinationRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovParams(uint64(m.Destination))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -166,6 +402,14 @@ func (m *Params) Size() (n int) {
 	if m.UnbondingPeriodEpochs != 0 {
 		n += 1 + sovParams(uint64(m.UnbondingPeriodEpochs))
 	}
+	if len(m.SlashDestinations) > 0 {
+		for _, e := range m.SlashDestinations {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.CompensationRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -175,6 +419,107 @@ func sovParams(x uint64) (n int) {
 func sozParams(x uint64) (n int) {
 	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *SlashDestinationRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashDestinationRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashDestinationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= SlashDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -223,6 +568,74 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDestinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashDestinations = append(m.SlashDestinations, SlashDestinationRule{})
+			if err := m.SlashDestinations[len(m.SlashDestinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompensationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CompensationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -490,6 +490,278 @@ func (m *QueryAllUnbondingCollateralsResponse) GetPagination() *query.PageRespon
 	return nil
 }
 
+// QueryCompensationPoolRequest is the request type for the Query/CompensationPool RPC method.
+type QueryCompensationPoolRequest struct {
+}
+
+func (m *QueryCompensationPoolRequest) Reset()         { *m = QueryCompensationPoolRequest{} }
+func (m *QueryCompensationPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCompensationPoolRequest) ProtoMessage()    {}
+func (*QueryCompensationPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{10}
+}
+func (m *QueryCompensationPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCompensationPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCompensationPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCompensationPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCompensationPoolRequest.Merge(m, src)
+}
+func (m *QueryCompensationPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCompensationPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCompensationPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCompensationPoolRequest proto.InternalMessageInfo
+
+// QueryCompensationPoolResponse is the response type for the Query/CompensationPool RPC method.
+type QueryCompensationPoolResponse struct {
+	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *QueryCompensationPoolResponse) Reset()         { *m = QueryCompensationPoolResponse{} }
+func (m *QueryCompensationPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCompensationPoolResponse) ProtoMessage()    {}
+func (*QueryCompensationPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{11}
+}
+func (m *QueryCompensationPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCompensationPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCompensationPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCompensationPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCompensationPoolResponse.Merge(m, src)
+}
+func (m *QueryCompensationPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCompensationPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCompensationPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCompensationPoolResponse proto.InternalMessageInfo
+
+func (m *QueryCompensationPoolResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+// QuerySlashDistributionsRequest is the request type for the Query/SlashDistributions RPC method.
+type QuerySlashDistributionsRequest struct {
+}
+
+func (m *QuerySlashDistributionsRequest) Reset()         { *m = QuerySlashDistributionsRequest{} }
+func (m *QuerySlashDistributionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashDistributionsRequest) ProtoMessage()    {}
+func (*QuerySlashDistributionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{12}
+}
+func (m *QuerySlashDistributionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashDistributionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashDistributionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashDistributionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashDistributionsRequest.Merge(m, src)
+}
+func (m *QuerySlashDistributionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashDistributionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashDistributionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashDistributionsRequest proto.InternalMessageInfo
+
+// QuerySlashDistributionsResponse is the response type for the Query/SlashDistributions RPC method.
+type QuerySlashDistributionsResponse struct {
+	Distributions []SlashDistribution `protobuf:"bytes,1,rep,name=distributions,proto3" json:"distributions"`
+}
+
+func (m *QuerySlashDistributionsResponse) Reset()         { *m = QuerySlashDistributionsResponse{} }
+func (m *QuerySlashDistributionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashDistributionsResponse) ProtoMessage()    {}
+func (*QuerySlashDistributionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{13}
+}
+func (m *QuerySlashDistributionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashDistributionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashDistributionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashDistributionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashDistributionsResponse.Merge(m, src)
+}
+func (m *QuerySlashDistributionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashDistributionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashDistributionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashDistributionsResponse proto.InternalMessageInfo
+
+func (m *QuerySlashDistributionsResponse) GetDistributions() []SlashDistribution {
+	if m != nil {
+		return m.Distributions
+	}
+	return nil
+}
+
+// QueryVestingPledgeRequest is the request type for the Query/VestingPledge RPC method.
+type QueryVestingPledgeRequest struct {
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+}
+
+func (m *QueryVestingPledgeRequest) Reset()         { *m = QueryVestingPledgeRequest{} }
+func (m *QueryVestingPledgeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingPledgeRequest) ProtoMessage()    {}
+func (*QueryVestingPledgeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{14}
+}
+func (m *QueryVestingPledgeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingPledgeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingPledgeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingPledgeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingPledgeRequest.Merge(m, src)
+}
+func (m *QueryVestingPledgeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingPledgeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingPledgeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingPledgeRequest proto.InternalMessageInfo
+
+func (m *QueryVestingPledgeRequest) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+// QueryVestingPledgeResponse is the response type for the Query/VestingPledge RPC method.
+type QueryVestingPledgeResponse struct {
+	Pledge VestingPledge `protobuf:"bytes,1,opt,name=pledge,proto3" json:"pledge"`
+	// pledged_amount is the unvested balance currently covered by the pledge
+	PledgedAmount types.Coin `protobuf:"bytes,2,opt,name=pledged_amount,json=pledgedAmount,proto3" json:"pledged_amount"`
+	// effective_collateral is the deposited collateral plus the pledged amount while the pledge is active
+	EffectiveCollateral types.Coin `protobuf:"bytes,3,opt,name=effective_collateral,json=effectiveCollateral,proto3" json:"effective_collateral"`
+}
+
+func (m *QueryVestingPledgeResponse) Reset()         { *m = QueryVestingPledgeResponse{} }
+func (m *QueryVestingPledgeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingPledgeResponse) ProtoMessage()    {}
+func (*QueryVestingPledgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{15}
+}
+func (m *QueryVestingPledgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingPledgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingPledgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingPledgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingPledgeResponse.Merge(m, src)
+}
+func (m *QueryVestingPledgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingPledgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingPledgeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingPledgeResponse proto.InternalMessageInfo
+
+func (m *QueryVestingPledgeResponse) GetPledge() VestingPledge {
+	if m != nil {
+		return m.Pledge
+	}
+	return VestingPledge{}
+}
+
+func (m *QueryVestingPledgeResponse) GetPledgedAmount() types.Coin {
+	if m != nil {
+		return m.PledgedAmount
+	}
+	return types.Coin{}
+}
+
+func (m *QueryVestingPledgeResponse) GetEffectiveCollateral() types.Coin {
+	if m != nil {
+		return m.EffectiveCollateral
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "inference.collateral.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "inference.collateral.QueryParamsResponse")
@@ -501,55 +773,79 @@ func init() {
 	proto.RegisterType((*QueryUnbondingCollateralResponse)(nil), "inference.collateral.QueryUnbondingCollateralResponse")
 	proto.RegisterType((*QueryAllUnbondingCollateralsRequest)(nil), "inference.collateral.QueryAllUnbondingCollateralsRequest")
 	proto.RegisterType((*QueryAllUnbondingCollateralsResponse)(nil), "inference.collateral.QueryAllUnbondingCollateralsResponse")
+	proto.RegisterType((*QueryCompensationPoolRequest)(nil), "inference.collateral.QueryCompensationPoolRequest")
+	proto.RegisterType((*QueryCompensationPoolResponse)(nil), "inference.collateral.QueryCompensationPoolResponse")
+	proto.RegisterType((*QuerySlashDistributionsRequest)(nil), "inference.collateral.QuerySlashDistributionsRequest")
+	proto.RegisterType((*QuerySlashDistributionsResponse)(nil), "inference.collateral.QuerySlashDistributionsResponse")
+	proto.RegisterType((*QueryVestingPledgeRequest)(nil), "inference.collateral.QueryVestingPledgeRequest")
+	proto.RegisterType((*QueryVestingPledgeResponse)(nil), "inference.collateral.QueryVestingPledgeResponse")
 }
 
 func init() { proto.RegisterFile("inference/collateral/query.proto", fileDescriptor_eba28a79880c27ca) }
 
 var fileDescriptor_eba28a79880c27ca = []byte{
-	// 681 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0x33, 0x05, 0x82, 0x38, 0x91, 0x58, 0x4c, 0x2b, 0x5a, 0x4c, 0xe5, 0x06, 0x53, 0xd1,
-	0x16, 0xa8, 0xdd, 0xa4, 0xa2, 0x15, 0x15, 0x17, 0xd1, 0x72, 0x59, 0x21, 0xda, 0x48, 0x6c, 0xd8,
-	0xa0, 0xb1, 0x3b, 0x18, 0x4b, 0xce, 0x8c, 0xeb, 0x0b, 0xa2, 0x42, 0x6c, 0x78, 0x02, 0x04, 0x1b,
-	0x9e, 0x02, 0xb1, 0x61, 0xcf, 0xb2, 0x62, 0x55, 0x89, 0x0d, 0x2b, 0x84, 0x5a, 0x1e, 0x04, 0x65,
-	0x3c, 0x8e, 0x1d, 0x32, 0x31, 0x49, 0x55, 0x76, 0x23, 0xe7, 0x3f, 0xff, 0xf9, 0xce, 0x3f, 0x97,
-	0x40, 0xdd, 0x63, 0xcf, 0x69, 0x48, 0x99, 0x43, 0x2d, 0x87, 0xfb, 0x3e, 0x89, 0x69, 0x48, 0x7c,
-	0x6b, 0x27, 0xa1, 0xe1, 0xae, 0x19, 0x84, 0x3c, 0xe6, 0x78, 0xa2, 0xab, 0x30, 0x73, 0x85, 0x36,
-	0xe1, 0x72, 0x97, 0x0b, 0x81, 0xd5, 0x59, 0xa5, 0x5a, 0x6d, 0xda, 0xe5, 0xdc, 0xf5, 0xa9, 0x45,
-	0x02, 0xcf, 0x22, 0x8c, 0xf1, 0x98, 0xc4, 0x1e, 0x67, 0x91, 0xfc, 0xf5, 0x8a, 0xc3, 0xa3, 0x36,
-	0x8f, 0x2c, 0x9b, 0x44, 0x34, 0x6d, 0x61, 0xbd, 0x6c, 0xd8, 0x34, 0x26, 0x0d, 0x2b, 0x20, 0xae,
-	0xc7, 0x84, 0x58, 0x6a, 0x2f, 0x2a, 0xb9, 0x02, 0x12, 0x92, 0x76, 0x66, 0xa7, 0x17, 0xed, 0x32,
-	0x23, 0x87, 0x7b, 0x99, 0xc5, 0xac, 0xd2, 0x22, 0x61, 0x36, 0x67, 0xdb, 0x1e, 0x73, 0xa5, 0x6a,
-	0x51, 0xa9, 0xca, 0x97, 0xcf, 0x6c, 0xe2, 0x93, 0xce, 0xf0, 0x42, 0x6e, 0x4c, 0x00, 0xde, 0xea,
-	0x90, 0x6f, 0x0a, 0x92, 0x16, 0xdd, 0x49, 0x68, 0x14, 0x1b, 0x5b, 0x30, 0xde, 0xf3, 0x35, 0x0a,
-	0x38, 0x8b, 0x28, 0x5e, 0x83, 0x6a, 0x4a, 0x3c, 0x85, 0xea, 0x68, 0xbe, 0xd6, 0x9c, 0x36, 0x55,
-	0x59, 0x9a, 0x69, 0xd5, 0xfa, 0xc9, 0xbd, 0x9f, 0x33, 0x95, 0x96, 0xac, 0x30, 0xd6, 0xe0, 0x9c,
-	0xb0, 0xdc, 0xe8, 0xea, 0x64, 0x33, 0x5c, 0x87, 0x5a, 0x40, 0xc2, 0xd8, 0x73, 0xbc, 0x80, 0xb0,
-	0x58, 0x58, 0x9f, 0x69, 0x15, 0x3f, 0x19, 0x2d, 0x98, 0xec, 0xab, 0x95, 0x48, 0xab, 0x50, 0x25,
-	0x6d, 0x9e, 0xc8, 0xba, 0x5a, 0xf3, 0xbc, 0x99, 0xa6, 0x68, 0x76, 0x52, 0x34, 0x65, 0x8a, 0xe6,
-	0x06, 0xf7, 0x58, 0xc6, 0x93, 0xca, 0x8d, 0x6d, 0xd0, 0x84, 0xe7, 0x5d, 0xdf, 0xcf, 0x6d, 0xb3,
-	0x00, 0xf0, 0x03, 0x80, 0x7c, 0x0b, 0xa5, 0xf5, 0xe5, 0x1e, 0xeb, 0xf4, 0x48, 0x65, 0x0d, 0x36,
-	0x89, 0x4b, 0x65, 0x6d, 0xab, 0x50, 0x69, 0x7c, 0x41, 0x70, 0x41, 0xd9, 0x46, 0xe2, 0x3f, 0x02,
-	0xc8, 0x83, 0x9b, 0x42, 0xf5, 0x13, 0xf3, 0xb5, 0xe6, 0x9c, 0x3a, 0xd5, 0xbc, 0x7c, 0x3d, 0xdd,
-	0x41, 0x39, 0x50, 0xc1, 0x00, 0x3f, 0xec, 0xc1, 0x1e, 0x13, 0xd8, 0x73, 0xff, 0xc4, 0x4e, 0x59,
-	0x7a, 0xb8, 0x37, 0x60, 0x46, 0x60, 0x3f, 0xc9, 0x4e, 0xd7, 0x51, 0xb6, 0x2d, 0x82, 0xfa, 0x60,
-	0x13, 0x19, 0xc0, 0x63, 0x80, 0xee, 0x09, 0x8e, 0x64, 0x00, 0x0b, 0xea, 0x00, 0x14, 0x36, 0x59,
-	0x04, 0xb9, 0x85, 0xd1, 0x86, 0x4b, 0x59, 0xe0, 0x8a, 0x82, 0x63, 0xdf, 0xe0, 0xaf, 0x08, 0x66,
-	0xcb, 0xfb, 0xfd, 0xa7, 0x41, 0x8f, 0x6d, 0xaf, 0x9b, 0x1f, 0x4f, 0xc3, 0x29, 0x31, 0x02, 0x7e,
-	0x8f, 0xa0, 0x9a, 0x5e, 0x5e, 0x3c, 0xaf, 0x46, 0xeb, 0x7f, 0x2b, 0xb4, 0x85, 0x21, 0x94, 0x69,
-	0x57, 0x63, 0xf9, 0xed, 0xf7, 0xdf, 0x1f, 0xc6, 0x16, 0xf1, 0x55, 0x2b, 0x08, 0xf9, 0x76, 0xe2,
-	0xc4, 0x91, 0xe3, 0x89, 0x97, 0xaa, 0xe4, 0x71, 0xc4, 0x9f, 0x11, 0x40, 0x1e, 0x04, 0xbe, 0x56,
-	0xd2, 0xae, 0xef, 0x90, 0x6a, 0x8b, 0x43, 0xaa, 0x25, 0xe0, 0x7d, 0x01, 0x78, 0x07, 0xdf, 0x1a,
-	0x0a, 0xb0, 0xb0, 0x7c, 0x5d, 0x38, 0xf7, 0x6f, 0xf0, 0x27, 0x04, 0x67, 0x7b, 0x2f, 0x3c, 0x5e,
-	0x2a, 0x01, 0x51, 0x3e, 0x41, 0x5a, 0x63, 0x84, 0x0a, 0x89, 0xbf, 0x2a, 0xf0, 0x1b, 0xd8, 0x1a,
-	0x11, 0x1f, 0x7f, 0x43, 0x30, 0xae, 0x38, 0x75, 0xf8, 0x7a, 0x09, 0xc3, 0xe0, 0xa7, 0x41, 0x5b,
-	0x19, 0xb5, 0x4c, 0xf2, 0xdf, 0x13, 0xfc, 0xb7, 0xf1, 0xcd, 0xa1, 0xf8, 0xbb, 0x77, 0xe1, 0xaf,
-	0xf4, 0xf7, 0x10, 0x4c, 0x0e, 0xb8, 0x8d, 0xf8, 0x46, 0x79, 0xa8, 0x25, 0x2f, 0x86, 0xb6, 0x76,
-	0x94, 0x52, 0x39, 0xd8, 0x8a, 0x18, 0x6c, 0x09, 0x9b, 0xa3, 0x0d, 0xb6, 0xbe, 0xb9, 0x77, 0xa0,
-	0xa3, 0xfd, 0x03, 0x1d, 0xfd, 0x3a, 0xd0, 0xd1, 0xbb, 0x43, 0xbd, 0xb2, 0x7f, 0xa8, 0x57, 0x7e,
-	0x1c, 0xea, 0x95, 0xa7, 0x2b, 0xae, 0x17, 0xbf, 0x48, 0x6c, 0xd3, 0xe1, 0xed, 0xc1, 0x9e, 0xaf,
-	0x8a, 0xae, 0xf1, 0x6e, 0x40, 0x23, 0xbb, 0x2a, 0xfe, 0xf6, 0x97, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xb0, 0x22, 0x4b, 0x7b, 0x28, 0x09, 0x00, 0x00,
+	// 965 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0xa4, 0xb0, 0x88, 0xb7, 0x4a, 0x85, 0x26, 0x11, 0x6d, 0x4d, 0x70, 0x17, 0xb7, 0xa2,
+	0x09, 0x10, 0x3b, 0xd9, 0xd0, 0xb4, 0x8d, 0x68, 0x21, 0x49, 0x29, 0xe2, 0x80, 0xd8, 0x6e, 0x05,
+	0x87, 0x5e, 0x56, 0xb3, 0xde, 0x89, 0x6b, 0xc9, 0xeb, 0x71, 0x77, 0xbc, 0x2b, 0x2a, 0xc4, 0x85,
+	0x5f, 0x80, 0xe0, 0x7f, 0x20, 0x2e, 0x9c, 0x10, 0x12, 0x12, 0x97, 0x88, 0x53, 0x25, 0x2e, 0x1c,
+	0x10, 0x42, 0x09, 0xff, 0x82, 0x0b, 0xda, 0xf1, 0xf3, 0xda, 0xce, 0xce, 0x1a, 0x6f, 0x95, 0xde,
+	0x1c, 0xef, 0xfb, 0xbe, 0xf7, 0xbd, 0x37, 0x6f, 0xbe, 0x17, 0x43, 0xc3, 0x0f, 0x0f, 0xf9, 0x80,
+	0x87, 0x2e, 0x77, 0x5c, 0x11, 0x04, 0x2c, 0xe6, 0x03, 0x16, 0x38, 0x8f, 0x87, 0x7c, 0xf0, 0xc4,
+	0x8e, 0x06, 0x22, 0x16, 0x74, 0x65, 0x12, 0x61, 0x67, 0x11, 0xc6, 0x8a, 0x27, 0x3c, 0xa1, 0x02,
+	0x9c, 0xf1, 0x53, 0x12, 0x6b, 0xac, 0x7a, 0x42, 0x78, 0x01, 0x77, 0x58, 0xe4, 0x3b, 0x2c, 0x0c,
+	0x45, 0xcc, 0x62, 0x5f, 0x84, 0x12, 0x7f, 0x7d, 0xcb, 0x15, 0xb2, 0x2f, 0xa4, 0xd3, 0x65, 0x92,
+	0x27, 0x29, 0x9c, 0xd1, 0x56, 0x97, 0xc7, 0x6c, 0xcb, 0x89, 0x98, 0xe7, 0x87, 0x2a, 0x18, 0x63,
+	0xdf, 0xd0, 0xea, 0x8a, 0xd8, 0x80, 0xf5, 0x53, 0x3a, 0x33, 0x4f, 0x97, 0x12, 0xb9, 0xc2, 0x4f,
+	0x29, 0xae, 0x6a, 0x29, 0x86, 0x61, 0x57, 0x84, 0x3d, 0x3f, 0xf4, 0x30, 0x6a, 0x43, 0x1b, 0x95,
+	0x3d, 0x76, 0xba, 0x2c, 0x60, 0xe3, 0xe2, 0xcb, 0xc2, 0x65, 0xc0, 0xe4, 0xa3, 0x4e, 0xcf, 0x97,
+	0xf1, 0xc0, 0xef, 0x0e, 0x73, 0x65, 0xac, 0x6b, 0xc3, 0x47, 0x5c, 0xc6, 0x7e, 0xe8, 0x75, 0xa2,
+	0x80, 0xf7, 0x3c, 0x64, 0xb6, 0x56, 0x80, 0xde, 0x1f, 0xf7, 0xa4, 0xa5, 0x6a, 0x6c, 0xf3, 0xc7,
+	0x43, 0x2e, 0x63, 0xeb, 0x3e, 0x2c, 0x17, 0xde, 0xca, 0x48, 0x84, 0x92, 0xd3, 0x5d, 0xa8, 0x25,
+	0xbd, 0xb8, 0x48, 0x1a, 0x64, 0xad, 0xde, 0x5c, 0xb5, 0x75, 0xa7, 0x64, 0x27, 0xa8, 0xfd, 0x17,
+	0x8e, 0xfe, 0xba, 0xbc, 0xd0, 0x46, 0x84, 0xb5, 0x0b, 0xaf, 0x2a, 0xca, 0x83, 0x49, 0x1c, 0x26,
+	0xa3, 0x0d, 0xa8, 0x47, 0x6c, 0x10, 0xfb, 0xae, 0x1f, 0xb1, 0x30, 0x56, 0xd4, 0x2f, 0xb7, 0xf3,
+	0xaf, 0xac, 0x36, 0x5c, 0x98, 0xc2, 0xa2, 0xa4, 0x1b, 0x50, 0x63, 0x7d, 0x31, 0x44, 0x5c, 0xbd,
+	0x79, 0xc9, 0x4e, 0xce, 0xc7, 0x1e, 0x9f, 0x8f, 0x8d, 0xe7, 0x63, 0x1f, 0x08, 0x3f, 0x4c, 0xf5,
+	0x24, 0xe1, 0x56, 0x0f, 0x0c, 0xc5, 0xb9, 0x17, 0x04, 0x19, 0x6d, 0xda, 0x00, 0x7a, 0x0f, 0x20,
+	0x1b, 0x0e, 0xa4, 0x7e, 0xb3, 0x40, 0x9d, 0x0c, 0x6b, 0x9a, 0xa0, 0xc5, 0x3c, 0x8e, 0xd8, 0x76,
+	0x0e, 0x69, 0xfd, 0x48, 0xe0, 0x35, 0x6d, 0x1a, 0x94, 0xff, 0x09, 0x40, 0xd6, 0xb8, 0x8b, 0xa4,
+	0x71, 0x6e, 0xad, 0xde, 0xbc, 0xa6, 0xef, 0x6a, 0x06, 0xdf, 0x4f, 0x66, 0x03, 0x0b, 0xca, 0x11,
+	0xd0, 0x8f, 0x0a, 0xb2, 0x17, 0x95, 0xec, 0x6b, 0xff, 0x2b, 0x3b, 0xd1, 0x52, 0xd0, 0x7d, 0x00,
+	0x97, 0x95, 0xec, 0xcf, 0xd2, 0xb9, 0x7d, 0x96, 0x63, 0x93, 0xd0, 0x98, 0x4d, 0x82, 0x0d, 0xf8,
+	0x14, 0x60, 0x72, 0x37, 0x24, 0x36, 0x60, 0x5d, 0xdf, 0x00, 0x0d, 0x4d, 0xda, 0x82, 0x8c, 0xc2,
+	0xea, 0xc3, 0x95, 0xb4, 0xe1, 0x1a, 0xc0, 0x99, 0x1f, 0xf0, 0x2f, 0x04, 0xae, 0x96, 0xe7, 0x7b,
+	0x4e, 0x85, 0x9e, 0xdd, 0x59, 0x9b, 0xb0, 0x8a, 0xb7, 0xab, 0x1f, 0xf1, 0x50, 0xaa, 0x97, 0x2d,
+	0x21, 0xd2, 0x83, 0xb6, 0x1e, 0xc2, 0xeb, 0x33, 0x7e, 0xc7, 0xd2, 0x6e, 0xc1, 0x4b, 0x68, 0x57,
+	0x55, 0x2f, 0x61, 0x1a, 0x6f, 0x35, 0xc0, 0x54, 0xdc, 0x0f, 0xc6, 0x56, 0x76, 0x37, 0xe7, 0x64,
+	0x13, 0x2b, 0x1a, 0xe1, 0x24, 0xea, 0x22, 0x30, 0xff, 0x03, 0x58, 0xca, 0x9b, 0xa0, 0x2c, 0xbf,
+	0x47, 0x53, 0x44, 0xa8, 0xa9, 0xc8, 0x61, 0xdd, 0x86, 0x4b, 0x2a, 0xef, 0xe7, 0x89, 0x6b, 0xb6,
+	0x94, 0x69, 0x56, 0x9f, 0xfd, 0x7f, 0x09, 0xfa, 0xcb, 0x29, 0x3c, 0x4a, 0xde, 0x83, 0x5a, 0x62,
+	0xc3, 0xd8, 0xb1, 0x2b, 0x7a, 0xad, 0x05, 0xf0, 0xc4, 0x50, 0xd5, 0x5f, 0xf4, 0x1e, 0x9c, 0x4f,
+	0x9e, 0x7a, 0x1d, 0x74, 0xc0, 0xc5, 0x6a, 0xcd, 0x5f, 0x42, 0xd8, 0x9e, 0x42, 0xd1, 0x36, 0xac,
+	0xf0, 0xc3, 0x43, 0xee, 0xc6, 0xfe, 0x88, 0x77, 0x72, 0x66, 0x74, 0xae, 0x1a, 0xdb, 0xf2, 0x04,
+	0x9c, 0x4d, 0x6b, 0xf3, 0xcf, 0x3a, 0xbc, 0xa8, 0xaa, 0xa7, 0xdf, 0x12, 0xa8, 0x25, 0xfb, 0x80,
+	0xae, 0xe9, 0x6b, 0x9c, 0x5e, 0x3f, 0xc6, 0x7a, 0x85, 0xc8, 0xa4, 0x91, 0xd6, 0xf6, 0xd7, 0xbf,
+	0xff, 0xf3, 0xdd, 0xe2, 0x06, 0x7d, 0xdb, 0x89, 0x06, 0xa2, 0x37, 0x74, 0x63, 0xe9, 0xfa, 0x6a,
+	0xf1, 0x95, 0x6c, 0x72, 0xfa, 0x03, 0x01, 0xc8, 0xd4, 0xd2, 0x77, 0x4a, 0xd2, 0x4d, 0xf9, 0x9e,
+	0xb1, 0x51, 0x31, 0x1a, 0x05, 0x7e, 0xa8, 0x04, 0xbe, 0x4f, 0x6f, 0x57, 0x12, 0x98, 0x7b, 0xfc,
+	0x32, 0x37, 0x4e, 0x5f, 0xd1, 0xef, 0x09, 0x9c, 0x2f, 0xee, 0x10, 0xba, 0x59, 0x22, 0x44, 0xbb,
+	0xd5, 0x8c, 0xad, 0x39, 0x10, 0x28, 0xff, 0x86, 0x92, 0xbf, 0x45, 0x9d, 0x39, 0xe5, 0xd3, 0xdf,
+	0x08, 0x2c, 0x6b, 0x8c, 0x8c, 0x5e, 0x2f, 0xd1, 0x30, 0x7b, 0xdb, 0x18, 0x3b, 0xf3, 0xc2, 0x50,
+	0xff, 0x5d, 0xa5, 0xff, 0x0e, 0x7d, 0xaf, 0x92, 0xfe, 0x89, 0xbd, 0x9e, 0xea, 0xfe, 0x11, 0x81,
+	0x0b, 0x33, 0x0c, 0x9e, 0xde, 0x2a, 0x6f, 0x6a, 0xc9, 0x12, 0x32, 0x76, 0x9f, 0x05, 0x8a, 0x85,
+	0xed, 0xa8, 0xc2, 0x36, 0xa9, 0x3d, 0x5f, 0x61, 0xf4, 0x27, 0x02, 0xaf, 0x9c, 0x76, 0x72, 0xda,
+	0x2c, 0x9d, 0x69, 0xed, 0x5a, 0x30, 0xb6, 0xe7, 0xc2, 0xa0, 0xea, 0x3b, 0x4a, 0xf5, 0x4d, 0xba,
+	0x53, 0x71, 0x9c, 0x32, 0x9a, 0x4e, 0x34, 0x16, 0xfa, 0x2b, 0x01, 0x3a, 0xbd, 0x09, 0xe8, 0xbb,
+	0x25, 0x5a, 0x66, 0xae, 0x16, 0xe3, 0xfa, 0x9c, 0x28, 0xac, 0xe1, 0x03, 0x55, 0xc3, 0x2e, 0xbd,
+	0x59, 0xa9, 0x86, 0xe9, 0x7f, 0xd2, 0x25, 0xfd, 0x99, 0xc0, 0x52, 0xc1, 0xda, 0xa9, 0x53, 0x22,
+	0x45, 0xb7, 0x81, 0x8c, 0xcd, 0xea, 0x00, 0x94, 0xfd, 0xb1, 0x92, 0x7d, 0x40, 0xf7, 0x2a, 0xc9,
+	0x2e, 0x7e, 0x2c, 0x14, 0xaf, 0xc3, 0x7e, 0xeb, 0xe8, 0xd8, 0x24, 0x4f, 0x8f, 0x4d, 0xf2, 0xf7,
+	0xb1, 0x49, 0xbe, 0x39, 0x31, 0x17, 0x9e, 0x9e, 0x98, 0x0b, 0x7f, 0x9c, 0x98, 0x0b, 0x0f, 0x77,
+	0x3c, 0x3f, 0x7e, 0x34, 0xec, 0xda, 0xae, 0xe8, 0xcf, 0x4e, 0xf3, 0x45, 0x3e, 0x51, 0xfc, 0x24,
+	0xe2, 0xb2, 0x5b, 0x53, 0x5f, 0x23, 0xdb, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc0, 0xeb, 0xda,
+	0xb8, 0x19, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -574,6 +870,12 @@ type QueryClient interface {
 	UnbondingCollateral(ctx context.Context, in *QueryUnbondingCollateralRequest, opts ...grpc.CallOption) (*QueryUnbondingCollateralResponse, error)
 	// Queries all unbonding collateral.
 	AllUnbondingCollaterals(ctx context.Context, in *QueryAllUnbondingCollateralsRequest, opts ...grpc.CallOption) (*QueryAllUnbondingCollateralsResponse, error)
+	// Queries the balance of the compensation pool funded by slashed collateral.
+	CompensationPool(ctx context.Context, in *QueryCompensationPoolRequest, opts ...grpc.CallOption) (*QueryCompensationPoolResponse, error)
+	// Queries the cumulative slashed amounts per reason and destination.
+	SlashDistributions(ctx context.Context, in *QuerySlashDistributionsRequest, opts ...grpc.CallOption) (*QuerySlashDistributionsResponse, error)
+	// Queries a participant's vesting collateral pledge and the collateral it counts for.
+	VestingPledge(ctx context.Context, in *QueryVestingPledgeRequest, opts ...grpc.CallOption) (*QueryVestingPledgeResponse, error)
 }
 
 type queryClient struct {
@@ -629,6 +931,33 @@ func (c *queryClient) AllUnbondingCollaterals(ctx context.Context, in *QueryAllU
 	return out, nil
 }
 
+func (c *queryClient) CompensationPool(ctx context.Context, in *QueryCompensationPoolRequest, opts ...grpc.CallOption) (*QueryCompensationPoolResponse, error) {
+	out := new(QueryCompensationPoolResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Query/CompensationPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashDistributions(ctx context.Context, in *QuerySlashDistributionsRequest, opts ...grpc.CallOption) (*QuerySlashDistributionsResponse, error) {
+	out := new(QuerySlashDistributionsResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Query/SlashDistributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingPledge(ctx context.Context, in *QueryVestingPledgeRequest, opts ...grpc.CallOption) (*QueryVestingPledgeResponse, error) {
+	out := new(QueryVestingPledgeResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Query/VestingPledge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -641,6 +970,12 @@ type QueryServer interface {
 	UnbondingCollateral(context.Context, *QueryUnbondingCollateralRequest) (*QueryUnbondingCollateralResponse, error)
 	// Queries all unbonding collateral.
 	AllUnbondingCollaterals(context.Context, *QueryAllUnbondingCollateralsRequest) (*QueryAllUnbondingCollateralsResponse, error)
+	// Queries the balance of the compensation pool funded by slashed collateral.
+	CompensationPool(context.Context, *QueryCompensationPoolRequest) (*QueryCompensationPoolResponse, error)
+	// Queries the cumulative slashed amounts per reason and destination.
+	SlashDistributions(context.Context, *QuerySlashDistributionsRequest) (*QuerySlashDistributionsResponse, error)
+	// Queries a participant's vesting collateral pledge and the collateral it counts for.
+	VestingPledge(context.Context, *QueryVestingPledgeRequest) (*QueryVestingPledgeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -662,6 +997,15 @@ func (*UnimplementedQueryServer) UnbondingCollateral(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) AllUnbondingCollaterals(ctx context.Context, req *QueryAllUnbondingCollateralsRequest) (*QueryAllUnbondingCollateralsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllUnbondingCollaterals not implemented")
 }
+func (*UnimplementedQueryServer) CompensationPool(ctx context.Context, req *QueryCompensationPoolRequest) (*QueryCompensationPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompensationPool not implemented")
+}
+func (*UnimplementedQueryServer) SlashDistributions(ctx context.Context, req *QuerySlashDistributionsRequest) (*QuerySlashDistributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashDistributions not implemented")
+}
+func (*UnimplementedQueryServer) VestingPledge(ctx context.Context, req *QueryVestingPledgeRequest) (*QueryVestingPledgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingPledge not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -757,6 +1101,60 @@ func _Query_AllUnbondingCollaterals_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CompensationPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCompensationPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CompensationPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Query/CompensationPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CompensationPool(ctx, req.(*QueryCompensationPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashDistributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Query/SlashDistributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashDistributions(ctx, req.(*QuerySlashDistributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingPledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingPledgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingPledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Query/VestingPledge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingPledge(ctx, req.(*QueryVestingPledgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.collateral.Query",
@@ -782,6 +1180,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllUnbondingCollaterals",
 			Handler:    _Query_AllUnbondingCollaterals_Handler,
 		},
+		{
+			MethodName: "CompensationPool",
+			Handler:    _Query_CompensationPool_Handler,
+		},
+		{
+			MethodName: "SlashDistributions",
+			Handler:    _Query_SlashDistributions_Handler,
+		},
+		{
+			MethodName: "VestingPledge",
+			Handler:    _Query_VestingPledge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/collateral/query.proto",
@@ -1141,34 +1551,233 @@ func (m *QueryAllUnbondingCollateralsResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCompensationPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryCompensationPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCompensationPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCompensationPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCompensationPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCompensationPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashDistributionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashDistributionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashDistributionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashDistributionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashDistributionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashDistributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingPledgeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingPledgeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingPledgeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingPledgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingPledgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingPledgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EffectiveCollateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PledgedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Pledge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1288,6 +1897,78 @@ func (m *QueryAllUnbondingCollateralsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCompensationPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCompensationPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySlashDistributionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySlashDistributionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVestingPledgeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingPledgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pledge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PledgedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EffectiveCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1370,12 +2051,346 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCollateralsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCollateralsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCollateralsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCollateralsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCollateralsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1402,7 +2417,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Collateral = append(m.Collateral, CollateralBalance{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1427,7 +2479,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingCollateralRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1450,10 +2502,10 @@ func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollateralRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingCollateralRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1509,7 +2561,7 @@ func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1532,15 +2584,15 @@ func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1567,7 +2619,8 @@ func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unbondings = append(m.Unbondings, UnbondingCollateral{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1592,7 +2645,7 @@ func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCollateralsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllUnbondingCollateralsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1615,10 +2668,10 @@ func (m *QueryAllCollateralsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCollateralsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllUnbondingCollateralsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCollateralsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllUnbondingCollateralsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1678,7 +2731,7 @@ func (m *QueryAllCollateralsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllUnbondingCollateralsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1701,15 +2754,15 @@ func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCollateralsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllUnbondingCollateralsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCollateralsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllUnbondingCollateralsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1736,8 +2789,8 @@ func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Collateral = append(m.Collateral, CollateralBalance{})
-			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unbondings = append(m.Unbondings, UnbondingCollateral{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1798,7 +2851,7 @@ func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingCollateralRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCompensationPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1821,17 +2874,67 @@ func (m *QueryUnbondingCollateralRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingCollateralRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCompensationPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCompensationPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCompensationPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCompensationPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCompensationPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1841,23 +2944,24 @@ func (m *QueryUnbondingCollateralRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1880,7 +2984,7 @@ func (m *QueryUnbondingCollateralRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashDistributionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1903,15 +3007,65 @@ func (m *QueryUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashDistributionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashDistributionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashDistributionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashDistributionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashDistributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1938,8 +3092,8 @@ func (m *QueryUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unbondings = append(m.Unbondings, UnbondingCollateral{})
-			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Distributions = append(m.Distributions, SlashDistribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1964,7 +3118,7 @@ func (m *QueryUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUnbondingCollateralsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingPledgeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1987,17 +3141,17 @@ func (m *QueryAllUnbondingCollateralsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUnbondingCollateralsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingPledgeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUnbondingCollateralsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingPledgeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2007,27 +3161,23 @@ func (m *QueryAllUnbondingCollateralsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2050,7 +3200,7 @@ func (m *QueryAllUnbondingCollateralsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUnbondingCollateralsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingPledgeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2073,15 +3223,15 @@ func (m *QueryAllUnbondingCollateralsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUnbondingCollateralsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingPledgeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUnbondingCollateralsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingPledgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pledge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2108,14 +3258,13 @@ func (m *QueryAllUnbondingCollateralsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unbondings = append(m.Unbondings, UnbondingCollateral{})
-			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pledge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PledgedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2142,10 +3291,40 @@ func (m *QueryAllUnbondingCollateralsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if err := m.PledgedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
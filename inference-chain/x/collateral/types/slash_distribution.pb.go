@@ -0,0 +1,416 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/collateral/slash_distribution.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SlashDistribution is the cumulative amount slashed for a reason and sent to a destination.
+type SlashDistribution struct {
+	Reason      string           `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Destination SlashDestination `protobuf:"varint,2,opt,name=destination,proto3,enum=inference.collateral.SlashDestination" json:"destination,omitempty"`
+	Amount      types.Coin       `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *SlashDistribution) Reset()         { *m = SlashDistribution{} }
+func (m *SlashDistribution) String() string { return proto.CompactTextString(m) }
+func (*SlashDistribution) ProtoMessage()    {}
+func (*SlashDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5318295634df5ce, []int{0}
+}
+func (m *SlashDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashDistribution.Merge(m, src)
+}
+func (m *SlashDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashDistribution proto.InternalMessageInfo
+
+func (m *SlashDistribution) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SlashDistribution) GetDestination() SlashDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return SlashDestination_SLASH_DESTINATION_BURN
+}
+
+func (m *SlashDistribution) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*SlashDistribution)(nil), "inference.collateral.SlashDistribution")
+}
+
+func init() {
+	proto.RegisterFile("inference/collateral/slash_distribution.proto", fileDescriptor_d5318295634df5ce)
+}
+
+var fileDescriptor_d5318295634df5ce = []byte{
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x3f, 0x4b, 0xc4, 0x30,
+	0x1c, 0x86, 0x1b, 0x95, 0x03, 0x7b, 0x20, 0x58, 0x0e, 0x39, 0x3b, 0xc4, 0xea, 0x20, 0x5d, 0x4c,
+	0xb8, 0x13, 0x74, 0x3f, 0x1d, 0x1c, 0xa5, 0x6e, 0x2e, 0x92, 0xa6, 0xb1, 0x17, 0x68, 0xf3, 0x2b,
+	0x49, 0x2a, 0xfa, 0x2d, 0xfc, 0x24, 0x7e, 0x8e, 0x1b, 0x6f, 0x74, 0x12, 0x69, 0xbf, 0x88, 0xf4,
+	0x0f, 0xb6, 0xc3, 0x6d, 0x09, 0x3c, 0x79, 0xf3, 0x3e, 0xaf, 0x7b, 0x25, 0xd5, 0xab, 0xd0, 0x42,
+	0x71, 0x41, 0x39, 0x64, 0x19, 0xb3, 0x42, 0xb3, 0x8c, 0x9a, 0x8c, 0x99, 0xf5, 0x4b, 0x22, 0x8d,
+	0xd5, 0x32, 0x2e, 0xad, 0x04, 0x45, 0x0a, 0x0d, 0x16, 0xbc, 0xd9, 0x3f, 0x4e, 0x06, 0xdc, 0x9f,
+	0xa5, 0x90, 0x42, 0x0b, 0xd0, 0xe6, 0xd4, 0xb1, 0x3e, 0xe6, 0x60, 0x72, 0x30, 0x34, 0x66, 0x46,
+	0xd0, 0xb7, 0x45, 0x2c, 0x2c, 0x5b, 0x50, 0x0e, 0xb2, 0xcf, 0xf2, 0xcf, 0x77, 0x7e, 0x5d, 0x30,
+	0xcd, 0x72, 0xd3, 0x21, 0x17, 0x5f, 0xc8, 0x3d, 0x7e, 0x6a, 0xba, 0xdc, 0x8f, 0xaa, 0x78, 0x27,
+	0xee, 0x44, 0x0b, 0x66, 0x40, 0xcd, 0x51, 0x80, 0xc2, 0xc3, 0xa8, 0xbf, 0x79, 0x0f, 0xee, 0x34,
+	0x11, 0xc6, 0x4a, 0xc5, 0x1a, 0x6c, 0xbe, 0x17, 0xa0, 0xf0, 0x68, 0x79, 0x49, 0x76, 0x55, 0x26,
+	0x5d, 0xea, 0x40, 0x47, 0xe3, 0xa7, 0xde, 0xad, 0x3b, 0x61, 0x39, 0x94, 0xca, 0xce, 0xf7, 0x03,
+	0x14, 0x4e, 0x97, 0xa7, 0xa4, 0x73, 0x21, 0x8d, 0x0b, 0xe9, 0x5d, 0xc8, 0x1d, 0x48, 0xb5, 0x3a,
+	0xd8, 0xfc, 0x9c, 0x39, 0x51, 0x8f, 0xaf, 0x1e, 0x37, 0x15, 0x46, 0xdb, 0x0a, 0xa3, 0xdf, 0x0a,
+	0xa3, 0xcf, 0x1a, 0x3b, 0xdb, 0x1a, 0x3b, 0xdf, 0x35, 0x76, 0x9e, 0x6f, 0x52, 0x69, 0xd7, 0x65,
+	0x4c, 0x38, 0xe4, 0xb4, 0xd0, 0x90, 0x94, 0xdc, 0x1a, 0x2e, 0x5b, 0xfb, 0x61, 0x87, 0xf7, 0xf1,
+	0x12, 0xf6, 0xa3, 0x10, 0x26, 0x9e, 0xb4, 0x4b, 0x5c, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x31,
+	0x68, 0x89, 0x77, 0xa9, 0x01, 0x00, 0x00,
+}
+
+func (m *SlashDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSlashDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Destination != 0 {
+		i = encodeVarintSlashDistribution(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSlashDistribution(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SlashDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSlashDistribution(uint64(l))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovSlashDistribution(uint64(m.Destination))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSlashDistribution(uint64(l))
+	return n
+}
+
+func sovSlashDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashDistribution(x uint64) (n int) {
+	return sovSlashDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SlashDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= SlashDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlashDistribution(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlashDistribution
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashDistribution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashDistribution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlashDistribution
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlashDistribution
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlashDistribution
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlashDistribution        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlashDistribution          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlashDistribution = fmt.Errorf("proto: unexpected end of group")
+)
@@ -322,6 +322,192 @@ func (m *MsgWithdrawCollateralResponse) GetCompletionEpoch() uint64 {
 	return 0
 }
 
+// MsgPledgeVestingCollateral defines a message to pledge unvested rewards as collateral
+type MsgPledgeVestingCollateral struct {
+	// participant is the address pledging its vesting schedule
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+}
+
+func (m *MsgPledgeVestingCollateral) Reset()         { *m = MsgPledgeVestingCollateral{} }
+func (m *MsgPledgeVestingCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgPledgeVestingCollateral) ProtoMessage()    {}
+func (*MsgPledgeVestingCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{6}
+}
+func (m *MsgPledgeVestingCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPledgeVestingCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPledgeVestingCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPledgeVestingCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPledgeVestingCollateral.Merge(m, src)
+}
+func (m *MsgPledgeVestingCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPledgeVestingCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPledgeVestingCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPledgeVestingCollateral proto.InternalMessageInfo
+
+func (m *MsgPledgeVestingCollateral) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+// MsgPledgeVestingCollateralResponse defines the response structure for executing a
+// MsgPledgeVestingCollateral message.
+type MsgPledgeVestingCollateralResponse struct {
+	// pledged_amount is the unvested balance currently counted as collateral
+	PledgedAmount types.Coin `protobuf:"bytes,1,opt,name=pledged_amount,json=pledgedAmount,proto3" json:"pledged_amount"`
+}
+
+func (m *MsgPledgeVestingCollateralResponse) Reset()         { *m = MsgPledgeVestingCollateralResponse{} }
+func (m *MsgPledgeVestingCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPledgeVestingCollateralResponse) ProtoMessage()    {}
+func (*MsgPledgeVestingCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{7}
+}
+func (m *MsgPledgeVestingCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPledgeVestingCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPledgeVestingCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPledgeVestingCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPledgeVestingCollateralResponse.Merge(m, src)
+}
+func (m *MsgPledgeVestingCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPledgeVestingCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPledgeVestingCollateralResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPledgeVestingCollateralResponse proto.InternalMessageInfo
+
+func (m *MsgPledgeVestingCollateralResponse) GetPledgedAmount() types.Coin {
+	if m != nil {
+		return m.PledgedAmount
+	}
+	return types.Coin{}
+}
+
+// MsgUnpledgeVestingCollateral defines a message to release a vesting collateral pledge
+type MsgUnpledgeVestingCollateral struct {
+	// participant is the address releasing its pledge
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+}
+
+func (m *MsgUnpledgeVestingCollateral) Reset()         { *m = MsgUnpledgeVestingCollateral{} }
+func (m *MsgUnpledgeVestingCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpledgeVestingCollateral) ProtoMessage()    {}
+func (*MsgUnpledgeVestingCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{8}
+}
+func (m *MsgUnpledgeVestingCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpledgeVestingCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpledgeVestingCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpledgeVestingCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpledgeVestingCollateral.Merge(m, src)
+}
+func (m *MsgUnpledgeVestingCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpledgeVestingCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpledgeVestingCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpledgeVestingCollateral proto.InternalMessageInfo
+
+func (m *MsgUnpledgeVestingCollateral) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+// MsgUnpledgeVestingCollateralResponse defines the response structure for executing a
+// MsgUnpledgeVestingCollateral message.
+type MsgUnpledgeVestingCollateralResponse struct {
+	// release_epoch is the epoch after which the pledge can no longer be slashed
+	ReleaseEpoch uint64 `protobuf:"varint,1,opt,name=release_epoch,json=releaseEpoch,proto3" json:"release_epoch,omitempty"`
+}
+
+func (m *MsgUnpledgeVestingCollateralResponse) Reset()         { *m = MsgUnpledgeVestingCollateralResponse{} }
+func (m *MsgUnpledgeVestingCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpledgeVestingCollateralResponse) ProtoMessage()    {}
+func (*MsgUnpledgeVestingCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{9}
+}
+func (m *MsgUnpledgeVestingCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpledgeVestingCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpledgeVestingCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpledgeVestingCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpledgeVestingCollateralResponse.Merge(m, src)
+}
+func (m *MsgUnpledgeVestingCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpledgeVestingCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpledgeVestingCollateralResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpledgeVestingCollateralResponse proto.InternalMessageInfo
+
+func (m *MsgUnpledgeVestingCollateralResponse) GetReleaseEpoch() uint64 {
+	if m != nil {
+		return m.ReleaseEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "inference.collateral.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.collateral.MsgUpdateParamsResponse")
@@ -329,47 +515,60 @@ func init() {
 	proto.RegisterType((*MsgDepositCollateralResponse)(nil), "inference.collateral.MsgDepositCollateralResponse")
 	proto.RegisterType((*MsgWithdrawCollateral)(nil), "inference.collateral.MsgWithdrawCollateral")
 	proto.RegisterType((*MsgWithdrawCollateralResponse)(nil), "inference.collateral.MsgWithdrawCollateralResponse")
+	proto.RegisterType((*MsgPledgeVestingCollateral)(nil), "inference.collateral.MsgPledgeVestingCollateral")
+	proto.RegisterType((*MsgPledgeVestingCollateralResponse)(nil), "inference.collateral.MsgPledgeVestingCollateralResponse")
+	proto.RegisterType((*MsgUnpledgeVestingCollateral)(nil), "inference.collateral.MsgUnpledgeVestingCollateral")
+	proto.RegisterType((*MsgUnpledgeVestingCollateralResponse)(nil), "inference.collateral.MsgUnpledgeVestingCollateralResponse")
 }
 
 func init() { proto.RegisterFile("inference/collateral/tx.proto", fileDescriptor_e738e4a8b0fcc692) }
 
 var fileDescriptor_e738e4a8b0fcc692 = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x4d, 0x6b, 0x13, 0x4f,
-	0x1c, 0xc7, 0x33, 0xfd, 0xff, 0x0d, 0x64, 0x2a, 0xd4, 0x2e, 0x91, 0x26, 0x4b, 0xbb, 0xd6, 0x80,
-	0x52, 0x53, 0xbb, 0x4b, 0x52, 0x28, 0x18, 0x04, 0x6d, 0xaa, 0x17, 0x21, 0x50, 0x22, 0x22, 0x78,
-	0x29, 0x93, 0xd9, 0x71, 0x33, 0x90, 0x9d, 0x19, 0x66, 0x26, 0xb1, 0xbd, 0x89, 0x47, 0x4f, 0xbe,
-	0x0c, 0x8f, 0x39, 0xf8, 0x16, 0x84, 0x1e, 0x4b, 0xf1, 0xe0, 0x49, 0x25, 0x39, 0xe4, 0x6d, 0xc8,
-	0x3e, 0xe4, 0xa1, 0xd9, 0x5d, 0xac, 0x37, 0x2f, 0xc9, 0xce, 0xef, 0xf7, 0xfd, 0x3d, 0x7c, 0x66,
-	0xbf, 0x2c, 0xdc, 0xa2, 0xec, 0x2d, 0x91, 0x84, 0x61, 0xe2, 0x60, 0xde, 0xeb, 0x21, 0x4d, 0x24,
-	0xea, 0x39, 0xfa, 0xd4, 0x16, 0x92, 0x6b, 0x6e, 0x14, 0x67, 0x69, 0x7b, 0x9e, 0x36, 0xd7, 0x91,
-	0x4f, 0x19, 0x77, 0xc2, 0xdf, 0x48, 0x68, 0x6e, 0x60, 0xae, 0x7c, 0xae, 0x1c, 0x5f, 0x79, 0xce,
-	0xa0, 0x16, 0xfc, 0xc5, 0x89, 0x72, 0x94, 0x38, 0x09, 0x4f, 0x4e, 0x74, 0x88, 0x53, 0x45, 0x8f,
-	0x7b, 0x3c, 0x8a, 0x07, 0x4f, 0x71, 0xf4, 0x6e, 0xea, 0x46, 0x02, 0x49, 0xe4, 0x4f, 0x0b, 0xad,
-	0x78, 0x58, 0x07, 0x29, 0xe2, 0x0c, 0x6a, 0x1d, 0xa2, 0x51, 0xcd, 0xc1, 0x9c, 0xb2, 0x28, 0x5f,
-	0xf9, 0x0a, 0xe0, 0x5a, 0x4b, 0x79, 0xaf, 0x84, 0x8b, 0x34, 0x39, 0x0e, 0x2b, 0x8d, 0x03, 0x58,
-	0x40, 0x7d, 0xdd, 0xe5, 0x92, 0xea, 0xb3, 0x12, 0xd8, 0x06, 0x3b, 0x85, 0x66, 0xe9, 0xf2, 0xcb,
-	0x5e, 0x31, 0xde, 0xe8, 0xd0, 0x75, 0x25, 0x51, 0xea, 0xa5, 0x96, 0x94, 0x79, 0xed, 0xb9, 0xd4,
-	0x78, 0x02, 0xf3, 0xd1, 0xec, 0xd2, 0xca, 0x36, 0xd8, 0x59, 0xad, 0x6f, 0xda, 0x69, 0x57, 0x62,
-	0x47, 0x53, 0x9a, 0x85, 0xf3, 0x1f, 0x77, 0x72, 0x9f, 0x27, 0xc3, 0x2a, 0x68, 0xc7, 0x65, 0x8d,
-	0x47, 0x1f, 0x26, 0xc3, 0xea, 0xbc, 0xe1, 0xc7, 0xc9, 0xb0, 0x7a, 0x7f, 0x8e, 0x78, 0xba, 0x08,
-	0xb9, 0xb4, 0x73, 0xa5, 0x0c, 0x37, 0x96, 0x42, 0x6d, 0xa2, 0x04, 0x67, 0x8a, 0x54, 0x2e, 0x01,
-	0x2c, 0xb6, 0x94, 0xf7, 0x8c, 0x08, 0xae, 0xa8, 0x3e, 0x9a, 0xf5, 0x30, 0x1a, 0x70, 0x55, 0x20,
-	0xa9, 0x29, 0xa6, 0x02, 0x31, 0xfd, 0x47, 0xd2, 0x45, 0xb1, 0xf1, 0x18, 0xe6, 0x91, 0xcf, 0xfb,
-	0x4c, 0xc7, 0xac, 0x65, 0x3b, 0xae, 0x09, 0x2e, 0xda, 0x8e, 0x2f, 0xda, 0x3e, 0xe2, 0x94, 0x5d,
-	0x01, 0x8d, 0x6a, 0x1a, 0x4f, 0x03, 0xd0, 0xc5, 0x7e, 0x01, 0xea, 0x6e, 0x36, 0x6a, 0x62, 0xf7,
-	0x8a, 0x05, 0x37, 0xd3, 0xe2, 0x33, 0xe8, 0x6f, 0x00, 0xde, 0x6e, 0x29, 0xef, 0x35, 0xd5, 0x5d,
-	0x57, 0xa2, 0x77, 0xff, 0x04, 0xf5, 0x61, 0x1a, 0xf5, 0xc3, 0x6c, 0xea, 0xe4, 0xf2, 0x95, 0x17,
-	0x70, 0x2b, 0x35, 0x31, 0xe5, 0x36, 0x1e, 0xc0, 0x5b, 0x98, 0xfb, 0xa2, 0x47, 0x34, 0xe5, 0xec,
-	0x84, 0x08, 0x8e, 0xbb, 0x21, 0xe2, 0xff, 0xed, 0xb5, 0x79, 0xfc, 0x79, 0x10, 0xae, 0xff, 0x5c,
-	0x81, 0xff, 0xb5, 0x94, 0x67, 0xb8, 0xf0, 0xe6, 0x15, 0xfb, 0xdf, 0x4b, 0xb7, 0xed, 0x92, 0xbd,
-	0xcc, 0xbd, 0x6b, 0xc9, 0x66, 0x8b, 0x29, 0xb8, 0x9e, 0x74, 0x60, 0x35, 0xb3, 0x47, 0x42, 0x6b,
-	0xd6, 0xaf, 0xaf, 0x9d, 0x0d, 0x1d, 0x40, 0x23, 0xc5, 0x01, 0xbb, 0x99, 0x9d, 0x92, 0x62, 0x73,
-	0xff, 0x2f, 0xc4, 0xd3, 0xb9, 0xe6, 0x8d, 0xf7, 0xc1, 0x8b, 0x6f, 0x1e, 0x9f, 0x8f, 0x2c, 0x70,
-	0x31, 0xb2, 0xc0, 0xaf, 0x91, 0x05, 0x3e, 0x8d, 0xad, 0xdc, 0xc5, 0xd8, 0xca, 0x7d, 0x1f, 0x5b,
-	0xb9, 0x37, 0x07, 0x1e, 0xd5, 0xdd, 0x7e, 0xc7, 0xc6, 0xdc, 0x77, 0x84, 0xe4, 0x6e, 0x1f, 0x6b,
-	0x85, 0x69, 0xe8, 0x82, 0x0c, 0x3f, 0xe8, 0x33, 0x41, 0x54, 0x27, 0x1f, 0x7e, 0xb5, 0xf6, 0x7f,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x12, 0x3f, 0x26, 0x8c, 0x05, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xde, 0xf9, 0xfd, 0x90, 0x84, 0x01, 0x44, 0x9a, 0x35, 0xb0, 0x0d, 0x54, 0xac, 0x7f, 0x82,
+	0x8b, 0xb4, 0x2e, 0x24, 0x44, 0x37, 0x26, 0x0a, 0xa8, 0x31, 0x92, 0x4d, 0xc8, 0x1a, 0x34, 0xf1,
+	0x42, 0x66, 0xdb, 0xb1, 0x3b, 0x49, 0xdb, 0x19, 0x3b, 0xb3, 0x08, 0x37, 0x63, 0xe2, 0x45, 0x2f,
+	0x7e, 0x04, 0x0f, 0x1e, 0x3c, 0x72, 0xf0, 0x2b, 0x98, 0x70, 0x24, 0xc4, 0x83, 0x27, 0x63, 0xe0,
+	0xc0, 0x27, 0xf0, 0x6e, 0xda, 0x0e, 0x5d, 0x60, 0x3b, 0xc8, 0x26, 0x9a, 0x78, 0xd9, 0xdd, 0xbe,
+	0xef, 0xf3, 0xbc, 0xf3, 0x3c, 0x6f, 0xe7, 0x7d, 0x17, 0x8e, 0x93, 0xf0, 0x39, 0x8e, 0x70, 0xe8,
+	0x60, 0xdb, 0xa1, 0xbe, 0x8f, 0x04, 0x8e, 0x90, 0x6f, 0x8b, 0x75, 0x8b, 0x45, 0x54, 0x50, 0xad,
+	0x98, 0xa5, 0xad, 0x76, 0x5a, 0x1f, 0x46, 0x01, 0x09, 0xa9, 0x9d, 0x7c, 0xa6, 0x40, 0x7d, 0xc4,
+	0xa1, 0x3c, 0xa0, 0xdc, 0x0e, 0xb8, 0x67, 0xaf, 0x55, 0xe2, 0x2f, 0x99, 0x28, 0xa5, 0x89, 0xd5,
+	0xe4, 0xc9, 0x4e, 0x1f, 0x64, 0xaa, 0xe8, 0x51, 0x8f, 0xa6, 0xf1, 0xf8, 0x97, 0x8c, 0x5e, 0xcc,
+	0x55, 0xc4, 0x50, 0x84, 0x82, 0x03, 0xa2, 0x21, 0x0f, 0x6b, 0x20, 0x8e, 0xed, 0xb5, 0x4a, 0x03,
+	0x0b, 0x54, 0xb1, 0x1d, 0x4a, 0xc2, 0x34, 0x6f, 0x7e, 0x01, 0x70, 0xa8, 0xc6, 0xbd, 0x15, 0xe6,
+	0x22, 0x81, 0x97, 0x13, 0xa6, 0x36, 0x07, 0xfb, 0x50, 0x4b, 0x34, 0x69, 0x44, 0xc4, 0xc6, 0x28,
+	0x98, 0x00, 0x93, 0x7d, 0x0b, 0xa3, 0x3b, 0x9f, 0xa7, 0x8b, 0x52, 0xd1, 0xbc, 0xeb, 0x46, 0x98,
+	0xf3, 0xc7, 0x22, 0x22, 0xa1, 0x57, 0x6f, 0x43, 0xb5, 0x3b, 0xb0, 0x37, 0x3d, 0x7b, 0xf4, 0xbf,
+	0x09, 0x30, 0xd9, 0x3f, 0x33, 0x66, 0xe5, 0xb5, 0xc4, 0x4a, 0x4f, 0x59, 0xe8, 0xdb, 0xfa, 0x7e,
+	0xa1, 0xf0, 0x69, 0x7f, 0xb3, 0x0c, 0xea, 0x92, 0x56, 0xbd, 0xf5, 0x7a, 0x7f, 0xb3, 0xdc, 0x2e,
+	0xf8, 0x76, 0x7f, 0xb3, 0x7c, 0xb5, 0x6d, 0x71, 0xfd, 0xb0, 0xc9, 0x63, 0x9a, 0xcd, 0x12, 0x1c,
+	0x39, 0x16, 0xaa, 0x63, 0xce, 0x68, 0xc8, 0xb1, 0xb9, 0x03, 0x60, 0xb1, 0xc6, 0xbd, 0x7b, 0x98,
+	0x51, 0x4e, 0xc4, 0x62, 0x56, 0x43, 0xab, 0xc2, 0x7e, 0x86, 0x22, 0x41, 0x1c, 0xc2, 0x50, 0x28,
+	0x7e, 0xeb, 0xf4, 0x30, 0x58, 0xbb, 0x0d, 0x7b, 0x51, 0x40, 0x5b, 0xa1, 0x90, 0x5e, 0x4b, 0x96,
+	0xe4, 0xc4, 0x8d, 0xb6, 0x64, 0xa3, 0xad, 0x45, 0x4a, 0xc2, 0x23, 0x46, 0x53, 0x4e, 0xf5, 0x6e,
+	0x6c, 0xf4, 0x70, 0xbd, 0xd8, 0xea, 0x94, 0xda, 0x6a, 0x87, 0x76, 0xd3, 0x80, 0x63, 0x79, 0xf1,
+	0xcc, 0xf4, 0x57, 0x00, 0xcf, 0xd7, 0xb8, 0xf7, 0x94, 0x88, 0xa6, 0x1b, 0xa1, 0x97, 0xff, 0x84,
+	0xeb, 0xf9, 0x3c, 0xd7, 0xd7, 0xd5, 0xae, 0x3b, 0xc5, 0x9b, 0x8f, 0xe0, 0x78, 0x6e, 0xe2, 0xc0,
+	0xb7, 0x76, 0x0d, 0x9e, 0x73, 0x68, 0xc0, 0x7c, 0x2c, 0x08, 0x0d, 0x57, 0x31, 0xa3, 0x4e, 0x33,
+	0xb1, 0xd8, 0x53, 0x1f, 0x6a, 0xc7, 0xef, 0xc7, 0x61, 0xf3, 0x03, 0x80, 0x7a, 0x8d, 0x7b, 0xcb,
+	0x3e, 0x76, 0x3d, 0xfc, 0x04, 0x73, 0x41, 0x42, 0xef, 0xcf, 0xf4, 0xa9, 0xfa, 0x20, 0xcf, 0x69,
+	0x45, 0xed, 0x54, 0xa1, 0xc1, 0x7c, 0x01, 0x4d, 0x75, 0x36, 0xf3, 0xbc, 0x04, 0xcf, 0xb2, 0x04,
+	0xe2, 0xae, 0xca, 0xb7, 0x03, 0xba, 0x78, 0x3b, 0x83, 0x92, 0x3b, 0x9f, 0x50, 0xcd, 0x8f, 0x20,
+	0xb9, 0x59, 0x2b, 0x21, 0xfb, 0x0b, 0x7d, 0x79, 0x98, 0xd7, 0x97, 0xd9, 0x13, 0x46, 0x5c, 0xa5,
+	0xc2, 0x5c, 0x82, 0x97, 0x4f, 0xca, 0x67, 0xbd, 0xb9, 0x04, 0x07, 0x23, 0xec, 0x63, 0xc4, 0xf1,
+	0x91, 0xcb, 0x30, 0x20, 0x83, 0xc9, 0x4d, 0x98, 0xf9, 0xd9, 0x03, 0xff, 0xaf, 0x71, 0x4f, 0x73,
+	0xe1, 0xc0, 0x91, 0x45, 0x78, 0x25, 0x7f, 0x81, 0x1d, 0x5b, 0x34, 0xfa, 0xf4, 0xa9, 0x60, 0x99,
+	0x24, 0x0e, 0x87, 0x3b, 0x77, 0x51, 0x59, 0x59, 0xa3, 0x03, 0xab, 0xcf, 0x9c, 0x1e, 0x9b, 0x1d,
+	0xba, 0x06, 0xb5, 0x9c, 0x5d, 0x30, 0xa5, 0xac, 0xd4, 0x09, 0xd6, 0x67, 0xbb, 0x00, 0x67, 0xe7,
+	0xbe, 0x01, 0x70, 0x44, 0x35, 0x61, 0x37, 0x94, 0x05, 0x15, 0x0c, 0xfd, 0x66, 0xb7, 0x8c, 0x4c,
+	0xc7, 0x3b, 0x00, 0x4b, 0xea, 0x3b, 0xad, 0xee, 0xa8, 0x92, 0xa3, 0x57, 0xbb, 0xe7, 0x1c, 0xa8,
+	0xd1, 0xcf, 0xbc, 0x8a, 0x47, 0x6f, 0x61, 0x79, 0x6b, 0xd7, 0x00, 0xdb, 0xbb, 0x06, 0xf8, 0xb1,
+	0x6b, 0x80, 0xf7, 0x7b, 0x46, 0x61, 0x7b, 0xcf, 0x28, 0x7c, 0xdb, 0x33, 0x0a, 0xcf, 0xe6, 0x3c,
+	0x22, 0x9a, 0xad, 0x86, 0xe5, 0xd0, 0xc0, 0x66, 0x11, 0x75, 0x5b, 0x8e, 0xe0, 0x0e, 0x49, 0x66,
+	0x44, 0x31, 0x2d, 0x62, 0x83, 0x61, 0xde, 0xe8, 0x4d, 0xfe, 0xd5, 0x67, 0x7f, 0x05, 0x00, 0x00,
+	0xff, 0xff, 0x5f, 0xa3, 0xa3, 0x08, 0xac, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -391,6 +590,10 @@ type MsgClient interface {
 	DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error)
 	// WithdrawCollateral initiates withdrawal of collateral (subject to unbonding period)
 	WithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateral, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error)
+	// PledgeVestingCollateral pledges the participant's unvested rewards as collateral
+	PledgeVestingCollateral(ctx context.Context, in *MsgPledgeVestingCollateral, opts ...grpc.CallOption) (*MsgPledgeVestingCollateralResponse, error)
+	// UnpledgeVestingCollateral releases the pledge (subject to unbonding period)
+	UnpledgeVestingCollateral(ctx context.Context, in *MsgUnpledgeVestingCollateral, opts ...grpc.CallOption) (*MsgUnpledgeVestingCollateralResponse, error)
 }
 
 type msgClient struct {
@@ -428,6 +631,24 @@ func (c *msgClient) WithdrawCollateral(ctx context.Context, in *MsgWithdrawColla
 	return out, nil
 }
 
+func (c *msgClient) PledgeVestingCollateral(ctx context.Context, in *MsgPledgeVestingCollateral, opts ...grpc.CallOption) (*MsgPledgeVestingCollateralResponse, error) {
+	out := new(MsgPledgeVestingCollateralResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/PledgeVestingCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpledgeVestingCollateral(ctx context.Context, in *MsgUnpledgeVestingCollateral, opts ...grpc.CallOption) (*MsgUnpledgeVestingCollateralResponse, error) {
+	out := new(MsgUnpledgeVestingCollateralResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/UnpledgeVestingCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -437,6 +658,10 @@ type MsgServer interface {
 	DepositCollateral(context.Context, *MsgDepositCollateral) (*MsgDepositCollateralResponse, error)
 	// WithdrawCollateral initiates withdrawal of collateral (subject to unbonding period)
 	WithdrawCollateral(context.Context, *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error)
+	// PledgeVestingCollateral pledges the participant's unvested rewards as collateral
+	PledgeVestingCollateral(context.Context, *MsgPledgeVestingCollateral) (*MsgPledgeVestingCollateralResponse, error)
+	// UnpledgeVestingCollateral releases the pledge (subject to unbonding period)
+	UnpledgeVestingCollateral(context.Context, *MsgUnpledgeVestingCollateral) (*MsgUnpledgeVestingCollateralResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -452,6 +677,12 @@ func (*UnimplementedMsgServer) DepositCollateral(ctx context.Context, req *MsgDe
 func (*UnimplementedMsgServer) WithdrawCollateral(ctx context.Context, req *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCollateral not implemented")
 }
+func (*UnimplementedMsgServer) PledgeVestingCollateral(ctx context.Context, req *MsgPledgeVestingCollateral) (*MsgPledgeVestingCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PledgeVestingCollateral not implemented")
+}
+func (*UnimplementedMsgServer) UnpledgeVestingCollateral(ctx context.Context, req *MsgUnpledgeVestingCollateral) (*MsgUnpledgeVestingCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpledgeVestingCollateral not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -511,6 +742,42 @@ func _Msg_WithdrawCollateral_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PledgeVestingCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPledgeVestingCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PledgeVestingCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Msg/PledgeVestingCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PledgeVestingCollateral(ctx, req.(*MsgPledgeVestingCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpledgeVestingCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpledgeVestingCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpledgeVestingCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Msg/UnpledgeVestingCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpledgeVestingCollateral(ctx, req.(*MsgUnpledgeVestingCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.collateral.Msg",
@@ -528,6 +795,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawCollateral",
 			Handler:    _Msg_WithdrawCollateral_Handler,
 		},
+		{
+			MethodName: "PledgeVestingCollateral",
+			Handler:    _Msg_PledgeVestingCollateral_Handler,
+		},
+		{
+			MethodName: "UnpledgeVestingCollateral",
+			Handler:    _Msg_UnpledgeVestingCollateral_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/collateral/tx.proto",
@@ -727,6 +1002,127 @@ func (m *MsgWithdrawCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPledgeVestingCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPledgeVestingCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPledgeVestingCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPledgeVestingCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPledgeVestingCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPledgeVestingCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PledgedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpledgeVestingCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpledgeVestingCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpledgeVestingCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpledgeVestingCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpledgeVestingCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpledgeVestingCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReleaseEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -805,21 +1201,235 @@ func (m *MsgWithdrawCollateralResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if m.CompletionEpoch != 0 {
-		n += 1 + sovTx(uint64(m.CompletionEpoch))
+	var l int
+	_ = l
+	if m.CompletionEpoch != 0 {
+		n += 1 + sovTx(uint64(m.CompletionEpoch))
+	}
+	return n
+}
+
+func (m *MsgPledgeVestingCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPledgeVestingCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PledgedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnpledgeVestingCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpledgeVestingCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReleaseEpoch != 0 {
+		n += 1 + sovTx(uint64(m.ReleaseEpoch))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -842,15 +1452,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -878,11 +1488,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -909,7 +1519,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -934,7 +1544,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -957,10 +1567,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -984,7 +1594,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1007,10 +1617,10 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1099,7 +1709,7 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1122,12 +1732,31 @@ func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEpoch", wireType)
+			}
+			m.CompletionEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1149,7 +1778,7 @@ func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgPledgeVestingCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1172,10 +1801,10 @@ func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPledgeVestingCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPledgeVestingCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1210,9 +1839,59 @@ func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 			}
 			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPledgeVestingCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPledgeVestingCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPledgeVestingCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PledgedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1239,7 +1918,7 @@ func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PledgedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1264,7 +1943,7 @@ func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpledgeVestingCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1287,17 +1966,99 @@ func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpledgeVestingCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpledgeVestingCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpledgeVestingCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpledgeVestingCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpledgeVestingCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseEpoch", wireType)
 			}
-			m.CompletionEpoch = 0
+			m.ReleaseEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1307,7 +2068,7 @@ func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompletionEpoch |= uint64(b&0x7F) << shift
+				m.ReleaseEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
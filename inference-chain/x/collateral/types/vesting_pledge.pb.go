@@ -0,0 +1,397 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/collateral/vesting_pledge.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VestingPledge records that a participant pledged their unvested streamvesting
+// balance as collateral. The pledged amount is not stored: it is always the
+// participant's current unvested balance, which shrinks as tokens unlock.
+type VestingPledge struct {
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// pledged_epoch is the collateral epoch in which the pledge was made
+	PledgedEpoch uint64 `protobuf:"varint,2,opt,name=pledged_epoch,json=pledgedEpoch,proto3" json:"pledged_epoch,omitempty"`
+	// release_epoch is set when the participant unpledges. From then on the
+	// pledge no longer counts toward weight, but it can still be slashed until
+	// the epoch completes, like unbonding collateral. Zero while active.
+	ReleaseEpoch uint64 `protobuf:"varint,3,opt,name=release_epoch,json=releaseEpoch,proto3" json:"release_epoch,omitempty"`
+}
+
+func (m *VestingPledge) Reset()         { *m = VestingPledge{} }
+func (m *VestingPledge) String() string { return proto.CompactTextString(m) }
+func (*VestingPledge) ProtoMessage()    {}
+func (*VestingPledge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_909d8def53872c7c, []int{0}
+}
+func (m *VestingPledge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingPledge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingPledge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingPledge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingPledge.Merge(m, src)
+}
+func (m *VestingPledge) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingPledge) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingPledge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingPledge proto.InternalMessageInfo
+
+func (m *VestingPledge) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *VestingPledge) GetPledgedEpoch() uint64 {
+	if m != nil {
+		return m.PledgedEpoch
+	}
+	return 0
+}
+
+func (m *VestingPledge) GetReleaseEpoch() uint64 {
+	if m != nil {
+		return m.ReleaseEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*VestingPledge)(nil), "inference.collateral.VestingPledge")
+}
+
+func init() {
+	proto.RegisterFile("inference/collateral/vesting_pledge.proto", fileDescriptor_909d8def53872c7c)
+}
+
+var fileDescriptor_909d8def53872c7c = []byte{
+	// 220 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcc, 0xcc, 0x4b, 0x4b,
+	0x2d, 0x4a, 0xcd, 0x4b, 0x4e, 0xd5, 0x4f, 0xce, 0xcf, 0xc9, 0x49, 0x2c, 0x49, 0x2d, 0x4a, 0xcc,
+	0xd1, 0x2f, 0x4b, 0x2d, 0x2e, 0xc9, 0xcc, 0x4b, 0x8f, 0x2f, 0xc8, 0x49, 0x4d, 0x49, 0x4f, 0xd5,
+	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81, 0x2b, 0xd5, 0x43, 0x28, 0x55, 0xaa, 0xe6, 0xe2,
+	0x0d, 0x83, 0xa8, 0x0e, 0x00, 0x2b, 0x16, 0x52, 0xe0, 0xe2, 0x2e, 0x48, 0x2c, 0x2a, 0xc9, 0x4c,
+	0xce, 0x2c, 0x48, 0xcc, 0x2b, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x42, 0x16, 0x12, 0x52,
+	0xe6, 0xe2, 0x85, 0x18, 0x9c, 0x12, 0x9f, 0x5a, 0x90, 0x9f, 0x9c, 0x21, 0xc1, 0xa4, 0xc0, 0xa8,
+	0xc1, 0x12, 0xc4, 0x03, 0x15, 0x74, 0x05, 0x89, 0x81, 0x14, 0x15, 0xa5, 0xe6, 0xa4, 0x26, 0x16,
+	0xa7, 0x42, 0x15, 0x31, 0x43, 0x14, 0x41, 0x05, 0xc1, 0x8a, 0x9c, 0x02, 0x4e, 0x3c, 0x92, 0x63,
+	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
+	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x2c, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39,
+	0x3f, 0x57, 0xbf, 0xa0, 0x28, 0x3f, 0xa5, 0x34, 0xb9, 0xa4, 0x38, 0x39, 0x13, 0xec, 0x4f, 0x84,
+	0x8f, 0x2b, 0x90, 0xfd, 0x5c, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0xab, 0x31, 0x20,
+	0x00, 0x00, 0xff, 0xff, 0x61, 0x04, 0xfc, 0xe6, 0x18, 0x01, 0x00, 0x00,
+}
+
+func (m *VestingPledge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingPledge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingPledge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseEpoch != 0 {
+		i = encodeVarintVestingPledge(dAtA, i, uint64(m.ReleaseEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PledgedEpoch != 0 {
+		i = encodeVarintVestingPledge(dAtA, i, uint64(m.PledgedEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintVestingPledge(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVestingPledge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVestingPledge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VestingPledge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovVestingPledge(uint64(l))
+	}
+	if m.PledgedEpoch != 0 {
+		n += 1 + sovVestingPledge(uint64(m.PledgedEpoch))
+	}
+	if m.ReleaseEpoch != 0 {
+		n += 1 + sovVestingPledge(uint64(m.ReleaseEpoch))
+	}
+	return n
+}
+
+func sovVestingPledge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVestingPledge(x uint64) (n int) {
+	return sovVestingPledge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VestingPledge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVestingPledge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingPledge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingPledge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingPledge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVestingPledge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingPledge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PledgedEpoch", wireType)
+			}
+			m.PledgedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingPledge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PledgedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseEpoch", wireType)
+			}
+			m.ReleaseEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingPledge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVestingPledge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVestingPledge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVestingPledge(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVestingPledge
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVestingPledge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVestingPledge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVestingPledge
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVestingPledge
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVestingPledge
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVestingPledge        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVestingPledge          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVestingPledge = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/genesistransfer/types"
+)
+
+// ProposeOwnershipSplit handles the MsgProposeOwnershipSplit message
+func (k msgServer) ProposeOwnershipSplit(goCtx context.Context, msg *types.MsgProposeOwnershipSplit) (*types.MsgProposeOwnershipSplitResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid message")
+	}
+	genesisAddr := sdk.MustAccAddressFromBech32(msg.GenesisAddress)
+
+	pending, err := k.Keeper.ProposeOwnershipSplit(goCtx, genesisAddr, msg.Allocations)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "ownership split proposal failed")
+	}
+	return &types.MsgProposeOwnershipSplitResponse{SplitId: pending.SplitId}, nil
+}
+
+// AcceptOwnershipSplit handles the MsgAcceptOwnershipSplit message
+func (k msgServer) AcceptOwnershipSplit(goCtx context.Context, msg *types.MsgAcceptOwnershipSplit) (*types.MsgAcceptOwnershipSplitResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid message")
+	}
+	genesisAddr := sdk.MustAccAddressFromBech32(msg.GenesisAddress)
+	recipientAddr := sdk.MustAccAddressFromBech32(msg.RecipientAddress)
+
+	executed, err := k.Keeper.AcceptOwnershipSplit(goCtx, genesisAddr, recipientAddr, msg.SplitId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgAcceptOwnershipSplitResponse{Executed: executed}, nil
+}
+
+// CancelOwnershipSplit handles the MsgCancelOwnershipSplit message
+func (k msgServer) CancelOwnershipSplit(goCtx context.Context, msg *types.MsgCancelOwnershipSplit) (*types.MsgCancelOwnershipSplitResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid message")
+	}
+	if err := k.Keeper.CancelOwnershipSplit(goCtx, sdk.MustAccAddressFromBech32(msg.GenesisAddress)); err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelOwnershipSplitResponse{}, nil
+}
@@ -0,0 +1,409 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/productscience/inference/x/genesistransfer/types"
+)
+
+// ProposeOwnershipSplit stores a pending split of a genesis account across several recipients.
+// Allocations are resolved against the balances of the genesis account when the last recipient accepts.
+func (k Keeper) ProposeOwnershipSplit(ctx context.Context, genesisAddr sdk.AccAddress, allocations []types.SplitAllocation) (types.PendingOwnershipSplit, error) {
+	if genesisAddr == nil {
+		return types.PendingOwnershipSplit{}, errorsmod.Wrap(types.ErrInvalidSplit, "genesis address cannot be nil")
+	}
+	if err := types.ValidateSplitAllocations(genesisAddr.String(), allocations); err != nil {
+		return types.PendingOwnershipSplit{}, err
+	}
+	if _, found := k.GetPendingOwnershipSplit(ctx, genesisAddr); found {
+		return types.PendingOwnershipSplit{}, types.ErrInvalidSplit.Wrapf("genesis account %s already has a pending split, cancel it first", genesisAddr)
+	}
+	if err := k.validateSplit(ctx, genesisAddr, allocations); err != nil {
+		return types.PendingOwnershipSplit{}, err
+	}
+
+	splitID, err := k.nextSplitID(ctx)
+	if err != nil {
+		return types.PendingOwnershipSplit{}, err
+	}
+	pending := types.PendingOwnershipSplit{
+		SplitId:        splitID,
+		GenesisAddress: genesisAddr.String(),
+		Allocations:    allocations,
+		ProposedHeight: uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+	}
+	if err := k.setPendingOwnershipSplit(ctx, pending); err != nil {
+		return types.PendingOwnershipSplit{}, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"ownership_split_proposed",
+			sdk.NewAttribute("genesis_address", pending.GenesisAddress),
+			sdk.NewAttribute("split_id", fmt.Sprintf("%d", splitID)),
+			sdk.NewAttribute("recipients", fmt.Sprintf("%d", len(allocations))),
+		),
+	)
+	k.Logger().Info(
+		"ownership split proposed",
+		"genesis_address", pending.GenesisAddress,
+		"split_id", splitID,
+		"recipients", len(allocations),
+	)
+	return pending, nil
+}
+
+// AcceptOwnershipSplit records the acceptance of a pending split by one of its recipients and executes the
+// split once every recipient has accepted. It returns whether the split was executed.
+func (k Keeper) AcceptOwnershipSplit(ctx context.Context, genesisAddr, recipientAddr sdk.AccAddress, splitID uint64) (bool, error) {
+	pending, found := k.GetPendingOwnershipSplit(ctx, genesisAddr)
+	if !found || pending.SplitId != splitID {
+		return false, types.ErrSplitNotFound.Wrapf("no pending split %d for genesis account %s", splitID, genesisAddr)
+	}
+
+	allAccepted := true
+	recipientFound := false
+	for i := range pending.Allocations {
+		allocation := &pending.Allocations[i]
+		if allocation.RecipientAddress == recipientAddr.String() {
+			if allocation.Accepted {
+				return false, types.ErrInvalidSplit.Wrapf("recipient %s has already accepted split %d", recipientAddr, splitID)
+			}
+			allocation.Accepted = true
+			recipientFound = true
+		}
+		allAccepted = allAccepted && allocation.Accepted
+	}
+	if !recipientFound {
+		return false, types.ErrInvalidSplit.Wrapf("%s is not a recipient of split %d", recipientAddr, splitID)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"ownership_split_accepted",
+			sdk.NewAttribute("genesis_address", pending.GenesisAddress),
+			sdk.NewAttribute("split_id", fmt.Sprintf("%d", splitID)),
+			sdk.NewAttribute("recipient_address", recipientAddr.String()),
+		),
+	)
+
+	if !allAccepted {
+		return false, k.setPendingOwnershipSplit(ctx, pending)
+	}
+
+	k.deletePendingOwnershipSplit(ctx, genesisAddr)
+	if _, err := k.executeOwnershipSplit(ctx, genesisAddr, pending); err != nil {
+		return false, errorsmod.Wrapf(err, "ownership split %d failed", splitID)
+	}
+	return true, nil
+}
+
+// CancelOwnershipSplit removes the pending split of a genesis account
+func (k Keeper) CancelOwnershipSplit(ctx context.Context, genesisAddr sdk.AccAddress) error {
+	pending, found := k.GetPendingOwnershipSplit(ctx, genesisAddr)
+	if !found {
+		return types.ErrSplitNotFound.Wrapf("no pending split for genesis account %s", genesisAddr)
+	}
+	k.deletePendingOwnershipSplit(ctx, genesisAddr)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"ownership_split_cancelled",
+			sdk.NewAttribute("genesis_address", pending.GenesisAddress),
+			sdk.NewAttribute("split_id", fmt.Sprintf("%d", pending.SplitId)),
+		),
+	)
+	return nil
+}
+
+// validateSplit checks that a split of the genesis account can be executed in the current state
+func (k Keeper) validateSplit(ctx context.Context, genesisAddr sdk.AccAddress, allocations []types.SplitAllocation) error {
+	// A completed full transfer ends all transfers of the account, partial ones included
+	if err := k.validateTransferHistory(ctx, genesisAddr); err != nil {
+		return err
+	}
+	if err := k.validateWhitelist(ctx, genesisAddr); err != nil {
+		return err
+	}
+	if err := k.validateAccountBalance(ctx, genesisAddr); err != nil {
+		return err
+	}
+
+	hasVesting, _, _, err := k.GetVestingInfo(ctx, genesisAddr)
+	if err != nil {
+		return err
+	}
+	for _, allocation := range allocations {
+		recipientAddr, err := sdk.AccAddressFromBech32(allocation.RecipientAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.validateAccountExistence(ctx, genesisAddr, recipientAddr); err != nil {
+			return err
+		}
+		// A recipient's own vesting schedule would be overwritten by the one it receives
+		if _, isVesting := k.accountKeeper.GetAccount(ctx, recipientAddr).(vestingexported.VestingAccount); hasVesting && isVesting {
+			return types.ErrInvalidSplit.Wrapf("recipient %s already has a vesting schedule", recipientAddr)
+		}
+	}
+
+	_, err = types.ResolveSplitAmounts(k.bankView.GetAllBalances(ctx, genesisAddr), allocations)
+	return err
+}
+
+// executeOwnershipSplit moves each recipient's share of the genesis account to it. A vesting schedule is split
+// in the same proportions as the balances: each recipient gets a vesting account of the same type holding its
+// share of every remaining period, and the genesis account keeps the rest.
+func (k Keeper) executeOwnershipSplit(ctx context.Context, genesisAddr sdk.AccAddress, pending types.PendingOwnershipSplit) (types.SplitTransferRecord, error) {
+	if err := k.validateSplit(ctx, genesisAddr, pending.Allocations); err != nil {
+		return types.SplitTransferRecord{}, err
+	}
+
+	genesisAccount := k.accountKeeper.GetAccount(ctx, genesisAddr)
+	allBalances := k.bankView.GetAllBalances(ctx, genesisAddr)
+	amounts, err := types.ResolveSplitAmounts(allBalances, pending.Allocations)
+	if err != nil {
+		return types.SplitTransferRecord{}, err
+	}
+
+	// The remaining schedule of the whole account, which the recipients and the genesis account share
+	var schedule sdk.AccountI
+	if hasVesting, vestingCoins, _, err := k.GetVestingInfo(ctx, genesisAddr); err != nil {
+		return types.SplitTransferRecord{}, err
+	} else if hasVesting && !vestingCoins.IsZero() {
+		schedule, err = k.remainingVestingSchedule(ctx, genesisAccount)
+		if err != nil {
+			return types.SplitTransferRecord{}, errorsmod.Wrapf(err, "failed to prepare vesting schedule")
+		}
+
+		// Unlock the vesting coins of the genesis account for the transfer
+		k.accountKeeper.SetAccount(ctx, authtypes.NewBaseAccount(
+			genesisAccount.GetAddress(),
+			genesisAccount.GetPubKey(),
+			genesisAccount.GetAccountNumber(),
+			genesisAccount.GetSequence(),
+		))
+	}
+
+	record := types.SplitTransferRecord{
+		SplitId:        pending.SplitId,
+		GenesisAddress: pending.GenesisAddress,
+		TransferHeight: uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+	}
+	var recipientAccounts []sdk.AccountI
+	for i, allocation := range pending.Allocations {
+		recipientAddr := sdk.MustAccAddressFromBech32(allocation.RecipientAddress)
+		recipientAccount := k.accountKeeper.GetAccount(ctx, recipientAddr)
+		if recipientAccount == nil {
+			recipientAccount = k.accountKeeper.NewAccountWithAddress(ctx, recipientAddr)
+			k.accountKeeper.SetAccount(ctx, recipientAccount)
+		}
+		if err := k.transferBalances(ctx, genesisAddr, recipientAddr, amounts[i]); err != nil {
+			return types.SplitTransferRecord{}, errorsmod.Wrapf(err, "balance transfer to %s failed", recipientAddr)
+		}
+
+		share := amounts[i]
+		vestingAccount, vesting, err := scaleVestingSchedule(schedule, recipientAccount, func(coins sdk.Coins) sdk.Coins {
+			return types.ScaleCoinsByShare(coins, share, allBalances)
+		})
+		if err != nil {
+			return types.SplitTransferRecord{}, errorsmod.Wrapf(err, "failed to split vesting schedule for %s", recipientAddr)
+		}
+		if vestingAccount != nil {
+			recipientAccounts = append(recipientAccounts, vestingAccount)
+		}
+
+		allocation.Amount = amounts[i]
+		allocation.BasisPoints = 0
+		allocation.Vesting = vesting
+		record.Allocations = append(record.Allocations, allocation)
+	}
+
+	// The genesis account keeps whatever the rounded down shares of the recipients leave of each period
+	genesisVesting, _, err := scaleVestingSchedule(schedule, genesisAccount, func(coins sdk.Coins) sdk.Coins {
+		given := sdk.NewCoins()
+		for _, share := range amounts {
+			given = given.Add(types.ScaleCoinsByShare(coins, share, allBalances)...)
+		}
+		return coins.Sub(given...)
+	})
+	if err != nil {
+		return types.SplitTransferRecord{}, errorsmod.Wrapf(err, "failed to split vesting schedule for %s", genesisAddr)
+	}
+	if genesisVesting != nil {
+		k.accountKeeper.SetAccount(ctx, genesisVesting)
+	}
+	for _, account := range recipientAccounts {
+		k.accountKeeper.SetAccount(ctx, account)
+	}
+
+	record.Remaining = k.bankView.GetAllBalances(ctx, genesisAddr)
+	if err := k.setSplitTransferRecord(ctx, record); err != nil {
+		return types.SplitTransferRecord{}, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"ownership_split_executed",
+			sdk.NewAttribute("genesis_address", record.GenesisAddress),
+			sdk.NewAttribute("split_id", fmt.Sprintf("%d", record.SplitId)),
+			sdk.NewAttribute("transfer_height", fmt.Sprintf("%d", record.TransferHeight)),
+			sdk.NewAttribute("remaining", record.Remaining.String()),
+		),
+	)
+	k.Logger().Info(
+		"ownership split executed",
+		"genesis_address", record.GenesisAddress,
+		"split_id", record.SplitId,
+		"recipients", len(record.Allocations),
+		"remaining", record.Remaining.String(),
+	)
+	return record, nil
+}
+
+// remainingVestingSchedule returns the not yet vested part of the schedule of a vesting account, starting now,
+// or nil if nothing is left to vest
+func (k Keeper) remainingVestingSchedule(ctx context.Context, account sdk.AccountI) (sdk.AccountI, error) {
+	currentTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	switch v := account.(type) {
+	case *vestingtypes.PeriodicVestingAccount:
+		return k.createPeriodicVestingAccount(ctx, v, account, currentTime)
+	case *vestingtypes.ContinuousVestingAccount:
+		return k.createContinuousVestingAccount(ctx, v, account, currentTime)
+	case *vestingtypes.DelayedVestingAccount:
+		return k.createDelayedVestingAccount(ctx, v, account, currentTime)
+	case *vestingtypes.BaseVestingAccount:
+		return k.createBaseVestingAccount(ctx, v, account, currentTime)
+	}
+	return nil, nil
+}
+
+// scaleVestingSchedule creates a vesting account for owner of the same type and timeline as schedule, with every
+// amount passed through scale. It returns nil when schedule is nil or nothing is left to vest after scaling.
+func scaleVestingSchedule(schedule sdk.AccountI, owner sdk.AccountI, scale func(sdk.Coins) sdk.Coins) (sdk.AccountI, sdk.Coins, error) {
+	baseAccount := authtypes.NewBaseAccount(owner.GetAddress(), owner.GetPubKey(), owner.GetAccountNumber(), owner.GetSequence())
+
+	switch v := schedule.(type) {
+	case *vestingtypes.PeriodicVestingAccount:
+		periods := make([]vestingtypes.Period, len(v.VestingPeriods))
+		original := sdk.NewCoins()
+		for i, period := range v.VestingPeriods {
+			periods[i] = vestingtypes.Period{Length: period.Length, Amount: scale(period.Amount)}
+			original = original.Add(periods[i].Amount...)
+		}
+		if original.IsZero() {
+			return nil, nil, nil
+		}
+		account, err := vestingtypes.NewPeriodicVestingAccount(baseAccount, original, v.StartTime, periods)
+		return account, original, err
+	case *vestingtypes.ContinuousVestingAccount:
+		original := scale(v.OriginalVesting)
+		if original.IsZero() {
+			return nil, nil, nil
+		}
+		account, err := vestingtypes.NewContinuousVestingAccount(baseAccount, original, v.StartTime, v.EndTime)
+		return account, original, err
+	case *vestingtypes.DelayedVestingAccount:
+		original := scale(v.OriginalVesting)
+		if original.IsZero() {
+			return nil, nil, nil
+		}
+		account, err := vestingtypes.NewDelayedVestingAccount(baseAccount, original, v.EndTime)
+		return account, original, err
+	case *vestingtypes.BaseVestingAccount:
+		original := scale(v.OriginalVesting)
+		if original.IsZero() {
+			return nil, nil, nil
+		}
+		account, err := vestingtypes.NewBaseVestingAccount(baseAccount, original, v.EndTime)
+		return account, original, err
+	}
+	return nil, nil, nil
+}
+
+// GetPendingOwnershipSplit returns the pending split of a genesis account
+func (k Keeper) GetPendingOwnershipSplit(ctx context.Context, genesisAddr sdk.AccAddress) (types.PendingOwnershipSplit, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := prefix.NewStore(store, []byte(types.PendingSplitKeyPrefix)).Get(genesisAddr.Bytes())
+	if bz == nil {
+		return types.PendingOwnershipSplit{}, false
+	}
+	var pending types.PendingOwnershipSplit
+	k.cdc.MustUnmarshal(bz, &pending)
+	return pending, true
+}
+
+func (k Keeper) setPendingOwnershipSplit(ctx context.Context, pending types.PendingOwnershipSplit) error {
+	genesisAddr, err := sdk.AccAddressFromBech32(pending.GenesisAddress)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&pending)
+	if err != nil {
+		return err
+	}
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix.NewStore(store, []byte(types.PendingSplitKeyPrefix)).Set(genesisAddr.Bytes(), bz)
+	return nil
+}
+
+func (k Keeper) deletePendingOwnershipSplit(ctx context.Context, genesisAddr sdk.AccAddress) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix.NewStore(store, []byte(types.PendingSplitKeyPrefix)).Delete(genesisAddr.Bytes())
+}
+
+// GetSplitTransferRecords returns the executed splits of a genesis account, oldest first
+func (k Keeper) GetSplitTransferRecords(ctx context.Context, genesisAddr sdk.AccAddress) []types.SplitTransferRecord {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	recordStore := prefix.NewStore(store, append([]byte(types.SplitRecordKeyPrefix), address.MustLengthPrefix(genesisAddr)...))
+	iterator := recordStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var records []types.SplitTransferRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SplitTransferRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+func (k Keeper) setSplitTransferRecord(ctx context.Context, record types.SplitTransferRecord) error {
+	genesisAddr, err := sdk.AccAddressFromBech32(record.GenesisAddress)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&record)
+	if err != nil {
+		return err
+	}
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	recordStore := prefix.NewStore(store, append([]byte(types.SplitRecordKeyPrefix), address.MustLengthPrefix(genesisAddr)...))
+	recordStore.Set(binary.BigEndian.AppendUint64(nil, record.SplitId), bz)
+	return nil
+}
+
+// nextSplitID returns the ID of the next proposed ownership split
+func (k Keeper) nextSplitID(ctx context.Context) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.SplitSequenceKey)
+	if err != nil {
+		return 0, err
+	}
+	splitID := uint64(1)
+	if bz != nil {
+		splitID = binary.BigEndian.Uint64(bz) + 1
+	}
+	return splitID, store.Set(types.SplitSequenceKey, binary.BigEndian.AppendUint64(nil, splitID))
+}
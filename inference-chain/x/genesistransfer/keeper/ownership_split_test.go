@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/genesistransfer/keeper"
+	"github.com/productscience/inference/x/genesistransfer/types"
+)
+
+func setupOwnershipSplitTest(t testing.TB) (sdk.Context, keeper.Keeper, *IntegrationMockAccountKeeper, *IntegrationMockBankKeeper, types.MsgServer) {
+	sdk.GetConfig().SetBech32PrefixForAccount("gonka", "gonkapub")
+	ctx, k, accountKeeper, bankKeeper, _, msgServer := setupVestingIntegrationKeepers(t)
+	return ctx, k, accountKeeper, bankKeeper, msgServer
+}
+
+func ugonka(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin("ugonka", math.NewInt(amount)))
+}
+
+// TestOwnershipSplit_PeriodicVestingSplitProportionally splits a periodic vesting genesis account between two
+// recipients, one by percentage and one by amount, and keeps the rest on the genesis account
+func TestOwnershipSplit_PeriodicVestingSplitProportionally(t *testing.T) {
+	ctx, k, accountKeeper, bankKeeper, msgServer := setupOwnershipSplitTest(t)
+
+	genesisAddr := sdk.MustAccAddressFromBech32(testutil.Creator)
+	currentTime := time.Now().Unix()
+	ctx = ctx.WithBlockTime(time.Unix(currentTime, 0))
+
+	periods := []vestingtypes.Period{
+		{Length: 1800, Amount: ugonka(300)},
+		{Length: 1800, Amount: ugonka(400)},
+		{Length: 1800, Amount: ugonka(300)},
+	}
+	genesisAccount, err := vestingtypes.NewPeriodicVestingAccount(authtypes.NewBaseAccountWithAddress(genesisAddr), ugonka(1000), currentTime, periods)
+	require.NoError(t, err)
+	accountKeeper.SetAccount(ctx, genesisAccount)
+	bankKeeper.SetBalance(genesisAddr, ugonka(1000))
+
+	allocations := []types.SplitAllocation{
+		{RecipientAddress: testutil.Requester, BasisPoints: 5000},
+		{RecipientAddress: testutil.Executor, Amount: ugonka(200)},
+	}
+	proposed, err := msgServer.ProposeOwnershipSplit(ctx, &types.MsgProposeOwnershipSplit{GenesisAddress: testutil.Creator, Allocations: allocations})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), proposed.SplitId)
+
+	// Only one split can be pending, and it blocks a full transfer
+	_, err = msgServer.ProposeOwnershipSplit(ctx, &types.MsgProposeOwnershipSplit{GenesisAddress: testutil.Creator, Allocations: allocations})
+	require.ErrorIs(t, err, types.ErrInvalidSplit)
+	require.ErrorIs(t, k.ExecuteOwnershipTransfer(ctx, genesisAddr, sdk.MustAccAddressFromBech32(testutil.Validator)), types.ErrInvalidSplit)
+
+	accepted, err := msgServer.AcceptOwnershipSplit(ctx, &types.MsgAcceptOwnershipSplit{RecipientAddress: testutil.Requester, GenesisAddress: testutil.Creator, SplitId: 1})
+	require.NoError(t, err)
+	require.False(t, accepted.Executed)
+	_, err = msgServer.AcceptOwnershipSplit(ctx, &types.MsgAcceptOwnershipSplit{RecipientAddress: testutil.Requester, GenesisAddress: testutil.Creator, SplitId: 1})
+	require.ErrorIs(t, err, types.ErrInvalidSplit)
+	_, err = msgServer.AcceptOwnershipSplit(ctx, &types.MsgAcceptOwnershipSplit{RecipientAddress: testutil.Validator, GenesisAddress: testutil.Creator, SplitId: 1})
+	require.ErrorIs(t, err, types.ErrInvalidSplit)
+	_, err = msgServer.AcceptOwnershipSplit(ctx, &types.MsgAcceptOwnershipSplit{RecipientAddress: testutil.Executor, GenesisAddress: testutil.Creator, SplitId: 2})
+	require.ErrorIs(t, err, types.ErrSplitNotFound)
+
+	accepted, err = msgServer.AcceptOwnershipSplit(ctx, &types.MsgAcceptOwnershipSplit{RecipientAddress: testutil.Executor, GenesisAddress: testutil.Creator, SplitId: 1})
+	require.NoError(t, err)
+	require.True(t, accepted.Executed)
+
+	// Balances follow the allocations
+	require.Equal(t, ugonka(500), bankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(testutil.Requester)))
+	require.Equal(t, ugonka(200), bankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(testutil.Executor)))
+	require.Equal(t, ugonka(300), bankKeeper.GetAllBalances(ctx, genesisAddr))
+
+	// Every period is split in the same proportions
+	expectedPeriods := map[string][]int64{
+		testutil.Requester: {150, 200, 150},
+		testutil.Executor:  {60, 80, 60},
+		testutil.Creator:   {90, 120, 90},
+	}
+	for address, amounts := range expectedPeriods {
+		account, ok := accountKeeper.GetAccount(ctx, sdk.MustAccAddressFromBech32(address)).(*vestingtypes.PeriodicVestingAccount)
+		require.True(t, ok, "%s should have a periodic vesting account", address)
+		require.Equal(t, currentTime, account.StartTime)
+		require.Len(t, account.VestingPeriods, 3)
+		for i, amount := range amounts {
+			require.Equal(t, int64(1800), account.VestingPeriods[i].Length)
+			require.Equal(t, ugonka(amount), account.VestingPeriods[i].Amount)
+		}
+	}
+
+	res, err := k.OwnershipSplits(ctx, &types.QueryOwnershipSplitsRequest{GenesisAddress: testutil.Creator})
+	require.NoError(t, err)
+	require.Nil(t, res.Pending)
+	require.Len(t, res.Executed, 1)
+	require.Equal(t, ugonka(300), res.Executed[0].Remaining)
+	require.Equal(t, ugonka(500), res.Executed[0].Allocations[0].Amount)
+	require.Equal(t, ugonka(500), res.Executed[0].Allocations[0].Vesting)
+	require.Equal(t, ugonka(200), res.Executed[0].Allocations[1].Vesting)
+
+	// The remainder can still be split, and a cancelled split is gone
+	_, err = msgServer.ProposeOwnershipSplit(ctx, &types.MsgProposeOwnershipSplit{
+		GenesisAddress: testutil.Creator,
+		Allocations:    []types.SplitAllocation{{RecipientAddress: testutil.Validator, BasisPoints: 10000}},
+	})
+	require.NoError(t, err)
+	_, err = msgServer.CancelOwnershipSplit(ctx, &types.MsgCancelOwnershipSplit{GenesisAddress: testutil.Creator})
+	require.NoError(t, err)
+	_, found := k.GetPendingOwnershipSplit(ctx, genesisAddr)
+	require.False(t, found)
+}
+
+func TestOwnershipSplit_Validation(t *testing.T) {
+	ctx, _, accountKeeper, bankKeeper, msgServer := setupOwnershipSplitTest(t)
+
+	genesisAddr := sdk.MustAccAddressFromBech32(testutil.Creator)
+	accountKeeper.SetAccount(ctx, authtypes.NewBaseAccountWithAddress(genesisAddr))
+	bankKeeper.SetBalance(genesisAddr, ugonka(1000))
+
+	invalid := map[string][]types.SplitAllocation{
+		"no allocations":       nil,
+		"amount and percent":   {{RecipientAddress: testutil.Requester, BasisPoints: 100, Amount: ugonka(1)}},
+		"neither":              {{RecipientAddress: testutil.Requester}},
+		"self":                 {{RecipientAddress: testutil.Creator, BasisPoints: 100}},
+		"duplicate recipients": {{RecipientAddress: testutil.Requester, BasisPoints: 100}, {RecipientAddress: testutil.Requester, BasisPoints: 100}},
+		"over 100 percent":     {{RecipientAddress: testutil.Requester, BasisPoints: 6000}, {RecipientAddress: testutil.Executor, BasisPoints: 5000}},
+		"accepted upfront":     {{RecipientAddress: testutil.Requester, BasisPoints: 100, Accepted: true}},
+	}
+	for name, allocations := range invalid {
+		_, err := msgServer.ProposeOwnershipSplit(ctx, &types.MsgProposeOwnershipSplit{GenesisAddress: testutil.Creator, Allocations: allocations})
+		require.ErrorIs(t, err, types.ErrInvalidSplit, name)
+	}
+
+	// Fixed amounts and percentages together cannot exceed the balance
+	_, err := msgServer.ProposeOwnershipSplit(ctx, &types.MsgProposeOwnershipSplit{
+		GenesisAddress: testutil.Creator,
+		Allocations: []types.SplitAllocation{
+			{RecipientAddress: testutil.Requester, BasisPoints: 9000},
+			{RecipientAddress: testutil.Executor, Amount: ugonka(200)},
+		},
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientBalance)
+}
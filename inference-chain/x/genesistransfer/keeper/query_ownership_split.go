@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/productscience/inference/x/genesistransfer/types"
+)
+
+// OwnershipSplits queries the pending and executed ownership splits of a genesis account
+func (k Keeper) OwnershipSplits(goCtx context.Context, req *types.QueryOwnershipSplitsRequest) (*types.QueryOwnershipSplitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	genesisAddr, err := sdk.AccAddressFromBech32(req.GenesisAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid genesis address")
+	}
+
+	response := &types.QueryOwnershipSplitsResponse{
+		Executed: k.GetSplitTransferRecords(goCtx, genesisAddr),
+	}
+	if pending, found := k.GetPendingOwnershipSplit(goCtx, genesisAddr); found {
+		response.Pending = &pending
+	}
+	return response, nil
+}
@@ -27,6 +27,11 @@ func (k Keeper) ValidateTransfer(ctx context.Context, genesisAddr, recipientAddr
 		return err
 	}
 
+	// A pending ownership split must execute or be cancelled first
+	if _, found := k.GetPendingOwnershipSplit(ctx, genesisAddr); found {
+		return types.ErrInvalidSplit.Wrapf("genesis account %s has a pending ownership split", genesisAddr.String())
+	}
+
 	// Validate account existence and ownership
 	if err := k.validateAccountExistence(ctx, genesisAddr, recipientAddr); err != nil {
 		return err
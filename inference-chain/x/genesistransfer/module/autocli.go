@@ -39,6 +39,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "allowed-accounts",
 					Short:     "List all accounts allowed for transfer",
 				},
+				{
+					RpcMethod:      "OwnershipSplits",
+					Use:            "ownership-splits [genesis-address]",
+					Short:          "Shows the pending and executed ownership splits of a genesis account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "genesis_address"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -59,6 +65,33 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "recipient_address"},
 					},
 				},
+				{
+					RpcMethod: "ProposeOwnershipSplit",
+					Use:       "propose-ownership-split [genesis-address]",
+					Short:     "Propose splitting a genesis account across several recipients",
+					Long:      "Propose splitting a genesis account across several recipients. Each --allocations entry is JSON with a recipient_address and either an amount or basis_points of every balance.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "genesis_address"},
+					},
+				},
+				{
+					RpcMethod: "AcceptOwnershipSplit",
+					Use:       "accept-ownership-split [recipient-address] [genesis-address] [split-id]",
+					Short:     "Accept a pending ownership split as one of its recipients",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "recipient_address"},
+						{ProtoField: "genesis_address"},
+						{ProtoField: "split_id"},
+					},
+				},
+				{
+					RpcMethod: "CancelOwnershipSplit",
+					Use:       "cancel-ownership-split [genesis-address]",
+					Short:     "Cancel the pending ownership split of a genesis account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "genesis_address"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
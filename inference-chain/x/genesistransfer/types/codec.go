@@ -13,6 +13,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgTransferOwnership{},
+		&MsgProposeOwnershipSplit{},
+		&MsgAcceptOwnershipSplit{},
+		&MsgCancelOwnershipSplit{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -20,4 +20,6 @@ var (
 	ErrInvalidTransfer          = sdkerrors.Register(ModuleName, 1109, "invalid transfer")
 	ErrAlreadyTransferred       = sdkerrors.Register(ModuleName, 1110, "account already transferred")
 	ErrNotInAllowedList         = sdkerrors.Register(ModuleName, 1111, "account not in allowed transfer list")
+	ErrInvalidSplit             = sdkerrors.Register(ModuleName, 1112, "invalid ownership split")
+	ErrSplitNotFound            = sdkerrors.Register(ModuleName, 1113, "ownership split not found")
 )
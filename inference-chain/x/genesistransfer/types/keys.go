@@ -17,6 +17,12 @@ var (
 
 const (
 	TransferRecordKeyPrefix = "transfer_record/"
+	PendingSplitKeyPrefix   = "pending_split/"
+	SplitRecordKeyPrefix    = "split_record/"
+)
+
+var (
+	SplitSequenceKey = []byte("split_sequence")
 )
 
 func KeyPrefix(p string) []byte {
@@ -0,0 +1,130 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// BasisPointsDenominator is the number of basis points in a whole genesis account
+	BasisPointsDenominator = 10000
+
+	// MaxSplitRecipients is the maximum number of recipients of a single ownership split
+	MaxSplitRecipients = 20
+)
+
+// ValidateSplitAllocations performs stateless validation of the allocations of an ownership split
+func ValidateSplitAllocations(genesisAddress string, allocations []SplitAllocation) error {
+	if len(allocations) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSplit, "at least one allocation is required")
+	}
+	if len(allocations) > MaxSplitRecipients {
+		return sdkerrors.Wrapf(ErrInvalidSplit, "%d allocations exceed the maximum of %d", len(allocations), MaxSplitRecipients)
+	}
+
+	seen := make(map[string]bool, len(allocations))
+	totalBasisPoints := uint64(0)
+	for _, allocation := range allocations {
+		if _, err := sdk.AccAddressFromBech32(allocation.RecipientAddress); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidSplit, "invalid recipient address %s: %v", allocation.RecipientAddress, err)
+		}
+		if allocation.RecipientAddress == genesisAddress {
+			return sdkerrors.Wrapf(ErrInvalidSplit, "cannot split to the same address %s", genesisAddress)
+		}
+		if seen[allocation.RecipientAddress] {
+			return sdkerrors.Wrapf(ErrInvalidSplit, "duplicate recipient %s", allocation.RecipientAddress)
+		}
+		seen[allocation.RecipientAddress] = true
+
+		hasAmount := !allocation.Amount.Empty()
+		if hasAmount == (allocation.BasisPoints != 0) {
+			return sdkerrors.Wrapf(ErrInvalidSplit, "recipient %s must have exactly one of amount and basis points", allocation.RecipientAddress)
+		}
+		if hasAmount && (!allocation.Amount.IsValid() || allocation.Amount.IsZero()) {
+			return sdkerrors.Wrapf(ErrInvalidSplit, "invalid amount %s for recipient %s", allocation.Amount, allocation.RecipientAddress)
+		}
+		if allocation.BasisPoints > BasisPointsDenominator {
+			return sdkerrors.Wrapf(ErrInvalidSplit, "%d basis points for recipient %s exceed %d", allocation.BasisPoints, allocation.RecipientAddress, BasisPointsDenominator)
+		}
+		if allocation.Accepted || !allocation.Vesting.Empty() {
+			return sdkerrors.Wrapf(ErrInvalidSplit, "acceptance and vesting of recipient %s are set by the chain", allocation.RecipientAddress)
+		}
+		totalBasisPoints += uint64(allocation.BasisPoints)
+	}
+	if totalBasisPoints > BasisPointsDenominator {
+		return sdkerrors.Wrapf(ErrInvalidSplit, "allocations total %d basis points, more than %d", totalBasisPoints, BasisPointsDenominator)
+	}
+	return nil
+}
+
+// ResolveSplitAmounts returns the coins each allocation receives out of balances. Basis points are taken from
+// every balance, rounding down. The allocations together cannot exceed balances.
+func ResolveSplitAmounts(balances sdk.Coins, allocations []SplitAllocation) ([]sdk.Coins, error) {
+	amounts := make([]sdk.Coins, len(allocations))
+	total := sdk.NewCoins()
+	for i, allocation := range allocations {
+		if allocation.BasisPoints != 0 {
+			amounts[i] = ScaleCoins(balances, math.NewIntFromUint64(uint64(allocation.BasisPoints)), math.NewInt(BasisPointsDenominator))
+		} else {
+			amounts[i] = allocation.Amount
+		}
+		if amounts[i].IsZero() {
+			return nil, sdkerrors.Wrapf(ErrInvalidSplit, "recipient %s would receive nothing from %s", allocation.RecipientAddress, balances)
+		}
+		total = total.Add(amounts[i]...)
+	}
+	if !balances.IsAllGTE(total) {
+		return nil, sdkerrors.Wrapf(ErrInsufficientBalance, "allocations total %s, more than the balance of %s", total, balances)
+	}
+	return amounts, nil
+}
+
+// ScaleCoins returns coins multiplied by numerator/denominator, rounding down and dropping zero coins
+func ScaleCoins(coins sdk.Coins, numerator, denominator math.Int) sdk.Coins {
+	scaled := sdk.NewCoins()
+	for _, coin := range coins {
+		scaled = scaled.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(numerator).Quo(denominator)))
+	}
+	return scaled
+}
+
+// ScaleCoinsByShare returns the share of coins that share is of whole, denom by denom, rounding down
+func ScaleCoinsByShare(coins, share, whole sdk.Coins) sdk.Coins {
+	scaled := sdk.NewCoins()
+	for _, coin := range coins {
+		wholeAmount := whole.AmountOf(coin.Denom)
+		if wholeAmount.IsZero() {
+			continue
+		}
+		scaled = scaled.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(share.AmountOf(coin.Denom)).Quo(wholeAmount)))
+	}
+	return scaled
+}
+
+// ValidateBasic performs basic validation of MsgProposeOwnershipSplit
+func (msg *MsgProposeOwnershipSplit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.GenesisAddress); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidTransferRequest, "invalid genesis address %s: %v", msg.GenesisAddress, err)
+	}
+	return ValidateSplitAllocations(msg.GenesisAddress, msg.Allocations)
+}
+
+// ValidateBasic performs basic validation of MsgAcceptOwnershipSplit
+func (msg *MsgAcceptOwnershipSplit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.RecipientAddress); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidTransferRequest, "invalid recipient address %s: %v", msg.RecipientAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.GenesisAddress); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidTransferRequest, "invalid genesis address %s: %v", msg.GenesisAddress, err)
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of MsgCancelOwnershipSplit
+func (msg *MsgCancelOwnershipSplit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.GenesisAddress); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidTransferRequest, "invalid genesis address %s: %v", msg.GenesisAddress, err)
+	}
+	return nil
+}
@@ -0,0 +1,1351 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/genesistransfer/ownership_split.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SplitAllocation is the share of a genesis account assigned to one recipient of an ownership split.
+// Exactly one of amount and basis_points is set when the split is proposed.
+type SplitAllocation struct {
+	RecipientAddress string `protobuf:"bytes,1,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	// amount is a fixed amount of coins for the recipient
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// basis_points is the share of every balance of the genesis account, 1 to 10000
+	BasisPoints uint32 `protobuf:"varint,3,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+	// accepted is set once the recipient has accepted the split
+	Accepted bool `protobuf:"varint,4,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// vesting is the part of amount received as a vesting schedule, set when the split executes
+	Vesting github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=vesting,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vesting"`
+}
+
+func (m *SplitAllocation) Reset()         { *m = SplitAllocation{} }
+func (m *SplitAllocation) String() string { return proto.CompactTextString(m) }
+func (*SplitAllocation) ProtoMessage()    {}
+func (*SplitAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbfa1953ad542be, []int{0}
+}
+func (m *SplitAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SplitAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SplitAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SplitAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitAllocation.Merge(m, src)
+}
+func (m *SplitAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SplitAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplitAllocation proto.InternalMessageInfo
+
+func (m *SplitAllocation) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+func (m *SplitAllocation) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *SplitAllocation) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
+func (m *SplitAllocation) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *SplitAllocation) GetVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vesting
+	}
+	return nil
+}
+
+// PendingOwnershipSplit is an ownership split proposed by a genesis account and waiting for its recipients to
+// accept it. It executes in the block its last recipient accepts it.
+type PendingOwnershipSplit struct {
+	SplitId        uint64            `protobuf:"varint,1,opt,name=split_id,json=splitId,proto3" json:"split_id,omitempty"`
+	GenesisAddress string            `protobuf:"bytes,2,opt,name=genesis_address,json=genesisAddress,proto3" json:"genesis_address,omitempty"`
+	Allocations    []SplitAllocation `protobuf:"bytes,3,rep,name=allocations,proto3" json:"allocations"`
+	ProposedHeight uint64            `protobuf:"varint,4,opt,name=proposed_height,json=proposedHeight,proto3" json:"proposed_height,omitempty"`
+}
+
+func (m *PendingOwnershipSplit) Reset()         { *m = PendingOwnershipSplit{} }
+func (m *PendingOwnershipSplit) String() string { return proto.CompactTextString(m) }
+func (*PendingOwnershipSplit) ProtoMessage()    {}
+func (*PendingOwnershipSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbfa1953ad542be, []int{1}
+}
+func (m *PendingOwnershipSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingOwnershipSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingOwnershipSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingOwnershipSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingOwnershipSplit.Merge(m, src)
+}
+func (m *PendingOwnershipSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingOwnershipSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingOwnershipSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingOwnershipSplit proto.InternalMessageInfo
+
+func (m *PendingOwnershipSplit) GetSplitId() uint64 {
+	if m != nil {
+		return m.SplitId
+	}
+	return 0
+}
+
+func (m *PendingOwnershipSplit) GetGenesisAddress() string {
+	if m != nil {
+		return m.GenesisAddress
+	}
+	return ""
+}
+
+func (m *PendingOwnershipSplit) GetAllocations() []SplitAllocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+func (m *PendingOwnershipSplit) GetProposedHeight() uint64 {
+	if m != nil {
+		return m.ProposedHeight
+	}
+	return 0
+}
+
+// SplitTransferRecord records an executed ownership split, with the amounts each recipient received
+type SplitTransferRecord struct {
+	SplitId        uint64            `protobuf:"varint,1,opt,name=split_id,json=splitId,proto3" json:"split_id,omitempty"`
+	GenesisAddress string            `protobuf:"bytes,2,opt,name=genesis_address,json=genesisAddress,proto3" json:"genesis_address,omitempty"`
+	Allocations    []SplitAllocation `protobuf:"bytes,3,rep,name=allocations,proto3" json:"allocations"`
+	TransferHeight uint64            `protobuf:"varint,4,opt,name=transfer_height,json=transferHeight,proto3" json:"transfer_height,omitempty"`
+	// remaining is the balance left on the genesis account after the split
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+}
+
+func (m *SplitTransferRecord) Reset()         { *m = SplitTransferRecord{} }
+func (m *SplitTransferRecord) String() string { return proto.CompactTextString(m) }
+func (*SplitTransferRecord) ProtoMessage()    {}
+func (*SplitTransferRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbfa1953ad542be, []int{2}
+}
+func (m *SplitTransferRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SplitTransferRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SplitTransferRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SplitTransferRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitTransferRecord.Merge(m, src)
+}
+func (m *SplitTransferRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SplitTransferRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitTransferRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplitTransferRecord proto.InternalMessageInfo
+
+func (m *SplitTransferRecord) GetSplitId() uint64 {
+	if m != nil {
+		return m.SplitId
+	}
+	return 0
+}
+
+func (m *SplitTransferRecord) GetGenesisAddress() string {
+	if m != nil {
+		return m.GenesisAddress
+	}
+	return ""
+}
+
+func (m *SplitTransferRecord) GetAllocations() []SplitAllocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+func (m *SplitTransferRecord) GetTransferHeight() uint64 {
+	if m != nil {
+		return m.TransferHeight
+	}
+	return 0
+}
+
+func (m *SplitTransferRecord) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SplitAllocation)(nil), "inference.genesistransfer.SplitAllocation")
+	proto.RegisterType((*PendingOwnershipSplit)(nil), "inference.genesistransfer.PendingOwnershipSplit")
+	proto.RegisterType((*SplitTransferRecord)(nil), "inference.genesistransfer.SplitTransferRecord")
+}
+
+func init() {
+	proto.RegisterFile("inference/genesistransfer/ownership_split.proto", fileDescriptor_1bbfa1953ad542be)
+}
+
+var fileDescriptor_1bbfa1953ad542be = []byte{
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0xd3, 0xd0, 0x26, 0x1b, 0x68, 0xa9, 0x29, 0x92, 0x93, 0x83, 0x13, 0x7a, 0x21, 0xaa,
+	0x54, 0x5b, 0x05, 0x71, 0x81, 0x53, 0x83, 0x90, 0xe0, 0x44, 0xe5, 0x82, 0x90, 0xb8, 0x58, 0x9b,
+	0xf5, 0xd4, 0x19, 0x48, 0x76, 0xad, 0xdd, 0x4d, 0x81, 0xbf, 0xe0, 0x13, 0x38, 0x22, 0x4e, 0x1c,
+	0xf8, 0x88, 0x1e, 0x0b, 0x27, 0x4e, 0x80, 0x92, 0x03, 0xdc, 0xf9, 0x01, 0xe4, 0xf5, 0xda, 0xad,
+	0x82, 0x38, 0x56, 0xe2, 0x62, 0x7b, 0xde, 0x78, 0x66, 0xde, 0xcc, 0x3c, 0x0d, 0x09, 0x91, 0x1f,
+	0x81, 0x04, 0xce, 0x20, 0x4c, 0x81, 0x83, 0x42, 0xa5, 0x25, 0xe5, 0xea, 0x08, 0x64, 0x28, 0x5e,
+	0x71, 0x90, 0x6a, 0x8c, 0x59, 0xac, 0xb2, 0x09, 0xea, 0x20, 0x93, 0x42, 0x0b, 0xb7, 0x53, 0x05,
+	0x04, 0x4b, 0x01, 0xdd, 0x4d, 0x3a, 0x45, 0x2e, 0x42, 0xf3, 0x2c, 0xfe, 0xee, 0xfa, 0x4c, 0xa8,
+	0xa9, 0x50, 0xe1, 0x88, 0x2a, 0x08, 0x8f, 0xf7, 0x46, 0xa0, 0xe9, 0x5e, 0xc8, 0x04, 0x72, 0xeb,
+	0xef, 0x14, 0xfe, 0xd8, 0x58, 0x61, 0x61, 0x58, 0xd7, 0x56, 0x2a, 0x52, 0x51, 0xe0, 0xf9, 0x57,
+	0x81, 0x6e, 0xff, 0xae, 0x93, 0x8d, 0xc3, 0x9c, 0xce, 0xfe, 0x64, 0x22, 0x18, 0xd5, 0x28, 0xb8,
+	0xfb, 0x80, 0x6c, 0x4a, 0x60, 0x98, 0x21, 0x70, 0x1d, 0xd3, 0x24, 0x91, 0xa0, 0x94, 0xe7, 0xf4,
+	0x9d, 0x41, 0x6b, 0xe8, 0x7d, 0xf9, 0xb4, 0xbb, 0x65, 0xd3, 0xee, 0x17, 0x9e, 0x43, 0x2d, 0x91,
+	0xa7, 0xd1, 0xd5, 0x2a, 0xc4, 0xe2, 0xee, 0x98, 0xac, 0xd2, 0xa9, 0x98, 0x71, 0xed, 0xd5, 0xfb,
+	0x2b, 0x83, 0xf6, 0xad, 0x4e, 0x60, 0x03, 0x73, 0xf2, 0x81, 0x25, 0x1f, 0xdc, 0x17, 0xc8, 0x87,
+	0x77, 0x4e, 0xbe, 0xf5, 0x6a, 0x1f, 0xbe, 0xf7, 0x06, 0x29, 0xea, 0xf1, 0x6c, 0x14, 0x30, 0x31,
+	0xb5, 0xe4, 0xed, 0x6b, 0x57, 0x25, 0x2f, 0x43, 0xfd, 0x26, 0x03, 0x65, 0x02, 0xd4, 0xfb, 0x9f,
+	0x1f, 0x77, 0x9c, 0xc8, 0xe6, 0x77, 0x6f, 0x90, 0xcb, 0x23, 0xaa, 0x50, 0xc5, 0x99, 0x40, 0xae,
+	0x95, 0xb7, 0xd2, 0x77, 0x06, 0x57, 0xa2, 0xb6, 0xc1, 0x0e, 0x0c, 0xe4, 0x76, 0x49, 0x93, 0x32,
+	0x06, 0x99, 0x86, 0xc4, 0x6b, 0xf4, 0x9d, 0x41, 0x33, 0xaa, 0x6c, 0xf7, 0x05, 0x59, 0x3b, 0x06,
+	0xa5, 0x91, 0xa7, 0xde, 0xa5, 0x0b, 0x62, 0x5a, 0x16, 0xb8, 0xdb, 0xf8, 0xf5, 0xae, 0xe7, 0x6c,
+	0x2f, 0x1c, 0x72, 0xfd, 0x00, 0x78, 0x82, 0x3c, 0x7d, 0x5c, 0xaa, 0xc2, 0x6c, 0xc1, 0xed, 0x90,
+	0xa6, 0x51, 0x47, 0x8c, 0x89, 0x19, 0x79, 0x23, 0x5a, 0x33, 0xf6, 0xa3, 0xc4, 0xbd, 0x49, 0x36,
+	0xac, 0x42, 0xaa, 0xa5, 0xd4, 0xf3, 0xa5, 0x44, 0xeb, 0x16, 0x2e, 0x07, 0xff, 0x8c, 0xb4, 0x69,
+	0xb5, 0xcd, 0x7c, 0x1a, 0x79, 0x4f, 0x3b, 0xc1, 0x3f, 0x85, 0x16, 0x2c, 0x09, 0x60, 0xd8, 0xca,
+	0x9b, 0x2c, 0x88, 0x9f, 0xcf, 0x94, 0x33, 0xc8, 0xa4, 0xc8, 0x84, 0x82, 0x24, 0x1e, 0x03, 0xa6,
+	0x63, 0x6d, 0x66, 0xd9, 0x88, 0xd6, 0x4b, 0xf8, 0xa1, 0x41, 0x6d, 0x97, 0x9f, 0xeb, 0xe4, 0x9a,
+	0x49, 0xfd, 0xc4, 0x16, 0x8a, 0x80, 0x09, 0x99, 0xfc, 0xf7, 0x3d, 0x96, 0x31, 0x4b, 0x3d, 0x96,
+	0x70, 0xd1, 0xa3, 0xcb, 0x49, 0x4b, 0xc2, 0x94, 0x22, 0xbf, 0x48, 0xdd, 0x9c, 0x95, 0x28, 0x66,
+	0x3a, 0x7c, 0x7a, 0x32, 0xf7, 0x9d, 0xd3, 0xb9, 0xef, 0xfc, 0x98, 0xfb, 0xce, 0xdb, 0x85, 0x5f,
+	0x3b, 0x5d, 0xf8, 0xb5, 0xaf, 0x0b, 0xbf, 0xf6, 0xfc, 0xde, 0xb9, 0xcc, 0x99, 0x14, 0xc9, 0x8c,
+	0x69, 0xc5, 0xd0, 0x5c, 0xa2, 0xb3, 0x9b, 0xf4, 0xfa, 0xaf, 0xab, 0x64, 0x4a, 0x8e, 0x56, 0xcd,
+	0x35, 0xb8, 0xfd, 0x27, 0x00, 0x00, 0xff, 0xff, 0xb4, 0xd4, 0xb2, 0x51, 0xbf, 0x04, 0x00, 0x00,
+}
+
+func (this *SplitAllocation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SplitAllocation)
+	if !ok {
+		that2, ok := that.(SplitAllocation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RecipientAddress != that1.RecipientAddress {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.BasisPoints != that1.BasisPoints {
+		return false
+	}
+	if this.Accepted != that1.Accepted {
+		return false
+	}
+	if len(this.Vesting) != len(that1.Vesting) {
+		return false
+	}
+	for i := range this.Vesting {
+		if !this.Vesting[i].Equal(&that1.Vesting[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PendingOwnershipSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingOwnershipSplit)
+	if !ok {
+		that2, ok := that.(PendingOwnershipSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SplitId != that1.SplitId {
+		return false
+	}
+	if this.GenesisAddress != that1.GenesisAddress {
+		return false
+	}
+	if len(this.Allocations) != len(that1.Allocations) {
+		return false
+	}
+	for i := range this.Allocations {
+		if !this.Allocations[i].Equal(&that1.Allocations[i]) {
+			return false
+		}
+	}
+	if this.ProposedHeight != that1.ProposedHeight {
+		return false
+	}
+	return true
+}
+func (this *SplitTransferRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SplitTransferRecord)
+	if !ok {
+		that2, ok := that.(SplitTransferRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SplitId != that1.SplitId {
+		return false
+	}
+	if this.GenesisAddress != that1.GenesisAddress {
+		return false
+	}
+	if len(this.Allocations) != len(that1.Allocations) {
+		return false
+	}
+	for i := range this.Allocations {
+		if !this.Allocations[i].Equal(&that1.Allocations[i]) {
+			return false
+		}
+	}
+	if this.TransferHeight != that1.TransferHeight {
+		return false
+	}
+	if len(this.Remaining) != len(that1.Remaining) {
+		return false
+	}
+	for i := range this.Remaining {
+		if !this.Remaining[i].Equal(&that1.Remaining[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *SplitAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vesting) > 0 {
+		for iNdEx := len(m.Vesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOwnershipSplit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BasisPoints != 0 {
+		i = encodeVarintOwnershipSplit(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOwnershipSplit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintOwnershipSplit(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingOwnershipSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingOwnershipSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingOwnershipSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposedHeight != 0 {
+		i = encodeVarintOwnershipSplit(dAtA, i, uint64(m.ProposedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOwnershipSplit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.GenesisAddress) > 0 {
+		i -= len(m.GenesisAddress)
+		copy(dAtA[i:], m.GenesisAddress)
+		i = encodeVarintOwnershipSplit(dAtA, i, uint64(len(m.GenesisAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SplitId != 0 {
+		i = encodeVarintOwnershipSplit(dAtA, i, uint64(m.SplitId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SplitTransferRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitTransferRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitTransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOwnershipSplit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TransferHeight != 0 {
+		i = encodeVarintOwnershipSplit(dAtA, i, uint64(m.TransferHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOwnershipSplit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.GenesisAddress) > 0 {
+		i -= len(m.GenesisAddress)
+		copy(dAtA[i:], m.GenesisAddress)
+		i = encodeVarintOwnershipSplit(dAtA, i, uint64(len(m.GenesisAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SplitId != 0 {
+		i = encodeVarintOwnershipSplit(dAtA, i, uint64(m.SplitId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOwnershipSplit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOwnershipSplit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SplitAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovOwnershipSplit(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovOwnershipSplit(uint64(l))
+		}
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovOwnershipSplit(uint64(m.BasisPoints))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	if len(m.Vesting) > 0 {
+		for _, e := range m.Vesting {
+			l = e.Size()
+			n += 1 + l + sovOwnershipSplit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingOwnershipSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SplitId != 0 {
+		n += 1 + sovOwnershipSplit(uint64(m.SplitId))
+	}
+	l = len(m.GenesisAddress)
+	if l > 0 {
+		n += 1 + l + sovOwnershipSplit(uint64(l))
+	}
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovOwnershipSplit(uint64(l))
+		}
+	}
+	if m.ProposedHeight != 0 {
+		n += 1 + sovOwnershipSplit(uint64(m.ProposedHeight))
+	}
+	return n
+}
+
+func (m *SplitTransferRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SplitId != 0 {
+		n += 1 + sovOwnershipSplit(uint64(m.SplitId))
+	}
+	l = len(m.GenesisAddress)
+	if l > 0 {
+		n += 1 + l + sovOwnershipSplit(uint64(l))
+	}
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovOwnershipSplit(uint64(l))
+		}
+	}
+	if m.TransferHeight != 0 {
+		n += 1 + sovOwnershipSplit(uint64(m.TransferHeight))
+	}
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovOwnershipSplit(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovOwnershipSplit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOwnershipSplit(x uint64) (n int) {
+	return sovOwnershipSplit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SplitAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOwnershipSplit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vesting = append(m.Vesting, types.Coin{})
+			if err := m.Vesting[len(m.Vesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOwnershipSplit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingOwnershipSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOwnershipSplit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingOwnershipSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingOwnershipSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitId", wireType)
+			}
+			m.SplitId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplitId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, SplitAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedHeight", wireType)
+			}
+			m.ProposedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOwnershipSplit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SplitTransferRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOwnershipSplit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitTransferRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitTransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitId", wireType)
+			}
+			m.SplitId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplitId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, SplitAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferHeight", wireType)
+			}
+			m.TransferHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOwnershipSplit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOwnershipSplit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOwnershipSplit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOwnershipSplit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOwnershipSplit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOwnershipSplit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOwnershipSplit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOwnershipSplit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOwnershipSplit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOwnershipSplit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOwnershipSplit = fmt.Errorf("proto: unexpected end of group")
+)
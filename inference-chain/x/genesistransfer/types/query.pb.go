@@ -506,6 +506,104 @@ func (m *QueryTransferEligibilityResponse) GetAlreadyTransferred() bool {
 	return false
 }
 
+// QueryOwnershipSplitsRequest is request type for the Query/OwnershipSplits RPC method.
+type QueryOwnershipSplitsRequest struct {
+	GenesisAddress string `protobuf:"bytes,1,opt,name=genesis_address,json=genesisAddress,proto3" json:"genesis_address,omitempty"`
+}
+
+func (m *QueryOwnershipSplitsRequest) Reset()         { *m = QueryOwnershipSplitsRequest{} }
+func (m *QueryOwnershipSplitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnershipSplitsRequest) ProtoMessage()    {}
+func (*QueryOwnershipSplitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1df3317d4ed4316, []int{10}
+}
+func (m *QueryOwnershipSplitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnershipSplitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnershipSplitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnershipSplitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnershipSplitsRequest.Merge(m, src)
+}
+func (m *QueryOwnershipSplitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnershipSplitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnershipSplitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnershipSplitsRequest proto.InternalMessageInfo
+
+func (m *QueryOwnershipSplitsRequest) GetGenesisAddress() string {
+	if m != nil {
+		return m.GenesisAddress
+	}
+	return ""
+}
+
+// QueryOwnershipSplitsResponse is response type for the Query/OwnershipSplits RPC method.
+type QueryOwnershipSplitsResponse struct {
+	Pending  *PendingOwnershipSplit `protobuf:"bytes,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	Executed []SplitTransferRecord  `protobuf:"bytes,2,rep,name=executed,proto3" json:"executed"`
+}
+
+func (m *QueryOwnershipSplitsResponse) Reset()         { *m = QueryOwnershipSplitsResponse{} }
+func (m *QueryOwnershipSplitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnershipSplitsResponse) ProtoMessage()    {}
+func (*QueryOwnershipSplitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1df3317d4ed4316, []int{11}
+}
+func (m *QueryOwnershipSplitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnershipSplitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnershipSplitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnershipSplitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnershipSplitsResponse.Merge(m, src)
+}
+func (m *QueryOwnershipSplitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnershipSplitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnershipSplitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnershipSplitsResponse proto.InternalMessageInfo
+
+func (m *QueryOwnershipSplitsResponse) GetPending() *PendingOwnershipSplit {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func (m *QueryOwnershipSplitsResponse) GetExecuted() []SplitTransferRecord {
+	if m != nil {
+		return m.Executed
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "inference.genesistransfer.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "inference.genesistransfer.QueryParamsResponse")
@@ -517,6 +615,8 @@ func init() {
 	proto.RegisterType((*QueryAllowedAccountsResponse)(nil), "inference.genesistransfer.QueryAllowedAccountsResponse")
 	proto.RegisterType((*QueryTransferEligibilityRequest)(nil), "inference.genesistransfer.QueryTransferEligibilityRequest")
 	proto.RegisterType((*QueryTransferEligibilityResponse)(nil), "inference.genesistransfer.QueryTransferEligibilityResponse")
+	proto.RegisterType((*QueryOwnershipSplitsRequest)(nil), "inference.genesistransfer.QueryOwnershipSplitsRequest")
+	proto.RegisterType((*QueryOwnershipSplitsResponse)(nil), "inference.genesistransfer.QueryOwnershipSplitsResponse")
 }
 
 func init() {
@@ -524,56 +624,63 @@ func init() {
 }
 
 var fileDescriptor_e1df3317d4ed4316 = []byte{
-	// 779 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4b, 0x6f, 0xd3, 0x4a,
-	0x14, 0x8e, 0x5b, 0xdd, 0xdc, 0x66, 0xaa, 0x9b, 0xf4, 0x4e, 0x2b, 0x54, 0x42, 0x49, 0x8b, 0xa5,
-	0x3e, 0x25, 0x6c, 0x5a, 0xc4, 0x43, 0x2d, 0x9b, 0x56, 0x14, 0x10, 0x0f, 0xa9, 0xb8, 0x45, 0x42,
-	0xb0, 0xb0, 0x26, 0xce, 0xd4, 0x1d, 0xc9, 0xf1, 0xb8, 0x33, 0x13, 0x20, 0x42, 0x6c, 0x58, 0xb3,
-	0x40, 0x62, 0xc3, 0x96, 0x1d, 0x4b, 0x7e, 0x05, 0xea, 0xb2, 0x12, 0x2c, 0x58, 0x21, 0xd4, 0x22,
-	0xc1, 0xbf, 0x00, 0x65, 0x66, 0xdc, 0xd6, 0x4e, 0xd2, 0x3c, 0x36, 0x91, 0x7d, 0x9e, 0xdf, 0x77,
-	0xfc, 0x9d, 0xa3, 0x80, 0x69, 0x12, 0x6e, 0x63, 0x86, 0x43, 0x0f, 0xdb, 0x3e, 0x0e, 0x31, 0x27,
-	0x5c, 0x30, 0x14, 0xf2, 0x6d, 0xcc, 0xec, 0xdd, 0x1a, 0x66, 0x75, 0x2b, 0x62, 0x54, 0x50, 0x78,
-	0xf6, 0x28, 0xcc, 0x4a, 0x85, 0x15, 0xff, 0x47, 0x55, 0x12, 0x52, 0x5b, 0xfe, 0xaa, 0xe8, 0xe2,
-	0x98, 0x4f, 0x7d, 0x2a, 0x1f, 0xed, 0xc6, 0x93, 0xb6, 0x4e, 0xf8, 0x94, 0xfa, 0x01, 0xb6, 0x51,
-	0x44, 0x6c, 0x14, 0x86, 0x54, 0x20, 0x41, 0x68, 0xc8, 0xb5, 0x77, 0xc1, 0xa3, 0xbc, 0x4a, 0xb9,
-	0x5d, 0x46, 0x1c, 0xab, 0xd6, 0xf6, 0xb3, 0xc5, 0x32, 0x16, 0x68, 0xd1, 0x8e, 0x90, 0x4f, 0x42,
-	0x19, 0xac, 0x63, 0x67, 0xda, 0x83, 0x8e, 0x10, 0x43, 0xd5, 0xb8, 0xe6, 0x6c, 0xfb, 0x38, 0xfd,
-	0xae, 0x02, 0xcd, 0x31, 0x00, 0x1f, 0x36, 0x5a, 0x6e, 0xc8, 0x6c, 0x07, 0xef, 0xd6, 0x30, 0x17,
-	0xe6, 0x53, 0x30, 0x9a, 0xb0, 0xf2, 0x88, 0x86, 0x1c, 0xc3, 0x9b, 0x20, 0xab, 0xba, 0x8c, 0x1b,
-	0x53, 0xc6, 0xdc, 0xf0, 0xd2, 0x05, 0xab, 0xed, 0x70, 0x2c, 0x95, 0xba, 0x96, 0xdb, 0xfb, 0x3e,
-	0x99, 0xf9, 0xf8, 0xeb, 0xd3, 0x82, 0xe1, 0xe8, 0x5c, 0x73, 0x1d, 0x14, 0x65, 0xf1, 0x2d, 0x1d,
-	0xba, 0x29, 0x90, 0xa8, 0xc5, 0xad, 0xe1, 0x2c, 0x28, 0xe8, 0x52, 0x2e, 0xaa, 0x54, 0x18, 0xe6,
-	0xaa, 0x59, 0xce, 0xc9, 0x6b, 0xf3, 0xaa, 0xb2, 0x9a, 0xef, 0x0d, 0x70, 0xae, 0x65, 0x1d, 0x0d,
-	0x76, 0x1a, 0xe4, 0x09, 0x77, 0x63, 0x3c, 0x0c, 0x57, 0x64, 0x9d, 0x21, 0xe7, 0x3f, 0xc2, 0xb7,
-	0x8e, 0x8d, 0xd0, 0x01, 0x85, 0x38, 0xc6, 0x65, 0xd8, 0xa3, 0xac, 0x32, 0x3e, 0x20, 0xc9, 0xcd,
-	0x9f, 0x42, 0x2e, 0x2e, 0xe0, 0xc8, 0x04, 0x27, 0x2f, 0x12, 0xef, 0x26, 0x4e, 0x21, 0xbb, 0x43,
-	0xb8, 0xa0, 0xac, 0x1e, 0x53, 0xbc, 0x05, 0xc0, 0xf1, 0x87, 0xd5, 0xa3, 0x9c, 0xb1, 0x94, 0x0a,
-	0xac, 0x86, 0x0a, 0x2c, 0x25, 0x40, 0xad, 0x02, 0x6b, 0x03, 0xf9, 0x58, 0xe7, 0x3a, 0x27, 0x32,
-	0xcd, 0x3d, 0x03, 0x4c, 0xb4, 0xee, 0xa3, 0x47, 0xe0, 0x82, 0x91, 0x14, 0xb7, 0xc6, 0x30, 0x07,
-	0x7b, 0x22, 0x77, 0xf2, 0x0b, 0x16, 0x92, 0x3c, 0x39, 0xbc, 0x9d, 0x60, 0xa2, 0xe6, 0x36, 0xdb,
-	0x91, 0x89, 0x42, 0x97, 0xa0, 0x72, 0x5e, 0x4f, 0x6c, 0x35, 0x08, 0xe8, 0x73, 0x5c, 0x59, 0xf5,
-	0x3c, 0x5a, 0x0b, 0xc5, 0x91, 0x1e, 0xb9, 0x26, 0xda, 0xe4, 0xd6, 0x44, 0xe7, 0xc1, 0x08, 0x52,
-	0x2e, 0x17, 0x69, 0x9f, 0x24, 0x9a, 0x73, 0x0a, 0x28, 0x99, 0x02, 0xe7, 0xc0, 0x08, 0xc3, 0x5c,
-	0x30, 0xe2, 0x09, 0x57, 0x50, 0x37, 0x20, 0x5c, 0x48, 0xe0, 0x43, 0x4e, 0x3e, 0xb6, 0x6f, 0xd1,
-	0xfb, 0x84, 0x0b, 0xf3, 0x2e, 0x98, 0x4c, 0x4c, 0x77, 0x3d, 0x20, 0x3e, 0x29, 0x93, 0x80, 0x88,
-	0x7a, 0xcf, 0x62, 0x7d, 0x63, 0x80, 0xa9, 0xf6, 0xc5, 0x34, 0x8b, 0x49, 0x30, 0x4c, 0xb8, 0x8b,
-	0xa5, 0x27, 0xc0, 0x5a, 0xae, 0x80, 0xf0, 0x75, 0x6d, 0x81, 0x67, 0x40, 0x96, 0x61, 0xc4, 0xf5,
-	0xa8, 0x73, 0x8e, 0x7e, 0x83, 0x36, 0x18, 0x45, 0x01, 0xc3, 0xa8, 0x52, 0x4f, 0xe8, 0x7d, 0x50,
-	0x16, 0x80, 0xda, 0x75, 0x42, 0xf4, 0x4b, 0x7f, 0xfe, 0x05, 0xff, 0x48, 0x38, 0xf0, 0x83, 0x01,
-	0xb2, 0x6a, 0x55, 0xe1, 0xc5, 0x53, 0x34, 0xd1, 0x7c, 0x23, 0x8a, 0x56, 0xb7, 0xe1, 0x8a, 0x9d,
-	0x79, 0xfd, 0xf5, 0x97, 0x9f, 0xef, 0x06, 0x96, 0xe0, 0x25, 0x3b, 0x62, 0xb4, 0x52, 0xf3, 0x04,
-	0xf7, 0x88, 0x3c, 0x50, 0x9d, 0x4e, 0x1a, 0xfc, 0x6a, 0x80, 0x7c, 0x72, 0xc9, 0xe1, 0x95, 0x4e,
-	0xcd, 0x5b, 0x1e, 0x97, 0xe2, 0xd5, 0x5e, 0xd3, 0x34, 0xf6, 0x4d, 0x89, 0xfd, 0x01, 0xbc, 0xd7,
-	0x3d, 0xf6, 0xa3, 0xc5, 0xe3, 0xb2, 0x94, 0xfd, 0x32, 0x25, 0x94, 0x57, 0xf0, 0xb3, 0x01, 0x0a,
-	0xa9, 0xcd, 0x85, 0x5d, 0x03, 0x4c, 0x9e, 0x94, 0xe2, 0xb5, 0x9e, 0xf3, 0x34, 0xb3, 0x35, 0xc9,
-	0xec, 0x06, 0x5c, 0xee, 0x83, 0xd9, 0x8e, 0x06, 0xdd, 0x20, 0x92, 0xda, 0xcc, 0xce, 0x44, 0x5a,
-	0x6f, 0x7a, 0x67, 0x22, 0x6d, 0x4e, 0x40, 0x3f, 0x44, 0xd2, 0x27, 0x03, 0xfe, 0x36, 0xc0, 0x68,
-	0x8b, 0x05, 0x85, 0xcb, 0xdd, 0x4e, 0xb7, 0xf9, 0x44, 0x14, 0x57, 0xfa, 0xca, 0xd5, 0xa4, 0x1e,
-	0x4b, 0x52, 0x0e, 0xdc, 0xe8, 0xe3, 0xeb, 0xe0, 0xe3, 0x7a, 0xcd, 0xe2, 0x5b, 0x7b, 0xb4, 0x77,
-	0x50, 0x32, 0xf6, 0x0f, 0x4a, 0xc6, 0x8f, 0x83, 0x92, 0xf1, 0xf6, 0xb0, 0x94, 0xd9, 0x3f, 0x2c,
-	0x65, 0xbe, 0x1d, 0x96, 0x32, 0x4f, 0x56, 0x7c, 0x22, 0x76, 0x6a, 0x65, 0xcb, 0xa3, 0xd5, 0xf6,
-	0x5d, 0x5f, 0x34, 0xf7, 0xad, 0x47, 0x98, 0x97, 0xb3, 0xf2, 0x5f, 0xc5, 0xe5, 0xbf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xcb, 0xe7, 0xcb, 0x7e, 0x5d, 0x09, 0x00, 0x00,
+	// 892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x4f, 0xf4, 0x44,
+	0x18, 0xde, 0x7e, 0x5f, 0x5c, 0xd9, 0xf9, 0xe2, 0x2e, 0x0e, 0xc4, 0xe0, 0x8a, 0x0b, 0x36, 0xe1,
+	0x67, 0x62, 0x0b, 0x18, 0x7f, 0x04, 0xbc, 0x40, 0x04, 0x0d, 0xa2, 0x62, 0x59, 0x12, 0xa3, 0x87,
+	0x66, 0xb6, 0x1d, 0xca, 0x24, 0xdd, 0x4e, 0x99, 0x99, 0x15, 0x36, 0xc6, 0x8b, 0x67, 0x0f, 0x26,
+	0x5e, 0xbc, 0x7a, 0xf3, 0xe8, 0xbf, 0xe0, 0xc5, 0x70, 0x24, 0xd1, 0x03, 0xf1, 0x60, 0x0c, 0x98,
+	0xe8, 0x9f, 0xf1, 0xa5, 0xd3, 0xe9, 0x42, 0xbb, 0xed, 0xfe, 0xba, 0x90, 0xed, 0x3b, 0xef, 0xfb,
+	0xbc, 0xef, 0xf3, 0xce, 0xd3, 0x87, 0x82, 0x25, 0x12, 0x9c, 0x61, 0x86, 0x03, 0x07, 0x9b, 0x1e,
+	0x0e, 0x30, 0x27, 0x5c, 0x30, 0x14, 0xf0, 0x33, 0xcc, 0xcc, 0x8b, 0x0e, 0x66, 0x5d, 0x23, 0x64,
+	0x54, 0x50, 0xf8, 0x6a, 0x2f, 0xcd, 0xc8, 0xa4, 0xd5, 0x5f, 0x46, 0x6d, 0x12, 0x50, 0x53, 0xfe,
+	0x8d, 0xb3, 0xeb, 0xb3, 0x1e, 0xf5, 0xa8, 0xfc, 0x69, 0x46, 0xbf, 0x54, 0x74, 0xde, 0xa3, 0xd4,
+	0xf3, 0xb1, 0x89, 0x42, 0x62, 0xa2, 0x20, 0xa0, 0x02, 0x09, 0x42, 0x03, 0xae, 0x4e, 0xd7, 0x1d,
+	0xca, 0xdb, 0x94, 0x9b, 0x2d, 0xc4, 0x71, 0xdc, 0xda, 0xfc, 0x7a, 0xb3, 0x85, 0x05, 0xda, 0x34,
+	0x43, 0xe4, 0x91, 0x40, 0x26, 0xab, 0xdc, 0xe5, 0xe2, 0xa1, 0x43, 0xc4, 0x50, 0x3b, 0xc1, 0x5c,
+	0x29, 0xce, 0x53, 0xcf, 0x2a, 0xd1, 0x2c, 0x4e, 0xa4, 0x97, 0x01, 0x66, 0xfc, 0x9c, 0x84, 0x36,
+	0x0f, 0x7d, 0x22, 0xe2, 0x02, 0x7d, 0x16, 0xc0, 0xcf, 0xa3, 0x19, 0x8f, 0x65, 0x3b, 0x0b, 0x5f,
+	0x74, 0x30, 0x17, 0xfa, 0x57, 0x60, 0x26, 0x15, 0xe5, 0x21, 0x0d, 0x38, 0x86, 0x1f, 0x80, 0x72,
+	0x3c, 0xd6, 0x9c, 0xb6, 0xa8, 0xad, 0x3e, 0xdb, 0x7a, 0xc3, 0x28, 0xdc, 0xa6, 0x11, 0x97, 0xee,
+	0x55, 0xae, 0xff, 0x5e, 0x28, 0xfd, 0xf2, 0xdf, 0xaf, 0xeb, 0x9a, 0xa5, 0x6a, 0xf5, 0x7d, 0x50,
+	0x97, 0xe0, 0x4d, 0x95, 0x7a, 0x22, 0x90, 0xe8, 0x24, 0xad, 0xe1, 0x0a, 0xa8, 0x29, 0x28, 0x1b,
+	0xb9, 0x2e, 0xc3, 0x3c, 0x6e, 0x56, 0xb1, 0xaa, 0x2a, 0xbc, 0x1b, 0x47, 0xf5, 0x9f, 0x34, 0xf0,
+	0x5a, 0x2e, 0x8e, 0x1a, 0x76, 0x09, 0x54, 0x09, 0xb7, 0x93, 0x79, 0x18, 0x76, 0x25, 0xce, 0x94,
+	0xf5, 0x12, 0xe1, 0xcd, 0x87, 0x20, 0xb4, 0x40, 0x2d, 0xc9, 0xb1, 0x19, 0x76, 0x28, 0x73, 0xe7,
+	0x9e, 0x48, 0x72, 0x6b, 0x03, 0xc8, 0x25, 0x00, 0x96, 0x2c, 0xb0, 0xaa, 0x22, 0xf5, 0xac, 0xe3,
+	0xcc, 0x64, 0x1f, 0x11, 0x2e, 0x28, 0xeb, 0x26, 0x14, 0x0f, 0x00, 0x78, 0x50, 0x82, 0x5a, 0xe5,
+	0xb2, 0x11, 0xcb, 0xc6, 0x88, 0x64, 0x63, 0xc4, 0x8a, 0x55, 0xb2, 0x31, 0x8e, 0x91, 0x87, 0x55,
+	0xad, 0xf5, 0xa8, 0x52, 0xbf, 0xd6, 0xc0, 0x7c, 0x7e, 0x1f, 0xb5, 0x02, 0x1b, 0x4c, 0x67, 0xb8,
+	0x45, 0xcb, 0x7c, 0x3a, 0x16, 0xb9, 0xc7, 0x37, 0x58, 0x4b, 0xf3, 0xe4, 0xf0, 0xc3, 0x14, 0x93,
+	0x78, 0x6f, 0x2b, 0x43, 0x99, 0xc4, 0xd3, 0xa5, 0xa8, 0xbc, 0xae, 0x36, 0xb6, 0xeb, 0xfb, 0xf4,
+	0x12, 0xbb, 0xbb, 0x8e, 0x43, 0x3b, 0x81, 0xe8, 0xe9, 0x91, 0x2b, 0xa2, 0x7d, 0xc7, 0x8a, 0xe8,
+	0x1a, 0x98, 0x46, 0xf1, 0x91, 0x8d, 0xd4, 0x99, 0x24, 0x5a, 0xb1, 0x6a, 0x28, 0x5d, 0x02, 0x57,
+	0xc1, 0x34, 0xc3, 0x5c, 0x30, 0xe2, 0x08, 0x5b, 0x50, 0xdb, 0x27, 0x5c, 0xc8, 0xc1, 0xa7, 0xac,
+	0x6a, 0x12, 0x6f, 0xd2, 0x23, 0xc2, 0x85, 0x7e, 0x08, 0x16, 0x52, 0xdb, 0xdd, 0xf7, 0x89, 0x47,
+	0x5a, 0xc4, 0x27, 0xa2, 0x3b, 0xb6, 0x58, 0xbf, 0xd7, 0xc0, 0x62, 0x31, 0x98, 0x62, 0xb1, 0x00,
+	0x9e, 0x11, 0x6e, 0x63, 0x79, 0xe2, 0x63, 0x25, 0x57, 0x40, 0xf8, 0xbe, 0x8a, 0xc0, 0x57, 0x40,
+	0x99, 0x61, 0xc4, 0xd5, 0xaa, 0x2b, 0x96, 0x7a, 0x82, 0x26, 0x98, 0x41, 0x3e, 0xc3, 0xc8, 0xed,
+	0xa6, 0xf4, 0xfe, 0x54, 0x02, 0x40, 0x75, 0xf4, 0x48, 0xf4, 0xfa, 0x81, 0x5a, 0xf7, 0x67, 0x89,
+	0x27, 0x9c, 0x44, 0x96, 0x30, 0xfe, 0x3b, 0xf8, 0x5b, 0xa2, 0xc0, 0x3e, 0x20, 0x45, 0xe9, 0x10,
+	0xbc, 0x18, 0xe2, 0xc0, 0x25, 0x81, 0xa7, 0x74, 0xbe, 0x31, 0xc8, 0x32, 0xe2, 0xcc, 0x34, 0x96,
+	0x95, 0x00, 0xc0, 0x53, 0x30, 0x85, 0xaf, 0xb0, 0xd3, 0x11, 0x38, 0x7a, 0x45, 0x23, 0x15, 0x1b,
+	0x03, 0xc0, 0x64, 0x71, 0xb1, 0x94, 0x7b, 0x50, 0x5b, 0x7f, 0x55, 0xc0, 0x0b, 0x92, 0x03, 0xfc,
+	0x59, 0x03, 0xe5, 0xd8, 0xb6, 0xe0, 0x9b, 0x03, 0x90, 0xfb, 0xfd, 0xb2, 0x6e, 0x8c, 0x9a, 0x1e,
+	0xaf, 0x45, 0x7f, 0xef, 0xbb, 0x3f, 0xfe, 0xfd, 0xf1, 0xc9, 0x16, 0xdc, 0x30, 0x43, 0x46, 0xdd,
+	0x8e, 0x23, 0xb8, 0x43, 0xa4, 0x69, 0x0f, 0xfb, 0x7f, 0x00, 0xff, 0xd4, 0x40, 0x35, 0x6d, 0x78,
+	0xf0, 0xed, 0x61, 0xcd, 0x73, 0x8d, 0xb6, 0xfe, 0xce, 0xb8, 0x65, 0x6a, 0xf6, 0x13, 0x39, 0xfb,
+	0x27, 0xf0, 0xe3, 0xd1, 0x67, 0xef, 0x99, 0x10, 0x97, 0x50, 0xe6, 0x37, 0x19, 0x75, 0x7d, 0x0b,
+	0x7f, 0xd7, 0x40, 0x2d, 0xe3, 0x62, 0x70, 0xe4, 0x01, 0xd3, 0xf6, 0x5a, 0x7f, 0x77, 0xec, 0x3a,
+	0xc5, 0x6c, 0x4f, 0x32, 0x7b, 0x1f, 0x6e, 0x4f, 0xc0, 0xec, 0x5c, 0x0d, 0x1d, 0x11, 0xc9, 0xb8,
+	0xd4, 0x70, 0x22, 0xf9, 0xae, 0x37, 0x9c, 0x48, 0x81, 0x1d, 0x4e, 0x42, 0x24, 0x6b, 0x9f, 0xf0,
+	0x7f, 0x0d, 0xcc, 0xe4, 0x98, 0x15, 0xdc, 0x1e, 0x75, 0xbb, 0xfd, 0x76, 0x59, 0xdf, 0x99, 0xa8,
+	0x56, 0x91, 0xfa, 0x42, 0x92, 0xb2, 0xe0, 0xf1, 0x04, 0xb7, 0x83, 0x1f, 0xf0, 0x72, 0xc4, 0x77,
+	0xab, 0x81, 0x5a, 0xc6, 0xc0, 0x86, 0xdf, 0x59, 0xbe, 0x75, 0x0e, 0xbf, 0xb3, 0x02, 0xa7, 0xd4,
+	0x9b, 0x92, 0xde, 0xa7, 0xf0, 0x68, 0x74, 0x7a, 0x99, 0x2f, 0xba, 0x9c, 0xf7, 0x6a, 0xef, 0xf4,
+	0xfa, 0xae, 0xa1, 0xdd, 0xdc, 0x35, 0xb4, 0x7f, 0xee, 0x1a, 0xda, 0x0f, 0xf7, 0x8d, 0xd2, 0xcd,
+	0x7d, 0xa3, 0x74, 0x7b, 0xdf, 0x28, 0x7d, 0xb9, 0xe3, 0x11, 0x71, 0xde, 0x69, 0x19, 0x0e, 0x6d,
+	0x17, 0x77, 0xbc, 0xea, 0x5f, 0x69, 0x37, 0xc4, 0xbc, 0x55, 0x96, 0x1f, 0x8f, 0x6f, 0x3d, 0x0f,
+	0x00, 0x00, 0xff, 0xff, 0xd3, 0x70, 0x09, 0x60, 0x75, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -598,6 +705,8 @@ type QueryClient interface {
 	AllowedAccounts(ctx context.Context, in *QueryAllowedAccountsRequest, opts ...grpc.CallOption) (*QueryAllowedAccountsResponse, error)
 	// TransferEligibility validates whether a specific account can be transferred.
 	TransferEligibility(ctx context.Context, in *QueryTransferEligibilityRequest, opts ...grpc.CallOption) (*QueryTransferEligibilityResponse, error)
+	// OwnershipSplits queries the pending and executed ownership splits of a genesis account.
+	OwnershipSplits(ctx context.Context, in *QueryOwnershipSplitsRequest, opts ...grpc.CallOption) (*QueryOwnershipSplitsResponse, error)
 }
 
 type queryClient struct {
@@ -653,6 +762,15 @@ func (c *queryClient) TransferEligibility(ctx context.Context, in *QueryTransfer
 	return out, nil
 }
 
+func (c *queryClient) OwnershipSplits(ctx context.Context, in *QueryOwnershipSplitsRequest, opts ...grpc.CallOption) (*QueryOwnershipSplitsResponse, error) {
+	out := new(QueryOwnershipSplitsResponse)
+	err := c.cc.Invoke(ctx, "/inference.genesistransfer.Query/OwnershipSplits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -665,6 +783,8 @@ type QueryServer interface {
 	AllowedAccounts(context.Context, *QueryAllowedAccountsRequest) (*QueryAllowedAccountsResponse, error)
 	// TransferEligibility validates whether a specific account can be transferred.
 	TransferEligibility(context.Context, *QueryTransferEligibilityRequest) (*QueryTransferEligibilityResponse, error)
+	// OwnershipSplits queries the pending and executed ownership splits of a genesis account.
+	OwnershipSplits(context.Context, *QueryOwnershipSplitsRequest) (*QueryOwnershipSplitsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -686,6 +806,9 @@ func (*UnimplementedQueryServer) AllowedAccounts(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) TransferEligibility(ctx context.Context, req *QueryTransferEligibilityRequest) (*QueryTransferEligibilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferEligibility not implemented")
 }
+func (*UnimplementedQueryServer) OwnershipSplits(ctx context.Context, req *QueryOwnershipSplitsRequest) (*QueryOwnershipSplitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnershipSplits not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -781,6 +904,24 @@ func _Query_TransferEligibility_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OwnershipSplits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnershipSplitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OwnershipSplits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.genesistransfer.Query/OwnershipSplits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnershipSplits(ctx, req.(*QueryOwnershipSplitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.genesistransfer.Query",
@@ -806,6 +947,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TransferEligibility",
 			Handler:    _Query_TransferEligibility_Handler,
 		},
+		{
+			MethodName: "OwnershipSplits",
+			Handler:    _Query_OwnershipSplits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/genesistransfer/query.proto",
@@ -1171,6 +1316,85 @@ func (m *QueryTransferEligibilityResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryOwnershipSplitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnershipSplitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnershipSplitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GenesisAddress) > 0 {
+		i -= len(m.GenesisAddress)
+		copy(dAtA[i:], m.GenesisAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GenesisAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnershipSplitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnershipSplitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnershipSplitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Executed) > 0 {
+		for iNdEx := len(m.Executed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Executed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pending != nil {
+		{
+			size, err := m.Pending.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1322,6 +1546,38 @@ func (m *QueryTransferEligibilityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOwnershipSplitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GenesisAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnershipSplitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pending != nil {
+		l = m.Pending.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Executed) > 0 {
+		for _, e := range m.Executed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2211,6 +2467,208 @@ func (m *QueryTransferEligibilityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryOwnershipSplitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnershipSplitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnershipSplitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnershipSplitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnershipSplitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnershipSplitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pending == nil {
+				m.Pending = &PendingOwnershipSplit{}
+			}
+			if err := m.Pending.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executed = append(m.Executed, SplitTransferRecord{})
+			if err := m.Executed[len(m.Executed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
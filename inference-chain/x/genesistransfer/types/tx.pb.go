@@ -228,11 +228,313 @@ func (m *MsgTransferOwnershipResponse) GetTransferRecord() TransferRecord {
 	return TransferRecord{}
 }
 
+// MsgProposeOwnershipSplit is the Msg/ProposeOwnershipSplit request type.
+type MsgProposeOwnershipSplit struct {
+	// genesis_address is the source genesis account to split (must be the signer)
+	GenesisAddress string `protobuf:"bytes,1,opt,name=genesis_address,json=genesisAddress,proto3" json:"genesis_address,omitempty"`
+	// allocations are the recipients and their shares, each a fixed amount or basis points of every balance
+	Allocations []SplitAllocation `protobuf:"bytes,2,rep,name=allocations,proto3" json:"allocations"`
+}
+
+func (m *MsgProposeOwnershipSplit) Reset()         { *m = MsgProposeOwnershipSplit{} }
+func (m *MsgProposeOwnershipSplit) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeOwnershipSplit) ProtoMessage()    {}
+func (*MsgProposeOwnershipSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bfff6e5ac1eeaf, []int{4}
+}
+func (m *MsgProposeOwnershipSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeOwnershipSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeOwnershipSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeOwnershipSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeOwnershipSplit.Merge(m, src)
+}
+func (m *MsgProposeOwnershipSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeOwnershipSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeOwnershipSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeOwnershipSplit proto.InternalMessageInfo
+
+func (m *MsgProposeOwnershipSplit) GetGenesisAddress() string {
+	if m != nil {
+		return m.GenesisAddress
+	}
+	return ""
+}
+
+func (m *MsgProposeOwnershipSplit) GetAllocations() []SplitAllocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+// MsgProposeOwnershipSplitResponse defines the response structure for executing a
+// MsgProposeOwnershipSplit message.
+type MsgProposeOwnershipSplitResponse struct {
+	SplitId uint64 `protobuf:"varint,1,opt,name=split_id,json=splitId,proto3" json:"split_id,omitempty"`
+}
+
+func (m *MsgProposeOwnershipSplitResponse) Reset()         { *m = MsgProposeOwnershipSplitResponse{} }
+func (m *MsgProposeOwnershipSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeOwnershipSplitResponse) ProtoMessage()    {}
+func (*MsgProposeOwnershipSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bfff6e5ac1eeaf, []int{5}
+}
+func (m *MsgProposeOwnershipSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeOwnershipSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeOwnershipSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeOwnershipSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeOwnershipSplitResponse.Merge(m, src)
+}
+func (m *MsgProposeOwnershipSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeOwnershipSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeOwnershipSplitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeOwnershipSplitResponse proto.InternalMessageInfo
+
+func (m *MsgProposeOwnershipSplitResponse) GetSplitId() uint64 {
+	if m != nil {
+		return m.SplitId
+	}
+	return 0
+}
+
+// MsgAcceptOwnershipSplit is the Msg/AcceptOwnershipSplit request type.
+type MsgAcceptOwnershipSplit struct {
+	// recipient_address is the accepting recipient of the split (must be the signer)
+	RecipientAddress string `protobuf:"bytes,1,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	// genesis_address is the genesis account with the pending split
+	GenesisAddress string `protobuf:"bytes,2,opt,name=genesis_address,json=genesisAddress,proto3" json:"genesis_address,omitempty"`
+	// split_id guards against accepting a split that was replaced after it was reviewed
+	SplitId uint64 `protobuf:"varint,3,opt,name=split_id,json=splitId,proto3" json:"split_id,omitempty"`
+}
+
+func (m *MsgAcceptOwnershipSplit) Reset()         { *m = MsgAcceptOwnershipSplit{} }
+func (m *MsgAcceptOwnershipSplit) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptOwnershipSplit) ProtoMessage()    {}
+func (*MsgAcceptOwnershipSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bfff6e5ac1eeaf, []int{6}
+}
+func (m *MsgAcceptOwnershipSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptOwnershipSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptOwnershipSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptOwnershipSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptOwnershipSplit.Merge(m, src)
+}
+func (m *MsgAcceptOwnershipSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptOwnershipSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptOwnershipSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptOwnershipSplit proto.InternalMessageInfo
+
+func (m *MsgAcceptOwnershipSplit) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+func (m *MsgAcceptOwnershipSplit) GetGenesisAddress() string {
+	if m != nil {
+		return m.GenesisAddress
+	}
+	return ""
+}
+
+func (m *MsgAcceptOwnershipSplit) GetSplitId() uint64 {
+	if m != nil {
+		return m.SplitId
+	}
+	return 0
+}
+
+// MsgAcceptOwnershipSplitResponse defines the response structure for executing a
+// MsgAcceptOwnershipSplit message.
+type MsgAcceptOwnershipSplitResponse struct {
+	// executed is true when this acceptance was the last one and the split was executed
+	Executed bool `protobuf:"varint,1,opt,name=executed,proto3" json:"executed,omitempty"`
+}
+
+func (m *MsgAcceptOwnershipSplitResponse) Reset()         { *m = MsgAcceptOwnershipSplitResponse{} }
+func (m *MsgAcceptOwnershipSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptOwnershipSplitResponse) ProtoMessage()    {}
+func (*MsgAcceptOwnershipSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bfff6e5ac1eeaf, []int{7}
+}
+func (m *MsgAcceptOwnershipSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptOwnershipSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptOwnershipSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptOwnershipSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptOwnershipSplitResponse.Merge(m, src)
+}
+func (m *MsgAcceptOwnershipSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptOwnershipSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptOwnershipSplitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptOwnershipSplitResponse proto.InternalMessageInfo
+
+func (m *MsgAcceptOwnershipSplitResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+// MsgCancelOwnershipSplit is the Msg/CancelOwnershipSplit request type.
+type MsgCancelOwnershipSplit struct {
+	// genesis_address is the genesis account with the pending split (must be the signer)
+	GenesisAddress string `protobuf:"bytes,1,opt,name=genesis_address,json=genesisAddress,proto3" json:"genesis_address,omitempty"`
+}
+
+func (m *MsgCancelOwnershipSplit) Reset()         { *m = MsgCancelOwnershipSplit{} }
+func (m *MsgCancelOwnershipSplit) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOwnershipSplit) ProtoMessage()    {}
+func (*MsgCancelOwnershipSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bfff6e5ac1eeaf, []int{8}
+}
+func (m *MsgCancelOwnershipSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelOwnershipSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelOwnershipSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelOwnershipSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOwnershipSplit.Merge(m, src)
+}
+func (m *MsgCancelOwnershipSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelOwnershipSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOwnershipSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelOwnershipSplit proto.InternalMessageInfo
+
+func (m *MsgCancelOwnershipSplit) GetGenesisAddress() string {
+	if m != nil {
+		return m.GenesisAddress
+	}
+	return ""
+}
+
+// MsgCancelOwnershipSplitResponse defines the response structure for executing a
+// MsgCancelOwnershipSplit message.
+type MsgCancelOwnershipSplitResponse struct {
+}
+
+func (m *MsgCancelOwnershipSplitResponse) Reset()         { *m = MsgCancelOwnershipSplitResponse{} }
+func (m *MsgCancelOwnershipSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOwnershipSplitResponse) ProtoMessage()    {}
+func (*MsgCancelOwnershipSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bfff6e5ac1eeaf, []int{9}
+}
+func (m *MsgCancelOwnershipSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelOwnershipSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelOwnershipSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelOwnershipSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOwnershipSplitResponse.Merge(m, src)
+}
+func (m *MsgCancelOwnershipSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelOwnershipSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOwnershipSplitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelOwnershipSplitResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "inference.genesistransfer.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.genesistransfer.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgTransferOwnership)(nil), "inference.genesistransfer.MsgTransferOwnership")
 	proto.RegisterType((*MsgTransferOwnershipResponse)(nil), "inference.genesistransfer.MsgTransferOwnershipResponse")
+	proto.RegisterType((*MsgProposeOwnershipSplit)(nil), "inference.genesistransfer.MsgProposeOwnershipSplit")
+	proto.RegisterType((*MsgProposeOwnershipSplitResponse)(nil), "inference.genesistransfer.MsgProposeOwnershipSplitResponse")
+	proto.RegisterType((*MsgAcceptOwnershipSplit)(nil), "inference.genesistransfer.MsgAcceptOwnershipSplit")
+	proto.RegisterType((*MsgAcceptOwnershipSplitResponse)(nil), "inference.genesistransfer.MsgAcceptOwnershipSplitResponse")
+	proto.RegisterType((*MsgCancelOwnershipSplit)(nil), "inference.genesistransfer.MsgCancelOwnershipSplit")
+	proto.RegisterType((*MsgCancelOwnershipSplitResponse)(nil), "inference.genesistransfer.MsgCancelOwnershipSplitResponse")
 }
 
 func init() {
@@ -240,39 +542,54 @@ func init() {
 }
 
 var fileDescriptor_a3bfff6e5ac1eeaf = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x15, 0x51, 0x29, 0x57, 0xd4, 0x10, 0x2b, 0x52, 0x13, 0x0b, 0x99, 0xe2, 0x01, 0x4a,
-	0x10, 0x36, 0x04, 0x09, 0xa4, 0x22, 0x21, 0x35, 0x82, 0x81, 0x21, 0x02, 0x05, 0xba, 0x20, 0xa1,
-	0xc8, 0xb5, 0xaf, 0x97, 0x1b, 0x7c, 0x67, 0xdd, 0xbb, 0x40, 0x3b, 0x54, 0x42, 0x8c, 0x4c, 0xfc,
-	0x0c, 0xc6, 0x0c, 0xfc, 0x88, 0x6e, 0x54, 0x4c, 0x4c, 0x08, 0x25, 0x43, 0x06, 0x7e, 0x02, 0x0b,
-	0xb2, 0xef, 0x9c, 0x50, 0x87, 0x24, 0xb0, 0x24, 0xbe, 0xf7, 0xbe, 0xef, 0xbb, 0xf7, 0xbd, 0xcf,
-	0xc6, 0x2e, 0xe3, 0x87, 0x44, 0x12, 0x1e, 0x12, 0x9f, 0x12, 0x4e, 0x80, 0x81, 0x92, 0x01, 0x87,
-	0x43, 0x22, 0x7d, 0x75, 0xe4, 0x25, 0x52, 0x28, 0x61, 0x35, 0xa6, 0x18, 0xaf, 0x80, 0xb1, 0xab,
-	0x41, 0xcc, 0xb8, 0xf0, 0xb3, 0x5f, 0x8d, 0xb6, 0xb7, 0x42, 0x01, 0xb1, 0x00, 0x3f, 0x06, 0xea,
-	0xbf, 0xb9, 0x9b, 0xfe, 0x99, 0x46, 0x43, 0x37, 0x7a, 0xd9, 0xc9, 0xd7, 0x07, 0xd3, 0xaa, 0x51,
-	0x41, 0x85, 0xae, 0xa7, 0x4f, 0xa6, 0x7a, 0x7d, 0xf1, 0x6c, 0x49, 0x20, 0x83, 0x38, 0x67, 0xdf,
-	0x58, 0x8c, 0x33, 0x67, 0x0d, 0x74, 0xbf, 0x20, 0x5c, 0xe9, 0x00, 0xdd, 0x4f, 0xa2, 0x40, 0x91,
-	0xe7, 0x99, 0x84, 0x75, 0x1f, 0x97, 0x83, 0x81, 0xea, 0x0b, 0xc9, 0xd4, 0x71, 0x1d, 0x6d, 0xa3,
-	0x9d, 0x72, 0xbb, 0xfe, 0xf5, 0xf3, 0xed, 0x9a, 0x99, 0x6f, 0x2f, 0x8a, 0x24, 0x01, 0x78, 0xa1,
-	0x24, 0xe3, 0xb4, 0x3b, 0x83, 0x5a, 0x8f, 0xf1, 0xba, 0x1e, 0xa2, 0xbe, 0xb6, 0x8d, 0x76, 0x36,
-	0x5a, 0xd7, 0xbc, 0x85, 0x5b, 0xf2, 0xf4, 0x55, 0xed, 0xf2, 0xe9, 0xf7, 0xab, 0xa5, 0x4f, 0x93,
-	0x61, 0x13, 0x75, 0x0d, 0x77, 0xf7, 0xd1, 0xfb, 0xc9, 0xb0, 0x39, 0x53, 0xfd, 0x30, 0x19, 0x36,
-	0x6f, 0xcd, 0xdc, 0x1c, 0xcd, 0xf9, 0x29, 0x4c, 0xef, 0x36, 0xf0, 0x56, 0xa1, 0xd4, 0x25, 0x90,
-	0x08, 0x0e, 0xc4, 0xfd, 0x89, 0x70, 0xad, 0x03, 0xf4, 0xa5, 0xa1, 0x3e, 0x7b, 0xcb, 0x89, 0x84,
-	0x3e, 0x4b, 0xac, 0x3d, 0x5c, 0x31, 0xb2, 0xbd, 0x40, 0xbb, 0x5b, 0xe9, 0x7b, 0xd3, 0x10, 0x4c,
-	0xd5, 0x7a, 0x82, 0xab, 0x92, 0x84, 0x2c, 0x61, 0x84, 0xab, 0xa9, 0xc8, 0xda, 0x0a, 0x91, 0xcb,
-	0x53, 0x8a, 0xa9, 0xef, 0x3e, 0x4d, 0xdd, 0x17, 0x87, 0x49, 0x77, 0x70, 0x67, 0xc5, 0x0e, 0xe6,
-	0x4c, 0xb9, 0x27, 0xf8, 0xca, 0xdf, 0xea, 0xf9, 0x36, 0xac, 0xd7, 0xb8, 0x92, 0x0b, 0xf4, 0x24,
-	0x09, 0x85, 0x8c, 0x32, 0xd3, 0x1b, 0xad, 0x9b, 0x4b, 0x72, 0xcb, 0xe5, 0xba, 0x19, 0xe1, 0xcf,
-	0xfc, 0x36, 0xd5, 0xb9, 0x56, 0xeb, 0x17, 0xc2, 0x17, 0x3a, 0x40, 0x2d, 0x8e, 0x2f, 0x9d, 0x7b,
-	0xbb, 0x9a, 0x4b, 0xd4, 0x0b, 0xc1, 0xd9, 0xad, 0x7f, 0xc7, 0x4e, 0x6d, 0x9d, 0xe0, 0xea, 0x7c,
-	0xc0, 0xfe, 0x72, 0xa1, 0x39, 0x82, 0xfd, 0xe0, 0x3f, 0x09, 0xf9, 0xf5, 0xf6, 0xc5, 0x77, 0xe9,
-	0x36, 0xda, 0xfb, 0xa7, 0x23, 0x07, 0x9d, 0x8d, 0x1c, 0xf4, 0x63, 0xe4, 0xa0, 0x8f, 0x63, 0xa7,
-	0x74, 0x36, 0x76, 0x4a, 0xdf, 0xc6, 0x4e, 0xe9, 0xd5, 0x43, 0xca, 0x54, 0x7f, 0x70, 0xe0, 0x85,
-	0x22, 0xf6, 0x13, 0x29, 0xa2, 0x41, 0xa8, 0x20, 0x64, 0x59, 0xb0, 0xcb, 0x22, 0x56, 0xc7, 0x09,
-	0x81, 0x83, 0xf5, 0xec, 0xab, 0xbd, 0xf7, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x50, 0x51, 0xf7,
-	0xa4, 0x04, 0x00, 0x00,
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0xa4, 0xb6, 0xb6, 0x13, 0x69, 0xed, 0x12, 0x69, 0xb2, 0x48, 0xda, 0xee, 0x41, 0x6b,
+	0xc4, 0xac, 0xa6, 0xa2, 0x90, 0x52, 0x21, 0x55, 0x0f, 0x05, 0xa3, 0x25, 0xb5, 0x08, 0x82, 0x84,
+	0xed, 0x66, 0xba, 0x1d, 0x48, 0x76, 0x96, 0x99, 0x89, 0xb6, 0x87, 0x82, 0x08, 0x0a, 0x7a, 0x10,
+	0xaf, 0xfe, 0x03, 0xf1, 0xd4, 0x83, 0x3f, 0xa2, 0x37, 0x8b, 0x27, 0x4f, 0x22, 0xed, 0xa1, 0x07,
+	0xff, 0x84, 0xec, 0xec, 0x66, 0x9b, 0x6c, 0x66, 0x77, 0x8d, 0xf4, 0x92, 0x64, 0xe6, 0xbd, 0xef,
+	0xcd, 0xf7, 0x7d, 0xef, 0xcd, 0x10, 0xa8, 0x61, 0x7b, 0x0b, 0x51, 0x64, 0x9b, 0x48, 0xb7, 0x90,
+	0x8d, 0x18, 0x66, 0x9c, 0x1a, 0x36, 0xdb, 0x42, 0x54, 0xe7, 0x3b, 0x25, 0x87, 0x12, 0x4e, 0x94,
+	0x7c, 0x90, 0x53, 0x0a, 0xe5, 0xa8, 0xd3, 0x46, 0x1b, 0xdb, 0x44, 0x17, 0x9f, 0x5e, 0xb6, 0x3a,
+	0x63, 0x12, 0xd6, 0x26, 0x4c, 0x6f, 0x33, 0x4b, 0x7f, 0x79, 0xcb, 0xfd, 0xf2, 0x03, 0x79, 0x2f,
+	0xd0, 0x10, 0x2b, 0xdd, 0x5b, 0xf8, 0xa1, 0xac, 0x45, 0x2c, 0xe2, 0xed, 0xbb, 0xbf, 0xfc, 0xdd,
+	0x2b, 0xd1, 0xdc, 0x1c, 0x83, 0x1a, 0xed, 0x2e, 0xfa, 0x6a, 0x74, 0x9e, 0xbf, 0xf6, 0x13, 0xf5,
+	0xe8, 0x44, 0xf2, 0xca, 0x46, 0x94, 0x6d, 0x63, 0xa7, 0xc1, 0x9c, 0x16, 0xe6, 0x1e, 0x40, 0xfb,
+	0x0e, 0xe0, 0x54, 0x8d, 0x59, 0x1b, 0x4e, 0xd3, 0xe0, 0x68, 0x4d, 0x9c, 0xa9, 0xdc, 0x81, 0x13,
+	0x46, 0x87, 0x6f, 0x13, 0x8a, 0xf9, 0x6e, 0x0e, 0xcc, 0x81, 0x85, 0x89, 0x95, 0xdc, 0x8f, 0x6f,
+	0x37, 0xb2, 0xbe, 0xa0, 0x6a, 0xb3, 0x49, 0x11, 0x63, 0xeb, 0x9c, 0x62, 0xdb, 0xaa, 0x9f, 0xa6,
+	0x2a, 0x0f, 0xe0, 0x98, 0xc7, 0x3a, 0x97, 0x9e, 0x03, 0x0b, 0x99, 0xf2, 0x7c, 0x29, 0xd2, 0xd6,
+	0x92, 0x77, 0xd4, 0xca, 0xc4, 0xc1, 0xaf, 0xd9, 0xd4, 0x97, 0x93, 0xfd, 0x22, 0xa8, 0xfb, 0xd8,
+	0xca, 0xbd, 0x37, 0x27, 0xfb, 0xc5, 0xd3, 0xaa, 0x1f, 0x4e, 0xf6, 0x8b, 0xd7, 0x4f, 0x55, 0xed,
+	0x0c, 0xe8, 0x0a, 0xb1, 0xd7, 0xf2, 0x70, 0x26, 0xb4, 0x55, 0x47, 0xcc, 0x21, 0x36, 0x43, 0xda,
+	0x1f, 0x00, 0xb3, 0x35, 0x66, 0x3d, 0xf5, 0xa1, 0x4f, 0xba, 0x8e, 0x28, 0x55, 0x38, 0xe5, 0x97,
+	0x6d, 0x18, 0x9e, 0xba, 0x44, 0xdd, 0x93, 0x3e, 0xc0, 0xdf, 0x55, 0x1e, 0xc2, 0x69, 0x8a, 0x4c,
+	0xec, 0x60, 0x64, 0xf3, 0xa0, 0x48, 0x3a, 0xa1, 0xc8, 0xc5, 0x00, 0xe2, 0xef, 0x57, 0x56, 0x5d,
+	0xf5, 0x61, 0x32, 0xae, 0x07, 0x37, 0x13, 0x3c, 0x18, 0x10, 0xa5, 0xed, 0xc1, 0xcb, 0xb2, 0xfd,
+	0xae, 0x1b, 0xca, 0x0b, 0x38, 0xd5, 0x2d, 0xd0, 0xa0, 0xc8, 0x24, 0xb4, 0x29, 0x44, 0x67, 0xca,
+	0xd7, 0x62, 0xfa, 0xd6, 0x2d, 0x57, 0x17, 0x80, 0xde, 0xfe, 0x4d, 0xf2, 0xbe, 0x90, 0xf6, 0x36,
+	0x0d, 0x73, 0x35, 0x66, 0xad, 0x51, 0xe2, 0x10, 0x86, 0x82, 0xe3, 0xd7, 0xdd, 0xe1, 0x3b, 0x0b,
+	0xc3, 0x9f, 0xc1, 0x8c, 0xd1, 0x6a, 0x11, 0xd3, 0xe0, 0x98, 0xd8, 0xae, 0xd5, 0x23, 0x0b, 0x99,
+	0x72, 0x31, 0x86, 0xba, 0x38, 0xb9, 0x1a, 0x40, 0x7a, 0xb9, 0xf7, 0x56, 0xaa, 0xd4, 0xa2, 0x5a,
+	0x70, 0x3b, 0xa1, 0x05, 0x52, 0xa9, 0xda, 0x32, 0x9c, 0x8b, 0x8a, 0x05, 0xad, 0xc8, 0xc3, 0x71,
+	0x71, 0x29, 0x1b, 0xd8, 0xeb, 0xc1, 0xb9, 0xfa, 0x79, 0xb1, 0x5e, 0x6d, 0x6a, 0x1f, 0xd3, 0x62,
+	0x9e, 0xab, 0xa6, 0x89, 0x1c, 0x1e, 0x72, 0x51, 0x3a, 0x73, 0x60, 0xd8, 0x99, 0x93, 0x35, 0x23,
+	0x3d, 0x64, 0x33, 0x7a, 0x05, 0x8c, 0xf4, 0x09, 0xa8, 0x3c, 0x76, 0xed, 0x1c, 0xe4, 0xe9, 0x1a,
+	0xba, 0x98, 0x60, 0xa8, 0x4c, 0xb4, 0xb6, 0x0c, 0x67, 0x23, 0x42, 0x81, 0x9d, 0x2a, 0x1c, 0x47,
+	0x3b, 0xc8, 0xec, 0x70, 0xe4, 0xd9, 0x39, 0x5e, 0x0f, 0xd6, 0xda, 0x57, 0x20, 0xfc, 0xbc, 0x6f,
+	0xd8, 0x26, 0x6a, 0x9d, 0xf9, 0x54, 0x56, 0x1e, 0x45, 0x0d, 0x4f, 0x92, 0x56, 0x19, 0x21, 0x6d,
+	0x5e, 0x68, 0x95, 0x85, 0xba, 0x5a, 0xcb, 0x9f, 0x47, 0xe1, 0x48, 0x8d, 0x59, 0x8a, 0x0d, 0x2f,
+	0xf4, 0x3d, 0xe2, 0x71, 0x37, 0x21, 0xf4, 0x3e, 0xaa, 0xe5, 0x7f, 0xcf, 0x0d, 0x3c, 0xde, 0x83,
+	0xd3, 0x83, 0xef, 0xa8, 0x1e, 0x5f, 0x68, 0x00, 0xa0, 0xde, 0x1d, 0x12, 0x10, 0x1c, 0xff, 0x1e,
+	0xc0, 0x4b, 0xf2, 0xa7, 0x65, 0x31, 0xbe, 0xa4, 0x14, 0xa4, 0x2e, 0xfd, 0x07, 0x28, 0xe0, 0xf2,
+	0x0e, 0xc0, 0xac, 0xf4, 0x7e, 0x26, 0xf8, 0x2a, 0xc3, 0xa8, 0x95, 0xe1, 0x31, 0x7d, 0x44, 0xa4,
+	0x83, 0x9d, 0x40, 0x44, 0x86, 0x49, 0x22, 0x12, 0x37, 0x94, 0xea, 0xe8, 0x6b, 0xf7, 0x59, 0x5d,
+	0xd9, 0x38, 0x38, 0x2a, 0x80, 0xc3, 0xa3, 0x02, 0xf8, 0x7d, 0x54, 0x00, 0x9f, 0x8e, 0x0b, 0xa9,
+	0xc3, 0xe3, 0x42, 0xea, 0xe7, 0x71, 0x21, 0xf5, 0x7c, 0xc9, 0xc2, 0x7c, 0xbb, 0xb3, 0x59, 0x32,
+	0x49, 0x5b, 0x77, 0x28, 0x69, 0x76, 0x4c, 0xce, 0x4c, 0x2c, 0x6e, 0x47, 0xdc, 0x3d, 0xe1, 0xbb,
+	0x0e, 0x62, 0x9b, 0x63, 0xe2, 0xaf, 0xcb, 0xe2, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xcd,
+	0x37, 0xec, 0xda, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -293,6 +610,13 @@ type MsgClient interface {
 	// TransferOwnership transfers complete ownership of a genesis account including
 	// all liquid balances and vesting schedules to the recipient account
 	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
+	// ProposeOwnershipSplit proposes splitting part or all of a genesis account across several recipients,
+	// preserving its vesting schedule proportionally. The split executes once every recipient accepts it.
+	ProposeOwnershipSplit(ctx context.Context, in *MsgProposeOwnershipSplit, opts ...grpc.CallOption) (*MsgProposeOwnershipSplitResponse, error)
+	// AcceptOwnershipSplit accepts a pending ownership split on behalf of one of its recipients
+	AcceptOwnershipSplit(ctx context.Context, in *MsgAcceptOwnershipSplit, opts ...grpc.CallOption) (*MsgAcceptOwnershipSplitResponse, error)
+	// CancelOwnershipSplit cancels the pending ownership split of a genesis account
+	CancelOwnershipSplit(ctx context.Context, in *MsgCancelOwnershipSplit, opts ...grpc.CallOption) (*MsgCancelOwnershipSplitResponse, error)
 }
 
 type msgClient struct {
@@ -321,6 +645,33 @@ func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwners
 	return out, nil
 }
 
+func (c *msgClient) ProposeOwnershipSplit(ctx context.Context, in *MsgProposeOwnershipSplit, opts ...grpc.CallOption) (*MsgProposeOwnershipSplitResponse, error) {
+	out := new(MsgProposeOwnershipSplitResponse)
+	err := c.cc.Invoke(ctx, "/inference.genesistransfer.Msg/ProposeOwnershipSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptOwnershipSplit(ctx context.Context, in *MsgAcceptOwnershipSplit, opts ...grpc.CallOption) (*MsgAcceptOwnershipSplitResponse, error) {
+	out := new(MsgAcceptOwnershipSplitResponse)
+	err := c.cc.Invoke(ctx, "/inference.genesistransfer.Msg/AcceptOwnershipSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelOwnershipSplit(ctx context.Context, in *MsgCancelOwnershipSplit, opts ...grpc.CallOption) (*MsgCancelOwnershipSplitResponse, error) {
+	out := new(MsgCancelOwnershipSplitResponse)
+	err := c.cc.Invoke(ctx, "/inference.genesistransfer.Msg/CancelOwnershipSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -329,6 +680,13 @@ type MsgServer interface {
 	// TransferOwnership transfers complete ownership of a genesis account including
 	// all liquid balances and vesting schedules to the recipient account
 	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
+	// ProposeOwnershipSplit proposes splitting part or all of a genesis account across several recipients,
+	// preserving its vesting schedule proportionally. The split executes once every recipient accepts it.
+	ProposeOwnershipSplit(context.Context, *MsgProposeOwnershipSplit) (*MsgProposeOwnershipSplitResponse, error)
+	// AcceptOwnershipSplit accepts a pending ownership split on behalf of one of its recipients
+	AcceptOwnershipSplit(context.Context, *MsgAcceptOwnershipSplit) (*MsgAcceptOwnershipSplitResponse, error)
+	// CancelOwnershipSplit cancels the pending ownership split of a genesis account
+	CancelOwnershipSplit(context.Context, *MsgCancelOwnershipSplit) (*MsgCancelOwnershipSplitResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -341,6 +699,15 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) TransferOwnership(ctx context.Context, req *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
 }
+func (*UnimplementedMsgServer) ProposeOwnershipSplit(ctx context.Context, req *MsgProposeOwnershipSplit) (*MsgProposeOwnershipSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeOwnershipSplit not implemented")
+}
+func (*UnimplementedMsgServer) AcceptOwnershipSplit(ctx context.Context, req *MsgAcceptOwnershipSplit) (*MsgAcceptOwnershipSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwnershipSplit not implemented")
+}
+func (*UnimplementedMsgServer) CancelOwnershipSplit(ctx context.Context, req *MsgCancelOwnershipSplit) (*MsgCancelOwnershipSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOwnershipSplit not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -382,6 +749,60 @@ func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ProposeOwnershipSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeOwnershipSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeOwnershipSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.genesistransfer.Msg/ProposeOwnershipSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeOwnershipSplit(ctx, req.(*MsgProposeOwnershipSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptOwnershipSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOwnershipSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOwnershipSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.genesistransfer.Msg/AcceptOwnershipSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOwnershipSplit(ctx, req.(*MsgAcceptOwnershipSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelOwnershipSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOwnershipSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOwnershipSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.genesistransfer.Msg/CancelOwnershipSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOwnershipSplit(ctx, req.(*MsgCancelOwnershipSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.genesistransfer.Msg",
@@ -395,6 +816,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TransferOwnership",
 			Handler:    _Msg_TransferOwnership_Handler,
 		},
+		{
+			MethodName: "ProposeOwnershipSplit",
+			Handler:    _Msg_ProposeOwnershipSplit_Handler,
+		},
+		{
+			MethodName: "AcceptOwnershipSplit",
+			Handler:    _Msg_AcceptOwnershipSplit_Handler,
+		},
+		{
+			MethodName: "CancelOwnershipSplit",
+			Handler:    _Msg_CancelOwnershipSplit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/genesistransfer/tx.proto",
@@ -525,84 +958,731 @@ func (m *MsgTransferOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgTransferOwnership) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeOwnershipSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeOwnershipSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeOwnershipSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.GenesisAddress) > 0 {
+		i -= len(m.GenesisAddress)
+		copy(dAtA[i:], m.GenesisAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeOwnershipSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeOwnershipSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeOwnershipSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SplitId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SplitId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptOwnershipSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptOwnershipSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptOwnershipSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SplitId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SplitId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GenesisAddress) > 0 {
+		i -= len(m.GenesisAddress)
+		copy(dAtA[i:], m.GenesisAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptOwnershipSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptOwnershipSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptOwnershipSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOwnershipSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOwnershipSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOwnershipSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GenesisAddress) > 0 {
+		i -= len(m.GenesisAddress)
+		copy(dAtA[i:], m.GenesisAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOwnershipSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOwnershipSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOwnershipSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GenesisAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TransferRecord.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgProposeOwnershipSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GenesisAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposeOwnershipSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SplitId != 0 {
+		n += 1 + sovTx(uint64(m.SplitId))
+	}
+	return n
+}
+
+func (m *MsgAcceptOwnershipSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GenesisAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SplitId != 0 {
+		n += 1 + sovTx(uint64(m.SplitId))
+	}
+	return n
+}
+
+func (m *MsgAcceptOwnershipSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCancelOwnershipSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GenesisAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelOwnershipSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.GenesisAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RecipientAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgTransferOwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TransferRecord.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeOwnershipSplit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -625,15 +1705,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeOwnershipSplit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeOwnershipSplit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -661,11 +1741,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -692,7 +1772,8 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Allocations = append(m.Allocations, SplitAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -717,7 +1798,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeOwnershipSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -740,12 +1821,31 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeOwnershipSplitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeOwnershipSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitId", wireType)
+			}
+			m.SplitId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplitId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -767,7 +1867,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptOwnershipSplit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -790,15 +1890,15 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptOwnershipSplit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptOwnershipSplit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -826,11 +1926,11 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -858,8 +1958,27 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitId", wireType)
+			}
+			m.SplitId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplitId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -881,7 +2000,7 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptOwnershipSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -904,17 +2023,87 @@ func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptOwnershipSplitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptOwnershipSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOwnershipSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOwnershipSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOwnershipSplit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -924,25 +2113,74 @@ func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TransferRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOwnershipSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOwnershipSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOwnershipSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
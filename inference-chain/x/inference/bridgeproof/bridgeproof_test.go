@@ -0,0 +1,112 @@
+package bridgeproof
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceiptProof_VerifiesEveryIndex(t *testing.T) {
+	for _, count := range []int{1, 2, 3, 17, 130} {
+		t.Run(fmt.Sprintf("%d receipts", count), func(t *testing.T) {
+			receipts := make([][]byte, count)
+			for i := range receipts {
+				receipts[i] = EncodeReceipt(2, true, uint64(21000*(i+1)), make([]byte, 256), []Log{{
+					Address: bytes.Repeat([]byte{byte(i)}, 20),
+					Topics:  [][]byte{bytes.Repeat([]byte{0xaa}, 32)},
+					Data:    []byte{byte(i)},
+				}})
+			}
+			for i := range receipts {
+				root, proof := ReceiptProof(receipts, uint64(i))
+				value, err := VerifyProof(root, ReceiptKey(uint64(i)), proof)
+				require.NoError(t, err)
+				require.Equal(t, receipts[i], value)
+			}
+		})
+	}
+}
+
+func TestVerifyProof_EmbeddedNodes(t *testing.T) {
+	// Values this small keep leaves under 32 bytes, so they are embedded in their parent branch
+	values := [][]byte{{0x01}, {0x02}, {0x03}}
+	for i := range values {
+		root, proof := ReceiptProof(values, uint64(i))
+		require.Len(t, proof, 1)
+		value, err := VerifyProof(root, ReceiptKey(uint64(i)), proof)
+		require.NoError(t, err)
+		require.Equal(t, values[i], value)
+	}
+}
+
+func TestVerifyProof_Rejects(t *testing.T) {
+	receipts := make([][]byte, 5)
+	for i := range receipts {
+		receipts[i] = EncodeReceipt(0, true, uint64(i+1), make([]byte, 256), nil)
+	}
+	root, proof := ReceiptProof(receipts, 3)
+
+	_, err := VerifyProof(Keccak256([]byte("other")), ReceiptKey(3), proof)
+	require.ErrorContains(t, err, "missing node")
+
+	_, err = VerifyProof(root, ReceiptKey(3), proof[:len(proof)-1])
+	require.ErrorContains(t, err, "missing node")
+
+	_, err = VerifyProof(root, ReceiptKey(7), proof)
+	require.ErrorIs(t, err, ErrKeyNotInTrie)
+
+	tampered := append([][]byte(nil), proof...)
+	last := append([]byte(nil), tampered[len(tampered)-1]...)
+	last[len(last)-1] ^= 0xff
+	tampered[len(tampered)-1] = last
+	_, err = VerifyProof(root, ReceiptKey(3), tampered)
+	require.Error(t, err)
+}
+
+func TestEmptyTrieRoot(t *testing.T) {
+	// The well-known root of an empty Ethereum trie
+	require.Equal(t, "56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421", hex.EncodeToString(Keccak256(EncodeBytes(nil))))
+}
+
+func TestDecodeReceipt(t *testing.T) {
+	log := Log{
+		Address: bytes.Repeat([]byte{0x11}, 20),
+		Topics:  [][]byte{bytes.Repeat([]byte{0x22}, 32), bytes.Repeat([]byte{0x33}, 32)},
+		Data:    bytes.Repeat([]byte{0x44}, 64),
+	}
+	for _, txType := range []byte{0, 1, 2} {
+		receipt, err := DecodeReceipt(EncodeReceipt(txType, true, 50000, make([]byte, 256), []Log{log}))
+		require.NoError(t, err)
+		require.True(t, receipt.Succeeded)
+		require.Equal(t, []Log{log}, receipt.Logs)
+	}
+
+	receipt, err := DecodeReceipt(EncodeReceipt(2, false, 50000, make([]byte, 256), nil))
+	require.NoError(t, err)
+	require.False(t, receipt.Succeeded)
+	require.Empty(t, receipt.Logs)
+
+	_, err = DecodeReceipt([]byte{0x02, 0xc1})
+	require.Error(t, err)
+}
+
+func TestTrieBuilder_KnownRoot(t *testing.T) {
+	// Root from the Ethereum trie test vectors ("puppy")
+	entries := []trieEntry{
+		{key: keyToNibbles([]byte("do")), value: []byte("verb")},
+		{key: keyToNibbles([]byte("dog")), value: []byte("puppy")},
+		{key: keyToNibbles([]byte("doge")), value: []byte("coin")},
+		{key: keyToNibbles([]byte("horse")), value: []byte("stallion")},
+	}
+	builder := &trieBuilder{target: keyToNibbles([]byte("dog"))}
+	rootNode := builder.node(entries, 0)
+	root := Keccak256(rootNode)
+	require.Equal(t, "5991bb8c6514148a29db676a14ac506cd2cd5775ace63c30a4fe457715e9ac84", hex.EncodeToString(root))
+
+	value, err := VerifyProof(root, []byte("dog"), append(builder.proof, rootNode))
+	require.NoError(t, err)
+	require.Equal(t, []byte("puppy"), value)
+}
@@ -0,0 +1,166 @@
+package bridgeproof
+
+import (
+	"bytes"
+	"sort"
+)
+
+// EncodeBytes returns the RLP encoding of a byte string
+func EncodeBytes(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return []byte{b[0]}
+	}
+	return append(encodeHeader(0x80, len(b)), b...)
+}
+
+// EncodeList returns the RLP encoding of a list whose elements are already encoded
+func EncodeList(elements ...[]byte) []byte {
+	content := bytes.Join(elements, nil)
+	return append(encodeHeader(0xc0, len(content)), content...)
+}
+
+func encodeHeader(offset byte, length int) []byte {
+	if length < 56 {
+		return []byte{offset + byte(length)}
+	}
+	var lengthBytes []byte
+	for v := length; v > 0; v >>= 8 {
+		lengthBytes = append([]byte{byte(v)}, lengthBytes...)
+	}
+	return append([]byte{offset + 55 + byte(len(lengthBytes))}, lengthBytes...)
+}
+
+// EncodeReceipt returns the consensus encoding of a receipt as stored in the receipts trie.
+// A txType of 0 produces a legacy receipt.
+func EncodeReceipt(txType byte, succeeded bool, cumulativeGasUsed uint64, bloom []byte, logs []Log) []byte {
+	var status []byte
+	if succeeded {
+		status = []byte{1}
+	}
+	encodedLogs := make([][]byte, 0, len(logs))
+	for _, log := range logs {
+		topics := make([][]byte, 0, len(log.Topics))
+		for _, topic := range log.Topics {
+			topics = append(topics, EncodeBytes(topic))
+		}
+		encodedLogs = append(encodedLogs, EncodeList(EncodeBytes(log.Address), EncodeList(topics...), EncodeBytes(log.Data)))
+	}
+	payload := EncodeList(EncodeBytes(status), encodeUint(cumulativeGasUsed), EncodeBytes(bloom), EncodeList(encodedLogs...))
+	if txType == 0 {
+		return payload
+	}
+	return append([]byte{txType}, payload...)
+}
+
+// ReceiptProof builds the receipts trie of a block from its encoded receipts and returns the
+// receipts root together with the proof of the receipt at index.
+func ReceiptProof(receipts [][]byte, index uint64) ([]byte, [][]byte) {
+	entries := make([]trieEntry, 0, len(receipts))
+	for i, receipt := range receipts {
+		entries = append(entries, trieEntry{key: keyToNibbles(ReceiptKey(uint64(i))), value: receipt})
+	}
+	sort.Slice(entries, func(i, j int) bool { return bytes.Compare(entries[i].key, entries[j].key) < 0 })
+
+	builder := &trieBuilder{target: keyToNibbles(ReceiptKey(index))}
+	rootNode := builder.node(entries, 0)
+	builder.proof = append(builder.proof, rootNode)
+	// Nodes are collected bottom-up; return them from the root down
+	for i, j := 0, len(builder.proof)-1; i < j; i, j = i+1, j-1 {
+		builder.proof[i], builder.proof[j] = builder.proof[j], builder.proof[i]
+	}
+	return Keccak256(rootNode), builder.proof
+}
+
+type trieEntry struct {
+	key   []byte
+	value []byte
+}
+
+type trieBuilder struct {
+	target []byte
+	proof  [][]byte
+}
+
+func (b *trieBuilder) node(entries []trieEntry, depth int) []byte {
+	if len(entries) == 0 {
+		return EncodeBytes(nil)
+	}
+	if len(entries) == 1 {
+		return EncodeList(EncodeBytes(hexPrefix(entries[0].key[depth:], true)), EncodeBytes(entries[0].value))
+	}
+
+	prefix := commonPrefix(entries, depth)
+	if prefix > 0 {
+		return EncodeList(EncodeBytes(hexPrefix(entries[0].key[depth:depth+prefix], false)), b.ref(entries, depth+prefix))
+	}
+
+	children := make([][]byte, 17)
+	var value []byte
+	for nibble := byte(0); nibble < 16; nibble++ {
+		var group []trieEntry
+		for _, entry := range entries {
+			if len(entry.key) > depth && entry.key[depth] == nibble {
+				group = append(group, entry)
+			}
+		}
+		children[nibble] = b.ref(group, depth+1)
+	}
+	for _, entry := range entries {
+		if len(entry.key) == depth {
+			value = entry.value
+		}
+	}
+	children[16] = EncodeBytes(value)
+	return EncodeList(children...)
+}
+
+// ref returns how a parent refers to the node built from entries: embedded when shorter than 32 bytes, hashed otherwise
+func (b *trieBuilder) ref(entries []trieEntry, depth int) []byte {
+	if len(entries) == 0 {
+		return EncodeBytes(nil)
+	}
+	node := b.node(entries, depth)
+	if len(node) < 32 {
+		return node
+	}
+	for _, entry := range entries {
+		if bytes.Equal(entry.key, b.target) {
+			b.proof = append(b.proof, node)
+			break
+		}
+	}
+	return EncodeBytes(Keccak256(node))
+}
+
+func commonPrefix(entries []trieEntry, depth int) int {
+	first := entries[0].key[depth:]
+	prefix := len(first)
+	for _, entry := range entries[1:] {
+		key := entry.key[depth:]
+		n := 0
+		for n < prefix && n < len(key) && key[n] == first[n] {
+			n++
+		}
+		prefix = n
+	}
+	return prefix
+}
+
+func hexPrefix(nibbles []byte, leaf bool) []byte {
+	flag := byte(0)
+	if leaf {
+		flag = 2
+	}
+	if len(nibbles)%2 == 1 {
+		compact := []byte{(flag+1)<<4 | nibbles[0]}
+		for i := 1; i < len(nibbles); i += 2 {
+			compact = append(compact, nibbles[i]<<4|nibbles[i+1])
+		}
+		return compact
+	}
+	compact := []byte{flag << 4}
+	for i := 0; i < len(nibbles); i += 2 {
+		compact = append(compact, nibbles[i]<<4|nibbles[i+1])
+	}
+	return compact
+}
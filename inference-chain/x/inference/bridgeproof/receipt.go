@@ -0,0 +1,85 @@
+package bridgeproof
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Log is an EVM event log taken from a transaction receipt
+type Log struct {
+	Address []byte
+	Topics  [][]byte
+	Data    []byte
+}
+
+// Receipt is the part of an EVM transaction receipt needed to verify deposits
+type Receipt struct {
+	Succeeded bool
+	Logs      []Log
+}
+
+// DecodeReceipt decodes a consensus-encoded receipt as stored in the receipts trie.
+// Both legacy receipts and typed (EIP-2718) receipts are supported. Receipts that carry a
+// pre-Byzantium state root instead of a status are reported as not succeeded.
+func DecodeReceipt(encoded []byte) (*Receipt, error) {
+	if len(encoded) == 0 {
+		return nil, errors.New("empty receipt")
+	}
+	if encoded[0] < 0x80 {
+		// Typed receipt: a single type byte followed by the RLP payload
+		encoded = encoded[1:]
+	}
+	fields, err := decodeList(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid receipt: %w", err)
+	}
+	if len(fields) != 4 {
+		return nil, fmt.Errorf("invalid receipt: expected 4 fields, got %d", len(fields))
+	}
+	status := fields[0].Content
+	receipt := &Receipt{Succeeded: len(status) == 1 && status[0] == 1}
+
+	if !fields[3].IsList {
+		return nil, errors.New("invalid receipt: logs is not a list")
+	}
+	logs, err := listElements(fields[3])
+	if err != nil {
+		return nil, fmt.Errorf("invalid receipt logs: %w", err)
+	}
+	for i, logItem := range logs {
+		log, err := decodeLog(logItem)
+		if err != nil {
+			return nil, fmt.Errorf("invalid receipt log %d: %w", i, err)
+		}
+		receipt.Logs = append(receipt.Logs, log)
+	}
+	return receipt, nil
+}
+
+func decodeLog(item rlpItem) (Log, error) {
+	if !item.IsList {
+		return Log{}, errors.New("log is not a list")
+	}
+	fields, err := listElements(item)
+	if err != nil {
+		return Log{}, err
+	}
+	if len(fields) != 3 || fields[0].IsList || !fields[1].IsList || fields[2].IsList {
+		return Log{}, errors.New("malformed log")
+	}
+	if len(fields[0].Content) != 20 {
+		return Log{}, fmt.Errorf("log address has %d bytes", len(fields[0].Content))
+	}
+	topics, err := listElements(fields[1])
+	if err != nil {
+		return Log{}, err
+	}
+	log := Log{Address: fields[0].Content, Data: fields[2].Content}
+	for _, topic := range topics {
+		if topic.IsList || len(topic.Content) != 32 {
+			return Log{}, errors.New("malformed log topic")
+		}
+		log.Topics = append(log.Topics, topic.Content)
+	}
+	return log, nil
+}
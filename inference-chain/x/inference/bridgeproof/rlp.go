@@ -0,0 +1,113 @@
+package bridgeproof
+
+import (
+	"errors"
+	"fmt"
+)
+
+// rlpItem is a single decoded RLP item. Raw holds the full encoding including the header,
+// which is needed to follow trie nodes that are embedded in their parent instead of hashed.
+type rlpItem struct {
+	IsList  bool
+	Content []byte
+	Raw     []byte
+}
+
+var errTruncated = errors.New("rlp: input truncated")
+
+// decodeItem decodes the first RLP item of b and returns it with the remaining bytes
+func decodeItem(b []byte) (rlpItem, []byte, error) {
+	if len(b) == 0 {
+		return rlpItem{}, nil, errTruncated
+	}
+	prefix := b[0]
+	var isList bool
+	var headerLen, contentLen int
+	switch {
+	case prefix < 0x80:
+		return rlpItem{Content: b[:1], Raw: b[:1]}, b[1:], nil
+	case prefix <= 0xb7:
+		headerLen, contentLen = 1, int(prefix-0x80)
+	case prefix <= 0xbf:
+		lenOfLen := int(prefix - 0xb7)
+		n, err := readLength(b[1:], lenOfLen)
+		if err != nil {
+			return rlpItem{}, nil, err
+		}
+		headerLen, contentLen = 1+lenOfLen, n
+	case prefix <= 0xf7:
+		isList = true
+		headerLen, contentLen = 1, int(prefix-0xc0)
+	default:
+		isList = true
+		lenOfLen := int(prefix - 0xf7)
+		n, err := readLength(b[1:], lenOfLen)
+		if err != nil {
+			return rlpItem{}, nil, err
+		}
+		headerLen, contentLen = 1+lenOfLen, n
+	}
+	if contentLen < 0 || len(b) < headerLen+contentLen {
+		return rlpItem{}, nil, errTruncated
+	}
+	end := headerLen + contentLen
+	return rlpItem{IsList: isList, Content: b[headerLen:end], Raw: b[:end]}, b[end:], nil
+}
+
+func readLength(b []byte, lenOfLen int) (int, error) {
+	if lenOfLen > 4 || len(b) < lenOfLen {
+		return 0, errTruncated
+	}
+	if b[0] == 0 {
+		return 0, errors.New("rlp: non-canonical length")
+	}
+	n := 0
+	for _, c := range b[:lenOfLen] {
+		n = n<<8 | int(c)
+	}
+	return n, nil
+}
+
+// decodeList decodes b as a single RLP list and returns its elements
+func decodeList(b []byte) ([]rlpItem, error) {
+	item, rest, err := decodeItem(b)
+	if err != nil {
+		return nil, err
+	}
+	if !item.IsList {
+		return nil, errors.New("rlp: expected list")
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("rlp: %d trailing bytes", len(rest))
+	}
+	return listElements(item)
+}
+
+func listElements(item rlpItem) ([]rlpItem, error) {
+	var elements []rlpItem
+	content := item.Content
+	for len(content) > 0 {
+		element, rest, err := decodeItem(content)
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+		content = rest
+	}
+	return elements, nil
+}
+
+// encodeUint returns the RLP encoding of an unsigned integer, as used for receipt trie keys
+func encodeUint(i uint64) []byte {
+	if i == 0 {
+		return []byte{0x80}
+	}
+	if i < 0x80 {
+		return []byte{byte(i)}
+	}
+	var buf []byte
+	for v := i; v > 0; v >>= 8 {
+		buf = append([]byte{byte(v)}, buf...)
+	}
+	return append([]byte{0x80 + byte(len(buf))}, buf...)
+}
@@ -0,0 +1,120 @@
+package bridgeproof
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/sha3"
+)
+
+var ErrKeyNotInTrie = errors.New("key not present in trie")
+
+// Keccak256 computes the Ethereum keccak256 hash of data
+func Keccak256(data []byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(data)
+	return hash.Sum(nil)
+}
+
+// VerifyProof walks a Merkle-Patricia trie proof from root and returns the value stored under key.
+// The proof is the list of RLP encoded trie nodes on the path to the key, in any order; nodes that are
+// not referenced from root are ignored.
+func VerifyProof(root []byte, key []byte, proof [][]byte) ([]byte, error) {
+	if len(root) != 32 {
+		return nil, fmt.Errorf("root must be 32 bytes, got %d", len(root))
+	}
+	nodes := make(map[string][]byte, len(proof))
+	for _, node := range proof {
+		nodes[string(Keccak256(node))] = node
+	}
+
+	path := keyToNibbles(key)
+	ref := rlpItem{Content: root}
+	for {
+		var node []byte
+		switch {
+		case ref.IsList:
+			// Nodes shorter than 32 bytes are embedded in their parent
+			node = ref.Raw
+		case len(ref.Content) == 32:
+			found, ok := nodes[string(ref.Content)]
+			if !ok {
+				return nil, fmt.Errorf("proof is missing node %s", hex.EncodeToString(ref.Content))
+			}
+			node = found
+		case len(ref.Content) == 0:
+			return nil, ErrKeyNotInTrie
+		default:
+			return nil, fmt.Errorf("invalid node reference of %d bytes", len(ref.Content))
+		}
+
+		elements, err := decodeList(node)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trie node: %w", err)
+		}
+		switch len(elements) {
+		case 17:
+			if len(path) == 0 {
+				if len(elements[16].Content) == 0 {
+					return nil, ErrKeyNotInTrie
+				}
+				return elements[16].Content, nil
+			}
+			ref = elements[path[0]]
+			path = path[1:]
+		case 2:
+			if elements[0].IsList {
+				return nil, errors.New("invalid trie node: malformed short node")
+			}
+			nodePath, isLeaf, err := decodeHexPrefix(elements[0].Content)
+			if err != nil {
+				return nil, err
+			}
+			if isLeaf {
+				if !bytes.Equal(nodePath, path) {
+					return nil, ErrKeyNotInTrie
+				}
+				return elements[1].Content, nil
+			}
+			if len(path) < len(nodePath) || !bytes.Equal(nodePath, path[:len(nodePath)]) {
+				return nil, ErrKeyNotInTrie
+			}
+			path = path[len(nodePath):]
+			ref = elements[1]
+		default:
+			return nil, fmt.Errorf("invalid trie node with %d elements", len(elements))
+		}
+	}
+}
+
+// ReceiptKey returns the trie key of the receipt at index in its block's receipts trie
+func ReceiptKey(index uint64) []byte {
+	return encodeUint(index)
+}
+
+func keyToNibbles(key []byte) []byte {
+	nibbles := make([]byte, 0, len(key)*2)
+	for _, b := range key {
+		nibbles = append(nibbles, b>>4, b&0x0f)
+	}
+	return nibbles
+}
+
+// decodeHexPrefix decodes the compact path encoding of leaf and extension nodes
+func decodeHexPrefix(compact []byte) ([]byte, bool, error) {
+	if len(compact) == 0 {
+		return nil, false, errors.New("invalid trie node: empty path")
+	}
+	flag := compact[0] >> 4
+	if flag > 3 {
+		return nil, false, fmt.Errorf("invalid trie node: path flag %d", flag)
+	}
+	isLeaf := flag >= 2
+	nibbles := keyToNibbles(compact[1:])
+	if flag%2 == 1 {
+		nibbles = append([]byte{compact[0] & 0x0f}, nibbles...)
+	}
+	return nibbles, isLeaf, nil
+}
@@ -0,0 +1,51 @@
+package calculations
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/rand"
+	"sort"
+)
+
+type WeightedCandidate struct {
+	Address string
+	Weight  int64
+}
+
+// SelectAppealValidators deterministically samples up to count distinct candidates, weighted by
+// their validation weight. Every node computes the same selection from the same seed and candidates,
+// regardless of the order the candidates are given in. Candidates without weight are never selected.
+func SelectAppealValidators(seed []byte, candidates []WeightedCandidate, count int) []string {
+	pool := make([]WeightedCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate.Weight > 0 {
+			pool = append(pool, candidate)
+		}
+	}
+	sort.Slice(pool, func(i, j int) bool {
+		return pool[i].Address < pool[j].Address
+	})
+
+	hash := sha256.Sum256(seed)
+	rng := rand.New(rand.NewSource(int64(binary.BigEndian.Uint64(hash[:8]))))
+
+	selected := make([]string, 0, count)
+	for len(selected) < count && len(pool) > 0 {
+		totalWeight := int64(0)
+		for _, candidate := range pool {
+			totalWeight += candidate.Weight
+		}
+		target := rng.Int63n(totalWeight)
+		index := len(pool) - 1
+		for i, candidate := range pool {
+			if target < candidate.Weight {
+				index = i
+				break
+			}
+			target -= candidate.Weight
+		}
+		selected = append(selected, pool[index].Address)
+		pool = append(pool[:index], pool[index+1:]...)
+	}
+	return selected
+}
@@ -0,0 +1,52 @@
+package calculations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectAppealValidators_Deterministic(t *testing.T) {
+	candidates := []WeightedCandidate{
+		{Address: "a", Weight: 10},
+		{Address: "b", Weight: 20},
+		{Address: "c", Weight: 30},
+		{Address: "d", Weight: 40},
+	}
+	reversed := []WeightedCandidate{candidates[3], candidates[2], candidates[1], candidates[0]}
+
+	first := SelectAppealValidators([]byte("seed"), candidates, 3)
+	second := SelectAppealValidators([]byte("seed"), reversed, 3)
+	require.Equal(t, first, second)
+	require.Len(t, first, 3)
+
+	seen := make(map[string]bool)
+	for _, address := range first {
+		require.False(t, seen[address], "validator selected twice")
+		seen[address] = true
+	}
+}
+
+func TestSelectAppealValidators_SkipsZeroWeight(t *testing.T) {
+	candidates := []WeightedCandidate{
+		{Address: "a", Weight: 0},
+		{Address: "b", Weight: 5},
+	}
+	require.Equal(t, []string{"b"}, SelectAppealValidators([]byte("seed"), candidates, 2))
+	require.Empty(t, SelectAppealValidators([]byte("seed"), nil, 2))
+}
+
+func TestSelectAppealValidators_WeightedByPower(t *testing.T) {
+	candidates := []WeightedCandidate{
+		{Address: "small", Weight: 1},
+		{Address: "large", Weight: 99},
+	}
+	largeFirst := 0
+	for i := 0; i < 200; i++ {
+		selected := SelectAppealValidators([]byte{byte(i), byte(i >> 8)}, candidates, 1)
+		if selected[0] == "large" {
+			largeFirst++
+		}
+	}
+	require.Greater(t, largeFirst, 180)
+}
@@ -143,7 +143,10 @@ func ProcessFinishInference(
 	currentInference.TransferredBy = finishMessage.TransferredBy
 	currentInference.TransferSignature = finishMessage.TransferSignature
 	currentInference.ExecutionSignature = finishMessage.ExecutorSignature
+	// A truncated output (client cancelled or executor failed mid-stream) reports only the tokens actually produced,
+	// so it's billed like any other finish and the rest of the escrow is refunded
 	currentInference.CompletionTokenCount = finishMessage.CompletionTokenCount
+	currentInference.CompletionStatus = finishMessage.CompletionStatus
 	currentInference.ExecutedBy = finishMessage.ExecutedBy
 	currentInference.EndBlockHeight = blockContext.BlockHeight
 	currentInference.EndBlockTimestamp = blockContext.BlockTimestamp
@@ -151,7 +154,10 @@ func ProcessFinishInference(
 	if currentInference.PromptTokenCount == 0 {
 		logger.LogWarn("PromptTokens is 0 when FinishInference is called!", types.Inferences, "inferenceId", currentInference.InferenceId)
 	}
-	if currentInference.CompletionTokenCount == 0 {
+	if currentInference.CompletionStatus != types.InferenceCompletionStatus_COMPLETION_STATUS_COMPLETE {
+		logger.LogInfo("FinishInference with truncated output", types.Inferences, "inferenceId", currentInference.InferenceId,
+			"completionStatus", currentInference.CompletionStatus.String(), "completionTokens", currentInference.CompletionTokenCount)
+	} else if currentInference.CompletionTokenCount == 0 {
 		logger.LogWarn("CompletionTokens is 0 when FinishInference is called!", types.Inferences, "inferenceId", currentInference.InferenceId)
 	}
 	currentInference.ActualCost = CalculateCost(currentInference)
@@ -184,6 +190,9 @@ func getMaxTokens(msg *types.MsgStartInference) uint64 {
 }
 
 func CalculateCost(inference *types.Inference) int64 {
+	if inference.ProducedNoOutput() {
+		return 0
+	}
 	// Simply use the per-token price stored in the inference
 	// RecordInferencePrice ensures this is always set to the correct value:
 	// - Dynamic price from BeginBlocker (including 0 for grace period)
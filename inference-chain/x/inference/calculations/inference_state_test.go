@@ -133,6 +133,15 @@ func TestCalculateCost(t *testing.T) {
 			},
 			expected: 30 * 500, // Should use dynamic price instead of legacy PerTokenCost
 		},
+		{
+			name: "Truncated before the first token",
+			inference: &types.Inference{
+				PromptTokenCount: 10,
+				PerTokenPrice:    PerTokenCost,
+				CompletionStatus: types.InferenceCompletionStatus_COMPLETION_STATUS_EXECUTOR_FAILED,
+			},
+			expected: 0,
+		},
 		{
 			name: "Dynamic pricing - zero price (grace period)",
 			inference: &types.Inference{
@@ -439,6 +448,28 @@ func TestProcessFinishInference(t *testing.T) {
 			},
 			expectedStatus: types.InferenceStatus_FINISHED,
 		},
+		{
+			name: "Client cancelled mid-stream",
+			currentInference: &types.Inference{
+				InferenceId:   "test-id",
+				PromptHash:    "hash",
+				EscrowAmount:  100 * PerTokenCost,
+				PerTokenPrice: PerTokenCost,
+			},
+			finishMessage: &types.MsgFinishInference{
+				InferenceId:          "test-id",
+				ResponseHash:         "hash",
+				PromptTokenCount:     10,
+				CompletionTokenCount: 5,
+				ExecutedBy:           "executor",
+				CompletionStatus:     types.InferenceCompletionStatus_COMPLETION_STATUS_CLIENT_CANCELLED,
+			},
+			blockContext: BlockContext{
+				BlockHeight:    100,
+				BlockTimestamp: 1000,
+			},
+			expectedStatus: types.InferenceStatus_FINISHED,
+		},
 	}
 
 	for _, tt := range tests {
@@ -472,6 +503,32 @@ func TestProcessFinishInference(t *testing.T) {
 			// Verify ActualCost calculation
 			expectedCost := int64((inference.PromptTokenCount + inference.CompletionTokenCount) * PerTokenCost)
 			assert.Equal(t, expectedCost, inference.ActualCost)
+			assert.Equal(t, tt.finishMessage.CompletionStatus, inference.CompletionStatus)
+			if tt.currentInference.EscrowAmount > expectedCost {
+				assert.Equal(t, expectedCost, payments.ExecutorPayment)
+				assert.Equal(t, expectedCost-tt.currentInference.EscrowAmount, payments.EscrowAmount)
+			}
 		})
 	}
 }
+
+func TestProcessFinishInference_NoOutputIsRefunded(t *testing.T) {
+	mockLogger := &MockInferenceLogger{}
+	inference, payments := ProcessFinishInference(
+		&types.Inference{InferenceId: "test-id", PromptHash: "hash", EscrowAmount: 100 * PerTokenCost, PerTokenPrice: PerTokenCost},
+		&types.MsgFinishInference{
+			InferenceId:      "test-id",
+			ResponseHash:     "hash",
+			PromptTokenCount: 10,
+			ExecutedBy:       "executor",
+			CompletionStatus: types.InferenceCompletionStatus_COMPLETION_STATUS_CLIENT_CANCELLED,
+		},
+		BlockContext{BlockHeight: 100, BlockTimestamp: 1000},
+		mockLogger,
+	)
+
+	assert.True(t, inference.ProducedNoOutput())
+	assert.Equal(t, int64(0), inference.ActualCost)
+	assert.Equal(t, int64(0), payments.ExecutorPayment)
+	assert.Equal(t, int64(-100*PerTokenCost), payments.EscrowAmount)
+}
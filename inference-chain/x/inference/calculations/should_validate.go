@@ -20,18 +20,7 @@ func ShouldValidate(
 	validationParams *types.ValidationParams,
 	debug bool,
 ) (bool, string) {
-	// Creating with exponent vs dividing
-	executorReputation := decimal.New(int64(inferenceDetails.ExecutorReputation), -2)
-	maxValidationAverage := validationParams.MaxValidationAverage.ToDecimal()
-	minValidationAverage := CalculateMinimumValidationAverage(int64(inferenceDetails.TrafficBasis), validationParams)
-	rangeSize := maxValidationAverage.Sub(minValidationAverage)
-	// algebraic simplification/removal of temp variables
-	targetValidations := maxValidationAverage.Sub(rangeSize.Mul(executorReputation))
-	// 100% rep will be minValidationAverage, 0% rep will be maxValidationAverage
-	ourProbability := targetValidations.Mul(decimal.NewFromInt(int64(validatorPower))).Div(decimal.NewFromInt(int64(totalPower - executorPower)))
-	if ourProbability.GreaterThan(one) {
-		ourProbability = one
-	}
+	ourProbability, rangeSize, targetValidations := validationProbability(inferenceDetails, totalPower, validatorPower, executorPower, validationParams)
 	randFloat := DeterministicFloat(seed, inferenceDetails.InferenceId)
 	shouldValidate := randFloat.LessThan(ourProbability)
 	// The debug string was very expensive to create
@@ -47,6 +36,41 @@ func ShouldValidate(
 	return shouldValidate, "ShouldValidate:true"
 }
 
+// ValidationProbability is the chance that a validator with validatorPower validates a given inference of an
+// executor with executorPower
+func ValidationProbability(
+	inferenceDetails *types.InferenceValidationDetails,
+	totalPower uint32,
+	validatorPower uint32,
+	executorPower uint32,
+	validationParams *types.ValidationParams,
+) decimal.Decimal {
+	probability, _, _ := validationProbability(inferenceDetails, totalPower, validatorPower, executorPower, validationParams)
+	return probability
+}
+
+func validationProbability(
+	inferenceDetails *types.InferenceValidationDetails,
+	totalPower uint32,
+	validatorPower uint32,
+	executorPower uint32,
+	validationParams *types.ValidationParams,
+) (probability decimal.Decimal, rangeSize decimal.Decimal, targetValidations decimal.Decimal) {
+	// Creating with exponent vs dividing
+	executorReputation := decimal.New(int64(inferenceDetails.ExecutorReputation), -2)
+	maxValidationAverage := validationParams.MaxValidationAverage.ToDecimal()
+	minValidationAverage := CalculateMinimumValidationAverage(int64(inferenceDetails.TrafficBasis), validationParams)
+	rangeSize = maxValidationAverage.Sub(minValidationAverage)
+	// algebraic simplification/removal of temp variables
+	targetValidations = maxValidationAverage.Sub(rangeSize.Mul(executorReputation))
+	// 100% rep will be minValidationAverage, 0% rep will be maxValidationAverage
+	probability = targetValidations.Mul(decimal.NewFromInt(int64(validatorPower))).Div(decimal.NewFromInt(int64(totalPower - executorPower)))
+	if probability.GreaterThan(one) {
+		probability = one
+	}
+	return probability, rangeSize, targetValidations
+}
+
 // DeterministicFloat generates a deterministic random float [0,1) from a seed and identifier.
 // Instead of a real random number generator, we use a deterministic function that takes a seed and an identifier.
 // This is more or less as random as using a seed in a deterministic random seed determined by this same hash, and has
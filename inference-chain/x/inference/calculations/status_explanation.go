@@ -0,0 +1,131 @@
+package calculations
+
+import (
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/shopspring/decimal"
+)
+
+// Computing the exact binomial p-value is linear in the number of requests, so it is skipped for large counts.
+// The downtime pass/fail result is always reported, since it uses the same lookup tables as settlement.
+const maxPValueRequests = 10_000
+
+// StatusForReason maps the reason recorded when a participant was excluded back to the status it caused
+func StatusForReason(reason ParticipantStatusReason) types.ParticipantStatus {
+	switch reason {
+	case ConsecutiveFailures, StatisticalInvalidations:
+		return types.ParticipantStatus_INVALID
+	case Downtime, FailedConfirmationPoC:
+		return types.ParticipantStatus_INACTIVE
+	default:
+		return types.ParticipantStatus_ACTIVE
+	}
+}
+
+// ExplainStatus evaluates the same rules as ComputeStatus against the participant's current stats,
+// without applying new counts, and reports every input and intermediate result.
+// StatusReason is the first rule that fires in ComputeStatus order.
+func ExplainStatus(
+	validationParameters *types.ValidationParams,
+	confirmationPocParams *types.ConfirmationPoCParams,
+	participant types.Participant,
+) types.ParticipantStatusExplanation {
+	stats := getStats(&participant)
+	explanation := types.ParticipantStatusExplanation{
+		Participant:                  participant.Address,
+		Live:                         true,
+		Status:                       participant.Status,
+		InferenceCount:               stats.InferenceCount,
+		MissedRequests:               stats.MissedRequests,
+		ValidatedInferences:          stats.ValidatedInferences,
+		InvalidatedInferences:        stats.InvalidatedInferences,
+		ConsecutiveInvalidInferences: participant.ConsecutiveInvalidInferences,
+		ConfirmationPocRatio:         stats.ConfirmationPoCRatio,
+		EpochsCompleted:              participant.EpochsCompleted,
+		ConfirmationPocPassed:        getConfirmationPoCStatus(&stats, confirmationPocParams) != Fail,
+	}
+	if confirmationPocParams != nil {
+		explanation.ConfirmationPocAlphaThreshold = confirmationPocParams.AlphaThreshold
+	}
+	if validationParameters == nil || validationParameters.FalsePositiveRate == nil || validationParameters.QuickFailureThreshold == nil {
+		return explanation
+	}
+
+	ExplainMissedRequests(&explanation, validationParameters)
+
+	falsePositiveRate := validationParameters.FalsePositiveRate.ToDecimal()
+	probability := probabilityOfConsecutiveFailures(falsePositiveRate, participant.ConsecutiveInvalidInferences)
+	explanation.ConsecutiveFailureProbability = types.DecimalFromDecimal(probability.Round(LogPrecision))
+	explanation.QuickFailureThreshold = validationParameters.QuickFailureThreshold
+
+	explanation.InvalidationSprt = explainSprt(validationParameters.FalsePositiveRate, validationParameters.BadParticipantInvalidationRate, validationParameters.InvalidationHThreshold, stats.InvalidLLR)
+	explanation.DowntimeSprt = explainSprt(validationParameters.DowntimeGoodPercentage, validationParameters.DowntimeBadPercentage, validationParameters.DowntimeHThreshold, stats.InactiveLLR)
+
+	switch {
+	case probability.LessThan(validationParameters.QuickFailureThreshold.ToDecimal()):
+		explanation.StatusReason = string(ConsecutiveFailures)
+	case explanation.InvalidationSprt.Decision == decisionName(Fail):
+		explanation.StatusReason = string(StatisticalInvalidations)
+	case explanation.DowntimeSprt.Decision == decisionName(Fail):
+		explanation.StatusReason = string(Downtime)
+	case !explanation.ConfirmationPocPassed:
+		explanation.StatusReason = string(FailedConfirmationPoC)
+	}
+	return explanation
+}
+
+// ExplainMissedRequests fills in the miss percentage (as used by reputation) and the downtime
+// binomial test (as used by reward settlement) from the explanation's request counts.
+func ExplainMissedRequests(explanation *types.ParticipantStatusExplanation, validationParameters *types.ValidationParams) {
+	if validationParameters == nil {
+		return
+	}
+	explanation.MissPercentageCutoff = validationParameters.MissPercentageCutoff
+	if explanation.InferenceCount > 0 {
+		missPercentage := decimal.NewFromInt(int64(explanation.MissedRequests)).Div(decimal.NewFromInt(int64(explanation.InferenceCount)))
+		explanation.MissPercentage = types.DecimalFromDecimal(missPercentage)
+		if validationParameters.MissPercentageCutoff != nil {
+			explanation.MissPercentageExceeded = missPercentage.GreaterThan(validationParameters.MissPercentageCutoff.ToDecimal())
+		}
+	}
+
+	p0 := decimal.NewFromFloat(0.10)
+	if validationParameters.BinomTestP0 != nil {
+		p0 = validationParameters.BinomTestP0.ToDecimal()
+	}
+	explanation.BinomTestP0 = types.DecimalFromDecimal(p0)
+	total := int(explanation.InferenceCount + explanation.MissedRequests)
+	missed := int(explanation.MissedRequests)
+	passed, err := MissedStatTest(missed, total, p0)
+	explanation.DowntimeTestPassed = err == nil && passed
+	if total > 0 && total <= maxPValueRequests {
+		if pValue, err := BinomialPValue(missed, total, p0, LogPrecision); err == nil {
+			explanation.DowntimePValue = types.DecimalFromDecimal(pValue)
+		}
+	}
+}
+
+func explainSprt(p0, p1, h, llr *types.Decimal) *types.SprtExplanation {
+	explanation := &types.SprtExplanation{P0: p0, P1: p1, Threshold: h, Llr: llr, Decision: decisionName(Error)}
+	if p0 == nil || p1 == nil || h == nil || llr == nil {
+		return explanation
+	}
+	sprt, err := NewSPRT(p0.ToDecimal(), p1.ToDecimal(), h.ToDecimal(), llr.ToDecimal(), LogPrecision)
+	if err != nil {
+		return explanation
+	}
+	explanation.Decision = decisionName(sprt.Decision())
+	return explanation
+}
+
+func decisionName(decision Decision) string {
+	switch decision {
+	case Pass:
+		return "pass"
+	case Fail:
+		return "fail"
+	case Undetermined:
+		return "undetermined"
+	default:
+		return "error"
+	}
+}
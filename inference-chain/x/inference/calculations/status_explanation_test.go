@@ -0,0 +1,87 @@
+package calculations
+
+import (
+	"testing"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func explanationParams() *types.ValidationParams {
+	return &types.ValidationParams{
+		FalsePositiveRate:              types.DecimalFromFloat(0.05),
+		BadParticipantInvalidationRate: types.DecimalFromFloat(0.1),
+		InvalidationHThreshold:         types.DecimalFromFloat(4),
+		DowntimeGoodPercentage:         types.DecimalFromFloat(0.1),
+		DowntimeBadPercentage:          types.DecimalFromFloat(0.2),
+		DowntimeHThreshold:             types.DecimalFromFloat(4),
+		QuickFailureThreshold:          types.DecimalFromFloat(0.000001),
+		MissPercentageCutoff:           types.DecimalFromFloat(0.01),
+	}
+}
+
+func TestExplainStatus_MatchesComputeStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		participant types.Participant
+		wantReason  ParticipantStatusReason
+	}{
+		{
+			name: "healthy participant",
+			participant: types.Participant{
+				CurrentEpochStats: &types.CurrentEpochStats{InferenceCount: 100, ValidatedInferences: 50, InvalidLLR: types.DecimalFromFloat(0), InactiveLLR: types.DecimalFromFloat(0)},
+			},
+			wantReason: NoReason,
+		},
+		{
+			name:        "consecutive failures",
+			participant: types.Participant{ConsecutiveInvalidInferences: 20},
+			wantReason:  ConsecutiveFailures,
+		},
+		{
+			name: "statistical invalidations",
+			participant: types.Participant{
+				CurrentEpochStats: &types.CurrentEpochStats{InvalidLLR: types.DecimalFromFloat(5), InactiveLLR: types.DecimalFromFloat(0)},
+			},
+			wantReason: StatisticalInvalidations,
+		},
+		{
+			name: "downtime",
+			participant: types.Participant{
+				CurrentEpochStats: &types.CurrentEpochStats{InvalidLLR: types.DecimalFromFloat(0), InactiveLLR: types.DecimalFromFloat(5)},
+			},
+			wantReason: Downtime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			explanation := ExplainStatus(explanationParams(), nil, tt.participant)
+			require.Equal(t, string(tt.wantReason), explanation.StatusReason)
+			require.NotNil(t, explanation.InvalidationSprt)
+			require.NotNil(t, explanation.DowntimeSprt)
+			require.NotNil(t, explanation.ConsecutiveFailureProbability)
+			require.True(t, explanation.Live)
+		})
+	}
+}
+
+func TestExplainMissedRequests(t *testing.T) {
+	explanation := types.ParticipantStatusExplanation{InferenceCount: 100, MissedRequests: 5}
+	ExplainMissedRequests(&explanation, explanationParams())
+
+	require.Equal(t, 0.05, explanation.MissPercentage.ToFloat())
+	require.True(t, explanation.MissPercentageExceeded)
+	require.Equal(t, 0.1, explanation.BinomTestP0.ToFloat())
+	require.True(t, explanation.DowntimeTestPassed)
+	require.NotNil(t, explanation.DowntimePValue)
+	require.Greater(t, explanation.DowntimePValue.ToFloat(), 0.5)
+}
+
+func TestStatusForReason(t *testing.T) {
+	require.Equal(t, types.ParticipantStatus_INVALID, StatusForReason(ConsecutiveFailures))
+	require.Equal(t, types.ParticipantStatus_INVALID, StatusForReason(StatisticalInvalidations))
+	require.Equal(t, types.ParticipantStatus_INACTIVE, StatusForReason(Downtime))
+	require.Equal(t, types.ParticipantStatus_INACTIVE, StatusForReason(FailedConfirmationPoC))
+	require.Equal(t, types.ParticipantStatus_ACTIVE, StatusForReason(NoReason))
+}
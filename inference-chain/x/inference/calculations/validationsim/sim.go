@@ -0,0 +1,388 @@
+// Package validationsim runs Monte Carlo simulations of the validation, reputation and slashing rules so proposed
+// ValidationParams and CollateralParams can be judged by their outcomes instead of by hand calculations.
+//
+// The simulation drives the same functions the chain uses: ValidationProbability decides how often an inference is
+// validated, ComputeStatus applies the consecutive-failure, invalidation and downtime tests after every event,
+// CalculateReputation turns the miss history into reputation and MissedStatTest decides whether an epoch is rewarded.
+// Invalidation votes are assumed to confirm every failed validation.
+package validationsim
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sync"
+
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/shopspring/decimal"
+)
+
+// Behavior describes a class of participants
+type Behavior struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+	// InvalidRate is the probability that a validation of one of its inferences fails
+	InvalidRate float64 `json:"invalid_rate"`
+	// MissRate is the probability that a request routed to it is missed
+	MissRate float64 `json:"miss_rate"`
+	// Malicious participants are expected to be invalidated, everyone else is expected to stay valid
+	Malicious bool `json:"malicious"`
+}
+
+type Config struct {
+	Params    types.Params
+	Behaviors []Behavior
+	Epochs    int
+	// InferencesPerEpoch is the number of requests routed to each participant every epoch
+	InferencesPerEpoch int
+	Runs               int
+	Seed               int64
+}
+
+func DefaultBehaviors() []Behavior {
+	return []Behavior{
+		{Name: "honest", Count: 10, InvalidRate: 0.01},
+		{Name: "faulty", Count: 2, InvalidRate: 0.01, MissRate: 0.3},
+		{Name: "malicious", Count: 2, InvalidRate: 0.5, Malicious: true},
+	}
+}
+
+func (c Config) Validate() error {
+	if c.Params.ValidationParams == nil || c.Params.CollateralParams == nil {
+		return errors.New("validation and collateral params are required")
+	}
+	if c.Epochs <= 0 || c.InferencesPerEpoch <= 0 || c.Runs <= 0 {
+		return errors.New("epochs, inferences per epoch and runs must be positive")
+	}
+	total := 0
+	for _, behavior := range c.Behaviors {
+		if behavior.Count < 0 {
+			return fmt.Errorf("%s: count must not be negative", behavior.Name)
+		}
+		if behavior.InvalidRate < 0 || behavior.InvalidRate > 1 || behavior.MissRate < 0 || behavior.MissRate > 1 {
+			return fmt.Errorf("%s: rates must be between 0 and 1", behavior.Name)
+		}
+		total += behavior.Count
+	}
+	if total < 2 {
+		return errors.New("at least two participants are needed to validate each other")
+	}
+	return nil
+}
+
+// ClassReport aggregates the outcomes of one behavior class over all runs. Rates are per participant epoch unless
+// stated otherwise.
+type ClassReport struct {
+	Behavior
+	ParticipantEpochs int `json:"participant_epochs"`
+	// ValidationsPerInference is the mean number of validations each served inference received
+	ValidationsPerInference float64 `json:"validations_per_inference"`
+	InvalidatedRate         float64 `json:"invalidated_rate"`
+	DeactivatedRate         float64 `json:"deactivated_rate"`
+	// EverInvalidatedRate is the fraction of participants invalidated at least once during a run
+	EverInvalidatedRate float64 `json:"ever_invalidated_rate"`
+	// MeanEpochsToInvalidation counts epochs, starting at 1, until the first invalidation of participants that were
+	// invalidated at all
+	MeanEpochsToInvalidation float64 `json:"mean_epochs_to_invalidation"`
+	// MeanInferencesToInvalidation is the mean number of inferences served in the epoch of an invalidation before it
+	MeanInferencesToInvalidation float64 `json:"mean_inferences_to_invalidation"`
+	// SlashedCollateral is the mean fraction of the initial collateral slashed by the end of a run
+	SlashedCollateral float64 `json:"slashed_collateral"`
+	RewardedRate      float64 `json:"rewarded_rate"`
+	// FinalReputation is the mean reputation at the end of a run
+	FinalReputation float64 `json:"final_reputation"`
+}
+
+type Report struct {
+	Runs               int   `json:"runs"`
+	Epochs             int   `json:"epochs"`
+	InferencesPerEpoch int   `json:"inferences_per_epoch"`
+	Seed               int64 `json:"seed"`
+	// FalsePositiveRate is the fraction of epochs of non-malicious participants that ended in invalidation
+	FalsePositiveRate float64 `json:"false_positive_rate"`
+	// FalseNegativeRate is the fraction of epochs of malicious participants that ended without invalidation
+	FalseNegativeRate float64       `json:"false_negative_rate"`
+	Classes           []ClassReport `json:"classes"`
+}
+
+type participantState struct {
+	behavior         int
+	participant      types.Participant
+	missHistory      []decimal.Decimal
+	collateral       float64
+	everInvalidated  bool
+	validations      uint64
+	served           uint64
+	reputation       int64
+	validationChance float64
+}
+
+type classTotals struct {
+	participantEpochs   int
+	invalidated         int
+	deactivated         int
+	rewarded            int
+	everInvalidated     int
+	epochsToInvalid     int
+	inferencesToInvalid uint64
+	served              uint64
+	validations         uint64
+	slashed             float64
+	reputation          int64
+	participants        int
+}
+
+func Run(config Config) (*Report, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	// Runs are independent, so they are spread over all CPUs and merged in run order to keep reports reproducible
+	runTotals := make([][]classTotals, config.Runs)
+	runs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(runtime.GOMAXPROCS(0), config.Runs); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for run := range runs {
+				runTotals[run] = make([]classTotals, len(config.Behaviors))
+				sim := newSimulation(config, rand.New(rand.NewSource(config.Seed+int64(run))))
+				sim.run(runTotals[run])
+			}
+		}()
+	}
+	for run := 0; run < config.Runs; run++ {
+		runs <- run
+	}
+	close(runs)
+	wg.Wait()
+
+	totals := make([]classTotals, len(config.Behaviors))
+	for _, run := range runTotals {
+		for i := range totals {
+			totals[i].add(run[i])
+		}
+	}
+	return buildReport(config, totals), nil
+}
+
+func (t *classTotals) add(other classTotals) {
+	t.participantEpochs += other.participantEpochs
+	t.invalidated += other.invalidated
+	t.deactivated += other.deactivated
+	t.rewarded += other.rewarded
+	t.everInvalidated += other.everInvalidated
+	t.epochsToInvalid += other.epochsToInvalid
+	t.inferencesToInvalid += other.inferencesToInvalid
+	t.served += other.served
+	t.validations += other.validations
+	t.slashed += other.slashed
+	t.reputation += other.reputation
+	t.participants += other.participants
+}
+
+type simulation struct {
+	config       Config
+	rng          *rand.Rand
+	participants []*participantState
+}
+
+func newSimulation(config Config, rng *rand.Rand) *simulation {
+	sim := &simulation{config: config, rng: rng}
+	for i, behavior := range config.Behaviors {
+		for j := 0; j < behavior.Count; j++ {
+			sim.participants = append(sim.participants, &participantState{
+				behavior: i,
+				participant: types.Participant{
+					Address: fmt.Sprintf("%s-%d", behavior.Name, j),
+					Status:  types.ParticipantStatus_ACTIVE,
+				},
+				collateral: 1,
+			})
+		}
+	}
+	return sim
+}
+
+func (s *simulation) run(totals []classTotals) {
+	validationParams := s.config.Params.ValidationParams
+	totalPower := uint32(len(s.participants))
+	trafficBasis := uint64(s.config.InferencesPerEpoch) * uint64(totalPower)
+
+	for epoch := 1; epoch <= s.config.Epochs; epoch++ {
+		for _, p := range s.participants {
+			p.participant.Status = types.ParticipantStatus_ACTIVE
+			p.participant.CurrentEpochStats = types.NewCurrentEpochStats()
+			p.reputation = calculations.CalculateReputation(&calculations.ReputationContext{
+				EpochCount:           int64(len(p.missHistory)),
+				EpochMissPercentages: p.missHistory,
+				ValidationParams:     validationParams,
+			})
+			details := &types.InferenceValidationDetails{
+				ExecutorReputation: int32(p.reputation),
+				TrafficBasis:       trafficBasis,
+			}
+			p.validationChance = calculations.ValidationProbability(details, totalPower, 1, 1, validationParams).InexactFloat64()
+		}
+
+		for i := 0; i < s.config.InferencesPerEpoch; i++ {
+			for _, p := range s.participants {
+				if p.participant.Status != types.ParticipantStatus_ACTIVE {
+					continue
+				}
+				s.serve(p, epoch, totals)
+			}
+		}
+
+		for _, p := range s.participants {
+			s.settle(p, &totals[p.behavior])
+		}
+	}
+
+	for _, p := range s.participants {
+		t := &totals[p.behavior]
+		t.participants++
+		t.slashed += 1 - p.collateral
+		t.reputation += calculations.CalculateReputation(&calculations.ReputationContext{
+			EpochCount:           int64(len(p.missHistory)),
+			EpochMissPercentages: p.missHistory,
+			ValidationParams:     validationParams,
+		})
+		if p.everInvalidated {
+			t.everInvalidated++
+		}
+		t.served += p.served
+		t.validations += p.validations
+	}
+}
+
+// serve routes one request to the participant and has every other participant decide whether to validate it
+func (s *simulation) serve(p *participantState, epoch int, totals []classTotals) {
+	behavior := s.config.Behaviors[p.behavior]
+	previous := *p.participant.CurrentEpochStats
+	if s.rng.Float64() < behavior.MissRate {
+		p.participant.CurrentEpochStats.MissedRequests++
+		s.updateStatus(p, previous, epoch, totals)
+		return
+	}
+	p.participant.CurrentEpochStats.InferenceCount++
+	p.served++
+	if s.updateStatus(p, previous, epoch, totals) {
+		return
+	}
+	for validators := len(s.participants) - 1; validators > 0; validators-- {
+		if s.rng.Float64() >= p.validationChance {
+			continue
+		}
+		p.validations++
+		previous = *p.participant.CurrentEpochStats
+		if s.rng.Float64() < behavior.InvalidRate {
+			p.participant.CurrentEpochStats.InvalidatedInferences++
+			p.participant.ConsecutiveInvalidInferences++
+		} else {
+			p.participant.CurrentEpochStats.ValidatedInferences++
+			p.participant.ConsecutiveInvalidInferences = 0
+		}
+		if s.updateStatus(p, previous, epoch, totals) {
+			return
+		}
+	}
+}
+
+// updateStatus mirrors Keeper.UpdateParticipantStatus, feeding the change since previous into the status tests, and
+// reports whether the participant was excluded
+func (s *simulation) updateStatus(p *participantState, previous types.CurrentEpochStats, epoch int, totals []classTotals) bool {
+	params := s.config.Params
+	status, _, stats := calculations.ComputeStatus(params.ValidationParams, params.ConfirmationPocParams, p.participant, previous)
+	p.participant.CurrentEpochStats = &stats
+	if status == p.participant.Status {
+		return false
+	}
+	p.participant.Status = status
+
+	switch status {
+	case types.ParticipantStatus_INVALID:
+		p.collateral *= 1 - params.CollateralParams.SlashFractionInvalid.ToFloat()
+		if !p.everInvalidated {
+			p.everInvalidated = true
+			t := &totals[p.behavior]
+			t.epochsToInvalid += epoch
+			t.inferencesToInvalid += stats.InferenceCount
+		}
+		return true
+	case types.ParticipantStatus_INACTIVE:
+		p.collateral *= 1 - params.CollateralParams.SlashFractionDowntime.ToFloat()
+		return true
+	}
+	return false
+}
+
+// settle applies the end of epoch reward decision and records the epoch in the miss history used for reputation
+func (s *simulation) settle(p *participantState, t *classTotals) {
+	stats := p.participant.CurrentEpochStats
+	t.participantEpochs++
+	switch p.participant.Status {
+	case types.ParticipantStatus_INVALID:
+		t.invalidated++
+	case types.ParticipantStatus_INACTIVE:
+		t.deactivated++
+	default:
+		total := stats.InferenceCount + stats.MissedRequests
+		passed, err := calculations.MissedStatTest(int(stats.MissedRequests), int(total), s.config.Params.ValidationParams.BinomTestP0.ToDecimal())
+		if err == nil && passed {
+			t.rewarded++
+		}
+	}
+
+	missPercentage := decimal.Zero
+	if stats.InferenceCount > 0 {
+		missPercentage = decimal.NewFromInt(int64(stats.MissedRequests)).Div(decimal.NewFromInt(int64(stats.InferenceCount)))
+	}
+	p.missHistory = append(p.missHistory, missPercentage)
+}
+
+func buildReport(config Config, totals []classTotals) *Report {
+	report := &Report{
+		Runs:               config.Runs,
+		Epochs:             config.Epochs,
+		InferencesPerEpoch: config.InferencesPerEpoch,
+		Seed:               config.Seed,
+	}
+	var honestEpochs, honestInvalidated, maliciousEpochs, maliciousInvalidated int
+	for i, behavior := range config.Behaviors {
+		t := totals[i]
+		class := ClassReport{
+			Behavior:                     behavior,
+			ParticipantEpochs:            t.participantEpochs,
+			ValidationsPerInference:      ratio(float64(t.validations), float64(t.served)),
+			InvalidatedRate:              ratio(float64(t.invalidated), float64(t.participantEpochs)),
+			DeactivatedRate:              ratio(float64(t.deactivated), float64(t.participantEpochs)),
+			EverInvalidatedRate:          ratio(float64(t.everInvalidated), float64(t.participants)),
+			MeanEpochsToInvalidation:     ratio(float64(t.epochsToInvalid), float64(t.everInvalidated)),
+			MeanInferencesToInvalidation: ratio(float64(t.inferencesToInvalid), float64(t.everInvalidated)),
+			SlashedCollateral:            ratio(t.slashed, float64(t.participants)),
+			RewardedRate:                 ratio(float64(t.rewarded), float64(t.participantEpochs)),
+			FinalReputation:              ratio(float64(t.reputation), float64(t.participants)),
+		}
+		report.Classes = append(report.Classes, class)
+		if behavior.Malicious {
+			maliciousEpochs += t.participantEpochs
+			maliciousInvalidated += t.invalidated
+		} else {
+			honestEpochs += t.participantEpochs
+			honestInvalidated += t.invalidated
+		}
+	}
+	report.FalsePositiveRate = ratio(float64(honestInvalidated), float64(honestEpochs))
+	report.FalseNegativeRate = ratio(float64(maliciousEpochs-maliciousInvalidated), float64(maliciousEpochs))
+	return report
+}
+
+func ratio(numerator, denominator float64) float64 {
+	if denominator == 0 {
+		return 0
+	}
+	return numerator / denominator
+}
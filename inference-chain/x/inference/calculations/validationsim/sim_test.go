@@ -0,0 +1,85 @@
+package validationsim
+
+import (
+	"testing"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig(behaviors ...Behavior) Config {
+	return Config{
+		Params:             types.DefaultParams(),
+		Behaviors:          behaviors,
+		Epochs:             3,
+		InferencesPerEpoch: 50,
+		Runs:               2,
+		Seed:               7,
+	}
+}
+
+func TestRun_PerfectParticipantsAreNeverPunished(t *testing.T) {
+	report, err := Run(testConfig(Behavior{Name: "honest", Count: 4}))
+	require.NoError(t, err)
+
+	honest := report.Classes[0]
+	require.Equal(t, 4*3*2, honest.ParticipantEpochs)
+	require.Zero(t, honest.InvalidatedRate)
+	require.Zero(t, honest.DeactivatedRate)
+	require.Zero(t, honest.SlashedCollateral)
+	require.Equal(t, 1.0, honest.RewardedRate)
+	require.Greater(t, honest.ValidationsPerInference, 0.0)
+	require.Zero(t, report.FalsePositiveRate)
+}
+
+func TestRun_MaliciousParticipantsAreInvalidatedAndSlashed(t *testing.T) {
+	report, err := Run(testConfig(
+		Behavior{Name: "honest", Count: 4},
+		Behavior{Name: "malicious", Count: 2, InvalidRate: 1, Malicious: true},
+	))
+	require.NoError(t, err)
+
+	malicious := report.Classes[1]
+	require.Equal(t, 1.0, malicious.InvalidatedRate)
+	require.Equal(t, 1.0, malicious.EverInvalidatedRate)
+	require.Equal(t, 1.0, malicious.MeanEpochsToInvalidation)
+	require.Zero(t, malicious.RewardedRate)
+	slashInvalid := types.DefaultParams().CollateralParams.SlashFractionInvalid.ToFloat()
+	require.Greater(t, malicious.SlashedCollateral, slashInvalid)
+	require.Zero(t, report.FalseNegativeRate)
+}
+
+func TestRun_FaultyParticipantsAreDeactivated(t *testing.T) {
+	report, err := Run(testConfig(
+		Behavior{Name: "honest", Count: 4},
+		Behavior{Name: "faulty", Count: 2, MissRate: 0.8},
+	))
+	require.NoError(t, err)
+
+	faulty := report.Classes[1]
+	require.Equal(t, 1.0, faulty.DeactivatedRate)
+	require.Zero(t, faulty.InvalidatedRate)
+	require.Zero(t, faulty.RewardedRate)
+	require.Zero(t, faulty.FinalReputation)
+}
+
+func TestRun_IsDeterministicForSeed(t *testing.T) {
+	config := testConfig(DefaultBehaviors()...)
+	first, err := Run(config)
+	require.NoError(t, err)
+	second, err := Run(config)
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+}
+
+func TestConfigValidate(t *testing.T) {
+	config := testConfig(Behavior{Name: "alone", Count: 1})
+	require.Error(t, config.Validate())
+
+	config = testConfig(Behavior{Name: "bad", Count: 2, MissRate: 1.5})
+	require.Error(t, config.Validate())
+
+	config = testConfig(DefaultBehaviors()...)
+	config.Epochs = 0
+	require.Error(t, config.Validate())
+}
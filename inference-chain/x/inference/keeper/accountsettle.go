@@ -74,6 +74,11 @@ func (k *Keeper) GetSettleParameters(ctx context.Context) (*SettleParameters, er
 	if err != nil {
 		return nil, err
 	}
+	return k.getSettleParametersForParams(ctx, params)
+}
+
+// getSettleParametersForParams combines the given params with the stored tokenomics data and supply
+func (k *Keeper) getSettleParametersForParams(ctx context.Context, params types.Params) (*SettleParameters, error) {
 	tokenomicsData, found := k.GetTokenomicsData(ctx)
 	if !found {
 		return nil, fmt.Errorf("tokenomics data not found")
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/epochgroup"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k Keeper) SetBridgeBlockAttestation(ctx context.Context, attestation *types.BridgeBlockAttestation) error {
+	key := collections.Join3(attestation.ChainId, attestation.BlockNumber, attestation.ReceiptsRoot)
+	if err := k.BridgeBlockAttestationsMap.Set(ctx, key, *attestation); err != nil {
+		return err
+	}
+	return k.BridgeAttestationsToPrune.Set(ctx, collections.Join3(attestation.EpochIndex, attestation.ChainId, attestation.BlockNumber))
+}
+
+// GetBridgeBlockVote returns the receipts root a validator voted for in a block
+func (k Keeper) GetBridgeBlockVote(ctx context.Context, chainId, blockNumber string, validator sdk.AccAddress) (string, bool) {
+	receiptsRoot, err := k.BridgeBlockVotes.Get(ctx, collections.Join3(chainId, blockNumber, validator))
+	if err != nil {
+		return "", false
+	}
+	return receiptsRoot, true
+}
+
+// removeBridgeBlockVoting removes the block's attestations matching shouldRemove, along with the votes for them
+func (k Keeper) removeBridgeBlockVoting(ctx context.Context, chainId, blockNumber string, shouldRemove func(types.BridgeBlockAttestation) bool) error {
+	attestations, err := k.GetBridgeBlockAttestations(ctx, chainId, blockNumber)
+	if err != nil {
+		return err
+	}
+	removedRoots := make(map[string]bool)
+	attested := false
+	for _, attestation := range attestations {
+		if !shouldRemove(attestation) {
+			attested = attested || attestation.Attested
+			continue
+		}
+		if err := k.BridgeBlockAttestationsMap.Remove(ctx, collections.Join3(chainId, blockNumber, attestation.ReceiptsRoot)); err != nil {
+			return err
+		}
+		removedRoots[attestation.ReceiptsRoot] = true
+	}
+
+	iter, err := k.BridgeBlockVotes.Iterate(ctx, collections.NewSuperPrefixedTripleRange[string, string, sdk.AccAddress](chainId, blockNumber))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	var votesToRemove []collections.Triple[string, string, sdk.AccAddress]
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
+		// Once the block is attested no further votes are accepted, so none of its votes are needed
+		if removedRoots[kv.Value] || attested {
+			votesToRemove = append(votesToRemove, kv.Key)
+		}
+	}
+	for _, key := range votesToRemove {
+		if err := k.BridgeBlockVotes.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) GetBridgeBlockAttestation(ctx context.Context, chainId, blockNumber, receiptsRoot string) (*types.BridgeBlockAttestation, bool) {
+	attestation, err := k.BridgeBlockAttestationsMap.Get(ctx, collections.Join3(chainId, blockNumber, receiptsRoot))
+	if err != nil {
+		return nil, false
+	}
+	return &attestation, true
+}
+
+// GetBridgeBlockAttestations returns every receipts root voted for in a block, attested or not
+func (k Keeper) GetBridgeBlockAttestations(ctx context.Context, chainId, blockNumber string) ([]types.BridgeBlockAttestation, error) {
+	iter, err := k.BridgeBlockAttestationsMap.Iterate(ctx, collections.NewSuperPrefixedTripleRange[string, string, string](chainId, blockNumber))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	return iter.Values()
+}
+
+// GetAttestedReceiptsRoot returns the receipts root of a block that reached majority attestation
+func (k Keeper) GetAttestedReceiptsRoot(ctx context.Context, chainId, blockNumber string) (string, bool) {
+	attestations, err := k.GetBridgeBlockAttestations(ctx, chainId, blockNumber)
+	if err != nil {
+		k.LogError("Bridge attestation: Failed to read attestations", types.Messages,
+			"chainId", chainId, "blockNumber", blockNumber, "error", err)
+		return "", false
+	}
+	for _, attestation := range attestations {
+		if attestation.Attested {
+			return attestation.ReceiptsRoot, true
+		}
+	}
+	return "", false
+}
+
+// epochGroupMemberPower returns the weight of addr in the epoch group, or false if it is not a member
+func epochGroupMemberPower(ctx context.Context, epochGroup *epochgroup.EpochGroup, addr sdk.AccAddress) (int64, bool, error) {
+	members, err := epochGroup.GetGroupMembers(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	for _, member := range members {
+		memberAddr, err := sdk.AccAddressFromBech32(member.Member.Address)
+		if err != nil || !memberAddr.Equals(addr) {
+			continue
+		}
+		weight, err := strconv.ParseInt(member.Member.Weight, 10, 64)
+		if err != nil {
+			return 0, false, err
+		}
+		return weight, true, nil
+	}
+	return 0, false, nil
+}
+
+// hasMajority reports whether power is more than half of the epoch group's total weight
+func hasMajority(epochGroup *epochgroup.EpochGroup, power int64) bool {
+	return power >= epochGroup.GroupData.TotalWeight/2+1
+}
@@ -0,0 +1,397 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	BridgeDirectionInbound  = "inbound"
+	BridgeDirectionOutbound = "outbound"
+
+	// maxHeldBridgeReleasesPerBlock bounds the work EndBlock spends on releasing held deposits
+	maxHeldBridgeReleasesPerBlock = 50
+)
+
+func bridgeRateLimitKey(chainId, contractAddress string) collections.Pair[string, string] {
+	return collections.Join(chainId, strings.ToLower(contractAddress))
+}
+
+// SetBridgeRateLimit stores the rate limit of a bridged token, or removes it together with its
+// recorded volume when the limit has no caps
+func (k Keeper) SetBridgeRateLimit(ctx context.Context, limit types.BridgeRateLimit) error {
+	limit.ContractAddress = strings.ToLower(limit.ContractAddress)
+	key := bridgeRateLimitKey(limit.ChainId, limit.ContractAddress)
+	if limit.HasCaps() {
+		return k.BridgeRateLimits.Set(ctx, key, limit)
+	}
+	if err := k.BridgeRateLimits.Remove(ctx, key); err != nil {
+		return err
+	}
+	for _, direction := range []string{BridgeDirectionInbound, BridgeDirectionOutbound} {
+		if err := k.BridgeVolumeUsage.Remove(ctx, collections.Join3(limit.ChainId, limit.ContractAddress, direction)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) GetBridgeRateLimit(ctx context.Context, chainId, contractAddress string) (types.BridgeRateLimit, bool) {
+	limit, err := k.BridgeRateLimits.Get(ctx, bridgeRateLimitKey(chainId, contractAddress))
+	if err != nil {
+		return types.BridgeRateLimit{}, false
+	}
+	return limit, true
+}
+
+func (k Keeper) GetBridgeRateLimitsByChain(ctx context.Context, chainId string) ([]types.BridgeRateLimit, error) {
+	iter, err := k.BridgeRateLimits.Iterate(ctx, collections.NewPrefixedPairRange[string, string](chainId))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	return iter.Values()
+}
+
+// GetBridgeChainStatus returns the circuit breaker of an origin chain, which is closed (unpaused) unless stored otherwise
+func (k Keeper) GetBridgeChainStatus(ctx context.Context, chainId string) types.BridgeChainStatus {
+	status, err := k.BridgeChainStatuses.Get(ctx, chainId)
+	if err != nil {
+		return types.BridgeChainStatus{ChainId: chainId}
+	}
+	return status
+}
+
+func (k Keeper) IsBridgePaused(ctx context.Context, chainId string) bool {
+	return k.GetBridgeChainStatus(ctx, chainId).Paused
+}
+
+// SetBridgePaused pauses or unpauses an origin chain. Unpausing clears the anomalies recorded
+// for the chain so that it is not paused again by the anomalies that paused it before.
+func (k Keeper) SetBridgePaused(ctx sdk.Context, chainId string, paused bool, reason string, autoPaused bool) error {
+	status := k.GetBridgeChainStatus(ctx, chainId)
+	status.Paused = paused
+	if paused {
+		status.PauseReason = reason
+		status.PausedAtHeight = ctx.BlockHeight()
+		status.AutoPaused = autoPaused
+	} else {
+		status.PauseReason = ""
+		status.PausedAtHeight = 0
+		status.AutoPaused = false
+		status.AnomalyHeights = nil
+	}
+	if err := k.BridgeChainStatuses.Set(ctx, chainId, status); err != nil {
+		return err
+	}
+
+	eventType := "bridge_chain_unpaused"
+	if paused {
+		eventType = "bridge_chain_paused"
+	}
+	k.LogInfo("Bridge: chain circuit breaker updated", types.Messages,
+		"chainId", chainId,
+		"paused", paused,
+		"autoPaused", autoPaused,
+		"reason", reason)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute("chain_id", chainId),
+			sdk.NewAttribute("reason", reason),
+			sdk.NewAttribute("auto_paused", strconv.FormatBool(autoPaused)),
+		))
+	return nil
+}
+
+// bridgeVolumeInWindow returns the usage of a rate limit in one direction, with the entries that
+// fell out of the rolling window already dropped, and the volume they add up to
+func (k Keeper) bridgeVolumeInWindow(ctx sdk.Context, limit types.BridgeRateLimit, direction string) (types.BridgeVolumeUsage, math.Int) {
+	key := collections.Join3(limit.ChainId, limit.ContractAddress, direction)
+	usage, err := k.BridgeVolumeUsage.Get(ctx, key)
+	if err != nil {
+		usage = types.BridgeVolumeUsage{ChainId: limit.ChainId, ContractAddress: limit.ContractAddress, Direction: direction}
+	}
+
+	windowStart := ctx.BlockHeight() - int64(limit.WindowBlocks)
+	used := math.ZeroInt()
+	kept := usage.Entries[:0]
+	for _, entry := range usage.Entries {
+		if entry.BlockHeight <= windowStart {
+			continue
+		}
+		if amount, ok := math.NewIntFromString(entry.Amount); ok {
+			used = used.Add(amount)
+		}
+		kept = append(kept, entry)
+	}
+	usage.Entries = kept
+	return usage, used
+}
+
+// checkBridgeRateLimit returns ErrBridgeRateLimitExceeded if moving amount of a token in direction would
+// exceed its rate limit. Tokens without a rate limit are not limited.
+func (k Keeper) checkBridgeRateLimit(ctx sdk.Context, chainId, contractAddress, direction, amount string) error {
+	limit, found := k.GetBridgeRateLimit(ctx, chainId, contractAddress)
+	if !found {
+		return nil
+	}
+	value, err := types.ParseBridgeAmount(amount)
+	if err != nil {
+		return err
+	}
+
+	if limit.MaxTransactionAmount != "" {
+		maxTransaction, _ := math.NewIntFromString(limit.MaxTransactionAmount)
+		if value.GT(maxTransaction) {
+			return errorsmod.Wrapf(types.ErrBridgeRateLimitExceeded, "amount %s exceeds the per-transaction maximum %s", amount, limit.MaxTransactionAmount)
+		}
+	}
+	if limit.MaxWindowVolume != "" {
+		maxVolume, _ := math.NewIntFromString(limit.MaxWindowVolume)
+		_, used := k.bridgeVolumeInWindow(ctx, limit, direction)
+		if used.Add(value).GT(maxVolume) {
+			return errorsmod.Wrapf(types.ErrBridgeRateLimitExceeded, "%s volume %s plus %s exceeds %s per %d blocks", direction, used, amount, limit.MaxWindowVolume, limit.WindowBlocks)
+		}
+	}
+	return nil
+}
+
+// recordBridgeVolume adds a transfer to the rolling window of its rate limit, if the token has a window cap
+func (k Keeper) recordBridgeVolume(ctx sdk.Context, chainId, contractAddress, direction, amount string) error {
+	limit, found := k.GetBridgeRateLimit(ctx, chainId, contractAddress)
+	if !found || limit.MaxWindowVolume == "" {
+		return nil
+	}
+	usage, _ := k.bridgeVolumeInWindow(ctx, limit, direction)
+	usage.Entries = append(usage.Entries, types.BridgeVolumeEntry{BlockHeight: ctx.BlockHeight(), Amount: amount})
+	return k.BridgeVolumeUsage.Set(ctx, collections.Join3(limit.ChainId, limit.ContractAddress, direction), usage)
+}
+
+// CheckAndRecordBridgeOutbound admits a transfer leaving Gonka towards an origin chain, recording it
+// against the rate limit of the token
+func (k Keeper) CheckAndRecordBridgeOutbound(ctx sdk.Context, chainId, contractAddress, amount string) error {
+	if k.IsBridgePaused(ctx, chainId) {
+		return errorsmod.Wrapf(types.ErrBridgePaused, "chain %s", chainId)
+	}
+	if err := k.checkBridgeRateLimit(ctx, chainId, contractAddress, BridgeDirectionOutbound, amount); err != nil {
+		return err
+	}
+	return k.recordBridgeVolume(ctx, chainId, contractAddress, BridgeDirectionOutbound, amount)
+}
+
+// releaseBridgeTransaction completes a verified deposit, or holds it if its chain is paused or it would
+// exceed the rate limit of its token. Deposits held for exceeding a rate limit count as anomalies
+// towards pausing the chain automatically.
+func (k Keeper) releaseBridgeTransaction(ctx sdk.Context, bridgeTx *types.BridgeTransaction) error {
+	if k.IsBridgePaused(ctx, bridgeTx.ChainId) {
+		return k.holdBridgeTransaction(ctx, bridgeTx, "chain paused")
+	}
+	if err := k.checkBridgeRateLimit(ctx, bridgeTx.ChainId, bridgeTx.ContractAddress, BridgeDirectionInbound, bridgeTx.Amount); err != nil {
+		if !errors.Is(err, types.ErrBridgeRateLimitExceeded) {
+			return err
+		}
+		if err := k.holdBridgeTransaction(ctx, bridgeTx, err.Error()); err != nil {
+			return err
+		}
+		return k.recordBridgeAnomaly(ctx, bridgeTx.ChainId)
+	}
+	return k.completeBridgeTransaction(ctx, bridgeTx)
+}
+
+func (k Keeper) completeBridgeTransaction(ctx sdk.Context, bridgeTx *types.BridgeTransaction) error {
+	bridgeTx.Status = types.BridgeTransactionStatus_BRIDGE_COMPLETED
+	k.SetBridgeTransaction(ctx, bridgeTx)
+	if err := k.handleCompletedBridgeTransaction(ctx, bridgeTx); err != nil {
+		return err
+	}
+	return k.recordBridgeVolume(ctx, bridgeTx.ChainId, bridgeTx.ContractAddress, BridgeDirectionInbound, bridgeTx.Amount)
+}
+
+func (k Keeper) holdBridgeTransaction(ctx sdk.Context, bridgeTx *types.BridgeTransaction, reason string) error {
+	bridgeTx.Status = types.BridgeTransactionStatus_BRIDGE_HELD
+	k.SetBridgeTransaction(ctx, bridgeTx)
+	if err := k.HeldBridgeTransactions.Set(ctx, collections.Join(bridgeTx.ChainId, bridgeTx.Id)); err != nil {
+		return err
+	}
+
+	k.LogWarn("Bridge exchange: transfer held", types.Messages,
+		"id", bridgeTx.Id,
+		"chainId", bridgeTx.ChainId,
+		"contractAddress", bridgeTx.ContractAddress,
+		"amount", bridgeTx.Amount,
+		"reason", reason)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"bridge_transfer_held",
+			sdk.NewAttribute("id", bridgeTx.Id),
+			sdk.NewAttribute("chain_id", bridgeTx.ChainId),
+			sdk.NewAttribute("contract_address", bridgeTx.ContractAddress),
+			sdk.NewAttribute("amount", bridgeTx.Amount),
+			sdk.NewAttribute("reason", reason),
+		))
+	return nil
+}
+
+// recordBridgeAnomaly notes a rate limit breach for a chain and pauses the chain once
+// AutoPauseAnomalyThreshold breaches happened within AnomalyWindowBlocks
+func (k Keeper) recordBridgeAnomaly(ctx sdk.Context, chainId string) error {
+	params := k.GetParams(ctx).BridgeParams
+	if params == nil || params.AutoPauseAnomalyThreshold == 0 {
+		return nil
+	}
+	status := k.GetBridgeChainStatus(ctx, chainId)
+	windowStart := ctx.BlockHeight() - params.AnomalyWindowBlocks
+	heights := []int64{ctx.BlockHeight()}
+	for _, height := range status.AnomalyHeights {
+		if height > windowStart {
+			heights = append(heights, height)
+		}
+	}
+	status.AnomalyHeights = heights
+	if err := k.BridgeChainStatuses.Set(ctx, chainId, status); err != nil {
+		return err
+	}
+
+	if !status.Paused && uint32(len(heights)) >= params.AutoPauseAnomalyThreshold {
+		reason := strconv.Itoa(len(heights)) + " transfers exceeded rate limits within " + strconv.FormatInt(params.AnomalyWindowBlocks, 10) + " blocks"
+		return k.SetBridgePaused(ctx, chainId, true, reason, true)
+	}
+	return nil
+}
+
+// GetHeldBridgeTransactions returns the held deposits, of all chains if chainId is empty
+func (k Keeper) GetHeldBridgeTransactions(ctx context.Context, chainId string) ([]types.BridgeTransaction, error) {
+	var ranger collections.Ranger[collections.Pair[string, string]]
+	if chainId != "" {
+		ranger = collections.NewPrefixedPairRange[string, string](chainId)
+	}
+	var held []types.BridgeTransaction
+	err := k.HeldBridgeTransactions.Walk(ctx, ranger, func(key collections.Pair[string, string]) (bool, error) {
+		if bridgeTx, found := k.getBridgeTransactionByID(ctx, key.K2()); found {
+			held = append(held, bridgeTx)
+		}
+		return false, nil
+	})
+	return held, err
+}
+
+// heldBridgeRange ranges over the held deposits from start on
+type heldBridgeRange struct {
+	start *collections.RangeKey[collections.Pair[string, string]]
+}
+
+func (r heldBridgeRange) RangeValues() (start, end *collections.RangeKey[collections.Pair[string, string]], order collections.Order, err error) {
+	return r.start, nil, collections.OrderAscending, nil
+}
+
+// nextHeldBridgeTransactions returns up to limit held deposits in key order after the release cursor, skipping the
+// chains that are paused without reading their deposits. The cursor moves to the last returned key, or back to the
+// start once the scan reaches the end, so deposits that keep failing can't hold back the ones behind them.
+func (k Keeper) nextHeldBridgeTransactions(ctx sdk.Context, limit int) ([]collections.Pair[string, string], error) {
+	var ranger heldBridgeRange
+	cursor, err := k.HeldBridgeReleaseCursor.Get(ctx)
+	if err == nil {
+		ranger.start = collections.RangeKeyNext(cursor)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	var keys []collections.Pair[string, string]
+	reachedEnd := true
+	for {
+		iter, err := k.HeldBridgeTransactions.Iterate(ctx, ranger)
+		if err != nil {
+			return nil, err
+		}
+		pausedChain := ""
+		for ; iter.Valid(); iter.Next() {
+			if len(keys) == limit {
+				reachedEnd = false
+				break
+			}
+			key, err := iter.Key()
+			if err != nil {
+				iter.Close()
+				return nil, err
+			}
+			if k.IsBridgePaused(ctx, key.K1()) {
+				pausedChain = key.K1()
+				break
+			}
+			keys = append(keys, key)
+		}
+		iter.Close()
+		if pausedChain == "" {
+			break
+		}
+		ranger.start = collections.RangeKeyPrefixEnd(collections.PairPrefix[string, string](pausedChain))
+	}
+
+	if reachedEnd {
+		err = k.HeldBridgeReleaseCursor.Remove(ctx)
+	} else {
+		err = k.HeldBridgeReleaseCursor.Set(ctx, keys[len(keys)-1])
+	}
+	return keys, err
+}
+
+// ReleaseHeldBridgeTransactions completes held deposits whose chain is no longer paused and which
+// now fit in the rate limit of their token. Each block considers the next maxHeldBridgeReleasesPerBlock
+// deposits after the release cursor, wrapping around once all were considered, and the deposits of paused
+// chains are not read. A deposit that still does not fit or fails to complete stays held and is retried
+// when the cursor comes around again.
+func (k Keeper) ReleaseHeldBridgeTransactions(ctx sdk.Context) {
+	keys, err := k.nextHeldBridgeTransactions(ctx, maxHeldBridgeReleasesPerBlock)
+	if err != nil {
+		k.LogError("Bridge exchange: Failed to iterate held transactions", types.Messages, "error", err)
+		return
+	}
+
+	for _, key := range keys {
+		id := key.K2()
+		bridgeTx, found := k.getBridgeTransactionByID(ctx, id)
+		if !found || bridgeTx.Status != types.BridgeTransactionStatus_BRIDGE_HELD {
+			_ = k.HeldBridgeTransactions.Remove(ctx, key)
+			continue
+		}
+		// A release earlier in this block may have tripped the circuit breaker
+		if k.IsBridgePaused(ctx, bridgeTx.ChainId) {
+			continue
+		}
+		if err := k.checkBridgeRateLimit(ctx, bridgeTx.ChainId, bridgeTx.ContractAddress, BridgeDirectionInbound, bridgeTx.Amount); err != nil {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.completeBridgeTransaction(cacheCtx, &bridgeTx); err != nil {
+			k.LogError("Bridge exchange: Failed to release held transaction", types.Messages, "id", id, "error", err)
+			continue
+		}
+		write()
+		if err := k.HeldBridgeTransactions.Remove(ctx, key); err != nil {
+			k.LogError("Bridge exchange: Failed to remove released transaction", types.Messages, "id", id, "error", err)
+		}
+
+		k.LogInfo("Bridge exchange: held transfer released", types.Messages,
+			"id", id,
+			"chainId", bridgeTx.ChainId,
+			"amount", bridgeTx.Amount)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"bridge_transfer_released",
+				sdk.NewAttribute("id", id),
+				sdk.NewAttribute("chain_id", bridgeTx.ChainId),
+				sdk.NewAttribute("contract_address", bridgeTx.ContractAddress),
+				sdk.NewAttribute("amount", bridgeTx.Amount),
+			))
+	}
+}
@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const testBridgeContract = "0xb1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1b1"
+
+func TestBridgeRateLimit_HoldAutoPauseAndRelease(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocks(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	params := k.GetParams(ctx)
+	params.BridgeParams = &types.BridgeParams{AutoPauseAnomalyThreshold: 2, AnomalyWindowBlocks: 100}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	validators := []string{sample.AccAddress(), sample.AccAddress()}
+	k.SetEffectiveEpochIndex(ctx, 1)
+	k.SetEpochGroupData(ctx, types.EpochGroupData{EpochIndex: 1, EpochGroupId: 1, TotalWeight: 20})
+	members := make([]*group.GroupMember, 0, len(validators))
+	for _, validator := range validators {
+		members = append(members, &group.GroupMember{GroupId: 1, Member: &group.Member{Address: validator, Weight: "10"}})
+	}
+	mocks.GroupKeeper.EXPECT().GroupMembers(gomock.Any(), gomock.Any()).Return(&group.QueryGroupMembersResponse{Members: members}, nil).AnyTimes()
+	mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(&authtypes.BaseAccount{}).AnyTimes()
+	escrow := authtypes.NewModuleAddress(types.BridgeEscrowAccName)
+	mocks.AccountKeeper.EXPECT().GetModuleAddress(types.BridgeEscrowAccName).Return(escrow).AnyTimes()
+	mocks.BankViewKeeper.EXPECT().SpendableCoin(gomock.Any(), escrow, types.BaseCoin).Return(sdk.NewInt64Coin(types.BaseCoin, 1_000_000)).AnyTimes()
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.BridgeEscrowAccName, gomock.Any(), gomock.Any(), "bridge_release").Return(nil).Times(3)
+
+	k.SetBridgeContractAddress(ctx, types.BridgeContractAddress{ChainId: "ethereum", Address: testBridgeContract})
+	_, err := ms.SetBridgeRateLimit(ctx, &types.MsgSetBridgeRateLimit{
+		Authority: k.GetAuthority(),
+		RateLimit: types.BridgeRateLimit{
+			ChainId:              "ethereum",
+			ContractAddress:      "0xB1B1B1B1B1B1B1B1B1B1B1B1B1B1B1B1B1B1B1B1",
+			WindowBlocks:         10,
+			MaxWindowVolume:      "1000",
+			MaxTransactionAmount: "600",
+		},
+	})
+	require.NoError(t, err)
+
+	owner := sample.AccAddress()
+	deposit := func(blockNumber, amount string) types.BridgeTransaction {
+		for _, validator := range validators {
+			_, err := ms.BridgeExchange(ctx, &types.MsgBridgeExchange{
+				Validator:       validator,
+				OriginChain:     "ethereum",
+				ContractAddress: testBridgeContract,
+				OwnerAddress:    owner,
+				Amount:          amount,
+				BlockNumber:     blockNumber,
+				ReceiptIndex:    "0",
+				ReceiptsRoot:    "0xroot",
+			})
+			require.NoError(t, err)
+		}
+		transactions := k.GetBridgeTransactionsByReceipt(ctx, "ethereum", blockNumber, "0")
+		require.Len(t, transactions, 1)
+		return transactions[0]
+	}
+
+	require.Equal(t, types.BridgeTransactionStatus_BRIDGE_COMPLETED, deposit("1", "500").Status)
+	// Exceeds the window volume, then the per-transaction maximum. The second anomaly pauses the chain.
+	require.Equal(t, types.BridgeTransactionStatus_BRIDGE_HELD, deposit("2", "600").Status)
+	require.False(t, k.IsBridgePaused(ctx, "ethereum"))
+	require.Equal(t, types.BridgeTransactionStatus_BRIDGE_HELD, deposit("3", "700").Status)
+	require.True(t, k.IsBridgePaused(ctx, "ethereum"))
+	// Within limits, but the chain is paused
+	require.Equal(t, types.BridgeTransactionStatus_BRIDGE_HELD, deposit("4", "100").Status)
+
+	capacity, err := k.BridgeCapacity(ctx, &types.QueryBridgeCapacityRequest{ChainId: "ethereum"})
+	require.NoError(t, err)
+	require.True(t, capacity.Status.AutoPaused)
+	require.Len(t, capacity.Status.AnomalyHeights, 2)
+	require.Len(t, capacity.Limits, 1)
+	require.Equal(t, "500", capacity.Limits[0].InboundUsed)
+	require.Equal(t, "500", capacity.Limits[0].InboundRemaining)
+	require.Equal(t, "1000", capacity.Limits[0].OutboundRemaining)
+	require.Len(t, capacity.HeldTransactions, 3)
+
+	// Nothing is released while paused
+	k.ReleaseHeldBridgeTransactions(ctx)
+	held, err := k.GetHeldBridgeTransactions(ctx, "ethereum")
+	require.NoError(t, err)
+	require.Len(t, held, 3)
+
+	_, err = ms.SetBridgePaused(ctx, &types.MsgSetBridgePaused{Authority: sample.AccAddress(), ChainId: "ethereum"})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.SetBridgePaused(ctx, &types.MsgSetBridgePaused{Authority: k.GetAuthority(), ChainId: "ethereum"})
+	require.NoError(t, err)
+	require.Empty(t, k.GetBridgeChainStatus(ctx, "ethereum").AnomalyHeights)
+
+	// Once the window has passed, the held deposits that fit are released; the one above the per-transaction maximum stays held
+	ctx = ctx.WithBlockHeight(111)
+	k.ReleaseHeldBridgeTransactions(ctx)
+	held, err = k.GetHeldBridgeTransactions(ctx, "ethereum")
+	require.NoError(t, err)
+	require.Len(t, held, 1)
+	require.Equal(t, "700", held[0].Amount)
+	require.Equal(t, types.BridgeTransactionStatus_BRIDGE_COMPLETED, k.GetBridgeTransactionsByReceipt(ctx, "ethereum", "2", "0")[0].Status)
+	require.Equal(t, types.BridgeTransactionStatus_BRIDGE_COMPLETED, k.GetBridgeTransactionsByReceipt(ctx, "ethereum", "4", "0")[0].Status)
+
+}
+
+func TestBridgeRateLimit_Outbound(t *testing.T) {
+	k, ms, ctx, _ := setupKeeperWithMocks(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	// Tokens without a rate limit are not limited
+	require.NoError(t, k.CheckAndRecordBridgeOutbound(ctx, "ethereum", "0xtoken", "1000000"))
+
+	_, err := ms.SetBridgeRateLimit(ctx, &types.MsgSetBridgeRateLimit{
+		Authority: k.GetAuthority(),
+		RateLimit: types.BridgeRateLimit{ChainId: "ethereum", ContractAddress: "0xtoken", WindowBlocks: 10, MaxWindowVolume: "100"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, k.CheckAndRecordBridgeOutbound(ctx, "ethereum", "0xtoken", "60"))
+	require.ErrorIs(t, k.CheckAndRecordBridgeOutbound(ctx, "ethereum", "0xtoken", "60"), types.ErrBridgeRateLimitExceeded)
+	require.ErrorIs(t, k.CheckAndRecordBridgeOutbound(ctx, "ethereum", "0xTOKEN", "60"), types.ErrBridgeRateLimitExceeded)
+	require.NoError(t, k.CheckAndRecordBridgeOutbound(ctx.WithBlockHeight(110), "ethereum", "0xtoken", "60"))
+
+	_, err = ms.SetBridgePaused(ctx, &types.MsgSetBridgePaused{Authority: k.GetAuthority(), ChainId: "ethereum", Paused: true, Reason: "incident"})
+	require.NoError(t, err)
+	require.ErrorIs(t, k.CheckAndRecordBridgeOutbound(ctx, "ethereum", "0xother", "1"), types.ErrBridgePaused)
+	require.False(t, k.GetBridgeChainStatus(ctx, "ethereum").AutoPaused)
+
+	// Setting a limit without caps removes it
+	_, err = ms.SetBridgeRateLimit(ctx, &types.MsgSetBridgeRateLimit{
+		Authority: k.GetAuthority(),
+		RateLimit: types.BridgeRateLimit{ChainId: "ethereum", ContractAddress: "0xtoken"},
+	})
+	require.NoError(t, err)
+	_, found := k.GetBridgeRateLimit(ctx, "ethereum", "0xtoken")
+	require.False(t, found)
+}
+
+func TestBridgeRateLimit_ReleaseBoundedAndSkipsPausedChains(t *testing.T) {
+	k, _, ctx, _ := setupKeeperWithMocks(t)
+
+	// Stale entries without a transaction are dropped when considered, which makes the work per block visible
+	for i := 0; i < 5; i++ {
+		require.NoError(t, k.HeldBridgeTransactions.Set(ctx, collections.Join("arbitrum", fmt.Sprintf("arbitrum_%02d", i))))
+	}
+	for i := 0; i < 60; i++ {
+		require.NoError(t, k.HeldBridgeTransactions.Set(ctx, collections.Join("ethereum", fmt.Sprintf("ethereum_%02d", i))))
+	}
+	require.NoError(t, k.SetBridgePaused(ctx, "arbitrum", true, "test", false))
+
+	countHeld := func(chainId string) int {
+		iter, err := k.HeldBridgeTransactions.Iterate(ctx, collections.NewPrefixedPairRange[string, string](chainId))
+		require.NoError(t, err)
+		defer iter.Close()
+		keys, err := iter.Keys()
+		require.NoError(t, err)
+		return len(keys)
+	}
+
+	k.ReleaseHeldBridgeTransactions(ctx)
+	require.Equal(t, 5, countHeld("arbitrum"))
+	require.Equal(t, 10, countHeld("ethereum"))
+
+	k.ReleaseHeldBridgeTransactions(ctx)
+	require.Equal(t, 5, countHeld("arbitrum"))
+	require.Equal(t, 0, countHeld("ethereum"))
+}
+
+func TestBridgeRateLimit_ReleaseMovesPastDepositsThatDoNotFit(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocks(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(&authtypes.BaseAccount{}).AnyTimes()
+	escrow := authtypes.NewModuleAddress(types.BridgeEscrowAccName)
+	mocks.AccountKeeper.EXPECT().GetModuleAddress(types.BridgeEscrowAccName).Return(escrow).AnyTimes()
+	mocks.BankViewKeeper.EXPECT().SpendableCoin(gomock.Any(), escrow, types.BaseCoin).Return(sdk.NewInt64Coin(types.BaseCoin, 1_000_000)).AnyTimes()
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.BridgeEscrowAccName, gomock.Any(), gomock.Any(), "bridge_release").Return(nil).Times(1)
+
+	k.SetBridgeContractAddress(ctx, types.BridgeContractAddress{ChainId: "ethereum", Address: testBridgeContract})
+	_, err := ms.SetBridgeRateLimit(ctx, &types.MsgSetBridgeRateLimit{
+		Authority: k.GetAuthority(),
+		RateLimit: types.BridgeRateLimit{ChainId: "ethereum", ContractAddress: testBridgeContract, MaxTransactionAmount: "600"},
+	})
+	require.NoError(t, err)
+
+	hold := func(blockNumber int, amount string) string {
+		bridgeTx := &types.BridgeTransaction{
+			ChainId:         "ethereum",
+			ContractAddress: testBridgeContract,
+			OwnerAddress:    sample.AccAddress(),
+			Amount:          amount,
+			BlockNumber:     fmt.Sprint(blockNumber),
+			ReceiptIndex:    "0",
+			ReceiptsRoot:    "0xroot",
+			Status:          types.BridgeTransactionStatus_BRIDGE_HELD,
+		}
+		k.SetBridgeTransaction(ctx, bridgeTx)
+		require.NoError(t, k.HeldBridgeTransactions.Set(ctx, collections.Join(bridgeTx.ChainId, bridgeTx.Id)))
+		return bridgeTx.Id
+	}
+	// The first 60 deposits in key order exceed the per-transaction maximum for good
+	for i := 0; i < 60; i++ {
+		hold(1000+i, "700")
+	}
+	fits := hold(2000, "100")
+
+	status := func() types.BridgeTransactionStatus {
+		return k.GetBridgeTransactionsByReceipt(ctx, "ethereum", "2000", "0")[0].Status
+	}
+	k.ReleaseHeldBridgeTransactions(ctx)
+	require.Equal(t, types.BridgeTransactionStatus_BRIDGE_HELD, status())
+
+	k.ReleaseHeldBridgeTransactions(ctx.WithBlockHeight(101))
+	require.Equal(t, types.BridgeTransactionStatus_BRIDGE_COMPLETED, status())
+	has, err := k.HeldBridgeTransactions.Has(ctx, collections.Join("ethereum", fits))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// The scan wrapped around, so the next block starts from the first deposit again
+	_, err = k.HeldBridgeReleaseCursor.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	held, err := k.GetHeldBridgeTransactions(ctx, "ethereum")
+	require.NoError(t, err)
+	require.Len(t, held, 60)
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	cosmossecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/productscience/inference/x/inference/bridgeproof"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+var (
+	// Transfer(address indexed from, address indexed to, uint256 value), emitted for ERC-20 deposits to a bridge contract
+	erc20TransferTopic = bridgeproof.Keccak256([]byte("Transfer(address,address,uint256)"))
+	// WGNKBurned(address indexed from, uint256 amount, uint256 timestamp), emitted when WGNK is sent back to the bridge contract
+	wgnkBurnedTopic = bridgeproof.Keccak256([]byte("WGNKBurned(address,uint256,uint256)"))
+)
+
+// verifyBridgeReceiptProof checks that the deposit described by msg happened on the origin chain: the receipt
+// proof must resolve against the attested receipts root of the block, the transaction must have succeeded,
+// and one of its logs must be a deposit of msg.Amount from the owner's key to a registered bridge contract.
+func (k Keeper) verifyBridgeReceiptProof(ctx context.Context, msg *types.MsgBridgeExchange) error {
+	attestedRoot, found := k.GetAttestedReceiptsRoot(ctx, msg.OriginChain, msg.BlockNumber)
+	if !found {
+		return errorsmod.Wrapf(types.ErrReceiptsRootNotAttested, "%s block %s", msg.OriginChain, msg.BlockNumber)
+	}
+	proposedRoot, err := types.NormalizeReceiptsRoot(msg.ReceiptsRoot)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidReceiptProof, err.Error())
+	}
+	if proposedRoot != attestedRoot {
+		return errorsmod.Wrapf(types.ErrReceiptsRootNotAttested, "receiptsRoot %s does not match attested root %s", proposedRoot, attestedRoot)
+	}
+
+	receiptIndex, err := strconv.ParseUint(msg.ReceiptIndex, 10, 64)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidReceiptProof, "invalid receipt index %s", msg.ReceiptIndex)
+	}
+	root, _ := types.ParseReceiptsRoot(attestedRoot)
+	encodedReceipt, err := bridgeproof.VerifyProof(root, bridgeproof.ReceiptKey(receiptIndex), msg.ReceiptProof)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidReceiptProof, err.Error())
+	}
+	receipt, err := bridgeproof.DecodeReceipt(encodedReceipt)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidReceiptProof, err.Error())
+	}
+	if !receipt.Succeeded {
+		return errorsmod.Wrap(types.ErrInvalidReceiptProof, "transaction reverted")
+	}
+
+	ownerEthAddress, err := verifyBridgeOwner(msg.OwnerPubKey, msg.OwnerAddress)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidReceiptProof, err.Error())
+	}
+	amount, ok := new(big.Int).SetString(msg.Amount, 10)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidReceiptProof, "invalid amount %s", msg.Amount)
+	}
+	contract, err := hexAddressBytes(msg.ContractAddress)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidReceiptProof, err.Error())
+	}
+
+	var bridgeAddresses [][]byte
+	for _, bridgeAddress := range k.GetBridgeContractAddressesByChain(ctx, msg.OriginChain) {
+		if decoded, err := hexAddressBytes(bridgeAddress.Address); err == nil {
+			bridgeAddresses = append(bridgeAddresses, decoded)
+		}
+	}
+
+	for _, log := range receipt.Logs {
+		if isBridgeDepositLog(log, contract, bridgeAddresses, ownerEthAddress, amount) {
+			return nil
+		}
+	}
+	return errorsmod.Wrap(types.ErrInvalidReceiptProof, "receipt has no matching deposit log")
+}
+
+func isBridgeDepositLog(log bridgeproof.Log, contract []byte, bridgeAddresses [][]byte, owner []byte, amount *big.Int) bool {
+	if !bytes.Equal(log.Address, contract) || len(log.Topics) == 0 || len(log.Data) < 32 {
+		return false
+	}
+	if new(big.Int).SetBytes(log.Data[:32]).Cmp(amount) != 0 {
+		return false
+	}
+	switch {
+	case bytes.Equal(log.Topics[0], erc20TransferTopic) && len(log.Topics) == 3:
+		return bytes.Equal(topicAddress(log.Topics[1]), owner) && containsAddress(bridgeAddresses, topicAddress(log.Topics[2]))
+	case bytes.Equal(log.Topics[0], wgnkBurnedTopic) && len(log.Topics) == 2:
+		return bytes.Equal(topicAddress(log.Topics[1]), owner) && containsAddress(bridgeAddresses, contract)
+	}
+	return false
+}
+
+// verifyBridgeOwner checks that the owner's Gonka address belongs to ownerPubKey and returns the
+// Ethereum address of the same key, which is the address the deposit must come from
+func verifyBridgeOwner(ownerPubKey string, ownerAddress string) ([]byte, error) {
+	keyBytes, err := hex.DecodeString(strings.TrimPrefix(ownerPubKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("owner public key is not hex: %v", err)
+	}
+	if len(keyBytes) == 64 {
+		keyBytes = append([]byte{0x04}, keyBytes...)
+	}
+	pubKey, err := secp256k1.ParsePubKey(keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid owner public key: %v", err)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(ownerAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid owner address: %v", err)
+	}
+	derived := sdk.AccAddress((&cosmossecp256k1.PubKey{Key: pubKey.SerializeCompressed()}).Address())
+	if !derived.Equals(owner) {
+		return nil, fmt.Errorf("owner address %s does not match public key", ownerAddress)
+	}
+
+	uncompressed := pubKey.SerializeUncompressed()
+	return bridgeproof.Keccak256(uncompressed[1:])[12:], nil
+}
+
+func hexAddressBytes(address string) ([]byte, error) {
+	decoded, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(address), "0x"))
+	if err != nil || len(decoded) != 20 {
+		return nil, fmt.Errorf("invalid address %s", address)
+	}
+	return decoded, nil
+}
+
+func topicAddress(topic []byte) []byte {
+	return topic[12:]
+}
+
+func containsAddress(addresses [][]byte, address []byte) bool {
+	for _, candidate := range addresses {
+		if bytes.Equal(candidate, address) {
+			return true
+		}
+	}
+	return false
+}
@@ -111,7 +111,8 @@ func (k Keeper) CleanupOldBridgeTransactions(ctx context.Context, chainId string
 	var deletedCount int
 	var firstErr error
 	for _, tx := range values {
-		if tx.BlockNumber < maxBlockNumber {
+		// Held deposits are kept until they are released
+		if tx.BlockNumber < maxBlockNumber && tx.Status != types.BridgeTransactionStatus_BRIDGE_HELD {
 			if err := k.removeBridgeTransactionByID(ctx, tx.Id); err != nil {
 				if firstErr == nil {
 					firstErr = err
@@ -134,6 +135,18 @@ func buildBridgeTransactionKey(tx *types.BridgeTransaction) (collections.Triple[
 	return collections.Join3(parts[0], parts[1], parts[2]), key, nil
 }
 
+func (k Keeper) getBridgeTransactionByID(ctx context.Context, id string) (types.BridgeTransaction, bool) {
+	parts := strings.SplitN(id, "_", 3)
+	if len(parts) != 3 {
+		return types.BridgeTransaction{}, false
+	}
+	tx, err := k.BridgeTransactionsMap.Get(ctx, collections.Join3(parts[0], parts[1], parts[2]))
+	if err != nil {
+		return types.BridgeTransaction{}, false
+	}
+	return tx, true
+}
+
 func (k Keeper) removeBridgeTransactionByID(ctx context.Context, id string) error {
 	parts := strings.SplitN(id, "_", 3)
 	if len(parts) != 3 {
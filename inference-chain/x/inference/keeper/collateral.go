@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/productscience/inference/x/inference/types"
@@ -67,7 +68,8 @@ func (k Keeper) AdjustWeightsByCollateral(ctx context.Context, participants []*t
 		var activatedWeight math.LegacyDec
 
 		// 3. Calculate Activated Weight: Determine how much of the eligible weight is activated by the participant's collateral.
-		collateral, found := k.collateralKeeper.GetCollateral(sdkCtx, participantAddress)
+		// Pledged unvested rewards count the same as deposited collateral
+		collateral, found := k.collateralKeeper.GetEffectiveCollateral(sdkCtx, participantAddress)
 		if !found || collateral.IsZero() {
 			activatedWeight = math.LegacyZeroDec()
 		} else {
@@ -117,7 +119,8 @@ func (k Keeper) SlashForInvalidStatus(ctx context.Context, participant *types.Pa
 			"participant", participant.Address,
 			"slash_fraction", slashFraction.String(),
 		)
-		_, err := k.collateralKeeper.Slash(ctx, participantAddress, slashFraction, types.SlashReasonInvalidation)
+		invalidators := k.GetEpochInvalidators(ctx, participantAddress)
+		_, err := k.collateralKeeper.SlashWithBeneficiaries(ctx, participantAddress, slashFraction, types.SlashReasonInvalidation, invalidators)
 		if err != nil {
 			k.LogError("Failed to slash participant", types.Tokenomics, "participant", participant.Address, "error", err)
 			// Non-fatal error, we log and continue. The participant is already marked INVALID.
@@ -144,3 +147,28 @@ func (k Keeper) SlashForDowntime(ctx context.Context, participant *types.Partici
 		k.LogError("Failed to slash participant for downtime", types.Tokenomics, "participant", participant.Address, "error", err)
 	}
 }
+
+// GetEpochInvalidators returns the validators who invalidated the executor's inferences in the current epoch.
+func (k Keeper) GetEpochInvalidators(ctx context.Context, executor sdk.AccAddress) []sdk.AccAddress {
+	epochIndex, found := k.GetEffectiveEpochIndex(ctx)
+	if !found {
+		return nil
+	}
+	iter, err := k.EpochInvalidators.Iterate(ctx, collections.NewSuperPrefixedTripleRange[uint64, sdk.AccAddress, sdk.AccAddress](epochIndex, executor))
+	if err != nil {
+		k.LogError("Failed to iterate epoch invalidators", types.Tokenomics, "error", err)
+		return nil
+	}
+	defer iter.Close()
+
+	var invalidators []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			k.LogError("Failed to read epoch invalidator", types.Tokenomics, "error", err)
+			return nil
+		}
+		invalidators = append(invalidators, key.K3())
+	}
+	return invalidators
+}
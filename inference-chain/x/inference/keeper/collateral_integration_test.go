@@ -81,6 +81,8 @@ func setupRealKeepers(t testing.TB) (sdk.Context, keeper.Keeper, collateralKeepe
 		authority.String(),
 		nil,                // bank keeper
 		bookkepingBankMock, // bookkeeping bank keeper
+		nil,                // distribution keeper
+		nil,                // streamvesting keeper
 	)
 
 	// Create a BLS keeper for testing (similar to testutil/keeper/inference.go)
@@ -91,6 +93,7 @@ func setupRealKeepers(t testing.TB) (sdk.Context, keeper.Keeper, collateralKeepe
 		runtime.NewKVStoreService(blsStoreKey),
 		keepertest.PrintlnLogger{},
 		authority.String(),
+		bookkepingBankMock,
 	)
 
 	upgradeMock := keepertest.NewMockUpgradeKeeper(ctrl)
@@ -151,7 +154,7 @@ func TestSlashingForInvalidStatus_Integration(t *testing.T) {
 	expectedSlashFraction, err := slashFraction.ToLegacyDec()
 	require.NoError(t, err)
 	mocks.CollateralKeeper.EXPECT().
-		Slash(gomock.Any(), participantAcc, expectedSlashFraction, types.SlashReasonInvalidation).
+		SlashWithBeneficiaries(gomock.Any(), participantAcc, expectedSlashFraction, types.SlashReasonInvalidation, gomock.Any()).
 		Return(sdk.NewCoin(types.BaseCoin, math.NewInt(0)), nil).Times(1)
 
 	// Execute the function under test directly
@@ -227,8 +230,8 @@ func TestInvalidateInference_FullFlow_WithStatefulMock(t *testing.T) {
 	// Mock Slash to modify our fake collateral
 	expectedSlashFraction, err := slashFraction.ToLegacyDec()
 	require.NoError(t, err)
-	mocks.CollateralKeeper.EXPECT().Slash(gomock.Any(), participantAcc, expectedSlashFraction, types.SlashReasonInvalidation).DoAndReturn(
-		func(ctx sdk.Context, pa sdk.AccAddress, fraction math.LegacyDec, reason string) (sdk.Coin, error) {
+	mocks.CollateralKeeper.EXPECT().SlashWithBeneficiaries(gomock.Any(), participantAcc, expectedSlashFraction, types.SlashReasonInvalidation, gomock.Any()).DoAndReturn(
+		func(ctx sdk.Context, pa sdk.AccAddress, fraction math.LegacyDec, reason string, beneficiaries []sdk.AccAddress) (sdk.Coin, error) {
 			slashedAmount := fakeCollateralAmount.ToLegacyDec().Mul(fraction).TruncateInt()
 			fakeCollateralAmount = fakeCollateralAmount.Sub(slashedAmount)
 			return sdk.NewCoin(types.BaseCoin, slashedAmount), nil
@@ -248,6 +251,7 @@ func TestInvalidateInference_FullFlow_WithStatefulMock(t *testing.T) {
 
 	// Mock bank keeper for the refund logic, even though cost is 0
 	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mocks.CollateralKeeper.EXPECT().CompensateRequester(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(sdk.NewInt64Coin(types.BaseCoin, 0), nil).AnyTimes()
 	mocks.GroupKeeper.EXPECT().UpdateGroupMembers(gomock.Any(), gomock.Any())
 	mocks.GroupKeeper.EXPECT().UpdateGroupMetadata(gomock.Any(), gomock.Any())
 	// Setup the inference object that will be invalidated
@@ -0,0 +1,278 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k Keeper) SetInvalidationAppeal(ctx context.Context, appeal types.InvalidationAppeal) error {
+	return k.InvalidationAppealsMap.Set(ctx, appeal.InferenceId, appeal)
+}
+
+func (k Keeper) GetInvalidationAppeal(ctx context.Context, inferenceId string) (types.InvalidationAppeal, bool) {
+	appeal, err := k.InvalidationAppealsMap.Get(ctx, inferenceId)
+	if err != nil {
+		return types.InvalidationAppeal{}, false
+	}
+	return appeal, true
+}
+
+// OpenInvalidationAppeal stores a new pending appeal and schedules it for resolution at its deadline
+func (k Keeper) OpenInvalidationAppeal(ctx context.Context, appeal types.InvalidationAppeal) error {
+	if err := k.SetInvalidationAppeal(ctx, appeal); err != nil {
+		return err
+	}
+	return k.PendingAppeals.Set(ctx, collections.Join(appeal.DeadlineBlockHeight, appeal.InferenceId))
+}
+
+// AppealOutcome decides an appeal from the votes received so far. A majority of the assigned validators
+// must pass the inference to reverse the invalidation. Before the deadline an appeal stays pending until
+// the outcome can no longer change; at the deadline missing votes count as upholding the invalidation.
+func AppealOutcome(appeal types.InvalidationAppeal, deadlineReached bool) types.AppealStatus {
+	passed, failed := 0, 0
+	for _, vote := range appeal.Votes {
+		if vote.Passed {
+			passed++
+		} else {
+			failed++
+		}
+	}
+	majority := len(appeal.AssignedValidators)/2 + 1
+	if passed >= majority {
+		return types.AppealStatus_APPEAL_STATUS_REVERSED
+	}
+	if failed > len(appeal.AssignedValidators)-majority {
+		return types.AppealStatus_APPEAL_STATUS_UPHELD
+	}
+	if !deadlineReached {
+		return types.AppealStatus_APPEAL_STATUS_PENDING
+	}
+	if len(appeal.Votes) == 0 {
+		return types.AppealStatus_APPEAL_STATUS_EXPIRED
+	}
+	return types.AppealStatus_APPEAL_STATUS_UPHELD
+}
+
+// ResolveExpiredAppeals closes every pending appeal whose voting period ended at or before blockHeight
+func (k Keeper) ResolveExpiredAppeals(ctx context.Context, blockHeight int64) {
+	rng := new(collections.Range[collections.Pair[int64, string]]).EndExclusive(collections.Join(blockHeight+1, ""))
+	keys, err := k.PendingAppeals.Iterate(ctx, rng)
+	if err != nil {
+		k.LogError("Failed to iterate pending appeals", types.Validation, "error", err)
+		return
+	}
+	expired, err := keys.Keys()
+	if err != nil {
+		k.LogError("Failed to read pending appeals", types.Validation, "error", err)
+		return
+	}
+
+	for _, key := range expired {
+		appeal, found := k.GetInvalidationAppeal(ctx, key.K2())
+		if !found || appeal.Status != types.AppealStatus_APPEAL_STATUS_PENDING {
+			// Resolved appeals are kept until the appeal window closes, so the same invalidation can't be appealed twice
+			_ = k.PendingAppeals.Remove(ctx, key)
+			if found {
+				_ = k.InvalidationAppealsMap.Remove(ctx, appeal.InferenceId)
+			}
+			continue
+		}
+		if err := k.ResolveAppeal(ctx, &appeal, AppealOutcome(appeal, true)); err != nil {
+			k.LogError("Failed to resolve expired appeal", types.Validation, "inferenceId", appeal.InferenceId, "error", err)
+		}
+	}
+}
+
+// ResolveAppeal settles the bond, applies the outcome and stores the closed appeal
+func (k Keeper) ResolveAppeal(ctx context.Context, appeal *types.InvalidationAppeal, outcome types.AppealStatus) error {
+	if outcome == types.AppealStatus_APPEAL_STATUS_PENDING {
+		return nil
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	switch outcome {
+	case types.AppealStatus_APPEAL_STATUS_REVERSED:
+		if err := k.returnAppealBond(ctx, appeal); err != nil {
+			return err
+		}
+		if err := k.reverseInvalidation(ctx, appeal); err != nil {
+			return err
+		}
+		k.penalizeInvalidator(ctx, appeal)
+	case types.AppealStatus_APPEAL_STATUS_UPHELD:
+		if err := k.BurnModuleCoins(ctx, appeal.Bond, "appeal_bond_slashed:"+appeal.InferenceId); err != nil {
+			return err
+		}
+	case types.AppealStatus_APPEAL_STATUS_EXPIRED:
+		if err := k.returnAppealBond(ctx, appeal); err != nil {
+			return err
+		}
+	}
+
+	appeal.Status = outcome
+	appeal.ResolvedAtBlockHeight = sdkCtx.BlockHeight()
+	if err := k.SetInvalidationAppeal(ctx, *appeal); err != nil {
+		return err
+	}
+	if err := k.PendingAppeals.Remove(ctx, collections.Join(appeal.DeadlineBlockHeight, appeal.InferenceId)); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err := k.PendingAppeals.Set(ctx, collections.Join(k.appealRemovalHeight(ctx, appeal), appeal.InferenceId)); err != nil {
+		return err
+	}
+	if err := k.InvalidationEffectsMap.Remove(ctx, appeal.InferenceId); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	k.LogInfo("Appeal resolved", types.Validation, "inferenceId", appeal.InferenceId, "executor", appeal.Executor, "outcome", outcome.String(), "votes", len(appeal.Votes))
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"inference_appeal_resolved",
+			sdk.NewAttribute("inference_id", appeal.InferenceId),
+			sdk.NewAttribute("executor", appeal.Executor),
+			sdk.NewAttribute("invalidator", appeal.Invalidator),
+			sdk.NewAttribute("status", outcome.String()),
+			sdk.NewAttribute("votes", strconv.Itoa(len(appeal.Votes))),
+		))
+	return nil
+}
+
+func (k Keeper) returnAppealBond(ctx context.Context, appeal *types.InvalidationAppeal) error {
+	if appeal.Bond <= 0 {
+		return nil
+	}
+	return k.PayParticipantFromEscrow(ctx, appeal.Executor, appeal.Bond, "appeal_bond_returned:"+appeal.InferenceId, nil)
+}
+
+// appealRemovalHeight is the first block after the appeal window of the invalidation has closed
+func (k Keeper) appealRemovalHeight(ctx context.Context, appeal *types.InvalidationAppeal) int64 {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	inference, found := k.GetInference(ctx, appeal.InferenceId)
+	validationParams := k.GetParams(ctx).ValidationParams
+	if found && validationParams != nil {
+		height = max(height, inference.InvalidatedAtBlockHeight+validationParams.AppealWindowBlocks)
+	}
+	return height + 1
+}
+
+// reverseInvalidation undoes the recorded effects of the invalidation:
+//   - the inference is VALIDATED and the executor's stats count it as a validation
+//   - a refunded cost is charged to the requester again and credited back to the executor. If the requester can't
+//     pay, the executor is not re-credited, since nothing backs the owed balance.
+//   - the requester compensation paid out of the collateral pool is taken back from the requester into the pool. If
+//     the requester already spent it, it stays paid.
+//   - an INVALID status caused by this invalidation is lifted, with the exclusion entry and reputation restored
+//
+// The rest is final. The collateral slash for the INVALID status was already distributed to its destination; the
+// false invalidator's slash, with the executor as beneficiary, compensates the executor instead. The executor also stays out of the current epoch's groups, since rejoining
+// needs its PoC weight and model assignments, and takes part again from the next PoC.
+func (k Keeper) reverseInvalidation(ctx context.Context, appeal *types.InvalidationAppeal) error {
+	effects, err := k.InvalidationEffectsMap.Get(ctx, appeal.InferenceId)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		k.LogWarn("Invalidation effects not recorded, only restoring stats", types.Validation, "inferenceId", appeal.InferenceId)
+	}
+
+	executor, found := k.GetParticipant(ctx, appeal.Executor)
+	if !found {
+		return types.ErrParticipantNotFound
+	}
+
+	inference, found := k.GetInference(ctx, appeal.InferenceId)
+	if !found {
+		k.LogWarn("Appealed inference no longer exists, skipping restore", types.Validation, "inferenceId", appeal.InferenceId)
+	} else {
+		inference.Status = types.InferenceStatus_VALIDATED
+		if err := k.SetInference(ctx, inference); err != nil {
+			return err
+		}
+		if effects.RefundedAmount > 0 {
+			k.recreditExecutor(ctx, &executor, &inference, effects.RefundedAmount)
+		}
+		if effects.CompensatedAmount > 0 {
+			k.reclaimCompensation(ctx, &inference, effects.CompensatedAmount)
+		}
+	}
+
+	if executor.ConsecutiveInvalidInferences > 0 {
+		executor.ConsecutiveInvalidInferences--
+	}
+	if executor.CurrentEpochStats != nil {
+		if executor.CurrentEpochStats.InvalidatedInferences > 0 {
+			executor.CurrentEpochStats.InvalidatedInferences--
+		}
+		executor.CurrentEpochStats.ValidatedInferences++
+	}
+	if effects.ExecutorInvalidated && executor.Status == types.ParticipantStatus_INVALID {
+		k.restoreInvalidatedParticipant(ctx, &executor, effects)
+	}
+	return k.SetParticipant(ctx, executor)
+}
+
+// reclaimCompensation takes the compensation for a reversed invalidation back from the requester
+func (k Keeper) reclaimCompensation(ctx context.Context, inference *types.Inference, amount int64) {
+	requester, err := sdk.AccAddressFromBech32(inference.RequestedBy)
+	if err != nil {
+		k.LogError("Could not parse requester address to reclaim compensation", types.Validation, "address", inference.RequestedBy, "error", err)
+		return
+	}
+	coin, err := types.GetCoin(amount)
+	if err != nil {
+		k.LogError("Invalid compensation amount", types.Validation, "inferenceId", inference.InferenceId, "error", err)
+		return
+	}
+	if err := k.collateralKeeper.ReclaimCompensation(ctx, requester, coin, "inference_appeal_reversed_compensation:"+inference.InferenceId); err != nil {
+		k.LogWarn("Requester can't return the compensation of a reversed invalidation", types.Validation,
+			"inferenceId", inference.InferenceId, "requester", inference.RequestedBy, "amount", amount, "error", err)
+		return
+	}
+	k.LogInfo("Compensation reclaimed for reversed invalidation", types.Validation, "inferenceId", inference.InferenceId, "requester", inference.RequestedBy, "amount", amount)
+}
+
+// recreditExecutor charges the requester again for an inference whose invalidation refund was reversed
+func (k Keeper) recreditExecutor(ctx context.Context, executor *types.Participant, inference *types.Inference, amount int64) {
+	if _, err := k.PutPaymentInEscrow(ctx, inference, amount); err != nil {
+		k.LogWarn("Requester can't cover the reversed refund, executor not re-credited", types.Validation,
+			"inferenceId", inference.InferenceId, "requester", inference.RequestedBy, "amount", amount, "error", err)
+		return
+	}
+	executor.CoinBalance += amount
+	k.SafeLogSubAccountTransaction(ctx, executor.Address, types.ModuleName, types.OwedSubAccount, amount, "inference_appeal_reversed:"+inference.InferenceId)
+	k.LogInfo("Executor re-credited for reversed invalidation", types.Balances, "inferenceId", inference.InferenceId, "executor", executor.Address, "amount", amount, "coinBalance", executor.CoinBalance)
+}
+
+// penalizeInvalidator slashes the collateral of the validator whose invalidation was reversed.
+// The executor is passed as beneficiary in case the slash destination rewards the wronged party.
+func (k Keeper) penalizeInvalidator(ctx context.Context, appeal *types.InvalidationAppeal) {
+	fractionParam := k.GetParams(ctx).ValidationParams.FalseInvalidationSlashFraction
+	if fractionParam == nil {
+		return
+	}
+	fraction, err := fractionParam.ToLegacyDec()
+	if err != nil || !fraction.IsPositive() {
+		return
+	}
+	invalidator, err := sdk.AccAddressFromBech32(appeal.Invalidator)
+	if err != nil {
+		k.LogError("Could not parse invalidator address", types.Validation, "address", appeal.Invalidator, "error", err)
+		return
+	}
+	executor, err := sdk.AccAddressFromBech32(appeal.Executor)
+	if err != nil {
+		k.LogError("Could not parse executor address", types.Validation, "address", appeal.Executor, "error", err)
+		return
+	}
+	slashed, err := k.collateralKeeper.SlashWithBeneficiaries(ctx, invalidator, fraction, types.SlashReasonFalseInvalidation, []sdk.AccAddress{executor})
+	if err != nil {
+		k.LogError("Failed to slash invalidator", types.Validation, "invalidator", appeal.Invalidator, "error", err)
+		return
+	}
+	k.LogInfo("Invalidator slashed for reversed invalidation", types.Validation, "invalidator", appeal.Invalidator, "inferenceId", appeal.InferenceId, "amount", slashed.String())
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -42,8 +43,9 @@ type (
 		// Dynamic pricing collections
 		ModelCurrentPriceMap collections.Map[string, uint64]
 		ModelCapacityMap     collections.Map[string, uint64]
-		// Governance models
+		// Governance models, and aliases resolved to model ids
 		Models                        collections.Map[string, types.Model]
+		ModelAliases                  collections.Map[string, string]
 		Inferences                    collections.Map[string, types.Inference]
 		InferenceTimeouts             collections.Map[collections.Pair[uint64, string], types.InferenceTimeout]
 		InferenceValidationDetailsMap collections.Map[collections.Pair[uint64, string], types.InferenceValidationDetails]
@@ -64,6 +66,14 @@ type (
 		InferencesToPrune         collections.Map[collections.Pair[int64, string], collections.NoValue]
 		ActiveInvalidations       collections.KeySet[collections.Pair[sdk.AccAddress, string]]
 		ExcludedParticipantsMap   collections.Map[collections.Pair[uint64, sdk.AccAddress], types.ExcludedParticipant]
+		// EpochInvalidators records (epoch, executor, invalidator) for every successful invalidation
+		EpochInvalidators collections.KeySet[collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress]]
+		// Invalidation appeals, indexed by (height, inference id) for resolution at their deadline and removal
+		// once they can no longer be re-filed
+		InvalidationAppealsMap collections.Map[string, types.InvalidationAppeal]
+		PendingAppeals         collections.KeySet[collections.Pair[int64, string]]
+		// InvalidationEffects records what each invalidation changed, so a reversed appeal can undo it
+		InvalidationEffectsMap collections.Map[string, types.InvalidationEffects]
 		// Confirmation PoC collections
 		ConfirmationPoCEvents          collections.Map[collections.Pair[uint64, uint64], types.ConfirmationPoCEvent]
 		ActiveConfirmationPoCEventItem collections.Item[types.ConfirmationPoCEvent]
@@ -71,6 +81,14 @@ type (
 		// Bridge & Wrapped Token collections
 		BridgeContractAddresses        collections.Map[collections.Pair[string, string], types.BridgeContractAddress]
 		BridgeTransactionsMap          collections.Map[collections.Triple[string, string, string], types.BridgeTransaction]
+		BridgeBlockAttestationsMap     collections.Map[collections.Triple[string, string, string], types.BridgeBlockAttestation]
+		BridgeBlockVotes               collections.Map[collections.Triple[string, string, sdk.AccAddress], string]
+		BridgeAttestationsToPrune      collections.KeySet[collections.Triple[uint64, string, string]]
+		BridgeRateLimits               collections.Map[collections.Pair[string, string], types.BridgeRateLimit]
+		BridgeVolumeUsage              collections.Map[collections.Triple[string, string, string], types.BridgeVolumeUsage]
+		BridgeChainStatuses            collections.Map[string, types.BridgeChainStatus]
+		HeldBridgeTransactions         collections.KeySet[collections.Pair[string, string]]
+		HeldBridgeReleaseCursor        collections.Item[collections.Pair[string, string]]
 		WrappedTokenCodeIDItem         collections.Item[uint64]
 		WrappedTokenMetadataMap        collections.Map[collections.Pair[string, string], types.BridgeTokenMetadata]
 		WrappedTokenContractsMap       collections.Map[collections.Pair[string, string], types.BridgeWrappedTokenContract]
@@ -173,6 +191,13 @@ func NewKeeper(
 			collections.StringKey,
 			codec.CollValue[types.Model](cdc),
 		),
+		ModelAliases: collections.NewMap(
+			sb,
+			types.ModelAliasesPrefix,
+			"model_aliases",
+			collections.StringKey,
+			collections.StringValue,
+		),
 		// inferences map
 		Inferences: collections.NewMap(
 			sb,
@@ -303,6 +328,32 @@ func NewKeeper(
 			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey),
 			codec.CollValue[types.ExcludedParticipant](cdc),
 		),
+		EpochInvalidators: collections.NewKeySet(
+			sb,
+			types.EpochInvalidatorsPrefix,
+			"epoch_invalidators",
+			collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, sdk.AccAddressKey),
+		),
+		InvalidationAppealsMap: collections.NewMap(
+			sb,
+			types.InvalidationAppealsPrefix,
+			"invalidation_appeals",
+			collections.StringKey,
+			codec.CollValue[types.InvalidationAppeal](cdc),
+		),
+		PendingAppeals: collections.NewKeySet(
+			sb,
+			types.PendingAppealsPrefix,
+			"pending_appeals",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+		),
+		InvalidationEffectsMap: collections.NewMap(
+			sb,
+			types.InvalidationEffectsPrefix,
+			"invalidation_effects",
+			collections.StringKey,
+			codec.CollValue[types.InvalidationEffects](cdc),
+		),
 		ConfirmationPoCEvents: collections.NewMap(
 			sb,
 			types.ConfirmationPoCEventsPrefix,
@@ -336,6 +387,59 @@ func NewKeeper(
 			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
 			codec.CollValue[types.BridgeTransaction](cdc),
 		),
+		BridgeBlockAttestationsMap: collections.NewMap(
+			sb,
+			types.BridgeBlockAttestationsPrefix,
+			"bridge_block_attestations",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
+			codec.CollValue[types.BridgeBlockAttestation](cdc),
+		),
+		BridgeBlockVotes: collections.NewMap(
+			sb,
+			types.BridgeBlockVotesPrefix,
+			"bridge_block_votes",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, sdk.AccAddressKey),
+			collections.StringValue,
+		),
+		BridgeAttestationsToPrune: collections.NewKeySet(
+			sb,
+			types.BridgeAttestationsToPrunePrefix,
+			"bridge_attestations_to_prune",
+			collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey),
+		),
+		BridgeRateLimits: collections.NewMap(
+			sb,
+			types.BridgeRateLimitsPrefix,
+			"bridge_rate_limits",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.BridgeRateLimit](cdc),
+		),
+		BridgeVolumeUsage: collections.NewMap(
+			sb,
+			types.BridgeVolumeUsagePrefix,
+			"bridge_volume_usage",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
+			codec.CollValue[types.BridgeVolumeUsage](cdc),
+		),
+		BridgeChainStatuses: collections.NewMap(
+			sb,
+			types.BridgeChainStatusPrefix,
+			"bridge_chain_statuses",
+			collections.StringKey,
+			codec.CollValue[types.BridgeChainStatus](cdc),
+		),
+		HeldBridgeTransactions: collections.NewKeySet(
+			sb,
+			types.HeldBridgeTransactionsPrefix,
+			"held_bridge_transactions",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		),
+		HeldBridgeReleaseCursor: collections.NewItem(
+			sb,
+			types.HeldBridgeReleaseCursorPrefix,
+			"held_bridge_release_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		),
 		WrappedTokenMetadataMap: collections.NewMap(
 			sb,
 			types.WrappedTokenMetadataPrefix,
@@ -411,6 +515,12 @@ func (k Keeper) Logger() log.Logger {
 	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// WithLogger returns a copy of the keeper that logs to logger
+func (k Keeper) WithLogger(logger log.Logger) Keeper {
+	k.logger = logger
+	return k
+}
+
 func (k Keeper) LogInfo(msg string, subSystem types.SubSystem, keyvals ...interface{}) {
 	k.Logger().Info(msg, append(keyvals, "subsystem", subSystem.String())...)
 }
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k Keeper) SetModelAlias(ctx context.Context, alias string, modelId string) error {
+	return k.ModelAliases.Set(ctx, alias, modelId)
+}
+
+func (k Keeper) RemoveModelAlias(ctx context.Context, alias string) error {
+	err := k.ModelAliases.Remove(ctx, alias)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (k Keeper) GetModelAlias(ctx context.Context, alias string) (string, bool) {
+	modelId, err := k.ModelAliases.Get(ctx, alias)
+	if err != nil {
+		return "", false
+	}
+	return modelId, true
+}
+
+func (k Keeper) GetAllModelAliases(ctx context.Context) ([]types.ModelAlias, error) {
+	iter, err := k.ModelAliases.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+	aliases := make([]types.ModelAlias, 0, len(kvs))
+	for _, kv := range kvs {
+		aliases = append(aliases, types.ModelAlias{Alias: kv.Key, ModelId: kv.Value})
+	}
+	return aliases, nil
+}
+
+// ResolveModelId returns the governance model named by a model id or alias. Model ids take precedence over aliases.
+func (k Keeper) ResolveModelId(ctx context.Context, model string) (*types.Model, bool) {
+	if m, found := k.GetGovernanceModel(ctx, model); found {
+		return m, true
+	}
+	modelId, found := k.GetModelAlias(ctx, model)
+	if !found {
+		return nil, false
+	}
+	return k.GetGovernanceModel(ctx, modelId)
+}
+
+// RetireSunsetModels retires every deprecated model whose sunset epoch has been reached by epochIndex
+func (k Keeper) RetireSunsetModels(ctx context.Context, epochIndex uint64) error {
+	models, err := k.GetGovernanceModelsSorted(ctx)
+	if err != nil {
+		return err
+	}
+	for _, model := range models {
+		if model.Status == types.ModelStatus_MODEL_STATUS_DEPRECATED && model.IsRetiredAt(epochIndex) {
+			if err := k.retireModel(ctx, model); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// retireModel marks the model retired and moves aliases pointing at it to its successor, if it has one
+func (k Keeper) retireModel(ctx context.Context, model *types.Model) error {
+	model.Status = types.ModelStatus_MODEL_STATUS_RETIRED
+	k.SetModel(ctx, model)
+
+	if model.SuccessorId != "" {
+		successor, found := k.GetGovernanceModel(ctx, model.SuccessorId)
+		if found && successor.Status != types.ModelStatus_MODEL_STATUS_RETIRED {
+			aliases, err := k.GetAllModelAliases(ctx)
+			if err != nil {
+				return err
+			}
+			for _, alias := range aliases {
+				if alias.ModelId != model.Id {
+					continue
+				}
+				if err := k.SetModelAlias(ctx, alias.Alias, successor.Id); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	k.LogInfo("Model retired", types.Inferences, "model", model.Id, "successor", model.SuccessorId)
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"model_retired",
+			sdk.NewAttribute("model_id", model.Id),
+			sdk.NewAttribute("successor_id", model.SuccessorId),
+			sdk.NewAttribute("sunset_epoch", strconv.FormatUint(model.SunsetEpoch, 10)),
+		))
+	return nil
+}
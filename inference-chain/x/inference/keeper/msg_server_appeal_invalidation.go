@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) AppealInvalidation(goCtx context.Context, msg *types.MsgAppealInvalidation) (*types.MsgAppealInvalidationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	validationParams := k.GetParams(ctx).ValidationParams
+	if validationParams == nil || validationParams.AppealWindowBlocks <= 0 {
+		return nil, types.ErrAppealsDisabled
+	}
+
+	inference, found := k.GetInference(ctx, msg.InferenceId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInferenceNotFound, "inference with id %s not found", msg.InferenceId)
+	}
+	if inference.ExecutedBy != msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "only the executor %s can appeal", inference.ExecutedBy)
+	}
+	if inference.Status != types.InferenceStatus_INVALIDATED {
+		return nil, types.ErrInferenceNotInvalidated
+	}
+	if ctx.BlockHeight() > inference.InvalidatedAtBlockHeight+validationParams.AppealWindowBlocks {
+		return nil, errorsmod.Wrapf(types.ErrAppealWindowClosed, "invalidated at %d, window is %d blocks", inference.InvalidatedAtBlockHeight, validationParams.AppealWindowBlocks)
+	}
+	if _, found := k.GetInvalidationAppeal(ctx, msg.InferenceId); found {
+		return nil, types.ErrAppealAlreadyExists
+	}
+
+	epochIndex, found := k.GetEffectiveEpochIndex(ctx)
+	if !found {
+		return nil, types.ErrEffectiveEpochNotFound
+	}
+	validators := k.sampleAppealValidators(ctx, epochIndex, inference, int(validationParams.AppealValidatorCount))
+	if len(validators) == 0 {
+		return nil, types.ErrNoAppealValidators
+	}
+
+	if validationParams.AppealBond > 0 {
+		coins, err := types.GetCoins(validationParams.AppealBond)
+		if err != nil {
+			return nil, err
+		}
+		err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, sdk.MustAccAddressFromBech32(msg.Creator), types.ModuleName, coins, "appeal_bond:"+inference.InferenceId)
+		if err != nil {
+			k.LogError("Failed to escrow appeal bond", types.Validation, "inferenceId", inference.InferenceId, "error", err)
+			return nil, err
+		}
+	}
+
+	appeal := types.InvalidationAppeal{
+		InferenceId:         inference.InferenceId,
+		Executor:            inference.ExecutedBy,
+		Invalidator:         inference.InvalidatedBy,
+		Bond:                validationParams.AppealBond,
+		OpenedAtBlockHeight: ctx.BlockHeight(),
+		DeadlineBlockHeight: ctx.BlockHeight() + validationParams.AppealVotingPeriodBlocks,
+		AssignedValidators:  validators,
+		Status:              types.AppealStatus_APPEAL_STATUS_PENDING,
+		EpochIndex:          epochIndex,
+	}
+	if err := k.OpenInvalidationAppeal(ctx, appeal); err != nil {
+		return nil, err
+	}
+
+	k.LogInfo("Invalidation appealed", types.Validation, "inferenceId", inference.InferenceId, "executor", msg.Creator, "validators", validators, "deadline", appeal.DeadlineBlockHeight)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"inference_appeal_opened",
+			sdk.NewAttribute("inference_id", inference.InferenceId),
+			sdk.NewAttribute("executor", appeal.Executor),
+			sdk.NewAttribute("invalidator", appeal.Invalidator),
+			sdk.NewAttribute("validators", strings.Join(validators, ",")),
+			sdk.NewAttribute("deadline_block_height", strconv.FormatInt(appeal.DeadlineBlockHeight, 10)),
+		))
+
+	return &types.MsgAppealInvalidationResponse{
+		DeadlineBlockHeight: appeal.DeadlineBlockHeight,
+		AssignedValidators:  validators,
+	}, nil
+}
+
+// sampleAppealValidators picks validators for the inference's model, weighted by validation weight.
+// Neither the executor nor the original invalidator can be picked.
+func (k msgServer) sampleAppealValidators(ctx sdk.Context, epochIndex uint64, inference types.Inference, count int) []string {
+	groupData, found := k.GetEpochGroupData(ctx, epochIndex, inference.Model)
+	if !found {
+		k.LogError("Epoch group data not found for appeal", types.Validation, "epochIndex", epochIndex, "model", inference.Model)
+		return nil
+	}
+	candidates := make([]calculations.WeightedCandidate, 0, len(groupData.ValidationWeights))
+	for _, weight := range groupData.ValidationWeights {
+		if weight.MemberAddress == inference.ExecutedBy || weight.MemberAddress == inference.InvalidatedBy {
+			continue
+		}
+		candidates = append(candidates, calculations.WeightedCandidate{Address: weight.MemberAddress, Weight: weight.Weight})
+	}
+	seed := append(append([]byte{}, ctx.HeaderHash()...), []byte(inference.InferenceId)...)
+	return calculations.SelectAppealValidators(seed, candidates, count)
+}
@@ -0,0 +1,303 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const appealModel = "appeal-model"
+
+type appealFixture struct {
+	executor    string
+	invalidator string
+	validators  []string
+	inferenceId string
+}
+
+// setupAppeal creates an invalidated inference and a model sub-group with three possible appeal validators
+func setupAppeal(t *testing.T, k keeper.Keeper, ctx sdk.Context, mocks *keepertest.InferenceMocks) appealFixture {
+	params := types.DefaultParams()
+	params.ValidationParams.AppealBond = 1_000
+	params.ValidationParams.AppealValidatorCount = 3
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, setEffectiveEpoch(ctx, k, 1, mocks))
+
+	f := appealFixture{
+		executor:    sample.AccAddress(),
+		invalidator: sample.AccAddress(),
+		validators:  []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()},
+		inferenceId: "appealed-inference",
+	}
+
+	parent, found := k.GetEpochGroupData(ctx, 1, "")
+	require.True(t, found)
+	parent.SubGroupModels = append(parent.SubGroupModels, appealModel)
+	k.SetEpochGroupData(ctx, parent)
+
+	weights := []*types.ValidationWeight{
+		{MemberAddress: f.executor, Weight: 100},
+		{MemberAddress: f.invalidator, Weight: 100},
+	}
+	for _, validator := range f.validators {
+		weights = append(weights, &types.ValidationWeight{MemberAddress: validator, Weight: 10})
+	}
+	k.SetEpochGroupData(ctx, types.EpochGroupData{
+		EpochIndex:        1,
+		ModelId:           appealModel,
+		ValidationWeights: weights,
+		ModelSnapshot:     &types.Model{Id: appealModel, ValidationThreshold: &types.Decimal{Value: 85, Exponent: -2}},
+	})
+
+	require.NoError(t, k.SetParticipant(ctx, types.Participant{
+		Index:                        f.executor,
+		Address:                      f.executor,
+		Status:                       types.ParticipantStatus_ACTIVE,
+		ConsecutiveInvalidInferences: 2,
+		CurrentEpochStats:            &types.CurrentEpochStats{InvalidatedInferences: 1},
+	}))
+	require.NoError(t, k.SetInference(ctx, types.Inference{
+		Index:                    f.inferenceId,
+		InferenceId:              f.inferenceId,
+		ExecutedBy:               f.executor,
+		Model:                    appealModel,
+		Status:                   types.InferenceStatus_INVALIDATED,
+		InvalidatedBy:            f.invalidator,
+		InvalidatedAtBlockHeight: ctx.BlockHeight(),
+		EpochId:                  1,
+	}))
+	return f
+}
+
+func TestAppealInvalidation_OpensAppealWithSampledValidators(t *testing.T) {
+	k, ms, ctx, mocks := setupInvalidateHarness(t)
+	f := setupAppeal(t, k, ctx, mocks)
+
+	executorAcc := sdk.MustAccAddressFromBech32(f.executor)
+	mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), executorAcc, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 1_000)), gomock.Any()).Return(nil).Times(1)
+
+	resp, err := ms.AppealInvalidation(ctx, &types.MsgAppealInvalidation{Creator: f.executor, InferenceId: f.inferenceId})
+	require.NoError(t, err)
+	require.ElementsMatch(t, f.validators, resp.AssignedValidators)
+
+	appeal, found := k.GetInvalidationAppeal(ctx, f.inferenceId)
+	require.True(t, found)
+	require.Equal(t, types.AppealStatus_APPEAL_STATUS_PENDING, appeal.Status)
+	require.Equal(t, f.invalidator, appeal.Invalidator)
+	require.NotContains(t, appeal.AssignedValidators, f.executor)
+	require.NotContains(t, appeal.AssignedValidators, f.invalidator)
+
+	_, err = ms.AppealInvalidation(ctx, &types.MsgAppealInvalidation{Creator: f.executor, InferenceId: f.inferenceId})
+	require.ErrorIs(t, err, types.ErrAppealAlreadyExists)
+}
+
+func TestAppealInvalidation_Rejected(t *testing.T) {
+	k, ms, ctx, mocks := setupInvalidateHarness(t)
+	f := setupAppeal(t, k, ctx, mocks)
+
+	_, err := ms.AppealInvalidation(ctx, &types.MsgAppealInvalidation{Creator: f.invalidator, InferenceId: f.inferenceId})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	params := k.GetParams(ctx)
+	lateCtx := ctx.WithBlockHeight(ctx.BlockHeight() + params.ValidationParams.AppealWindowBlocks + 1)
+	_, err = ms.AppealInvalidation(lateCtx, &types.MsgAppealInvalidation{Creator: f.executor, InferenceId: f.inferenceId})
+	require.ErrorIs(t, err, types.ErrAppealWindowClosed)
+
+	params.ValidationParams.AppealWindowBlocks = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = ms.AppealInvalidation(ctx, &types.MsgAppealInvalidation{Creator: f.executor, InferenceId: f.inferenceId})
+	require.ErrorIs(t, err, types.ErrAppealsDisabled)
+}
+
+func TestSubmitAppealVote_MajorityReversesInvalidation(t *testing.T) {
+	k, ms, ctx, mocks := setupInvalidateHarness(t)
+	f := setupAppeal(t, k, ctx, mocks)
+
+	mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	_, err := ms.AppealInvalidation(ctx, &types.MsgAppealInvalidation{Creator: f.executor, InferenceId: f.inferenceId})
+	require.NoError(t, err)
+
+	_, err = ms.SubmitAppealVote(ctx, &types.MsgSubmitAppealVote{Creator: f.invalidator, InferenceId: f.inferenceId, Value: 0.99})
+	require.ErrorIs(t, err, types.ErrNotAppealValidator)
+
+	_, err = ms.SubmitAppealVote(ctx, &types.MsgSubmitAppealVote{Creator: f.validators[0], InferenceId: f.inferenceId, Value: 0.99})
+	require.NoError(t, err)
+	_, err = ms.SubmitAppealVote(ctx, &types.MsgSubmitAppealVote{Creator: f.validators[0], InferenceId: f.inferenceId, Value: 0.99})
+	require.ErrorIs(t, err, types.ErrDuplicateValidation)
+
+	// The second passing vote is a majority: bond returned, invalidator slashed, executor restored
+	executorAcc := sdk.MustAccAddressFromBech32(f.executor)
+	invalidatorAcc := sdk.MustAccAddressFromBech32(f.invalidator)
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, executorAcc, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 1_000)), gomock.Any()).Return(nil).Times(1)
+	mocks.CollateralKeeper.EXPECT().SlashWithBeneficiaries(gomock.Any(), invalidatorAcc, math.LegacyNewDecWithPrec(1, 2), types.SlashReasonFalseInvalidation, []sdk.AccAddress{executorAcc}).Return(sdk.NewInt64Coin(types.BaseCoin, 10), nil).Times(1)
+
+	_, err = ms.SubmitAppealVote(ctx, &types.MsgSubmitAppealVote{Creator: f.validators[1], InferenceId: f.inferenceId, Value: 0.95})
+	require.NoError(t, err)
+
+	appeal, found := k.GetInvalidationAppeal(ctx, f.inferenceId)
+	require.True(t, found)
+	require.Equal(t, types.AppealStatus_APPEAL_STATUS_REVERSED, appeal.Status)
+
+	inference, found := k.GetInference(ctx, f.inferenceId)
+	require.True(t, found)
+	require.Equal(t, types.InferenceStatus_VALIDATED, inference.Status)
+
+	executor, found := k.GetParticipant(ctx, f.executor)
+	require.True(t, found)
+	require.Equal(t, int64(1), executor.ConsecutiveInvalidInferences)
+	require.Equal(t, uint64(0), executor.CurrentEpochStats.InvalidatedInferences)
+	require.Equal(t, uint64(1), executor.CurrentEpochStats.ValidatedInferences)
+
+	_, err = ms.SubmitAppealVote(ctx, &types.MsgSubmitAppealVote{Creator: f.validators[2], InferenceId: f.inferenceId, Value: 0.95})
+	require.ErrorIs(t, err, types.ErrAppealNotPending)
+}
+
+func TestResolveExpiredAppeals(t *testing.T) {
+	k, ms, ctx, mocks := setupInvalidateHarness(t)
+	f := setupAppeal(t, k, ctx, mocks)
+
+	mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	resp, err := ms.AppealInvalidation(ctx, &types.MsgAppealInvalidation{Creator: f.executor, InferenceId: f.inferenceId})
+	require.NoError(t, err)
+
+	// One failing vote is not yet decisive
+	_, err = ms.SubmitAppealVote(ctx, &types.MsgSubmitAppealVote{Creator: f.validators[0], InferenceId: f.inferenceId, Value: 0.1})
+	require.NoError(t, err)
+
+	k.ResolveExpiredAppeals(ctx, resp.DeadlineBlockHeight-1)
+	appeal, _ := k.GetInvalidationAppeal(ctx, f.inferenceId)
+	require.Equal(t, types.AppealStatus_APPEAL_STATUS_PENDING, appeal.Status)
+
+	// At the deadline missing votes uphold the invalidation and the bond is burned
+	mocks.BankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 1_000)), gomock.Any()).Return(nil).Times(1)
+	deadlineCtx := ctx.WithBlockHeight(resp.DeadlineBlockHeight)
+	k.ResolveExpiredAppeals(deadlineCtx, resp.DeadlineBlockHeight)
+
+	appeal, _ = k.GetInvalidationAppeal(ctx, f.inferenceId)
+	require.Equal(t, types.AppealStatus_APPEAL_STATUS_UPHELD, appeal.Status)
+	inference, _ := k.GetInference(ctx, f.inferenceId)
+	require.Equal(t, types.InferenceStatus_INVALIDATED, inference.Status)
+
+	// The resolved appeal is removed once the appeal window has closed
+	_, err = ms.AppealInvalidation(deadlineCtx, &types.MsgAppealInvalidation{Creator: f.executor, InferenceId: f.inferenceId})
+	require.Error(t, err)
+	k.ResolveExpiredAppeals(ctx.WithBlockHeight(resp.DeadlineBlockHeight+1), resp.DeadlineBlockHeight+1)
+	_, found := k.GetInvalidationAppeal(ctx, f.inferenceId)
+	require.False(t, found)
+}
+
+func TestSubmitAppealVote_ReversalUndoesInvalidationEffects(t *testing.T) {
+	k, ms, ctx, mocks := setupInvalidateHarness(t)
+	f := setupAppeal(t, k, ctx, mocks)
+	requester := sample.AccAddress()
+
+	inference, _ := k.GetInference(ctx, f.inferenceId)
+	inference.RequestedBy = requester
+	inference.ActualCost = 500
+	require.NoError(t, k.SetInference(ctx, inference))
+	executor, _ := k.GetParticipant(ctx, f.executor)
+	executor.Status = types.ParticipantStatus_INVALID
+	executor.EpochsCompleted = 2
+	executor.CoinBalance = 100
+	require.NoError(t, k.SetParticipant(ctx, executor))
+	executorAcc := sdk.MustAccAddressFromBech32(f.executor)
+	require.NoError(t, k.ExcludedParticipantsMap.Set(ctx, collections.Join(uint64(1), executorAcc), types.ExcludedParticipant{Address: f.executor, EpochIndex: 1}))
+	require.NoError(t, k.InvalidationEffectsMap.Set(ctx, f.inferenceId, types.InvalidationEffects{
+		InferenceId:           f.inferenceId,
+		RefundedAmount:        500,
+		ExecutorInvalidated:   true,
+		EpochsCompletedBefore: 4,
+		EpochIndex:            1,
+	}))
+
+	mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), executorAcc, types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	_, err := ms.AppealInvalidation(ctx, &types.MsgAppealInvalidation{Creator: f.executor, InferenceId: f.inferenceId})
+	require.NoError(t, err)
+
+	// The requester is charged again for the inference, now judged valid
+	requesterAcc := sdk.MustAccAddressFromBech32(requester)
+	mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), requesterAcc, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 500)), gomock.Any()).Return(nil).Times(1)
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, executorAcc, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mocks.CollateralKeeper.EXPECT().SlashWithBeneficiaries(gomock.Any(), gomock.Any(), gomock.Any(), types.SlashReasonFalseInvalidation, gomock.Any()).Return(sdk.NewInt64Coin(types.BaseCoin, 10), nil).Times(1)
+
+	for _, validator := range f.validators[:2] {
+		_, err = ms.SubmitAppealVote(ctx, &types.MsgSubmitAppealVote{Creator: validator, InferenceId: f.inferenceId, Value: 0.99})
+		require.NoError(t, err)
+	}
+
+	executor, _ = k.GetParticipant(ctx, f.executor)
+	require.Equal(t, types.ParticipantStatus_ACTIVE, executor.Status)
+	require.Equal(t, int64(600), executor.CoinBalance)
+	require.Equal(t, uint32(4), executor.EpochsCompleted)
+	require.Equal(t, int64(1), executor.ConsecutiveInvalidInferences)
+	has, err := k.ExcludedParticipantsMap.Has(ctx, collections.Join(uint64(1), executorAcc))
+	require.NoError(t, err)
+	require.False(t, has)
+	_, err = k.InvalidationEffectsMap.Get(ctx, f.inferenceId)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestSubmitAppealVote_ReversalReclaimsCompensation(t *testing.T) {
+	k, ms, ctx, mocks := setupInvalidateHarness(t)
+	f := setupAppeal(t, k, ctx, mocks)
+	requester := sample.AccAddress()
+
+	inference, _ := k.GetInference(ctx, f.inferenceId)
+	inference.RequestedBy = requester
+	inference.ActualCost = 500
+	require.NoError(t, k.SetInference(ctx, inference))
+	require.NoError(t, k.InvalidationEffectsMap.Set(ctx, f.inferenceId, types.InvalidationEffects{
+		InferenceId:       f.inferenceId,
+		RefundedAmount:    500,
+		CompensatedAmount: 250,
+		EpochIndex:        1,
+	}))
+
+	executorAcc := sdk.MustAccAddressFromBech32(f.executor)
+	mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), executorAcc, types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	_, err := ms.AppealInvalidation(ctx, &types.MsgAppealInvalidation{Creator: f.executor, InferenceId: f.inferenceId})
+	require.NoError(t, err)
+
+	// The requester pays for the inference again and returns the compensation to the pool
+	requesterAcc := sdk.MustAccAddressFromBech32(requester)
+	mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), requesterAcc, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 500)), gomock.Any()).Return(nil).Times(1)
+	mocks.CollateralKeeper.EXPECT().ReclaimCompensation(gomock.Any(), requesterAcc, sdk.NewInt64Coin(types.BaseCoin, 250), gomock.Any()).Return(nil).Times(1)
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, executorAcc, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mocks.CollateralKeeper.EXPECT().SlashWithBeneficiaries(gomock.Any(), gomock.Any(), gomock.Any(), types.SlashReasonFalseInvalidation, gomock.Any()).Return(sdk.NewInt64Coin(types.BaseCoin, 10), nil).Times(1)
+
+	for _, validator := range f.validators[:2] {
+		_, err = ms.SubmitAppealVote(ctx, &types.MsgSubmitAppealVote{Creator: validator, InferenceId: f.inferenceId, Value: 0.99})
+		require.NoError(t, err)
+	}
+
+	appeal, found := k.GetInvalidationAppeal(ctx, f.inferenceId)
+	require.True(t, found)
+	require.Equal(t, types.AppealStatus_APPEAL_STATUS_REVERSED, appeal.Status)
+}
+
+func TestAppealOutcome(t *testing.T) {
+	appeal := types.InvalidationAppeal{AssignedValidators: []string{"a", "b", "c"}}
+	require.Equal(t, types.AppealStatus_APPEAL_STATUS_PENDING, keeper.AppealOutcome(appeal, false))
+	require.Equal(t, types.AppealStatus_APPEAL_STATUS_EXPIRED, keeper.AppealOutcome(appeal, true))
+
+	appeal.Votes = []*types.AppealVote{{Validator: "a", Passed: false}, {Validator: "b", Passed: false}}
+	require.Equal(t, types.AppealStatus_APPEAL_STATUS_UPHELD, keeper.AppealOutcome(appeal, false))
+
+	appeal.Votes = []*types.AppealVote{{Validator: "a", Passed: true}, {Validator: "b", Passed: false}}
+	require.Equal(t, types.AppealStatus_APPEAL_STATUS_PENDING, keeper.AppealOutcome(appeal, false))
+	require.Equal(t, types.AppealStatus_APPEAL_STATUS_UPHELD, keeper.AppealOutcome(appeal, true))
+
+	appeal.Votes = append(appeal.Votes, &types.AppealVote{Validator: "c", Passed: true})
+	require.Equal(t, types.AppealStatus_APPEAL_STATUS_REVERSED, keeper.AppealOutcome(appeal, false))
+}
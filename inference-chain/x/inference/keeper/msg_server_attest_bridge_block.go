@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// AttestBridgeBlock records a validator vote for the receipts root of an origin chain block.
+// Votes are weighted by the power of the epoch in which the root was first proposed, and the first
+// root to gather a majority becomes the attested root of the block. Each validator gets one vote per
+// block, so it cannot back two competing roots.
+func (k msgServer) AttestBridgeBlock(goCtx context.Context, msg *types.MsgAttestBridgeBlock) (*types.MsgAttestBridgeBlockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator address: %v", err)
+	}
+	receiptsRoot, err := types.NormalizeReceiptsRoot(msg.ReceiptsRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	if attestedRoot, found := k.GetAttestedReceiptsRoot(ctx, msg.OriginChain, msg.BlockNumber); found {
+		if attestedRoot != receiptsRoot {
+			k.LogError("Bridge attestation: Vote conflicts with attested receipts root", types.Messages,
+				"validator", msg.Validator,
+				"originChain", msg.OriginChain,
+				"blockNumber", msg.BlockNumber,
+				"attestedRoot", attestedRoot,
+				"proposedRoot", receiptsRoot)
+			return nil, fmt.Errorf("block %s already has attested receipts root %s", msg.BlockNumber, attestedRoot)
+		}
+		return &types.MsgAttestBridgeBlockResponse{Attested: true}, nil
+	}
+
+	if votedRoot, voted := k.GetBridgeBlockVote(ctx, msg.OriginChain, msg.BlockNumber, addr); voted {
+		if votedRoot == receiptsRoot {
+			return nil, fmt.Errorf("validator has already attested this receipts root")
+		}
+		k.LogError("Bridge attestation: Validator voted for a second receipts root", types.Messages,
+			"validator", msg.Validator,
+			"originChain", msg.OriginChain,
+			"blockNumber", msg.BlockNumber,
+			"votedRoot", votedRoot,
+			"proposedRoot", receiptsRoot)
+		return nil, fmt.Errorf("validator already voted for receipts root %s in block %s", votedRoot, msg.BlockNumber)
+	}
+
+	attestation, found := k.GetBridgeBlockAttestation(ctx, msg.OriginChain, msg.BlockNumber, receiptsRoot)
+	if !found {
+		effectiveEpochIndex, found := k.GetEffectiveEpochIndex(ctx)
+		if !found {
+			return nil, types.ErrEffectiveEpochNotFound
+		}
+		attestation = &types.BridgeBlockAttestation{
+			ChainId:      msg.OriginChain,
+			BlockNumber:  msg.BlockNumber,
+			ReceiptsRoot: receiptsRoot,
+			EpochIndex:   effectiveEpochIndex,
+		}
+	}
+
+	epochGroup, err := k.GetEpochGroup(goCtx, attestation.EpochIndex, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get epoch group for attestation: %v", err)
+	}
+	power, isMember, err := epochGroupMemberPower(ctx, epochGroup, addr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get epoch group members: %v", err)
+	}
+	if !isMember {
+		k.LogError("Bridge attestation: Validator not in attestation's epoch group", types.Messages,
+			"validator", msg.Validator, "epochIndex", attestation.EpochIndex)
+		return nil, fmt.Errorf("validator not in attestation's epoch group")
+	}
+
+	attestation.Validators = append(attestation.Validators, addr.String())
+	attestation.TotalValidationPower += power
+	attestation.Attested = hasMajority(epochGroup, attestation.TotalValidationPower)
+	if err := k.SetBridgeBlockAttestation(ctx, attestation); err != nil {
+		return nil, err
+	}
+	if err := k.BridgeBlockVotes.Set(ctx, collections.Join3(msg.OriginChain, msg.BlockNumber, addr), receiptsRoot); err != nil {
+		return nil, err
+	}
+	if attestation.Attested {
+		// Only the attested root is needed to verify receipt proofs, the votes and competing roots can go
+		if err := k.removeBridgeBlockVoting(ctx, msg.OriginChain, msg.BlockNumber, func(candidate types.BridgeBlockAttestation) bool {
+			return !candidate.Attested
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	k.LogInfo("Bridge attestation: Vote recorded", types.Messages,
+		"validator", msg.Validator,
+		"originChain", msg.OriginChain,
+		"blockNumber", msg.BlockNumber,
+		"receiptsRoot", receiptsRoot,
+		"powerReceived", attestation.TotalValidationPower,
+		"totalEpochPower", epochGroup.GroupData.TotalWeight,
+		"attested", attestation.Attested)
+
+	if attestation.Attested {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"bridge_block_attested",
+				sdk.NewAttribute("origin_chain", msg.OriginChain),
+				sdk.NewAttribute("block_number", msg.BlockNumber),
+				sdk.NewAttribute("receipts_root", receiptsRoot),
+			))
+	}
+
+	return &types.MsgAttestBridgeBlockResponse{Attested: attestation.Attested}, nil
+}
@@ -70,6 +70,11 @@ func (k msgServer) BridgeExchange(goCtx context.Context, msg *types.MsgBridgeExc
 		// Status and other fields will be set later
 	}
 
+	// A receipt proof completes the deposit on its own, without waiting for validator votes
+	if len(msg.ReceiptProof) > 0 {
+		return k.completeBridgeExchangeWithProof(ctx, msg, proposedTx, addr)
+	}
+
 	// Check if this exact transaction content has already been processed
 	existingTx, found := k.GetBridgeTransactionByContent(ctx, proposedTx)
 	if found {
@@ -164,14 +169,22 @@ func (k msgServer) BridgeExchange(goCtx context.Context, msg *types.MsgBridgeExc
 		// Check if we have majority (50+% of total power)
 		requiredPower := (totalEpochPower / 2) + 1
 
-		if existingTx.TotalValidationPower >= requiredPower {
+		if existingTx.TotalValidationPower >= requiredPower && k.IsBridgeReceiptProofRequired(ctx) {
+			k.LogInfo("Bridge exchange: transaction has majority votes, awaiting receipt proof",
+				types.Messages,
+				"originChain", msg.OriginChain,
+				"blockNumber", msg.BlockNumber,
+				"receiptIndex", msg.ReceiptIndex,
+				"powerReceived", existingTx.TotalValidationPower,
+				"totalEpochPower", totalEpochPower)
+		} else if existingTx.TotalValidationPower >= requiredPower {
 			// Only process completion once to avoid duplicate mints
 			if existingTx.Status == types.BridgeTransactionStatus_BRIDGE_PENDING {
-				existingTx.Status = types.BridgeTransactionStatus_BRIDGE_COMPLETED
-				k.SetBridgeTransaction(ctx, existingTx)
-
-				// Handle token minting for completed transaction
-				if err := k.handleCompletedBridgeTransaction(ctx, existingTx); err != nil {
+				if k.isBridgeReceiptCompleted(ctx, existingTx.ChainId, existingTx.BlockNumber, existingTx.ReceiptIndex) {
+					return nil, fmt.Errorf("receipt %s/%s/%s has already been completed", existingTx.ChainId, existingTx.BlockNumber, existingTx.ReceiptIndex)
+				}
+				// Mint or release the tokens, unless the chain is paused or the transfer exceeds a rate limit
+				if err := k.releaseBridgeTransaction(ctx, existingTx); err != nil {
 					k.LogError("Bridge exchange: Failed to handle completed bridge transaction",
 						types.Messages,
 						"error", err,
@@ -279,3 +292,64 @@ func (k msgServer) BridgeExchange(goCtx context.Context, msg *types.MsgBridgeExc
 		Id: proposedTx.Id,
 	}, nil
 }
+
+// completeBridgeExchangeWithProof completes a deposit whose receipt is proven against the attested receipts
+// root of its block. Votes already collected for the same content are kept on the completed transaction.
+func (k msgServer) completeBridgeExchangeWithProof(ctx sdk.Context, msg *types.MsgBridgeExchange, proposedTx *types.BridgeTransaction, submitter sdk.AccAddress) (*types.MsgBridgeExchangeResponse, error) {
+	if err := k.verifyBridgeReceiptProof(ctx, msg); err != nil {
+		k.LogError("Bridge exchange: Receipt proof rejected", types.Messages,
+			"originChain", msg.OriginChain,
+			"blockNumber", msg.BlockNumber,
+			"receiptIndex", msg.ReceiptIndex,
+			"error", err)
+		return nil, err
+	}
+	if k.isBridgeReceiptCompleted(ctx, msg.OriginChain, msg.BlockNumber, msg.ReceiptIndex) {
+		return nil, fmt.Errorf("receipt %s/%s/%s has already been completed", msg.OriginChain, msg.BlockNumber, msg.ReceiptIndex)
+	}
+
+	bridgeTx, found := k.GetBridgeTransactionByContent(ctx, proposedTx)
+	if !found {
+		effectiveEpochIndex, found := k.GetEffectiveEpochIndex(ctx)
+		if !found {
+			return nil, types.ErrEffectiveEpochNotFound
+		}
+		bridgeTx = proposedTx
+		bridgeTx.EpochIndex = effectiveEpochIndex
+		bridgeTx.Validators = []string{submitter.String()}
+	}
+	if err := k.releaseBridgeTransaction(ctx, bridgeTx); err != nil {
+		k.LogError("Bridge exchange: Failed to handle completed bridge transaction",
+			types.Messages,
+			"error", err,
+			"originChain", msg.OriginChain,
+			"blockNumber", msg.BlockNumber,
+			"receiptIndex", msg.ReceiptIndex)
+		return nil, err
+	}
+
+	k.LogInfo("Bridge exchange: transaction completed with receipt proof",
+		types.Messages,
+		"originChain", msg.OriginChain,
+		"blockNumber", msg.BlockNumber,
+		"receiptIndex", msg.ReceiptIndex,
+		"submitter", msg.Validator,
+		"amount", msg.Amount,
+		"status", bridgeTx.Status,
+		"uniqueId", bridgeTx.Id)
+
+	return &types.MsgBridgeExchangeResponse{
+		Id: bridgeTx.Id,
+	}, nil
+}
+
+// isBridgeReceiptCompleted reports whether any transaction for the receipt location has completed or is held
+// for release, whatever its content
+func (k msgServer) isBridgeReceiptCompleted(ctx sdk.Context, chainId, blockNumber, receiptIndex string) bool {
+	for _, tx := range k.GetBridgeTransactionsByReceipt(ctx, chainId, blockNumber, receiptIndex) {
+		if tx.Status == types.BridgeTransactionStatus_BRIDGE_COMPLETED || tx.Status == types.BridgeTransactionStatus_BRIDGE_HELD {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	dcrsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/inference/bridgeproof"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func topicFromAddress(address []byte) []byte {
+	return append(make([]byte, 12), address...)
+}
+
+func uint256(value int64) []byte {
+	return big.NewInt(value).FillBytes(make([]byte, 32))
+}
+
+func TestBridgeExchange_ReceiptProof(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocks(t)
+
+	validators := []string{sample.AccAddress(), sample.AccAddress()}
+	k.SetEffectiveEpochIndex(ctx, 1)
+	k.SetEpochGroupData(ctx, types.EpochGroupData{EpochIndex: 1, EpochGroupId: 1, TotalWeight: 20})
+	members := make([]*group.GroupMember, 0, len(validators))
+	for _, validator := range validators {
+		members = append(members, &group.GroupMember{GroupId: 1, Member: &group.Member{Address: validator, Weight: "10"}})
+	}
+	mocks.GroupKeeper.EXPECT().GroupMembers(ctx, gomock.Any()).Return(&group.QueryGroupMembersResponse{Members: members}, nil).AnyTimes()
+	mocks.AccountKeeper.EXPECT().GetAccount(ctx, gomock.Any()).Return(&authtypes.BaseAccount{}).AnyTimes()
+
+	bridgeContract := bytes.Repeat([]byte{0xb1}, 20)
+	k.SetBridgeContractAddress(ctx, types.BridgeContractAddress{ChainId: "ethereum", Address: "0x" + hex.EncodeToString(bridgeContract)})
+
+	// The owner's Gonka and Ethereum addresses are derived from the same key
+	ownerKey := secp256k1.GenPrivKey()
+	ownerPubKey, err := dcrsecp256k1.ParsePubKey(ownerKey.PubKey().Bytes())
+	require.NoError(t, err)
+	ownerEthAddress := bridgeproof.Keccak256(ownerPubKey.SerializeUncompressed()[1:])[12:]
+	ownerAddress := sdk.AccAddress(ownerKey.PubKey().Address()).String()
+
+	burned := bridgeproof.Log{
+		Address: bridgeContract,
+		Topics:  [][]byte{bridgeproof.Keccak256([]byte("WGNKBurned(address,uint256,uint256)")), topicFromAddress(ownerEthAddress)},
+		Data:    append(uint256(500), uint256(1_700_000_000)...),
+	}
+	receipts := [][]byte{
+		bridgeproof.EncodeReceipt(2, true, 21000, make([]byte, 256), nil),
+		bridgeproof.EncodeReceipt(2, true, 60000, make([]byte, 256), []bridgeproof.Log{burned}),
+		bridgeproof.EncodeReceipt(2, false, 90000, make([]byte, 256), []bridgeproof.Log{burned}),
+	}
+	root, proof := bridgeproof.ReceiptProof(receipts, 1)
+	receiptsRoot := "0x" + hex.EncodeToString(root)
+
+	msg := &types.MsgBridgeExchange{
+		Validator:       sample.AccAddress(),
+		OriginChain:     "ethereum",
+		ContractAddress: "0x" + hex.EncodeToString(bridgeContract),
+		OwnerAddress:    ownerAddress,
+		OwnerPubKey:     "0x" + hex.EncodeToString(ownerKey.PubKey().Bytes()),
+		Amount:          "500",
+		BlockNumber:     "1000",
+		ReceiptIndex:    "1",
+		ReceiptsRoot:    receiptsRoot,
+		ReceiptProof:    proof,
+	}
+
+	// The proof is useless until validators holding a majority attest the block's receipts root
+	_, err = ms.BridgeExchange(ctx, msg)
+	require.ErrorIs(t, err, types.ErrReceiptsRootNotAttested)
+
+	resp, err := ms.AttestBridgeBlock(ctx, &types.MsgAttestBridgeBlock{Validator: validators[0], OriginChain: "ethereum", BlockNumber: "1000", ReceiptsRoot: receiptsRoot})
+	require.NoError(t, err)
+	require.False(t, resp.Attested)
+	_, err = ms.AttestBridgeBlock(ctx, &types.MsgAttestBridgeBlock{Validator: validators[0], OriginChain: "ethereum", BlockNumber: "1000", ReceiptsRoot: receiptsRoot})
+	require.ErrorContains(t, err, "already attested")
+	resp, err = ms.AttestBridgeBlock(ctx, &types.MsgAttestBridgeBlock{Validator: validators[1], OriginChain: "ethereum", BlockNumber: "1000", ReceiptsRoot: receiptsRoot})
+	require.NoError(t, err)
+	require.True(t, resp.Attested)
+
+	// Once attested, votes for a different root are refused
+	_, err = ms.AttestBridgeBlock(ctx, &types.MsgAttestBridgeBlock{Validator: validators[1], OriginChain: "ethereum", BlockNumber: "1000", ReceiptsRoot: "0x" + hex.EncodeToString(bytes.Repeat([]byte{1}, 32))})
+	require.ErrorContains(t, err, "already has attested receipts root")
+
+	// A deposit that does not match the proven receipt is rejected
+	inflated := *msg
+	inflated.Amount = "5000"
+	_, err = ms.BridgeExchange(ctx, &inflated)
+	require.ErrorIs(t, err, types.ErrInvalidReceiptProof)
+
+	otherOwner := *msg
+	otherOwner.OwnerAddress = sample.AccAddress()
+	_, err = ms.BridgeExchange(ctx, &otherOwner)
+	require.ErrorIs(t, err, types.ErrInvalidReceiptProof)
+
+	reverted := *msg
+	reverted.ReceiptIndex = "2"
+	_, reverted.ReceiptProof = bridgeproof.ReceiptProof(receipts, 2)
+	_, err = ms.BridgeExchange(ctx, &reverted)
+	require.ErrorIs(t, err, types.ErrInvalidReceiptProof)
+
+	// A single message with a valid proof completes the deposit
+	escrow := authtypes.NewModuleAddress(types.BridgeEscrowAccName)
+	mocks.AccountKeeper.EXPECT().GetModuleAddress(types.BridgeEscrowAccName).Return(escrow).AnyTimes()
+	mocks.BankViewKeeper.EXPECT().SpendableCoin(ctx, escrow, types.BaseCoin).Return(sdk.NewInt64Coin(types.BaseCoin, 1000))
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.BridgeEscrowAccName, sdk.MustAccAddressFromBech32(ownerAddress), sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 500)), "bridge_release").Return(nil)
+
+	_, err = ms.BridgeExchange(ctx, msg)
+	require.NoError(t, err)
+	transactions := k.GetBridgeTransactionsByReceipt(ctx, "ethereum", "1000", "1")
+	require.Len(t, transactions, 1)
+	require.Equal(t, types.BridgeTransactionStatus_BRIDGE_COMPLETED, transactions[0].Status)
+
+	_, err = ms.BridgeExchange(ctx, msg)
+	require.ErrorContains(t, err, "already been completed")
+}
+
+func TestBridgeExchange_VotesAwaitReceiptProof(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocks(t)
+
+	validators := []string{sample.AccAddress(), sample.AccAddress()}
+	k.SetEffectiveEpochIndex(ctx, 1)
+	k.SetEpochGroupData(ctx, types.EpochGroupData{EpochIndex: 1, EpochGroupId: 1, TotalWeight: 20})
+	members := make([]*group.GroupMember, 0, len(validators))
+	for _, validator := range validators {
+		members = append(members, &group.GroupMember{GroupId: 1, Member: &group.Member{Address: validator, Weight: "10"}})
+	}
+	mocks.GroupKeeper.EXPECT().GroupMembers(ctx, gomock.Any()).Return(&group.QueryGroupMembersResponse{Members: members}, nil).AnyTimes()
+	mocks.AccountKeeper.EXPECT().GetAccount(ctx, gomock.Any()).Return(&authtypes.BaseAccount{}).AnyTimes()
+	require.True(t, k.IsBridgeReceiptProofRequired(ctx))
+
+	// A majority of votes alone no longer completes the deposit
+	for _, validator := range validators {
+		_, err := ms.BridgeExchange(ctx, &types.MsgBridgeExchange{
+			Validator:       validator,
+			OriginChain:     "ethereum",
+			ContractAddress: "0x123",
+			OwnerAddress:    "0xabc",
+			Amount:          "100",
+			BlockNumber:     "1000",
+			ReceiptIndex:    "1",
+			ReceiptsRoot:    "0xroot",
+		})
+		require.NoError(t, err)
+	}
+	transactions := k.GetBridgeTransactionsByReceipt(ctx, "ethereum", "1000", "1")
+	require.Len(t, transactions, 1)
+	require.Equal(t, int64(20), transactions[0].TotalValidationPower)
+	require.Equal(t, types.BridgeTransactionStatus_BRIDGE_PENDING, transactions[0].Status)
+}
+
+func TestBridgeExchange_OneVotePerValidatorPerBlock(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocks(t)
+
+	validators := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	k.SetEffectiveEpochIndex(ctx, 1)
+	k.SetEpochGroupData(ctx, types.EpochGroupData{EpochIndex: 1, EpochGroupId: 1, TotalWeight: 30})
+	members := make([]*group.GroupMember, 0, len(validators))
+	for _, validator := range validators {
+		members = append(members, &group.GroupMember{GroupId: 1, Member: &group.Member{Address: validator, Weight: "10"}})
+	}
+	mocks.GroupKeeper.EXPECT().GroupMembers(ctx, gomock.Any()).Return(&group.QueryGroupMembersResponse{Members: members}, nil).AnyTimes()
+	require.NoError(t, k.PruningState.Set(ctx, types.PruningState{}))
+
+	rootA := "0x" + hex.EncodeToString(bytes.Repeat([]byte{0xa}, 32))
+	rootB := "0x" + hex.EncodeToString(bytes.Repeat([]byte{0xb}, 32))
+	attest := func(validator, blockNumber, root string) (*types.MsgAttestBridgeBlockResponse, error) {
+		return ms.AttestBridgeBlock(ctx, &types.MsgAttestBridgeBlock{Validator: validator, OriginChain: "ethereum", BlockNumber: blockNumber, ReceiptsRoot: root})
+	}
+
+	_, err := attest(validators[0], "1000", rootA)
+	require.NoError(t, err)
+	// A validator cannot back two competing roots for the same block
+	_, err = attest(validators[0], "1000", rootB)
+	require.ErrorContains(t, err, "already voted for receipts root")
+	_, err = attest(validators[1], "1000", rootB)
+	require.NoError(t, err)
+	resp, err := attest(validators[2], "1000", rootA)
+	require.NoError(t, err)
+	require.True(t, resp.Attested)
+
+	// Attesting drops the competing root and the block's votes
+	attestations, err := k.GetBridgeBlockAttestations(ctx, "ethereum", "1000")
+	require.NoError(t, err)
+	require.Len(t, attestations, 1)
+	require.Equal(t, rootA, attestations[0].ReceiptsRoot)
+	for _, validator := range validators {
+		_, voted := k.GetBridgeBlockVote(ctx, "ethereum", "1000", sdk.MustAccAddressFromBech32(validator))
+		require.False(t, voted)
+	}
+
+	// A root that never reaches a majority ages out with its votes, the attested root stays
+	_, err = attest(validators[0], "2000", rootB)
+	require.NoError(t, err)
+	setPruningConfig(ctx, k, PruningSettings{InferenceMaxPrune: 10})
+	require.NoError(t, k.Prune(ctx, 3)) // default threshold 2 prunes epoch 1
+
+	attestations, err = k.GetBridgeBlockAttestations(ctx, "ethereum", "2000")
+	require.NoError(t, err)
+	require.Empty(t, attestations)
+	_, voted := k.GetBridgeBlockVote(ctx, "ethereum", "2000", sdk.MustAccAddressFromBech32(validators[0]))
+	require.False(t, voted)
+	attestedRoot, found := k.GetAttestedReceiptsRoot(ctx, "ethereum", "1000")
+	require.True(t, found)
+	require.Equal(t, rootA, attestedRoot)
+}
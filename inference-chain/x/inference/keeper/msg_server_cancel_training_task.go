@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// CancelTrainingTask stops a training task on behalf of its requester. Outer steps the assignees already worked
+// on and committed checkpoints for are paid for, and the rest of the budget is refunded.
+func (k msgServer) CancelTrainingTask(goCtx context.Context, msg *types.MsgCancelTrainingTask) (*types.MsgCancelTrainingTaskResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	task, found := k.GetTrainingTask(ctx, msg.TaskId)
+	if !found {
+		return nil, types.ErrTrainingTaskNotFound
+	}
+	if task.FinishedAtBlockHeight != 0 {
+		return nil, types.ErrTrainingTaskFinished
+	}
+	if msg.Creator != task.RequestedBy {
+		return nil, sdkerrors.Wrap(errortypes.ErrUnauthorized, "only the requester can cancel a training task")
+	}
+
+	paid := uint64(0)
+	if task.AssignedAtBlockHeight != 0 {
+		var err error
+		paid, err = k.Keeper.SettleTrainingPayments(ctx, task, LastSettleableOuterStep(task, true))
+		if err != nil {
+			return nil, err
+		}
+	}
+	refunded, err := k.RefundTrainingBudget(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+
+	task.FinishedAtBlockHeight = uint64(ctx.BlockHeight())
+	task.CancelledAtBlockHeight = uint64(ctx.BlockHeight())
+	k.SetTrainingTask(ctx, task)
+	if task.AssignedAtBlockHeight != 0 {
+		err = k.RemoveTaskFromInProgress(ctx, task.Id)
+	} else {
+		err = k.RemoveTaskFromQueue(ctx, task.Id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	k.LogInfo("MsgCancelTrainingTask: training task cancelled", types.Training, "taskId", task.Id, "paid", paid, "refunded", refunded)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"training_task_cancelled",
+			sdk.NewAttribute("task_id", strconv.FormatUint(task.Id, 10)),
+			sdk.NewAttribute("paid", strconv.FormatUint(paid, 10)),
+			sdk.NewAttribute("refunded", strconv.FormatUint(refunded, 10)),
+		),
+	)
+
+	return &types.MsgCancelTrainingTaskResponse{
+		Paid:     paid,
+		Refunded: refunded,
+	}, nil
+}
@@ -12,6 +12,7 @@ import (
 	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/productscience/inference/testutil"
 	"github.com/productscience/inference/x/inference/types"
+	streamvestingtypes "github.com/productscience/inference/x/streamvesting/types"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -138,10 +139,11 @@ func TestMsgServer_ClaimRewards(t *testing.T) {
 		gomock.Any(),
 	).Return(nil).AnyTimes()
 
-	mocks.StreamVestingKeeper.EXPECT().AddVestedRewards(
+	mocks.StreamVestingKeeper.EXPECT().AddVestedRewardsFromSource(
 		gomock.Any(),
 		testutil.Creator,
 		gomock.Any(),
+		streamvestingtypes.VestingSource_VESTING_SOURCE_WORK,
 		workCoins,
 		gomock.Any(), // vestingEpochs is a pointer to 180
 		gomock.Any(),
@@ -155,10 +157,11 @@ func TestMsgServer_ClaimRewards(t *testing.T) {
 		gomock.Any(),
 	).Return(nil).AnyTimes()
 
-	mocks.StreamVestingKeeper.EXPECT().AddVestedRewards(
+	mocks.StreamVestingKeeper.EXPECT().AddVestedRewardsFromSource(
 		gomock.Any(),
 		testutil.Creator,
 		gomock.Any(),
+		streamvestingtypes.VestingSource_VESTING_SOURCE_BITCOIN_REWARD, // default params use bitcoin rewards
 		rewardCoins,
 		gomock.Any(), // vestingEpochs is a pointer to 180
 		gomock.Any(),
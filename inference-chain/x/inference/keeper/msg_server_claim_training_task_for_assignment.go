@@ -24,6 +24,10 @@ func (k msgServer) ClaimTrainingTaskForAssignment(goCtx context.Context, msg *ty
 		return nil, types.ErrTrainingTaskNotFound
 	}
 
+	if task.FinishedAtBlockHeight != 0 {
+		return nil, types.ErrTrainingTaskFinished
+	}
+
 	if task.AssignedAtBlockHeight != 0 {
 		return nil, types.ErrTrainingTaskAlreadyAssigned
 	}
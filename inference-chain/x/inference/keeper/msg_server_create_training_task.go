@@ -17,6 +17,10 @@ func (k msgServer) CreateTrainingTask(goCtx context.Context, msg *types.MsgCreat
 		return nil, err
 	}
 
+	if err := msg.ValidateBudget(); err != nil {
+		return nil, err
+	}
+
 	taskId := k.GetNextTaskID(ctx)
 
 	task := &types.TrainingTask{
@@ -28,6 +32,14 @@ func (k msgServer) CreateTrainingTask(goCtx context.Context, msg *types.MsgCreat
 		HardwareResources:     msg.HardwareResources,
 		Config:                msg.Config,
 		Epoch:                 training.NewEmptyEpochInfo(),
+		Budget: &types.TrainingBudget{
+			Total:               msg.Budget,
+			PaymentPerNodeEpoch: msg.PaymentPerNodeEpoch,
+		},
+	}
+
+	if err := k.EscrowTrainingBudget(ctx, task); err != nil {
+		return nil, err
 	}
 
 	err := k.CreateTask(ctx, task)
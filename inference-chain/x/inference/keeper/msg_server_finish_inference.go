@@ -213,7 +213,9 @@ func (k msgServer) handleInferenceCompleted(ctx sdk.Context, existingInference *
 	if !found {
 		k.LogError("handleInferenceCompleted: executor not found", types.Inferences, "executed_by", executedBy)
 	} else {
-		executor.CurrentEpochStats.InferenceCount++
+		if !existingInference.ProducedNoOutput() {
+			executor.CurrentEpochStats.InferenceCount++
+		}
 		executor.LastInferenceTime = existingInference.EndBlockTimestamp
 		if err := k.SetParticipant(ctx, executor); err != nil {
 			return err
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// FinishTrainingTask completes an assigned training task once every participant ranked in its last outer step
+// has committed a checkpoint for it. All outer steps are paid for and the rest of the budget is refunded.
+func (k msgServer) FinishTrainingTask(goCtx context.Context, msg *types.MsgFinishTrainingTask) (*types.MsgFinishTrainingTaskResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	task, found := k.GetTrainingTask(ctx, msg.TaskId)
+	if !found {
+		return nil, types.ErrTrainingTaskNotFound
+	}
+	if task.FinishedAtBlockHeight != 0 {
+		return nil, types.ErrTrainingTaskFinished
+	}
+	if msg.Creator != task.RequestedBy && msg.Creator != task.Assigner {
+		return nil, sdkerrors.Wrap(errortypes.ErrUnauthorized, "only the requester or assigner can finish a training task")
+	}
+	if task.AssignedAtBlockHeight == 0 {
+		return nil, sdkerrors.Wrap(errortypes.ErrInvalidRequest, "training task is not assigned, cancel it instead")
+	}
+
+	missing, err := k.missingTrainingCheckpoints(ctx, task)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidTrainingCheckpoint, err.Error())
+	}
+	if len(missing) > 0 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTrainingCheckpoint, "no final checkpoint committed by %v", missing)
+	}
+
+	paid, err := k.Keeper.SettleTrainingPayments(ctx, task, LastSettleableOuterStep(task, true))
+	if err != nil {
+		return nil, err
+	}
+	refunded, err := k.RefundTrainingBudget(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+
+	task.FinishedAtBlockHeight = uint64(ctx.BlockHeight())
+	k.SetTrainingTask(ctx, task)
+	if err := k.RemoveTaskFromInProgress(ctx, task.Id); err != nil {
+		return nil, err
+	}
+
+	k.LogInfo("MsgFinishTrainingTask: training task finished", types.Training, "taskId", task.Id, "paid", paid, "refunded", refunded)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"training_task_finished",
+			sdk.NewAttribute("task_id", strconv.FormatUint(task.Id, 10)),
+			sdk.NewAttribute("paid", strconv.FormatUint(paid, 10)),
+			sdk.NewAttribute("refunded", strconv.FormatUint(refunded, 10)),
+		),
+	)
+
+	return &types.MsgFinishTrainingTaskResponse{
+		Paid:     paid,
+		Refunded: refunded,
+	}, nil
+}
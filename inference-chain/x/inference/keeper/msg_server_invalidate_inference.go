@@ -20,6 +20,13 @@ func (k msgServer) InvalidateInference(ctx context.Context, msg *types.MsgInvali
 		return nil, nil
 	}
 	inference.Status = types.InferenceStatus_INVALIDATED
+	inference.InvalidatedBy = msg.Invalidator
+	inference.InvalidatedAtBlockHeight = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	effects := types.InvalidationEffects{
+		InferenceId:           inference.InferenceId,
+		EpochsCompletedBefore: executor.EpochsCompleted,
+	}
+	statusBefore := executor.Status
 	executor.CurrentEpochStats.InvalidatedInferences++
 	executor.ConsecutiveInvalidInferences++
 	epochGroup, err := k.GetCurrentEpochGroup(ctx)
@@ -27,14 +34,17 @@ func (k msgServer) InvalidateInference(ctx context.Context, msg *types.MsgInvali
 		k.LogError("Failed to get current epoch group", types.Validation, "error", err)
 		return nil, err
 	}
+	k.recordInvalidator(ctx, inference.ExecutedBy, msg.Invalidator)
 
 	shouldRefund, reason := k.inferenceIsBeforeClaimsSet(ctx, *inference, epochGroup)
 	k.LogInfo("Inference refund decision", types.Validation, "inferenceId", inference.InferenceId, "executor", executor.Address, "shouldRefund", shouldRefund, "reason", reason)
 	if shouldRefund {
-		err := k.refundInvalidatedInference(executor, inference, ctx)
+		compensated, err := k.refundInvalidatedInference(executor, inference, ctx)
 		if err != nil {
 			return nil, err
 		}
+		effects.RefundedAmount = inference.ActualCost
+		effects.CompensatedAmount = compensated
 	}
 
 	k.LogInfo("Inference invalidated", types.Inferences, "inferenceId", inference.InferenceId, "executor", executor.Address, "actualCost", inference.ActualCost)
@@ -49,10 +59,19 @@ func (k msgServer) InvalidateInference(ctx context.Context, msg *types.MsgInvali
 		return nil, err
 	}
 
+	if updated, found := k.GetParticipant(ctx, executor.Address); found {
+		effects.ExecutorInvalidated = statusBefore != types.ParticipantStatus_INVALID && updated.Status == types.ParticipantStatus_INVALID
+	}
+	effects.EpochIndex, _ = k.GetEffectiveEpochIndex(ctx)
+	if err := k.InvalidationEffectsMap.Set(ctx, inference.InferenceId, effects); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgInvalidateInferenceResponse{}, nil
 }
 
-func (k msgServer) refundInvalidatedInference(executor *types.Participant, inference *types.Inference, ctx context.Context) error {
+// refundInvalidatedInference refunds the requester and returns the compensation paid on top of the refund
+func (k msgServer) refundInvalidatedInference(executor *types.Participant, inference *types.Inference, ctx context.Context) (int64, error) {
 	executor.CoinBalance -= inference.ActualCost
 	k.SafeLogSubAccountTransaction(ctx, types.ModuleName, executor.Address, types.OwedSubAccount, inference.ActualCost, "inference_invalidated:"+inference.InferenceId)
 	k.LogInfo("Invalid Inference subtracted from Executor CoinBalance ", types.Balances, "inferenceId", inference.InferenceId, "executor", executor.Address, "actualCost", inference.ActualCost, "coinBalance", executor.CoinBalance)
@@ -60,13 +79,59 @@ func (k msgServer) refundInvalidatedInference(executor *types.Participant, infer
 	payer, found := k.GetParticipant(ctx, inference.RequestedBy)
 	if !found {
 		k.LogError("Payer not found", types.Validation, "address", inference.RequestedBy)
-		return types.ErrParticipantNotFound
+		return 0, types.ErrParticipantNotFound
 	}
 	err := k.IssueRefund(ctx, inference.ActualCost, payer.Address, "invalidated_inference:"+inference.InferenceId)
 	if err != nil {
 		k.LogError("Refund failed", types.Validation, "error", err)
+		return 0, nil
+	}
+	return k.compensateRequester(ctx, payer.Address, inference), nil
+}
+
+// compensateRequester reimburses the developer from the collateral compensation pool, on top of the escrow refund.
+// It returns the amount paid.
+func (k msgServer) compensateRequester(ctx context.Context, requester string, inference *types.Inference) int64 {
+	requesterAddress, err := sdk.AccAddressFromBech32(requester)
+	if err != nil {
+		k.LogError("Could not parse requester address for compensation", types.Validation, "address", requester, "error", err)
+		return 0
+	}
+	cost, err := types.GetCoin(inference.ActualCost)
+	if err != nil {
+		k.LogError("Invalid inference cost for compensation", types.Validation, "inferenceId", inference.InferenceId, "error", err)
+		return 0
+	}
+	paid, err := k.collateralKeeper.CompensateRequester(ctx, requesterAddress, cost, "invalidated_inference_compensation:"+inference.InferenceId)
+	if err != nil {
+		k.LogError("Compensation failed", types.Validation, "inferenceId", inference.InferenceId, "error", err)
+		return 0
+	}
+	k.LogInfo("Requester compensated for invalidated inference", types.Validation, "inferenceId", inference.InferenceId, "requester", requester, "amount", paid.String())
+	return paid.Amount.Int64()
+}
+
+// recordInvalidator remembers which validator caught the executor, so slashing can reward them. It is keyed by the
+// effective epoch, the same epoch GetEpochInvalidators reads.
+func (k msgServer) recordInvalidator(ctx context.Context, executor string, invalidator string) {
+	epochIndex, found := k.GetEffectiveEpochIndex(ctx)
+	if !found {
+		k.LogError("Effective epoch not found, invalidator not recorded", types.Validation, "executor", executor)
+		return
+	}
+	executorAddress, err := sdk.AccAddressFromBech32(executor)
+	if err != nil {
+		k.LogError("Could not parse executor address", types.Validation, "address", executor, "error", err)
+		return
+	}
+	invalidatorAddress, err := sdk.AccAddressFromBech32(invalidator)
+	if err != nil {
+		k.LogError("Could not parse invalidator address", types.Validation, "address", invalidator, "error", err)
+		return
+	}
+	if err := k.EpochInvalidators.Set(ctx, collections.Join3(epochIndex, executorAddress, invalidatorAddress)); err != nil {
+		k.LogError("Failed to record invalidator", types.Validation, "error", err)
 	}
-	return nil
 }
 
 type ValidationDecision interface {
@@ -89,8 +89,13 @@ func TestInvalidateInference_RefundsRequesterAndChargesExecutor_NoSlash(t *testi
 	// Expect refund to be issued to the payer via module->account transfer inside IssueRefund
 	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
+	// Compensation from the collateral pool is requested on top of the refund
+	payerAcc, _ := sdk.AccAddressFromBech32(payerAddr)
+	mocks.CollateralKeeper.EXPECT().CompensateRequester(gomock.Any(), payerAcc, sdk.NewInt64Coin(types.BaseCoin, actualCost), gomock.Any()).Return(sdk.NewInt64Coin(types.BaseCoin, 61), nil).Times(1)
+
 	// We do NOT expect any slashing in this test
 	mocks.CollateralKeeper.EXPECT().Slash(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mocks.CollateralKeeper.EXPECT().SlashWithBeneficiaries(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 	_, err = ms.InvalidateInference(ctx, &types.MsgInvalidateInference{Creator: payerAddr, InferenceId: inferenceID})
 	require.NoError(t, err)
@@ -104,6 +109,12 @@ func TestInvalidateInference_RefundsRequesterAndChargesExecutor_NoSlash(t *testi
 	updatedInf, found := k.GetInference(ctx, inferenceID)
 	require.True(t, found)
 	require.Equal(t, types.InferenceStatus_INVALIDATED, updatedInf.Status)
+
+	// The refund and the compensation are recorded so a reversed appeal can undo them
+	effects, err := k.InvalidationEffectsMap.Get(ctx, inferenceID)
+	require.NoError(t, err)
+	require.Equal(t, actualCost, effects.RefundedAmount)
+	require.Equal(t, int64(61), effects.CompensatedAmount)
 }
 
 func TestInvalidateInference_RefundsRequesterAndChargesExecutor_WithSlash(t *testing.T) {
@@ -161,7 +172,8 @@ func TestInvalidateInference_RefundsRequesterAndChargesExecutor_WithSlash(t *tes
 	// Expect a slash due to status transition to INVALID
 	execAcc, _ := sdk.AccAddressFromBech32(executorAddr)
 	slashFraction, _ := params.CollateralParams.SlashFractionInvalid.ToLegacyDec()
-	mocks.CollateralKeeper.EXPECT().Slash(gomock.Any(), execAcc, slashFraction, types.SlashReasonInvalidation).Return(sdk.NewCoin(types.BaseCoin, math.NewInt(0)), nil).Times(1)
+	mocks.CollateralKeeper.EXPECT().SlashWithBeneficiaries(gomock.Any(), execAcc, slashFraction, types.SlashReasonInvalidation, gomock.Any()).Return(sdk.NewCoin(types.BaseCoin, math.NewInt(0)), nil).Times(1)
+	mocks.CollateralKeeper.EXPECT().CompensateRequester(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(sdk.NewInt64Coin(types.BaseCoin, 0), nil).Times(1)
 
 	_, err = ms.InvalidateInference(ctx, &types.MsgInvalidateInference{Creator: payerAddr, InferenceId: inferenceID})
 	require.NoError(t, err)
@@ -176,6 +188,13 @@ func TestInvalidateInference_RefundsRequesterAndChargesExecutor_WithSlash(t *tes
 	updatedInf, found := k.GetInference(ctx, inferenceID)
 	require.True(t, found)
 	require.Equal(t, types.InferenceStatus_INVALIDATED, updatedInf.Status)
+
+	// Recorded so that a reversed appeal can undo the refund and the status change
+	effects, err := k.InvalidationEffectsMap.Get(ctx, inferenceID)
+	require.NoError(t, err)
+	require.Equal(t, actualCost, effects.RefundedAmount)
+	require.True(t, effects.ExecutorInvalidated)
+	require.Equal(t, uint64(1), effects.EpochIndex)
 }
 
 func TestInvalidateInference_NextEpoch_NoRefundNoCharge_NoSlash(t *testing.T) {
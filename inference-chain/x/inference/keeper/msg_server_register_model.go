@@ -16,17 +16,31 @@ func (k msgServer) RegisterModel(goCtx context.Context, msg *types.MsgRegisterMo
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	k.SetModel(ctx, &types.Model{
+	model := &types.Model{
 		ProposedBy:             msg.ProposedBy,
 		Id:                     msg.Id,
 		UnitsOfComputePerToken: msg.UnitsOfComputePerToken,
+		ContextWindow:          msg.ContextWindow,
+		Quantization:           msg.Quantization,
+		CoinsPerInputToken:     msg.CoinsPerInputToken,
+		CoinsPerOutputToken:    msg.CoinsPerOutputToken,
 		HfRepo:                 msg.HfRepo,
 		HfCommit:               msg.HfCommit,
 		ModelArgs:              msg.ModelArgs,
 		VRam:                   msg.VRam,
 		ThroughputPerNonce:     msg.ThroughputPerNonce,
 		ValidationThreshold:    msg.ValidationThreshold,
-	})
+	}
+
+	if existing, found := k.GetGovernanceModel(ctx, msg.Id); found {
+		// Updating a registered model keeps its lifecycle state; use MsgSetModelStatus to change it
+		model.Status = existing.Status
+		model.SunsetEpoch = existing.SunsetEpoch
+		model.SuccessorId = existing.SuccessorId
+	} else if _, isAlias := k.GetModelAlias(ctx, msg.Id); isAlias {
+		return nil, errorsmod.Wrapf(types.ErrInvalidModel, "model id %s is already used as an alias", msg.Id)
+	}
+	k.SetModel(ctx, model)
 
 	return &types.MsgRegisterModelResponse{}, nil
 }
@@ -49,24 +49,36 @@ func (k msgServer) RequestBridgeMint(goCtx context.Context, msg *types.MsgReques
 		return nil, fmt.Errorf("bridge mint validation failed: %v", err)
 	}
 
-	// 3. Parse amount and create coins
+	// 3. Enforce the circuit breaker and rate limit of the destination chain. Native GNK is
+	// rate limited under the address of the chain's bridge contract.
+	bridgeContract := ""
+	if addresses := k.GetBridgeContractAddressesByChain(ctx, msg.ChainId); len(addresses) > 0 {
+		bridgeContract = addresses[0].Address
+	}
+	err = k.CheckAndRecordBridgeOutbound(ctx, msg.ChainId, bridgeContract, msg.Amount)
+	if err != nil {
+		k.LogWarn("Bridge mint: Rejected", types.Messages, "chainId", msg.ChainId, "amount", msg.Amount, "error", err)
+		return nil, err
+	}
+
+	// 4. Parse amount and create coins
 	amountInt, ok := math.NewIntFromString(msg.Amount)
 	if !ok {
 		return nil, fmt.Errorf("invalid amount format: %s", msg.Amount)
 	}
 	nativeCoins := sdk.NewCoins(sdk.NewCoin(types.BaseCoin, amountInt))
 
-	// 4. Transfer native tokens to bridge escrow account (atomic operation)
+	// 5. Transfer native tokens to bridge escrow account (atomic operation)
 	err = k.TransferToEscrow(ctx, userAddr, nativeCoins)
 	if err != nil {
 		k.LogError("Bridge mint: Failed to transfer tokens to escrow", types.Messages, "error", err)
 		return nil, fmt.Errorf("failed to transfer tokens to escrow: %v", err)
 	}
 
-	// 5. Generate request ID from transaction context
+	// 6. Generate request ID from transaction context
 	requestID := k.generateRequestID(ctx)
 
-	// 6. Get current epoch for BLS signature
+	// 7. Get current epoch for BLS signature
 	currentEpochGroup, err := k.GetCurrentEpochGroup(goCtx)
 	if err != nil {
 		// Rollback the escrow transfer if epoch retrieval fails
@@ -77,7 +89,7 @@ func (k msgServer) RequestBridgeMint(goCtx context.Context, msg *types.MsgReques
 		return nil, fmt.Errorf("failed to get current epoch group: %v", err)
 	}
 
-	// 7. Prepare BLS signature data for WGNK minting on Ethereum
+	// 8. Prepare BLS signature data for WGNK minting on Ethereum
 	// Get numeric chain ID from string chain identifier
 	destinationChainId, found := mintChainIdMapping[msg.ChainId]
 	if !found {
@@ -97,7 +109,7 @@ func (k msgServer) RequestBridgeMint(goCtx context.Context, msg *types.MsgReques
 		msg.Amount,             // Amount as string
 	)
 
-	// 8. Request BLS threshold signature for WGNK minting
+	// 9. Request BLS threshold signature for WGNK minting
 	// Use the actual Gonka chain ID from context (source chain)
 	gonkaChainID := ctx.ChainID()
 	gonkaChainIdHash := sha256.Sum256([]byte(gonkaChainID)) // Convert to bytes32
@@ -134,7 +146,7 @@ func (k msgServer) RequestBridgeMint(goCtx context.Context, msg *types.MsgReques
 		"epochIndex", currentEpochGroup.GroupData.EpochIndex,
 		"blsRequestId", blsRequestId)
 
-	// 9. Emit bridge mint event for off-chain monitoring
+	// 10. Emit bridge mint event for off-chain monitoring
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"bridge_mint_requested",
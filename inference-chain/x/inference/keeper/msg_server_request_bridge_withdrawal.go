@@ -50,20 +50,31 @@ func (k msgServer) RequestBridgeWithdrawal(goCtx context.Context, msg *types.Msg
 		return nil, fmt.Errorf("calling contract %s is not a registered wrapped token contract", contractAddrStr)
 	}
 
-	// 4. Get chain ID for request identification
+	// 4. Enforce the circuit breaker and rate limit of the token's origin chain
+	err = k.CheckAndRecordBridgeOutbound(ctx, bridgeWrappedTokenContract.ChainId, bridgeWrappedTokenContract.ContractAddress, msg.Amount)
+	if err != nil {
+		k.LogWarn("Bridge withdrawal rejected", types.Messages,
+			"contract_address", contractAddrStr,
+			"chain_id", bridgeWrappedTokenContract.ChainId,
+			"amount", msg.Amount,
+			"error", err)
+		return nil, err
+	}
+
+	// 5. Get chain ID for request identification
 	chainID := ctx.ChainID()
 
-	// 5. Generate request ID from transaction hash
+	// 6. Generate request ID from transaction hash
 	requestID := k.generateRequestID(ctx)
 	requestIdHash := keccak256Hash([]byte(requestID))
 
-	// 6. Get current epoch for BLS signature
+	// 7. Get current epoch for BLS signature
 	currentEpochGroup, err := k.GetCurrentEpochGroup(goCtx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get current epoch group: %v", err)
 	}
 
-	// 7. Prepare BLS signature data according to Ethereum bridge format
+	// 8. Prepare BLS signature data according to Ethereum bridge format
 	// Get numeric chain ID from metadata's string chain identifier
 	destinationChainId, found := chainIdMapping[bridgeWrappedTokenContract.ChainId]
 	if !found {
@@ -80,7 +91,7 @@ func (k msgServer) RequestBridgeWithdrawal(goCtx context.Context, msg *types.Msg
 		msg.Amount, // Amount as string
 	)
 
-	// 8. Request BLS threshold signature
+	// 9. Request BLS threshold signature
 	// Use the actual Gonka chain ID from context (source chain)
 	gonkaChainID := ctx.ChainID()
 	gonkaChainIdHash := sha256.Sum256([]byte(gonkaChainID)) // Convert to bytes32
@@ -97,7 +108,7 @@ func (k msgServer) RequestBridgeWithdrawal(goCtx context.Context, msg *types.Msg
 		return nil, fmt.Errorf("failed to request BLS signature: %v", err)
 	}
 
-	// 9. Log the withdrawal request
+	// 10. Log the withdrawal request
 	k.LogInfo("Contract bridge withdrawal requested", types.Messages,
 		"contract_address", contractAddrStr,
 		"user_address", msg.UserAddress,
@@ -18,8 +18,10 @@ func (k msgServer) RevalidateInference(ctx context.Context, msg *types.MsgRevali
 	}
 
 	inference.Status = types.InferenceStatus_VALIDATED
-	executor.ConsecutiveInvalidInferences = 0
-	executor.CurrentEpochStats.ValidatedInferences++
+	if !inference.ProducedNoOutput() {
+		executor.ConsecutiveInvalidInferences = 0
+		executor.CurrentEpochStats.ValidatedInferences++
+	}
 
 	err = k.SetParticipant(ctx, *executor)
 	if err != nil {
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) SetBridgeRateLimit(goCtx context.Context, msg *types.MsgSetBridgeRateLimit) (*types.MsgSetBridgeRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "MsgSetBridgeRateLimit. invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.SetBridgeRateLimit(ctx, msg.RateLimit); err != nil {
+		return nil, err
+	}
+
+	k.LogInfo("Bridge rate limit set", types.Messages,
+		"chainId", msg.RateLimit.ChainId,
+		"contractAddress", msg.RateLimit.ContractAddress,
+		"windowBlocks", msg.RateLimit.WindowBlocks,
+		"maxWindowVolume", msg.RateLimit.MaxWindowVolume,
+		"maxTransactionAmount", msg.RateLimit.MaxTransactionAmount)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"bridge_rate_limit_set",
+			sdk.NewAttribute("chain_id", msg.RateLimit.ChainId),
+			sdk.NewAttribute("contract_address", msg.RateLimit.ContractAddress),
+			sdk.NewAttribute("max_window_volume", msg.RateLimit.MaxWindowVolume),
+			sdk.NewAttribute("max_transaction_amount", msg.RateLimit.MaxTransactionAmount),
+		))
+
+	return &types.MsgSetBridgeRateLimitResponse{}, nil
+}
+
+func (k msgServer) SetBridgePaused(goCtx context.Context, msg *types.MsgSetBridgePaused) (*types.MsgSetBridgePausedResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "MsgSetBridgePaused. invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.SetBridgePaused(ctx, msg.ChainId, msg.Paused, msg.Reason, false); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetBridgePausedResponse{}, nil
+}
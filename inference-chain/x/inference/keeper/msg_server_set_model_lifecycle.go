@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) SetModelStatus(goCtx context.Context, msg *types.MsgSetModelStatus) (*types.MsgSetModelStatusResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "MsgSetModelStatus. invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	model, found := k.GetGovernanceModel(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrModelNotFound, "model %s", msg.Id)
+	}
+	if msg.SuccessorId != "" {
+		successor, found := k.GetGovernanceModel(ctx, msg.SuccessorId)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrModelNotFound, "successor model %s", msg.SuccessorId)
+		}
+		if successor.Status == types.ModelStatus_MODEL_STATUS_RETIRED {
+			return nil, errorsmod.Wrapf(types.ErrInvalidModelStatus, "successor model %s is retired", msg.SuccessorId)
+		}
+	}
+	model.SuccessorId = msg.SuccessorId
+
+	switch msg.Status {
+	case types.ModelStatus_MODEL_STATUS_ACTIVE:
+		model.Status = types.ModelStatus_MODEL_STATUS_ACTIVE
+		model.SunsetEpoch = 0
+		k.SetModel(ctx, model)
+	case types.ModelStatus_MODEL_STATUS_DEPRECATED:
+		currentEpoch, _ := k.GetEffectiveEpochIndex(ctx)
+		if msg.SunsetEpoch <= currentEpoch {
+			return nil, errorsmod.Wrapf(types.ErrInvalidModelStatus, "sunset epoch %d must be after the current epoch %d", msg.SunsetEpoch, currentEpoch)
+		}
+		model.Status = types.ModelStatus_MODEL_STATUS_DEPRECATED
+		model.SunsetEpoch = msg.SunsetEpoch
+		k.SetModel(ctx, model)
+	case types.ModelStatus_MODEL_STATUS_RETIRED:
+		if err := k.retireModel(ctx, model); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errorsmod.Wrapf(types.ErrInvalidModelStatus, "unknown status %d", msg.Status)
+	}
+
+	k.LogInfo("Model status updated", types.Inferences, "model", model.Id, "status", model.Status.String(), "sunsetEpoch", model.SunsetEpoch)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"model_status_updated",
+			sdk.NewAttribute("model_id", model.Id),
+			sdk.NewAttribute("status", model.Status.String()),
+			sdk.NewAttribute("sunset_epoch", strconv.FormatUint(model.SunsetEpoch, 10)),
+			sdk.NewAttribute("successor_id", model.SuccessorId),
+		))
+
+	return &types.MsgSetModelStatusResponse{}, nil
+}
+
+func (k msgServer) SetModelAlias(goCtx context.Context, msg *types.MsgSetModelAlias) (*types.MsgSetModelAliasResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "MsgSetModelAlias. invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.ModelId == "" {
+		if err := k.RemoveModelAlias(ctx, msg.Alias); err != nil {
+			return nil, err
+		}
+		k.LogInfo("Model alias removed", types.Inferences, "alias", msg.Alias)
+		return &types.MsgSetModelAliasResponse{}, nil
+	}
+
+	if k.IsValidGovernanceModel(ctx, msg.Alias) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidModelAlias, "alias %s is already a model id", msg.Alias)
+	}
+	model, found := k.GetGovernanceModel(ctx, msg.ModelId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrModelNotFound, "model %s", msg.ModelId)
+	}
+	if model.Status == types.ModelStatus_MODEL_STATUS_RETIRED {
+		return nil, errorsmod.Wrapf(types.ErrInvalidModelAlias, "model %s is retired", msg.ModelId)
+	}
+	if err := k.Keeper.SetModelAlias(ctx, msg.Alias, msg.ModelId); err != nil {
+		return nil, err
+	}
+
+	k.LogInfo("Model alias set", types.Inferences, "alias", msg.Alias, "model", msg.ModelId)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"model_alias_set",
+			sdk.NewAttribute("alias", msg.Alias),
+			sdk.NewAttribute("model_id", msg.ModelId),
+		))
+
+	return &types.MsgSetModelAliasResponse{}, nil
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestModelLifecycle_DeprecateAliasAndSunset(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	wctx := sdk.UnwrapSDKContext(ctx)
+	k.SetEffectiveEpochIndex(wctx, 10)
+
+	_, err := ms.RegisterModel(wctx, &types.MsgRegisterModel{
+		Authority:          k.GetAuthority(),
+		Id:                 "Qwen/Qwen2.5-7B-Instruct",
+		ContextWindow:      32768,
+		Quantization:       "fp8",
+		CoinsPerInputToken: 3,
+	})
+	require.NoError(t, err)
+	k.SetModel(wctx, &types.Model{Id: "Qwen/Qwen3-8B"})
+
+	model, found := k.GetGovernanceModel(wctx, "Qwen/Qwen2.5-7B-Instruct")
+	require.True(t, found)
+	require.Equal(t, uint64(32768), model.ContextWindow)
+	require.Equal(t, "fp8", model.Quantization)
+	require.Equal(t, uint64(3), model.CoinsPerInputToken)
+
+	_, err = ms.SetModelAlias(wctx, &types.MsgSetModelAlias{Authority: "invalid", Alias: "qwen-latest", ModelId: "Qwen/Qwen2.5-7B-Instruct"})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.SetModelAlias(wctx, &types.MsgSetModelAlias{Authority: k.GetAuthority(), Alias: "Qwen/Qwen3-8B", ModelId: "Qwen/Qwen2.5-7B-Instruct"})
+	require.ErrorIs(t, err, types.ErrInvalidModelAlias)
+	_, err = ms.SetModelAlias(wctx, &types.MsgSetModelAlias{Authority: k.GetAuthority(), Alias: "qwen-latest", ModelId: "Qwen/Qwen2.5-7B-Instruct"})
+	require.NoError(t, err)
+
+	resolved, err := k.ResolveModel(wctx, &types.QueryResolveModelRequest{Model: "qwen-latest"})
+	require.NoError(t, err)
+	require.Equal(t, "Qwen/Qwen2.5-7B-Instruct", resolved.Model.Id)
+	require.Equal(t, "qwen-latest", resolved.Alias)
+
+	_, err = ms.SetModelStatus(wctx, &types.MsgSetModelStatus{
+		Authority:   k.GetAuthority(),
+		Id:          "Qwen/Qwen2.5-7B-Instruct",
+		Status:      types.ModelStatus_MODEL_STATUS_DEPRECATED,
+		SunsetEpoch: 10,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidModelStatus)
+	_, err = ms.SetModelStatus(wctx, &types.MsgSetModelStatus{
+		Authority:   k.GetAuthority(),
+		Id:          "Qwen/Qwen2.5-7B-Instruct",
+		Status:      types.ModelStatus_MODEL_STATUS_DEPRECATED,
+		SunsetEpoch: 12,
+		SuccessorId: "Qwen/Qwen3-8B",
+	})
+	require.NoError(t, err)
+
+	// Re-registering to update parameters keeps the deprecation
+	_, err = ms.RegisterModel(wctx, &types.MsgRegisterModel{Authority: k.GetAuthority(), Id: "Qwen/Qwen2.5-7B-Instruct", ContextWindow: 65536})
+	require.NoError(t, err)
+	model, _ = k.GetGovernanceModel(wctx, "Qwen/Qwen2.5-7B-Instruct")
+	require.Equal(t, types.ModelStatus_MODEL_STATUS_DEPRECATED, model.Status)
+	require.False(t, model.IsRetiredAt(11))
+	require.True(t, model.IsRetiredAt(12))
+
+	require.NoError(t, k.RetireSunsetModels(wctx, 11))
+	model, _ = k.GetGovernanceModel(wctx, "Qwen/Qwen2.5-7B-Instruct")
+	require.Equal(t, types.ModelStatus_MODEL_STATUS_DEPRECATED, model.Status)
+
+	// At the sunset the model is retired and the alias follows the successor
+	require.NoError(t, k.RetireSunsetModels(wctx, 12))
+	model, _ = k.GetGovernanceModel(wctx, "Qwen/Qwen2.5-7B-Instruct")
+	require.Equal(t, types.ModelStatus_MODEL_STATUS_RETIRED, model.Status)
+	target, found := k.GetModelAlias(wctx, "qwen-latest")
+	require.True(t, found)
+	require.Equal(t, "Qwen/Qwen3-8B", target)
+
+	_, err = ms.SetModelAlias(wctx, &types.MsgSetModelAlias{Authority: k.GetAuthority(), Alias: "qwen-old", ModelId: "Qwen/Qwen2.5-7B-Instruct"})
+	require.ErrorIs(t, err, types.ErrInvalidModelAlias)
+
+	_, err = ms.SetModelAlias(wctx, &types.MsgSetModelAlias{Authority: k.GetAuthority(), Alias: "qwen-latest"})
+	require.NoError(t, err)
+	aliases, err := k.ModelAliasAll(wctx, &types.QueryAllModelAliasRequest{})
+	require.NoError(t, err)
+	require.Empty(t, aliases.Aliases)
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) SettleTrainingPayments(goCtx context.Context, msg *types.MsgSettleTrainingPayments) (*types.MsgSettleTrainingPaymentsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	task, found := k.GetTrainingTask(ctx, msg.TaskId)
+	if !found {
+		return nil, types.ErrTrainingTaskNotFound
+	}
+	if task.FinishedAtBlockHeight != 0 {
+		return nil, types.ErrTrainingTaskFinished
+	}
+
+	isAssignee := slices.ContainsFunc(task.Assignees, func(assignee *types.TrainingTaskAssignee) bool {
+		return assignee.Participant == msg.Creator
+	})
+	if msg.Creator != task.RequestedBy && msg.Creator != task.Assigner && !isAssignee {
+		return nil, sdkerrors.Wrap(errortypes.ErrUnauthorized, "only the requester, assigner or assignees can settle training payments")
+	}
+
+	paid, err := k.Keeper.SettleTrainingPayments(ctx, task, LastSettleableOuterStep(task, false))
+	if err != nil {
+		k.LogError("MsgSettleTrainingPayments: failed to settle payments", types.Training, "taskId", msg.TaskId, "error", err)
+		return nil, err
+	}
+
+	return &types.MsgSettleTrainingPaymentsResponse{
+		Paid: paid,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) SubmitAppealVote(goCtx context.Context, msg *types.MsgSubmitAppealVote) (*types.MsgSubmitAppealVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	appeal, found := k.GetInvalidationAppeal(ctx, msg.InferenceId)
+	if !found {
+		return nil, types.ErrAppealNotFound
+	}
+	if appeal.Status != types.AppealStatus_APPEAL_STATUS_PENDING {
+		return nil, types.ErrAppealNotPending
+	}
+	if !slices.Contains(appeal.AssignedValidators, msg.Creator) {
+		return nil, types.ErrNotAppealValidator
+	}
+	for _, vote := range appeal.Votes {
+		if vote.Validator == msg.Creator {
+			return nil, types.ErrDuplicateValidation
+		}
+	}
+
+	inference, found := k.GetInference(ctx, msg.InferenceId)
+	if !found {
+		return nil, types.ErrInferenceNotFound
+	}
+	model, err := k.GetEpochModel(ctx, inference.Model)
+	if err != nil {
+		k.LogError("Failed to get epoch model", types.Validation, "model", inference.Model, "inferenceId", msg.InferenceId, "error", err)
+		return nil, err
+	}
+	passed := msg.Value > model.ValidationThreshold.ToFloat()
+
+	appeal.Votes = append(appeal.Votes, &types.AppealVote{
+		Validator: msg.Creator,
+		Value:     msg.Value,
+		Passed:    passed,
+	})
+	k.LogInfo("Appeal vote received", types.Validation, "inferenceId", msg.InferenceId, "validator", msg.Creator, "value", msg.Value, "passed", passed)
+
+	outcome := AppealOutcome(appeal, false)
+	if outcome != types.AppealStatus_APPEAL_STATUS_PENDING {
+		if err := k.ResolveAppeal(ctx, &appeal, outcome); err != nil {
+			return nil, err
+		}
+		return &types.MsgSubmitAppealVoteResponse{}, nil
+	}
+	if err := k.SetInvalidationAppeal(ctx, appeal); err != nil {
+		return nil, err
+	}
+	return &types.MsgSubmitAppealVoteResponse{}, nil
+}
@@ -25,6 +25,13 @@ func (k msgServer) SubmitPocBatch(goCtx context.Context, msg *types.MsgSubmitPoc
 		return nil, sdkerrors.Wrap(types.ErrPocNodeIdEmpty, "NodeId is empty")
 	}
 
+	if len(msg.MerkleRoot) > 0 && !k.GetParams(ctx).PocParams.GetBatchCommitmentsEnabled() {
+		k.LogError(PocFailureTag+"[SubmitPocBatch] batch commitments are disabled", types.PoC,
+			"participant", msg.Creator,
+			"msg.BatchId", msg.BatchId)
+		return nil, sdkerrors.Wrap(types.ErrPoCBatchCommitmentsDisabled, msg.BatchId)
+	}
+
 	currentBlockHeight := ctx.BlockHeight()
 	startBlockHeight := msg.PocStageStartBlockHeight
 
@@ -62,14 +69,9 @@ func (k msgServer) SubmitPocBatch(goCtx context.Context, msg *types.MsgSubmitPoc
 		}
 
 		// Store batch using trigger_height as key
-		storedBatch := types.PoCBatch{
-			ParticipantAddress:       msg.Creator,
-			PocStageStartBlockHeight: activeEvent.TriggerHeight, // Use trigger_height as key
-			ReceivedAtBlockHeight:    currentBlockHeight,
-			Nonces:                   msg.Nonces,
-			Dist:                     msg.Dist,
-			BatchId:                  msg.BatchId,
-			NodeId:                   msg.NodeId,
+		storedBatch := toPoCBatch(msg, activeEvent.TriggerHeight, currentBlockHeight) // Use trigger_height as key
+		if err := k.checkCommittedNonces(ctx, storedBatch); err != nil {
+			return nil, err
 		}
 
 		k.SetPocBatch(ctx, storedBatch)
@@ -116,17 +118,43 @@ func (k msgServer) SubmitPocBatch(goCtx context.Context, msg *types.MsgSubmitPoc
 		return nil, sdkerrors.Wrap(types.ErrPocTooLate, errMsg)
 	}
 
-	storedBatch := types.PoCBatch{
+	storedBatch := toPoCBatch(msg, startBlockHeight, currentBlockHeight)
+	if err := k.checkCommittedNonces(ctx, storedBatch); err != nil {
+		return nil, err
+	}
+
+	k.SetPocBatch(ctx, storedBatch)
+
+	return &types.MsgSubmitPocBatchResponse{}, nil
+}
+
+func (k msgServer) checkCommittedNonces(ctx sdk.Context, batch types.PoCBatch) error {
+	if !batch.IsCommitment() {
+		return nil
+	}
+	if err := k.CheckPoCBatchNoncesDisjoint(ctx, batch); err != nil {
+		k.LogError(PocFailureTag+"[SubmitPocBatch] committed nonces overlap another batch", types.PoC,
+			"participant", batch.ParticipantAddress,
+			"batchId", batch.BatchId,
+			"error", err)
+		return err
+	}
+	return nil
+}
+
+func toPoCBatch(msg *types.MsgSubmitPocBatch, pocStageStartBlockHeight int64, currentBlockHeight int64) types.PoCBatch {
+	return types.PoCBatch{
 		ParticipantAddress:       msg.Creator,
-		PocStageStartBlockHeight: startBlockHeight,
+		PocStageStartBlockHeight: pocStageStartBlockHeight,
 		ReceivedAtBlockHeight:    currentBlockHeight,
 		Nonces:                   msg.Nonces,
 		Dist:                     msg.Dist,
 		BatchId:                  msg.BatchId,
 		NodeId:                   msg.NodeId,
+		MerkleRoot:               msg.MerkleRoot,
+		NonceCount:               msg.NonceCount,
+		FirstNonce:               msg.FirstNonce,
+		LastNonce:                msg.LastNonce,
+		NonceStride:              msg.NonceStride,
 	}
-
-	k.SetPocBatch(ctx, storedBatch)
-
-	return &types.MsgSubmitPocBatchResponse{}, nil
 }
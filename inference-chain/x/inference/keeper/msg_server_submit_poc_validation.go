@@ -59,6 +59,14 @@ func (k msgServer) SubmitPocValidation(goCtx context.Context, msg *types.MsgSubm
 		}
 
 		// Store validation using trigger_height as key
+		if err := k.VerifyPoCLeafDisputes(ctx, msg.ParticipantAddress, activeEvent.TriggerHeight, msg.RTarget, msg.Disputes); err != nil {
+			k.LogError(PocFailureTag+"[SubmitPocValidation] Confirmation PoC: invalid leaf disputes", types.PoC,
+				"participant", msg.ParticipantAddress,
+				"validatorParticipant", msg.Creator,
+				"error", err)
+			return nil, err
+		}
+
 		validation := toPoCValidation(msg, currentBlockHeight)
 		validation.PocStageStartBlockHeight = activeEvent.TriggerHeight // Use trigger_height as key
 		k.SetPoCValidation(ctx, *validation)
@@ -109,6 +117,14 @@ func (k msgServer) SubmitPocValidation(goCtx context.Context, msg *types.MsgSubm
 		return nil, sdkerrors.Wrap(types.ErrPocTooLate, errMsg)
 	}
 
+	if err := k.VerifyPoCLeafDisputes(ctx, msg.ParticipantAddress, startBlockHeight, msg.RTarget, msg.Disputes); err != nil {
+		k.LogError(PocFailureTag+"[SubmitPocValidation] invalid leaf disputes", types.PoC,
+			"participant", msg.ParticipantAddress,
+			"validatorParticipant", msg.Creator,
+			"error", err)
+		return nil, err
+	}
+
 	validation := toPoCValidation(msg, currentBlockHeight)
 	k.SetPoCValidation(ctx, *validation)
 
@@ -129,5 +145,6 @@ func toPoCValidation(msg *types.MsgSubmitPocValidation, currentBlockHeight int64
 		NInvalid:                    msg.NInvalid,
 		ProbabilityHonest:           msg.ProbabilityHonest,
 		FraudDetected:               msg.FraudDetected,
+		Disputes:                    msg.Disputes,
 	}
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/productscience/inference/x/inference/types"
 )
@@ -35,6 +36,16 @@ func (k msgServer) SubmitTrainingKvRecord(goCtx context.Context, msg *types.MsgS
 		return nil, types.ErrTrainingTaskNotAssigned
 	}
 
+	if types.IsTrainingCheckpointKey(msg.Key) {
+		if err := types.ValidateTrainingCheckpointRecord(msg.Key, msg.Value, msg.Creator); err != nil {
+			return nil, err
+		}
+		// Checkpoint commitments back payments, so they can't be rewritten
+		if _, found := k.GetTrainingKVRecord(ctx, msg.TaskId, msg.Key); found {
+			return nil, errorsmod.Wrapf(types.ErrInvalidTrainingCheckpoint, "checkpoint %s is already committed", msg.Key)
+		}
+	}
+
 	record := types.TrainingTaskKVRecord{
 		TaskId:      msg.TaskId,
 		Participant: msg.Creator,
@@ -113,8 +113,10 @@ func (k msgServer) Validation(goCtx context.Context, msg *types.MsgValidation) (
 			k.shareWorkWithValidators(ctx, inference, msg, &executor)
 			inference.ValidatedBy = append(inference.ValidatedBy, msg.Creator)
 		}
-		executor.ConsecutiveInvalidInferences = 0
-		executor.CurrentEpochStats.ValidatedInferences++
+		if !inference.ProducedNoOutput() {
+			executor.ConsecutiveInvalidInferences = 0
+			executor.CurrentEpochStats.ValidatedInferences++
+		}
 	} else {
 		if k.MaximumInvalidationsReached(ctx, sdk.MustAccAddressFromBech32(creator.Address), groupData) {
 			k.LogWarn("Maximum invalidations reached.", types.Validation,
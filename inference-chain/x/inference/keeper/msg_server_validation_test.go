@@ -45,6 +45,41 @@ func TestMsgServer_Validation(t *testing.T) {
 	require.Equal(t, types.InferenceStatus_VALIDATED, inference.Status)
 }
 
+func TestMsgServer_Validation_NoOutputEarnsNoReputation(t *testing.T) {
+	inferenceHelper, k, ctx := NewMockInferenceHelper(t)
+	createParticipants(t, inferenceHelper.MessageServer, ctx)
+
+	model := &types.Model{Id: MODEL_ID, ValidationThreshold: &types.Decimal{Value: 85, Exponent: -2}}
+	k.SetModel(ctx, model)
+	StubModelSubgroup(t, ctx, k, inferenceHelper.Mocks, model)
+	addMembersToGroupData(k, ctx)
+
+	expected, err := inferenceHelper.StartInference("promptPayload", model.Id, time.Now().UnixNano(), calculations.DefaultMaxTokens)
+	require.NoError(t, err)
+	_, err = inferenceHelper.FinishInference()
+	require.NoError(t, err)
+	inference, found := k.GetInference(ctx, expected.InferenceId)
+	require.True(t, found)
+	inference.CompletionStatus = types.InferenceCompletionStatus_COMPLETION_STATUS_CLIENT_CANCELLED
+	inference.CompletionTokenCount = 0
+	require.NoError(t, k.SetInference(ctx, inference))
+	executorBefore, found := k.GetParticipant(ctx, testutil.Executor)
+	require.True(t, found)
+
+	_, err = inferenceHelper.MessageServer.Validation(ctx, &types.MsgValidation{
+		InferenceId: expected.InferenceId,
+		Creator:     testutil.Validator,
+		Value:       1,
+	})
+	require.NoError(t, err)
+	inference, found = k.GetInference(ctx, expected.InferenceId)
+	require.True(t, found)
+	require.Equal(t, types.InferenceStatus_VALIDATED, inference.Status)
+	executor, found := k.GetParticipant(ctx, testutil.Executor)
+	require.True(t, found)
+	require.Equal(t, executorBefore.CurrentEpochStats.ValidatedInferences, executor.CurrentEpochStats.ValidatedInferences)
+}
+
 func createParticipants(t *testing.T, ms types.MsgServer, ctx context.Context) {
 	mockRequester := NewMockAccount(testutil.Requester)
 	mockExecutor := NewMockAccount(testutil.Executor)
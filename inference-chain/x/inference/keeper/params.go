@@ -165,6 +165,13 @@ func (k Keeper) IsAllowedDeveloper(ctx context.Context, developerAddress string)
 	return false
 }
 
+// IsBridgeReceiptProofRequired reports whether deposits need a receipt proof to complete.
+// Chains whose params predate BridgeParams keep completing deposits on validator votes alone.
+func (k Keeper) IsBridgeReceiptProofRequired(ctx context.Context) bool {
+	p := k.GetParams(ctx).BridgeParams
+	return p != nil && p.RequireReceiptProof
+}
+
 func (k Keeper) GetParticipantAccessParams(ctx context.Context) *types.ParticipantAccessParams {
 	return k.GetParams(ctx).ParticipantAccessParams
 }
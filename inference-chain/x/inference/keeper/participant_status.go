@@ -94,6 +94,22 @@ func (k Keeper) invalidateParticipant(ctx context.Context, participant *types.Pa
 	return k.removeFromEpochGroups(ctx, participant, reason)
 }
 
+// restoreInvalidatedParticipant lifts an INVALID status whose cause was reversed on appeal. The status is recomputed
+// from the restored stats when the participant is saved.
+func (k Keeper) restoreInvalidatedParticipant(ctx context.Context, participant *types.Participant, effects types.InvalidationEffects) {
+	k.LogInfo("Participant restored after reversed invalidation", types.Validation, "address", participant.Address, "inferenceId", effects.InferenceId)
+	participant.Status = types.ParticipantStatus_ACTIVE
+	participant.EpochsCompleted = max(participant.EpochsCompleted, effects.EpochsCompletedBefore)
+	addr, err := sdk.AccAddressFromBech32(participant.Address)
+	if err != nil {
+		k.LogError("Failed to parse participant address for exclusion entry", types.Validation, "address", participant.Address, "error", err)
+		return
+	}
+	if err := k.ExcludedParticipantsMap.Remove(ctx, collections.Join(effects.EpochIndex, addr)); err != nil {
+		k.LogError("Failed to remove exclusion entry", types.Validation, "address", participant.Address, "error", err)
+	}
+}
+
 // This is way messier than you'd expect...
 func multiply(completed uint32, preserve *types.Decimal) uint32 {
 	if preserve == nil {
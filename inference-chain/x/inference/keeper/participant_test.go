@@ -145,7 +145,7 @@ func TestUpdateParticipantStatus_TransitionToInvalid(t *testing.T) {
 
 	// Expect slashing to be called
 	mocks.CollateralKeeper.EXPECT().
-		Slash(ctx, gomock.Any(), gomock.Any(), types.SlashReasonInvalidation).
+		SlashWithBeneficiaries(ctx, gomock.Any(), gomock.Any(), types.SlashReasonInvalidation, gomock.Any()).
 		Return(sdk.Coin{}, nil).
 		Times(1)
 
@@ -195,6 +195,9 @@ func TestUpdateParticipantStatus_AlreadyInvalid(t *testing.T) {
 	mocks.CollateralKeeper.EXPECT().
 		Slash(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Times(0)
+	mocks.CollateralKeeper.EXPECT().
+		SlashWithBeneficiaries(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
 
 	// Call UpdateParticipantStatus
 	err := k.UpdateParticipantStatus(ctx, &participant)
@@ -233,6 +236,9 @@ func TestUpdateParticipantStatus_AlreadyInactive(t *testing.T) {
 	mocks.CollateralKeeper.EXPECT().
 		Slash(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Times(0)
+	mocks.CollateralKeeper.EXPECT().
+		SlashWithBeneficiaries(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
 
 	// Call UpdateParticipantStatus
 	err := k.UpdateParticipantStatus(ctx, &participant)
@@ -281,7 +287,7 @@ func TestInvalidParticipant_ReputationReduced(t *testing.T) {
 
 	// Mock slashing
 	mocks.CollateralKeeper.EXPECT().
-		Slash(ctx, gomock.Any(), gomock.Any(), types.SlashReasonInvalidation).
+		SlashWithBeneficiaries(ctx, gomock.Any(), gomock.Any(), types.SlashReasonInvalidation, gomock.Any()).
 		Return(sdk.Coin{}, nil).
 		Times(1)
 
@@ -342,7 +348,7 @@ func TestParticipantStatusFlow_ActiveToInvalid(t *testing.T) {
 
 	// Mock slashing
 	mocks.CollateralKeeper.EXPECT().
-		Slash(ctx, gomock.Any(), gomock.Any(), types.SlashReasonInvalidation).
+		SlashWithBeneficiaries(ctx, gomock.Any(), gomock.Any(), types.SlashReasonInvalidation, gomock.Any()).
 		Return(sdk.Coin{}, nil).
 		Times(1)
 
@@ -395,6 +401,9 @@ func TestParticipantStatusFlow_InactiveStaysInactive(t *testing.T) {
 	mocks.CollateralKeeper.EXPECT().
 		Slash(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Times(0)
+	mocks.CollateralKeeper.EXPECT().
+		SlashWithBeneficiaries(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
 
 	// Save participant
 	err := k.SetParticipant(ctx, participant)
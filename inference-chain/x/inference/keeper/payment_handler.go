@@ -7,6 +7,7 @@ import (
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/productscience/inference/x/inference/types"
+	streamvestingtypes "github.com/productscience/inference/x/streamvesting/types"
 )
 
 func (k *Keeper) PutPaymentInEscrow(ctx context.Context, inference *types.Inference, cost int64) (int64, error) {
@@ -46,10 +47,11 @@ func (k *Keeper) MintRewardCoins(ctx context.Context, newCoins int64, memo strin
 }
 
 func (k *Keeper) PayParticipantFromEscrow(ctx context.Context, address string, amount int64, memo string, vestingPeriods *uint64) error {
-	return k.PayParticipantFromModule(ctx, address, amount, types.ModuleName, memo, vestingPeriods)
+	return k.PayParticipantFromModule(ctx, address, amount, types.ModuleName, streamvestingtypes.VestingSource_VESTING_SOURCE_WORK, memo, vestingPeriods)
 }
 
-func (k *Keeper) PayParticipantFromModule(ctx context.Context, address string, amount int64, moduleName string, memo string, vestingPeriods *uint64) error {
+// PayParticipantFromModule pays amount from moduleName, through a vesting schedule tagged with source if vestingPeriods is set
+func (k *Keeper) PayParticipantFromModule(ctx context.Context, address string, amount int64, moduleName string, source streamvestingtypes.VestingSource, memo string, vestingPeriods *uint64) error {
 	participantAddress, err := sdk.AccAddressFromBech32(address)
 	if err != nil {
 		return err
@@ -69,7 +71,7 @@ func (k *Keeper) PayParticipantFromModule(ctx context.Context, address string, a
 			return err
 		}
 		// Vesting keeper should move funds and create vesting schedule
-		err = k.GetStreamVestingKeeper().AddVestedRewards(ctx, address, types.ModuleName, vestingAmount, vestingEpochs, memo+"_vested")
+		err = k.GetStreamVestingKeeper().AddVestedRewardsFromSource(ctx, address, types.ModuleName, source, vestingAmount, vestingEpochs, memo+"_vested")
 		if err != nil {
 			k.LogError("Error adding vested payment", types.Payments, "error", err, "amount", vestingAmount)
 			return err
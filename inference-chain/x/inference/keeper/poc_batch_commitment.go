@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// CheckPoCBatchNoncesDisjoint rejects a committed batch whose nonce progression may share a nonce with another
+// batch the participant committed to for the same PoC stage, so committed weight can't count a nonce twice
+func (k Keeper) CheckPoCBatchNoncesDisjoint(ctx context.Context, batch types.PoCBatch) error {
+	addr, err := sdk.AccAddressFromBech32(batch.ParticipantAddress)
+	if err != nil {
+		return err
+	}
+	progression := batch.NonceProgression()
+	iter, err := k.PoCBatches.Iterate(ctx, collections.NewSuperPrefixedTripleRange[int64, sdk.AccAddress, string](batch.PocStageStartBlockHeight, addr))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		existing, err := iter.Value()
+		if err != nil {
+			return err
+		}
+		if existing.BatchId == batch.BatchId || !existing.IsCommitment() {
+			continue
+		}
+		if progression.Overlaps(existing.NonceProgression()) {
+			return sdkerrors.Wrapf(types.ErrPoCBatchNoncesOverlap, "batch %s overlaps batch %s", batch.BatchId, existing.BatchId)
+		}
+	}
+	return nil
+}
+
+// VerifyPoCLeafDisputes checks that every disputed leaf belongs to a batch the participant committed to for the
+// PoC stage, and that the distance the participant committed to fails the validation target. The distance the
+// validator recomputed is not trusted, so a dispute rests only on what the participant committed to
+func (k Keeper) VerifyPoCLeafDisputes(ctx context.Context, participant string, pocStageStartBlockHeight int64, rTarget float64, disputes []types.PoCLeafDispute) error {
+	if len(disputes) == 0 {
+		return nil
+	}
+	addr, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		return err
+	}
+
+	for i, dispute := range disputes {
+		batch, err := k.PoCBatches.Get(ctx, collections.Join3(pocStageStartBlockHeight, addr, dispute.BatchId))
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidPoCLeafDispute, "disputes[%d]: batch %s not found", i, dispute.BatchId)
+		}
+		if !batch.IsCommitment() {
+			return sdkerrors.Wrapf(types.ErrInvalidPoCLeafDispute, "disputes[%d]: batch %s was submitted in full", i, dispute.BatchId)
+		}
+		if !types.VerifyPoCBatchLeaf(batch.MerkleRoot, batch.NonceProgression(), dispute.LeafIndex, dispute.Nonce, dispute.ReceivedDist, dispute.Proof) {
+			return sdkerrors.Wrapf(types.ErrInvalidPoCLeafDispute, "disputes[%d]: leaf %d doesn't match the root of batch %s", i, dispute.LeafIndex, dispute.BatchId)
+		}
+		if dispute.ReceivedDist < rTarget {
+			return sdkerrors.Wrapf(types.ErrInvalidPoCLeafDispute, "disputes[%d]: committed leaf %d of batch %s meets the target", i, dispute.LeafIndex, dispute.BatchId)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/testutil"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestSubmitPocBatch_CommitmentsDisabledByDefault(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	_, err := ms.SubmitPocBatch(ctx, &types.MsgSubmitPocBatch{
+		Creator:                  testutil.Executor,
+		PocStageStartBlockHeight: 100,
+		BatchId:                  "committed",
+		NodeId:                   "node1",
+		MerkleRoot:               make([]byte, 32),
+		NonceCount:               10,
+	})
+	require.ErrorIs(t, err, types.ErrPoCBatchCommitmentsDisabled)
+}
+
+func TestVerifyPoCLeafDisputes(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	nonces := []int64{3, 9, 27}
+	dist := []float64{0.2, 0.9, 0.4}
+	root, progression, err := types.PoCBatchMerkleRoot(nonces, dist)
+	require.NoError(t, err)
+
+	k.SetPocBatch(ctx, committedBatch("committed", root, progression))
+	k.SetPocBatch(ctx, types.PoCBatch{
+		ParticipantAddress:       testutil.Executor,
+		PocStageStartBlockHeight: 100,
+		BatchId:                  "full",
+		Nonces:                   nonces,
+		Dist:                     dist,
+	})
+
+	dispute := func(batchId string, index int, recomputed float64) types.PoCLeafDispute {
+		proof, err := types.PoCBatchMerkleProof(nonces, dist, index)
+		require.NoError(t, err)
+		return types.PoCLeafDispute{BatchId: batchId, LeafIndex: uint32(index), Nonce: nonces[index], ReceivedDist: dist[index], Dist: recomputed, Proof: proof}
+	}
+	verify := func(disputes ...types.PoCLeafDispute) error {
+		return k.VerifyPoCLeafDisputes(ctx, testutil.Executor, 100, 0.5, disputes)
+	}
+
+	// Only a committed distance that misses the target can be disputed
+	require.NoError(t, verify(dispute("committed", 1, 0.1)))
+
+	require.ErrorIs(t, verify(dispute("committed", 0, 0.3)), types.ErrInvalidPoCLeafDispute)
+	// A recomputed distance above the target is the validator's word alone
+	require.ErrorIs(t, verify(dispute("committed", 0, 0.7)), types.ErrInvalidPoCLeafDispute)
+	require.ErrorIs(t, verify(dispute("full", 1, 0.1)), types.ErrInvalidPoCLeafDispute)
+	require.ErrorIs(t, verify(dispute("missing", 1, 0.1)), types.ErrInvalidPoCLeafDispute)
+	forged := dispute("committed", 2, 0.7)
+	forged.ReceivedDist = 0.6
+	require.ErrorIs(t, verify(forged), types.ErrInvalidPoCLeafDispute)
+	require.ErrorIs(t, k.VerifyPoCLeafDisputes(ctx, testutil.Executor2, 100, 0.5, []types.PoCLeafDispute{dispute("committed", 1, 0.1)}), types.ErrInvalidPoCLeafDispute)
+}
+
+func committedBatch(batchId string, root []byte, progression types.PoCNonceProgression) types.PoCBatch {
+	return types.PoCBatch{
+		ParticipantAddress:       testutil.Executor,
+		PocStageStartBlockHeight: 100,
+		BatchId:                  batchId,
+		MerkleRoot:               root,
+		NonceCount:               progression.Count,
+		FirstNonce:               progression.First,
+		LastNonce:                progression.Last,
+		NonceStride:              progression.Stride,
+	}
+}
+
+func TestCheckPoCBatchNoncesDisjoint(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	root := make([]byte, 32)
+	k.SetPocBatch(ctx, committedBatch("node0", root, types.PoCNonceProgression{Count: 10, First: 0, Last: 36, Stride: 4}))
+
+	// Another node interleaving over the same range, or a later batch of the same node, is accepted
+	require.NoError(t, k.CheckPoCBatchNoncesDisjoint(ctx, committedBatch("node1", root, types.PoCNonceProgression{Count: 10, First: 1, Last: 37, Stride: 4})))
+	require.NoError(t, k.CheckPoCBatchNoncesDisjoint(ctx, committedBatch("node0-next", root, types.PoCNonceProgression{Count: 5, First: 40, Last: 56, Stride: 4})))
+	// Resubmitting the same batch isn't an overlap with itself
+	require.NoError(t, k.CheckPoCBatchNoncesDisjoint(ctx, committedBatch("node0", root, types.PoCNonceProgression{Count: 10, First: 0, Last: 36, Stride: 4})))
+
+	// Claiming nonces of a committed batch again is rejected
+	err := k.CheckPoCBatchNoncesDisjoint(ctx, committedBatch("replay", root, types.PoCNonceProgression{Count: 3, First: 8, Last: 24, Stride: 8}))
+	require.ErrorIs(t, err, types.ErrPoCBatchNoncesOverlap)
+
+	// Other participants and stages are independent
+	other := committedBatch("replay", root, types.PoCNonceProgression{Count: 3, First: 8, Last: 24, Stride: 8})
+	other.ParticipantAddress = testutil.Executor2
+	require.NoError(t, k.CheckPoCBatchNoncesDisjoint(ctx, other))
+	other = committedBatch("replay", root, types.PoCNonceProgression{Count: 3, First: 8, Last: 24, Stride: 8})
+	other.PocStageStartBlockHeight = 200
+	require.NoError(t, k.CheckPoCBatchNoncesDisjoint(ctx, other))
+}
@@ -29,6 +29,14 @@ func (k Keeper) Prune(ctx context.Context, currentEpochIndex int64) error {
 	if err != nil {
 		return err
 	}
+	err = k.GetEpochInvalidatorsPruner(params).Prune(ctx, k, currentEpochIndex)
+	if err != nil {
+		return err
+	}
+	err = k.GetBridgeAttestationsPruner(params).Prune(ctx, k, currentEpochIndex)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -51,6 +59,10 @@ func (k Keeper) GetInferencePruner(params types.Params) Pruner[collections.Pair[
 			if err != nil {
 				return err
 			}
+			err = k.InvalidationEffectsMap.Remove(ctx, key.K2())
+			if err != nil {
+				return err
+			}
 			return k.InferencesToPrune.Remove(ctx, key)
 		},
 		Logger: k,
@@ -111,6 +123,57 @@ func (k Keeper) GetPoCValidationsPruner(params types.Params) Pruner[collections.
 	}
 }
 
+// GetEpochInvalidatorsPruner drops invalidator records once their epoch can no longer be slashed for
+func (k Keeper) GetEpochInvalidatorsPruner(params types.Params) Pruner[collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], collections.NoValue] {
+	return Pruner[collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], collections.NoValue]{
+		Threshold:  params.EpochParams.InferencePruningEpochThreshold,
+		PruningMax: params.EpochParams.InferencePruningMax,
+		List:       collections.Map[collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], collections.NoValue](k.EpochInvalidators),
+		Ranger: func(ctx context.Context, epoch int64) collections.Ranger[collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress]] {
+			return collections.NewPrefixedTripleRange[uint64, sdk.AccAddress, sdk.AccAddress](uint64(epoch))
+		},
+		GetLastPruned: func(state types.PruningState) int64 {
+			return state.EpochInvalidatorsPrunedEpoch
+		},
+		SetLastPruned: func(state *types.PruningState, epoch int64) {
+			state.EpochInvalidatorsPrunedEpoch = epoch
+		},
+		Remover: func(ctx context.Context, key collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress]) error {
+			return k.EpochInvalidators.Remove(ctx, key)
+		},
+		Logger: k,
+	}
+}
+
+// GetBridgeAttestationsPruner drops receipts roots that were proposed but never attested, with their votes.
+// Attested roots are kept, receipt proofs for the block are verified against them.
+func (k Keeper) GetBridgeAttestationsPruner(params types.Params) Pruner[collections.Triple[uint64, string, string], collections.NoValue] {
+	return Pruner[collections.Triple[uint64, string, string], collections.NoValue]{
+		Threshold:  params.EpochParams.InferencePruningEpochThreshold,
+		PruningMax: params.EpochParams.InferencePruningMax,
+		List:       collections.Map[collections.Triple[uint64, string, string], collections.NoValue](k.BridgeAttestationsToPrune),
+		Ranger: func(ctx context.Context, epoch int64) collections.Ranger[collections.Triple[uint64, string, string]] {
+			return collections.NewPrefixedTripleRange[uint64, string, string](uint64(epoch))
+		},
+		GetLastPruned: func(state types.PruningState) int64 {
+			return state.BridgeAttestationsPrunedEpoch
+		},
+		SetLastPruned: func(state *types.PruningState, epoch int64) {
+			state.BridgeAttestationsPrunedEpoch = epoch
+		},
+		Remover: func(ctx context.Context, key collections.Triple[uint64, string, string]) error {
+			err := k.removeBridgeBlockVoting(ctx, key.K2(), key.K3(), func(attestation types.BridgeBlockAttestation) bool {
+				return !attestation.Attested && attestation.EpochIndex <= key.K1()
+			})
+			if err != nil {
+				return err
+			}
+			return k.BridgeAttestationsToPrune.Remove(ctx, key)
+		},
+		Logger: k,
+	}
+}
+
 type Pruner[K any, V any] struct {
 	Threshold     uint64
 	PruningMax    int64
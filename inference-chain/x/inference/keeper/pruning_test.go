@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"testing"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/productscience/inference/testutil/keeper"
 	"github.com/productscience/inference/x/inference/keeper"
@@ -384,3 +385,23 @@ func TestPoCBatchesPruningMaxLimit_MultiCall_EpochAdvanceAfterEmpty(t *testing.T
 	st, _ = k.PruningState.Get(ctx)
 	require.Equal(t, int64(2), st.PocBatchesPrunedEpoch)
 }
+
+func TestEpochInvalidatorsPruning(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	require.NoError(t, k.PruningState.Set(ctx, types.PruningState{}))
+	executor := sdk.MustAccAddressFromBech32(mkAddr(1))
+	invalidator := sdk.MustAccAddressFromBech32(mkAddr(2))
+	for epoch := uint64(1); epoch <= 4; epoch++ {
+		require.NoError(t, k.EpochInvalidators.Set(ctx, collections.Join3(epoch, executor, invalidator)))
+	}
+
+	require.NoError(t, k.Prune(ctx, 4)) // default threshold 2 prunes epochs 1 and 2
+
+	for epoch := uint64(1); epoch <= 4; epoch++ {
+		has, err := k.EpochInvalidators.Has(ctx, collections.Join3(epoch, executor, invalidator))
+		require.NoError(t, err)
+		require.Equal(t, epoch > 2, has, "epoch %d", epoch)
+	}
+	k.SetEffectiveEpochIndex(ctx, 4)
+	require.Equal(t, []sdk.AccAddress{invalidator}, k.GetEpochInvalidators(ctx, executor))
+}
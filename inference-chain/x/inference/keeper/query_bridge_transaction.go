@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/productscience/inference/x/inference/types"
 	"google.golang.org/grpc/codes"
@@ -50,3 +52,50 @@ func (k Keeper) BridgeTransactions(goCtx context.Context, req *types.QueryAllBri
 		Pagination:         pageRes,
 	}, nil
 }
+
+func (k Keeper) BridgeBlockAttestations(ctx context.Context, req *types.QueryBridgeBlockAttestationsRequest) (*types.QueryBridgeBlockAttestationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	attestations, err := k.GetBridgeBlockAttestations(ctx, req.OriginChain, req.BlockNumber)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryBridgeBlockAttestationsResponse{Attestations: attestations}, nil
+}
+
+func (k Keeper) BridgeCapacity(goCtx context.Context, req *types.QueryBridgeCapacityRequest) (*types.QueryBridgeCapacityResponse, error) {
+	if req == nil || req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	limits, err := k.GetBridgeRateLimitsByChain(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	capacities := make([]types.BridgeRateLimitCapacity, 0, len(limits))
+	for _, limit := range limits {
+		capacity := types.BridgeRateLimitCapacity{RateLimit: limit}
+		_, inboundUsed := k.bridgeVolumeInWindow(ctx, limit, BridgeDirectionInbound)
+		_, outboundUsed := k.bridgeVolumeInWindow(ctx, limit, BridgeDirectionOutbound)
+		capacity.InboundUsed = inboundUsed.String()
+		capacity.OutboundUsed = outboundUsed.String()
+		if maxVolume, ok := math.NewIntFromString(limit.MaxWindowVolume); ok {
+			capacity.InboundRemaining = math.MaxInt(maxVolume.Sub(inboundUsed), math.ZeroInt()).String()
+			capacity.OutboundRemaining = math.MaxInt(maxVolume.Sub(outboundUsed), math.ZeroInt()).String()
+		}
+		capacities = append(capacities, capacity)
+	}
+
+	held, err := k.GetHeldBridgeTransactions(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryBridgeCapacityResponse{
+		Status:           k.GetBridgeChainStatus(ctx, req.ChainId),
+		Limits:           capacities,
+		HeldTransactions: held,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) InvalidationAppealAll(ctx context.Context, req *types.QueryAllInvalidationAppealRequest) (*types.QueryAllInvalidationAppealResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	appeals, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.InvalidationAppealsMap,
+		req.Pagination,
+		func(_ string, v types.InvalidationAppeal) (types.InvalidationAppeal, error) { return v, nil },
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAllInvalidationAppealResponse{Appeal: appeals, Pagination: pageRes}, nil
+}
+
+func (k Keeper) InvalidationAppeal(ctx context.Context, req *types.QueryGetInvalidationAppealRequest) (*types.QueryGetInvalidationAppealResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	appeal, found := k.GetInvalidationAppeal(ctx, req.InferenceId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &types.QueryGetInvalidationAppealResponse{Appeal: appeal}, nil
+}
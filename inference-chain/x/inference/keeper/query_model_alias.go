@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ResolveModel(ctx context.Context, req *types.QueryResolveModelRequest) (*types.QueryResolveModelResponse, error) {
+	if req == nil || req.Model == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	model, found := k.ResolveModelId(ctx, req.Model)
+	if !found {
+		return nil, status.Error(codes.NotFound, "model not found")
+	}
+	response := &types.QueryResolveModelResponse{Model: *model}
+	if model.Id != req.Model {
+		response.Alias = req.Model
+	}
+	return response, nil
+}
+
+func (k Keeper) ModelAliasAll(ctx context.Context, req *types.QueryAllModelAliasRequest) (*types.QueryAllModelAliasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	aliases, err := k.GetAllModelAliases(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAllModelAliasResponse{Aliases: aliases}, nil
+}
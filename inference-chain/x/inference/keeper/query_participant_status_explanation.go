@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ParticipantStatusExplanation reports the inputs and intermediate results behind a participant's status and reputation.
+// For the current effective epoch (or req.EpochIndex == 0) the live counters and LLRs are evaluated.
+// For past epochs only the settled performance summary and the epoch's group membership are available, so the SPRT
+// state is omitted and the status and reputation are the ones the participant had in that epoch.
+func (k Keeper) ParticipantStatusExplanation(ctx context.Context, req *types.QueryParticipantStatusExplanationRequest) (*types.QueryParticipantStatusExplanationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	participant, found := k.GetParticipant(ctx, req.Address)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	params := k.GetParams(ctx)
+	effectiveEpoch, _ := k.GetEffectiveEpochIndex(ctx)
+	epochIndex := req.EpochIndex
+	if epochIndex == 0 {
+		epochIndex = effectiveEpoch
+	}
+
+	var explanation types.ParticipantStatusExplanation
+	if epochIndex == effectiveEpoch {
+		explanation = calculations.ExplainStatus(params.ValidationParams, params.ConfirmationPocParams, participant)
+		explanation.Reputation = k.CalculateParticipantReputation(ctx, req.Address, params.ValidationParams)
+	} else {
+		summary, found := k.GetEpochPerformanceSummary(ctx, epochIndex, req.Address)
+		if !found {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+		// The epoch's group data holds the reputation the participant was admitted with. Anyone in it was active
+		// for the epoch, unless excluded, which is applied below.
+		groupData, found := k.GetEpochGroupData(ctx, epochIndex, "")
+		if !found {
+			return nil, status.Error(codes.NotFound, "epoch group data not found")
+		}
+		weight := groupData.ValidationWeight(req.Address)
+		if weight == nil {
+			return nil, status.Errorf(codes.NotFound, "participant was not a member of epoch %d", epochIndex)
+		}
+		explanation = types.ParticipantStatusExplanation{
+			Participant:           req.Address,
+			Status:                types.ParticipantStatus_ACTIVE,
+			InferenceCount:        summary.InferenceCount,
+			MissedRequests:        summary.MissedRequests,
+			ValidatedInferences:   summary.ValidatedInferences,
+			InvalidatedInferences: summary.InvalidatedInferences,
+			Reputation:            int64(weight.Reputation),
+		}
+		calculations.ExplainMissedRequests(&explanation, params.ValidationParams)
+	}
+	explanation.EpochIndex = epochIndex
+
+	excluded, err := k.ExcludedParticipantsMap.Get(ctx, collections.Join(epochIndex, addr))
+	if err == nil {
+		explanation.ExclusionReason = excluded.Reason
+		explanation.ExclusionBlockHeight = excluded.ExclusionBlockHeight
+		if !explanation.Live {
+			explanation.Status = calculations.StatusForReason(calculations.ParticipantStatusReason(excluded.Reason))
+			explanation.StatusReason = excluded.Reason
+		}
+	}
+
+	return &types.QueryParticipantStatusExplanationResponse{Explanation: explanation}, nil
+}
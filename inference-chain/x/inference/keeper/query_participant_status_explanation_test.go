@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestParticipantStatusExplanationQuery(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+	k.SetEffectiveEpochIndex(ctx, 3)
+
+	address := sample.AccAddress()
+	require.NoError(t, k.SetParticipant(ctx, types.Participant{
+		Index:                        address,
+		Address:                      address,
+		Status:                       types.ParticipantStatus_ACTIVE,
+		ConsecutiveInvalidInferences: 2,
+		EpochsCompleted:              2,
+		CurrentEpochStats: &types.CurrentEpochStats{
+			InferenceCount:      40,
+			MissedRequests:      1,
+			ValidatedInferences: 20,
+			InvalidLLR:          types.DecimalFromFloat(0),
+			InactiveLLR:         types.DecimalFromFloat(0),
+		},
+	}))
+	require.NoError(t, k.SetEpochPerformanceSummary(ctx, types.EpochPerformanceSummary{
+		EpochIndex:     2,
+		ParticipantId:  address,
+		InferenceCount: 100,
+		MissedRequests: 30,
+	}))
+	k.SetEpochGroupData(ctx, types.EpochGroupData{
+		EpochIndex:        2,
+		ValidationWeights: []*types.ValidationWeight{{MemberAddress: address, Weight: 10, Reputation: 35}},
+	})
+	require.NoError(t, k.ExcludedParticipantsMap.Set(ctx, collections.Join(uint64(2), sdk.MustAccAddressFromBech32(address)), types.ExcludedParticipant{
+		Address:              address,
+		EpochIndex:           2,
+		Reason:               string(calculations.Downtime),
+		ExclusionBlockHeight: 77,
+	}))
+
+	live, err := k.ParticipantStatusExplanation(ctx, &types.QueryParticipantStatusExplanationRequest{Address: address})
+	require.NoError(t, err)
+	require.True(t, live.Explanation.Live)
+	require.Equal(t, uint64(3), live.Explanation.EpochIndex)
+	require.Equal(t, uint64(40), live.Explanation.InferenceCount)
+	require.Equal(t, int64(2), live.Explanation.ConsecutiveInvalidInferences)
+	require.NotNil(t, live.Explanation.InvalidationSprt)
+	require.Empty(t, live.Explanation.StatusReason)
+	require.Empty(t, live.Explanation.ExclusionReason)
+
+	past, err := k.ParticipantStatusExplanation(ctx, &types.QueryParticipantStatusExplanationRequest{Address: address, EpochIndex: 2})
+	require.NoError(t, err)
+	require.False(t, past.Explanation.Live)
+	require.Equal(t, uint64(100), past.Explanation.InferenceCount)
+	require.Equal(t, 0.3, past.Explanation.MissPercentage.ToFloat())
+	require.False(t, past.Explanation.DowntimeTestPassed)
+	require.Equal(t, types.ParticipantStatus_INACTIVE, past.Explanation.Status)
+	require.Equal(t, string(calculations.Downtime), past.Explanation.ExclusionReason)
+	require.Equal(t, uint64(77), past.Explanation.ExclusionBlockHeight)
+	require.Equal(t, int64(35), past.Explanation.Reputation)
+	require.Zero(t, past.Explanation.EpochsCompleted)
+
+	_, err = k.ParticipantStatusExplanation(ctx, &types.QueryParticipantStatusExplanationRequest{Address: address, EpochIndex: 1})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	// A summary without membership in the epoch's group can't tell the status, so it isn't guessed
+	require.NoError(t, k.SetEpochPerformanceSummary(ctx, types.EpochPerformanceSummary{EpochIndex: 1, ParticipantId: address}))
+	k.SetEpochGroupData(ctx, types.EpochGroupData{EpochIndex: 1})
+	_, err = k.ParticipantStatusExplanation(ctx, &types.QueryParticipantStatusExplanationRequest{Address: address, EpochIndex: 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = k.ParticipantStatusExplanation(ctx, &types.QueryParticipantStatusExplanationRequest{Address: sample.AccAddress()})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/log"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultProjectionEpochs = 10
+	maxProjectionEpochs     = 1000
+)
+
+// TokenomicsProjection projects reward issuance for the next epochs by running the settlement reward calculations on
+// the current participants and weights, under the current params or hypothetical ones.
+func (k Keeper) TokenomicsProjection(ctx context.Context, req *types.QueryTokenomicsProjectionRequest) (*types.QueryTokenomicsProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	epochs := req.Epochs
+	if epochs == 0 {
+		epochs = defaultProjectionEpochs
+	}
+	if epochs > maxProjectionEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d epochs can be projected", maxProjectionEpochs)
+	}
+
+	params := k.GetParams(ctx)
+	if req.Params != nil {
+		params = *req.Params
+	}
+	projection, err := k.projectTokenomics(ctx, params, epochs)
+	if err != nil {
+		return nil, err
+	}
+	response := &types.QueryTokenomicsProjectionResponse{Projection: *projection}
+
+	if req.AlternativeParams != nil {
+		response.AlternativeProjection, err = k.projectTokenomics(ctx, *req.AlternativeParams, epochs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (k Keeper) projectTokenomics(ctx context.Context, params types.Params, epochs uint32) (*types.TokenomicsProjection, error) {
+	if err := params.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid params: %v", err)
+	}
+	settleParams, err := k.getSettleParametersForParams(ctx, params)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	epochIndex, found := k.GetEffectiveEpochIndex(ctx)
+	if !found {
+		return nil, status.Error(codes.FailedPrecondition, "no effective epoch")
+	}
+	epochGroupData, found := k.GetEpochGroupData(ctx, epochIndex, "")
+	if !found {
+		return nil, status.Error(codes.NotFound, "epoch group data not found")
+	}
+	participants := k.projectionParticipants(ctx, epochIndex)
+	participantMLNodes := k.AggregateMLNodesFromModelSubgroups(ctx, epochIndex, epochGroupData.ValidationWeights)
+
+	projection := &types.TokenomicsProjection{
+		UseBitcoinRewards:   params.BitcoinRewardParams.UseBitcoinRewards,
+		TotalSubsidySupply:  settleParams.TotalSubsidySupply,
+		StartingSubsidyPaid: settleParams.TotalSubsidyPaid,
+	}
+	rewards := make(map[string]*types.ParticipantRewardProjection, len(participants))
+	for _, participant := range participants {
+		rewards[participant.Address] = &types.ParticipantRewardProjection{
+			Address: participant.Address,
+			Weight:  int64(GetParticipantPoCWeight(participant.Address, &epochGroupData)),
+		}
+	}
+
+	tokenomicsParams := *params.TokenomicsParams
+	logger := log.NewNopLogger()
+	var totalDistributed uint64
+	for i := uint32(0); i < epochs; i++ {
+		epoch := &types.EpochRewardProjection{EpochIndex: epochIndex + uint64(i)}
+		var amounts []*SettleResult
+		if params.BitcoinRewardParams.UseBitcoinRewards {
+			epochData := epochGroupData
+			epochData.EpochIndex = epoch.EpochIndex
+			var result BitcoinResult
+			amounts, result, err = GetBitcoinSettleAmounts(participants, &epochData, params.BitcoinRewardParams, params.ValidationParams, settleParams, participantMLNodes, logger)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			epoch.RewardAmount = result.Amount
+			epoch.GovernanceAmount = result.GovernanceAmount
+		} else {
+			epoch.SubsidyPercentage = tokenomicsParams.CurrentSubsidyPercentage
+			var result SubsidyResult
+			amounts, result, err = GetSettleAmounts(participants, settleParams, logger)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			epoch.RewardAmount = result.Amount
+			if result.CrossedCutoff {
+				tokenomicsParams.ReduceSubsidyPercentage()
+				settleParams.CurrentSubsidyPercentage = tokenomicsParams.CurrentSubsidyPercentage.ToFloat32()
+			}
+		}
+
+		for j, amount := range amounts {
+			if amount.Error != nil || amount.Settle == nil {
+				continue
+			}
+			reward := rewards[participants[j].Address]
+			if i == 0 {
+				reward.FirstEpochReward = amount.Settle.RewardCoins
+			}
+			reward.TotalReward += amount.Settle.RewardCoins
+			epoch.DistributedAmount += int64(amount.Settle.RewardCoins)
+			totalDistributed += amount.Settle.RewardCoins
+		}
+
+		settleParams.TotalSubsidyPaid += epoch.RewardAmount
+		epoch.TotalSubsidyPaid = settleParams.TotalSubsidyPaid
+		projection.TotalIssuance += epoch.RewardAmount
+		if projection.SupplyExhaustedEpoch == 0 && settleParams.TotalSubsidyPaid >= settleParams.TotalSubsidySupply {
+			projection.SupplyExhaustedEpoch = epoch.EpochIndex
+		}
+		projection.Epochs = append(projection.Epochs, epoch)
+	}
+
+	for _, participant := range participants {
+		reward := rewards[participant.Address]
+		if totalDistributed > 0 {
+			share := decimal.NewFromUint64(reward.TotalReward).Div(decimal.NewFromUint64(totalDistributed)).Round(6)
+			reward.RewardShare = types.DecimalFromDecimal(share)
+		}
+		projection.Participants = append(projection.Participants, reward)
+	}
+	return projection, nil
+}
+
+// projectionParticipants returns the active participants of the epoch as if they stay active without missed
+// requests, keeping their current coin balance as the work of every projected epoch
+func (k Keeper) projectionParticipants(ctx context.Context, epochIndex uint64) []types.Participant {
+	activeParticipants, found := k.GetActiveParticipants(ctx, epochIndex)
+	if !found {
+		return []types.Participant{}
+	}
+	addresses := make([]string, len(activeParticipants.Participants))
+	for i, participant := range activeParticipants.Participants {
+		addresses[i] = participant.Index
+	}
+	participants := k.GetParticipants(ctx, addresses)
+	for i := range participants {
+		participants[i].Status = types.ParticipantStatus_ACTIVE
+		participants[i].CurrentEpochStats = types.NewCurrentEpochStats()
+	}
+	if participants == nil {
+		return []types.Participant{}
+	}
+	return participants
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func setupProjectionState(t *testing.T, k keeper.Keeper, ctx sdk.Context, epochIndex uint64, weights []int64) []string {
+	k.SetEffectiveEpochIndex(ctx, epochIndex)
+	k.SetTokenomicsData(ctx, types.TokenomicsData{TotalSubsidies: 1000})
+	genesisOnlyParams := types.DefaultGenesisOnlyParams()
+	k.SetGenesisOnlyParams(ctx, &genesisOnlyParams)
+	groupData := types.EpochGroupData{EpochIndex: epochIndex}
+	active := types.ActiveParticipants{EpochId: epochIndex}
+	var addresses []string
+	for _, weight := range weights {
+		address := sample.AccAddress()
+		addresses = append(addresses, address)
+		require.NoError(t, k.SetParticipant(ctx, types.Participant{
+			Index:       address,
+			Address:     address,
+			Status:      types.ParticipantStatus_ACTIVE,
+			CoinBalance: 1000,
+		}))
+		groupData.ValidationWeights = append(groupData.ValidationWeights, &types.ValidationWeight{
+			MemberAddress:      address,
+			Weight:             weight,
+			ConfirmationWeight: weight,
+		})
+		active.Participants = append(active.Participants, &types.ActiveParticipant{Index: address, Weight: weight})
+	}
+	k.SetEpochGroupData(ctx, groupData)
+	k.SetActiveParticipants(ctx, active)
+	return addresses
+}
+
+func TestTokenomicsProjection_BitcoinRewards(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	params := types.DefaultParams()
+	params.BitcoinRewardParams.UseBitcoinRewards = true
+	require.NoError(t, k.SetParams(ctx, params))
+	addresses := setupProjectionState(t, k, ctx, 5, []int64{100, 100, 100, 100})
+
+	resp, err := k.TokenomicsProjection(ctx, &types.QueryTokenomicsProjectionRequest{Epochs: 4})
+	require.NoError(t, err)
+	projection := resp.Projection
+	require.Nil(t, resp.AlternativeProjection)
+	require.True(t, projection.UseBitcoinRewards)
+	require.Len(t, projection.Epochs, 4)
+	require.Equal(t, uint64(5), projection.Epochs[0].EpochIndex)
+
+	expectedFirst := keeper.CalculateFixedEpochReward(5-params.BitcoinRewardParams.GenesisEpoch, params.BitcoinRewardParams.InitialEpochReward, params.BitcoinRewardParams.DecayRate)
+	require.Equal(t, int64(expectedFirst), projection.Epochs[0].RewardAmount)
+
+	total := int64(0)
+	for i, epoch := range projection.Epochs {
+		total += epoch.RewardAmount
+		require.Equal(t, projection.StartingSubsidyPaid+total, epoch.TotalSubsidyPaid)
+		if i > 0 {
+			require.LessOrEqual(t, epoch.RewardAmount, projection.Epochs[i-1].RewardAmount)
+		}
+	}
+	require.Equal(t, total, projection.TotalIssuance)
+
+	require.Len(t, projection.Participants, len(addresses))
+	for _, participant := range projection.Participants {
+		require.Equal(t, int64(100), participant.Weight)
+		require.Equal(t, expectedFirst/4, participant.FirstEpochReward)
+		require.Equal(t, "0.25", participant.RewardShare.ToDecimal().String())
+	}
+}
+
+func TestTokenomicsProjection_ComparesAlternativeParams(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	params := types.DefaultParams()
+	params.BitcoinRewardParams.UseBitcoinRewards = true
+	require.NoError(t, k.SetParams(ctx, params))
+	setupProjectionState(t, k, ctx, 5, []int64{100, 100, 100, 100})
+
+	alternative := types.DefaultParams()
+	alternative.BitcoinRewardParams.UseBitcoinRewards = true
+	alternative.BitcoinRewardParams.InitialEpochReward = params.BitcoinRewardParams.InitialEpochReward * 2
+
+	resp, err := k.TokenomicsProjection(ctx, &types.QueryTokenomicsProjectionRequest{Epochs: 2, AlternativeParams: &alternative})
+	require.NoError(t, err)
+	require.NotNil(t, resp.AlternativeProjection)
+	require.InDelta(t, 2*resp.Projection.Epochs[0].RewardAmount, resp.AlternativeProjection.Epochs[0].RewardAmount, 1)
+
+	legacy := types.DefaultParams()
+	legacy.BitcoinRewardParams.UseBitcoinRewards = false
+	resp, err = k.TokenomicsProjection(ctx, &types.QueryTokenomicsProjectionRequest{Epochs: 2, Params: &legacy})
+	require.NoError(t, err)
+	require.False(t, resp.Projection.UseBitcoinRewards)
+	require.Positive(t, resp.Projection.Epochs[0].RewardAmount)
+	require.NotNil(t, resp.Projection.Epochs[0].SubsidyPercentage)
+}
+
+func TestTokenomicsProjection_InvalidRequests(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+	setupProjectionState(t, k, ctx, 5, []int64{100})
+
+	_, err := k.TokenomicsProjection(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = k.TokenomicsProjection(ctx, &types.QueryTokenomicsProjectionRequest{Epochs: 100000})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	invalid := types.DefaultParams()
+	invalid.ValidationParams = nil
+	_, err = k.TokenomicsProjection(ctx, &types.QueryTokenomicsProjectionRequest{Params: &invalid})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
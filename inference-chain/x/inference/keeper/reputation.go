@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/shopspring/decimal"
+)
+
+// CalculateParticipantReputation computes reputation from the miss percentage of every epoch the participant has a performance summary for
+func (k Keeper) CalculateParticipantReputation(ctx context.Context, participantId string, params *types.ValidationParams) int64 {
+	summaries := k.GetEpochPerformanceSummariesByParticipant(ctx, participantId)
+
+	reputationContext := calculations.ReputationContext{
+		EpochCount:           int64(len(summaries)),
+		EpochMissPercentages: make([]decimal.Decimal, len(summaries)),
+		ValidationParams:     params,
+	}
+
+	for i, summary := range summaries {
+		inferenceCount := decimal.NewFromInt(int64(summary.InferenceCount))
+		if inferenceCount.IsZero() {
+			reputationContext.EpochMissPercentages[i] = decimal.Zero
+			continue
+		}
+
+		missed := decimal.NewFromInt(int64(summary.MissedRequests))
+		reputationContext.EpochMissPercentages[i] = missed.Div(inferenceCount)
+	}
+
+	return calculations.CalculateReputation(&reputationContext)
+}
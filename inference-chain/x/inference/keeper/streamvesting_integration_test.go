@@ -80,6 +80,7 @@ func setupRealStreamVestingKeepers(t testing.TB) (sdk.Context, keeper.Keeper, st
 		runtime.NewKVStoreService(blsStoreKey),
 		keepertest.PrintlnLogger{},
 		authority.String(),
+		bookkeepingBankKeeper,
 	)
 
 	upgradeMock := keepertest.NewMockUpgradeKeeper(ctrl)
@@ -137,7 +138,7 @@ func TestVestingIntegration_ParameterBased(t *testing.T) {
 	// Mock expectations for vesting flow (escrow payment goes through inference module)
 	expectedWorkCoins := sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, int64(workAmount)))
 	mocks.StreamVestingKeeper.EXPECT().
-		AddVestedRewards(ctx, participantAddrStr, "inference", expectedWorkCoins, &workVestingPeriod, gomock.Any()).
+		AddVestedRewardsFromSource(ctx, participantAddrStr, "inference", streamvestingtypes.VestingSource_VESTING_SOURCE_WORK, expectedWorkCoins, &workVestingPeriod, gomock.Any()).
 		Return(nil)
 
 	// Execute payment from escrow
@@ -150,11 +151,11 @@ func TestVestingIntegration_ParameterBased(t *testing.T) {
 
 	expectedRewardCoins := sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, int64(rewardAmount)))
 	mocks.StreamVestingKeeper.EXPECT().
-		AddVestedRewards(ctx, participantAddrStr, "inference", expectedRewardCoins, &rewardVestingPeriod, gomock.Any()).
+		AddVestedRewardsFromSource(ctx, participantAddrStr, "inference", streamvestingtypes.VestingSource_VESTING_SOURCE_SUBSIDY, expectedRewardCoins, &rewardVestingPeriod, gomock.Any()).
 		Return(nil)
 
 	// Execute payment from top reward pool module
-	err = k.PayParticipantFromModule(ctx, participantAddrStr, rewardAmount, types.TopRewardPoolAccName, "reward-memo", &rewardVestingPeriod)
+	err = k.PayParticipantFromModule(ctx, participantAddrStr, rewardAmount, types.TopRewardPoolAccName, streamvestingtypes.VestingSource_VESTING_SOURCE_SUBSIDY, "reward-memo", &rewardVestingPeriod)
 	require.NoError(t, err)
 }
 
@@ -186,7 +187,7 @@ func TestVestingIntegration_DirectPayment(t *testing.T) {
 	// No vesting keeper calls should be made
 
 	// Execute payment with zero vesting period
-	err = k.PayParticipantFromModule(ctx, participantAddrStr, amount, types.TopRewardPoolAccName, "direct-payment", &zeroVestingPeriod)
+	err = k.PayParticipantFromModule(ctx, participantAddrStr, amount, types.TopRewardPoolAccName, streamvestingtypes.VestingSource_VESTING_SOURCE_SUBSIDY, "direct-payment", &zeroVestingPeriod)
 	require.NoError(t, err)
 }
 
@@ -278,10 +279,10 @@ func TestVestingIntegration_MixedVestingScenario(t *testing.T) {
 
 	expectedRewardCoins := sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, int64(rewardAmount)))
 	mocks.StreamVestingKeeper.EXPECT().
-		AddVestedRewards(ctx, participantAddrStr, "inference", expectedRewardCoins, &rewardVestingPeriod, gomock.Any()).
+		AddVestedRewardsFromSource(ctx, participantAddrStr, "inference", streamvestingtypes.VestingSource_VESTING_SOURCE_SUBSIDY, expectedRewardCoins, &rewardVestingPeriod, gomock.Any()).
 		Return(nil)
 
-	err = k.PayParticipantFromModule(ctx, participantAddrStr, rewardAmount, types.TopRewardPoolAccName, "reward-payment", &rewardVestingPeriod)
+	err = k.PayParticipantFromModule(ctx, participantAddrStr, rewardAmount, types.TopRewardPoolAccName, streamvestingtypes.VestingSource_VESTING_SOURCE_SUBSIDY, "reward-payment", &rewardVestingPeriod)
 	require.NoError(t, err)
 }
 
@@ -300,11 +301,11 @@ func TestVestingIntegration_TopMinerRewards(t *testing.T) {
 	expectedCoins := sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, int64(rewardAmount)))
 
 	mocks.StreamVestingKeeper.EXPECT().
-		AddVestedRewards(ctx, participantAddrStr, "inference", expectedCoins, &topMinerVestingPeriod, gomock.Any()).
+		AddVestedRewardsFromSource(ctx, participantAddrStr, "inference", streamvestingtypes.VestingSource_VESTING_SOURCE_TOP_MINER, expectedCoins, &topMinerVestingPeriod, gomock.Any()).
 		Return(nil)
 
 	// Execute top miner reward payment
-	err := k.PayParticipantFromModule(ctx, participantAddrStr, rewardAmount, types.TopRewardPoolAccName, "top-miner-reward", &topMinerVestingPeriod)
+	err := k.PayParticipantFromModule(ctx, participantAddrStr, rewardAmount, types.TopRewardPoolAccName, streamvestingtypes.VestingSource_VESTING_SOURCE_TOP_MINER, "top-miner-reward", &topMinerVestingPeriod)
 	require.NoError(t, err)
 }
 
@@ -339,10 +340,10 @@ func TestVestingIntegration_ErrorHandling(t *testing.T) {
 
 	// Test case 2: Vesting keeper failure should be handled
 	mocks.StreamVestingKeeper.EXPECT().
-		AddVestedRewards(ctx, participantAddrStr, types.ModuleName, expectedCoins, &vestingPeriod, gomock.Any()).
+		AddVestedRewardsFromSource(ctx, participantAddrStr, types.ModuleName, streamvestingtypes.VestingSource_VESTING_SOURCE_SUBSIDY, expectedCoins, &vestingPeriod, gomock.Any()).
 		Return(fmt.Errorf("invalid request"))
 
-	err := k.PayParticipantFromModule(ctx, participantAddrStr, amount, types.TopRewardPoolAccName, "vesting-error-test", &vestingPeriod)
+	err := k.PayParticipantFromModule(ctx, participantAddrStr, amount, types.TopRewardPoolAccName, streamvestingtypes.VestingSource_VESTING_SOURCE_SUBSIDY, "vesting-error-test", &vestingPeriod)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "invalid request")
 }
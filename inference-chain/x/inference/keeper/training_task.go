@@ -124,6 +124,19 @@ func (k Keeper) RemoveTaskFromInProgress(ctx sdk.Context, taskId uint64) error {
 	return nil
 }
 
+// RemoveTaskFromQueue removes a task that was never assigned from the queued set.
+func (k Keeper) RemoveTaskFromQueue(ctx sdk.Context, taskId uint64) error {
+	store := EmptyPrefixStore(ctx, &k)
+
+	queuedKey := types.QueuedTrainingTaskFullKey(taskId)
+	if !store.Has(queuedKey) {
+		return fmt.Errorf("task %d is not queued", taskId)
+	}
+	store.Delete(queuedKey)
+
+	return nil
+}
+
 // GetTrainingTask retrieves the full task object given its taskId.
 func (k Keeper) GetTrainingTask(ctx sdk.Context, taskId uint64) (*types.TrainingTask, bool) {
 	var task types.TrainingTask
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// EscrowTrainingBudget locks the budget of a new training task in the module account
+func (k Keeper) EscrowTrainingBudget(ctx sdk.Context, task *types.TrainingTask) error {
+	if task.Budget == nil || task.Budget.Total == 0 {
+		return nil
+	}
+	if task.Budget.Total > math.MaxInt64 {
+		return sdkerrors.Wrapf(types.ErrInvalidTrainingBudget, "budget %d is too large", task.Budget.Total)
+	}
+	requester, err := sdk.AccAddressFromBech32(task.RequestedBy)
+	if err != nil {
+		return err
+	}
+	coins, err := types.GetCoins(int64(task.Budget.Total))
+	if err != nil {
+		return err
+	}
+	err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleName, coins, "escrow for training task:"+strconv.FormatUint(task.Id, 10))
+	if err != nil {
+		k.LogError("Error sending training budget to escrow", types.Training, "taskId", task.Id, "error", err)
+		return sdkerrors.Wrap(err, types.ErrRequesterCannotPay.Error())
+	}
+	k.LogInfo("Training budget escrowed", types.Training, "taskId", task.Id, "budget", task.Budget.Total, "requester", task.RequestedBy)
+	return nil
+}
+
+// SettleTrainingPayments pays the assignees of a training task for every outer step from the first unsettled one
+// through throughOuterStep. A node earns the per node epoch payment for an outer step when it was ranked in it,
+// sent a heartbeat, and its participant committed a checkpoint hash for the step. Payments stop when the budget
+// runs out.
+func (k Keeper) SettleTrainingPayments(ctx sdk.Context, task *types.TrainingTask, throughOuterStep int32) (uint64, error) {
+	budget := task.Budget
+	if budget == nil || budget.Total == 0 || throughOuterStep < budget.NextOuterStepToSettle {
+		return 0, nil
+	}
+
+	remaining := budget.Total - budget.Paid - budget.Refunded
+	earned := make(map[string]uint64)
+	var participants []string
+	for outerStep := budget.NextOuterStepToSettle; outerStep <= throughOuterStep; outerStep++ {
+		activities, err := k.GetTrainingTaskNodeActivityAtEpoch(ctx, task.Id, outerStep)
+		if err != nil {
+			return 0, err
+		}
+		for _, activity := range activities {
+			if !k.isPayableTrainingActivity(ctx, task, activity) {
+				continue
+			}
+			amount := min(budget.PaymentPerNodeEpoch, remaining)
+			if amount == 0 {
+				break
+			}
+			if _, ok := earned[activity.Participant]; !ok {
+				participants = append(participants, activity.Participant)
+			}
+			earned[activity.Participant] += amount
+			remaining -= amount
+		}
+	}
+	budget.NextOuterStepToSettle = throughOuterStep + 1
+
+	paid := uint64(0)
+	memo := "training payment for task:" + strconv.FormatUint(task.Id, 10)
+	for _, participant := range participants {
+		if err := k.PayParticipantFromEscrow(ctx, participant, int64(earned[participant]), memo, nil); err != nil {
+			return 0, err
+		}
+		paid += earned[participant]
+	}
+	budget.Paid += paid
+	k.SetTrainingTask(ctx, task)
+
+	k.LogInfo("Training payments settled", types.Training, "taskId", task.Id, "throughOuterStep", throughOuterStep, "paid", paid, "participants", len(participants))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"training_payments_settled",
+			sdk.NewAttribute("task_id", strconv.FormatUint(task.Id, 10)),
+			sdk.NewAttribute("through_outer_step", strconv.FormatInt(int64(throughOuterStep), 10)),
+			sdk.NewAttribute("paid", strconv.FormatUint(paid, 10)),
+		),
+	)
+	return paid, nil
+}
+
+func (k Keeper) isPayableTrainingActivity(ctx sdk.Context, task *types.TrainingTask, activity *types.TrainingTaskNodeEpochActivity) bool {
+	if activity.Rank < 0 || activity.Heartbeat == nil || activity.Heartbeat.BlockHeight == 0 {
+		return false
+	}
+	assigned := slices.ContainsFunc(task.Assignees, func(assignee *types.TrainingTaskAssignee) bool {
+		return assignee.Participant == activity.Participant && slices.Contains(assignee.NodeIds, activity.NodeId)
+	})
+	if !assigned {
+		return false
+	}
+	_, committed := k.GetTrainingKVRecord(ctx, task.Id, types.TrainingCheckpointKey(activity.Heartbeat.OuterStep, activity.Participant))
+	return committed
+}
+
+// RefundTrainingBudget returns the unspent budget of a training task to its requester
+func (k Keeper) RefundTrainingBudget(ctx sdk.Context, task *types.TrainingTask) (uint64, error) {
+	budget := task.Budget
+	if budget == nil || budget.Total == 0 {
+		return 0, nil
+	}
+	refund := budget.Total - budget.Paid - budget.Refunded
+	if refund == 0 {
+		return 0, nil
+	}
+	if err := k.IssueRefund(ctx, int64(refund), task.RequestedBy, "training refund for task:"+strconv.FormatUint(task.Id, 10)); err != nil {
+		return 0, err
+	}
+	budget.Refunded += refund
+	k.SetTrainingTask(ctx, task)
+	return refund, nil
+}
+
+// LastSettleableOuterStep returns the last outer step of a training task that can be paid for. Outer steps are
+// settled once a later one has started, and the last one once its ranks are assigned if includeLast is set.
+func LastSettleableOuterStep(task *types.TrainingTask, includeLast bool) int32 {
+	if task.Epoch == nil {
+		return -1
+	}
+	if includeLast && task.Epoch.LastEpochIsFinished {
+		return task.Epoch.LastEpoch
+	}
+	return task.Epoch.LastEpoch - 1
+}
+
+// missingTrainingCheckpoints returns the participants ranked in the last outer step of a training task that did
+// not commit a checkpoint for it
+func (k Keeper) missingTrainingCheckpoints(ctx sdk.Context, task *types.TrainingTask) ([]string, error) {
+	if task.Epoch == nil || task.Epoch.LastEpoch < 0 || !task.Epoch.LastEpochIsFinished {
+		return nil, fmt.Errorf("training task %d has no ranked outer step", task.Id)
+	}
+	activities, err := k.GetTrainingTaskNodeActivityAtEpoch(ctx, task.Id, task.Epoch.LastEpoch)
+	if err != nil {
+		return nil, err
+	}
+	var missing []string
+	for _, activity := range activities {
+		if activity.Rank < 0 || slices.Contains(missing, activity.Participant) {
+			continue
+		}
+		if _, found := k.GetTrainingKVRecord(ctx, task.Id, types.TrainingCheckpointKey(task.Epoch.LastEpoch, activity.Participant)); !found {
+			missing = append(missing, activity.Participant)
+		}
+	}
+	return missing, nil
+}
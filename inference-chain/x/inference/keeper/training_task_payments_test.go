@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/productscience/inference/testutil"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func expectTrainingPayment(mocks keepertest.InferenceMocks, address string, amount int64) {
+	mocks.BankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(address), sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, amount)), gomock.Any()).
+		Return(nil).Times(1)
+}
+
+func setTrainingActivity(k keeper.Keeper, ctx sdk.Context, taskId uint64, outerStep int32, participant string, nodeId string, rank int32) {
+	k.SetTrainingTaskNodeEpochActivity(ctx, &types.TrainingTaskNodeEpochActivity{
+		TaskId:      taskId,
+		Participant: participant,
+		NodeId:      nodeId,
+		Rank:        rank,
+		Heartbeat:   &types.TrainingTaskHeartbeat{OuterStep: outerStep, BlockHeight: 10},
+	})
+}
+
+func commitTrainingCheckpoint(k keeper.Keeper, ctx sdk.Context, taskId uint64, outerStep int32, participant string) {
+	k.SetTrainingKVRecord(ctx, &types.TrainingTaskKVRecord{
+		TaskId:      taskId,
+		Participant: participant,
+		Key:         types.TrainingCheckpointKey(outerStep, participant),
+		Value:       strings.Repeat("ab", 32),
+	})
+}
+
+func TestTrainingTaskPayments_Lifecycle(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	ctx = ctx.WithBlockHeight(10)
+	ms := keeper.NewMsgServerImpl(k)
+	requester, assigneeA, assigneeB := testutil.Creator, testutil.Executor, testutil.Executor2
+	require.NoError(t, k.TrainingStartAllowListSet.Set(ctx, sdk.MustAccAddressFromBech32(requester)))
+
+	_, err := ms.CreateTrainingTask(ctx, &types.MsgCreateTrainingTask{Creator: requester, Budget: 100, PaymentPerNodeEpoch: 200})
+	require.ErrorIs(t, err, types.ErrInvalidTrainingBudget)
+
+	mocks.BankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), sdk.MustAccAddressFromBech32(requester), types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 1000)), gomock.Any()).
+		Return(nil).Times(1)
+	created, err := ms.CreateTrainingTask(ctx, &types.MsgCreateTrainingTask{Creator: requester, Config: &types.TrainingConfig{}, Budget: 1000, PaymentPerNodeEpoch: 100})
+	require.NoError(t, err)
+	taskId := created.Task.Id
+
+	require.NoError(t, k.StartTask(ctx, taskId, []*types.TrainingTaskAssignee{
+		{Participant: assigneeA, NodeIds: []string{"node1", "node2"}},
+		{Participant: assigneeB, NodeIds: []string{"node1"}},
+	}))
+
+	// Outer step 0: three ranked nodes, but B never committed a checkpoint for it
+	setTrainingActivity(k, ctx, taskId, 0, assigneeA, "node1", 0)
+	setTrainingActivity(k, ctx, taskId, 0, assigneeA, "node2", 1)
+	setTrainingActivity(k, ctx, taskId, 0, assigneeB, "node1", 2)
+	commitTrainingCheckpoint(k, ctx, taskId, 0, assigneeA)
+	// Outer step 1: only A's first node is ranked
+	setTrainingActivity(k, ctx, taskId, 1, assigneeA, "node1", 0)
+	setTrainingActivity(k, ctx, taskId, 1, assigneeB, "node1", -1)
+	task, _ := k.GetTrainingTask(ctx, taskId)
+	task.Epoch = &types.EpochInfo{LastEpoch: 1, LastEpochIsFinished: true}
+	k.SetTrainingTask(ctx, task)
+
+	// Outsiders cannot settle, assignees settle every outer step before the current one
+	_, err = ms.SettleTrainingPayments(ctx, &types.MsgSettleTrainingPayments{Creator: testutil.Validator, TaskId: taskId})
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+	expectTrainingPayment(mocks, assigneeA, 200)
+	settled, err := ms.SettleTrainingPayments(ctx, &types.MsgSettleTrainingPayments{Creator: assigneeB, TaskId: taskId})
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), settled.Paid)
+
+	// Settled outer steps are not paid twice
+	settled, err = ms.SettleTrainingPayments(ctx, &types.MsgSettleTrainingPayments{Creator: assigneeA, TaskId: taskId})
+	require.NoError(t, err)
+	require.Zero(t, settled.Paid)
+
+	// Finishing requires the final checkpoint of every ranked participant
+	_, err = ms.FinishTrainingTask(ctx, &types.MsgFinishTrainingTask{Creator: requester, TaskId: taskId})
+	require.ErrorIs(t, err, types.ErrInvalidTrainingCheckpoint)
+	commitTrainingCheckpoint(k, ctx, taskId, 1, assigneeA)
+
+	expectTrainingPayment(mocks, assigneeA, 100)
+	expectTrainingPayment(mocks, requester, 700)
+	finished, err := ms.FinishTrainingTask(ctx, &types.MsgFinishTrainingTask{Creator: requester, TaskId: taskId})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), finished.Paid)
+	require.Equal(t, uint64(700), finished.Refunded)
+
+	task, _ = k.GetTrainingTask(ctx, taskId)
+	require.Equal(t, uint64(300), task.Budget.Paid)
+	require.Equal(t, uint64(700), task.Budget.Refunded)
+	require.NotZero(t, task.FinishedAtBlockHeight)
+	checkPoolSize(t, ctx, k, 0, 0)
+
+	_, err = ms.CancelTrainingTask(ctx, &types.MsgCancelTrainingTask{Creator: requester, TaskId: taskId})
+	require.ErrorIs(t, err, types.ErrTrainingTaskFinished)
+}
+
+func TestTrainingTaskPayments_CancelQueuedTaskRefundsBudget(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	ctx = ctx.WithBlockHeight(10)
+	ms := keeper.NewMsgServerImpl(k)
+	requester := testutil.Creator
+	require.NoError(t, k.TrainingStartAllowListSet.Set(ctx, sdk.MustAccAddressFromBech32(requester)))
+
+	mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	created, err := ms.CreateTrainingTask(ctx, &types.MsgCreateTrainingTask{Creator: requester, Config: &types.TrainingConfig{}, Budget: 500, PaymentPerNodeEpoch: 50})
+	require.NoError(t, err)
+
+	_, err = ms.CancelTrainingTask(ctx, &types.MsgCancelTrainingTask{Creator: testutil.Executor, TaskId: created.Task.Id})
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+
+	expectTrainingPayment(mocks, requester, 500)
+	cancelled, err := ms.CancelTrainingTask(ctx, &types.MsgCancelTrainingTask{Creator: requester, TaskId: created.Task.Id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), cancelled.Refunded)
+
+	task, _ := k.GetTrainingTask(ctx, created.Task.Id)
+	require.NotZero(t, task.CancelledAtBlockHeight)
+	checkPoolSize(t, ctx, k, 0, 0)
+}
+
+func TestTrainingCheckpointRecords(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	assignee := testutil.Executor
+	require.NoError(t, k.TrainingExecAllowListSet.Set(ctx, sdk.MustAccAddressFromBech32(assignee)))
+	k.SetParticipant(ctx, types.Participant{Index: assignee, Address: assignee})
+	require.NoError(t, k.CreateTask(ctx, &types.TrainingTask{Id: 1, RequestedBy: testutil.Creator}))
+	require.NoError(t, k.StartTask(ctx, 1, []*types.TrainingTaskAssignee{{Participant: assignee, NodeIds: []string{"node1"}}}))
+
+	hash := strings.Repeat("ab", 32)
+	submit := func(key, value string) error {
+		_, err := ms.SubmitTrainingKvRecord(ctx, &types.MsgSubmitTrainingKvRecord{Creator: assignee, TaskId: 1, Participant: assignee, Key: key, Value: value})
+		return err
+	}
+	require.ErrorIs(t, submit(types.TrainingCheckpointKey(0, testutil.Executor2), hash), types.ErrInvalidTrainingCheckpoint)
+	require.ErrorIs(t, submit(types.TrainingCheckpointKey(0, assignee), "not-a-hash"), types.ErrInvalidTrainingCheckpoint)
+	require.NoError(t, submit(types.TrainingCheckpointKey(0, assignee), hash))
+	// Commitments cannot be rewritten
+	require.ErrorIs(t, submit(types.TrainingCheckpointKey(0, assignee), strings.Repeat("cd", 32)), types.ErrInvalidTrainingCheckpoint)
+}
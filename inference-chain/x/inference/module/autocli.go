@@ -319,6 +319,60 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Get cached capacity for a specific model",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "model_id"}},
 				},
+				{
+					RpcMethod: "InvalidationAppealAll",
+					Use:       "list-invalidation-appeal",
+					Short:     "List all invalidation appeals",
+				},
+				{
+					RpcMethod:      "InvalidationAppeal",
+					Use:            "show-invalidation-appeal [inference-id]",
+					Short:          "Shows the appeal of an invalidated inference",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "inference_id"}},
+				},
+				{
+					RpcMethod:      "ParticipantStatusExplanation",
+					Use:            "participant-status-explanation [address] [epoch-index]",
+					Short:          "Explains a participant's status and reputation for an epoch (0 for the current epoch)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "epoch_index"}},
+				},
+				{
+					RpcMethod:      "ResolveModel",
+					Use:            "resolve-model [model]",
+					Short:          "Resolves a model id or alias to the governance model",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "model"}},
+				},
+				{
+					RpcMethod: "ModelAliasAll",
+					Use:       "list-model-alias",
+					Short:     "Lists all model aliases",
+				},
+				{
+					RpcMethod:      "BridgeBlockAttestations",
+					Use:            "bridge-block-attestations [origin-chain] [block-number]",
+					Short:          "Shows the receipts root attestations for an origin chain block",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "origin_chain"}, {ProtoField: "block_number"}},
+				},
+				{
+					RpcMethod:      "BridgeCapacity",
+					Use:            "bridge-capacity [chain-id]",
+					Short:          "Shows the circuit breaker, remaining rate limit capacity and held deposits of an origin chain",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "chain_id"}},
+				},
+				{
+					RpcMethod:      "TokenomicsProjection",
+					Use:            "tokenomics-projection [epochs]",
+					Short:          "Projects reward issuance, per-participant rewards and the supply curve for the next epochs",
+					Long:           "Projects the next epochs (default 10) from the current participants and weights. --params and --alternative-params take params JSON (or a .json file) to project hypothetical params and compare two sets.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "epochs", Optional: true}},
+				},
+				{
+					RpcMethod:      "ParamsDryRun",
+					Use:            "params-dry-run [params]",
+					Short:          "Validates proposed params, diffs them against the current params and simulates the next epoch transition",
+					Long:           "Takes the full proposed params as JSON (or a .json file), e.g. the params of a MsgUpdateParams proposal. Lists every validation error and changed field, and runs the upcoming epoch's formation and settlement under the current and proposed params to compare rewards, weights and the unit of compute price. The transition can only be simulated between the start of the next PoC and the end of its validation.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -342,6 +396,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "RegisterLiquidityPool",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "SetModelStatus",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SetModelAlias",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SetBridgeRateLimit",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SetBridgePaused",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod:      "StartInference",
 					Use:            "start-inference [inference-id] [prompt-hash] [prompt-payload] [received-by]",
@@ -438,6 +508,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a assign-training-task tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
 				},
+				{
+					RpcMethod:      "SettleTrainingPayments",
+					Use:            "settle-training-payments [task-id]",
+					Short:          "Pay assignees of a training task for the outer steps they completed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}},
+				},
+				{
+					RpcMethod:      "FinishTrainingTask",
+					Use:            "finish-training-task [task-id]",
+					Short:          "Finish a training task, paying its assignees and refunding the rest of the budget",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}},
+				},
+				{
+					RpcMethod:      "CancelTrainingTask",
+					Use:            "cancel-training-task [task-id]",
+					Short:          "Cancel a training task and refund the unspent budget",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}},
+				},
 				{
 					RpcMethod:      "CreatePartialUpgrade",
 					Use:            "create-partial-upgrade [height] [node-version] [api-binaries-json]",
@@ -474,6 +562,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Request minting of WGNK tokens on Ethereum by bridging native Gonka",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}, {ProtoField: "destination_address"}, {ProtoField: "chain_id"}},
 				},
+				{
+					RpcMethod:      "AppealInvalidation",
+					Use:            "appeal-invalidation [inference-id]",
+					Short:          "Appeal the invalidation of an inference you executed, escrowing the appeal bond",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "inference_id"}},
+				},
+				{
+					RpcMethod:      "SubmitAppealVote",
+					Use:            "submit-appeal-vote [inference-id] [value]",
+					Short:          "Submit the re-execution result for an appealed inference",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "inference_id"}, {ProtoField: "value"}},
+				},
+				{
+					RpcMethod:      "AttestBridgeBlock",
+					Use:            "attest-bridge-block [origin-chain] [block-number] [receipts-root]",
+					Short:          "Vote for the receipts root of an origin chain block",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "originChain"}, {ProtoField: "blockNumber"}, {ProtoField: "receiptsRoot"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
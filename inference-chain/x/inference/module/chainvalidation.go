@@ -573,7 +573,7 @@ func (wc *WeightCalculator) validatedParticipant(participantAddress string) *typ
 		return nil
 	}
 
-	nodeWeights, claimedWeight := wc.calculateParticipantWeight(wc.OriginalBatches[participantAddress])
+	nodeWeights, claimedWeight := wc.calculateParticipantWeight(wc.OriginalBatches[participantAddress], vals)
 	if claimedWeight < 1 {
 		wc.Logger.LogWarn("Calculate: Participant has non-positive claimedWeight.", types.PoC, "participant", participantAddress, "claimedWeight", claimedWeight)
 		return nil
@@ -707,18 +707,32 @@ type nodeWeight struct {
 	weight int64
 }
 
-func (wc *WeightCalculator) calculateParticipantWeight(batches []types.PoCBatch) ([]nodeWeight, int64) {
+func (wc *WeightCalculator) calculateParticipantWeight(batches []types.PoCBatch, validations []types.PoCValidation) ([]nodeWeight, int64) {
 	nodeWeights := make(map[string]int64)
 	totalWeight := int64(0)
 
+	// Committed batches were checked to claim disjoint nonces on submission. Leaves proven on-chain to fail
+	// validation don't count towards their batch.
+	var committed []types.PoCNonceProgression
+	for _, batch := range batches {
+		if batch.IsCommitment() {
+			committed = append(committed, batch.NonceProgression())
+		}
+	}
+	disputedLeaves := countDisputedLeaves(validations)
+
 	uniqueNonces := make(map[int64]struct{})
 	for _, batch := range batches {
 		weight := int64(0)
+		if batch.IsCommitment() {
+			weight = max(batch.NonceTotal()-disputedLeaves[batch.BatchId], 0)
+		}
 		for _, nonce := range batch.Nonces {
-			if _, exists := uniqueNonces[nonce]; !exists {
-				uniqueNonces[nonce] = struct{}{}
-				weight++
+			if _, exists := uniqueNonces[nonce]; exists || inAnyProgression(committed, nonce) {
+				continue
 			}
+			uniqueNonces[nonce] = struct{}{}
+			weight++
 		}
 
 		weight = mathsdk.LegacyNewDec(weight).Mul(wc.WeightScaleFactor).TruncateInt64()
@@ -738,6 +752,36 @@ func (wc *WeightCalculator) calculateParticipantWeight(batches []types.PoCBatch)
 	return nodeWeightsSlice, totalWeight
 }
 
+// countDisputedLeaves counts the distinct disputed leaves of each committed batch across validations
+func countDisputedLeaves(validations []types.PoCValidation) map[string]int64 {
+	seen := make(map[string]map[uint32]struct{})
+	counts := make(map[string]int64)
+	for _, validation := range validations {
+		for _, dispute := range validation.Disputes {
+			leaves, ok := seen[dispute.BatchId]
+			if !ok {
+				leaves = make(map[uint32]struct{})
+				seen[dispute.BatchId] = leaves
+			}
+			if _, exists := leaves[dispute.LeafIndex]; exists {
+				continue
+			}
+			leaves[dispute.LeafIndex] = struct{}{}
+			counts[dispute.BatchId]++
+		}
+	}
+	return counts
+}
+
+func inAnyProgression(progressions []types.PoCNonceProgression, nonce int64) bool {
+	for _, progression := range progressions {
+		if progression.Contains(nonce) {
+			return true
+		}
+	}
+	return false
+}
+
 // calculateTotalWeight calculates the total weight of all validators
 func calculateTotalWeight(validatorWeights map[string]int64) uint64 {
 	if validatorWeights == nil {
@@ -826,7 +870,7 @@ func (am AppModule) filterPoCBatchesFromInferenceNodes(allBatches map[string][]t
 				am.LogWarn("filterPoCBatchesFromInferenceNodes: Excluding PoC batch from inference-serving node", types.PoC,
 					"participantAddress", participantAddress,
 					"nodeId", batch.NodeId,
-					"batchNonceCount", len(batch.Nonces))
+					"batchNonceCount", batch.NonceTotal())
 			} else {
 				// Include this batch - it's from a legitimate PoC mining node
 				validBatches = append(validBatches, batch)
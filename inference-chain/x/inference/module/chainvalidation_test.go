@@ -192,7 +192,7 @@ func TestNoCollateralPostGracePeriod(t *testing.T) {
 	addr, err := sdk.AccAddressFromBech32(participantAddress)
 	require.NoError(t, err)
 	mocks.CollateralKeeper.EXPECT().
-		GetCollateral(gomock.Any(), addr).
+		GetEffectiveCollateral(gomock.Any(), addr).
 		Return(sdk.Coin{}, false). // No collateral found
 		Times(1)
 
@@ -239,7 +239,7 @@ func TestPostGracePeriod_FullCollateral(t *testing.T) {
 	require.NoError(t, err)
 	requiredCollateral := sdk.NewCoin(types.BaseCoin, math.NewInt(800))
 	mocks.CollateralKeeper.EXPECT().
-		GetCollateral(gomock.Any(), addr).
+		GetEffectiveCollateral(gomock.Any(), addr).
 		Return(requiredCollateral, true). // Full collateral found
 		Times(1)
 
@@ -286,7 +286,7 @@ func TestPostGracePeriod_PartialCollateral(t *testing.T) {
 	require.NoError(t, err)
 	partialCollateral := sdk.NewCoin(types.BaseCoin, math.NewInt(400))
 	mocks.CollateralKeeper.EXPECT().
-		GetCollateral(gomock.Any(), addr).
+		GetEffectiveCollateral(gomock.Any(), addr).
 		Return(partialCollateral, true). // Partial collateral found
 		Times(1)
 
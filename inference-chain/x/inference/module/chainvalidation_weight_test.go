@@ -0,0 +1,41 @@
+package inference
+
+import (
+	"testing"
+
+	mathsdk "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestCalculateParticipantWeight_CommittedBatches(t *testing.T) {
+	wc := &WeightCalculator{WeightScaleFactor: mathsdk.LegacyOneDec()}
+	nodeWeights, total := wc.calculateParticipantWeight([]types.PoCBatch{
+		{NodeId: "node1", Nonces: []int64{1, 2, 3}},
+		{NodeId: "node1", Nonces: []int64{3, 4}},
+		{NodeId: "node2", BatchId: "c1", MerkleRoot: make([]byte, 32), NonceCount: 500, FirstNonce: 1000, LastNonce: 1998, NonceStride: 2},
+	}, nil)
+
+	// Full batches are deduplicated, committed batches count their claimed leaves
+	require.Equal(t, int64(504), total)
+	require.Equal(t, []nodeWeight{{nodeId: "node1", weight: 4}, {nodeId: "node2", weight: 500}}, nodeWeights)
+}
+
+func TestCalculateParticipantWeight_DisputesAndCommittedNonces(t *testing.T) {
+	wc := &WeightCalculator{WeightScaleFactor: mathsdk.LegacyOneDec()}
+	batches := []types.PoCBatch{
+		// 1000 and 1002 are already claimed by the committed batch, 1001 is outside its progression
+		{NodeId: "node1", Nonces: []int64{1000, 1001, 1002}},
+		{NodeId: "node2", BatchId: "c1", MerkleRoot: make([]byte, 32), NonceCount: 500, FirstNonce: 1000, LastNonce: 1998, NonceStride: 2},
+	}
+	validations := []types.PoCValidation{
+		{Disputes: []types.PoCLeafDispute{{BatchId: "c1", LeafIndex: 3}, {BatchId: "c1", LeafIndex: 7}}},
+		// The same leaf disputed by another validator is deducted once
+		{Disputes: []types.PoCLeafDispute{{BatchId: "c1", LeafIndex: 7}}},
+	}
+
+	nodeWeights, total := wc.calculateParticipantWeight(batches, validations)
+	require.Equal(t, int64(499), total)
+	require.Equal(t, []nodeWeight{{nodeId: "node1", weight: 1}, {nodeId: "node2", weight: 498}}, nodeWeights)
+}
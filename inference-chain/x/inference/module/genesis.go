@@ -74,6 +74,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		elem.ProposedBy = k.GetAuthority()
 		k.SetModel(ctx, &elem)
 	}
+	for _, elem := range genState.ModelAliasList {
+		if err := k.SetModelAlias(ctx, elem.Alias, elem.ModelId); err != nil {
+			panic(err)
+		}
+	}
 
 	// Set all bridge contract addresses from genesis
 	if genState.Bridge != nil {
@@ -251,6 +256,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.MlnodeVersion = &mlnodeVersion
 	}
 	genesis.ModelList = getModels(&ctx, &k)
+	modelAliases, err := k.GetAllModelAliases(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.ModelAliasList = modelAliases
 	// Export participants
 	participants := k.GetAllParticipant(ctx)
 	genesis.ParticipantList = participants
@@ -284,11 +284,20 @@ func (ma *ModelAssigner) setModelsForParticipants(ctx context.Context, participa
 	// rather than relying on hardware node declarations alone.
 	ma.LogInfo("Starting model and slot assignment for participants", types.Allocation, "flow_context", FlowContext, "step", "start", "num_participants", len(participants), "epoch_index", upcomingEpoch.Index)
 
-	governanceModels, err := ma.keeper.GetGovernanceModelsSorted(ctx)
+	allGovernanceModels, err := ma.keeper.GetGovernanceModelsSorted(ctx)
 	if err != nil {
 		ma.LogError("setModelsForParticipants: Unable to get governance models", types.Allocation, "error", err.Error(), "flow_context", FlowContext)
 		return
 	}
+	// Retired models, including deprecated ones past their sunset, are no longer assigned
+	governanceModels := make([]*types.Model, 0, len(allGovernanceModels))
+	for _, model := range allGovernanceModels {
+		if model.IsRetiredAt(upcomingEpoch.Index) {
+			ma.LogInfo("Skipping retired model", types.Allocation, "flow_context", FlowContext, "step", "skip_retired_model", "model_id", model.Id, "status", model.Status.String())
+			continue
+		}
+		governanceModels = append(governanceModels, model)
+	}
 	ma.LogInfo("Retrieved governance models", types.Allocation, "flow_context", FlowContext, "step", "get_governance_models", "num_models", len(governanceModels))
 
 	for _, p := range participants {
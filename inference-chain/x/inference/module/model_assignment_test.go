@@ -290,6 +290,52 @@ func TestSetModelsForParticipants_OneNodeOneModel(t *testing.T) {
 	assertTimeslotAllocationCount(t, modelGroup.MlNodes, []bool{true, true}, 0)  // Not allocated for PoC
 }
 
+func TestSetModelsForParticipants_SkipsRetiredModels(t *testing.T) {
+	ctx := context.Background()
+	participantAddress := "gonka1xmwh48ugfvd2ktmy0t90ueuzqxdk4g0anwe3v6"
+	oldModel := "Qwen/Qwen2.5-7B-Instruct"
+	newModel := "Qwen/Qwen3-8B"
+
+	mockKeeper := &mockKeeperForModelAssigner{
+		governanceModels: []types.Model{
+			{Id: newModel},
+			{Id: oldModel, Status: types.ModelStatus_MODEL_STATUS_DEPRECATED, SunsetEpoch: 2, SuccessorId: newModel},
+		},
+		hardwareNodes: map[string]*types.HardwareNodes{
+			participantAddress: {
+				Participant: participantAddress,
+				HardwareNodes: []*types.HardwareNode{
+					{LocalId: "mlnode1", Models: []string{oldModel}},
+					{LocalId: "mlnode2", Models: []string{oldModel, newModel}},
+				},
+			},
+		},
+	}
+	modelAssigner := NewModelAssigner(mockKeeper, mockLogger{})
+
+	newParticipants := func() []*types.ActiveParticipant {
+		return []*types.ActiveParticipant{{
+			Index: participantAddress,
+			MlNodes: []*types.ModelMLNodes{{MlNodes: []*types.MLNodeInfo{
+				{NodeId: "mlnode1", PocWeight: 10},
+				{NodeId: "mlnode2", PocWeight: 10},
+			}}},
+		}}
+	}
+
+	// Before the sunset the deprecated model is still assigned
+	participants := newParticipants()
+	modelAssigner.setModelsForParticipants(ctx, participants, types.Epoch{Index: 1})
+	require.ElementsMatch(t, []string{oldModel, newModel}, participants[0].Models)
+
+	// From the sunset epoch on only the successor is assigned, and the old-only node stays unassigned
+	participants = newParticipants()
+	modelAssigner.setModelsForParticipants(ctx, participants, types.Epoch{Index: 2})
+	require.Equal(t, []string{newModel}, participants[0].Models)
+	require.Len(t, participants[0].MlNodes, 1)
+	assertNodeInGroup(t, participants[0].MlNodes[0].MlNodes, "mlnode2")
+}
+
 func TestSetModelsForParticipants_ManyNodesManyModels(t *testing.T) {
 	// 1. Setup
 	ctx := context.Background()
@@ -23,9 +23,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/productscience/inference/testenv"
-	"github.com/productscience/inference/x/inference/calculations"
 	"github.com/productscience/inference/x/inference/epochgroup"
-	"github.com/shopspring/decimal"
 	"github.com/spf13/cobra"
 
 	// this line is used by starport scaffolding # 1
@@ -140,7 +138,7 @@ func NewAppModule(
 // RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
-	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), NewQueryServer(am))
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -260,6 +258,9 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 		am.keeper.RemoveInferenceTimeout(ctx, t.ExpirationHeight, t.InferenceId)
 	}
 
+	am.keeper.ResolveExpiredAppeals(ctx, blockHeight)
+	am.keeper.ReleaseHeldBridgeTransactions(sdkCtx)
+
 	err = am.keeper.Prune(ctx, int64(currentEpoch.Index))
 	if err != nil {
 		am.LogError("Error during pruning", types.Pruning, "error", err.Error())
@@ -427,6 +428,10 @@ func (am AppModule) onEndOfPoCValidationStage(ctx context.Context, blockHeight i
 		return
 	}
 
+	if err := am.keeper.RetireSunsetModels(ctx, upcomingEpoch.Index); err != nil {
+		am.LogError("onEndOfPoCValidationStage: failed to retire sunset models", types.EpochGroup, "error", err)
+	}
+
 	modelAssigner := NewModelAssigner(am.keeper, am.keeper)
 	modelAssigner.setModelsForParticipants(ctx, activeParticipants, *upcomingEpoch)
 
@@ -580,27 +585,7 @@ func (am AppModule) computePrice(ctx context.Context, upcomingEpoch types.Epoch,
 }
 
 func (am AppModule) calculateParticipantReputation(ctx context.Context, p *types.ActiveParticipant, params *types.ValidationParams) (int64, error) {
-	summaries := am.keeper.GetEpochPerformanceSummariesByParticipant(ctx, p.Index)
-
-	reputationContext := calculations.ReputationContext{
-		EpochCount:           int64(len(summaries)),
-		EpochMissPercentages: make([]decimal.Decimal, len(summaries)),
-		ValidationParams:     params,
-	}
-
-	for i, summary := range summaries {
-		inferenceCount := decimal.NewFromInt(int64(summary.InferenceCount))
-		if inferenceCount.IsZero() {
-			reputationContext.EpochMissPercentages[i] = decimal.Zero
-			continue
-		}
-
-		missed := decimal.NewFromInt(int64(summary.MissedRequests))
-		reputationMetric := missed.Div(inferenceCount)
-		reputationContext.EpochMissPercentages[i] = reputationMetric
-	}
-
-	reputation := calculations.CalculateReputation(&reputationContext)
+	reputation := am.keeper.CalculateParticipantReputation(ctx, p.Index, params)
 	am.LogInfo("ReputationCalculated", types.EpochGroup, "participantIndex", p.Index, "reputation", reputation)
 
 	return reputation, nil
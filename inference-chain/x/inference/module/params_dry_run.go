@@ -0,0 +1,188 @@
+package inference
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dryRunGasLimit bounds the gas a single simulated epoch transition may consume, so the query can't be used to
+// make a node run arbitrarily expensive stages
+const dryRunGasLimit uint64 = 500_000_000
+
+// queryServer serves the keeper queries plus the ones that need the module's epoch transition stages
+type queryServer struct {
+	keeper.Keeper
+	am AppModule
+}
+
+var _ types.QueryServer = queryServer{}
+
+func NewQueryServer(am AppModule) types.QueryServer {
+	return queryServer{Keeper: am.keeper, am: am}
+}
+
+// ParamsDryRun validates proposed params, diffs them against the current params and runs the next epoch transition
+// on cached contexts under both. Nothing is written to state.
+func (q queryServer) ParamsDryRun(ctx context.Context, req *types.QueryParamsDryRunRequest) (*types.QueryParamsDryRunResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	current := q.GetParams(ctx)
+	response := &types.QueryParamsDryRunResponse{
+		ValidationErrors: req.Params.ValidationErrors(),
+		Changes:          types.DiffParams(current, req.Params),
+	}
+	response.Valid = len(response.ValidationErrors) == 0
+	if !response.Valid {
+		response.SimulationError = "invalid params can't be simulated"
+		return response, nil
+	}
+
+	currentOutcome, err := q.am.dryRunEpochTransition(ctx, current)
+	if err != nil {
+		response.SimulationError = err.Error()
+		return response, nil
+	}
+	proposedOutcome, err := q.am.dryRunEpochTransition(ctx, req.Params)
+	if err != nil {
+		response.SimulationError = fmt.Sprintf("proposed params: %s", err)
+		return response, nil
+	}
+	response.Transition = compareTransitions(currentOutcome, proposedOutcome)
+	return response, nil
+}
+
+type transitionOutcome struct {
+	upcomingEpochIndex uint64
+	rewardAmount       int64
+	unitOfComputePrice uint64
+	weights            map[string]int64
+	rewards            map[string]uint64
+}
+
+// dryRunEpochTransition runs onEndOfPoCValidationStage and onSetNewValidatorsStage for the upcoming epoch on a cached
+// context with the given params. It only works between the start of the upcoming epoch's PoC and its epoch formation.
+// The stages run silently and are aborted once they exceed dryRunGasLimit.
+func (am AppModule) dryRunEpochTransition(ctx context.Context, params types.Params) (outcome *transitionOutcome, err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, _ := sdkCtx.CacheContext()
+	cacheCtx = cacheCtx.WithLogger(log.NewNopLogger())
+	am.keeper = am.keeper.WithLogger(log.NewNopLogger())
+	cacheCtx, done := withGasLimit(cacheCtx, dryRunGasLimit)
+	defer done(&err)
+
+	effectiveEpoch, found := am.keeper.GetEffectiveEpoch(cacheCtx)
+	if !found || effectiveEpoch == nil {
+		return nil, fmt.Errorf("no effective epoch")
+	}
+	upcomingEpoch, found := am.keeper.GetUpcomingEpoch(cacheCtx)
+	if !found || upcomingEpoch == nil {
+		return nil, fmt.Errorf("no upcoming epoch, the transition can only be simulated once the next PoC stage has started")
+	}
+	epochContext := types.NewEpochContext(*upcomingEpoch, *am.keeper.GetParams(cacheCtx).EpochParams)
+	endOfPoCValidation := epochContext.EndOfPoCValidation()
+	setNewValidators := epochContext.SetNewValidators()
+	if sdkCtx.BlockHeight() >= endOfPoCValidation {
+		return nil, fmt.Errorf("epoch %d was already formed at block %d", upcomingEpoch.Index, endOfPoCValidation)
+	}
+
+	if err := am.keeper.SetParams(cacheCtx, params); err != nil {
+		return nil, err
+	}
+	tokenomicsBefore, _ := am.keeper.GetTokenomicsData(cacheCtx)
+	blockTime := sdkCtx.BlockTime().Unix()
+
+	am.onEndOfPoCValidationStage(cacheCtx.WithBlockHeight(endOfPoCValidation), endOfPoCValidation, blockTime)
+
+	outcome = &transitionOutcome{
+		upcomingEpochIndex: upcomingEpoch.Index,
+		weights:            make(map[string]int64),
+		rewards:            make(map[string]uint64),
+	}
+	tokenomicsAfter, _ := am.keeper.GetTokenomicsData(cacheCtx)
+	outcome.rewardAmount = int64(tokenomicsAfter.TotalSubsidies) - int64(tokenomicsBefore.TotalSubsidies)
+
+	if settled, found := am.keeper.GetActiveParticipants(cacheCtx, effectiveEpoch.Index); found {
+		for _, participant := range settled.Participants {
+			amount, found := am.keeper.GetSettleAmount(cacheCtx, participant.Index)
+			if found && amount.EpochIndex == effectiveEpoch.Index {
+				outcome.rewards[participant.Index] = amount.RewardCoins
+			}
+		}
+	}
+	activeParticipants, found := am.keeper.GetActiveParticipants(cacheCtx, upcomingEpoch.Index)
+	if !found {
+		return nil, fmt.Errorf("epoch %d could not be formed", upcomingEpoch.Index)
+	}
+	for _, participant := range activeParticipants.Participants {
+		outcome.weights[participant.Index] = participant.Weight
+	}
+
+	am.onSetNewValidatorsStage(cacheCtx.WithBlockHeight(setNewValidators), setNewValidators, blockTime)
+	if groupData, found := am.keeper.GetEpochGroupData(cacheCtx, upcomingEpoch.Index, ""); found {
+		outcome.unitOfComputePrice = uint64(groupData.UnitOfComputePrice)
+	}
+	return outcome, nil
+}
+
+// withGasLimit meters ctx with a gas meter bounded by limit. The returned func must be deferred, it turns running out
+// of that gas into an error and re-panics anything else.
+func withGasLimit(ctx sdk.Context, limit uint64) (sdk.Context, func(*error)) {
+	meter := storetypes.NewGasMeter(limit)
+	return ctx.WithGasMeter(meter), func(err *error) {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			*err = fmt.Errorf("simulation exceeded the gas limit of %d in %s", limit, outOfGas.Descriptor)
+		}
+	}
+}
+
+func compareTransitions(current, proposed *transitionOutcome) *types.EpochTransitionDryRun {
+	dryRun := &types.EpochTransitionDryRun{
+		UpcomingEpochIndex:         current.upcomingEpochIndex,
+		CurrentRewardAmount:        current.rewardAmount,
+		ProposedRewardAmount:       proposed.rewardAmount,
+		CurrentUnitOfComputePrice:  current.unitOfComputePrice,
+		ProposedUnitOfComputePrice: proposed.unitOfComputePrice,
+	}
+
+	addresses := make(map[string]struct{})
+	for _, outcome := range []*transitionOutcome{current, proposed} {
+		for address := range outcome.weights {
+			addresses[address] = struct{}{}
+		}
+		for address := range outcome.rewards {
+			addresses[address] = struct{}{}
+		}
+	}
+	sorted := make([]string, 0, len(addresses))
+	for address := range addresses {
+		sorted = append(sorted, address)
+	}
+	sort.Strings(sorted)
+
+	for _, address := range sorted {
+		dryRun.CurrentTotalWeight += current.weights[address]
+		dryRun.ProposedTotalWeight += proposed.weights[address]
+		dryRun.Participants = append(dryRun.Participants, &types.ParticipantTransitionDelta{
+			Address:        address,
+			CurrentWeight:  current.weights[address],
+			ProposedWeight: proposed.weights[address],
+			CurrentReward:  current.rewards[address],
+			ProposedReward: proposed.rewards[address],
+		})
+	}
+	return dryRun
+}
@@ -0,0 +1,30 @@
+package inference
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func runWithGasLimit(limit, consume uint64) (err error) {
+	ctx, done := withGasLimit(sdk.Context{}, limit)
+	defer done(&err)
+	ctx.GasMeter().ConsumeGas(consume, "stage")
+	return nil
+}
+
+func TestWithGasLimit(t *testing.T) {
+	require.NoError(t, runWithGasLimit(100, 100))
+
+	err := runWithGasLimit(100, 101)
+	require.ErrorContains(t, err, "exceeded the gas limit of 100 in stage")
+
+	require.PanicsWithError(t, "boom", func() {
+		var err error
+		_, done := withGasLimit(sdk.Context{}, 100)
+		defer done(&err)
+		panic(errors.New("boom"))
+	})
+}
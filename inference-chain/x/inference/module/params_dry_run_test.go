@@ -0,0 +1,129 @@
+package inference_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/productscience/inference/testutil"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	inference "github.com/productscience/inference/x/inference/module"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/productscience/inference/x/inference/utils"
+)
+
+func TestParamsDryRun_InvalidParams(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+	server := inference.NewQueryServer(inference.NewAppModule(nil, k, nil, nil, nil, nil))
+
+	_, err := server.ParamsDryRun(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	proposed := types.DefaultParams()
+	proposed.EpochParams.EpochLength = 0
+	proposed.ValidationParams.TimestampExpiration = 0
+	resp, err := server.ParamsDryRun(ctx, &types.QueryParamsDryRunRequest{Params: proposed})
+	require.NoError(t, err)
+	require.False(t, resp.Valid)
+	require.Len(t, resp.ValidationErrors, 2)
+	require.Contains(t, resp.Changes, types.ParamChange{Field: "epoch_params.epoch_length", Current: "40", Proposed: "0"})
+	require.Nil(t, resp.Transition)
+	require.NotEmpty(t, resp.SimulationError)
+}
+
+func TestParamsDryRun_RequiresUpcomingEpoch(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+	require.NoError(t, k.SetEpoch(ctx, &types.Epoch{Index: 1, PocStartBlockHeight: 10}))
+	k.SetEffectiveEpochIndex(ctx, 1)
+	server := inference.NewQueryServer(inference.NewAppModule(nil, k, nil, nil, nil, nil))
+
+	proposed := types.DefaultParams()
+	proposed.ValidationParams.FalsePositiveRate = types.DecimalFromFloat(0.1)
+	resp, err := server.ParamsDryRun(ctx, &types.QueryParamsDryRunRequest{Params: proposed})
+	require.NoError(t, err)
+	require.True(t, resp.Valid)
+	require.Empty(t, resp.ValidationErrors)
+	require.Len(t, resp.Changes, 1)
+	require.Equal(t, "validation_params.false_positive_rate", resp.Changes[0].Field)
+	require.Equal(t, "0.1", resp.Changes[0].Proposed)
+	require.Contains(t, resp.SimulationError, "no upcoming epoch")
+	require.Nil(t, resp.Transition)
+}
+
+func TestParamsDryRun_SimulatesUpcomingEpoch(t *testing.T) {
+	setupSDKConfig()
+	validatorAccAddress, err := utils.OperatorAddressToAccAddress(validatorOperatorAddress2)
+	require.NoError(t, err)
+	validators := []stakingtypes.Validator{{OperatorAddress: validatorOperatorAddress2, ConsensusPubkey: &codectypes.Any{}, Tokens: math.NewInt(200)}}
+
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	mocks.StubForInitGenesisWithValidators(ctx, validators)
+	inference.InitGenesis(ctx, k, mocks.StubGenesisState())
+	mocks.GroupKeeper.EXPECT().GroupMembers(gomock.Any(), gomock.Any()).Return(&group.QueryGroupMembersResponse{Members: []*group.GroupMember{
+		{Member: &group.Member{Address: validatorAccAddress, Weight: "200"}},
+	}}, nil).AnyTimes()
+	mocks.CollateralKeeper.EXPECT().AdvanceEpoch(gomock.Any(), gomock.Any()).AnyTimes()
+	mocks.StreamVestingKeeper.EXPECT().AdvanceEpoch(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mocks.ExpectAnyCreateGroupWithPolicyCall().AnyTimes()
+	mocks.GroupKeeper.EXPECT().UpdateGroupMembers(gomock.Any(), gomock.Any()).Return(&group.MsgUpdateGroupMembersResponse{}, nil).AnyTimes()
+	mocks.GroupKeeper.EXPECT().UpdateGroupMetadata(gomock.Any(), gomock.Any()).Return(&group.MsgUpdateGroupMetadataResponse{}, nil).AnyTimes()
+	mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	// The upcoming epoch's PoC has started: two participants mined and were validated
+	require.NoError(t, k.SetEpoch(ctx, &types.Epoch{Index: 1, PocStartBlockHeight: 100}))
+	_, err = k.CreateEpochGroup(ctx, 100, 1)
+	require.NoError(t, err)
+	for i, address := range []string{testutil.Executor, testutil.Executor2} {
+		nonces := make([]int64, 100*(i+1))
+		for j := range nonces {
+			nonces[j] = int64(j)
+		}
+		require.NoError(t, k.SetParticipant(ctx, types.Participant{Index: address, Address: address, ValidatorKey: "validatorKey", InferenceUrl: "http://node.example.com/"}))
+		k.SetPocBatch(ctx, types.PoCBatch{ParticipantAddress: address, PocStageStartBlockHeight: 100, BatchId: "batch", Nonces: nonces})
+		k.SetPoCValidation(ctx, types.PoCValidation{ParticipantAddress: address, ValidatorParticipantAddress: validatorAccAddress, PocStageStartBlockHeight: 100})
+		k.SetRandomSeed(ctx, types.RandomSeed{Participant: address, EpochIndex: 1, Signature: "seed"})
+	}
+	ctx = ctx.WithBlockHeight(101)
+	paramsBefore := k.GetParams(ctx)
+	tokenomicsBefore, _ := k.GetTokenomicsData(ctx)
+	groupDataBefore, found := k.GetEpochGroupData(ctx, 1, "")
+	require.True(t, found)
+	server := inference.NewQueryServer(inference.NewAppModule(nil, k, mocks.AccountKeeper, nil, nil, nil))
+
+	proposed := types.DefaultParams()
+	proposed.PocParams.WeightScaleFactor = types.DecimalFromFloat(0.5)
+	resp, err := server.ParamsDryRun(ctx, &types.QueryParamsDryRunRequest{Params: proposed})
+	require.NoError(t, err)
+	require.True(t, resp.Valid)
+	require.Empty(t, resp.SimulationError)
+	require.NotNil(t, resp.Transition)
+	require.Equal(t, uint64(1), resp.Transition.UpcomingEpochIndex)
+	// Halving the weight scale factor halves every weight of the upcoming epoch
+	require.Equal(t, int64(200), resp.Transition.CurrentTotalWeight)
+	require.Equal(t, int64(100), resp.Transition.ProposedTotalWeight)
+	require.Len(t, resp.Transition.Participants, 2)
+	for _, participant := range resp.Transition.Participants {
+		require.Equal(t, int64(100), participant.CurrentWeight, participant.Address)
+		require.Equal(t, int64(50), participant.ProposedWeight, participant.Address)
+	}
+
+	// Both simulations ran on cached contexts, the store is untouched
+	require.Equal(t, paramsBefore, k.GetParams(ctx))
+	tokenomicsAfter, _ := k.GetTokenomicsData(ctx)
+	require.Equal(t, tokenomicsBefore, tokenomicsAfter)
+	effectiveEpochIndex, _ := k.GetEffectiveEpochIndex(ctx)
+	require.Equal(t, uint64(0), effectiveEpochIndex)
+	_, found = k.GetActiveParticipants(ctx, 1)
+	require.False(t, found)
+	groupDataAfter, _ := k.GetEpochGroupData(ctx, 1, "")
+	require.Equal(t, groupDataBefore, groupDataAfter)
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/productscience/inference/x/inference/keeper"
 	"github.com/productscience/inference/x/inference/types"
+	streamvestingtypes "github.com/productscience/inference/x/streamvesting/types"
 )
 
 func (am AppModule) RegisterTopMiners(ctx context.Context, participants []*types.ActiveParticipant, time int64) error {
@@ -54,7 +55,7 @@ func (am AppModule) RegisterTopMiners(ctx context.Context, participants []*types
 				return err
 			}
 			topMinerVestingPeriod := &params.TokenomicsParams.TopMinerVestingPeriod
-			err = am.keeper.PayParticipantFromModule(ctx, typedAction.Miner.Address, typedAction.Payout, types.TopRewardPoolAccName, "top_miner", topMinerVestingPeriod)
+			err = am.keeper.PayParticipantFromModule(ctx, typedAction.Miner.Address, typedAction.Payout, types.TopRewardPoolAccName, streamvestingtypes.VestingSource_VESTING_SOURCE_TOP_MINER, "top_miner", topMinerVestingPeriod)
 			if err != nil {
 				return err
 			}
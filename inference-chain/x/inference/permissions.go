@@ -24,6 +24,7 @@ var InferenceOperationKeyPerms = []sdk.Msg{
 	&types.MsgSubmitPocValidation{},
 	&types.MsgSubmitSeed{},
 	&types.MsgBridgeExchange{},
+	&types.MsgAttestBridgeBlock{},
 	&types.MsgSubmitTrainingKvRecord{},
 	&types.MsgJoinTraining{},
 	&types.MsgJoinTrainingStatus{},
@@ -31,10 +32,12 @@ var InferenceOperationKeyPerms = []sdk.Msg{
 	&types.MsgSetBarrier{},
 	&types.MsgClaimTrainingTaskForAssignment{},
 	&types.MsgAssignTrainingTask{},
+	&types.MsgSettleTrainingPayments{},
 	&types.MsgSubmitNewUnfundedParticipant{},
 	&types.MsgSubmitHardwareDiff{},
 	&types.MsgInvalidateInference{},
 	&types.MsgRevalidateInference{},
+	&types.MsgSubmitAppealVote{},
 	&blstypes.MsgSubmitDealerPart{},
 	&blstypes.MsgSubmitVerificationVector{},
 	&blstypes.MsgRequestThresholdSignature{},
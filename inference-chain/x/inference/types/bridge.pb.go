@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -27,16 +28,21 @@ type BridgeTransactionStatus int32
 const (
 	BridgeTransactionStatus_BRIDGE_PENDING   BridgeTransactionStatus = 0
 	BridgeTransactionStatus_BRIDGE_COMPLETED BridgeTransactionStatus = 1
+	// BRIDGE_HELD is a verified deposit whose release is held back by a rate limit or a paused chain.
+	// It is released automatically once the chain is unpaused and the limits allow it.
+	BridgeTransactionStatus_BRIDGE_HELD BridgeTransactionStatus = 2
 )
 
 var BridgeTransactionStatus_name = map[int32]string{
 	0: "BRIDGE_PENDING",
 	1: "BRIDGE_COMPLETED",
+	2: "BRIDGE_HELD",
 }
 
 var BridgeTransactionStatus_value = map[string]int32{
 	"BRIDGE_PENDING":   0,
 	"BRIDGE_COMPLETED": 1,
+	"BRIDGE_HELD":      2,
 }
 
 func (x BridgeTransactionStatus) String() string {
@@ -370,6 +376,101 @@ func (m *BridgeTransaction) GetTotalValidationPower() int64 {
 	return 0
 }
 
+// BridgeBlockAttestation collects validator votes for the receipts root of an origin chain block.
+// Once validators holding more than half of the epoch power agree on a root, it is attested and
+// receipt proofs against it can complete deposits from that block.
+type BridgeBlockAttestation struct {
+	ChainId              string   `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	BlockNumber          string   `protobuf:"bytes,2,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	ReceiptsRoot         string   `protobuf:"bytes,3,opt,name=receiptsRoot,proto3" json:"receiptsRoot,omitempty"`
+	EpochIndex           uint64   `protobuf:"varint,4,opt,name=epochIndex,proto3" json:"epochIndex,omitempty"`
+	Validators           []string `protobuf:"bytes,5,rep,name=validators,proto3" json:"validators,omitempty"`
+	TotalValidationPower int64    `protobuf:"varint,6,opt,name=totalValidationPower,proto3" json:"totalValidationPower,omitempty"`
+	Attested             bool     `protobuf:"varint,7,opt,name=attested,proto3" json:"attested,omitempty"`
+}
+
+func (m *BridgeBlockAttestation) Reset()         { *m = BridgeBlockAttestation{} }
+func (m *BridgeBlockAttestation) String() string { return proto.CompactTextString(m) }
+func (*BridgeBlockAttestation) ProtoMessage()    {}
+func (*BridgeBlockAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad499bd0e2b8894, []int{4}
+}
+func (m *BridgeBlockAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeBlockAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeBlockAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeBlockAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeBlockAttestation.Merge(m, src)
+}
+func (m *BridgeBlockAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeBlockAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeBlockAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeBlockAttestation proto.InternalMessageInfo
+
+func (m *BridgeBlockAttestation) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *BridgeBlockAttestation) GetBlockNumber() string {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return ""
+}
+
+func (m *BridgeBlockAttestation) GetReceiptsRoot() string {
+	if m != nil {
+		return m.ReceiptsRoot
+	}
+	return ""
+}
+
+func (m *BridgeBlockAttestation) GetEpochIndex() uint64 {
+	if m != nil {
+		return m.EpochIndex
+	}
+	return 0
+}
+
+func (m *BridgeBlockAttestation) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *BridgeBlockAttestation) GetTotalValidationPower() int64 {
+	if m != nil {
+		return m.TotalValidationPower
+	}
+	return 0
+}
+
+func (m *BridgeBlockAttestation) GetAttested() bool {
+	if m != nil {
+		return m.Attested
+	}
+	return false
+}
+
 // Stores mapping between external chain tokens and their CW20 counterparts
 type BridgeWrappedTokenContract struct {
 	ChainId                string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
@@ -381,7 +482,7 @@ func (m *BridgeWrappedTokenContract) Reset()         { *m = BridgeWrappedTokenCo
 func (m *BridgeWrappedTokenContract) String() string { return proto.CompactTextString(m) }
 func (*BridgeWrappedTokenContract) ProtoMessage()    {}
 func (*BridgeWrappedTokenContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ad499bd0e2b8894, []int{4}
+	return fileDescriptor_3ad499bd0e2b8894, []int{5}
 }
 func (m *BridgeWrappedTokenContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,7 +543,7 @@ func (m *Bridge) Reset()         { *m = Bridge{} }
 func (m *Bridge) String() string { return proto.CompactTextString(m) }
 func (*Bridge) ProtoMessage()    {}
 func (*Bridge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ad499bd0e2b8894, []int{5}
+	return fileDescriptor_3ad499bd0e2b8894, []int{6}
 }
 func (m *Bridge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,60 +593,368 @@ func (m *Bridge) GetTradeApprovedTokens() []*BridgeTokenReference {
 	return nil
 }
 
+// BridgeRateLimit caps the volume of a token moving across the bridge for one origin chain. Releases and
+// mints on Gonka (inbound) and withdrawals to the origin chain (outbound) are limited separately.
+type BridgeRateLimit struct {
+	ChainId              string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	ContractAddress      string `protobuf:"bytes,2,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	WindowBlocks         uint64 `protobuf:"varint,3,opt,name=windowBlocks,proto3" json:"windowBlocks,omitempty"`
+	MaxWindowVolume      string `protobuf:"bytes,4,opt,name=maxWindowVolume,proto3" json:"maxWindowVolume,omitempty"`
+	MaxTransactionAmount string `protobuf:"bytes,5,opt,name=maxTransactionAmount,proto3" json:"maxTransactionAmount,omitempty"`
+}
+
+func (m *BridgeRateLimit) Reset()         { *m = BridgeRateLimit{} }
+func (m *BridgeRateLimit) String() string { return proto.CompactTextString(m) }
+func (*BridgeRateLimit) ProtoMessage()    {}
+func (*BridgeRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad499bd0e2b8894, []int{7}
+}
+func (m *BridgeRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeRateLimit.Merge(m, src)
+}
+func (m *BridgeRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeRateLimit proto.InternalMessageInfo
+
+func (m *BridgeRateLimit) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *BridgeRateLimit) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *BridgeRateLimit) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *BridgeRateLimit) GetMaxWindowVolume() string {
+	if m != nil {
+		return m.MaxWindowVolume
+	}
+	return ""
+}
+
+func (m *BridgeRateLimit) GetMaxTransactionAmount() string {
+	if m != nil {
+		return m.MaxTransactionAmount
+	}
+	return ""
+}
+
+type BridgeVolumeEntry struct {
+	BlockHeight int64  `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	Amount      string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *BridgeVolumeEntry) Reset()         { *m = BridgeVolumeEntry{} }
+func (m *BridgeVolumeEntry) String() string { return proto.CompactTextString(m) }
+func (*BridgeVolumeEntry) ProtoMessage()    {}
+func (*BridgeVolumeEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad499bd0e2b8894, []int{8}
+}
+func (m *BridgeVolumeEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeVolumeEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeVolumeEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeVolumeEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeVolumeEntry.Merge(m, src)
+}
+func (m *BridgeVolumeEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeVolumeEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeVolumeEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeVolumeEntry proto.InternalMessageInfo
+
+func (m *BridgeVolumeEntry) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *BridgeVolumeEntry) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// BridgeVolumeUsage holds the transfers of one direction that fall inside the rolling window of a rate limit
+type BridgeVolumeUsage struct {
+	ChainId         string              `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	ContractAddress string              `protobuf:"bytes,2,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Direction       string              `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Entries         []BridgeVolumeEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *BridgeVolumeUsage) Reset()         { *m = BridgeVolumeUsage{} }
+func (m *BridgeVolumeUsage) String() string { return proto.CompactTextString(m) }
+func (*BridgeVolumeUsage) ProtoMessage()    {}
+func (*BridgeVolumeUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad499bd0e2b8894, []int{9}
+}
+func (m *BridgeVolumeUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeVolumeUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeVolumeUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeVolumeUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeVolumeUsage.Merge(m, src)
+}
+func (m *BridgeVolumeUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeVolumeUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeVolumeUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeVolumeUsage proto.InternalMessageInfo
+
+func (m *BridgeVolumeUsage) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *BridgeVolumeUsage) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *BridgeVolumeUsage) GetDirection() string {
+	if m != nil {
+		return m.Direction
+	}
+	return ""
+}
+
+func (m *BridgeVolumeUsage) GetEntries() []BridgeVolumeEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// BridgeChainStatus is the circuit breaker of an origin chain. While paused no deposit from the chain is
+// released and no withdrawal or mint towards it is accepted.
+type BridgeChainStatus struct {
+	ChainId        string  `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Paused         bool    `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	PauseReason    string  `protobuf:"bytes,3,opt,name=pauseReason,proto3" json:"pauseReason,omitempty"`
+	PausedAtHeight int64   `protobuf:"varint,4,opt,name=pausedAtHeight,proto3" json:"pausedAtHeight,omitempty"`
+	AutoPaused     bool    `protobuf:"varint,5,opt,name=autoPaused,proto3" json:"autoPaused,omitempty"`
+	AnomalyHeights []int64 `protobuf:"varint,6,rep,packed,name=anomalyHeights,proto3" json:"anomalyHeights,omitempty"`
+}
+
+func (m *BridgeChainStatus) Reset()         { *m = BridgeChainStatus{} }
+func (m *BridgeChainStatus) String() string { return proto.CompactTextString(m) }
+func (*BridgeChainStatus) ProtoMessage()    {}
+func (*BridgeChainStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad499bd0e2b8894, []int{10}
+}
+func (m *BridgeChainStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeChainStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeChainStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeChainStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeChainStatus.Merge(m, src)
+}
+func (m *BridgeChainStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeChainStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeChainStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeChainStatus proto.InternalMessageInfo
+
+func (m *BridgeChainStatus) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *BridgeChainStatus) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *BridgeChainStatus) GetPauseReason() string {
+	if m != nil {
+		return m.PauseReason
+	}
+	return ""
+}
+
+func (m *BridgeChainStatus) GetPausedAtHeight() int64 {
+	if m != nil {
+		return m.PausedAtHeight
+	}
+	return 0
+}
+
+func (m *BridgeChainStatus) GetAutoPaused() bool {
+	if m != nil {
+		return m.AutoPaused
+	}
+	return false
+}
+
+func (m *BridgeChainStatus) GetAnomalyHeights() []int64 {
+	if m != nil {
+		return m.AnomalyHeights
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("inference.inference.BridgeTransactionStatus", BridgeTransactionStatus_name, BridgeTransactionStatus_value)
 	proto.RegisterType((*BridgeContractAddress)(nil), "inference.inference.BridgeContractAddress")
 	proto.RegisterType((*BridgeTokenMetadata)(nil), "inference.inference.BridgeTokenMetadata")
 	proto.RegisterType((*BridgeTokenReference)(nil), "inference.inference.BridgeTokenReference")
 	proto.RegisterType((*BridgeTransaction)(nil), "inference.inference.BridgeTransaction")
+	proto.RegisterType((*BridgeBlockAttestation)(nil), "inference.inference.BridgeBlockAttestation")
 	proto.RegisterType((*BridgeWrappedTokenContract)(nil), "inference.inference.BridgeWrappedTokenContract")
 	proto.RegisterType((*Bridge)(nil), "inference.inference.Bridge")
+	proto.RegisterType((*BridgeRateLimit)(nil), "inference.inference.BridgeRateLimit")
+	proto.RegisterType((*BridgeVolumeEntry)(nil), "inference.inference.BridgeVolumeEntry")
+	proto.RegisterType((*BridgeVolumeUsage)(nil), "inference.inference.BridgeVolumeUsage")
+	proto.RegisterType((*BridgeChainStatus)(nil), "inference.inference.BridgeChainStatus")
 }
 
 func init() { proto.RegisterFile("inference/inference/bridge.proto", fileDescriptor_3ad499bd0e2b8894) }
 
 var fileDescriptor_3ad499bd0e2b8894 = []byte{
-	// 630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x4f, 0x1b, 0x3d,
-	0x10, 0x8e, 0x13, 0xde, 0x40, 0x06, 0xc8, 0x0b, 0xe6, 0xa3, 0x16, 0x87, 0xd5, 0x6a, 0x4f, 0x5b,
-	0x54, 0x05, 0x89, 0xaa, 0xbd, 0x43, 0x12, 0xa1, 0x48, 0xe5, 0x43, 0x2e, 0x6a, 0x55, 0xaa, 0x2a,
-	0x72, 0xd6, 0x2e, 0xac, 0xc8, 0xae, 0x57, 0xb6, 0xc3, 0xc7, 0xbf, 0xa8, 0xd4, 0x4b, 0xaf, 0xfd,
-	0x35, 0xed, 0x91, 0x63, 0x8f, 0x15, 0xfc, 0x91, 0x6a, 0xbd, 0x9b, 0x74, 0x93, 0x06, 0xaa, 0x4a,
-	0xdc, 0x3c, 0xcf, 0x8c, 0x9f, 0x79, 0x66, 0x3c, 0x63, 0x70, 0xc3, 0xf8, 0xa3, 0x50, 0x22, 0x0e,
-	0xc4, 0xd6, 0xef, 0x53, 0x4f, 0x85, 0xfc, 0x54, 0x34, 0x12, 0x25, 0x8d, 0xc4, 0x2b, 0x23, 0xbc,
-	0x31, 0x3a, 0x79, 0xef, 0x61, 0x6d, 0xd7, 0x06, 0x35, 0x65, 0x6c, 0x14, 0x0b, 0xcc, 0x0e, 0xe7,
-	0x4a, 0x68, 0x8d, 0xeb, 0x50, 0x0e, 0x39, 0x41, 0x2e, 0xf2, 0x6b, 0xb4, 0x1c, 0x72, 0x4c, 0x60,
-	0x36, 0x38, 0x63, 0x61, 0xdc, 0xe1, 0xa4, 0x6c, 0xc1, 0xa1, 0x99, 0x7a, 0x58, 0x76, 0x89, 0x54,
-	0x32, 0x4f, 0x6e, 0x7a, 0x5f, 0x11, 0xac, 0x64, 0xec, 0xc7, 0xf2, 0x5c, 0xc4, 0xfb, 0xc2, 0x30,
-	0xce, 0x0c, 0x2b, 0x72, 0xa1, 0x71, 0x2e, 0x1f, 0xfe, 0x0f, 0xc6, 0x85, 0xe4, 0xd9, 0x26, 0x61,
-	0x8c, 0x61, 0x26, 0x66, 0x91, 0xc8, 0x53, 0xda, 0x33, 0x5e, 0x87, 0xaa, 0xbe, 0x8e, 0x7a, 0xb2,
-	0x4f, 0x66, 0x2c, 0x9a, 0x5b, 0x78, 0x03, 0xe6, 0xb8, 0x08, 0xc2, 0x88, 0xf5, 0x35, 0xf9, 0xcf,
-	0x45, 0xfe, 0x22, 0x1d, 0xd9, 0xde, 0x09, 0xac, 0x16, 0x24, 0x52, 0x91, 0x37, 0xe6, 0x31, 0x34,
-	0x7a, 0xdf, 0x2a, 0xb0, 0x9c, 0x93, 0x2b, 0x16, 0x6b, 0x16, 0x98, 0x50, 0xc6, 0xff, 0xd0, 0xd9,
-	0x29, 0x99, 0x2a, 0xd3, 0xbb, 0xe1, 0xc1, 0x82, 0xbc, 0x8c, 0x85, 0x1a, 0x86, 0x65, 0xf5, 0x8f,
-	0x61, 0x69, 0x77, 0x58, 0x24, 0x07, 0xb1, 0xb1, 0x3d, 0xa8, 0xd1, 0xdc, 0xc2, 0x2d, 0xa8, 0x6a,
-	0xc3, 0xcc, 0x40, 0x93, 0xaa, 0x8b, 0xfc, 0xfa, 0xf6, 0xb3, 0xc6, 0x94, 0x41, 0x69, 0xfc, 0x51,
-	0xc7, 0x6b, 0x7b, 0x87, 0xe6, 0x77, 0xb1, 0x0b, 0xf3, 0xbd, 0xbe, 0x0c, 0xce, 0x0f, 0x06, 0x51,
-	0x4f, 0x28, 0x32, 0x6b, 0x53, 0x14, 0xa1, 0x54, 0xa3, 0x12, 0x81, 0x08, 0x13, 0xd3, 0x89, 0xb9,
-	0xb8, 0x22, 0x73, 0x99, 0xc6, 0x22, 0x56, 0x88, 0xd1, 0x54, 0x4a, 0x43, 0x6a, 0x63, 0x31, 0x16,
-	0xc3, 0x0e, 0x80, 0x48, 0x64, 0x70, 0x96, 0xb1, 0x80, 0x8b, 0xfc, 0x19, 0x5a, 0x40, 0x52, 0xff,
-	0x05, 0xeb, 0x87, 0x9c, 0x19, 0xa9, 0x34, 0x99, 0x77, 0x2b, 0x7e, 0x8d, 0x16, 0x10, 0xbc, 0x0d,
-	0xab, 0x46, 0x1a, 0xd6, 0x7f, 0x93, 0x41, 0xa1, 0x8c, 0x8f, 0xe4, 0xa5, 0x50, 0x64, 0xc1, 0x45,
-	0x7e, 0x85, 0x4e, 0xf5, 0x79, 0x5f, 0x10, 0x6c, 0x64, 0x1d, 0x78, 0xab, 0x58, 0x92, 0x08, 0x6e,
-	0xa7, 0x65, 0xb8, 0x33, 0x8f, 0x32, 0xd0, 0x2f, 0x61, 0xfd, 0x32, 0xe3, 0x6e, 0x4e, 0x7d, 0xf3,
-	0x7b, 0xbc, 0xde, 0xe7, 0x32, 0x54, 0x33, 0x69, 0xf8, 0x1d, 0xe0, 0x21, 0x6b, 0x37, 0xdf, 0x41,
-	0xa1, 0x09, 0x72, 0x2b, 0xfe, 0xfc, 0xf6, 0xe6, 0x03, 0xaf, 0x3a, 0x41, 0x49, 0x97, 0x27, 0xb4,
-	0x09, 0x8d, 0x0f, 0xa1, 0x6e, 0xd2, 0x92, 0xbb, 0x51, 0xbe, 0xc4, 0xa4, 0x6c, 0x69, 0xfd, 0x87,
-	0x86, 0xa5, 0xb8, 0xf4, 0x74, 0xd1, 0x8c, 0xfd, 0x01, 0x1f, 0x60, 0xcd, 0x28, 0xc6, 0x45, 0x97,
-	0x25, 0x89, 0x92, 0x17, 0x82, 0x77, 0xad, 0x3f, 0xad, 0x36, 0xe5, 0x7d, 0xfa, 0x37, 0xde, 0xd1,
-	0xa6, 0xd2, 0x15, 0xcb, 0xb3, 0x93, 0xd3, 0x58, 0xa7, 0xde, 0x6c, 0xc2, 0x93, 0x7b, 0x26, 0x16,
-	0x63, 0xa8, 0xef, 0xd2, 0x4e, 0x6b, 0xaf, 0xdd, 0x3d, 0x6a, 0x1f, 0xb4, 0x3a, 0x07, 0x7b, 0x4b,
-	0x25, 0xbc, 0x0a, 0x4b, 0x39, 0xd6, 0x3c, 0xdc, 0x3f, 0x7a, 0xd5, 0x3e, 0x6e, 0xb7, 0x96, 0xd0,
-	0xee, 0xe1, 0xf7, 0x5b, 0x07, 0xdd, 0xdc, 0x3a, 0xe8, 0xe7, 0xad, 0x83, 0x3e, 0xdd, 0x39, 0xa5,
-	0x9b, 0x3b, 0xa7, 0xf4, 0xe3, 0xce, 0x29, 0x9d, 0xbc, 0x38, 0x0d, 0xcd, 0xd9, 0xa0, 0xd7, 0x08,
-	0x64, 0xb4, 0x95, 0x28, 0xc9, 0x07, 0x81, 0xd1, 0x41, 0x38, 0xf1, 0xfb, 0x5e, 0x15, 0xce, 0xe6,
-	0x3a, 0x11, 0xba, 0x57, 0xb5, 0x3f, 0xf1, 0xf3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x25, 0x76,
-	0xef, 0x6a, 0xad, 0x05, 0x00, 0x00,
+	// 924 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x23, 0x35,
+	0x14, 0xce, 0x64, 0xd2, 0xb4, 0x79, 0xed, 0xa6, 0x5d, 0xb7, 0x5b, 0x46, 0x15, 0x0a, 0xa3, 0x39,
+	0xac, 0x86, 0x15, 0x4a, 0xa5, 0x22, 0xb8, 0x27, 0x4d, 0xd8, 0xad, 0xd4, 0x5f, 0x32, 0x65, 0x57,
+	0x2c, 0x42, 0x91, 0x33, 0x63, 0x52, 0x6b, 0x33, 0xe3, 0x91, 0xc7, 0xd9, 0xb4, 0xff, 0x01, 0x47,
+	0x24, 0x2e, 0x5c, 0xf9, 0x43, 0x38, 0xb3, 0xc7, 0xbd, 0xc1, 0x09, 0xa1, 0xf6, 0x1f, 0x41, 0xfe,
+	0x91, 0xec, 0x24, 0xa4, 0x29, 0x48, 0xbd, 0xd9, 0xdf, 0xb3, 0x3f, 0xbf, 0xef, 0x3d, 0xfb, 0x9b,
+	0x01, 0x9f, 0xa5, 0x3f, 0x50, 0x41, 0xd3, 0x88, 0xee, 0x7f, 0x18, 0xf5, 0x05, 0x8b, 0x07, 0xb4,
+	0x99, 0x09, 0x2e, 0x39, 0xda, 0x9e, 0xe2, 0xcd, 0xe9, 0x68, 0x6f, 0x67, 0xc0, 0x07, 0x5c, 0xc7,
+	0xf7, 0xd5, 0xc8, 0x2c, 0x0d, 0xbe, 0x83, 0x27, 0x6d, 0xbd, 0xf5, 0x90, 0xa7, 0x52, 0x90, 0x48,
+	0xb6, 0xe2, 0x58, 0xd0, 0x3c, 0x47, 0x75, 0x28, 0xb3, 0xd8, 0x73, 0x7c, 0x27, 0xac, 0xe1, 0x32,
+	0x8b, 0x91, 0x07, 0xab, 0xd1, 0x25, 0x61, 0xe9, 0x51, 0xec, 0x95, 0x35, 0x38, 0x99, 0xaa, 0x08,
+	0x31, 0x9b, 0x3c, 0xd7, 0x44, 0xec, 0x34, 0xf8, 0xd5, 0x81, 0x6d, 0xc3, 0x7e, 0xc1, 0xdf, 0xd0,
+	0xf4, 0x84, 0x4a, 0x12, 0x13, 0x49, 0x8a, 0x5c, 0xce, 0x2c, 0x57, 0x08, 0x9b, 0xd1, 0x6c, 0x22,
+	0xf6, 0xb4, 0x79, 0x18, 0x21, 0xa8, 0xa4, 0x24, 0xa1, 0xf6, 0x48, 0x3d, 0x46, 0xbb, 0x50, 0xcd,
+	0xaf, 0x93, 0x3e, 0x1f, 0x7a, 0x15, 0x8d, 0xda, 0x19, 0xda, 0x83, 0xb5, 0x98, 0x46, 0x2c, 0x21,
+	0xc3, 0xdc, 0x5b, 0xf1, 0x9d, 0xf0, 0x11, 0x9e, 0xce, 0x83, 0xd7, 0xb0, 0x53, 0x48, 0x11, 0x53,
+	0x5b, 0xae, 0x87, 0xc8, 0x31, 0xf8, 0xdd, 0x85, 0xc7, 0x96, 0x5c, 0x90, 0x34, 0x27, 0x91, 0x64,
+	0x3c, 0xfd, 0x1f, 0x95, 0x5d, 0x70, 0x92, 0xbb, 0xb8, 0x1a, 0x01, 0x6c, 0xf0, 0x71, 0x4a, 0xc5,
+	0x64, 0x99, 0xd1, 0x3f, 0x83, 0xa9, 0xea, 0x90, 0x84, 0x8f, 0x52, 0xa9, 0x6b, 0x50, 0xc3, 0x76,
+	0x86, 0x3a, 0x50, 0xcd, 0x25, 0x91, 0xa3, 0xdc, 0xab, 0xfa, 0x4e, 0x58, 0x3f, 0xf8, 0xac, 0xb9,
+	0xe0, 0xfa, 0x34, 0xff, 0xa5, 0xe3, 0x6b, 0xbd, 0x07, 0xdb, 0xbd, 0xc8, 0x87, 0xf5, 0xfe, 0x90,
+	0x47, 0x6f, 0x4e, 0x47, 0x49, 0x9f, 0x0a, 0x6f, 0x55, 0x1f, 0x51, 0x84, 0x54, 0x8e, 0x82, 0x46,
+	0x94, 0x65, 0xf2, 0x28, 0x8d, 0xe9, 0x95, 0xb7, 0x66, 0x72, 0x2c, 0x62, 0x85, 0x35, 0x39, 0xe6,
+	0x5c, 0x7a, 0xb5, 0x99, 0x35, 0x1a, 0x43, 0x0d, 0x00, 0x9a, 0xf1, 0xe8, 0xd2, 0xb0, 0x80, 0xef,
+	0x84, 0x15, 0x5c, 0x40, 0x54, 0xfc, 0x2d, 0x19, 0xb2, 0x98, 0x48, 0x2e, 0x72, 0x6f, 0xdd, 0x77,
+	0xc3, 0x1a, 0x2e, 0x20, 0xe8, 0x00, 0x76, 0x24, 0x97, 0x64, 0xf8, 0xd2, 0x40, 0x8c, 0xa7, 0xe7,
+	0x7c, 0x4c, 0x85, 0xb7, 0xe1, 0x3b, 0xa1, 0x8b, 0x17, 0xc6, 0x82, 0x1f, 0xcb, 0xb0, 0x6b, 0x2a,
+	0xd0, 0x56, 0x8a, 0x5a, 0x52, 0x52, 0xa5, 0x5b, 0xb5, 0xf3, 0xee, 0x8b, 0x32, 0x57, 0x92, 0xf2,
+	0xb2, 0x92, 0x18, 0xb9, 0xee, 0xbd, 0x72, 0x2b, 0xf7, 0xc8, 0x5d, 0xf9, 0xcf, 0x72, 0xab, 0x77,
+	0xcb, 0x55, 0x0f, 0x86, 0x68, 0x89, 0x34, 0xd6, 0x9d, 0x5c, 0xc3, 0xd3, 0x79, 0xf0, 0x8b, 0x03,
+	0x7b, 0xa6, 0x14, 0xaf, 0x04, 0xc9, 0x32, 0x1a, 0xeb, 0x87, 0x33, 0xb1, 0x8f, 0x07, 0x79, 0xdb,
+	0x5f, 0xc2, 0xee, 0xd8, 0x70, 0x1f, 0x2e, 0xbc, 0xfe, 0x77, 0x44, 0x83, 0x9f, 0xcb, 0x50, 0x35,
+	0xa9, 0xa1, 0x6f, 0x01, 0x4d, 0x58, 0x7b, 0xd6, 0x8e, 0x68, 0xee, 0x39, 0xbe, 0x1b, 0xae, 0x1f,
+	0x3c, 0x5b, 0x72, 0xc1, 0xe7, 0x28, 0xf1, 0xe3, 0xb9, 0xdc, 0x68, 0x8e, 0xce, 0xa0, 0x2e, 0x95,
+	0xe4, 0x5e, 0x62, 0xfd, 0xcc, 0x2b, 0x6b, 0xda, 0x70, 0xd9, 0xbb, 0x29, 0xfa, 0x1f, 0x7e, 0x24,
+	0x67, 0xec, 0xf0, 0x7b, 0x78, 0x22, 0x05, 0x89, 0x69, 0x8f, 0x64, 0x99, 0xe0, 0x6f, 0x69, 0xdc,
+	0xd3, 0x71, 0xa5, 0x56, 0xf1, 0x7e, 0x7a, 0x1f, 0xef, 0xd4, 0xb4, 0xf0, 0xb6, 0xe6, 0x69, 0x59,
+	0x1a, 0x1d, 0xcc, 0x83, 0x3f, 0x1c, 0xd8, 0x34, 0xab, 0x31, 0x91, 0xf4, 0x98, 0x25, 0xec, 0x61,
+	0xba, 0x14, 0xc0, 0xc6, 0x98, 0xa5, 0x31, 0x1f, 0xeb, 0x27, 0x61, 0x7a, 0x53, 0xc1, 0x33, 0x98,
+	0x62, 0x4b, 0xc8, 0xd5, 0x2b, 0x0d, 0xbd, 0xe4, 0xc3, 0x51, 0x42, 0xad, 0x35, 0xcd, 0xc3, 0xea,
+	0x9a, 0x26, 0xe4, 0xaa, 0xe0, 0x2f, 0xad, 0xa2, 0x57, 0x2d, 0x8c, 0x05, 0x27, 0x13, 0x7b, 0x35,
+	0x1c, 0xdd, 0x54, 0x8a, 0xeb, 0xe9, 0xab, 0x7b, 0x41, 0xd9, 0xe0, 0x52, 0x6a, 0x79, 0x2e, 0x2e,
+	0x42, 0x05, 0x23, 0x2c, 0x17, 0x8d, 0x30, 0xf8, 0xcd, 0x99, 0xe5, 0xfb, 0x26, 0x27, 0x83, 0x07,
+	0xf9, 0x10, 0xa0, 0x8f, 0xa1, 0x16, 0x33, 0x41, 0x75, 0xee, 0xf6, 0x0e, 0x7f, 0x00, 0xd0, 0x57,
+	0xb0, 0x4a, 0x53, 0x29, 0x18, 0x55, 0xbe, 0xad, 0x3a, 0xfe, 0x74, 0x49, 0xc7, 0x0b, 0x52, 0xdb,
+	0x95, 0x77, 0x7f, 0x7d, 0x52, 0xc2, 0x93, 0xcd, 0xaa, 0xd1, 0x36, 0xff, 0x43, 0x95, 0xa1, 0x31,
+	0xe8, 0x25, 0xf9, 0xef, 0x42, 0x35, 0x23, 0xa3, 0x9c, 0x9a, 0xef, 0xce, 0x1a, 0xb6, 0x33, 0x55,
+	0x41, 0x3d, 0xc2, 0x94, 0xe4, 0xd3, 0x7c, 0x8b, 0x10, 0x7a, 0x0a, 0x75, 0xb3, 0xb6, 0x25, 0x6d,
+	0x99, 0x2b, 0xba, 0xcc, 0x73, 0xa8, 0xf2, 0x26, 0x32, 0x92, 0xfc, 0xdc, 0x9c, 0xb2, 0xa2, 0x4f,
+	0x29, 0x20, 0x8a, 0x87, 0xa4, 0x3c, 0x21, 0xc3, 0x6b, 0xb3, 0x41, 0x7d, 0x82, 0x5c, 0xc5, 0x33,
+	0x8b, 0x3e, 0xbb, 0x80, 0x8f, 0xee, 0xf8, 0xfe, 0x20, 0x04, 0xf5, 0x36, 0x3e, 0xea, 0x3c, 0xef,
+	0xf6, 0xce, 0xbb, 0xa7, 0x9d, 0xa3, 0xd3, 0xe7, 0x5b, 0x25, 0xb4, 0x03, 0x5b, 0x16, 0x3b, 0x3c,
+	0x3b, 0x39, 0x3f, 0xee, 0x5e, 0x74, 0x3b, 0x5b, 0x0e, 0xda, 0x84, 0x75, 0x8b, 0xbe, 0xe8, 0x1e,
+	0x77, 0xb6, 0xca, 0xed, 0xb3, 0x77, 0x37, 0x0d, 0xe7, 0xfd, 0x4d, 0xc3, 0xf9, 0xfb, 0xa6, 0xe1,
+	0xfc, 0x74, 0xdb, 0x28, 0xbd, 0xbf, 0x6d, 0x94, 0xfe, 0xbc, 0x6d, 0x94, 0x5e, 0x7f, 0x31, 0x60,
+	0xf2, 0x72, 0xd4, 0x6f, 0x46, 0x3c, 0xd9, 0xcf, 0x04, 0x8f, 0x47, 0x91, 0xcc, 0x23, 0x36, 0xf7,
+	0xcb, 0x75, 0x55, 0x18, 0xcb, 0xeb, 0x8c, 0xe6, 0xfd, 0xaa, 0xfe, 0xa7, 0xfa, 0xfc, 0x9f, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x62, 0x51, 0xa5, 0x7e, 0xa2, 0x09, 0x00, 0x00,
 }
 
 func (m *BridgeContractAddress) Marshal() (dAtA []byte, err error) {
@@ -788,7 +1197,7 @@ func (m *BridgeTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *BridgeWrappedTokenContract) Marshal() (dAtA []byte, err error) {
+func (m *BridgeBlockAttestation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -798,29 +1207,102 @@ func (m *BridgeWrappedTokenContract) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BridgeWrappedTokenContract) MarshalTo(dAtA []byte) (int, error) {
+func (m *BridgeBlockAttestation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BridgeWrappedTokenContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BridgeBlockAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.WrappedContractAddress) > 0 {
-		i -= len(m.WrappedContractAddress)
-		copy(dAtA[i:], m.WrappedContractAddress)
-		i = encodeVarintBridge(dAtA, i, uint64(len(m.WrappedContractAddress)))
+	if m.Attested {
 		i--
-		dAtA[i] = 0x1a
+		if m.Attested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintBridge(dAtA, i, uint64(len(m.ContractAddress)))
+	if m.TotalValidationPower != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.TotalValidationPower))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x30
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintBridge(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.EpochIndex != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ReceiptsRoot) > 0 {
+		i -= len(m.ReceiptsRoot)
+		copy(dAtA[i:], m.ReceiptsRoot)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.ReceiptsRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockNumber) > 0 {
+		i -= len(m.BlockNumber)
+		copy(dAtA[i:], m.BlockNumber)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.BlockNumber)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeWrappedTokenContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeWrappedTokenContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeWrappedTokenContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WrappedContractAddress) > 0 {
+		i -= len(m.WrappedContractAddress)
+		copy(dAtA[i:], m.WrappedContractAddress)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.WrappedContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
@@ -897,6 +1379,236 @@ func (m *Bridge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BridgeRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxTransactionAmount) > 0 {
+		i -= len(m.MaxTransactionAmount)
+		copy(dAtA[i:], m.MaxTransactionAmount)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.MaxTransactionAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MaxWindowVolume) > 0 {
+		i -= len(m.MaxWindowVolume)
+		copy(dAtA[i:], m.MaxWindowVolume)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.MaxWindowVolume)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeVolumeEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeVolumeEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeVolumeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeVolumeUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeVolumeUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeVolumeUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Direction) > 0 {
+		i -= len(m.Direction)
+		copy(dAtA[i:], m.Direction)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Direction)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeChainStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeChainStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeChainStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AnomalyHeights) > 0 {
+		dAtA2 := make([]byte, len(m.AnomalyHeights)*10)
+		var j1 int
+		for _, num1 := range m.AnomalyHeights {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintBridge(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AutoPaused {
+		i--
+		if m.AutoPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PausedAtHeight != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.PausedAtHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PauseReason) > 0 {
+		i -= len(m.PauseReason)
+		copy(dAtA[i:], m.PauseReason)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.PauseReason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBridge(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBridge(v)
 	base := offset
@@ -1030,7 +1742,7 @@ func (m *BridgeTransaction) Size() (n int) {
 	return n
 }
 
-func (m *BridgeWrappedTokenContract) Size() (n int) {
+func (m *BridgeBlockAttestation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1040,27 +1752,63 @@ func (m *BridgeWrappedTokenContract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBridge(uint64(l))
 	}
-	l = len(m.ContractAddress)
+	l = len(m.BlockNumber)
 	if l > 0 {
 		n += 1 + l + sovBridge(uint64(l))
 	}
-	l = len(m.WrappedContractAddress)
+	l = len(m.ReceiptsRoot)
 	if l > 0 {
 		n += 1 + l + sovBridge(uint64(l))
 	}
+	if m.EpochIndex != 0 {
+		n += 1 + sovBridge(uint64(m.EpochIndex))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovBridge(uint64(l))
+		}
+	}
+	if m.TotalValidationPower != 0 {
+		n += 1 + sovBridge(uint64(m.TotalValidationPower))
+	}
+	if m.Attested {
+		n += 2
+	}
 	return n
 }
 
-func (m *Bridge) Size() (n int) {
+func (m *BridgeWrappedTokenContract) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ContractAddresses) > 0 {
-		for _, e := range m.ContractAddresses {
-			l = e.Size()
-			n += 1 + l + sovBridge(uint64(l))
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.WrappedContractAddress)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	return n
+}
+
+func (m *Bridge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractAddresses) > 0 {
+		for _, e := range m.ContractAddresses {
+			l = e.Size()
+			n += 1 + l + sovBridge(uint64(l))
 		}
 	}
 	if len(m.TokenMetadata) > 0 {
@@ -1078,6 +1826,110 @@ func (m *Bridge) Size() (n int) {
 	return n
 }
 
+func (m *BridgeRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovBridge(uint64(m.WindowBlocks))
+	}
+	l = len(m.MaxWindowVolume)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.MaxTransactionAmount)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeVolumeEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovBridge(uint64(m.BlockHeight))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeVolumeUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.Direction)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovBridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeChainStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.PauseReason)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.PausedAtHeight != 0 {
+		n += 1 + sovBridge(uint64(m.PausedAtHeight))
+	}
+	if m.AutoPaused {
+		n += 2
+	}
+	if len(m.AnomalyHeights) > 0 {
+		l = 0
+		for _, e := range m.AnomalyHeights {
+			l += sovBridge(uint64(e))
+		}
+		n += 1 + sovBridge(uint64(l)) + l
+	}
+	return n
+}
+
 func sovBridge(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1115,7 +1967,675 @@ func (m *BridgeContractAddress) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeTokenMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeTokenMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeTokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeTokenReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeTokenReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeTokenReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BridgeTransactionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1143,11 +2663,11 @@ func (m *BridgeContractAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ReceiptIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1175,11 +2695,30 @@ func (m *BridgeContractAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			}
+			m.EpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1207,8 +2746,27 @@ func (m *BridgeContractAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidationPower", wireType)
+			}
+			m.TotalValidationPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalValidationPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBridge(dAtA[iNdEx:])
@@ -1230,7 +2788,7 @@ func (m *BridgeContractAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BridgeTokenMetadata) Unmarshal(dAtA []byte) error {
+func (m *BridgeBlockAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1253,10 +2811,10 @@ func (m *BridgeTokenMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BridgeTokenMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeBlockAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BridgeTokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeBlockAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1293,7 +2851,7 @@ func (m *BridgeTokenMetadata) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1321,11 +2879,11 @@ func (m *BridgeTokenMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.BlockNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1353,11 +2911,30 @@ func (m *BridgeTokenMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			}
+			m.EpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1385,13 +2962,13 @@ func (m *BridgeTokenMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidationPower", wireType)
 			}
-			m.Decimals = 0
+			m.TotalValidationPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -1401,11 +2978,31 @@ func (m *BridgeTokenMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= uint32(b&0x7F) << shift
+				m.TotalValidationPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Attested = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBridge(dAtA[iNdEx:])
@@ -1427,7 +3024,7 @@ func (m *BridgeTokenMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BridgeTokenReference) Unmarshal(dAtA []byte) error {
+func (m *BridgeWrappedTokenContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1450,10 +3047,10 @@ func (m *BridgeTokenReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BridgeTokenReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeWrappedTokenContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BridgeTokenReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeWrappedTokenContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1520,6 +3117,38 @@ func (m *BridgeTokenReference) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrappedContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WrappedContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBridge(dAtA[iNdEx:])
@@ -1541,7 +3170,7 @@ func (m *BridgeTokenReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
+func (m *Bridge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1564,17 +3193,17 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BridgeTransaction: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bridge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BridgeTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bridge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -1584,29 +3213,31 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBridge
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ContractAddresses = append(m.ContractAddresses, &BridgeContractAddress{})
+			if err := m.ContractAddresses[len(m.ContractAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMetadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -1616,29 +3247,31 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBridge
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.TokenMetadata = append(m.TokenMetadata, &BridgeTokenMetadata{})
+			if err := m.TokenMetadata[len(m.TokenMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeApprovedTokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -1648,27 +3281,79 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBridge
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.TradeApprovedTokens = append(m.TradeApprovedTokens, &BridgeTokenReference{})
+			if err := m.TradeApprovedTokens[len(m.TradeApprovedTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1696,11 +3381,11 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1728,13 +3413,13 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
 			}
-			m.Status = 0
+			m.WindowBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -1744,14 +3429,14 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BridgeTransactionStatus(b&0x7F) << shift
+				m.WindowBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWindowVolume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1779,11 +3464,11 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockNumber = string(dAtA[iNdEx:postIndex])
+			m.MaxWindowVolume = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransactionAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1811,45 +3496,63 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiptIndex = string(dAtA[iNdEx:postIndex])
+			m.MaxTransactionAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBridge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBridge
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBridge
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeVolumeEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeVolumeEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeVolumeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.EpochIndex = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -1859,14 +3562,14 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochIndex |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1894,27 +3597,8 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidationPower", wireType)
-			}
-			m.TotalValidationPower = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBridge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalValidationPower |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBridge(dAtA[iNdEx:])
@@ -1936,7 +3620,7 @@ func (m *BridgeTransaction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BridgeWrappedTokenContract) Unmarshal(dAtA []byte) error {
+func (m *BridgeVolumeUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1959,10 +3643,10 @@ func (m *BridgeWrappedTokenContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BridgeWrappedTokenContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeVolumeUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BridgeWrappedTokenContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeVolumeUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2031,7 +3715,7 @@ func (m *BridgeWrappedTokenContract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WrappedContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2059,7 +3743,41 @@ func (m *BridgeWrappedTokenContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WrappedContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Direction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, BridgeVolumeEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2082,7 +3800,7 @@ func (m *BridgeWrappedTokenContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bridge) Unmarshal(dAtA []byte) error {
+func (m *BridgeChainStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2105,17 +3823,17 @@ func (m *Bridge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bridge: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeChainStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bridge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeChainStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -2125,31 +3843,49 @@ func (m *Bridge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBridge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddresses = append(m.ContractAddresses, &BridgeContractAddress{})
-			if err := m.ContractAddresses[len(m.ContractAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -2159,31 +3895,29 @@ func (m *Bridge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBridge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenMetadata = append(m.TokenMetadata, &BridgeTokenMetadata{})
-			if err := m.TokenMetadata[len(m.TokenMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PauseReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradeApprovedTokens", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAtHeight", wireType)
 			}
-			var msglen int
+			m.PausedAtHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -2193,26 +3927,107 @@ func (m *Bridge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PausedAtHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBridge
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBridge
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPaused", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.TradeApprovedTokens = append(m.TradeApprovedTokens, &BridgeTokenReference{})
-			if err := m.TradeApprovedTokens[len(m.TradeApprovedTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AutoPaused = bool(v != 0)
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBridge
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AnomalyHeights = append(m.AnomalyHeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBridge
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBridge
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBridge
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AnomalyHeights) == 0 {
+					m.AnomalyHeights = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBridge
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AnomalyHeights = append(m.AnomalyHeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnomalyHeights", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBridge(dAtA[iNdEx:])
@@ -46,6 +46,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRegisterModel{},
+		&MsgSetModelStatus{},
+		&MsgSetModelAlias{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateTrainingTask{},
@@ -59,6 +61,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAssignTrainingTask{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSettleTrainingPayments{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFinishTrainingTask{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelTrainingTask{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreatePartialUpgrade{},
 	)
@@ -98,12 +109,23 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRequestBridgeMint{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAttestBridgeBlock{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetBridgeRateLimit{},
+		&MsgSetBridgePaused{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAddParticipantsToAllowList{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRemoveParticipantsFromAllowList{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAppealInvalidation{},
+		&MsgSubmitAppealVote{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
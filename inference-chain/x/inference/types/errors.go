@@ -60,4 +60,25 @@ var (
 	ErrDeveloperNotAllowlisted               = sdkerrors.Register(ModuleName, 1154, "developer is not allowlisted to request inference at this height")
 	ErrParticipantBlocked                    = sdkerrors.Register(ModuleName, 1155, "participant is blocked from participating in PoC")
 	ErrNewParticipantRegistrationClosed      = sdkerrors.Register(ModuleName, 1156, "new participant registration is closed")
+	ErrAppealsDisabled                       = sdkerrors.Register(ModuleName, 1157, "invalidation appeals are disabled")
+	ErrInferenceNotInvalidated               = sdkerrors.Register(ModuleName, 1158, "inference is not invalidated")
+	ErrAppealWindowClosed                    = sdkerrors.Register(ModuleName, 1159, "appeal window has closed")
+	ErrAppealAlreadyExists                   = sdkerrors.Register(ModuleName, 1160, "inference has already been appealed")
+	ErrAppealNotFound                        = sdkerrors.Register(ModuleName, 1161, "appeal not found")
+	ErrAppealNotPending                      = sdkerrors.Register(ModuleName, 1162, "appeal is not pending")
+	ErrNotAppealValidator                    = sdkerrors.Register(ModuleName, 1163, "participant is not assigned to this appeal")
+	ErrNoAppealValidators                    = sdkerrors.Register(ModuleName, 1164, "no validators available for appeal")
+	ErrModelNotFound                         = sdkerrors.Register(ModuleName, 1165, "model not found")
+	ErrInvalidModelStatus                    = sdkerrors.Register(ModuleName, 1166, "invalid model status transition")
+	ErrInvalidModelAlias                     = sdkerrors.Register(ModuleName, 1167, "invalid model alias")
+	ErrReceiptsRootNotAttested               = sdkerrors.Register(ModuleName, 1168, "receipts root is not attested")
+	ErrInvalidReceiptProof                   = sdkerrors.Register(ModuleName, 1169, "invalid receipt proof")
+	ErrBridgePaused                          = sdkerrors.Register(ModuleName, 1170, "bridge is paused for this chain")
+	ErrBridgeRateLimitExceeded               = sdkerrors.Register(ModuleName, 1171, "bridge rate limit exceeded")
+	ErrTrainingTaskFinished                  = sdkerrors.Register(ModuleName, 1172, "training task is already finished")
+	ErrInvalidTrainingCheckpoint             = sdkerrors.Register(ModuleName, 1173, "invalid training checkpoint")
+	ErrInvalidTrainingBudget                 = sdkerrors.Register(ModuleName, 1174, "invalid training budget")
+	ErrPoCBatchCommitmentsDisabled           = sdkerrors.Register(ModuleName, 1175, "PoC batch commitments are disabled")
+	ErrInvalidPoCLeafDispute                 = sdkerrors.Register(ModuleName, 1176, "invalid PoC leaf dispute")
+	ErrPoCBatchNoncesOverlap                 = sdkerrors.Register(ModuleName, 1177, "PoC batch nonces overlap another committed batch")
 )
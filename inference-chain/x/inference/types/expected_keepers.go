@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 	blstypes "github.com/productscience/inference/x/bls/types"
+	streamvestingtypes "github.com/productscience/inference/x/streamvesting/types"
 )
 
 // AccountKeeper defines the expected interface for the account module.
@@ -78,12 +79,16 @@ type StakingKeeper interface {
 type CollateralKeeper interface {
 	AdvanceEpoch(ctx context.Context, completedEpoch uint64)
 	GetCollateral(ctx context.Context, participant sdk.AccAddress) (collateral sdk.Coin, found bool)
+	GetEffectiveCollateral(ctx context.Context, participant sdk.AccAddress) (collateral sdk.Coin, found bool)
 	Slash(ctx context.Context, participant sdk.AccAddress, slashFraction math.LegacyDec, reason string) (sdk.Coin, error)
+	SlashWithBeneficiaries(ctx context.Context, participant sdk.AccAddress, slashFraction math.LegacyDec, reason string, beneficiaries []sdk.AccAddress) (sdk.Coin, error)
+	CompensateRequester(ctx context.Context, requester sdk.AccAddress, baseAmount sdk.Coin, memo string) (sdk.Coin, error)
+	ReclaimCompensation(ctx context.Context, requester sdk.AccAddress, amount sdk.Coin, memo string) error
 }
 
 // StreamVestingKeeper defines the expected interface for the StreamVesting module.
 type StreamVestingKeeper interface {
-	AddVestedRewards(ctx context.Context, participantAddress string, fundingModule string, amount sdk.Coins, vestingEpochs *uint64, memo string) error
+	AddVestedRewardsFromSource(ctx context.Context, participantAddress string, fundingModule string, source streamvestingtypes.VestingSource, amount sdk.Coins, vestingEpochs *uint64, memo string) error
 	AdvanceEpoch(ctx context.Context, completedEpoch uint64) error
 }
 
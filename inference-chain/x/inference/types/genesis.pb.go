@@ -89,6 +89,7 @@ type GenesisState struct {
 	ParticipantList []Participant   `protobuf:"bytes,5,rep,name=participant_list,json=participantList,proto3" json:"participant_list"`
 	MlnodeVersion   *MLNodeVersion  `protobuf:"bytes,6,opt,name=mlnode_version,json=mlnodeVersion,proto3" json:"mlnode_version,omitempty"`
 	Bridge          *Bridge         `protobuf:"bytes,7,opt,name=bridge,proto3" json:"bridge,omitempty"`
+	ModelAliasList  []ModelAlias    `protobuf:"bytes,8,rep,name=model_alias_list,json=modelAliasList,proto3" json:"model_alias_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -174,6 +175,13 @@ func (m *GenesisState) GetBridge() *Bridge {
 	return nil
 }
 
+func (m *GenesisState) GetModelAliasList() []ModelAlias {
+	if m != nil {
+		return m.ModelAliasList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CosmWasmParams)(nil), "inference.inference.CosmWasmParams")
 	proto.RegisterType((*GenesisState)(nil), "inference.inference.GenesisState")
@@ -182,46 +190,48 @@ func init() {
 func init() { proto.RegisterFile("inference/inference/genesis.proto", fileDescriptor_ba05d339ce8ae856) }
 
 var fileDescriptor_ba05d339ce8ae856 = []byte{
-	// 612 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0xc7, 0xbb, 0xd0, 0x5f, 0x7f, 0x32, 0x20, 0xc2, 0xe2, 0xa1, 0x29, 0x49, 0x59, 0x21, 0x6a,
-	0xc5, 0xa4, 0x55, 0x88, 0x1e, 0x3c, 0x68, 0x52, 0x0e, 0x86, 0x04, 0x45, 0xd7, 0x88, 0x89, 0x97,
-	0xc9, 0xb0, 0x33, 0x2c, 0x13, 0x77, 0xe6, 0x6d, 0x66, 0x66, 0xc1, 0xfe, 0x17, 0xfe, 0x03, 0x26,
-	0x1e, 0x3d, 0xfa, 0x67, 0x70, 0xe4, 0xe8, 0xc9, 0x18, 0x38, 0xe8, 0x9f, 0x61, 0x3a, 0xbb, 0x5b,
-	0x0a, 0x4e, 0xd7, 0x4b, 0x33, 0xdd, 0xf7, 0x79, 0xdf, 0x79, 0xef, 0xcd, 0xf7, 0xa1, 0x5b, 0x5c,
-	0x1e, 0x30, 0xc5, 0x64, 0xc4, 0x7a, 0x17, 0xa7, 0x98, 0x49, 0xa6, 0xb9, 0xee, 0xa6, 0x0a, 0x0c,
-	0xf8, 0x4b, 0xa3, 0x40, 0x77, 0x74, 0x6a, 0x2d, 0x12, 0xc1, 0x25, 0xf4, 0xec, 0x6f, 0xce, 0xb5,
-	0x6e, 0xc6, 0x10, 0x83, 0x3d, 0xf6, 0x86, 0xa7, 0xe2, 0x6b, 0xe0, 0xba, 0x20, 0x25, 0x8a, 0x88,
-	0x42, 0xbf, 0xb5, 0xe6, 0x22, 0x2e, 0xee, 0xcc, 0xa1, 0xdb, 0x13, 0x64, 0x0c, 0x8f, 0x78, 0x4a,
-	0xa4, 0x29, 0xb0, 0x75, 0x17, 0xc6, 0x52, 0x88, 0x0e, 0x71, 0xac, 0x20, 0x4b, 0x31, 0x25, 0x86,
-	0x14, 0xec, 0x5d, 0x17, 0xab, 0x99, 0x31, 0x09, 0xc3, 0x44, 0x40, 0x36, 0x12, 0x7d, 0xf8, 0x2f,
-	0xd1, 0x23, 0x92, 0x70, 0x4a, 0x0c, 0x07, 0x59, 0xf6, 0x74, 0xcf, 0x95, 0x62, 0xe0, 0x03, 0x93,
-	0x20, 0x78, 0xa4, 0xc7, 0xcb, 0x58, 0x71, 0xa1, 0x02, 0x28, 0x4b, 0xaa, 0xe6, 0x63, 0x20, 0xc5,
-	0x82, 0x4b, 0xa6, 0x0a, 0xe8, 0x7e, 0xe5, 0x10, 0xb1, 0xe1, 0x82, 0x41, 0x56, 0x36, 0xf4, 0xb8,
-	0x1a, 0xbe, 0x68, 0x07, 0x53, 0x66, 0x08, 0x4f, 0xca, 0xae, 0x36, 0x27, 0x0f, 0x22, 0x65, 0xea,
-	0x00, 0x94, 0x20, 0xc3, 0x7c, 0x9d, 0x09, 0x41, 0xd4, 0xa0, 0x6a, 0x14, 0xf6, 0xe5, 0x48, 0x82,
-	0xb3, 0x34, 0x56, 0x84, 0xb2, 0x2a, 0xaf, 0xec, 0x2b, 0x4e, 0xe3, 0x92, 0xe8, 0x38, 0x87, 0x95,
-	0x48, 0xa0, 0x0c, 0x1f, 0x31, 0xa5, 0x39, 0xc8, 0x9c, 0x5c, 0x7d, 0x8b, 0xe6, 0xb7, 0x40, 0x8b,
-	0x77, 0x44, 0x8b, 0x57, 0xd6, 0x6d, 0xfe, 0x32, 0x9a, 0x89, 0x8e, 0x37, 0x1e, 0xe0, 0x08, 0x28,
-	0x6b, 0x7a, 0x81, 0xd7, 0x99, 0x0b, 0xaf, 0x0d, 0x3f, 0x6c, 0x01, 0x65, 0x7e, 0x80, 0xe6, 0x46,
-	0x41, 0xcc, 0x69, 0x73, 0x2a, 0xf0, 0x3a, 0xf5, 0x10, 0x95, 0xf1, 0x6d, 0xfa, 0xa4, 0xfe, 0xfb,
-	0xcb, 0x8a, 0xb7, 0xfa, 0xb9, 0x8e, 0xe6, 0x9e, 0xe7, 0xeb, 0xf1, 0xc6, 0x10, 0xc3, 0xfc, 0xa7,
-	0xa8, 0x91, 0xbb, 0xd9, 0x4a, 0xce, 0x6e, 0x2c, 0x77, 0x1d, 0xeb, 0xd2, 0xcd, 0x4b, 0xe8, 0xcf,
-	0x9c, 0xfc, 0x58, 0xa9, 0x7d, 0xfd, 0xf5, 0x6d, 0xdd, 0x0b, 0x8b, 0x2c, 0x9f, 0xa0, 0xa5, 0x62,
-	0xdd, 0x30, 0xc8, 0x64, 0x80, 0x0b, 0xb1, 0x29, 0x2b, 0x76, 0xc7, 0x29, 0x56, 0xdc, 0xbf, 0x2b,
-	0x93, 0xc1, 0xdf, 0xba, 0x8b, 0xf1, 0xd5, 0xa8, 0xff, 0x0c, 0x21, 0xeb, 0x27, 0x9c, 0x70, 0x6d,
-	0x9a, 0xd3, 0xc1, 0x74, 0x67, 0x76, 0xa3, 0xe5, 0x54, 0x7e, 0x31, 0xc4, 0xfa, 0xf5, 0xa1, 0x5a,
-	0x38, 0x63, 0x73, 0x76, 0xb8, 0x36, 0xfe, 0x6b, 0xb4, 0x10, 0x81, 0x16, 0xf8, 0x98, 0x68, 0x51,
-	0x16, 0x58, 0xb7, 0x05, 0xae, 0x39, 0x65, 0x2e, 0x0f, 0xbe, 0x3f, 0xd5, 0xf4, 0xc2, 0xf9, 0xe8,
-	0xf2, 0x63, 0xec, 0xa1, 0x85, 0xb1, 0xed, 0xcd, 0x2b, 0xfb, 0xcf, 0x56, 0x16, 0x4c, 0x1a, 0x60,
-	0x09, 0x8f, 0x77, 0x7b, 0x63, 0x4c, 0xc4, 0x96, 0xba, 0x8d, 0xe6, 0x2f, 0xdb, 0xa1, 0xd9, 0xb0,
-	0x85, 0xae, 0xba, 0xfb, 0xdd, 0x79, 0x09, 0x94, 0xed, 0xe5, 0x64, 0x78, 0x3d, 0xcf, 0x2c, 0xfe,
-	0xfa, 0x9b, 0xa8, 0x91, 0x7b, 0xaf, 0xf9, 0x7f, 0xc5, 0xcb, 0xf6, 0x2d, 0x12, 0x16, 0x68, 0x7f,
-	0xf7, 0xe4, 0xac, 0xed, 0x9d, 0x9e, 0xb5, 0xbd, 0x9f, 0x67, 0x6d, 0xef, 0xd3, 0x79, 0xbb, 0x76,
-	0x7a, 0xde, 0xae, 0x7d, 0x3f, 0x6f, 0xd7, 0xde, 0x3f, 0x8a, 0xb9, 0x39, 0xcc, 0xf6, 0xbb, 0x11,
-	0x88, 0x5e, 0xaa, 0x80, 0x66, 0x91, 0xd1, 0x11, 0xbf, 0x62, 0xe5, 0x8f, 0xe3, 0x2b, 0x3e, 0x48,
-	0x99, 0xde, 0x6f, 0x58, 0x3b, 0x6f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x87, 0x6c, 0x15,
-	0xa9, 0x05, 0x00, 0x00,
+	// 641 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xc1, 0x6e, 0x13, 0x3d,
+	0x10, 0xc7, 0xb3, 0x6d, 0xbe, 0x7c, 0xad, 0x5b, 0x42, 0xbb, 0xe5, 0x10, 0xa5, 0x52, 0x12, 0x5a,
+	0x01, 0xa1, 0x48, 0x09, 0xb4, 0x82, 0x03, 0x07, 0x10, 0xe9, 0x01, 0x55, 0x2a, 0x14, 0x16, 0x51,
+	0x24, 0x2e, 0x96, 0xbb, 0x76, 0xb7, 0x16, 0xeb, 0x9d, 0xd5, 0xda, 0xdb, 0x92, 0xb7, 0xe0, 0x11,
+	0x38, 0x72, 0xe4, 0x31, 0x7a, 0xec, 0x91, 0x13, 0x42, 0xed, 0x01, 0x4e, 0x3c, 0x03, 0x5a, 0xaf,
+	0x37, 0x4d, 0x8a, 0xb3, 0x5c, 0x22, 0xc7, 0xfe, 0xcd, 0xdf, 0x33, 0x7f, 0xcf, 0x2c, 0xba, 0xc9,
+	0xa3, 0x43, 0x96, 0xb0, 0xc8, 0x67, 0xfd, 0xcb, 0x55, 0xc0, 0x22, 0x26, 0xb9, 0xec, 0xc5, 0x09,
+	0x28, 0x70, 0x57, 0x46, 0x07, 0xbd, 0xd1, 0xaa, 0xb9, 0x4c, 0x04, 0x8f, 0xa0, 0xaf, 0x7f, 0x73,
+	0xae, 0x79, 0x23, 0x80, 0x00, 0xf4, 0xb2, 0x9f, 0xad, 0xcc, 0x6e, 0xc7, 0x76, 0x41, 0x4c, 0x12,
+	0x22, 0x8c, 0x7e, 0x73, 0xdd, 0x46, 0x5c, 0xde, 0x99, 0x43, 0xb7, 0xa6, 0xc8, 0x28, 0xee, 0xf3,
+	0x98, 0x44, 0xca, 0x60, 0x1b, 0x36, 0x8c, 0xc5, 0xe0, 0x1f, 0xe1, 0x20, 0x81, 0x34, 0xc6, 0x94,
+	0x28, 0x62, 0xd8, 0x3b, 0x36, 0x56, 0x32, 0xa5, 0x42, 0x86, 0x89, 0x80, 0x74, 0x24, 0xfa, 0xe0,
+	0x5f, 0xa2, 0xc7, 0x24, 0xe4, 0x94, 0x28, 0x0e, 0x51, 0x51, 0xd3, 0x5d, 0x5b, 0x88, 0x82, 0x0f,
+	0x2c, 0x02, 0xc1, 0x7d, 0x39, 0x9e, 0x46, 0xdb, 0x86, 0x0a, 0xa0, 0x2c, 0x2c, 0xf3, 0x47, 0x41,
+	0x8c, 0x05, 0x8f, 0x58, 0x62, 0xa0, 0x7b, 0xa5, 0x26, 0x62, 0xc5, 0x05, 0x83, 0xb4, 0x28, 0xe8,
+	0x51, 0x39, 0x7c, 0x59, 0x0e, 0xa6, 0x4c, 0x11, 0x1e, 0x16, 0x55, 0x6d, 0x4d, 0x37, 0x22, 0x66,
+	0xc9, 0x21, 0x24, 0x82, 0x64, 0xf1, 0x32, 0x15, 0x82, 0x24, 0xc3, 0x32, 0x2b, 0xf4, 0xcb, 0x91,
+	0x10, 0xa7, 0x71, 0x90, 0x10, 0xca, 0xca, 0x7a, 0xe5, 0x20, 0xe1, 0x34, 0x28, 0x88, 0xae, 0xd5,
+	0xac, 0x30, 0x02, 0xca, 0xf0, 0x31, 0x4b, 0x24, 0x87, 0x28, 0x27, 0xd7, 0xde, 0xa2, 0xfa, 0x36,
+	0x48, 0xf1, 0x8e, 0x48, 0xf1, 0x4a, 0x77, 0x9b, 0xbb, 0x8a, 0xe6, 0xfd, 0x93, 0xcd, 0xfb, 0xd8,
+	0x07, 0xca, 0x1a, 0x4e, 0xc7, 0xe9, 0x2e, 0x7a, 0x73, 0xd9, 0xc6, 0x36, 0x50, 0xe6, 0x76, 0xd0,
+	0xe2, 0xe8, 0x10, 0x73, 0xda, 0x98, 0xe9, 0x38, 0xdd, 0xaa, 0x87, 0x8a, 0xf3, 0x1d, 0xfa, 0xb8,
+	0xfa, 0xeb, 0x73, 0xdb, 0x59, 0xfb, 0x5d, 0x45, 0x8b, 0xcf, 0xf3, 0xf1, 0x78, 0xa3, 0x88, 0x62,
+	0xee, 0x13, 0x54, 0xcb, 0xbb, 0x59, 0x4b, 0x2e, 0x6c, 0xae, 0xf6, 0x2c, 0xe3, 0xd2, 0xcb, 0x53,
+	0x18, 0xcc, 0x9f, 0x7e, 0x6f, 0x57, 0xbe, 0xfc, 0xfc, 0xba, 0xe1, 0x78, 0x26, 0xca, 0x25, 0x68,
+	0xc5, 0x8c, 0x1b, 0x86, 0x28, 0x1c, 0x62, 0x23, 0x36, 0xa3, 0xc5, 0x6e, 0x5b, 0xc5, 0xcc, 0xfd,
+	0x7b, 0x51, 0x38, 0xfc, 0x5b, 0x77, 0x39, 0xb8, 0x7a, 0xea, 0x3e, 0x45, 0x48, 0xf7, 0x13, 0x0e,
+	0xb9, 0x54, 0x8d, 0xd9, 0xce, 0x6c, 0x77, 0x61, 0xb3, 0x69, 0x55, 0x7e, 0x91, 0x61, 0x83, 0x6a,
+	0xa6, 0xe6, 0xcd, 0xeb, 0x98, 0x5d, 0x2e, 0x95, 0xfb, 0x1a, 0x2d, 0xf9, 0x20, 0x05, 0x3e, 0x21,
+	0x52, 0x14, 0x09, 0x56, 0x75, 0x82, 0xeb, 0x56, 0x99, 0x49, 0xe3, 0x07, 0x33, 0x0d, 0xc7, 0xab,
+	0xfb, 0x93, 0x8f, 0xb1, 0x8f, 0x96, 0xc6, 0xa6, 0x37, 0xcf, 0xec, 0x3f, 0x9d, 0x59, 0x67, 0x9a,
+	0x81, 0x05, 0x3c, 0x5e, 0xed, 0xf5, 0x31, 0x11, 0x9d, 0xea, 0x0e, 0xaa, 0x4f, 0xb6, 0x43, 0xa3,
+	0xa6, 0x13, 0x5d, 0xb3, 0xd7, 0xbb, 0xfb, 0x12, 0x28, 0xdb, 0xcf, 0x49, 0xef, 0x5a, 0x1e, 0x69,
+	0xfe, 0xba, 0x5b, 0xa8, 0x96, 0xf7, 0x5e, 0xe3, 0xff, 0x92, 0x97, 0x1d, 0x68, 0xc4, 0x33, 0xa8,
+	0xbb, 0x87, 0x96, 0x72, 0xaf, 0x49, 0xc8, 0x89, 0xcc, 0xeb, 0x9a, 0xd3, 0x75, 0xb5, 0xa7, 0x3b,
+	0xfe, 0x2c, 0x63, 0x8d, 0xed, 0x75, 0x31, 0xda, 0xc9, 0x0a, 0x1a, 0xec, 0x9d, 0x9e, 0xb7, 0x9c,
+	0xb3, 0xf3, 0x96, 0xf3, 0xe3, 0xbc, 0xe5, 0x7c, 0xba, 0x68, 0x55, 0xce, 0x2e, 0x5a, 0x95, 0x6f,
+	0x17, 0xad, 0xca, 0xfb, 0x87, 0x01, 0x57, 0x47, 0xe9, 0x41, 0xcf, 0x07, 0xd1, 0x8f, 0x13, 0xa0,
+	0xa9, 0xaf, 0xa4, 0xcf, 0xaf, 0xcc, 0xc6, 0xc7, 0xf1, 0x6f, 0xc6, 0x30, 0x66, 0xf2, 0xa0, 0xa6,
+	0xe7, 0x63, 0xeb, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x93, 0xdf, 0x8a, 0xae, 0xfa, 0x05, 0x00,
+	0x00,
 }
 
 func (this *CosmWasmParams) Equal(that interface{}) bool {
@@ -306,6 +316,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ModelAliasList) > 0 {
+		for iNdEx := len(m.ModelAliasList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModelAliasList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.Bridge != nil {
 		{
 			size, err := m.Bridge.MarshalToSizedBuffer(dAtA[:i])
@@ -454,6 +478,12 @@ func (m *GenesisState) Size() (n int) {
 		l = m.Bridge.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ModelAliasList) > 0 {
+		for _, e := range m.ModelAliasList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -837,6 +867,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelAliasList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModelAliasList = append(m.ModelAliasList, ModelAlias{})
+			if err := m.ModelAliasList[len(m.ModelAliasList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -12,3 +12,9 @@ func (i *Inference) StartProcessed() bool {
 func (i *Inference) FinishedProcessed() bool {
 	return i.ExecutedBy != ""
 }
+
+// ProducedNoOutput returns true for an inference that was truncated before its first token. It is not billed, and
+// since validators auto-pass it, it earns no work or reputation credit either.
+func (i *Inference) ProducedNoOutput() bool {
+	return i.CompletionStatus != InferenceCompletionStatus_COMPLETION_STATUS_COMPLETE && i.CompletionTokenCount == 0
+}
@@ -59,6 +59,37 @@ func (InferenceStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ce060d6da7916311, []int{0}
 }
 
+// How the execution of a finished inference ended. Truncated inferences are billed for the tokens actually produced.
+type InferenceCompletionStatus int32
+
+const (
+	InferenceCompletionStatus_COMPLETION_STATUS_COMPLETE InferenceCompletionStatus = 0
+	// The client disconnected mid-stream and the executor stopped generating
+	InferenceCompletionStatus_COMPLETION_STATUS_CLIENT_CANCELLED InferenceCompletionStatus = 1
+	// The ML node failed mid-stream, the output is what was produced before the failure
+	InferenceCompletionStatus_COMPLETION_STATUS_EXECUTOR_FAILED InferenceCompletionStatus = 2
+)
+
+var InferenceCompletionStatus_name = map[int32]string{
+	0: "COMPLETION_STATUS_COMPLETE",
+	1: "COMPLETION_STATUS_CLIENT_CANCELLED",
+	2: "COMPLETION_STATUS_EXECUTOR_FAILED",
+}
+
+var InferenceCompletionStatus_value = map[string]int32{
+	"COMPLETION_STATUS_COMPLETE":         0,
+	"COMPLETION_STATUS_CLIENT_CANCELLED": 1,
+	"COMPLETION_STATUS_EXECUTOR_FAILED":  2,
+}
+
+func (x InferenceCompletionStatus) String() string {
+	return proto.EnumName(InferenceCompletionStatus_name, int32(x))
+}
+
+func (InferenceCompletionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ce060d6da7916311, []int{1}
+}
+
 type ProposalDetails struct {
 	ReValidatePolicyId uint64 `protobuf:"varint,1,opt,name=re_validate_policy_id,json=reValidatePolicyId,proto3" json:"re_validate_policy_id,omitempty"`
 	InvalidatePolicyId uint64 `protobuf:"varint,2,opt,name=invalidate_policy_id,json=invalidatePolicyId,proto3" json:"invalidate_policy_id,omitempty"`
@@ -120,39 +151,42 @@ func (m *ProposalDetails) GetPolicyAddress() string {
 }
 
 type Inference struct {
-	Index                    string           `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	InferenceId              string           `protobuf:"bytes,2,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
-	PromptHash               string           `protobuf:"bytes,3,opt,name=prompt_hash,json=promptHash,proto3" json:"prompt_hash,omitempty"`
-	PromptPayload            string           `protobuf:"bytes,4,opt,name=prompt_payload,json=promptPayload,proto3" json:"prompt_payload,omitempty"` // Deprecated: Do not use.
-	ResponseHash             string           `protobuf:"bytes,5,opt,name=response_hash,json=responseHash,proto3" json:"response_hash,omitempty"`
-	ResponsePayload          string           `protobuf:"bytes,6,opt,name=response_payload,json=responsePayload,proto3" json:"response_payload,omitempty"` // Deprecated: Do not use.
-	PromptTokenCount         uint64           `protobuf:"varint,7,opt,name=prompt_token_count,json=promptTokenCount,proto3" json:"prompt_token_count,omitempty"`
-	CompletionTokenCount     uint64           `protobuf:"varint,8,opt,name=completion_token_count,json=completionTokenCount,proto3" json:"completion_token_count,omitempty"`
-	RequestedBy              string           `protobuf:"bytes,9,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
-	ExecutedBy               string           `protobuf:"bytes,10,opt,name=executed_by,json=executedBy,proto3" json:"executed_by,omitempty"`
-	Status                   InferenceStatus  `protobuf:"varint,11,opt,name=status,proto3,enum=inference.inference.InferenceStatus" json:"status,omitempty"`
-	StartBlockHeight         int64            `protobuf:"varint,12,opt,name=start_block_height,json=startBlockHeight,proto3" json:"start_block_height,omitempty"`
-	EndBlockHeight           int64            `protobuf:"varint,13,opt,name=end_block_height,json=endBlockHeight,proto3" json:"end_block_height,omitempty"`
-	StartBlockTimestamp      int64            `protobuf:"varint,14,opt,name=start_block_timestamp,json=startBlockTimestamp,proto3" json:"start_block_timestamp,omitempty"`
-	EndBlockTimestamp        int64            `protobuf:"varint,15,opt,name=end_block_timestamp,json=endBlockTimestamp,proto3" json:"end_block_timestamp,omitempty"`
-	Model                    string           `protobuf:"bytes,16,opt,name=model,proto3" json:"model,omitempty"`
-	MaxTokens                uint64           `protobuf:"varint,17,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
-	ActualCost               int64            `protobuf:"varint,18,opt,name=actual_cost,json=actualCost,proto3" json:"actual_cost,omitempty"`
-	EscrowAmount             int64            `protobuf:"varint,19,opt,name=escrow_amount,json=escrowAmount,proto3" json:"escrow_amount,omitempty"`
-	ProposalDetails          *ProposalDetails `protobuf:"bytes,20,opt,name=proposal_details,json=proposalDetails,proto3" json:"proposal_details,omitempty"`
-	EpochGroupId             uint64           `protobuf:"varint,21,opt,name=epoch_group_id,json=epochGroupId,proto3" json:"epoch_group_id,omitempty"`
-	AssignedTo               string           `protobuf:"bytes,22,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
-	ValidatedBy              []string         `protobuf:"bytes,23,rep,name=validated_by,json=validatedBy,proto3" json:"validated_by,omitempty"`
-	NodeVersion              string           `protobuf:"bytes,24,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	EpochId                  uint64           `protobuf:"varint,25,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
-	EpochPocStartBlockHeight uint64           `protobuf:"varint,26,opt,name=epoch_poc_start_block_height,json=epochPocStartBlockHeight,proto3" json:"epoch_poc_start_block_height,omitempty"`
-	TransferredBy            string           `protobuf:"bytes,27,opt,name=transferred_by,json=transferredBy,proto3" json:"transferred_by,omitempty"`
-	RequestTimestamp         int64            `protobuf:"varint,28,opt,name=request_timestamp,json=requestTimestamp,proto3" json:"request_timestamp,omitempty"`
-	TransferSignature        string           `protobuf:"bytes,29,opt,name=transfer_signature,json=transferSignature,proto3" json:"transfer_signature,omitempty"`
-	ExecutionSignature       string           `protobuf:"bytes,30,opt,name=execution_signature,json=executionSignature,proto3" json:"execution_signature,omitempty"`
-	OriginalPrompt           string           `protobuf:"bytes,31,opt,name=original_prompt,json=originalPrompt,proto3" json:"original_prompt,omitempty"` // Deprecated: Do not use.
-	PerTokenPrice            uint64           `protobuf:"varint,32,opt,name=per_token_price,json=perTokenPrice,proto3" json:"per_token_price,omitempty"`
-	OriginalPromptHash       string           `protobuf:"bytes,33,opt,name=original_prompt_hash,json=originalPromptHash,proto3" json:"original_prompt_hash,omitempty"`
+	Index                    string                    `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	InferenceId              string                    `protobuf:"bytes,2,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	PromptHash               string                    `protobuf:"bytes,3,opt,name=prompt_hash,json=promptHash,proto3" json:"prompt_hash,omitempty"`
+	PromptPayload            string                    `protobuf:"bytes,4,opt,name=prompt_payload,json=promptPayload,proto3" json:"prompt_payload,omitempty"` // Deprecated: Do not use.
+	ResponseHash             string                    `protobuf:"bytes,5,opt,name=response_hash,json=responseHash,proto3" json:"response_hash,omitempty"`
+	ResponsePayload          string                    `protobuf:"bytes,6,opt,name=response_payload,json=responsePayload,proto3" json:"response_payload,omitempty"` // Deprecated: Do not use.
+	PromptTokenCount         uint64                    `protobuf:"varint,7,opt,name=prompt_token_count,json=promptTokenCount,proto3" json:"prompt_token_count,omitempty"`
+	CompletionTokenCount     uint64                    `protobuf:"varint,8,opt,name=completion_token_count,json=completionTokenCount,proto3" json:"completion_token_count,omitempty"`
+	RequestedBy              string                    `protobuf:"bytes,9,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	ExecutedBy               string                    `protobuf:"bytes,10,opt,name=executed_by,json=executedBy,proto3" json:"executed_by,omitempty"`
+	Status                   InferenceStatus           `protobuf:"varint,11,opt,name=status,proto3,enum=inference.inference.InferenceStatus" json:"status,omitempty"`
+	StartBlockHeight         int64                     `protobuf:"varint,12,opt,name=start_block_height,json=startBlockHeight,proto3" json:"start_block_height,omitempty"`
+	EndBlockHeight           int64                     `protobuf:"varint,13,opt,name=end_block_height,json=endBlockHeight,proto3" json:"end_block_height,omitempty"`
+	StartBlockTimestamp      int64                     `protobuf:"varint,14,opt,name=start_block_timestamp,json=startBlockTimestamp,proto3" json:"start_block_timestamp,omitempty"`
+	EndBlockTimestamp        int64                     `protobuf:"varint,15,opt,name=end_block_timestamp,json=endBlockTimestamp,proto3" json:"end_block_timestamp,omitempty"`
+	Model                    string                    `protobuf:"bytes,16,opt,name=model,proto3" json:"model,omitempty"`
+	MaxTokens                uint64                    `protobuf:"varint,17,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	ActualCost               int64                     `protobuf:"varint,18,opt,name=actual_cost,json=actualCost,proto3" json:"actual_cost,omitempty"`
+	EscrowAmount             int64                     `protobuf:"varint,19,opt,name=escrow_amount,json=escrowAmount,proto3" json:"escrow_amount,omitempty"`
+	ProposalDetails          *ProposalDetails          `protobuf:"bytes,20,opt,name=proposal_details,json=proposalDetails,proto3" json:"proposal_details,omitempty"`
+	EpochGroupId             uint64                    `protobuf:"varint,21,opt,name=epoch_group_id,json=epochGroupId,proto3" json:"epoch_group_id,omitempty"`
+	AssignedTo               string                    `protobuf:"bytes,22,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
+	ValidatedBy              []string                  `protobuf:"bytes,23,rep,name=validated_by,json=validatedBy,proto3" json:"validated_by,omitempty"`
+	NodeVersion              string                    `protobuf:"bytes,24,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	EpochId                  uint64                    `protobuf:"varint,25,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	EpochPocStartBlockHeight uint64                    `protobuf:"varint,26,opt,name=epoch_poc_start_block_height,json=epochPocStartBlockHeight,proto3" json:"epoch_poc_start_block_height,omitempty"`
+	TransferredBy            string                    `protobuf:"bytes,27,opt,name=transferred_by,json=transferredBy,proto3" json:"transferred_by,omitempty"`
+	RequestTimestamp         int64                     `protobuf:"varint,28,opt,name=request_timestamp,json=requestTimestamp,proto3" json:"request_timestamp,omitempty"`
+	TransferSignature        string                    `protobuf:"bytes,29,opt,name=transfer_signature,json=transferSignature,proto3" json:"transfer_signature,omitempty"`
+	ExecutionSignature       string                    `protobuf:"bytes,30,opt,name=execution_signature,json=executionSignature,proto3" json:"execution_signature,omitempty"`
+	OriginalPrompt           string                    `protobuf:"bytes,31,opt,name=original_prompt,json=originalPrompt,proto3" json:"original_prompt,omitempty"` // Deprecated: Do not use.
+	PerTokenPrice            uint64                    `protobuf:"varint,32,opt,name=per_token_price,json=perTokenPrice,proto3" json:"per_token_price,omitempty"`
+	OriginalPromptHash       string                    `protobuf:"bytes,33,opt,name=original_prompt_hash,json=originalPromptHash,proto3" json:"original_prompt_hash,omitempty"`
+	InvalidatedBy            string                    `protobuf:"bytes,34,opt,name=invalidated_by,json=invalidatedBy,proto3" json:"invalidated_by,omitempty"`
+	InvalidatedAtBlockHeight int64                     `protobuf:"varint,35,opt,name=invalidated_at_block_height,json=invalidatedAtBlockHeight,proto3" json:"invalidated_at_block_height,omitempty"`
+	CompletionStatus         InferenceCompletionStatus `protobuf:"varint,36,opt,name=completion_status,json=completionStatus,proto3,enum=inference.inference.InferenceCompletionStatus" json:"completion_status,omitempty"`
 }
 
 func (m *Inference) Reset()         { *m = Inference{} }
@@ -422,8 +456,30 @@ func (m *Inference) GetOriginalPromptHash() string {
 	return ""
 }
 
+func (m *Inference) GetInvalidatedBy() string {
+	if m != nil {
+		return m.InvalidatedBy
+	}
+	return ""
+}
+
+func (m *Inference) GetInvalidatedAtBlockHeight() int64 {
+	if m != nil {
+		return m.InvalidatedAtBlockHeight
+	}
+	return 0
+}
+
+func (m *Inference) GetCompletionStatus() InferenceCompletionStatus {
+	if m != nil {
+		return m.CompletionStatus
+	}
+	return InferenceCompletionStatus_COMPLETION_STATUS_COMPLETE
+}
+
 func init() {
 	proto.RegisterEnum("inference.inference.InferenceStatus", InferenceStatus_name, InferenceStatus_value)
+	proto.RegisterEnum("inference.inference.InferenceCompletionStatus", InferenceCompletionStatus_name, InferenceCompletionStatus_value)
 	proto.RegisterType((*ProposalDetails)(nil), "inference.inference.ProposalDetails")
 	proto.RegisterType((*Inference)(nil), "inference.inference.Inference")
 }
@@ -433,65 +489,73 @@ func init() {
 }
 
 var fileDescriptor_ce060d6da7916311 = []byte{
-	// 917 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xcb, 0x6e, 0x1b, 0x37,
-	0x14, 0xf5, 0xf8, 0xad, 0xab, 0xa7, 0x29, 0x39, 0x65, 0xda, 0x44, 0x91, 0xf3, 0x28, 0xd4, 0xa6,
-	0x91, 0xdb, 0xb4, 0xdd, 0x15, 0x05, 0xa4, 0xc8, 0x8d, 0x07, 0x28, 0x6c, 0x61, 0x24, 0x18, 0x45,
-	0x37, 0x03, 0x7a, 0x86, 0x91, 0x06, 0x19, 0x0d, 0x59, 0x92, 0x4a, 0xa5, 0xbf, 0xe8, 0x0f, 0xf4,
-	0x7f, 0xba, 0xcc, 0xb2, 0xcb, 0xc2, 0xfe, 0x86, 0xee, 0x0b, 0x92, 0xf3, 0x90, 0x05, 0x77, 0x37,
-	0x73, 0xce, 0xb9, 0x87, 0x57, 0x77, 0xce, 0xa5, 0xe0, 0x59, 0x94, 0xbc, 0xa3, 0x82, 0x26, 0x01,
-	0x3d, 0xbd, 0xe7, 0xa9, 0xc7, 0x05, 0x53, 0x0c, 0x35, 0x0b, 0x20, 0x7f, 0x7a, 0xfa, 0xa7, 0x03,
-	0xf5, 0x91, 0x60, 0x9c, 0x49, 0x12, 0x0f, 0xa9, 0x22, 0x51, 0x2c, 0xd1, 0x37, 0x70, 0x2c, 0xa8,
-	0xff, 0x81, 0xc4, 0x51, 0x48, 0x14, 0xf5, 0x39, 0x8b, 0xa3, 0x60, 0xe5, 0x47, 0x21, 0x76, 0x3a,
-	0x4e, 0x77, 0xd7, 0x43, 0x82, 0x5e, 0xa5, 0xdc, 0xc8, 0x50, 0x6e, 0x88, 0xbe, 0x86, 0x56, 0x94,
-	0xdc, 0x53, 0xb1, 0x6d, 0x2b, 0x0a, 0x2e, 0xaf, 0x78, 0x01, 0xb5, 0x54, 0x46, 0xc2, 0x50, 0x50,
-	0x29, 0xf1, 0x4e, 0xc7, 0xe9, 0x96, 0xbc, 0xaa, 0x45, 0xfb, 0x16, 0x7c, 0xfa, 0x2f, 0x40, 0xc9,
-	0xcd, 0xba, 0x45, 0x2d, 0xd8, 0x8b, 0x92, 0x90, 0x2e, 0x4d, 0x27, 0x25, 0xcf, 0xbe, 0xa0, 0x13,
-	0xa8, 0xe4, 0x3f, 0x28, 0x3b, 0xb4, 0xe4, 0x95, 0x73, 0xcc, 0x0d, 0xd1, 0x13, 0x28, 0x73, 0xc1,
-	0xe6, 0x5c, 0xf9, 0x33, 0x22, 0x67, 0xe9, 0x51, 0x60, 0xa1, 0x73, 0x22, 0x67, 0xe8, 0x0b, 0xa8,
-	0xa5, 0x02, 0x4e, 0x56, 0x31, 0x23, 0x21, 0xde, 0xd5, 0x9a, 0xc1, 0x36, 0x76, 0xbc, 0xaa, 0x65,
-	0x46, 0x96, 0x40, 0xcf, 0xa0, 0x2a, 0xa8, 0xe4, 0x2c, 0x91, 0xd4, 0xba, 0xed, 0x19, 0xb7, 0x4a,
-	0x06, 0x1a, 0xbf, 0x57, 0xd0, 0xc8, 0x45, 0x99, 0xe3, 0x7e, 0xee, 0x58, 0xcf, 0xb8, 0xcc, 0xf3,
-	0x2b, 0x40, 0xe9, 0xf1, 0x8a, 0xbd, 0xa7, 0x89, 0x1f, 0xb0, 0x45, 0xa2, 0xf0, 0x81, 0x99, 0x5e,
-	0xc3, 0x32, 0x13, 0x4d, 0xbc, 0xd1, 0x38, 0xfa, 0x0e, 0x1e, 0x04, 0x6c, 0xce, 0x63, 0xaa, 0x22,
-	0x96, 0xdc, 0xa9, 0x38, 0x34, 0x15, 0xad, 0x82, 0x5d, 0xab, 0x3a, 0x81, 0x8a, 0xa0, 0xbf, 0x2d,
-	0xa8, 0x54, 0x34, 0xf4, 0xaf, 0x57, 0xb8, 0x64, 0xc7, 0x94, 0x63, 0x83, 0x95, 0x1e, 0x13, 0x5d,
-	0xd2, 0x60, 0x91, 0x2a, 0xc0, 0x8e, 0x29, 0x83, 0x06, 0x2b, 0xf4, 0x03, 0xec, 0x4b, 0x45, 0xd4,
-	0x42, 0xe2, 0x72, 0xc7, 0xe9, 0xd6, 0x5e, 0x3f, 0xef, 0xdd, 0x13, 0xaa, 0x5e, 0xfe, 0xc1, 0xc6,
-	0x46, 0xeb, 0xa5, 0x35, 0xfa, 0x57, 0x4a, 0x45, 0x84, 0xf2, 0xaf, 0x63, 0x16, 0xbc, 0xf7, 0x67,
-	0x34, 0x9a, 0xce, 0x14, 0xae, 0x74, 0x9c, 0xee, 0x8e, 0xd7, 0x30, 0xcc, 0x40, 0x13, 0xe7, 0x06,
-	0x47, 0x5d, 0x68, 0xd0, 0x24, 0xbc, 0xab, 0xad, 0x1a, 0x6d, 0x8d, 0x26, 0xe1, 0xba, 0xf2, 0x35,
-	0x1c, 0xaf, 0xfb, 0xaa, 0x68, 0x4e, 0xa5, 0x22, 0x73, 0x8e, 0x6b, 0x46, 0xde, 0x2c, 0xac, 0x27,
-	0x19, 0x85, 0x7a, 0xd0, 0x2c, 0xdc, 0x8b, 0x8a, 0xba, 0xa9, 0x38, 0xca, 0x0e, 0x28, 0xf4, 0x2d,
-	0xd8, 0x9b, 0xb3, 0x90, 0xc6, 0xb8, 0x61, 0xa3, 0x67, 0x5e, 0xd0, 0x63, 0x80, 0x39, 0x59, 0xda,
-	0x4f, 0x20, 0xf1, 0x91, 0x99, 0x7e, 0x69, 0x4e, 0x96, 0x66, 0xec, 0x52, 0xcf, 0x93, 0x04, 0x6a,
-	0x41, 0x62, 0x3f, 0x60, 0x52, 0x61, 0x64, 0xcc, 0xc1, 0x42, 0x6f, 0x98, 0x54, 0x3a, 0x4b, 0x54,
-	0x06, 0x82, 0xfd, 0xee, 0x93, 0xb9, 0xf9, 0x80, 0x4d, 0x23, 0xa9, 0x58, 0xb0, 0x6f, 0x30, 0x74,
-	0x09, 0x3a, 0x02, 0x66, 0x45, 0xfd, 0xd0, 0xee, 0x28, 0x6e, 0x75, 0x9c, 0x6e, 0xf9, 0x7f, 0xc6,
-	0xbf, 0xb1, 0xcf, 0x5e, 0x9d, 0x6f, 0x2c, 0xf8, 0x73, 0xa8, 0x51, 0xce, 0x82, 0x99, 0x3f, 0x15,
-	0x6c, 0xc1, 0xf5, 0xca, 0x1c, 0x9b, 0xce, 0x2b, 0x06, 0x7d, 0xab, 0x41, 0xbb, 0x33, 0x44, 0xca,
-	0x68, 0x9a, 0xd0, 0xd0, 0x57, 0x0c, 0x3f, 0xb0, 0x61, 0xc8, 0xa0, 0x09, 0xd3, 0x81, 0xca, 0xd6,
-	0xda, 0xc4, 0xe5, 0x93, 0xce, 0x8e, 0x0e, 0x54, 0x8e, 0x0d, 0x56, 0x5a, 0x92, 0xb0, 0x90, 0xfa,
-	0x1f, 0xa8, 0x90, 0x11, 0x4b, 0x30, 0xb6, 0x99, 0xd3, 0xd8, 0x95, 0x85, 0xd0, 0x43, 0x38, 0xb4,
-	0xcd, 0x44, 0x21, 0x7e, 0x68, 0xda, 0x38, 0x30, 0xef, 0x6e, 0x88, 0x7e, 0x84, 0x47, 0x96, 0xe2,
-	0x2c, 0xf0, 0xef, 0x49, 0xce, 0xa7, 0x46, 0x8e, 0x8d, 0x66, 0xc4, 0x82, 0xf1, 0x66, 0x82, 0x5e,
-	0x40, 0x4d, 0x09, 0x92, 0xc8, 0x77, 0x54, 0x08, 0xdb, 0xe2, 0x67, 0xf6, 0x8e, 0x59, 0x43, 0x07,
-	0x2b, 0xf4, 0x12, 0x8e, 0xd2, 0x25, 0x58, 0x0b, 0xc2, 0x23, 0x9b, 0xca, 0x94, 0x28, 0x72, 0xf0,
-	0x0a, 0x50, 0x56, 0xed, 0xeb, 0x49, 0x10, 0xb5, 0x10, 0x14, 0x3f, 0x36, 0xbe, 0x47, 0x19, 0x33,
-	0xce, 0x08, 0x74, 0x0a, 0x4d, 0xbb, 0x3e, 0x7a, 0x53, 0x0b, 0x7d, 0xdb, 0xe8, 0x51, 0x4e, 0x15,
-	0x05, 0x2f, 0xa1, 0xce, 0x44, 0x34, 0x8d, 0x12, 0x12, 0xfb, 0x76, 0xf1, 0xf1, 0x93, 0xfc, 0xde,
-	0xa8, 0x65, 0xd4, 0xc8, 0x30, 0xe8, 0x73, 0xa8, 0x73, 0x2a, 0xd2, 0x1b, 0x80, 0x8b, 0x28, 0xa0,
-	0xb8, 0x63, 0x66, 0x52, 0xe5, 0x54, 0x98, 0x0c, 0x8e, 0x34, 0xa8, 0xaf, 0xe7, 0x0d, 0x53, 0x7b,
-	0x73, 0x9d, 0xd8, 0x36, 0xee, 0xba, 0xea, 0xfb, 0xeb, 0x4b, 0x0a, 0xf5, 0x8d, 0x2d, 0x46, 0x65,
-	0x38, 0x18, 0x4f, 0xfa, 0xde, 0xe4, 0x6c, 0xd8, 0xd8, 0x42, 0x15, 0x38, 0xfc, 0xc9, 0xbd, 0x70,
-	0xc7, 0xe7, 0x67, 0xc3, 0x86, 0x83, 0xaa, 0x50, 0xba, 0xea, 0xff, 0xec, 0x0e, 0xfb, 0x9a, 0xdc,
-	0x46, 0x75, 0x28, 0xbb, 0x17, 0x05, 0xb0, 0x83, 0x00, 0xf6, 0xaf, 0x2e, 0x27, 0xee, 0xc5, 0xdb,
-	0xc6, 0xae, 0xb6, 0x39, 0xfb, 0x65, 0xe4, 0x7a, 0x67, 0xc3, 0xc6, 0xde, 0xe0, 0xf2, 0xaf, 0x9b,
-	0xb6, 0xf3, 0xf1, 0xa6, 0xed, 0xfc, 0x73, 0xd3, 0x76, 0xfe, 0xb8, 0x6d, 0x6f, 0x7d, 0xbc, 0x6d,
-	0x6f, 0xfd, 0x7d, 0xdb, 0xde, 0xfa, 0xf5, 0xfb, 0x69, 0xa4, 0x66, 0x8b, 0xeb, 0x5e, 0xc0, 0xe6,
-	0xa7, 0x5c, 0xb0, 0x70, 0x11, 0x28, 0x19, 0x44, 0x1b, 0x7f, 0x71, 0xcb, 0xb5, 0x67, 0xb5, 0xe2,
-	0x54, 0x5e, 0xef, 0x9b, 0xff, 0xba, 0x6f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x13, 0x56,
-	0xaa, 0x12, 0x07, 0x00, 0x00,
+	// 1046 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0xcb, 0x72, 0xdb, 0x36,
+	0x14, 0x35, 0xed, 0xf8, 0xa1, 0xab, 0xa7, 0x61, 0x3b, 0x85, 0xf3, 0x50, 0xe4, 0x57, 0x46, 0x4d,
+	0x1a, 0xb9, 0x4d, 0xdb, 0x5d, 0xdb, 0x19, 0x49, 0x56, 0x62, 0xce, 0xb8, 0x92, 0x46, 0x62, 0x3c,
+	0x99, 0x76, 0xc1, 0x81, 0x49, 0xc4, 0xe2, 0x44, 0x22, 0x58, 0x00, 0x4a, 0xad, 0x5f, 0xe8, 0xaa,
+	0x3f, 0xd0, 0xff, 0xe8, 0x27, 0x74, 0x99, 0x65, 0x97, 0x1d, 0xfb, 0x47, 0x3a, 0x00, 0xf8, 0x90,
+	0x55, 0xa7, 0x3b, 0xf2, 0x9c, 0x73, 0x2f, 0x2e, 0x2f, 0xce, 0xbd, 0x43, 0x38, 0x08, 0xc2, 0x77,
+	0x94, 0xd3, 0xd0, 0xa3, 0xc7, 0x77, 0x3c, 0x35, 0x22, 0xce, 0x24, 0x43, 0x5b, 0x19, 0x90, 0x3e,
+	0xed, 0xff, 0x61, 0x41, 0xb9, 0xcf, 0x59, 0xc4, 0x04, 0x19, 0x9f, 0x50, 0x49, 0x82, 0xb1, 0x40,
+	0x5f, 0xc1, 0x0e, 0xa7, 0xee, 0x07, 0x32, 0x0e, 0x7c, 0x22, 0xa9, 0x1b, 0xb1, 0x71, 0xe0, 0xcd,
+	0xdc, 0xc0, 0xc7, 0x56, 0xcd, 0xaa, 0xdf, 0x1b, 0x20, 0x4e, 0xcf, 0x63, 0xae, 0xaf, 0x29, 0xdb,
+	0x47, 0x5f, 0xc2, 0x76, 0x10, 0xde, 0x11, 0xb1, 0x6c, 0x22, 0x32, 0x2e, 0x8d, 0x38, 0x82, 0x52,
+	0x2c, 0x23, 0xbe, 0xcf, 0xa9, 0x10, 0x78, 0xa5, 0x66, 0xd5, 0x73, 0x83, 0xa2, 0x41, 0x9b, 0x06,
+	0xdc, 0xff, 0xb3, 0x00, 0x39, 0x3b, 0xa9, 0x16, 0x6d, 0xc3, 0x6a, 0x10, 0xfa, 0xf4, 0x4a, 0x57,
+	0x92, 0x1b, 0x98, 0x17, 0xb4, 0x07, 0x85, 0xf4, 0x83, 0x92, 0x43, 0x73, 0x83, 0x7c, 0x8a, 0xd9,
+	0x3e, 0x7a, 0x02, 0xf9, 0x88, 0xb3, 0x49, 0x24, 0xdd, 0x11, 0x11, 0xa3, 0xf8, 0x28, 0x30, 0xd0,
+	0x29, 0x11, 0x23, 0xf4, 0x39, 0x94, 0x62, 0x41, 0x44, 0x66, 0x63, 0x46, 0x7c, 0x7c, 0x4f, 0x69,
+	0x5a, 0xcb, 0xd8, 0x1a, 0x14, 0x0d, 0xd3, 0x37, 0x04, 0x3a, 0x80, 0x22, 0xa7, 0x22, 0x62, 0xa1,
+	0xa0, 0x26, 0xdb, 0xaa, 0xce, 0x56, 0x48, 0x40, 0x9d, 0xef, 0x05, 0x54, 0x52, 0x51, 0x92, 0x71,
+	0x2d, 0xcd, 0x58, 0x4e, 0xb8, 0x24, 0xe7, 0x17, 0x80, 0xe2, 0xe3, 0x25, 0x7b, 0x4f, 0x43, 0xd7,
+	0x63, 0xd3, 0x50, 0xe2, 0x75, 0xdd, 0xbd, 0x8a, 0x61, 0x1c, 0x45, 0xb4, 0x15, 0x8e, 0xbe, 0x81,
+	0xfb, 0x1e, 0x9b, 0x44, 0x63, 0x2a, 0x03, 0x16, 0xde, 0x8a, 0xd8, 0xd0, 0x11, 0xdb, 0x19, 0x3b,
+	0x17, 0xb5, 0x07, 0x05, 0x4e, 0x7f, 0x99, 0x52, 0x21, 0xa9, 0xef, 0x5e, 0xcc, 0x70, 0xce, 0xb4,
+	0x29, 0xc5, 0x5a, 0x33, 0xd5, 0x26, 0x7a, 0x45, 0xbd, 0x69, 0xac, 0x00, 0xd3, 0xa6, 0x04, 0x6a,
+	0xcd, 0xd0, 0x77, 0xb0, 0x26, 0x24, 0x91, 0x53, 0x81, 0xf3, 0x35, 0xab, 0x5e, 0x7a, 0x79, 0xd8,
+	0xb8, 0xc3, 0x54, 0x8d, 0xf4, 0xc2, 0x86, 0x5a, 0x3b, 0x88, 0x63, 0xd4, 0x57, 0x0a, 0x49, 0xb8,
+	0x74, 0x2f, 0xc6, 0xcc, 0x7b, 0xef, 0x8e, 0x68, 0x70, 0x39, 0x92, 0xb8, 0x50, 0xb3, 0xea, 0x2b,
+	0x83, 0x8a, 0x66, 0x5a, 0x8a, 0x38, 0xd5, 0x38, 0xaa, 0x43, 0x85, 0x86, 0xfe, 0x6d, 0x6d, 0x51,
+	0x6b, 0x4b, 0x34, 0xf4, 0xe7, 0x95, 0x2f, 0x61, 0x67, 0x3e, 0xaf, 0x0c, 0x26, 0x54, 0x48, 0x32,
+	0x89, 0x70, 0x49, 0xcb, 0xb7, 0xb2, 0xd4, 0x4e, 0x42, 0xa1, 0x06, 0x6c, 0x65, 0xd9, 0xb3, 0x88,
+	0xb2, 0x8e, 0xd8, 0x4c, 0x0e, 0xc8, 0xf4, 0xdb, 0xb0, 0x3a, 0x61, 0x3e, 0x1d, 0xe3, 0x8a, 0xb1,
+	0x9e, 0x7e, 0x41, 0x8f, 0x01, 0x26, 0xe4, 0xca, 0x5c, 0x81, 0xc0, 0x9b, 0xba, 0xfb, 0xb9, 0x09,
+	0xb9, 0xd2, 0x6d, 0x17, 0xaa, 0x9f, 0xc4, 0x93, 0x53, 0x32, 0x76, 0x3d, 0x26, 0x24, 0x46, 0x3a,
+	0x39, 0x18, 0xa8, 0xcd, 0x84, 0x54, 0x5e, 0xa2, 0xc2, 0xe3, 0xec, 0x57, 0x97, 0x4c, 0xf4, 0x05,
+	0x6e, 0x69, 0x49, 0xc1, 0x80, 0x4d, 0x8d, 0xa1, 0x1e, 0x28, 0x0b, 0xe8, 0x11, 0x75, 0x7d, 0x33,
+	0xa3, 0x78, 0xbb, 0x66, 0xd5, 0xf3, 0x9f, 0x68, 0xff, 0xc2, 0x3c, 0x0f, 0xca, 0xd1, 0xc2, 0x80,
+	0x1f, 0x42, 0x89, 0x46, 0xcc, 0x1b, 0xb9, 0x97, 0x9c, 0x4d, 0x23, 0x35, 0x32, 0x3b, 0xba, 0xf2,
+	0x82, 0x46, 0x5f, 0x2b, 0xd0, 0xcc, 0x0c, 0x11, 0x22, 0xb8, 0x0c, 0xa9, 0xef, 0x4a, 0x86, 0xef,
+	0x1b, 0x33, 0x24, 0x90, 0xc3, 0x94, 0xa1, 0x92, 0xb1, 0xd6, 0x76, 0xf9, 0xac, 0xb6, 0xa2, 0x0c,
+	0x95, 0x62, 0xad, 0x99, 0x92, 0x84, 0xcc, 0xa7, 0xee, 0x07, 0xca, 0x45, 0xc0, 0x42, 0x8c, 0x8d,
+	0xe7, 0x14, 0x76, 0x6e, 0x20, 0xb4, 0x0b, 0x1b, 0xa6, 0x98, 0xc0, 0xc7, 0xbb, 0xba, 0x8c, 0x75,
+	0xfd, 0x6e, 0xfb, 0xe8, 0x07, 0x78, 0x64, 0xa8, 0x88, 0x79, 0xee, 0x1d, 0xce, 0x79, 0xa0, 0xe5,
+	0x58, 0x6b, 0xfa, 0xcc, 0x1b, 0x2e, 0x3a, 0xe8, 0x08, 0x4a, 0x92, 0x93, 0x50, 0xbc, 0xa3, 0x9c,
+	0x9b, 0x12, 0x1f, 0x9a, 0x1d, 0x33, 0x87, 0xb6, 0x66, 0xe8, 0x39, 0x6c, 0xc6, 0x43, 0x30, 0x67,
+	0x84, 0x47, 0xc6, 0x95, 0x31, 0x91, 0xf9, 0xe0, 0x05, 0xa0, 0x24, 0xda, 0x55, 0x9d, 0x20, 0x72,
+	0xca, 0x29, 0x7e, 0xac, 0xf3, 0x6e, 0x26, 0xcc, 0x30, 0x21, 0xd0, 0x31, 0x6c, 0x99, 0xf1, 0x51,
+	0x93, 0x9a, 0xe9, 0xab, 0x5a, 0x8f, 0x52, 0x2a, 0x0b, 0x78, 0x0e, 0x65, 0xc6, 0x83, 0xcb, 0x20,
+	0x24, 0x63, 0xd7, 0x0c, 0x3e, 0x7e, 0x92, 0xee, 0x8d, 0x52, 0x42, 0xf5, 0x35, 0x83, 0x9e, 0x42,
+	0x39, 0xa2, 0x3c, 0xde, 0x00, 0x11, 0x0f, 0x3c, 0x8a, 0x6b, 0xba, 0x27, 0xc5, 0x88, 0x72, 0xed,
+	0xc1, 0xbe, 0x02, 0xd5, 0x7a, 0x5e, 0x48, 0x6a, 0x36, 0xd7, 0x9e, 0x29, 0xe3, 0x76, 0x56, 0xbd,
+	0xbf, 0x8e, 0xa0, 0x94, 0x2d, 0x6d, 0xdd, 0xba, 0x7d, 0xd3, 0xba, 0x39, 0xb4, 0x35, 0x43, 0xdf,
+	0xc3, 0xc3, 0x79, 0x19, 0x59, 0xb8, 0xa0, 0x03, 0xdd, 0x44, 0x3c, 0x27, 0x69, 0xde, 0xba, 0xa0,
+	0x9f, 0x61, 0x73, 0x6e, 0x91, 0xc5, 0x9b, 0xe5, 0x50, 0x6f, 0x96, 0xc6, 0xff, 0x6f, 0x96, 0x76,
+	0x1a, 0x16, 0xef, 0x98, 0x8a, 0xb7, 0x80, 0x3c, 0xa3, 0x50, 0x5e, 0x58, 0x44, 0x28, 0x0f, 0xeb,
+	0x43, 0xa7, 0x39, 0x70, 0x3a, 0x27, 0x95, 0x25, 0x54, 0x80, 0x8d, 0x57, 0x76, 0xd7, 0x1e, 0x9e,
+	0x76, 0x4e, 0x2a, 0x16, 0x2a, 0x42, 0xee, 0xbc, 0x79, 0x66, 0x9f, 0x34, 0x15, 0xb9, 0x8c, 0xca,
+	0x90, 0xb7, 0xbb, 0x19, 0xb0, 0x82, 0x00, 0xd6, 0xce, 0x7b, 0x8e, 0xdd, 0x7d, 0x5d, 0xb9, 0xa7,
+	0xd2, 0x74, 0xde, 0xf6, 0xed, 0x41, 0xe7, 0xa4, 0xb2, 0xfa, 0xec, 0x37, 0x0b, 0x76, 0x3f, 0x59,
+	0x16, 0xaa, 0xc2, 0x83, 0x76, 0xef, 0xc7, 0xfe, 0x59, 0xc7, 0xb1, 0x7b, 0x5d, 0x77, 0xe8, 0x34,
+	0x9d, 0x37, 0x43, 0x37, 0x46, 0x3a, 0x95, 0x25, 0xf4, 0x14, 0xf6, 0xef, 0xe0, 0xcf, 0xec, 0x4e,
+	0xd7, 0x71, 0xdb, 0xcd, 0x6e, 0xbb, 0x73, 0x76, 0xa6, 0xcb, 0x3b, 0x82, 0xbd, 0xff, 0xea, 0x3a,
+	0x6f, 0x3b, 0xed, 0x37, 0x4e, 0x6f, 0xe0, 0xbe, 0x6a, 0xda, 0x4a, 0xb6, 0xdc, 0xea, 0xfd, 0x75,
+	0x5d, 0xb5, 0x3e, 0x5e, 0x57, 0xad, 0x7f, 0xae, 0xab, 0xd6, 0xef, 0x37, 0xd5, 0xa5, 0x8f, 0x37,
+	0xd5, 0xa5, 0xbf, 0x6f, 0xaa, 0x4b, 0x3f, 0x7d, 0x7b, 0x19, 0xc8, 0xd1, 0xf4, 0xa2, 0xe1, 0xb1,
+	0xc9, 0x71, 0xc4, 0x99, 0x3f, 0xf5, 0xa4, 0xf0, 0x82, 0x85, 0x5f, 0x86, 0xab, 0xb9, 0x67, 0x39,
+	0x8b, 0xa8, 0xb8, 0x58, 0xd3, 0xff, 0x0e, 0x5f, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xf3, 0x29,
+	0xeb, 0xa9, 0x62, 0x08, 0x00, 0x00,
 }
 
 func (m *ProposalDetails) Marshal() (dAtA []byte, err error) {
@@ -554,6 +618,29 @@ func (m *Inference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CompletionStatus != 0 {
+		i = encodeVarintInference(dAtA, i, uint64(m.CompletionStatus))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.InvalidatedAtBlockHeight != 0 {
+		i = encodeVarintInference(dAtA, i, uint64(m.InvalidatedAtBlockHeight))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.InvalidatedBy) > 0 {
+		i -= len(m.InvalidatedBy)
+		copy(dAtA[i:], m.InvalidatedBy)
+		i = encodeVarintInference(dAtA, i, uint64(len(m.InvalidatedBy)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
 	if len(m.OriginalPromptHash) > 0 {
 		i -= len(m.OriginalPromptHash)
 		copy(dAtA[i:], m.OriginalPromptHash)
@@ -956,6 +1043,16 @@ func (m *Inference) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovInference(uint64(l))
 	}
+	l = len(m.InvalidatedBy)
+	if l > 0 {
+		n += 2 + l + sovInference(uint64(l))
+	}
+	if m.InvalidatedAtBlockHeight != 0 {
+		n += 2 + sovInference(uint64(m.InvalidatedAtBlockHeight))
+	}
+	if m.CompletionStatus != 0 {
+		n += 2 + sovInference(uint64(m.CompletionStatus))
+	}
 	return n
 }
 
@@ -1979,6 +2076,76 @@ func (m *Inference) Unmarshal(dAtA []byte) error {
 			}
 			m.OriginalPromptHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidatedAtBlockHeight", wireType)
+			}
+			m.InvalidatedAtBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidatedAtBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionStatus", wireType)
+			}
+			m.CompletionStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionStatus |= InferenceCompletionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInference(dAtA[iNdEx:])
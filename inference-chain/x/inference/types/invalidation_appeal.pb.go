@@ -0,0 +1,1403 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/inference/invalidation_appeal.proto
+
+package types
+
+import (
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AppealStatus int32
+
+const (
+	AppealStatus_APPEAL_STATUS_PENDING  AppealStatus = 0
+	AppealStatus_APPEAL_STATUS_UPHELD   AppealStatus = 1
+	AppealStatus_APPEAL_STATUS_REVERSED AppealStatus = 2
+	AppealStatus_APPEAL_STATUS_EXPIRED  AppealStatus = 3
+)
+
+var AppealStatus_name = map[int32]string{
+	0: "APPEAL_STATUS_PENDING",
+	1: "APPEAL_STATUS_UPHELD",
+	2: "APPEAL_STATUS_REVERSED",
+	3: "APPEAL_STATUS_EXPIRED",
+}
+
+var AppealStatus_value = map[string]int32{
+	"APPEAL_STATUS_PENDING":  0,
+	"APPEAL_STATUS_UPHELD":   1,
+	"APPEAL_STATUS_REVERSED": 2,
+	"APPEAL_STATUS_EXPIRED":  3,
+}
+
+func (x AppealStatus) String() string {
+	return proto.EnumName(AppealStatus_name, int32(x))
+}
+
+func (AppealStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1d7f9a861d65d8f6, []int{0}
+}
+
+type AppealVote struct {
+	Validator string  `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Value     float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Passed    bool    `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
+}
+
+func (m *AppealVote) Reset()         { *m = AppealVote{} }
+func (m *AppealVote) String() string { return proto.CompactTextString(m) }
+func (*AppealVote) ProtoMessage()    {}
+func (*AppealVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d7f9a861d65d8f6, []int{0}
+}
+func (m *AppealVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppealVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppealVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppealVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppealVote.Merge(m, src)
+}
+func (m *AppealVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppealVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppealVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppealVote proto.InternalMessageInfo
+
+func (m *AppealVote) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *AppealVote) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *AppealVote) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+// InvalidationAppeal is an executor's dispute of an invalidated inference.
+// Assigned validators are sampled by validation weight and re-execute the inference.
+type InvalidationAppeal struct {
+	InferenceId           string        `protobuf:"bytes,1,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	Executor              string        `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+	Invalidator           string        `protobuf:"bytes,3,opt,name=invalidator,proto3" json:"invalidator,omitempty"`
+	Bond                  int64         `protobuf:"varint,4,opt,name=bond,proto3" json:"bond,omitempty"`
+	OpenedAtBlockHeight   int64         `protobuf:"varint,5,opt,name=opened_at_block_height,json=openedAtBlockHeight,proto3" json:"opened_at_block_height,omitempty"`
+	DeadlineBlockHeight   int64         `protobuf:"varint,6,opt,name=deadline_block_height,json=deadlineBlockHeight,proto3" json:"deadline_block_height,omitempty"`
+	AssignedValidators    []string      `protobuf:"bytes,7,rep,name=assigned_validators,json=assignedValidators,proto3" json:"assigned_validators,omitempty"`
+	Votes                 []*AppealVote `protobuf:"bytes,8,rep,name=votes,proto3" json:"votes,omitempty"`
+	Status                AppealStatus  `protobuf:"varint,9,opt,name=status,proto3,enum=inference.inference.AppealStatus" json:"status,omitempty"`
+	ResolvedAtBlockHeight int64         `protobuf:"varint,10,opt,name=resolved_at_block_height,json=resolvedAtBlockHeight,proto3" json:"resolved_at_block_height,omitempty"`
+	EpochIndex            uint64        `protobuf:"varint,11,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+}
+
+func (m *InvalidationAppeal) Reset()         { *m = InvalidationAppeal{} }
+func (m *InvalidationAppeal) String() string { return proto.CompactTextString(m) }
+func (*InvalidationAppeal) ProtoMessage()    {}
+func (*InvalidationAppeal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d7f9a861d65d8f6, []int{1}
+}
+func (m *InvalidationAppeal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvalidationAppeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvalidationAppeal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvalidationAppeal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidationAppeal.Merge(m, src)
+}
+func (m *InvalidationAppeal) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvalidationAppeal) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidationAppeal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvalidationAppeal proto.InternalMessageInfo
+
+func (m *InvalidationAppeal) GetInferenceId() string {
+	if m != nil {
+		return m.InferenceId
+	}
+	return ""
+}
+
+func (m *InvalidationAppeal) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
+func (m *InvalidationAppeal) GetInvalidator() string {
+	if m != nil {
+		return m.Invalidator
+	}
+	return ""
+}
+
+func (m *InvalidationAppeal) GetBond() int64 {
+	if m != nil {
+		return m.Bond
+	}
+	return 0
+}
+
+func (m *InvalidationAppeal) GetOpenedAtBlockHeight() int64 {
+	if m != nil {
+		return m.OpenedAtBlockHeight
+	}
+	return 0
+}
+
+func (m *InvalidationAppeal) GetDeadlineBlockHeight() int64 {
+	if m != nil {
+		return m.DeadlineBlockHeight
+	}
+	return 0
+}
+
+func (m *InvalidationAppeal) GetAssignedValidators() []string {
+	if m != nil {
+		return m.AssignedValidators
+	}
+	return nil
+}
+
+func (m *InvalidationAppeal) GetVotes() []*AppealVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *InvalidationAppeal) GetStatus() AppealStatus {
+	if m != nil {
+		return m.Status
+	}
+	return AppealStatus_APPEAL_STATUS_PENDING
+}
+
+func (m *InvalidationAppeal) GetResolvedAtBlockHeight() int64 {
+	if m != nil {
+		return m.ResolvedAtBlockHeight
+	}
+	return 0
+}
+
+func (m *InvalidationAppeal) GetEpochIndex() uint64 {
+	if m != nil {
+		return m.EpochIndex
+	}
+	return 0
+}
+
+// InvalidationEffects records the side effects of an invalidation, so a reversed appeal can undo them
+type InvalidationEffects struct {
+	InferenceId string `protobuf:"bytes,1,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	// the executor's ActualCost was deducted and refunded to the requester
+	RefundedAmount int64 `protobuf:"varint,2,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	// the invalidation moved the executor to INVALID
+	ExecutorInvalidated   bool   `protobuf:"varint,3,opt,name=executor_invalidated,json=executorInvalidated,proto3" json:"executor_invalidated,omitempty"`
+	EpochsCompletedBefore uint32 `protobuf:"varint,4,opt,name=epochs_completed_before,json=epochsCompletedBefore,proto3" json:"epochs_completed_before,omitempty"`
+	EpochIndex            uint64 `protobuf:"varint,5,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	// paid to the requester from the collateral compensation pool on top of the refund
+	CompensatedAmount int64 `protobuf:"varint,6,opt,name=compensated_amount,json=compensatedAmount,proto3" json:"compensated_amount,omitempty"`
+}
+
+func (m *InvalidationEffects) Reset()         { *m = InvalidationEffects{} }
+func (m *InvalidationEffects) String() string { return proto.CompactTextString(m) }
+func (*InvalidationEffects) ProtoMessage()    {}
+func (*InvalidationEffects) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d7f9a861d65d8f6, []int{2}
+}
+func (m *InvalidationEffects) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvalidationEffects) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvalidationEffects.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvalidationEffects) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidationEffects.Merge(m, src)
+}
+func (m *InvalidationEffects) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvalidationEffects) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidationEffects.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvalidationEffects proto.InternalMessageInfo
+
+func (m *InvalidationEffects) GetInferenceId() string {
+	if m != nil {
+		return m.InferenceId
+	}
+	return ""
+}
+
+func (m *InvalidationEffects) GetRefundedAmount() int64 {
+	if m != nil {
+		return m.RefundedAmount
+	}
+	return 0
+}
+
+func (m *InvalidationEffects) GetExecutorInvalidated() bool {
+	if m != nil {
+		return m.ExecutorInvalidated
+	}
+	return false
+}
+
+func (m *InvalidationEffects) GetEpochsCompletedBefore() uint32 {
+	if m != nil {
+		return m.EpochsCompletedBefore
+	}
+	return 0
+}
+
+func (m *InvalidationEffects) GetEpochIndex() uint64 {
+	if m != nil {
+		return m.EpochIndex
+	}
+	return 0
+}
+
+func (m *InvalidationEffects) GetCompensatedAmount() int64 {
+	if m != nil {
+		return m.CompensatedAmount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("inference.inference.AppealStatus", AppealStatus_name, AppealStatus_value)
+	proto.RegisterType((*AppealVote)(nil), "inference.inference.AppealVote")
+	proto.RegisterType((*InvalidationAppeal)(nil), "inference.inference.InvalidationAppeal")
+	proto.RegisterType((*InvalidationEffects)(nil), "inference.inference.InvalidationEffects")
+}
+
+func init() {
+	proto.RegisterFile("inference/inference/invalidation_appeal.proto", fileDescriptor_1d7f9a861d65d8f6)
+}
+
+var fileDescriptor_1d7f9a861d65d8f6 = []byte{
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xc1, 0x4e, 0xdb, 0x40,
+	0x10, 0x86, 0xe3, 0x98, 0xa4, 0x64, 0x42, 0x69, 0xba, 0x21, 0xd4, 0x45, 0x55, 0x30, 0x5c, 0x6a,
+	0x55, 0x22, 0xa8, 0x20, 0x5a, 0xf5, 0x18, 0x1a, 0xab, 0x44, 0x42, 0x34, 0xda, 0x00, 0x42, 0xbd,
+	0x58, 0x8e, 0x3d, 0x21, 0x56, 0x8d, 0xd7, 0xf2, 0xae, 0x23, 0xda, 0xa7, 0xe8, 0x03, 0xf4, 0x81,
+	0x7a, 0xe4, 0xd8, 0x63, 0x05, 0x0f, 0xd0, 0x57, 0xa8, 0xbc, 0x8e, 0x1d, 0x87, 0xa2, 0xaa, 0xb7,
+	0xd9, 0xf9, 0x66, 0x76, 0xfe, 0xd9, 0x19, 0x2d, 0xec, 0x78, 0xc1, 0x18, 0x23, 0x0c, 0x1c, 0xdc,
+	0x2d, 0x5a, 0x53, 0xdb, 0xf7, 0x5c, 0x5b, 0x78, 0x2c, 0xb0, 0xec, 0x30, 0x44, 0xdb, 0xef, 0x84,
+	0x11, 0x13, 0x8c, 0x34, 0xf3, 0xa0, 0x4e, 0x6e, 0x6d, 0x5f, 0x00, 0x74, 0x65, 0xd0, 0x39, 0x13,
+	0x48, 0x5e, 0x40, 0x6d, 0x96, 0xcd, 0x22, 0x4d, 0xd1, 0x15, 0xa3, 0x46, 0xe7, 0x0e, 0xb2, 0x06,
+	0x95, 0xa9, 0xed, 0xc7, 0xa8, 0x95, 0x75, 0xc5, 0x50, 0x68, 0x7a, 0x20, 0xeb, 0x50, 0x0d, 0x6d,
+	0xce, 0xd1, 0xd5, 0x54, 0x5d, 0x31, 0x96, 0xe9, 0xec, 0xb4, 0xfd, 0x5b, 0x05, 0xd2, 0x2f, 0x88,
+	0x49, 0xcb, 0x90, 0x2d, 0x58, 0xc9, 0xab, 0x5b, 0x9e, 0x3b, 0xab, 0x52, 0xcf, 0x7d, 0x7d, 0x97,
+	0x6c, 0xc0, 0x32, 0x5e, 0xa3, 0x13, 0x27, 0x22, 0xca, 0x12, 0xe7, 0x67, 0xa2, 0x43, 0x3d, 0xef,
+	0x90, 0x45, 0xb2, 0xa4, 0xcc, 0x9e, 0xab, 0x24, 0xb0, 0x34, 0x62, 0x81, 0xab, 0x2d, 0xe9, 0x8a,
+	0xa1, 0x52, 0x69, 0x93, 0x7d, 0x58, 0x67, 0x21, 0x06, 0xe8, 0x5a, 0xb6, 0xb0, 0x46, 0x3e, 0x73,
+	0x3e, 0x5b, 0x13, 0xf4, 0x2e, 0x27, 0x42, 0xab, 0xc8, 0xa8, 0x66, 0x4a, 0xbb, 0xe2, 0x30, 0x61,
+	0x47, 0x12, 0x91, 0x3d, 0x68, 0xb9, 0x68, 0xbb, 0xbe, 0x17, 0xe0, 0x62, 0x4e, 0x35, 0xcd, 0xc9,
+	0x60, 0x31, 0x67, 0x17, 0x9a, 0x36, 0xe7, 0xde, 0x65, 0x52, 0x2a, 0x97, 0xc4, 0xb5, 0x47, 0xba,
+	0x6a, 0xd4, 0x28, 0xc9, 0xd0, 0x79, 0x4e, 0xc8, 0x01, 0x54, 0xa6, 0x4c, 0x20, 0xd7, 0x96, 0x75,
+	0xd5, 0xa8, 0xef, 0x6d, 0x76, 0x1e, 0x18, 0x52, 0x67, 0x3e, 0x21, 0x9a, 0x46, 0x93, 0x77, 0x50,
+	0xe5, 0xc2, 0x16, 0x31, 0xd7, 0x6a, 0xba, 0x62, 0xac, 0xee, 0x6d, 0xfd, 0x23, 0x6f, 0x28, 0x03,
+	0xe9, 0x2c, 0x81, 0xbc, 0x05, 0x2d, 0x42, 0xce, 0xfc, 0xe9, 0x03, 0xaf, 0x01, 0xb2, 0xb3, 0x56,
+	0xc6, 0x17, 0xdf, 0x63, 0x13, 0xea, 0x18, 0x32, 0x67, 0x62, 0x79, 0x81, 0x8b, 0xd7, 0x5a, 0x5d,
+	0x57, 0x8c, 0x25, 0x0a, 0xd2, 0xd5, 0x4f, 0x3c, 0xdb, 0xdf, 0xcb, 0xd0, 0x2c, 0x4e, 0xdc, 0x1c,
+	0x8f, 0xd1, 0x11, 0xfc, 0x7f, 0x46, 0xfe, 0x12, 0x9e, 0x44, 0x38, 0x8e, 0x03, 0x37, 0x11, 0x75,
+	0xc5, 0xe2, 0x40, 0xc8, 0xc9, 0xab, 0x74, 0x35, 0x73, 0x77, 0xa5, 0x97, 0xbc, 0x86, 0xb5, 0x6c,
+	0x17, 0xac, 0x7c, 0xea, 0xf9, 0xee, 0x35, 0x33, 0xd6, 0x9f, 0x23, 0xf2, 0x06, 0x9e, 0x49, 0x91,
+	0xdc, 0x72, 0xd8, 0x55, 0xe8, 0xa3, 0x40, 0xd7, 0x1a, 0xe1, 0x98, 0x45, 0x28, 0x77, 0xe4, 0x31,
+	0x6d, 0xa5, 0xf8, 0x7d, 0x46, 0x0f, 0x25, 0xbc, 0xdf, 0x6f, 0xe5, 0x7e, 0xbf, 0x64, 0x07, 0x48,
+	0x72, 0x23, 0x06, 0x3c, 0xa9, 0x93, 0xe9, 0x4e, 0xb7, 0xe3, 0x69, 0x81, 0xa4, 0xd2, 0x5f, 0x7d,
+	0x85, 0x95, 0xe2, 0x40, 0xc8, 0x73, 0x68, 0x75, 0x07, 0x03, 0xb3, 0x7b, 0x6c, 0x0d, 0x4f, 0xbb,
+	0xa7, 0x67, 0x43, 0x6b, 0x60, 0x9e, 0xf4, 0xfa, 0x27, 0x1f, 0x1a, 0x25, 0xa2, 0xc1, 0xda, 0x22,
+	0x3a, 0x1b, 0x1c, 0x99, 0xc7, 0xbd, 0x86, 0x42, 0x36, 0x60, 0x7d, 0x91, 0x50, 0xf3, 0xdc, 0xa4,
+	0x43, 0xb3, 0xd7, 0x28, 0xff, 0x7d, 0xa1, 0x79, 0x31, 0xe8, 0x53, 0xb3, 0xd7, 0x50, 0x0f, 0x3f,
+	0xfe, 0xb8, 0x6d, 0x2b, 0x37, 0xb7, 0x6d, 0xe5, 0xd7, 0x6d, 0x5b, 0xf9, 0x76, 0xd7, 0x2e, 0xdd,
+	0xdc, 0xb5, 0x4b, 0x3f, 0xef, 0xda, 0xa5, 0x4f, 0x07, 0x97, 0x9e, 0x98, 0xc4, 0xa3, 0x8e, 0xc3,
+	0xae, 0x76, 0xc3, 0x88, 0xb9, 0xb1, 0x23, 0xb8, 0xe3, 0xdd, 0xfb, 0x54, 0xae, 0x0b, 0xb6, 0xf8,
+	0x12, 0x22, 0x1f, 0x55, 0xe5, 0x9f, 0xb2, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x46, 0x22, 0xc8,
+	0x1f, 0x84, 0x04, 0x00, 0x00,
+}
+
+func (m *AppealVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppealVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppealVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintInvalidationAppeal(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvalidationAppeal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvalidationAppeal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvalidationAppeal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochIndex != 0 {
+		i = encodeVarintInvalidationAppeal(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ResolvedAtBlockHeight != 0 {
+		i = encodeVarintInvalidationAppeal(dAtA, i, uint64(m.ResolvedAtBlockHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Status != 0 {
+		i = encodeVarintInvalidationAppeal(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInvalidationAppeal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AssignedValidators) > 0 {
+		for iNdEx := len(m.AssignedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AssignedValidators[iNdEx])
+			copy(dAtA[i:], m.AssignedValidators[iNdEx])
+			i = encodeVarintInvalidationAppeal(dAtA, i, uint64(len(m.AssignedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DeadlineBlockHeight != 0 {
+		i = encodeVarintInvalidationAppeal(dAtA, i, uint64(m.DeadlineBlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OpenedAtBlockHeight != 0 {
+		i = encodeVarintInvalidationAppeal(dAtA, i, uint64(m.OpenedAtBlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Bond != 0 {
+		i = encodeVarintInvalidationAppeal(dAtA, i, uint64(m.Bond))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Invalidator) > 0 {
+		i -= len(m.Invalidator)
+		copy(dAtA[i:], m.Invalidator)
+		i = encodeVarintInvalidationAppeal(dAtA, i, uint64(len(m.Invalidator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintInvalidationAppeal(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintInvalidationAppeal(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvalidationEffects) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvalidationEffects) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvalidationEffects) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompensatedAmount != 0 {
+		i = encodeVarintInvalidationAppeal(dAtA, i, uint64(m.CompensatedAmount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EpochIndex != 0 {
+		i = encodeVarintInvalidationAppeal(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EpochsCompletedBefore != 0 {
+		i = encodeVarintInvalidationAppeal(dAtA, i, uint64(m.EpochsCompletedBefore))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExecutorInvalidated {
+		i--
+		if m.ExecutorInvalidated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RefundedAmount != 0 {
+		i = encodeVarintInvalidationAppeal(dAtA, i, uint64(m.RefundedAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintInvalidationAppeal(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInvalidationAppeal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInvalidationAppeal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AppealVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovInvalidationAppeal(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.Passed {
+		n += 2
+	}
+	return n
+}
+
+func (m *InvalidationAppeal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovInvalidationAppeal(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovInvalidationAppeal(uint64(l))
+	}
+	l = len(m.Invalidator)
+	if l > 0 {
+		n += 1 + l + sovInvalidationAppeal(uint64(l))
+	}
+	if m.Bond != 0 {
+		n += 1 + sovInvalidationAppeal(uint64(m.Bond))
+	}
+	if m.OpenedAtBlockHeight != 0 {
+		n += 1 + sovInvalidationAppeal(uint64(m.OpenedAtBlockHeight))
+	}
+	if m.DeadlineBlockHeight != 0 {
+		n += 1 + sovInvalidationAppeal(uint64(m.DeadlineBlockHeight))
+	}
+	if len(m.AssignedValidators) > 0 {
+		for _, s := range m.AssignedValidators {
+			l = len(s)
+			n += 1 + l + sovInvalidationAppeal(uint64(l))
+		}
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovInvalidationAppeal(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovInvalidationAppeal(uint64(m.Status))
+	}
+	if m.ResolvedAtBlockHeight != 0 {
+		n += 1 + sovInvalidationAppeal(uint64(m.ResolvedAtBlockHeight))
+	}
+	if m.EpochIndex != 0 {
+		n += 1 + sovInvalidationAppeal(uint64(m.EpochIndex))
+	}
+	return n
+}
+
+func (m *InvalidationEffects) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovInvalidationAppeal(uint64(l))
+	}
+	if m.RefundedAmount != 0 {
+		n += 1 + sovInvalidationAppeal(uint64(m.RefundedAmount))
+	}
+	if m.ExecutorInvalidated {
+		n += 2
+	}
+	if m.EpochsCompletedBefore != 0 {
+		n += 1 + sovInvalidationAppeal(uint64(m.EpochsCompletedBefore))
+	}
+	if m.EpochIndex != 0 {
+		n += 1 + sovInvalidationAppeal(uint64(m.EpochIndex))
+	}
+	if m.CompensatedAmount != 0 {
+		n += 1 + sovInvalidationAppeal(uint64(m.CompensatedAmount))
+	}
+	return n
+}
+
+func sovInvalidationAppeal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInvalidationAppeal(x uint64) (n int) {
+	return sovInvalidationAppeal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AppealVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInvalidationAppeal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppealVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppealVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInvalidationAppeal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvalidationAppeal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInvalidationAppeal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvalidationAppeal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvalidationAppeal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invalidator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			m.Bond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedAtBlockHeight", wireType)
+			}
+			m.OpenedAtBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenedAtBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineBlockHeight", wireType)
+			}
+			m.DeadlineBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssignedValidators = append(m.AssignedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &AppealVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AppealStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAtBlockHeight", wireType)
+			}
+			m.ResolvedAtBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedAtBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			}
+			m.EpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInvalidationAppeal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvalidationEffects) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInvalidationAppeal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvalidationEffects: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvalidationEffects: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedAmount", wireType)
+			}
+			m.RefundedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundedAmount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorInvalidated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExecutorInvalidated = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsCompletedBefore", wireType)
+			}
+			m.EpochsCompletedBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsCompletedBefore |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			}
+			m.EpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompensatedAmount", wireType)
+			}
+			m.CompensatedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompensatedAmount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInvalidationAppeal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInvalidationAppeal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInvalidationAppeal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInvalidationAppeal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInvalidationAppeal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInvalidationAppeal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInvalidationAppeal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInvalidationAppeal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInvalidationAppeal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInvalidationAppeal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInvalidationAppeal = fmt.Errorf("proto: unexpected end of group")
+)
@@ -58,6 +58,19 @@ var (
 	LiquidityPoolPrefix               = collections.NewPrefix(34)
 	LiquidityPoolApprovedTokensPrefix = collections.NewPrefix(35)
 	ParticipantAllowListPrefix        = collections.NewPrefix(36)
+	EpochInvalidatorsPrefix           = collections.NewPrefix(37)
+	InvalidationAppealsPrefix         = collections.NewPrefix(38)
+	PendingAppealsPrefix              = collections.NewPrefix(39)
+	ModelAliasesPrefix                = collections.NewPrefix(40)
+	BridgeBlockAttestationsPrefix     = collections.NewPrefix(41)
+	BridgeRateLimitsPrefix            = collections.NewPrefix(42)
+	BridgeVolumeUsagePrefix           = collections.NewPrefix(43)
+	BridgeChainStatusPrefix           = collections.NewPrefix(44)
+	HeldBridgeTransactionsPrefix      = collections.NewPrefix(45)
+	InvalidationEffectsPrefix         = collections.NewPrefix(46)
+	BridgeBlockVotesPrefix            = collections.NewPrefix(47)
+	BridgeAttestationsToPrunePrefix   = collections.NewPrefix(48)
+	HeldBridgeReleaseCursorPrefix     = collections.NewPrefix(49)
 	ParamsKey                         = []byte("p_inference")
 )
 
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAppealInvalidation_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAppealInvalidation
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAppealInvalidation{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing inference id",
+			msg: MsgAppealInvalidation{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgAppealInvalidation{
+				Creator:     sample.AccAddress(),
+				InferenceId: "iid",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSubmitAppealVote_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSubmitAppealVote
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSubmitAppealVote{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "nan value",
+			msg: MsgSubmitAppealVote{
+				Creator:     sample.AccAddress(),
+				InferenceId: "iid",
+				Value:       math.NaN(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgSubmitAppealVote{
+				Creator:     sample.AccAddress(),
+				InferenceId: "iid",
+				Value:       0.99,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAttestBridgeBlock{}
+
+// maxReceiptProofNodes bounds the size of a receipt proof. Receipt tries are keyed by short
+// RLP encoded indexes, so real proofs are only a handful of nodes deep.
+const maxReceiptProofNodes = 16
+
+func (msg *MsgAttestBridgeBlock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Validator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+	if len(msg.OriginChain) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "originChain is required")
+	}
+	if !reDigits.MatchString(msg.BlockNumber) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "blockNumber must be a base-10 unsigned integer string")
+	}
+	if _, err := ParseReceiptsRoot(msg.ReceiptsRoot); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// ParseReceiptsRoot decodes a hex receipts root, with or without 0x prefix, into its 32 bytes
+func ParseReceiptsRoot(root string) ([]byte, error) {
+	decoded, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(root), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("receiptsRoot is not hex: %w", err)
+	}
+	if len(decoded) != 32 {
+		return nil, fmt.Errorf("receiptsRoot must be 32 bytes, got %d", len(decoded))
+	}
+	return decoded, nil
+}
+
+// NormalizeReceiptsRoot returns the canonical 0x prefixed lowercase form of a receipts root
+func NormalizeReceiptsRoot(root string) (string, error) {
+	decoded, err := ParseReceiptsRoot(root)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(decoded), nil
+}
@@ -70,5 +70,14 @@ func (msg *MsgBridgeExchange) ValidateBasic() error {
 	if bi, ok := new(big.Int).SetString(msg.Amount, 10); !ok || bi.Sign() <= 0 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be > 0")
 	}
+	// a receipt proof can only be checked against a well-formed root
+	if len(msg.ReceiptProof) > 0 {
+		if len(msg.ReceiptProof) > maxReceiptProofNodes {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "receiptProof has more than %d nodes", maxReceiptProofNodes)
+		}
+		if _, err := ParseReceiptsRoot(msg.ReceiptsRoot); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
 	return nil
 }
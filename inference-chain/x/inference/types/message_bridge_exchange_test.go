@@ -54,3 +54,14 @@ func TestMsgBridgeExchange_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgAttestBridgeBlock_ValidateBasic(t *testing.T) {
+	root := "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421"
+	require.NoError(t, (&MsgAttestBridgeBlock{Validator: sample.AccAddress(), OriginChain: "ethereum", BlockNumber: "100", ReceiptsRoot: root}).ValidateBasic())
+	require.ErrorIs(t, (&MsgAttestBridgeBlock{Validator: sample.AccAddress(), OriginChain: "ethereum", BlockNumber: "0x64", ReceiptsRoot: root}).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, (&MsgAttestBridgeBlock{Validator: sample.AccAddress(), OriginChain: "ethereum", BlockNumber: "100", ReceiptsRoot: "0xroot"}).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+
+	normalized, err := NormalizeReceiptsRoot("56E81F171BCC55A6FF8345E692C0F86E5B48E01B996CADC001622FB5E363B421")
+	require.NoError(t, err)
+	require.Equal(t, root, normalized)
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCancelTrainingTask{}
+
+func NewMsgCancelTrainingTask(creator string, taskId uint64) *MsgCancelTrainingTask {
+	return &MsgCancelTrainingTask{
+		Creator: creator,
+		TaskId:  taskId,
+	}
+}
+
+func (msg *MsgCancelTrainingTask) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.TaskId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "task_id is required")
+	}
+	return nil
+}
@@ -19,5 +19,20 @@ func (msg *MsgCreateTrainingTask) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	return msg.ValidateBudget()
+}
+
+// ValidateBudget checks that a paid task pays something per node epoch and can afford at least one.
+// Tasks without a budget pay nothing.
+func (msg *MsgCreateTrainingTask) ValidateBudget() error {
+	if msg.Budget == 0 {
+		if msg.PaymentPerNodeEpoch != 0 {
+			return errorsmod.Wrap(ErrInvalidTrainingBudget, "payment per node epoch requires a budget")
+		}
+		return nil
+	}
+	if msg.PaymentPerNodeEpoch == 0 || msg.PaymentPerNodeEpoch > msg.Budget {
+		return errorsmod.Wrapf(ErrInvalidTrainingBudget, "payment per node epoch must be between 1 and the budget (%d)", msg.Budget)
+	}
 	return nil
 }
@@ -25,6 +25,35 @@ func TestMsgCreateTrainingTask_ValidateBasic(t *testing.T) {
 			msg: MsgCreateTrainingTask{
 				Creator: sample.AccAddress(),
 			},
+		}, {
+			name: "valid budget",
+			msg: MsgCreateTrainingTask{
+				Creator:             sample.AccAddress(),
+				Budget:              1000,
+				PaymentPerNodeEpoch: 100,
+			},
+		}, {
+			name: "budget without payment",
+			msg: MsgCreateTrainingTask{
+				Creator: sample.AccAddress(),
+				Budget:  1000,
+			},
+			err: ErrInvalidTrainingBudget,
+		}, {
+			name: "payment above budget",
+			msg: MsgCreateTrainingTask{
+				Creator:             sample.AccAddress(),
+				Budget:              100,
+				PaymentPerNodeEpoch: 200,
+			},
+			err: ErrInvalidTrainingBudget,
+		}, {
+			name: "payment without budget",
+			msg: MsgCreateTrainingTask{
+				Creator:             sample.AccAddress(),
+				PaymentPerNodeEpoch: 100,
+			},
+			err: ErrInvalidTrainingBudget,
 		},
 	}
 	for _, tt := range tests {
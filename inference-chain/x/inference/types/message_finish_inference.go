@@ -51,6 +51,9 @@ func (msg *MsgFinishInference) ValidateBasic() error {
 	if strings.TrimSpace(msg.Model) == "" {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "model is required")
 	}
+	if _, ok := InferenceCompletionStatus_name[int32(msg.CompletionStatus)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown completion_status %d", msg.CompletionStatus)
+	}
 	// request_timestamp must be > 0
 	if msg.RequestTimestamp <= 0 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "request_timestamp must be > 0")
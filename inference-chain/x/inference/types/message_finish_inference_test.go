@@ -52,6 +52,24 @@ func TestMsgFinishInference_ValidateBasic(t *testing.T) {
 				PromptTokenCount:     0,
 				CompletionTokenCount: 0,
 			},
+		}, {
+			name: "unknown completion status",
+			msg: MsgFinishInference{
+				Creator:            sample.AccAddress(),
+				ExecutedBy:         sample.AccAddress(),
+				TransferredBy:      sample.AccAddress(),
+				RequestedBy:        sample.AccAddress(),
+				InferenceId:        base64.StdEncoding.EncodeToString(make([]byte, 64)),
+				ResponseHash:       "rh",
+				PromptHash:         "ph",
+				OriginalPromptHash: "oph",
+				Model:              "m",
+				RequestTimestamp:   1,
+				TransferSignature:  base64.StdEncoding.EncodeToString(make([]byte, 64)),
+				ExecutorSignature:  base64.StdEncoding.EncodeToString(make([]byte, 64)),
+				CompletionStatus:   InferenceCompletionStatus(7),
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
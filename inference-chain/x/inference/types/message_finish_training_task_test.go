@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgFinishTrainingTask_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgFinishTrainingTask
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgFinishTrainingTask{
+				Creator: "invalid_address",
+				TaskId:  1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing task id",
+			msg: MsgFinishTrainingTask{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgFinishTrainingTask{
+				Creator: sample.AccAddress(),
+				TaskId:  1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
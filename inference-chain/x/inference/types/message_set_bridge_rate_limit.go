@@ -0,0 +1,57 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetBridgeRateLimit{}
+
+func (msg *MsgSetBridgeRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return msg.RateLimit.Validate()
+}
+
+func (l BridgeRateLimit) Validate() error {
+	if len(l.ChainId) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "chainId is required")
+	}
+	if len(l.ContractAddress) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "contractAddress is required")
+	}
+	if l.MaxWindowVolume != "" {
+		if _, err := ParseBridgeAmount(l.MaxWindowVolume); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid maxWindowVolume: %s", err)
+		}
+		if l.WindowBlocks == 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "windowBlocks must be positive when maxWindowVolume is set")
+		}
+	}
+	if l.MaxTransactionAmount != "" {
+		if _, err := ParseBridgeAmount(l.MaxTransactionAmount); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid maxTransactionAmount: %s", err)
+		}
+	}
+	return nil
+}
+
+// HasCaps reports whether the rate limit caps anything. Setting a rate limit without caps removes it.
+func (l BridgeRateLimit) HasCaps() bool {
+	return l.MaxWindowVolume != "" || l.MaxTransactionAmount != ""
+}
+
+// ParseBridgeAmount parses a base-10 token amount, which must be positive
+func ParseBridgeAmount(amount string) (math.Int, error) {
+	if !reDigits.MatchString(amount) {
+		return math.Int{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amount %q must be a base-10 unsigned integer string", amount)
+	}
+	value, ok := math.NewIntFromString(amount)
+	if !ok || !value.IsPositive() {
+		return math.Int{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amount %q must be positive", amount)
+	}
+	return value, nil
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetBridgeRateLimit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetBridgeRateLimit
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  MsgSetBridgeRateLimit{Authority: "invalid_address", RateLimit: BridgeRateLimit{ChainId: "ethereum", ContractAddress: "0x1"}},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing chain",
+			msg:  MsgSetBridgeRateLimit{Authority: sample.AccAddress(), RateLimit: BridgeRateLimit{ContractAddress: "0x1"}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "window volume without window",
+			msg:  MsgSetBridgeRateLimit{Authority: sample.AccAddress(), RateLimit: BridgeRateLimit{ChainId: "ethereum", ContractAddress: "0x1", MaxWindowVolume: "100"}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero transaction amount",
+			msg:  MsgSetBridgeRateLimit{Authority: sample.AccAddress(), RateLimit: BridgeRateLimit{ChainId: "ethereum", ContractAddress: "0x1", MaxTransactionAmount: "0"}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "removal",
+			msg:  MsgSetBridgeRateLimit{Authority: sample.AccAddress(), RateLimit: BridgeRateLimit{ChainId: "ethereum", ContractAddress: "0x1"}},
+		}, {
+			name: "valid",
+			msg:  MsgSetBridgeRateLimit{Authority: sample.AccAddress(), RateLimit: BridgeRateLimit{ChainId: "ethereum", ContractAddress: "0x1", WindowBlocks: 100, MaxWindowVolume: "1000", MaxTransactionAmount: "100"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
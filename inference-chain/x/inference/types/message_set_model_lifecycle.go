@@ -0,0 +1,46 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgSetModelStatus{}
+	_ sdk.Msg = &MsgSetModelAlias{}
+)
+
+func (msg *MsgSetModelStatus) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if strings.TrimSpace(msg.Id) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "model id is required")
+	}
+	if _, ok := ModelStatus_name[int32(msg.Status)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidModelStatus, "unknown status %d", msg.Status)
+	}
+	if msg.Status == ModelStatus_MODEL_STATUS_DEPRECATED && msg.SunsetEpoch == 0 {
+		return errorsmod.Wrap(ErrInvalidModelStatus, "deprecation requires a sunset epoch")
+	}
+	if msg.SuccessorId == msg.Id {
+		return errorsmod.Wrap(ErrInvalidModelStatus, "a model cannot succeed itself")
+	}
+	return nil
+}
+
+func (msg *MsgSetModelAlias) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if strings.TrimSpace(msg.Alias) == "" || strings.TrimSpace(msg.Alias) != msg.Alias {
+		return errorsmod.Wrap(ErrInvalidModelAlias, "alias must be non-empty without surrounding whitespace")
+	}
+	if msg.Alias == msg.ModelId {
+		return errorsmod.Wrap(ErrInvalidModelAlias, "alias cannot point to itself")
+	}
+	return nil
+}
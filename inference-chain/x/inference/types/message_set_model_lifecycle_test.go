@@ -0,0 +1,78 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetModelStatus_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetModelStatus
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  MsgSetModelStatus{Authority: "invalid_address", Id: "model-1"},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "deprecation without sunset",
+			msg:  MsgSetModelStatus{Authority: sample.AccAddress(), Id: "model-1", Status: ModelStatus_MODEL_STATUS_DEPRECATED},
+			err:  ErrInvalidModelStatus,
+		}, {
+			name: "self succession",
+			msg:  MsgSetModelStatus{Authority: sample.AccAddress(), Id: "model-1", Status: ModelStatus_MODEL_STATUS_RETIRED, SuccessorId: "model-1"},
+			err:  ErrInvalidModelStatus,
+		}, {
+			name: "valid",
+			msg:  MsgSetModelStatus{Authority: sample.AccAddress(), Id: "model-1", Status: ModelStatus_MODEL_STATUS_DEPRECATED, SunsetEpoch: 5, SuccessorId: "model-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetModelAlias_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetModelAlias
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  MsgSetModelAlias{Authority: "invalid_address", Alias: "latest"},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty alias",
+			msg:  MsgSetModelAlias{Authority: sample.AccAddress(), ModelId: "model-1"},
+			err:  ErrInvalidModelAlias,
+		}, {
+			name: "valid removal",
+			msg:  MsgSetModelAlias{Authority: sample.AccAddress(), Alias: "latest"},
+		}, {
+			name: "valid",
+			msg:  MsgSetModelAlias{Authority: sample.AccAddress(), Alias: "latest", ModelId: "model-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
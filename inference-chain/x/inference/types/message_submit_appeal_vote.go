@@ -0,0 +1,33 @@
+package types
+
+import (
+	"math"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSubmitAppealVote{}
+
+func NewMsgSubmitAppealVote(creator string, inferenceID string, value float64) *MsgSubmitAppealVote {
+	return &MsgSubmitAppealVote{
+		Creator:     creator,
+		InferenceId: inferenceID,
+		Value:       value,
+	}
+}
+
+func (msg *MsgSubmitAppealVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if strings.TrimSpace(msg.InferenceId) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "inference_id is required")
+	}
+	if math.IsNaN(msg.Value) || math.IsInf(msg.Value, 0) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "value must be a finite number")
+	}
+	return nil
+}
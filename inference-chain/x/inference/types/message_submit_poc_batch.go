@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"math"
 	"strings"
 
@@ -35,6 +36,12 @@ func (msg *MsgSubmitPocBatch) ValidateBasic() error {
 	if strings.TrimSpace(msg.BatchId) == "" {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "batch_id is required")
 	}
+	if len(msg.MerkleRoot) > 0 {
+		return msg.validateCommitment()
+	}
+	if msg.NonceCount != 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nonce_count requires a merkle_root")
+	}
 	// nonces required and each >= 0
 	if len(msg.Nonces) == 0 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nonces must be non-empty")
@@ -62,3 +69,22 @@ func (msg *MsgSubmitPocBatch) ValidateBasic() error {
 	}
 	return nil
 }
+
+// validateCommitment checks a batch submitted as a Merkle root over its (nonce, dist) leaves
+func (msg *MsgSubmitPocBatch) validateCommitment() error {
+	if len(msg.MerkleRoot) != sha256.Size {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "merkle_root must be %d bytes", sha256.Size)
+	}
+	if err := msg.NonceProgression().Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if len(msg.Nonces) != 0 || len(msg.Dist) != 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "committed batches must not include nonces or dist")
+	}
+	return nil
+}
+
+// NonceProgression returns the progression a committed batch claims its nonces follow
+func (msg *MsgSubmitPocBatch) NonceProgression() PoCNonceProgression {
+	return PoCNonceProgression{Count: msg.NonceCount, First: msg.FirstNonce, Last: msg.LastNonce, Stride: msg.NonceStride}
+}
@@ -30,6 +30,56 @@ func TestMsgSubmitPocBatch_ValidateBasic(t *testing.T) {
 				Dist:                     []float64{0.3, 0.7},
 				NodeId:                   "node-1",
 			},
+		}, {
+			name: "valid commitment",
+			msg: MsgSubmitPocBatch{
+				Creator:                  sample.AccAddress(),
+				PocStageStartBlockHeight: 1,
+				BatchId:                  "b1",
+				MerkleRoot:               make([]byte, 32),
+				NonceCount:               2,
+				FirstNonce:               3,
+				LastNonce:                11,
+				NonceStride:              4,
+				NodeId:                   "node-1",
+			},
+		}, {
+			name: "commitment claiming more nonces than its range holds",
+			msg: MsgSubmitPocBatch{
+				Creator:                  sample.AccAddress(),
+				PocStageStartBlockHeight: 1,
+				BatchId:                  "b1",
+				MerkleRoot:               make([]byte, 32),
+				NonceCount:               4,
+				FirstNonce:               3,
+				LastNonce:                11,
+				NonceStride:              4,
+				NodeId:                   "node-1",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "commitment with nonces",
+			msg: MsgSubmitPocBatch{
+				Creator:                  sample.AccAddress(),
+				PocStageStartBlockHeight: 1,
+				BatchId:                  "b1",
+				MerkleRoot:               make([]byte, 32),
+				NonceCount:               2,
+				Nonces:                   []int64{0, 1},
+				Dist:                     []float64{0.3, 0.7},
+				NodeId:                   "node-1",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "commitment without nonce count",
+			msg: MsgSubmitPocBatch{
+				Creator:                  sample.AccAddress(),
+				PocStageStartBlockHeight: 1,
+				BatchId:                  "b1",
+				MerkleRoot:               make([]byte, 32),
+				NodeId:                   "node-1",
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"crypto/sha256"
+	"math"
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -97,5 +101,27 @@ func (msg *MsgSubmitPocValidation) ValidateBasic() error {
 	//if math.IsNaN(msg.ProbabilityHonest) || math.IsInf(msg.ProbabilityHonest, 0) || msg.ProbabilityHonest < 0 || msg.ProbabilityHonest > 1 {
 	//	return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "probability_honest must be in [0,1] and finite")
 	//}
+	return validatePoCLeafDisputes(msg.Disputes)
+}
+
+// validatePoCLeafDisputes checks the shape of disputed leaves, their proofs are checked against the committed
+// batch by the keeper
+func validatePoCLeafDisputes(disputes []PoCLeafDispute) error {
+	if len(disputes) > MaxPoCLeafDisputes {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "at most %d disputes per validation", MaxPoCLeafDisputes)
+	}
+	for i, dispute := range disputes {
+		if strings.TrimSpace(dispute.BatchId) == "" {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "disputes[%d].batch_id is required", i)
+		}
+		if math.IsNaN(dispute.Dist) || math.IsInf(dispute.Dist, 0) || math.IsNaN(dispute.ReceivedDist) || math.IsInf(dispute.ReceivedDist, 0) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "disputes[%d] distances must be finite", i)
+		}
+		for _, hash := range dispute.Proof {
+			if len(hash) != sha256.Size {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "disputes[%d].proof hashes must be %d bytes", i, sha256.Size)
+			}
+		}
+	}
 	return nil
 }
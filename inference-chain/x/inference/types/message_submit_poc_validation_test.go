@@ -35,6 +35,35 @@ func TestMsgSubmitPocValidation_ValidateBasic(t *testing.T) {
 				ProbabilityHonest:        0.8,
 				FraudDetected:            false,
 			},
+		}, {
+			name: "valid disputes",
+			msg: MsgSubmitPocValidation{
+				Creator:                  sample.AccAddress(),
+				ParticipantAddress:       sample.AccAddress(),
+				PocStageStartBlockHeight: 1,
+				RTarget:                  0.5,
+				NInvalid:                 1,
+				FraudDetected:            true,
+				Disputes:                 []PoCLeafDispute{{BatchId: "b1", LeafIndex: 3, Nonce: 7, ReceivedDist: 0.2, Dist: 0.8, Proof: [][]byte{make([]byte, 32)}}},
+			},
+		}, {
+			name: "dispute with malformed proof",
+			msg: MsgSubmitPocValidation{
+				Creator:                  sample.AccAddress(),
+				ParticipantAddress:       sample.AccAddress(),
+				PocStageStartBlockHeight: 1,
+				Disputes:                 []PoCLeafDispute{{BatchId: "b1", Proof: [][]byte{{1, 2, 3}}}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "too many disputes",
+			msg: MsgSubmitPocValidation{
+				Creator:                  sample.AccAddress(),
+				ParticipantAddress:       sample.AccAddress(),
+				PocStageStartBlockHeight: 1,
+				Disputes:                 make([]PoCLeafDispute, MaxPoCLeafDisputes+1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
@@ -22,20 +22,56 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ModelStatus is the lifecycle state of a governance model
+type ModelStatus int32
+
+const (
+	ModelStatus_MODEL_STATUS_ACTIVE ModelStatus = 0
+	// Still assigned and served until sunset_epoch, after which it is retired
+	ModelStatus_MODEL_STATUS_DEPRECATED ModelStatus = 1
+	// No longer assigned to participants
+	ModelStatus_MODEL_STATUS_RETIRED ModelStatus = 2
+)
+
+var ModelStatus_name = map[int32]string{
+	0: "MODEL_STATUS_ACTIVE",
+	1: "MODEL_STATUS_DEPRECATED",
+	2: "MODEL_STATUS_RETIRED",
+}
+
+var ModelStatus_value = map[string]int32{
+	"MODEL_STATUS_ACTIVE":     0,
+	"MODEL_STATUS_DEPRECATED": 1,
+	"MODEL_STATUS_RETIRED":    2,
+}
+
+func (x ModelStatus) String() string {
+	return proto.EnumName(ModelStatus_name, int32(x))
+}
+
+func (ModelStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d7b516097210bdb1, []int{0}
+}
+
 type Model struct {
-	ProposedBy             string   `protobuf:"bytes,1,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
-	Id                     string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	UnitsOfComputePerToken uint64   `protobuf:"varint,3,opt,name=units_of_compute_per_token,json=unitsOfComputePerToken,proto3" json:"units_of_compute_per_token,omitempty"`
-	ContextWindow          uint64   `protobuf:"varint,4,opt,name=context_window,json=contextWindow,proto3" json:"context_window,omitempty"`
-	Quantization           string   `protobuf:"bytes,5,opt,name=quantization,proto3" json:"quantization,omitempty"`
-	CoinsPerInputToken     uint64   `protobuf:"varint,6,opt,name=coins_per_input_token,json=coinsPerInputToken,proto3" json:"coins_per_input_token,omitempty"`
-	CoinsPerOutputToken    uint64   `protobuf:"varint,7,opt,name=coins_per_output_token,json=coinsPerOutputToken,proto3" json:"coins_per_output_token,omitempty"`
-	HfRepo                 string   `protobuf:"bytes,8,opt,name=hf_repo,json=hfRepo,proto3" json:"hf_repo,omitempty"`
-	HfCommit               string   `protobuf:"bytes,9,opt,name=hf_commit,json=hfCommit,proto3" json:"hf_commit,omitempty"`
-	ModelArgs              []string `protobuf:"bytes,10,rep,name=model_args,json=modelArgs,proto3" json:"model_args,omitempty"`
-	VRam                   uint64   `protobuf:"varint,11,opt,name=v_ram,json=vRam,proto3" json:"v_ram,omitempty"`
-	ThroughputPerNonce     uint64   `protobuf:"varint,12,opt,name=throughput_per_nonce,json=throughputPerNonce,proto3" json:"throughput_per_nonce,omitempty"`
-	ValidationThreshold    *Decimal `protobuf:"bytes,13,opt,name=validation_threshold,json=validationThreshold,proto3" json:"validation_threshold,omitempty"`
+	ProposedBy             string      `protobuf:"bytes,1,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
+	Id                     string      `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	UnitsOfComputePerToken uint64      `protobuf:"varint,3,opt,name=units_of_compute_per_token,json=unitsOfComputePerToken,proto3" json:"units_of_compute_per_token,omitempty"`
+	ContextWindow          uint64      `protobuf:"varint,4,opt,name=context_window,json=contextWindow,proto3" json:"context_window,omitempty"`
+	Quantization           string      `protobuf:"bytes,5,opt,name=quantization,proto3" json:"quantization,omitempty"`
+	CoinsPerInputToken     uint64      `protobuf:"varint,6,opt,name=coins_per_input_token,json=coinsPerInputToken,proto3" json:"coins_per_input_token,omitempty"`
+	CoinsPerOutputToken    uint64      `protobuf:"varint,7,opt,name=coins_per_output_token,json=coinsPerOutputToken,proto3" json:"coins_per_output_token,omitempty"`
+	HfRepo                 string      `protobuf:"bytes,8,opt,name=hf_repo,json=hfRepo,proto3" json:"hf_repo,omitempty"`
+	HfCommit               string      `protobuf:"bytes,9,opt,name=hf_commit,json=hfCommit,proto3" json:"hf_commit,omitempty"`
+	ModelArgs              []string    `protobuf:"bytes,10,rep,name=model_args,json=modelArgs,proto3" json:"model_args,omitempty"`
+	VRam                   uint64      `protobuf:"varint,11,opt,name=v_ram,json=vRam,proto3" json:"v_ram,omitempty"`
+	ThroughputPerNonce     uint64      `protobuf:"varint,12,opt,name=throughput_per_nonce,json=throughputPerNonce,proto3" json:"throughput_per_nonce,omitempty"`
+	ValidationThreshold    *Decimal    `protobuf:"bytes,13,opt,name=validation_threshold,json=validationThreshold,proto3" json:"validation_threshold,omitempty"`
+	Status                 ModelStatus `protobuf:"varint,14,opt,name=status,proto3,enum=inference.inference.ModelStatus" json:"status,omitempty"`
+	// sunset_epoch is the first epoch a deprecated model is no longer assigned in
+	SunsetEpoch uint64 `protobuf:"varint,15,opt,name=sunset_epoch,json=sunsetEpoch,proto3" json:"sunset_epoch,omitempty"`
+	// successor_id is the model clients should move to; aliases follow it when this model is retired
+	SuccessorId string `protobuf:"bytes,16,opt,name=successor_id,json=successorId,proto3" json:"successor_id,omitempty"`
 }
 
 func (m *Model) Reset()         { *m = Model{} }
@@ -162,44 +198,129 @@ func (m *Model) GetValidationThreshold() *Decimal {
 	return nil
 }
 
+func (m *Model) GetStatus() ModelStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ModelStatus_MODEL_STATUS_ACTIVE
+}
+
+func (m *Model) GetSunsetEpoch() uint64 {
+	if m != nil {
+		return m.SunsetEpoch
+	}
+	return 0
+}
+
+func (m *Model) GetSuccessorId() string {
+	if m != nil {
+		return m.SuccessorId
+	}
+	return ""
+}
+
+// ModelAlias is a stable name resolved to a concrete model id, e.g. qwen-latest
+type ModelAlias struct {
+	Alias   string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	ModelId string `protobuf:"bytes,2,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+}
+
+func (m *ModelAlias) Reset()         { *m = ModelAlias{} }
+func (m *ModelAlias) String() string { return proto.CompactTextString(m) }
+func (*ModelAlias) ProtoMessage()    {}
+func (*ModelAlias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7b516097210bdb1, []int{1}
+}
+func (m *ModelAlias) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModelAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModelAlias.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModelAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModelAlias.Merge(m, src)
+}
+func (m *ModelAlias) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModelAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModelAlias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModelAlias proto.InternalMessageInfo
+
+func (m *ModelAlias) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *ModelAlias) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("inference.inference.ModelStatus", ModelStatus_name, ModelStatus_value)
 	proto.RegisterType((*Model)(nil), "inference.inference.Model")
+	proto.RegisterType((*ModelAlias)(nil), "inference.inference.ModelAlias")
 }
 
 func init() { proto.RegisterFile("inference/inference/model.proto", fileDescriptor_d7b516097210bdb1) }
 
 var fileDescriptor_d7b516097210bdb1 = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6f, 0xd3, 0x3e,
-	0x14, 0xc7, 0x9b, 0xae, 0xed, 0x16, 0x77, 0xdb, 0xc1, 0xdd, 0x6f, 0x3f, 0x6b, 0x40, 0x16, 0x4d,
-	0x42, 0xea, 0x29, 0x03, 0x26, 0x2e, 0xdc, 0x18, 0x5c, 0x38, 0x40, 0xab, 0x68, 0x12, 0x12, 0x17,
-	0x2b, 0x4d, 0x9c, 0xc6, 0xa2, 0xf1, 0x33, 0xb6, 0xd3, 0xad, 0xfc, 0x15, 0x1c, 0xf8, 0xa3, 0x38,
-	0xee, 0xc8, 0x11, 0xb5, 0xff, 0x08, 0xca, 0xcb, 0xba, 0x00, 0xda, 0xed, 0xe9, 0xfb, 0xf5, 0xc7,
-	0xef, 0xeb, 0xe7, 0x47, 0x4e, 0xa5, 0xca, 0x85, 0x11, 0x2a, 0x15, 0xe7, 0x6d, 0x55, 0x42, 0x26,
-	0x16, 0x91, 0x36, 0xe0, 0x80, 0x8e, 0xee, 0xe5, 0xe8, 0xbe, 0x3a, 0x09, 0x1f, 0xa2, 0x74, 0x62,
-	0x92, 0xd2, 0x36, 0xd8, 0xd9, 0xf7, 0x1e, 0xe9, 0xbf, 0xaf, 0xaf, 0xa1, 0xa7, 0x64, 0xa8, 0x0d,
-	0x68, 0xb0, 0x22, 0xe3, 0xb3, 0x15, 0xf3, 0x42, 0x6f, 0xec, 0xc7, 0x64, 0x2b, 0x5d, 0xae, 0xe8,
-	0x21, 0xe9, 0xca, 0x8c, 0x75, 0x51, 0xef, 0xca, 0x8c, 0xbe, 0x22, 0x27, 0x95, 0x92, 0xce, 0x72,
-	0xc8, 0x79, 0x0a, 0xa5, 0xae, 0x9c, 0xe0, 0x5a, 0x18, 0xee, 0xe0, 0xb3, 0x50, 0x6c, 0x27, 0xf4,
-	0xc6, 0xbd, 0xf8, 0x18, 0x4f, 0x4c, 0xf2, 0x37, 0x8d, 0x3f, 0x15, 0xe6, 0xaa, 0x76, 0xe9, 0x53,
-	0x72, 0x98, 0x82, 0x72, 0xe2, 0xc6, 0xf1, 0x6b, 0xa9, 0x32, 0xb8, 0x66, 0x3d, 0x3c, 0x7f, 0x70,
-	0xa7, 0x7e, 0x44, 0x91, 0x9e, 0x91, 0xfd, 0x2f, 0x55, 0xa2, 0x9c, 0xfc, 0x9a, 0x38, 0x09, 0x8a,
-	0xf5, 0xb1, 0xf9, 0x5f, 0x1a, 0x7d, 0x4e, 0xfe, 0x4b, 0x41, 0x2a, 0x8b, 0xbd, 0xa5, 0xd2, 0x95,
-	0xbb, 0x4b, 0x30, 0xc0, 0x1b, 0x29, 0x9a, 0x53, 0x61, 0xde, 0xd5, 0x56, 0xd3, 0xfd, 0x82, 0x1c,
-	0xb7, 0x08, 0x54, 0xae, 0x65, 0x76, 0x91, 0x19, 0x6d, 0x99, 0x09, 0x7a, 0x0d, 0xf4, 0x3f, 0xd9,
-	0x2d, 0x72, 0x6e, 0x84, 0x06, 0xb6, 0x87, 0x31, 0x06, 0x45, 0x1e, 0x0b, 0x0d, 0xf4, 0x11, 0xf1,
-	0x0b, 0x9c, 0x40, 0x29, 0x1d, 0xf3, 0xd1, 0xda, 0x2b, 0xea, 0x17, 0x97, 0xd2, 0xd1, 0x27, 0x84,
-	0xe0, 0x2f, 0xf1, 0xc4, 0xcc, 0x2d, 0x23, 0xe1, 0xce, 0xd8, 0x8f, 0x7d, 0x54, 0x5e, 0x9b, 0xb9,
-	0xa5, 0x23, 0xd2, 0x5f, 0x72, 0x93, 0x94, 0x6c, 0x88, 0x8d, 0x7b, 0xcb, 0x38, 0x29, 0xe9, 0x33,
-	0x72, 0xe4, 0x0a, 0x03, 0xd5, 0xbc, 0xa8, 0x83, 0xd5, 0x19, 0x15, 0xa8, 0x54, 0xb0, 0xfd, 0xe6,
-	0x41, 0xad, 0x37, 0x15, 0xe6, 0x43, 0xed, 0xd0, 0x09, 0x39, 0x5a, 0x26, 0x0b, 0x99, 0xe1, 0x44,
-	0xb8, 0x2b, 0x8c, 0xb0, 0x05, 0x2c, 0x32, 0x76, 0x10, 0x7a, 0xe3, 0xe1, 0x8b, 0xc7, 0xd1, 0x03,
-	0xbb, 0x11, 0xbd, 0x15, 0xa9, 0x2c, 0x93, 0x45, 0x3c, 0x6a, 0xc9, 0xab, 0x2d, 0x78, 0x39, 0xf9,
-	0xb1, 0x0e, 0xbc, 0xdb, 0x75, 0xe0, 0xfd, 0x5a, 0x07, 0xde, 0xb7, 0x4d, 0xd0, 0xb9, 0xdd, 0x04,
-	0x9d, 0x9f, 0x9b, 0xa0, 0xf3, 0xe9, 0xe5, 0x5c, 0xba, 0xa2, 0x9a, 0x45, 0x29, 0x94, 0xe7, 0xda,
-	0x40, 0x56, 0xa5, 0xce, 0xa6, 0xf2, 0x9f, 0x15, 0xbb, 0xf9, 0xa3, 0x76, 0x2b, 0x2d, 0xec, 0x6c,
-	0x80, 0xeb, 0x76, 0xf1, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x33, 0x3f, 0xc3, 0xc8, 0x02, 0x00,
-	0x00,
+	// 618 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x5f, 0x6f, 0xd3, 0x3e,
+	0x14, 0x6d, 0xba, 0xb5, 0x5b, 0x6f, 0xb7, 0xfe, 0x2a, 0xb7, 0xbf, 0xcd, 0x6c, 0x90, 0x85, 0x49,
+	0x48, 0x15, 0x0f, 0x1d, 0x6c, 0x42, 0x42, 0x48, 0x3c, 0x74, 0x6b, 0x1e, 0x2a, 0x31, 0x5a, 0x65,
+	0x01, 0x24, 0x24, 0x64, 0x65, 0x89, 0xdb, 0x58, 0x34, 0x76, 0xb0, 0x9d, 0xfd, 0xe1, 0x53, 0xf0,
+	0xc0, 0x87, 0xe2, 0x71, 0x8f, 0x3c, 0xa2, 0xed, 0x8b, 0xa0, 0x38, 0x5d, 0xbb, 0xa1, 0xbd, 0x5d,
+	0x9f, 0x73, 0xcf, 0xbd, 0xc7, 0xd7, 0xd7, 0xb0, 0xc3, 0xf8, 0x98, 0x4a, 0xca, 0x43, 0xba, 0xb7,
+	0x88, 0x12, 0x11, 0xd1, 0x69, 0x37, 0x95, 0x42, 0x0b, 0xd4, 0x9a, 0xc3, 0xdd, 0x79, 0xb4, 0xe5,
+	0x3c, 0xa4, 0x4a, 0x03, 0x19, 0x24, 0xaa, 0x90, 0xed, 0xfe, 0xac, 0x40, 0xe5, 0x38, 0x2f, 0x83,
+	0x76, 0xa0, 0x9e, 0x4a, 0x91, 0x0a, 0x45, 0x23, 0x72, 0x7a, 0x89, 0x2d, 0xc7, 0xea, 0xd4, 0x3c,
+	0xb8, 0x85, 0x0e, 0x2f, 0x51, 0x03, 0xca, 0x2c, 0xc2, 0x65, 0x83, 0x97, 0x59, 0x84, 0xde, 0xc0,
+	0x56, 0xc6, 0x99, 0x56, 0x44, 0x8c, 0x49, 0x28, 0x92, 0x34, 0xd3, 0x94, 0xa4, 0x54, 0x12, 0x2d,
+	0xbe, 0x52, 0x8e, 0x97, 0x1c, 0xab, 0xb3, 0xec, 0x6d, 0x98, 0x8c, 0xe1, 0xf8, 0xa8, 0xe0, 0x47,
+	0x54, 0xfa, 0x39, 0x8b, 0x9e, 0x41, 0x23, 0x14, 0x5c, 0xd3, 0x0b, 0x4d, 0xce, 0x19, 0x8f, 0xc4,
+	0x39, 0x5e, 0x36, 0xf9, 0xeb, 0x33, 0xf4, 0x93, 0x01, 0xd1, 0x2e, 0xac, 0x7d, 0xcb, 0x02, 0xae,
+	0xd9, 0xf7, 0x40, 0x33, 0xc1, 0x71, 0xc5, 0x34, 0xbf, 0x87, 0xa1, 0x97, 0xf0, 0x7f, 0x28, 0x18,
+	0x57, 0xa6, 0x37, 0xe3, 0x69, 0xa6, 0x67, 0x0e, 0xaa, 0xa6, 0x22, 0x32, 0xe4, 0x88, 0xca, 0x41,
+	0x4e, 0x15, 0xdd, 0x0f, 0x60, 0x63, 0x21, 0x11, 0x99, 0x5e, 0x68, 0x56, 0x8c, 0xa6, 0x75, 0xab,
+	0x19, 0x1a, 0xae, 0x10, 0x6d, 0xc2, 0x4a, 0x3c, 0x26, 0x92, 0xa6, 0x02, 0xaf, 0x1a, 0x1b, 0xd5,
+	0x78, 0xec, 0xd1, 0x54, 0xa0, 0x6d, 0xa8, 0xc5, 0x66, 0x02, 0x09, 0xd3, 0xb8, 0x66, 0xa8, 0xd5,
+	0x38, 0xbf, 0x71, 0xc2, 0x34, 0x7a, 0x02, 0x60, 0x5e, 0x89, 0x04, 0x72, 0xa2, 0x30, 0x38, 0x4b,
+	0x9d, 0x9a, 0x57, 0x33, 0x48, 0x4f, 0x4e, 0x14, 0x6a, 0x41, 0xe5, 0x8c, 0xc8, 0x20, 0xc1, 0x75,
+	0xd3, 0x78, 0xf9, 0xcc, 0x0b, 0x12, 0xf4, 0x02, 0xda, 0x3a, 0x96, 0x22, 0x9b, 0xc4, 0xb9, 0xb1,
+	0xdc, 0x23, 0x17, 0x3c, 0xa4, 0x78, 0xad, 0xb8, 0xd0, 0x82, 0x1b, 0x51, 0xf9, 0x3e, 0x67, 0xd0,
+	0x10, 0xda, 0x67, 0xc1, 0x94, 0x45, 0x66, 0x22, 0x44, 0xc7, 0x92, 0xaa, 0x58, 0x4c, 0x23, 0xbc,
+	0xee, 0x58, 0x9d, 0xfa, 0xfe, 0xe3, 0xee, 0x03, 0xbb, 0xd1, 0xed, 0xd3, 0x90, 0x25, 0xc1, 0xd4,
+	0x6b, 0x2d, 0x94, 0xfe, 0xad, 0x10, 0xbd, 0x86, 0xaa, 0xd2, 0x81, 0xce, 0x14, 0x6e, 0x38, 0x56,
+	0xa7, 0xb1, 0xef, 0x3c, 0x58, 0xc2, 0x2c, 0xce, 0x89, 0xc9, 0xf3, 0x66, 0xf9, 0xe8, 0x29, 0xac,
+	0xa9, 0x8c, 0x2b, 0xaa, 0x09, 0x4d, 0x45, 0x18, 0xe3, 0xff, 0x8c, 0xe9, 0x7a, 0x81, 0xb9, 0x39,
+	0x54, 0xa4, 0x84, 0x21, 0x55, 0x4a, 0x48, 0xc2, 0x22, 0xdc, 0x34, 0x33, 0xab, 0xcf, 0xb1, 0x41,
+	0xb4, 0xfb, 0x16, 0xc0, 0x14, 0xef, 0x4d, 0x59, 0xa0, 0x50, 0x1b, 0x2a, 0x41, 0x1e, 0xcc, 0x96,
+	0xb2, 0x38, 0xa0, 0x47, 0xb0, 0x5a, 0x8c, 0x76, 0xbe, 0x95, 0x2b, 0xe6, 0x3c, 0x88, 0x9e, 0x7f,
+	0x81, 0xfa, 0x1d, 0x6f, 0x68, 0x13, 0x5a, 0xc7, 0xc3, 0xbe, 0xfb, 0x8e, 0x9c, 0xf8, 0x3d, 0xff,
+	0xc3, 0x09, 0xe9, 0x1d, 0xf9, 0x83, 0x8f, 0x6e, 0xb3, 0x84, 0xb6, 0x61, 0xf3, 0x1e, 0xd1, 0x77,
+	0x47, 0x9e, 0x7b, 0xd4, 0xf3, 0xdd, 0x7e, 0xd3, 0x42, 0x18, 0xda, 0xf7, 0x48, 0xcf, 0xf5, 0x07,
+	0x9e, 0xdb, 0x6f, 0x96, 0x0f, 0x87, 0xbf, 0xae, 0x6d, 0xeb, 0xea, 0xda, 0xb6, 0xfe, 0x5c, 0xdb,
+	0xd6, 0x8f, 0x1b, 0xbb, 0x74, 0x75, 0x63, 0x97, 0x7e, 0xdf, 0xd8, 0xa5, 0xcf, 0xaf, 0x26, 0x4c,
+	0xc7, 0xd9, 0x69, 0x37, 0x14, 0xc9, 0x5e, 0x2a, 0x45, 0x94, 0x85, 0x5a, 0x85, 0xec, 0x9f, 0x0f,
+	0x78, 0x71, 0x27, 0xd6, 0x97, 0x29, 0x55, 0xa7, 0x55, 0xf3, 0x19, 0x0f, 0xfe, 0x06, 0x00, 0x00,
+	0xff, 0xff, 0xc4, 0x24, 0xbc, 0x89, 0xe6, 0x03, 0x00, 0x00,
 }
 
 func (m *Model) Marshal() (dAtA []byte, err error) {
@@ -222,6 +343,25 @@ func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SuccessorId) > 0 {
+		i -= len(m.SuccessorId)
+		copy(dAtA[i:], m.SuccessorId)
+		i = encodeVarintModel(dAtA, i, uint64(len(m.SuccessorId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.SunsetEpoch != 0 {
+		i = encodeVarintModel(dAtA, i, uint64(m.SunsetEpoch))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Status != 0 {
+		i = encodeVarintModel(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.ValidationThreshold != nil {
 		{
 			size, err := m.ValidationThreshold.MarshalToSizedBuffer(dAtA[:i])
@@ -311,6 +451,43 @@ func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ModelAlias) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModelAlias) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModelAlias) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModelId) > 0 {
+		i -= len(m.ModelId)
+		copy(dAtA[i:], m.ModelId)
+		i = encodeVarintModel(dAtA, i, uint64(len(m.ModelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Alias) > 0 {
+		i -= len(m.Alias)
+		copy(dAtA[i:], m.Alias)
+		i = encodeVarintModel(dAtA, i, uint64(len(m.Alias)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintModel(dAtA []byte, offset int, v uint64) int {
 	offset -= sovModel(v)
 	base := offset
@@ -376,6 +553,33 @@ func (m *Model) Size() (n int) {
 		l = m.ValidationThreshold.Size()
 		n += 1 + l + sovModel(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovModel(uint64(m.Status))
+	}
+	if m.SunsetEpoch != 0 {
+		n += 1 + sovModel(uint64(m.SunsetEpoch))
+	}
+	l = len(m.SuccessorId)
+	if l > 0 {
+		n += 2 + l + sovModel(uint64(l))
+	}
+	return n
+}
+
+func (m *ModelAlias) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovModel(uint64(l))
+	}
+	l = len(m.ModelId)
+	if l > 0 {
+		n += 1 + l + sovModel(uint64(l))
+	}
 	return n
 }
 
@@ -756,6 +960,190 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ModelStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetEpoch", wireType)
+			}
+			m.SunsetEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SunsetEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuccessorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModelAlias) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModelAlias: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModelAlias: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModel(dAtA[iNdEx:])
@@ -0,0 +1,14 @@
+package types
+
+// IsRetiredAt reports whether the model is no longer assigned to participants in the given epoch,
+// either because it was retired or because its deprecation sunset has been reached.
+func (m *Model) IsRetiredAt(epochIndex uint64) bool {
+	switch m.Status {
+	case ModelStatus_MODEL_STATUS_RETIRED:
+		return true
+	case ModelStatus_MODEL_STATUS_DEPRECATED:
+		return m.SunsetEpoch > 0 && epochIndex >= m.SunsetEpoch
+	default:
+		return false
+	}
+}
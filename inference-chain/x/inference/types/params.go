@@ -104,6 +104,11 @@ func DefaultParams() Params {
 			UseParticipantAllowlist:                false, // disabled by default
 			ParticipantAllowlistUntilBlockHeight:   0,     // no cutoff
 		},
+		BridgeParams: &BridgeParams{
+			RequireReceiptProof:       true,
+			AutoPauseAnomalyThreshold: 3,
+			AnomalyWindowBlocks:       14400, // ~1 day at 6s blocks
+		},
 	}
 }
 
@@ -152,6 +157,11 @@ func DefaultValidationParams() *ValidationParams {
 		DowntimeReputationPreserve:     DecimalFromFloat(0.0),
 		QuickFailureThreshold:          DecimalFromFloat(0.000001),
 		BinomTestP0:                    DecimalFromFloat(0.10),
+		AppealWindowBlocks:             200,
+		AppealVotingPeriodBlocks:       400,
+		AppealBond:                     1_000_000_000,
+		AppealValidatorCount:           3,
+		FalseInvalidationSlashFraction: DecimalFromFloat(0.01),
 	}
 }
 
@@ -394,6 +404,15 @@ func (p Params) Validate() error {
 			return fmt.Errorf("participant allowlist until block height cannot be negative")
 		}
 	}
+
+	if p.BridgeParams != nil {
+		if p.BridgeParams.AnomalyWindowBlocks < 0 {
+			return fmt.Errorf("bridge anomaly window blocks cannot be negative")
+		}
+		if p.BridgeParams.AutoPauseAnomalyThreshold > 0 && p.BridgeParams.AnomalyWindowBlocks == 0 {
+			return fmt.Errorf("bridge anomaly window blocks must be positive when automatic pausing is enabled")
+		}
+	}
 	return nil
 }
 
@@ -455,6 +474,30 @@ func (p *ValidationParams) Validate() error {
 	if p.TimestampAdvance <= 0 {
 		return fmt.Errorf("timestamp advance must be positive")
 	}
+	// Appeal parameters are optional, a zero window disables appeals
+	if p.AppealWindowBlocks < 0 {
+		return fmt.Errorf("appeal window blocks cannot be negative")
+	}
+	if p.AppealWindowBlocks > 0 {
+		if p.AppealVotingPeriodBlocks <= 0 {
+			return fmt.Errorf("appeal voting period blocks must be positive when appeals are enabled")
+		}
+		if p.AppealValidatorCount <= 0 {
+			return fmt.Errorf("appeal validator count must be positive when appeals are enabled")
+		}
+	}
+	if p.AppealBond < 0 {
+		return fmt.Errorf("appeal bond cannot be negative")
+	}
+	if p.FalseInvalidationSlashFraction != nil {
+		fraction, err := p.FalseInvalidationSlashFraction.ToLegacyDec()
+		if err != nil {
+			return errors.Wrap(err, "invalid false_invalidation_slash_fraction")
+		}
+		if fraction.IsNegative() || fraction.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("false invalidation slash fraction must be between 0 and 1")
+		}
+	}
 	return nil
 }
 
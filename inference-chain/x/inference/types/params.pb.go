@@ -39,6 +39,7 @@ type Params struct {
 	GenesisGuardianParams   *GenesisGuardianParams   `protobuf:"bytes,10,opt,name=genesis_guardian_params,json=genesisGuardianParams,proto3" json:"genesis_guardian_params,omitempty"`
 	DeveloperAccessParams   *DeveloperAccessParams   `protobuf:"bytes,11,opt,name=developer_access_params,json=developerAccessParams,proto3" json:"developer_access_params,omitempty"`
 	ParticipantAccessParams *ParticipantAccessParams `protobuf:"bytes,12,opt,name=participant_access_params,json=participantAccessParams,proto3" json:"participant_access_params,omitempty"`
+	BridgeParams            *BridgeParams            `protobuf:"bytes,13,opt,name=bridge_params,json=bridgeParams,proto3" json:"bridge_params,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -158,6 +159,13 @@ func (m *Params) GetParticipantAccessParams() *ParticipantAccessParams {
 	return nil
 }
 
+func (m *Params) GetBridgeParams() *BridgeParams {
+	if m != nil {
+		return m.BridgeParams
+	}
+	return nil
+}
+
 type GenesisOnlyParams struct {
 	TotalSupply                             int64    `protobuf:"varint,1,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
 	OriginatorSupply                        int64    `protobuf:"varint,2,opt,name=originator_supply,json=originatorSupply,proto3" json:"originator_supply,omitempty"`
@@ -595,6 +603,11 @@ type ValidationParams struct {
 	DowntimeReputationPreserve     *Decimal `protobuf:"bytes,22,opt,name=downtime_reputation_preserve,json=downtimeReputationPreserve,proto3" json:"downtime_reputation_preserve,omitempty"`
 	QuickFailureThreshold          *Decimal `protobuf:"bytes,23,opt,name=quick_failure_threshold,json=quickFailureThreshold,proto3" json:"quick_failure_threshold,omitempty"`
 	BinomTestP0                    *Decimal `protobuf:"bytes,24,opt,name=binom_test_p0,json=binomTestP0,proto3" json:"binom_test_p0,omitempty"`
+	AppealWindowBlocks             int64    `protobuf:"varint,25,opt,name=appeal_window_blocks,json=appealWindowBlocks,proto3" json:"appeal_window_blocks,omitempty"`
+	AppealVotingPeriodBlocks       int64    `protobuf:"varint,26,opt,name=appeal_voting_period_blocks,json=appealVotingPeriodBlocks,proto3" json:"appeal_voting_period_blocks,omitempty"`
+	AppealBond                     int64    `protobuf:"varint,27,opt,name=appeal_bond,json=appealBond,proto3" json:"appeal_bond,omitempty"`
+	AppealValidatorCount           int32    `protobuf:"varint,28,opt,name=appeal_validator_count,json=appealValidatorCount,proto3" json:"appeal_validator_count,omitempty"`
+	FalseInvalidationSlashFraction *Decimal `protobuf:"bytes,29,opt,name=false_invalidation_slash_fraction,json=falseInvalidationSlashFraction,proto3" json:"false_invalidation_slash_fraction,omitempty"`
 }
 
 func (m *ValidationParams) Reset()         { *m = ValidationParams{} }
@@ -798,6 +811,41 @@ func (m *ValidationParams) GetBinomTestP0() *Decimal {
 	return nil
 }
 
+func (m *ValidationParams) GetAppealWindowBlocks() int64 {
+	if m != nil {
+		return m.AppealWindowBlocks
+	}
+	return 0
+}
+
+func (m *ValidationParams) GetAppealVotingPeriodBlocks() int64 {
+	if m != nil {
+		return m.AppealVotingPeriodBlocks
+	}
+	return 0
+}
+
+func (m *ValidationParams) GetAppealBond() int64 {
+	if m != nil {
+		return m.AppealBond
+	}
+	return 0
+}
+
+func (m *ValidationParams) GetAppealValidatorCount() int32 {
+	if m != nil {
+		return m.AppealValidatorCount
+	}
+	return 0
+}
+
+func (m *ValidationParams) GetFalseInvalidationSlashFraction() *Decimal {
+	if m != nil {
+		return m.FalseInvalidationSlashFraction
+	}
+	return nil
+}
+
 type PoCModelParams struct {
 	Dim              int32    `protobuf:"varint,1,opt,name=dim,proto3" json:"dim,omitempty"`
 	NLayers          int32    `protobuf:"varint,2,opt,name=n_layers,json=nLayers,proto3" json:"n_layers,omitempty"`
@@ -936,6 +984,8 @@ type PocParams struct {
 	PocDataPruningEpochThreshold uint64          `protobuf:"varint,3,opt,name=poc_data_pruning_epoch_threshold,json=pocDataPruningEpochThreshold,proto3" json:"poc_data_pruning_epoch_threshold,omitempty"`
 	WeightScaleFactor            *Decimal        `protobuf:"bytes,4,opt,name=weight_scale_factor,json=weightScaleFactor,proto3" json:"weight_scale_factor,omitempty"`
 	ModelParams                  *PoCModelParams `protobuf:"bytes,5,opt,name=model_params,json=modelParams,proto3" json:"model_params,omitempty"`
+	// batch_commitments_enabled lets participants submit PoC batches as a Merkle root and nonce count
+	BatchCommitmentsEnabled bool `protobuf:"varint,6,opt,name=batch_commitments_enabled,json=batchCommitmentsEnabled,proto3" json:"batch_commitments_enabled,omitempty"`
 }
 
 func (m *PocParams) Reset()         { *m = PocParams{} }
@@ -1006,6 +1056,13 @@ func (m *PocParams) GetModelParams() *PoCModelParams {
 	return nil
 }
 
+func (m *PocParams) GetBatchCommitmentsEnabled() bool {
+	if m != nil {
+		return m.BatchCommitmentsEnabled
+	}
+	return false
+}
+
 type Decimal struct {
 	Value    int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 	Exponent int32 `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
@@ -1743,6 +1800,71 @@ func (m *ParticipantAccessParams) GetParticipantAllowlistUntilBlockHeight() int6
 	return 0
 }
 
+// BridgeParams controls how deposits from origin chains are accepted.
+type BridgeParams struct {
+	// require_receipt_proof stops validator votes alone from completing a deposit. Deposits then complete only
+	// with a Merkle-Patricia receipt proof against a receipts root attested by a validator majority.
+	RequireReceiptProof bool `protobuf:"varint,1,opt,name=require_receipt_proof,json=requireReceiptProof,proto3" json:"require_receipt_proof,omitempty"`
+	// auto_pause_anomaly_threshold pauses an origin chain once this many of its deposits were held for exceeding a
+	// rate limit within anomaly_window_blocks. Zero disables automatic pausing.
+	AutoPauseAnomalyThreshold uint32 `protobuf:"varint,2,opt,name=auto_pause_anomaly_threshold,json=autoPauseAnomalyThreshold,proto3" json:"auto_pause_anomaly_threshold,omitempty"`
+	AnomalyWindowBlocks       int64  `protobuf:"varint,3,opt,name=anomaly_window_blocks,json=anomalyWindowBlocks,proto3" json:"anomaly_window_blocks,omitempty"`
+}
+
+func (m *BridgeParams) Reset()         { *m = BridgeParams{} }
+func (m *BridgeParams) String() string { return proto.CompactTextString(m) }
+func (*BridgeParams) ProtoMessage()    {}
+func (*BridgeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cf34332021bbe94, []int{16}
+}
+func (m *BridgeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeParams.Merge(m, src)
+}
+func (m *BridgeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeParams proto.InternalMessageInfo
+
+func (m *BridgeParams) GetRequireReceiptProof() bool {
+	if m != nil {
+		return m.RequireReceiptProof
+	}
+	return false
+}
+
+func (m *BridgeParams) GetAutoPauseAnomalyThreshold() uint32 {
+	if m != nil {
+		return m.AutoPauseAnomalyThreshold
+	}
+	return 0
+}
+
+func (m *BridgeParams) GetAnomalyWindowBlocks() int64 {
+	if m != nil {
+		return m.AnomalyWindowBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "inference.inference.Params")
 	proto.RegisterType((*GenesisOnlyParams)(nil), "inference.inference.GenesisOnlyParams")
@@ -1760,214 +1882,229 @@ func init() {
 	proto.RegisterType((*GenesisGuardianParams)(nil), "inference.inference.GenesisGuardianParams")
 	proto.RegisterType((*DeveloperAccessParams)(nil), "inference.inference.DeveloperAccessParams")
 	proto.RegisterType((*ParticipantAccessParams)(nil), "inference.inference.ParticipantAccessParams")
+	proto.RegisterType((*BridgeParams)(nil), "inference.inference.BridgeParams")
 }
 
 func init() { proto.RegisterFile("inference/inference/params.proto", fileDescriptor_3cf34332021bbe94) }
 
 var fileDescriptor_3cf34332021bbe94 = []byte{
-	// 3217 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x5a, 0x4b, 0x73, 0x1c, 0x37,
-	0x92, 0x36, 0x1f, 0x2d, 0x92, 0x49, 0x51, 0x6c, 0xa2, 0xd9, 0xec, 0xe6, 0x43, 0x14, 0x25, 0xdb,
-	0xbb, 0xf2, 0x4b, 0xf2, 0xca, 0xf6, 0x7a, 0xc3, 0x0f, 0x85, 0x29, 0x92, 0x7a, 0xac, 0x45, 0xab,
-	0xb7, 0x48, 0xd1, 0x6b, 0x85, 0x63, 0x2b, 0xd0, 0x55, 0xe8, 0x26, 0x82, 0x55, 0x40, 0xa9, 0x0a,
-	0xc5, 0x87, 0x7e, 0xc2, 0x6e, 0xc4, 0xc6, 0xfc, 0x84, 0xb9, 0xcd, 0x6d, 0xc2, 0x3f, 0x63, 0xe6,
-	0xe6, 0x89, 0x98, 0x83, 0x6f, 0x33, 0x61, 0x1f, 0xc6, 0xf7, 0xb9, 0x4f, 0x4c, 0x20, 0x81, 0x7a,
-	0x74, 0xb3, 0x49, 0x97, 0x2f, 0x8c, 0x2e, 0x64, 0x7e, 0x5f, 0xe2, 0x91, 0x99, 0x48, 0x00, 0x84,
-	0x0d, 0x2e, 0x7a, 0x2c, 0x66, 0xc2, 0x63, 0x77, 0x8b, 0x5f, 0x11, 0x8d, 0x69, 0x98, 0xdc, 0x89,
-	0x62, 0xa9, 0x24, 0x69, 0xe4, 0xed, 0x77, 0xf2, 0x5f, 0x2b, 0x0b, 0x34, 0xe4, 0x42, 0xde, 0xc5,
-	0xbf, 0x46, 0x6f, 0x65, 0xb1, 0x2f, 0xfb, 0x12, 0x7f, 0xde, 0xd5, 0xbf, 0x6c, 0xeb, 0xb2, 0x27,
-	0x93, 0x50, 0x26, 0xae, 0x11, 0x98, 0x0f, 0x23, 0xba, 0xf5, 0xf3, 0x34, 0x5c, 0xe9, 0xa0, 0x25,
-	0xb2, 0x05, 0x57, 0x59, 0x24, 0xbd, 0x43, 0xd7, 0x58, 0x6e, 0x8f, 0x6d, 0x8c, 0xdd, 0x9e, 0xbd,
-	0xb7, 0x71, 0x67, 0x84, 0xe9, 0x3b, 0x3b, 0x5a, 0xd1, 0xe0, 0x9c, 0x59, 0x56, 0x7c, 0x10, 0x07,
-	0x16, 0x8e, 0x69, 0xc0, 0x7d, 0xaa, 0xb8, 0x14, 0x19, 0xd3, 0x38, 0x32, 0xbd, 0x39, 0x92, 0xe9,
-	0x20, 0xd7, 0xb6, 0x74, 0xf5, 0xe3, 0xa1, 0x16, 0xf2, 0x39, 0x40, 0x24, 0xbd, 0x8c, 0x6c, 0x02,
-	0xc9, 0xd6, 0x47, 0x92, 0x75, 0xa4, 0x67, 0x59, 0x66, 0xa2, 0xec, 0xa7, 0xee, 0x92, 0x92, 0x47,
-	0x4c, 0xc8, 0x90, 0x7b, 0x49, 0xc6, 0x32, 0x79, 0x49, 0x97, 0xf6, 0x73, 0xed, 0xac, 0x4b, 0x6a,
-	0xa8, 0x45, 0x73, 0x7a, 0x32, 0x08, 0xa8, 0x62, 0x31, 0x0d, 0x32, 0xce, 0xda, 0x25, 0x9c, 0x5b,
-	0xb9, 0x76, 0xc6, 0xe9, 0x0d, 0xb5, 0x90, 0x6f, 0xa1, 0xd9, 0xe5, 0xca, 0x93, 0x5c, 0xb8, 0x31,
-	0x3b, 0xa1, 0xb1, 0x9f, 0xf1, 0x5e, 0x41, 0xde, 0xdb, 0x23, 0x79, 0x1f, 0x18, 0x84, 0x83, 0x00,
-	0x4b, 0xdd, 0xe8, 0x9e, 0x6f, 0x24, 0x2e, 0x2c, 0xf9, 0x67, 0x82, 0x86, 0xdc, 0x73, 0xa3, 0x98,
-	0x7b, 0x5c, 0xf4, 0x33, 0xfa, 0x29, 0xa4, 0x7f, 0x6b, 0x24, 0xfd, 0xb6, 0x81, 0x74, 0x0c, 0xc2,
-	0xf2, 0x2f, 0xfa, 0x23, 0x5a, 0x49, 0x17, 0x5a, 0x5d, 0x2a, 0xfc, 0x13, 0xee, 0xab, 0x43, 0x37,
-	0xe0, 0x21, 0x57, 0xf9, 0x64, 0x4f, 0xa3, 0x85, 0xb7, 0x47, 0x0f, 0x20, 0xc3, 0x3c, 0x45, 0x88,
-	0x35, 0xd1, 0xec, 0x8e, 0x6a, 0xd6, 0x36, 0x3c, 0x29, 0x7a, 0x3c, 0x0e, 0xad, 0x7f, 0x15, 0x6e,
-	0x31, 0x73, 0x89, 0x8d, 0xad, 0x12, 0xa6, 0x23, 0xb7, 0x32, 0x1b, 0xde, 0x40, 0xb3, 0x57, 0xd8,
-	0xe8, 0x33, 0xc1, 0x12, 0x9e, 0xb8, 0xfd, 0x94, 0xc6, 0x3e, 0xa7, 0xb9, 0x1f, 0xc3, 0x25, 0x36,
-	0x1e, 0x19, 0xcc, 0x23, 0x0b, 0xc9, 0x6c, 0xf4, 0x47, 0x35, 0x6b, 0x1b, 0x3e, 0x3b, 0x66, 0x81,
-	0x8c, 0x58, 0xec, 0x52, 0xcf, 0x63, 0x49, 0x3e, 0x57, 0xb3, 0x97, 0xd8, 0xd8, 0xce, 0x30, 0x9b,
-	0x08, 0xc9, 0x6c, 0xf8, 0xa3, 0x9a, 0xc9, 0x21, 0x2c, 0x47, 0x34, 0x56, 0xdc, 0xe3, 0x11, 0x15,
-	0x6a, 0xc8, 0xca, 0x55, 0xb4, 0xf2, 0xee, 0xe8, 0x20, 0x2a, 0x50, 0x03, 0x76, 0x5a, 0xd1, 0x68,
-	0xc1, 0x27, 0x6f, 0xfe, 0xfc, 0xdb, 0x1b, 0x63, 0xff, 0xfb, 0xb7, 0xef, 0xde, 0x5e, 0x2b, 0xb2,
-	0xd7, 0x69, 0x29, 0x93, 0x19, 0xb5, 0x5b, 0xdf, 0x4d, 0xc1, 0x82, 0x9d, 0xa5, 0x67, 0x22, 0x38,
-	0xb3, 0xdd, 0xbc, 0x09, 0x57, 0x95, 0x54, 0x34, 0x70, 0x93, 0x34, 0x8a, 0x82, 0x33, 0xcc, 0x3a,
-	0x13, 0xce, 0x2c, 0xb6, 0xed, 0x61, 0x13, 0x79, 0x07, 0x16, 0x64, 0xcc, 0xfb, 0x5c, 0x50, 0x25,
-	0xe3, 0x4c, 0x6f, 0x1c, 0xf5, 0xea, 0x85, 0xc0, 0x2a, 0xbf, 0xad, 0xa3, 0x3d, 0xca, 0x22, 0x88,
-	0x86, 0x32, 0x15, 0x0a, 0x73, 0xc6, 0x84, 0x33, 0xaf, 0x64, 0x64, 0x62, 0x62, 0x13, 0x9b, 0xc9,
-	0x87, 0xb0, 0x94, 0x28, 0x2a, 0x7c, 0xad, 0x39, 0x08, 0x98, 0x44, 0xc0, 0x62, 0x26, 0x1d, 0x40,
-	0x7d, 0x0a, 0x2b, 0x51, 0xcc, 0x74, 0x32, 0xed, 0xc7, 0x34, 0x0c, 0x99, 0xef, 0x26, 0x34, 0x60,
-	0x19, 0xb2, 0x86, 0xc8, 0x56, 0x14, 0xb3, 0x4e, 0xae, 0xb0, 0x47, 0x03, 0x66, 0xc1, 0x37, 0x60,
-	0xb6, 0xe8, 0x9e, 0x09, 0xed, 0x9a, 0x03, 0x79, 0xc7, 0x70, 0x3e, 0xcc, 0x08, 0x5d, 0x5f, 0xa7,
-	0x1c, 0x8c, 0xce, 0x19, 0x67, 0xd6, 0xb4, 0x6d, 0xeb, 0xa6, 0xa1, 0x21, 0x46, 0x2c, 0xe6, 0xd2,
-	0xc7, 0x18, 0x2b, 0x0f, 0xb1, 0x83, 0xcd, 0xe4, 0x5d, 0x20, 0x65, 0x5d, 0x7a, 0x26, 0x53, 0x65,
-	0x82, 0x65, 0x42, 0xa7, 0xb5, 0x4c, 0xd9, 0xb4, 0x93, 0xfb, 0xb0, 0x76, 0x5e, 0x5b, 0x5b, 0x70,
-	0x43, 0x2e, 0x58, 0x8c, 0x01, 0x30, 0xe1, 0xb4, 0x87, 0x71, 0x1d, 0x16, 0xef, 0x6a, 0x39, 0xf9,
-	0x08, 0x5a, 0x25, 0x7c, 0x48, 0x4f, 0x5d, 0x3f, 0x8d, 0x31, 0xbe, 0xd0, 0xaf, 0x27, 0x9c, 0xc5,
-	0x1c, 0xba, 0x4b, 0x4f, 0xb7, 0xad, 0x8c, 0x78, 0x70, 0x43, 0xeb, 0x72, 0xe1, 0xf3, 0x63, 0xee,
-	0xa7, 0x3a, 0xa3, 0xca, 0x13, 0x16, 0x6b, 0xc3, 0x1e, 0x13, 0x8a, 0xf6, 0x99, 0x75, 0xd8, 0xb5,
-	0x0b, 0xc2, 0xc2, 0xe3, 0x21, 0x0d, 0x9c, 0xb5, 0x90, 0x9e, 0x3e, 0xc9, 0x39, 0x3a, 0x9a, 0xa2,
-	0x93, 0x33, 0x90, 0xff, 0x80, 0xf6, 0xb9, 0xb8, 0x66, 0x82, 0x76, 0x03, 0xe6, 0xb7, 0xe7, 0x36,
-	0xc6, 0x6e, 0x4f, 0x3b, 0x4b, 0x43, 0xc1, 0xba, 0x63, 0xa4, 0xe4, 0x5b, 0x78, 0xe7, 0x1c, 0x52,
-	0x30, 0x75, 0x22, 0xe3, 0x23, 0x37, 0xa4, 0x2a, 0x8d, 0xb9, 0x3a, 0x73, 0xd5, 0x61, 0xcc, 0x92,
-	0x43, 0x19, 0xf8, 0xed, 0x6b, 0x38, 0xd2, 0x7f, 0x1d, 0x22, 0xfb, 0xca, 0x00, 0x76, 0xad, 0xfe,
-	0x7e, 0xa6, 0x4e, 0xbe, 0x85, 0xd5, 0x73, 0xec, 0x61, 0x1a, 0x28, 0x1e, 0x05, 0x9c, 0xc5, 0xed,
-	0xf9, 0x0a, 0x03, 0x5f, 0x1e, 0xb2, 0xb5, 0x9b, 0xc3, 0xc9, 0x67, 0xb0, 0x72, 0x8e, 0x9d, 0xfa,
-	0x7e, 0xcc, 0x92, 0x84, 0x25, 0xed, 0xfa, 0xc6, 0xc4, 0xed, 0x19, 0xa7, 0x3d, 0x04, 0xdf, 0xcc,
-	0xe4, 0xb7, 0xfe, 0x32, 0x09, 0xf5, 0xe1, 0xdd, 0x90, 0xbc, 0x80, 0x95, 0x24, 0xed, 0x26, 0xdc,
-	0x3f, 0x73, 0x63, 0xe6, 0xa7, 0x1e, 0x66, 0x62, 0x2e, 0x14, 0x8b, 0x8f, 0x69, 0x60, 0xab, 0x86,
-	0xcb, 0xfb, 0xdb, 0xb6, 0x78, 0x27, 0x83, 0x3f, 0xb1, 0x68, 0x72, 0x00, 0xed, 0xf3, 0xdc, 0x36,
-	0xb2, 0xc6, 0x2b, 0x30, 0x2f, 0x0d, 0x33, 0xdb, 0xb0, 0x7b, 0x01, 0x2b, 0x5e, 0x1a, 0xc7, 0x4c,
-	0x28, 0x37, 0xe3, 0x2f, 0x39, 0xd7, 0x44, 0x95, 0x3e, 0x5b, 0xfc, 0x9e, 0x81, 0x97, 0x1c, 0xeb,
-	0x1b, 0x58, 0x29, 0x67, 0x9c, 0x20, 0x90, 0x27, 0xcc, 0x77, 0x7b, 0x94, 0x07, 0x69, 0xcc, 0x6c,
-	0xa1, 0x71, 0x39, 0x77, 0xab, 0x48, 0x4c, 0x06, 0xfd, 0xd0, 0x80, 0xc9, 0xe7, 0xb0, 0xaa, 0xa9,
-	0x31, 0xf8, 0x70, 0xb3, 0x7b, 0x99, 0xd2, 0x80, 0xf7, 0xb8, 0x67, 0x62, 0xaa, 0x96, 0x87, 0x23,
-	0x86, 0x5f, 0x47, 0x7a, 0xff, 0x55, 0x96, 0x93, 0x3b, 0xd0, 0x40, 0x27, 0x3d, 0x66, 0x89, 0xc2,
-	0x0d, 0xdf, 0xa4, 0x0a, 0x9d, 0x74, 0x26, 0x9d, 0x05, 0x2d, 0x3a, 0x30, 0x12, 0x9b, 0x2c, 0xee,
-	0x41, 0xd3, 0x8e, 0x62, 0x08, 0x31, 0x85, 0x88, 0x86, 0x11, 0x0e, 0x62, 0x3e, 0x86, 0x76, 0xd1,
-	0xc5, 0x21, 0xd8, 0x34, 0xc2, 0x9a, 0x59, 0xff, 0x06, 0x80, 0x9f, 0x4c, 0xea, 0x5d, 0xe3, 0xd6,
-	0x3f, 0x6a, 0x30, 0x5b, 0x2a, 0x26, 0x75, 0xfa, 0x33, 0x45, 0x68, 0xc0, 0x44, 0x5f, 0x1d, 0x66,
-	0xdb, 0x01, 0xb6, 0x3d, 0xc5, 0x26, 0xf2, 0x16, 0xd4, 0x8d, 0x4a, 0x29, 0x4a, 0xcc, 0x6e, 0x30,
-	0x8f, 0xed, 0x25, 0xef, 0xbf, 0x01, 0x06, 0xe9, 0x26, 0x87, 0xbc, 0x97, 0x6d, 0x03, 0x80, 0x4d,
-	0x7b, 0xba, 0x85, 0x7c, 0x01, 0xd7, 0x7d, 0xd6, 0xa3, 0x69, 0xa0, 0xdc, 0x54, 0x70, 0xe5, 0xca,
-	0x9e, 0xeb, 0xc9, 0x30, 0x4a, 0x15, 0xc3, 0x2a, 0x89, 0xd9, 0x8d, 0x60, 0xd9, 0x2a, 0x3d, 0x17,
-	0x5c, 0x3d, 0xeb, 0x6d, 0x19, 0x0d, 0x5d, 0xfe, 0x30, 0x9d, 0x60, 0xf5, 0xc2, 0x24, 0xda, 0x15,
-	0x8a, 0x6c, 0x67, 0x56, 0xa6, 0x1e, 0x49, 0x6f, 0x4f, 0x0b, 0xf2, 0x4c, 0x77, 0x0f, 0x9a, 0x5a,
-	0x9b, 0x9d, 0x7a, 0x87, 0x54, 0x94, 0x01, 0x57, 0x10, 0xd0, 0x88, 0xa4, 0xb7, 0x63, 0x65, 0x39,
-	0xe6, 0x7d, 0x58, 0xd4, 0x98, 0x52, 0x59, 0xed, 0xb3, 0x80, 0x9e, 0xe1, 0xa2, 0x4c, 0x38, 0xda,
-	0x7a, 0x51, 0x43, 0x6f, 0x6b, 0x09, 0xf9, 0x77, 0x68, 0x0d, 0x23, 0x32, 0x3b, 0x66, 0x9b, 0x68,
-	0x0e, 0x82, 0x32, 0x4b, 0x1f, 0x43, 0x3b, 0x61, 0xca, 0x15, 0xec, 0x24, 0xc3, 0xca, 0x38, 0xb1,
-	0xd6, 0xcc, 0x96, 0xd1, 0x4c, 0x98, 0xfa, 0x8a, 0x9d, 0x1c, 0xe4, 0x52, 0x63, 0xf0, 0x3e, 0xac,
-	0xe6, 0x5e, 0x5d, 0x36, 0xeb, 0xa5, 0x4a, 0xf6, 0x7a, 0x76, 0xdb, 0x58, 0xce, 0x55, 0x0a, 0xd3,
-	0x5b, 0xa8, 0x40, 0x9e, 0xc0, 0xcd, 0x02, 0x1f, 0xc5, 0xa9, 0xd0, 0x4e, 0x64, 0x56, 0xae, 0xc8,
-	0xab, 0xb3, 0xe8, 0x4d, 0xeb, 0xb9, 0x62, 0xc7, 0xe8, 0xa1, 0xf7, 0x14, 0xe9, 0xf4, 0x1e, 0x34,
-	0xcf, 0x53, 0x85, 0xf4, 0x14, 0x77, 0x90, 0x09, 0xa7, 0x31, 0x0c, 0xdf, 0xa5, 0xa7, 0xe4, 0x5f,
-	0x60, 0x1e, 0x2b, 0xc9, 0x92, 0xf6, 0x1c, 0x6a, 0xcf, 0xe9, 0x53, 0x44, 0xa1, 0xf7, 0x14, 0x1a,
-	0xb8, 0xd6, 0x81, 0x54, 0x18, 0xe7, 0x36, 0x0c, 0xaf, 0x55, 0x08, 0xf1, 0x05, 0xed, 0x0a, 0x81,
-	0x54, 0x9b, 0x39, 0xcc, 0x06, 0xc0, 0xff, 0x5f, 0x83, 0xfa, 0xf0, 0x19, 0x48, 0x1b, 0xea, 0xd1,
-	0x20, 0x61, 0x6e, 0x24, 0x13, 0xae, 0xf8, 0x31, 0x73, 0x63, 0xaa, 0x58, 0xa5, 0xdc, 0xba, 0x80,
-	0xc0, 0x8e, 0xc5, 0x39, 0x54, 0x31, 0xbd, 0xac, 0xa1, 0x3e, 0x54, 0xd0, 0x30, 0x72, 0xd3, 0xc8,
-	0x0d, 0x19, 0x4d, 0xd2, 0x98, 0x85, 0x4c, 0x28, 0x73, 0x34, 0xab, 0x39, 0xcd, 0x90, 0x0b, 0x87,
-	0x86, 0xd1, 0xf3, 0x68, 0xb7, 0x24, 0x24, 0x9f, 0x02, 0x44, 0x34, 0x49, 0xf4, 0x8a, 0xa6, 0xd5,
-	0xb2, 0xe4, 0x8c, 0xd6, 0x3f, 0xd0, 0xea, 0xc4, 0x81, 0x25, 0x6d, 0xb5, 0xe4, 0x0d, 0xf4, 0x98,
-	0xc5, 0x3a, 0xdd, 0x56, 0x49, 0x89, 0x8b, 0x21, 0x17, 0xc5, 0xb4, 0x6c, 0x1a, 0x24, 0x72, 0xd2,
-	0xd3, 0x51, 0x9c, 0xb5, 0x4a, 0x9c, 0xf4, 0xf4, 0x3c, 0xe7, 0x3b, 0xb0, 0xc0, 0x4e, 0x23, 0x6e,
-	0x42, 0xc0, 0xed, 0x06, 0xd2, 0x3b, 0x4a, 0x6c, 0x38, 0xd6, 0x0b, 0xc1, 0x03, 0x6c, 0x27, 0xb7,
-	0x60, 0x0e, 0xdd, 0x32, 0x71, 0x95, 0x44, 0x3f, 0x99, 0x2a, 0xe5, 0xa7, 0x64, 0x5f, 0x6a, 0x2f,
-	0xd9, 0x82, 0xf5, 0x5e, 0x1a, 0x04, 0xe5, 0x5e, 0xaa, 0x98, 0xf6, 0x7a, 0xdc, 0xcb, 0xe2, 0xc1,
-	0x04, 0xe1, 0xaa, 0xd6, 0x2a, 0xfa, 0xb3, 0x6f, 0x74, 0x6c, 0x44, 0x9c, 0x9f, 0xbd, 0x43, 0x1a,
-	0xf4, 0x4e, 0x6c, 0x20, 0xfe, 0xba, 0xd9, 0x7b, 0x6c, 0x90, 0x64, 0x13, 0xae, 0x0f, 0x71, 0x0e,
-	0xf5, 0xcb, 0xc4, 0xe9, 0xca, 0x00, 0x78, 0x44, 0xb7, 0x92, 0xa4, 0xb4, 0x79, 0x66, 0xd8, 0xd9,
-	0x6a, 0xdd, 0x4a, 0x92, 0x62, 0xe7, 0xb4, 0x9c, 0x1d, 0x68, 0x22, 0x67, 0xcc, 0x5e, 0xa6, 0x2c,
-	0xc1, 0x7a, 0x53, 0xd0, 0x40, 0x9d, 0x55, 0xaa, 0xf9, 0x1a, 0x1a, 0xea, 0x58, 0x64, 0xc7, 0x00,
-	0xc9, 0xbf, 0xc1, 0xa2, 0xe2, 0x21, 0x4b, 0x94, 0xf6, 0xf8, 0x62, 0x0d, 0x6d, 0x50, 0x37, 0x72,
-	0xd9, 0x4e, 0x2e, 0xd2, 0x5e, 0x50, 0x40, 0xa8, 0x7f, 0x4c, 0x85, 0xc7, 0x6c, 0x25, 0x57, 0xcf,
-	0x05, 0x9b, 0xa6, 0x5d, 0xef, 0x1a, 0x7a, 0x2b, 0x0b, 0xa9, 0x62, 0x7e, 0x7e, 0xd4, 0x65, 0xb1,
-	0x71, 0x1e, 0xf7, 0xa8, 0x8b, 0x45, 0xdb, 0xa4, 0xb3, 0x9c, 0x2b, 0xd9, 0x43, 0x2c, 0x8b, 0xd1,
-	0x8d, 0xbe, 0xec, 0xea, 0xa2, 0x8f, 0x0b, 0x5c, 0x08, 0x37, 0x66, 0x51, 0xaa, 0xec, 0x71, 0x36,
-	0x66, 0x09, 0x8b, 0x8f, 0x59, 0xbb, 0x5e, 0xa5, 0xe8, 0xb3, 0x04, 0x4e, 0x8e, 0xef, 0x58, 0x38,
-	0xe9, 0xc3, 0xcd, 0x2e, 0xc5, 0xeb, 0x83, 0xfc, 0xf8, 0x67, 0x95, 0x8d, 0x1d, 0x4c, 0x26, 0x0b,
-	0x15, 0x6c, 0xac, 0x77, 0xa9, 0x5f, 0x3a, 0x0e, 0x3e, 0x29, 0x91, 0x60, 0x66, 0x39, 0x80, 0xf6,
-	0x00, 0x71, 0x39, 0x5d, 0x93, 0x2a, 0xe5, 0x5a, 0x19, 0xfd, 0xb8, 0x48, 0xe2, 0x07, 0xd0, 0xf6,
-	0xe5, 0x89, 0xd0, 0x13, 0xef, 0xf6, 0xa5, 0xf4, 0xcb, 0xc5, 0x5a, 0xa3, 0x0a, 0x6f, 0x86, 0x7e,
-	0x24, 0xa5, 0x5f, 0x2a, 0xd5, 0xf6, 0xa1, 0x95, 0xf3, 0xe2, 0x0c, 0x15, 0xb4, 0x8b, 0x15, 0x68,
-	0x9b, 0x19, 0xf8, 0x01, 0x2d, 0xb3, 0x7e, 0x05, 0x8b, 0x39, 0x6b, 0x79, 0x06, 0x9a, 0x15, 0x28,
-	0x49, 0x86, 0x2c, 0x8d, 0xfe, 0x7f, 0x60, 0x2d, 0xe7, 0x1b, 0xe5, 0x1d, 0x4b, 0x15, 0x78, 0x57,
-	0x32, 0x86, 0x11, 0xee, 0xb1, 0x0f, 0xad, 0x97, 0x29, 0xf7, 0x8e, 0xb2, 0x1a, 0xb5, 0xd4, 0xe5,
-	0x56, 0x95, 0x59, 0x40, 0xb0, 0x2d, 0x51, 0x8b, 0x5e, 0x7f, 0x01, 0x73, 0x5d, 0x2e, 0x64, 0xe8,
-	0x2a, 0x96, 0x28, 0x37, 0x7a, 0xbf, 0xdd, 0xae, 0xc0, 0x35, 0x8b, 0x90, 0x7d, 0x96, 0xa8, 0xce,
-	0xfb, 0x76, 0x43, 0xfc, 0x61, 0x02, 0xae, 0x75, 0xe4, 0xd6, 0xae, 0xf4, 0x59, 0x76, 0x33, 0x56,
-	0x87, 0x09, 0x9f, 0x87, 0xb8, 0xfd, 0xd5, 0x1c, 0xfd, 0x93, 0x2c, 0xc3, 0xb4, 0x70, 0x03, 0x7a,
-	0xc6, 0xe2, 0x6c, 0x0b, 0x9b, 0x12, 0x4f, 0xf1, 0x93, 0xb4, 0x60, 0x4a, 0xb8, 0x87, 0x8c, 0xfa,
-	0xe6, 0xaa, 0xb0, 0xe6, 0x5c, 0x11, 0x8f, 0xf5, 0x17, 0x59, 0x03, 0x10, 0xee, 0xd1, 0xb1, 0x95,
-	0x4d, 0xa2, 0x6c, 0x5a, 0x7c, 0x79, 0x6c, 0xa4, 0xd7, 0x01, 0x8e, 0xa5, 0x47, 0xbb, 0x6e, 0xc2,
-	0x5f, 0x99, 0xed, 0xa4, 0xe6, 0xcc, 0x60, 0xcb, 0x1e, 0x7f, 0xc5, 0xc8, 0x7f, 0x02, 0xe9, 0xf5,
-	0x84, 0xeb, 0xf3, 0xb0, 0x5c, 0x76, 0x5e, 0xa9, 0x30, 0xc4, 0x7a, 0xaf, 0x27, 0xb6, 0x79, 0x38,
-	0x58, 0x95, 0x5a, 0x0e, 0xe6, 0xca, 0x1e, 0x6e, 0x21, 0x35, 0x07, 0xb2, 0xa6, 0x67, 0x3d, 0xf2,
-	0x31, 0x4c, 0x0b, 0x19, 0x87, 0x2e, 0x8b, 0xb2, 0xbb, 0xb3, 0xcb, 0x4d, 0x4c, 0x69, 0xed, 0x9d,
-	0x08, 0x07, 0x11, 0xcb, 0x48, 0x2f, 0x28, 0x53, 0x14, 0x77, 0x8a, 0x9a, 0x33, 0xa3, 0x5b, 0xf6,
-	0x75, 0x83, 0xae, 0x73, 0xd2, 0x84, 0xb9, 0x89, 0x47, 0x03, 0xe6, 0xbb, 0xba, 0x1d, 0x53, 0xfe,
-	0xb4, 0x33, 0x97, 0x26, 0x6c, 0x0f, 0x5b, 0x1d, 0x19, 0x31, 0x3d, 0x85, 0x09, 0x7b, 0xa9, 0x4b,
-	0x70, 0x4c, 0xeb, 0x35, 0xe7, 0x4a, 0xc2, 0x5e, 0x3e, 0x65, 0xba, 0x40, 0x9c, 0x8e, 0x5d, 0x45,
-	0xe3, 0x3e, 0x53, 0x95, 0xb2, 0xf3, 0x54, 0xbc, 0x8f, 0xca, 0x76, 0x69, 0xff, 0x3c, 0x0e, 0x33,
-	0xc5, 0x45, 0xdb, 0x7b, 0x40, 0xb2, 0xda, 0xdb, 0xe7, 0x7a, 0x8f, 0x49, 0x75, 0xd2, 0x37, 0x8b,
-	0xbc, 0x60, 0x25, 0xdb, 0xb9, 0x80, 0x7c, 0x08, 0x4b, 0xa5, 0x4c, 0x93, 0xd0, 0x50, 0x4f, 0x1f,
-	0x2e, 0x96, 0x71, 0x80, 0xc5, 0x42, 0xba, 0x87, 0x42, 0x5c, 0xb7, 0x87, 0xb0, 0xa1, 0x4b, 0x36,
-	0x9f, 0x2a, 0x7a, 0x61, 0x61, 0x39, 0x81, 0xd9, 0x7a, 0x2d, 0x92, 0xde, 0x36, 0x55, 0x74, 0x74,
-	0x59, 0xf9, 0x14, 0x1a, 0x27, 0x8c, 0xf7, 0x0f, 0x95, 0x99, 0x3d, 0xb7, 0x47, 0x3d, 0x25, 0xe3,
-	0x4a, 0xa5, 0xcc, 0x82, 0x01, 0xe2, 0xfc, 0x3e, 0x44, 0x18, 0x79, 0x08, 0x57, 0x43, 0xed, 0xdf,
-	0x83, 0x37, 0xc7, 0xaf, 0x5f, 0x70, 0xa7, 0x5d, 0x8e, 0x05, 0x67, 0x36, 0x2c, 0x3e, 0xec, 0xb4,
-	0x6e, 0xc2, 0x94, 0xb5, 0x45, 0x16, 0xa1, 0x66, 0x8a, 0x35, 0x73, 0x6e, 0x32, 0x1f, 0x64, 0x05,
-	0xa6, 0xd9, 0x69, 0x24, 0x05, 0xb3, 0xa7, 0xe8, 0x9a, 0x93, 0x7f, 0x5b, 0x8a, 0xff, 0x9b, 0x84,
-	0xfa, 0xf0, 0x15, 0xb5, 0xde, 0xec, 0x93, 0x80, 0x26, 0x87, 0x6e, 0x2f, 0xa6, 0xd9, 0x29, 0x1f,
-	0x27, 0xb9, 0x52, 0x21, 0xba, 0x88, 0xd8, 0x87, 0x16, 0x6a, 0xb7, 0x0e, 0x9d, 0x7b, 0x86, 0x38,
-	0xb3, 0x44, 0x55, 0xe9, 0x7c, 0xdf, 0x1c, 0x20, 0xdd, 0xb6, 0x50, 0x12, 0xc2, 0x1b, 0x79, 0xc6,
-	0xd4, 0x05, 0x01, 0x2b, 0xa7, 0xf6, 0xa1, 0x95, 0xfe, 0x25, 0x13, 0x37, 0x33, 0xa6, 0x5d, 0x24,
-	0x2a, 0xf2, 0x7c, 0xe1, 0x0c, 0x1f, 0xc0, 0x52, 0x3f, 0xa6, 0xfa, 0x7c, 0x81, 0x47, 0x59, 0x97,
-	0x09, 0xdf, 0x38, 0x15, 0xfa, 0xc3, 0xa4, 0xd3, 0x40, 0xa9, 0x39, 0xe7, 0xee, 0x08, 0x1f, 0x5d,
-	0x89, 0x3c, 0x86, 0x85, 0x2e, 0x4d, 0x98, 0x6b, 0xdd, 0x08, 0xeb, 0x8e, 0x4a, 0x65, 0xeb, 0xbc,
-	0x86, 0x7d, 0x8d, 0x28, 0x47, 0x83, 0xc8, 0x37, 0xb0, 0x52, 0x7e, 0x7c, 0x60, 0x71, 0xc6, 0xa9,
-	0x8f, 0xb0, 0x95, 0x72, 0x52, 0xab, 0xf4, 0xf8, 0xc0, 0x62, 0xc3, 0xad, 0xcf, 0xb6, 0xd6, 0x1b,
-	0xfe, 0x3e, 0x01, 0x8d, 0x11, 0x0f, 0x0b, 0xe4, 0x0e, 0x34, 0x74, 0xfe, 0x18, 0x7c, 0xa5, 0x30,
-	0x0f, 0x45, 0xd3, 0xce, 0x42, 0x9a, 0xb0, 0x01, 0x50, 0xa2, 0x4f, 0xae, 0x5c, 0x70, 0xc5, 0x69,
-	0x60, 0x63, 0xce, 0x20, 0x70, 0xa5, 0x27, 0x1d, 0x62, 0x65, 0x38, 0x3d, 0x06, 0xa2, 0x4f, 0x1c,
-	0x3e, 0xf3, 0xe8, 0x99, 0x29, 0x51, 0x2a, 0x9d, 0x38, 0x50, 0x1f, 0xab, 0x91, 0xd7, 0x61, 0x2e,
-	0xbb, 0xeb, 0x2a, 0xaf, 0xc6, 0x55, 0xdb, 0x68, 0x96, 0xe1, 0x00, 0xda, 0xa9, 0xe2, 0x01, 0x7f,
-	0x65, 0xeb, 0x7d, 0x29, 0xd2, 0x24, 0x8b, 0xe6, 0x2a, 0xab, 0xb1, 0x54, 0x42, 0x3f, 0xd0, 0x60,
-	0x1b, 0xd2, 0xdf, 0xc0, 0x0a, 0x56, 0xfd, 0x9e, 0x34, 0xe7, 0x8a, 0x41, 0xe6, 0x4a, 0x8b, 0xa2,
-	0xf1, 0x5b, 0x16, 0x5e, 0xa6, 0x76, 0xe1, 0x3a, 0x96, 0x72, 0xf4, 0x22, 0xf6, 0xa9, 0x2a, 0x05,
-	0x81, 0xa5, 0x18, 0x61, 0xc0, 0xae, 0xfa, 0x77, 0x93, 0xb0, 0x38, 0xea, 0xbd, 0x47, 0x0f, 0x2d,
-	0x51, 0xb4, 0xcb, 0x03, 0xae, 0xce, 0xdc, 0x57, 0x52, 0x30, 0x37, 0xc0, 0xeb, 0xd9, 0xae, 0x4c,
-	0x45, 0xb5, 0x5c, 0xd0, 0xca, 0xf1, 0x2f, 0xa4, 0x60, 0x4f, 0x35, 0xfa, 0x81, 0x06, 0x8f, 0xa0,
-	0x4e, 0xa3, 0x28, 0xa7, 0x1e, 0xff, 0xd5, 0xd4, 0xcf, 0x35, 0xda, 0x50, 0x3f, 0x82, 0x3a, 0x5e,
-	0xe1, 0xb8, 0x2c, 0xa0, 0x89, 0xae, 0x60, 0xd5, 0x59, 0x25, 0x87, 0x9a, 0x47, 0xd4, 0x4e, 0x0e,
-	0x22, 0xf7, 0x61, 0xb5, 0xec, 0x31, 0x27, 0x5c, 0xf8, 0xf2, 0xa4, 0xb8, 0x51, 0x31, 0x4e, 0xb6,
-	0x5c, 0x52, 0xf9, 0x1a, 0x35, 0xf2, 0x5b, 0x95, 0xf7, 0xa0, 0xa1, 0x8f, 0x5d, 0x7a, 0x58, 0xf8,
-	0x8e, 0x68, 0x6f, 0x96, 0x6a, 0x88, 0xab, 0x87, 0x5c, 0x74, 0x58, 0x8c, 0x97, 0xac, 0xe6, 0x42,
-	0xe9, 0x2e, 0x2c, 0x62, 0x9e, 0x18, 0xd6, 0xb7, 0xb7, 0x76, 0x5a, 0x36, 0x08, 0xb8, 0x38, 0x1b,
-	0x4d, 0x5d, 0x9c, 0x8d, 0xee, 0xc3, 0xda, 0x00, 0x68, 0xd8, 0x9a, 0xb9, 0xba, 0x6b, 0x97, 0xa0,
-	0x03, 0x46, 0xad, 0xcb, 0xfc, 0x6e, 0x12, 0x9a, 0x23, 0x1f, 0xf0, 0x7e, 0xf9, 0x88, 0x34, 0xf6,
-	0x4b, 0x47, 0xa4, 0x27, 0x40, 0x8e, 0xba, 0x88, 0xe1, 0x22, 0x4a, 0x95, 0xe9, 0x5d, 0x25, 0x97,
-	0x98, 0x3f, 0xea, 0x76, 0x58, 0xfc, 0x44, 0xa3, 0xb0, 0xc7, 0xe4, 0x4b, 0x68, 0x58, 0x2a, 0x99,
-	0xaa, 0x82, 0xab, 0x8a, 0x37, 0xd4, 0x91, 0xeb, 0x19, 0xc2, 0x0c, 0xd9, 0x5d, 0x68, 0x94, 0x4f,
-	0x2d, 0x89, 0x19, 0x9d, 0x75, 0x03, 0x32, 0x20, 0xc2, 0x31, 0x99, 0xcb, 0xb1, 0x32, 0xc0, 0xd6,
-	0x2e, 0xf6, 0x92, 0xd4, 0xf8, 0xc1, 0xf2, 0x80, 0x8a, 0x29, 0x60, 0xec, 0x0d, 0xeb, 0x27, 0xb0,
-	0x3c, 0xc2, 0xa0, 0xeb, 0xa5, 0xfa, 0x2c, 0x60, 0xbc, 0xa2, 0x75, 0xde, 0xec, 0x96, 0x16, 0x93,
-	0xc7, 0xb0, 0x11, 0x72, 0xc1, 0xc3, 0x34, 0x74, 0x3d, 0x29, 0xb2, 0x2b, 0xf0, 0x01, 0x6d, 0xf4,
-	0x92, 0x39, 0x67, 0xdd, 0xea, 0x6d, 0xe5, 0x6a, 0xe5, 0xd3, 0x5e, 0x82, 0x97, 0x48, 0xf8, 0x46,
-	0x63, 0x67, 0xa8, 0xb4, 0x9c, 0xd9, 0x3d, 0x2f, 0x3e, 0xbf, 0x64, 0xe2, 0x6c, 0x25, 0xad, 0xa7,
-	0xfc, 0x7e, 0x1c, 0x9a, 0x23, 0x9f, 0x61, 0xc9, 0x23, 0xd8, 0x60, 0xa7, 0x11, 0xf3, 0xb4, 0xa3,
-	0x94, 0x5f, 0x64, 0x8d, 0x01, 0xe3, 0xc8, 0xc6, 0x59, 0xae, 0x67, 0x7a, 0x65, 0x22, 0x6d, 0xc8,
-	0xb8, 0xf4, 0x0e, 0xcc, 0xd3, 0x20, 0x3a, 0xa4, 0xa5, 0xfd, 0xbe, 0x8a, 0xb7, 0x5c, 0x43, 0x50,
-	0xb1, 0xb9, 0x6f, 0xc1, 0xb5, 0xc1, 0x0a, 0xa5, 0x92, 0x9f, 0xcc, 0x0d, 0x14, 0x26, 0x7a, 0xcd,
-	0xd2, 0xa8, 0x1f, 0x53, 0x1f, 0xdf, 0x09, 0x15, 0xf3, 0x4a, 0xa9, 0xc3, 0xde, 0x29, 0xb7, 0xac,
-	0x42, 0x27, 0x97, 0x9b, 0xbc, 0x61, 0x27, 0xec, 0x8f, 0x63, 0xd0, 0x1c, 0xf9, 0xa6, 0x4c, 0x3e,
-	0x83, 0x95, 0x4b, 0x5e, 0x9f, 0x4c, 0xe1, 0xd7, 0x16, 0x17, 0x3d, 0x37, 0x7d, 0x0e, 0xab, 0xe7,
-	0xd0, 0x3a, 0x3d, 0x1d, 0x62, 0x11, 0x60, 0x2f, 0xd2, 0x87, 0xe1, 0xbb, 0x5c, 0x3c, 0x46, 0xb9,
-	0x2e, 0xda, 0x47, 0xbc, 0x23, 0x4d, 0xe0, 0x3b, 0xd2, 0x42, 0x7f, 0xf8, 0x01, 0x29, 0xab, 0x2e,
-	0xc7, 0xa0, 0x39, 0xf2, 0xed, 0x9a, 0xbc, 0x0b, 0x24, 0x15, 0x8a, 0x07, 0x36, 0x2f, 0xd8, 0x4e,
-	0x98, 0x31, 0xd4, 0x51, 0x82, 0x4e, 0x64, 0x8d, 0xdf, 0x87, 0xd5, 0xec, 0x79, 0xa5, 0xf4, 0x7c,
-	0x9e, 0xf7, 0x62, 0x1c, 0x7b, 0xb1, 0x6c, 0x55, 0x0a, 0x83, 0x43, 0xbd, 0xf9, 0xd3, 0x38, 0xb4,
-	0x2e, 0x78, 0xe3, 0x26, 0xff, 0x0d, 0x6f, 0x09, 0x76, 0x32, 0x70, 0x73, 0x12, 0xb3, 0x3e, 0x4f,
-	0x94, 0xbd, 0x1e, 0x4c, 0x14, 0x8d, 0xd5, 0x60, 0x37, 0xdf, 0x14, 0xec, 0xa4, 0x44, 0xe7, 0x94,
-	0xd4, 0xf7, 0xb4, 0xb6, 0xed, 0xfb, 0x03, 0xb8, 0x8e, 0x63, 0x64, 0x83, 0xf7, 0x32, 0xc3, 0xbd,
-	0x5f, 0xb5, 0x4a, 0xe5, 0x0e, 0x66, 0x2a, 0xe8, 0x55, 0x7a, 0x67, 0x28, 0xe3, 0xf5, 0x60, 0x03,
-	0x9e, 0x98, 0xc7, 0x8d, 0x69, 0xa7, 0x95, 0x26, 0xac, 0x8c, 0xcd, 0xc4, 0xe4, 0x00, 0x6e, 0x8f,
-	0xc4, 0xb9, 0x23, 0xe6, 0xdf, 0x38, 0xe8, 0x1b, 0xd1, 0x08, 0x9e, 0xe7, 0x43, 0x6b, 0x62, 0xe6,
-	0xf4, 0xc1, 0xb3, 0x3f, 0xfc, 0xb8, 0x3e, 0xf6, 0xfd, 0x8f, 0xeb, 0x63, 0x7f, 0xfd, 0x71, 0x7d,
-	0xec, 0x37, 0x3f, 0xad, 0xbf, 0xf6, 0xfd, 0x4f, 0xeb, 0xaf, 0xfd, 0xf0, 0xd3, 0xfa, 0x6b, 0x2f,
-	0x3e, 0xea, 0x73, 0x75, 0x98, 0x76, 0xef, 0x78, 0x32, 0xbc, 0x1b, 0xc5, 0xd2, 0x4f, 0x3d, 0x95,
-	0x78, 0x7c, 0xe8, 0x7f, 0x9d, 0xca, 0xff, 0x2d, 0xa0, 0xce, 0x22, 0x96, 0x74, 0xaf, 0xe0, 0xbf,
-	0x27, 0x7d, 0xf0, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xee, 0x72, 0xd6, 0x42, 0x1b, 0x25, 0x00,
-	0x00,
+	// 3445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x5a, 0x4b, 0x73, 0x1c, 0x47,
+	0x72, 0x16, 0x08, 0x80, 0x00, 0x12, 0x04, 0x39, 0xa8, 0xc1, 0x60, 0x06, 0x0f, 0x82, 0x8f, 0x5d,
+	0xd9, 0x7a, 0x2d, 0x29, 0x6b, 0x77, 0x2d, 0x87, 0x76, 0x45, 0x2f, 0x08, 0xf0, 0x65, 0x11, 0xe2,
+	0xb8, 0x01, 0x62, 0x2d, 0x85, 0xc2, 0x1d, 0x35, 0xdd, 0x35, 0x33, 0x15, 0xec, 0xae, 0x6a, 0x76,
+	0x57, 0xe3, 0xa1, 0x9f, 0x60, 0x5f, 0xf6, 0x27, 0xf8, 0xe6, 0x9b, 0x43, 0x7f, 0xc0, 0xe1, 0xab,
+	0x7d, 0x5b, 0xdf, 0xf6, 0x66, 0x87, 0x74, 0xb0, 0xef, 0xbe, 0x3b, 0x36, 0x2a, 0xab, 0xba, 0xbb,
+	0xa6, 0x31, 0x80, 0x7a, 0x2f, 0x08, 0x4c, 0x65, 0x7e, 0x5f, 0xd6, 0x23, 0x33, 0x2b, 0xab, 0xaa,
+	0xe1, 0x2e, 0x17, 0x43, 0x96, 0x32, 0x11, 0xb0, 0x87, 0xd5, 0x7f, 0x09, 0x4d, 0x69, 0x9c, 0x3d,
+	0x48, 0x52, 0xa9, 0x24, 0x69, 0x97, 0xed, 0x0f, 0xca, 0xff, 0x36, 0x57, 0x69, 0xcc, 0x85, 0x7c,
+	0x88, 0x7f, 0x8d, 0xde, 0xe6, 0xda, 0x48, 0x8e, 0x24, 0xfe, 0xfb, 0x50, 0xff, 0x67, 0x5b, 0x37,
+	0x02, 0x99, 0xc5, 0x32, 0xf3, 0x8d, 0xc0, 0xfc, 0x30, 0xa2, 0xfb, 0xdf, 0x2d, 0xc1, 0xf5, 0x3e,
+	0x5a, 0x22, 0x7b, 0x70, 0x83, 0x25, 0x32, 0x18, 0xfb, 0xc6, 0x72, 0x6f, 0xe6, 0xee, 0xcc, 0x7b,
+	0xcb, 0x9f, 0xdc, 0x7d, 0x30, 0xc5, 0xf4, 0x83, 0x27, 0x5a, 0xd1, 0xe0, 0xbc, 0x65, 0x56, 0xfd,
+	0x20, 0x1e, 0xac, 0x9e, 0xd0, 0x88, 0x87, 0x54, 0x71, 0x29, 0x0a, 0xa6, 0x6b, 0xc8, 0xf4, 0xee,
+	0x54, 0xa6, 0xe3, 0x52, 0xdb, 0xd2, 0xb5, 0x4e, 0x6a, 0x2d, 0xe4, 0x73, 0x80, 0x44, 0x06, 0x05,
+	0xd9, 0x2c, 0x92, 0xed, 0x4c, 0x25, 0xeb, 0xcb, 0xc0, 0xb2, 0x2c, 0x25, 0xc5, 0xbf, 0xba, 0x4b,
+	0x4a, 0xbe, 0x61, 0x42, 0xc6, 0x3c, 0xc8, 0x0a, 0x96, 0xb9, 0x2b, 0xba, 0x74, 0x54, 0x6a, 0x17,
+	0x5d, 0x52, 0xb5, 0x16, 0xcd, 0x19, 0xc8, 0x28, 0xa2, 0x8a, 0xa5, 0x34, 0x2a, 0x38, 0xe7, 0xaf,
+	0xe0, 0xdc, 0x2b, 0xb5, 0x0b, 0xce, 0xa0, 0xd6, 0x42, 0xbe, 0x81, 0xce, 0x80, 0xab, 0x40, 0x72,
+	0xe1, 0xa7, 0xec, 0x94, 0xa6, 0x61, 0xc1, 0x7b, 0x1d, 0x79, 0xdf, 0x9b, 0xca, 0xfb, 0xd8, 0x20,
+	0x3c, 0x04, 0x58, 0xea, 0xf6, 0xe0, 0x62, 0x23, 0xf1, 0x61, 0x3d, 0x3c, 0x17, 0x34, 0xe6, 0x81,
+	0x9f, 0xa4, 0x3c, 0xe0, 0x62, 0x54, 0xd0, 0x2f, 0x20, 0xfd, 0xfb, 0x53, 0xe9, 0xf7, 0x0d, 0xa4,
+	0x6f, 0x10, 0x96, 0x7f, 0x2d, 0x9c, 0xd2, 0x4a, 0x06, 0xd0, 0x1d, 0x50, 0x11, 0x9e, 0xf2, 0x50,
+	0x8d, 0xfd, 0x88, 0xc7, 0x5c, 0x95, 0x93, 0xbd, 0x88, 0x16, 0x3e, 0x98, 0x3e, 0x80, 0x02, 0xf3,
+	0x12, 0x21, 0xd6, 0x44, 0x67, 0x30, 0xad, 0x59, 0xdb, 0x08, 0xa4, 0x18, 0xf2, 0x34, 0xb6, 0xfe,
+	0x55, 0xb9, 0xc5, 0xd2, 0x15, 0x36, 0xf6, 0x1c, 0x4c, 0x5f, 0xee, 0x15, 0x36, 0x82, 0x89, 0xe6,
+	0xa0, 0xb2, 0x31, 0x62, 0x82, 0x65, 0x3c, 0xf3, 0x47, 0x39, 0x4d, 0x43, 0x4e, 0x4b, 0x3f, 0x86,
+	0x2b, 0x6c, 0x3c, 0x33, 0x98, 0x67, 0x16, 0x52, 0xd8, 0x18, 0x4d, 0x6b, 0xd6, 0x36, 0x42, 0x76,
+	0xc2, 0x22, 0x99, 0xb0, 0xd4, 0xa7, 0x41, 0xc0, 0xb2, 0x72, 0xae, 0x96, 0xaf, 0xb0, 0xb1, 0x5f,
+	0x60, 0x76, 0x11, 0x52, 0xd8, 0x08, 0xa7, 0x35, 0x93, 0x31, 0x6c, 0x24, 0x34, 0x55, 0x3c, 0xe0,
+	0x09, 0x15, 0xaa, 0x66, 0xe5, 0x06, 0x5a, 0xf9, 0x68, 0x7a, 0x10, 0x55, 0xa8, 0x09, 0x3b, 0xdd,
+	0x64, 0xba, 0x80, 0x3c, 0x85, 0x95, 0x41, 0xca, 0xc3, 0x11, 0x2b, 0xd8, 0x57, 0x90, 0xfd, 0xde,
+	0xf4, 0xf5, 0x46, 0x4d, 0x4b, 0x79, 0x63, 0xe0, 0xfc, 0xfa, 0xec, 0xdd, 0xff, 0xfd, 0xa7, 0x3b,
+	0x33, 0xff, 0xf0, 0x3f, 0xdf, 0x7d, 0xb0, 0x5d, 0x65, 0xc1, 0x33, 0x27, 0x23, 0x1a, 0xb5, 0xfb,
+	0xdf, 0x2d, 0xc0, 0xaa, 0x9d, 0xed, 0x57, 0x22, 0x3a, 0xb7, 0x9d, 0xb8, 0x07, 0x37, 0x94, 0x54,
+	0x34, 0xf2, 0xb3, 0x3c, 0x49, 0xa2, 0x73, 0xcc, 0x5e, 0xb3, 0xde, 0x32, 0xb6, 0x1d, 0x62, 0x13,
+	0xf9, 0x10, 0x56, 0x65, 0xca, 0x47, 0x5c, 0x50, 0x25, 0xd3, 0x42, 0xef, 0x1a, 0xea, 0xb5, 0x2a,
+	0x81, 0x55, 0xfe, 0x40, 0x67, 0x8d, 0xa4, 0x88, 0x44, 0x1a, 0xcb, 0x5c, 0x28, 0xcc, 0x3d, 0xb3,
+	0xde, 0x2d, 0x25, 0x13, 0x13, 0x5b, 0xbb, 0xd8, 0x4c, 0x7e, 0x01, 0xeb, 0x99, 0xa2, 0x22, 0xd4,
+	0x9a, 0x93, 0x80, 0x39, 0x04, 0xac, 0x15, 0xd2, 0x09, 0xd4, 0xaf, 0x60, 0x33, 0x49, 0x99, 0x4e,
+	0xca, 0xa3, 0x94, 0xc6, 0x31, 0x0b, 0xfd, 0x8c, 0x46, 0xac, 0x40, 0xce, 0x23, 0xb2, 0x9b, 0xa4,
+	0xac, 0x5f, 0x2a, 0x1c, 0xd2, 0x88, 0x59, 0xf0, 0x1d, 0x58, 0xae, 0xba, 0x67, 0x52, 0xc4, 0xbc,
+	0x07, 0x65, 0xc7, 0x70, 0x3e, 0xcc, 0x08, 0xfd, 0x50, 0xa7, 0x2e, 0x8c, 0xf2, 0x25, 0x6f, 0xd9,
+	0xb4, 0xed, 0xeb, 0xa6, 0xda, 0x10, 0x13, 0x96, 0x72, 0x19, 0x62, 0xac, 0xba, 0x43, 0xec, 0x63,
+	0x33, 0xf9, 0x08, 0x88, 0xab, 0x4b, 0xcf, 0x65, 0xae, 0x4c, 0xd0, 0xcd, 0xea, 0xf4, 0x58, 0x28,
+	0x9b, 0x76, 0xf2, 0x08, 0xb6, 0x2f, 0x6a, 0x6b, 0x0b, 0x7e, 0xcc, 0x05, 0x4b, 0x31, 0x90, 0x66,
+	0xbd, 0x5e, 0x1d, 0xd7, 0x67, 0xe9, 0x81, 0x96, 0x93, 0x5f, 0x42, 0xd7, 0xc1, 0xc7, 0xf4, 0xcc,
+	0x0f, 0xf3, 0x14, 0xe3, 0x14, 0xe3, 0x63, 0xd6, 0x5b, 0x2b, 0xa1, 0x07, 0xf4, 0x6c, 0xdf, 0xca,
+	0x48, 0x00, 0x77, 0xb4, 0x2e, 0x17, 0x21, 0x3f, 0xe1, 0x61, 0xae, 0x33, 0xb3, 0x3c, 0x65, 0xa9,
+	0x36, 0x1c, 0x30, 0xa1, 0xe8, 0x88, 0x59, 0xc7, 0xdf, 0xbe, 0x24, 0xbc, 0x02, 0x1e, 0xd3, 0xc8,
+	0xdb, 0x8e, 0xe9, 0xd9, 0x8b, 0x92, 0xa3, 0xaf, 0x29, 0xfa, 0x25, 0x03, 0xf9, 0x2b, 0xe8, 0x5d,
+	0xc8, 0x0f, 0x4c, 0xd0, 0x41, 0xc4, 0x42, 0x74, 0xfc, 0x45, 0x6f, 0xbd, 0x16, 0xf4, 0x4f, 0x8c,
+	0x94, 0x7c, 0x03, 0x1f, 0x5e, 0x40, 0x0a, 0xa6, 0x4e, 0x65, 0xfa, 0xc6, 0x8f, 0xa9, 0xca, 0x53,
+	0xae, 0xce, 0x7d, 0x35, 0x4e, 0x59, 0x36, 0x96, 0x51, 0xd8, 0xbb, 0x89, 0x23, 0xfd, 0xf3, 0x1a,
+	0xd9, 0x97, 0x06, 0x70, 0x60, 0xf5, 0x8f, 0x0a, 0x75, 0xf2, 0x0d, 0x6c, 0x5d, 0x60, 0x8f, 0xf3,
+	0x48, 0xf1, 0x24, 0xe2, 0x2c, 0xed, 0xdd, 0x6a, 0x30, 0xf0, 0x8d, 0x9a, 0xad, 0x83, 0x12, 0x4e,
+	0x7e, 0x0d, 0x9b, 0x17, 0xd8, 0x69, 0x18, 0xa6, 0x2c, 0xcb, 0x58, 0xd6, 0x6b, 0xdd, 0x9d, 0x7d,
+	0x6f, 0xc9, 0xeb, 0xd5, 0xe0, 0xbb, 0x85, 0xfc, 0xfe, 0x7f, 0xcd, 0x41, 0xab, 0xbe, 0xab, 0x92,
+	0xaf, 0x61, 0x33, 0xcb, 0x07, 0x19, 0x0f, 0xcf, 0xfd, 0x94, 0x85, 0x79, 0x80, 0x19, 0x9d, 0x0b,
+	0xc5, 0xd2, 0x13, 0x1a, 0xd9, 0xea, 0xe3, 0xea, 0xfe, 0xf6, 0x2c, 0xde, 0x2b, 0xe0, 0x2f, 0x2c,
+	0x9a, 0x1c, 0x43, 0xef, 0x22, 0xb7, 0x8d, 0xac, 0x6b, 0x0d, 0x98, 0xd7, 0xeb, 0xcc, 0x36, 0xec,
+	0xbe, 0x86, 0xcd, 0x20, 0x4f, 0x53, 0x26, 0x94, 0x5f, 0xf0, 0x3b, 0xce, 0x35, 0xdb, 0xa4, 0xcf,
+	0x16, 0x7f, 0x68, 0xe0, 0x8e, 0x63, 0x7d, 0x05, 0x9b, 0x6e, 0xc6, 0x89, 0x22, 0x79, 0xca, 0x42,
+	0x7f, 0x48, 0x79, 0x94, 0xa7, 0xcc, 0x16, 0x2c, 0x57, 0x73, 0x77, 0xab, 0xc4, 0x64, 0xd0, 0x4f,
+	0x0d, 0x98, 0x7c, 0x0e, 0x5b, 0x9a, 0x1a, 0x83, 0x0f, 0x37, 0xcd, 0xb7, 0x39, 0x8d, 0xf8, 0x90,
+	0x07, 0x26, 0xa6, 0xe6, 0xcb, 0x70, 0xc4, 0xf0, 0xeb, 0xcb, 0xe0, 0x6f, 0x5d, 0x39, 0x79, 0x00,
+	0x6d, 0x74, 0xd2, 0x13, 0x96, 0x29, 0x2c, 0x1c, 0x4c, 0xaa, 0xd0, 0x49, 0x67, 0xce, 0x5b, 0xd5,
+	0xa2, 0x63, 0x23, 0xb1, 0xc9, 0xe2, 0x13, 0xe8, 0xd8, 0x51, 0xd4, 0x10, 0x0b, 0x88, 0x68, 0x1b,
+	0xe1, 0x24, 0xe6, 0x53, 0xe8, 0x55, 0x5d, 0xac, 0xc1, 0x16, 0x11, 0xd6, 0x29, 0xfa, 0x37, 0x01,
+	0xfc, 0x6c, 0x4e, 0xef, 0x1a, 0xf7, 0xff, 0x7f, 0x1e, 0x96, 0x9d, 0xa2, 0x54, 0xa7, 0x3f, 0x53,
+	0xcc, 0x46, 0x4c, 0x8c, 0xd4, 0xb8, 0xd8, 0x0e, 0xb0, 0xed, 0x25, 0x36, 0x91, 0xf7, 0xa1, 0x65,
+	0x54, 0x9c, 0x28, 0x31, 0xbb, 0xc1, 0x2d, 0x6c, 0x77, 0xbc, 0xff, 0x0e, 0x18, 0xa4, 0x9f, 0x8d,
+	0xf9, 0xb0, 0xd8, 0x06, 0x00, 0x9b, 0x0e, 0x75, 0x0b, 0xf9, 0x0d, 0xdc, 0x0e, 0xd9, 0x90, 0xe6,
+	0x91, 0xf2, 0x73, 0xc1, 0x95, 0x2f, 0x87, 0x7e, 0x20, 0xe3, 0x24, 0x57, 0x0c, 0xab, 0x2d, 0x66,
+	0x37, 0x82, 0x0d, 0xab, 0xf4, 0x5a, 0x70, 0xf5, 0x6a, 0xb8, 0x67, 0x34, 0x74, 0x19, 0xc5, 0x74,
+	0x82, 0xd5, 0x0b, 0x93, 0x69, 0x57, 0xa8, 0xb2, 0x9d, 0x59, 0x99, 0x56, 0x22, 0x83, 0x43, 0x2d,
+	0x28, 0x33, 0xdd, 0x27, 0xd0, 0xd1, 0xda, 0xec, 0x2c, 0x18, 0x53, 0xe1, 0x02, 0xae, 0x23, 0xa0,
+	0x9d, 0xc8, 0xe0, 0x89, 0x95, 0x95, 0x98, 0x8f, 0x61, 0x4d, 0x63, 0x9c, 0xf2, 0x3c, 0x64, 0x11,
+	0x3d, 0xc7, 0x45, 0x99, 0xf5, 0xb4, 0xf5, 0xaa, 0x16, 0xdf, 0xd7, 0x12, 0xf2, 0x97, 0xd0, 0xad,
+	0x23, 0x0a, 0x3b, 0x66, 0x9b, 0xe8, 0x4c, 0x82, 0x0a, 0x4b, 0x9f, 0x42, 0x2f, 0x63, 0xca, 0x17,
+	0xec, 0xb4, 0xc0, 0xca, 0x34, 0xb3, 0xd6, 0xcc, 0x96, 0xd1, 0xc9, 0x98, 0xfa, 0x92, 0x9d, 0x1e,
+	0x97, 0x52, 0x63, 0xf0, 0x11, 0x6c, 0x95, 0x5e, 0xed, 0x9a, 0x0d, 0x72, 0x25, 0x87, 0x43, 0xbb,
+	0x6d, 0x6c, 0x94, 0x2a, 0x95, 0xe9, 0x3d, 0x54, 0x20, 0x2f, 0xe0, 0x5e, 0x85, 0x4f, 0xd2, 0x5c,
+	0x68, 0x27, 0x32, 0x2b, 0x57, 0xe5, 0xd5, 0x65, 0xf4, 0xa6, 0x9d, 0x52, 0xb1, 0x6f, 0xf4, 0xd0,
+	0x7b, 0xaa, 0x74, 0xfa, 0x09, 0x74, 0x2e, 0x52, 0xc5, 0xf4, 0x0c, 0x77, 0x90, 0x59, 0xaf, 0x5d,
+	0x87, 0x1f, 0xd0, 0x33, 0xf2, 0x67, 0x70, 0x0b, 0x2b, 0x52, 0x47, 0x7b, 0x05, 0xb5, 0x57, 0xf4,
+	0x69, 0xa4, 0xd2, 0x7b, 0x09, 0x6d, 0x5c, 0xeb, 0x48, 0x2a, 0x8c, 0x73, 0x1b, 0x86, 0x37, 0x1b,
+	0x84, 0xf8, 0xaa, 0x76, 0x85, 0x48, 0xaa, 0xdd, 0x12, 0x66, 0x03, 0xe0, 0xdf, 0x5a, 0xd0, 0xaa,
+	0x9f, 0xa5, 0xb4, 0xa1, 0x21, 0x8d, 0x32, 0xe6, 0x27, 0x32, 0xe3, 0x8a, 0x9f, 0x30, 0x3f, 0xa5,
+	0x8a, 0x35, 0xca, 0xad, 0xab, 0x08, 0xec, 0x5b, 0x9c, 0x47, 0x15, 0xd3, 0xcb, 0x1a, 0xeb, 0xc3,
+	0x09, 0x8d, 0x13, 0x3f, 0x4f, 0xfc, 0x98, 0xd1, 0x2c, 0x4f, 0x59, 0xcc, 0x84, 0x32, 0x47, 0xbc,
+	0x79, 0xaf, 0x13, 0x73, 0xe1, 0xd1, 0x38, 0x79, 0x9d, 0x1c, 0x38, 0x42, 0xf2, 0x2b, 0x80, 0x84,
+	0x66, 0x99, 0x5e, 0xd1, 0xbc, 0x59, 0x96, 0x5c, 0xd2, 0xfa, 0xc7, 0x5a, 0x9d, 0x78, 0xb0, 0xae,
+	0xad, 0x3a, 0xde, 0x40, 0x4f, 0x58, 0xaa, 0xd3, 0x6d, 0x93, 0x94, 0xb8, 0x16, 0x73, 0x51, 0x4d,
+	0xcb, 0xae, 0x41, 0x22, 0x27, 0x3d, 0x9b, 0xc6, 0x39, 0xdf, 0x88, 0x93, 0x9e, 0x5d, 0xe4, 0xfc,
+	0x10, 0x56, 0xd9, 0x59, 0xc2, 0x4d, 0x08, 0xf8, 0x83, 0x48, 0x06, 0x6f, 0x32, 0x1b, 0x8e, 0xad,
+	0x4a, 0xf0, 0x18, 0xdb, 0xc9, 0x7d, 0x58, 0x41, 0xb7, 0xcc, 0x7c, 0x25, 0xd1, 0x4f, 0x16, 0x9c,
+	0xfc, 0x94, 0x1d, 0x49, 0xed, 0x25, 0x7b, 0xb0, 0x33, 0xcc, 0xa3, 0xc8, 0xed, 0xa5, 0x4a, 0xe9,
+	0x70, 0xc8, 0x83, 0x22, 0x1e, 0x4c, 0x10, 0x6e, 0x69, 0xad, 0xaa, 0x3f, 0x47, 0x46, 0xc7, 0x46,
+	0xc4, 0xc5, 0xd9, 0x1b, 0xd3, 0x68, 0x78, 0x6a, 0x03, 0xf1, 0x4f, 0x9b, 0xbd, 0xe7, 0x06, 0x49,
+	0x76, 0xe1, 0x76, 0x8d, 0xb3, 0xd6, 0x2f, 0x13, 0xa7, 0x9b, 0x13, 0xe0, 0x29, 0xdd, 0xca, 0x32,
+	0x67, 0xf3, 0x2c, 0xb0, 0xcb, 0xcd, 0xba, 0x95, 0x65, 0xd5, 0xce, 0x69, 0x39, 0xfb, 0xd0, 0x41,
+	0xce, 0x94, 0xbd, 0xcd, 0x59, 0x86, 0xf5, 0xa6, 0xa0, 0x91, 0x3a, 0x6f, 0x54, 0xf3, 0xb5, 0x35,
+	0xd4, 0xb3, 0xc8, 0xbe, 0x01, 0x92, 0xbf, 0x80, 0x35, 0xc5, 0x63, 0x96, 0x29, 0xed, 0xf1, 0xd5,
+	0x1a, 0xda, 0xa0, 0x6e, 0x97, 0xb2, 0x27, 0xa5, 0x48, 0x7b, 0x41, 0x05, 0xa1, 0xe1, 0x09, 0x15,
+	0x01, 0xb3, 0x95, 0x5c, 0xab, 0x14, 0xec, 0x9a, 0x76, 0xbd, 0x6b, 0xe8, 0xad, 0x2c, 0xa6, 0x8a,
+	0x85, 0xe5, 0x91, 0x99, 0xa5, 0xc6, 0x79, 0xfc, 0x37, 0x03, 0x2c, 0xda, 0xe6, 0xbc, 0x8d, 0x52,
+	0xc9, 0x1e, 0x86, 0x59, 0x8a, 0x6e, 0xf4, 0xc5, 0x40, 0x17, 0x7d, 0x5c, 0xe0, 0x42, 0xf8, 0x29,
+	0x4b, 0x72, 0x65, 0x8f, 0xc5, 0x29, 0xcb, 0x58, 0x7a, 0xc2, 0x7a, 0xad, 0x26, 0x45, 0x9f, 0x25,
+	0xf0, 0x4a, 0x7c, 0xdf, 0xc2, 0xc9, 0x08, 0xee, 0x0d, 0x28, 0x5e, 0x43, 0x94, 0xc7, 0x48, 0xab,
+	0x6c, 0xec, 0x60, 0x32, 0x59, 0x6d, 0x60, 0x63, 0x67, 0x40, 0x43, 0xe7, 0x58, 0xf9, 0xc2, 0x21,
+	0xc1, 0xcc, 0x72, 0x0c, 0xbd, 0x09, 0x62, 0x37, 0x5d, 0x93, 0x26, 0xe5, 0x9a, 0x8b, 0x7e, 0x5e,
+	0x25, 0xf1, 0x63, 0xe8, 0x85, 0xf2, 0x54, 0xe8, 0x89, 0xf7, 0x47, 0x52, 0x86, 0x6e, 0xb1, 0xd6,
+	0x6e, 0xc2, 0x5b, 0xa0, 0x9f, 0x49, 0x19, 0x3a, 0xa5, 0xda, 0x11, 0x74, 0x4b, 0x5e, 0x9c, 0xa1,
+	0x8a, 0x76, 0xad, 0x01, 0x6d, 0xa7, 0x00, 0x3f, 0xa6, 0x2e, 0xeb, 0x97, 0xb0, 0x56, 0xb2, 0xba,
+	0x33, 0xd0, 0x69, 0x40, 0x49, 0x0a, 0xa4, 0x33, 0xfa, 0xbf, 0x87, 0xed, 0x92, 0x6f, 0x9a, 0x77,
+	0xac, 0x37, 0xe0, 0xdd, 0x2c, 0x18, 0xa6, 0xb8, 0xc7, 0x11, 0x74, 0xdf, 0xe6, 0x3c, 0x78, 0x53,
+	0xd4, 0xa8, 0x4e, 0x97, 0xbb, 0x4d, 0x66, 0x01, 0xc1, 0xb6, 0x44, 0xad, 0x7a, 0xfd, 0x1b, 0x58,
+	0x19, 0x70, 0x21, 0x63, 0x5f, 0xb1, 0x4c, 0xf9, 0xc9, 0xc7, 0xbd, 0x5e, 0x03, 0xae, 0x65, 0x84,
+	0x1c, 0xb1, 0x4c, 0xf5, 0x3f, 0xd6, 0x85, 0x0e, 0x4d, 0x12, 0x46, 0x23, 0xff, 0x94, 0x8b, 0x50,
+	0x9e, 0x16, 0xc9, 0x78, 0xc3, 0x14, 0x3a, 0x46, 0xf6, 0x5b, 0x14, 0xd9, 0x74, 0xfc, 0x39, 0x6c,
+	0x59, 0xc4, 0x89, 0x74, 0x0a, 0xcf, 0x02, 0xb8, 0x69, 0xea, 0x63, 0xa3, 0x72, 0x2c, 0xab, 0xe2,
+	0xd3, 0xc2, 0xef, 0xc0, 0xb2, 0x85, 0x0f, 0xa4, 0x08, 0x7b, 0x5b, 0xa6, 0x3c, 0x34, 0x4d, 0x8f,
+	0xa5, 0x08, 0xc9, 0x2f, 0x60, 0xbd, 0xe0, 0x2f, 0x2a, 0x1e, 0x3f, 0xc0, 0xc3, 0xc8, 0x36, 0xee,
+	0x9b, 0xb6, 0xbf, 0x65, 0x39, 0xb4, 0x87, 0x87, 0x8d, 0x11, 0xdc, 0x33, 0xbb, 0xf7, 0x44, 0x6c,
+	0x64, 0x11, 0xcd, 0xc6, 0xfe, 0x30, 0xa5, 0x78, 0x2e, 0xe9, 0xdd, 0x6e, 0x12, 0x7e, 0x48, 0xe3,
+	0x46, 0xdd, 0xa1, 0x26, 0x79, 0x6a, 0x39, 0x6c, 0x05, 0xf1, 0x87, 0x59, 0xb8, 0xd9, 0x97, 0x7b,
+	0x07, 0x32, 0x64, 0xc5, 0x95, 0x64, 0x0b, 0x66, 0x43, 0x1e, 0x63, 0xbd, 0x30, 0xef, 0xe9, 0x7f,
+	0xc9, 0x06, 0x2c, 0x0a, 0x3f, 0xa2, 0xe7, 0x2c, 0x2d, 0xf6, 0xfc, 0x05, 0xf1, 0x12, 0x7f, 0x92,
+	0x2e, 0x2c, 0x08, 0x7f, 0xcc, 0x68, 0x68, 0xee, 0x68, 0xe7, 0xbd, 0xeb, 0xe2, 0xb9, 0xfe, 0x45,
+	0xb6, 0x01, 0x84, 0xff, 0xe6, 0xc4, 0xca, 0xe6, 0x50, 0xb6, 0x28, 0xbe, 0x38, 0x31, 0xd2, 0xdb,
+	0x00, 0x27, 0x32, 0xa0, 0x03, 0x3f, 0xe3, 0xdf, 0x9a, 0xfd, 0x77, 0xde, 0x5b, 0xc2, 0x96, 0x43,
+	0xfe, 0x2d, 0x23, 0x7f, 0x03, 0x64, 0x38, 0x14, 0x7e, 0xc8, 0x63, 0xb7, 0x4e, 0xbf, 0xde, 0x60,
+	0xd4, 0xad, 0xe1, 0x50, 0xec, 0xf3, 0x78, 0xb2, 0x8c, 0xb7, 0x1c, 0xcc, 0x97, 0x43, 0xdc, 0x73,
+	0xe7, 0x3d, 0x28, 0x9a, 0x5e, 0x0d, 0xc9, 0xa7, 0xb0, 0x28, 0x64, 0x1a, 0xfb, 0x2c, 0x29, 0x2e,
+	0x2d, 0xaf, 0x36, 0xb1, 0xa0, 0xb5, 0x9f, 0x24, 0x38, 0x88, 0x54, 0x26, 0x3a, 0x02, 0x98, 0xa2,
+	0xb8, 0xb5, 0xce, 0x7b, 0x4b, 0xba, 0xe5, 0x48, 0x37, 0xe8, 0xc2, 0x30, 0xcf, 0x98, 0x9f, 0x05,
+	0x34, 0x62, 0xa1, 0xaf, 0xdb, 0x71, 0x8f, 0x5c, 0xf4, 0x56, 0xf2, 0x8c, 0x1d, 0x62, 0xab, 0x27,
+	0x13, 0xa6, 0xa7, 0x30, 0x63, 0x6f, 0xf5, 0x99, 0x05, 0xf7, 0xc1, 0x79, 0xef, 0x7a, 0xc6, 0xde,
+	0xbe, 0x64, 0xba, 0xa2, 0x5e, 0x4c, 0x7d, 0x45, 0xd3, 0x11, 0x53, 0x8d, 0xb6, 0xb3, 0x85, 0xf4,
+	0x08, 0x95, 0xed, 0xd2, 0xfe, 0x6e, 0x16, 0x96, 0xaa, 0x1b, 0xce, 0x9f, 0x01, 0x29, 0x0e, 0x2b,
+	0x21, 0xd7, 0x9b, 0x72, 0xae, 0x77, 0x49, 0xb3, 0xc8, 0xab, 0x56, 0xb2, 0x5f, 0x0a, 0xb4, 0xf3,
+	0xba, 0xee, 0x47, 0x63, 0x3d, 0x7d, 0xb8, 0x58, 0xc6, 0x01, 0xd6, 0x1c, 0xb7, 0x42, 0x21, 0xae,
+	0xdb, 0x53, 0xb8, 0xab, 0x6b, 0xdc, 0x90, 0x2a, 0x7a, 0x69, 0x25, 0x3e, 0x8b, 0xdb, 0xdb, 0x76,
+	0x22, 0x83, 0x7d, 0xaa, 0xe8, 0xf4, 0x3a, 0xfc, 0x25, 0xb4, 0x4f, 0x19, 0x1f, 0x8d, 0x95, 0x99,
+	0x3d, 0x7f, 0x48, 0x03, 0x25, 0xd3, 0x46, 0xb5, 0xdf, 0xaa, 0x01, 0xe2, 0xfc, 0x3e, 0x45, 0x18,
+	0x79, 0x0a, 0x37, 0x62, 0xed, 0xdf, 0x93, 0x57, 0xf6, 0x3f, 0xb9, 0xe4, 0x31, 0xc1, 0x8d, 0x05,
+	0x6f, 0x39, 0x76, 0x02, 0xe3, 0x33, 0xd8, 0x18, 0x50, 0x15, 0x8c, 0xf5, 0x29, 0x2f, 0xe6, 0x0a,
+	0xcb, 0xdc, 0xf2, 0x16, 0xe8, 0x3a, 0x2e, 0x6d, 0x17, 0x15, 0xf6, 0x2a, 0xb9, 0xbd, 0x06, 0xb2,
+	0x4b, 0xb2, 0x0b, 0x0b, 0xb6, 0x9f, 0x64, 0x0d, 0xe6, 0x4d, 0x65, 0x6c, 0x0e, 0xa9, 0xe6, 0x07,
+	0xd9, 0x84, 0x45, 0x76, 0x96, 0x48, 0xc1, 0xec, 0x95, 0xc5, 0xbc, 0x57, 0xfe, 0xb6, 0x14, 0xff,
+	0x38, 0x07, 0xad, 0xfa, 0xbb, 0x82, 0xae, 0xac, 0x26, 0x33, 0x44, 0x91, 0x3d, 0x1a, 0x55, 0xfd,
+	0x6b, 0x99, 0x9b, 0x18, 0x6c, 0xc6, 0xd0, 0x89, 0xbe, 0xc6, 0x59, 0xec, 0x0a, 0x8d, 0x2e, 0x53,
+	0x3a, 0x13, 0xa4, 0xfb, 0x16, 0x4a, 0x62, 0xf8, 0x69, 0xb9, 0x3d, 0xe9, 0xea, 0x8b, 0xb9, 0xfb,
+	0x68, 0xcd, 0x4b, 0x7e, 0xcc, 0xc4, 0xbd, 0x82, 0xe9, 0x00, 0x89, 0xaa, 0x4d, 0xb5, 0x72, 0xa4,
+	0x9f, 0xc3, 0xfa, 0x28, 0xa5, 0xfa, 0x30, 0x67, 0x72, 0x3b, 0x13, 0xa1, 0x71, 0x48, 0xf4, 0xa5,
+	0x39, 0xaf, 0x8d, 0x52, 0x93, 0xd7, 0x9f, 0x88, 0x10, 0xdd, 0x90, 0x3c, 0x87, 0xd5, 0x01, 0xcd,
+	0x98, 0x6f, 0x5d, 0x10, 0x8b, 0xbc, 0x46, 0x67, 0x84, 0x5b, 0x1a, 0xf6, 0x5b, 0x44, 0x79, 0x1a,
+	0x44, 0xbe, 0x82, 0x4d, 0xf7, 0xc5, 0x88, 0xa5, 0x05, 0x67, 0x2e, 0xb8, 0x6a, 0x94, 0xcf, 0xba,
+	0xce, 0x8b, 0x11, 0x4b, 0x0d, 0xf7, 0x6b, 0xc1, 0x0b, 0x6f, 0xf8, 0xbf, 0x59, 0x68, 0x4f, 0x79,
+	0x0d, 0x22, 0x0f, 0xa0, 0xad, 0x73, 0xcf, 0xe4, 0xd3, 0x92, 0x79, 0xdd, 0x5b, 0xf4, 0x56, 0xf3,
+	0x8c, 0x4d, 0x80, 0x32, 0xbd, 0x7b, 0x72, 0xc1, 0x15, 0xa7, 0x91, 0x8d, 0x57, 0x83, 0xc0, 0x95,
+	0x9e, 0xf3, 0x88, 0x95, 0xe1, 0xf4, 0x18, 0x88, 0x3e, 0xde, 0x85, 0x2c, 0xa0, 0xe7, 0xa6, 0x1e,
+	0x6c, 0x74, 0xbc, 0x43, 0x7d, 0x2c, 0xfd, 0x7e, 0x02, 0x2b, 0xc5, 0xc5, 0xa2, 0xbb, 0x1a, 0x37,
+	0x6c, 0xa3, 0x59, 0x86, 0x63, 0xe8, 0xe5, 0x8a, 0x47, 0xfc, 0x5b, 0x7b, 0xb8, 0x92, 0x22, 0xcf,
+	0x8a, 0x4c, 0xd0, 0x64, 0x35, 0xd6, 0x1d, 0xf4, 0x63, 0x0d, 0xb6, 0xe9, 0xe0, 0x2b, 0xd8, 0xc4,
+	0x23, 0x56, 0x20, 0xcd, 0x21, 0x6e, 0x92, 0xb9, 0xd1, 0xa2, 0x68, 0xfc, 0x9e, 0x85, 0xbb, 0xd4,
+	0x3e, 0xdc, 0xc6, 0xba, 0x99, 0x5e, 0xc6, 0xbe, 0xd0, 0xa4, 0xfa, 0xb2, 0x14, 0x53, 0x0c, 0xd8,
+	0x55, 0xff, 0x6e, 0x0e, 0xd6, 0xa6, 0x3d, 0xd2, 0xe9, 0xa1, 0x65, 0x8a, 0x0e, 0x78, 0xc4, 0xd5,
+	0xb9, 0xff, 0xad, 0x14, 0xcc, 0x8f, 0xf0, 0x2e, 0x7c, 0x20, 0x73, 0xd1, 0x2c, 0x17, 0x74, 0x4b,
+	0xfc, 0xd7, 0x52, 0xb0, 0x97, 0x1a, 0xfd, 0x58, 0x83, 0xa7, 0x50, 0xe7, 0x49, 0x52, 0x52, 0x5f,
+	0xfb, 0x93, 0xa9, 0x5f, 0x6b, 0xb4, 0xa1, 0x7e, 0x06, 0x2d, 0xbc, 0x2f, 0xf3, 0x59, 0x44, 0x33,
+	0x7d, 0x5c, 0x50, 0xe7, 0x8d, 0x1c, 0xea, 0x16, 0xa2, 0x9e, 0x94, 0x20, 0xf2, 0x08, 0xb6, 0x5c,
+	0x8f, 0xb1, 0x85, 0x60, 0x79, 0x7d, 0x65, 0x9c, 0x6c, 0xc3, 0x51, 0x31, 0xf5, 0x60, 0x79, 0x85,
+	0xf5, 0x33, 0x68, 0xeb, 0x33, 0xae, 0x1e, 0x16, 0x3e, 0xfe, 0xda, 0x6b, 0xbc, 0x79, 0xc4, 0xb5,
+	0x62, 0x2e, 0xfa, 0x2c, 0xc5, 0x1b, 0x6d, 0x73, 0x7b, 0xf7, 0x10, 0xd6, 0x30, 0x4f, 0xd4, 0xf5,
+	0xed, 0x15, 0xa9, 0x96, 0x4d, 0x02, 0x2e, 0xcf, 0x46, 0x0b, 0x97, 0x67, 0xa3, 0x47, 0xb0, 0x3d,
+	0x01, 0xaa, 0x5b, 0x33, 0xf7, 0xa4, 0x3d, 0x07, 0x3a, 0x61, 0xd4, 0xba, 0xcc, 0x3f, 0xcf, 0x41,
+	0x67, 0xea, 0xab, 0xeb, 0x8f, 0x9f, 0x47, 0x67, 0x7e, 0xec, 0x3c, 0xfa, 0x02, 0xc8, 0x9b, 0x01,
+	0x62, 0xb8, 0x48, 0x72, 0x65, 0x7a, 0xd7, 0xc8, 0x25, 0x6e, 0xbd, 0x19, 0xf4, 0x59, 0xfa, 0x42,
+	0xa3, 0xb0, 0xc7, 0xe4, 0x0b, 0x68, 0x5b, 0x2a, 0x99, 0xab, 0x8a, 0xab, 0x89, 0x37, 0xb4, 0x90,
+	0xeb, 0x15, 0xc2, 0x0c, 0xd9, 0x43, 0x68, 0xbb, 0x45, 0x74, 0x66, 0x46, 0x67, 0xdd, 0x80, 0x4c,
+	0x88, 0x70, 0x4c, 0xe6, 0x26, 0xd2, 0x05, 0xd8, 0xba, 0xc7, 0xde, 0x48, 0x1b, 0x3f, 0xd8, 0x98,
+	0x50, 0x31, 0xc5, 0x8f, 0xbd, 0xce, 0xfe, 0x0c, 0x36, 0xa6, 0x18, 0xf4, 0x83, 0x5c, 0x1f, 0xbc,
+	0x8c, 0x57, 0x74, 0x2f, 0x9a, 0xdd, 0xd3, 0x62, 0xf2, 0x1c, 0xee, 0xc6, 0x5c, 0xf0, 0x38, 0x8f,
+	0xfd, 0x40, 0x8a, 0xe2, 0xbd, 0x61, 0x42, 0x1b, 0xbd, 0x64, 0xc5, 0xdb, 0xb1, 0x7a, 0x7b, 0xa5,
+	0x9a, 0x5b, 0xe4, 0x67, 0x78, 0x63, 0x87, 0x0f, 0x62, 0x76, 0x86, 0x9c, 0xe5, 0x2c, 0x2e, 0xd5,
+	0xf1, 0xad, 0xab, 0x10, 0x17, 0x2b, 0x69, 0x3d, 0xe5, 0x5f, 0xae, 0x41, 0x67, 0xea, 0xdb, 0x39,
+	0x79, 0x06, 0x77, 0xd9, 0x59, 0xc2, 0x02, 0xed, 0x28, 0xee, 0x33, 0xba, 0x31, 0x60, 0x1c, 0xd9,
+	0x38, 0xcb, 0xed, 0x42, 0xcf, 0x25, 0xd2, 0x86, 0x8c, 0x4b, 0x3f, 0x81, 0x5b, 0x34, 0x4a, 0xc6,
+	0xd4, 0xd9, 0xef, 0x9b, 0x78, 0xcb, 0x4d, 0x04, 0x55, 0x9b, 0xfb, 0x1e, 0xdc, 0xac, 0x9d, 0x8b,
+	0x9a, 0xf8, 0xc9, 0xca, 0x44, 0x61, 0xa2, 0xd7, 0x2c, 0x4f, 0x46, 0x29, 0x0d, 0xf1, 0x51, 0x56,
+	0xb1, 0xc0, 0x49, 0x1d, 0xf6, 0x02, 0xbf, 0x6b, 0x15, 0xfa, 0xa5, 0xdc, 0xe4, 0x0d, 0x3b, 0x61,
+	0xff, 0x31, 0x03, 0x9d, 0xa9, 0x1f, 0x02, 0x90, 0x5f, 0xc3, 0xe6, 0x15, 0x4f, 0x7d, 0xa6, 0xf0,
+	0xeb, 0x89, 0xcb, 0xde, 0xf6, 0x3e, 0x87, 0xad, 0x0b, 0x68, 0x9d, 0x9e, 0xc6, 0x58, 0x04, 0xd8,
+	0x57, 0x8b, 0x3a, 0xfc, 0x80, 0x8b, 0xe7, 0x28, 0xd7, 0x05, 0xff, 0x94, 0x47, 0xbb, 0x59, 0x7c,
+	0xb4, 0x5b, 0x1d, 0xd5, 0x5f, 0xeb, 0x8a, 0xea, 0x72, 0x06, 0x3a, 0x53, 0x3f, 0x38, 0x20, 0x1f,
+	0x01, 0xc9, 0x85, 0xe2, 0x91, 0xcd, 0x0b, 0xb6, 0x13, 0x66, 0x0c, 0x2d, 0x94, 0xa0, 0x13, 0x59,
+	0xe3, 0x8f, 0x60, 0xab, 0x78, 0xcb, 0x72, 0xbe, 0x79, 0x28, 0x7b, 0x71, 0x0d, 0x7b, 0xb1, 0x61,
+	0x55, 0x2a, 0x83, 0xb5, 0xde, 0xfc, 0xe7, 0x35, 0xe8, 0x5e, 0xf2, 0x61, 0x02, 0xf9, 0x3b, 0x78,
+	0x5f, 0xb0, 0xd3, 0x89, 0x6b, 0xaa, 0x94, 0x8d, 0x78, 0xa6, 0xec, 0x5d, 0x6c, 0xa6, 0x68, 0xaa,
+	0x26, 0xbb, 0xf9, 0xae, 0x60, 0xa7, 0x0e, 0x9d, 0xe7, 0xa8, 0x1f, 0x6a, 0x6d, 0xdb, 0xf7, 0xc7,
+	0x70, 0x1b, 0xc7, 0xc8, 0x26, 0x2f, 0xc1, 0xea, 0xbd, 0xdf, 0xb2, 0x4a, 0x6e, 0x07, 0x0b, 0x15,
+	0xf4, 0xaa, 0x8c, 0x4d, 0xe2, 0xf5, 0x60, 0x23, 0x9e, 0x99, 0x97, 0xa4, 0x45, 0xaf, 0x9b, 0x67,
+	0xcc, 0xc5, 0x16, 0x62, 0x72, 0x0c, 0xef, 0x4d, 0xc5, 0xf9, 0x53, 0xe6, 0xdf, 0x38, 0xe8, 0x4f,
+	0x93, 0x29, 0x3c, 0xaf, 0x6b, 0x6b, 0x62, 0xe7, 0xf4, 0x5f, 0x67, 0xe0, 0x86, 0xfb, 0x39, 0x86,
+	0x79, 0xb7, 0x7b, 0x9b, 0xf3, 0x94, 0xf9, 0x29, 0x0b, 0x18, 0x4f, 0x94, 0x0e, 0x04, 0x39, 0xb4,
+	0xc5, 0x62, 0xdb, 0x0a, 0x3d, 0x23, 0xeb, 0x6b, 0x11, 0xf9, 0x6b, 0xd8, 0xa6, 0xb9, 0x92, 0x7e,
+	0x42, 0xf5, 0x28, 0xa9, 0x90, 0x31, 0x8d, 0xce, 0x6b, 0xd1, 0xbc, 0xe2, 0x6d, 0x68, 0x9d, 0xbe,
+	0x56, 0xd9, 0x35, 0x1a, 0x13, 0x0f, 0x2d, 0x05, 0x6a, 0xf2, 0xba, 0xc6, 0xbc, 0xb2, 0xb5, 0xad,
+	0xd0, 0xbd, 0xaf, 0x31, 0xfd, 0x7f, 0xfc, 0xea, 0xdf, 0xbf, 0xdf, 0x99, 0xf9, 0xfd, 0xf7, 0x3b,
+	0x33, 0xff, 0xfd, 0xfd, 0xce, 0xcc, 0xef, 0x7e, 0xd8, 0x79, 0xe7, 0xf7, 0x3f, 0xec, 0xbc, 0xf3,
+	0x87, 0x1f, 0x76, 0xde, 0xf9, 0xfa, 0x97, 0x23, 0xae, 0xc6, 0xf9, 0xe0, 0x41, 0x20, 0xe3, 0x87,
+	0x49, 0x2a, 0xc3, 0x3c, 0x50, 0x59, 0xc0, 0x6b, 0x1f, 0xd8, 0xb9, 0x9f, 0x96, 0xa8, 0xf3, 0x84,
+	0x65, 0x83, 0xeb, 0xf8, 0x4d, 0xdc, 0xcf, 0xff, 0x18, 0x00, 0x00, 0xff, 0xff, 0xdc, 0xc7, 0xf1,
+	0x00, 0x90, 0x27, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -2025,6 +2162,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.ParticipantAccessParams.Equal(that1.ParticipantAccessParams) {
 		return false
 	}
+	if !this.BridgeParams.Equal(that1.BridgeParams) {
+		return false
+	}
 	return true
 }
 func (this *TokenomicsParams) Equal(that interface{}) bool {
@@ -2226,6 +2366,21 @@ func (this *ValidationParams) Equal(that interface{}) bool {
 	if !this.BinomTestP0.Equal(that1.BinomTestP0) {
 		return false
 	}
+	if this.AppealWindowBlocks != that1.AppealWindowBlocks {
+		return false
+	}
+	if this.AppealVotingPeriodBlocks != that1.AppealVotingPeriodBlocks {
+		return false
+	}
+	if this.AppealBond != that1.AppealBond {
+		return false
+	}
+	if this.AppealValidatorCount != that1.AppealValidatorCount {
+		return false
+	}
+	if !this.FalseInvalidationSlashFraction.Equal(that1.FalseInvalidationSlashFraction) {
+		return false
+	}
 	return true
 }
 func (this *PoCModelParams) Equal(that interface{}) bool {
@@ -2319,6 +2474,9 @@ func (this *PocParams) Equal(that interface{}) bool {
 	if !this.ModelParams.Equal(that1.ModelParams) {
 		return false
 	}
+	if this.BatchCommitmentsEnabled != that1.BatchCommitmentsEnabled {
+		return false
+	}
 	return true
 }
 func (this *Decimal) Equal(that interface{}) bool {
@@ -2657,6 +2815,36 @@ func (this *ParticipantAccessParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *BridgeParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BridgeParams)
+	if !ok {
+		that2, ok := that.(BridgeParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RequireReceiptProof != that1.RequireReceiptProof {
+		return false
+	}
+	if this.AutoPauseAnomalyThreshold != that1.AutoPauseAnomalyThreshold {
+		return false
+	}
+	if this.AnomalyWindowBlocks != that1.AnomalyWindowBlocks {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2677,6 +2865,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BridgeParams != nil {
+		{
+			size, err := m.BridgeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.ParticipantAccessParams != nil {
 		{
 			size, err := m.ParticipantAccessParams.MarshalToSizedBuffer(dAtA[:i])
@@ -3165,6 +3365,48 @@ func (m *ValidationParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FalseInvalidationSlashFraction != nil {
+		{
+			size, err := m.FalseInvalidationSlashFraction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.AppealValidatorCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AppealValidatorCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.AppealBond != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AppealBond))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.AppealVotingPeriodBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AppealVotingPeriodBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.AppealWindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AppealWindowBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
 	if m.BinomTestP0 != nil {
 		{
 			size, err := m.BinomTestP0.MarshalToSizedBuffer(dAtA[:i])
@@ -3547,6 +3789,16 @@ func (m *PocParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BatchCommitmentsEnabled {
+		i--
+		if m.BatchCommitmentsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.ModelParams != nil {
 		{
 			size, err := m.ModelParams.MarshalToSizedBuffer(dAtA[:i])
@@ -4150,6 +4402,49 @@ func (m *ParticipantAccessParams) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *BridgeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AnomalyWindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AnomalyWindowBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AutoPauseAnomalyThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoPauseAnomalyThreshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequireReceiptProof {
+		i--
+		if m.RequireReceiptProof {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -4215,6 +4510,10 @@ func (m *Params) Size() (n int) {
 		l = m.ParticipantAccessParams.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.BridgeParams != nil {
+		l = m.BridgeParams.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -4464,6 +4763,22 @@ func (m *ValidationParams) Size() (n int) {
 		l = m.BinomTestP0.Size()
 		n += 2 + l + sovParams(uint64(l))
 	}
+	if m.AppealWindowBlocks != 0 {
+		n += 2 + sovParams(uint64(m.AppealWindowBlocks))
+	}
+	if m.AppealVotingPeriodBlocks != 0 {
+		n += 2 + sovParams(uint64(m.AppealVotingPeriodBlocks))
+	}
+	if m.AppealBond != 0 {
+		n += 2 + sovParams(uint64(m.AppealBond))
+	}
+	if m.AppealValidatorCount != 0 {
+		n += 2 + sovParams(uint64(m.AppealValidatorCount))
+	}
+	if m.FalseInvalidationSlashFraction != nil {
+		l = m.FalseInvalidationSlashFraction.Size()
+		n += 2 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -4538,6 +4853,9 @@ func (m *PocParams) Size() (n int) {
 		l = m.ModelParams.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.BatchCommitmentsEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -4779,6 +5097,24 @@ func (m *ParticipantAccessParams) Size() (n int) {
 	return n
 }
 
+func (m *BridgeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequireReceiptProof {
+		n += 2
+	}
+	if m.AutoPauseAnomalyThreshold != 0 {
+		n += 1 + sovParams(uint64(m.AutoPauseAnomalyThreshold))
+	}
+	if m.AnomalyWindowBlocks != 0 {
+		n += 1 + sovParams(uint64(m.AnomalyWindowBlocks))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5246,6 +5582,42 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BridgeParams == nil {
+				m.BridgeParams = &BridgeParams{}
+			}
+			if err := m.BridgeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -7042,6 +7414,118 @@ func (m *ValidationParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealWindowBlocks", wireType)
+			}
+			m.AppealWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppealWindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealVotingPeriodBlocks", wireType)
+			}
+			m.AppealVotingPeriodBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppealVotingPeriodBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealBond", wireType)
+			}
+			m.AppealBond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppealBond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealValidatorCount", wireType)
+			}
+			m.AppealValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppealValidatorCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FalseInvalidationSlashFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FalseInvalidationSlashFraction == nil {
+				m.FalseInvalidationSlashFraction = &Decimal{}
+			}
+			if err := m.FalseInvalidationSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -7551,6 +8035,26 @@ func (m *PocParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchCommitmentsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BatchCommitmentsEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -9171,6 +9675,114 @@ func (m *ParticipantAccessParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BridgeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireReceiptProof", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireReceiptProof = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPauseAnomalyThreshold", wireType)
+			}
+			m.AutoPauseAnomalyThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoPauseAnomalyThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnomalyWindowBlocks", wireType)
+			}
+			m.AnomalyWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnomalyWindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ValidationErrors runs every params validator and collects all errors instead of stopping at the first one
+func (p Params) ValidationErrors() []string {
+	var errs []string
+	add := func(err error) {
+		if err == nil {
+			return
+		}
+		for _, existing := range errs {
+			if existing == err.Error() {
+				return
+			}
+		}
+		errs = append(errs, err.Error())
+	}
+
+	if p.ValidationParams != nil {
+		add(p.ValidationParams.Validate())
+	}
+	if p.TokenomicsParams != nil {
+		add(p.TokenomicsParams.Validate())
+	}
+	if p.BitcoinRewardParams != nil {
+		add(p.BitcoinRewardParams.Validate())
+	}
+	if p.EpochParams != nil {
+		add(p.EpochParams.Validate())
+	}
+	if p.CollateralParams != nil {
+		add(p.CollateralParams.Validate())
+	}
+	// Validate doesn't nil check dynamic pricing params, so a missing section is reported here and the remaining
+	// checks run against the defaults
+	if p.DynamicPricingParams == nil {
+		add(fmt.Errorf("dynamic pricing params cannot be nil"))
+		p.DynamicPricingParams = DefaultDynamicPricingParams()
+	}
+	add(p.DynamicPricingParams.Validate())
+	add(p.Validate())
+	return errs
+}
+
+// DiffParams lists the fields that differ between two params, using dotted proto field names
+func DiffParams(current, proposed Params) []ParamChange {
+	var changes []ParamChange
+	diffParamValues("", reflect.ValueOf(current), reflect.ValueOf(proposed), &changes)
+	return changes
+}
+
+var decimalPtrType = reflect.TypeOf(&Decimal{})
+
+func diffParamValues(path string, current, proposed reflect.Value, changes *[]ParamChange) {
+	t := current.Type()
+	if t.Kind() == reflect.Ptr && t != decimalPtrType {
+		if current.IsNil() && proposed.IsNil() {
+			return
+		}
+		diffParamValues(path, derefOrZero(current), derefOrZero(proposed), changes)
+		return
+	}
+
+	if t.Kind() == reflect.Struct {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name := protoFieldName(field)
+			if name == "" {
+				continue
+			}
+			if path != "" {
+				name = path + "." + name
+			}
+			diffParamValues(name, current.Field(i), proposed.Field(i), changes)
+		}
+		return
+	}
+
+	currentValue := formatParamValue(current)
+	proposedValue := formatParamValue(proposed)
+	if currentValue != proposedValue {
+		*changes = append(*changes, ParamChange{Field: path, Current: currentValue, Proposed: proposedValue})
+	}
+}
+
+func derefOrZero(v reflect.Value) reflect.Value {
+	if v.IsNil() {
+		return reflect.Zero(v.Type().Elem())
+	}
+	return v.Elem()
+}
+
+func protoFieldName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+	for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if name, ok := strings.CutPrefix(part, "name="); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+func formatParamValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return ""
+		}
+		return v.Interface().(*Decimal).ToDecimal().String()
+	case reflect.Slice, reflect.Map:
+		if v.Len() == 0 {
+			return ""
+		}
+		bz, err := json.Marshal(v.Interface())
+		if err != nil {
+			return fmt.Sprint(v.Interface())
+		}
+		return string(bz)
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
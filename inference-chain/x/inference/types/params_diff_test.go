@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestDiffParams(t *testing.T) {
+	current := types.DefaultParams()
+	require.Empty(t, types.DiffParams(current, types.DefaultParams()))
+
+	proposed := types.DefaultParams()
+	proposed.EpochParams.EpochLength = current.EpochParams.EpochLength * 2
+	proposed.ValidationParams.MissPercentageCutoff = types.DecimalFromFloat(0.5)
+	proposed.BandwidthLimitsParams = nil
+
+	changes := types.DiffParams(current, proposed)
+	fields := make(map[string]types.ParamChange)
+	for _, change := range changes {
+		fields[change.Field] = change
+	}
+	require.Equal(t, "0.5", fields["validation_params.miss_percentage_cutoff"].Proposed)
+	require.Equal(t, types.ParamChange{
+		Field:    "epoch_params.epoch_length",
+		Current:  "40",
+		Proposed: "80",
+	}, fields["epoch_params.epoch_length"])
+	for field, change := range fields {
+		if field == "epoch_params.epoch_length" || field == "validation_params.miss_percentage_cutoff" {
+			continue
+		}
+		require.Contains(t, field, "bandwidth_limits_params.")
+		require.NotEqual(t, change.Current, change.Proposed)
+	}
+}
+
+func TestParamsValidationErrors(t *testing.T) {
+	require.Empty(t, types.DefaultParams().ValidationErrors())
+
+	params := types.DefaultParams()
+	params.ValidationParams = nil
+	params.DynamicPricingParams = nil
+	errs := params.ValidationErrors()
+	require.Contains(t, errs, "dynamic pricing params cannot be nil")
+	require.Contains(t, errs, "validation params cannot be nil")
+}
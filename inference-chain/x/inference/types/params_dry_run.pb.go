@@ -0,0 +1,1180 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/inference/params_dry_run.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ParamChange is a single params field that differs between the current and the proposed params.
+type ParamChange struct {
+	Field    string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Current  string `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	Proposed string `protobuf:"bytes,3,opt,name=proposed,proto3" json:"proposed,omitempty"`
+}
+
+func (m *ParamChange) Reset()         { *m = ParamChange{} }
+func (m *ParamChange) String() string { return proto.CompactTextString(m) }
+func (*ParamChange) ProtoMessage()    {}
+func (*ParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44cc376d8c977d43, []int{0}
+}
+func (m *ParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChange.Merge(m, src)
+}
+func (m *ParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChange proto.InternalMessageInfo
+
+func (m *ParamChange) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *ParamChange) GetCurrent() string {
+	if m != nil {
+		return m.Current
+	}
+	return ""
+}
+
+func (m *ParamChange) GetProposed() string {
+	if m != nil {
+		return m.Proposed
+	}
+	return ""
+}
+
+// ParticipantTransitionDelta compares a participant's outcome of the simulated epoch transition
+// under the current and the proposed params.
+type ParticipantTransitionDelta struct {
+	Address        string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	CurrentWeight  int64  `protobuf:"varint,2,opt,name=current_weight,json=currentWeight,proto3" json:"current_weight,omitempty"`
+	ProposedWeight int64  `protobuf:"varint,3,opt,name=proposed_weight,json=proposedWeight,proto3" json:"proposed_weight,omitempty"`
+	CurrentReward  uint64 `protobuf:"varint,4,opt,name=current_reward,json=currentReward,proto3" json:"current_reward,omitempty"`
+	ProposedReward uint64 `protobuf:"varint,5,opt,name=proposed_reward,json=proposedReward,proto3" json:"proposed_reward,omitempty"`
+}
+
+func (m *ParticipantTransitionDelta) Reset()         { *m = ParticipantTransitionDelta{} }
+func (m *ParticipantTransitionDelta) String() string { return proto.CompactTextString(m) }
+func (*ParticipantTransitionDelta) ProtoMessage()    {}
+func (*ParticipantTransitionDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44cc376d8c977d43, []int{1}
+}
+func (m *ParticipantTransitionDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantTransitionDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantTransitionDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantTransitionDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantTransitionDelta.Merge(m, src)
+}
+func (m *ParticipantTransitionDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantTransitionDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantTransitionDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantTransitionDelta proto.InternalMessageInfo
+
+func (m *ParticipantTransitionDelta) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ParticipantTransitionDelta) GetCurrentWeight() int64 {
+	if m != nil {
+		return m.CurrentWeight
+	}
+	return 0
+}
+
+func (m *ParticipantTransitionDelta) GetProposedWeight() int64 {
+	if m != nil {
+		return m.ProposedWeight
+	}
+	return 0
+}
+
+func (m *ParticipantTransitionDelta) GetCurrentReward() uint64 {
+	if m != nil {
+		return m.CurrentReward
+	}
+	return 0
+}
+
+func (m *ParticipantTransitionDelta) GetProposedReward() uint64 {
+	if m != nil {
+		return m.ProposedReward
+	}
+	return 0
+}
+
+// EpochTransitionDryRun is the outcome of running the next epoch transition on a cached context,
+// once with the current params and once with the proposed params.
+type EpochTransitionDryRun struct {
+	UpcomingEpochIndex         uint64                        `protobuf:"varint,1,opt,name=upcoming_epoch_index,json=upcomingEpochIndex,proto3" json:"upcoming_epoch_index,omitempty"`
+	CurrentRewardAmount        int64                         `protobuf:"varint,2,opt,name=current_reward_amount,json=currentRewardAmount,proto3" json:"current_reward_amount,omitempty"`
+	ProposedRewardAmount       int64                         `protobuf:"varint,3,opt,name=proposed_reward_amount,json=proposedRewardAmount,proto3" json:"proposed_reward_amount,omitempty"`
+	CurrentTotalWeight         int64                         `protobuf:"varint,4,opt,name=current_total_weight,json=currentTotalWeight,proto3" json:"current_total_weight,omitempty"`
+	ProposedTotalWeight        int64                         `protobuf:"varint,5,opt,name=proposed_total_weight,json=proposedTotalWeight,proto3" json:"proposed_total_weight,omitempty"`
+	CurrentUnitOfComputePrice  uint64                        `protobuf:"varint,6,opt,name=current_unit_of_compute_price,json=currentUnitOfComputePrice,proto3" json:"current_unit_of_compute_price,omitempty"`
+	ProposedUnitOfComputePrice uint64                        `protobuf:"varint,7,opt,name=proposed_unit_of_compute_price,json=proposedUnitOfComputePrice,proto3" json:"proposed_unit_of_compute_price,omitempty"`
+	Participants               []*ParticipantTransitionDelta `protobuf:"bytes,8,rep,name=participants,proto3" json:"participants,omitempty"`
+}
+
+func (m *EpochTransitionDryRun) Reset()         { *m = EpochTransitionDryRun{} }
+func (m *EpochTransitionDryRun) String() string { return proto.CompactTextString(m) }
+func (*EpochTransitionDryRun) ProtoMessage()    {}
+func (*EpochTransitionDryRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44cc376d8c977d43, []int{2}
+}
+func (m *EpochTransitionDryRun) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochTransitionDryRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochTransitionDryRun.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochTransitionDryRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochTransitionDryRun.Merge(m, src)
+}
+func (m *EpochTransitionDryRun) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochTransitionDryRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochTransitionDryRun.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochTransitionDryRun proto.InternalMessageInfo
+
+func (m *EpochTransitionDryRun) GetUpcomingEpochIndex() uint64 {
+	if m != nil {
+		return m.UpcomingEpochIndex
+	}
+	return 0
+}
+
+func (m *EpochTransitionDryRun) GetCurrentRewardAmount() int64 {
+	if m != nil {
+		return m.CurrentRewardAmount
+	}
+	return 0
+}
+
+func (m *EpochTransitionDryRun) GetProposedRewardAmount() int64 {
+	if m != nil {
+		return m.ProposedRewardAmount
+	}
+	return 0
+}
+
+func (m *EpochTransitionDryRun) GetCurrentTotalWeight() int64 {
+	if m != nil {
+		return m.CurrentTotalWeight
+	}
+	return 0
+}
+
+func (m *EpochTransitionDryRun) GetProposedTotalWeight() int64 {
+	if m != nil {
+		return m.ProposedTotalWeight
+	}
+	return 0
+}
+
+func (m *EpochTransitionDryRun) GetCurrentUnitOfComputePrice() uint64 {
+	if m != nil {
+		return m.CurrentUnitOfComputePrice
+	}
+	return 0
+}
+
+func (m *EpochTransitionDryRun) GetProposedUnitOfComputePrice() uint64 {
+	if m != nil {
+		return m.ProposedUnitOfComputePrice
+	}
+	return 0
+}
+
+func (m *EpochTransitionDryRun) GetParticipants() []*ParticipantTransitionDelta {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ParamChange)(nil), "inference.inference.ParamChange")
+	proto.RegisterType((*ParticipantTransitionDelta)(nil), "inference.inference.ParticipantTransitionDelta")
+	proto.RegisterType((*EpochTransitionDryRun)(nil), "inference.inference.EpochTransitionDryRun")
+}
+
+func init() {
+	proto.RegisterFile("inference/inference/params_dry_run.proto", fileDescriptor_44cc376d8c977d43)
+}
+
+var fileDescriptor_44cc376d8c977d43 = []byte{
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x6f, 0x48, 0xbb, 0x0d, 0x0f, 0x86, 0xe4, 0xb5, 0x28, 0x54, 0x22, 0xaa, 0x2a, 0x21, 0x7a,
+	0x4a, 0xa7, 0x02, 0x77, 0xd8, 0xe0, 0xc0, 0x69, 0x55, 0x18, 0x42, 0x70, 0xb1, 0x3c, 0xc7, 0x6d,
+	0x2d, 0x35, 0xb6, 0xe5, 0xd8, 0xda, 0xfa, 0x16, 0x3c, 0x16, 0xc7, 0x9d, 0x10, 0x47, 0xd4, 0xbe,
+	0x02, 0x0f, 0x80, 0xe2, 0xd8, 0x59, 0x53, 0x75, 0x37, 0x7f, 0xdf, 0xef, 0xdf, 0xf7, 0x59, 0x36,
+	0x18, 0x31, 0x3e, 0xa3, 0x8a, 0x72, 0x42, 0xc7, 0xf7, 0x27, 0x89, 0x15, 0xce, 0x0b, 0x94, 0xa9,
+	0x15, 0x52, 0x86, 0x27, 0x52, 0x09, 0x2d, 0xe0, 0x69, 0x8d, 0x27, 0xf5, 0x69, 0xf8, 0x1d, 0x1c,
+	0x4f, 0x4b, 0xf2, 0xc5, 0x02, 0xf3, 0x39, 0x85, 0x5d, 0xd0, 0x99, 0x31, 0xba, 0xcc, 0xa2, 0x60,
+	0x10, 0x8c, 0x1e, 0xa7, 0x55, 0x01, 0x23, 0x70, 0x48, 0x8c, 0x52, 0x94, 0xeb, 0xe8, 0x91, 0xed,
+	0xfb, 0x12, 0xf6, 0xc1, 0x91, 0x54, 0x42, 0x8a, 0x82, 0x66, 0x51, 0x68, 0xa1, 0xba, 0x1e, 0xfe,
+	0x0e, 0x40, 0x7f, 0x8a, 0x95, 0x66, 0x84, 0x49, 0xcc, 0xf5, 0x95, 0xc2, 0xbc, 0x60, 0x9a, 0x09,
+	0xfe, 0x91, 0x2e, 0x35, 0x2e, 0x4d, 0x71, 0x96, 0x29, 0x5a, 0x14, 0x2e, 0xcc, 0x97, 0xf0, 0x15,
+	0x38, 0x71, 0xfe, 0xe8, 0x86, 0xb2, 0xf9, 0xa2, 0x4a, 0x0d, 0xd3, 0xa7, 0xae, 0xfb, 0xcd, 0x36,
+	0xe1, 0x6b, 0xf0, 0xcc, 0x67, 0x79, 0x5e, 0x68, 0x79, 0x27, 0xbe, 0xed, 0x88, 0x5b, 0x7e, 0x8a,
+	0xde, 0x60, 0x95, 0x45, 0xed, 0x41, 0x30, 0x6a, 0xd7, 0x7e, 0xa9, 0x6d, 0x36, 0xfc, 0x1c, 0xaf,
+	0x63, 0x79, 0xb5, 0x5f, 0x45, 0x1c, 0xfe, 0x0b, 0x41, 0xef, 0x93, 0x14, 0x64, 0xb1, 0xb5, 0x92,
+	0x5a, 0xa5, 0x86, 0xc3, 0x33, 0xd0, 0x35, 0x92, 0x88, 0x9c, 0xf1, 0x39, 0xa2, 0x25, 0x03, 0x31,
+	0x9e, 0xd1, 0x5b, 0xbb, 0x60, 0x3b, 0x85, 0x1e, 0xb3, 0xe2, 0xcf, 0x25, 0x02, 0x27, 0xa0, 0xd7,
+	0x9c, 0x0d, 0xe1, 0x5c, 0x18, 0xee, 0x57, 0x3e, 0x6d, 0x8c, 0xf8, 0xc1, 0x42, 0xf0, 0x2d, 0x78,
+	0xbe, 0x33, 0xa8, 0x17, 0x55, 0xfb, 0x77, 0x9b, 0xf3, 0x3a, 0xd5, 0x19, 0xe8, 0xfa, 0x24, 0x2d,
+	0x34, 0x5e, 0xfa, 0x3b, 0x6b, 0x5b, 0x0d, 0x74, 0xd8, 0x55, 0x09, 0xb9, 0x7b, 0x9b, 0x80, 0x5e,
+	0x9d, 0xd3, 0x90, 0x74, 0xaa, 0xd9, 0x3c, 0xb8, 0xad, 0x79, 0x0f, 0x5e, 0xfa, 0x14, 0xc3, 0x99,
+	0x46, 0x62, 0x86, 0x88, 0xc8, 0xa5, 0xd1, 0x14, 0x49, 0xc5, 0x08, 0x8d, 0x0e, 0xec, 0x55, 0xbc,
+	0x70, 0xa4, 0xaf, 0x9c, 0xe9, 0xcb, 0xd9, 0x45, 0xc5, 0x98, 0x96, 0x04, 0x78, 0x0e, 0xe2, 0x3a,
+	0x75, 0xbf, 0xc5, 0xa1, 0xb5, 0xe8, 0x7b, 0xd6, 0x1e, 0x8f, 0x2f, 0xe0, 0x89, 0xbc, 0x7f, 0x79,
+	0x45, 0x74, 0x34, 0x08, 0x47, 0xc7, 0x93, 0x71, 0xb2, 0xe7, 0x07, 0x24, 0x0f, 0x3f, 0xd1, 0xb4,
+	0x61, 0x72, 0x7e, 0xf9, 0x6b, 0x1d, 0x07, 0x77, 0xeb, 0x38, 0xf8, 0xbb, 0x8e, 0x83, 0x9f, 0x9b,
+	0xb8, 0x75, 0xb7, 0x89, 0x5b, 0x7f, 0x36, 0x71, 0xeb, 0xc7, 0xbb, 0x39, 0xd3, 0x0b, 0x73, 0x9d,
+	0x10, 0x91, 0x8f, 0xa5, 0x12, 0x99, 0x21, 0xba, 0x20, 0x6c, 0xe7, 0x4f, 0xde, 0x6e, 0x9d, 0xf5,
+	0x4a, 0xd2, 0xe2, 0xfa, 0xc0, 0xfe, 0xcb, 0x37, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x98,
+	0x89, 0x70, 0xc3, 0x03, 0x00, 0x00,
+}
+
+func (m *ParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposed) > 0 {
+		i -= len(m.Proposed)
+		copy(dAtA[i:], m.Proposed)
+		i = encodeVarintParamsDryRun(dAtA, i, uint64(len(m.Proposed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Current) > 0 {
+		i -= len(m.Current)
+		copy(dAtA[i:], m.Current)
+		i = encodeVarintParamsDryRun(dAtA, i, uint64(len(m.Current)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintParamsDryRun(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantTransitionDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantTransitionDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantTransitionDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposedReward != 0 {
+		i = encodeVarintParamsDryRun(dAtA, i, uint64(m.ProposedReward))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CurrentReward != 0 {
+		i = encodeVarintParamsDryRun(dAtA, i, uint64(m.CurrentReward))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProposedWeight != 0 {
+		i = encodeVarintParamsDryRun(dAtA, i, uint64(m.ProposedWeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentWeight != 0 {
+		i = encodeVarintParamsDryRun(dAtA, i, uint64(m.CurrentWeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParamsDryRun(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochTransitionDryRun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochTransitionDryRun) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochTransitionDryRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParamsDryRun(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ProposedUnitOfComputePrice != 0 {
+		i = encodeVarintParamsDryRun(dAtA, i, uint64(m.ProposedUnitOfComputePrice))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CurrentUnitOfComputePrice != 0 {
+		i = encodeVarintParamsDryRun(dAtA, i, uint64(m.CurrentUnitOfComputePrice))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ProposedTotalWeight != 0 {
+		i = encodeVarintParamsDryRun(dAtA, i, uint64(m.ProposedTotalWeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CurrentTotalWeight != 0 {
+		i = encodeVarintParamsDryRun(dAtA, i, uint64(m.CurrentTotalWeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProposedRewardAmount != 0 {
+		i = encodeVarintParamsDryRun(dAtA, i, uint64(m.ProposedRewardAmount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentRewardAmount != 0 {
+		i = encodeVarintParamsDryRun(dAtA, i, uint64(m.CurrentRewardAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UpcomingEpochIndex != 0 {
+		i = encodeVarintParamsDryRun(dAtA, i, uint64(m.UpcomingEpochIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParamsDryRun(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParamsDryRun(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovParamsDryRun(uint64(l))
+	}
+	l = len(m.Current)
+	if l > 0 {
+		n += 1 + l + sovParamsDryRun(uint64(l))
+	}
+	l = len(m.Proposed)
+	if l > 0 {
+		n += 1 + l + sovParamsDryRun(uint64(l))
+	}
+	return n
+}
+
+func (m *ParticipantTransitionDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParamsDryRun(uint64(l))
+	}
+	if m.CurrentWeight != 0 {
+		n += 1 + sovParamsDryRun(uint64(m.CurrentWeight))
+	}
+	if m.ProposedWeight != 0 {
+		n += 1 + sovParamsDryRun(uint64(m.ProposedWeight))
+	}
+	if m.CurrentReward != 0 {
+		n += 1 + sovParamsDryRun(uint64(m.CurrentReward))
+	}
+	if m.ProposedReward != 0 {
+		n += 1 + sovParamsDryRun(uint64(m.ProposedReward))
+	}
+	return n
+}
+
+func (m *EpochTransitionDryRun) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpcomingEpochIndex != 0 {
+		n += 1 + sovParamsDryRun(uint64(m.UpcomingEpochIndex))
+	}
+	if m.CurrentRewardAmount != 0 {
+		n += 1 + sovParamsDryRun(uint64(m.CurrentRewardAmount))
+	}
+	if m.ProposedRewardAmount != 0 {
+		n += 1 + sovParamsDryRun(uint64(m.ProposedRewardAmount))
+	}
+	if m.CurrentTotalWeight != 0 {
+		n += 1 + sovParamsDryRun(uint64(m.CurrentTotalWeight))
+	}
+	if m.ProposedTotalWeight != 0 {
+		n += 1 + sovParamsDryRun(uint64(m.ProposedTotalWeight))
+	}
+	if m.CurrentUnitOfComputePrice != 0 {
+		n += 1 + sovParamsDryRun(uint64(m.CurrentUnitOfComputePrice))
+	}
+	if m.ProposedUnitOfComputePrice != 0 {
+		n += 1 + sovParamsDryRun(uint64(m.ProposedUnitOfComputePrice))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovParamsDryRun(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParamsDryRun(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParamsDryRun(x uint64) (n int) {
+	return sovParamsDryRun(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParamsDryRun
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamsDryRun
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamsDryRun
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamsDryRun
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamsDryRun
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Current = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamsDryRun
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamsDryRun
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParamsDryRun(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParamsDryRun
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantTransitionDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParamsDryRun
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantTransitionDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantTransitionDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamsDryRun
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamsDryRun
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWeight", wireType)
+			}
+			m.CurrentWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentWeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedWeight", wireType)
+			}
+			m.ProposedWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedWeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReward", wireType)
+			}
+			m.CurrentReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedReward", wireType)
+			}
+			m.ProposedReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParamsDryRun(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParamsDryRun
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochTransitionDryRun) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParamsDryRun
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochTransitionDryRun: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochTransitionDryRun: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpcomingEpochIndex", wireType)
+			}
+			m.UpcomingEpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpcomingEpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRewardAmount", wireType)
+			}
+			m.CurrentRewardAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentRewardAmount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedRewardAmount", wireType)
+			}
+			m.ProposedRewardAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedRewardAmount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTotalWeight", wireType)
+			}
+			m.CurrentTotalWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTotalWeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedTotalWeight", wireType)
+			}
+			m.ProposedTotalWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedTotalWeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentUnitOfComputePrice", wireType)
+			}
+			m.CurrentUnitOfComputePrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentUnitOfComputePrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedUnitOfComputePrice", wireType)
+			}
+			m.ProposedUnitOfComputePrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedUnitOfComputePrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParamsDryRun
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamsDryRun
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &ParticipantTransitionDelta{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParamsDryRun(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParamsDryRun
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParamsDryRun(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParamsDryRun
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParamsDryRun
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParamsDryRun
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParamsDryRun
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParamsDryRun
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParamsDryRun        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParamsDryRun          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParamsDryRun = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,2015 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/inference/participant_status_explanation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SprtExplanation shows the state of a sequential probability ratio test.
+// The test fails once llr >= threshold and passes once llr <= -threshold.
+type SprtExplanation struct {
+	Llr       *Decimal `protobuf:"bytes,1,opt,name=llr,proto3" json:"llr,omitempty"`
+	Threshold *Decimal `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	P0        *Decimal `protobuf:"bytes,3,opt,name=p0,proto3" json:"p0,omitempty"`
+	P1        *Decimal `protobuf:"bytes,4,opt,name=p1,proto3" json:"p1,omitempty"`
+	Decision  string   `protobuf:"bytes,5,opt,name=decision,proto3" json:"decision,omitempty"`
+}
+
+func (m *SprtExplanation) Reset()         { *m = SprtExplanation{} }
+func (m *SprtExplanation) String() string { return proto.CompactTextString(m) }
+func (*SprtExplanation) ProtoMessage()    {}
+func (*SprtExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4bd909614bc56bd, []int{0}
+}
+func (m *SprtExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SprtExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SprtExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SprtExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SprtExplanation.Merge(m, src)
+}
+func (m *SprtExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SprtExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SprtExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SprtExplanation proto.InternalMessageInfo
+
+func (m *SprtExplanation) GetLlr() *Decimal {
+	if m != nil {
+		return m.Llr
+	}
+	return nil
+}
+
+func (m *SprtExplanation) GetThreshold() *Decimal {
+	if m != nil {
+		return m.Threshold
+	}
+	return nil
+}
+
+func (m *SprtExplanation) GetP0() *Decimal {
+	if m != nil {
+		return m.P0
+	}
+	return nil
+}
+
+func (m *SprtExplanation) GetP1() *Decimal {
+	if m != nil {
+		return m.P1
+	}
+	return nil
+}
+
+func (m *SprtExplanation) GetDecision() string {
+	if m != nil {
+		return m.Decision
+	}
+	return ""
+}
+
+// ParticipantStatusExplanation exposes the inputs and intermediate results behind a participant's
+// status and reputation for one epoch.
+type ParticipantStatusExplanation struct {
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	EpochIndex  uint64 `protobuf:"varint,2,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	// live is true when computed from the participant's current epoch stats. Past epochs are computed
+	// from the stored epoch performance summary, which has no SPRT or confirmation PoC state.
+	Live                          bool              `protobuf:"varint,3,opt,name=live,proto3" json:"live,omitempty"`
+	Status                        ParticipantStatus `protobuf:"varint,4,opt,name=status,proto3,enum=inference.inference.ParticipantStatus" json:"status,omitempty"`
+	StatusReason                  string            `protobuf:"bytes,5,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	InferenceCount                uint64            `protobuf:"varint,6,opt,name=inference_count,json=inferenceCount,proto3" json:"inference_count,omitempty"`
+	MissedRequests                uint64            `protobuf:"varint,7,opt,name=missed_requests,json=missedRequests,proto3" json:"missed_requests,omitempty"`
+	ValidatedInferences           uint64            `protobuf:"varint,8,opt,name=validated_inferences,json=validatedInferences,proto3" json:"validated_inferences,omitempty"`
+	InvalidatedInferences         uint64            `protobuf:"varint,9,opt,name=invalidated_inferences,json=invalidatedInferences,proto3" json:"invalidated_inferences,omitempty"`
+	ConsecutiveInvalidInferences  int64             `protobuf:"varint,10,opt,name=consecutive_invalid_inferences,json=consecutiveInvalidInferences,proto3" json:"consecutive_invalid_inferences,omitempty"`
+	ConsecutiveFailureProbability *Decimal          `protobuf:"bytes,11,opt,name=consecutive_failure_probability,json=consecutiveFailureProbability,proto3" json:"consecutive_failure_probability,omitempty"`
+	QuickFailureThreshold         *Decimal          `protobuf:"bytes,12,opt,name=quick_failure_threshold,json=quickFailureThreshold,proto3" json:"quick_failure_threshold,omitempty"`
+	InvalidationSprt              *SprtExplanation  `protobuf:"bytes,13,opt,name=invalidation_sprt,json=invalidationSprt,proto3" json:"invalidation_sprt,omitempty"`
+	DowntimeSprt                  *SprtExplanation  `protobuf:"bytes,14,opt,name=downtime_sprt,json=downtimeSprt,proto3" json:"downtime_sprt,omitempty"`
+	MissPercentage                *Decimal          `protobuf:"bytes,15,opt,name=miss_percentage,json=missPercentage,proto3" json:"miss_percentage,omitempty"`
+	MissPercentageCutoff          *Decimal          `protobuf:"bytes,16,opt,name=miss_percentage_cutoff,json=missPercentageCutoff,proto3" json:"miss_percentage_cutoff,omitempty"`
+	MissPercentageExceeded        bool              `protobuf:"varint,17,opt,name=miss_percentage_exceeded,json=missPercentageExceeded,proto3" json:"miss_percentage_exceeded,omitempty"`
+	DowntimePValue                *Decimal          `protobuf:"bytes,18,opt,name=downtime_p_value,json=downtimePValue,proto3" json:"downtime_p_value,omitempty"`
+	BinomTestP0                   *Decimal          `protobuf:"bytes,19,opt,name=binom_test_p0,json=binomTestP0,proto3" json:"binom_test_p0,omitempty"`
+	DowntimeTestPassed            bool              `protobuf:"varint,20,opt,name=downtime_test_passed,json=downtimeTestPassed,proto3" json:"downtime_test_passed,omitempty"`
+	ConfirmationPocRatio          *Decimal          `protobuf:"bytes,21,opt,name=confirmation_poc_ratio,json=confirmationPocRatio,proto3" json:"confirmation_poc_ratio,omitempty"`
+	ConfirmationPocAlphaThreshold *Decimal          `protobuf:"bytes,22,opt,name=confirmation_poc_alpha_threshold,json=confirmationPocAlphaThreshold,proto3" json:"confirmation_poc_alpha_threshold,omitempty"`
+	ConfirmationPocPassed         bool              `protobuf:"varint,23,opt,name=confirmation_poc_passed,json=confirmationPocPassed,proto3" json:"confirmation_poc_passed,omitempty"`
+	Reputation                    int64             `protobuf:"varint,24,opt,name=reputation,proto3" json:"reputation,omitempty"`
+	EpochsCompleted               uint32            `protobuf:"varint,25,opt,name=epochs_completed,json=epochsCompleted,proto3" json:"epochs_completed,omitempty"`
+	ExclusionReason               string            `protobuf:"bytes,26,opt,name=exclusion_reason,json=exclusionReason,proto3" json:"exclusion_reason,omitempty"`
+	ExclusionBlockHeight          uint64            `protobuf:"varint,27,opt,name=exclusion_block_height,json=exclusionBlockHeight,proto3" json:"exclusion_block_height,omitempty"`
+}
+
+func (m *ParticipantStatusExplanation) Reset()         { *m = ParticipantStatusExplanation{} }
+func (m *ParticipantStatusExplanation) String() string { return proto.CompactTextString(m) }
+func (*ParticipantStatusExplanation) ProtoMessage()    {}
+func (*ParticipantStatusExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4bd909614bc56bd, []int{1}
+}
+func (m *ParticipantStatusExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantStatusExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantStatusExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantStatusExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantStatusExplanation.Merge(m, src)
+}
+func (m *ParticipantStatusExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantStatusExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantStatusExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantStatusExplanation proto.InternalMessageInfo
+
+func (m *ParticipantStatusExplanation) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *ParticipantStatusExplanation) GetEpochIndex() uint64 {
+	if m != nil {
+		return m.EpochIndex
+	}
+	return 0
+}
+
+func (m *ParticipantStatusExplanation) GetLive() bool {
+	if m != nil {
+		return m.Live
+	}
+	return false
+}
+
+func (m *ParticipantStatusExplanation) GetStatus() ParticipantStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ParticipantStatus_UNSPECIFIED
+}
+
+func (m *ParticipantStatusExplanation) GetStatusReason() string {
+	if m != nil {
+		return m.StatusReason
+	}
+	return ""
+}
+
+func (m *ParticipantStatusExplanation) GetInferenceCount() uint64 {
+	if m != nil {
+		return m.InferenceCount
+	}
+	return 0
+}
+
+func (m *ParticipantStatusExplanation) GetMissedRequests() uint64 {
+	if m != nil {
+		return m.MissedRequests
+	}
+	return 0
+}
+
+func (m *ParticipantStatusExplanation) GetValidatedInferences() uint64 {
+	if m != nil {
+		return m.ValidatedInferences
+	}
+	return 0
+}
+
+func (m *ParticipantStatusExplanation) GetInvalidatedInferences() uint64 {
+	if m != nil {
+		return m.InvalidatedInferences
+	}
+	return 0
+}
+
+func (m *ParticipantStatusExplanation) GetConsecutiveInvalidInferences() int64 {
+	if m != nil {
+		return m.ConsecutiveInvalidInferences
+	}
+	return 0
+}
+
+func (m *ParticipantStatusExplanation) GetConsecutiveFailureProbability() *Decimal {
+	if m != nil {
+		return m.ConsecutiveFailureProbability
+	}
+	return nil
+}
+
+func (m *ParticipantStatusExplanation) GetQuickFailureThreshold() *Decimal {
+	if m != nil {
+		return m.QuickFailureThreshold
+	}
+	return nil
+}
+
+func (m *ParticipantStatusExplanation) GetInvalidationSprt() *SprtExplanation {
+	if m != nil {
+		return m.InvalidationSprt
+	}
+	return nil
+}
+
+func (m *ParticipantStatusExplanation) GetDowntimeSprt() *SprtExplanation {
+	if m != nil {
+		return m.DowntimeSprt
+	}
+	return nil
+}
+
+func (m *ParticipantStatusExplanation) GetMissPercentage() *Decimal {
+	if m != nil {
+		return m.MissPercentage
+	}
+	return nil
+}
+
+func (m *ParticipantStatusExplanation) GetMissPercentageCutoff() *Decimal {
+	if m != nil {
+		return m.MissPercentageCutoff
+	}
+	return nil
+}
+
+func (m *ParticipantStatusExplanation) GetMissPercentageExceeded() bool {
+	if m != nil {
+		return m.MissPercentageExceeded
+	}
+	return false
+}
+
+func (m *ParticipantStatusExplanation) GetDowntimePValue() *Decimal {
+	if m != nil {
+		return m.DowntimePValue
+	}
+	return nil
+}
+
+func (m *ParticipantStatusExplanation) GetBinomTestP0() *Decimal {
+	if m != nil {
+		return m.BinomTestP0
+	}
+	return nil
+}
+
+func (m *ParticipantStatusExplanation) GetDowntimeTestPassed() bool {
+	if m != nil {
+		return m.DowntimeTestPassed
+	}
+	return false
+}
+
+func (m *ParticipantStatusExplanation) GetConfirmationPocRatio() *Decimal {
+	if m != nil {
+		return m.ConfirmationPocRatio
+	}
+	return nil
+}
+
+func (m *ParticipantStatusExplanation) GetConfirmationPocAlphaThreshold() *Decimal {
+	if m != nil {
+		return m.ConfirmationPocAlphaThreshold
+	}
+	return nil
+}
+
+func (m *ParticipantStatusExplanation) GetConfirmationPocPassed() bool {
+	if m != nil {
+		return m.ConfirmationPocPassed
+	}
+	return false
+}
+
+func (m *ParticipantStatusExplanation) GetReputation() int64 {
+	if m != nil {
+		return m.Reputation
+	}
+	return 0
+}
+
+func (m *ParticipantStatusExplanation) GetEpochsCompleted() uint32 {
+	if m != nil {
+		return m.EpochsCompleted
+	}
+	return 0
+}
+
+func (m *ParticipantStatusExplanation) GetExclusionReason() string {
+	if m != nil {
+		return m.ExclusionReason
+	}
+	return ""
+}
+
+func (m *ParticipantStatusExplanation) GetExclusionBlockHeight() uint64 {
+	if m != nil {
+		return m.ExclusionBlockHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SprtExplanation)(nil), "inference.inference.SprtExplanation")
+	proto.RegisterType((*ParticipantStatusExplanation)(nil), "inference.inference.ParticipantStatusExplanation")
+}
+
+func init() {
+	proto.RegisterFile("inference/inference/participant_status_explanation.proto", fileDescriptor_f4bd909614bc56bd)
+}
+
+var fileDescriptor_f4bd909614bc56bd = []byte{
+	// 837 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x6f, 0x1b, 0x37,
+	0x10, 0xf5, 0xda, 0xae, 0x6b, 0x8f, 0x2c, 0xcb, 0xa1, 0x65, 0x99, 0x75, 0x5d, 0x45, 0x48, 0xbf,
+	0x5c, 0xa0, 0x50, 0xe4, 0xb4, 0x29, 0x82, 0x1e, 0x8a, 0x36, 0x8e, 0x83, 0xfa, 0x54, 0x75, 0x63,
+	0xf4, 0xd0, 0x0b, 0x41, 0x71, 0x47, 0x16, 0x91, 0xd5, 0x92, 0x59, 0x72, 0x5d, 0xe5, 0x5f, 0xf4,
+	0x67, 0xf5, 0x98, 0x63, 0x8f, 0x85, 0x0d, 0xf4, 0x1f, 0xf4, 0x5e, 0x2c, 0x77, 0x45, 0x6d, 0x64,
+	0x17, 0xdd, 0xdc, 0xa8, 0x37, 0xef, 0x3d, 0x72, 0x46, 0x33, 0xb3, 0xf0, 0x44, 0x26, 0x63, 0x4c,
+	0x31, 0x11, 0xf8, 0x70, 0x71, 0xd2, 0x3c, 0xb5, 0x52, 0x48, 0xcd, 0x13, 0xcb, 0x8c, 0xe5, 0x36,
+	0x33, 0x0c, 0x67, 0x3a, 0xe6, 0x09, 0xb7, 0x52, 0x25, 0x7d, 0x9d, 0x2a, 0xab, 0xc8, 0x9e, 0xe7,
+	0xf7, 0xfd, 0xe9, 0xb0, 0xf7, 0x1f, 0x76, 0x7c, 0x6a, 0x0a, 0xd9, 0xe1, 0xa7, 0xff, 0x73, 0x61,
+	0x41, 0x7b, 0xf0, 0x4f, 0x00, 0xad, 0x17, 0x3a, 0xb5, 0x67, 0x8b, 0x7b, 0x49, 0x1f, 0xd6, 0xe2,
+	0x38, 0xa5, 0x41, 0x2f, 0x38, 0x6e, 0x3c, 0x3a, 0xea, 0xdf, 0x71, 0x7f, 0xff, 0x19, 0x0a, 0x39,
+	0xe5, 0x71, 0x98, 0x13, 0xc9, 0xb7, 0xb0, 0x65, 0x27, 0x29, 0x9a, 0x89, 0x8a, 0x23, 0xba, 0x5a,
+	0x43, 0xb5, 0xa0, 0x93, 0x2f, 0x61, 0x55, 0x0f, 0xe8, 0x5a, 0x0d, 0xd1, 0xaa, 0x1e, 0x38, 0xf6,
+	0x09, 0x5d, 0xaf, 0xc5, 0x3e, 0x21, 0x87, 0xb0, 0x19, 0xa1, 0x90, 0x46, 0xaa, 0x84, 0xbe, 0xd7,
+	0x0b, 0x8e, 0xb7, 0x42, 0xff, 0xfb, 0xc1, 0xdf, 0xdb, 0x70, 0x34, 0x5c, 0x54, 0xe3, 0x85, 0xab,
+	0x7e, 0xb5, 0x08, 0x3d, 0x68, 0x54, 0xaa, 0xe5, 0x8a, 0xb1, 0x15, 0x56, 0x21, 0x72, 0x1f, 0x1a,
+	0xa8, 0x95, 0x98, 0x30, 0x99, 0x44, 0x38, 0x73, 0x89, 0xaf, 0x87, 0xe0, 0xa0, 0xf3, 0x1c, 0x21,
+	0x04, 0xd6, 0x63, 0x79, 0x85, 0x2e, 0xbb, 0xcd, 0xd0, 0x9d, 0xc9, 0x77, 0xb0, 0x51, 0xfc, 0xd3,
+	0x2e, 0x8b, 0x9d, 0x47, 0x9f, 0xdd, 0x99, 0xc5, 0xad, 0x97, 0x85, 0xa5, 0x8a, 0x7c, 0x0c, 0xcd,
+	0xb2, 0x53, 0x52, 0xe4, 0xc6, 0x27, 0xb6, 0x5d, 0x80, 0xa1, 0xc3, 0xc8, 0xe7, 0xd0, 0xf2, 0x5e,
+	0x4c, 0xa8, 0x2c, 0xb1, 0x74, 0xc3, 0xbd, 0x6e, 0xc7, 0xc3, 0xa7, 0x39, 0x9a, 0x13, 0xa7, 0xd2,
+	0x18, 0x8c, 0x58, 0x8a, 0xaf, 0x32, 0x34, 0xd6, 0xd0, 0xf7, 0x0b, 0x62, 0x01, 0x87, 0x25, 0x4a,
+	0x4e, 0xa0, 0x7d, 0xc5, 0x63, 0x19, 0x71, 0x8b, 0x11, 0xf3, 0x26, 0x86, 0x6e, 0x3a, 0xf6, 0x9e,
+	0x8f, 0x9d, 0xfb, 0x10, 0x79, 0x0c, 0x1d, 0x99, 0xdc, 0x29, 0xda, 0x72, 0xa2, 0xfd, 0x4a, 0xb4,
+	0x22, 0x7b, 0x06, 0x5d, 0xa1, 0x12, 0x83, 0x22, 0xb3, 0xf2, 0x0a, 0x59, 0x49, 0xaa, 0xca, 0xa1,
+	0x17, 0x1c, 0xaf, 0x85, 0x47, 0x15, 0xd6, 0x79, 0x41, 0xaa, 0xb8, 0x44, 0x70, 0xbf, 0xea, 0x32,
+	0xe6, 0x32, 0xce, 0x52, 0x64, 0x3a, 0x55, 0x23, 0x3e, 0x92, 0xb1, 0xb4, 0xaf, 0x69, 0xa3, 0x46,
+	0x17, 0x7d, 0x54, 0x31, 0x79, 0x5e, 0x78, 0x0c, 0x17, 0x16, 0xe4, 0x02, 0x0e, 0x5e, 0x65, 0x52,
+	0xbc, 0xf4, 0xfe, 0x8b, 0x31, 0xd8, 0xae, 0xe1, 0xbe, 0xef, 0xc4, 0xa5, 0xef, 0x85, 0x1f, 0x89,
+	0x9f, 0xe1, 0x9e, 0x2f, 0x8d, 0x54, 0x09, 0x33, 0x3a, 0xb5, 0xb4, 0xe9, 0xfc, 0x3e, 0xb9, 0xd3,
+	0x6f, 0x69, 0x7e, 0xc3, 0xdd, 0xaa, 0x3c, 0x0f, 0x92, 0x73, 0x68, 0x46, 0xea, 0xb7, 0xc4, 0xca,
+	0x29, 0x16, 0x76, 0x3b, 0xef, 0x60, 0xb7, 0x3d, 0x97, 0x3a, 0xab, 0xb3, 0xa2, 0x65, 0x98, 0xc6,
+	0x54, 0x60, 0x62, 0xf9, 0x25, 0xd2, 0x56, 0x8d, 0x5c, 0x5d, 0x43, 0x0d, 0xbd, 0x86, 0x84, 0xd0,
+	0x59, 0xb2, 0x61, 0x22, 0xb3, 0x6a, 0x3c, 0xa6, 0xbb, 0x35, 0xdc, 0xda, 0x6f, 0xbb, 0x9d, 0x3a,
+	0x25, 0x79, 0x02, 0x74, 0xd9, 0x13, 0x67, 0x02, 0x31, 0xc2, 0x88, 0xde, 0x73, 0x33, 0xd8, 0x79,
+	0x5b, 0x77, 0x56, 0x46, 0xc9, 0x73, 0xd8, 0xf5, 0xf5, 0xd1, 0xec, 0x8a, 0xc7, 0x19, 0x52, 0x52,
+	0x27, 0xab, 0xb9, 0x6a, 0xf8, 0x4b, 0xae, 0x21, 0xdf, 0x43, 0x73, 0x24, 0x13, 0x35, 0x65, 0x16,
+	0x8d, 0x65, 0x7a, 0x40, 0xf7, 0x6a, 0x98, 0x34, 0x9c, 0xe4, 0x02, 0x8d, 0x1d, 0x0e, 0xc8, 0x00,
+	0xda, 0xfe, 0x25, 0x85, 0x09, 0xcf, 0x07, 0x91, 0xb6, 0xdd, 0xfb, 0xc9, 0x3c, 0xe6, 0xd8, 0x2e,
+	0x92, 0x57, 0x52, 0xa8, 0x64, 0x2c, 0xd3, 0x69, 0xd1, 0x2e, 0x5a, 0x09, 0x96, 0xe6, 0x47, 0xba,
+	0x5f, 0xa7, 0x92, 0x55, 0xed, 0x50, 0x89, 0x30, 0x3f, 0x10, 0x84, 0xde, 0x2d, 0x4f, 0x1e, 0xeb,
+	0x09, 0xaf, 0x74, 0x78, 0xa7, 0xe6, 0xfc, 0x54, 0xdd, 0x7f, 0xc8, 0x3d, 0x16, 0x9d, 0xfe, 0x0d,
+	0x1c, 0xdc, 0xba, 0xa6, 0xcc, 0xf7, 0xc0, 0xe5, 0xbb, 0xbf, 0xa4, 0x2f, 0x53, 0xee, 0x02, 0xa4,
+	0xa8, 0x33, 0xeb, 0x60, 0x4a, 0xdd, 0x3e, 0xa8, 0x20, 0xe4, 0x0b, 0xd8, 0x75, 0x6b, 0xd8, 0x30,
+	0xa1, 0xa6, 0x3a, 0x46, 0x8b, 0x11, 0xfd, 0xa0, 0x17, 0x1c, 0x37, 0xc3, 0x56, 0x81, 0x9f, 0xce,
+	0x61, 0x47, 0x9d, 0x89, 0x38, 0xcb, 0x3f, 0x0a, 0xf3, 0x95, 0x7a, 0xe8, 0x56, 0x6a, 0xcb, 0xe3,
+	0xe5, 0x56, 0xfd, 0x1a, 0x3a, 0x0b, 0xea, 0x28, 0x56, 0xe2, 0x25, 0x9b, 0xa0, 0xbc, 0x9c, 0x58,
+	0xfa, 0xa1, 0x5b, 0x68, 0x6d, 0x1f, 0x7d, 0x9a, 0x07, 0x7f, 0x74, 0xb1, 0xa7, 0x3f, 0xfd, 0x71,
+	0xdd, 0x0d, 0xde, 0x5c, 0x77, 0x83, 0xbf, 0xae, 0xbb, 0xc1, 0xef, 0x37, 0xdd, 0x95, 0x37, 0x37,
+	0xdd, 0x95, 0x3f, 0x6f, 0xba, 0x2b, 0xbf, 0x3e, 0xbe, 0x94, 0x76, 0x92, 0x8d, 0xfa, 0x42, 0x4d,
+	0x1f, 0xea, 0x54, 0x45, 0x99, 0xb0, 0x46, 0xc8, 0xa5, 0x2f, 0xf6, 0xac, 0x72, 0xb6, 0xaf, 0x35,
+	0x9a, 0xd1, 0x86, 0xfb, 0x70, 0x7f, 0xf5, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x55, 0xf8, 0x18,
+	0xf9, 0x52, 0x08, 0x00, 0x00,
+}
+
+func (m *SprtExplanation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SprtExplanation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SprtExplanation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Decision) > 0 {
+		i -= len(m.Decision)
+		copy(dAtA[i:], m.Decision)
+		i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(len(m.Decision)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.P1 != nil {
+		{
+			size, err := m.P1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.P0 != nil {
+		{
+			size, err := m.P0.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Threshold != nil {
+		{
+			size, err := m.Threshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Llr != nil {
+		{
+			size, err := m.Llr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantStatusExplanation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantStatusExplanation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantStatusExplanation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExclusionBlockHeight != 0 {
+		i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(m.ExclusionBlockHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.ExclusionReason) > 0 {
+		i -= len(m.ExclusionReason)
+		copy(dAtA[i:], m.ExclusionReason)
+		i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(len(m.ExclusionReason)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.EpochsCompleted != 0 {
+		i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(m.EpochsCompleted))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.Reputation != 0 {
+		i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(m.Reputation))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.ConfirmationPocPassed {
+		i--
+		if m.ConfirmationPocPassed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.ConfirmationPocAlphaThreshold != nil {
+		{
+			size, err := m.ConfirmationPocAlphaThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.ConfirmationPocRatio != nil {
+		{
+			size, err := m.ConfirmationPocRatio.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.DowntimeTestPassed {
+		i--
+		if m.DowntimeTestPassed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.BinomTestP0 != nil {
+		{
+			size, err := m.BinomTestP0.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.DowntimePValue != nil {
+		{
+			size, err := m.DowntimePValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.MissPercentageExceeded {
+		i--
+		if m.MissPercentageExceeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MissPercentageCutoff != nil {
+		{
+			size, err := m.MissPercentageCutoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.MissPercentage != nil {
+		{
+			size, err := m.MissPercentage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.DowntimeSprt != nil {
+		{
+			size, err := m.DowntimeSprt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.InvalidationSprt != nil {
+		{
+			size, err := m.InvalidationSprt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.QuickFailureThreshold != nil {
+		{
+			size, err := m.QuickFailureThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ConsecutiveFailureProbability != nil {
+		{
+			size, err := m.ConsecutiveFailureProbability.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ConsecutiveInvalidInferences != 0 {
+		i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(m.ConsecutiveInvalidInferences))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.InvalidatedInferences != 0 {
+		i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(m.InvalidatedInferences))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ValidatedInferences != 0 {
+		i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(m.ValidatedInferences))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MissedRequests != 0 {
+		i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(m.MissedRequests))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.InferenceCount != 0 {
+		i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(m.InferenceCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.StatusReason) > 0 {
+		i -= len(m.StatusReason)
+		copy(dAtA[i:], m.StatusReason)
+		i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(len(m.StatusReason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Live {
+		i--
+		if m.Live {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochIndex != 0 {
+		i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintParticipantStatusExplanation(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParticipantStatusExplanation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParticipantStatusExplanation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SprtExplanation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Llr != nil {
+		l = m.Llr.Size()
+		n += 1 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.Threshold != nil {
+		l = m.Threshold.Size()
+		n += 1 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.P0 != nil {
+		l = m.P0.Size()
+		n += 1 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.P1 != nil {
+		l = m.P1.Size()
+		n += 1 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	l = len(m.Decision)
+	if l > 0 {
+		n += 1 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	return n
+}
+
+func (m *ParticipantStatusExplanation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.EpochIndex != 0 {
+		n += 1 + sovParticipantStatusExplanation(uint64(m.EpochIndex))
+	}
+	if m.Live {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovParticipantStatusExplanation(uint64(m.Status))
+	}
+	l = len(m.StatusReason)
+	if l > 0 {
+		n += 1 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.InferenceCount != 0 {
+		n += 1 + sovParticipantStatusExplanation(uint64(m.InferenceCount))
+	}
+	if m.MissedRequests != 0 {
+		n += 1 + sovParticipantStatusExplanation(uint64(m.MissedRequests))
+	}
+	if m.ValidatedInferences != 0 {
+		n += 1 + sovParticipantStatusExplanation(uint64(m.ValidatedInferences))
+	}
+	if m.InvalidatedInferences != 0 {
+		n += 1 + sovParticipantStatusExplanation(uint64(m.InvalidatedInferences))
+	}
+	if m.ConsecutiveInvalidInferences != 0 {
+		n += 1 + sovParticipantStatusExplanation(uint64(m.ConsecutiveInvalidInferences))
+	}
+	if m.ConsecutiveFailureProbability != nil {
+		l = m.ConsecutiveFailureProbability.Size()
+		n += 1 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.QuickFailureThreshold != nil {
+		l = m.QuickFailureThreshold.Size()
+		n += 1 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.InvalidationSprt != nil {
+		l = m.InvalidationSprt.Size()
+		n += 1 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.DowntimeSprt != nil {
+		l = m.DowntimeSprt.Size()
+		n += 1 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.MissPercentage != nil {
+		l = m.MissPercentage.Size()
+		n += 1 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.MissPercentageCutoff != nil {
+		l = m.MissPercentageCutoff.Size()
+		n += 2 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.MissPercentageExceeded {
+		n += 3
+	}
+	if m.DowntimePValue != nil {
+		l = m.DowntimePValue.Size()
+		n += 2 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.BinomTestP0 != nil {
+		l = m.BinomTestP0.Size()
+		n += 2 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.DowntimeTestPassed {
+		n += 3
+	}
+	if m.ConfirmationPocRatio != nil {
+		l = m.ConfirmationPocRatio.Size()
+		n += 2 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.ConfirmationPocAlphaThreshold != nil {
+		l = m.ConfirmationPocAlphaThreshold.Size()
+		n += 2 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.ConfirmationPocPassed {
+		n += 3
+	}
+	if m.Reputation != 0 {
+		n += 2 + sovParticipantStatusExplanation(uint64(m.Reputation))
+	}
+	if m.EpochsCompleted != 0 {
+		n += 2 + sovParticipantStatusExplanation(uint64(m.EpochsCompleted))
+	}
+	l = len(m.ExclusionReason)
+	if l > 0 {
+		n += 2 + l + sovParticipantStatusExplanation(uint64(l))
+	}
+	if m.ExclusionBlockHeight != 0 {
+		n += 2 + sovParticipantStatusExplanation(uint64(m.ExclusionBlockHeight))
+	}
+	return n
+}
+
+func sovParticipantStatusExplanation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParticipantStatusExplanation(x uint64) (n int) {
+	return sovParticipantStatusExplanation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SprtExplanation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipantStatusExplanation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SprtExplanation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SprtExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Llr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Llr == nil {
+				m.Llr = &Decimal{}
+			}
+			if err := m.Llr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Threshold == nil {
+				m.Threshold = &Decimal{}
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P0", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.P0 == nil {
+				m.P0 = &Decimal{}
+			}
+			if err := m.P0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.P1 == nil {
+				m.P1 = &Decimal{}
+			}
+			if err := m.P1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipantStatusExplanation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantStatusExplanation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipantStatusExplanation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantStatusExplanation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantStatusExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			}
+			m.EpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Live", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Live = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ParticipantStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceCount", wireType)
+			}
+			m.InferenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InferenceCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRequests", wireType)
+			}
+			m.MissedRequests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedRequests |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatedInferences", wireType)
+			}
+			m.ValidatedInferences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatedInferences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidatedInferences", wireType)
+			}
+			m.InvalidatedInferences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidatedInferences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveInvalidInferences", wireType)
+			}
+			m.ConsecutiveInvalidInferences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveInvalidInferences |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailureProbability", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsecutiveFailureProbability == nil {
+				m.ConsecutiveFailureProbability = &Decimal{}
+			}
+			if err := m.ConsecutiveFailureProbability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuickFailureThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuickFailureThreshold == nil {
+				m.QuickFailureThreshold = &Decimal{}
+			}
+			if err := m.QuickFailureThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationSprt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InvalidationSprt == nil {
+				m.InvalidationSprt = &SprtExplanation{}
+			}
+			if err := m.InvalidationSprt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeSprt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DowntimeSprt == nil {
+				m.DowntimeSprt = &SprtExplanation{}
+			}
+			if err := m.DowntimeSprt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissPercentage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MissPercentage == nil {
+				m.MissPercentage = &Decimal{}
+			}
+			if err := m.MissPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissPercentageCutoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MissPercentageCutoff == nil {
+				m.MissPercentageCutoff = &Decimal{}
+			}
+			if err := m.MissPercentageCutoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissPercentageExceeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MissPercentageExceeded = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimePValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DowntimePValue == nil {
+				m.DowntimePValue = &Decimal{}
+			}
+			if err := m.DowntimePValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinomTestP0", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BinomTestP0 == nil {
+				m.BinomTestP0 = &Decimal{}
+			}
+			if err := m.BinomTestP0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeTestPassed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DowntimeTestPassed = bool(v != 0)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationPocRatio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfirmationPocRatio == nil {
+				m.ConfirmationPocRatio = &Decimal{}
+			}
+			if err := m.ConfirmationPocRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationPocAlphaThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfirmationPocAlphaThreshold == nil {
+				m.ConfirmationPocAlphaThreshold = &Decimal{}
+			}
+			if err := m.ConfirmationPocAlphaThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationPocPassed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConfirmationPocPassed = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
+			}
+			m.Reputation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reputation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsCompleted", wireType)
+			}
+			m.EpochsCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsCompleted |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusionReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExclusionReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusionBlockHeight", wireType)
+			}
+			m.ExclusionBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExclusionBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipantStatusExplanation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipantStatusExplanation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParticipantStatusExplanation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParticipantStatusExplanation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipantStatusExplanation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParticipantStatusExplanation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParticipantStatusExplanation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParticipantStatusExplanation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParticipantStatusExplanation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParticipantStatusExplanation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParticipantStatusExplanation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,262 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// PoC batches can be committed to instead of submitted in full: the participant stores a Merkle root over
+// (nonce, dist) leaves and the leaf count, serves the full batch from its API node and validators check sampled
+// leaves against the root. Leaves are sorted by nonce, strictly increasing; an odd node at the end of a level is
+// promoted to the next level unchanged. The root binds the leaf count, which is the weight the batch claims, and
+// the nonce progression the leaves follow, so the chain can keep the nonce sets of a participant's batches apart.
+
+const (
+	pocLeafHashPrefix = 0x00
+	pocNodeHashPrefix = 0x01
+	pocRootHashPrefix = 0x02
+
+	// MaxPoCBatchCommitmentNonces bounds the number of leaves a single committed batch can claim
+	MaxPoCBatchCommitmentNonces = 1 << 20
+	// MaxPoCLeafDisputes bounds the number of disputed leaves a single validation can put on-chain
+	MaxPoCLeafDisputes = 64
+)
+
+// IsCommitment reports whether the batch was submitted as a Merkle commitment rather than in full
+func (b PoCBatch) IsCommitment() bool {
+	return len(b.MerkleRoot) > 0
+}
+
+// NonceTotal returns the number of nonces the batch claims, whether submitted in full or committed to
+func (b PoCBatch) NonceTotal() int64 {
+	if b.IsCommitment() {
+		return int64(b.NonceCount)
+	}
+	return int64(len(b.Nonces))
+}
+
+// PoCNonceProgression describes the nonces of a committed batch: Count strictly increasing nonces from First to
+// Last, all congruent to First modulo Stride. A single nonce has Stride 0. ML nodes hand out nonces in arithmetic
+// progressions per node and GPU group, so the batches of different nodes interleave without sharing a nonce.
+type PoCNonceProgression struct {
+	Count  uint32
+	First  int64
+	Last   int64
+	Stride int64
+}
+
+// NewPoCNonceProgression returns the progression of sorted, strictly increasing nonces, with the largest stride
+// they share
+func NewPoCNonceProgression(nonces []int64) (PoCNonceProgression, error) {
+	if len(nonces) == 0 {
+		return PoCNonceProgression{}, fmt.Errorf("batch is empty")
+	}
+	if len(nonces) > MaxPoCBatchCommitmentNonces {
+		return PoCNonceProgression{}, fmt.Errorf("batch has %d nonces, at most %d can be committed", len(nonces), MaxPoCBatchCommitmentNonces)
+	}
+	if nonces[0] < 0 {
+		return PoCNonceProgression{}, fmt.Errorf("nonce %d is negative", nonces[0])
+	}
+	stride := int64(0)
+	for i := 1; i < len(nonces); i++ {
+		if nonces[i] <= nonces[i-1] {
+			return PoCNonceProgression{}, fmt.Errorf("nonces must be strictly increasing: %d follows %d", nonces[i], nonces[i-1])
+		}
+		stride = gcd(stride, nonces[i]-nonces[i-1])
+	}
+	return PoCNonceProgression{
+		Count:  uint32(len(nonces)),
+		First:  nonces[0],
+		Last:   nonces[len(nonces)-1],
+		Stride: stride,
+	}, nil
+}
+
+// NonceProgression returns the progression a committed batch claims its nonces follow
+func (b PoCBatch) NonceProgression() PoCNonceProgression {
+	return PoCNonceProgression{Count: b.NonceCount, First: b.FirstNonce, Last: b.LastNonce, Stride: b.NonceStride}
+}
+
+// Validate checks that Count strictly increasing nonces fit in the progression
+func (p PoCNonceProgression) Validate() error {
+	if p.Count == 0 || p.Count > MaxPoCBatchCommitmentNonces {
+		return fmt.Errorf("nonce count must be between 1 and %d", MaxPoCBatchCommitmentNonces)
+	}
+	if p.First < 0 || p.Last < p.First || p.Stride < 0 {
+		return fmt.Errorf("invalid nonce range [%d, %d] with stride %d", p.First, p.Last, p.Stride)
+	}
+	if p.Count == 1 {
+		if p.Stride != 0 || p.First != p.Last {
+			return fmt.Errorf("a single nonce must have first == last and stride 0")
+		}
+		return nil
+	}
+	if p.Stride == 0 || (p.Last-p.First)%p.Stride != 0 {
+		return fmt.Errorf("nonce range [%d, %d] is not a multiple of stride %d", p.First, p.Last, p.Stride)
+	}
+	if (p.Last-p.First)/p.Stride < int64(p.Count-1) {
+		return fmt.Errorf("%d nonces don't fit in [%d, %d] with stride %d", p.Count, p.First, p.Last, p.Stride)
+	}
+	return nil
+}
+
+// Contains reports whether nonce is one of the values the progression can hold
+func (p PoCNonceProgression) Contains(nonce int64) bool {
+	if nonce < p.First || nonce > p.Last {
+		return false
+	}
+	return p.Stride == 0 || (nonce-p.First)%p.Stride == 0
+}
+
+// Admits reports whether nonce can be the leaf at index: it is in the progression and leaves enough room for the
+// strictly increasing leaves before and after it
+func (p PoCNonceProgression) Admits(index uint32, nonce int64) bool {
+	if index >= p.Count || !p.Contains(nonce) {
+		return false
+	}
+	return nonce-p.First >= int64(index)*p.Stride && p.Last-nonce >= int64(p.Count-1-index)*p.Stride &&
+		(index != 0 || nonce == p.First) && (index != p.Count-1 || nonce == p.Last)
+}
+
+// Overlaps reports whether two progressions may share a nonce: their ranges intersect and their residues agree
+// modulo the greatest common divisor of the strides
+func (p PoCNonceProgression) Overlaps(other PoCNonceProgression) bool {
+	if p.Last < other.First || other.Last < p.First {
+		return false
+	}
+	g := gcd(p.Stride, other.Stride)
+	if g == 0 {
+		return p.First == other.First
+	}
+	return (p.First-other.First)%g == 0
+}
+
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// PoCLeafHash returns the Merkle leaf hash of a nonce and its distance
+func PoCLeafHash(nonce int64, dist float64) []byte {
+	var buf [17]byte
+	buf[0] = pocLeafHashPrefix
+	binary.BigEndian.PutUint64(buf[1:9], uint64(nonce))
+	binary.BigEndian.PutUint64(buf[9:], math.Float64bits(dist))
+	hash := sha256.Sum256(buf[:])
+	return hash[:]
+}
+
+func pocNodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{pocNodeHashPrefix})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+func pocLeafHashes(nonces []int64, dist []float64) ([][]byte, PoCNonceProgression, error) {
+	if len(nonces) != len(dist) {
+		return nil, PoCNonceProgression{}, fmt.Errorf("nonces and dist must have the same length: %d != %d", len(nonces), len(dist))
+	}
+	progression, err := NewPoCNonceProgression(nonces)
+	if err != nil {
+		return nil, PoCNonceProgression{}, err
+	}
+	leaves := make([][]byte, len(nonces))
+	for i := range nonces {
+		leaves[i] = PoCLeafHash(nonces[i], dist[i])
+	}
+	return leaves, progression, nil
+}
+
+func pocNextLevel(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+			continue
+		}
+		next = append(next, pocNodeHash(level[i], level[i+1]))
+	}
+	return next
+}
+
+func pocRootHash(progression PoCNonceProgression, treeRoot []byte) []byte {
+	var buf [29]byte
+	buf[0] = pocRootHashPrefix
+	binary.BigEndian.PutUint32(buf[1:5], progression.Count)
+	binary.BigEndian.PutUint64(buf[5:13], uint64(progression.First))
+	binary.BigEndian.PutUint64(buf[13:21], uint64(progression.Last))
+	binary.BigEndian.PutUint64(buf[21:], uint64(progression.Stride))
+	h := sha256.New()
+	h.Write(buf[:])
+	h.Write(treeRoot)
+	return h.Sum(nil)
+}
+
+// PoCBatchMerkleRoot returns the Merkle root a participant commits to for a batch, and the nonce progression it
+// binds. The nonces must be sorted and strictly increasing.
+func PoCBatchMerkleRoot(nonces []int64, dist []float64) ([]byte, PoCNonceProgression, error) {
+	level, progression, err := pocLeafHashes(nonces, dist)
+	if err != nil {
+		return nil, PoCNonceProgression{}, err
+	}
+	for len(level) > 1 {
+		level = pocNextLevel(level)
+	}
+	return pocRootHash(progression, level[0]), progression, nil
+}
+
+// PoCBatchMerkleProof returns the sibling hashes proving the leaf at index, from the bottom of the tree up
+func PoCBatchMerkleProof(nonces []int64, dist []float64, index int) ([][]byte, error) {
+	level, _, err := pocLeafHashes(nonces, dist)
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 || index >= len(level) {
+		return nil, fmt.Errorf("leaf index %d out of range [0, %d)", index, len(level))
+	}
+	var proof [][]byte
+	for len(level) > 1 {
+		if sibling := index ^ 1; sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		level = pocNextLevel(level)
+		index /= 2
+	}
+	return proof, nil
+}
+
+// VerifyPoCBatchLeaf checks that (nonce, dist) is the leaf at index of a committed batch with the given nonce
+// progression, and that the nonce fits the progression at that position
+func VerifyPoCBatchLeaf(root []byte, progression PoCNonceProgression, index uint32, nonce int64, dist float64, proof [][]byte) bool {
+	if !progression.Admits(index, nonce) {
+		return false
+	}
+	count := progression.Count
+	hash := PoCLeafHash(nonce, dist)
+	used := 0
+	for size := count; size > 1; size = (size + 1) / 2 {
+		switch {
+		case index%2 == 1:
+			if used == len(proof) {
+				return false
+			}
+			hash = pocNodeHash(proof[used], hash)
+			used++
+		case index+1 < size:
+			if used == len(proof) {
+				return false
+			}
+			hash = pocNodeHash(hash, proof[used])
+			used++
+		}
+		index /= 2
+	}
+	return used == len(proof) && bytes.Equal(pocRootHash(progression, hash), root)
+}
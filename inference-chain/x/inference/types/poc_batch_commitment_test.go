@@ -0,0 +1,73 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoCBatchMerkleProofs(t *testing.T) {
+	for _, size := range []int{1, 2, 3, 5, 8, 13} {
+		nonces := make([]int64, size)
+		dist := make([]float64, size)
+		for i := range nonces {
+			nonces[i] = int64(i*7 + 3)
+			dist[i] = float64(i) / 10
+		}
+		root, progression, err := PoCBatchMerkleRoot(nonces, dist)
+		require.NoError(t, err)
+		require.NoError(t, progression.Validate())
+
+		for i := range nonces {
+			proof, err := PoCBatchMerkleProof(nonces, dist, i)
+			require.NoError(t, err)
+			require.True(t, VerifyPoCBatchLeaf(root, progression, uint32(i), nonces[i], dist[i], proof), "size %d leaf %d", size, i)
+			// A different distance, position or progression doesn't verify
+			require.False(t, VerifyPoCBatchLeaf(root, progression, uint32(i), nonces[i], dist[i]+0.5, proof))
+			require.False(t, VerifyPoCBatchLeaf(root, progression, uint32(i+1), nonces[i], dist[i], proof))
+			wider := progression
+			wider.Count += 2
+			wider.Last += 2 * max(wider.Stride, 1)
+			wider.Stride = max(wider.Stride, 1)
+			require.False(t, VerifyPoCBatchLeaf(root, wider, uint32(i), nonces[i], dist[i], proof))
+		}
+	}
+
+	_, _, err := PoCBatchMerkleRoot([]int64{1, 2}, []float64{0.1})
+	require.Error(t, err)
+	_, err = PoCBatchMerkleProof([]int64{1}, []float64{0.1}, 1)
+	require.Error(t, err)
+}
+
+func TestPoCBatchMerkleRoot_RequiresStrictlyIncreasingNonces(t *testing.T) {
+	_, _, err := PoCBatchMerkleRoot([]int64{1, 5, 5}, []float64{0.1, 0.2, 0.3})
+	require.Error(t, err)
+	_, _, err = PoCBatchMerkleRoot([]int64{5, 1}, []float64{0.1, 0.2})
+	require.Error(t, err)
+
+	_, progression, err := PoCBatchMerkleRoot([]int64{2, 8, 14, 26}, []float64{0.1, 0.2, 0.3, 0.4})
+	require.NoError(t, err)
+	require.Equal(t, PoCNonceProgression{Count: 4, First: 2, Last: 26, Stride: 6}, progression)
+}
+
+func TestPoCNonceProgression_Overlaps(t *testing.T) {
+	// Two ML nodes of four, interleaving over the same range, never share a nonce
+	node0 := PoCNonceProgression{Count: 10, First: 0, Last: 36, Stride: 4}
+	node1 := PoCNonceProgression{Count: 10, First: 1, Last: 37, Stride: 4}
+	require.False(t, node0.Overlaps(node1))
+	// A later batch of the same node starts after the earlier one ends
+	require.False(t, node0.Overlaps(PoCNonceProgression{Count: 5, First: 40, Last: 56, Stride: 4}))
+	// Claiming the same nonces again, even with another stride, is caught
+	require.True(t, node0.Overlaps(PoCNonceProgression{Count: 3, First: 8, Last: 24, Stride: 8}))
+	require.True(t, node0.Overlaps(PoCNonceProgression{Count: 1, First: 12, Last: 12}))
+	require.False(t, node0.Overlaps(PoCNonceProgression{Count: 1, First: 13, Last: 13}))
+
+	require.True(t, node0.Admits(0, 0))
+	require.False(t, node0.Admits(0, 4))
+	require.False(t, node0.Admits(5, 2))
+	// The 6th leaf needs 5 leaves before and 4 after it
+	require.False(t, node0.Admits(5, 16))
+	require.True(t, node0.Admits(5, 20))
+	require.False(t, node0.Admits(5, 24))
+	require.Error(t, PoCNonceProgression{Count: 11, First: 0, Last: 36, Stride: 4}.Validate())
+}
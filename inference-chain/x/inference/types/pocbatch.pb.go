@@ -6,6 +6,7 @@ package types
 import (
 	encoding_binary "encoding/binary"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -31,6 +32,15 @@ type PoCBatch struct {
 	Dist                     []float64 `protobuf:"fixed64,5,rep,packed,name=dist,proto3" json:"dist,omitempty"`
 	BatchId                  string    `protobuf:"bytes,6,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
 	NodeId                   string    `protobuf:"bytes,7,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// merkle_root and nonce_count replace nonces and dist for batches submitted as commitments, the full batch
+	// is served by the participant's API node
+	MerkleRoot []byte `protobuf:"bytes,8,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	NonceCount uint32 `protobuf:"varint,9,opt,name=nonce_count,json=nonceCount,proto3" json:"nonce_count,omitempty"`
+	// The committed leaves are sorted by nonce, strictly increasing from first_nonce to last_nonce and all congruent
+	// to first_nonce modulo nonce_stride (0 for a single leaf). The root binds all three.
+	FirstNonce  int64 `protobuf:"varint,10,opt,name=first_nonce,json=firstNonce,proto3" json:"first_nonce,omitempty"`
+	LastNonce   int64 `protobuf:"varint,11,opt,name=last_nonce,json=lastNonce,proto3" json:"last_nonce,omitempty"`
+	NonceStride int64 `protobuf:"varint,12,opt,name=nonce_stride,json=nonceStride,proto3" json:"nonce_stride,omitempty"`
 }
 
 func (m *PoCBatch) Reset()         { *m = PoCBatch{} }
@@ -115,27 +125,150 @@ func (m *PoCBatch) GetNodeId() string {
 	return ""
 }
 
+func (m *PoCBatch) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *PoCBatch) GetNonceCount() uint32 {
+	if m != nil {
+		return m.NonceCount
+	}
+	return 0
+}
+
+func (m *PoCBatch) GetFirstNonce() int64 {
+	if m != nil {
+		return m.FirstNonce
+	}
+	return 0
+}
+
+func (m *PoCBatch) GetLastNonce() int64 {
+	if m != nil {
+		return m.LastNonce
+	}
+	return 0
+}
+
+func (m *PoCBatch) GetNonceStride() int64 {
+	if m != nil {
+		return m.NonceStride
+	}
+	return 0
+}
+
+// PoCLeafDispute is a leaf of a committed batch that failed validation, with the proof that the participant
+// committed to it
+type PoCLeafDispute struct {
+	BatchId   string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	LeafIndex uint32 `protobuf:"varint,2,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	Nonce     int64  `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// received_dist is the distance the participant committed to, dist the one the validator computed
+	ReceivedDist float64  `protobuf:"fixed64,4,opt,name=received_dist,json=receivedDist,proto3" json:"received_dist,omitempty"`
+	Dist         float64  `protobuf:"fixed64,5,opt,name=dist,proto3" json:"dist,omitempty"`
+	Proof        [][]byte `protobuf:"bytes,6,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *PoCLeafDispute) Reset()         { *m = PoCLeafDispute{} }
+func (m *PoCLeafDispute) String() string { return proto.CompactTextString(m) }
+func (*PoCLeafDispute) ProtoMessage()    {}
+func (*PoCLeafDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba47c2fd2ca3ae22, []int{1}
+}
+func (m *PoCLeafDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoCLeafDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoCLeafDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoCLeafDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoCLeafDispute.Merge(m, src)
+}
+func (m *PoCLeafDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoCLeafDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoCLeafDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoCLeafDispute proto.InternalMessageInfo
+
+func (m *PoCLeafDispute) GetBatchId() string {
+	if m != nil {
+		return m.BatchId
+	}
+	return ""
+}
+
+func (m *PoCLeafDispute) GetLeafIndex() uint32 {
+	if m != nil {
+		return m.LeafIndex
+	}
+	return 0
+}
+
+func (m *PoCLeafDispute) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *PoCLeafDispute) GetReceivedDist() float64 {
+	if m != nil {
+		return m.ReceivedDist
+	}
+	return 0
+}
+
+func (m *PoCLeafDispute) GetDist() float64 {
+	if m != nil {
+		return m.Dist
+	}
+	return 0
+}
+
+func (m *PoCLeafDispute) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 // ignite scaffold message SubmitPocValidation participant_address poc_stage_start_block_height:int nonces:array.int dist:array.int received_dist:array.int r_target:int fraud_threshold:int n_invalid:int probability_honest:int fraud_detected:bool
 type PoCValidation struct {
-	ParticipantAddress          string    `protobuf:"bytes,1,opt,name=participant_address,json=participantAddress,proto3" json:"participant_address,omitempty"`
-	ValidatorParticipantAddress string    `protobuf:"bytes,2,opt,name=validator_participant_address,json=validatorParticipantAddress,proto3" json:"validator_participant_address,omitempty"`
-	PocStageStartBlockHeight    int64     `protobuf:"varint,3,opt,name=poc_stage_start_block_height,json=pocStageStartBlockHeight,proto3" json:"poc_stage_start_block_height,omitempty"`
-	ValidatedAtBlockHeight      int64     `protobuf:"varint,4,opt,name=validated_at_block_height,json=validatedAtBlockHeight,proto3" json:"validated_at_block_height,omitempty"`
-	Nonces                      []int64   `protobuf:"varint,5,rep,packed,name=nonces,proto3" json:"nonces,omitempty"`
-	Dist                        []float64 `protobuf:"fixed64,6,rep,packed,name=dist,proto3" json:"dist,omitempty"`
-	ReceivedDist                []float64 `protobuf:"fixed64,7,rep,packed,name=received_dist,json=receivedDist,proto3" json:"received_dist,omitempty"`
-	RTarget                     float64   `protobuf:"fixed64,8,opt,name=r_target,json=rTarget,proto3" json:"r_target,omitempty"`
-	FraudThreshold              float64   `protobuf:"fixed64,9,opt,name=fraud_threshold,json=fraudThreshold,proto3" json:"fraud_threshold,omitempty"`
-	NInvalid                    int64     `protobuf:"varint,10,opt,name=n_invalid,json=nInvalid,proto3" json:"n_invalid,omitempty"`
-	ProbabilityHonest           float64   `protobuf:"fixed64,11,opt,name=probability_honest,json=probabilityHonest,proto3" json:"probability_honest,omitempty"`
-	FraudDetected               bool      `protobuf:"varint,12,opt,name=fraud_detected,json=fraudDetected,proto3" json:"fraud_detected,omitempty"`
+	ParticipantAddress          string           `protobuf:"bytes,1,opt,name=participant_address,json=participantAddress,proto3" json:"participant_address,omitempty"`
+	ValidatorParticipantAddress string           `protobuf:"bytes,2,opt,name=validator_participant_address,json=validatorParticipantAddress,proto3" json:"validator_participant_address,omitempty"`
+	PocStageStartBlockHeight    int64            `protobuf:"varint,3,opt,name=poc_stage_start_block_height,json=pocStageStartBlockHeight,proto3" json:"poc_stage_start_block_height,omitempty"`
+	ValidatedAtBlockHeight      int64            `protobuf:"varint,4,opt,name=validated_at_block_height,json=validatedAtBlockHeight,proto3" json:"validated_at_block_height,omitempty"`
+	Nonces                      []int64          `protobuf:"varint,5,rep,packed,name=nonces,proto3" json:"nonces,omitempty"`
+	Dist                        []float64        `protobuf:"fixed64,6,rep,packed,name=dist,proto3" json:"dist,omitempty"`
+	ReceivedDist                []float64        `protobuf:"fixed64,7,rep,packed,name=received_dist,json=receivedDist,proto3" json:"received_dist,omitempty"`
+	RTarget                     float64          `protobuf:"fixed64,8,opt,name=r_target,json=rTarget,proto3" json:"r_target,omitempty"`
+	FraudThreshold              float64          `protobuf:"fixed64,9,opt,name=fraud_threshold,json=fraudThreshold,proto3" json:"fraud_threshold,omitempty"`
+	NInvalid                    int64            `protobuf:"varint,10,opt,name=n_invalid,json=nInvalid,proto3" json:"n_invalid,omitempty"`
+	ProbabilityHonest           float64          `protobuf:"fixed64,11,opt,name=probability_honest,json=probabilityHonest,proto3" json:"probability_honest,omitempty"`
+	FraudDetected               bool             `protobuf:"varint,12,opt,name=fraud_detected,json=fraudDetected,proto3" json:"fraud_detected,omitempty"`
+	Disputes                    []PoCLeafDispute `protobuf:"bytes,13,rep,name=disputes,proto3" json:"disputes"`
 }
 
 func (m *PoCValidation) Reset()         { *m = PoCValidation{} }
 func (m *PoCValidation) String() string { return proto.CompactTextString(m) }
 func (*PoCValidation) ProtoMessage()    {}
 func (*PoCValidation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba47c2fd2ca3ae22, []int{1}
+	return fileDescriptor_ba47c2fd2ca3ae22, []int{2}
 }
 func (m *PoCValidation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -248,8 +381,16 @@ func (m *PoCValidation) GetFraudDetected() bool {
 	return false
 }
 
+func (m *PoCValidation) GetDisputes() []PoCLeafDispute {
+	if m != nil {
+		return m.Disputes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PoCBatch)(nil), "inference.inference.PoCBatch")
+	proto.RegisterType((*PoCLeafDispute)(nil), "inference.inference.PoCLeafDispute")
 	proto.RegisterType((*PoCValidation)(nil), "inference.inference.PoCValidation")
 }
 
@@ -258,38 +399,51 @@ func init() {
 }
 
 var fileDescriptor_ba47c2fd2ca3ae22 = []byte{
-	// 493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe7, 0xa6, 0x6b, 0x53, 0xb3, 0x82, 0xf0, 0xc4, 0xf0, 0x34, 0x88, 0xa2, 0x22, 0x44,
-	0x2e, 0xb4, 0x07, 0x84, 0x10, 0x17, 0xa4, 0x75, 0x3b, 0xac, 0x27, 0xaa, 0x6c, 0xe2, 0xc0, 0xc5,
-	0x72, 0x6c, 0xaf, 0xb1, 0x28, 0x76, 0xe4, 0xb8, 0x13, 0xfb, 0x04, 0x5c, 0x39, 0xf0, 0xa1, 0x38,
-	0xee, 0xc8, 0x11, 0xb5, 0x5f, 0x04, 0xe5, 0xb5, 0x0d, 0x65, 0x2a, 0x42, 0xbb, 0x44, 0xef, 0xbd,
-	0xdf, 0xff, 0xff, 0xe4, 0xfc, 0x2d, 0xe3, 0x9e, 0x36, 0x97, 0xca, 0x29, 0x23, 0xd4, 0xe0, 0x4f,
-	0x55, 0x58, 0x91, 0x71, 0x2f, 0xf2, 0x7e, 0xe1, 0xac, 0xb7, 0x64, 0xbf, 0x26, 0xfd, 0xba, 0xea,
-	0x7d, 0x6f, 0xe0, 0x70, 0x6c, 0x4f, 0x86, 0x95, 0x8e, 0x0c, 0xf0, 0x7e, 0xc1, 0x9d, 0xd7, 0x42,
-	0x17, 0xdc, 0x78, 0xc6, 0xa5, 0x74, 0xaa, 0x2c, 0x29, 0x8a, 0x51, 0xd2, 0x49, 0xc9, 0x06, 0x3a,
-	0x5e, 0x12, 0xf2, 0x0e, 0x3f, 0x29, 0xac, 0x60, 0xa5, 0xe7, 0x13, 0x55, 0x7d, 0x9d, 0x67, 0xd9,
-	0xd4, 0x8a, 0x4f, 0x2c, 0x57, 0x7a, 0x92, 0x7b, 0xda, 0x88, 0x51, 0x12, 0xa4, 0xb4, 0xb0, 0xe2,
-	0xbc, 0x92, 0x9c, 0x57, 0x8a, 0x61, 0x25, 0x38, 0x03, 0x4e, 0xde, 0x60, 0xea, 0x94, 0x50, 0xfa,
-	0x4a, 0x49, 0xc6, 0x6f, 0x79, 0x03, 0xf0, 0x3e, 0x5a, 0xf3, 0xe3, 0xbf, 0x8c, 0x07, 0xb8, 0x65,
-	0xac, 0x11, 0xaa, 0xa4, 0xcd, 0x38, 0x48, 0x82, 0x74, 0xd5, 0x11, 0x82, 0x9b, 0x52, 0x97, 0x9e,
-	0xee, 0xc6, 0x41, 0x82, 0x52, 0xa8, 0xc9, 0x21, 0x0e, 0x21, 0x06, 0xa6, 0x25, 0x6d, 0xc1, 0xaf,
-	0xb4, 0xa1, 0x1f, 0x49, 0xf2, 0x18, 0xb7, 0x8d, 0x95, 0xaa, 0x22, 0x6d, 0x20, 0xad, 0xaa, 0x1d,
-	0xc9, 0xde, 0xd7, 0x26, 0xee, 0x8e, 0xed, 0xc9, 0x07, 0x3e, 0xd5, 0x92, 0x7b, 0x6d, 0xcd, 0xdd,
-	0xb3, 0x19, 0xe2, 0xa7, 0x57, 0x4b, 0xbb, 0x75, 0x6c, 0x9b, 0xb5, 0x01, 0xd6, 0xa3, 0x5a, 0x34,
-	0xbe, 0x7b, 0xbe, 0xc1, 0x7f, 0xf2, 0x7d, 0x8b, 0x0f, 0x57, 0xeb, 0xb7, 0x04, 0xdc, 0x04, 0xf3,
-	0x41, 0x2d, 0xf8, 0x57, 0xc2, 0xbb, 0x5b, 0x13, 0x6e, 0x6d, 0x24, 0xfc, 0x0c, 0x77, 0xeb, 0x6b,
-	0x04, 0xd8, 0x06, 0xb8, 0xb7, 0x1e, 0x9e, 0xae, 0xae, 0xc1, 0x31, 0xcf, 0xdd, 0x44, 0x79, 0x1a,
-	0xc6, 0x28, 0x41, 0x69, 0xdb, 0x5d, 0x40, 0x4b, 0x5e, 0xe0, 0x07, 0x97, 0x8e, 0xcf, 0x24, 0xf3,
-	0xb9, 0x53, 0x65, 0x6e, 0xa7, 0x92, 0x76, 0x40, 0x71, 0x1f, 0xc6, 0x17, 0xeb, 0x29, 0x39, 0xc2,
-	0x1d, 0xc3, 0xb4, 0x81, 0x23, 0x53, 0x0c, 0xe7, 0x0f, 0xcd, 0x68, 0xd9, 0x93, 0x97, 0x98, 0x14,
-	0xce, 0x66, 0x3c, 0xd3, 0x53, 0xed, 0xaf, 0x59, 0x6e, 0x8d, 0x2a, 0x3d, 0xbd, 0x07, 0x8b, 0x1e,
-	0x6e, 0x90, 0x33, 0x00, 0xe4, 0x39, 0x5e, 0x6e, 0x67, 0x52, 0x79, 0x25, 0xbc, 0x92, 0x74, 0x2f,
-	0x46, 0x49, 0x98, 0x76, 0x61, 0x7a, 0xba, 0x1a, 0x0e, 0xdf, 0xff, 0x98, 0x47, 0xe8, 0x66, 0x1e,
-	0xa1, 0x5f, 0xf3, 0x08, 0x7d, 0x5b, 0x44, 0x3b, 0x37, 0x8b, 0x68, 0xe7, 0xe7, 0x22, 0xda, 0xf9,
-	0xf8, 0x7a, 0xa2, 0x7d, 0x3e, 0xcb, 0xfa, 0xc2, 0x7e, 0x1e, 0x14, 0xce, 0xca, 0x99, 0xf0, 0xa5,
-	0xd0, 0xb7, 0xde, 0xe0, 0x97, 0x8d, 0xda, 0x5f, 0x17, 0xaa, 0xcc, 0x5a, 0xf0, 0x1a, 0x5f, 0xfd,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x81, 0x5e, 0x7b, 0xb7, 0xb3, 0x03, 0x00, 0x00,
+	// 697 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0xdb, 0x48,
+	0x14, 0xcf, 0xe0, 0xfc, 0x7d, 0x24, 0xac, 0x76, 0x60, 0x59, 0xb3, 0x2c, 0xc1, 0x1b, 0xb4, 0x5a,
+	0x5f, 0x36, 0x91, 0x76, 0xb5, 0x5a, 0xf5, 0x52, 0x89, 0x84, 0x4a, 0x44, 0xaa, 0xda, 0xc8, 0xa0,
+	0x1e, 0x7a, 0x19, 0x39, 0x9e, 0x49, 0x32, 0x22, 0x78, 0xac, 0xf1, 0x04, 0xc1, 0xb7, 0xe8, 0x37,
+	0xe9, 0xb9, 0xdf, 0x80, 0x53, 0xc5, 0xb1, 0xa7, 0xaa, 0x82, 0x2f, 0x52, 0xcd, 0x73, 0x30, 0x09,
+	0x4d, 0x55, 0x71, 0xb1, 0xde, 0xfb, 0xfd, 0xf1, 0xf8, 0x3d, 0xff, 0x34, 0xd0, 0x92, 0xf1, 0x48,
+	0x68, 0x11, 0x47, 0xa2, 0xf3, 0x50, 0x25, 0x2a, 0x1a, 0x86, 0x26, 0x9a, 0xb4, 0x13, 0xad, 0x8c,
+	0xa2, 0x9b, 0x39, 0xd3, 0xce, 0xab, 0xdf, 0xb6, 0xc6, 0x6a, 0xac, 0x90, 0xef, 0xd8, 0x2a, 0x93,
+	0xb6, 0x3e, 0x38, 0x50, 0x1d, 0xa8, 0x5e, 0xd7, 0xba, 0x69, 0x07, 0x36, 0x93, 0x50, 0x1b, 0x19,
+	0xc9, 0x24, 0x8c, 0x0d, 0x0b, 0x39, 0xd7, 0x22, 0x4d, 0x5d, 0xe2, 0x11, 0xbf, 0x16, 0xd0, 0x05,
+	0xea, 0x30, 0x63, 0xe8, 0x73, 0xf8, 0x3d, 0x51, 0x11, 0x4b, 0x4d, 0x38, 0x16, 0xf6, 0xa9, 0x0d,
+	0x1b, 0x4e, 0x55, 0x74, 0xc6, 0x26, 0x42, 0x8e, 0x27, 0xc6, 0x5d, 0xf3, 0x88, 0xef, 0x04, 0x6e,
+	0xa2, 0xa2, 0x13, 0x2b, 0x39, 0xb1, 0x8a, 0xae, 0x15, 0x1c, 0x23, 0x4f, 0xff, 0x07, 0x57, 0x8b,
+	0x48, 0xc8, 0x0b, 0xc1, 0x59, 0xf8, 0xc8, 0xeb, 0xa0, 0xf7, 0x97, 0x7b, 0xfe, 0x70, 0xc9, 0xb8,
+	0x0d, 0xe5, 0x58, 0xc5, 0x91, 0x48, 0xdd, 0xa2, 0xe7, 0xf8, 0x4e, 0x30, 0xef, 0x28, 0x85, 0x22,
+	0x97, 0xa9, 0x71, 0x4b, 0x9e, 0xe3, 0x93, 0x00, 0x6b, 0xba, 0x03, 0x55, 0x5c, 0x0e, 0x93, 0xdc,
+	0x2d, 0xe3, 0x28, 0x15, 0xec, 0xfb, 0x9c, 0xfe, 0x0a, 0x95, 0x58, 0x71, 0x61, 0x99, 0x0a, 0x32,
+	0x65, 0xdb, 0xf6, 0x39, 0xdd, 0x87, 0xf5, 0x73, 0xa1, 0xcf, 0xa6, 0x82, 0x69, 0xa5, 0x8c, 0x5b,
+	0xf5, 0x88, 0x5f, 0x0f, 0x20, 0x83, 0x02, 0xa5, 0x8c, 0x15, 0xe0, 0x91, 0x2c, 0x52, 0xb3, 0xd8,
+	0xb8, 0x35, 0x8f, 0xf8, 0x8d, 0x00, 0x10, 0xea, 0x59, 0xc4, 0x0a, 0x46, 0x52, 0xa7, 0x86, 0x21,
+	0xe6, 0x02, 0x4e, 0x03, 0x08, 0xbd, 0xb2, 0x08, 0xdd, 0x03, 0x98, 0x86, 0x39, 0xbf, 0x8e, 0x7c,
+	0xcd, 0x22, 0x19, 0xfd, 0x07, 0xd4, 0xb3, 0x03, 0x52, 0xa3, 0x25, 0x17, 0x6e, 0x1d, 0x05, 0xd9,
+	0xa1, 0x27, 0x08, 0xb5, 0xde, 0x13, 0xd8, 0x18, 0xa8, 0xde, 0x4b, 0x11, 0x8e, 0x8e, 0x64, 0x9a,
+	0xcc, 0x8c, 0x58, 0x9a, 0x95, 0x2c, 0xcf, 0x6a, 0xcf, 0x13, 0xe1, 0x88, 0xc9, 0x98, 0x8b, 0x4b,
+	0xfc, 0x33, 0x8d, 0xa0, 0x66, 0x91, 0xbe, 0x05, 0xe8, 0x16, 0x94, 0xb2, 0x2f, 0xc9, 0xf6, 0x9e,
+	0x35, 0xf4, 0x00, 0x1a, 0xf9, 0x0f, 0xc2, 0xc5, 0x16, 0x3d, 0xe2, 0x93, 0xa0, 0x7e, 0x0f, 0x1e,
+	0xd9, 0x05, 0x3f, 0x2c, 0x9d, 0xe4, 0x4b, 0xdf, 0x82, 0x52, 0xa2, 0x95, 0x1a, 0xb9, 0x65, 0xcf,
+	0xf1, 0xeb, 0x41, 0xd6, 0xb4, 0x3e, 0x16, 0xa1, 0x31, 0x50, 0xbd, 0x37, 0xe1, 0x54, 0xf2, 0xd0,
+	0x48, 0x15, 0x3f, 0x3d, 0x72, 0x5d, 0xd8, 0xbb, 0xc8, 0xec, 0x4a, 0xb3, 0x55, 0xd6, 0x35, 0xb4,
+	0xee, 0xe6, 0xa2, 0xc1, 0xd3, 0x63, 0xeb, 0xfc, 0x20, 0xb6, 0xcf, 0x60, 0x67, 0xfe, 0xfa, 0x15,
+	0xb9, 0x2d, 0xa2, 0x79, 0x3b, 0x17, 0x7c, 0x2f, 0xb8, 0xa5, 0x95, 0xc1, 0x2d, 0x2f, 0x04, 0xf7,
+	0x9b, 0xe5, 0x57, 0x90, 0x5c, 0x5e, 0xfe, 0x0e, 0x54, 0x35, 0x33, 0xa1, 0x1e, 0x8b, 0x2c, 0xa6,
+	0x24, 0xa8, 0xe8, 0x53, 0x6c, 0xe9, 0x5f, 0xf0, 0xd3, 0x48, 0x87, 0x33, 0xce, 0xcc, 0x44, 0x8b,
+	0x74, 0xa2, 0xa6, 0x1c, 0x73, 0x4a, 0x82, 0x0d, 0x84, 0x4f, 0xef, 0x51, 0xba, 0x0b, 0xb5, 0x98,
+	0xc9, 0x18, 0x3f, 0x79, 0x9e, 0xd4, 0x6a, 0xdc, 0xcf, 0x7a, 0xfa, 0x37, 0xd0, 0x44, 0xab, 0x61,
+	0x38, 0x94, 0x53, 0x69, 0xae, 0xd8, 0x44, 0xc5, 0x22, 0x35, 0x98, 0x57, 0x12, 0xfc, 0xbc, 0xc0,
+	0x1c, 0x23, 0x41, 0xff, 0x84, 0xec, 0xed, 0x8c, 0x0b, 0x23, 0x22, 0x23, 0x38, 0x26, 0xb7, 0x1a,
+	0x34, 0x10, 0x3d, 0x9a, 0x83, 0xf4, 0x05, 0x54, 0x79, 0x96, 0xd9, 0xd4, 0x6d, 0x78, 0x8e, 0xbf,
+	0xfe, 0xcf, 0x41, 0x7b, 0xc5, 0xad, 0xd5, 0x5e, 0xce, 0x77, 0xb7, 0x78, 0xfd, 0x79, 0xbf, 0x10,
+	0xe4, 0xd6, 0xee, 0xeb, 0xeb, 0xdb, 0x26, 0xb9, 0xb9, 0x6d, 0x92, 0x2f, 0xb7, 0x4d, 0xf2, 0xee,
+	0xae, 0x59, 0xb8, 0xb9, 0x6b, 0x16, 0x3e, 0xdd, 0x35, 0x0b, 0x6f, 0xff, 0x1b, 0x4b, 0x33, 0x99,
+	0x0d, 0xdb, 0x91, 0x3a, 0xef, 0x24, 0x5a, 0xf1, 0x59, 0x64, 0xd2, 0x48, 0x3e, 0xba, 0x37, 0x2f,
+	0x17, 0x6a, 0x73, 0x95, 0x88, 0x74, 0x58, 0xc6, 0x6b, 0xf1, 0xdf, 0xaf, 0x01, 0x00, 0x00, 0xff,
+	0xff, 0x78, 0x8f, 0x3d, 0xfa, 0x67, 0x05, 0x00, 0x00,
 }
 
 func (m *PoCBatch) Marshal() (dAtA []byte, err error) {
@@ -312,6 +466,33 @@ func (m *PoCBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NonceStride != 0 {
+		i = encodeVarintPocbatch(dAtA, i, uint64(m.NonceStride))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.LastNonce != 0 {
+		i = encodeVarintPocbatch(dAtA, i, uint64(m.LastNonce))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.FirstNonce != 0 {
+		i = encodeVarintPocbatch(dAtA, i, uint64(m.FirstNonce))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.NonceCount != 0 {
+		i = encodeVarintPocbatch(dAtA, i, uint64(m.NonceCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintPocbatch(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.NodeId) > 0 {
 		i -= len(m.NodeId)
 		copy(dAtA[i:], m.NodeId)
@@ -375,6 +556,67 @@ func (m *PoCBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoCLeafDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoCLeafDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoCLeafDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintPocbatch(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Dist != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Dist))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.ReceivedDist != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ReceivedDist))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintPocbatch(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LeafIndex != 0 {
+		i = encodeVarintPocbatch(dAtA, i, uint64(m.LeafIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BatchId) > 0 {
+		i -= len(m.BatchId)
+		copy(dAtA[i:], m.BatchId)
+		i = encodeVarintPocbatch(dAtA, i, uint64(len(m.BatchId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PoCValidation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -395,6 +637,20 @@ func (m *PoCValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Disputes) > 0 {
+		for iNdEx := len(m.Disputes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Disputes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPocbatch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if m.FraudDetected {
 		i--
 		if m.FraudDetected {
@@ -539,6 +795,53 @@ func (m *PoCBatch) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPocbatch(uint64(l))
 	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovPocbatch(uint64(l))
+	}
+	if m.NonceCount != 0 {
+		n += 1 + sovPocbatch(uint64(m.NonceCount))
+	}
+	if m.FirstNonce != 0 {
+		n += 1 + sovPocbatch(uint64(m.FirstNonce))
+	}
+	if m.LastNonce != 0 {
+		n += 1 + sovPocbatch(uint64(m.LastNonce))
+	}
+	if m.NonceStride != 0 {
+		n += 1 + sovPocbatch(uint64(m.NonceStride))
+	}
+	return n
+}
+
+func (m *PoCLeafDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BatchId)
+	if l > 0 {
+		n += 1 + l + sovPocbatch(uint64(l))
+	}
+	if m.LeafIndex != 0 {
+		n += 1 + sovPocbatch(uint64(m.LeafIndex))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovPocbatch(uint64(m.Nonce))
+	}
+	if m.ReceivedDist != 0 {
+		n += 9
+	}
+	if m.Dist != 0 {
+		n += 9
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovPocbatch(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -590,6 +893,12 @@ func (m *PoCValidation) Size() (n int) {
 	if m.FraudDetected {
 		n += 2
 	}
+	if len(m.Disputes) > 0 {
+		for _, e := range m.Disputes {
+			l = e.Size()
+			n += 1 + l + sovPocbatch(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -892,6 +1201,290 @@ func (m *PoCBatch) Unmarshal(dAtA []byte) error {
 			}
 			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocbatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPocbatch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPocbatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceCount", wireType)
+			}
+			m.NonceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocbatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonceCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstNonce", wireType)
+			}
+			m.FirstNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocbatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstNonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastNonce", wireType)
+			}
+			m.LastNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocbatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastNonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceStride", wireType)
+			}
+			m.NonceStride = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocbatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonceStride |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPocbatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPocbatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoCLeafDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPocbatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoCLeafDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoCLeafDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocbatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPocbatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPocbatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
+			}
+			m.LeafIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocbatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocbatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedDist", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ReceivedDist = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Dist = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocbatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPocbatch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPocbatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPocbatch(dAtA[iNdEx:])
@@ -1300,6 +1893,40 @@ func (m *PoCValidation) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.FraudDetected = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocbatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPocbatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPocbatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disputes = append(m.Disputes, PoCLeafDispute{})
+			if err := m.Disputes[len(m.Disputes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPocbatch(dAtA[iNdEx:])
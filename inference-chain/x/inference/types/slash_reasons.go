@@ -2,6 +2,7 @@ package types
 
 // Slash reasons used by x/inference when calling x/collateral
 const (
-	SlashReasonInvalidation = "invalidation"
-	SlashReasonDowntime     = "downtime"
+	SlashReasonInvalidation      = "invalidation"
+	SlashReasonDowntime          = "downtime"
+	SlashReasonFalseInvalidation = "false_invalidation"
 )
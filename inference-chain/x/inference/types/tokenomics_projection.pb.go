@@ -0,0 +1,1292 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/inference/tokenomics_projection.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EpochRewardProjection is the projected settlement of one future epoch.
+type EpochRewardProjection struct {
+	EpochIndex        uint64   `protobuf:"varint,1,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	RewardAmount      int64    `protobuf:"varint,2,opt,name=reward_amount,json=rewardAmount,proto3" json:"reward_amount,omitempty"`
+	DistributedAmount int64    `protobuf:"varint,3,opt,name=distributed_amount,json=distributedAmount,proto3" json:"distributed_amount,omitempty"`
+	GovernanceAmount  int64    `protobuf:"varint,4,opt,name=governance_amount,json=governanceAmount,proto3" json:"governance_amount,omitempty"`
+	TotalSubsidyPaid  int64    `protobuf:"varint,5,opt,name=total_subsidy_paid,json=totalSubsidyPaid,proto3" json:"total_subsidy_paid,omitempty"`
+	SubsidyPercentage *Decimal `protobuf:"bytes,6,opt,name=subsidy_percentage,json=subsidyPercentage,proto3" json:"subsidy_percentage,omitempty"`
+}
+
+func (m *EpochRewardProjection) Reset()         { *m = EpochRewardProjection{} }
+func (m *EpochRewardProjection) String() string { return proto.CompactTextString(m) }
+func (*EpochRewardProjection) ProtoMessage()    {}
+func (*EpochRewardProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29dec27d1d50d33b, []int{0}
+}
+func (m *EpochRewardProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochRewardProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochRewardProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochRewardProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochRewardProjection.Merge(m, src)
+}
+func (m *EpochRewardProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochRewardProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochRewardProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochRewardProjection proto.InternalMessageInfo
+
+func (m *EpochRewardProjection) GetEpochIndex() uint64 {
+	if m != nil {
+		return m.EpochIndex
+	}
+	return 0
+}
+
+func (m *EpochRewardProjection) GetRewardAmount() int64 {
+	if m != nil {
+		return m.RewardAmount
+	}
+	return 0
+}
+
+func (m *EpochRewardProjection) GetDistributedAmount() int64 {
+	if m != nil {
+		return m.DistributedAmount
+	}
+	return 0
+}
+
+func (m *EpochRewardProjection) GetGovernanceAmount() int64 {
+	if m != nil {
+		return m.GovernanceAmount
+	}
+	return 0
+}
+
+func (m *EpochRewardProjection) GetTotalSubsidyPaid() int64 {
+	if m != nil {
+		return m.TotalSubsidyPaid
+	}
+	return 0
+}
+
+func (m *EpochRewardProjection) GetSubsidyPercentage() *Decimal {
+	if m != nil {
+		return m.SubsidyPercentage
+	}
+	return nil
+}
+
+// ParticipantRewardProjection sums the projected reward coins of one participant.
+type ParticipantRewardProjection struct {
+	Address          string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight           int64    `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	FirstEpochReward uint64   `protobuf:"varint,3,opt,name=first_epoch_reward,json=firstEpochReward,proto3" json:"first_epoch_reward,omitempty"`
+	TotalReward      uint64   `protobuf:"varint,4,opt,name=total_reward,json=totalReward,proto3" json:"total_reward,omitempty"`
+	RewardShare      *Decimal `protobuf:"bytes,5,opt,name=reward_share,json=rewardShare,proto3" json:"reward_share,omitempty"`
+}
+
+func (m *ParticipantRewardProjection) Reset()         { *m = ParticipantRewardProjection{} }
+func (m *ParticipantRewardProjection) String() string { return proto.CompactTextString(m) }
+func (*ParticipantRewardProjection) ProtoMessage()    {}
+func (*ParticipantRewardProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29dec27d1d50d33b, []int{1}
+}
+func (m *ParticipantRewardProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantRewardProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantRewardProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantRewardProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantRewardProjection.Merge(m, src)
+}
+func (m *ParticipantRewardProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantRewardProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantRewardProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantRewardProjection proto.InternalMessageInfo
+
+func (m *ParticipantRewardProjection) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ParticipantRewardProjection) GetWeight() int64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *ParticipantRewardProjection) GetFirstEpochReward() uint64 {
+	if m != nil {
+		return m.FirstEpochReward
+	}
+	return 0
+}
+
+func (m *ParticipantRewardProjection) GetTotalReward() uint64 {
+	if m != nil {
+		return m.TotalReward
+	}
+	return 0
+}
+
+func (m *ParticipantRewardProjection) GetRewardShare() *Decimal {
+	if m != nil {
+		return m.RewardShare
+	}
+	return nil
+}
+
+// TokenomicsProjection projects reward issuance for a params set, assuming the current participants,
+// weights and (for legacy rewards) per-epoch work stay as they are and nobody is punished for downtime.
+// Top miner payouts come from a pre-funded pool and are not part of the issuance.
+type TokenomicsProjection struct {
+	UseBitcoinRewards   bool  `protobuf:"varint,1,opt,name=use_bitcoin_rewards,json=useBitcoinRewards,proto3" json:"use_bitcoin_rewards,omitempty"`
+	TotalSubsidySupply  int64 `protobuf:"varint,2,opt,name=total_subsidy_supply,json=totalSubsidySupply,proto3" json:"total_subsidy_supply,omitempty"`
+	StartingSubsidyPaid int64 `protobuf:"varint,3,opt,name=starting_subsidy_paid,json=startingSubsidyPaid,proto3" json:"starting_subsidy_paid,omitempty"`
+	TotalIssuance       int64 `protobuf:"varint,4,opt,name=total_issuance,json=totalIssuance,proto3" json:"total_issuance,omitempty"`
+	// first projected epoch in which the subsidy supply is exhausted, 0 if not within the projection
+	SupplyExhaustedEpoch uint64                         `protobuf:"varint,5,opt,name=supply_exhausted_epoch,json=supplyExhaustedEpoch,proto3" json:"supply_exhausted_epoch,omitempty"`
+	Epochs               []*EpochRewardProjection       `protobuf:"bytes,6,rep,name=epochs,proto3" json:"epochs,omitempty"`
+	Participants         []*ParticipantRewardProjection `protobuf:"bytes,7,rep,name=participants,proto3" json:"participants,omitempty"`
+}
+
+func (m *TokenomicsProjection) Reset()         { *m = TokenomicsProjection{} }
+func (m *TokenomicsProjection) String() string { return proto.CompactTextString(m) }
+func (*TokenomicsProjection) ProtoMessage()    {}
+func (*TokenomicsProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29dec27d1d50d33b, []int{2}
+}
+func (m *TokenomicsProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenomicsProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenomicsProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenomicsProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenomicsProjection.Merge(m, src)
+}
+func (m *TokenomicsProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenomicsProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenomicsProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenomicsProjection proto.InternalMessageInfo
+
+func (m *TokenomicsProjection) GetUseBitcoinRewards() bool {
+	if m != nil {
+		return m.UseBitcoinRewards
+	}
+	return false
+}
+
+func (m *TokenomicsProjection) GetTotalSubsidySupply() int64 {
+	if m != nil {
+		return m.TotalSubsidySupply
+	}
+	return 0
+}
+
+func (m *TokenomicsProjection) GetStartingSubsidyPaid() int64 {
+	if m != nil {
+		return m.StartingSubsidyPaid
+	}
+	return 0
+}
+
+func (m *TokenomicsProjection) GetTotalIssuance() int64 {
+	if m != nil {
+		return m.TotalIssuance
+	}
+	return 0
+}
+
+func (m *TokenomicsProjection) GetSupplyExhaustedEpoch() uint64 {
+	if m != nil {
+		return m.SupplyExhaustedEpoch
+	}
+	return 0
+}
+
+func (m *TokenomicsProjection) GetEpochs() []*EpochRewardProjection {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+func (m *TokenomicsProjection) GetParticipants() []*ParticipantRewardProjection {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EpochRewardProjection)(nil), "inference.inference.EpochRewardProjection")
+	proto.RegisterType((*ParticipantRewardProjection)(nil), "inference.inference.ParticipantRewardProjection")
+	proto.RegisterType((*TokenomicsProjection)(nil), "inference.inference.TokenomicsProjection")
+}
+
+func init() {
+	proto.RegisterFile("inference/inference/tokenomics_projection.proto", fileDescriptor_29dec27d1d50d33b)
+}
+
+var fileDescriptor_29dec27d1d50d33b = []byte{
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xeb, 0x26, 0xbf, 0xf4, 0xc7, 0xa6, 0x45, 0xcd, 0x26, 0xad, 0x2c, 0x40, 0x26, 0x04,
+	0x21, 0x45, 0xfc, 0x71, 0xab, 0x00, 0x67, 0x44, 0x44, 0x0f, 0x15, 0x07, 0x22, 0xa7, 0x27, 0x2e,
+	0xd6, 0x66, 0x3d, 0x4d, 0x16, 0x92, 0x5d, 0x6b, 0x77, 0x4d, 0x93, 0xb7, 0xe0, 0x1d, 0x78, 0x05,
+	0x1e, 0x82, 0x63, 0x8f, 0x1c, 0x21, 0x79, 0x11, 0xe4, 0xdd, 0x8d, 0xeb, 0x40, 0x04, 0x37, 0x7b,
+	0xbe, 0xdf, 0x19, 0xef, 0x7c, 0x66, 0xbc, 0xe8, 0x84, 0xf1, 0x4b, 0x90, 0xc0, 0x29, 0x94, 0x9e,
+	0xb4, 0xf8, 0x08, 0x5c, 0xcc, 0x18, 0x55, 0x71, 0x2a, 0xc5, 0x07, 0xa0, 0x9a, 0x09, 0x1e, 0xa6,
+	0x52, 0x68, 0x81, 0x9b, 0x85, 0x2d, 0x2c, 0x9e, 0xee, 0xb4, 0xb7, 0x55, 0x49, 0x89, 0x24, 0x33,
+	0x65, 0xd3, 0x3a, 0x5f, 0x77, 0xd1, 0xd1, 0x59, 0x2a, 0xe8, 0x24, 0x82, 0x2b, 0x22, 0x93, 0x41,
+	0x51, 0x16, 0xdf, 0x47, 0x75, 0xc8, 0x85, 0x98, 0xf1, 0x04, 0xe6, 0xbe, 0xd7, 0xf6, 0xba, 0xd5,
+	0x08, 0x99, 0xd0, 0x79, 0x1e, 0xc1, 0x0f, 0xd1, 0x81, 0x34, 0x49, 0x31, 0x99, 0x89, 0x8c, 0x6b,
+	0x7f, 0xb7, 0xed, 0x75, 0x2b, 0xd1, 0xbe, 0x0d, 0xbe, 0x36, 0x31, 0xfc, 0x0c, 0xe1, 0x84, 0x29,
+	0x2d, 0xd9, 0x28, 0xd3, 0x50, 0x38, 0x2b, 0xc6, 0xd9, 0x28, 0x29, 0xce, 0xfe, 0x04, 0x35, 0xc6,
+	0xe2, 0x13, 0x48, 0x4e, 0x38, 0x85, 0xb5, 0xbb, 0x6a, 0xdc, 0x87, 0x37, 0x82, 0x33, 0x3f, 0x45,
+	0x58, 0x0b, 0x4d, 0xa6, 0xb1, 0xca, 0x46, 0x8a, 0x25, 0x8b, 0x38, 0x25, 0x2c, 0xf1, 0xff, 0xb3,
+	0x6e, 0xa3, 0x0c, 0xad, 0x30, 0x20, 0x2c, 0xc1, 0x6f, 0x11, 0x2e, 0x7c, 0x20, 0x29, 0x70, 0x4d,
+	0xc6, 0xe0, 0xd7, 0xda, 0x5e, 0xb7, 0xde, 0xbb, 0x17, 0x6e, 0xa1, 0x17, 0xbe, 0x01, 0xca, 0x66,
+	0x64, 0x1a, 0x35, 0x5c, 0xde, 0xa0, 0x48, 0xeb, 0xfc, 0xf4, 0xd0, 0xdd, 0x01, 0x91, 0x9a, 0x51,
+	0x96, 0x12, 0xae, 0xff, 0x80, 0xe7, 0xa3, 0x3d, 0x92, 0x24, 0x12, 0x94, 0x32, 0xe0, 0x6e, 0x45,
+	0xeb, 0x57, 0x7c, 0x8c, 0x6a, 0x57, 0xc0, 0xc6, 0x93, 0x35, 0x2e, 0xf7, 0x96, 0x37, 0x73, 0xc9,
+	0xa4, 0xd2, 0xb1, 0x85, 0x6e, 0x21, 0x1a, 0x50, 0xd5, 0xe8, 0xd0, 0x28, 0xa5, 0x31, 0xe1, 0x07,
+	0x68, 0xdf, 0xb6, 0xee, 0x7c, 0x55, 0xe3, 0xab, 0x9b, 0x98, 0xb3, 0xbc, 0x42, 0x6e, 0x12, 0xb1,
+	0x9a, 0x10, 0x09, 0x86, 0xcb, 0xbf, 0x3a, 0xad, 0xdb, 0x8c, 0x61, 0x9e, 0xd0, 0xf9, 0x52, 0x41,
+	0xad, 0x8b, 0x62, 0xe3, 0x4a, 0xcd, 0x85, 0xa8, 0x99, 0x29, 0x88, 0x47, 0x4c, 0x53, 0xc1, 0xb8,
+	0x3b, 0x82, 0x6d, 0xf4, 0xff, 0xa8, 0x91, 0x29, 0xe8, 0x5b, 0xc5, 0x1e, 0x44, 0xe1, 0x53, 0xd4,
+	0xda, 0x9c, 0x93, 0xca, 0xd2, 0x74, 0xba, 0x70, 0x00, 0x70, 0x79, 0x52, 0x43, 0xa3, 0xe0, 0x1e,
+	0x3a, 0x52, 0x3a, 0xc7, 0xcb, 0xc7, 0x9b, 0xc3, 0xb5, 0x8b, 0xd3, 0x5c, 0x8b, 0xe5, 0xf9, 0x3e,
+	0x42, 0xb7, 0xed, 0x57, 0x98, 0x52, 0x59, 0xbe, 0x25, 0x6e, 0x6f, 0x0e, 0x4c, 0xf4, 0xdc, 0x05,
+	0xf1, 0x0b, 0x74, 0x6c, 0x3f, 0x1f, 0xc3, 0x7c, 0x42, 0x32, 0x95, 0x6f, 0xa5, 0x41, 0x6e, 0x00,
+	0x55, 0xa3, 0x96, 0x55, 0xcf, 0xd6, 0xa2, 0xa1, 0x8e, 0xfb, 0xa8, 0x66, 0x4c, 0xca, 0xaf, 0xb5,
+	0x2b, 0xdd, 0x7a, 0xef, 0xf1, 0x56, 0x8c, 0x5b, 0x7f, 0xa4, 0xc8, 0x65, 0xe2, 0x0b, 0xb4, 0x9f,
+	0xde, 0xac, 0x8c, 0xf2, 0xf7, 0x4c, 0xa5, 0xd3, 0xad, 0x95, 0xfe, 0xb2, 0x5b, 0xd1, 0x46, 0x95,
+	0xfe, 0xbb, 0x6f, 0xcb, 0xc0, 0xbb, 0x5e, 0x06, 0xde, 0x8f, 0x65, 0xe0, 0x7d, 0x5e, 0x05, 0x3b,
+	0xd7, 0xab, 0x60, 0xe7, 0xfb, 0x2a, 0xd8, 0x79, 0xff, 0x72, 0xcc, 0xf4, 0x24, 0x1b, 0x85, 0x54,
+	0xcc, 0x4e, 0x52, 0x29, 0x92, 0x8c, 0x6a, 0x45, 0xd9, 0x6f, 0x97, 0xc1, 0xbc, 0x7c, 0xbd, 0x2c,
+	0x52, 0x50, 0xa3, 0x9a, 0xb9, 0x18, 0x9e, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x23, 0x54, 0xcb,
+	0x8f, 0x82, 0x04, 0x00, 0x00,
+}
+
+func (m *EpochRewardProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochRewardProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochRewardProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubsidyPercentage != nil {
+		{
+			size, err := m.SubsidyPercentage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTokenomicsProjection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TotalSubsidyPaid != 0 {
+		i = encodeVarintTokenomicsProjection(dAtA, i, uint64(m.TotalSubsidyPaid))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GovernanceAmount != 0 {
+		i = encodeVarintTokenomicsProjection(dAtA, i, uint64(m.GovernanceAmount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DistributedAmount != 0 {
+		i = encodeVarintTokenomicsProjection(dAtA, i, uint64(m.DistributedAmount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RewardAmount != 0 {
+		i = encodeVarintTokenomicsProjection(dAtA, i, uint64(m.RewardAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochIndex != 0 {
+		i = encodeVarintTokenomicsProjection(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantRewardProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantRewardProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantRewardProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardShare != nil {
+		{
+			size, err := m.RewardShare.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTokenomicsProjection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TotalReward != 0 {
+		i = encodeVarintTokenomicsProjection(dAtA, i, uint64(m.TotalReward))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FirstEpochReward != 0 {
+		i = encodeVarintTokenomicsProjection(dAtA, i, uint64(m.FirstEpochReward))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Weight != 0 {
+		i = encodeVarintTokenomicsProjection(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTokenomicsProjection(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenomicsProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenomicsProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenomicsProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTokenomicsProjection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTokenomicsProjection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.SupplyExhaustedEpoch != 0 {
+		i = encodeVarintTokenomicsProjection(dAtA, i, uint64(m.SupplyExhaustedEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalIssuance != 0 {
+		i = encodeVarintTokenomicsProjection(dAtA, i, uint64(m.TotalIssuance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartingSubsidyPaid != 0 {
+		i = encodeVarintTokenomicsProjection(dAtA, i, uint64(m.StartingSubsidyPaid))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalSubsidySupply != 0 {
+		i = encodeVarintTokenomicsProjection(dAtA, i, uint64(m.TotalSubsidySupply))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UseBitcoinRewards {
+		i--
+		if m.UseBitcoinRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTokenomicsProjection(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTokenomicsProjection(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EpochRewardProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochIndex != 0 {
+		n += 1 + sovTokenomicsProjection(uint64(m.EpochIndex))
+	}
+	if m.RewardAmount != 0 {
+		n += 1 + sovTokenomicsProjection(uint64(m.RewardAmount))
+	}
+	if m.DistributedAmount != 0 {
+		n += 1 + sovTokenomicsProjection(uint64(m.DistributedAmount))
+	}
+	if m.GovernanceAmount != 0 {
+		n += 1 + sovTokenomicsProjection(uint64(m.GovernanceAmount))
+	}
+	if m.TotalSubsidyPaid != 0 {
+		n += 1 + sovTokenomicsProjection(uint64(m.TotalSubsidyPaid))
+	}
+	if m.SubsidyPercentage != nil {
+		l = m.SubsidyPercentage.Size()
+		n += 1 + l + sovTokenomicsProjection(uint64(l))
+	}
+	return n
+}
+
+func (m *ParticipantRewardProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTokenomicsProjection(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovTokenomicsProjection(uint64(m.Weight))
+	}
+	if m.FirstEpochReward != 0 {
+		n += 1 + sovTokenomicsProjection(uint64(m.FirstEpochReward))
+	}
+	if m.TotalReward != 0 {
+		n += 1 + sovTokenomicsProjection(uint64(m.TotalReward))
+	}
+	if m.RewardShare != nil {
+		l = m.RewardShare.Size()
+		n += 1 + l + sovTokenomicsProjection(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenomicsProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UseBitcoinRewards {
+		n += 2
+	}
+	if m.TotalSubsidySupply != 0 {
+		n += 1 + sovTokenomicsProjection(uint64(m.TotalSubsidySupply))
+	}
+	if m.StartingSubsidyPaid != 0 {
+		n += 1 + sovTokenomicsProjection(uint64(m.StartingSubsidyPaid))
+	}
+	if m.TotalIssuance != 0 {
+		n += 1 + sovTokenomicsProjection(uint64(m.TotalIssuance))
+	}
+	if m.SupplyExhaustedEpoch != 0 {
+		n += 1 + sovTokenomicsProjection(uint64(m.SupplyExhaustedEpoch))
+	}
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovTokenomicsProjection(uint64(l))
+		}
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovTokenomicsProjection(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTokenomicsProjection(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTokenomicsProjection(x uint64) (n int) {
+	return sovTokenomicsProjection(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EpochRewardProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTokenomicsProjection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochRewardProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochRewardProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			}
+			m.EpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+			}
+			m.RewardAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardAmount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedAmount", wireType)
+			}
+			m.DistributedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributedAmount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceAmount", wireType)
+			}
+			m.GovernanceAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GovernanceAmount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSubsidyPaid", wireType)
+			}
+			m.TotalSubsidyPaid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSubsidyPaid |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubsidyPercentage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTokenomicsProjection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenomicsProjection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubsidyPercentage == nil {
+				m.SubsidyPercentage = &Decimal{}
+			}
+			if err := m.SubsidyPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTokenomicsProjection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTokenomicsProjection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantRewardProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTokenomicsProjection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantRewardProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantRewardProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenomicsProjection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenomicsProjection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstEpochReward", wireType)
+			}
+			m.FirstEpochReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstEpochReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReward", wireType)
+			}
+			m.TotalReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTokenomicsProjection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenomicsProjection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardShare == nil {
+				m.RewardShare = &Decimal{}
+			}
+			if err := m.RewardShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTokenomicsProjection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTokenomicsProjection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenomicsProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTokenomicsProjection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenomicsProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenomicsProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseBitcoinRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseBitcoinRewards = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSubsidySupply", wireType)
+			}
+			m.TotalSubsidySupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSubsidySupply |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingSubsidyPaid", wireType)
+			}
+			m.StartingSubsidyPaid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingSubsidyPaid |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalIssuance", wireType)
+			}
+			m.TotalIssuance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalIssuance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyExhaustedEpoch", wireType)
+			}
+			m.SupplyExhaustedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplyExhaustedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTokenomicsProjection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenomicsProjection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, &EpochRewardProjection{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTokenomicsProjection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenomicsProjection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &ParticipantRewardProjection{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTokenomicsProjection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTokenomicsProjection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTokenomicsProjection(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTokenomicsProjection
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenomicsProjection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTokenomicsProjection
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTokenomicsProjection
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTokenomicsProjection
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTokenomicsProjection        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTokenomicsProjection          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTokenomicsProjection = fmt.Errorf("proto: unexpected end of group")
+)
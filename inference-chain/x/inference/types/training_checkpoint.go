@@ -0,0 +1,41 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TrainingCheckpointKeyPrefix marks training KV records that commit a participant to the hash of its checkpoint
+// for an outer step. Assignees are only paid for outer steps they committed a checkpoint for.
+const TrainingCheckpointKeyPrefix = "checkpoint/"
+
+// TrainingCheckpointKey returns the training KV record key of the checkpoint commitment of participant for outerStep
+func TrainingCheckpointKey(outerStep int32, participant string) string {
+	return fmt.Sprintf("%s%d/%s", TrainingCheckpointKeyPrefix, outerStep, participant)
+}
+
+// IsTrainingCheckpointKey reports whether a training KV record key is a checkpoint commitment
+func IsTrainingCheckpointKey(key string) bool {
+	return strings.HasPrefix(key, TrainingCheckpointKeyPrefix)
+}
+
+// ValidateTrainingCheckpointRecord checks that a checkpoint commitment is keyed by its submitter and holds a
+// hex encoded SHA-256 hash
+func ValidateTrainingCheckpointRecord(key string, value string, creator string) error {
+	parts := strings.Split(strings.TrimPrefix(key, TrainingCheckpointKeyPrefix), "/")
+	if len(parts) != 2 {
+		return fmt.Errorf("%w: key %s must be %s<outer_step>/<participant>", ErrInvalidTrainingCheckpoint, key, TrainingCheckpointKeyPrefix)
+	}
+	if outerStep, err := strconv.ParseInt(parts[0], 10, 32); err != nil || outerStep < 0 {
+		return fmt.Errorf("%w: invalid outer step %s", ErrInvalidTrainingCheckpoint, parts[0])
+	}
+	if parts[1] != creator {
+		return fmt.Errorf("%w: participants can only commit their own checkpoints", ErrInvalidTrainingCheckpoint)
+	}
+	if hash, err := hex.DecodeString(value); err != nil || len(hash) != 32 {
+		return fmt.Errorf("%w: value must be a hex encoded 32 byte hash", ErrInvalidTrainingCheckpoint)
+	}
+	return nil
+}
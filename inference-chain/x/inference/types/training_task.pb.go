@@ -34,6 +34,8 @@ type TrainingTask struct {
 	Config                         *TrainingConfig              `protobuf:"bytes,9,opt,name=config,proto3" json:"config,omitempty"`
 	Assignees                      []*TrainingTaskAssignee      `protobuf:"bytes,10,rep,name=assignees,proto3" json:"assignees,omitempty"`
 	Epoch                          *EpochInfo                   `protobuf:"bytes,11,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Budget                         *TrainingBudget              `protobuf:"bytes,12,opt,name=budget,proto3" json:"budget,omitempty"`
+	CancelledAtBlockHeight         uint64                       `protobuf:"varint,13,opt,name=cancelled_at_block_height,json=cancelledAtBlockHeight,proto3" json:"cancelled_at_block_height,omitempty"`
 }
 
 func (m *TrainingTask) Reset()         { *m = TrainingTask{} }
@@ -146,6 +148,100 @@ func (m *TrainingTask) GetEpoch() *EpochInfo {
 	return nil
 }
 
+func (m *TrainingTask) GetBudget() *TrainingBudget {
+	if m != nil {
+		return m.Budget
+	}
+	return nil
+}
+
+func (m *TrainingTask) GetCancelledAtBlockHeight() uint64 {
+	if m != nil {
+		return m.CancelledAtBlockHeight
+	}
+	return 0
+}
+
+// TrainingBudget is the escrow of a training task. The requester locks total when creating the task. Each
+// ranked node of an outer step whose participant committed a checkpoint hash for that step earns
+// payment_per_node_epoch, and whatever is left is refunded when the task finishes or is cancelled.
+type TrainingBudget struct {
+	Total               uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PaymentPerNodeEpoch uint64 `protobuf:"varint,2,opt,name=payment_per_node_epoch,json=paymentPerNodeEpoch,proto3" json:"payment_per_node_epoch,omitempty"`
+	Paid                uint64 `protobuf:"varint,3,opt,name=paid,proto3" json:"paid,omitempty"`
+	Refunded            uint64 `protobuf:"varint,4,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	// next_outer_step_to_settle is the first outer step not paid for yet
+	NextOuterStepToSettle int32 `protobuf:"varint,5,opt,name=next_outer_step_to_settle,json=nextOuterStepToSettle,proto3" json:"next_outer_step_to_settle,omitempty"`
+}
+
+func (m *TrainingBudget) Reset()         { *m = TrainingBudget{} }
+func (m *TrainingBudget) String() string { return proto.CompactTextString(m) }
+func (*TrainingBudget) ProtoMessage()    {}
+func (*TrainingBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a3d50542a135bda, []int{1}
+}
+func (m *TrainingBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrainingBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrainingBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrainingBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrainingBudget.Merge(m, src)
+}
+func (m *TrainingBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrainingBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrainingBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrainingBudget proto.InternalMessageInfo
+
+func (m *TrainingBudget) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *TrainingBudget) GetPaymentPerNodeEpoch() uint64 {
+	if m != nil {
+		return m.PaymentPerNodeEpoch
+	}
+	return 0
+}
+
+func (m *TrainingBudget) GetPaid() uint64 {
+	if m != nil {
+		return m.Paid
+	}
+	return 0
+}
+
+func (m *TrainingBudget) GetRefunded() uint64 {
+	if m != nil {
+		return m.Refunded
+	}
+	return 0
+}
+
+func (m *TrainingBudget) GetNextOuterStepToSettle() int32 {
+	if m != nil {
+		return m.NextOuterStepToSettle
+	}
+	return 0
+}
+
 type TrainingHardwareResources struct {
 	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
@@ -155,7 +251,7 @@ func (m *TrainingHardwareResources) Reset()         { *m = TrainingHardwareResou
 func (m *TrainingHardwareResources) String() string { return proto.CompactTextString(m) }
 func (*TrainingHardwareResources) ProtoMessage()    {}
 func (*TrainingHardwareResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a3d50542a135bda, []int{1}
+	return fileDescriptor_8a3d50542a135bda, []int{2}
 }
 func (m *TrainingHardwareResources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +303,7 @@ func (m *TrainingConfig) Reset()         { *m = TrainingConfig{} }
 func (m *TrainingConfig) String() string { return proto.CompactTextString(m) }
 func (*TrainingConfig) ProtoMessage()    {}
 func (*TrainingConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a3d50542a135bda, []int{2}
+	return fileDescriptor_8a3d50542a135bda, []int{3}
 }
 func (m *TrainingConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,7 +355,7 @@ func (m *TrainingDatasets) Reset()         { *m = TrainingDatasets{} }
 func (m *TrainingDatasets) String() string { return proto.CompactTextString(m) }
 func (*TrainingDatasets) ProtoMessage()    {}
 func (*TrainingDatasets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a3d50542a135bda, []int{3}
+	return fileDescriptor_8a3d50542a135bda, []int{4}
 }
 func (m *TrainingDatasets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,7 +407,7 @@ func (m *TrainingTaskAssignee) Reset()         { *m = TrainingTaskAssignee{} }
 func (m *TrainingTaskAssignee) String() string { return proto.CompactTextString(m) }
 func (*TrainingTaskAssignee) ProtoMessage()    {}
 func (*TrainingTaskAssignee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a3d50542a135bda, []int{4}
+	return fileDescriptor_8a3d50542a135bda, []int{5}
 }
 func (m *TrainingTaskAssignee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,7 +461,7 @@ func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
 func (m *EpochInfo) String() string { return proto.CompactTextString(m) }
 func (*EpochInfo) ProtoMessage()    {}
 func (*EpochInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a3d50542a135bda, []int{5}
+	return fileDescriptor_8a3d50542a135bda, []int{6}
 }
 func (m *EpochInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,6 +520,7 @@ func (m *EpochInfo) GetLastEpochIsFinished() bool {
 
 func init() {
 	proto.RegisterType((*TrainingTask)(nil), "inference.inference.TrainingTask")
+	proto.RegisterType((*TrainingBudget)(nil), "inference.inference.TrainingBudget")
 	proto.RegisterType((*TrainingHardwareResources)(nil), "inference.inference.TrainingHardwareResources")
 	proto.RegisterType((*TrainingConfig)(nil), "inference.inference.TrainingConfig")
 	proto.RegisterType((*TrainingDatasets)(nil), "inference.inference.TrainingDatasets")
@@ -436,48 +533,56 @@ func init() {
 }
 
 var fileDescriptor_8a3d50542a135bda = []byte{
-	// 644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4f, 0x4f, 0x1b, 0x39,
-	0x14, 0x67, 0x48, 0x02, 0x99, 0x17, 0x16, 0xed, 0x9a, 0xb0, 0x3b, 0xac, 0xd4, 0x51, 0x1a, 0x54,
-	0x35, 0xbd, 0x84, 0x0a, 0x8a, 0x7a, 0x68, 0x2f, 0xa4, 0xa5, 0x05, 0xf5, 0x50, 0xc9, 0xd0, 0x4b,
-	0xa5, 0x6a, 0xe4, 0x78, 0x9c, 0x8c, 0x05, 0x63, 0x4f, 0xc7, 0x1e, 0xb5, 0xf9, 0x0e, 0x3d, 0xf4,
-	0x63, 0x71, 0xe4, 0xd8, 0x63, 0x05, 0xf7, 0x7e, 0x86, 0xca, 0x8e, 0x67, 0x48, 0x20, 0xe5, 0x66,
-	0xbf, 0xdf, 0x1f, 0xbf, 0x67, 0xbf, 0x67, 0x78, 0xcc, 0xc5, 0x88, 0xe5, 0x4c, 0x50, 0xb6, 0x73,
-	0xb3, 0xd2, 0x39, 0xe1, 0x82, 0x8b, 0x71, 0xa4, 0x89, 0x3a, 0xeb, 0x67, 0xb9, 0xd4, 0x12, 0x6d,
-	0x54, 0x70, 0xbf, 0x5a, 0x75, 0x7f, 0xd5, 0x61, 0xed, 0xd4, 0x91, 0x4f, 0x89, 0x3a, 0x43, 0xeb,
-	0xb0, 0xcc, 0xe3, 0xc0, 0xeb, 0x78, 0xbd, 0x3a, 0x5e, 0xe6, 0x31, 0x7a, 0x08, 0x6b, 0x39, 0xfb,
-	0x5c, 0x30, 0xa5, 0x59, 0x1c, 0x0d, 0x27, 0xc1, 0x72, 0xc7, 0xeb, 0xf9, 0xb8, 0x55, 0xc5, 0x06,
-	0x13, 0xb4, 0x0f, 0xff, 0xd1, 0x9c, 0x11, 0x43, 0x20, 0x3a, 0x1a, 0x9e, 0x4b, 0x7a, 0x16, 0x25,
-	0x8c, 0x8f, 0x13, 0x1d, 0xd4, 0xac, 0x4f, 0xdb, 0xc1, 0x07, 0x7a, 0x60, 0xc0, 0x23, 0x8b, 0xa1,
-	0xff, 0xa1, 0x49, 0x94, 0xe2, 0x63, 0xc1, 0xf2, 0xa0, 0x6e, 0x5d, 0xab, 0x3d, 0x7a, 0x07, 0xdb,
-	0xf4, 0x9c, 0xf0, 0xd4, 0x9e, 0x19, 0x95, 0xe1, 0x3b, 0xf6, 0x0d, 0x6b, 0x1f, 0x3a, 0xea, 0x60,
-	0x72, 0xe0, 0x88, 0xf3, 0x07, 0x3d, 0x87, 0xc0, 0x39, 0xdc, 0x4d, 0x70, 0xc5, 0x3a, 0x6c, 0x96,
-	0xf8, 0x1d, 0xe1, 0x88, 0x0b, 0xae, 0x92, 0x05, 0xc2, 0xd5, 0xa9, 0xb0, 0xc4, 0xe7, 0x85, 0x9f,
-	0x00, 0x25, 0x24, 0x8f, 0xbf, 0x90, 0x9c, 0x45, 0x39, 0x53, 0xb2, 0xc8, 0x29, 0x53, 0x41, 0xb3,
-	0x53, 0xeb, 0xb5, 0x76, 0xfb, 0xfd, 0x05, 0xef, 0xd0, 0x2f, 0xdf, 0xe0, 0xc8, 0xc9, 0x70, 0xa9,
-	0xc2, 0xff, 0x24, 0xb7, 0x43, 0xe8, 0x05, 0xac, 0x50, 0x29, 0x46, 0x7c, 0x1c, 0xf8, 0x1d, 0xaf,
-	0xd7, 0xda, 0xdd, 0xbe, 0xd7, 0xf2, 0x95, 0xa5, 0x62, 0x27, 0x41, 0x6f, 0xc1, 0x77, 0xd5, 0x32,
-	0x15, 0x80, 0x4d, 0xe9, 0xc9, 0xbd, 0x7a, 0xd3, 0x16, 0xee, 0x62, 0x19, 0xbe, 0xd1, 0xa2, 0x67,
-	0xd0, 0x60, 0x99, 0xa4, 0x49, 0xd0, 0xb2, 0x49, 0x84, 0x0b, 0x4d, 0x0e, 0x0d, 0xe3, 0x58, 0x8c,
-	0x24, 0x9e, 0x92, 0xbb, 0x87, 0xb0, 0xf5, 0xc7, 0x5a, 0x11, 0x82, 0xba, 0x9e, 0x64, 0xcc, 0xb6,
-	0x9f, 0x8f, 0xed, 0x1a, 0xb5, 0xa1, 0x41, 0x65, 0x21, 0xb4, 0xed, 0xbc, 0xbf, 0xf0, 0x74, 0xd3,
-	0xfd, 0xe6, 0xc1, 0xfa, 0x7c, 0x81, 0xe8, 0x00, 0x9a, 0x31, 0xd1, 0x44, 0x31, 0xad, 0xac, 0x41,
-	0x6b, 0xf7, 0xd1, 0xbd, 0x75, 0xbd, 0x76, 0x64, 0x5c, 0xc9, 0xcc, 0x83, 0x8b, 0x22, 0x8d, 0x0a,
-	0x49, 0x23, 0xa6, 0x34, 0x4f, 0x89, 0xe6, 0x52, 0x44, 0x4a, 0xb3, 0x4c, 0xb9, 0xe3, 0x37, 0x45,
-	0x91, 0x7e, 0x90, 0xf4, 0xb0, 0x42, 0x4f, 0x0c, 0xd8, 0x7d, 0x09, 0x7f, 0xdf, 0xb6, 0x35, 0x89,
-	0xdb, 0x31, 0x74, 0xd5, 0x4c, 0x37, 0xb6, 0x44, 0xa6, 0xb4, 0x9b, 0x23, 0xbb, 0xee, 0x9e, 0x40,
-	0x7b, 0xd1, 0x65, 0xa3, 0x0e, 0xb4, 0x32, 0x92, 0x6b, 0x4e, 0x79, 0x46, 0x84, 0x76, 0x3e, 0xb3,
-	0x21, 0xb4, 0x05, 0x4d, 0x21, 0x63, 0x16, 0xf1, 0xd8, 0x24, 0x58, 0xeb, 0xf9, 0x78, 0xd5, 0xec,
-	0x8f, 0x63, 0xd5, 0xbd, 0xf0, 0xc0, 0xaf, 0x6e, 0x1f, 0x3d, 0x00, 0x38, 0x27, 0x4a, 0x47, 0xd3,
-	0x17, 0x33, 0x4e, 0x0d, 0xec, 0x9b, 0x88, 0xa5, 0x98, 0x11, 0xbe, 0x81, 0xe7, 0x1b, 0xdd, 0x24,
-	0x5a, 0xc3, 0xed, 0x8a, 0x3b, 0xdb, 0xe7, 0x4f, 0xa1, 0x3d, 0x23, 0xd3, 0x3c, 0x65, 0x4a, 0x93,
-	0x34, 0xb3, 0x63, 0x5f, 0xc3, 0xa8, 0xd2, 0x9c, 0x96, 0x08, 0xda, 0x83, 0x7f, 0x67, 0x14, 0x5c,
-	0x45, 0xe5, 0x00, 0xd9, 0x2f, 0xa0, 0x89, 0x37, 0x2a, 0xcd, 0xb1, 0x7a, 0xe3, 0xa0, 0xc1, 0xfb,
-	0x8b, 0xab, 0xd0, 0xbb, 0xbc, 0x0a, 0xbd, 0x9f, 0x57, 0xa1, 0xf7, 0xfd, 0x3a, 0x5c, 0xba, 0xbc,
-	0x0e, 0x97, 0x7e, 0x5c, 0x87, 0x4b, 0x1f, 0xf7, 0xc7, 0x5c, 0x27, 0xc5, 0xb0, 0x4f, 0x65, 0xba,
-	0x93, 0xe5, 0x32, 0x2e, 0xa8, 0x56, 0x94, 0xdf, 0xfa, 0x0c, 0xbf, 0xce, 0x7e, 0x8c, 0x93, 0x8c,
-	0xa9, 0xe1, 0x8a, 0xfd, 0x11, 0xf7, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x93, 0xb0, 0x85,
-	0x3c, 0x05, 0x00, 0x00,
+	// 780 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4f, 0x6f, 0x23, 0x35,
+	0x14, 0xef, 0x34, 0x4d, 0x37, 0x71, 0xba, 0x2b, 0x70, 0xd3, 0xdd, 0x29, 0x12, 0x51, 0xc8, 0x0a,
+	0x11, 0x2e, 0x59, 0xd4, 0xa5, 0x02, 0x04, 0x97, 0x06, 0x0a, 0x5b, 0x21, 0xb1, 0xc8, 0x2d, 0x17,
+	0x24, 0x64, 0x39, 0x9e, 0x97, 0xc4, 0x6a, 0x62, 0x0f, 0xf6, 0x1b, 0xb1, 0xf9, 0x0e, 0x1c, 0xf8,
+	0x52, 0x48, 0x7b, 0xec, 0x91, 0x23, 0x6a, 0x25, 0x3e, 0x07, 0xb2, 0xc7, 0x99, 0x26, 0x6d, 0xa8,
+	0xb8, 0xd9, 0xef, 0xf7, 0x67, 0xde, 0xcb, 0xfb, 0x65, 0x86, 0x7c, 0xa4, 0xf4, 0x18, 0x2c, 0x68,
+	0x09, 0x2f, 0x6e, 0x4f, 0x68, 0x85, 0xd2, 0x4a, 0x4f, 0x38, 0x0a, 0x77, 0x39, 0xc8, 0xad, 0x41,
+	0x43, 0xf7, 0x2b, 0x78, 0x50, 0x9d, 0x7a, 0xff, 0xd4, 0xc9, 0xde, 0x45, 0x24, 0x5f, 0x08, 0x77,
+	0x49, 0x9f, 0x90, 0x6d, 0x95, 0xa5, 0x49, 0x37, 0xe9, 0xef, 0xb0, 0x6d, 0x95, 0xd1, 0x0f, 0xc8,
+	0x9e, 0x85, 0x5f, 0x0b, 0x70, 0x08, 0x19, 0x1f, 0x2d, 0xd2, 0xed, 0x6e, 0xd2, 0x6f, 0xb2, 0x56,
+	0x55, 0x1b, 0x2e, 0xe8, 0x31, 0x79, 0x26, 0x2d, 0x08, 0x4f, 0x10, 0xc8, 0x47, 0x33, 0x23, 0x2f,
+	0xf9, 0x14, 0xd4, 0x64, 0x8a, 0x69, 0x2d, 0xf8, 0xb4, 0x23, 0x7c, 0x82, 0x43, 0x0f, 0xbe, 0x0a,
+	0x18, 0x7d, 0x8f, 0x34, 0x84, 0x73, 0x6a, 0xa2, 0xc1, 0xa6, 0x3b, 0xc1, 0xb5, 0xba, 0xd3, 0xef,
+	0xc9, 0x73, 0x39, 0x13, 0x6a, 0x1e, 0x9e, 0xc9, 0x97, 0xe5, 0x7b, 0xf6, 0xf5, 0x60, 0xdf, 0x89,
+	0xd4, 0xe1, 0xe2, 0x24, 0x12, 0xd7, 0x1f, 0xf4, 0x19, 0x49, 0xa3, 0xc3, 0xfd, 0x06, 0x77, 0x83,
+	0xc3, 0xc1, 0x12, 0xbf, 0x27, 0x1c, 0x2b, 0xad, 0xdc, 0x74, 0x83, 0xf0, 0x51, 0x29, 0x5c, 0xe2,
+	0xeb, 0xc2, 0x5f, 0x08, 0x9d, 0x0a, 0x9b, 0xfd, 0x26, 0x2c, 0x70, 0x0b, 0xce, 0x14, 0x56, 0x82,
+	0x4b, 0x1b, 0xdd, 0x5a, 0xbf, 0x75, 0x34, 0x18, 0x6c, 0xd8, 0xc3, 0x60, 0xb9, 0x83, 0x57, 0x51,
+	0xc6, 0x96, 0x2a, 0xf6, 0xee, 0xf4, 0x6e, 0x89, 0x7e, 0x49, 0x76, 0xa5, 0xd1, 0x63, 0x35, 0x49,
+	0x9b, 0xdd, 0xa4, 0xdf, 0x3a, 0x7a, 0xfe, 0xa0, 0xe5, 0xd7, 0x81, 0xca, 0xa2, 0x84, 0x7e, 0x47,
+	0x9a, 0x71, 0x5a, 0x70, 0x29, 0x09, 0x2d, 0x7d, 0xfc, 0xa0, 0xde, 0xc7, 0x22, 0xfe, 0xb0, 0xc0,
+	0x6e, 0xb5, 0xf4, 0x53, 0x52, 0x87, 0xdc, 0xc8, 0x69, 0xda, 0x0a, 0x4d, 0x74, 0x36, 0x9a, 0x9c,
+	0x7a, 0xc6, 0x99, 0x1e, 0x1b, 0x56, 0x92, 0x7d, 0xef, 0xa3, 0x22, 0x9b, 0x00, 0xa6, 0x7b, 0xff,
+	0xa3, 0xf7, 0x61, 0xa0, 0xb2, 0x28, 0xa1, 0x5f, 0x90, 0x43, 0x29, 0xb4, 0x84, 0xd9, 0x6c, 0xc3,
+	0x46, 0x1e, 0x87, 0x8d, 0x3c, 0xad, 0x08, 0x6b, 0x2b, 0xe9, 0xfd, 0x99, 0x90, 0x27, 0xeb, 0xae,
+	0xb4, 0x4d, 0xea, 0x68, 0x50, 0xcc, 0x62, 0xda, 0xcb, 0x0b, 0x7d, 0x49, 0x9e, 0xe6, 0x62, 0x31,
+	0x07, 0x8d, 0x3c, 0x07, 0xcb, 0xb5, 0xc9, 0x80, 0x97, 0x73, 0x6e, 0x07, 0xda, 0x7e, 0x44, 0x7f,
+	0x04, 0xfb, 0x83, 0xc9, 0x20, 0x0c, 0x48, 0x29, 0xd9, 0xc9, 0x85, 0xca, 0x62, 0xde, 0xc3, 0xd9,
+	0xe7, 0xdb, 0xc2, 0xb8, 0xd0, 0x19, 0x64, 0x21, 0xdf, 0x3b, 0xac, 0xba, 0xd3, 0xcf, 0xc9, 0xa1,
+	0x86, 0x37, 0xc8, 0x4d, 0x81, 0x60, 0xb9, 0x43, 0xc8, 0x39, 0x1a, 0xee, 0x00, 0x71, 0x06, 0x21,
+	0xd5, 0x75, 0x76, 0xe0, 0x09, 0xaf, 0x3d, 0x7e, 0x8e, 0x90, 0x5f, 0x98, 0xf3, 0x00, 0xf6, 0x4e,
+	0xc9, 0xe1, 0x7f, 0x66, 0xc5, 0xb7, 0x81, 0x8b, 0x1c, 0xc2, 0x40, 0x4d, 0x16, 0xce, 0x7e, 0x4a,
+	0x69, 0x0a, 0x8d, 0xa1, 0xfd, 0xc7, 0xac, 0xbc, 0xf4, 0x7e, 0x5f, 0xf9, 0x39, 0xca, 0x80, 0xd0,
+	0x13, 0xd2, 0xc8, 0x04, 0x0a, 0x07, 0xe8, 0x82, 0x41, 0xeb, 0xe8, 0xc3, 0x07, 0x77, 0xf3, 0x4d,
+	0x24, 0xb3, 0x4a, 0xe6, 0xff, 0x30, 0xba, 0x98, 0xf3, 0xc2, 0x48, 0x0e, 0x0e, 0xd5, 0x5c, 0xa0,
+	0x32, 0x3a, 0x8c, 0xe7, 0xe2, 0xe3, 0x0f, 0x74, 0x31, 0xff, 0xc9, 0xc8, 0xd3, 0x0a, 0xf5, 0xc3,
+	0xb9, 0xde, 0x57, 0xe4, 0x9d, 0xbb, 0xb6, 0x61, 0x3d, 0xbe, 0x16, 0xa7, 0x29, 0x2f, 0x61, 0x44,
+	0x70, 0x18, 0xdf, 0x43, 0xe1, 0xdc, 0x3b, 0x27, 0xed, 0x4d, 0x61, 0xa5, 0x5d, 0xd2, 0xca, 0x85,
+	0x45, 0x25, 0x55, 0x2e, 0x34, 0x46, 0x9f, 0xd5, 0x12, 0x3d, 0x24, 0x8d, 0xb0, 0x60, 0x95, 0xf9,
+	0x06, 0x6b, 0xfd, 0x26, 0x7b, 0xe4, 0xef, 0x67, 0x99, 0xeb, 0xbd, 0x4d, 0x48, 0xb3, 0x4a, 0x2f,
+	0x7d, 0x9f, 0x90, 0x99, 0x70, 0x18, 0x93, 0x90, 0x84, 0x0d, 0x35, 0x7d, 0xa5, 0xdc, 0xff, 0x31,
+	0x79, 0x76, 0x0b, 0xaf, 0xc7, 0xd2, 0x37, 0x5a, 0x63, 0xed, 0x8a, 0xbb, 0xfa, 0x9e, 0xf8, 0x84,
+	0xb4, 0x57, 0x64, 0xa8, 0xe6, 0xe0, 0x50, 0xcc, 0xf3, 0x10, 0xa3, 0x1a, 0xa3, 0x95, 0xe6, 0x62,
+	0x89, 0xf8, 0x74, 0xae, 0x28, 0x94, 0xe3, 0xcb, 0x17, 0x50, 0x88, 0x58, 0x83, 0xed, 0x57, 0x9a,
+	0x33, 0xf7, 0x6d, 0x84, 0x86, 0xaf, 0xdf, 0x5e, 0x77, 0x92, 0xab, 0xeb, 0x4e, 0xf2, 0xf7, 0x75,
+	0x27, 0xf9, 0xe3, 0xa6, 0xb3, 0x75, 0x75, 0xd3, 0xd9, 0xfa, 0xeb, 0xa6, 0xb3, 0xf5, 0xf3, 0xf1,
+	0x44, 0xe1, 0xb4, 0x18, 0x0d, 0xa4, 0x99, 0xbf, 0xc8, 0xad, 0xc9, 0x0a, 0x89, 0x4e, 0xaa, 0x3b,
+	0x1f, 0x93, 0x37, 0xab, 0x1f, 0x96, 0x45, 0x0e, 0x6e, 0xb4, 0x1b, 0xbe, 0x28, 0x2f, 0xff, 0x0d,
+	0x00, 0x00, 0xff, 0xff, 0x5e, 0x17, 0x81, 0x15, 0x7c, 0x06, 0x00, 0x00,
 }
 
 func (m *TrainingTask) Marshal() (dAtA []byte, err error) {
@@ -500,6 +605,23 @@ func (m *TrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CancelledAtBlockHeight != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.CancelledAtBlockHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Budget != nil {
+		{
+			size, err := m.Budget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTrainingTask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.Epoch != nil {
 		{
 			size, err := m.Epoch.MarshalToSizedBuffer(dAtA[:i])
@@ -594,6 +716,54 @@ func (m *TrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TrainingBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrainingBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrainingBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextOuterStepToSettle != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.NextOuterStepToSettle))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Refunded != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.Refunded))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Paid != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.Paid))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PaymentPerNodeEpoch != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.PaymentPerNodeEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintTrainingTask(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TrainingHardwareResources) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -853,6 +1023,37 @@ func (m *TrainingTask) Size() (n int) {
 		l = m.Epoch.Size()
 		n += 1 + l + sovTrainingTask(uint64(l))
 	}
+	if m.Budget != nil {
+		l = m.Budget.Size()
+		n += 1 + l + sovTrainingTask(uint64(l))
+	}
+	if m.CancelledAtBlockHeight != 0 {
+		n += 1 + sovTrainingTask(uint64(m.CancelledAtBlockHeight))
+	}
+	return n
+}
+
+func (m *TrainingBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovTrainingTask(uint64(m.Total))
+	}
+	if m.PaymentPerNodeEpoch != 0 {
+		n += 1 + sovTrainingTask(uint64(m.PaymentPerNodeEpoch))
+	}
+	if m.Paid != 0 {
+		n += 1 + sovTrainingTask(uint64(m.Paid))
+	}
+	if m.Refunded != 0 {
+		n += 1 + sovTrainingTask(uint64(m.Refunded))
+	}
+	if m.NextOuterStepToSettle != 0 {
+		n += 1 + sovTrainingTask(uint64(m.NextOuterStepToSettle))
+	}
 	return n
 }
 
@@ -1279,6 +1480,206 @@ func (m *TrainingTask) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Budget == nil {
+				m.Budget = &TrainingBudget{}
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAtBlockHeight", wireType)
+			}
+			m.CancelledAtBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancelledAtBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrainingTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrainingTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrainingBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrainingTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrainingBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrainingBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentPerNodeEpoch", wireType)
+			}
+			m.PaymentPerNodeEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentPerNodeEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			m.Paid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Paid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			m.Refunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Refunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOuterStepToSettle", wireType)
+			}
+			m.NextOuterStepToSettle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrainingTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOuterStepToSettle |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrainingTask(dAtA[iNdEx:])
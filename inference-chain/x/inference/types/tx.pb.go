@@ -354,22 +354,23 @@ func (m *MsgStartInferenceResponse) GetErrorMessage() string {
 }
 
 type MsgFinishInference struct {
-	Creator              string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	InferenceId          string `protobuf:"bytes,2,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
-	ResponseHash         string `protobuf:"bytes,3,opt,name=response_hash,json=responseHash,proto3" json:"response_hash,omitempty"`
-	ResponsePayload      string `protobuf:"bytes,4,opt,name=response_payload,json=responsePayload,proto3" json:"response_payload,omitempty"` // Deprecated: Do not use.
-	PromptTokenCount     uint64 `protobuf:"varint,5,opt,name=prompt_token_count,json=promptTokenCount,proto3" json:"prompt_token_count,omitempty"`
-	CompletionTokenCount uint64 `protobuf:"varint,6,opt,name=completion_token_count,json=completionTokenCount,proto3" json:"completion_token_count,omitempty"`
-	ExecutedBy           string `protobuf:"bytes,7,opt,name=executed_by,json=executedBy,proto3" json:"executed_by,omitempty"`
-	TransferredBy        string `protobuf:"bytes,8,opt,name=transferred_by,json=transferredBy,proto3" json:"transferred_by,omitempty"`
-	RequestTimestamp     int64  `protobuf:"varint,9,opt,name=request_timestamp,json=requestTimestamp,proto3" json:"request_timestamp,omitempty"`
-	TransferSignature    string `protobuf:"bytes,10,opt,name=transfer_signature,json=transferSignature,proto3" json:"transfer_signature,omitempty"`
-	ExecutorSignature    string `protobuf:"bytes,11,opt,name=executor_signature,json=executorSignature,proto3" json:"executor_signature,omitempty"`
-	RequestedBy          string `protobuf:"bytes,12,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
-	OriginalPrompt       string `protobuf:"bytes,13,opt,name=original_prompt,json=originalPrompt,proto3" json:"original_prompt,omitempty"` // Deprecated: Do not use.
-	Model                string `protobuf:"bytes,14,opt,name=model,proto3" json:"model,omitempty"`
-	PromptHash           string `protobuf:"bytes,15,opt,name=prompt_hash,json=promptHash,proto3" json:"prompt_hash,omitempty"`
-	OriginalPromptHash   string `protobuf:"bytes,16,opt,name=original_prompt_hash,json=originalPromptHash,proto3" json:"original_prompt_hash,omitempty"`
+	Creator              string                    `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	InferenceId          string                    `protobuf:"bytes,2,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	ResponseHash         string                    `protobuf:"bytes,3,opt,name=response_hash,json=responseHash,proto3" json:"response_hash,omitempty"`
+	ResponsePayload      string                    `protobuf:"bytes,4,opt,name=response_payload,json=responsePayload,proto3" json:"response_payload,omitempty"` // Deprecated: Do not use.
+	PromptTokenCount     uint64                    `protobuf:"varint,5,opt,name=prompt_token_count,json=promptTokenCount,proto3" json:"prompt_token_count,omitempty"`
+	CompletionTokenCount uint64                    `protobuf:"varint,6,opt,name=completion_token_count,json=completionTokenCount,proto3" json:"completion_token_count,omitempty"`
+	ExecutedBy           string                    `protobuf:"bytes,7,opt,name=executed_by,json=executedBy,proto3" json:"executed_by,omitempty"`
+	TransferredBy        string                    `protobuf:"bytes,8,opt,name=transferred_by,json=transferredBy,proto3" json:"transferred_by,omitempty"`
+	RequestTimestamp     int64                     `protobuf:"varint,9,opt,name=request_timestamp,json=requestTimestamp,proto3" json:"request_timestamp,omitempty"`
+	TransferSignature    string                    `protobuf:"bytes,10,opt,name=transfer_signature,json=transferSignature,proto3" json:"transfer_signature,omitempty"`
+	ExecutorSignature    string                    `protobuf:"bytes,11,opt,name=executor_signature,json=executorSignature,proto3" json:"executor_signature,omitempty"`
+	RequestedBy          string                    `protobuf:"bytes,12,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	OriginalPrompt       string                    `protobuf:"bytes,13,opt,name=original_prompt,json=originalPrompt,proto3" json:"original_prompt,omitempty"` // Deprecated: Do not use.
+	Model                string                    `protobuf:"bytes,14,opt,name=model,proto3" json:"model,omitempty"`
+	PromptHash           string                    `protobuf:"bytes,15,opt,name=prompt_hash,json=promptHash,proto3" json:"prompt_hash,omitempty"`
+	OriginalPromptHash   string                    `protobuf:"bytes,16,opt,name=original_prompt_hash,json=originalPromptHash,proto3" json:"original_prompt_hash,omitempty"`
+	CompletionStatus     InferenceCompletionStatus `protobuf:"varint,17,opt,name=completion_status,json=completionStatus,proto3,enum=inference.inference.InferenceCompletionStatus" json:"completion_status,omitempty"`
 }
 
 func (m *MsgFinishInference) Reset()         { *m = MsgFinishInference{} }
@@ -519,6 +520,13 @@ func (m *MsgFinishInference) GetOriginalPromptHash() string {
 	return ""
 }
 
+func (m *MsgFinishInference) GetCompletionStatus() InferenceCompletionStatus {
+	if m != nil {
+		return m.CompletionStatus
+	}
+	return InferenceCompletionStatus_COMPLETION_STATUS_COMPLETE
+}
+
 type MsgFinishInferenceResponse struct {
 	InferenceIndex string `protobuf:"bytes,1,opt,name=inference_index,json=inferenceIndex,proto3" json:"inference_index,omitempty"`
 	ErrorMessage   string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
@@ -1253,6 +1261,11 @@ type MsgSubmitPocBatch struct {
 	Nonces                   []int64   `protobuf:"varint,4,rep,packed,name=nonces,proto3" json:"nonces,omitempty"`
 	Dist                     []float64 `protobuf:"fixed64,5,rep,packed,name=dist,proto3" json:"dist,omitempty"`
 	NodeId                   string    `protobuf:"bytes,6,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	MerkleRoot               []byte    `protobuf:"bytes,7,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	NonceCount               uint32    `protobuf:"varint,8,opt,name=nonce_count,json=nonceCount,proto3" json:"nonce_count,omitempty"`
+	FirstNonce               int64     `protobuf:"varint,9,opt,name=first_nonce,json=firstNonce,proto3" json:"first_nonce,omitempty"`
+	LastNonce                int64     `protobuf:"varint,10,opt,name=last_nonce,json=lastNonce,proto3" json:"last_nonce,omitempty"`
+	NonceStride              int64     `protobuf:"varint,11,opt,name=nonce_stride,json=nonceStride,proto3" json:"nonce_stride,omitempty"`
 }
 
 func (m *MsgSubmitPocBatch) Reset()         { *m = MsgSubmitPocBatch{} }
@@ -1330,6 +1343,41 @@ func (m *MsgSubmitPocBatch) GetNodeId() string {
 	return ""
 }
 
+func (m *MsgSubmitPocBatch) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *MsgSubmitPocBatch) GetNonceCount() uint32 {
+	if m != nil {
+		return m.NonceCount
+	}
+	return 0
+}
+
+func (m *MsgSubmitPocBatch) GetFirstNonce() int64 {
+	if m != nil {
+		return m.FirstNonce
+	}
+	return 0
+}
+
+func (m *MsgSubmitPocBatch) GetLastNonce() int64 {
+	if m != nil {
+		return m.LastNonce
+	}
+	return 0
+}
+
+func (m *MsgSubmitPocBatch) GetNonceStride() int64 {
+	if m != nil {
+		return m.NonceStride
+	}
+	return 0
+}
+
 type MsgSubmitPocBatchResponse struct {
 }
 
@@ -1367,17 +1415,18 @@ func (m *MsgSubmitPocBatchResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgSubmitPocBatchResponse proto.InternalMessageInfo
 
 type MsgSubmitPocValidation struct {
-	Creator                  string    `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ParticipantAddress       string    `protobuf:"bytes,2,opt,name=participant_address,json=participantAddress,proto3" json:"participant_address,omitempty"`
-	PocStageStartBlockHeight int64     `protobuf:"varint,3,opt,name=poc_stage_start_block_height,json=pocStageStartBlockHeight,proto3" json:"poc_stage_start_block_height,omitempty"`
-	Nonces                   []int64   `protobuf:"varint,4,rep,packed,name=nonces,proto3" json:"nonces,omitempty"`
-	Dist                     []float64 `protobuf:"fixed64,5,rep,packed,name=dist,proto3" json:"dist,omitempty"`
-	ReceivedDist             []float64 `protobuf:"fixed64,6,rep,packed,name=received_dist,json=receivedDist,proto3" json:"received_dist,omitempty"`
-	RTarget                  float64   `protobuf:"fixed64,7,opt,name=r_target,json=rTarget,proto3" json:"r_target,omitempty"`
-	FraudThreshold           float64   `protobuf:"fixed64,8,opt,name=fraud_threshold,json=fraudThreshold,proto3" json:"fraud_threshold,omitempty"`
-	NInvalid                 int64     `protobuf:"varint,9,opt,name=n_invalid,json=nInvalid,proto3" json:"n_invalid,omitempty"`
-	ProbabilityHonest        float64   `protobuf:"fixed64,10,opt,name=probability_honest,json=probabilityHonest,proto3" json:"probability_honest,omitempty"`
-	FraudDetected            bool      `protobuf:"varint,11,opt,name=fraud_detected,json=fraudDetected,proto3" json:"fraud_detected,omitempty"`
+	Creator                  string           `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ParticipantAddress       string           `protobuf:"bytes,2,opt,name=participant_address,json=participantAddress,proto3" json:"participant_address,omitempty"`
+	PocStageStartBlockHeight int64            `protobuf:"varint,3,opt,name=poc_stage_start_block_height,json=pocStageStartBlockHeight,proto3" json:"poc_stage_start_block_height,omitempty"`
+	Nonces                   []int64          `protobuf:"varint,4,rep,packed,name=nonces,proto3" json:"nonces,omitempty"`
+	Dist                     []float64        `protobuf:"fixed64,5,rep,packed,name=dist,proto3" json:"dist,omitempty"`
+	ReceivedDist             []float64        `protobuf:"fixed64,6,rep,packed,name=received_dist,json=receivedDist,proto3" json:"received_dist,omitempty"`
+	RTarget                  float64          `protobuf:"fixed64,7,opt,name=r_target,json=rTarget,proto3" json:"r_target,omitempty"`
+	FraudThreshold           float64          `protobuf:"fixed64,8,opt,name=fraud_threshold,json=fraudThreshold,proto3" json:"fraud_threshold,omitempty"`
+	NInvalid                 int64            `protobuf:"varint,9,opt,name=n_invalid,json=nInvalid,proto3" json:"n_invalid,omitempty"`
+	ProbabilityHonest        float64          `protobuf:"fixed64,10,opt,name=probability_honest,json=probabilityHonest,proto3" json:"probability_honest,omitempty"`
+	FraudDetected            bool             `protobuf:"varint,11,opt,name=fraud_detected,json=fraudDetected,proto3" json:"fraud_detected,omitempty"`
+	Disputes                 []PoCLeafDispute `protobuf:"bytes,12,rep,name=disputes,proto3" json:"disputes"`
 }
 
 func (m *MsgSubmitPocValidation) Reset()         { *m = MsgSubmitPocValidation{} }
@@ -1490,6 +1539,13 @@ func (m *MsgSubmitPocValidation) GetFraudDetected() bool {
 	return false
 }
 
+func (m *MsgSubmitPocValidation) GetDisputes() []PoCLeafDispute {
+	if m != nil {
+		return m.Disputes
+	}
+	return nil
+}
+
 type MsgSubmitPocValidationResponse struct {
 }
 
@@ -1725,6 +1781,10 @@ type MsgRegisterModel struct {
 	VRam                   uint64   `protobuf:"varint,8,opt,name=v_ram,json=vRam,proto3" json:"v_ram,omitempty"`
 	ThroughputPerNonce     uint64   `protobuf:"varint,9,opt,name=throughput_per_nonce,json=throughputPerNonce,proto3" json:"throughput_per_nonce,omitempty"`
 	ValidationThreshold    *Decimal `protobuf:"bytes,10,opt,name=validation_threshold,json=validationThreshold,proto3" json:"validation_threshold,omitempty"`
+	ContextWindow          uint64   `protobuf:"varint,11,opt,name=context_window,json=contextWindow,proto3" json:"context_window,omitempty"`
+	Quantization           string   `protobuf:"bytes,12,opt,name=quantization,proto3" json:"quantization,omitempty"`
+	CoinsPerInputToken     uint64   `protobuf:"varint,13,opt,name=coins_per_input_token,json=coinsPerInputToken,proto3" json:"coins_per_input_token,omitempty"`
+	CoinsPerOutputToken    uint64   `protobuf:"varint,14,opt,name=coins_per_output_token,json=coinsPerOutputToken,proto3" json:"coins_per_output_token,omitempty"`
 }
 
 func (m *MsgRegisterModel) Reset()         { *m = MsgRegisterModel{} }
@@ -1830,6 +1890,34 @@ func (m *MsgRegisterModel) GetValidationThreshold() *Decimal {
 	return nil
 }
 
+func (m *MsgRegisterModel) GetContextWindow() uint64 {
+	if m != nil {
+		return m.ContextWindow
+	}
+	return 0
+}
+
+func (m *MsgRegisterModel) GetQuantization() string {
+	if m != nil {
+		return m.Quantization
+	}
+	return ""
+}
+
+func (m *MsgRegisterModel) GetCoinsPerInputToken() uint64 {
+	if m != nil {
+		return m.CoinsPerInputToken
+	}
+	return 0
+}
+
+func (m *MsgRegisterModel) GetCoinsPerOutputToken() uint64 {
+	if m != nil {
+		return m.CoinsPerOutputToken
+	}
+	return 0
+}
+
 type MsgRegisterModelResponse struct {
 }
 
@@ -1867,9 +1955,11 @@ func (m *MsgRegisterModelResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgRegisterModelResponse proto.InternalMessageInfo
 
 type MsgCreateTrainingTask struct {
-	Creator           string                       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	HardwareResources []*TrainingHardwareResources `protobuf:"bytes,2,rep,name=hardware_resources,json=hardwareResources,proto3" json:"hardware_resources,omitempty"`
-	Config            *TrainingConfig              `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Creator             string                       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	HardwareResources   []*TrainingHardwareResources `protobuf:"bytes,2,rep,name=hardware_resources,json=hardwareResources,proto3" json:"hardware_resources,omitempty"`
+	Config              *TrainingConfig              `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Budget              uint64                       `protobuf:"varint,4,opt,name=budget,proto3" json:"budget,omitempty"`
+	PaymentPerNodeEpoch uint64                       `protobuf:"varint,5,opt,name=payment_per_node_epoch,json=paymentPerNodeEpoch,proto3" json:"payment_per_node_epoch,omitempty"`
 }
 
 func (m *MsgCreateTrainingTask) Reset()         { *m = MsgCreateTrainingTask{} }
@@ -1926,6 +2016,20 @@ func (m *MsgCreateTrainingTask) GetConfig() *TrainingConfig {
 	return nil
 }
 
+func (m *MsgCreateTrainingTask) GetBudget() uint64 {
+	if m != nil {
+		return m.Budget
+	}
+	return 0
+}
+
+func (m *MsgCreateTrainingTask) GetPaymentPerNodeEpoch() uint64 {
+	if m != nil {
+		return m.PaymentPerNodeEpoch
+	}
+	return 0
+}
+
 type MsgCreateTrainingTaskResponse struct {
 	Task *TrainingTask `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 }
@@ -1970,24 +2074,23 @@ func (m *MsgCreateTrainingTaskResponse) GetTask() *TrainingTask {
 	return nil
 }
 
-type MsgSubmitHardwareDiff struct {
-	Creator       string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	NewOrModified []*HardwareNode `protobuf:"bytes,2,rep,name=newOrModified,proto3" json:"newOrModified,omitempty"`
-	Removed       []*HardwareNode `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`
+type MsgSettleTrainingPayments struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId  uint64 `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
-func (m *MsgSubmitHardwareDiff) Reset()         { *m = MsgSubmitHardwareDiff{} }
-func (m *MsgSubmitHardwareDiff) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitHardwareDiff) ProtoMessage()    {}
-func (*MsgSubmitHardwareDiff) Descriptor() ([]byte, []int) {
+func (m *MsgSettleTrainingPayments) Reset()         { *m = MsgSettleTrainingPayments{} }
+func (m *MsgSettleTrainingPayments) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleTrainingPayments) ProtoMessage()    {}
+func (*MsgSettleTrainingPayments) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{30}
 }
-func (m *MsgSubmitHardwareDiff) XXX_Unmarshal(b []byte) error {
+func (m *MsgSettleTrainingPayments) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitHardwareDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSettleTrainingPayments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitHardwareDiff.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSettleTrainingPayments.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1997,54 +2100,48 @@ func (m *MsgSubmitHardwareDiff) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitHardwareDiff) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitHardwareDiff.Merge(m, src)
+func (m *MsgSettleTrainingPayments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleTrainingPayments.Merge(m, src)
 }
-func (m *MsgSubmitHardwareDiff) XXX_Size() int {
+func (m *MsgSettleTrainingPayments) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitHardwareDiff) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitHardwareDiff.DiscardUnknown(m)
+func (m *MsgSettleTrainingPayments) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleTrainingPayments.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitHardwareDiff proto.InternalMessageInfo
+var xxx_messageInfo_MsgSettleTrainingPayments proto.InternalMessageInfo
 
-func (m *MsgSubmitHardwareDiff) GetCreator() string {
+func (m *MsgSettleTrainingPayments) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgSubmitHardwareDiff) GetNewOrModified() []*HardwareNode {
-	if m != nil {
-		return m.NewOrModified
-	}
-	return nil
-}
-
-func (m *MsgSubmitHardwareDiff) GetRemoved() []*HardwareNode {
+func (m *MsgSettleTrainingPayments) GetTaskId() uint64 {
 	if m != nil {
-		return m.Removed
+		return m.TaskId
 	}
-	return nil
+	return 0
 }
 
-type MsgSubmitHardwareDiffResponse struct {
+type MsgSettleTrainingPaymentsResponse struct {
+	Paid uint64 `protobuf:"varint,1,opt,name=paid,proto3" json:"paid,omitempty"`
 }
 
-func (m *MsgSubmitHardwareDiffResponse) Reset()         { *m = MsgSubmitHardwareDiffResponse{} }
-func (m *MsgSubmitHardwareDiffResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitHardwareDiffResponse) ProtoMessage()    {}
-func (*MsgSubmitHardwareDiffResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSettleTrainingPaymentsResponse) Reset()         { *m = MsgSettleTrainingPaymentsResponse{} }
+func (m *MsgSettleTrainingPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleTrainingPaymentsResponse) ProtoMessage()    {}
+func (*MsgSettleTrainingPaymentsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{31}
 }
-func (m *MsgSubmitHardwareDiffResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSettleTrainingPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitHardwareDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSettleTrainingPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitHardwareDiffResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSettleTrainingPaymentsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2054,35 +2151,42 @@ func (m *MsgSubmitHardwareDiffResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitHardwareDiffResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitHardwareDiffResponse.Merge(m, src)
+func (m *MsgSettleTrainingPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleTrainingPaymentsResponse.Merge(m, src)
 }
-func (m *MsgSubmitHardwareDiffResponse) XXX_Size() int {
+func (m *MsgSettleTrainingPaymentsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitHardwareDiffResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitHardwareDiffResponse.DiscardUnknown(m)
+func (m *MsgSettleTrainingPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleTrainingPaymentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitHardwareDiffResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSettleTrainingPaymentsResponse proto.InternalMessageInfo
 
-type MsgClaimTrainingTaskForAssignment struct {
+func (m *MsgSettleTrainingPaymentsResponse) GetPaid() uint64 {
+	if m != nil {
+		return m.Paid
+	}
+	return 0
+}
+
+type MsgFinishTrainingTask struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	TaskId  uint64 `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
-func (m *MsgClaimTrainingTaskForAssignment) Reset()         { *m = MsgClaimTrainingTaskForAssignment{} }
-func (m *MsgClaimTrainingTaskForAssignment) String() string { return proto.CompactTextString(m) }
-func (*MsgClaimTrainingTaskForAssignment) ProtoMessage()    {}
-func (*MsgClaimTrainingTaskForAssignment) Descriptor() ([]byte, []int) {
+func (m *MsgFinishTrainingTask) Reset()         { *m = MsgFinishTrainingTask{} }
+func (m *MsgFinishTrainingTask) String() string { return proto.CompactTextString(m) }
+func (*MsgFinishTrainingTask) ProtoMessage()    {}
+func (*MsgFinishTrainingTask) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{32}
 }
-func (m *MsgClaimTrainingTaskForAssignment) XXX_Unmarshal(b []byte) error {
+func (m *MsgFinishTrainingTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgClaimTrainingTaskForAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFinishTrainingTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgClaimTrainingTaskForAssignment.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFinishTrainingTask.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2092,51 +2196,49 @@ func (m *MsgClaimTrainingTaskForAssignment) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgClaimTrainingTaskForAssignment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgClaimTrainingTaskForAssignment.Merge(m, src)
+func (m *MsgFinishTrainingTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinishTrainingTask.Merge(m, src)
 }
-func (m *MsgClaimTrainingTaskForAssignment) XXX_Size() int {
+func (m *MsgFinishTrainingTask) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgClaimTrainingTaskForAssignment) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgClaimTrainingTaskForAssignment.DiscardUnknown(m)
+func (m *MsgFinishTrainingTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinishTrainingTask.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgClaimTrainingTaskForAssignment proto.InternalMessageInfo
+var xxx_messageInfo_MsgFinishTrainingTask proto.InternalMessageInfo
 
-func (m *MsgClaimTrainingTaskForAssignment) GetCreator() string {
+func (m *MsgFinishTrainingTask) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgClaimTrainingTaskForAssignment) GetTaskId() uint64 {
+func (m *MsgFinishTrainingTask) GetTaskId() uint64 {
 	if m != nil {
 		return m.TaskId
 	}
 	return 0
 }
 
-type MsgClaimTrainingTaskForAssignmentResponse struct {
+type MsgFinishTrainingTaskResponse struct {
+	Paid     uint64 `protobuf:"varint,1,opt,name=paid,proto3" json:"paid,omitempty"`
+	Refunded uint64 `protobuf:"varint,2,opt,name=refunded,proto3" json:"refunded,omitempty"`
 }
 
-func (m *MsgClaimTrainingTaskForAssignmentResponse) Reset() {
-	*m = MsgClaimTrainingTaskForAssignmentResponse{}
-}
-func (m *MsgClaimTrainingTaskForAssignmentResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgClaimTrainingTaskForAssignmentResponse) ProtoMessage() {}
-func (*MsgClaimTrainingTaskForAssignmentResponse) Descriptor() ([]byte, []int) {
+func (m *MsgFinishTrainingTaskResponse) Reset()         { *m = MsgFinishTrainingTaskResponse{} }
+func (m *MsgFinishTrainingTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFinishTrainingTaskResponse) ProtoMessage()    {}
+func (*MsgFinishTrainingTaskResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{33}
 }
-func (m *MsgClaimTrainingTaskForAssignmentResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgFinishTrainingTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgClaimTrainingTaskForAssignmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFinishTrainingTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgClaimTrainingTaskForAssignmentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFinishTrainingTaskResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2146,36 +2248,49 @@ func (m *MsgClaimTrainingTaskForAssignmentResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *MsgClaimTrainingTaskForAssignmentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgClaimTrainingTaskForAssignmentResponse.Merge(m, src)
+func (m *MsgFinishTrainingTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinishTrainingTaskResponse.Merge(m, src)
 }
-func (m *MsgClaimTrainingTaskForAssignmentResponse) XXX_Size() int {
+func (m *MsgFinishTrainingTaskResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgClaimTrainingTaskForAssignmentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgClaimTrainingTaskForAssignmentResponse.DiscardUnknown(m)
+func (m *MsgFinishTrainingTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinishTrainingTaskResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgClaimTrainingTaskForAssignmentResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgFinishTrainingTaskResponse proto.InternalMessageInfo
 
-type MsgAssignTrainingTask struct {
-	Creator   string                  `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId    uint64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Assignees []*TrainingTaskAssignee `protobuf:"bytes,3,rep,name=assignees,proto3" json:"assignees,omitempty"`
+func (m *MsgFinishTrainingTaskResponse) GetPaid() uint64 {
+	if m != nil {
+		return m.Paid
+	}
+	return 0
 }
 
-func (m *MsgAssignTrainingTask) Reset()         { *m = MsgAssignTrainingTask{} }
-func (m *MsgAssignTrainingTask) String() string { return proto.CompactTextString(m) }
-func (*MsgAssignTrainingTask) ProtoMessage()    {}
-func (*MsgAssignTrainingTask) Descriptor() ([]byte, []int) {
+func (m *MsgFinishTrainingTaskResponse) GetRefunded() uint64 {
+	if m != nil {
+		return m.Refunded
+	}
+	return 0
+}
+
+type MsgCancelTrainingTask struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId  uint64 `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (m *MsgCancelTrainingTask) Reset()         { *m = MsgCancelTrainingTask{} }
+func (m *MsgCancelTrainingTask) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTrainingTask) ProtoMessage()    {}
+func (*MsgCancelTrainingTask) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{34}
 }
-func (m *MsgAssignTrainingTask) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelTrainingTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAssignTrainingTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelTrainingTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAssignTrainingTask.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelTrainingTask.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2185,54 +2300,49 @@ func (m *MsgAssignTrainingTask) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgAssignTrainingTask) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAssignTrainingTask.Merge(m, src)
+func (m *MsgCancelTrainingTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTrainingTask.Merge(m, src)
 }
-func (m *MsgAssignTrainingTask) XXX_Size() int {
+func (m *MsgCancelTrainingTask) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAssignTrainingTask) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAssignTrainingTask.DiscardUnknown(m)
+func (m *MsgCancelTrainingTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTrainingTask.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAssignTrainingTask proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelTrainingTask proto.InternalMessageInfo
 
-func (m *MsgAssignTrainingTask) GetCreator() string {
+func (m *MsgCancelTrainingTask) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgAssignTrainingTask) GetTaskId() uint64 {
+func (m *MsgCancelTrainingTask) GetTaskId() uint64 {
 	if m != nil {
 		return m.TaskId
 	}
 	return 0
 }
 
-func (m *MsgAssignTrainingTask) GetAssignees() []*TrainingTaskAssignee {
-	if m != nil {
-		return m.Assignees
-	}
-	return nil
-}
-
-type MsgAssignTrainingTaskResponse struct {
+type MsgCancelTrainingTaskResponse struct {
+	Paid     uint64 `protobuf:"varint,1,opt,name=paid,proto3" json:"paid,omitempty"`
+	Refunded uint64 `protobuf:"varint,2,opt,name=refunded,proto3" json:"refunded,omitempty"`
 }
 
-func (m *MsgAssignTrainingTaskResponse) Reset()         { *m = MsgAssignTrainingTaskResponse{} }
-func (m *MsgAssignTrainingTaskResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAssignTrainingTaskResponse) ProtoMessage()    {}
-func (*MsgAssignTrainingTaskResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCancelTrainingTaskResponse) Reset()         { *m = MsgCancelTrainingTaskResponse{} }
+func (m *MsgCancelTrainingTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTrainingTaskResponse) ProtoMessage()    {}
+func (*MsgCancelTrainingTaskResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{35}
 }
-func (m *MsgAssignTrainingTaskResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelTrainingTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAssignTrainingTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelTrainingTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAssignTrainingTaskResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelTrainingTaskResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2242,37 +2352,50 @@ func (m *MsgAssignTrainingTaskResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgAssignTrainingTaskResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAssignTrainingTaskResponse.Merge(m, src)
+func (m *MsgCancelTrainingTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTrainingTaskResponse.Merge(m, src)
 }
-func (m *MsgAssignTrainingTaskResponse) XXX_Size() int {
+func (m *MsgCancelTrainingTaskResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAssignTrainingTaskResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAssignTrainingTaskResponse.DiscardUnknown(m)
+func (m *MsgCancelTrainingTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTrainingTaskResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAssignTrainingTaskResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelTrainingTaskResponse proto.InternalMessageInfo
 
-type MsgCreatePartialUpgrade struct {
-	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Height          uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	NodeVersion     string `protobuf:"bytes,3,opt,name=nodeVersion,proto3" json:"nodeVersion,omitempty"`
-	ApiBinariesJson string `protobuf:"bytes,4,opt,name=apiBinariesJson,proto3" json:"apiBinariesJson,omitempty"`
+func (m *MsgCancelTrainingTaskResponse) GetPaid() uint64 {
+	if m != nil {
+		return m.Paid
+	}
+	return 0
 }
 
-func (m *MsgCreatePartialUpgrade) Reset()         { *m = MsgCreatePartialUpgrade{} }
-func (m *MsgCreatePartialUpgrade) String() string { return proto.CompactTextString(m) }
-func (*MsgCreatePartialUpgrade) ProtoMessage()    {}
-func (*MsgCreatePartialUpgrade) Descriptor() ([]byte, []int) {
+func (m *MsgCancelTrainingTaskResponse) GetRefunded() uint64 {
+	if m != nil {
+		return m.Refunded
+	}
+	return 0
+}
+
+type MsgSubmitHardwareDiff struct {
+	Creator       string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	NewOrModified []*HardwareNode `protobuf:"bytes,2,rep,name=newOrModified,proto3" json:"newOrModified,omitempty"`
+	Removed       []*HardwareNode `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (m *MsgSubmitHardwareDiff) Reset()         { *m = MsgSubmitHardwareDiff{} }
+func (m *MsgSubmitHardwareDiff) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitHardwareDiff) ProtoMessage()    {}
+func (*MsgSubmitHardwareDiff) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{36}
 }
-func (m *MsgCreatePartialUpgrade) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitHardwareDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreatePartialUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitHardwareDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreatePartialUpgrade.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitHardwareDiff.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2282,61 +2405,54 @@ func (m *MsgCreatePartialUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgCreatePartialUpgrade) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreatePartialUpgrade.Merge(m, src)
+func (m *MsgSubmitHardwareDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitHardwareDiff.Merge(m, src)
 }
-func (m *MsgCreatePartialUpgrade) XXX_Size() int {
+func (m *MsgSubmitHardwareDiff) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreatePartialUpgrade) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreatePartialUpgrade.DiscardUnknown(m)
+func (m *MsgSubmitHardwareDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitHardwareDiff.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreatePartialUpgrade proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitHardwareDiff proto.InternalMessageInfo
 
-func (m *MsgCreatePartialUpgrade) GetAuthority() string {
+func (m *MsgSubmitHardwareDiff) GetCreator() string {
 	if m != nil {
-		return m.Authority
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCreatePartialUpgrade) GetHeight() uint64 {
+func (m *MsgSubmitHardwareDiff) GetNewOrModified() []*HardwareNode {
 	if m != nil {
-		return m.Height
-	}
-	return 0
-}
-
-func (m *MsgCreatePartialUpgrade) GetNodeVersion() string {
-	if m != nil {
-		return m.NodeVersion
+		return m.NewOrModified
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgCreatePartialUpgrade) GetApiBinariesJson() string {
+func (m *MsgSubmitHardwareDiff) GetRemoved() []*HardwareNode {
 	if m != nil {
-		return m.ApiBinariesJson
+		return m.Removed
 	}
-	return ""
+	return nil
 }
 
-type MsgCreatePartialUpgradeResponse struct {
+type MsgSubmitHardwareDiffResponse struct {
 }
 
-func (m *MsgCreatePartialUpgradeResponse) Reset()         { *m = MsgCreatePartialUpgradeResponse{} }
-func (m *MsgCreatePartialUpgradeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreatePartialUpgradeResponse) ProtoMessage()    {}
-func (*MsgCreatePartialUpgradeResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSubmitHardwareDiffResponse) Reset()         { *m = MsgSubmitHardwareDiffResponse{} }
+func (m *MsgSubmitHardwareDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitHardwareDiffResponse) ProtoMessage()    {}
+func (*MsgSubmitHardwareDiffResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{37}
 }
-func (m *MsgCreatePartialUpgradeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitHardwareDiffResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreatePartialUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitHardwareDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreatePartialUpgradeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitHardwareDiffResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2346,38 +2462,35 @@ func (m *MsgCreatePartialUpgradeResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgCreatePartialUpgradeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreatePartialUpgradeResponse.Merge(m, src)
+func (m *MsgSubmitHardwareDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitHardwareDiffResponse.Merge(m, src)
 }
-func (m *MsgCreatePartialUpgradeResponse) XXX_Size() int {
+func (m *MsgSubmitHardwareDiffResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreatePartialUpgradeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreatePartialUpgradeResponse.DiscardUnknown(m)
+func (m *MsgSubmitHardwareDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitHardwareDiffResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreatePartialUpgradeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitHardwareDiffResponse proto.InternalMessageInfo
 
-type MsgSubmitTrainingKvRecord struct {
-	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId      uint64 `protobuf:"varint,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	Participant string `protobuf:"bytes,3,opt,name=participant,proto3" json:"participant,omitempty"`
-	Key         string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
-	Value       string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+type MsgClaimTrainingTaskForAssignment struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId  uint64 `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
-func (m *MsgSubmitTrainingKvRecord) Reset()         { *m = MsgSubmitTrainingKvRecord{} }
-func (m *MsgSubmitTrainingKvRecord) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitTrainingKvRecord) ProtoMessage()    {}
-func (*MsgSubmitTrainingKvRecord) Descriptor() ([]byte, []int) {
+func (m *MsgClaimTrainingTaskForAssignment) Reset()         { *m = MsgClaimTrainingTaskForAssignment{} }
+func (m *MsgClaimTrainingTaskForAssignment) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimTrainingTaskForAssignment) ProtoMessage()    {}
+func (*MsgClaimTrainingTaskForAssignment) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{38}
 }
-func (m *MsgSubmitTrainingKvRecord) XXX_Unmarshal(b []byte) error {
+func (m *MsgClaimTrainingTaskForAssignment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitTrainingKvRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgClaimTrainingTaskForAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitTrainingKvRecord.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgClaimTrainingTaskForAssignment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2387,68 +2500,51 @@ func (m *MsgSubmitTrainingKvRecord) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitTrainingKvRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitTrainingKvRecord.Merge(m, src)
+func (m *MsgClaimTrainingTaskForAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimTrainingTaskForAssignment.Merge(m, src)
 }
-func (m *MsgSubmitTrainingKvRecord) XXX_Size() int {
+func (m *MsgClaimTrainingTaskForAssignment) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitTrainingKvRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitTrainingKvRecord.DiscardUnknown(m)
+func (m *MsgClaimTrainingTaskForAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimTrainingTaskForAssignment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitTrainingKvRecord proto.InternalMessageInfo
+var xxx_messageInfo_MsgClaimTrainingTaskForAssignment proto.InternalMessageInfo
 
-func (m *MsgSubmitTrainingKvRecord) GetCreator() string {
+func (m *MsgClaimTrainingTaskForAssignment) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgSubmitTrainingKvRecord) GetTaskId() uint64 {
+func (m *MsgClaimTrainingTaskForAssignment) GetTaskId() uint64 {
 	if m != nil {
 		return m.TaskId
 	}
 	return 0
 }
 
-func (m *MsgSubmitTrainingKvRecord) GetParticipant() string {
-	if m != nil {
-		return m.Participant
-	}
-	return ""
-}
-
-func (m *MsgSubmitTrainingKvRecord) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
+type MsgClaimTrainingTaskForAssignmentResponse struct {
 }
 
-func (m *MsgSubmitTrainingKvRecord) GetValue() string {
-	if m != nil {
-		return m.Value
-	}
-	return ""
+func (m *MsgClaimTrainingTaskForAssignmentResponse) Reset() {
+	*m = MsgClaimTrainingTaskForAssignmentResponse{}
 }
-
-type MsgSubmitTrainingKvRecordResponse struct {
+func (m *MsgClaimTrainingTaskForAssignmentResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *MsgSubmitTrainingKvRecordResponse) Reset()         { *m = MsgSubmitTrainingKvRecordResponse{} }
-func (m *MsgSubmitTrainingKvRecordResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitTrainingKvRecordResponse) ProtoMessage()    {}
-func (*MsgSubmitTrainingKvRecordResponse) Descriptor() ([]byte, []int) {
+func (*MsgClaimTrainingTaskForAssignmentResponse) ProtoMessage() {}
+func (*MsgClaimTrainingTaskForAssignmentResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{39}
 }
-func (m *MsgSubmitTrainingKvRecordResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgClaimTrainingTaskForAssignmentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitTrainingKvRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgClaimTrainingTaskForAssignmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitTrainingKvRecordResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgClaimTrainingTaskForAssignmentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2458,35 +2554,36 @@ func (m *MsgSubmitTrainingKvRecordResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitTrainingKvRecordResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitTrainingKvRecordResponse.Merge(m, src)
+func (m *MsgClaimTrainingTaskForAssignmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimTrainingTaskForAssignmentResponse.Merge(m, src)
 }
-func (m *MsgSubmitTrainingKvRecordResponse) XXX_Size() int {
+func (m *MsgClaimTrainingTaskForAssignmentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitTrainingKvRecordResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitTrainingKvRecordResponse.DiscardUnknown(m)
+func (m *MsgClaimTrainingTaskForAssignmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimTrainingTaskForAssignmentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitTrainingKvRecordResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgClaimTrainingTaskForAssignmentResponse proto.InternalMessageInfo
 
-type MsgJoinTraining struct {
-	Creator string               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Req     *JoinTrainingRequest `protobuf:"bytes,2,opt,name=req,proto3" json:"req,omitempty"`
+type MsgAssignTrainingTask struct {
+	Creator   string                  `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId    uint64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Assignees []*TrainingTaskAssignee `protobuf:"bytes,3,rep,name=assignees,proto3" json:"assignees,omitempty"`
 }
 
-func (m *MsgJoinTraining) Reset()         { *m = MsgJoinTraining{} }
-func (m *MsgJoinTraining) String() string { return proto.CompactTextString(m) }
-func (*MsgJoinTraining) ProtoMessage()    {}
-func (*MsgJoinTraining) Descriptor() ([]byte, []int) {
+func (m *MsgAssignTrainingTask) Reset()         { *m = MsgAssignTrainingTask{} }
+func (m *MsgAssignTrainingTask) String() string { return proto.CompactTextString(m) }
+func (*MsgAssignTrainingTask) ProtoMessage()    {}
+func (*MsgAssignTrainingTask) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{40}
 }
-func (m *MsgJoinTraining) XXX_Unmarshal(b []byte) error {
+func (m *MsgAssignTrainingTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgJoinTraining) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAssignTrainingTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgJoinTraining.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAssignTrainingTask.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2496,48 +2593,54 @@ func (m *MsgJoinTraining) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgJoinTraining) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgJoinTraining.Merge(m, src)
+func (m *MsgAssignTrainingTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAssignTrainingTask.Merge(m, src)
 }
-func (m *MsgJoinTraining) XXX_Size() int {
+func (m *MsgAssignTrainingTask) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgJoinTraining) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgJoinTraining.DiscardUnknown(m)
+func (m *MsgAssignTrainingTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAssignTrainingTask.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgJoinTraining proto.InternalMessageInfo
+var xxx_messageInfo_MsgAssignTrainingTask proto.InternalMessageInfo
 
-func (m *MsgJoinTraining) GetCreator() string {
+func (m *MsgAssignTrainingTask) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgJoinTraining) GetReq() *JoinTrainingRequest {
+func (m *MsgAssignTrainingTask) GetTaskId() uint64 {
 	if m != nil {
-		return m.Req
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *MsgAssignTrainingTask) GetAssignees() []*TrainingTaskAssignee {
+	if m != nil {
+		return m.Assignees
 	}
 	return nil
 }
 
-type MsgJoinTrainingResponse struct {
-	Status *MLNodeTrainStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+type MsgAssignTrainingTaskResponse struct {
 }
 
-func (m *MsgJoinTrainingResponse) Reset()         { *m = MsgJoinTrainingResponse{} }
-func (m *MsgJoinTrainingResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgJoinTrainingResponse) ProtoMessage()    {}
-func (*MsgJoinTrainingResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAssignTrainingTaskResponse) Reset()         { *m = MsgAssignTrainingTaskResponse{} }
+func (m *MsgAssignTrainingTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAssignTrainingTaskResponse) ProtoMessage()    {}
+func (*MsgAssignTrainingTaskResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{41}
 }
-func (m *MsgJoinTrainingResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAssignTrainingTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgJoinTrainingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAssignTrainingTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgJoinTrainingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAssignTrainingTaskResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2547,42 +2650,37 @@ func (m *MsgJoinTrainingResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgJoinTrainingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgJoinTrainingResponse.Merge(m, src)
+func (m *MsgAssignTrainingTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAssignTrainingTaskResponse.Merge(m, src)
 }
-func (m *MsgJoinTrainingResponse) XXX_Size() int {
+func (m *MsgAssignTrainingTaskResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgJoinTrainingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgJoinTrainingResponse.DiscardUnknown(m)
+func (m *MsgAssignTrainingTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAssignTrainingTaskResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgJoinTrainingResponse proto.InternalMessageInfo
-
-func (m *MsgJoinTrainingResponse) GetStatus() *MLNodeTrainStatus {
-	if m != nil {
-		return m.Status
-	}
-	return nil
-}
+var xxx_messageInfo_MsgAssignTrainingTaskResponse proto.InternalMessageInfo
 
-type MsgTrainingHeartbeat struct {
-	Creator string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Req     *HeartbeatRequest `protobuf:"bytes,2,opt,name=req,proto3" json:"req,omitempty"`
+type MsgCreatePartialUpgrade struct {
+	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Height          uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	NodeVersion     string `protobuf:"bytes,3,opt,name=nodeVersion,proto3" json:"nodeVersion,omitempty"`
+	ApiBinariesJson string `protobuf:"bytes,4,opt,name=apiBinariesJson,proto3" json:"apiBinariesJson,omitempty"`
 }
 
-func (m *MsgTrainingHeartbeat) Reset()         { *m = MsgTrainingHeartbeat{} }
-func (m *MsgTrainingHeartbeat) String() string { return proto.CompactTextString(m) }
-func (*MsgTrainingHeartbeat) ProtoMessage()    {}
-func (*MsgTrainingHeartbeat) Descriptor() ([]byte, []int) {
+func (m *MsgCreatePartialUpgrade) Reset()         { *m = MsgCreatePartialUpgrade{} }
+func (m *MsgCreatePartialUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePartialUpgrade) ProtoMessage()    {}
+func (*MsgCreatePartialUpgrade) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{42}
 }
-func (m *MsgTrainingHeartbeat) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreatePartialUpgrade) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgTrainingHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreatePartialUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgTrainingHeartbeat.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreatePartialUpgrade.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2592,48 +2690,61 @@ func (m *MsgTrainingHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgTrainingHeartbeat) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgTrainingHeartbeat.Merge(m, src)
+func (m *MsgCreatePartialUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePartialUpgrade.Merge(m, src)
 }
-func (m *MsgTrainingHeartbeat) XXX_Size() int {
+func (m *MsgCreatePartialUpgrade) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgTrainingHeartbeat) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgTrainingHeartbeat.DiscardUnknown(m)
+func (m *MsgCreatePartialUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePartialUpgrade.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgTrainingHeartbeat proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreatePartialUpgrade proto.InternalMessageInfo
 
-func (m *MsgTrainingHeartbeat) GetCreator() string {
+func (m *MsgCreatePartialUpgrade) GetAuthority() string {
 	if m != nil {
-		return m.Creator
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgTrainingHeartbeat) GetReq() *HeartbeatRequest {
+func (m *MsgCreatePartialUpgrade) GetHeight() uint64 {
 	if m != nil {
-		return m.Req
+		return m.Height
 	}
-	return nil
+	return 0
 }
 
-type MsgTrainingHeartbeatResponse struct {
-	Resp *HeartbeatResponse `protobuf:"bytes,1,opt,name=resp,proto3" json:"resp,omitempty"`
+func (m *MsgCreatePartialUpgrade) GetNodeVersion() string {
+	if m != nil {
+		return m.NodeVersion
+	}
+	return ""
 }
 
-func (m *MsgTrainingHeartbeatResponse) Reset()         { *m = MsgTrainingHeartbeatResponse{} }
-func (m *MsgTrainingHeartbeatResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgTrainingHeartbeatResponse) ProtoMessage()    {}
-func (*MsgTrainingHeartbeatResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreatePartialUpgrade) GetApiBinariesJson() string {
+	if m != nil {
+		return m.ApiBinariesJson
+	}
+	return ""
+}
+
+type MsgCreatePartialUpgradeResponse struct {
+}
+
+func (m *MsgCreatePartialUpgradeResponse) Reset()         { *m = MsgCreatePartialUpgradeResponse{} }
+func (m *MsgCreatePartialUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePartialUpgradeResponse) ProtoMessage()    {}
+func (*MsgCreatePartialUpgradeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{43}
 }
-func (m *MsgTrainingHeartbeatResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreatePartialUpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgTrainingHeartbeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreatePartialUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgTrainingHeartbeatResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreatePartialUpgradeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2643,42 +2754,38 @@ func (m *MsgTrainingHeartbeatResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgTrainingHeartbeatResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgTrainingHeartbeatResponse.Merge(m, src)
+func (m *MsgCreatePartialUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePartialUpgradeResponse.Merge(m, src)
 }
-func (m *MsgTrainingHeartbeatResponse) XXX_Size() int {
+func (m *MsgCreatePartialUpgradeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgTrainingHeartbeatResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgTrainingHeartbeatResponse.DiscardUnknown(m)
+func (m *MsgCreatePartialUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePartialUpgradeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgTrainingHeartbeatResponse proto.InternalMessageInfo
-
-func (m *MsgTrainingHeartbeatResponse) GetResp() *HeartbeatResponse {
-	if m != nil {
-		return m.Resp
-	}
-	return nil
-}
+var xxx_messageInfo_MsgCreatePartialUpgradeResponse proto.InternalMessageInfo
 
-type MsgSetBarrier struct {
-	Creator string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Req     *SetBarrierRequest `protobuf:"bytes,2,opt,name=req,proto3" json:"req,omitempty"`
+type MsgSubmitTrainingKvRecord struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId      uint64 `protobuf:"varint,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Participant string `protobuf:"bytes,3,opt,name=participant,proto3" json:"participant,omitempty"`
+	Key         string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Value       string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *MsgSetBarrier) Reset()         { *m = MsgSetBarrier{} }
-func (m *MsgSetBarrier) String() string { return proto.CompactTextString(m) }
-func (*MsgSetBarrier) ProtoMessage()    {}
-func (*MsgSetBarrier) Descriptor() ([]byte, []int) {
+func (m *MsgSubmitTrainingKvRecord) Reset()         { *m = MsgSubmitTrainingKvRecord{} }
+func (m *MsgSubmitTrainingKvRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitTrainingKvRecord) ProtoMessage()    {}
+func (*MsgSubmitTrainingKvRecord) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{44}
 }
-func (m *MsgSetBarrier) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitTrainingKvRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetBarrier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitTrainingKvRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetBarrier.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitTrainingKvRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2688,48 +2795,68 @@ func (m *MsgSetBarrier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *MsgSetBarrier) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetBarrier.Merge(m, src)
+func (m *MsgSubmitTrainingKvRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitTrainingKvRecord.Merge(m, src)
 }
-func (m *MsgSetBarrier) XXX_Size() int {
+func (m *MsgSubmitTrainingKvRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetBarrier) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetBarrier.DiscardUnknown(m)
+func (m *MsgSubmitTrainingKvRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitTrainingKvRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetBarrier proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitTrainingKvRecord proto.InternalMessageInfo
 
-func (m *MsgSetBarrier) GetCreator() string {
+func (m *MsgSubmitTrainingKvRecord) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgSetBarrier) GetReq() *SetBarrierRequest {
+func (m *MsgSubmitTrainingKvRecord) GetTaskId() uint64 {
 	if m != nil {
-		return m.Req
+		return m.TaskId
 	}
-	return nil
+	return 0
 }
 
-type MsgSetBarrierResponse struct {
-	Resp *SetBarrierResponse `protobuf:"bytes,1,opt,name=resp,proto3" json:"resp,omitempty"`
+func (m *MsgSubmitTrainingKvRecord) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
 }
 
-func (m *MsgSetBarrierResponse) Reset()         { *m = MsgSetBarrierResponse{} }
-func (m *MsgSetBarrierResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetBarrierResponse) ProtoMessage()    {}
-func (*MsgSetBarrierResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSubmitTrainingKvRecord) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *MsgSubmitTrainingKvRecord) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type MsgSubmitTrainingKvRecordResponse struct {
+}
+
+func (m *MsgSubmitTrainingKvRecordResponse) Reset()         { *m = MsgSubmitTrainingKvRecordResponse{} }
+func (m *MsgSubmitTrainingKvRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitTrainingKvRecordResponse) ProtoMessage()    {}
+func (*MsgSubmitTrainingKvRecordResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{45}
 }
-func (m *MsgSetBarrierResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitTrainingKvRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetBarrierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitTrainingKvRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetBarrierResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitTrainingKvRecordResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2739,42 +2866,35 @@ func (m *MsgSetBarrierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgSetBarrierResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetBarrierResponse.Merge(m, src)
+func (m *MsgSubmitTrainingKvRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitTrainingKvRecordResponse.Merge(m, src)
 }
-func (m *MsgSetBarrierResponse) XXX_Size() int {
+func (m *MsgSubmitTrainingKvRecordResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetBarrierResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetBarrierResponse.DiscardUnknown(m)
+func (m *MsgSubmitTrainingKvRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitTrainingKvRecordResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetBarrierResponse proto.InternalMessageInfo
-
-func (m *MsgSetBarrierResponse) GetResp() *SetBarrierResponse {
-	if m != nil {
-		return m.Resp
-	}
-	return nil
-}
+var xxx_messageInfo_MsgSubmitTrainingKvRecordResponse proto.InternalMessageInfo
 
-type MsgJoinTrainingStatus struct {
+type MsgJoinTraining struct {
 	Creator string               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Req     *JoinTrainingRequest `protobuf:"bytes,2,opt,name=req,proto3" json:"req,omitempty"`
 }
 
-func (m *MsgJoinTrainingStatus) Reset()         { *m = MsgJoinTrainingStatus{} }
-func (m *MsgJoinTrainingStatus) String() string { return proto.CompactTextString(m) }
-func (*MsgJoinTrainingStatus) ProtoMessage()    {}
-func (*MsgJoinTrainingStatus) Descriptor() ([]byte, []int) {
+func (m *MsgJoinTraining) Reset()         { *m = MsgJoinTraining{} }
+func (m *MsgJoinTraining) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinTraining) ProtoMessage()    {}
+func (*MsgJoinTraining) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{46}
 }
-func (m *MsgJoinTrainingStatus) XXX_Unmarshal(b []byte) error {
+func (m *MsgJoinTraining) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgJoinTrainingStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgJoinTraining) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgJoinTrainingStatus.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgJoinTraining.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2784,48 +2904,48 @@ func (m *MsgJoinTrainingStatus) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgJoinTrainingStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgJoinTrainingStatus.Merge(m, src)
+func (m *MsgJoinTraining) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinTraining.Merge(m, src)
 }
-func (m *MsgJoinTrainingStatus) XXX_Size() int {
+func (m *MsgJoinTraining) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgJoinTrainingStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgJoinTrainingStatus.DiscardUnknown(m)
+func (m *MsgJoinTraining) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinTraining.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgJoinTrainingStatus proto.InternalMessageInfo
+var xxx_messageInfo_MsgJoinTraining proto.InternalMessageInfo
 
-func (m *MsgJoinTrainingStatus) GetCreator() string {
+func (m *MsgJoinTraining) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgJoinTrainingStatus) GetReq() *JoinTrainingRequest {
+func (m *MsgJoinTraining) GetReq() *JoinTrainingRequest {
 	if m != nil {
 		return m.Req
 	}
 	return nil
 }
 
-type MsgJoinTrainingStatusResponse struct {
+type MsgJoinTrainingResponse struct {
 	Status *MLNodeTrainStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (m *MsgJoinTrainingStatusResponse) Reset()         { *m = MsgJoinTrainingStatusResponse{} }
-func (m *MsgJoinTrainingStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgJoinTrainingStatusResponse) ProtoMessage()    {}
-func (*MsgJoinTrainingStatusResponse) Descriptor() ([]byte, []int) {
+func (m *MsgJoinTrainingResponse) Reset()         { *m = MsgJoinTrainingResponse{} }
+func (m *MsgJoinTrainingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinTrainingResponse) ProtoMessage()    {}
+func (*MsgJoinTrainingResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{47}
 }
-func (m *MsgJoinTrainingStatusResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgJoinTrainingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgJoinTrainingStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgJoinTrainingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgJoinTrainingStatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgJoinTrainingResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2835,42 +2955,42 @@ func (m *MsgJoinTrainingStatusResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgJoinTrainingStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgJoinTrainingStatusResponse.Merge(m, src)
+func (m *MsgJoinTrainingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinTrainingResponse.Merge(m, src)
 }
-func (m *MsgJoinTrainingStatusResponse) XXX_Size() int {
+func (m *MsgJoinTrainingResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgJoinTrainingStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgJoinTrainingStatusResponse.DiscardUnknown(m)
+func (m *MsgJoinTrainingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinTrainingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgJoinTrainingStatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgJoinTrainingResponse proto.InternalMessageInfo
 
-func (m *MsgJoinTrainingStatusResponse) GetStatus() *MLNodeTrainStatus {
+func (m *MsgJoinTrainingResponse) GetStatus() *MLNodeTrainStatus {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-type MsgCreateDummyTrainingTask struct {
-	Creator string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Task    *TrainingTask `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+type MsgTrainingHeartbeat struct {
+	Creator string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Req     *HeartbeatRequest `protobuf:"bytes,2,opt,name=req,proto3" json:"req,omitempty"`
 }
 
-func (m *MsgCreateDummyTrainingTask) Reset()         { *m = MsgCreateDummyTrainingTask{} }
-func (m *MsgCreateDummyTrainingTask) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateDummyTrainingTask) ProtoMessage()    {}
-func (*MsgCreateDummyTrainingTask) Descriptor() ([]byte, []int) {
+func (m *MsgTrainingHeartbeat) Reset()         { *m = MsgTrainingHeartbeat{} }
+func (m *MsgTrainingHeartbeat) String() string { return proto.CompactTextString(m) }
+func (*MsgTrainingHeartbeat) ProtoMessage()    {}
+func (*MsgTrainingHeartbeat) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{48}
 }
-func (m *MsgCreateDummyTrainingTask) XXX_Unmarshal(b []byte) error {
+func (m *MsgTrainingHeartbeat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateDummyTrainingTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTrainingHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateDummyTrainingTask.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTrainingHeartbeat.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2880,48 +3000,48 @@ func (m *MsgCreateDummyTrainingTask) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateDummyTrainingTask) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateDummyTrainingTask.Merge(m, src)
+func (m *MsgTrainingHeartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTrainingHeartbeat.Merge(m, src)
 }
-func (m *MsgCreateDummyTrainingTask) XXX_Size() int {
+func (m *MsgTrainingHeartbeat) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateDummyTrainingTask) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateDummyTrainingTask.DiscardUnknown(m)
+func (m *MsgTrainingHeartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTrainingHeartbeat.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateDummyTrainingTask proto.InternalMessageInfo
+var xxx_messageInfo_MsgTrainingHeartbeat proto.InternalMessageInfo
 
-func (m *MsgCreateDummyTrainingTask) GetCreator() string {
+func (m *MsgTrainingHeartbeat) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCreateDummyTrainingTask) GetTask() *TrainingTask {
+func (m *MsgTrainingHeartbeat) GetReq() *HeartbeatRequest {
 	if m != nil {
-		return m.Task
+		return m.Req
 	}
 	return nil
 }
 
-type MsgCreateDummyTrainingTaskResponse struct {
-	Task *TrainingTask `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+type MsgTrainingHeartbeatResponse struct {
+	Resp *HeartbeatResponse `protobuf:"bytes,1,opt,name=resp,proto3" json:"resp,omitempty"`
 }
 
-func (m *MsgCreateDummyTrainingTaskResponse) Reset()         { *m = MsgCreateDummyTrainingTaskResponse{} }
-func (m *MsgCreateDummyTrainingTaskResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateDummyTrainingTaskResponse) ProtoMessage()    {}
-func (*MsgCreateDummyTrainingTaskResponse) Descriptor() ([]byte, []int) {
+func (m *MsgTrainingHeartbeatResponse) Reset()         { *m = MsgTrainingHeartbeatResponse{} }
+func (m *MsgTrainingHeartbeatResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTrainingHeartbeatResponse) ProtoMessage()    {}
+func (*MsgTrainingHeartbeatResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{49}
 }
-func (m *MsgCreateDummyTrainingTaskResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgTrainingHeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateDummyTrainingTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTrainingHeartbeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateDummyTrainingTaskResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTrainingHeartbeatResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2931,49 +3051,42 @@ func (m *MsgCreateDummyTrainingTaskResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateDummyTrainingTaskResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateDummyTrainingTaskResponse.Merge(m, src)
+func (m *MsgTrainingHeartbeatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTrainingHeartbeatResponse.Merge(m, src)
 }
-func (m *MsgCreateDummyTrainingTaskResponse) XXX_Size() int {
+func (m *MsgTrainingHeartbeatResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateDummyTrainingTaskResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateDummyTrainingTaskResponse.DiscardUnknown(m)
+func (m *MsgTrainingHeartbeatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTrainingHeartbeatResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateDummyTrainingTaskResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgTrainingHeartbeatResponse proto.InternalMessageInfo
 
-func (m *MsgCreateDummyTrainingTaskResponse) GetTask() *TrainingTask {
+func (m *MsgTrainingHeartbeatResponse) GetResp() *HeartbeatResponse {
 	if m != nil {
-		return m.Task
+		return m.Resp
 	}
 	return nil
 }
 
-type MsgBridgeExchange struct {
-	Validator       string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	OriginChain     string `protobuf:"bytes,2,opt,name=originChain,proto3" json:"originChain,omitempty"`
-	ContractAddress string `protobuf:"bytes,3,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
-	OwnerAddress    string `protobuf:"bytes,4,opt,name=ownerAddress,proto3" json:"ownerAddress,omitempty"`
-	OwnerPubKey     string `protobuf:"bytes,5,opt,name=ownerPubKey,proto3" json:"ownerPubKey,omitempty"`
-	Amount          string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	BlockNumber     string `protobuf:"bytes,7,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
-	ReceiptIndex    string `protobuf:"bytes,8,opt,name=receiptIndex,proto3" json:"receiptIndex,omitempty"`
-	ReceiptsRoot    string `protobuf:"bytes,9,opt,name=receiptsRoot,proto3" json:"receiptsRoot,omitempty"`
+type MsgSetBarrier struct {
+	Creator string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Req     *SetBarrierRequest `protobuf:"bytes,2,opt,name=req,proto3" json:"req,omitempty"`
 }
 
-func (m *MsgBridgeExchange) Reset()         { *m = MsgBridgeExchange{} }
-func (m *MsgBridgeExchange) String() string { return proto.CompactTextString(m) }
-func (*MsgBridgeExchange) ProtoMessage()    {}
-func (*MsgBridgeExchange) Descriptor() ([]byte, []int) {
+func (m *MsgSetBarrier) Reset()         { *m = MsgSetBarrier{} }
+func (m *MsgSetBarrier) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBarrier) ProtoMessage()    {}
+func (*MsgSetBarrier) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{50}
 }
-func (m *MsgBridgeExchange) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetBarrier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBridgeExchange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetBarrier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBridgeExchange.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetBarrier.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2983,97 +3096,48 @@ func (m *MsgBridgeExchange) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgBridgeExchange) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBridgeExchange.Merge(m, src)
+func (m *MsgSetBarrier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBarrier.Merge(m, src)
 }
-func (m *MsgBridgeExchange) XXX_Size() int {
+func (m *MsgSetBarrier) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBridgeExchange) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBridgeExchange.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgBridgeExchange proto.InternalMessageInfo
-
-func (m *MsgBridgeExchange) GetValidator() string {
-	if m != nil {
-		return m.Validator
-	}
-	return ""
-}
-
-func (m *MsgBridgeExchange) GetOriginChain() string {
-	if m != nil {
-		return m.OriginChain
-	}
-	return ""
-}
-
-func (m *MsgBridgeExchange) GetContractAddress() string {
-	if m != nil {
-		return m.ContractAddress
-	}
-	return ""
-}
-
-func (m *MsgBridgeExchange) GetOwnerAddress() string {
-	if m != nil {
-		return m.OwnerAddress
-	}
-	return ""
-}
-
-func (m *MsgBridgeExchange) GetOwnerPubKey() string {
-	if m != nil {
-		return m.OwnerPubKey
-	}
-	return ""
-}
-
-func (m *MsgBridgeExchange) GetAmount() string {
-	if m != nil {
-		return m.Amount
-	}
-	return ""
+func (m *MsgSetBarrier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBarrier.DiscardUnknown(m)
 }
 
-func (m *MsgBridgeExchange) GetBlockNumber() string {
-	if m != nil {
-		return m.BlockNumber
-	}
-	return ""
-}
+var xxx_messageInfo_MsgSetBarrier proto.InternalMessageInfo
 
-func (m *MsgBridgeExchange) GetReceiptIndex() string {
+func (m *MsgSetBarrier) GetCreator() string {
 	if m != nil {
-		return m.ReceiptIndex
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgBridgeExchange) GetReceiptsRoot() string {
+func (m *MsgSetBarrier) GetReq() *SetBarrierRequest {
 	if m != nil {
-		return m.ReceiptsRoot
+		return m.Req
 	}
-	return ""
+	return nil
 }
 
-type MsgBridgeExchangeResponse struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type MsgSetBarrierResponse struct {
+	Resp *SetBarrierResponse `protobuf:"bytes,1,opt,name=resp,proto3" json:"resp,omitempty"`
 }
 
-func (m *MsgBridgeExchangeResponse) Reset()         { *m = MsgBridgeExchangeResponse{} }
-func (m *MsgBridgeExchangeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBridgeExchangeResponse) ProtoMessage()    {}
-func (*MsgBridgeExchangeResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetBarrierResponse) Reset()         { *m = MsgSetBarrierResponse{} }
+func (m *MsgSetBarrierResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBarrierResponse) ProtoMessage()    {}
+func (*MsgSetBarrierResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{51}
 }
-func (m *MsgBridgeExchangeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetBarrierResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBridgeExchangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetBarrierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBridgeExchangeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetBarrierResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3083,43 +3147,42 @@ func (m *MsgBridgeExchangeResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgBridgeExchangeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBridgeExchangeResponse.Merge(m, src)
+func (m *MsgSetBarrierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBarrierResponse.Merge(m, src)
 }
-func (m *MsgBridgeExchangeResponse) XXX_Size() int {
+func (m *MsgSetBarrierResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBridgeExchangeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBridgeExchangeResponse.DiscardUnknown(m)
+func (m *MsgSetBarrierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBarrierResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBridgeExchangeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetBarrierResponse proto.InternalMessageInfo
 
-func (m *MsgBridgeExchangeResponse) GetId() string {
+func (m *MsgSetBarrierResponse) GetResp() *SetBarrierResponse {
 	if m != nil {
-		return m.Id
+		return m.Resp
 	}
-	return ""
+	return nil
 }
 
-type MsgAddUserToTrainingAllowList struct {
-	Authority string       `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Address   string       `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Role      TrainingRole `protobuf:"varint,3,opt,name=role,proto3,enum=inference.inference.TrainingRole" json:"role,omitempty"`
+type MsgJoinTrainingStatus struct {
+	Creator string               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Req     *JoinTrainingRequest `protobuf:"bytes,2,opt,name=req,proto3" json:"req,omitempty"`
 }
 
-func (m *MsgAddUserToTrainingAllowList) Reset()         { *m = MsgAddUserToTrainingAllowList{} }
-func (m *MsgAddUserToTrainingAllowList) String() string { return proto.CompactTextString(m) }
-func (*MsgAddUserToTrainingAllowList) ProtoMessage()    {}
-func (*MsgAddUserToTrainingAllowList) Descriptor() ([]byte, []int) {
+func (m *MsgJoinTrainingStatus) Reset()         { *m = MsgJoinTrainingStatus{} }
+func (m *MsgJoinTrainingStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinTrainingStatus) ProtoMessage()    {}
+func (*MsgJoinTrainingStatus) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{52}
 }
-func (m *MsgAddUserToTrainingAllowList) XXX_Unmarshal(b []byte) error {
+func (m *MsgJoinTrainingStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddUserToTrainingAllowList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgJoinTrainingStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddUserToTrainingAllowList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgJoinTrainingStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3129,54 +3192,48 @@ func (m *MsgAddUserToTrainingAllowList) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgAddUserToTrainingAllowList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddUserToTrainingAllowList.Merge(m, src)
+func (m *MsgJoinTrainingStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinTrainingStatus.Merge(m, src)
 }
-func (m *MsgAddUserToTrainingAllowList) XXX_Size() int {
+func (m *MsgJoinTrainingStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddUserToTrainingAllowList) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddUserToTrainingAllowList.DiscardUnknown(m)
+func (m *MsgJoinTrainingStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinTrainingStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddUserToTrainingAllowList proto.InternalMessageInfo
-
-func (m *MsgAddUserToTrainingAllowList) GetAuthority() string {
-	if m != nil {
-		return m.Authority
-	}
-	return ""
-}
+var xxx_messageInfo_MsgJoinTrainingStatus proto.InternalMessageInfo
 
-func (m *MsgAddUserToTrainingAllowList) GetAddress() string {
+func (m *MsgJoinTrainingStatus) GetCreator() string {
 	if m != nil {
-		return m.Address
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgAddUserToTrainingAllowList) GetRole() TrainingRole {
+func (m *MsgJoinTrainingStatus) GetReq() *JoinTrainingRequest {
 	if m != nil {
-		return m.Role
+		return m.Req
 	}
-	return TrainingRole_ROLE_EXEC
+	return nil
 }
 
-type MsgAddUserToTrainingAllowListResponse struct {
+type MsgJoinTrainingStatusResponse struct {
+	Status *MLNodeTrainStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (m *MsgAddUserToTrainingAllowListResponse) Reset()         { *m = MsgAddUserToTrainingAllowListResponse{} }
-func (m *MsgAddUserToTrainingAllowListResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddUserToTrainingAllowListResponse) ProtoMessage()    {}
-func (*MsgAddUserToTrainingAllowListResponse) Descriptor() ([]byte, []int) {
+func (m *MsgJoinTrainingStatusResponse) Reset()         { *m = MsgJoinTrainingStatusResponse{} }
+func (m *MsgJoinTrainingStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinTrainingStatusResponse) ProtoMessage()    {}
+func (*MsgJoinTrainingStatusResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{53}
 }
-func (m *MsgAddUserToTrainingAllowListResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgJoinTrainingStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddUserToTrainingAllowListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgJoinTrainingStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddUserToTrainingAllowListResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgJoinTrainingStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3186,36 +3243,42 @@ func (m *MsgAddUserToTrainingAllowListResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgAddUserToTrainingAllowListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddUserToTrainingAllowListResponse.Merge(m, src)
+func (m *MsgJoinTrainingStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinTrainingStatusResponse.Merge(m, src)
 }
-func (m *MsgAddUserToTrainingAllowListResponse) XXX_Size() int {
+func (m *MsgJoinTrainingStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddUserToTrainingAllowListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddUserToTrainingAllowListResponse.DiscardUnknown(m)
+func (m *MsgJoinTrainingStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinTrainingStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddUserToTrainingAllowListResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgJoinTrainingStatusResponse proto.InternalMessageInfo
 
-type MsgRemoveUserFromTrainingAllowList struct {
-	Authority string       `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Address   string       `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Role      TrainingRole `protobuf:"varint,3,opt,name=role,proto3,enum=inference.inference.TrainingRole" json:"role,omitempty"`
+func (m *MsgJoinTrainingStatusResponse) GetStatus() *MLNodeTrainStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowList) Reset()         { *m = MsgRemoveUserFromTrainingAllowList{} }
-func (m *MsgRemoveUserFromTrainingAllowList) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveUserFromTrainingAllowList) ProtoMessage()    {}
-func (*MsgRemoveUserFromTrainingAllowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09b36d0241b9acd5, []int{54}
-}
-func (m *MsgRemoveUserFromTrainingAllowList) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+type MsgCreateDummyTrainingTask struct {
+	Creator string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Task    *TrainingTask `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
 }
-func (m *MsgRemoveUserFromTrainingAllowList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+
+func (m *MsgCreateDummyTrainingTask) Reset()         { *m = MsgCreateDummyTrainingTask{} }
+func (m *MsgCreateDummyTrainingTask) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDummyTrainingTask) ProtoMessage()    {}
+func (*MsgCreateDummyTrainingTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{54}
+}
+func (m *MsgCreateDummyTrainingTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDummyTrainingTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveUserFromTrainingAllowList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateDummyTrainingTask.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3225,58 +3288,48 @@ func (m *MsgRemoveUserFromTrainingAllowList) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveUserFromTrainingAllowList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveUserFromTrainingAllowList.Merge(m, src)
+func (m *MsgCreateDummyTrainingTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDummyTrainingTask.Merge(m, src)
 }
-func (m *MsgRemoveUserFromTrainingAllowList) XXX_Size() int {
+func (m *MsgCreateDummyTrainingTask) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveUserFromTrainingAllowList) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveUserFromTrainingAllowList.DiscardUnknown(m)
+func (m *MsgCreateDummyTrainingTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDummyTrainingTask.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveUserFromTrainingAllowList proto.InternalMessageInfo
-
-func (m *MsgRemoveUserFromTrainingAllowList) GetAuthority() string {
-	if m != nil {
-		return m.Authority
-	}
-	return ""
-}
+var xxx_messageInfo_MsgCreateDummyTrainingTask proto.InternalMessageInfo
 
-func (m *MsgRemoveUserFromTrainingAllowList) GetAddress() string {
+func (m *MsgCreateDummyTrainingTask) GetCreator() string {
 	if m != nil {
-		return m.Address
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgRemoveUserFromTrainingAllowList) GetRole() TrainingRole {
+func (m *MsgCreateDummyTrainingTask) GetTask() *TrainingTask {
 	if m != nil {
-		return m.Role
+		return m.Task
 	}
-	return TrainingRole_ROLE_EXEC
+	return nil
 }
 
-type MsgRemoveUserFromTrainingAllowListResponse struct {
+type MsgCreateDummyTrainingTaskResponse struct {
+	Task *TrainingTask `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 }
 
-func (m *MsgRemoveUserFromTrainingAllowListResponse) Reset() {
-	*m = MsgRemoveUserFromTrainingAllowListResponse{}
-}
-func (m *MsgRemoveUserFromTrainingAllowListResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgRemoveUserFromTrainingAllowListResponse) ProtoMessage() {}
-func (*MsgRemoveUserFromTrainingAllowListResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateDummyTrainingTaskResponse) Reset()         { *m = MsgCreateDummyTrainingTaskResponse{} }
+func (m *MsgCreateDummyTrainingTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDummyTrainingTaskResponse) ProtoMessage()    {}
+func (*MsgCreateDummyTrainingTaskResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{55}
 }
-func (m *MsgRemoveUserFromTrainingAllowListResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateDummyTrainingTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveUserFromTrainingAllowListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateDummyTrainingTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveUserFromTrainingAllowListResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateDummyTrainingTaskResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3286,36 +3339,50 @@ func (m *MsgRemoveUserFromTrainingAllowListResponse) XXX_Marshal(b []byte, deter
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveUserFromTrainingAllowListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveUserFromTrainingAllowListResponse.Merge(m, src)
+func (m *MsgCreateDummyTrainingTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDummyTrainingTaskResponse.Merge(m, src)
 }
-func (m *MsgRemoveUserFromTrainingAllowListResponse) XXX_Size() int {
+func (m *MsgCreateDummyTrainingTaskResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveUserFromTrainingAllowListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveUserFromTrainingAllowListResponse.DiscardUnknown(m)
+func (m *MsgCreateDummyTrainingTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDummyTrainingTaskResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveUserFromTrainingAllowListResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateDummyTrainingTaskResponse proto.InternalMessageInfo
 
-type MsgSetTrainingAllowList struct {
-	Authority string       `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Addresses []string     `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Role      TrainingRole `protobuf:"varint,3,opt,name=role,proto3,enum=inference.inference.TrainingRole" json:"role,omitempty"`
+func (m *MsgCreateDummyTrainingTaskResponse) GetTask() *TrainingTask {
+	if m != nil {
+		return m.Task
+	}
+	return nil
 }
 
-func (m *MsgSetTrainingAllowList) Reset()         { *m = MsgSetTrainingAllowList{} }
-func (m *MsgSetTrainingAllowList) String() string { return proto.CompactTextString(m) }
-func (*MsgSetTrainingAllowList) ProtoMessage()    {}
-func (*MsgSetTrainingAllowList) Descriptor() ([]byte, []int) {
+type MsgBridgeExchange struct {
+	Validator       string   `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	OriginChain     string   `protobuf:"bytes,2,opt,name=originChain,proto3" json:"originChain,omitempty"`
+	ContractAddress string   `protobuf:"bytes,3,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	OwnerAddress    string   `protobuf:"bytes,4,opt,name=ownerAddress,proto3" json:"ownerAddress,omitempty"`
+	OwnerPubKey     string   `protobuf:"bytes,5,opt,name=ownerPubKey,proto3" json:"ownerPubKey,omitempty"`
+	Amount          string   `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockNumber     string   `protobuf:"bytes,7,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	ReceiptIndex    string   `protobuf:"bytes,8,opt,name=receiptIndex,proto3" json:"receiptIndex,omitempty"`
+	ReceiptsRoot    string   `protobuf:"bytes,9,opt,name=receiptsRoot,proto3" json:"receiptsRoot,omitempty"`
+	ReceiptProof    [][]byte `protobuf:"bytes,10,rep,name=receiptProof,proto3" json:"receiptProof,omitempty"`
+}
+
+func (m *MsgBridgeExchange) Reset()         { *m = MsgBridgeExchange{} }
+func (m *MsgBridgeExchange) String() string { return proto.CompactTextString(m) }
+func (*MsgBridgeExchange) ProtoMessage()    {}
+func (*MsgBridgeExchange) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{56}
 }
-func (m *MsgSetTrainingAllowList) XXX_Unmarshal(b []byte) error {
+func (m *MsgBridgeExchange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetTrainingAllowList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBridgeExchange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetTrainingAllowList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBridgeExchange.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3325,54 +3392,104 @@ func (m *MsgSetTrainingAllowList) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgSetTrainingAllowList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetTrainingAllowList.Merge(m, src)
+func (m *MsgBridgeExchange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBridgeExchange.Merge(m, src)
 }
-func (m *MsgSetTrainingAllowList) XXX_Size() int {
+func (m *MsgBridgeExchange) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetTrainingAllowList) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetTrainingAllowList.DiscardUnknown(m)
+func (m *MsgBridgeExchange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBridgeExchange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetTrainingAllowList proto.InternalMessageInfo
+var xxx_messageInfo_MsgBridgeExchange proto.InternalMessageInfo
 
-func (m *MsgSetTrainingAllowList) GetAuthority() string {
+func (m *MsgBridgeExchange) GetValidator() string {
 	if m != nil {
-		return m.Authority
+		return m.Validator
 	}
 	return ""
 }
 
-func (m *MsgSetTrainingAllowList) GetAddresses() []string {
+func (m *MsgBridgeExchange) GetOriginChain() string {
 	if m != nil {
-		return m.Addresses
+		return m.OriginChain
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgSetTrainingAllowList) GetRole() TrainingRole {
+func (m *MsgBridgeExchange) GetContractAddress() string {
 	if m != nil {
-		return m.Role
+		return m.ContractAddress
 	}
-	return TrainingRole_ROLE_EXEC
+	return ""
 }
 
-type MsgSetTrainingAllowListResponse struct {
+func (m *MsgBridgeExchange) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
 }
 
-func (m *MsgSetTrainingAllowListResponse) Reset()         { *m = MsgSetTrainingAllowListResponse{} }
-func (m *MsgSetTrainingAllowListResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetTrainingAllowListResponse) ProtoMessage()    {}
-func (*MsgSetTrainingAllowListResponse) Descriptor() ([]byte, []int) {
+func (m *MsgBridgeExchange) GetOwnerPubKey() string {
+	if m != nil {
+		return m.OwnerPubKey
+	}
+	return ""
+}
+
+func (m *MsgBridgeExchange) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *MsgBridgeExchange) GetBlockNumber() string {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return ""
+}
+
+func (m *MsgBridgeExchange) GetReceiptIndex() string {
+	if m != nil {
+		return m.ReceiptIndex
+	}
+	return ""
+}
+
+func (m *MsgBridgeExchange) GetReceiptsRoot() string {
+	if m != nil {
+		return m.ReceiptsRoot
+	}
+	return ""
+}
+
+func (m *MsgBridgeExchange) GetReceiptProof() [][]byte {
+	if m != nil {
+		return m.ReceiptProof
+	}
+	return nil
+}
+
+type MsgBridgeExchangeResponse struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgBridgeExchangeResponse) Reset()         { *m = MsgBridgeExchangeResponse{} }
+func (m *MsgBridgeExchangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBridgeExchangeResponse) ProtoMessage()    {}
+func (*MsgBridgeExchangeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{57}
 }
-func (m *MsgSetTrainingAllowListResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBridgeExchangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetTrainingAllowListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBridgeExchangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetTrainingAllowListResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBridgeExchangeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3382,37 +3499,43 @@ func (m *MsgSetTrainingAllowListResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgSetTrainingAllowListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetTrainingAllowListResponse.Merge(m, src)
+func (m *MsgBridgeExchangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBridgeExchangeResponse.Merge(m, src)
 }
-func (m *MsgSetTrainingAllowListResponse) XXX_Size() int {
+func (m *MsgBridgeExchangeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetTrainingAllowListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetTrainingAllowListResponse.DiscardUnknown(m)
+func (m *MsgBridgeExchangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBridgeExchangeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetTrainingAllowListResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBridgeExchangeResponse proto.InternalMessageInfo
 
-// MsgAddParticipantsToAllowList adds addresses to the participant epoch-formation allowlist.
-// Recommended max 500 addresses per message to stay within tx size limits.
-type MsgAddParticipantsToAllowList struct {
-	Authority string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+func (m *MsgBridgeExchangeResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (m *MsgAddParticipantsToAllowList) Reset()         { *m = MsgAddParticipantsToAllowList{} }
-func (m *MsgAddParticipantsToAllowList) String() string { return proto.CompactTextString(m) }
-func (*MsgAddParticipantsToAllowList) ProtoMessage()    {}
-func (*MsgAddParticipantsToAllowList) Descriptor() ([]byte, []int) {
+type MsgAddUserToTrainingAllowList struct {
+	Authority string       `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Address   string       `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Role      TrainingRole `protobuf:"varint,3,opt,name=role,proto3,enum=inference.inference.TrainingRole" json:"role,omitempty"`
+}
+
+func (m *MsgAddUserToTrainingAllowList) Reset()         { *m = MsgAddUserToTrainingAllowList{} }
+func (m *MsgAddUserToTrainingAllowList) String() string { return proto.CompactTextString(m) }
+func (*MsgAddUserToTrainingAllowList) ProtoMessage()    {}
+func (*MsgAddUserToTrainingAllowList) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{58}
 }
-func (m *MsgAddParticipantsToAllowList) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddUserToTrainingAllowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddParticipantsToAllowList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddUserToTrainingAllowList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddParticipantsToAllowList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddUserToTrainingAllowList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3422,47 +3545,54 @@ func (m *MsgAddParticipantsToAllowList) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgAddParticipantsToAllowList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddParticipantsToAllowList.Merge(m, src)
+func (m *MsgAddUserToTrainingAllowList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddUserToTrainingAllowList.Merge(m, src)
 }
-func (m *MsgAddParticipantsToAllowList) XXX_Size() int {
+func (m *MsgAddUserToTrainingAllowList) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddParticipantsToAllowList) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddParticipantsToAllowList.DiscardUnknown(m)
+func (m *MsgAddUserToTrainingAllowList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddUserToTrainingAllowList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddParticipantsToAllowList proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddUserToTrainingAllowList proto.InternalMessageInfo
 
-func (m *MsgAddParticipantsToAllowList) GetAuthority() string {
+func (m *MsgAddUserToTrainingAllowList) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgAddParticipantsToAllowList) GetAddresses() []string {
+func (m *MsgAddUserToTrainingAllowList) GetAddress() string {
 	if m != nil {
-		return m.Addresses
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-type MsgAddParticipantsToAllowListResponse struct {
+func (m *MsgAddUserToTrainingAllowList) GetRole() TrainingRole {
+	if m != nil {
+		return m.Role
+	}
+	return TrainingRole_ROLE_EXEC
 }
 
-func (m *MsgAddParticipantsToAllowListResponse) Reset()         { *m = MsgAddParticipantsToAllowListResponse{} }
-func (m *MsgAddParticipantsToAllowListResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddParticipantsToAllowListResponse) ProtoMessage()    {}
-func (*MsgAddParticipantsToAllowListResponse) Descriptor() ([]byte, []int) {
+type MsgAddUserToTrainingAllowListResponse struct {
+}
+
+func (m *MsgAddUserToTrainingAllowListResponse) Reset()         { *m = MsgAddUserToTrainingAllowListResponse{} }
+func (m *MsgAddUserToTrainingAllowListResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddUserToTrainingAllowListResponse) ProtoMessage()    {}
+func (*MsgAddUserToTrainingAllowListResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{59}
 }
-func (m *MsgAddParticipantsToAllowListResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddUserToTrainingAllowListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddParticipantsToAllowListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddUserToTrainingAllowListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddParticipantsToAllowListResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddUserToTrainingAllowListResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3472,37 +3602,36 @@ func (m *MsgAddParticipantsToAllowListResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgAddParticipantsToAllowListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddParticipantsToAllowListResponse.Merge(m, src)
+func (m *MsgAddUserToTrainingAllowListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddUserToTrainingAllowListResponse.Merge(m, src)
 }
-func (m *MsgAddParticipantsToAllowListResponse) XXX_Size() int {
+func (m *MsgAddUserToTrainingAllowListResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddParticipantsToAllowListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddParticipantsToAllowListResponse.DiscardUnknown(m)
+func (m *MsgAddUserToTrainingAllowListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddUserToTrainingAllowListResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddParticipantsToAllowListResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddUserToTrainingAllowListResponse proto.InternalMessageInfo
 
-// MsgRemoveParticipantsFromAllowList removes addresses from the participant epoch-formation allowlist.
-// Recommended max 500 addresses per message to stay within tx size limits.
-type MsgRemoveParticipantsFromAllowList struct {
-	Authority string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+type MsgRemoveUserFromTrainingAllowList struct {
+	Authority string       `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Address   string       `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Role      TrainingRole `protobuf:"varint,3,opt,name=role,proto3,enum=inference.inference.TrainingRole" json:"role,omitempty"`
 }
 
-func (m *MsgRemoveParticipantsFromAllowList) Reset()         { *m = MsgRemoveParticipantsFromAllowList{} }
-func (m *MsgRemoveParticipantsFromAllowList) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveParticipantsFromAllowList) ProtoMessage()    {}
-func (*MsgRemoveParticipantsFromAllowList) Descriptor() ([]byte, []int) {
+func (m *MsgRemoveUserFromTrainingAllowList) Reset()         { *m = MsgRemoveUserFromTrainingAllowList{} }
+func (m *MsgRemoveUserFromTrainingAllowList) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveUserFromTrainingAllowList) ProtoMessage()    {}
+func (*MsgRemoveUserFromTrainingAllowList) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{60}
 }
-func (m *MsgRemoveParticipantsFromAllowList) XXX_Unmarshal(b []byte) error {
+func (m *MsgRemoveUserFromTrainingAllowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveParticipantsFromAllowList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRemoveUserFromTrainingAllowList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveParticipantsFromAllowList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRemoveUserFromTrainingAllowList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3512,51 +3641,58 @@ func (m *MsgRemoveParticipantsFromAllowList) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveParticipantsFromAllowList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveParticipantsFromAllowList.Merge(m, src)
+func (m *MsgRemoveUserFromTrainingAllowList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveUserFromTrainingAllowList.Merge(m, src)
 }
-func (m *MsgRemoveParticipantsFromAllowList) XXX_Size() int {
+func (m *MsgRemoveUserFromTrainingAllowList) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveParticipantsFromAllowList) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveParticipantsFromAllowList.DiscardUnknown(m)
+func (m *MsgRemoveUserFromTrainingAllowList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveUserFromTrainingAllowList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveParticipantsFromAllowList proto.InternalMessageInfo
+var xxx_messageInfo_MsgRemoveUserFromTrainingAllowList proto.InternalMessageInfo
 
-func (m *MsgRemoveParticipantsFromAllowList) GetAuthority() string {
+func (m *MsgRemoveUserFromTrainingAllowList) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgRemoveParticipantsFromAllowList) GetAddresses() []string {
+func (m *MsgRemoveUserFromTrainingAllowList) GetAddress() string {
 	if m != nil {
-		return m.Addresses
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-type MsgRemoveParticipantsFromAllowListResponse struct {
+func (m *MsgRemoveUserFromTrainingAllowList) GetRole() TrainingRole {
+	if m != nil {
+		return m.Role
+	}
+	return TrainingRole_ROLE_EXEC
 }
 
-func (m *MsgRemoveParticipantsFromAllowListResponse) Reset() {
-	*m = MsgRemoveParticipantsFromAllowListResponse{}
+type MsgRemoveUserFromTrainingAllowListResponse struct {
 }
-func (m *MsgRemoveParticipantsFromAllowListResponse) String() string {
+
+func (m *MsgRemoveUserFromTrainingAllowListResponse) Reset() {
+	*m = MsgRemoveUserFromTrainingAllowListResponse{}
+}
+func (m *MsgRemoveUserFromTrainingAllowListResponse) String() string {
 	return proto.CompactTextString(m)
 }
-func (*MsgRemoveParticipantsFromAllowListResponse) ProtoMessage() {}
-func (*MsgRemoveParticipantsFromAllowListResponse) Descriptor() ([]byte, []int) {
+func (*MsgRemoveUserFromTrainingAllowListResponse) ProtoMessage() {}
+func (*MsgRemoveUserFromTrainingAllowListResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{61}
 }
-func (m *MsgRemoveParticipantsFromAllowListResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRemoveUserFromTrainingAllowListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveParticipantsFromAllowListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRemoveUserFromTrainingAllowListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveParticipantsFromAllowListResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRemoveUserFromTrainingAllowListResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3566,36 +3702,36 @@ func (m *MsgRemoveParticipantsFromAllowListResponse) XXX_Marshal(b []byte, deter
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveParticipantsFromAllowListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveParticipantsFromAllowListResponse.Merge(m, src)
+func (m *MsgRemoveUserFromTrainingAllowListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveUserFromTrainingAllowListResponse.Merge(m, src)
 }
-func (m *MsgRemoveParticipantsFromAllowListResponse) XXX_Size() int {
+func (m *MsgRemoveUserFromTrainingAllowListResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveParticipantsFromAllowListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveParticipantsFromAllowListResponse.DiscardUnknown(m)
+func (m *MsgRemoveUserFromTrainingAllowListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveUserFromTrainingAllowListResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveParticipantsFromAllowListResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRemoveUserFromTrainingAllowListResponse proto.InternalMessageInfo
 
-type MsgRegisterBridgeAddresses struct {
-	Authority string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	ChainName string   `protobuf:"bytes,2,opt,name=chainName,proto3" json:"chainName,omitempty"`
-	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+type MsgSetTrainingAllowList struct {
+	Authority string       `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Addresses []string     `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Role      TrainingRole `protobuf:"varint,3,opt,name=role,proto3,enum=inference.inference.TrainingRole" json:"role,omitempty"`
 }
 
-func (m *MsgRegisterBridgeAddresses) Reset()         { *m = MsgRegisterBridgeAddresses{} }
-func (m *MsgRegisterBridgeAddresses) String() string { return proto.CompactTextString(m) }
-func (*MsgRegisterBridgeAddresses) ProtoMessage()    {}
-func (*MsgRegisterBridgeAddresses) Descriptor() ([]byte, []int) {
+func (m *MsgSetTrainingAllowList) Reset()         { *m = MsgSetTrainingAllowList{} }
+func (m *MsgSetTrainingAllowList) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTrainingAllowList) ProtoMessage()    {}
+func (*MsgSetTrainingAllowList) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{62}
 }
-func (m *MsgRegisterBridgeAddresses) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetTrainingAllowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRegisterBridgeAddresses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetTrainingAllowList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRegisterBridgeAddresses.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetTrainingAllowList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3605,54 +3741,54 @@ func (m *MsgRegisterBridgeAddresses) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgRegisterBridgeAddresses) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRegisterBridgeAddresses.Merge(m, src)
+func (m *MsgSetTrainingAllowList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTrainingAllowList.Merge(m, src)
 }
-func (m *MsgRegisterBridgeAddresses) XXX_Size() int {
+func (m *MsgSetTrainingAllowList) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRegisterBridgeAddresses) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRegisterBridgeAddresses.DiscardUnknown(m)
+func (m *MsgSetTrainingAllowList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTrainingAllowList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRegisterBridgeAddresses proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetTrainingAllowList proto.InternalMessageInfo
 
-func (m *MsgRegisterBridgeAddresses) GetAuthority() string {
+func (m *MsgSetTrainingAllowList) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgRegisterBridgeAddresses) GetChainName() string {
+func (m *MsgSetTrainingAllowList) GetAddresses() []string {
 	if m != nil {
-		return m.ChainName
+		return m.Addresses
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgRegisterBridgeAddresses) GetAddresses() []string {
+func (m *MsgSetTrainingAllowList) GetRole() TrainingRole {
 	if m != nil {
-		return m.Addresses
+		return m.Role
 	}
-	return nil
+	return TrainingRole_ROLE_EXEC
 }
 
-type MsgRegisterBridgeAddressesResponse struct {
+type MsgSetTrainingAllowListResponse struct {
 }
 
-func (m *MsgRegisterBridgeAddressesResponse) Reset()         { *m = MsgRegisterBridgeAddressesResponse{} }
-func (m *MsgRegisterBridgeAddressesResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRegisterBridgeAddressesResponse) ProtoMessage()    {}
-func (*MsgRegisterBridgeAddressesResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetTrainingAllowListResponse) Reset()         { *m = MsgSetTrainingAllowListResponse{} }
+func (m *MsgSetTrainingAllowListResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTrainingAllowListResponse) ProtoMessage()    {}
+func (*MsgSetTrainingAllowListResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{63}
 }
-func (m *MsgRegisterBridgeAddressesResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetTrainingAllowListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRegisterBridgeAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetTrainingAllowListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRegisterBridgeAddressesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetTrainingAllowListResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3662,40 +3798,37 @@ func (m *MsgRegisterBridgeAddressesResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgRegisterBridgeAddressesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRegisterBridgeAddressesResponse.Merge(m, src)
+func (m *MsgSetTrainingAllowListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTrainingAllowListResponse.Merge(m, src)
 }
-func (m *MsgRegisterBridgeAddressesResponse) XXX_Size() int {
+func (m *MsgSetTrainingAllowListResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRegisterBridgeAddressesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRegisterBridgeAddressesResponse.DiscardUnknown(m)
+func (m *MsgSetTrainingAllowListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTrainingAllowListResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRegisterBridgeAddressesResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetTrainingAllowListResponse proto.InternalMessageInfo
 
-type MsgRegisterTokenMetadata struct {
-	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	ChainId         string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
-	ContractAddress string `protobuf:"bytes,3,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
-	Name            string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Symbol          string `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Decimals        uint32 `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	Overwrite       bool   `protobuf:"varint,7,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+// MsgAddParticipantsToAllowList adds addresses to the participant epoch-formation allowlist.
+// Recommended max 500 addresses per message to stay within tx size limits.
+type MsgAddParticipantsToAllowList struct {
+	Authority string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (m *MsgRegisterTokenMetadata) Reset()         { *m = MsgRegisterTokenMetadata{} }
-func (m *MsgRegisterTokenMetadata) String() string { return proto.CompactTextString(m) }
-func (*MsgRegisterTokenMetadata) ProtoMessage()    {}
-func (*MsgRegisterTokenMetadata) Descriptor() ([]byte, []int) {
+func (m *MsgAddParticipantsToAllowList) Reset()         { *m = MsgAddParticipantsToAllowList{} }
+func (m *MsgAddParticipantsToAllowList) String() string { return proto.CompactTextString(m) }
+func (*MsgAddParticipantsToAllowList) ProtoMessage()    {}
+func (*MsgAddParticipantsToAllowList) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{64}
 }
-func (m *MsgRegisterTokenMetadata) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddParticipantsToAllowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRegisterTokenMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddParticipantsToAllowList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRegisterTokenMetadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddParticipantsToAllowList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3705,82 +3838,47 @@ func (m *MsgRegisterTokenMetadata) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgRegisterTokenMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRegisterTokenMetadata.Merge(m, src)
+func (m *MsgAddParticipantsToAllowList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddParticipantsToAllowList.Merge(m, src)
 }
-func (m *MsgRegisterTokenMetadata) XXX_Size() int {
+func (m *MsgAddParticipantsToAllowList) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRegisterTokenMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRegisterTokenMetadata.DiscardUnknown(m)
+func (m *MsgAddParticipantsToAllowList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddParticipantsToAllowList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRegisterTokenMetadata proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddParticipantsToAllowList proto.InternalMessageInfo
 
-func (m *MsgRegisterTokenMetadata) GetAuthority() string {
+func (m *MsgAddParticipantsToAllowList) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgRegisterTokenMetadata) GetChainId() string {
+func (m *MsgAddParticipantsToAllowList) GetAddresses() []string {
 	if m != nil {
-		return m.ChainId
+		return m.Addresses
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgRegisterTokenMetadata) GetContractAddress() string {
-	if m != nil {
-		return m.ContractAddress
-	}
-	return ""
+type MsgAddParticipantsToAllowListResponse struct {
 }
 
-func (m *MsgRegisterTokenMetadata) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
+func (m *MsgAddParticipantsToAllowListResponse) Reset()         { *m = MsgAddParticipantsToAllowListResponse{} }
+func (m *MsgAddParticipantsToAllowListResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddParticipantsToAllowListResponse) ProtoMessage()    {}
+func (*MsgAddParticipantsToAllowListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{65}
 }
-
-func (m *MsgRegisterTokenMetadata) GetSymbol() string {
-	if m != nil {
-		return m.Symbol
-	}
-	return ""
-}
-
-func (m *MsgRegisterTokenMetadata) GetDecimals() uint32 {
-	if m != nil {
-		return m.Decimals
-	}
-	return 0
-}
-
-func (m *MsgRegisterTokenMetadata) GetOverwrite() bool {
-	if m != nil {
-		return m.Overwrite
-	}
-	return false
-}
-
-type MsgRegisterTokenMetadataResponse struct {
-}
-
-func (m *MsgRegisterTokenMetadataResponse) Reset()         { *m = MsgRegisterTokenMetadataResponse{} }
-func (m *MsgRegisterTokenMetadataResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRegisterTokenMetadataResponse) ProtoMessage()    {}
-func (*MsgRegisterTokenMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09b36d0241b9acd5, []int{65}
-}
-func (m *MsgRegisterTokenMetadataResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddParticipantsToAllowListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRegisterTokenMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddParticipantsToAllowListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRegisterTokenMetadataResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddParticipantsToAllowListResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3790,36 +3888,37 @@ func (m *MsgRegisterTokenMetadataResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgRegisterTokenMetadataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRegisterTokenMetadataResponse.Merge(m, src)
+func (m *MsgAddParticipantsToAllowListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddParticipantsToAllowListResponse.Merge(m, src)
 }
-func (m *MsgRegisterTokenMetadataResponse) XXX_Size() int {
+func (m *MsgAddParticipantsToAllowListResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRegisterTokenMetadataResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRegisterTokenMetadataResponse.DiscardUnknown(m)
+func (m *MsgAddParticipantsToAllowListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddParticipantsToAllowListResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRegisterTokenMetadataResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddParticipantsToAllowListResponse proto.InternalMessageInfo
 
-type MsgApproveBridgeTokenForTrading struct {
-	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	ChainId         string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
-	ContractAddress string `protobuf:"bytes,3,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+// MsgRemoveParticipantsFromAllowList removes addresses from the participant epoch-formation allowlist.
+// Recommended max 500 addresses per message to stay within tx size limits.
+type MsgRemoveParticipantsFromAllowList struct {
+	Authority string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (m *MsgApproveBridgeTokenForTrading) Reset()         { *m = MsgApproveBridgeTokenForTrading{} }
-func (m *MsgApproveBridgeTokenForTrading) String() string { return proto.CompactTextString(m) }
-func (*MsgApproveBridgeTokenForTrading) ProtoMessage()    {}
-func (*MsgApproveBridgeTokenForTrading) Descriptor() ([]byte, []int) {
+func (m *MsgRemoveParticipantsFromAllowList) Reset()         { *m = MsgRemoveParticipantsFromAllowList{} }
+func (m *MsgRemoveParticipantsFromAllowList) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveParticipantsFromAllowList) ProtoMessage()    {}
+func (*MsgRemoveParticipantsFromAllowList) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{66}
 }
-func (m *MsgApproveBridgeTokenForTrading) XXX_Unmarshal(b []byte) error {
+func (m *MsgRemoveParticipantsFromAllowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgApproveBridgeTokenForTrading) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRemoveParticipantsFromAllowList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgApproveBridgeTokenForTrading.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRemoveParticipantsFromAllowList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3829,56 +3928,51 @@ func (m *MsgApproveBridgeTokenForTrading) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgApproveBridgeTokenForTrading) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgApproveBridgeTokenForTrading.Merge(m, src)
+func (m *MsgRemoveParticipantsFromAllowList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveParticipantsFromAllowList.Merge(m, src)
 }
-func (m *MsgApproveBridgeTokenForTrading) XXX_Size() int {
+func (m *MsgRemoveParticipantsFromAllowList) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgApproveBridgeTokenForTrading) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgApproveBridgeTokenForTrading.DiscardUnknown(m)
+func (m *MsgRemoveParticipantsFromAllowList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveParticipantsFromAllowList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgApproveBridgeTokenForTrading proto.InternalMessageInfo
+var xxx_messageInfo_MsgRemoveParticipantsFromAllowList proto.InternalMessageInfo
 
-func (m *MsgApproveBridgeTokenForTrading) GetAuthority() string {
+func (m *MsgRemoveParticipantsFromAllowList) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgApproveBridgeTokenForTrading) GetChainId() string {
+func (m *MsgRemoveParticipantsFromAllowList) GetAddresses() []string {
 	if m != nil {
-		return m.ChainId
+		return m.Addresses
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgApproveBridgeTokenForTrading) GetContractAddress() string {
-	if m != nil {
-		return m.ContractAddress
-	}
-	return ""
+type MsgRemoveParticipantsFromAllowListResponse struct {
 }
 
-type MsgApproveBridgeTokenForTradingResponse struct {
+func (m *MsgRemoveParticipantsFromAllowListResponse) Reset() {
+	*m = MsgRemoveParticipantsFromAllowListResponse{}
 }
-
-func (m *MsgApproveBridgeTokenForTradingResponse) Reset() {
-	*m = MsgApproveBridgeTokenForTradingResponse{}
+func (m *MsgRemoveParticipantsFromAllowListResponse) String() string {
+	return proto.CompactTextString(m)
 }
-func (m *MsgApproveBridgeTokenForTradingResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgApproveBridgeTokenForTradingResponse) ProtoMessage()    {}
-func (*MsgApproveBridgeTokenForTradingResponse) Descriptor() ([]byte, []int) {
+func (*MsgRemoveParticipantsFromAllowListResponse) ProtoMessage() {}
+func (*MsgRemoveParticipantsFromAllowListResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{67}
 }
-func (m *MsgApproveBridgeTokenForTradingResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRemoveParticipantsFromAllowListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgApproveBridgeTokenForTradingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRemoveParticipantsFromAllowListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgApproveBridgeTokenForTradingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRemoveParticipantsFromAllowListResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3888,37 +3982,36 @@ func (m *MsgApproveBridgeTokenForTradingResponse) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *MsgApproveBridgeTokenForTradingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgApproveBridgeTokenForTradingResponse.Merge(m, src)
+func (m *MsgRemoveParticipantsFromAllowListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveParticipantsFromAllowListResponse.Merge(m, src)
 }
-func (m *MsgApproveBridgeTokenForTradingResponse) XXX_Size() int {
+func (m *MsgRemoveParticipantsFromAllowListResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgApproveBridgeTokenForTradingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgApproveBridgeTokenForTradingResponse.DiscardUnknown(m)
+func (m *MsgRemoveParticipantsFromAllowListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveParticipantsFromAllowListResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgApproveBridgeTokenForTradingResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRemoveParticipantsFromAllowListResponse proto.InternalMessageInfo
 
-type MsgRegisterLiquidityPool struct {
-	Authority      string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	CodeId         string `protobuf:"bytes,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
-	Label          string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
-	InstantiateMsg string `protobuf:"bytes,4,opt,name=instantiate_msg,json=instantiateMsg,proto3" json:"instantiate_msg,omitempty"`
+type MsgRegisterBridgeAddresses struct {
+	Authority string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ChainName string   `protobuf:"bytes,2,opt,name=chainName,proto3" json:"chainName,omitempty"`
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (m *MsgRegisterLiquidityPool) Reset()         { *m = MsgRegisterLiquidityPool{} }
-func (m *MsgRegisterLiquidityPool) String() string { return proto.CompactTextString(m) }
-func (*MsgRegisterLiquidityPool) ProtoMessage()    {}
-func (*MsgRegisterLiquidityPool) Descriptor() ([]byte, []int) {
+func (m *MsgRegisterBridgeAddresses) Reset()         { *m = MsgRegisterBridgeAddresses{} }
+func (m *MsgRegisterBridgeAddresses) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBridgeAddresses) ProtoMessage()    {}
+func (*MsgRegisterBridgeAddresses) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{68}
 }
-func (m *MsgRegisterLiquidityPool) XXX_Unmarshal(b []byte) error {
+func (m *MsgRegisterBridgeAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRegisterLiquidityPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterBridgeAddresses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRegisterLiquidityPool.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterBridgeAddresses.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3928,61 +4021,54 @@ func (m *MsgRegisterLiquidityPool) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgRegisterLiquidityPool) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRegisterLiquidityPool.Merge(m, src)
+func (m *MsgRegisterBridgeAddresses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBridgeAddresses.Merge(m, src)
 }
-func (m *MsgRegisterLiquidityPool) XXX_Size() int {
+func (m *MsgRegisterBridgeAddresses) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRegisterLiquidityPool) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRegisterLiquidityPool.DiscardUnknown(m)
+func (m *MsgRegisterBridgeAddresses) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBridgeAddresses.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRegisterLiquidityPool proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterBridgeAddresses proto.InternalMessageInfo
 
-func (m *MsgRegisterLiquidityPool) GetAuthority() string {
+func (m *MsgRegisterBridgeAddresses) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgRegisterLiquidityPool) GetCodeId() string {
-	if m != nil {
-		return m.CodeId
-	}
-	return ""
-}
-
-func (m *MsgRegisterLiquidityPool) GetLabel() string {
+func (m *MsgRegisterBridgeAddresses) GetChainName() string {
 	if m != nil {
-		return m.Label
+		return m.ChainName
 	}
 	return ""
 }
 
-func (m *MsgRegisterLiquidityPool) GetInstantiateMsg() string {
+func (m *MsgRegisterBridgeAddresses) GetAddresses() []string {
 	if m != nil {
-		return m.InstantiateMsg
+		return m.Addresses
 	}
-	return ""
+	return nil
 }
 
-type MsgRegisterLiquidityPoolResponse struct {
+type MsgRegisterBridgeAddressesResponse struct {
 }
 
-func (m *MsgRegisterLiquidityPoolResponse) Reset()         { *m = MsgRegisterLiquidityPoolResponse{} }
-func (m *MsgRegisterLiquidityPoolResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRegisterLiquidityPoolResponse) ProtoMessage()    {}
-func (*MsgRegisterLiquidityPoolResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRegisterBridgeAddressesResponse) Reset()         { *m = MsgRegisterBridgeAddressesResponse{} }
+func (m *MsgRegisterBridgeAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBridgeAddressesResponse) ProtoMessage()    {}
+func (*MsgRegisterBridgeAddressesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{69}
 }
-func (m *MsgRegisterLiquidityPoolResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRegisterBridgeAddressesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRegisterLiquidityPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterBridgeAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRegisterLiquidityPoolResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterBridgeAddressesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3992,38 +4078,36 @@ func (m *MsgRegisterLiquidityPoolResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgRegisterLiquidityPoolResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRegisterLiquidityPoolResponse.Merge(m, src)
+func (m *MsgRegisterBridgeAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBridgeAddressesResponse.Merge(m, src)
 }
-func (m *MsgRegisterLiquidityPoolResponse) XXX_Size() int {
+func (m *MsgRegisterBridgeAddressesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRegisterLiquidityPoolResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRegisterLiquidityPoolResponse.DiscardUnknown(m)
+func (m *MsgRegisterBridgeAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBridgeAddressesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRegisterLiquidityPoolResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterBridgeAddressesResponse proto.InternalMessageInfo
 
-// Contract-only bridge withdrawal request - can only be executed by smart contracts
-type MsgRequestBridgeWithdrawal struct {
-	Creator            string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	UserAddress        string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
-	Amount             string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	DestinationAddress string `protobuf:"bytes,4,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+// MsgSetBridgeRateLimit sets the rate limit of a bridged token. A rate limit without any cap removes it.
+type MsgSetBridgeRateLimit struct {
+	Authority string          `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	RateLimit BridgeRateLimit `protobuf:"bytes,2,opt,name=rateLimit,proto3" json:"rateLimit"`
 }
 
-func (m *MsgRequestBridgeWithdrawal) Reset()         { *m = MsgRequestBridgeWithdrawal{} }
-func (m *MsgRequestBridgeWithdrawal) String() string { return proto.CompactTextString(m) }
-func (*MsgRequestBridgeWithdrawal) ProtoMessage()    {}
-func (*MsgRequestBridgeWithdrawal) Descriptor() ([]byte, []int) {
+func (m *MsgSetBridgeRateLimit) Reset()         { *m = MsgSetBridgeRateLimit{} }
+func (m *MsgSetBridgeRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgeRateLimit) ProtoMessage()    {}
+func (*MsgSetBridgeRateLimit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{70}
 }
-func (m *MsgRequestBridgeWithdrawal) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetBridgeRateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRequestBridgeWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetBridgeRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRequestBridgeWithdrawal.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetBridgeRateLimit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4033,64 +4117,47 @@ func (m *MsgRequestBridgeWithdrawal) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgRequestBridgeWithdrawal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRequestBridgeWithdrawal.Merge(m, src)
+func (m *MsgSetBridgeRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgeRateLimit.Merge(m, src)
 }
-func (m *MsgRequestBridgeWithdrawal) XXX_Size() int {
+func (m *MsgSetBridgeRateLimit) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRequestBridgeWithdrawal) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRequestBridgeWithdrawal.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgRequestBridgeWithdrawal proto.InternalMessageInfo
-
-func (m *MsgRequestBridgeWithdrawal) GetCreator() string {
-	if m != nil {
-		return m.Creator
-	}
-	return ""
+func (m *MsgSetBridgeRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgeRateLimit.DiscardUnknown(m)
 }
 
-func (m *MsgRequestBridgeWithdrawal) GetUserAddress() string {
-	if m != nil {
-		return m.UserAddress
-	}
-	return ""
-}
+var xxx_messageInfo_MsgSetBridgeRateLimit proto.InternalMessageInfo
 
-func (m *MsgRequestBridgeWithdrawal) GetAmount() string {
+func (m *MsgSetBridgeRateLimit) GetAuthority() string {
 	if m != nil {
-		return m.Amount
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgRequestBridgeWithdrawal) GetDestinationAddress() string {
+func (m *MsgSetBridgeRateLimit) GetRateLimit() BridgeRateLimit {
 	if m != nil {
-		return m.DestinationAddress
+		return m.RateLimit
 	}
-	return ""
+	return BridgeRateLimit{}
 }
 
-type MsgRequestBridgeWithdrawalResponse struct {
-	RequestId    string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	EpochIndex   uint64 `protobuf:"varint,2,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
-	BlsRequestId string `protobuf:"bytes,3,opt,name=bls_request_id,json=blsRequestId,proto3" json:"bls_request_id,omitempty"`
+type MsgSetBridgeRateLimitResponse struct {
 }
 
-func (m *MsgRequestBridgeWithdrawalResponse) Reset()         { *m = MsgRequestBridgeWithdrawalResponse{} }
-func (m *MsgRequestBridgeWithdrawalResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRequestBridgeWithdrawalResponse) ProtoMessage()    {}
-func (*MsgRequestBridgeWithdrawalResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetBridgeRateLimitResponse) Reset()         { *m = MsgSetBridgeRateLimitResponse{} }
+func (m *MsgSetBridgeRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgeRateLimitResponse) ProtoMessage()    {}
+func (*MsgSetBridgeRateLimitResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{71}
 }
-func (m *MsgRequestBridgeWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetBridgeRateLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRequestBridgeWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetBridgeRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRequestBridgeWithdrawalResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetBridgeRateLimitResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4100,59 +4167,39 @@ func (m *MsgRequestBridgeWithdrawalResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgRequestBridgeWithdrawalResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRequestBridgeWithdrawalResponse.Merge(m, src)
+func (m *MsgSetBridgeRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgeRateLimitResponse.Merge(m, src)
 }
-func (m *MsgRequestBridgeWithdrawalResponse) XXX_Size() int {
+func (m *MsgSetBridgeRateLimitResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRequestBridgeWithdrawalResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRequestBridgeWithdrawalResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgRequestBridgeWithdrawalResponse proto.InternalMessageInfo
-
-func (m *MsgRequestBridgeWithdrawalResponse) GetRequestId() string {
-	if m != nil {
-		return m.RequestId
-	}
-	return ""
-}
-
-func (m *MsgRequestBridgeWithdrawalResponse) GetEpochIndex() uint64 {
-	if m != nil {
-		return m.EpochIndex
-	}
-	return 0
+func (m *MsgSetBridgeRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgeRateLimitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRequestBridgeWithdrawalResponse) GetBlsRequestId() string {
-	if m != nil {
-		return m.BlsRequestId
-	}
-	return ""
-}
+var xxx_messageInfo_MsgSetBridgeRateLimitResponse proto.InternalMessageInfo
 
-// Native Gonka bridge mint request - bridges native tokens to WGNK
-type MsgRequestBridgeMint struct {
-	Creator            string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Amount             string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	DestinationAddress string `protobuf:"bytes,3,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
-	ChainId            string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+// MsgSetBridgePaused pauses or unpauses all bridge transfers of an origin chain. Unpausing also clears
+// the anomalies that count towards an automatic pause.
+type MsgSetBridgePaused struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ChainId   string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Paused    bool   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgRequestBridgeMint) Reset()         { *m = MsgRequestBridgeMint{} }
-func (m *MsgRequestBridgeMint) String() string { return proto.CompactTextString(m) }
-func (*MsgRequestBridgeMint) ProtoMessage()    {}
-func (*MsgRequestBridgeMint) Descriptor() ([]byte, []int) {
+func (m *MsgSetBridgePaused) Reset()         { *m = MsgSetBridgePaused{} }
+func (m *MsgSetBridgePaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgePaused) ProtoMessage()    {}
+func (*MsgSetBridgePaused) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{72}
 }
-func (m *MsgRequestBridgeMint) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetBridgePaused) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRequestBridgeMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetBridgePaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRequestBridgeMint.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetBridgePaused.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4162,64 +4209,61 @@ func (m *MsgRequestBridgeMint) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgRequestBridgeMint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRequestBridgeMint.Merge(m, src)
+func (m *MsgSetBridgePaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgePaused.Merge(m, src)
 }
-func (m *MsgRequestBridgeMint) XXX_Size() int {
+func (m *MsgSetBridgePaused) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRequestBridgeMint) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRequestBridgeMint.DiscardUnknown(m)
+func (m *MsgSetBridgePaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgePaused.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRequestBridgeMint proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetBridgePaused proto.InternalMessageInfo
 
-func (m *MsgRequestBridgeMint) GetCreator() string {
+func (m *MsgSetBridgePaused) GetAuthority() string {
 	if m != nil {
-		return m.Creator
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgRequestBridgeMint) GetAmount() string {
+func (m *MsgSetBridgePaused) GetChainId() string {
 	if m != nil {
-		return m.Amount
+		return m.ChainId
 	}
 	return ""
 }
 
-func (m *MsgRequestBridgeMint) GetDestinationAddress() string {
+func (m *MsgSetBridgePaused) GetPaused() bool {
 	if m != nil {
-		return m.DestinationAddress
+		return m.Paused
 	}
-	return ""
+	return false
 }
 
-func (m *MsgRequestBridgeMint) GetChainId() string {
+func (m *MsgSetBridgePaused) GetReason() string {
 	if m != nil {
-		return m.ChainId
+		return m.Reason
 	}
 	return ""
 }
 
-type MsgRequestBridgeMintResponse struct {
-	RequestId    string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	EpochIndex   uint64 `protobuf:"varint,2,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
-	BlsRequestId string `protobuf:"bytes,3,opt,name=bls_request_id,json=blsRequestId,proto3" json:"bls_request_id,omitempty"`
+type MsgSetBridgePausedResponse struct {
 }
 
-func (m *MsgRequestBridgeMintResponse) Reset()         { *m = MsgRequestBridgeMintResponse{} }
-func (m *MsgRequestBridgeMintResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRequestBridgeMintResponse) ProtoMessage()    {}
-func (*MsgRequestBridgeMintResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetBridgePausedResponse) Reset()         { *m = MsgSetBridgePausedResponse{} }
+func (m *MsgSetBridgePausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgePausedResponse) ProtoMessage()    {}
+func (*MsgSetBridgePausedResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{73}
 }
-func (m *MsgRequestBridgeMintResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetBridgePausedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRequestBridgeMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetBridgePausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRequestBridgeMintResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetBridgePausedResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4229,57 +4273,40 @@ func (m *MsgRequestBridgeMintResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgRequestBridgeMintResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRequestBridgeMintResponse.Merge(m, src)
+func (m *MsgSetBridgePausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgePausedResponse.Merge(m, src)
 }
-func (m *MsgRequestBridgeMintResponse) XXX_Size() int {
+func (m *MsgSetBridgePausedResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRequestBridgeMintResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRequestBridgeMintResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgRequestBridgeMintResponse proto.InternalMessageInfo
-
-func (m *MsgRequestBridgeMintResponse) GetRequestId() string {
-	if m != nil {
-		return m.RequestId
-	}
-	return ""
-}
-
-func (m *MsgRequestBridgeMintResponse) GetEpochIndex() uint64 {
-	if m != nil {
-		return m.EpochIndex
-	}
-	return 0
+func (m *MsgSetBridgePausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgePausedResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRequestBridgeMintResponse) GetBlsRequestId() string {
-	if m != nil {
-		return m.BlsRequestId
-	}
-	return ""
-}
+var xxx_messageInfo_MsgSetBridgePausedResponse proto.InternalMessageInfo
 
-// SetCw20CodeId updates the code id used for new wrapped-token instantiations.
-type MsgRegisterWrappedTokenContract struct {
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	CodeId    uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+type MsgRegisterTokenMetadata struct {
+	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ChainId         string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	ContractAddress string `protobuf:"bytes,3,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Name            string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol          string `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals        uint32 `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Overwrite       bool   `protobuf:"varint,7,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
 }
 
-func (m *MsgRegisterWrappedTokenContract) Reset()         { *m = MsgRegisterWrappedTokenContract{} }
-func (m *MsgRegisterWrappedTokenContract) String() string { return proto.CompactTextString(m) }
-func (*MsgRegisterWrappedTokenContract) ProtoMessage()    {}
-func (*MsgRegisterWrappedTokenContract) Descriptor() ([]byte, []int) {
+func (m *MsgRegisterTokenMetadata) Reset()         { *m = MsgRegisterTokenMetadata{} }
+func (m *MsgRegisterTokenMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterTokenMetadata) ProtoMessage()    {}
+func (*MsgRegisterTokenMetadata) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{74}
 }
-func (m *MsgRegisterWrappedTokenContract) XXX_Unmarshal(b []byte) error {
+func (m *MsgRegisterTokenMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRegisterWrappedTokenContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterTokenMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRegisterWrappedTokenContract.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterTokenMetadata.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4289,49 +4316,82 @@ func (m *MsgRegisterWrappedTokenContract) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgRegisterWrappedTokenContract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRegisterWrappedTokenContract.Merge(m, src)
+func (m *MsgRegisterTokenMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterTokenMetadata.Merge(m, src)
 }
-func (m *MsgRegisterWrappedTokenContract) XXX_Size() int {
+func (m *MsgRegisterTokenMetadata) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRegisterWrappedTokenContract) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRegisterWrappedTokenContract.DiscardUnknown(m)
+func (m *MsgRegisterTokenMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterTokenMetadata.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRegisterWrappedTokenContract proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterTokenMetadata proto.InternalMessageInfo
 
-func (m *MsgRegisterWrappedTokenContract) GetAuthority() string {
+func (m *MsgRegisterTokenMetadata) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgRegisterWrappedTokenContract) GetCodeId() uint64 {
+func (m *MsgRegisterTokenMetadata) GetChainId() string {
 	if m != nil {
-		return m.CodeId
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *MsgRegisterTokenMetadata) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterTokenMetadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgRegisterTokenMetadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *MsgRegisterTokenMetadata) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
 	}
 	return 0
 }
 
-type MsgRegisterWrappedTokenContractResponse struct {
+func (m *MsgRegisterTokenMetadata) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
+	}
+	return false
 }
 
-func (m *MsgRegisterWrappedTokenContractResponse) Reset() {
-	*m = MsgRegisterWrappedTokenContractResponse{}
+type MsgRegisterTokenMetadataResponse struct {
 }
-func (m *MsgRegisterWrappedTokenContractResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRegisterWrappedTokenContractResponse) ProtoMessage()    {}
-func (*MsgRegisterWrappedTokenContractResponse) Descriptor() ([]byte, []int) {
+
+func (m *MsgRegisterTokenMetadataResponse) Reset()         { *m = MsgRegisterTokenMetadataResponse{} }
+func (m *MsgRegisterTokenMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterTokenMetadataResponse) ProtoMessage()    {}
+func (*MsgRegisterTokenMetadataResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{75}
 }
-func (m *MsgRegisterWrappedTokenContractResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRegisterTokenMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRegisterWrappedTokenContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterTokenMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRegisterWrappedTokenContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterTokenMetadataResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4341,40 +4401,36 @@ func (m *MsgRegisterWrappedTokenContractResponse) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *MsgRegisterWrappedTokenContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRegisterWrappedTokenContractResponse.Merge(m, src)
+func (m *MsgRegisterTokenMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterTokenMetadataResponse.Merge(m, src)
 }
-func (m *MsgRegisterWrappedTokenContractResponse) XXX_Size() int {
+func (m *MsgRegisterTokenMetadataResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRegisterWrappedTokenContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRegisterWrappedTokenContractResponse.DiscardUnknown(m)
+func (m *MsgRegisterTokenMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterTokenMetadataResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRegisterWrappedTokenContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterTokenMetadataResponse proto.InternalMessageInfo
 
-// MigrateAllWrappedTokens migrates all known wrapped-token instances to new_code_id.
-// migrate_msg_json can be "{}" for no-op migrations.
-type MsgMigrateAllWrappedTokens struct {
-	Authority      string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	NewCodeId      uint64 `protobuf:"varint,2,opt,name=new_code_id,json=newCodeId,proto3" json:"new_code_id,omitempty"`
-	MigrateMsgJson string `protobuf:"bytes,3,opt,name=migrate_msg_json,json=migrateMsgJson,proto3" json:"migrate_msg_json,omitempty"`
-	// Optional batch limit per call; 0 means migrate all.
-	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+type MsgApproveBridgeTokenForTrading struct {
+	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ChainId         string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	ContractAddress string `protobuf:"bytes,3,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
 }
 
-func (m *MsgMigrateAllWrappedTokens) Reset()         { *m = MsgMigrateAllWrappedTokens{} }
-func (m *MsgMigrateAllWrappedTokens) String() string { return proto.CompactTextString(m) }
-func (*MsgMigrateAllWrappedTokens) ProtoMessage()    {}
-func (*MsgMigrateAllWrappedTokens) Descriptor() ([]byte, []int) {
+func (m *MsgApproveBridgeTokenForTrading) Reset()         { *m = MsgApproveBridgeTokenForTrading{} }
+func (m *MsgApproveBridgeTokenForTrading) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveBridgeTokenForTrading) ProtoMessage()    {}
+func (*MsgApproveBridgeTokenForTrading) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{76}
 }
-func (m *MsgMigrateAllWrappedTokens) XXX_Unmarshal(b []byte) error {
+func (m *MsgApproveBridgeTokenForTrading) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMigrateAllWrappedTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgApproveBridgeTokenForTrading) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMigrateAllWrappedTokens.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgApproveBridgeTokenForTrading.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4384,63 +4440,56 @@ func (m *MsgMigrateAllWrappedTokens) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgMigrateAllWrappedTokens) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMigrateAllWrappedTokens.Merge(m, src)
+func (m *MsgApproveBridgeTokenForTrading) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveBridgeTokenForTrading.Merge(m, src)
 }
-func (m *MsgMigrateAllWrappedTokens) XXX_Size() int {
+func (m *MsgApproveBridgeTokenForTrading) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMigrateAllWrappedTokens) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMigrateAllWrappedTokens.DiscardUnknown(m)
+func (m *MsgApproveBridgeTokenForTrading) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveBridgeTokenForTrading.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMigrateAllWrappedTokens proto.InternalMessageInfo
+var xxx_messageInfo_MsgApproveBridgeTokenForTrading proto.InternalMessageInfo
 
-func (m *MsgMigrateAllWrappedTokens) GetAuthority() string {
+func (m *MsgApproveBridgeTokenForTrading) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgMigrateAllWrappedTokens) GetNewCodeId() uint64 {
+func (m *MsgApproveBridgeTokenForTrading) GetChainId() string {
 	if m != nil {
-		return m.NewCodeId
+		return m.ChainId
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgMigrateAllWrappedTokens) GetMigrateMsgJson() string {
+func (m *MsgApproveBridgeTokenForTrading) GetContractAddress() string {
 	if m != nil {
-		return m.MigrateMsgJson
+		return m.ContractAddress
 	}
 	return ""
 }
 
-func (m *MsgMigrateAllWrappedTokens) GetLimit() uint32 {
-	if m != nil {
-		return m.Limit
-	}
-	return 0
+type MsgApproveBridgeTokenForTradingResponse struct {
 }
 
-type MsgMigrateAllWrappedTokensResponse struct {
-	// number of contracts attempted; success/failure details are in logs/events
-	Attempted uint32 `protobuf:"varint,1,opt,name=attempted,proto3" json:"attempted,omitempty"`
+func (m *MsgApproveBridgeTokenForTradingResponse) Reset() {
+	*m = MsgApproveBridgeTokenForTradingResponse{}
 }
-
-func (m *MsgMigrateAllWrappedTokensResponse) Reset()         { *m = MsgMigrateAllWrappedTokensResponse{} }
-func (m *MsgMigrateAllWrappedTokensResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMigrateAllWrappedTokensResponse) ProtoMessage()    {}
-func (*MsgMigrateAllWrappedTokensResponse) Descriptor() ([]byte, []int) {
+func (m *MsgApproveBridgeTokenForTradingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveBridgeTokenForTradingResponse) ProtoMessage()    {}
+func (*MsgApproveBridgeTokenForTradingResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09b36d0241b9acd5, []int{77}
 }
-func (m *MsgMigrateAllWrappedTokensResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgApproveBridgeTokenForTradingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMigrateAllWrappedTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgApproveBridgeTokenForTradingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMigrateAllWrappedTokensResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgApproveBridgeTokenForTradingResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4450,3522 +4499,3365 @@ func (m *MsgMigrateAllWrappedTokensResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgMigrateAllWrappedTokensResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMigrateAllWrappedTokensResponse.Merge(m, src)
+func (m *MsgApproveBridgeTokenForTradingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveBridgeTokenForTradingResponse.Merge(m, src)
 }
-func (m *MsgMigrateAllWrappedTokensResponse) XXX_Size() int {
+func (m *MsgApproveBridgeTokenForTradingResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMigrateAllWrappedTokensResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMigrateAllWrappedTokensResponse.DiscardUnknown(m)
+func (m *MsgApproveBridgeTokenForTradingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveBridgeTokenForTradingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMigrateAllWrappedTokensResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgApproveBridgeTokenForTradingResponse proto.InternalMessageInfo
 
-func (m *MsgMigrateAllWrappedTokensResponse) GetAttempted() uint32 {
-	if m != nil {
-		return m.Attempted
-	}
-	return 0
+type MsgRegisterLiquidityPool struct {
+	Authority      string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	CodeId         string `protobuf:"bytes,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	Label          string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	InstantiateMsg string `protobuf:"bytes,4,opt,name=instantiate_msg,json=instantiateMsg,proto3" json:"instantiate_msg,omitempty"`
 }
 
-func init() {
-	proto.RegisterEnum("inference.inference.TrainingRole", TrainingRole_name, TrainingRole_value)
-	proto.RegisterType((*MsgUpdateParams)(nil), "inference.inference.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.inference.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgStartInference)(nil), "inference.inference.MsgStartInference")
-	proto.RegisterType((*MsgStartInferenceResponse)(nil), "inference.inference.MsgStartInferenceResponse")
-	proto.RegisterType((*MsgFinishInference)(nil), "inference.inference.MsgFinishInference")
-	proto.RegisterType((*MsgFinishInferenceResponse)(nil), "inference.inference.MsgFinishInferenceResponse")
-	proto.RegisterType((*MsgSubmitNewParticipant)(nil), "inference.inference.MsgSubmitNewParticipant")
-	proto.RegisterType((*MsgSubmitNewParticipantResponse)(nil), "inference.inference.MsgSubmitNewParticipantResponse")
-	proto.RegisterType((*MsgValidation)(nil), "inference.inference.MsgValidation")
-	proto.RegisterType((*MsgValidationResponse)(nil), "inference.inference.MsgValidationResponse")
-	proto.RegisterType((*MsgSubmitNewUnfundedParticipant)(nil), "inference.inference.MsgSubmitNewUnfundedParticipant")
-	proto.RegisterType((*MsgSubmitNewUnfundedParticipantResponse)(nil), "inference.inference.MsgSubmitNewUnfundedParticipantResponse")
-	proto.RegisterType((*MsgInvalidateInference)(nil), "inference.inference.MsgInvalidateInference")
-	proto.RegisterType((*MsgInvalidateInferenceResponse)(nil), "inference.inference.MsgInvalidateInferenceResponse")
-	proto.RegisterType((*MsgRevalidateInference)(nil), "inference.inference.MsgRevalidateInference")
-	proto.RegisterType((*MsgRevalidateInferenceResponse)(nil), "inference.inference.MsgRevalidateInferenceResponse")
-	proto.RegisterType((*MsgClaimRewards)(nil), "inference.inference.MsgClaimRewards")
-	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "inference.inference.MsgClaimRewardsResponse")
-	proto.RegisterType((*MsgSubmitPocBatch)(nil), "inference.inference.MsgSubmitPocBatch")
-	proto.RegisterType((*MsgSubmitPocBatchResponse)(nil), "inference.inference.MsgSubmitPocBatchResponse")
-	proto.RegisterType((*MsgSubmitPocValidation)(nil), "inference.inference.MsgSubmitPocValidation")
-	proto.RegisterType((*MsgSubmitPocValidationResponse)(nil), "inference.inference.MsgSubmitPocValidationResponse")
-	proto.RegisterType((*MsgSubmitSeed)(nil), "inference.inference.MsgSubmitSeed")
-	proto.RegisterType((*MsgSubmitSeedResponse)(nil), "inference.inference.MsgSubmitSeedResponse")
-	proto.RegisterType((*MsgSubmitUnitOfComputePriceProposal)(nil), "inference.inference.MsgSubmitUnitOfComputePriceProposal")
-	proto.RegisterType((*MsgSubmitUnitOfComputePriceProposalResponse)(nil), "inference.inference.MsgSubmitUnitOfComputePriceProposalResponse")
-	proto.RegisterType((*MsgRegisterModel)(nil), "inference.inference.MsgRegisterModel")
-	proto.RegisterType((*MsgRegisterModelResponse)(nil), "inference.inference.MsgRegisterModelResponse")
-	proto.RegisterType((*MsgCreateTrainingTask)(nil), "inference.inference.MsgCreateTrainingTask")
-	proto.RegisterType((*MsgCreateTrainingTaskResponse)(nil), "inference.inference.MsgCreateTrainingTaskResponse")
-	proto.RegisterType((*MsgSubmitHardwareDiff)(nil), "inference.inference.MsgSubmitHardwareDiff")
-	proto.RegisterType((*MsgSubmitHardwareDiffResponse)(nil), "inference.inference.MsgSubmitHardwareDiffResponse")
-	proto.RegisterType((*MsgClaimTrainingTaskForAssignment)(nil), "inference.inference.MsgClaimTrainingTaskForAssignment")
-	proto.RegisterType((*MsgClaimTrainingTaskForAssignmentResponse)(nil), "inference.inference.MsgClaimTrainingTaskForAssignmentResponse")
-	proto.RegisterType((*MsgAssignTrainingTask)(nil), "inference.inference.MsgAssignTrainingTask")
-	proto.RegisterType((*MsgAssignTrainingTaskResponse)(nil), "inference.inference.MsgAssignTrainingTaskResponse")
-	proto.RegisterType((*MsgCreatePartialUpgrade)(nil), "inference.inference.MsgCreatePartialUpgrade")
-	proto.RegisterType((*MsgCreatePartialUpgradeResponse)(nil), "inference.inference.MsgCreatePartialUpgradeResponse")
-	proto.RegisterType((*MsgSubmitTrainingKvRecord)(nil), "inference.inference.MsgSubmitTrainingKvRecord")
-	proto.RegisterType((*MsgSubmitTrainingKvRecordResponse)(nil), "inference.inference.MsgSubmitTrainingKvRecordResponse")
-	proto.RegisterType((*MsgJoinTraining)(nil), "inference.inference.MsgJoinTraining")
-	proto.RegisterType((*MsgJoinTrainingResponse)(nil), "inference.inference.MsgJoinTrainingResponse")
-	proto.RegisterType((*MsgTrainingHeartbeat)(nil), "inference.inference.MsgTrainingHeartbeat")
-	proto.RegisterType((*MsgTrainingHeartbeatResponse)(nil), "inference.inference.MsgTrainingHeartbeatResponse")
-	proto.RegisterType((*MsgSetBarrier)(nil), "inference.inference.MsgSetBarrier")
-	proto.RegisterType((*MsgSetBarrierResponse)(nil), "inference.inference.MsgSetBarrierResponse")
-	proto.RegisterType((*MsgJoinTrainingStatus)(nil), "inference.inference.MsgJoinTrainingStatus")
-	proto.RegisterType((*MsgJoinTrainingStatusResponse)(nil), "inference.inference.MsgJoinTrainingStatusResponse")
-	proto.RegisterType((*MsgCreateDummyTrainingTask)(nil), "inference.inference.MsgCreateDummyTrainingTask")
-	proto.RegisterType((*MsgCreateDummyTrainingTaskResponse)(nil), "inference.inference.MsgCreateDummyTrainingTaskResponse")
-	proto.RegisterType((*MsgBridgeExchange)(nil), "inference.inference.MsgBridgeExchange")
-	proto.RegisterType((*MsgBridgeExchangeResponse)(nil), "inference.inference.MsgBridgeExchangeResponse")
-	proto.RegisterType((*MsgAddUserToTrainingAllowList)(nil), "inference.inference.MsgAddUserToTrainingAllowList")
-	proto.RegisterType((*MsgAddUserToTrainingAllowListResponse)(nil), "inference.inference.MsgAddUserToTrainingAllowListResponse")
-	proto.RegisterType((*MsgRemoveUserFromTrainingAllowList)(nil), "inference.inference.MsgRemoveUserFromTrainingAllowList")
-	proto.RegisterType((*MsgRemoveUserFromTrainingAllowListResponse)(nil), "inference.inference.MsgRemoveUserFromTrainingAllowListResponse")
-	proto.RegisterType((*MsgSetTrainingAllowList)(nil), "inference.inference.MsgSetTrainingAllowList")
-	proto.RegisterType((*MsgSetTrainingAllowListResponse)(nil), "inference.inference.MsgSetTrainingAllowListResponse")
-	proto.RegisterType((*MsgAddParticipantsToAllowList)(nil), "inference.inference.MsgAddParticipantsToAllowList")
-	proto.RegisterType((*MsgAddParticipantsToAllowListResponse)(nil), "inference.inference.MsgAddParticipantsToAllowListResponse")
-	proto.RegisterType((*MsgRemoveParticipantsFromAllowList)(nil), "inference.inference.MsgRemoveParticipantsFromAllowList")
-	proto.RegisterType((*MsgRemoveParticipantsFromAllowListResponse)(nil), "inference.inference.MsgRemoveParticipantsFromAllowListResponse")
-	proto.RegisterType((*MsgRegisterBridgeAddresses)(nil), "inference.inference.MsgRegisterBridgeAddresses")
-	proto.RegisterType((*MsgRegisterBridgeAddressesResponse)(nil), "inference.inference.MsgRegisterBridgeAddressesResponse")
-	proto.RegisterType((*MsgRegisterTokenMetadata)(nil), "inference.inference.MsgRegisterTokenMetadata")
-	proto.RegisterType((*MsgRegisterTokenMetadataResponse)(nil), "inference.inference.MsgRegisterTokenMetadataResponse")
-	proto.RegisterType((*MsgApproveBridgeTokenForTrading)(nil), "inference.inference.MsgApproveBridgeTokenForTrading")
-	proto.RegisterType((*MsgApproveBridgeTokenForTradingResponse)(nil), "inference.inference.MsgApproveBridgeTokenForTradingResponse")
-	proto.RegisterType((*MsgRegisterLiquidityPool)(nil), "inference.inference.MsgRegisterLiquidityPool")
-	proto.RegisterType((*MsgRegisterLiquidityPoolResponse)(nil), "inference.inference.MsgRegisterLiquidityPoolResponse")
-	proto.RegisterType((*MsgRequestBridgeWithdrawal)(nil), "inference.inference.MsgRequestBridgeWithdrawal")
-	proto.RegisterType((*MsgRequestBridgeWithdrawalResponse)(nil), "inference.inference.MsgRequestBridgeWithdrawalResponse")
-	proto.RegisterType((*MsgRequestBridgeMint)(nil), "inference.inference.MsgRequestBridgeMint")
-	proto.RegisterType((*MsgRequestBridgeMintResponse)(nil), "inference.inference.MsgRequestBridgeMintResponse")
-	proto.RegisterType((*MsgRegisterWrappedTokenContract)(nil), "inference.inference.MsgRegisterWrappedTokenContract")
-	proto.RegisterType((*MsgRegisterWrappedTokenContractResponse)(nil), "inference.inference.MsgRegisterWrappedTokenContractResponse")
-	proto.RegisterType((*MsgMigrateAllWrappedTokens)(nil), "inference.inference.MsgMigrateAllWrappedTokens")
-	proto.RegisterType((*MsgMigrateAllWrappedTokensResponse)(nil), "inference.inference.MsgMigrateAllWrappedTokensResponse")
+func (m *MsgRegisterLiquidityPool) Reset()         { *m = MsgRegisterLiquidityPool{} }
+func (m *MsgRegisterLiquidityPool) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterLiquidityPool) ProtoMessage()    {}
+func (*MsgRegisterLiquidityPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{78}
 }
-
-func init() { proto.RegisterFile("inference/inference/tx.proto", fileDescriptor_09b36d0241b9acd5) }
-
-var fileDescriptor_09b36d0241b9acd5 = []byte{
-	// 3768 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x4b, 0x8c, 0x1c, 0x49,
-	0x56, 0xce, 0xae, 0xea, 0x5f, 0xf4, 0xc7, 0xdd, 0xe9, 0x5e, 0xbb, 0x5c, 0xe3, 0x69, 0xb7, 0xcb,
-	0xe3, 0x71, 0xfb, 0xdb, 0xac, 0xe7, 0xb3, 0x23, 0x0f, 0x1a, 0xe8, 0xb6, 0x67, 0x76, 0xbc, 0x33,
-	0x6d, 0xb7, 0xd2, 0xed, 0x59, 0x58, 0x40, 0xa9, 0xa8, 0xcc, 0xe8, 0xac, 0xdc, 0xae, 0xcc, 0x48,
-	0x47, 0x44, 0x75, 0xbb, 0x58, 0x21, 0xad, 0x96, 0xcf, 0x61, 0xc5, 0x01, 0xc1, 0x01, 0x04, 0x68,
-	0x05, 0xac, 0x90, 0x90, 0x38, 0xe0, 0x03, 0x17, 0xce, 0x80, 0xb4, 0xc7, 0xd5, 0x4a, 0x48, 0x48,
-	0x2b, 0x21, 0x34, 0x73, 0xf0, 0x11, 0x89, 0x13, 0x47, 0x14, 0x9f, 0x8c, 0xca, 0xcc, 0xca, 0xc8,
-	0xac, 0xf6, 0x7a, 0x56, 0x73, 0xb1, 0x2b, 0x5e, 0xbc, 0x88, 0x78, 0xff, 0x78, 0xf1, 0x5e, 0x36,
-	0xb8, 0x10, 0xc6, 0x07, 0x88, 0xa0, 0xd8, 0x43, 0x5b, 0xa3, 0x5f, 0xec, 0xd9, 0xed, 0x84, 0x60,
-	0x86, 0xed, 0x33, 0x1a, 0x76, 0x5b, 0xff, 0x6a, 0xaf, 0xc2, 0x28, 0x8c, 0xf1, 0x96, 0xf8, 0x57,
-	0xe2, 0xb5, 0xcf, 0x79, 0x98, 0x46, 0x98, 0x6e, 0x45, 0x34, 0xd8, 0x3a, 0xfa, 0x3a, 0xff, 0x4f,
-	0x4d, 0x9c, 0x97, 0x13, 0xae, 0x18, 0x6d, 0xc9, 0x81, 0x9a, 0x5a, 0x0b, 0x70, 0x80, 0x25, 0x9c,
-	0xff, 0x4a, 0x17, 0x04, 0x18, 0x07, 0x7d, 0xb4, 0x25, 0x46, 0xdd, 0xc1, 0xc1, 0x16, 0x8c, 0x87,
-	0x6a, 0x6a, 0xa3, 0x8c, 0xd4, 0x04, 0x12, 0x18, 0xa5, 0x5b, 0x5e, 0x2d, 0x65, 0x86, 0xc0, 0x30,
-	0x0e, 0xe3, 0xc0, 0x65, 0x90, 0x1e, 0x56, 0x21, 0xf6, 0x20, 0xf1, 0x8f, 0x21, 0x41, 0x6e, 0x8c,
-	0x7d, 0xa4, 0x10, 0xdf, 0x2c, 0x43, 0x8c, 0x11, 0x3b, 0xc6, 0xe4, 0x30, 0x8b, 0x57, 0x4a, 0x5b,
-	0x97, 0x84, 0x7e, 0xa0, 0x30, 0x3a, 0xff, 0x6a, 0x81, 0xd3, 0xbb, 0x34, 0x78, 0x92, 0xf8, 0x90,
-	0xa1, 0x3d, 0x41, 0xb5, 0xfd, 0x2e, 0x98, 0x87, 0x03, 0xd6, 0xc3, 0x24, 0x64, 0xc3, 0x96, 0xb5,
-	0x61, 0x6d, 0xce, 0xef, 0xb4, 0x7e, 0xf6, 0xcf, 0xb7, 0xd6, 0x94, 0x9c, 0xb6, 0x7d, 0x9f, 0x20,
-	0x4a, 0x1f, 0x33, 0x12, 0xc6, 0x81, 0x33, 0x42, 0xb5, 0x3f, 0x00, 0x33, 0x92, 0xef, 0xd6, 0xd4,
-	0x86, 0xb5, 0xb9, 0x70, 0xe7, 0xb5, 0xdb, 0x25, 0x7a, 0xba, 0x2d, 0x0f, 0xd9, 0x99, 0xff, 0xc9,
-	0x7f, 0x5d, 0x3c, 0xf5, 0x0f, 0x2f, 0x9e, 0x5f, 0xb7, 0x1c, 0xb5, 0xea, 0xee, 0x7b, 0x3f, 0x78,
-	0xf1, 0xfc, 0xfa, 0x68, 0xbf, 0x1f, 0xbe, 0x78, 0x7e, 0xfd, 0xca, 0x88, 0xec, 0x67, 0x19, 0x16,
-	0x0a, 0x14, 0x77, 0xce, 0x83, 0x73, 0x05, 0x90, 0x83, 0x68, 0x82, 0x63, 0x8a, 0x3a, 0xff, 0xd4,
-	0x04, 0xab, 0xbb, 0x34, 0x78, 0xcc, 0x20, 0x61, 0x0f, 0xd2, 0x0d, 0xec, 0x16, 0x98, 0xf5, 0x08,
-	0x82, 0x0c, 0x13, 0xc9, 0xa0, 0x93, 0x0e, 0xed, 0x4b, 0x60, 0x51, 0x9f, 0xe3, 0x86, 0xbe, 0x60,
-	0x65, 0xde, 0x59, 0xd0, 0xb0, 0x07, 0xbe, 0x7d, 0x11, 0x2c, 0x24, 0x04, 0x47, 0x09, 0x73, 0x7b,
-	0x90, 0xf6, 0x5a, 0x0d, 0x81, 0x01, 0x24, 0xe8, 0x63, 0x48, 0x7b, 0xf6, 0x35, 0xb0, 0xac, 0x10,
-	0x12, 0x38, 0xec, 0x63, 0xe8, 0xb7, 0x9a, 0x42, 0x8a, 0x53, 0x2d, 0xcb, 0x59, 0x92, 0x33, 0x7b,
-	0x72, 0xc2, 0x5e, 0x03, 0xd3, 0x11, 0xf6, 0x51, 0xbf, 0x35, 0x23, 0x76, 0x91, 0x03, 0x4e, 0x04,
-	0x41, 0x4f, 0x07, 0x88, 0x32, 0xe4, 0xbb, 0xdd, 0x61, 0x6b, 0x56, 0x12, 0xa1, 0x61, 0x3b, 0x43,
-	0x4e, 0x04, 0xa4, 0x34, 0x0c, 0x62, 0xe4, 0xbb, 0x0c, 0xb7, 0xe6, 0x24, 0x11, 0x29, 0x68, 0x1f,
-	0xf3, 0x3d, 0xb8, 0x25, 0xb8, 0x47, 0x88, 0xd0, 0x10, 0xc7, 0xad, 0x79, 0xb9, 0x07, 0x87, 0x7d,
-	0x26, 0x41, 0xf6, 0xeb, 0x00, 0x44, 0xf0, 0x99, 0xcb, 0xf0, 0x21, 0x8a, 0x69, 0x0b, 0x6c, 0x58,
-	0x9b, 0x4d, 0x67, 0x3e, 0x82, 0xcf, 0xf6, 0x05, 0xc0, 0xbe, 0x09, 0x6c, 0xc5, 0x86, 0xc0, 0x70,
-	0x3d, 0x3c, 0x88, 0x59, 0x6b, 0x41, 0xa0, 0xad, 0xc8, 0x19, 0x81, 0x79, 0x8f, 0xc3, 0xed, 0x1b,
-	0x60, 0x55, 0xd1, 0xe7, 0xb2, 0x30, 0x42, 0x94, 0xc1, 0x28, 0x69, 0x2d, 0x6e, 0x58, 0x9b, 0x0d,
-	0x67, 0x45, 0x4d, 0xec, 0xa7, 0x70, 0xfb, 0x16, 0xb0, 0x19, 0x81, 0x31, 0x3d, 0x40, 0xc4, 0xe5,
-	0x14, 0x43, 0x36, 0x20, 0xa8, 0xb5, 0x2c, 0x48, 0x5c, 0x4d, 0x67, 0x1e, 0xa7, 0x13, 0xf6, 0x0d,
-	0x70, 0x1a, 0x93, 0x30, 0x08, 0x63, 0xd8, 0x77, 0xe5, 0xc1, 0xad, 0xd3, 0x5a, 0xa2, 0xcb, 0xe9,
-	0xd4, 0x9e, 0x98, 0xb1, 0x7f, 0x05, 0xac, 0x15, 0x90, 0xa5, 0x9e, 0x56, 0xc4, 0xee, 0x76, 0x1e,
-	0x9b, 0xeb, 0xeb, 0xee, 0x22, 0x37, 0xbc, 0xd4, 0x02, 0x3a, 0x21, 0x38, 0x3f, 0x66, 0x30, 0xa9,
-	0x39, 0xd9, 0x57, 0xc1, 0xe9, 0x8c, 0x79, 0xc4, 0x3e, 0x7a, 0xa6, 0x0c, 0x68, 0x79, 0x64, 0x21,
-	0x1c, 0x6a, 0x5f, 0x06, 0x4b, 0x88, 0x10, 0x4c, 0xdc, 0x08, 0x51, 0x0a, 0x03, 0xa4, 0x0c, 0x69,
-	0x51, 0x00, 0x77, 0x25, 0xac, 0xf3, 0xe3, 0x69, 0x60, 0xef, 0xd2, 0xe0, 0xa3, 0x30, 0x0e, 0x69,
-	0xef, 0x15, 0x59, 0xe7, 0x65, 0xb0, 0x44, 0x14, 0xb5, 0x59, 0xfb, 0x5c, 0x4c, 0x81, 0xc2, 0x42,
-	0x6f, 0x81, 0x15, 0x8d, 0x34, 0x6e, 0xa3, 0xa7, 0xd3, 0xb9, 0xd4, 0x4a, 0xcb, 0x2d, 0x61, 0xda,
-	0x60, 0x09, 0x6f, 0x83, 0xb3, 0x1e, 0x8e, 0x92, 0x3e, 0x62, 0x21, 0x8e, 0x73, 0x2b, 0x66, 0xc4,
-	0x8a, 0xb5, 0xd1, 0x6c, 0x66, 0xd5, 0x45, 0xb0, 0x80, 0x9e, 0x21, 0x6f, 0x90, 0x33, 0x79, 0x90,
-	0x82, 0x76, 0x86, 0xf6, 0x15, 0xb0, 0x9c, 0x5a, 0x06, 0x91, 0x38, 0xd2, 0xe8, 0x97, 0x32, 0xd0,
-	0x9d, 0x61, 0xb9, 0x1d, 0xce, 0x9f, 0xc8, 0x0e, 0x81, 0xc9, 0x0e, 0x6f, 0x01, 0x5b, 0x12, 0x84,
-	0xb3, 0xe8, 0x0b, 0x12, 0x3d, 0x9d, 0x19, 0xa1, 0x17, 0xdd, 0x78, 0x71, 0xdc, 0x8d, 0x4b, 0x2c,
-	0x7b, 0xc9, 0x68, 0xd9, 0x3a, 0x58, 0x2c, 0x67, 0x83, 0x45, 0x21, 0x1c, 0x9d, 0x1e, 0x0b, 0x47,
-	0xbf, 0xa8, 0x43, 0x7c, 0x17, 0xb4, 0xc7, 0x8d, 0xf4, 0x4b, 0xf2, 0x88, 0x3f, 0xb7, 0x44, 0x28,
-	0x7f, 0x3c, 0xe8, 0x46, 0x21, 0x7b, 0x88, 0x8e, 0xf7, 0x20, 0x61, 0xa1, 0x17, 0x26, 0x30, 0x66,
-	0x15, 0x6e, 0xb1, 0x02, 0x1a, 0x03, 0xd2, 0x57, 0x1b, 0xf2, 0x9f, 0xfc, 0xb0, 0x23, 0xd8, 0x0f,
-	0x7d, 0x3e, 0xed, 0x1e, 0xa2, 0x61, 0xea, 0x05, 0x1a, 0xf8, 0x09, 0x1a, 0xf2, 0xf8, 0xc7, 0x6f,
-	0x4c, 0x24, 0x31, 0x84, 0xfd, 0x3b, 0xf3, 0x12, 0xf2, 0x09, 0x1a, 0x16, 0xa4, 0x70, 0x00, 0x2e,
-	0x1a, 0x08, 0xd3, 0xa2, 0xb8, 0x01, 0x56, 0x93, 0x11, 0x38, 0x27, 0x8c, 0x95, 0xcc, 0x84, 0x14,
-	0xc7, 0x59, 0x30, 0x43, 0x19, 0x64, 0x03, 0xaa, 0xc8, 0x56, 0xa3, 0xce, 0xff, 0x59, 0x60, 0x69,
-	0x97, 0x06, 0x9f, 0x49, 0x42, 0x79, 0x98, 0x36, 0xf3, 0xbd, 0x0c, 0xa6, 0x74, 0x10, 0x98, 0x0a,
-	0xfd, 0xb1, 0xf0, 0xd0, 0x18, 0x0f, 0x0f, 0x27, 0xf4, 0xfc, 0xb1, 0x68, 0x32, 0x5d, 0x12, 0x4d,
-	0xd6, 0xc0, 0xf4, 0x11, 0xec, 0x0f, 0x90, 0xf0, 0x6f, 0xcb, 0x91, 0x03, 0xbb, 0xc3, 0xad, 0xff,
-	0x48, 0xb3, 0x21, 0x3c, 0x7a, 0xce, 0xc9, 0xc1, 0x0a, 0x22, 0x3e, 0x07, 0xbe, 0x96, 0xe3, 0x5c,
-	0x5f, 0xe2, 0x3f, 0xb3, 0xf2, 0xc2, 0x7f, 0x12, 0x1f, 0x0c, 0x62, 0x1f, 0xf9, 0x93, 0x59, 0x47,
-	0x0b, 0xcc, 0x42, 0x99, 0xb3, 0x28, 0x51, 0xa5, 0xc3, 0xd4, 0x6e, 0x1a, 0x23, 0xbb, 0x39, 0x07,
-	0x66, 0x93, 0x41, 0x37, 0x63, 0x0f, 0x33, 0xc9, 0xa0, 0xcb, 0x6d, 0x65, 0xcc, 0xa0, 0xa6, 0x6b,
-	0x0d, 0x6a, 0xa6, 0xda, 0xa0, 0xae, 0x81, 0xab, 0x35, 0x3c, 0x69, 0xfe, 0xff, 0xd0, 0x02, 0x67,
-	0x77, 0x69, 0xf0, 0x20, 0x56, 0xa7, 0xa1, 0x57, 0x74, 0x57, 0x6c, 0x80, 0x85, 0x30, 0xd6, 0x1c,
-	0x8c, 0xcc, 0x45, 0x83, 0x0a, 0x24, 0x6f, 0x80, 0xf5, 0x72, 0x32, 0x8a, 0x94, 0x3a, 0xe8, 0x2b,
-	0x41, 0x69, 0x09, 0x19, 0x9a, 0xd2, 0x58, 0x24, 0xbe, 0xf7, 0xfa, 0x30, 0x8c, 0x1c, 0x74, 0x0c,
-	0x89, 0x4f, 0x2b, 0x28, 0xb4, 0x41, 0x93, 0x22, 0x24, 0x29, 0x6b, 0x38, 0xe2, 0xb7, 0xb8, 0xb0,
-	0x12, 0xec, 0xf5, 0x94, 0x9f, 0x37, 0xc4, 0xdd, 0x06, 0x04, 0x48, 0x78, 0x78, 0x81, 0xa2, 0x07,
-	0x22, 0xb0, 0x65, 0xcf, 0xd3, 0x71, 0xe3, 0x2c, 0x98, 0x81, 0x91, 0xb8, 0x20, 0x2d, 0xb1, 0x89,
-	0x1a, 0x71, 0x38, 0x41, 0x74, 0xd0, 0x67, 0x69, 0x88, 0x90, 0xa3, 0xce, 0xcf, 0x2d, 0x99, 0xd3,
-	0x0a, 0xd3, 0xd9, 0xc3, 0xde, 0x0e, 0x64, 0x5e, 0xaf, 0x82, 0xfa, 0x0f, 0xc0, 0x85, 0x04, 0x7b,
-	0x2e, 0x65, 0x30, 0x40, 0xfc, 0x5f, 0xc2, 0xdc, 0x6e, 0x1f, 0x7b, 0x87, 0x6e, 0x0f, 0x85, 0x41,
-	0x8f, 0x29, 0xae, 0x5a, 0x09, 0xf6, 0x1e, 0x73, 0x14, 0x91, 0xfa, 0xec, 0x70, 0x84, 0x8f, 0xc5,
-	0xbc, 0x7d, 0x1e, 0xcc, 0x75, 0xf9, 0x11, 0xa3, 0x90, 0x32, 0x2b, 0xc6, 0x0f, 0x7c, 0x4e, 0x62,
-	0x8c, 0x63, 0x0f, 0xd1, 0x56, 0x73, 0xa3, 0xb1, 0xd9, 0x70, 0xd4, 0x88, 0x0b, 0xcc, 0x0f, 0x29,
-	0xcf, 0x11, 0x1a, 0x9b, 0x96, 0x23, 0x7e, 0x73, 0xdf, 0x12, 0x19, 0x69, 0xe8, 0x2b, 0xd7, 0x98,
-	0xe1, 0xc3, 0x07, 0x7e, 0x41, 0x50, 0xaf, 0xc9, 0xfc, 0x2b, 0xc7, 0x9c, 0xd6, 0xda, 0xbf, 0x34,
-	0x84, 0x7d, 0xe9, 0xd9, 0x89, 0xc2, 0xe4, 0x16, 0x38, 0x93, 0x8d, 0xcb, 0xf9, 0x60, 0x60, 0x67,
-	0xa6, 0xd4, 0xd3, 0xa6, 0x56, 0x60, 0x8d, 0x1a, 0x81, 0x9d, 0x44, 0x2a, 0x22, 0xc2, 0x7a, 0x28,
-	0x3c, 0x42, 0xbe, 0x2b, 0x26, 0x67, 0xc4, 0xe4, 0x62, 0x0a, 0xbc, 0xcf, 0x91, 0xce, 0x83, 0x39,
-	0xe2, 0x32, 0x48, 0x02, 0xc4, 0x44, 0x1c, 0xb5, 0x9c, 0x59, 0xb2, 0x2f, 0x86, 0xfc, 0xfe, 0x3d,
-	0x20, 0x70, 0xe0, 0xbb, 0xac, 0x47, 0x10, 0xed, 0xe1, 0xbe, 0x2f, 0xf2, 0x22, 0xcb, 0x59, 0x16,
-	0xe0, 0xfd, 0x14, 0x6a, 0xbf, 0x06, 0xe6, 0x63, 0x57, 0x79, 0x8c, 0x4a, 0x88, 0xe6, 0x62, 0xe5,
-	0xcb, 0x3c, 0xb3, 0x49, 0x08, 0xee, 0xc2, 0x6e, 0xd8, 0x0f, 0xd9, 0xd0, 0xed, 0xe1, 0x18, 0x51,
-	0x26, 0x12, 0x21, 0xcb, 0x59, 0xcd, 0xcc, 0x7c, 0x2c, 0x26, 0x78, 0x2e, 0x26, 0x0f, 0xf5, 0x11,
-	0x43, 0x1e, 0x43, 0xbe, 0x48, 0x82, 0xe6, 0x9c, 0x25, 0x01, 0xbd, 0xaf, 0x80, 0xa5, 0x3e, 0x59,
-	0xa2, 0x3a, 0xad, 0xdd, 0x23, 0x71, 0xf5, 0x49, 0x8c, 0xc7, 0xdc, 0xc7, 0xcc, 0x3a, 0x2d, 0x78,
-	0xdf, 0x54, 0xd1, 0xfb, 0xec, 0x0b, 0x60, 0x7e, 0x94, 0xa2, 0x49, 0xab, 0x1d, 0x01, 0x4a, 0x2f,
-	0x9e, 0xd1, 0xb9, 0x9a, 0x20, 0x0f, 0x5c, 0xd6, 0x13, 0x4f, 0xe2, 0x90, 0x3d, 0x3a, 0xb8, 0x87,
-	0xa3, 0x64, 0xc0, 0xd0, 0x1e, 0x09, 0x3d, 0xb4, 0x47, 0x70, 0x82, 0x29, 0xec, 0x57, 0x90, 0xb9,
-	0x06, 0xa6, 0x13, 0x8e, 0xaa, 0x08, 0x94, 0x83, 0xc2, 0xe9, 0xb7, 0xc0, 0x8d, 0x09, 0x0e, 0xd1,
-	0x34, 0xfd, 0x65, 0x03, 0xac, 0x88, 0xd8, 0x16, 0x84, 0x94, 0x21, 0xb2, 0x2b, 0x92, 0xc0, 0x0b,
-	0x63, 0x6f, 0xf6, 0xec, 0xcb, 0x5c, 0xa6, 0x88, 0x09, 0xa6, 0x32, 0x0f, 0x9d, 0xd2, 0x29, 0xa2,
-	0x00, 0xed, 0x0c, 0x55, 0x22, 0xd1, 0xd0, 0x89, 0xc4, 0x5d, 0xd0, 0x1e, 0xc4, 0x21, 0xa3, 0x2e,
-	0x3e, 0x70, 0x3d, 0x49, 0x8c, 0x9b, 0x20, 0x22, 0x93, 0x79, 0x71, 0x33, 0x36, 0x9d, 0xb3, 0x02,
-	0x63, 0x44, 0x2c, 0x22, 0x22, 0x9b, 0xe7, 0x6e, 0xde, 0x3b, 0x70, 0x09, 0x4a, 0xb0, 0xba, 0x23,
-	0x67, 0x7a, 0x07, 0x0e, 0x4a, 0x30, 0x37, 0xc0, 0x9e, 0xd8, 0x2e, 0x0a, 0x99, 0x8a, 0x00, 0x73,
-	0x3d, 0xbe, 0x3c, 0x0a, 0x99, 0x78, 0x8b, 0x72, 0x4e, 0x5c, 0x48, 0x02, 0xda, 0x9a, 0xdd, 0x68,
-	0x70, 0x0e, 0x04, 0x64, 0x9b, 0x04, 0xd4, 0x3e, 0x03, 0xa6, 0x8f, 0x5c, 0x02, 0x23, 0x61, 0xdb,
-	0x4d, 0xa7, 0x79, 0xe4, 0xc0, 0x88, 0x27, 0xb6, 0xac, 0x47, 0xf0, 0x20, 0xe8, 0x25, 0x03, 0x26,
-	0xe8, 0x13, 0x7e, 0x26, 0x8c, 0xbb, 0xe9, 0xd8, 0xa3, 0xb9, 0x3d, 0x44, 0x1e, 0xf2, 0x19, 0xfb,
-	0x11, 0x58, 0x1b, 0x65, 0x1f, 0x19, 0x8f, 0x01, 0xa2, 0x60, 0x71, 0xa1, 0xb4, 0x60, 0x71, 0x1f,
-	0x79, 0x61, 0x04, 0xfb, 0xce, 0x99, 0xd1, 0x4a, 0xed, 0x54, 0x77, 0x97, 0xf3, 0x35, 0x8b, 0x4e,
-	0x1b, 0xb4, 0x8a, 0xba, 0xd1, 0x8a, 0xfb, 0xb9, 0x25, 0xcc, 0xec, 0x1e, 0x57, 0x3b, 0xda, 0x57,
-	0xf5, 0x9f, 0x7d, 0x48, 0x0f, 0x2b, 0xec, 0xe7, 0x77, 0x80, 0xad, 0x0b, 0x40, 0x04, 0x51, 0x3c,
-	0x20, 0x3c, 0xaa, 0x4c, 0x6d, 0x34, 0x36, 0x17, 0xee, 0xdc, 0x2e, 0x25, 0x37, 0xdd, 0xf8, 0x63,
-	0xb5, 0xcc, 0x49, 0x57, 0x39, 0xab, 0xbd, 0x22, 0xc8, 0x7e, 0x1f, 0xcc, 0x78, 0x38, 0x3e, 0x08,
-	0x03, 0xa1, 0xfb, 0x85, 0x3b, 0x97, 0x2b, 0xb7, 0xbc, 0x27, 0x50, 0x1d, 0xb5, 0xa4, 0x60, 0xc5,
-	0x9f, 0x81, 0xd7, 0x4b, 0x99, 0xd3, 0xb7, 0xdc, 0x3b, 0xa0, 0xc9, 0x20, 0x3d, 0x14, 0x1c, 0x2e,
-	0xdc, 0xb9, 0x54, 0x79, 0x92, 0x58, 0x28, 0xd0, 0x3b, 0xff, 0x6e, 0x65, 0x9c, 0x33, 0x65, 0xea,
-	0x7e, 0x78, 0x70, 0x50, 0x21, 0xb5, 0x6f, 0x82, 0xa5, 0x18, 0x1d, 0x3f, 0xe2, 0xf2, 0x0f, 0x0f,
-	0x42, 0x71, 0x6f, 0x37, 0x8c, 0x67, 0xa6, 0x7b, 0x3e, 0xc4, 0x3e, 0x72, 0xf2, 0xeb, 0xec, 0xf7,
-	0xc1, 0x2c, 0x41, 0x11, 0x3e, 0x42, 0xdc, 0x39, 0x26, 0xdc, 0x22, 0x5d, 0x51, 0x90, 0xcf, 0x45,
-	0x21, 0x9f, 0x71, 0x36, 0xb4, 0x79, 0xf8, 0xe0, 0x52, 0x9a, 0x20, 0x64, 0xc5, 0xf0, 0x11, 0x26,
-	0xdb, 0xa2, 0xaa, 0x13, 0xa1, 0xca, 0x2c, 0xf7, 0x1c, 0x98, 0xe5, 0xf2, 0x4a, 0xf3, 0xa7, 0xa6,
-	0x33, 0xc3, 0x87, 0x63, 0xb7, 0xeb, 0x0d, 0x70, 0xad, 0xf6, 0x14, 0x4d, 0xd2, 0xdf, 0x4a, 0xd9,
-	0xcb, 0x99, 0x09, 0x2d, 0xd6, 0x44, 0x87, 0xfd, 0x4d, 0x30, 0xaf, 0xca, 0x53, 0x88, 0x2a, 0x69,
-	0x5e, 0xab, 0x35, 0x82, 0x6d, 0xb5, 0xc2, 0x19, 0xad, 0x2d, 0x95, 0xeb, 0x38, 0x89, 0x9a, 0x89,
-	0x7f, 0x94, 0x4f, 0x4a, 0x69, 0x99, 0x22, 0xbb, 0x86, 0xfd, 0x27, 0x49, 0x40, 0xa0, 0x8f, 0x6a,
-	0xc2, 0xe6, 0x59, 0x30, 0x93, 0xc9, 0x90, 0x9a, 0x8e, 0x1a, 0xf1, 0x64, 0x34, 0x53, 0x46, 0x4b,
-	0x93, 0xd1, 0x6c, 0x65, 0x6d, 0x13, 0x9c, 0x86, 0x49, 0xb8, 0x13, 0xc6, 0x90, 0x84, 0x88, 0x7e,
-	0x8b, 0xe2, 0x58, 0x3d, 0x27, 0x8a, 0xe0, 0xb1, 0x00, 0x72, 0x49, 0xbc, 0x74, 0xca, 0x88, 0xd5,
-	0x0c, 0xfd, 0xbd, 0x95, 0xc9, 0x90, 0x52, 0x96, 0x3f, 0x39, 0x72, 0x90, 0x87, 0x49, 0xd5, 0x95,
-	0x79, 0x16, 0x28, 0x55, 0x14, 0x14, 0xb3, 0x01, 0x16, 0x32, 0x39, 0x50, 0xca, 0x4e, 0x06, 0xc4,
-	0xdf, 0x49, 0xa3, 0x17, 0x11, 0xff, 0x39, 0x7a, 0xf2, 0xc9, 0x10, 0x2f, 0x07, 0x05, 0xcd, 0x5c,
-	0x16, 0x06, 0x5d, 0x4e, 0xa6, 0x66, 0x66, 0x20, 0xd2, 0xf0, 0x6f, 0xe1, 0x50, 0x2b, 0xaf, 0x82,
-	0x83, 0xbb, 0xa0, 0x41, 0xd0, 0x53, 0x55, 0x5e, 0xde, 0x2c, 0x35, 0x9e, 0xec, 0x4e, 0x8e, 0x2c,
-	0xb4, 0x38, 0x7c, 0x51, 0x81, 0xb6, 0xdf, 0x14, 0x36, 0x91, 0x47, 0x56, 0x71, 0xea, 0x03, 0xfd,
-	0x30, 0x97, 0x91, 0xea, 0xcd, 0xd2, 0x73, 0x76, 0x3f, 0xe5, 0xce, 0x2e, 0x16, 0x3f, 0x16, 0xd8,
-	0xfa, 0x01, 0x7f, 0x0c, 0xd6, 0x76, 0x69, 0xa0, 0xc3, 0x30, 0x82, 0x84, 0x75, 0x11, 0xac, 0x72,
-	0xdd, 0x6f, 0x64, 0xd9, 0xba, 0x52, 0x1e, 0x61, 0xd2, 0x6d, 0x2a, 0x78, 0xfa, 0x0e, 0xb8, 0x50,
-	0x76, 0xb0, 0x66, 0xec, 0x2e, 0x68, 0xf2, 0x67, 0x7b, 0x25, 0x5b, 0x63, 0xab, 0x1c, 0xb1, 0xa6,
-	0xf3, 0x54, 0x66, 0x66, 0x88, 0xed, 0x40, 0x42, 0x42, 0x44, 0x2a, 0xb8, 0x79, 0x2f, 0xcb, 0x4d,
-	0xf9, 0x29, 0xa3, 0x7d, 0x2a, 0xd8, 0xd9, 0x97, 0x71, 0x3f, 0x83, 0xaa, 0xf8, 0x78, 0x3f, 0xc7,
-	0xc7, 0xd5, 0xda, 0x13, 0x72, 0x8c, 0x7c, 0x4f, 0xec, 0x9a, 0x55, 0xbc, 0x54, 0xdf, 0x2f, 0xc5,
-	0xea, 0x5c, 0x11, 0xab, 0xc6, 0x0f, 0x7f, 0x65, 0xb6, 0xf7, 0x3d, 0x51, 0xaa, 0x93, 0xd1, 0xe3,
-	0xfe, 0x20, 0x8a, 0x86, 0x13, 0x06, 0xed, 0xf4, 0x6e, 0x9e, 0x3a, 0xd1, 0xdd, 0x5c, 0xe0, 0xee,
-	0xb7, 0x40, 0xc7, 0x7c, 0xf8, 0x2f, 0x9a, 0x06, 0xfc, 0xc7, 0x94, 0x78, 0xf3, 0xee, 0x88, 0xe6,
-	0xd5, 0x87, 0xcf, 0xbc, 0x1e, 0x8c, 0x03, 0x11, 0xbf, 0x47, 0x45, 0x01, 0x15, 0xbf, 0x35, 0x80,
-	0x07, 0x36, 0x59, 0xdc, 0xbc, 0xd7, 0x83, 0x61, 0x9c, 0x96, 0x15, 0x32, 0x20, 0x1e, 0xa7, 0x3d,
-	0x1c, 0x33, 0x02, 0xbd, 0xf4, 0xed, 0xa7, 0xc2, 0x5f, 0x11, 0x6c, 0x77, 0xc0, 0x22, 0x3e, 0x8e,
-	0x11, 0x49, 0xd1, 0x64, 0x2c, 0xcc, 0xc1, 0xc4, 0x79, 0x7c, 0xbc, 0x27, 0x4a, 0x46, 0x2a, 0x34,
-	0x66, 0x41, 0x99, 0x97, 0xbe, 0x7a, 0x01, 0xab, 0x97, 0xfe, 0x06, 0x58, 0x10, 0x0f, 0xcc, 0x87,
-	0x83, 0xa8, 0x8b, 0x48, 0xda, 0xef, 0xc9, 0x80, 0x64, 0x35, 0xcd, 0x43, 0x61, 0x22, 0xcb, 0x87,
-	0xaa, 0xf6, 0x9d, 0x83, 0x65, 0x70, 0xa8, 0x83, 0x31, 0x53, 0x2d, 0x9f, 0x1c, 0x4c, 0xdd, 0x37,
-	0x5a, 0x46, 0x9d, 0x1b, 0xe2, 0x2e, 0xc9, 0x8b, 0x55, 0xeb, 0x4a, 0x3e, 0x0b, 0xac, 0xf4, 0x59,
-	0xd0, 0xf9, 0x91, 0x25, 0x2f, 0x5b, 0xdf, 0x7f, 0x42, 0x79, 0xba, 0x9f, 0xea, 0x69, 0xbb, 0xdf,
-	0xc7, 0xc7, 0x9f, 0xf2, 0x87, 0x6a, 0xf5, 0x85, 0x6a, 0xae, 0xc4, 0xbd, 0x03, 0x9a, 0x04, 0xf7,
-	0xe5, 0x43, 0x6d, 0xb9, 0xc6, 0x2a, 0x1c, 0xdc, 0xe7, 0xde, 0x8c, 0xfb, 0x68, 0xec, 0xf6, 0xbc,
-	0x0a, 0xae, 0x54, 0xd2, 0xa7, 0xaf, 0x9d, 0x1f, 0x5b, 0xc2, 0x58, 0x1d, 0x91, 0xaa, 0x71, 0xe4,
-	0x8f, 0x08, 0x8e, 0xbe, 0x72, 0xec, 0xdc, 0x04, 0xd7, 0xeb, 0x89, 0xd4, 0x3c, 0xfd, 0xb5, 0xaa,
-	0x9d, 0x23, 0x76, 0x52, 0x46, 0xf8, 0xac, 0xa4, 0x5c, 0x3d, 0x2e, 0xf8, 0x6c, 0x0a, 0x78, 0x55,
-	0xcc, 0xc8, 0xcc, 0xa6, 0x8c, 0x3a, 0xcd, 0xc1, 0x61, 0x6a, 0x5e, 0x99, 0x22, 0x28, 0xdd, 0xc7,
-	0xaf, 0x84, 0x0d, 0xb3, 0xad, 0x18, 0x0e, 0xd3, 0x54, 0x25, 0x19, 0x53, 0xc9, 0xe2, 0x72, 0x6d,
-	0x7c, 0x39, 0xa4, 0x65, 0xf5, 0x6e, 0x3c, 0x51, 0xd3, 0xf7, 0x47, 0x96, 0x88, 0xfa, 0xe9, 0xa3,
-	0x53, 0xfa, 0xf2, 0xb6, 0x56, 0x5f, 0x2d, 0x61, 0x1e, 0x0f, 0x85, 0x0f, 0x61, 0x94, 0x76, 0x64,
-	0x46, 0x80, 0x3c, 0xd9, 0x8d, 0x3a, 0xb2, 0xdf, 0x50, 0x82, 0x2a, 0xa5, 0x43, 0x93, 0xfb, 0xbf,
-	0x56, 0xee, 0x8d, 0x2c, 0x8a, 0x06, 0xbb, 0x88, 0x41, 0x1f, 0x32, 0x58, 0xef, 0x70, 0x82, 0xb6,
-	0x07, 0x69, 0x8d, 0x38, 0x1d, 0x9e, 0x20, 0x90, 0xdb, 0xa0, 0x19, 0x73, 0x5e, 0x65, 0x00, 0x17,
-	0xbf, 0x45, 0x2f, 0x66, 0x18, 0x75, 0x71, 0x3f, 0xad, 0x58, 0xc8, 0x91, 0xdd, 0x06, 0x73, 0xbe,
-	0x7c, 0xfd, 0x53, 0x11, 0xb0, 0x97, 0x1c, 0x3d, 0xe6, 0x94, 0xe2, 0x23, 0x44, 0x8e, 0x49, 0xc8,
-	0x90, 0xea, 0x6d, 0x8c, 0x00, 0x63, 0xa2, 0xe9, 0x80, 0x0d, 0x13, 0xcf, 0x5a, 0x30, 0x7f, 0x26,
-	0xbb, 0x1c, 0xdb, 0x49, 0x42, 0xf0, 0x11, 0x92, 0xe2, 0x13, 0x98, 0x1f, 0x61, 0xb2, 0x4f, 0xa0,
-	0xcf, 0x73, 0xe3, 0x2f, 0x5d, 0x3e, 0x63, 0x94, 0xcb, 0x36, 0x45, 0x15, 0x51, 0x9a, 0x81, 0xbf,
-	0xc9, 0x6b, 0xf6, 0xd3, 0xf0, 0xe9, 0x20, 0xf4, 0x43, 0x36, 0xdc, 0xc3, 0xb8, 0xae, 0x42, 0x75,
-	0x0e, 0xcc, 0x7a, 0xaa, 0x36, 0xac, 0x6a, 0xdd, 0x9e, 0xa8, 0x0d, 0xf3, 0x87, 0x46, 0x1f, 0x76,
-	0x51, 0xda, 0xa4, 0x91, 0x03, 0xd9, 0x74, 0xa4, 0x0c, 0xc6, 0x2c, 0x84, 0x0c, 0xb9, 0x11, 0x0d,
-	0x94, 0x3e, 0x97, 0x33, 0xe0, 0x5d, 0x1a, 0xd4, 0xe8, 0x21, 0x47, 0xa1, 0x66, 0xe3, 0x79, 0xea,
-	0x4f, 0x22, 0x8f, 0x93, 0x2c, 0x7f, 0x3b, 0x64, 0x3d, 0x9f, 0xc0, 0xe3, 0xca, 0x62, 0xdf, 0x25,
-	0xb0, 0x38, 0xa0, 0x88, 0x14, 0x0a, 0xcc, 0x0b, 0x1c, 0x96, 0x5a, 0xdf, 0x28, 0x01, 0x68, 0xe4,
-	0x12, 0x80, 0x2d, 0x70, 0xc6, 0x47, 0x94, 0x85, 0xb1, 0xac, 0x4c, 0xc1, 0x5c, 0x96, 0x61, 0x67,
-	0xa6, 0x52, 0x35, 0xe5, 0x53, 0xaf, 0x1f, 0xa6, 0xd7, 0x59, 0x29, 0xc9, 0xfa, 0x3e, 0x7f, 0x1d,
-	0x80, 0xb4, 0x37, 0xae, 0xef, 0xf5, 0x79, 0x05, 0x79, 0xe0, 0xd7, 0xd7, 0x54, 0xdf, 0x00, 0xcb,
-	0xdd, 0x3e, 0x75, 0x33, 0x7b, 0xa8, 0xb6, 0x6a, 0xb7, 0x4f, 0x9d, 0x74, 0x9b, 0xce, 0xdf, 0x59,
-	0xe2, 0x05, 0x94, 0x23, 0x66, 0x37, 0xac, 0x2c, 0x5e, 0x8c, 0xc4, 0x32, 0x35, 0x89, 0x58, 0x1a,
-	0x26, 0xb1, 0xd8, 0xe7, 0xc1, 0x9c, 0x30, 0x79, 0x4e, 0x5b, 0x33, 0xe7, 0x02, 0x05, 0x89, 0xfd,
-	0x81, 0x25, 0x5e, 0x4b, 0x63, 0x44, 0xfe, 0x92, 0x65, 0xf5, 0x03, 0xe9, 0xf3, 0xa9, 0x41, 0x7e,
-	0x9b, 0xc0, 0x24, 0x41, 0xbe, 0xfa, 0x2c, 0x42, 0x3a, 0xe6, 0x4b, 0x7f, 0x8f, 0x55, 0xf0, 0xa9,
-	0x66, 0xea, 0x53, 0x06, 0x17, 0xaf, 0xa2, 0x41, 0xfb, 0xc6, 0xbf, 0x49, 0xdf, 0xd8, 0x0d, 0x03,
-	0x02, 0x19, 0xda, 0xee, 0xf7, 0xb3, 0xd8, 0x2f, 0xff, 0xe9, 0xd8, 0x3a, 0x58, 0x88, 0xd1, 0xb1,
-	0x9b, 0x27, 0x77, 0x3e, 0x46, 0xc7, 0xf7, 0x64, 0x14, 0xd8, 0x04, 0x2b, 0x91, 0x3c, 0x92, 0xfb,
-	0xba, 0xfb, 0x5d, 0xaa, 0xcb, 0x2e, 0xcb, 0x0a, 0xce, 0xdf, 0x55, 0x14, 0xc7, 0x22, 0x5e, 0x84,
-	0x51, 0xc8, 0x84, 0xf6, 0x97, 0x1c, 0x39, 0x18, 0xe3, 0x78, 0x47, 0xb8, 0x8b, 0x81, 0x0b, 0x6d,
-	0x02, 0x3c, 0x64, 0x31, 0x86, 0xa2, 0x84, 0x21, 0x69, 0x01, 0x4b, 0xce, 0x08, 0x70, 0xfd, 0x16,
-	0x58, 0xcc, 0x66, 0x3d, 0xf6, 0x12, 0x98, 0x77, 0x1e, 0x7d, 0xfa, 0xa1, 0xfb, 0xe1, 0x6f, 0x7c,
-	0x78, 0x6f, 0xe5, 0x94, 0xbd, 0x0c, 0x80, 0x18, 0x3e, 0xde, 0xdf, 0x76, 0xf6, 0x57, 0xac, 0x3b,
-	0xff, 0x73, 0x05, 0x34, 0x76, 0x69, 0x60, 0x77, 0xc1, 0x62, 0xee, 0x6b, 0xbb, 0x37, 0xca, 0x9f,
-	0x78, 0xf9, 0xcf, 0xd9, 0xda, 0x37, 0x27, 0xc1, 0xd2, 0x84, 0xf7, 0xc0, 0x72, 0xe1, 0x83, 0xb7,
-	0x37, 0x4d, 0xeb, 0xf3, 0x78, 0xed, 0xdb, 0x93, 0xe1, 0xe9, 0x93, 0x0e, 0xc1, 0xe9, 0xe2, 0xd7,
-	0x4b, 0x57, 0x4d, 0x5b, 0x14, 0x10, 0xdb, 0x5b, 0x13, 0x22, 0xea, 0xc3, 0x7e, 0x17, 0xac, 0x95,
-	0x7e, 0x18, 0x62, 0x14, 0x4e, 0x19, 0x76, 0xfb, 0xed, 0x93, 0x60, 0xeb, 0xb3, 0x7f, 0x1b, 0x80,
-	0x4c, 0xaf, 0xb1, 0x63, 0xda, 0x63, 0x84, 0xd3, 0xbe, 0x5e, 0x8f, 0xa3, 0x77, 0xff, 0x53, 0x0b,
-	0x5c, 0xa8, 0xfc, 0xba, 0xa1, 0x9e, 0xe8, 0x92, 0x55, 0xed, 0x5f, 0x7d, 0x99, 0x55, 0x9a, 0xa8,
-	0x63, 0x70, 0xa6, 0xec, 0x8b, 0x83, 0x1b, 0xa6, 0x4d, 0x4b, 0x90, 0xdb, 0x6f, 0x9d, 0x00, 0x39,
-	0x7b, 0x70, 0xd9, 0x07, 0x04, 0xc6, 0x83, 0x4b, 0x90, 0xcd, 0x07, 0x57, 0x7c, 0x13, 0xc0, 0x7d,
-	0x33, 0xf7, 0x41, 0x80, 0xd1, 0x37, 0xb3, 0x58, 0x66, 0xdf, 0x2c, 0x6d, 0xf6, 0x73, 0xdf, 0xcc,
-	0x37, 0xee, 0xdf, 0xac, 0xd6, 0x52, 0x8a, 0x57, 0xe1, 0x9b, 0xa5, 0xbd, 0x72, 0x2e, 0xc6, 0xb2,
-	0x3e, 0xf9, 0x8d, 0xda, 0x6d, 0x32, 0x46, 0xfc, 0xd6, 0x09, 0x90, 0xb3, 0xbe, 0x92, 0xe9, 0xe1,
-	0x76, 0xaa, 0xb7, 0xe0, 0x38, 0x66, 0x5f, 0x19, 0xef, 0xc9, 0xda, 0x3f, 0xb2, 0xc0, 0x46, 0x6d,
-	0x47, 0xf6, 0xbd, 0xea, 0x0d, 0xcd, 0x2b, 0xdb, 0xbf, 0xfe, 0xb2, 0x2b, 0x35, 0x81, 0x08, 0x2c,
-	0xe5, 0x9b, 0xb3, 0x57, 0xcc, 0xb6, 0x98, 0x41, 0x6b, 0xdf, 0x9a, 0x08, 0x4d, 0x1f, 0xc3, 0x80,
-	0x5d, 0xd2, 0x4a, 0x34, 0x4a, 0x72, 0x1c, 0xb7, 0x7d, 0x67, 0x72, 0xdc, 0xec, 0xa9, 0x25, 0xad,
-	0xb8, 0x1a, 0xfd, 0x65, 0x71, 0xcd, 0xa7, 0x9a, 0x7b, 0x63, 0x3c, 0xf2, 0x97, 0xf6, 0x6f, 0x6e,
-	0x56, 0x73, 0x90, 0xc7, 0x36, 0x47, 0xfe, 0xaa, 0x76, 0x8b, 0xfd, 0x17, 0x16, 0x58, 0xaf, 0xe9,
-	0xca, 0xbd, 0x5b, 0x19, 0x01, 0x8c, 0xeb, 0xda, 0x1f, 0xbc, 0xdc, 0xba, 0xac, 0x32, 0x4a, 0x7a,
-	0x73, 0x46, 0x65, 0x8c, 0xe3, 0x9a, 0x95, 0x61, 0x6e, 0xa8, 0xd9, 0xdf, 0xb7, 0xc0, 0x59, 0x43,
-	0xf3, 0xa9, 0x26, 0x44, 0x15, 0xf1, 0xdb, 0xef, 0x9e, 0x0c, 0x3f, 0x1b, 0xa8, 0x73, 0x2d, 0x23,
-	0x63, 0xa0, 0xce, 0x62, 0x99, 0x03, 0x75, 0x69, 0x1f, 0xe8, 0x29, 0x58, 0x1d, 0x6f, 0xe2, 0x5c,
-	0x33, 0x6d, 0x31, 0x86, 0xda, 0xfe, 0xfa, 0xc4, 0xa8, 0xb9, 0xc0, 0x39, 0x6a, 0xb1, 0x98, 0x03,
-	0xa7, 0xc6, 0xa9, 0x08, 0x9c, 0xe3, 0x7d, 0x13, 0x06, 0xec, 0x92, 0xbe, 0xc7, 0xf5, 0x49, 0x84,
-	0x22, 0x71, 0xcd, 0xd6, 0x52, 0xd1, 0xd2, 0xf8, 0x7d, 0x0b, 0x9c, 0x33, 0x35, 0x24, 0xb6, 0xaa,
-	0x1d, 0x72, 0x6c, 0x41, 0xfb, 0x1b, 0x27, 0x5c, 0x90, 0xbd, 0x75, 0x0b, 0xad, 0x03, 0xe3, 0xad,
-	0x9b, 0xc7, 0x33, 0xdf, 0xba, 0x86, 0x9a, 0x39, 0xe7, 0xd7, 0x54, 0x8a, 0xdb, 0xaa, 0x8b, 0xf0,
-	0x85, 0x05, 0x66, 0x7e, 0x6b, 0x8a, 0x6c, 0xf6, 0xef, 0x81, 0xaf, 0x95, 0x97, 0x61, 0x6a, 0x2f,
-	0x99, 0x1c, 0x7a, 0xfb, 0x9d, 0x13, 0xa1, 0x97, 0x1d, 0x9f, 0xaf, 0xef, 0xd5, 0x1e, 0x9f, 0x43,
-	0xaf, 0x3f, 0xbe, 0xb4, 0x92, 0x26, 0xd2, 0xe9, 0xca, 0x32, 0x9a, 0xf1, 0x26, 0xa8, 0x5a, 0x65,
-	0x4e, 0xa7, 0x27, 0xa9, 0x8e, 0x29, 0xc3, 0x28, 0xaf, 0x29, 0x55, 0x18, 0x46, 0xe9, 0x82, 0x2a,
-	0xc3, 0xa8, 0x2e, 0x01, 0x3d, 0x05, 0xab, 0xe3, 0x85, 0x99, 0x6b, 0x13, 0xed, 0xc6, 0x51, 0xcd,
-	0x51, 0xcd, 0x5c, 0x49, 0xe1, 0xda, 0xa8, 0x2c, 0x70, 0xbc, 0x5d, 0xa7, 0xe5, 0xb2, 0x55, 0x66,
-	0x6d, 0x4c, 0x52, 0xc8, 0x10, 0xda, 0x30, 0x55, 0x31, 0x8c, 0xda, 0x30, 0x2c, 0x30, 0x6b, 0xa3,
-	0xae, 0xc2, 0xf0, 0xc7, 0x16, 0x68, 0x57, 0x74, 0xd3, 0xcc, 0xb7, 0xb3, 0x71, 0x4d, 0xfb, 0xee,
-	0xc9, 0xd7, 0x68, 0x72, 0xfe, 0xca, 0x02, 0x17, 0xeb, 0x5a, 0x62, 0x15, 0x96, 0x57, 0xb9, 0xb0,
-	0xfd, 0x6b, 0x2f, 0xb9, 0x30, 0xf7, 0xfc, 0x2f, 0xeb, 0x6d, 0xdd, 0xac, 0xb8, 0x03, 0xc7, 0xc9,
-	0x78, 0xfb, 0x24, 0xd8, 0x45, 0x45, 0x99, 0xfa, 0x52, 0x55, 0x8a, 0x32, 0xac, 0xa9, 0x54, 0x54,
-	0x4d, 0x4b, 0x2a, 0xa3, 0x28, 0x73, 0x43, 0xaa, 0x46, 0x51, 0xc6, 0x85, 0x75, 0x8a, 0xaa, 0x6d,
-	0x48, 0xb5, 0xa7, 0xbf, 0xff, 0xe2, 0xf9, 0x75, 0x6b, 0xe7, 0xd1, 0x4f, 0x3e, 0x5f, 0xb7, 0x7e,
-	0xfa, 0xf9, 0xba, 0xf5, 0xdf, 0x9f, 0xaf, 0x5b, 0x7f, 0xf2, 0xc5, 0xfa, 0xa9, 0x9f, 0x7e, 0xb1,
-	0x7e, 0xea, 0x3f, 0xbf, 0x58, 0x3f, 0xf5, 0x9d, 0x77, 0x82, 0x90, 0xf5, 0x06, 0xdd, 0xdb, 0x1e,
-	0x8e, 0xb6, 0x12, 0x82, 0xfd, 0x81, 0xc7, 0xa8, 0x17, 0x16, 0xfe, 0x4e, 0x35, 0xfb, 0x07, 0x9f,
-	0x6c, 0x98, 0x20, 0xda, 0x9d, 0x11, 0x7f, 0xb3, 0xfa, 0xd6, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0xb0, 0x83, 0x2c, 0x1c, 0x1e, 0x3c, 0x00, 0x00,
+func (m *MsgRegisterLiquidityPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	StartInference(ctx context.Context, in *MsgStartInference, opts ...grpc.CallOption) (*MsgStartInferenceResponse, error)
-	FinishInference(ctx context.Context, in *MsgFinishInference, opts ...grpc.CallOption) (*MsgFinishInferenceResponse, error)
-	SubmitNewParticipant(ctx context.Context, in *MsgSubmitNewParticipant, opts ...grpc.CallOption) (*MsgSubmitNewParticipantResponse, error)
-	Validation(ctx context.Context, in *MsgValidation, opts ...grpc.CallOption) (*MsgValidationResponse, error)
-	SubmitNewUnfundedParticipant(ctx context.Context, in *MsgSubmitNewUnfundedParticipant, opts ...grpc.CallOption) (*MsgSubmitNewUnfundedParticipantResponse, error)
-	InvalidateInference(ctx context.Context, in *MsgInvalidateInference, opts ...grpc.CallOption) (*MsgInvalidateInferenceResponse, error)
-	RevalidateInference(ctx context.Context, in *MsgRevalidateInference, opts ...grpc.CallOption) (*MsgRevalidateInferenceResponse, error)
-	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
-	SubmitPocBatch(ctx context.Context, in *MsgSubmitPocBatch, opts ...grpc.CallOption) (*MsgSubmitPocBatchResponse, error)
-	SubmitPocValidation(ctx context.Context, in *MsgSubmitPocValidation, opts ...grpc.CallOption) (*MsgSubmitPocValidationResponse, error)
-	SubmitSeed(ctx context.Context, in *MsgSubmitSeed, opts ...grpc.CallOption) (*MsgSubmitSeedResponse, error)
-	SubmitUnitOfComputePriceProposal(ctx context.Context, in *MsgSubmitUnitOfComputePriceProposal, opts ...grpc.CallOption) (*MsgSubmitUnitOfComputePriceProposalResponse, error)
-	RegisterModel(ctx context.Context, in *MsgRegisterModel, opts ...grpc.CallOption) (*MsgRegisterModelResponse, error)
-	CreateTrainingTask(ctx context.Context, in *MsgCreateTrainingTask, opts ...grpc.CallOption) (*MsgCreateTrainingTaskResponse, error)
-	SubmitHardwareDiff(ctx context.Context, in *MsgSubmitHardwareDiff, opts ...grpc.CallOption) (*MsgSubmitHardwareDiffResponse, error)
-	CreatePartialUpgrade(ctx context.Context, in *MsgCreatePartialUpgrade, opts ...grpc.CallOption) (*MsgCreatePartialUpgradeResponse, error)
-	ClaimTrainingTaskForAssignment(ctx context.Context, in *MsgClaimTrainingTaskForAssignment, opts ...grpc.CallOption) (*MsgClaimTrainingTaskForAssignmentResponse, error)
-	AssignTrainingTask(ctx context.Context, in *MsgAssignTrainingTask, opts ...grpc.CallOption) (*MsgAssignTrainingTaskResponse, error)
-	SubmitTrainingKvRecord(ctx context.Context, in *MsgSubmitTrainingKvRecord, opts ...grpc.CallOption) (*MsgSubmitTrainingKvRecordResponse, error)
-	JoinTraining(ctx context.Context, in *MsgJoinTraining, opts ...grpc.CallOption) (*MsgJoinTrainingResponse, error)
-	TrainingHeartbeat(ctx context.Context, in *MsgTrainingHeartbeat, opts ...grpc.CallOption) (*MsgTrainingHeartbeatResponse, error)
-	SetBarrier(ctx context.Context, in *MsgSetBarrier, opts ...grpc.CallOption) (*MsgSetBarrierResponse, error)
-	JoinTrainingStatus(ctx context.Context, in *MsgJoinTrainingStatus, opts ...grpc.CallOption) (*MsgJoinTrainingStatusResponse, error)
-	CreateDummyTrainingTask(ctx context.Context, in *MsgCreateDummyTrainingTask, opts ...grpc.CallOption) (*MsgCreateDummyTrainingTaskResponse, error)
-	BridgeExchange(ctx context.Context, in *MsgBridgeExchange, opts ...grpc.CallOption) (*MsgBridgeExchangeResponse, error)
-	RegisterBridgeAddresses(ctx context.Context, in *MsgRegisterBridgeAddresses, opts ...grpc.CallOption) (*MsgRegisterBridgeAddressesResponse, error)
-	RegisterLiquidityPool(ctx context.Context, in *MsgRegisterLiquidityPool, opts ...grpc.CallOption) (*MsgRegisterLiquidityPoolResponse, error)
-	RegisterTokenMetadata(ctx context.Context, in *MsgRegisterTokenMetadata, opts ...grpc.CallOption) (*MsgRegisterTokenMetadataResponse, error)
-	ApproveBridgeTokenForTrading(ctx context.Context, in *MsgApproveBridgeTokenForTrading, opts ...grpc.CallOption) (*MsgApproveBridgeTokenForTradingResponse, error)
-	RequestBridgeWithdrawal(ctx context.Context, in *MsgRequestBridgeWithdrawal, opts ...grpc.CallOption) (*MsgRequestBridgeWithdrawalResponse, error)
-	RequestBridgeMint(ctx context.Context, in *MsgRequestBridgeMint, opts ...grpc.CallOption) (*MsgRequestBridgeMintResponse, error)
-	RegisterWrappedTokenContract(ctx context.Context, in *MsgRegisterWrappedTokenContract, opts ...grpc.CallOption) (*MsgRegisterWrappedTokenContractResponse, error)
-	MigrateAllWrappedTokens(ctx context.Context, in *MsgMigrateAllWrappedTokens, opts ...grpc.CallOption) (*MsgMigrateAllWrappedTokensResponse, error)
-	AddUserToTrainingAllowList(ctx context.Context, in *MsgAddUserToTrainingAllowList, opts ...grpc.CallOption) (*MsgAddUserToTrainingAllowListResponse, error)
-	RemoveUserFromTrainingAllowList(ctx context.Context, in *MsgRemoveUserFromTrainingAllowList, opts ...grpc.CallOption) (*MsgRemoveUserFromTrainingAllowListResponse, error)
-	SetTrainingAllowList(ctx context.Context, in *MsgSetTrainingAllowList, opts ...grpc.CallOption) (*MsgSetTrainingAllowListResponse, error)
-	AddParticipantsToAllowList(ctx context.Context, in *MsgAddParticipantsToAllowList, opts ...grpc.CallOption) (*MsgAddParticipantsToAllowListResponse, error)
-	RemoveParticipantsFromAllowList(ctx context.Context, in *MsgRemoveParticipantsFromAllowList, opts ...grpc.CallOption) (*MsgRemoveParticipantsFromAllowListResponse, error)
+func (m *MsgRegisterLiquidityPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterLiquidityPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterLiquidityPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterLiquidityPool.Merge(m, src)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRegisterLiquidityPool) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRegisterLiquidityPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterLiquidityPool.DiscardUnknown(m)
 }
 
-func (c *msgClient) StartInference(ctx context.Context, in *MsgStartInference, opts ...grpc.CallOption) (*MsgStartInferenceResponse, error) {
-	out := new(MsgStartInferenceResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/StartInference", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterLiquidityPool proto.InternalMessageInfo
+
+func (m *MsgRegisterLiquidityPool) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) FinishInference(ctx context.Context, in *MsgFinishInference, opts ...grpc.CallOption) (*MsgFinishInferenceResponse, error) {
-	out := new(MsgFinishInferenceResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/FinishInference", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterLiquidityPool) GetCodeId() string {
+	if m != nil {
+		return m.CodeId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitNewParticipant(ctx context.Context, in *MsgSubmitNewParticipant, opts ...grpc.CallOption) (*MsgSubmitNewParticipantResponse, error) {
-	out := new(MsgSubmitNewParticipantResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitNewParticipant", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterLiquidityPool) GetLabel() string {
+	if m != nil {
+		return m.Label
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Validation(ctx context.Context, in *MsgValidation, opts ...grpc.CallOption) (*MsgValidationResponse, error) {
-	out := new(MsgValidationResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/Validation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterLiquidityPool) GetInstantiateMsg() string {
+	if m != nil {
+		return m.InstantiateMsg
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitNewUnfundedParticipant(ctx context.Context, in *MsgSubmitNewUnfundedParticipant, opts ...grpc.CallOption) (*MsgSubmitNewUnfundedParticipantResponse, error) {
-	out := new(MsgSubmitNewUnfundedParticipantResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitNewUnfundedParticipant", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgRegisterLiquidityPoolResponse struct {
 }
 
-func (c *msgClient) InvalidateInference(ctx context.Context, in *MsgInvalidateInference, opts ...grpc.CallOption) (*MsgInvalidateInferenceResponse, error) {
-	out := new(MsgInvalidateInferenceResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/InvalidateInference", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRegisterLiquidityPoolResponse) Reset()         { *m = MsgRegisterLiquidityPoolResponse{} }
+func (m *MsgRegisterLiquidityPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterLiquidityPoolResponse) ProtoMessage()    {}
+func (*MsgRegisterLiquidityPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{79}
 }
-
-func (c *msgClient) RevalidateInference(ctx context.Context, in *MsgRevalidateInference, opts ...grpc.CallOption) (*MsgRevalidateInferenceResponse, error) {
-	out := new(MsgRevalidateInferenceResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RevalidateInference", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRegisterLiquidityPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
-	out := new(MsgClaimRewardsResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ClaimRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterLiquidityPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterLiquidityPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) SubmitPocBatch(ctx context.Context, in *MsgSubmitPocBatch, opts ...grpc.CallOption) (*MsgSubmitPocBatchResponse, error) {
-	out := new(MsgSubmitPocBatchResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitPocBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRegisterLiquidityPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterLiquidityPoolResponse.Merge(m, src)
 }
-
-func (c *msgClient) SubmitPocValidation(ctx context.Context, in *MsgSubmitPocValidation, opts ...grpc.CallOption) (*MsgSubmitPocValidationResponse, error) {
-	out := new(MsgSubmitPocValidationResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitPocValidation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRegisterLiquidityPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterLiquidityPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterLiquidityPoolResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitSeed(ctx context.Context, in *MsgSubmitSeed, opts ...grpc.CallOption) (*MsgSubmitSeedResponse, error) {
-	out := new(MsgSubmitSeedResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitSeed", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRegisterLiquidityPoolResponse proto.InternalMessageInfo
+
+// Contract-only bridge withdrawal request - can only be executed by smart contracts
+type MsgRequestBridgeWithdrawal struct {
+	Creator            string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	UserAddress        string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	Amount             string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	DestinationAddress string `protobuf:"bytes,4,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
 }
 
-func (c *msgClient) SubmitUnitOfComputePriceProposal(ctx context.Context, in *MsgSubmitUnitOfComputePriceProposal, opts ...grpc.CallOption) (*MsgSubmitUnitOfComputePriceProposalResponse, error) {
-	out := new(MsgSubmitUnitOfComputePriceProposalResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitUnitOfComputePriceProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestBridgeWithdrawal) Reset()         { *m = MsgRequestBridgeWithdrawal{} }
+func (m *MsgRequestBridgeWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestBridgeWithdrawal) ProtoMessage()    {}
+func (*MsgRequestBridgeWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{80}
+}
+func (m *MsgRequestBridgeWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestBridgeWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestBridgeWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRequestBridgeWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestBridgeWithdrawal.Merge(m, src)
+}
+func (m *MsgRequestBridgeWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestBridgeWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestBridgeWithdrawal.DiscardUnknown(m)
 }
 
-func (c *msgClient) RegisterModel(ctx context.Context, in *MsgRegisterModel, opts ...grpc.CallOption) (*MsgRegisterModelResponse, error) {
-	out := new(MsgRegisterModelResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterModel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRequestBridgeWithdrawal proto.InternalMessageInfo
+
+func (m *MsgRequestBridgeWithdrawal) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateTrainingTask(ctx context.Context, in *MsgCreateTrainingTask, opts ...grpc.CallOption) (*MsgCreateTrainingTaskResponse, error) {
-	out := new(MsgCreateTrainingTaskResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreateTrainingTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestBridgeWithdrawal) GetUserAddress() string {
+	if m != nil {
+		return m.UserAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitHardwareDiff(ctx context.Context, in *MsgSubmitHardwareDiff, opts ...grpc.CallOption) (*MsgSubmitHardwareDiffResponse, error) {
-	out := new(MsgSubmitHardwareDiffResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitHardwareDiff", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestBridgeWithdrawal) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreatePartialUpgrade(ctx context.Context, in *MsgCreatePartialUpgrade, opts ...grpc.CallOption) (*MsgCreatePartialUpgradeResponse, error) {
-	out := new(MsgCreatePartialUpgradeResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreatePartialUpgrade", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestBridgeWithdrawal) GetDestinationAddress() string {
+	if m != nil {
+		return m.DestinationAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ClaimTrainingTaskForAssignment(ctx context.Context, in *MsgClaimTrainingTaskForAssignment, opts ...grpc.CallOption) (*MsgClaimTrainingTaskForAssignmentResponse, error) {
-	out := new(MsgClaimTrainingTaskForAssignmentResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ClaimTrainingTaskForAssignment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgRequestBridgeWithdrawalResponse struct {
+	RequestId    string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	EpochIndex   uint64 `protobuf:"varint,2,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	BlsRequestId string `protobuf:"bytes,3,opt,name=bls_request_id,json=blsRequestId,proto3" json:"bls_request_id,omitempty"`
 }
 
-func (c *msgClient) AssignTrainingTask(ctx context.Context, in *MsgAssignTrainingTask, opts ...grpc.CallOption) (*MsgAssignTrainingTaskResponse, error) {
-	out := new(MsgAssignTrainingTaskResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AssignTrainingTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestBridgeWithdrawalResponse) Reset()         { *m = MsgRequestBridgeWithdrawalResponse{} }
+func (m *MsgRequestBridgeWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestBridgeWithdrawalResponse) ProtoMessage()    {}
+func (*MsgRequestBridgeWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{81}
+}
+func (m *MsgRequestBridgeWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestBridgeWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestBridgeWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRequestBridgeWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestBridgeWithdrawalResponse.Merge(m, src)
+}
+func (m *MsgRequestBridgeWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestBridgeWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestBridgeWithdrawalResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitTrainingKvRecord(ctx context.Context, in *MsgSubmitTrainingKvRecord, opts ...grpc.CallOption) (*MsgSubmitTrainingKvRecordResponse, error) {
-	out := new(MsgSubmitTrainingKvRecordResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitTrainingKvRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRequestBridgeWithdrawalResponse proto.InternalMessageInfo
+
+func (m *MsgRequestBridgeWithdrawalResponse) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) JoinTraining(ctx context.Context, in *MsgJoinTraining, opts ...grpc.CallOption) (*MsgJoinTrainingResponse, error) {
-	out := new(MsgJoinTrainingResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/JoinTraining", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestBridgeWithdrawalResponse) GetEpochIndex() uint64 {
+	if m != nil {
+		return m.EpochIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) TrainingHeartbeat(ctx context.Context, in *MsgTrainingHeartbeat, opts ...grpc.CallOption) (*MsgTrainingHeartbeatResponse, error) {
-	out := new(MsgTrainingHeartbeatResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/TrainingHeartbeat", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestBridgeWithdrawalResponse) GetBlsRequestId() string {
+	if m != nil {
+		return m.BlsRequestId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetBarrier(ctx context.Context, in *MsgSetBarrier, opts ...grpc.CallOption) (*MsgSetBarrierResponse, error) {
-	out := new(MsgSetBarrierResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetBarrier", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Native Gonka bridge mint request - bridges native tokens to WGNK
+type MsgRequestBridgeMint struct {
+	Creator            string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount             string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	DestinationAddress string `protobuf:"bytes,3,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+	ChainId            string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (c *msgClient) JoinTrainingStatus(ctx context.Context, in *MsgJoinTrainingStatus, opts ...grpc.CallOption) (*MsgJoinTrainingStatusResponse, error) {
-	out := new(MsgJoinTrainingStatusResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/JoinTrainingStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestBridgeMint) Reset()         { *m = MsgRequestBridgeMint{} }
+func (m *MsgRequestBridgeMint) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestBridgeMint) ProtoMessage()    {}
+func (*MsgRequestBridgeMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{82}
+}
+func (m *MsgRequestBridgeMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestBridgeMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestBridgeMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRequestBridgeMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestBridgeMint.Merge(m, src)
+}
+func (m *MsgRequestBridgeMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestBridgeMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestBridgeMint.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateDummyTrainingTask(ctx context.Context, in *MsgCreateDummyTrainingTask, opts ...grpc.CallOption) (*MsgCreateDummyTrainingTaskResponse, error) {
-	out := new(MsgCreateDummyTrainingTaskResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreateDummyTrainingTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRequestBridgeMint proto.InternalMessageInfo
+
+func (m *MsgRequestBridgeMint) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) BridgeExchange(ctx context.Context, in *MsgBridgeExchange, opts ...grpc.CallOption) (*MsgBridgeExchangeResponse, error) {
-	out := new(MsgBridgeExchangeResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/BridgeExchange", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestBridgeMint) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RegisterBridgeAddresses(ctx context.Context, in *MsgRegisterBridgeAddresses, opts ...grpc.CallOption) (*MsgRegisterBridgeAddressesResponse, error) {
-	out := new(MsgRegisterBridgeAddressesResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterBridgeAddresses", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestBridgeMint) GetDestinationAddress() string {
+	if m != nil {
+		return m.DestinationAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RegisterLiquidityPool(ctx context.Context, in *MsgRegisterLiquidityPool, opts ...grpc.CallOption) (*MsgRegisterLiquidityPoolResponse, error) {
-	out := new(MsgRegisterLiquidityPoolResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterLiquidityPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestBridgeMint) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RegisterTokenMetadata(ctx context.Context, in *MsgRegisterTokenMetadata, opts ...grpc.CallOption) (*MsgRegisterTokenMetadataResponse, error) {
-	out := new(MsgRegisterTokenMetadataResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterTokenMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgRequestBridgeMintResponse struct {
+	RequestId    string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	EpochIndex   uint64 `protobuf:"varint,2,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	BlsRequestId string `protobuf:"bytes,3,opt,name=bls_request_id,json=blsRequestId,proto3" json:"bls_request_id,omitempty"`
 }
 
-func (c *msgClient) ApproveBridgeTokenForTrading(ctx context.Context, in *MsgApproveBridgeTokenForTrading, opts ...grpc.CallOption) (*MsgApproveBridgeTokenForTradingResponse, error) {
-	out := new(MsgApproveBridgeTokenForTradingResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ApproveBridgeTokenForTrading", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestBridgeMintResponse) Reset()         { *m = MsgRequestBridgeMintResponse{} }
+func (m *MsgRequestBridgeMintResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestBridgeMintResponse) ProtoMessage()    {}
+func (*MsgRequestBridgeMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{83}
+}
+func (m *MsgRequestBridgeMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestBridgeMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestBridgeMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRequestBridgeMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestBridgeMintResponse.Merge(m, src)
+}
+func (m *MsgRequestBridgeMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestBridgeMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestBridgeMintResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RequestBridgeWithdrawal(ctx context.Context, in *MsgRequestBridgeWithdrawal, opts ...grpc.CallOption) (*MsgRequestBridgeWithdrawalResponse, error) {
-	out := new(MsgRequestBridgeWithdrawalResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RequestBridgeWithdrawal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRequestBridgeMintResponse proto.InternalMessageInfo
+
+func (m *MsgRequestBridgeMintResponse) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RequestBridgeMint(ctx context.Context, in *MsgRequestBridgeMint, opts ...grpc.CallOption) (*MsgRequestBridgeMintResponse, error) {
-	out := new(MsgRequestBridgeMintResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RequestBridgeMint", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestBridgeMintResponse) GetEpochIndex() uint64 {
+	if m != nil {
+		return m.EpochIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RegisterWrappedTokenContract(ctx context.Context, in *MsgRegisterWrappedTokenContract, opts ...grpc.CallOption) (*MsgRegisterWrappedTokenContractResponse, error) {
-	out := new(MsgRegisterWrappedTokenContractResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterWrappedTokenContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestBridgeMintResponse) GetBlsRequestId() string {
+	if m != nil {
+		return m.BlsRequestId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) MigrateAllWrappedTokens(ctx context.Context, in *MsgMigrateAllWrappedTokens, opts ...grpc.CallOption) (*MsgMigrateAllWrappedTokensResponse, error) {
-	out := new(MsgMigrateAllWrappedTokensResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/MigrateAllWrappedTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// SetCw20CodeId updates the code id used for new wrapped-token instantiations.
+type MsgRegisterWrappedTokenContract struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	CodeId    uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
 }
 
-func (c *msgClient) AddUserToTrainingAllowList(ctx context.Context, in *MsgAddUserToTrainingAllowList, opts ...grpc.CallOption) (*MsgAddUserToTrainingAllowListResponse, error) {
-	out := new(MsgAddUserToTrainingAllowListResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AddUserToTrainingAllowList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterWrappedTokenContract) Reset()         { *m = MsgRegisterWrappedTokenContract{} }
+func (m *MsgRegisterWrappedTokenContract) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterWrappedTokenContract) ProtoMessage()    {}
+func (*MsgRegisterWrappedTokenContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{84}
+}
+func (m *MsgRegisterWrappedTokenContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterWrappedTokenContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterWrappedTokenContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRegisterWrappedTokenContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterWrappedTokenContract.Merge(m, src)
+}
+func (m *MsgRegisterWrappedTokenContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterWrappedTokenContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterWrappedTokenContract.DiscardUnknown(m)
 }
 
-func (c *msgClient) RemoveUserFromTrainingAllowList(ctx context.Context, in *MsgRemoveUserFromTrainingAllowList, opts ...grpc.CallOption) (*MsgRemoveUserFromTrainingAllowListResponse, error) {
-	out := new(MsgRemoveUserFromTrainingAllowListResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RemoveUserFromTrainingAllowList", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterWrappedTokenContract proto.InternalMessageInfo
+
+func (m *MsgRegisterWrappedTokenContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetTrainingAllowList(ctx context.Context, in *MsgSetTrainingAllowList, opts ...grpc.CallOption) (*MsgSetTrainingAllowListResponse, error) {
-	out := new(MsgSetTrainingAllowListResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetTrainingAllowList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterWrappedTokenContract) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) AddParticipantsToAllowList(ctx context.Context, in *MsgAddParticipantsToAllowList, opts ...grpc.CallOption) (*MsgAddParticipantsToAllowListResponse, error) {
-	out := new(MsgAddParticipantsToAllowListResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AddParticipantsToAllowList", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgRegisterWrappedTokenContractResponse struct {
 }
 
-func (c *msgClient) RemoveParticipantsFromAllowList(ctx context.Context, in *MsgRemoveParticipantsFromAllowList, opts ...grpc.CallOption) (*MsgRemoveParticipantsFromAllowListResponse, error) {
-	out := new(MsgRemoveParticipantsFromAllowListResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RemoveParticipantsFromAllowList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterWrappedTokenContractResponse) Reset() {
+	*m = MsgRegisterWrappedTokenContractResponse{}
+}
+func (m *MsgRegisterWrappedTokenContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterWrappedTokenContractResponse) ProtoMessage()    {}
+func (*MsgRegisterWrappedTokenContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{85}
+}
+func (m *MsgRegisterWrappedTokenContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterWrappedTokenContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterWrappedTokenContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	StartInference(context.Context, *MsgStartInference) (*MsgStartInferenceResponse, error)
-	FinishInference(context.Context, *MsgFinishInference) (*MsgFinishInferenceResponse, error)
-	SubmitNewParticipant(context.Context, *MsgSubmitNewParticipant) (*MsgSubmitNewParticipantResponse, error)
-	Validation(context.Context, *MsgValidation) (*MsgValidationResponse, error)
-	SubmitNewUnfundedParticipant(context.Context, *MsgSubmitNewUnfundedParticipant) (*MsgSubmitNewUnfundedParticipantResponse, error)
-	InvalidateInference(context.Context, *MsgInvalidateInference) (*MsgInvalidateInferenceResponse, error)
-	RevalidateInference(context.Context, *MsgRevalidateInference) (*MsgRevalidateInferenceResponse, error)
-	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
-	SubmitPocBatch(context.Context, *MsgSubmitPocBatch) (*MsgSubmitPocBatchResponse, error)
-	SubmitPocValidation(context.Context, *MsgSubmitPocValidation) (*MsgSubmitPocValidationResponse, error)
-	SubmitSeed(context.Context, *MsgSubmitSeed) (*MsgSubmitSeedResponse, error)
-	SubmitUnitOfComputePriceProposal(context.Context, *MsgSubmitUnitOfComputePriceProposal) (*MsgSubmitUnitOfComputePriceProposalResponse, error)
-	RegisterModel(context.Context, *MsgRegisterModel) (*MsgRegisterModelResponse, error)
-	CreateTrainingTask(context.Context, *MsgCreateTrainingTask) (*MsgCreateTrainingTaskResponse, error)
-	SubmitHardwareDiff(context.Context, *MsgSubmitHardwareDiff) (*MsgSubmitHardwareDiffResponse, error)
-	CreatePartialUpgrade(context.Context, *MsgCreatePartialUpgrade) (*MsgCreatePartialUpgradeResponse, error)
-	ClaimTrainingTaskForAssignment(context.Context, *MsgClaimTrainingTaskForAssignment) (*MsgClaimTrainingTaskForAssignmentResponse, error)
-	AssignTrainingTask(context.Context, *MsgAssignTrainingTask) (*MsgAssignTrainingTaskResponse, error)
-	SubmitTrainingKvRecord(context.Context, *MsgSubmitTrainingKvRecord) (*MsgSubmitTrainingKvRecordResponse, error)
-	JoinTraining(context.Context, *MsgJoinTraining) (*MsgJoinTrainingResponse, error)
-	TrainingHeartbeat(context.Context, *MsgTrainingHeartbeat) (*MsgTrainingHeartbeatResponse, error)
-	SetBarrier(context.Context, *MsgSetBarrier) (*MsgSetBarrierResponse, error)
-	JoinTrainingStatus(context.Context, *MsgJoinTrainingStatus) (*MsgJoinTrainingStatusResponse, error)
-	CreateDummyTrainingTask(context.Context, *MsgCreateDummyTrainingTask) (*MsgCreateDummyTrainingTaskResponse, error)
-	BridgeExchange(context.Context, *MsgBridgeExchange) (*MsgBridgeExchangeResponse, error)
-	RegisterBridgeAddresses(context.Context, *MsgRegisterBridgeAddresses) (*MsgRegisterBridgeAddressesResponse, error)
-	RegisterLiquidityPool(context.Context, *MsgRegisterLiquidityPool) (*MsgRegisterLiquidityPoolResponse, error)
-	RegisterTokenMetadata(context.Context, *MsgRegisterTokenMetadata) (*MsgRegisterTokenMetadataResponse, error)
-	ApproveBridgeTokenForTrading(context.Context, *MsgApproveBridgeTokenForTrading) (*MsgApproveBridgeTokenForTradingResponse, error)
-	RequestBridgeWithdrawal(context.Context, *MsgRequestBridgeWithdrawal) (*MsgRequestBridgeWithdrawalResponse, error)
-	RequestBridgeMint(context.Context, *MsgRequestBridgeMint) (*MsgRequestBridgeMintResponse, error)
-	RegisterWrappedTokenContract(context.Context, *MsgRegisterWrappedTokenContract) (*MsgRegisterWrappedTokenContractResponse, error)
-	MigrateAllWrappedTokens(context.Context, *MsgMigrateAllWrappedTokens) (*MsgMigrateAllWrappedTokensResponse, error)
-	AddUserToTrainingAllowList(context.Context, *MsgAddUserToTrainingAllowList) (*MsgAddUserToTrainingAllowListResponse, error)
-	RemoveUserFromTrainingAllowList(context.Context, *MsgRemoveUserFromTrainingAllowList) (*MsgRemoveUserFromTrainingAllowListResponse, error)
-	SetTrainingAllowList(context.Context, *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error)
-	AddParticipantsToAllowList(context.Context, *MsgAddParticipantsToAllowList) (*MsgAddParticipantsToAllowListResponse, error)
-	RemoveParticipantsFromAllowList(context.Context, *MsgRemoveParticipantsFromAllowList) (*MsgRemoveParticipantsFromAllowListResponse, error)
+func (m *MsgRegisterWrappedTokenContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterWrappedTokenContractResponse.Merge(m, src)
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgRegisterWrappedTokenContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterWrappedTokenContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterWrappedTokenContractResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+var xxx_messageInfo_MsgRegisterWrappedTokenContractResponse proto.InternalMessageInfo
+
+// MigrateAllWrappedTokens migrates all known wrapped-token instances to new_code_id.
+// migrate_msg_json can be "{}" for no-op migrations.
+type MsgMigrateAllWrappedTokens struct {
+	Authority      string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	NewCodeId      uint64 `protobuf:"varint,2,opt,name=new_code_id,json=newCodeId,proto3" json:"new_code_id,omitempty"`
+	MigrateMsgJson string `protobuf:"bytes,3,opt,name=migrate_msg_json,json=migrateMsgJson,proto3" json:"migrate_msg_json,omitempty"`
+	// Optional batch limit per call; 0 means migrate all.
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
-func (*UnimplementedMsgServer) StartInference(ctx context.Context, req *MsgStartInference) (*MsgStartInferenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartInference not implemented")
+
+func (m *MsgMigrateAllWrappedTokens) Reset()         { *m = MsgMigrateAllWrappedTokens{} }
+func (m *MsgMigrateAllWrappedTokens) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateAllWrappedTokens) ProtoMessage()    {}
+func (*MsgMigrateAllWrappedTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{86}
 }
-func (*UnimplementedMsgServer) FinishInference(ctx context.Context, req *MsgFinishInference) (*MsgFinishInferenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinishInference not implemented")
+func (m *MsgMigrateAllWrappedTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SubmitNewParticipant(ctx context.Context, req *MsgSubmitNewParticipant) (*MsgSubmitNewParticipantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitNewParticipant not implemented")
+func (m *MsgMigrateAllWrappedTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateAllWrappedTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Validation(ctx context.Context, req *MsgValidation) (*MsgValidationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validation not implemented")
+func (m *MsgMigrateAllWrappedTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateAllWrappedTokens.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SubmitNewUnfundedParticipant(ctx context.Context, req *MsgSubmitNewUnfundedParticipant) (*MsgSubmitNewUnfundedParticipantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitNewUnfundedParticipant not implemented")
+func (m *MsgMigrateAllWrappedTokens) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) InvalidateInference(ctx context.Context, req *MsgInvalidateInference) (*MsgInvalidateInferenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InvalidateInference not implemented")
+func (m *MsgMigrateAllWrappedTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateAllWrappedTokens.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) RevalidateInference(ctx context.Context, req *MsgRevalidateInference) (*MsgRevalidateInferenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevalidateInference not implemented")
+
+var xxx_messageInfo_MsgMigrateAllWrappedTokens proto.InternalMessageInfo
+
+func (m *MsgMigrateAllWrappedTokens) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+
+func (m *MsgMigrateAllWrappedTokens) GetNewCodeId() uint64 {
+	if m != nil {
+		return m.NewCodeId
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) SubmitPocBatch(ctx context.Context, req *MsgSubmitPocBatch) (*MsgSubmitPocBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitPocBatch not implemented")
+
+func (m *MsgMigrateAllWrappedTokens) GetMigrateMsgJson() string {
+	if m != nil {
+		return m.MigrateMsgJson
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) SubmitPocValidation(ctx context.Context, req *MsgSubmitPocValidation) (*MsgSubmitPocValidationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitPocValidation not implemented")
+
+func (m *MsgMigrateAllWrappedTokens) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) SubmitSeed(ctx context.Context, req *MsgSubmitSeed) (*MsgSubmitSeedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitSeed not implemented")
+
+type MsgMigrateAllWrappedTokensResponse struct {
+	// number of contracts attempted; success/failure details are in logs/events
+	Attempted uint32 `protobuf:"varint,1,opt,name=attempted,proto3" json:"attempted,omitempty"`
 }
-func (*UnimplementedMsgServer) SubmitUnitOfComputePriceProposal(ctx context.Context, req *MsgSubmitUnitOfComputePriceProposal) (*MsgSubmitUnitOfComputePriceProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitUnitOfComputePriceProposal not implemented")
+
+func (m *MsgMigrateAllWrappedTokensResponse) Reset()         { *m = MsgMigrateAllWrappedTokensResponse{} }
+func (m *MsgMigrateAllWrappedTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateAllWrappedTokensResponse) ProtoMessage()    {}
+func (*MsgMigrateAllWrappedTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{87}
 }
-func (*UnimplementedMsgServer) RegisterModel(ctx context.Context, req *MsgRegisterModel) (*MsgRegisterModelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterModel not implemented")
+func (m *MsgMigrateAllWrappedTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateTrainingTask(ctx context.Context, req *MsgCreateTrainingTask) (*MsgCreateTrainingTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTrainingTask not implemented")
+func (m *MsgMigrateAllWrappedTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateAllWrappedTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SubmitHardwareDiff(ctx context.Context, req *MsgSubmitHardwareDiff) (*MsgSubmitHardwareDiffResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitHardwareDiff not implemented")
+func (m *MsgMigrateAllWrappedTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateAllWrappedTokensResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CreatePartialUpgrade(ctx context.Context, req *MsgCreatePartialUpgrade) (*MsgCreatePartialUpgradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePartialUpgrade not implemented")
+func (m *MsgMigrateAllWrappedTokensResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ClaimTrainingTaskForAssignment(ctx context.Context, req *MsgClaimTrainingTaskForAssignment) (*MsgClaimTrainingTaskForAssignmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimTrainingTaskForAssignment not implemented")
+func (m *MsgMigrateAllWrappedTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateAllWrappedTokensResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AssignTrainingTask(ctx context.Context, req *MsgAssignTrainingTask) (*MsgAssignTrainingTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignTrainingTask not implemented")
+
+var xxx_messageInfo_MsgMigrateAllWrappedTokensResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateAllWrappedTokensResponse) GetAttempted() uint32 {
+	if m != nil {
+		return m.Attempted
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) SubmitTrainingKvRecord(ctx context.Context, req *MsgSubmitTrainingKvRecord) (*MsgSubmitTrainingKvRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitTrainingKvRecord not implemented")
+
+// MsgAppealInvalidation disputes an invalidated inference. The executor escrows the appeal bond.
+type MsgAppealInvalidation struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	InferenceId string `protobuf:"bytes,2,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
 }
-func (*UnimplementedMsgServer) JoinTraining(ctx context.Context, req *MsgJoinTraining) (*MsgJoinTrainingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinTraining not implemented")
+
+func (m *MsgAppealInvalidation) Reset()         { *m = MsgAppealInvalidation{} }
+func (m *MsgAppealInvalidation) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealInvalidation) ProtoMessage()    {}
+func (*MsgAppealInvalidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{88}
 }
-func (*UnimplementedMsgServer) TrainingHeartbeat(ctx context.Context, req *MsgTrainingHeartbeat) (*MsgTrainingHeartbeatResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TrainingHeartbeat not implemented")
+func (m *MsgAppealInvalidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SetBarrier(ctx context.Context, req *MsgSetBarrier) (*MsgSetBarrierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetBarrier not implemented")
+func (m *MsgAppealInvalidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealInvalidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) JoinTrainingStatus(ctx context.Context, req *MsgJoinTrainingStatus) (*MsgJoinTrainingStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinTrainingStatus not implemented")
+func (m *MsgAppealInvalidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealInvalidation.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CreateDummyTrainingTask(ctx context.Context, req *MsgCreateDummyTrainingTask) (*MsgCreateDummyTrainingTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDummyTrainingTask not implemented")
+func (m *MsgAppealInvalidation) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) BridgeExchange(ctx context.Context, req *MsgBridgeExchange) (*MsgBridgeExchangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BridgeExchange not implemented")
+func (m *MsgAppealInvalidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealInvalidation.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) RegisterBridgeAddresses(ctx context.Context, req *MsgRegisterBridgeAddresses) (*MsgRegisterBridgeAddressesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterBridgeAddresses not implemented")
+
+var xxx_messageInfo_MsgAppealInvalidation proto.InternalMessageInfo
+
+func (m *MsgAppealInvalidation) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) RegisterLiquidityPool(ctx context.Context, req *MsgRegisterLiquidityPool) (*MsgRegisterLiquidityPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterLiquidityPool not implemented")
+
+func (m *MsgAppealInvalidation) GetInferenceId() string {
+	if m != nil {
+		return m.InferenceId
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) RegisterTokenMetadata(ctx context.Context, req *MsgRegisterTokenMetadata) (*MsgRegisterTokenMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterTokenMetadata not implemented")
+
+type MsgAppealInvalidationResponse struct {
+	DeadlineBlockHeight int64    `protobuf:"varint,1,opt,name=deadline_block_height,json=deadlineBlockHeight,proto3" json:"deadline_block_height,omitempty"`
+	AssignedValidators  []string `protobuf:"bytes,2,rep,name=assigned_validators,json=assignedValidators,proto3" json:"assigned_validators,omitempty"`
 }
-func (*UnimplementedMsgServer) ApproveBridgeTokenForTrading(ctx context.Context, req *MsgApproveBridgeTokenForTrading) (*MsgApproveBridgeTokenForTradingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ApproveBridgeTokenForTrading not implemented")
+
+func (m *MsgAppealInvalidationResponse) Reset()         { *m = MsgAppealInvalidationResponse{} }
+func (m *MsgAppealInvalidationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealInvalidationResponse) ProtoMessage()    {}
+func (*MsgAppealInvalidationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{89}
 }
-func (*UnimplementedMsgServer) RequestBridgeWithdrawal(ctx context.Context, req *MsgRequestBridgeWithdrawal) (*MsgRequestBridgeWithdrawalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestBridgeWithdrawal not implemented")
+func (m *MsgAppealInvalidationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RequestBridgeMint(ctx context.Context, req *MsgRequestBridgeMint) (*MsgRequestBridgeMintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestBridgeMint not implemented")
+func (m *MsgAppealInvalidationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealInvalidationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RegisterWrappedTokenContract(ctx context.Context, req *MsgRegisterWrappedTokenContract) (*MsgRegisterWrappedTokenContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterWrappedTokenContract not implemented")
+func (m *MsgAppealInvalidationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealInvalidationResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) MigrateAllWrappedTokens(ctx context.Context, req *MsgMigrateAllWrappedTokens) (*MsgMigrateAllWrappedTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MigrateAllWrappedTokens not implemented")
+func (m *MsgAppealInvalidationResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) AddUserToTrainingAllowList(ctx context.Context, req *MsgAddUserToTrainingAllowList) (*MsgAddUserToTrainingAllowListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddUserToTrainingAllowList not implemented")
+func (m *MsgAppealInvalidationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealInvalidationResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) RemoveUserFromTrainingAllowList(ctx context.Context, req *MsgRemoveUserFromTrainingAllowList) (*MsgRemoveUserFromTrainingAllowListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserFromTrainingAllowList not implemented")
-}
-func (*UnimplementedMsgServer) SetTrainingAllowList(ctx context.Context, req *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetTrainingAllowList not implemented")
-}
-func (*UnimplementedMsgServer) AddParticipantsToAllowList(ctx context.Context, req *MsgAddParticipantsToAllowList) (*MsgAddParticipantsToAllowListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddParticipantsToAllowList not implemented")
+
+var xxx_messageInfo_MsgAppealInvalidationResponse proto.InternalMessageInfo
+
+func (m *MsgAppealInvalidationResponse) GetDeadlineBlockHeight() int64 {
+	if m != nil {
+		return m.DeadlineBlockHeight
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) RemoveParticipantsFromAllowList(ctx context.Context, req *MsgRemoveParticipantsFromAllowList) (*MsgRemoveParticipantsFromAllowListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveParticipantsFromAllowList not implemented")
+
+func (m *MsgAppealInvalidationResponse) GetAssignedValidators() []string {
+	if m != nil {
+		return m.AssignedValidators
+	}
+	return nil
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+// MsgSubmitAppealVote reports the result of re-executing an appealed inference.
+type MsgSubmitAppealVote struct {
+	Creator     string  `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	InferenceId string  `protobuf:"bytes,2,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	Value       float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgSubmitAppealVote) Reset()         { *m = MsgSubmitAppealVote{} }
+func (m *MsgSubmitAppealVote) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAppealVote) ProtoMessage()    {}
+func (*MsgSubmitAppealVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{90}
+}
+func (m *MsgSubmitAppealVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitAppealVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAppealVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSubmitAppealVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAppealVote.Merge(m, src)
+}
+func (m *MsgSubmitAppealVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitAppealVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAppealVote.DiscardUnknown(m)
 }
 
-func _Msg_StartInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStartInference)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StartInference(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/StartInference",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StartInference(ctx, req.(*MsgStartInference))
+var xxx_messageInfo_MsgSubmitAppealVote proto.InternalMessageInfo
+
+func (m *MsgSubmitAppealVote) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_FinishInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFinishInference)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FinishInference(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/FinishInference",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FinishInference(ctx, req.(*MsgFinishInference))
+func (m *MsgSubmitAppealVote) GetInferenceId() string {
+	if m != nil {
+		return m.InferenceId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitNewParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitNewParticipant)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitNewParticipant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitNewParticipant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitNewParticipant(ctx, req.(*MsgSubmitNewParticipant))
+func (m *MsgSubmitAppealVote) GetValue() float64 {
+	if m != nil {
+		return m.Value
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_Validation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgValidation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Validation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/Validation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Validation(ctx, req.(*MsgValidation))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSubmitAppealVoteResponse struct {
 }
 
-func _Msg_SubmitNewUnfundedParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitNewUnfundedParticipant)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitNewUnfundedParticipant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitNewUnfundedParticipant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitNewUnfundedParticipant(ctx, req.(*MsgSubmitNewUnfundedParticipant))
+func (m *MsgSubmitAppealVoteResponse) Reset()         { *m = MsgSubmitAppealVoteResponse{} }
+func (m *MsgSubmitAppealVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAppealVoteResponse) ProtoMessage()    {}
+func (*MsgSubmitAppealVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{91}
+}
+func (m *MsgSubmitAppealVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitAppealVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAppealVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSubmitAppealVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAppealVoteResponse.Merge(m, src)
+}
+func (m *MsgSubmitAppealVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitAppealVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAppealVoteResponse.DiscardUnknown(m)
 }
 
-func _Msg_InvalidateInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInvalidateInference)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InvalidateInference(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/InvalidateInference",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InvalidateInference(ctx, req.(*MsgInvalidateInference))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSubmitAppealVoteResponse proto.InternalMessageInfo
+
+// MsgSetModelStatus moves a governance model through its lifecycle.
+// Deprecation requires a sunset epoch after the current one; active clears the sunset.
+type MsgSetModelStatus struct {
+	Authority   string      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Id          string      `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Status      ModelStatus `protobuf:"varint,3,opt,name=status,proto3,enum=inference.inference.ModelStatus" json:"status,omitempty"`
+	SunsetEpoch uint64      `protobuf:"varint,4,opt,name=sunset_epoch,json=sunsetEpoch,proto3" json:"sunset_epoch,omitempty"`
+	SuccessorId string      `protobuf:"bytes,5,opt,name=successor_id,json=successorId,proto3" json:"successor_id,omitempty"`
 }
 
-func _Msg_RevalidateInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevalidateInference)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevalidateInference(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RevalidateInference",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevalidateInference(ctx, req.(*MsgRevalidateInference))
+func (m *MsgSetModelStatus) Reset()         { *m = MsgSetModelStatus{} }
+func (m *MsgSetModelStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgSetModelStatus) ProtoMessage()    {}
+func (*MsgSetModelStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{92}
+}
+func (m *MsgSetModelStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetModelStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetModelStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetModelStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetModelStatus.Merge(m, src)
+}
+func (m *MsgSetModelStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetModelStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetModelStatus.DiscardUnknown(m)
 }
 
-func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimRewards)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/ClaimRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+var xxx_messageInfo_MsgSetModelStatus proto.InternalMessageInfo
+
+func (m *MsgSetModelStatus) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitPocBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitPocBatch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitPocBatch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitPocBatch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitPocBatch(ctx, req.(*MsgSubmitPocBatch))
+func (m *MsgSetModelStatus) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitPocValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitPocValidation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitPocValidation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitPocValidation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitPocValidation(ctx, req.(*MsgSubmitPocValidation))
+func (m *MsgSetModelStatus) GetStatus() ModelStatus {
+	if m != nil {
+		return m.Status
 	}
-	return interceptor(ctx, in, info, handler)
+	return ModelStatus_MODEL_STATUS_ACTIVE
 }
 
-func _Msg_SubmitSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitSeed)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSetModelStatus) GetSunsetEpoch() uint64 {
+	if m != nil {
+		return m.SunsetEpoch
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitSeed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitSeed",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitSeed(ctx, req.(*MsgSubmitSeed))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SubmitUnitOfComputePriceProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitUnitOfComputePriceProposal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitUnitOfComputePriceProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitUnitOfComputePriceProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitUnitOfComputePriceProposal(ctx, req.(*MsgSubmitUnitOfComputePriceProposal))
+func (m *MsgSetModelStatus) GetSuccessorId() string {
+	if m != nil {
+		return m.SuccessorId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RegisterModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterModel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterModel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RegisterModel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterModel(ctx, req.(*MsgRegisterModel))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSetModelStatusResponse struct {
 }
 
-func _Msg_CreateTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateTrainingTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateTrainingTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/CreateTrainingTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateTrainingTask(ctx, req.(*MsgCreateTrainingTask))
+func (m *MsgSetModelStatusResponse) Reset()         { *m = MsgSetModelStatusResponse{} }
+func (m *MsgSetModelStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetModelStatusResponse) ProtoMessage()    {}
+func (*MsgSetModelStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{93}
+}
+func (m *MsgSetModelStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetModelStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetModelStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetModelStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetModelStatusResponse.Merge(m, src)
+}
+func (m *MsgSetModelStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetModelStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetModelStatusResponse.DiscardUnknown(m)
 }
 
-func _Msg_SubmitHardwareDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitHardwareDiff)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitHardwareDiff(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitHardwareDiff",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitHardwareDiff(ctx, req.(*MsgSubmitHardwareDiff))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSetModelStatusResponse proto.InternalMessageInfo
+
+// MsgSetModelAlias points an alias at a model. An empty model_id removes the alias.
+type MsgSetModelAlias struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Alias     string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	ModelId   string `protobuf:"bytes,3,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
 }
 
-func _Msg_CreatePartialUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePartialUpgrade)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePartialUpgrade(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/CreatePartialUpgrade",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePartialUpgrade(ctx, req.(*MsgCreatePartialUpgrade))
+func (m *MsgSetModelAlias) Reset()         { *m = MsgSetModelAlias{} }
+func (m *MsgSetModelAlias) String() string { return proto.CompactTextString(m) }
+func (*MsgSetModelAlias) ProtoMessage()    {}
+func (*MsgSetModelAlias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{94}
+}
+func (m *MsgSetModelAlias) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetModelAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetModelAlias.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetModelAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetModelAlias.Merge(m, src)
+}
+func (m *MsgSetModelAlias) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetModelAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetModelAlias.DiscardUnknown(m)
 }
 
-func _Msg_ClaimTrainingTaskForAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimTrainingTaskForAssignment)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimTrainingTaskForAssignment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/ClaimTrainingTaskForAssignment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimTrainingTaskForAssignment(ctx, req.(*MsgClaimTrainingTaskForAssignment))
+var xxx_messageInfo_MsgSetModelAlias proto.InternalMessageInfo
+
+func (m *MsgSetModelAlias) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AssignTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAssignTrainingTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AssignTrainingTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/AssignTrainingTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AssignTrainingTask(ctx, req.(*MsgAssignTrainingTask))
+func (m *MsgSetModelAlias) GetAlias() string {
+	if m != nil {
+		return m.Alias
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitTrainingKvRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitTrainingKvRecord)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitTrainingKvRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitTrainingKvRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitTrainingKvRecord(ctx, req.(*MsgSubmitTrainingKvRecord))
+func (m *MsgSetModelAlias) GetModelId() string {
+	if m != nil {
+		return m.ModelId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_JoinTraining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgJoinTraining)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).JoinTraining(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/JoinTraining",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).JoinTraining(ctx, req.(*MsgJoinTraining))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSetModelAliasResponse struct {
 }
 
-func _Msg_TrainingHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTrainingHeartbeat)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSetModelAliasResponse) Reset()         { *m = MsgSetModelAliasResponse{} }
+func (m *MsgSetModelAliasResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetModelAliasResponse) ProtoMessage()    {}
+func (*MsgSetModelAliasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{95}
+}
+func (m *MsgSetModelAliasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetModelAliasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetModelAliasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).TrainingHeartbeat(ctx, in)
+}
+func (m *MsgSetModelAliasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetModelAliasResponse.Merge(m, src)
+}
+func (m *MsgSetModelAliasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetModelAliasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetModelAliasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetModelAliasResponse proto.InternalMessageInfo
+
+// MsgAttestBridgeBlock is a validator vote for the receipts root of an origin chain block.
+type MsgAttestBridgeBlock struct {
+	Validator    string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	OriginChain  string `protobuf:"bytes,2,opt,name=originChain,proto3" json:"originChain,omitempty"`
+	BlockNumber  string `protobuf:"bytes,3,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	ReceiptsRoot string `protobuf:"bytes,4,opt,name=receiptsRoot,proto3" json:"receiptsRoot,omitempty"`
+}
+
+func (m *MsgAttestBridgeBlock) Reset()         { *m = MsgAttestBridgeBlock{} }
+func (m *MsgAttestBridgeBlock) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestBridgeBlock) ProtoMessage()    {}
+func (*MsgAttestBridgeBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{96}
+}
+func (m *MsgAttestBridgeBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestBridgeBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestBridgeBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/TrainingHeartbeat",
+}
+func (m *MsgAttestBridgeBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestBridgeBlock.Merge(m, src)
+}
+func (m *MsgAttestBridgeBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestBridgeBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestBridgeBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestBridgeBlock proto.InternalMessageInfo
+
+func (m *MsgAttestBridgeBlock) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TrainingHeartbeat(ctx, req.(*MsgTrainingHeartbeat))
+	return ""
+}
+
+func (m *MsgAttestBridgeBlock) GetOriginChain() string {
+	if m != nil {
+		return m.OriginChain
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetBarrier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetBarrier)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgAttestBridgeBlock) GetBlockNumber() string {
+	if m != nil {
+		return m.BlockNumber
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetBarrier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SetBarrier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetBarrier(ctx, req.(*MsgSetBarrier))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Msg_JoinTrainingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgJoinTrainingStatus)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).JoinTrainingStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/JoinTrainingStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).JoinTrainingStatus(ctx, req.(*MsgJoinTrainingStatus))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Msg_CreateDummyTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateDummyTrainingTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateDummyTrainingTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/CreateDummyTrainingTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateDummyTrainingTask(ctx, req.(*MsgCreateDummyTrainingTask))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Msg_BridgeExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBridgeExchange)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BridgeExchange(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/BridgeExchange",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BridgeExchange(ctx, req.(*MsgBridgeExchange))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RegisterBridgeAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterBridgeAddresses)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterBridgeAddresses(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RegisterBridgeAddresses",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterBridgeAddresses(ctx, req.(*MsgRegisterBridgeAddresses))
+func (m *MsgAttestBridgeBlock) GetReceiptsRoot() string {
+	if m != nil {
+		return m.ReceiptsRoot
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RegisterLiquidityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterLiquidityPool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterLiquidityPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RegisterLiquidityPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterLiquidityPool(ctx, req.(*MsgRegisterLiquidityPool))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgAttestBridgeBlockResponse struct {
+	Attested bool `protobuf:"varint,1,opt,name=attested,proto3" json:"attested,omitempty"`
 }
 
-func _Msg_RegisterTokenMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterTokenMetadata)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterTokenMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RegisterTokenMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterTokenMetadata(ctx, req.(*MsgRegisterTokenMetadata))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgAttestBridgeBlockResponse) Reset()         { *m = MsgAttestBridgeBlockResponse{} }
+func (m *MsgAttestBridgeBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestBridgeBlockResponse) ProtoMessage()    {}
+func (*MsgAttestBridgeBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{97}
 }
-
-func _Msg_ApproveBridgeTokenForTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgApproveBridgeTokenForTrading)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ApproveBridgeTokenForTrading(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/ApproveBridgeTokenForTrading",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ApproveBridgeTokenForTrading(ctx, req.(*MsgApproveBridgeTokenForTrading))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgAttestBridgeBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_RequestBridgeWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestBridgeWithdrawal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestBridgeWithdrawal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RequestBridgeWithdrawal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestBridgeWithdrawal(ctx, req.(*MsgRequestBridgeWithdrawal))
+func (m *MsgAttestBridgeBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestBridgeBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_RequestBridgeMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestBridgeMint)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestBridgeMint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RequestBridgeMint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestBridgeMint(ctx, req.(*MsgRequestBridgeMint))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgAttestBridgeBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestBridgeBlockResponse.Merge(m, src)
 }
-
-func _Msg_RegisterWrappedTokenContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterWrappedTokenContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterWrappedTokenContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RegisterWrappedTokenContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterWrappedTokenContract(ctx, req.(*MsgRegisterWrappedTokenContract))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgAttestBridgeBlockResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Msg_MigrateAllWrappedTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMigrateAllWrappedTokens)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MigrateAllWrappedTokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/MigrateAllWrappedTokens",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MigrateAllWrappedTokens(ctx, req.(*MsgMigrateAllWrappedTokens))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgAttestBridgeBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestBridgeBlockResponse.DiscardUnknown(m)
 }
 
-func _Msg_AddUserToTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddUserToTrainingAllowList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddUserToTrainingAllowList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/AddUserToTrainingAllowList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddUserToTrainingAllowList(ctx, req.(*MsgAddUserToTrainingAllowList))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_MsgAttestBridgeBlockResponse proto.InternalMessageInfo
 
-func _Msg_RemoveUserFromTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveUserFromTrainingAllowList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveUserFromTrainingAllowList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RemoveUserFromTrainingAllowList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveUserFromTrainingAllowList(ctx, req.(*MsgRemoveUserFromTrainingAllowList))
+func (m *MsgAttestBridgeBlockResponse) GetAttested() bool {
+	if m != nil {
+		return m.Attested
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_SetTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetTrainingAllowList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetTrainingAllowList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SetTrainingAllowList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetTrainingAllowList(ctx, req.(*MsgSetTrainingAllowList))
+func init() {
+	proto.RegisterEnum("inference.inference.TrainingRole", TrainingRole_name, TrainingRole_value)
+	proto.RegisterType((*MsgUpdateParams)(nil), "inference.inference.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.inference.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgStartInference)(nil), "inference.inference.MsgStartInference")
+	proto.RegisterType((*MsgStartInferenceResponse)(nil), "inference.inference.MsgStartInferenceResponse")
+	proto.RegisterType((*MsgFinishInference)(nil), "inference.inference.MsgFinishInference")
+	proto.RegisterType((*MsgFinishInferenceResponse)(nil), "inference.inference.MsgFinishInferenceResponse")
+	proto.RegisterType((*MsgSubmitNewParticipant)(nil), "inference.inference.MsgSubmitNewParticipant")
+	proto.RegisterType((*MsgSubmitNewParticipantResponse)(nil), "inference.inference.MsgSubmitNewParticipantResponse")
+	proto.RegisterType((*MsgValidation)(nil), "inference.inference.MsgValidation")
+	proto.RegisterType((*MsgValidationResponse)(nil), "inference.inference.MsgValidationResponse")
+	proto.RegisterType((*MsgSubmitNewUnfundedParticipant)(nil), "inference.inference.MsgSubmitNewUnfundedParticipant")
+	proto.RegisterType((*MsgSubmitNewUnfundedParticipantResponse)(nil), "inference.inference.MsgSubmitNewUnfundedParticipantResponse")
+	proto.RegisterType((*MsgInvalidateInference)(nil), "inference.inference.MsgInvalidateInference")
+	proto.RegisterType((*MsgInvalidateInferenceResponse)(nil), "inference.inference.MsgInvalidateInferenceResponse")
+	proto.RegisterType((*MsgRevalidateInference)(nil), "inference.inference.MsgRevalidateInference")
+	proto.RegisterType((*MsgRevalidateInferenceResponse)(nil), "inference.inference.MsgRevalidateInferenceResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "inference.inference.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "inference.inference.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgSubmitPocBatch)(nil), "inference.inference.MsgSubmitPocBatch")
+	proto.RegisterType((*MsgSubmitPocBatchResponse)(nil), "inference.inference.MsgSubmitPocBatchResponse")
+	proto.RegisterType((*MsgSubmitPocValidation)(nil), "inference.inference.MsgSubmitPocValidation")
+	proto.RegisterType((*MsgSubmitPocValidationResponse)(nil), "inference.inference.MsgSubmitPocValidationResponse")
+	proto.RegisterType((*MsgSubmitSeed)(nil), "inference.inference.MsgSubmitSeed")
+	proto.RegisterType((*MsgSubmitSeedResponse)(nil), "inference.inference.MsgSubmitSeedResponse")
+	proto.RegisterType((*MsgSubmitUnitOfComputePriceProposal)(nil), "inference.inference.MsgSubmitUnitOfComputePriceProposal")
+	proto.RegisterType((*MsgSubmitUnitOfComputePriceProposalResponse)(nil), "inference.inference.MsgSubmitUnitOfComputePriceProposalResponse")
+	proto.RegisterType((*MsgRegisterModel)(nil), "inference.inference.MsgRegisterModel")
+	proto.RegisterType((*MsgRegisterModelResponse)(nil), "inference.inference.MsgRegisterModelResponse")
+	proto.RegisterType((*MsgCreateTrainingTask)(nil), "inference.inference.MsgCreateTrainingTask")
+	proto.RegisterType((*MsgCreateTrainingTaskResponse)(nil), "inference.inference.MsgCreateTrainingTaskResponse")
+	proto.RegisterType((*MsgSettleTrainingPayments)(nil), "inference.inference.MsgSettleTrainingPayments")
+	proto.RegisterType((*MsgSettleTrainingPaymentsResponse)(nil), "inference.inference.MsgSettleTrainingPaymentsResponse")
+	proto.RegisterType((*MsgFinishTrainingTask)(nil), "inference.inference.MsgFinishTrainingTask")
+	proto.RegisterType((*MsgFinishTrainingTaskResponse)(nil), "inference.inference.MsgFinishTrainingTaskResponse")
+	proto.RegisterType((*MsgCancelTrainingTask)(nil), "inference.inference.MsgCancelTrainingTask")
+	proto.RegisterType((*MsgCancelTrainingTaskResponse)(nil), "inference.inference.MsgCancelTrainingTaskResponse")
+	proto.RegisterType((*MsgSubmitHardwareDiff)(nil), "inference.inference.MsgSubmitHardwareDiff")
+	proto.RegisterType((*MsgSubmitHardwareDiffResponse)(nil), "inference.inference.MsgSubmitHardwareDiffResponse")
+	proto.RegisterType((*MsgClaimTrainingTaskForAssignment)(nil), "inference.inference.MsgClaimTrainingTaskForAssignment")
+	proto.RegisterType((*MsgClaimTrainingTaskForAssignmentResponse)(nil), "inference.inference.MsgClaimTrainingTaskForAssignmentResponse")
+	proto.RegisterType((*MsgAssignTrainingTask)(nil), "inference.inference.MsgAssignTrainingTask")
+	proto.RegisterType((*MsgAssignTrainingTaskResponse)(nil), "inference.inference.MsgAssignTrainingTaskResponse")
+	proto.RegisterType((*MsgCreatePartialUpgrade)(nil), "inference.inference.MsgCreatePartialUpgrade")
+	proto.RegisterType((*MsgCreatePartialUpgradeResponse)(nil), "inference.inference.MsgCreatePartialUpgradeResponse")
+	proto.RegisterType((*MsgSubmitTrainingKvRecord)(nil), "inference.inference.MsgSubmitTrainingKvRecord")
+	proto.RegisterType((*MsgSubmitTrainingKvRecordResponse)(nil), "inference.inference.MsgSubmitTrainingKvRecordResponse")
+	proto.RegisterType((*MsgJoinTraining)(nil), "inference.inference.MsgJoinTraining")
+	proto.RegisterType((*MsgJoinTrainingResponse)(nil), "inference.inference.MsgJoinTrainingResponse")
+	proto.RegisterType((*MsgTrainingHeartbeat)(nil), "inference.inference.MsgTrainingHeartbeat")
+	proto.RegisterType((*MsgTrainingHeartbeatResponse)(nil), "inference.inference.MsgTrainingHeartbeatResponse")
+	proto.RegisterType((*MsgSetBarrier)(nil), "inference.inference.MsgSetBarrier")
+	proto.RegisterType((*MsgSetBarrierResponse)(nil), "inference.inference.MsgSetBarrierResponse")
+	proto.RegisterType((*MsgJoinTrainingStatus)(nil), "inference.inference.MsgJoinTrainingStatus")
+	proto.RegisterType((*MsgJoinTrainingStatusResponse)(nil), "inference.inference.MsgJoinTrainingStatusResponse")
+	proto.RegisterType((*MsgCreateDummyTrainingTask)(nil), "inference.inference.MsgCreateDummyTrainingTask")
+	proto.RegisterType((*MsgCreateDummyTrainingTaskResponse)(nil), "inference.inference.MsgCreateDummyTrainingTaskResponse")
+	proto.RegisterType((*MsgBridgeExchange)(nil), "inference.inference.MsgBridgeExchange")
+	proto.RegisterType((*MsgBridgeExchangeResponse)(nil), "inference.inference.MsgBridgeExchangeResponse")
+	proto.RegisterType((*MsgAddUserToTrainingAllowList)(nil), "inference.inference.MsgAddUserToTrainingAllowList")
+	proto.RegisterType((*MsgAddUserToTrainingAllowListResponse)(nil), "inference.inference.MsgAddUserToTrainingAllowListResponse")
+	proto.RegisterType((*MsgRemoveUserFromTrainingAllowList)(nil), "inference.inference.MsgRemoveUserFromTrainingAllowList")
+	proto.RegisterType((*MsgRemoveUserFromTrainingAllowListResponse)(nil), "inference.inference.MsgRemoveUserFromTrainingAllowListResponse")
+	proto.RegisterType((*MsgSetTrainingAllowList)(nil), "inference.inference.MsgSetTrainingAllowList")
+	proto.RegisterType((*MsgSetTrainingAllowListResponse)(nil), "inference.inference.MsgSetTrainingAllowListResponse")
+	proto.RegisterType((*MsgAddParticipantsToAllowList)(nil), "inference.inference.MsgAddParticipantsToAllowList")
+	proto.RegisterType((*MsgAddParticipantsToAllowListResponse)(nil), "inference.inference.MsgAddParticipantsToAllowListResponse")
+	proto.RegisterType((*MsgRemoveParticipantsFromAllowList)(nil), "inference.inference.MsgRemoveParticipantsFromAllowList")
+	proto.RegisterType((*MsgRemoveParticipantsFromAllowListResponse)(nil), "inference.inference.MsgRemoveParticipantsFromAllowListResponse")
+	proto.RegisterType((*MsgRegisterBridgeAddresses)(nil), "inference.inference.MsgRegisterBridgeAddresses")
+	proto.RegisterType((*MsgRegisterBridgeAddressesResponse)(nil), "inference.inference.MsgRegisterBridgeAddressesResponse")
+	proto.RegisterType((*MsgSetBridgeRateLimit)(nil), "inference.inference.MsgSetBridgeRateLimit")
+	proto.RegisterType((*MsgSetBridgeRateLimitResponse)(nil), "inference.inference.MsgSetBridgeRateLimitResponse")
+	proto.RegisterType((*MsgSetBridgePaused)(nil), "inference.inference.MsgSetBridgePaused")
+	proto.RegisterType((*MsgSetBridgePausedResponse)(nil), "inference.inference.MsgSetBridgePausedResponse")
+	proto.RegisterType((*MsgRegisterTokenMetadata)(nil), "inference.inference.MsgRegisterTokenMetadata")
+	proto.RegisterType((*MsgRegisterTokenMetadataResponse)(nil), "inference.inference.MsgRegisterTokenMetadataResponse")
+	proto.RegisterType((*MsgApproveBridgeTokenForTrading)(nil), "inference.inference.MsgApproveBridgeTokenForTrading")
+	proto.RegisterType((*MsgApproveBridgeTokenForTradingResponse)(nil), "inference.inference.MsgApproveBridgeTokenForTradingResponse")
+	proto.RegisterType((*MsgRegisterLiquidityPool)(nil), "inference.inference.MsgRegisterLiquidityPool")
+	proto.RegisterType((*MsgRegisterLiquidityPoolResponse)(nil), "inference.inference.MsgRegisterLiquidityPoolResponse")
+	proto.RegisterType((*MsgRequestBridgeWithdrawal)(nil), "inference.inference.MsgRequestBridgeWithdrawal")
+	proto.RegisterType((*MsgRequestBridgeWithdrawalResponse)(nil), "inference.inference.MsgRequestBridgeWithdrawalResponse")
+	proto.RegisterType((*MsgRequestBridgeMint)(nil), "inference.inference.MsgRequestBridgeMint")
+	proto.RegisterType((*MsgRequestBridgeMintResponse)(nil), "inference.inference.MsgRequestBridgeMintResponse")
+	proto.RegisterType((*MsgRegisterWrappedTokenContract)(nil), "inference.inference.MsgRegisterWrappedTokenContract")
+	proto.RegisterType((*MsgRegisterWrappedTokenContractResponse)(nil), "inference.inference.MsgRegisterWrappedTokenContractResponse")
+	proto.RegisterType((*MsgMigrateAllWrappedTokens)(nil), "inference.inference.MsgMigrateAllWrappedTokens")
+	proto.RegisterType((*MsgMigrateAllWrappedTokensResponse)(nil), "inference.inference.MsgMigrateAllWrappedTokensResponse")
+	proto.RegisterType((*MsgAppealInvalidation)(nil), "inference.inference.MsgAppealInvalidation")
+	proto.RegisterType((*MsgAppealInvalidationResponse)(nil), "inference.inference.MsgAppealInvalidationResponse")
+	proto.RegisterType((*MsgSubmitAppealVote)(nil), "inference.inference.MsgSubmitAppealVote")
+	proto.RegisterType((*MsgSubmitAppealVoteResponse)(nil), "inference.inference.MsgSubmitAppealVoteResponse")
+	proto.RegisterType((*MsgSetModelStatus)(nil), "inference.inference.MsgSetModelStatus")
+	proto.RegisterType((*MsgSetModelStatusResponse)(nil), "inference.inference.MsgSetModelStatusResponse")
+	proto.RegisterType((*MsgSetModelAlias)(nil), "inference.inference.MsgSetModelAlias")
+	proto.RegisterType((*MsgSetModelAliasResponse)(nil), "inference.inference.MsgSetModelAliasResponse")
+	proto.RegisterType((*MsgAttestBridgeBlock)(nil), "inference.inference.MsgAttestBridgeBlock")
+	proto.RegisterType((*MsgAttestBridgeBlockResponse)(nil), "inference.inference.MsgAttestBridgeBlockResponse")
+}
+
+func init() { proto.RegisterFile("inference/inference/tx.proto", fileDescriptor_09b36d0241b9acd5) }
+
+var fileDescriptor_09b36d0241b9acd5 = []byte{
+	// 4626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3c, 0x4d, 0x6f, 0x1c, 0xc9,
+	0x75, 0xdb, 0x9c, 0xe1, 0x57, 0xf1, 0x43, 0x64, 0x93, 0x2b, 0x8d, 0x66, 0xb5, 0x12, 0x35, 0x5a,
+	0x49, 0x14, 0xf5, 0x41, 0xaf, 0xf6, 0x13, 0xda, 0x60, 0x13, 0x92, 0xd2, 0x5a, 0xf2, 0x8a, 0x2b,
+	0xa2, 0x45, 0x69, 0x1d, 0xdb, 0xc1, 0xa0, 0xa6, 0xbb, 0x38, 0xd3, 0xe6, 0x4c, 0x57, 0xab, 0xba,
+	0x86, 0x14, 0x6d, 0x04, 0x10, 0x9c, 0x8f, 0x83, 0x61, 0x20, 0x41, 0x72, 0x48, 0x80, 0x04, 0x46,
+	0x3e, 0x10, 0xc0, 0x40, 0x02, 0x44, 0x87, 0xfc, 0x83, 0x24, 0x80, 0x2f, 0x01, 0x1c, 0x9f, 0x72,
+	0x0a, 0x82, 0xdd, 0xc3, 0x1e, 0x72, 0xcb, 0x29, 0xb9, 0x05, 0xf5, 0xaa, 0xba, 0xfa, 0xbb, 0x7b,
+	0x28, 0x69, 0x0d, 0x5f, 0xa4, 0xae, 0x57, 0xaf, 0xaa, 0xde, 0xab, 0x7a, 0xef, 0xd5, 0xab, 0xf7,
+	0x1e, 0x07, 0x9d, 0x71, 0xbd, 0x3d, 0xc2, 0x88, 0x67, 0x93, 0xf5, 0xe8, 0x8b, 0x3f, 0xbd, 0xe1,
+	0x33, 0xca, 0xa9, 0xb9, 0xa4, 0x61, 0x37, 0xf4, 0x57, 0x73, 0x11, 0x0f, 0x5c, 0x8f, 0xae, 0xc3,
+	0xbf, 0x12, 0xaf, 0x79, 0xca, 0xa6, 0xc1, 0x80, 0x06, 0xeb, 0x83, 0xa0, 0xbb, 0x7e, 0xf0, 0xb6,
+	0xf8, 0x4f, 0x75, 0x9c, 0x96, 0x1d, 0x6d, 0x68, 0xad, 0xcb, 0x86, 0xea, 0x5a, 0xee, 0xd2, 0x2e,
+	0x95, 0x70, 0xf1, 0x15, 0x0e, 0xe8, 0x52, 0xda, 0xed, 0x93, 0x75, 0x68, 0x75, 0x86, 0x7b, 0xeb,
+	0xd8, 0x3b, 0x52, 0x5d, 0x2b, 0x79, 0xa4, 0xfa, 0x98, 0xe1, 0x41, 0x38, 0xe5, 0xe5, 0x5c, 0x66,
+	0x18, 0x76, 0x3d, 0xd7, 0xeb, 0xb6, 0x39, 0x0e, 0xf6, 0xcb, 0x10, 0x7b, 0x98, 0x39, 0x87, 0x98,
+	0x91, 0xb6, 0x47, 0x1d, 0xa2, 0x10, 0x2f, 0xe5, 0x21, 0x7a, 0x84, 0x1f, 0x52, 0xb6, 0x1f, 0xc7,
+	0xcb, 0xa5, 0xad, 0xc3, 0x5c, 0xa7, 0x1b, 0x62, 0xb4, 0x72, 0xa9, 0xa7, 0x76, 0x07, 0x73, 0xbb,
+	0xa7, 0x70, 0xce, 0xe5, 0xe1, 0x0c, 0xa8, 0x43, 0xfa, 0x0a, 0xe1, 0x42, 0x1e, 0x42, 0x74, 0x46,
+	0x80, 0xd4, 0xfa, 0x67, 0x03, 0x9d, 0xd8, 0x0e, 0xba, 0x8f, 0x7c, 0x07, 0x73, 0xb2, 0x03, 0xfb,
+	0x63, 0xbe, 0x8f, 0xa6, 0xf1, 0x90, 0xf7, 0x28, 0x73, 0xf9, 0x51, 0xc3, 0x58, 0x31, 0x56, 0xa7,
+	0x37, 0x1b, 0xbf, 0xfc, 0xa7, 0xeb, 0xcb, 0xea, 0x44, 0x36, 0x1c, 0x87, 0x91, 0x20, 0x78, 0xc8,
+	0x99, 0xeb, 0x75, 0xad, 0x08, 0xd5, 0xfc, 0x18, 0x4d, 0xc8, 0x1d, 0x6e, 0x8c, 0xad, 0x18, 0xab,
+	0x33, 0x37, 0xdf, 0xb8, 0x91, 0x23, 0x11, 0x37, 0xe4, 0x22, 0x9b, 0xd3, 0x3f, 0xff, 0xcf, 0x73,
+	0xaf, 0xfd, 0xec, 0xab, 0xe7, 0x6b, 0x86, 0xa5, 0x46, 0xdd, 0xfa, 0xf0, 0x47, 0x5f, 0x3d, 0x5f,
+	0x8b, 0xe6, 0xfb, 0xf1, 0x57, 0xcf, 0xd7, 0x2e, 0x46, 0x94, 0x3f, 0x8d, 0x71, 0x91, 0xa2, 0xb8,
+	0x75, 0x1a, 0x9d, 0x4a, 0x81, 0x2c, 0x12, 0xf8, 0xd4, 0x0b, 0x48, 0xeb, 0x1f, 0xeb, 0x68, 0x71,
+	0x3b, 0xe8, 0x3e, 0xe4, 0x98, 0xf1, 0x7b, 0xe1, 0x04, 0x66, 0x03, 0x4d, 0xda, 0x8c, 0x60, 0x4e,
+	0x99, 0x64, 0xd0, 0x0a, 0x9b, 0xe6, 0x79, 0x34, 0xab, 0xd7, 0x69, 0xbb, 0x0e, 0xb0, 0x32, 0x6d,
+	0xcd, 0x68, 0xd8, 0x3d, 0xc7, 0x3c, 0x87, 0x66, 0x7c, 0x46, 0x07, 0x3e, 0x6f, 0xf7, 0x70, 0xd0,
+	0x6b, 0xd4, 0x00, 0x03, 0x49, 0xd0, 0x5d, 0x1c, 0xf4, 0xcc, 0x2b, 0x68, 0x5e, 0x21, 0xf8, 0xf8,
+	0xa8, 0x4f, 0xb1, 0xd3, 0xa8, 0xc3, 0x2e, 0x8e, 0x35, 0x0c, 0x6b, 0x4e, 0xf6, 0xec, 0xc8, 0x0e,
+	0x73, 0x19, 0x8d, 0xc3, 0x99, 0x35, 0x26, 0x60, 0x16, 0xd9, 0x10, 0x44, 0x30, 0xf2, 0x64, 0x48,
+	0x02, 0x4e, 0x9c, 0x76, 0xe7, 0xa8, 0x31, 0x29, 0x89, 0xd0, 0xb0, 0xcd, 0x23, 0x41, 0x04, 0x0e,
+	0x02, 0xb7, 0xeb, 0x11, 0xa7, 0xcd, 0x69, 0x63, 0x4a, 0x12, 0x11, 0x82, 0x76, 0xa9, 0x98, 0x43,
+	0xc8, 0x5c, 0xfb, 0x80, 0xb0, 0xc0, 0xa5, 0x5e, 0x63, 0x5a, 0xce, 0x21, 0x60, 0x8f, 0x25, 0xc8,
+	0x7c, 0x13, 0xa1, 0x01, 0x7e, 0xda, 0xe6, 0x74, 0x9f, 0x78, 0x41, 0x03, 0xad, 0x18, 0xab, 0x75,
+	0x6b, 0x7a, 0x80, 0x9f, 0xee, 0x02, 0xc0, 0xbc, 0x86, 0x4c, 0xc5, 0x06, 0x60, 0xb4, 0x6d, 0x3a,
+	0xf4, 0x78, 0x63, 0x06, 0xd0, 0x16, 0x64, 0x0f, 0x60, 0x6e, 0x09, 0xb8, 0x79, 0x15, 0x2d, 0x2a,
+	0xfa, 0xda, 0xdc, 0x1d, 0x90, 0x80, 0xe3, 0x81, 0xdf, 0x98, 0x5d, 0x31, 0x56, 0x6b, 0xd6, 0x82,
+	0xea, 0xd8, 0x0d, 0xe1, 0xe6, 0x75, 0x64, 0x72, 0x86, 0xbd, 0x60, 0x8f, 0xb0, 0xb6, 0xa0, 0x18,
+	0xf3, 0x21, 0x23, 0x8d, 0x79, 0x20, 0x71, 0x31, 0xec, 0x79, 0x18, 0x76, 0x98, 0x57, 0xd1, 0x09,
+	0xca, 0xdc, 0xae, 0xeb, 0xe1, 0x7e, 0x5b, 0x2e, 0xdc, 0x38, 0xa1, 0x77, 0x74, 0x3e, 0xec, 0xda,
+	0x81, 0x1e, 0xf3, 0x1b, 0x68, 0x39, 0x85, 0x2c, 0xcf, 0x69, 0x01, 0x66, 0x37, 0x93, 0xd8, 0xe2,
+	0xbc, 0x6e, 0xcd, 0x0a, 0xc1, 0x0b, 0x25, 0xa0, 0xe5, 0xa2, 0xd3, 0x19, 0x81, 0x09, 0xc5, 0xc9,
+	0xbc, 0x8c, 0x4e, 0xc4, 0xc4, 0xc3, 0x73, 0xc8, 0x53, 0x25, 0x40, 0xf3, 0x91, 0x84, 0x08, 0xa8,
+	0x79, 0x01, 0xcd, 0x11, 0xc6, 0x28, 0x6b, 0x0f, 0x48, 0x10, 0xe0, 0x2e, 0x51, 0x82, 0x34, 0x0b,
+	0xc0, 0x6d, 0x09, 0x6b, 0x3d, 0x9b, 0x40, 0xe6, 0x76, 0xd0, 0xfd, 0xc4, 0xf5, 0xdc, 0xa0, 0xf7,
+	0x8a, 0xa4, 0xf3, 0x02, 0x9a, 0x63, 0x8a, 0xda, 0xb8, 0x7c, 0xce, 0x86, 0x40, 0x90, 0xd0, 0xeb,
+	0x68, 0x41, 0x23, 0x65, 0x65, 0xf4, 0x44, 0xd8, 0x17, 0x4a, 0x69, 0xbe, 0x24, 0x8c, 0x17, 0x48,
+	0xc2, 0xbb, 0xe8, 0xa4, 0x4d, 0x07, 0x7e, 0x9f, 0x70, 0x97, 0x7a, 0x89, 0x11, 0x13, 0x30, 0x62,
+	0x39, 0xea, 0x8d, 0x8d, 0x3a, 0x87, 0x66, 0xc8, 0x53, 0x62, 0x0f, 0x13, 0x22, 0x8f, 0x42, 0xd0,
+	0xe6, 0x91, 0x79, 0x11, 0xcd, 0x87, 0x92, 0xc1, 0x24, 0x8e, 0x14, 0xfa, 0xb9, 0x18, 0x74, 0xf3,
+	0x28, 0x5f, 0x0e, 0xa7, 0x8f, 0x25, 0x87, 0xa8, 0x48, 0x0e, 0xaf, 0x23, 0x53, 0x12, 0x44, 0xe3,
+	0xe8, 0x33, 0x12, 0x3d, 0xec, 0x89, 0xd0, 0xd3, 0x6a, 0x3c, 0x9b, 0x55, 0xe3, 0x1c, 0xc9, 0x9e,
+	0x2b, 0x94, 0x6c, 0x6d, 0x2c, 0xe6, 0xe3, 0xc6, 0x22, 0x65, 0x8e, 0x4e, 0x64, 0xcc, 0xd1, 0xb1,
+	0x15, 0xc2, 0xfc, 0x2e, 0x5a, 0x8c, 0x9d, 0x60, 0xc0, 0x31, 0x1f, 0x06, 0x8d, 0xc5, 0x15, 0x63,
+	0x75, 0xfe, 0xe6, 0x8d, 0x5c, 0xa3, 0xae, 0x65, 0x77, 0x4b, 0x0f, 0x7b, 0x08, 0xa3, 0xac, 0x05,
+	0x3b, 0x05, 0x49, 0x69, 0xdb, 0xf7, 0x51, 0x33, 0xab, 0x01, 0x5f, 0x93, 0xba, 0xfd, 0x99, 0x01,
+	0xf7, 0xc4, 0xc3, 0x61, 0x67, 0xe0, 0xf2, 0xcf, 0xc8, 0xe1, 0x0e, 0x66, 0xdc, 0xb5, 0x5d, 0x1f,
+	0x7b, 0xbc, 0x44, 0xe7, 0x16, 0x50, 0x6d, 0xc8, 0xfa, 0x6a, 0x42, 0xf1, 0x29, 0x16, 0x3b, 0xc0,
+	0x7d, 0xd7, 0x11, 0xdd, 0xed, 0x7d, 0x72, 0x14, 0xaa, 0x98, 0x06, 0x7e, 0x4a, 0x8e, 0x84, 0x71,
+	0x15, 0x17, 0x3f, 0x91, 0x18, 0xa0, 0x5c, 0xd6, 0xb4, 0x84, 0x7c, 0x4a, 0x8e, 0x52, 0xbb, 0xb0,
+	0x87, 0xce, 0x15, 0x10, 0xa6, 0xb7, 0xe2, 0x2a, 0x5a, 0xf4, 0x23, 0x70, 0x62, 0x33, 0x16, 0x62,
+	0x1d, 0x72, 0x3b, 0x4e, 0xa2, 0x09, 0x75, 0x6a, 0x92, 0x6c, 0xd5, 0x6a, 0xfd, 0xaf, 0x81, 0xe6,
+	0xb6, 0x83, 0xee, 0x63, 0x49, 0xa8, 0xb8, 0x03, 0x8a, 0xf9, 0x9e, 0x47, 0x63, 0xda, 0xc2, 0x8c,
+	0xb9, 0x4e, 0xc6, 0xf6, 0xd4, 0xb2, 0xb6, 0xe7, 0x98, 0x66, 0x25, 0x63, 0xaa, 0xc6, 0x73, 0x4c,
+	0xd5, 0x32, 0x1a, 0x3f, 0xc0, 0xfd, 0x21, 0x01, 0xe3, 0x61, 0x58, 0xb2, 0x61, 0xb6, 0x84, 0x6a,
+	0x1d, 0x68, 0x36, 0xc0, 0x5c, 0x4c, 0x59, 0x09, 0x58, 0x6a, 0x8b, 0x4f, 0xa1, 0xd7, 0x13, 0x9c,
+	0x6b, 0x0f, 0xe1, 0x97, 0x46, 0x72, 0xf3, 0x1f, 0x79, 0x7b, 0x43, 0xcf, 0x21, 0xce, 0x68, 0xd2,
+	0xd1, 0x40, 0x93, 0x58, 0x3a, 0x44, 0x6a, 0xab, 0xc2, 0x66, 0x28, 0x37, 0xb5, 0x48, 0x6e, 0x4e,
+	0xa1, 0x49, 0x7f, 0xd8, 0x89, 0xc9, 0xc3, 0x84, 0x3f, 0xec, 0x08, 0x59, 0xc9, 0x08, 0xd4, 0x78,
+	0xa5, 0x40, 0x4d, 0x94, 0x0b, 0xd4, 0x15, 0x74, 0xb9, 0x82, 0x27, 0xcd, 0xff, 0x1f, 0x18, 0xe8,
+	0xe4, 0x76, 0xd0, 0xbd, 0xe7, 0xa9, 0xd5, 0xc8, 0x2b, 0xba, 0x88, 0x56, 0xd0, 0x8c, 0xeb, 0x69,
+	0x0e, 0x22, 0x71, 0xd1, 0xa0, 0x14, 0xc9, 0x2b, 0xe8, 0x6c, 0x3e, 0x19, 0x69, 0x4a, 0x2d, 0xf2,
+	0x6b, 0x41, 0x69, 0x0e, 0x19, 0x9a, 0x52, 0x0f, 0xbc, 0xea, 0xad, 0x3e, 0x76, 0x07, 0x16, 0x39,
+	0xc4, 0xcc, 0x09, 0x4a, 0x28, 0x34, 0x51, 0x3d, 0x20, 0x44, 0x52, 0x56, 0xb3, 0xe0, 0x1b, 0x6e,
+	0x43, 0x9f, 0xda, 0x3d, 0xa5, 0xe7, 0x35, 0xb8, 0x38, 0x11, 0x80, 0x40, 0xc3, 0x53, 0x14, 0xdd,
+	0x03, 0xc3, 0x16, 0x5f, 0x4f, 0xdb, 0x8d, 0x93, 0x68, 0x02, 0x0f, 0xe0, 0xf6, 0x35, 0x60, 0x12,
+	0xd5, 0x12, 0x70, 0x46, 0x82, 0x61, 0x9f, 0x87, 0x26, 0x42, 0xb6, 0x5a, 0xcf, 0x6a, 0xd2, 0x61,
+	0x06, 0xd1, 0xd9, 0xa1, 0xf6, 0xa6, 0x78, 0x73, 0x94, 0x50, 0xff, 0x31, 0x3a, 0xe3, 0x53, 0x5b,
+	0x5c, 0x12, 0x5d, 0x22, 0xfe, 0x65, 0xbc, 0xdd, 0xe9, 0x53, 0x7b, 0xbf, 0xdd, 0x23, 0x6e, 0xb7,
+	0xc7, 0x15, 0x57, 0x0d, 0x9f, 0xda, 0x0f, 0x05, 0x0a, 0xf8, 0x55, 0x9b, 0x02, 0xe1, 0x2e, 0xf4,
+	0x9b, 0xa7, 0xd1, 0x14, 0x3c, 0x6b, 0x22, 0x93, 0x32, 0x09, 0xed, 0x7b, 0x8e, 0x20, 0xd1, 0xa3,
+	0x9e, 0x4d, 0x82, 0x46, 0x7d, 0xa5, 0xb6, 0x5a, 0xb3, 0x54, 0x4b, 0x6c, 0x98, 0xe3, 0x06, 0xc2,
+	0x01, 0xa9, 0xad, 0x1a, 0x16, 0x7c, 0x0b, 0xdd, 0x02, 0x77, 0xd7, 0x75, 0x94, 0x6a, 0x4c, 0x88,
+	0xa6, 0xf4, 0xd6, 0x07, 0x84, 0xed, 0xf7, 0x49, 0x9b, 0x51, 0xca, 0xc1, 0x50, 0xcc, 0x5a, 0x48,
+	0x82, 0x2c, 0x4a, 0xc1, 0xf1, 0x80, 0x79, 0x95, 0x8f, 0x22, 0x9c, 0x8a, 0x39, 0x0b, 0x01, 0x48,
+	0x7b, 0x26, 0x7b, 0x2e, 0x0b, 0x78, 0x1b, 0x60, 0xca, 0x97, 0x40, 0x00, 0xfa, 0x4c, 0x40, 0x84,
+	0x66, 0xf6, 0xb1, 0xee, 0x47, 0xd0, 0x3f, 0x2d, 0x20, 0xb2, 0x1b, 0x3c, 0x71, 0xb1, 0x40, 0xc0,
+	0x99, 0xeb, 0x48, 0x7f, 0xa1, 0x66, 0xc9, 0x45, 0x1f, 0x02, 0x28, 0x75, 0x9a, 0x6f, 0x48, 0x0f,
+	0x34, 0x71, 0x02, 0x5a, 0xb4, 0x9e, 0xd5, 0x41, 0x09, 0x74, 0xef, 0x48, 0xb6, 0x7c, 0x1d, 0x2d,
+	0xc5, 0x2f, 0x8f, 0xa4, 0xc5, 0x32, 0x63, 0x5d, 0xea, 0x71, 0x57, 0x79, 0xaa, 0xb5, 0x8a, 0x53,
+	0x3d, 0xce, 0xd1, 0xc1, 0x35, 0x60, 0x13, 0xf7, 0x80, 0x38, 0x6d, 0xe8, 0x9c, 0x80, 0xce, 0xd9,
+	0x10, 0x78, 0x5b, 0x20, 0x9d, 0x46, 0x53, 0xac, 0xcd, 0x31, 0xeb, 0x12, 0x79, 0x86, 0x86, 0x35,
+	0xc9, 0x76, 0xa1, 0x29, 0x9c, 0x84, 0x3d, 0x86, 0x87, 0x4e, 0x9b, 0xf7, 0x18, 0x09, 0x7a, 0xb4,
+	0xef, 0xc0, 0x21, 0x1a, 0xd6, 0x3c, 0x80, 0x77, 0x43, 0xa8, 0xf9, 0x06, 0x9a, 0xf6, 0xda, 0x4a,
+	0xad, 0xd5, 0x31, 0x4e, 0x79, 0xca, 0xe0, 0x08, 0xdf, 0xce, 0x67, 0xb4, 0x83, 0x3b, 0x6e, 0xdf,
+	0xe5, 0x47, 0xed, 0x1e, 0xf5, 0x48, 0xc0, 0xe1, 0x30, 0x0d, 0x6b, 0x31, 0xd6, 0x73, 0x17, 0x3a,
+	0x84, 0x37, 0x2a, 0x17, 0x75, 0x08, 0x27, 0x36, 0x27, 0x0e, 0x1c, 0xeb, 0x94, 0x35, 0x07, 0xd0,
+	0xdb, 0x0a, 0x68, 0xde, 0x41, 0x53, 0x8e, 0x1b, 0xf8, 0x43, 0x4e, 0x82, 0xc6, 0xec, 0x4a, 0x6d,
+	0x75, 0xe6, 0xe6, 0x85, 0xfc, 0x57, 0x31, 0xdd, 0xba, 0x4f, 0xf0, 0xde, 0x6d, 0x89, 0xbb, 0x59,
+	0x17, 0xaf, 0x63, 0x4b, 0x0f, 0xcd, 0xb5, 0x3f, 0x39, 0x12, 0xa0, 0x85, 0xe4, 0x00, 0xae, 0x79,
+	0x89, 0xf1, 0x50, 0xd8, 0x93, 0x62, 0xd1, 0x48, 0x59, 0x9a, 0xb1, 0xb4, 0xa5, 0x31, 0xcf, 0xa0,
+	0xe9, 0xc8, 0xd7, 0x95, 0x1a, 0x1a, 0x01, 0x72, 0x2f, 0xd9, 0x68, 0x5d, 0x4d, 0x90, 0x8d, 0x2e,
+	0xe8, 0x8e, 0x47, 0x9e, 0xcb, 0x1f, 0xec, 0x09, 0x4f, 0x71, 0xc8, 0xc9, 0x0e, 0x73, 0x6d, 0xb2,
+	0xc3, 0xa8, 0x4f, 0x03, 0xdc, 0x2f, 0x21, 0x73, 0x19, 0x8d, 0xfb, 0x02, 0x55, 0x11, 0x28, 0x1b,
+	0xa9, 0xd5, 0xaf, 0xa3, 0xab, 0x23, 0x2c, 0xa2, 0x69, 0xfa, 0xb7, 0x3a, 0x5a, 0x00, 0x3b, 0xde,
+	0x75, 0x03, 0x4e, 0xd8, 0x36, 0x78, 0xd3, 0x67, 0x32, 0xc1, 0x8f, 0x78, 0x88, 0x43, 0xfa, 0xda,
+	0x3e, 0x0d, 0xa4, 0x43, 0x3f, 0xa6, 0x7d, 0x6d, 0x00, 0x6d, 0x1e, 0x29, 0xa7, 0xa9, 0xa6, 0x9d,
+	0xa6, 0x5b, 0xa8, 0x39, 0xf4, 0x5c, 0x1e, 0xb4, 0xe9, 0x5e, 0xdb, 0x96, 0xc4, 0xb4, 0x7d, 0xc2,
+	0xe4, 0xab, 0x08, 0xbc, 0x80, 0xba, 0x75, 0x12, 0x30, 0x22, 0x62, 0x09, 0x83, 0x67, 0x91, 0x30,
+	0x69, 0xbd, 0xbd, 0x36, 0x23, 0x3e, 0x55, 0xfe, 0xc0, 0x44, 0x6f, 0xcf, 0x22, 0x3e, 0x15, 0x72,
+	0xdc, 0x83, 0xe9, 0x06, 0x2e, 0x57, 0xd6, 0x6e, 0xaa, 0x27, 0x86, 0x0f, 0x5c, 0x0e, 0x8f, 0x7a,
+	0xc1, 0x49, 0x1b, 0xb3, 0x6e, 0xd0, 0x98, 0x5c, 0xa9, 0x09, 0x0e, 0x00, 0xb2, 0xc1, 0xba, 0x81,
+	0xb9, 0x84, 0xc6, 0x0f, 0xda, 0x0c, 0x0f, 0x40, 0x45, 0xea, 0x56, 0xfd, 0xc0, 0xc2, 0x03, 0xf1,
+	0x42, 0xe0, 0x3d, 0x46, 0x87, 0xdd, 0x9e, 0x3f, 0xe4, 0x40, 0x5f, 0x64, 0xea, 0xea, 0x96, 0x19,
+	0xf5, 0xed, 0x10, 0x26, 0x6d, 0xda, 0x03, 0xb4, 0x1c, 0x79, 0x5a, 0x31, 0xc5, 0x43, 0x10, 0xf9,
+	0x39, 0x93, 0x2b, 0xe3, 0xb7, 0x89, 0xed, 0x0e, 0x70, 0xdf, 0x5a, 0x8a, 0x46, 0x46, 0xba, 0x79,
+	0x11, 0xcd, 0xdb, 0xd4, 0xe3, 0xe4, 0x29, 0x6f, 0x1f, 0xba, 0x9e, 0x43, 0x0f, 0x55, 0xa0, 0x61,
+	0x4e, 0x41, 0x3f, 0x07, 0xa0, 0xf0, 0xfb, 0x9e, 0x0c, 0xb1, 0xc7, 0xdd, 0x1f, 0x48, 0xbf, 0x4f,
+	0x3e, 0xa9, 0x12, 0x30, 0xf3, 0x6d, 0xf4, 0xba, 0x4d, 0x5d, 0x2f, 0x00, 0x46, 0x5c, 0x4f, 0xb0,
+	0x24, 0xb7, 0x7b, 0x4e, 0xb2, 0x03, 0x9d, 0x3b, 0x84, 0xdd, 0x13, 0x5d, 0x72, 0xab, 0xdf, 0x11,
+	0x4f, 0xd6, 0x70, 0x08, 0x1d, 0xf2, 0x68, 0xcc, 0x3c, 0x8c, 0x59, 0x0a, 0xc7, 0x3c, 0x80, 0x3e,
+	0x18, 0x74, 0x6b, 0x3e, 0x19, 0xaf, 0x6a, 0x35, 0x51, 0x23, 0x2d, 0x4e, 0x5a, 0xd6, 0x7e, 0x36,
+	0x06, 0x9a, 0xb1, 0x25, 0x24, 0x95, 0xec, 0xaa, 0x28, 0xe3, 0x2e, 0x0e, 0xf6, 0x4b, 0x44, 0xfe,
+	0x77, 0x90, 0xa9, 0xc3, 0x8c, 0x8c, 0x04, 0x74, 0xc8, 0x84, 0x3d, 0x1d, 0x03, 0x2b, 0x92, 0xff,
+	0x0c, 0x0b, 0x27, 0xbe, 0xab, 0x86, 0x59, 0xe1, 0x28, 0x6b, 0xb1, 0x97, 0x06, 0x99, 0x1f, 0xa1,
+	0x09, 0x9b, 0x7a, 0x7b, 0x6e, 0x17, 0xc4, 0xb5, 0xc8, 0x30, 0x85, 0x53, 0x6e, 0x01, 0xaa, 0xa5,
+	0x86, 0x08, 0xfb, 0xde, 0x19, 0x3a, 0xc2, 0x18, 0x4b, 0x19, 0x56, 0x2d, 0xb1, 0x91, 0x3e, 0x3e,
+	0x1a, 0x10, 0x2f, 0x14, 0x23, 0x87, 0xb4, 0xc1, 0x96, 0xa8, 0x68, 0xc1, 0x92, 0xea, 0x05, 0x41,
+	0x72, 0xc8, 0x1d, 0xd1, 0x95, 0xd2, 0xe2, 0xc7, 0xe8, 0xcd, 0xdc, 0x9d, 0xd2, 0x1e, 0xcd, 0x7b,
+	0xa8, 0xce, 0x71, 0xb0, 0x0f, 0xdb, 0x35, 0x73, 0xf3, 0x7c, 0x29, 0xd9, 0x30, 0x10, 0xd0, 0x5b,
+	0xdf, 0x93, 0xb7, 0x2a, 0xe1, 0xbc, 0xaf, 0xe7, 0xdd, 0x91, 0xd4, 0x94, 0x79, 0x67, 0xa7, 0xd0,
+	0xa4, 0x18, 0x1e, 0xba, 0x8e, 0x75, 0x6b, 0x42, 0x34, 0xef, 0x39, 0x29, 0xaa, 0x3f, 0x40, 0xe7,
+	0x0b, 0x67, 0xd7, 0x94, 0x9b, 0xa8, 0xee, 0x63, 0xd7, 0x51, 0x9e, 0x18, 0x7c, 0xb7, 0xbe, 0x0d,
+	0x82, 0x21, 0x1f, 0xc0, 0x23, 0x0a, 0xc6, 0x88, 0x24, 0x3d, 0x80, 0x8d, 0xcc, 0xce, 0x5c, 0x46,
+	0x8e, 0xd9, 0x44, 0x53, 0x8c, 0xc8, 0xc7, 0x82, 0x9a, 0x5c, 0xb7, 0x15, 0xa9, 0x5b, 0xd8, 0xb3,
+	0x49, 0xff, 0xeb, 0x20, 0x35, 0x3b, 0xf3, 0x0b, 0x93, 0xfa, 0xaf, 0x46, 0xec, 0x26, 0x0a, 0xd5,
+	0xe1, 0xb6, 0xbb, 0xb7, 0x57, 0x42, 0xeb, 0x37, 0xd1, 0x9c, 0x47, 0x0e, 0x1f, 0x08, 0xcd, 0x75,
+	0xf7, 0x5c, 0x98, 0xb4, 0x56, 0x28, 0x60, 0xe1, 0x9c, 0x42, 0x8a, 0xad, 0xe4, 0x38, 0xf3, 0x23,
+	0x34, 0xc9, 0xc8, 0x80, 0x1e, 0x10, 0x71, 0x13, 0x8c, 0x38, 0x45, 0x38, 0x22, 0xb5, 0x31, 0xe7,
+	0x60, 0x63, 0xb2, 0x6c, 0x68, 0xc3, 0xe2, 0x80, 0xdc, 0x81, 0xe7, 0x1f, 0xdf, 0xb8, 0x4f, 0x28,
+	0xdb, 0x80, 0x58, 0xb0, 0x10, 0xc0, 0x97, 0x3f, 0x9f, 0xab, 0xe8, 0x4a, 0xe5, 0x2a, 0x9a, 0xa4,
+	0xbf, 0x96, 0x7b, 0x2f, 0x7b, 0x5e, 0x52, 0x4e, 0xcc, 0x6f, 0xa2, 0x69, 0x15, 0xd4, 0x26, 0x81,
+	0xda, 0xcd, 0x2b, 0x95, 0x1a, 0xbf, 0xa1, 0x46, 0x58, 0xd1, 0xd8, 0xdc, 0x7d, 0xcd, 0x92, 0xa8,
+	0x99, 0xf8, 0x7b, 0x19, 0x2b, 0x92, 0x66, 0x08, 0x9e, 0xcd, 0xb8, 0xff, 0xc8, 0xef, 0x32, 0xec,
+	0x90, 0x0a, 0x1f, 0xe1, 0x24, 0x9a, 0x88, 0x3d, 0x7d, 0xea, 0x96, 0x6a, 0x89, 0x57, 0x66, 0x2c,
+	0xf8, 0x1e, 0xbe, 0x32, 0xe3, 0xf1, 0xf8, 0x55, 0x74, 0x02, 0xfb, 0xee, 0xa6, 0xeb, 0x61, 0xe6,
+	0x92, 0xe0, 0x5b, 0x01, 0xf5, 0x54, 0x9c, 0x20, 0x0d, 0xce, 0x5c, 0x3d, 0xe7, 0x21, 0x84, 0x91,
+	0x47, 0xac, 0x66, 0xe8, 0xef, 0x8c, 0xd8, 0xab, 0x22, 0x64, 0xf9, 0xd3, 0x03, 0x8b, 0xd8, 0x94,
+	0x95, 0xf9, 0x87, 0x27, 0x91, 0x3a, 0x8a, 0xd4, 0xc1, 0xac, 0xa0, 0x99, 0xd8, 0xbb, 0x21, 0x64,
+	0x27, 0x06, 0x32, 0x17, 0x50, 0x2d, 0x0a, 0x75, 0x88, 0xcf, 0x28, 0x96, 0x23, 0xfd, 0x19, 0xd9,
+	0x48, 0x9d, 0xcc, 0x05, 0x69, 0x48, 0x73, 0xc9, 0xd4, 0xcc, 0x0c, 0xe1, 0x7d, 0xfd, 0x2d, 0xea,
+	0xea, 0xc3, 0x2b, 0xe1, 0xe0, 0x16, 0xaa, 0x31, 0xf2, 0x44, 0x25, 0xa5, 0x56, 0x73, 0x85, 0x27,
+	0x3e, 0x93, 0x25, 0xc3, 0xb3, 0x96, 0x18, 0x94, 0xa2, 0xed, 0xb7, 0x41, 0x26, 0x92, 0xc8, 0xca,
+	0x40, 0x7d, 0xac, 0x23, 0x6e, 0xf2, 0x5a, 0xba, 0x94, 0xbb, 0xce, 0xf6, 0x7d, 0xa1, 0xec, 0x30,
+	0x58, 0xc5, 0x47, 0xc3, 0xc8, 0xdc, 0x21, 0x5a, 0xde, 0x0e, 0xba, 0xfa, 0x02, 0x27, 0x98, 0xf1,
+	0x0e, 0xc1, 0x65, 0xaa, 0xfb, 0x41, 0x9c, 0xad, 0x8b, 0xf9, 0x16, 0x26, 0x9c, 0xa6, 0x84, 0xa7,
+	0xef, 0xa0, 0x33, 0x79, 0x0b, 0x6b, 0xc6, 0x6e, 0xa1, 0x3a, 0x23, 0x81, 0x5f, 0xca, 0x56, 0x66,
+	0x94, 0x05, 0x63, 0x5a, 0x4f, 0xe4, 0x33, 0x84, 0xf0, 0x4d, 0xcc, 0x98, 0x4b, 0x58, 0x09, 0x37,
+	0x1f, 0xc6, 0xb9, 0xc9, 0x5f, 0x25, 0x9a, 0xa7, 0x84, 0x9d, 0x5d, 0x69, 0xf7, 0x63, 0xa8, 0x8a,
+	0x8f, 0x8f, 0x12, 0x7c, 0x5c, 0xae, 0x5c, 0x21, 0xc1, 0xc8, 0x0f, 0x61, 0xd6, 0xf8, 0xc1, 0xcb,
+	0xe3, 0xfb, 0x95, 0x48, 0x5d, 0x1b, 0x6c, 0x55, 0x76, 0xf1, 0x57, 0x26, 0x7b, 0x3f, 0x84, 0x18,
+	0xbc, 0xb4, 0x1e, 0xb7, 0x87, 0x83, 0xc1, 0xd1, 0x88, 0x46, 0x3b, 0x74, 0xc4, 0xc6, 0x8e, 0xe5,
+	0x88, 0xa5, 0xb8, 0xfb, 0x2e, 0x6a, 0x15, 0x2f, 0xfe, 0xb2, 0x3e, 0xdf, 0xff, 0x8d, 0x41, 0x30,
+	0x6b, 0x13, 0x92, 0xeb, 0x77, 0x9e, 0xda, 0x3d, 0xec, 0x75, 0xc1, 0x7e, 0x47, 0xd1, 0x3e, 0x65,
+	0xbf, 0x35, 0x40, 0x18, 0x36, 0x99, 0x12, 0xd9, 0xea, 0x61, 0xd7, 0x0b, 0xe3, 0x85, 0x31, 0x90,
+	0xb0, 0xd3, 0xe2, 0x55, 0xc2, 0xb0, 0x1d, 0xc6, 0x4b, 0x94, 0xf9, 0x4b, 0x83, 0xc5, 0x73, 0x85,
+	0x1e, 0x7a, 0x84, 0x85, 0x68, 0xd2, 0x16, 0x26, 0x60, 0xb0, 0x9e, 0x68, 0xef, 0x40, 0x2c, 0x58,
+	0x99, 0xc6, 0x38, 0x28, 0x16, 0xc2, 0x53, 0xa1, 0x2d, 0x15, 0xc2, 0x5b, 0x41, 0x33, 0x10, 0x94,
+	0xf9, 0x6c, 0x38, 0xe8, 0x10, 0x16, 0x66, 0x89, 0x63, 0x20, 0x19, 0x26, 0xb7, 0x89, 0xeb, 0xcb,
+	0xbc, 0x80, 0xca, 0x98, 0x25, 0x60, 0x31, 0x9c, 0xc0, 0xa2, 0x94, 0xab, 0x44, 0x71, 0x02, 0x16,
+	0xc3, 0xd9, 0x61, 0x94, 0xee, 0x35, 0xd0, 0x4a, 0x6d, 0x75, 0xd6, 0x4a, 0xc0, 0xd4, 0x9d, 0xa4,
+	0xf7, 0xb1, 0x75, 0x15, 0xee, 0x9b, 0xe4, 0xd6, 0xeb, 0xf3, 0x94, 0xef, 0x64, 0x23, 0x7c, 0x27,
+	0xb7, 0x7e, 0x6a, 0xc8, 0x0b, 0xd9, 0x71, 0x1e, 0x05, 0xe2, 0xfd, 0x1b, 0x9e, 0xe5, 0x46, 0xbf,
+	0x4f, 0x0f, 0xef, 0xbb, 0x01, 0xaf, 0xb8, 0x74, 0x8b, 0xc3, 0xf0, 0xef, 0xa1, 0x3a, 0xa3, 0x7d,
+	0x19, 0xb9, 0x98, 0xaf, 0x90, 0x1c, 0x8b, 0xf6, 0x85, 0xc6, 0xd3, 0x3e, 0xc9, 0xdc, 0xb0, 0x97,
+	0xd1, 0xc5, 0x52, 0xfa, 0xf4, 0xd5, 0xf4, 0xb7, 0x06, 0x08, 0xb4, 0x05, 0xee, 0x9c, 0x40, 0xfe,
+	0x84, 0xd1, 0xc1, 0xaf, 0x1d, 0x3b, 0xd7, 0xd0, 0x5a, 0x35, 0x91, 0x9a, 0xa7, 0xbf, 0x54, 0x89,
+	0x33, 0xc2, 0x8f, 0xcb, 0x88, 0xe8, 0x95, 0x94, 0xab, 0xa7, 0xab, 0xe8, 0x0d, 0x01, 0xaf, 0x8a,
+	0x19, 0xe9, 0xfd, 0xe4, 0x51, 0xa7, 0x39, 0xd8, 0x0f, 0xc5, 0x2b, 0x96, 0x01, 0x09, 0x76, 0xe9,
+	0x2b, 0x61, 0xa3, 0x58, 0x56, 0x0a, 0x16, 0xd3, 0x54, 0xf9, 0x31, 0x51, 0x89, 0xe3, 0x8a, 0xd3,
+	0xf8, 0x7a, 0x48, 0x8b, 0x9f, 0x7b, 0xe1, 0x8a, 0x9a, 0xbe, 0x3f, 0x34, 0xe0, 0x66, 0x08, 0x43,
+	0x1a, 0x52, 0x97, 0x37, 0xf4, 0xf1, 0x55, 0x12, 0x66, 0x0b, 0x73, 0xf9, 0x19, 0x1e, 0x84, 0xe9,
+	0xd8, 0x08, 0x90, 0x24, 0xbb, 0x56, 0x45, 0xf6, 0x5b, 0x6a, 0xa3, 0x72, 0xe9, 0xd0, 0xe4, 0xfe,
+	0x91, 0xa1, 0x2f, 0x7f, 0xc0, 0xb0, 0x30, 0x27, 0xf7, 0xdd, 0x81, 0x5b, 0xb5, 0x85, 0x77, 0xd1,
+	0x34, 0x0b, 0x51, 0xd5, 0x65, 0xf6, 0x56, 0xae, 0x2c, 0xa6, 0xa6, 0x55, 0x61, 0xda, 0x68, 0x70,
+	0x86, 0x6e, 0xf5, 0x7c, 0xcb, 0x10, 0xa4, 0x49, 0xfe, 0x89, 0x01, 0x15, 0x20, 0x1a, 0x63, 0x07,
+	0x0f, 0x03, 0xe2, 0x54, 0x5b, 0x07, 0xd8, 0xc8, 0x7b, 0x61, 0x36, 0x2b, 0x6c, 0x8a, 0x9b, 0xc2,
+	0x87, 0x19, 0x40, 0xa5, 0xa6, 0x2c, 0xd5, 0x92, 0xc9, 0x1e, 0x1c, 0x3d, 0x28, 0x54, 0x2b, 0x43,
+	0xef, 0x19, 0x38, 0xef, 0x14, 0x35, 0x9a, 0xd8, 0xff, 0x31, 0x12, 0x11, 0x2e, 0x88, 0x82, 0x6d,
+	0x13, 0x8e, 0x1d, 0xcc, 0xf1, 0x0b, 0x93, 0x3c, 0xfa, 0x65, 0x6a, 0xa2, 0xba, 0x27, 0x64, 0x49,
+	0xb2, 0x00, 0xdf, 0x90, 0xe8, 0x3e, 0x1a, 0x74, 0x68, 0x3f, 0x0c, 0x91, 0xca, 0x96, 0xd9, 0x44,
+	0x53, 0x8e, 0x0c, 0x37, 0x06, 0x70, 0x69, 0xce, 0x59, 0xba, 0x2d, 0x28, 0xa5, 0x07, 0x84, 0x1d,
+	0x32, 0x97, 0x13, 0x95, 0x38, 0x8e, 0x00, 0x99, 0x2d, 0x69, 0xa1, 0x95, 0x22, 0x9e, 0xf5, 0xc6,
+	0xfc, 0xa9, 0x4c, 0x21, 0x6f, 0xf8, 0x3e, 0xa3, 0x07, 0x44, 0xee, 0x1d, 0x60, 0x7e, 0x42, 0xd9,
+	0x2e, 0xc3, 0x8e, 0x78, 0x9f, 0x7c, 0xed, 0xfb, 0x93, 0xa1, 0x5c, 0xe6, 0x80, 0xcb, 0x88, 0xd2,
+	0x0c, 0xfc, 0x55, 0xf2, 0x64, 0xef, 0xbb, 0x4f, 0x86, 0xae, 0xe3, 0xf2, 0xa3, 0x1d, 0x4a, 0xab,
+	0x42, 0xe2, 0xa7, 0xd0, 0xa4, 0xad, 0x12, 0x6f, 0x2a, 0x91, 0x68, 0xcb, 0xc4, 0xdb, 0x32, 0x1a,
+	0xef, 0xe3, 0x0e, 0x09, 0x33, 0xe0, 0xb2, 0x21, 0x2b, 0x3a, 0x02, 0x8e, 0x3d, 0xee, 0x62, 0x4e,
+	0xda, 0x83, 0xa0, 0xab, 0xce, 0x73, 0x3e, 0x06, 0xde, 0x0e, 0xba, 0x15, 0xe7, 0x90, 0xa0, 0x50,
+	0xb3, 0xf1, 0x3c, 0xb4, 0x57, 0xe0, 0x4b, 0x4b, 0x96, 0x3f, 0x77, 0x79, 0xcf, 0x61, 0xf8, 0xb0,
+	0x34, 0xbb, 0x70, 0x1e, 0xcd, 0x0e, 0x03, 0xc2, 0x52, 0x89, 0xb1, 0x19, 0x01, 0x0b, 0xa5, 0x2f,
+	0x72, 0xc2, 0x6a, 0x09, 0x27, 0x6c, 0x1d, 0x2d, 0x39, 0x24, 0xe0, 0xae, 0x27, 0x43, 0xe1, 0x38,
+	0xe1, 0xe9, 0x99, 0xb1, 0xae, 0xf0, 0x98, 0x92, 0xee, 0xef, 0x8f, 0x43, 0x77, 0x21, 0x97, 0x64,
+	0xed, 0x2f, 0xbd, 0x89, 0x50, 0x58, 0xd5, 0xa4, 0xfd, 0xa6, 0x69, 0x05, 0xb9, 0xe7, 0x54, 0x27,
+	0x71, 0xde, 0x42, 0xf3, 0x9d, 0x7e, 0xd0, 0x8e, 0xcd, 0xa1, 0x6a, 0x56, 0x3a, 0xfd, 0xc0, 0x0a,
+	0xa7, 0x69, 0xfd, 0x8d, 0x01, 0xaf, 0xd0, 0x04, 0x31, 0xdb, 0x6e, 0x69, 0x00, 0x29, 0xda, 0x96,
+	0xb1, 0x51, 0xb6, 0xa5, 0x56, 0xb4, 0x2d, 0xe6, 0x69, 0x34, 0x05, 0x22, 0x2f, 0x68, 0xab, 0x27,
+	0x54, 0x20, 0xb5, 0x63, 0xbf, 0x6f, 0xc0, 0x8b, 0x35, 0x43, 0xe4, 0xaf, 0x78, 0xaf, 0x7e, 0x24,
+	0x75, 0x3e, 0x14, 0xc8, 0xcf, 0x19, 0xf6, 0x7d, 0xe2, 0xa8, 0x82, 0x36, 0xa9, 0x98, 0x2f, 0x5c,
+	0x49, 0x9b, 0xd2, 0xa9, 0x7a, 0xa8, 0x53, 0x05, 0x2a, 0x5e, 0x46, 0x83, 0xd6, 0x8d, 0x7f, 0x91,
+	0xba, 0xb1, 0xed, 0x76, 0xc5, 0x75, 0xb5, 0xd1, 0xef, 0xc7, 0xb1, 0x5f, 0xbc, 0xe8, 0xf7, 0x2c,
+	0x9a, 0xf1, 0xc8, 0x61, 0x3b, 0x49, 0xee, 0xb4, 0x47, 0x0e, 0xb7, 0xa4, 0x15, 0x58, 0x45, 0x0b,
+	0x03, 0xb9, 0xa4, 0xd0, 0xf5, 0xf6, 0xf7, 0x03, 0x1d, 0xfa, 0x9a, 0x57, 0x70, 0xf1, 0xb6, 0x0d,
+	0xa8, 0x07, 0xf6, 0x02, 0x6e, 0xe0, 0x3a, 0xd8, 0x6b, 0xd9, 0xc8, 0x70, 0xbc, 0x09, 0xea, 0x52,
+	0xc0, 0x85, 0x16, 0x01, 0x61, 0xb2, 0x38, 0x27, 0x03, 0x9f, 0x13, 0x29, 0x01, 0x73, 0x56, 0x04,
+	0x68, 0x75, 0x64, 0x7c, 0xd2, 0xf7, 0x09, 0xee, 0xeb, 0x7a, 0x93, 0xf2, 0x04, 0x7a, 0x75, 0x15,
+	0x49, 0x56, 0x4a, 0xdf, 0xcc, 0x5d, 0x44, 0xd3, 0x78, 0x13, 0xbd, 0xee, 0x10, 0xec, 0xf4, 0x5d,
+	0x8f, 0x24, 0x73, 0xeb, 0x06, 0x64, 0xa6, 0x97, 0xc2, 0xce, 0x78, 0x5a, 0x7d, 0x1d, 0x2d, 0xe9,
+	0xaa, 0x5f, 0xfd, 0xd2, 0x0a, 0xdd, 0x3e, 0x33, 0xec, 0x7a, 0xac, 0x7b, 0x5a, 0x07, 0x68, 0x49,
+	0x47, 0xd3, 0x24, 0x2d, 0x8f, 0x29, 0x7f, 0xc9, 0x72, 0x19, 0x1d, 0xc5, 0xab, 0xc5, 0x2a, 0xb2,
+	0x52, 0xec, 0xbf, 0x89, 0xde, 0xc8, 0x59, 0x57, 0x0b, 0xe3, 0xbf, 0x1b, 0xb2, 0xc8, 0x84, 0x70,
+	0x48, 0x93, 0xa9, 0x60, 0x4a, 0xf9, 0x45, 0x93, 0xae, 0x47, 0xfb, 0x50, 0x47, 0x3d, 0xe4, 0xf3,
+	0x61, 0x25, 0x3f, 0xea, 0x11, 0xcd, 0x1f, 0xc6, 0x3b, 0x04, 0x8f, 0xc1, 0xd0, 0x0b, 0x08, 0x57,
+	0xa9, 0x29, 0x99, 0xc2, 0x9a, 0x91, 0x30, 0x48, 0x49, 0x49, 0x14, 0xdb, 0x26, 0x41, 0x40, 0x99,
+	0xd8, 0x06, 0xf5, 0x2a, 0xd7, 0xb0, 0x1c, 0x5d, 0x7c, 0x23, 0xcc, 0x2f, 0xc5, 0x97, 0x0c, 0x19,
+	0x7e, 0x02, 0xa9, 0xe6, 0xb0, 0x73, 0xa3, 0xef, 0xe2, 0x2a, 0x76, 0x97, 0xd1, 0x38, 0x16, 0x68,
+	0x8a, 0x63, 0xd9, 0x10, 0x56, 0x52, 0x66, 0x77, 0xa3, 0x6a, 0x19, 0x68, 0xe7, 0xd0, 0x23, 0xd3,
+	0x91, 0x89, 0x25, 0x35, 0x39, 0xff, 0x20, 0x0d, 0xfd, 0x06, 0xe7, 0xda, 0x84, 0x82, 0x98, 0xbd,
+	0x74, 0x68, 0x24, 0x15, 0x92, 0xa8, 0x95, 0x85, 0x24, 0x64, 0xb8, 0xa1, 0x9e, 0x0d, 0x37, 0x64,
+	0x42, 0x09, 0xb7, 0xc0, 0xe2, 0x67, 0xa8, 0xd5, 0xaa, 0xd4, 0x44, 0x53, 0x18, 0x3a, 0x95, 0xb6,
+	0x4f, 0x59, 0xba, 0xbd, 0x76, 0x1d, 0xcd, 0xc6, 0x9f, 0x90, 0xe6, 0x1c, 0x9a, 0xb6, 0x1e, 0xdc,
+	0xbf, 0xd3, 0xbe, 0xf3, 0xed, 0x3b, 0x5b, 0x0b, 0xaf, 0x99, 0xf3, 0x08, 0x41, 0xf3, 0xe1, 0xee,
+	0x86, 0xb5, 0xbb, 0x60, 0xdc, 0xfc, 0xef, 0x1b, 0xa8, 0xb6, 0x1d, 0x74, 0xcd, 0x0e, 0x9a, 0x4d,
+	0xfc, 0x51, 0x44, 0xfe, 0x83, 0x20, 0xf5, 0x57, 0x07, 0xcd, 0x6b, 0xa3, 0x60, 0x69, 0xb2, 0x7b,
+	0x68, 0x3e, 0xf5, 0x77, 0x09, 0x97, 0x8a, 0xc6, 0x27, 0xf1, 0x9a, 0x37, 0x46, 0xc3, 0xd3, 0x2b,
+	0xed, 0xa3, 0x13, 0xe9, 0x22, 0xf3, 0xcb, 0x45, 0x53, 0xa4, 0x10, 0x9b, 0xeb, 0x23, 0x22, 0xea,
+	0xc5, 0x7e, 0x80, 0x96, 0x73, 0x4b, 0x6c, 0x0b, 0x37, 0x27, 0x0f, 0xbb, 0xf9, 0xee, 0x71, 0xb0,
+	0xf5, 0xda, 0xdf, 0x43, 0x28, 0x56, 0x10, 0xd5, 0x2a, 0x9a, 0x23, 0xc2, 0x69, 0xae, 0x55, 0xe3,
+	0xe8, 0xd9, 0xff, 0xc4, 0x40, 0x67, 0x4a, 0xeb, 0x44, 0xab, 0x89, 0xce, 0x19, 0xd5, 0xfc, 0x8d,
+	0x17, 0x19, 0xa5, 0x89, 0x3a, 0x44, 0x4b, 0x79, 0xb5, 0x9b, 0x57, 0x8b, 0x26, 0xcd, 0x41, 0x6e,
+	0xbe, 0x73, 0x0c, 0xe4, 0xf8, 0xc2, 0x79, 0xa5, 0x98, 0x85, 0x0b, 0xe7, 0x20, 0x17, 0x2f, 0x5c,
+	0x52, 0x5d, 0x29, 0x74, 0x33, 0x51, 0x5a, 0x59, 0xa8, 0x9b, 0x71, 0xac, 0x62, 0xdd, 0xcc, 0x2d,
+	0x9b, 0x14, 0xba, 0x99, 0x2c, 0x81, 0xbc, 0x54, 0x7e, 0x4a, 0x21, 0x5e, 0x89, 0x6e, 0xe6, 0x16,
+	0xf4, 0x89, 0x6d, 0xcc, 0x2b, 0xe6, 0xbb, 0x5a, 0x39, 0x4d, 0x4c, 0x88, 0xdf, 0x39, 0x06, 0x72,
+	0x5c, 0x57, 0x62, 0x15, 0x62, 0xad, 0xf2, 0x29, 0x04, 0x4e, 0xb1, 0xae, 0x64, 0x2b, 0xbe, 0xcc,
+	0x9f, 0x1a, 0x68, 0xa5, 0xb2, 0xde, 0xeb, 0xc3, 0xf2, 0x09, 0x8b, 0x47, 0x36, 0x7f, 0xeb, 0x45,
+	0x47, 0x6a, 0x02, 0x09, 0x9a, 0x4b, 0x96, 0x7e, 0x5d, 0x2c, 0x96, 0xc5, 0x18, 0x5a, 0xf3, 0xfa,
+	0x48, 0x68, 0x7a, 0x19, 0x8e, 0xcc, 0x9c, 0xaa, 0x9f, 0xc2, 0x9d, 0xcc, 0xe2, 0x36, 0x6f, 0x8e,
+	0x8e, 0x1b, 0x5f, 0x35, 0xa7, 0xf6, 0xa1, 0xe2, 0xfc, 0xe2, 0xb8, 0xc5, 0xab, 0x16, 0x17, 0x23,
+	0x08, 0xcb, 0x9f, 0x9b, 0x30, 0xbf, 0x56, 0xce, 0x41, 0x12, 0xbb, 0xd8, 0xf2, 0x97, 0xe5, 0xb7,
+	0xcd, 0x3f, 0x37, 0xd0, 0xd9, 0x8a, 0x32, 0x88, 0xf7, 0x4b, 0x2d, 0x40, 0xe1, 0xb8, 0xe6, 0xc7,
+	0x2f, 0x36, 0x2e, 0x7e, 0x18, 0x39, 0xc5, 0x10, 0x85, 0x87, 0x91, 0xc5, 0x2d, 0x3e, 0x8c, 0xe2,
+	0x0a, 0x06, 0xf3, 0x99, 0x81, 0x4e, 0x16, 0x54, 0x3b, 0x15, 0x9b, 0xa8, 0x5c, 0xfc, 0xe6, 0xfb,
+	0xc7, 0xc3, 0x8f, 0x33, 0x9e, 0x53, 0xd8, 0xb4, 0x56, 0xee, 0x50, 0x8c, 0xc6, 0x78, 0x49, 0x59,
+	0x93, 0xd0, 0xb8, 0x6c, 0x8d, 0x52, 0xb1, 0xc6, 0x65, 0x70, 0x4b, 0x34, 0xae, 0xb8, 0x42, 0x09,
+	0xb6, 0x3b, 0xbf, 0xb8, 0xa2, 0xe2, 0x46, 0x48, 0xe3, 0x97, 0x6c, 0x77, 0x69, 0x55, 0x84, 0xb8,
+	0x17, 0x13, 0x25, 0x11, 0x85, 0xf7, 0x62, 0x1c, 0xab, 0xf8, 0x5e, 0xcc, 0xad, 0x73, 0x78, 0x82,
+	0x16, 0xb3, 0x45, 0x0a, 0x57, 0x8a, 0xa6, 0xc8, 0xa0, 0x36, 0xdf, 0x1e, 0x19, 0x35, 0x71, 0x4f,
+	0x45, 0x25, 0x04, 0xad, 0x12, 0x59, 0x54, 0x38, 0x25, 0xf7, 0x54, 0xb6, 0x2e, 0x80, 0x23, 0x33,
+	0x27, 0xaf, 0xbf, 0x36, 0xca, 0xa6, 0x48, 0xdc, 0x62, 0x69, 0x29, 0x49, 0xd9, 0xff, 0x9e, 0x81,
+	0x4e, 0x15, 0x25, 0xdc, 0xd7, 0xcb, 0xed, 0x5f, 0x66, 0x40, 0xf3, 0x83, 0x63, 0x0e, 0x88, 0x3b,
+	0x39, 0xa9, 0xd4, 0x78, 0xa1, 0x93, 0x93, 0xc4, 0x2b, 0x76, 0x72, 0x0a, 0xf2, 0xbd, 0x82, 0xdf,
+	0xa2, 0x34, 0xd2, 0x7a, 0xd5, 0x85, 0x9a, 0x1a, 0x50, 0xcc, 0x6f, 0x45, 0x82, 0xc8, 0xfc, 0x5d,
+	0xf4, 0x7a, 0x7e, 0x88, 0xbb, 0xf2, 0x4e, 0x4f, 0xa0, 0x37, 0xdf, 0x3b, 0x16, 0x7a, 0xde, 0xf2,
+	0xc9, 0xdc, 0x49, 0xe5, 0xf2, 0x09, 0xf4, 0xea, 0xe5, 0x73, 0xb3, 0x14, 0xf0, 0x7a, 0x29, 0x4d,
+	0x51, 0x14, 0x5e, 0xbc, 0x65, 0xa3, 0x8a, 0x5f, 0x2f, 0xa3, 0x64, 0x1e, 0x94, 0x60, 0xe4, 0xc7,
+	0xeb, 0x4b, 0x04, 0x23, 0x77, 0x40, 0x99, 0x60, 0x94, 0x87, 0xd7, 0x9f, 0xa0, 0xc5, 0x6c, 0xd0,
+	0xfb, 0xca, 0x48, 0xb3, 0x09, 0xd4, 0x62, 0xab, 0x56, 0x1c, 0xa5, 0x16, 0x1e, 0x5a, 0x36, 0x51,
+	0x59, 0x6a, 0xb9, 0x92, 0xb8, 0x25, 0x1e, 0x5a, 0x61, 0xbe, 0xd1, 0xdc, 0x47, 0x27, 0xd2, 0xb9,
+	0xc6, 0xcb, 0x95, 0xd3, 0x48, 0xc4, 0xe2, 0x40, 0x40, 0x41, 0xbe, 0x10, 0x04, 0xae, 0x34, 0x3e,
+	0xfe, 0x6e, 0x95, 0x20, 0xe7, 0x8d, 0x2a, 0x16, 0xb8, 0x51, 0xe2, 0xe0, 0x20, 0x70, 0x45, 0x41,
+	0xf0, 0x42, 0x0e, 0x0b, 0x06, 0x14, 0x0b, 0x5c, 0x55, 0x80, 0xfa, 0x27, 0x06, 0x6a, 0x96, 0x14,
+	0xbb, 0x14, 0xfb, 0x7b, 0x85, 0x63, 0x9a, 0xb7, 0x8e, 0x3f, 0x46, 0x93, 0xf3, 0x17, 0x06, 0x3a,
+	0x57, 0x55, 0xb1, 0x52, 0xa2, 0x5c, 0xa5, 0x03, 0x9b, 0xbf, 0xf9, 0x82, 0x03, 0x13, 0x01, 0xa5,
+	0xbc, 0xd2, 0x93, 0x6b, 0x25, 0x02, 0x99, 0x25, 0xe3, 0xdd, 0xe3, 0x60, 0xa7, 0x0f, 0xaa, 0xa8,
+	0x6c, 0xa4, 0xec, 0xa0, 0x0a, 0xc6, 0x94, 0x1e, 0x54, 0x45, 0xc5, 0x48, 0xec, 0xa0, 0x8a, 0xeb,
+	0x45, 0x2a, 0x0e, 0xaa, 0x70, 0x60, 0xd5, 0x41, 0x55, 0xd6, 0x8b, 0xc0, 0x43, 0x27, 0x9b, 0x55,
+	0x59, 0x2b, 0xb9, 0x20, 0x52, 0xb8, 0x25, 0x0f, 0x9d, 0xe2, 0x44, 0x8a, 0x87, 0x16, 0x32, 0x09,
+	0x8e, 0xd5, 0x72, 0x17, 0x3a, 0xc2, 0x6c, 0x7e, 0x63, 0x54, 0xcc, 0x44, 0x68, 0x28, 0x99, 0xb8,
+	0xb8, 0x54, 0x22, 0x5a, 0x31, 0xbc, 0xe6, 0x8d, 0xd1, 0xf0, 0xe2, 0x21, 0x8a, 0x64, 0xca, 0xe0,
+	0x62, 0xd5, 0x04, 0x80, 0x56, 0x1c, 0xa2, 0xc8, 0xcd, 0x06, 0x88, 0xdb, 0x2f, 0x9b, 0x09, 0x28,
+	0xbc, 0xfd, 0x32, 0xa8, 0xc5, 0xb7, 0x5f, 0x61, 0xc4, 0xbe, 0x39, 0xfe, 0xec, 0xab, 0xe7, 0x6b,
+	0xc6, 0xe6, 0x83, 0x9f, 0x7f, 0x71, 0xd6, 0xf8, 0xc5, 0x17, 0x67, 0x8d, 0xff, 0xfa, 0xe2, 0xac,
+	0xf1, 0xc7, 0x5f, 0x9e, 0x7d, 0xed, 0x17, 0x5f, 0x9e, 0x7d, 0xed, 0x3f, 0xbe, 0x3c, 0xfb, 0xda,
+	0x77, 0xde, 0xeb, 0xba, 0xbc, 0x37, 0xec, 0xdc, 0xb0, 0xe9, 0x60, 0xdd, 0x67, 0xd4, 0x19, 0xda,
+	0x3c, 0xb0, 0xdd, 0xd4, 0xaf, 0x19, 0xc5, 0x7f, 0x13, 0x88, 0x1f, 0xf9, 0x24, 0xe8, 0x4c, 0xc0,
+	0xcf, 0x1a, 0xbd, 0xf3, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa1, 0xcb, 0x8b, 0xb1, 0xab, 0x4a,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	StartInference(ctx context.Context, in *MsgStartInference, opts ...grpc.CallOption) (*MsgStartInferenceResponse, error)
+	FinishInference(ctx context.Context, in *MsgFinishInference, opts ...grpc.CallOption) (*MsgFinishInferenceResponse, error)
+	SubmitNewParticipant(ctx context.Context, in *MsgSubmitNewParticipant, opts ...grpc.CallOption) (*MsgSubmitNewParticipantResponse, error)
+	Validation(ctx context.Context, in *MsgValidation, opts ...grpc.CallOption) (*MsgValidationResponse, error)
+	SubmitNewUnfundedParticipant(ctx context.Context, in *MsgSubmitNewUnfundedParticipant, opts ...grpc.CallOption) (*MsgSubmitNewUnfundedParticipantResponse, error)
+	InvalidateInference(ctx context.Context, in *MsgInvalidateInference, opts ...grpc.CallOption) (*MsgInvalidateInferenceResponse, error)
+	RevalidateInference(ctx context.Context, in *MsgRevalidateInference, opts ...grpc.CallOption) (*MsgRevalidateInferenceResponse, error)
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	SubmitPocBatch(ctx context.Context, in *MsgSubmitPocBatch, opts ...grpc.CallOption) (*MsgSubmitPocBatchResponse, error)
+	SubmitPocValidation(ctx context.Context, in *MsgSubmitPocValidation, opts ...grpc.CallOption) (*MsgSubmitPocValidationResponse, error)
+	SubmitSeed(ctx context.Context, in *MsgSubmitSeed, opts ...grpc.CallOption) (*MsgSubmitSeedResponse, error)
+	SubmitUnitOfComputePriceProposal(ctx context.Context, in *MsgSubmitUnitOfComputePriceProposal, opts ...grpc.CallOption) (*MsgSubmitUnitOfComputePriceProposalResponse, error)
+	RegisterModel(ctx context.Context, in *MsgRegisterModel, opts ...grpc.CallOption) (*MsgRegisterModelResponse, error)
+	CreateTrainingTask(ctx context.Context, in *MsgCreateTrainingTask, opts ...grpc.CallOption) (*MsgCreateTrainingTaskResponse, error)
+	SubmitHardwareDiff(ctx context.Context, in *MsgSubmitHardwareDiff, opts ...grpc.CallOption) (*MsgSubmitHardwareDiffResponse, error)
+	CreatePartialUpgrade(ctx context.Context, in *MsgCreatePartialUpgrade, opts ...grpc.CallOption) (*MsgCreatePartialUpgradeResponse, error)
+	ClaimTrainingTaskForAssignment(ctx context.Context, in *MsgClaimTrainingTaskForAssignment, opts ...grpc.CallOption) (*MsgClaimTrainingTaskForAssignmentResponse, error)
+	AssignTrainingTask(ctx context.Context, in *MsgAssignTrainingTask, opts ...grpc.CallOption) (*MsgAssignTrainingTaskResponse, error)
+	SettleTrainingPayments(ctx context.Context, in *MsgSettleTrainingPayments, opts ...grpc.CallOption) (*MsgSettleTrainingPaymentsResponse, error)
+	FinishTrainingTask(ctx context.Context, in *MsgFinishTrainingTask, opts ...grpc.CallOption) (*MsgFinishTrainingTaskResponse, error)
+	CancelTrainingTask(ctx context.Context, in *MsgCancelTrainingTask, opts ...grpc.CallOption) (*MsgCancelTrainingTaskResponse, error)
+	SubmitTrainingKvRecord(ctx context.Context, in *MsgSubmitTrainingKvRecord, opts ...grpc.CallOption) (*MsgSubmitTrainingKvRecordResponse, error)
+	JoinTraining(ctx context.Context, in *MsgJoinTraining, opts ...grpc.CallOption) (*MsgJoinTrainingResponse, error)
+	TrainingHeartbeat(ctx context.Context, in *MsgTrainingHeartbeat, opts ...grpc.CallOption) (*MsgTrainingHeartbeatResponse, error)
+	SetBarrier(ctx context.Context, in *MsgSetBarrier, opts ...grpc.CallOption) (*MsgSetBarrierResponse, error)
+	JoinTrainingStatus(ctx context.Context, in *MsgJoinTrainingStatus, opts ...grpc.CallOption) (*MsgJoinTrainingStatusResponse, error)
+	CreateDummyTrainingTask(ctx context.Context, in *MsgCreateDummyTrainingTask, opts ...grpc.CallOption) (*MsgCreateDummyTrainingTaskResponse, error)
+	BridgeExchange(ctx context.Context, in *MsgBridgeExchange, opts ...grpc.CallOption) (*MsgBridgeExchangeResponse, error)
+	RegisterBridgeAddresses(ctx context.Context, in *MsgRegisterBridgeAddresses, opts ...grpc.CallOption) (*MsgRegisterBridgeAddressesResponse, error)
+	RegisterLiquidityPool(ctx context.Context, in *MsgRegisterLiquidityPool, opts ...grpc.CallOption) (*MsgRegisterLiquidityPoolResponse, error)
+	RegisterTokenMetadata(ctx context.Context, in *MsgRegisterTokenMetadata, opts ...grpc.CallOption) (*MsgRegisterTokenMetadataResponse, error)
+	ApproveBridgeTokenForTrading(ctx context.Context, in *MsgApproveBridgeTokenForTrading, opts ...grpc.CallOption) (*MsgApproveBridgeTokenForTradingResponse, error)
+	RequestBridgeWithdrawal(ctx context.Context, in *MsgRequestBridgeWithdrawal, opts ...grpc.CallOption) (*MsgRequestBridgeWithdrawalResponse, error)
+	RequestBridgeMint(ctx context.Context, in *MsgRequestBridgeMint, opts ...grpc.CallOption) (*MsgRequestBridgeMintResponse, error)
+	SetBridgeRateLimit(ctx context.Context, in *MsgSetBridgeRateLimit, opts ...grpc.CallOption) (*MsgSetBridgeRateLimitResponse, error)
+	SetBridgePaused(ctx context.Context, in *MsgSetBridgePaused, opts ...grpc.CallOption) (*MsgSetBridgePausedResponse, error)
+	RegisterWrappedTokenContract(ctx context.Context, in *MsgRegisterWrappedTokenContract, opts ...grpc.CallOption) (*MsgRegisterWrappedTokenContractResponse, error)
+	MigrateAllWrappedTokens(ctx context.Context, in *MsgMigrateAllWrappedTokens, opts ...grpc.CallOption) (*MsgMigrateAllWrappedTokensResponse, error)
+	AddUserToTrainingAllowList(ctx context.Context, in *MsgAddUserToTrainingAllowList, opts ...grpc.CallOption) (*MsgAddUserToTrainingAllowListResponse, error)
+	RemoveUserFromTrainingAllowList(ctx context.Context, in *MsgRemoveUserFromTrainingAllowList, opts ...grpc.CallOption) (*MsgRemoveUserFromTrainingAllowListResponse, error)
+	SetTrainingAllowList(ctx context.Context, in *MsgSetTrainingAllowList, opts ...grpc.CallOption) (*MsgSetTrainingAllowListResponse, error)
+	AddParticipantsToAllowList(ctx context.Context, in *MsgAddParticipantsToAllowList, opts ...grpc.CallOption) (*MsgAddParticipantsToAllowListResponse, error)
+	RemoveParticipantsFromAllowList(ctx context.Context, in *MsgRemoveParticipantsFromAllowList, opts ...grpc.CallOption) (*MsgRemoveParticipantsFromAllowListResponse, error)
+	AppealInvalidation(ctx context.Context, in *MsgAppealInvalidation, opts ...grpc.CallOption) (*MsgAppealInvalidationResponse, error)
+	SubmitAppealVote(ctx context.Context, in *MsgSubmitAppealVote, opts ...grpc.CallOption) (*MsgSubmitAppealVoteResponse, error)
+	SetModelStatus(ctx context.Context, in *MsgSetModelStatus, opts ...grpc.CallOption) (*MsgSetModelStatusResponse, error)
+	SetModelAlias(ctx context.Context, in *MsgSetModelAlias, opts ...grpc.CallOption) (*MsgSetModelAliasResponse, error)
+	AttestBridgeBlock(ctx context.Context, in *MsgAttestBridgeBlock, opts ...grpc.CallOption) (*MsgAttestBridgeBlockResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_AddParticipantsToAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddParticipantsToAllowList)
-	if err := dec(in); err != nil {
+func (c *msgClient) StartInference(ctx context.Context, in *MsgStartInference, opts ...grpc.CallOption) (*MsgStartInferenceResponse, error) {
+	out := new(MsgStartInferenceResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/StartInference", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddParticipantsToAllowList(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) FinishInference(ctx context.Context, in *MsgFinishInference, opts ...grpc.CallOption) (*MsgFinishInferenceResponse, error) {
+	out := new(MsgFinishInferenceResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/FinishInference", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/AddParticipantsToAllowList",
+	return out, nil
+}
+
+func (c *msgClient) SubmitNewParticipant(ctx context.Context, in *MsgSubmitNewParticipant, opts ...grpc.CallOption) (*MsgSubmitNewParticipantResponse, error) {
+	out := new(MsgSubmitNewParticipantResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitNewParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddParticipantsToAllowList(ctx, req.(*MsgAddParticipantsToAllowList))
+	return out, nil
+}
+
+func (c *msgClient) Validation(ctx context.Context, in *MsgValidation, opts ...grpc.CallOption) (*MsgValidationResponse, error) {
+	out := new(MsgValidationResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/Validation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RemoveParticipantsFromAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveParticipantsFromAllowList)
-	if err := dec(in); err != nil {
+func (c *msgClient) SubmitNewUnfundedParticipant(ctx context.Context, in *MsgSubmitNewUnfundedParticipant, opts ...grpc.CallOption) (*MsgSubmitNewUnfundedParticipantResponse, error) {
+	out := new(MsgSubmitNewUnfundedParticipantResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitNewUnfundedParticipant", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveParticipantsFromAllowList(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) InvalidateInference(ctx context.Context, in *MsgInvalidateInference, opts ...grpc.CallOption) (*MsgInvalidateInferenceResponse, error) {
+	out := new(MsgInvalidateInferenceResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/InvalidateInference", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RemoveParticipantsFromAllowList",
+	return out, nil
+}
+
+func (c *msgClient) RevalidateInference(ctx context.Context, in *MsgRevalidateInference, opts ...grpc.CallOption) (*MsgRevalidateInferenceResponse, error) {
+	out := new(MsgRevalidateInferenceResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RevalidateInference", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveParticipantsFromAllowList(ctx, req.(*MsgRemoveParticipantsFromAllowList))
+	return out, nil
+}
+
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "inference.inference.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "StartInference",
-			Handler:    _Msg_StartInference_Handler,
-		},
-		{
-			MethodName: "FinishInference",
-			Handler:    _Msg_FinishInference_Handler,
-		},
-		{
-			MethodName: "SubmitNewParticipant",
-			Handler:    _Msg_SubmitNewParticipant_Handler,
-		},
-		{
-			MethodName: "Validation",
-			Handler:    _Msg_Validation_Handler,
-		},
-		{
-			MethodName: "SubmitNewUnfundedParticipant",
-			Handler:    _Msg_SubmitNewUnfundedParticipant_Handler,
-		},
-		{
-			MethodName: "InvalidateInference",
-			Handler:    _Msg_InvalidateInference_Handler,
-		},
-		{
-			MethodName: "RevalidateInference",
-			Handler:    _Msg_RevalidateInference_Handler,
-		},
-		{
-			MethodName: "ClaimRewards",
-			Handler:    _Msg_ClaimRewards_Handler,
-		},
-		{
-			MethodName: "SubmitPocBatch",
-			Handler:    _Msg_SubmitPocBatch_Handler,
-		},
-		{
-			MethodName: "SubmitPocValidation",
-			Handler:    _Msg_SubmitPocValidation_Handler,
-		},
-		{
-			MethodName: "SubmitSeed",
-			Handler:    _Msg_SubmitSeed_Handler,
-		},
-		{
-			MethodName: "SubmitUnitOfComputePriceProposal",
-			Handler:    _Msg_SubmitUnitOfComputePriceProposal_Handler,
-		},
-		{
-			MethodName: "RegisterModel",
-			Handler:    _Msg_RegisterModel_Handler,
-		},
-		{
-			MethodName: "CreateTrainingTask",
-			Handler:    _Msg_CreateTrainingTask_Handler,
-		},
-		{
-			MethodName: "SubmitHardwareDiff",
-			Handler:    _Msg_SubmitHardwareDiff_Handler,
-		},
-		{
-			MethodName: "CreatePartialUpgrade",
-			Handler:    _Msg_CreatePartialUpgrade_Handler,
-		},
-		{
-			MethodName: "ClaimTrainingTaskForAssignment",
-			Handler:    _Msg_ClaimTrainingTaskForAssignment_Handler,
-		},
-		{
-			MethodName: "AssignTrainingTask",
-			Handler:    _Msg_AssignTrainingTask_Handler,
-		},
-		{
-			MethodName: "SubmitTrainingKvRecord",
-			Handler:    _Msg_SubmitTrainingKvRecord_Handler,
-		},
-		{
-			MethodName: "JoinTraining",
-			Handler:    _Msg_JoinTraining_Handler,
-		},
-		{
-			MethodName: "TrainingHeartbeat",
-			Handler:    _Msg_TrainingHeartbeat_Handler,
-		},
-		{
-			MethodName: "SetBarrier",
-			Handler:    _Msg_SetBarrier_Handler,
-		},
-		{
-			MethodName: "JoinTrainingStatus",
-			Handler:    _Msg_JoinTrainingStatus_Handler,
-		},
-		{
-			MethodName: "CreateDummyTrainingTask",
-			Handler:    _Msg_CreateDummyTrainingTask_Handler,
-		},
-		{
-			MethodName: "BridgeExchange",
-			Handler:    _Msg_BridgeExchange_Handler,
-		},
-		{
-			MethodName: "RegisterBridgeAddresses",
-			Handler:    _Msg_RegisterBridgeAddresses_Handler,
-		},
-		{
-			MethodName: "RegisterLiquidityPool",
-			Handler:    _Msg_RegisterLiquidityPool_Handler,
-		},
-		{
-			MethodName: "RegisterTokenMetadata",
-			Handler:    _Msg_RegisterTokenMetadata_Handler,
-		},
-		{
-			MethodName: "ApproveBridgeTokenForTrading",
-			Handler:    _Msg_ApproveBridgeTokenForTrading_Handler,
-		},
-		{
-			MethodName: "RequestBridgeWithdrawal",
-			Handler:    _Msg_RequestBridgeWithdrawal_Handler,
-		},
-		{
-			MethodName: "RequestBridgeMint",
-			Handler:    _Msg_RequestBridgeMint_Handler,
-		},
-		{
-			MethodName: "RegisterWrappedTokenContract",
-			Handler:    _Msg_RegisterWrappedTokenContract_Handler,
-		},
-		{
-			MethodName: "MigrateAllWrappedTokens",
-			Handler:    _Msg_MigrateAllWrappedTokens_Handler,
-		},
-		{
-			MethodName: "AddUserToTrainingAllowList",
-			Handler:    _Msg_AddUserToTrainingAllowList_Handler,
-		},
-		{
-			MethodName: "RemoveUserFromTrainingAllowList",
-			Handler:    _Msg_RemoveUserFromTrainingAllowList_Handler,
-		},
-		{
-			MethodName: "SetTrainingAllowList",
-			Handler:    _Msg_SetTrainingAllowList_Handler,
-		},
-		{
-			MethodName: "AddParticipantsToAllowList",
-			Handler:    _Msg_AddParticipantsToAllowList_Handler,
-		},
-		{
-			MethodName: "RemoveParticipantsFromAllowList",
-			Handler:    _Msg_RemoveParticipantsFromAllowList_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "inference/inference/tx.proto",
+func (c *msgClient) SubmitPocBatch(ctx context.Context, in *MsgSubmitPocBatch, opts ...grpc.CallOption) (*MsgSubmitPocBatchResponse, error) {
+	out := new(MsgSubmitPocBatchResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitPocBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitPocValidation(ctx context.Context, in *MsgSubmitPocValidation, opts ...grpc.CallOption) (*MsgSubmitPocValidationResponse, error) {
+	out := new(MsgSubmitPocValidationResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitPocValidation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitSeed(ctx context.Context, in *MsgSubmitSeed, opts ...grpc.CallOption) (*MsgSubmitSeedResponse, error) {
+	out := new(MsgSubmitSeedResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitSeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SubmitUnitOfComputePriceProposal(ctx context.Context, in *MsgSubmitUnitOfComputePriceProposal, opts ...grpc.CallOption) (*MsgSubmitUnitOfComputePriceProposalResponse, error) {
+	out := new(MsgSubmitUnitOfComputePriceProposalResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitUnitOfComputePriceProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterModel(ctx context.Context, in *MsgRegisterModel, opts ...grpc.CallOption) (*MsgRegisterModelResponse, error) {
+	out := new(MsgRegisterModelResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterModel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateTrainingTask(ctx context.Context, in *MsgCreateTrainingTask, opts ...grpc.CallOption) (*MsgCreateTrainingTaskResponse, error) {
+	out := new(MsgCreateTrainingTaskResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreateTrainingTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SubmitHardwareDiff(ctx context.Context, in *MsgSubmitHardwareDiff, opts ...grpc.CallOption) (*MsgSubmitHardwareDiffResponse, error) {
+	out := new(MsgSubmitHardwareDiffResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitHardwareDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgStartInference) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreatePartialUpgrade(ctx context.Context, in *MsgCreatePartialUpgrade, opts ...grpc.CallOption) (*MsgCreatePartialUpgradeResponse, error) {
+	out := new(MsgCreatePartialUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreatePartialUpgrade", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgStartInference) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ClaimTrainingTaskForAssignment(ctx context.Context, in *MsgClaimTrainingTaskForAssignment, opts ...grpc.CallOption) (*MsgClaimTrainingTaskForAssignmentResponse, error) {
+	out := new(MsgClaimTrainingTaskForAssignmentResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ClaimTrainingTaskForAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgStartInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OriginalPromptHash) > 0 {
-		i -= len(m.OriginalPromptHash)
-		copy(dAtA[i:], m.OriginalPromptHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPromptHash)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+func (c *msgClient) AssignTrainingTask(ctx context.Context, in *MsgAssignTrainingTask, opts ...grpc.CallOption) (*MsgAssignTrainingTaskResponse, error) {
+	out := new(MsgAssignTrainingTaskResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AssignTrainingTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.OriginalPrompt) > 0 {
-		i -= len(m.OriginalPrompt)
-		copy(dAtA[i:], m.OriginalPrompt)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPrompt)))
-		i--
-		dAtA[i] = 0x7a
+	return out, nil
+}
+
+func (c *msgClient) SettleTrainingPayments(ctx context.Context, in *MsgSettleTrainingPayments, opts ...grpc.CallOption) (*MsgSettleTrainingPaymentsResponse, error) {
+	out := new(MsgSettleTrainingPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SettleTrainingPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.TransferSignature) > 0 {
-		i -= len(m.TransferSignature)
-		copy(dAtA[i:], m.TransferSignature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferSignature)))
-		i--
-		dAtA[i] = 0x72
+	return out, nil
+}
+
+func (c *msgClient) FinishTrainingTask(ctx context.Context, in *MsgFinishTrainingTask, opts ...grpc.CallOption) (*MsgFinishTrainingTaskResponse, error) {
+	out := new(MsgFinishTrainingTaskResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/FinishTrainingTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.RequestTimestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestTimestamp))
-		i--
-		dAtA[i] = 0x60
+	return out, nil
+}
+
+func (c *msgClient) CancelTrainingTask(ctx context.Context, in *MsgCancelTrainingTask, opts ...grpc.CallOption) (*MsgCancelTrainingTaskResponse, error) {
+	out := new(MsgCancelTrainingTaskResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CancelTrainingTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PromptTokenCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PromptTokenCount))
-		i--
-		dAtA[i] = 0x58
+	return out, nil
+}
+
+func (c *msgClient) SubmitTrainingKvRecord(ctx context.Context, in *MsgSubmitTrainingKvRecord, opts ...grpc.CallOption) (*MsgSubmitTrainingKvRecordResponse, error) {
+	out := new(MsgSubmitTrainingKvRecordResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitTrainingKvRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.MaxTokens != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxTokens))
-		i--
-		dAtA[i] = 0x50
+	return out, nil
+}
+
+func (c *msgClient) JoinTraining(ctx context.Context, in *MsgJoinTraining, opts ...grpc.CallOption) (*MsgJoinTrainingResponse, error) {
+	out := new(MsgJoinTrainingResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/JoinTraining", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.NodeVersion) > 0 {
-		i -= len(m.NodeVersion)
-		copy(dAtA[i:], m.NodeVersion)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeVersion)))
-		i--
-		dAtA[i] = 0x4a
+	return out, nil
+}
+
+func (c *msgClient) TrainingHeartbeat(ctx context.Context, in *MsgTrainingHeartbeat, opts ...grpc.CallOption) (*MsgTrainingHeartbeatResponse, error) {
+	out := new(MsgTrainingHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/TrainingHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.AssignedTo) > 0 {
-		i -= len(m.AssignedTo)
-		copy(dAtA[i:], m.AssignedTo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AssignedTo)))
-		i--
-		dAtA[i] = 0x42
+	return out, nil
+}
+
+func (c *msgClient) SetBarrier(ctx context.Context, in *MsgSetBarrier, opts ...grpc.CallOption) (*MsgSetBarrierResponse, error) {
+	out := new(MsgSetBarrierResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetBarrier", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RequestedBy) > 0 {
-		i -= len(m.RequestedBy)
-		copy(dAtA[i:], m.RequestedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestedBy)))
-		i--
-		dAtA[i] = 0x3a
+	return out, nil
+}
+
+func (c *msgClient) JoinTrainingStatus(ctx context.Context, in *MsgJoinTrainingStatus, opts ...grpc.CallOption) (*MsgJoinTrainingStatusResponse, error) {
+	out := new(MsgJoinTrainingStatusResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/JoinTrainingStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Model) > 0 {
-		i -= len(m.Model)
-		copy(dAtA[i:], m.Model)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Model)))
-		i--
-		dAtA[i] = 0x32
+	return out, nil
+}
+
+func (c *msgClient) CreateDummyTrainingTask(ctx context.Context, in *MsgCreateDummyTrainingTask, opts ...grpc.CallOption) (*MsgCreateDummyTrainingTaskResponse, error) {
+	out := new(MsgCreateDummyTrainingTaskResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreateDummyTrainingTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PromptPayload) > 0 {
-		i -= len(m.PromptPayload)
-		copy(dAtA[i:], m.PromptPayload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptPayload)))
-		i--
-		dAtA[i] = 0x22
+	return out, nil
+}
+
+func (c *msgClient) BridgeExchange(ctx context.Context, in *MsgBridgeExchange, opts ...grpc.CallOption) (*MsgBridgeExchangeResponse, error) {
+	out := new(MsgBridgeExchangeResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/BridgeExchange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PromptHash) > 0 {
-		i -= len(m.PromptHash)
-		copy(dAtA[i:], m.PromptHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptHash)))
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+func (c *msgClient) RegisterBridgeAddresses(ctx context.Context, in *MsgRegisterBridgeAddresses, opts ...grpc.CallOption) (*MsgRegisterBridgeAddressesResponse, error) {
+	out := new(MsgRegisterBridgeAddressesResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterBridgeAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.InferenceId) > 0 {
-		i -= len(m.InferenceId)
-		copy(dAtA[i:], m.InferenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) RegisterLiquidityPool(ctx context.Context, in *MsgRegisterLiquidityPool, opts ...grpc.CallOption) (*MsgRegisterLiquidityPoolResponse, error) {
+	out := new(MsgRegisterLiquidityPoolResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterLiquidityPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) RegisterTokenMetadata(ctx context.Context, in *MsgRegisterTokenMetadata, opts ...grpc.CallOption) (*MsgRegisterTokenMetadataResponse, error) {
+	out := new(MsgRegisterTokenMetadataResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterTokenMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgStartInferenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ApproveBridgeTokenForTrading(ctx context.Context, in *MsgApproveBridgeTokenForTrading, opts ...grpc.CallOption) (*MsgApproveBridgeTokenForTradingResponse, error) {
+	out := new(MsgApproveBridgeTokenForTradingResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ApproveBridgeTokenForTrading", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgStartInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RequestBridgeWithdrawal(ctx context.Context, in *MsgRequestBridgeWithdrawal, opts ...grpc.CallOption) (*MsgRequestBridgeWithdrawalResponse, error) {
+	out := new(MsgRequestBridgeWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RequestBridgeWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgStartInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ErrorMessage) > 0 {
-		i -= len(m.ErrorMessage)
-		copy(dAtA[i:], m.ErrorMessage)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ErrorMessage)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) RequestBridgeMint(ctx context.Context, in *MsgRequestBridgeMint, opts ...grpc.CallOption) (*MsgRequestBridgeMintResponse, error) {
+	out := new(MsgRequestBridgeMintResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RequestBridgeMint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.InferenceIndex) > 0 {
-		i -= len(m.InferenceIndex)
-		copy(dAtA[i:], m.InferenceIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceIndex)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) SetBridgeRateLimit(ctx context.Context, in *MsgSetBridgeRateLimit, opts ...grpc.CallOption) (*MsgSetBridgeRateLimitResponse, error) {
+	out := new(MsgSetBridgeRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetBridgeRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgFinishInference) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetBridgePaused(ctx context.Context, in *MsgSetBridgePaused, opts ...grpc.CallOption) (*MsgSetBridgePausedResponse, error) {
+	out := new(MsgSetBridgePausedResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetBridgePaused", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgFinishInference) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterWrappedTokenContract(ctx context.Context, in *MsgRegisterWrappedTokenContract, opts ...grpc.CallOption) (*MsgRegisterWrappedTokenContractResponse, error) {
+	out := new(MsgRegisterWrappedTokenContractResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterWrappedTokenContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFinishInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OriginalPromptHash) > 0 {
-		i -= len(m.OriginalPromptHash)
-		copy(dAtA[i:], m.OriginalPromptHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPromptHash)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if len(m.PromptHash) > 0 {
-		i -= len(m.PromptHash)
-		copy(dAtA[i:], m.PromptHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptHash)))
-		i--
-		dAtA[i] = 0x7a
-	}
-	if len(m.Model) > 0 {
-		i -= len(m.Model)
-		copy(dAtA[i:], m.Model)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Model)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.OriginalPrompt) > 0 {
-		i -= len(m.OriginalPrompt)
-		copy(dAtA[i:], m.OriginalPrompt)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPrompt)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.RequestedBy) > 0 {
-		i -= len(m.RequestedBy)
-		copy(dAtA[i:], m.RequestedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestedBy)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.ExecutorSignature) > 0 {
-		i -= len(m.ExecutorSignature)
-		copy(dAtA[i:], m.ExecutorSignature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutorSignature)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.TransferSignature) > 0 {
-		i -= len(m.TransferSignature)
-		copy(dAtA[i:], m.TransferSignature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferSignature)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.RequestTimestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestTimestamp))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.TransferredBy) > 0 {
-		i -= len(m.TransferredBy)
-		copy(dAtA[i:], m.TransferredBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferredBy)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ExecutedBy) > 0 {
-		i -= len(m.ExecutedBy)
-		copy(dAtA[i:], m.ExecutedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutedBy)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.CompletionTokenCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CompletionTokenCount))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.PromptTokenCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PromptTokenCount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.ResponsePayload) > 0 {
-		i -= len(m.ResponsePayload)
-		copy(dAtA[i:], m.ResponsePayload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponsePayload)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ResponseHash) > 0 {
-		i -= len(m.ResponseHash)
-		copy(dAtA[i:], m.ResponseHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.InferenceId) > 0 {
-		i -= len(m.InferenceId)
-		copy(dAtA[i:], m.InferenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) MigrateAllWrappedTokens(ctx context.Context, in *MsgMigrateAllWrappedTokens, opts ...grpc.CallOption) (*MsgMigrateAllWrappedTokensResponse, error) {
+	out := new(MsgMigrateAllWrappedTokensResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/MigrateAllWrappedTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgFinishInferenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddUserToTrainingAllowList(ctx context.Context, in *MsgAddUserToTrainingAllowList, opts ...grpc.CallOption) (*MsgAddUserToTrainingAllowListResponse, error) {
+	out := new(MsgAddUserToTrainingAllowListResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AddUserToTrainingAllowList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgFinishInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgFinishInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ErrorMessage) > 0 {
-		i -= len(m.ErrorMessage)
-		copy(dAtA[i:], m.ErrorMessage)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ErrorMessage)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.InferenceIndex) > 0 {
-		i -= len(m.InferenceIndex)
-		copy(dAtA[i:], m.InferenceIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceIndex)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RemoveUserFromTrainingAllowList(ctx context.Context, in *MsgRemoveUserFromTrainingAllowList, opts ...grpc.CallOption) (*MsgRemoveUserFromTrainingAllowListResponse, error) {
+	out := new(MsgRemoveUserFromTrainingAllowListResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RemoveUserFromTrainingAllowList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSubmitNewParticipant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetTrainingAllowList(ctx context.Context, in *MsgSetTrainingAllowList, opts ...grpc.CallOption) (*MsgSetTrainingAllowListResponse, error) {
+	out := new(MsgSetTrainingAllowListResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetTrainingAllowList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSubmitNewParticipant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddParticipantsToAllowList(ctx context.Context, in *MsgAddParticipantsToAllowList, opts ...grpc.CallOption) (*MsgAddParticipantsToAllowListResponse, error) {
+	out := new(MsgAddParticipantsToAllowListResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AddParticipantsToAllowList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSubmitNewParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WorkerKey) > 0 {
-		i -= len(m.WorkerKey)
-		copy(dAtA[i:], m.WorkerKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WorkerKey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ValidatorKey) > 0 {
-		i -= len(m.ValidatorKey)
-		copy(dAtA[i:], m.ValidatorKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorKey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RemoveParticipantsFromAllowList(ctx context.Context, in *MsgRemoveParticipantsFromAllowList, opts ...grpc.CallOption) (*MsgRemoveParticipantsFromAllowListResponse, error) {
+	out := new(MsgRemoveParticipantsFromAllowListResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RemoveParticipantsFromAllowList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSubmitNewParticipantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AppealInvalidation(ctx context.Context, in *MsgAppealInvalidation, opts ...grpc.CallOption) (*MsgAppealInvalidationResponse, error) {
+	out := new(MsgAppealInvalidationResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AppealInvalidation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSubmitNewParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitAppealVote(ctx context.Context, in *MsgSubmitAppealVote, opts ...grpc.CallOption) (*MsgSubmitAppealVoteResponse, error) {
+	out := new(MsgSubmitAppealVoteResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitAppealVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSubmitNewParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ParticipantIndex) > 0 {
-		i -= len(m.ParticipantIndex)
-		copy(dAtA[i:], m.ParticipantIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ParticipantIndex)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetModelStatus(ctx context.Context, in *MsgSetModelStatus, opts ...grpc.CallOption) (*MsgSetModelStatusResponse, error) {
+	out := new(MsgSetModelStatusResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetModelStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgValidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetModelAlias(ctx context.Context, in *MsgSetModelAlias, opts ...grpc.CallOption) (*MsgSetModelAliasResponse, error) {
+	out := new(MsgSetModelAliasResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetModelAlias", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgValidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AttestBridgeBlock(ctx context.Context, in *MsgAttestBridgeBlock, opts ...grpc.CallOption) (*MsgAttestBridgeBlockResponse, error) {
+	out := new(MsgAttestBridgeBlockResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AttestBridgeBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Revalidation {
-		i--
-		if m.Revalidation {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Value != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
-		i--
-		dAtA[i] = 0x31
-	}
-	if len(m.ResponseHash) > 0 {
-		i -= len(m.ResponseHash)
-		copy(dAtA[i:], m.ResponseHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ResponsePayload) > 0 {
-		i -= len(m.ResponsePayload)
-		copy(dAtA[i:], m.ResponsePayload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponsePayload)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.InferenceId) > 0 {
-		i -= len(m.InferenceId)
-		copy(dAtA[i:], m.InferenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	StartInference(context.Context, *MsgStartInference) (*MsgStartInferenceResponse, error)
+	FinishInference(context.Context, *MsgFinishInference) (*MsgFinishInferenceResponse, error)
+	SubmitNewParticipant(context.Context, *MsgSubmitNewParticipant) (*MsgSubmitNewParticipantResponse, error)
+	Validation(context.Context, *MsgValidation) (*MsgValidationResponse, error)
+	SubmitNewUnfundedParticipant(context.Context, *MsgSubmitNewUnfundedParticipant) (*MsgSubmitNewUnfundedParticipantResponse, error)
+	InvalidateInference(context.Context, *MsgInvalidateInference) (*MsgInvalidateInferenceResponse, error)
+	RevalidateInference(context.Context, *MsgRevalidateInference) (*MsgRevalidateInferenceResponse, error)
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	SubmitPocBatch(context.Context, *MsgSubmitPocBatch) (*MsgSubmitPocBatchResponse, error)
+	SubmitPocValidation(context.Context, *MsgSubmitPocValidation) (*MsgSubmitPocValidationResponse, error)
+	SubmitSeed(context.Context, *MsgSubmitSeed) (*MsgSubmitSeedResponse, error)
+	SubmitUnitOfComputePriceProposal(context.Context, *MsgSubmitUnitOfComputePriceProposal) (*MsgSubmitUnitOfComputePriceProposalResponse, error)
+	RegisterModel(context.Context, *MsgRegisterModel) (*MsgRegisterModelResponse, error)
+	CreateTrainingTask(context.Context, *MsgCreateTrainingTask) (*MsgCreateTrainingTaskResponse, error)
+	SubmitHardwareDiff(context.Context, *MsgSubmitHardwareDiff) (*MsgSubmitHardwareDiffResponse, error)
+	CreatePartialUpgrade(context.Context, *MsgCreatePartialUpgrade) (*MsgCreatePartialUpgradeResponse, error)
+	ClaimTrainingTaskForAssignment(context.Context, *MsgClaimTrainingTaskForAssignment) (*MsgClaimTrainingTaskForAssignmentResponse, error)
+	AssignTrainingTask(context.Context, *MsgAssignTrainingTask) (*MsgAssignTrainingTaskResponse, error)
+	SettleTrainingPayments(context.Context, *MsgSettleTrainingPayments) (*MsgSettleTrainingPaymentsResponse, error)
+	FinishTrainingTask(context.Context, *MsgFinishTrainingTask) (*MsgFinishTrainingTaskResponse, error)
+	CancelTrainingTask(context.Context, *MsgCancelTrainingTask) (*MsgCancelTrainingTaskResponse, error)
+	SubmitTrainingKvRecord(context.Context, *MsgSubmitTrainingKvRecord) (*MsgSubmitTrainingKvRecordResponse, error)
+	JoinTraining(context.Context, *MsgJoinTraining) (*MsgJoinTrainingResponse, error)
+	TrainingHeartbeat(context.Context, *MsgTrainingHeartbeat) (*MsgTrainingHeartbeatResponse, error)
+	SetBarrier(context.Context, *MsgSetBarrier) (*MsgSetBarrierResponse, error)
+	JoinTrainingStatus(context.Context, *MsgJoinTrainingStatus) (*MsgJoinTrainingStatusResponse, error)
+	CreateDummyTrainingTask(context.Context, *MsgCreateDummyTrainingTask) (*MsgCreateDummyTrainingTaskResponse, error)
+	BridgeExchange(context.Context, *MsgBridgeExchange) (*MsgBridgeExchangeResponse, error)
+	RegisterBridgeAddresses(context.Context, *MsgRegisterBridgeAddresses) (*MsgRegisterBridgeAddressesResponse, error)
+	RegisterLiquidityPool(context.Context, *MsgRegisterLiquidityPool) (*MsgRegisterLiquidityPoolResponse, error)
+	RegisterTokenMetadata(context.Context, *MsgRegisterTokenMetadata) (*MsgRegisterTokenMetadataResponse, error)
+	ApproveBridgeTokenForTrading(context.Context, *MsgApproveBridgeTokenForTrading) (*MsgApproveBridgeTokenForTradingResponse, error)
+	RequestBridgeWithdrawal(context.Context, *MsgRequestBridgeWithdrawal) (*MsgRequestBridgeWithdrawalResponse, error)
+	RequestBridgeMint(context.Context, *MsgRequestBridgeMint) (*MsgRequestBridgeMintResponse, error)
+	SetBridgeRateLimit(context.Context, *MsgSetBridgeRateLimit) (*MsgSetBridgeRateLimitResponse, error)
+	SetBridgePaused(context.Context, *MsgSetBridgePaused) (*MsgSetBridgePausedResponse, error)
+	RegisterWrappedTokenContract(context.Context, *MsgRegisterWrappedTokenContract) (*MsgRegisterWrappedTokenContractResponse, error)
+	MigrateAllWrappedTokens(context.Context, *MsgMigrateAllWrappedTokens) (*MsgMigrateAllWrappedTokensResponse, error)
+	AddUserToTrainingAllowList(context.Context, *MsgAddUserToTrainingAllowList) (*MsgAddUserToTrainingAllowListResponse, error)
+	RemoveUserFromTrainingAllowList(context.Context, *MsgRemoveUserFromTrainingAllowList) (*MsgRemoveUserFromTrainingAllowListResponse, error)
+	SetTrainingAllowList(context.Context, *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error)
+	AddParticipantsToAllowList(context.Context, *MsgAddParticipantsToAllowList) (*MsgAddParticipantsToAllowListResponse, error)
+	RemoveParticipantsFromAllowList(context.Context, *MsgRemoveParticipantsFromAllowList) (*MsgRemoveParticipantsFromAllowListResponse, error)
+	AppealInvalidation(context.Context, *MsgAppealInvalidation) (*MsgAppealInvalidationResponse, error)
+	SubmitAppealVote(context.Context, *MsgSubmitAppealVote) (*MsgSubmitAppealVoteResponse, error)
+	SetModelStatus(context.Context, *MsgSetModelStatus) (*MsgSetModelStatusResponse, error)
+	SetModelAlias(context.Context, *MsgSetModelAlias) (*MsgSetModelAliasResponse, error)
+	AttestBridgeBlock(context.Context, *MsgAttestBridgeBlock) (*MsgAttestBridgeBlockResponse, error)
 }
 
-func (m *MsgValidationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgValidationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
-
-func (m *MsgValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) StartInference(ctx context.Context, req *MsgStartInference) (*MsgStartInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartInference not implemented")
 }
-
-func (m *MsgSubmitNewUnfundedParticipant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) FinishInference(ctx context.Context, req *MsgFinishInference) (*MsgFinishInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishInference not implemented")
 }
-
-func (m *MsgSubmitNewUnfundedParticipant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) SubmitNewParticipant(ctx context.Context, req *MsgSubmitNewParticipant) (*MsgSubmitNewParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitNewParticipant not implemented")
 }
-
-func (m *MsgSubmitNewUnfundedParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WorkerKey) > 0 {
-		i -= len(m.WorkerKey)
-		copy(dAtA[i:], m.WorkerKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WorkerKey)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ValidatorKey) > 0 {
-		i -= len(m.ValidatorKey)
-		copy(dAtA[i:], m.ValidatorKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorKey)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) Validation(ctx context.Context, req *MsgValidation) (*MsgValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validation not implemented")
 }
-
-func (m *MsgSubmitNewUnfundedParticipantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) SubmitNewUnfundedParticipant(ctx context.Context, req *MsgSubmitNewUnfundedParticipant) (*MsgSubmitNewUnfundedParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitNewUnfundedParticipant not implemented")
 }
-
-func (m *MsgSubmitNewUnfundedParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) InvalidateInference(ctx context.Context, req *MsgInvalidateInference) (*MsgInvalidateInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateInference not implemented")
 }
-
-func (m *MsgSubmitNewUnfundedParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) RevalidateInference(ctx context.Context, req *MsgRevalidateInference) (*MsgRevalidateInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevalidateInference not implemented")
 }
-
-func (m *MsgInvalidateInference) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
 }
-
-func (m *MsgInvalidateInference) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) SubmitPocBatch(ctx context.Context, req *MsgSubmitPocBatch) (*MsgSubmitPocBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPocBatch not implemented")
 }
-
-func (m *MsgInvalidateInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Invalidator) > 0 {
-		i -= len(m.Invalidator)
-		copy(dAtA[i:], m.Invalidator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Invalidator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.InferenceId) > 0 {
-		i -= len(m.InferenceId)
-		copy(dAtA[i:], m.InferenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) SubmitPocValidation(ctx context.Context, req *MsgSubmitPocValidation) (*MsgSubmitPocValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPocValidation not implemented")
 }
-
-func (m *MsgInvalidateInferenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) SubmitSeed(ctx context.Context, req *MsgSubmitSeed) (*MsgSubmitSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSeed not implemented")
 }
-
-func (m *MsgInvalidateInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) SubmitUnitOfComputePriceProposal(ctx context.Context, req *MsgSubmitUnitOfComputePriceProposal) (*MsgSubmitUnitOfComputePriceProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitUnitOfComputePriceProposal not implemented")
 }
-
-func (m *MsgInvalidateInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) RegisterModel(ctx context.Context, req *MsgRegisterModel) (*MsgRegisterModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterModel not implemented")
 }
-
-func (m *MsgRevalidateInference) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateTrainingTask(ctx context.Context, req *MsgCreateTrainingTask) (*MsgCreateTrainingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrainingTask not implemented")
+}
+func (*UnimplementedMsgServer) SubmitHardwareDiff(ctx context.Context, req *MsgSubmitHardwareDiff) (*MsgSubmitHardwareDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitHardwareDiff not implemented")
+}
+func (*UnimplementedMsgServer) CreatePartialUpgrade(ctx context.Context, req *MsgCreatePartialUpgrade) (*MsgCreatePartialUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePartialUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) ClaimTrainingTaskForAssignment(ctx context.Context, req *MsgClaimTrainingTaskForAssignment) (*MsgClaimTrainingTaskForAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimTrainingTaskForAssignment not implemented")
+}
+func (*UnimplementedMsgServer) AssignTrainingTask(ctx context.Context, req *MsgAssignTrainingTask) (*MsgAssignTrainingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTrainingTask not implemented")
+}
+func (*UnimplementedMsgServer) SettleTrainingPayments(ctx context.Context, req *MsgSettleTrainingPayments) (*MsgSettleTrainingPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleTrainingPayments not implemented")
+}
+func (*UnimplementedMsgServer) FinishTrainingTask(ctx context.Context, req *MsgFinishTrainingTask) (*MsgFinishTrainingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishTrainingTask not implemented")
+}
+func (*UnimplementedMsgServer) CancelTrainingTask(ctx context.Context, req *MsgCancelTrainingTask) (*MsgCancelTrainingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTrainingTask not implemented")
+}
+func (*UnimplementedMsgServer) SubmitTrainingKvRecord(ctx context.Context, req *MsgSubmitTrainingKvRecord) (*MsgSubmitTrainingKvRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTrainingKvRecord not implemented")
+}
+func (*UnimplementedMsgServer) JoinTraining(ctx context.Context, req *MsgJoinTraining) (*MsgJoinTrainingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTraining not implemented")
+}
+func (*UnimplementedMsgServer) TrainingHeartbeat(ctx context.Context, req *MsgTrainingHeartbeat) (*MsgTrainingHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrainingHeartbeat not implemented")
+}
+func (*UnimplementedMsgServer) SetBarrier(ctx context.Context, req *MsgSetBarrier) (*MsgSetBarrierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBarrier not implemented")
+}
+func (*UnimplementedMsgServer) JoinTrainingStatus(ctx context.Context, req *MsgJoinTrainingStatus) (*MsgJoinTrainingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTrainingStatus not implemented")
+}
+func (*UnimplementedMsgServer) CreateDummyTrainingTask(ctx context.Context, req *MsgCreateDummyTrainingTask) (*MsgCreateDummyTrainingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDummyTrainingTask not implemented")
+}
+func (*UnimplementedMsgServer) BridgeExchange(ctx context.Context, req *MsgBridgeExchange) (*MsgBridgeExchangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeExchange not implemented")
+}
+func (*UnimplementedMsgServer) RegisterBridgeAddresses(ctx context.Context, req *MsgRegisterBridgeAddresses) (*MsgRegisterBridgeAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBridgeAddresses not implemented")
+}
+func (*UnimplementedMsgServer) RegisterLiquidityPool(ctx context.Context, req *MsgRegisterLiquidityPool) (*MsgRegisterLiquidityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterLiquidityPool not implemented")
+}
+func (*UnimplementedMsgServer) RegisterTokenMetadata(ctx context.Context, req *MsgRegisterTokenMetadata) (*MsgRegisterTokenMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTokenMetadata not implemented")
+}
+func (*UnimplementedMsgServer) ApproveBridgeTokenForTrading(ctx context.Context, req *MsgApproveBridgeTokenForTrading) (*MsgApproveBridgeTokenForTradingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveBridgeTokenForTrading not implemented")
+}
+func (*UnimplementedMsgServer) RequestBridgeWithdrawal(ctx context.Context, req *MsgRequestBridgeWithdrawal) (*MsgRequestBridgeWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestBridgeWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) RequestBridgeMint(ctx context.Context, req *MsgRequestBridgeMint) (*MsgRequestBridgeMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestBridgeMint not implemented")
+}
+func (*UnimplementedMsgServer) SetBridgeRateLimit(ctx context.Context, req *MsgSetBridgeRateLimit) (*MsgSetBridgeRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBridgeRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) SetBridgePaused(ctx context.Context, req *MsgSetBridgePaused) (*MsgSetBridgePausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBridgePaused not implemented")
+}
+func (*UnimplementedMsgServer) RegisterWrappedTokenContract(ctx context.Context, req *MsgRegisterWrappedTokenContract) (*MsgRegisterWrappedTokenContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWrappedTokenContract not implemented")
+}
+func (*UnimplementedMsgServer) MigrateAllWrappedTokens(ctx context.Context, req *MsgMigrateAllWrappedTokens) (*MsgMigrateAllWrappedTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateAllWrappedTokens not implemented")
+}
+func (*UnimplementedMsgServer) AddUserToTrainingAllowList(ctx context.Context, req *MsgAddUserToTrainingAllowList) (*MsgAddUserToTrainingAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserToTrainingAllowList not implemented")
+}
+func (*UnimplementedMsgServer) RemoveUserFromTrainingAllowList(ctx context.Context, req *MsgRemoveUserFromTrainingAllowList) (*MsgRemoveUserFromTrainingAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserFromTrainingAllowList not implemented")
+}
+func (*UnimplementedMsgServer) SetTrainingAllowList(ctx context.Context, req *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrainingAllowList not implemented")
+}
+func (*UnimplementedMsgServer) AddParticipantsToAllowList(ctx context.Context, req *MsgAddParticipantsToAllowList) (*MsgAddParticipantsToAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddParticipantsToAllowList not implemented")
+}
+func (*UnimplementedMsgServer) RemoveParticipantsFromAllowList(ctx context.Context, req *MsgRemoveParticipantsFromAllowList) (*MsgRemoveParticipantsFromAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveParticipantsFromAllowList not implemented")
+}
+func (*UnimplementedMsgServer) AppealInvalidation(ctx context.Context, req *MsgAppealInvalidation) (*MsgAppealInvalidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealInvalidation not implemented")
+}
+func (*UnimplementedMsgServer) SubmitAppealVote(ctx context.Context, req *MsgSubmitAppealVote) (*MsgSubmitAppealVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAppealVote not implemented")
+}
+func (*UnimplementedMsgServer) SetModelStatus(ctx context.Context, req *MsgSetModelStatus) (*MsgSetModelStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetModelStatus not implemented")
+}
+func (*UnimplementedMsgServer) SetModelAlias(ctx context.Context, req *MsgSetModelAlias) (*MsgSetModelAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetModelAlias not implemented")
+}
+func (*UnimplementedMsgServer) AttestBridgeBlock(ctx context.Context, req *MsgAttestBridgeBlock) (*MsgAttestBridgeBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestBridgeBlock not implemented")
 }
 
-func (m *MsgRevalidateInference) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgRevalidateInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Invalidator) > 0 {
-		i -= len(m.Invalidator)
-		copy(dAtA[i:], m.Invalidator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Invalidator)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.InferenceId) > 0 {
-		i -= len(m.InferenceId)
-		copy(dAtA[i:], m.InferenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/UpdateParams",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevalidateInferenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_StartInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartInference)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRevalidateInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRevalidateInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).StartInference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/StartInference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartInference(ctx, req.(*MsgStartInference))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_FinishInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinishInference)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(MsgServer).FinishInference(ctx, in)
 	}
-	if m.Seed != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Seed))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/FinishInference",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinishInference(ctx, req.(*MsgFinishInference))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SubmitNewParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitNewParticipant)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Result) > 0 {
-		i -= len(m.Result)
-		copy(dAtA[i:], m.Result)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Result)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitNewParticipant(ctx, in)
 	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitNewParticipant",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitNewParticipant(ctx, req.(*MsgSubmitNewParticipant))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Validation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgValidation)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).Validation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/Validation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Validation(ctx, req.(*MsgValidation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SubmitNewUnfundedParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitNewUnfundedParticipant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitNewUnfundedParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitNewUnfundedParticipant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitNewUnfundedParticipant(ctx, req.(*MsgSubmitNewUnfundedParticipant))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NodeId) > 0 {
-		i -= len(m.NodeId)
-		copy(dAtA[i:], m.NodeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeId)))
-		i--
-		dAtA[i] = 0x32
+func _Msg_InvalidateInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInvalidateInference)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Dist) > 0 {
-		for iNdEx := len(m.Dist) - 1; iNdEx >= 0; iNdEx-- {
-			f2 := math.Float64bits(float64(m.Dist[iNdEx]))
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f2))
-		}
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Dist)*8))
-		i--
-		dAtA[i] = 0x2a
+	if interceptor == nil {
+		return srv.(MsgServer).InvalidateInference(ctx, in)
 	}
-	if len(m.Nonces) > 0 {
-		dAtA4 := make([]byte, len(m.Nonces)*10)
-		var j3 int
-		for _, num1 := range m.Nonces {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x22
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/InvalidateInference",
 	}
-	if len(m.BatchId) > 0 {
-		i -= len(m.BatchId)
-		copy(dAtA[i:], m.BatchId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BatchId)))
-		i--
-		dAtA[i] = 0x1a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InvalidateInference(ctx, req.(*MsgInvalidateInference))
 	}
-	if m.PocStageStartBlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
-		i--
-		dAtA[i] = 0x10
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevalidateInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevalidateInference)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).RevalidateInference(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RevalidateInference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevalidateInference(ctx, req.(*MsgRevalidateInference))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SubmitPocBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPocBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPocBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitPocBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPocBatch(ctx, req.(*MsgSubmitPocBatch))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SubmitPocValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPocValidation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPocValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitPocValidation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPocValidation(ctx, req.(*MsgSubmitPocValidation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocValidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SubmitSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSeed)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSeed(ctx, req.(*MsgSubmitSeed))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocValidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SubmitUnitOfComputePriceProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitUnitOfComputePriceProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitUnitOfComputePriceProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitUnitOfComputePriceProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitUnitOfComputePriceProposal(ctx, req.(*MsgSubmitUnitOfComputePriceProposal))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FraudDetected {
-		i--
-		if m.FraudDetected {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
+func _Msg_RegisterModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterModel)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ProbabilityHonest != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ProbabilityHonest))))
-		i--
-		dAtA[i] = 0x51
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterModel(ctx, in)
 	}
-	if m.NInvalid != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NInvalid))
-		i--
-		dAtA[i] = 0x48
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RegisterModel",
 	}
-	if m.FraudThreshold != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FraudThreshold))))
-		i--
-		dAtA[i] = 0x41
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterModel(ctx, req.(*MsgRegisterModel))
 	}
-	if m.RTarget != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RTarget))))
-		i--
-		dAtA[i] = 0x39
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTrainingTask)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ReceivedDist) > 0 {
-		for iNdEx := len(m.ReceivedDist) - 1; iNdEx >= 0; iNdEx-- {
-			f5 := math.Float64bits(float64(m.ReceivedDist[iNdEx]))
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f5))
-		}
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceivedDist)*8))
-		i--
-		dAtA[i] = 0x32
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTrainingTask(ctx, in)
 	}
-	if len(m.Dist) > 0 {
-		for iNdEx := len(m.Dist) - 1; iNdEx >= 0; iNdEx-- {
-			f6 := math.Float64bits(float64(m.Dist[iNdEx]))
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f6))
-		}
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Dist)*8))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/CreateTrainingTask",
 	}
-	if len(m.Nonces) > 0 {
-		dAtA8 := make([]byte, len(m.Nonces)*10)
-		var j7 int
-		for _, num1 := range m.Nonces {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintTx(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTrainingTask(ctx, req.(*MsgCreateTrainingTask))
 	}
-	if m.PocStageStartBlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
-		i--
-		dAtA[i] = 0x18
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitHardwareDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitHardwareDiff)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ParticipantAddress) > 0 {
-		i -= len(m.ParticipantAddress)
-		copy(dAtA[i:], m.ParticipantAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ParticipantAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitHardwareDiff(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitHardwareDiff",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitHardwareDiff(ctx, req.(*MsgSubmitHardwareDiff))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocValidationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreatePartialUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePartialUpgrade)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitPocValidationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitPocValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePartialUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/CreatePartialUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePartialUpgrade(ctx, req.(*MsgCreatePartialUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSeed) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ClaimTrainingTaskForAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimTrainingTaskForAssignment)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimTrainingTaskForAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/ClaimTrainingTaskForAssignment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimTrainingTaskForAssignment(ctx, req.(*MsgClaimTrainingTaskForAssignment))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSeed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AssignTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAssignTrainingTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AssignTrainingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/AssignTrainingTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AssignTrainingTask(ctx, req.(*MsgAssignTrainingTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_SettleTrainingPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettleTrainingPayments)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.EpochIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServer).SettleTrainingPayments(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SettleTrainingPayments",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettleTrainingPayments(ctx, req.(*MsgSettleTrainingPayments))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSeedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_FinishTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinishTrainingTask)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).FinishTrainingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/FinishTrainingTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinishTrainingTask(ctx, req.(*MsgFinishTrainingTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSeedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CancelTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTrainingTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTrainingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/CancelTrainingTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTrainingTask(ctx, req.(*MsgCancelTrainingTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSubmitUnitOfComputePriceProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SubmitTrainingKvRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitTrainingKvRecord)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitUnitOfComputePriceProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitUnitOfComputePriceProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Price != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Price))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitTrainingKvRecord(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitTrainingKvRecord",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitTrainingKvRecord(ctx, req.(*MsgSubmitTrainingKvRecord))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_JoinTraining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinTraining)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitUnitOfComputePriceProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitUnitOfComputePriceProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).JoinTraining(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/JoinTraining",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinTraining(ctx, req.(*MsgJoinTraining))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterModel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_TrainingHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTrainingHeartbeat)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRegisterModel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRegisterModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValidationThreshold != nil {
-		{
-			size, err := m.ValidationThreshold.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+	if interceptor == nil {
+		return srv.(MsgServer).TrainingHeartbeat(ctx, in)
 	}
-	if m.ThroughputPerNonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ThroughputPerNonce))
-		i--
-		dAtA[i] = 0x48
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/TrainingHeartbeat",
 	}
-	if m.VRam != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VRam))
-		i--
-		dAtA[i] = 0x40
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TrainingHeartbeat(ctx, req.(*MsgTrainingHeartbeat))
 	}
-	if len(m.ModelArgs) > 0 {
-		for iNdEx := len(m.ModelArgs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ModelArgs[iNdEx])
-			copy(dAtA[i:], m.ModelArgs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ModelArgs[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBarrier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBarrier)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.HfCommit) > 0 {
-		i -= len(m.HfCommit)
-		copy(dAtA[i:], m.HfCommit)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HfCommit)))
-		i--
-		dAtA[i] = 0x32
+	if interceptor == nil {
+		return srv.(MsgServer).SetBarrier(ctx, in)
 	}
-	if len(m.HfRepo) > 0 {
-		i -= len(m.HfRepo)
-		copy(dAtA[i:], m.HfRepo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HfRepo)))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SetBarrier",
 	}
-	if m.UnitsOfComputePerToken != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UnitsOfComputePerToken))
-		i--
-		dAtA[i] = 0x20
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBarrier(ctx, req.(*MsgSetBarrier))
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_JoinTrainingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinTrainingStatus)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ProposedBy) > 0 {
-		i -= len(m.ProposedBy)
-		copy(dAtA[i:], m.ProposedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProposedBy)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).JoinTrainingStatus(ctx, in)
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/JoinTrainingStatus",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinTrainingStatus(ctx, req.(*MsgJoinTrainingStatus))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterModelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateDummyTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDummyTrainingTask)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRegisterModelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRegisterModelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDummyTrainingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/CreateDummyTrainingTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDummyTrainingTask(ctx, req.(*MsgCreateDummyTrainingTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTrainingTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_BridgeExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBridgeExchange)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).BridgeExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/BridgeExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BridgeExchange(ctx, req.(*MsgBridgeExchange))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTrainingTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Config != nil {
-		{
-			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func _Msg_RegisterBridgeAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterBridgeAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.HardwareResources) > 0 {
-		for iNdEx := len(m.HardwareResources) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HardwareResources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterBridgeAddresses(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RegisterBridgeAddresses",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterBridgeAddresses(ctx, req.(*MsgRegisterBridgeAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RegisterLiquidityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterLiquidityPool)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Task != nil {
-		{
-			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterLiquidityPool(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RegisterLiquidityPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterLiquidityPool(ctx, req.(*MsgRegisterLiquidityPool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitHardwareDiff) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RegisterTokenMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterTokenMetadata)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterTokenMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RegisterTokenMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterTokenMetadata(ctx, req.(*MsgRegisterTokenMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitHardwareDiff) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ApproveBridgeTokenForTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveBridgeTokenForTrading)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveBridgeTokenForTrading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/ApproveBridgeTokenForTrading",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveBridgeTokenForTrading(ctx, req.(*MsgApproveBridgeTokenForTrading))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitHardwareDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Removed) > 0 {
-		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_RequestBridgeWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestBridgeWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.NewOrModified) > 0 {
-		for iNdEx := len(m.NewOrModified) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NewOrModified[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestBridgeWithdrawal(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RequestBridgeWithdrawal",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestBridgeWithdrawal(ctx, req.(*MsgRequestBridgeWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitHardwareDiffResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RequestBridgeMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestBridgeMint)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RequestBridgeMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RequestBridgeMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestBridgeMint(ctx, req.(*MsgRequestBridgeMint))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitHardwareDiffResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SetBridgeRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBridgeRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBridgeRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SetBridgeRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBridgeRateLimit(ctx, req.(*MsgSetBridgeRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitHardwareDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SetBridgePaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBridgePaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBridgePaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SetBridgePaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBridgePaused(ctx, req.(*MsgSetBridgePaused))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimTrainingTaskForAssignment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RegisterWrappedTokenContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterWrappedTokenContract)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterWrappedTokenContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RegisterWrappedTokenContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterWrappedTokenContract(ctx, req.(*MsgRegisterWrappedTokenContract))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimTrainingTaskForAssignment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgClaimTrainingTaskForAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TaskId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x10
+func _Msg_MigrateAllWrappedTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateAllWrappedTokens)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateAllWrappedTokens(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/MigrateAllWrappedTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateAllWrappedTokens(ctx, req.(*MsgMigrateAllWrappedTokens))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimTrainingTaskForAssignmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddUserToTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddUserToTrainingAllowList)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgClaimTrainingTaskForAssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgClaimTrainingTaskForAssignmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddUserToTrainingAllowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/AddUserToTrainingAllowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddUserToTrainingAllowList(ctx, req.(*MsgAddUserToTrainingAllowList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAssignTrainingTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveUserFromTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveUserFromTrainingAllowList)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAssignTrainingTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAssignTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Assignees) > 0 {
-		for iNdEx := len(m.Assignees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Assignees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveUserFromTrainingAllowList(ctx, in)
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RemoveUserFromTrainingAllowList",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveUserFromTrainingAllowList(ctx, req.(*MsgRemoveUserFromTrainingAllowList))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAssignTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTrainingAllowList)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAssignTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).SetTrainingAllowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SetTrainingAllowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTrainingAllowList(ctx, req.(*MsgSetTrainingAllowList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAssignTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_AddParticipantsToAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddParticipantsToAllowList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddParticipantsToAllowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/AddParticipantsToAllowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddParticipantsToAllowList(ctx, req.(*MsgAddParticipantsToAllowList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePartialUpgrade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveParticipantsFromAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveParticipantsFromAllowList)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveParticipantsFromAllowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RemoveParticipantsFromAllowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveParticipantsFromAllowList(ctx, req.(*MsgRemoveParticipantsFromAllowList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePartialUpgrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AppealInvalidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppealInvalidation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppealInvalidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/AppealInvalidation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppealInvalidation(ctx, req.(*MsgAppealInvalidation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePartialUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ApiBinariesJson) > 0 {
-		i -= len(m.ApiBinariesJson)
-		copy(dAtA[i:], m.ApiBinariesJson)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ApiBinariesJson)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_SubmitAppealVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitAppealVote)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.NodeVersion) > 0 {
-		i -= len(m.NodeVersion)
-		copy(dAtA[i:], m.NodeVersion)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeVersion)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitAppealVote(ctx, in)
 	}
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitAppealVote",
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitAppealVote(ctx, req.(*MsgSubmitAppealVote))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePartialUpgradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetModelStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetModelStatus)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SetModelStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SetModelStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetModelStatus(ctx, req.(*MsgSetModelStatus))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePartialUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func _Msg_SetModelAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetModelAlias)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetModelAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SetModelAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetModelAlias(ctx, req.(*MsgSetModelAlias))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (m *MsgCreatePartialUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Msg_AttestBridgeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestBridgeBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestBridgeBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/AttestBridgeBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestBridgeBlock(ctx, req.(*MsgAttestBridgeBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inference.inference.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "StartInference",
+			Handler:    _Msg_StartInference_Handler,
+		},
+		{
+			MethodName: "FinishInference",
+			Handler:    _Msg_FinishInference_Handler,
+		},
+		{
+			MethodName: "SubmitNewParticipant",
+			Handler:    _Msg_SubmitNewParticipant_Handler,
+		},
+		{
+			MethodName: "Validation",
+			Handler:    _Msg_Validation_Handler,
+		},
+		{
+			MethodName: "SubmitNewUnfundedParticipant",
+			Handler:    _Msg_SubmitNewUnfundedParticipant_Handler,
+		},
+		{
+			MethodName: "InvalidateInference",
+			Handler:    _Msg_InvalidateInference_Handler,
+		},
+		{
+			MethodName: "RevalidateInference",
+			Handler:    _Msg_RevalidateInference_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "SubmitPocBatch",
+			Handler:    _Msg_SubmitPocBatch_Handler,
+		},
+		{
+			MethodName: "SubmitPocValidation",
+			Handler:    _Msg_SubmitPocValidation_Handler,
+		},
+		{
+			MethodName: "SubmitSeed",
+			Handler:    _Msg_SubmitSeed_Handler,
+		},
+		{
+			MethodName: "SubmitUnitOfComputePriceProposal",
+			Handler:    _Msg_SubmitUnitOfComputePriceProposal_Handler,
+		},
+		{
+			MethodName: "RegisterModel",
+			Handler:    _Msg_RegisterModel_Handler,
+		},
+		{
+			MethodName: "CreateTrainingTask",
+			Handler:    _Msg_CreateTrainingTask_Handler,
+		},
+		{
+			MethodName: "SubmitHardwareDiff",
+			Handler:    _Msg_SubmitHardwareDiff_Handler,
+		},
+		{
+			MethodName: "CreatePartialUpgrade",
+			Handler:    _Msg_CreatePartialUpgrade_Handler,
+		},
+		{
+			MethodName: "ClaimTrainingTaskForAssignment",
+			Handler:    _Msg_ClaimTrainingTaskForAssignment_Handler,
+		},
+		{
+			MethodName: "AssignTrainingTask",
+			Handler:    _Msg_AssignTrainingTask_Handler,
+		},
+		{
+			MethodName: "SettleTrainingPayments",
+			Handler:    _Msg_SettleTrainingPayments_Handler,
+		},
+		{
+			MethodName: "FinishTrainingTask",
+			Handler:    _Msg_FinishTrainingTask_Handler,
+		},
+		{
+			MethodName: "CancelTrainingTask",
+			Handler:    _Msg_CancelTrainingTask_Handler,
+		},
+		{
+			MethodName: "SubmitTrainingKvRecord",
+			Handler:    _Msg_SubmitTrainingKvRecord_Handler,
+		},
+		{
+			MethodName: "JoinTraining",
+			Handler:    _Msg_JoinTraining_Handler,
+		},
+		{
+			MethodName: "TrainingHeartbeat",
+			Handler:    _Msg_TrainingHeartbeat_Handler,
+		},
+		{
+			MethodName: "SetBarrier",
+			Handler:    _Msg_SetBarrier_Handler,
+		},
+		{
+			MethodName: "JoinTrainingStatus",
+			Handler:    _Msg_JoinTrainingStatus_Handler,
+		},
+		{
+			MethodName: "CreateDummyTrainingTask",
+			Handler:    _Msg_CreateDummyTrainingTask_Handler,
+		},
+		{
+			MethodName: "BridgeExchange",
+			Handler:    _Msg_BridgeExchange_Handler,
+		},
+		{
+			MethodName: "RegisterBridgeAddresses",
+			Handler:    _Msg_RegisterBridgeAddresses_Handler,
+		},
+		{
+			MethodName: "RegisterLiquidityPool",
+			Handler:    _Msg_RegisterLiquidityPool_Handler,
+		},
+		{
+			MethodName: "RegisterTokenMetadata",
+			Handler:    _Msg_RegisterTokenMetadata_Handler,
+		},
+		{
+			MethodName: "ApproveBridgeTokenForTrading",
+			Handler:    _Msg_ApproveBridgeTokenForTrading_Handler,
+		},
+		{
+			MethodName: "RequestBridgeWithdrawal",
+			Handler:    _Msg_RequestBridgeWithdrawal_Handler,
+		},
+		{
+			MethodName: "RequestBridgeMint",
+			Handler:    _Msg_RequestBridgeMint_Handler,
+		},
+		{
+			MethodName: "SetBridgeRateLimit",
+			Handler:    _Msg_SetBridgeRateLimit_Handler,
+		},
+		{
+			MethodName: "SetBridgePaused",
+			Handler:    _Msg_SetBridgePaused_Handler,
+		},
+		{
+			MethodName: "RegisterWrappedTokenContract",
+			Handler:    _Msg_RegisterWrappedTokenContract_Handler,
+		},
+		{
+			MethodName: "MigrateAllWrappedTokens",
+			Handler:    _Msg_MigrateAllWrappedTokens_Handler,
+		},
+		{
+			MethodName: "AddUserToTrainingAllowList",
+			Handler:    _Msg_AddUserToTrainingAllowList_Handler,
+		},
+		{
+			MethodName: "RemoveUserFromTrainingAllowList",
+			Handler:    _Msg_RemoveUserFromTrainingAllowList_Handler,
+		},
+		{
+			MethodName: "SetTrainingAllowList",
+			Handler:    _Msg_SetTrainingAllowList_Handler,
+		},
+		{
+			MethodName: "AddParticipantsToAllowList",
+			Handler:    _Msg_AddParticipantsToAllowList_Handler,
+		},
+		{
+			MethodName: "RemoveParticipantsFromAllowList",
+			Handler:    _Msg_RemoveParticipantsFromAllowList_Handler,
+		},
+		{
+			MethodName: "AppealInvalidation",
+			Handler:    _Msg_AppealInvalidation_Handler,
+		},
+		{
+			MethodName: "SubmitAppealVote",
+			Handler:    _Msg_SubmitAppealVote_Handler,
+		},
+		{
+			MethodName: "SetModelStatus",
+			Handler:    _Msg_SetModelStatus_Handler,
+		},
+		{
+			MethodName: "SetModelAlias",
+			Handler:    _Msg_SetModelAlias_Handler,
+		},
+		{
+			MethodName: "AttestBridgeBlock",
+			Handler:    _Msg_AttestBridgeBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inference/inference/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitTrainingKvRecord) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7975,41 +7867,125 @@ func (m *MsgSubmitTrainingKvRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitTrainingKvRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitTrainingKvRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartInference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartInference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OriginalPromptHash) > 0 {
+		i -= len(m.OriginalPromptHash)
+		copy(dAtA[i:], m.OriginalPromptHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPromptHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.OriginalPrompt) > 0 {
+		i -= len(m.OriginalPrompt)
+		copy(dAtA[i:], m.OriginalPrompt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPrompt)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.TransferSignature) > 0 {
+		i -= len(m.TransferSignature)
+		copy(dAtA[i:], m.TransferSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferSignature)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.RequestTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestTimestamp))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.PromptTokenCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PromptTokenCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxTokens != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxTokens))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.NodeVersion) > 0 {
+		i -= len(m.NodeVersion)
+		copy(dAtA[i:], m.NodeVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeVersion)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.AssignedTo) > 0 {
+		i -= len(m.AssignedTo)
+		copy(dAtA[i:], m.AssignedTo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssignedTo)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RequestedBy) > 0 {
+		i -= len(m.RequestedBy)
+		copy(dAtA[i:], m.RequestedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Model) > 0 {
+		i -= len(m.Model)
+		copy(dAtA[i:], m.Model)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Model)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PromptPayload) > 0 {
+		i -= len(m.PromptPayload)
+		copy(dAtA[i:], m.PromptPayload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptPayload)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Participant) > 0 {
-		i -= len(m.Participant)
-		copy(dAtA[i:], m.Participant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+	if len(m.PromptHash) > 0 {
+		i -= len(m.PromptHash)
+		copy(dAtA[i:], m.PromptHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptHash)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -8021,7 +7997,7 @@ func (m *MsgSubmitTrainingKvRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitTrainingKvRecordResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgStartInferenceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8031,20 +8007,34 @@ func (m *MsgSubmitTrainingKvRecordResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitTrainingKvRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgStartInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitTrainingKvRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgStartInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InferenceIndex) > 0 {
+		i -= len(m.InferenceIndex)
+		copy(dAtA[i:], m.InferenceIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinTraining) Marshal() (dAtA []byte, err error) {
+func (m *MsgFinishInference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8054,25 +8044,121 @@ func (m *MsgJoinTraining) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTraining) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFinishInference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinTraining) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFinishInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if m.CompletionStatus != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionStatus))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.OriginalPromptHash) > 0 {
+		i -= len(m.OriginalPromptHash)
+		copy(dAtA[i:], m.OriginalPromptHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPromptHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.PromptHash) > 0 {
+		i -= len(m.PromptHash)
+		copy(dAtA[i:], m.PromptHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptHash)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Model) > 0 {
+		i -= len(m.Model)
+		copy(dAtA[i:], m.Model)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Model)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.OriginalPrompt) > 0 {
+		i -= len(m.OriginalPrompt)
+		copy(dAtA[i:], m.OriginalPrompt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPrompt)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RequestedBy) > 0 {
+		i -= len(m.RequestedBy)
+		copy(dAtA[i:], m.RequestedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestedBy)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ExecutorSignature) > 0 {
+		i -= len(m.ExecutorSignature)
+		copy(dAtA[i:], m.ExecutorSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutorSignature)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.TransferSignature) > 0 {
+		i -= len(m.TransferSignature)
+		copy(dAtA[i:], m.TransferSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferSignature)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RequestTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.TransferredBy) > 0 {
+		i -= len(m.TransferredBy)
+		copy(dAtA[i:], m.TransferredBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferredBy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ExecutedBy) > 0 {
+		i -= len(m.ExecutedBy)
+		copy(dAtA[i:], m.ExecutedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CompletionTokenCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionTokenCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PromptTokenCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PromptTokenCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ResponsePayload) > 0 {
+		i -= len(m.ResponsePayload)
+		copy(dAtA[i:], m.ResponsePayload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponsePayload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ResponseHash) > 0 {
+		i -= len(m.ResponseHash)
+		copy(dAtA[i:], m.ResponseHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -8086,7 +8172,7 @@ func (m *MsgJoinTraining) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinTrainingResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgFinishInferenceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8096,32 +8182,34 @@ func (m *MsgJoinTrainingResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTrainingResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFinishInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinTrainingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFinishInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InferenceIndex) > 0 {
+		i -= len(m.InferenceIndex)
+		copy(dAtA[i:], m.InferenceIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceIndex)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgTrainingHeartbeat) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitNewParticipant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8131,25 +8219,34 @@ func (m *MsgTrainingHeartbeat) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgTrainingHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewParticipant) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTrainingHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.WorkerKey) > 0 {
+		i -= len(m.WorkerKey)
+		copy(dAtA[i:], m.WorkerKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WorkerKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValidatorKey) > 0 {
+		i -= len(m.ValidatorKey)
+		copy(dAtA[i:], m.ValidatorKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -8163,7 +8260,7 @@ func (m *MsgTrainingHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgTrainingHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitNewParticipantResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8173,32 +8270,34 @@ func (m *MsgTrainingHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgTrainingHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTrainingHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
-		{
-			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ParticipantIndex) > 0 {
+		i -= len(m.ParticipantIndex)
+		copy(dAtA[i:], m.ParticipantIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ParticipantIndex)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBarrier) Marshal() (dAtA []byte, err error) {
+func (m *MsgValidation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8208,26 +8307,58 @@ func (m *MsgSetBarrier) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetBarrier) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgValidation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetBarrier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	if m.Revalidation {
+		i--
+		if m.Revalidation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x38
+	}
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if len(m.ResponseHash) > 0 {
+		i -= len(m.ResponseHash)
+		copy(dAtA[i:], m.ResponseHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ResponsePayload) > 0 {
+		i -= len(m.ResponsePayload)
+		copy(dAtA[i:], m.ResponsePayload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponsePayload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
@@ -8240,7 +8371,7 @@ func (m *MsgSetBarrier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBarrierResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgValidationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8250,32 +8381,20 @@ func (m *MsgSetBarrierResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetBarrierResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgValidationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetBarrierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
-		{
-			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinTrainingStatus) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitNewUnfundedParticipant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8285,25 +8404,48 @@ func (m *MsgJoinTrainingStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTrainingStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewUnfundedParticipant) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinTrainingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewUnfundedParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.WorkerKey) > 0 {
+		i -= len(m.WorkerKey)
+		copy(dAtA[i:], m.WorkerKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WorkerKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ValidatorKey) > 0 {
+		i -= len(m.ValidatorKey)
+		copy(dAtA[i:], m.ValidatorKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -8317,7 +8459,7 @@ func (m *MsgJoinTrainingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinTrainingStatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitNewUnfundedParticipantResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8327,32 +8469,20 @@ func (m *MsgJoinTrainingStatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTrainingStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewUnfundedParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinTrainingStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewUnfundedParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDummyTrainingTask) Marshal() (dAtA []byte, err error) {
+func (m *MsgInvalidateInference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8362,25 +8492,27 @@ func (m *MsgCreateDummyTrainingTask) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateDummyTrainingTask) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInvalidateInference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateDummyTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInvalidateInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Task != nil {
-		{
-			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.Invalidator) > 0 {
+		i -= len(m.Invalidator)
+		copy(dAtA[i:], m.Invalidator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Invalidator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -8394,7 +8526,7 @@ func (m *MsgCreateDummyTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDummyTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgInvalidateInferenceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8404,32 +8536,20 @@ func (m *MsgCreateDummyTrainingTaskResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateDummyTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInvalidateInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateDummyTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInvalidateInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Task != nil {
-		{
-			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBridgeExchange) Marshal() (dAtA []byte, err error) {
+func (m *MsgRevalidateInference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8439,83 +8559,41 @@ func (m *MsgBridgeExchange) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBridgeExchange) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRevalidateInference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBridgeExchange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRevalidateInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ReceiptsRoot) > 0 {
-		i -= len(m.ReceiptsRoot)
-		copy(dAtA[i:], m.ReceiptsRoot)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptsRoot)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ReceiptIndex) > 0 {
-		i -= len(m.ReceiptIndex)
-		copy(dAtA[i:], m.ReceiptIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptIndex)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.BlockNumber) > 0 {
-		i -= len(m.BlockNumber)
-		copy(dAtA[i:], m.BlockNumber)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockNumber)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.OwnerPubKey) > 0 {
-		i -= len(m.OwnerPubKey)
-		copy(dAtA[i:], m.OwnerPubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerPubKey)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+	if len(m.Invalidator) > 0 {
+		i -= len(m.Invalidator)
+		copy(dAtA[i:], m.Invalidator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Invalidator)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.OriginChain) > 0 {
-		i -= len(m.OriginChain)
-		copy(dAtA[i:], m.OriginChain)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginChain)))
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBridgeExchangeResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRevalidateInferenceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8525,27 +8603,20 @@ func (m *MsgBridgeExchangeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBridgeExchangeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRevalidateInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBridgeExchangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRevalidateInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddUserToTrainingAllowList) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8555,39 +8626,37 @@ func (m *MsgAddUserToTrainingAllowList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddUserToTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddUserToTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Role != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+	if m.EpochIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+	if m.Seed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Seed))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddUserToTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8597,20 +8666,32 @@ func (m *MsgAddUserToTrainingAllowListResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddUserToTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddUserToTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowList) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitPocBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8620,39 +8701,102 @@ func (m *MsgRemoveUserFromTrainingAllowList) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocBatch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveUserFromTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Role != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+	if m.NonceStride != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NonceStride))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x58
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+	if m.LastNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LastNonce))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x50
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.FirstNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FirstNonce))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.NonceCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NonceCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Dist) > 0 {
+		for iNdEx := len(m.Dist) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float64bits(float64(m.Dist[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f2))
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Dist)*8))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Nonces) > 0 {
+		dAtA4 := make([]byte, len(m.Nonces)*10)
+		var j3 int
+		for _, num1 := range m.Nonces {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BatchId) > 0 {
+		i -= len(m.BatchId)
+		copy(dAtA[i:], m.BatchId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BatchId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PocStageStartBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitPocBatchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8662,12 +8806,12 @@ func (m *MsgRemoveUserFromTrainingAllowListResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocBatchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveUserFromTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8675,7 +8819,7 @@ func (m *MsgRemoveUserFromTrainingAllowListResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTrainingAllowList) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitPocValidation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8685,42 +8829,126 @@ func (m *MsgSetTrainingAllowList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Role != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+	if len(m.Disputes) > 0 {
+		for iNdEx := len(m.Disputes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Disputes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x62
 		}
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.FraudDetected {
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSetTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+		if m.FraudDetected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ProbabilityHonest != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ProbabilityHonest))))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.NInvalid != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NInvalid))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FraudThreshold != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FraudThreshold))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.RTarget != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RTarget))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if len(m.ReceivedDist) > 0 {
+		for iNdEx := len(m.ReceivedDist) - 1; iNdEx >= 0; iNdEx-- {
+			f5 := math.Float64bits(float64(m.ReceivedDist[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f5))
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceivedDist)*8))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Dist) > 0 {
+		for iNdEx := len(m.Dist) - 1; iNdEx >= 0; iNdEx-- {
+			f6 := math.Float64bits(float64(m.Dist[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f6))
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Dist)*8))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Nonces) > 0 {
+		dAtA8 := make([]byte, len(m.Nonces)*10)
+		var j7 int
+		for _, num1 := range m.Nonces {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PocStageStartBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ParticipantAddress) > 0 {
+		i -= len(m.ParticipantAddress)
+		copy(dAtA[i:], m.ParticipantAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ParticipantAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitPocValidationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -8729,12 +8957,12 @@ func (m *MsgSetTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8742,7 +8970,7 @@ func (m *MsgSetTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddParticipantsToAllowList) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitSeed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8752,36 +8980,39 @@ func (m *MsgAddParticipantsToAllowList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddParticipantsToAllowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitSeed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddParticipantsToAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.EpochIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddParticipantsToAllowListResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitSeedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8791,12 +9022,12 @@ func (m *MsgAddParticipantsToAllowListResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddParticipantsToAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitSeedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddParticipantsToAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8804,7 +9035,7 @@ func (m *MsgAddParticipantsToAllowListResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveParticipantsFromAllowList) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitUnitOfComputePriceProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8814,36 +9045,32 @@ func (m *MsgRemoveParticipantsFromAllowList) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveParticipantsFromAllowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitUnitOfComputePriceProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveParticipantsFromAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitUnitOfComputePriceProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.Price != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveParticipantsFromAllowListResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitUnitOfComputePriceProposalResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8853,12 +9080,12 @@ func (m *MsgRemoveParticipantsFromAllowListResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveParticipantsFromAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitUnitOfComputePriceProposalResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveParticipantsFromAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitUnitOfComputePriceProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8866,7 +9093,7 @@ func (m *MsgRemoveParticipantsFromAllowListResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterBridgeAddresses) Marshal() (dAtA []byte, err error) {
+func (m *MsgRegisterModel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8876,29 +9103,99 @@ func (m *MsgRegisterBridgeAddresses) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterBridgeAddresses) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRegisterModel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterBridgeAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRegisterModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+	if m.CoinsPerOutputToken != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CoinsPerOutputToken))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.CoinsPerInputToken != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CoinsPerInputToken))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Quantization) > 0 {
+		i -= len(m.Quantization)
+		copy(dAtA[i:], m.Quantization)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Quantization)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ContextWindow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContextWindow))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ValidationThreshold != nil {
+		{
+			size, err := m.ValidationThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ThroughputPerNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ThroughputPerNonce))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.VRam != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VRam))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ModelArgs) > 0 {
+		for iNdEx := len(m.ModelArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ModelArgs[iNdEx])
+			copy(dAtA[i:], m.ModelArgs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ModelArgs[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.ChainName) > 0 {
-		i -= len(m.ChainName)
-		copy(dAtA[i:], m.ChainName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainName)))
+	if len(m.HfCommit) > 0 {
+		i -= len(m.HfCommit)
+		copy(dAtA[i:], m.HfCommit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HfCommit)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.HfRepo) > 0 {
+		i -= len(m.HfRepo)
+		copy(dAtA[i:], m.HfRepo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HfRepo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UnitsOfComputePerToken != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnitsOfComputePerToken))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProposedBy) > 0 {
+		i -= len(m.ProposedBy)
+		copy(dAtA[i:], m.ProposedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProposedBy)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -8912,7 +9209,7 @@ func (m *MsgRegisterBridgeAddresses) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterBridgeAddressesResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRegisterModelResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8922,12 +9219,12 @@ func (m *MsgRegisterBridgeAddressesResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterBridgeAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRegisterModelResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterBridgeAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRegisterModelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8935,7 +9232,7 @@ func (m *MsgRegisterBridgeAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterTokenMetadata) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateTrainingTask) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8945,70 +9242,63 @@ func (m *MsgRegisterTokenMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterTokenMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateTrainingTask) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterTokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Overwrite {
-		i--
-		if m.Overwrite {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.PaymentPerNodeEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PaymentPerNodeEpoch))
 		i--
-		dAtA[i] = 0x38
-	}
-	if m.Decimals != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x28
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+	if m.Budget != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Budget))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.HardwareResources) > 0 {
+		for iNdEx := len(m.HardwareResources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HardwareResources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterTokenMetadataResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9018,20 +9308,32 @@ func (m *MsgRegisterTokenMetadataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterTokenMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterTokenMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgApproveBridgeTokenForTrading) Marshal() (dAtA []byte, err error) {
+func (m *MsgSettleTrainingPayments) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9041,41 +9343,32 @@ func (m *MsgApproveBridgeTokenForTrading) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgApproveBridgeTokenForTrading) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSettleTrainingPayments) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgApproveBridgeTokenForTrading) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSettleTrainingPayments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgApproveBridgeTokenForTradingResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSettleTrainingPaymentsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9085,20 +9378,25 @@ func (m *MsgApproveBridgeTokenForTradingResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *MsgApproveBridgeTokenForTradingResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSettleTrainingPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgApproveBridgeTokenForTradingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSettleTrainingPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Paid != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Paid))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterLiquidityPool) Marshal() (dAtA []byte, err error) {
+func (m *MsgFinishTrainingTask) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9108,48 +9406,32 @@ func (m *MsgRegisterLiquidityPool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterLiquidityPool) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFinishTrainingTask) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterLiquidityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFinishTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.InstantiateMsg) > 0 {
-		i -= len(m.InstantiateMsg)
-		copy(dAtA[i:], m.InstantiateMsg)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InstantiateMsg)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CodeId) > 0 {
-		i -= len(m.CodeId)
-		copy(dAtA[i:], m.CodeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeId)))
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterLiquidityPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgFinishTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9159,20 +9441,30 @@ func (m *MsgRegisterLiquidityPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterLiquidityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFinishTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterLiquidityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFinishTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Refunded != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Refunded))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Paid != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Paid))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBridgeWithdrawal) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelTrainingTask) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9182,36 +9474,20 @@ func (m *MsgRequestBridgeWithdrawal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRequestBridgeWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelTrainingTask) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestBridgeWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DestinationAddress) > 0 {
-		i -= len(m.DestinationAddress)
-		copy(dAtA[i:], m.DestinationAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.UserAddress) > 0 {
-		i -= len(m.UserAddress)
-		copy(dAtA[i:], m.UserAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UserAddress)))
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -9223,7 +9499,7 @@ func (m *MsgRequestBridgeWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBridgeWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9233,39 +9509,30 @@ func (m *MsgRequestBridgeWithdrawalResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgRequestBridgeWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestBridgeWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlsRequestId) > 0 {
-		i -= len(m.BlsRequestId)
-		copy(dAtA[i:], m.BlsRequestId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BlsRequestId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.EpochIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
+	if m.Refunded != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Refunded))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
+	if m.Paid != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Paid))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBridgeMint) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitHardwareDiff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9275,36 +9542,43 @@ func (m *MsgRequestBridgeMint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRequestBridgeMint) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitHardwareDiff) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestBridgeMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitHardwareDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x22
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.DestinationAddress) > 0 {
-		i -= len(m.DestinationAddress)
-		copy(dAtA[i:], m.DestinationAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.NewOrModified) > 0 {
+		for iNdEx := len(m.NewOrModified) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewOrModified[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -9316,7 +9590,7 @@ func (m *MsgRequestBridgeMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBridgeMintResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitHardwareDiffResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9326,39 +9600,55 @@ func (m *MsgRequestBridgeMintResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRequestBridgeMintResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitHardwareDiffResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestBridgeMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitHardwareDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlsRequestId) > 0 {
-		i -= len(m.BlsRequestId)
-		copy(dAtA[i:], m.BlsRequestId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BlsRequestId)))
-		i--
-		dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimTrainingTaskForAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.EpochIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimTrainingTaskForAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimTrainingTaskForAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterWrappedTokenContract) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimTrainingTaskForAssignmentResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9368,32 +9658,69 @@ func (m *MsgRegisterWrappedTokenContract) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterWrappedTokenContract) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimTrainingTaskForAssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterWrappedTokenContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimTrainingTaskForAssignmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CodeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CodeId))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAssignTrainingTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAssignTrainingTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAssignTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Assignees) > 0 {
+		for iNdEx := len(m.Assignees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assignees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterWrappedTokenContractResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAssignTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9403,12 +9730,12 @@ func (m *MsgRegisterWrappedTokenContractResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterWrappedTokenContractResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAssignTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterWrappedTokenContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAssignTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9416,7 +9743,7 @@ func (m *MsgRegisterWrappedTokenContractResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateAllWrappedTokens) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreatePartialUpgrade) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9426,30 +9753,32 @@ func (m *MsgMigrateAllWrappedTokens) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMigrateAllWrappedTokens) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreatePartialUpgrade) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMigrateAllWrappedTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreatePartialUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+	if len(m.ApiBinariesJson) > 0 {
+		i -= len(m.ApiBinariesJson)
+		copy(dAtA[i:], m.ApiBinariesJson)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ApiBinariesJson)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if len(m.MigrateMsgJson) > 0 {
-		i -= len(m.MigrateMsgJson)
-		copy(dAtA[i:], m.MigrateMsgJson)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MigrateMsgJson)))
+	if len(m.NodeVersion) > 0 {
+		i -= len(m.NodeVersion)
+		copy(dAtA[i:], m.NodeVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeVersion)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.NewCodeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NewCodeId))
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -9463,7 +9792,7 @@ func (m *MsgMigrateAllWrappedTokens) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateAllWrappedTokensResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreatePartialUpgradeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9473,1505 +9802,5884 @@ func (m *MsgMigrateAllWrappedTokensResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgMigrateAllWrappedTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreatePartialUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMigrateAllWrappedTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreatePartialUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Attempted != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Attempted))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSubmitTrainingKvRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSubmitTrainingKvRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgStartInference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSubmitTrainingKvRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InferenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.PromptHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.PromptPayload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Model)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.RequestedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.AssignedTo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitTrainingKvRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.NodeVersion)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MaxTokens != 0 {
-		n += 1 + sovTx(uint64(m.MaxTokens))
-	}
-	if m.PromptTokenCount != 0 {
-		n += 1 + sovTx(uint64(m.PromptTokenCount))
-	}
-	if m.RequestTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.RequestTimestamp))
-	}
-	l = len(m.TransferSignature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OriginalPrompt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OriginalPromptHash)
-	if l > 0 {
-		n += 2 + l + sovTx(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgStartInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InferenceIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ErrorMessage)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSubmitTrainingKvRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFinishInference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSubmitTrainingKvRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InferenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ResponseHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ResponsePayload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PromptTokenCount != 0 {
-		n += 1 + sovTx(uint64(m.PromptTokenCount))
-	}
-	if m.CompletionTokenCount != 0 {
-		n += 1 + sovTx(uint64(m.CompletionTokenCount))
-	}
-	l = len(m.ExecutedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TransferredBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.RequestTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.RequestTimestamp))
-	}
-	l = len(m.TransferSignature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ExecutorSignature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RequestedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OriginalPrompt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Model)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PromptHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OriginalPromptHash)
-	if l > 0 {
-		n += 2 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFinishInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgJoinTraining) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinTraining) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinTraining) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.InferenceIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ErrorMessage)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitNewParticipant) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgJoinTrainingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinTrainingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinTrainingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.WorkerKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitNewParticipantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTrainingHeartbeat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTrainingHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTrainingHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ParticipantIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgValidation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InferenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ResponsePayload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ResponseHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Value != 0 {
-		n += 9
-	}
-	if m.Revalidation {
-		n += 2
+func (m *MsgTrainingHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgValidationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgTrainingHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitNewUnfundedParticipant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgTrainingHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.WorkerKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitNewUnfundedParticipantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetBarrier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgInvalidateInference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetBarrier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBarrier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InferenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Invalidator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInvalidateInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetBarrierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRevalidateInference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetBarrierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBarrierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InferenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Invalidator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevalidateInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgJoinTrainingStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgClaimRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Seed != 0 {
-		n += 1 + sovTx(uint64(m.Seed))
-	}
-	if m.EpochIndex != 0 {
-		n += 1 + sovTx(uint64(m.EpochIndex))
-	}
-	return n
+func (m *MsgJoinTrainingStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClaimRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgJoinTrainingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitPocBatch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PocStageStartBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
-	}
-	l = len(m.BatchId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Nonces) > 0 {
-		l = 0
-		for _, e := range m.Nonces {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if len(m.Dist) > 0 {
-		n += 1 + sovTx(uint64(len(m.Dist)*8)) + len(m.Dist)*8
-	}
-	l = len(m.NodeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgJoinTrainingStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitPocBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgJoinTrainingStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitPocValidation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgJoinTrainingStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ParticipantAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PocStageStartBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
-	}
-	if len(m.Nonces) > 0 {
-		l = 0
-		for _, e := range m.Nonces {
-			l += sovTx(uint64(e))
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if len(m.Dist) > 0 {
-		n += 1 + sovTx(uint64(len(m.Dist)*8)) + len(m.Dist)*8
-	}
-	if len(m.ReceivedDist) > 0 {
-		n += 1 + sovTx(uint64(len(m.ReceivedDist)*8)) + len(m.ReceivedDist)*8
-	}
-	if m.RTarget != 0 {
-		n += 9
-	}
-	if m.FraudThreshold != 0 {
-		n += 9
-	}
-	if m.NInvalid != 0 {
-		n += 1 + sovTx(uint64(m.NInvalid))
-	}
-	if m.ProbabilityHonest != 0 {
-		n += 9
-	}
-	if m.FraudDetected {
-		n += 2
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitPocValidationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateDummyTrainingTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitSeed) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateDummyTrainingTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDummyTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.EpochIndex != 0 {
-		n += 1 + sovTx(uint64(m.EpochIndex))
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitSeedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateDummyTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateDummyTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDummyTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Price != 0 {
-		n += 1 + sovTx(uint64(m.Price))
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBridgeExchange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterModel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBridgeExchange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBridgeExchange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProposedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ReceiptProof) > 0 {
+		for iNdEx := len(m.ReceiptProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiptProof[iNdEx])
+			copy(dAtA[i:], m.ReceiptProof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptProof[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.UnitsOfComputePerToken != 0 {
-		n += 1 + sovTx(uint64(m.UnitsOfComputePerToken))
+	if len(m.ReceiptsRoot) > 0 {
+		i -= len(m.ReceiptsRoot)
+		copy(dAtA[i:], m.ReceiptsRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptsRoot)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.HfRepo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ReceiptIndex) > 0 {
+		i -= len(m.ReceiptIndex)
+		copy(dAtA[i:], m.ReceiptIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptIndex)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.HfCommit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BlockNumber) > 0 {
+		i -= len(m.BlockNumber)
+		copy(dAtA[i:], m.BlockNumber)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockNumber)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.ModelArgs) > 0 {
-		for _, s := range m.ModelArgs {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.VRam != 0 {
-		n += 1 + sovTx(uint64(m.VRam))
+	if len(m.OwnerPubKey) > 0 {
+		i -= len(m.OwnerPubKey)
+		copy(dAtA[i:], m.OwnerPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerPubKey)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.ThroughputPerNonce != 0 {
-		n += 1 + sovTx(uint64(m.ThroughputPerNonce))
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ValidationThreshold != nil {
-		l = m.ValidationThreshold.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.OriginChain) > 0 {
+		i -= len(m.OriginChain)
+		copy(dAtA[i:], m.OriginChain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginChain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterModelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBridgeExchangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateTrainingTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.HardwareResources) > 0 {
-		for _, e := range m.HardwareResources {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgBridgeExchangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateTrainingTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBridgeExchangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitHardwareDiff) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddUserToTrainingAllowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddUserToTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddUserToTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.NewOrModified) > 0 {
-		for _, e := range m.NewOrModified {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Removed) > 0 {
-		for _, e := range m.Removed {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitHardwareDiffResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddUserToTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgClaimTrainingTaskForAssignment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovTx(uint64(m.TaskId))
-	}
-	return n
+func (m *MsgAddUserToTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClaimTrainingTaskForAssignmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddUserToTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAssignTrainingTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovTx(uint64(m.TaskId))
-	}
-	if len(m.Assignees) > 0 {
-		for _, e := range m.Assignees {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgRemoveUserFromTrainingAllowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAssignTrainingTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgRemoveUserFromTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreatePartialUpgrade) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRemoveUserFromTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
-	}
-	l = len(m.NodeVersion)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ApiBinariesJson)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePartialUpgradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveUserFromTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveUserFromTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveUserFromTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitTrainingKvRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetTrainingAllowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovTx(uint64(m.TaskId))
-	}
-	l = len(m.Participant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitTrainingKvRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTraining) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Req != nil {
-		l = m.Req.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSetTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinTrainingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTrainingHeartbeat) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Req != nil {
-		l = m.Req.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAddParticipantsToAllowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgTrainingHeartbeatResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Resp != nil {
-		l = m.Resp.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgAddParticipantsToAllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetBarrier) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddParticipantsToAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Req != nil {
-		l = m.Req.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBarrierResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Resp != nil {
-		l = m.Resp.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAddParticipantsToAllowListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTrainingStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddParticipantsToAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddParticipantsToAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Req != nil {
-		l = m.Req.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveParticipantsFromAllowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTrainingStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgRemoveParticipantsFromAllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateDummyTrainingTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRemoveParticipantsFromAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDummyTrainingTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRemoveParticipantsFromAllowListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBridgeExchange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OriginChain)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OwnerPubKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BlockNumber)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ReceiptIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ReceiptsRoot)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgRemoveParticipantsFromAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBridgeExchangeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRemoveParticipantsFromAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddUserToTrainingAllowList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Role != 0 {
-		n += 1 + sovTx(uint64(m.Role))
+func (m *MsgRegisterBridgeAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddUserToTrainingAllowListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgRegisterBridgeAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveUserFromTrainingAllowList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRegisterBridgeAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChainName) > 0 {
+		i -= len(m.ChainName)
+		copy(dAtA[i:], m.ChainName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Role != 0 {
-		n += 1 + sovTx(uint64(m.Role))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterBridgeAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBridgeAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBridgeAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTrainingAllowList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetBridgeRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBridgeRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgeRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.Role != 0 {
-		n += 1 + sovTx(uint64(m.Role))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTrainingAllowListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetBridgeRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddParticipantsToAllowList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgSetBridgeRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddParticipantsToAllowListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetBridgeRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveParticipantsFromAllowList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgSetBridgePaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveParticipantsFromAllowListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetBridgePaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterBridgeAddresses) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetBridgePaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChainName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterBridgeAddressesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetBridgePausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBridgePausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgePausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterTokenMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterTokenMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterTokenMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterTokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Overwrite {
+		i--
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Decimals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Decimals != 0 {
-		n += 1 + sovTx(uint64(m.Decimals))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Overwrite {
-		n += 2
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterTokenMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterTokenMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgApproveBridgeTokenForTrading) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgRegisterTokenMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgApproveBridgeTokenForTradingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRegisterTokenMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterLiquidityPool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgApproveBridgeTokenForTrading) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveBridgeTokenForTrading) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveBridgeTokenForTrading) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CodeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.InstantiateMsg)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterLiquidityPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgApproveBridgeTokenForTradingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveBridgeTokenForTradingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveBridgeTokenForTradingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBridgeWithdrawal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterLiquidityPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterLiquidityPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterLiquidityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.InstantiateMsg) > 0 {
+		i -= len(m.InstantiateMsg)
+		copy(dAtA[i:], m.InstantiateMsg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InstantiateMsg)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.UserAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CodeId) > 0 {
+		i -= len(m.CodeId)
+		copy(dAtA[i:], m.CodeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.DestinationAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBridgeWithdrawalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterLiquidityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterLiquidityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterLiquidityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.EpochIndex != 0 {
-		n += 1 + sovTx(uint64(m.EpochIndex))
-	}
-	l = len(m.BlsRequestId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBridgeMint) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRequestBridgeWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBridgeWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBridgeWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.DestinationAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.UserAddress) > 0 {
+		i -= len(m.UserAddress)
+		copy(dAtA[i:], m.UserAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UserAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBridgeMintResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRequestBridgeWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBridgeWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBridgeWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BlsRequestId) > 0 {
+		i -= len(m.BlsRequestId)
+		copy(dAtA[i:], m.BlsRequestId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlsRequestId)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.EpochIndex != 0 {
-		n += 1 + sovTx(uint64(m.EpochIndex))
-	}
-	l = len(m.BlsRequestId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterWrappedTokenContract) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRequestBridgeMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBridgeMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBridgeMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestBridgeMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBridgeMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBridgeMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlsRequestId) > 0 {
+		i -= len(m.BlsRequestId)
+		copy(dAtA[i:], m.BlsRequestId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlsRequestId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterWrappedTokenContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterWrappedTokenContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterWrappedTokenContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.CodeId != 0 {
-		n += 1 + sovTx(uint64(m.CodeId))
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterWrappedTokenContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterWrappedTokenContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterWrappedTokenContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterWrappedTokenContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateAllWrappedTokens) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMigrateAllWrappedTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateAllWrappedTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAllWrappedTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MigrateMsgJson) > 0 {
+		i -= len(m.MigrateMsgJson)
+		copy(dAtA[i:], m.MigrateMsgJson)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MigrateMsgJson)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.NewCodeId != 0 {
-		n += 1 + sovTx(uint64(m.NewCodeId))
+		i = encodeVarintTx(dAtA, i, uint64(m.NewCodeId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.MigrateMsgJson)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Limit != 0 {
-		n += 1 + sovTx(uint64(m.Limit))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateAllWrappedTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateAllWrappedTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAllWrappedTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempted != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Attempted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppealInvalidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealInvalidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealInvalidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppealInvalidationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealInvalidationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealInvalidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssignedValidators) > 0 {
+		for iNdEx := len(m.AssignedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AssignedValidators[iNdEx])
+			copy(dAtA[i:], m.AssignedValidators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AssignedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DeadlineBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeadlineBlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAppealVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAppealVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAppealVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAppealVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAppealVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAppealVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetModelStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetModelStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetModelStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SuccessorId) > 0 {
+		i -= len(m.SuccessorId)
+		copy(dAtA[i:], m.SuccessorId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SuccessorId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SunsetEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SunsetEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetModelStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetModelStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetModelStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetModelAlias) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetModelAlias) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetModelAlias) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModelId) > 0 {
+		i -= len(m.ModelId)
+		copy(dAtA[i:], m.ModelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Alias) > 0 {
+		i -= len(m.Alias)
+		copy(dAtA[i:], m.Alias)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Alias)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetModelAliasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetModelAliasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetModelAliasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestBridgeBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestBridgeBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestBridgeBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiptsRoot) > 0 {
+		i -= len(m.ReceiptsRoot)
+		copy(dAtA[i:], m.ReceiptsRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptsRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BlockNumber) > 0 {
+		i -= len(m.BlockNumber)
+		copy(dAtA[i:], m.BlockNumber)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockNumber)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OriginChain) > 0 {
+		i -= len(m.OriginChain)
+		copy(dAtA[i:], m.OriginChain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginChain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestBridgeBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestBridgeBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestBridgeBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attested {
+		i--
+		if m.Attested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStartInference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PromptHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PromptPayload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RequestedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssignedTo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NodeVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxTokens != 0 {
+		n += 1 + sovTx(uint64(m.MaxTokens))
+	}
+	if m.PromptTokenCount != 0 {
+		n += 1 + sovTx(uint64(m.PromptTokenCount))
+	}
+	if m.RequestTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.RequestTimestamp))
+	}
+	l = len(m.TransferSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginalPrompt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginalPromptHash)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStartInferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InferenceIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFinishInference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ResponseHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ResponsePayload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PromptTokenCount != 0 {
+		n += 1 + sovTx(uint64(m.PromptTokenCount))
+	}
+	if m.CompletionTokenCount != 0 {
+		n += 1 + sovTx(uint64(m.CompletionTokenCount))
+	}
+	l = len(m.ExecutedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TransferredBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequestTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.RequestTimestamp))
+	}
+	l = len(m.TransferSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExecutorSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RequestedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginalPrompt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PromptHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginalPromptHash)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.CompletionStatus != 0 {
+		n += 2 + sovTx(uint64(m.CompletionStatus))
+	}
+	return n
+}
+
+func (m *MsgFinishInferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InferenceIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitNewParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WorkerKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitNewParticipantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParticipantIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ResponsePayload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ResponseHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.Revalidation {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgValidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitNewUnfundedParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WorkerKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitNewUnfundedParticipantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInvalidateInference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Invalidator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInvalidateInferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevalidateInference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Invalidator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevalidateInferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Seed != 0 {
+		n += 1 + sovTx(uint64(m.Seed))
+	}
+	if m.EpochIndex != 0 {
+		n += 1 + sovTx(uint64(m.EpochIndex))
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitPocBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PocStageStartBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
+	}
+	l = len(m.BatchId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Nonces) > 0 {
+		l = 0
+		for _, e := range m.Nonces {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Dist) > 0 {
+		n += 1 + sovTx(uint64(len(m.Dist)*8)) + len(m.Dist)*8
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NonceCount != 0 {
+		n += 1 + sovTx(uint64(m.NonceCount))
+	}
+	if m.FirstNonce != 0 {
+		n += 1 + sovTx(uint64(m.FirstNonce))
+	}
+	if m.LastNonce != 0 {
+		n += 1 + sovTx(uint64(m.LastNonce))
+	}
+	if m.NonceStride != 0 {
+		n += 1 + sovTx(uint64(m.NonceStride))
+	}
+	return n
+}
+
+func (m *MsgSubmitPocBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitPocValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ParticipantAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PocStageStartBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
+	}
+	if len(m.Nonces) > 0 {
+		l = 0
+		for _, e := range m.Nonces {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Dist) > 0 {
+		n += 1 + sovTx(uint64(len(m.Dist)*8)) + len(m.Dist)*8
+	}
+	if len(m.ReceivedDist) > 0 {
+		n += 1 + sovTx(uint64(len(m.ReceivedDist)*8)) + len(m.ReceivedDist)*8
+	}
+	if m.RTarget != 0 {
+		n += 9
+	}
+	if m.FraudThreshold != 0 {
+		n += 9
+	}
+	if m.NInvalid != 0 {
+		n += 1 + sovTx(uint64(m.NInvalid))
+	}
+	if m.ProbabilityHonest != 0 {
+		n += 9
+	}
+	if m.FraudDetected {
+		n += 2
+	}
+	if len(m.Disputes) > 0 {
+		for _, e := range m.Disputes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitPocValidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitSeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochIndex != 0 {
+		n += 1 + sovTx(uint64(m.EpochIndex))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitSeedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitUnitOfComputePriceProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 1 + sovTx(uint64(m.Price))
+	}
+	return n
+}
+
+func (m *MsgSubmitUnitOfComputePriceProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProposedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnitsOfComputePerToken != 0 {
+		n += 1 + sovTx(uint64(m.UnitsOfComputePerToken))
+	}
+	l = len(m.HfRepo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HfCommit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ModelArgs) > 0 {
+		for _, s := range m.ModelArgs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.VRam != 0 {
+		n += 1 + sovTx(uint64(m.VRam))
+	}
+	if m.ThroughputPerNonce != 0 {
+		n += 1 + sovTx(uint64(m.ThroughputPerNonce))
+	}
+	if m.ValidationThreshold != nil {
+		l = m.ValidationThreshold.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContextWindow != 0 {
+		n += 1 + sovTx(uint64(m.ContextWindow))
+	}
+	l = len(m.Quantization)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CoinsPerInputToken != 0 {
+		n += 1 + sovTx(uint64(m.CoinsPerInputToken))
+	}
+	if m.CoinsPerOutputToken != 0 {
+		n += 1 + sovTx(uint64(m.CoinsPerOutputToken))
+	}
+	return n
+}
+
+func (m *MsgRegisterModelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateTrainingTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.HardwareResources) > 0 {
+		for _, e := range m.HardwareResources {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Budget != 0 {
+		n += 1 + sovTx(uint64(m.Budget))
+	}
+	if m.PaymentPerNodeEpoch != 0 {
+		n += 1 + sovTx(uint64(m.PaymentPerNodeEpoch))
+	}
+	return n
+}
+
+func (m *MsgCreateTrainingTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSettleTrainingPayments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *MsgSettleTrainingPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paid != 0 {
+		n += 1 + sovTx(uint64(m.Paid))
+	}
+	return n
+}
+
+func (m *MsgFinishTrainingTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *MsgFinishTrainingTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paid != 0 {
+		n += 1 + sovTx(uint64(m.Paid))
+	}
+	if m.Refunded != 0 {
+		n += 1 + sovTx(uint64(m.Refunded))
+	}
+	return n
+}
+
+func (m *MsgCancelTrainingTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *MsgCancelTrainingTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paid != 0 {
+		n += 1 + sovTx(uint64(m.Paid))
+	}
+	if m.Refunded != 0 {
+		n += 1 + sovTx(uint64(m.Refunded))
+	}
+	return n
+}
+
+func (m *MsgSubmitHardwareDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NewOrModified) > 0 {
+		for _, e := range m.NewOrModified {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, e := range m.Removed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitHardwareDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimTrainingTaskForAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *MsgClaimTrainingTaskForAssignmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAssignTrainingTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	if len(m.Assignees) > 0 {
+		for _, e := range m.Assignees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAssignTrainingTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePartialUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = len(m.NodeVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ApiBinariesJson)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreatePartialUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitTrainingKvRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitTrainingKvRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgJoinTraining) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinTrainingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTrainingHeartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTrainingHeartbeatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBarrier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBarrierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinTrainingStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinTrainingStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDummyTrainingTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDummyTrainingTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBridgeExchange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginChain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OwnerPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlockNumber)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReceiptIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReceiptsRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ReceiptProof) > 0 {
+		for _, b := range m.ReceiptProof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBridgeExchangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddUserToTrainingAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgAddUserToTrainingAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveUserFromTrainingAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgRemoveUserFromTrainingAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTrainingAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgSetTrainingAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddParticipantsToAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddParticipantsToAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveParticipantsFromAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveParticipantsFromAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterBridgeAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterBridgeAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBridgeRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RateLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetBridgeRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBridgePaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBridgePausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterTokenMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTx(uint64(m.Decimals))
+	}
+	if m.Overwrite {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRegisterTokenMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveBridgeTokenForTrading) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveBridgeTokenForTradingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterLiquidityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CodeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InstantiateMsg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterLiquidityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestBridgeWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UserAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestBridgeWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochIndex != 0 {
+		n += 1 + sovTx(uint64(m.EpochIndex))
+	}
+	l = len(m.BlsRequestId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestBridgeMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestBridgeMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochIndex != 0 {
+		n += 1 + sovTx(uint64(m.EpochIndex))
+	}
+	l = len(m.BlsRequestId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterWrappedTokenContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeId != 0 {
+		n += 1 + sovTx(uint64(m.CodeId))
+	}
+	return n
+}
+
+func (m *MsgRegisterWrappedTokenContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateAllWrappedTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewCodeId != 0 {
+		n += 1 + sovTx(uint64(m.NewCodeId))
+	}
+	l = len(m.MigrateMsgJson)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MsgMigrateAllWrappedTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attempted != 0 {
+		n += 1 + sovTx(uint64(m.Attempted))
+	}
+	return n
+}
+
+func (m *MsgAppealInvalidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAppealInvalidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeadlineBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.DeadlineBlockHeight))
+	}
+	if len(m.AssignedValidators) > 0 {
+		for _, s := range m.AssignedValidators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitAppealVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *MsgSubmitAppealVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetModelStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.SunsetEpoch != 0 {
+		n += 1 + sovTx(uint64(m.SunsetEpoch))
+	}
+	l = len(m.SuccessorId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetModelStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetModelAlias) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetModelAliasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAttestBridgeBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginChain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlockNumber)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReceiptsRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttestBridgeBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attested {
+		n += 2
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartInference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartInference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromptHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromptHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromptPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromptPayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Model = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssignedTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokens", wireType)
+			}
+			m.MaxTokens = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTokens |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromptTokenCount", wireType)
+			}
+			m.PromptTokenCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PromptTokenCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimestamp", wireType)
+			}
+			m.RequestTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPrompt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalPrompt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPromptHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalPromptHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartInferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartInferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinishInference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinishInference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponsePayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromptTokenCount", wireType)
+			}
+			m.PromptTokenCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PromptTokenCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTokenCount", wireType)
+			}
+			m.CompletionTokenCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionTokenCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferredBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferredBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimestamp", wireType)
+			}
+			m.RequestTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPrompt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalPrompt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Model = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromptHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromptHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPromptHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalPromptHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionStatus", wireType)
+			}
+			m.CompletionStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionStatus |= InferenceCompletionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinishInferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinishInferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinishInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitNewParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitNewParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitNewParticipantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitNewParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponsePayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revalidation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revalidation = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValidationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValidationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgMigrateAllWrappedTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Attempted != 0 {
-		n += 1 + sovTx(uint64(m.Attempted))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10994,15 +15702,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11030,13 +15738,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11046,24 +15754,151 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.WorkerKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11086,7 +15921,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitNewUnfundedParticipantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11109,10 +15944,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11136,7 +15971,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
+func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11153,85 +15988,21 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartInference: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartInference: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				break
 			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInvalidateInference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInvalidateInference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromptHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11259,11 +16030,11 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PromptHash = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromptPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11291,11 +16062,11 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PromptPayload = string(dAtA[iNdEx:postIndex])
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11323,11 +16094,111 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Model = string(dAtA[iNdEx:postIndex])
+			m.Invalidator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInvalidateInferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInvalidateInferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInvalidateInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevalidateInference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevalidateInference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11355,11 +16226,11 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestedBy = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignedTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11387,11 +16258,11 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssignedTo = string(dAtA[iNdEx:postIndex])
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11419,68 +16290,111 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeVersion = string(dAtA[iNdEx:postIndex])
+			m.Invalidator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokens", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MaxTokens = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxTokens |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromptTokenCount", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.PromptTokenCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PromptTokenCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevalidateInferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevalidateInferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevalidateInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimestamp", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.RequestTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 14:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11508,13 +16422,13 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferSignature = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPrompt", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
 			}
-			var stringLen uint64
+			m.Seed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11524,29 +16438,16 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Seed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OriginalPrompt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPromptHash", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
 			}
-			var stringLen uint64
+			m.EpochIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11556,24 +16457,11 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OriginalPromptHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11595,7 +16483,7 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStartInferenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11618,17 +16506,17 @@ func (m *MsgStartInferenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartInferenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceIndex", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11638,27 +16526,14 @@ func (m *MsgStartInferenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InferenceIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11686,7 +16561,7 @@ func (m *MsgStartInferenceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			m.Result = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11709,7 +16584,7 @@ func (m *MsgStartInferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11732,10 +16607,10 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinishInference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPocBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinishInference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPocBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11771,40 +16646,189 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
+			}
+			m.PocStageStartBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nonces = append(m.Nonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nonces) == 0 {
+					m.Nonces = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nonces = append(m.Nonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+		case 5:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Dist = append(m.Dist, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Dist) == 0 {
+					m.Dist = make([]float64, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Dist = append(m.Dist, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11832,13 +16856,13 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResponseHash = string(dAtA[iNdEx:postIndex])
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11848,29 +16872,31 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResponsePayload = string(dAtA[iNdEx:postIndex])
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromptTokenCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceCount", wireType)
 			}
-			m.PromptTokenCount = 0
+			m.NonceCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11880,16 +16906,16 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PromptTokenCount |= uint64(b&0x7F) << shift
+				m.NonceCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTokenCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstNonce", wireType)
 			}
-			m.CompletionTokenCount = 0
+			m.FirstNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11899,16 +16925,16 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompletionTokenCount |= uint64(b&0x7F) << shift
+				m.FirstNonce |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBy", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastNonce", wireType)
 			}
-			var stringLen uint64
+			m.LastNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11918,29 +16944,16 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastNonce |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExecutedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferredBy", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceStride", wireType)
 			}
-			var stringLen uint64
+			m.NonceStride = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11950,78 +16963,114 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NonceStride |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferredBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimestamp", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitPocBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			m.RequestTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferSignature", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitPocBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitPocBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferSignature = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitPocValidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitPocValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12049,11 +17098,11 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecutorSignature = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12081,13 +17130,13 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestedBy = string(dAtA[iNdEx:postIndex])
+			m.ParticipantAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPrompt", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.PocStageStartBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12097,29 +17146,222 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nonces = append(m.Nonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nonces) == 0 {
+					m.Nonces = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nonces = append(m.Nonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 5:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Dist = append(m.Dist, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Dist) == 0 {
+					m.Dist = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Dist = append(m.Dist, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
 			}
-			if postIndex > l {
+		case 6:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.ReceivedDist = append(m.ReceivedDist, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.ReceivedDist) == 0 {
+					m.ReceivedDist = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.ReceivedDist = append(m.ReceivedDist, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedDist", wireType)
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RTarget", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalPrompt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RTarget = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudThreshold", wireType)
 			}
-			var stringLen uint64
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FraudThreshold = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NInvalid", wireType)
+			}
+			m.NInvalid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12129,29 +17371,27 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NInvalid |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbabilityHonest", wireType)
 			}
-			m.Model = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromptHash", wireType)
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ProbabilityHonest = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudDetected", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12161,29 +17401,17 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PromptHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 16:
+			m.FraudDetected = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPromptHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12193,23 +17421,25 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalPromptHash = string(dAtA[iNdEx:postIndex])
+			m.Disputes = append(m.Disputes, PoCLeafDispute{})
+			if err := m.Disputes[len(m.Disputes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12232,7 +17462,7 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinishInferenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPocValidationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12255,76 +17485,12 @@ func (m *MsgFinishInferenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinishInferenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPocValidationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinishInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPocValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceIndex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InferenceIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12346,7 +17512,7 @@ func (m *MsgFinishInferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12369,10 +17535,10 @@ func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitNewParticipant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitSeed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitNewParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitSeed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12408,10 +17574,10 @@ func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
 			}
-			var stringLen uint64
+			m.EpochIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12421,27 +17587,14 @@ func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12469,40 +17622,58 @@ func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorKey = string(dAtA[iNdEx:postIndex])
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSeedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSeedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12524,7 +17695,7 @@ func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12547,15 +17718,15 @@ func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitNewParticipantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitNewParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12583,13 +17754,13 @@ func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParticipantIndex = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var stringLen uint64
+			m.Price = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12599,24 +17770,61 @@ func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Price |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12638,7 +17846,7 @@ func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValidation) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12661,15 +17869,15 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterModel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterModel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12697,11 +17905,11 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12729,11 +17937,11 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ProposedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12761,11 +17969,30 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitsOfComputePerToken", wireType)
+			}
+			m.UnitsOfComputePerToken = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnitsOfComputePerToken |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HfRepo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12793,11 +18020,11 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResponsePayload = string(dAtA[iNdEx:postIndex])
+			m.HfRepo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HfCommit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12825,24 +18052,13 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResponseHash = string(dAtA[iNdEx:postIndex])
+			m.HfCommit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Value = float64(math.Float64frombits(v))
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revalidation", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelArgs", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12852,117 +18068,29 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Revalidation = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgValidationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValidationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipant: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			m.ModelArgs = append(m.ModelArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRam", wireType)
 			}
-			var stringLen uint64
+			m.VRam = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12972,29 +18100,35 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.VRam |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThroughputPerNonce", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ThroughputPerNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThroughputPerNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationThreshold", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13004,29 +18138,33 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.ValidationThreshold == nil {
+				m.ValidationThreshold = &Decimal{}
+			}
+			if err := m.ValidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextWindow", wireType)
 			}
-			var stringLen uint64
+			m.ContextWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13036,27 +18174,14 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ContextWindow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13084,13 +18209,13 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
+			m.Quantization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKey", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinsPerInputToken", wireType)
 			}
-			var stringLen uint64
+			m.CoinsPerInputToken = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13100,29 +18225,16 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CoinsPerInputToken |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerKey", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinsPerOutputToken", wireType)
 			}
-			var stringLen uint64
+			m.CoinsPerOutputToken = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13132,24 +18244,11 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CoinsPerOutputToken |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WorkerKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13171,7 +18270,7 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitNewUnfundedParticipantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterModelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13194,10 +18293,10 @@ func (m *MsgSubmitNewUnfundedParticipantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterModelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterModelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13221,7 +18320,7 @@ func (m *MsgSubmitNewUnfundedParticipantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13244,10 +18343,10 @@ func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInvalidateInference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateTrainingTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInvalidateInference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13284,9 +18383,9 @@ func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HardwareResources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13296,29 +18395,31 @@ func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			m.HardwareResources = append(m.HardwareResources, &TrainingHardwareResources{})
+			if err := m.HardwareResources[len(m.HardwareResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Invalidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13328,24 +18429,66 @@ func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Invalidator = string(dAtA[iNdEx:postIndex])
+			if m.Config == nil {
+				m.Config = &TrainingConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			m.Budget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Budget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentPerNodeEpoch", wireType)
+			}
+			m.PaymentPerNodeEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentPerNodeEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13367,7 +18510,7 @@ func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInvalidateInferenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13390,12 +18533,48 @@ func (m *MsgInvalidateInferenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInvalidateInferenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateTrainingTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInvalidateInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &TrainingTask{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13417,7 +18596,7 @@ func (m *MsgInvalidateInferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
+func (m *MsgSettleTrainingPayments) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13440,10 +18619,10 @@ func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevalidateInference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSettleTrainingPayments: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevalidateInference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSettleTrainingPayments: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13479,42 +18658,10 @@ func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Invalidator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var stringLen uint64
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13524,24 +18671,11 @@ func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Invalidator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13563,7 +18697,7 @@ func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevalidateInferenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSettleTrainingPaymentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13586,12 +18720,31 @@ func (m *MsgRevalidateInferenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevalidateInferenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSettleTrainingPaymentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevalidateInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSettleTrainingPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			m.Paid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Paid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13613,7 +18766,7 @@ func (m *MsgRevalidateInferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgFinishTrainingTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13636,10 +18789,10 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinishTrainingTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinishTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13676,28 +18829,9 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
-			}
-			m.Seed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Seed |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			m.EpochIndex = 0
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13707,7 +18841,7 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochIndex |= uint64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13733,7 +18867,7 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFinishTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13756,17 +18890,17 @@ func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinishTrainingTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinishTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
 			}
-			m.Amount = 0
+			m.Paid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13776,16 +18910,16 @@ func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.Paid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 			}
-			var stringLen uint64
+			m.Refunded = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13795,24 +18929,11 @@ func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Refunded |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13834,7 +18955,7 @@ func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelTrainingTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13857,10 +18978,10 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitPocBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelTrainingTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitPocBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13897,190 +19018,9 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
-			}
-			m.PocStageStartBlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BatchId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Nonces = append(m.Nonces, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Nonces) == 0 {
-					m.Nonces = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Nonces = append(m.Nonces, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
-			}
-		case 5:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
-				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				v2 := float64(math.Float64frombits(v))
-				m.Dist = append(m.Dist, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.Dist) == 0 {
-					m.Dist = make([]float64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					v2 := float64(math.Float64frombits(v))
-					m.Dist = append(m.Dist, v2)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var stringLen uint64
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14090,24 +19030,11 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14129,7 +19056,7 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitPocBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14152,12 +19079,50 @@ func (m *MsgSubmitPocBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitPocBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelTrainingTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitPocBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			m.Paid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Paid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			m.Refunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Refunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14179,7 +19144,7 @@ func (m *MsgSubmitPocBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14202,10 +19167,10 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitPocValidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitHardwareDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitPocValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitHardwareDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14232,276 +19197,187 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthTx
 			}
 			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ParticipantAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
-			}
-			m.PocStageStartBlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Nonces = append(m.Nonces, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Nonces) == 0 {
-					m.Nonces = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Nonces = append(m.Nonces, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
-			}
-		case 5:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
-				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				v2 := float64(math.Float64frombits(v))
-				m.Dist = append(m.Dist, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.Dist) == 0 {
-					m.Dist = make([]float64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					v2 := float64(math.Float64frombits(v))
-					m.Dist = append(m.Dist, v2)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
-			}
-		case 6:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
-				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				v2 := float64(math.Float64frombits(v))
-				m.ReceivedDist = append(m.ReceivedDist, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOrModified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOrModified = append(m.NewOrModified, &HardwareNode{})
+			if err := m.NewOrModified[len(m.NewOrModified)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.ReceivedDist) == 0 {
-					m.ReceivedDist = make([]float64, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					v2 := float64(math.Float64frombits(v))
-					m.ReceivedDist = append(m.ReceivedDist, v2)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedDist", wireType)
 			}
-		case 7:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RTarget", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.RTarget = float64(math.Float64frombits(v))
-		case 8:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FraudThreshold", wireType)
+			m.Removed = append(m.Removed, &HardwareNode{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.FraudThreshold = float64(math.Float64frombits(v))
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NInvalid", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitHardwareDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			m.NInvalid = 0
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitHardwareDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitHardwareDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14511,27 +19387,29 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NInvalid |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProbabilityHonest", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.ProbabilityHonest = float64(math.Float64frombits(v))
-		case 11:
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FraudDetected", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var v int
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14541,12 +19419,11 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FraudDetected = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14568,7 +19445,7 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitPocValidationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimTrainingTaskForAssignmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14591,10 +19468,10 @@ func (m *MsgSubmitPocValidationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitPocValidationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitPocValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14618,7 +19495,7 @@ func (m *MsgSubmitPocValidationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
+func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14641,10 +19518,10 @@ func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSeed: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAssignTrainingTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSeed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAssignTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14681,9 +19558,9 @@ func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			m.EpochIndex = 0
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14693,16 +19570,16 @@ func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochIndex |= uint64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14712,23 +19589,25 @@ func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.Assignees = append(m.Assignees, &TrainingTaskAssignee{})
+			if err := m.Assignees[len(m.Assignees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14751,7 +19630,7 @@ func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSeedResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAssignTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14774,10 +19653,10 @@ func (m *MsgSubmitSeedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSeedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAssignTrainingTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAssignTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14801,7 +19680,7 @@ func (m *MsgSubmitSeedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14824,15 +19703,15 @@ func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePartialUpgrade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePartialUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14860,13 +19739,13 @@ func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Price = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14876,11 +19755,75 @@ func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiBinariesJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiBinariesJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14902,7 +19845,7 @@ func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePartialUpgradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14925,10 +19868,10 @@ func (m *MsgSubmitUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePartialUpgradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePartialUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14952,7 +19895,7 @@ func (m *MsgSubmitUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14975,15 +19918,15 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterModel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitTrainingKvRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterModel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitTrainingKvRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15011,13 +19954,13 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var stringLen uint64
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15027,27 +19970,14 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProposedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15075,30 +20005,11 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnitsOfComputePerToken", wireType)
-			}
-			m.UnitsOfComputePerToken = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnitsOfComputePerToken |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HfRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15126,11 +20037,11 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HfRepo = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HfCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15155,14 +20066,114 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitTrainingKvRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitTrainingKvRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HfCommit = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinTraining: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinTraining: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModelArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15190,49 +20201,11 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModelArgs = append(m.ModelArgs, string(dAtA[iNdEx:postIndex]))
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VRam", wireType)
-			}
-			m.VRam = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VRam |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThroughputPerNonce", wireType)
-			}
-			m.ThroughputPerNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ThroughputPerNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidationThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15259,10 +20232,10 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidationThreshold == nil {
-				m.ValidationThreshold = &Decimal{}
+			if m.Req == nil {
+				m.Req = &JoinTrainingRequest{}
 			}
-			if err := m.ValidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15287,7 +20260,7 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterModelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinTrainingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15310,12 +20283,48 @@ func (m *MsgRegisterModelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterModelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinTrainingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterModelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinTrainingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &MLNodeTrainStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15337,7 +20346,7 @@ func (m *MsgRegisterModelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
+func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15360,10 +20369,10 @@ func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTrainingTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTrainingHeartbeat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTrainingHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15400,41 +20409,7 @@ func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HardwareResources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HardwareResources = append(m.HardwareResources, &TrainingHardwareResources{})
-			if err := m.HardwareResources[len(m.HardwareResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15461,10 +20436,10 @@ func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &TrainingConfig{}
+			if m.Req == nil {
+				m.Req = &HeartbeatRequest{}
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15489,7 +20464,7 @@ func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTrainingTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTrainingHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15512,15 +20487,15 @@ func (m *MsgCreateTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTrainingTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTrainingHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTrainingHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15547,10 +20522,10 @@ func (m *MsgCreateTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &TrainingTask{}
+			if m.Resp == nil {
+				m.Resp = &HeartbeatResponse{}
 			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15575,7 +20550,7 @@ func (m *MsgCreateTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15598,10 +20573,10 @@ func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitHardwareDiff: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBarrier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitHardwareDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBarrier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15638,7 +20613,7 @@ func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOrModified", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15665,42 +20640,10 @@ func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOrModified = append(m.NewOrModified, &HardwareNode{})
-			if err := m.NewOrModified[len(m.NewOrModified)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Req == nil {
+				m.Req = &SetBarrierRequest{}
 			}
-			m.Removed = append(m.Removed, &HardwareNode{})
-			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15725,7 +20668,7 @@ func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitHardwareDiffResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBarrierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15748,12 +20691,48 @@ func (m *MsgSubmitHardwareDiffResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitHardwareDiffResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBarrierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitHardwareDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBarrierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resp == nil {
+				m.Resp = &SetBarrierResponse{}
+			}
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15775,7 +20754,7 @@ func (m *MsgSubmitHardwareDiffResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15798,10 +20777,10 @@ func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignment: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinTrainingStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinTrainingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15837,10 +20816,10 @@ func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
 			}
-			m.TaskId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15850,11 +20829,28 @@ func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Req == nil {
+				m.Req = &JoinTrainingRequest{}
+			}
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15876,7 +20872,7 @@ func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimTrainingTaskForAssignmentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinTrainingStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15899,12 +20895,48 @@ func (m *MsgClaimTrainingTaskForAssignmentResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinTrainingStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinTrainingStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &MLNodeTrainStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15926,7 +20958,7 @@ func (m *MsgClaimTrainingTaskForAssignmentResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15949,10 +20981,10 @@ func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAssignTrainingTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateDummyTrainingTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAssignTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateDummyTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15988,27 +21020,8 @@ func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assignees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16035,8 +21048,10 @@ func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assignees = append(m.Assignees, &TrainingTaskAssignee{})
-			if err := m.Assignees[len(m.Assignees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Task == nil {
+				m.Task = &TrainingTask{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16061,7 +21076,7 @@ func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAssignTrainingTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateDummyTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16084,12 +21099,48 @@ func (m *MsgAssignTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAssignTrainingTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateDummyTrainingTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAssignTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateDummyTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &TrainingTask{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16111,7 +21162,7 @@ func (m *MsgAssignTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
+func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16134,15 +21185,15 @@ func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePartialUpgrade: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBridgeExchange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePartialUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBridgeExchange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16170,30 +21221,11 @@ func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16221,11 +21253,11 @@ func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeVersion = string(dAtA[iNdEx:postIndex])
+			m.OriginChain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiBinariesJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16253,111 +21285,43 @@ func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiBinariesJson = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreatePartialUpgradeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePartialUpgradeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePartialUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitTrainingKvRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitTrainingKvRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerPubKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16385,13 +21349,13 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.OwnerPubKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.TaskId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -16401,14 +21365,27 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16436,11 +21413,11 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
+			m.BlockNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16468,11 +21445,11 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.ReceiptIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16500,7 +21477,39 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptProof = append(m.ReceiptProof, make([]byte, postIndex-iNdEx))
+			copy(m.ReceiptProof[len(m.ReceiptProof)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16523,7 +21532,7 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBridgeExchangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16546,12 +21555,44 @@ func (m *MsgSubmitTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitTrainingKvRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBridgeExchangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitTrainingKvRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBridgeExchangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16573,7 +21614,7 @@ func (m *MsgSubmitTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
+func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16596,15 +21637,15 @@ func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinTraining: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddUserToTrainingAllowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinTraining: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddUserToTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16632,13 +21673,13 @@ func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -16648,28 +21689,43 @@ func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Req == nil {
-				m.Req = &JoinTrainingRequest{}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= TrainingRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16691,7 +21747,7 @@ func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinTrainingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddUserToTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16714,48 +21770,12 @@ func (m *MsgJoinTrainingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinTrainingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddUserToTrainingAllowListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinTrainingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddUserToTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Status == nil {
-				m.Status = &MLNodeTrainStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16777,7 +21797,7 @@ func (m *MsgJoinTrainingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16800,15 +21820,15 @@ func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTrainingHeartbeat: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTrainingHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16836,13 +21856,13 @@ func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -16852,28 +21872,43 @@ func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Req == nil {
-				m.Req = &HeartbeatRequest{}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= TrainingRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16895,7 +21930,7 @@ func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTrainingHeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveUserFromTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16918,48 +21953,12 @@ func (m *MsgTrainingHeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTrainingHeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTrainingHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Resp == nil {
-				m.Resp = &HeartbeatResponse{}
-			}
-			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16981,7 +21980,7 @@ func (m *MsgTrainingHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17004,15 +22003,15 @@ func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBarrier: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTrainingAllowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBarrier: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17040,13 +22039,13 @@ func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -17056,28 +22055,43 @@ func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Req == nil {
-				m.Req = &SetBarrierRequest{}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= TrainingRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -17099,7 +22113,7 @@ func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBarrierResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17122,48 +22136,12 @@ func (m *MsgSetBarrierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBarrierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTrainingAllowListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBarrierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Resp == nil {
-				m.Resp = &SetBarrierResponse{}
-			}
-			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -17185,7 +22163,7 @@ func (m *MsgSetBarrierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
+func (m *MsgAddParticipantsToAllowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17208,15 +22186,15 @@ func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinTrainingStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddParticipantsToAllowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinTrainingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddParticipantsToAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17244,13 +22222,13 @@ func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -17260,27 +22238,23 @@ func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Req == nil {
-				m.Req = &JoinTrainingRequest{}
-			}
-			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17303,7 +22277,7 @@ func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinTrainingStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddParticipantsToAllowListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17326,48 +22300,12 @@ func (m *MsgJoinTrainingStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinTrainingStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddParticipantsToAllowListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinTrainingStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddParticipantsToAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Status == nil {
-				m.Status = &MLNodeTrainStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -17389,7 +22327,7 @@ func (m *MsgJoinTrainingStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveParticipantsFromAllowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17412,15 +22350,15 @@ func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDummyTrainingTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDummyTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17448,13 +22386,13 @@ func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -17464,27 +22402,23 @@ func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &TrainingTask{}
-			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17507,7 +22441,7 @@ func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDummyTrainingTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveParticipantsFromAllowListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17530,48 +22464,12 @@ func (m *MsgCreateDummyTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDummyTrainingTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDummyTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Task == nil {
-				m.Task = &TrainingTask{}
-			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -17593,7 +22491,7 @@ func (m *MsgCreateDummyTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterBridgeAddresses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17616,15 +22514,15 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBridgeExchange: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterBridgeAddresses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBridgeExchange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterBridgeAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17652,11 +22550,11 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17684,11 +22582,11 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginChain = string(dAtA[iNdEx:postIndex])
+			m.ChainName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17716,139 +22614,111 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerPubKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterBridgeAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerPubKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterBridgeAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterBridgeAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBridgeRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockNumber = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBridgeRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBridgeRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17876,13 +22746,13 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiptIndex = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -17892,23 +22762,24 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17931,7 +22802,7 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBridgeExchangeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBridgeRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17954,44 +22825,12 @@ func (m *MsgBridgeExchangeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBridgeExchangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBridgeRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBridgeExchangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBridgeRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -18013,7 +22852,7 @@ func (m *MsgBridgeExchangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBridgePaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18036,10 +22875,10 @@ func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddUserToTrainingAllowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBridgePaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddUserToTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBridgePaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18076,7 +22915,7 @@ func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18104,13 +22943,13 @@ func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			m.Role = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -18120,11 +22959,44 @@ func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= TrainingRole(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -18146,7 +23018,7 @@ func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddUserToTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBridgePausedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18169,10 +23041,10 @@ func (m *MsgAddUserToTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddUserToTrainingAllowListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBridgePausedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddUserToTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBridgePausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18196,7 +23068,7 @@ func (m *MsgAddUserToTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18219,10 +23091,10 @@ func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterTokenMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterTokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18259,7 +23131,7 @@ func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18287,13 +23159,109 @@ func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
 			}
-			m.Role = 0
+			m.Decimals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -18303,11 +23271,31 @@ func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= TrainingRole(b&0x7F) << shift
+				m.Decimals |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -18329,7 +23317,7 @@ func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveUserFromTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterTokenMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18352,10 +23340,10 @@ func (m *MsgRemoveUserFromTrainingAllowListResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterTokenMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterTokenMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18379,7 +23367,7 @@ func (m *MsgRemoveUserFromTrainingAllowListResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveBridgeTokenForTrading) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18402,10 +23390,10 @@ func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTrainingAllowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveBridgeTokenForTrading: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveBridgeTokenForTrading: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18442,7 +23430,7 @@ func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18470,13 +23458,13 @@ func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			m.Role = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -18486,11 +23474,24 @@ func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= TrainingRole(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -18512,7 +23513,7 @@ func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveBridgeTokenForTradingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18535,10 +23536,10 @@ func (m *MsgSetTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTrainingAllowListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveBridgeTokenForTradingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveBridgeTokenForTradingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18562,7 +23563,7 @@ func (m *MsgSetTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddParticipantsToAllowList) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterLiquidityPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18585,10 +23586,10 @@ func (m *MsgAddParticipantsToAllowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddParticipantsToAllowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterLiquidityPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddParticipantsToAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterLiquidityPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18625,7 +23626,7 @@ func (m *MsgAddParticipantsToAllowList) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18653,7 +23654,71 @@ func (m *MsgAddParticipantsToAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.CodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateMsg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstantiateMsg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18676,7 +23741,7 @@ func (m *MsgAddParticipantsToAllowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddParticipantsToAllowListResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18699,10 +23764,10 @@ func (m *MsgAddParticipantsToAllowListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddParticipantsToAllowListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterLiquidityPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddParticipantsToAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterLiquidityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18726,7 +23791,7 @@ func (m *MsgAddParticipantsToAllowListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveParticipantsFromAllowList) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18749,15 +23814,15 @@ func (m *MsgRemoveParticipantsFromAllowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestBridgeWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestBridgeWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18785,11 +23850,11 @@ func (m *MsgRemoveParticipantsFromAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18817,58 +23882,72 @@ func (m *MsgRemoveParticipantsFromAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.UserAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRemoveParticipantsFromAllowListResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -18890,7 +23969,7 @@ func (m *MsgRemoveParticipantsFromAllowListResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *MsgRegisterBridgeAddresses) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestBridgeWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18913,15 +23992,15 @@ func (m *MsgRegisterBridgeAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterBridgeAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestBridgeWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterBridgeAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestBridgeWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18949,13 +24028,13 @@ func (m *MsgRegisterBridgeAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
 			}
-			var stringLen uint64
+			m.EpochIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -18963,29 +24042,16 @@ func (m *MsgRegisterBridgeAddresses) Unmarshal(dAtA []byte) error {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ChainName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsRequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19013,7 +24079,7 @@ func (m *MsgRegisterBridgeAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.BlsRequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19036,57 +24102,7 @@ func (m *MsgRegisterBridgeAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterBridgeAddressesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterBridgeAddressesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterBridgeAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19109,15 +24125,15 @@ func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterTokenMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestBridgeMint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterTokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestBridgeMint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19145,11 +24161,11 @@ func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19177,11 +24193,11 @@ func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19209,11 +24225,11 @@ func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19241,11 +24257,61 @@ func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestBridgeMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestBridgeMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestBridgeMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19273,13 +24339,13 @@ func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
 			}
-			m.Decimals = 0
+			m.EpochIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -19289,16 +24355,16 @@ func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= uint32(b&0x7F) << shift
+				m.EpochIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsRequestId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -19308,62 +24374,24 @@ func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Overwrite = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRegisterTokenMetadataResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterTokenMetadataResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterTokenMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.BlsRequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -19385,7 +24413,7 @@ func (m *MsgRegisterTokenMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgApproveBridgeTokenForTrading) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterWrappedTokenContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19408,10 +24436,10 @@ func (m *MsgApproveBridgeTokenForTrading) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgApproveBridgeTokenForTrading: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterWrappedTokenContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgApproveBridgeTokenForTrading: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterWrappedTokenContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19447,42 +24475,10 @@ func (m *MsgApproveBridgeTokenForTrading) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
 			}
-			var stringLen uint64
+			m.CodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -19492,24 +24488,11 @@ func (m *MsgApproveBridgeTokenForTrading) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CodeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -19531,7 +24514,7 @@ func (m *MsgApproveBridgeTokenForTrading) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgApproveBridgeTokenForTradingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterWrappedTokenContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19554,10 +24537,10 @@ func (m *MsgApproveBridgeTokenForTradingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgApproveBridgeTokenForTradingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterWrappedTokenContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgApproveBridgeTokenForTradingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterWrappedTokenContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -19581,7 +24564,7 @@ func (m *MsgApproveBridgeTokenForTradingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterLiquidityPool) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateAllWrappedTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19604,10 +24587,10 @@ func (m *MsgRegisterLiquidityPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterLiquidityPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateAllWrappedTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterLiquidityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateAllWrappedTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19643,10 +24626,10 @@ func (m *MsgRegisterLiquidityPool) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCodeId", wireType)
 			}
-			var stringLen uint64
+			m.NewCodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -19656,27 +24639,14 @@ func (m *MsgRegisterLiquidityPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NewCodeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CodeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsgJson", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19704,13 +24674,13 @@ func (m *MsgRegisterLiquidityPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.MigrateMsgJson = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateMsg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -19720,24 +24690,11 @@ func (m *MsgRegisterLiquidityPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InstantiateMsg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -19759,7 +24716,7 @@ func (m *MsgRegisterLiquidityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateAllWrappedTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19782,12 +24739,31 @@ func (m *MsgRegisterLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterLiquidityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateAllWrappedTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterLiquidityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateAllWrappedTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempted", wireType)
+			}
+			m.Attempted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempted |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -19809,7 +24785,7 @@ func (m *MsgRegisterLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
+func (m *MsgAppealInvalidation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19832,10 +24808,10 @@ func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBridgeWithdrawal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAppealInvalidation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBridgeWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAppealInvalidation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19872,7 +24848,7 @@ func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19900,13 +24876,63 @@ func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserAddress = string(dAtA[iNdEx:postIndex])
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAppealInvalidationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppealInvalidationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppealInvalidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineBlockHeight", wireType)
+			}
+			m.DeadlineBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -19916,27 +24942,14 @@ func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DeadlineBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedValidators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19964,7 +24977,7 @@ func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			m.AssignedValidators = append(m.AssignedValidators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19987,7 +25000,7 @@ func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBridgeWithdrawalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitAppealVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20010,15 +25023,15 @@ func (m *MsgRequestBridgeWithdrawalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBridgeWithdrawalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitAppealVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBridgeWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitAppealVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20046,30 +25059,11 @@ func (m *MsgRequestBridgeWithdrawalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
-			}
-			m.EpochIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsRequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20097,8 +25091,69 @@ func (m *MsgRequestBridgeWithdrawalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlsRequestId = string(dAtA[iNdEx:postIndex])
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitAppealVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitAppealVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitAppealVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -20120,7 +25175,7 @@ func (m *MsgRequestBridgeWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
+func (m *MsgSetModelStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20143,15 +25198,15 @@ func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBridgeMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetModelStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBridgeMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetModelStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20179,11 +25234,11 @@ func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20211,13 +25266,13 @@ func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -20227,27 +25282,33 @@ func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= ModelStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetEpoch", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SunsetEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SunsetEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20275,7 +25336,7 @@ func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.SuccessorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20298,7 +25359,7 @@ func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBridgeMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetModelStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20321,95 +25382,12 @@ func (m *MsgRequestBridgeMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBridgeMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetModelStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBridgeMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetModelStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
-			}
-			m.EpochIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsRequestId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlsRequestId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -20431,7 +25409,7 @@ func (m *MsgRequestBridgeMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterWrappedTokenContract) Unmarshal(dAtA []byte) error {
+func (m *MsgSetModelAlias) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20454,10 +25432,10 @@ func (m *MsgRegisterWrappedTokenContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterWrappedTokenContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetModelAlias: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterWrappedTokenContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetModelAlias: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20493,10 +25471,10 @@ func (m *MsgRegisterWrappedTokenContract) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
-			m.CodeId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -20506,11 +25484,56 @@ func (m *MsgRegisterWrappedTokenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -20532,7 +25555,7 @@ func (m *MsgRegisterWrappedTokenContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterWrappedTokenContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetModelAliasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20555,10 +25578,10 @@ func (m *MsgRegisterWrappedTokenContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterWrappedTokenContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetModelAliasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterWrappedTokenContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetModelAliasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -20582,7 +25605,7 @@ func (m *MsgRegisterWrappedTokenContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateAllWrappedTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgAttestBridgeBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20605,15 +25628,15 @@ func (m *MsgMigrateAllWrappedTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateAllWrappedTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAttestBridgeBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateAllWrappedTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAttestBridgeBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20641,13 +25664,13 @@ func (m *MsgMigrateAllWrappedTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewCodeId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
 			}
-			m.NewCodeId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -20657,14 +25680,27 @@ func (m *MsgMigrateAllWrappedTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewCodeId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsgJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20692,13 +25728,13 @@ func (m *MsgMigrateAllWrappedTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MigrateMsgJson = string(dAtA[iNdEx:postIndex])
+			m.BlockNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -20708,11 +25744,24 @@ func (m *MsgMigrateAllWrappedTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -20734,7 +25783,7 @@ func (m *MsgMigrateAllWrappedTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateAllWrappedTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAttestBridgeBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20757,17 +25806,17 @@ func (m *MsgMigrateAllWrappedTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateAllWrappedTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAttestBridgeBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateAllWrappedTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAttestBridgeBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attested", wireType)
 			}
-			m.Attempted = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -20777,11 +25826,12 @@ func (m *MsgMigrateAllWrappedTokensResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempted |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Attested = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
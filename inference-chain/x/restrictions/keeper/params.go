@@ -25,6 +25,9 @@ func (k Keeper) GetParams(ctx context.Context) (params types.Params) {
 	if params.ExemptionUsageTracking == nil {
 		params.ExemptionUsageTracking = []types.ExemptionUsage{}
 	}
+	if params.UnlockSchedules == nil {
+		params.UnlockSchedules = []types.UnlockSchedule{}
+	}
 
 	return params
 }
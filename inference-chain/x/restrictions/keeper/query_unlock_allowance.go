@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/productscience/inference/x/restrictions/types"
+)
+
+// UnlockAllowance queries the graduated unlock allowance of an account
+func (k Keeper) UnlockAllowance(goCtx context.Context, req *types.QueryUnlockAllowanceRequest) (*types.QueryUnlockAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.AccountAddress); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	allowance, found := k.GetUnlockAllowance(ctx, req.AccountAddress)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "account %s has no unlock schedule", req.AccountAddress)
+	}
+
+	return &types.QueryUnlockAllowanceResponse{
+		Allowance: allowance,
+	}, nil
+}
@@ -44,7 +44,13 @@ func (k Keeper) SendRestrictionFn(ctx context.Context, from, to sdk.AccAddress,
 		return to, nil
 	}
 
-	// 5. RESTRICTED - Direct User Transfers
+	// 5. PERMITTED - Graduated Unlock Transfers
+	// Transfers within the sender's unlocked allowance are allowed and counted against it
+	if k.ConsumeUnlockAllowance(sdkCtx, from, to, amt) {
+		return to, nil
+	}
+
+	// 6. RESTRICTED - Direct User Transfers
 	// This is a user-to-user transfer, which is restricted
 	params := k.GetParams(sdkCtx)
 	remainingBlocks := params.RestrictionEndBlock - uint64(sdkCtx.BlockHeight())
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/restrictions/types"
+)
+
+// GetUnlockScheduleForAccount returns the unlock schedule the account follows, if any
+func (k Keeper) GetUnlockScheduleForAccount(ctx sdk.Context, address string) (types.UnlockSchedule, bool) {
+	for _, schedule := range k.GetParams(ctx).UnlockSchedules {
+		if schedule.HasAccount(address) {
+			return schedule, true
+		}
+	}
+	return types.UnlockSchedule{}, false
+}
+
+// GetUnlockTransferred returns the amount an account has transferred under an unlock schedule
+func (k Keeper) GetUnlockTransferred(ctx sdk.Context, scheduleID, address string) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.UnlockUsageKey(scheduleID, address))
+	if bz == nil {
+		return 0
+	}
+	var usage types.UnlockUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	transferred, _ := strconv.ParseUint(usage.Transferred, 10, 64)
+	return transferred
+}
+
+// SetUnlockUsage stores the amount an account has transferred under an unlock schedule
+func (k Keeper) SetUnlockUsage(ctx sdk.Context, usage types.UnlockUsage) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.UnlockUsageKey(usage.ScheduleId, usage.AccountAddress), k.cdc.MustMarshal(&usage))
+}
+
+// GetAllUnlockUsages returns the unlock usage of every account that transferred under a schedule
+func (k Keeper) GetAllUnlockUsages(ctx sdk.Context) []types.UnlockUsage {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.UnlockUsagePrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var usages []types.UnlockUsage
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.UnlockUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+		usages = append(usages, usage)
+	}
+	return usages
+}
+
+// GetUnlockAllowance returns how much of its unlock schedule an account has unlocked, transferred and can still transfer
+func (k Keeper) GetUnlockAllowance(ctx sdk.Context, address string) (types.UnlockAllowance, bool) {
+	schedule, found := k.GetUnlockScheduleForAccount(ctx, address)
+	if !found {
+		return types.UnlockAllowance{}, false
+	}
+
+	unlocked := schedule.UnlockedAt(uint64(ctx.BlockHeight()))
+	transferred := k.GetUnlockTransferred(ctx, schedule.ScheduleId, address)
+	available := uint64(0)
+	if unlocked > transferred {
+		available = unlocked - transferred
+	}
+
+	return types.UnlockAllowance{
+		ScheduleId:  schedule.ScheduleId,
+		Category:    schedule.Category,
+		Denom:       schedule.Denom,
+		Unlocked:    strconv.FormatUint(unlocked, 10),
+		Transferred: strconv.FormatUint(transferred, 10),
+		Available:   strconv.FormatUint(available, 10),
+		EndBlock:    schedule.EndBlock,
+	}, true
+}
+
+// ConsumeUnlockAllowance permits a user-to-user transfer that fits in the sender's available unlock allowance
+// and records it against the schedule
+func (k Keeper) ConsumeUnlockAllowance(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) bool {
+	fromStr := from.String()
+	schedule, found := k.GetUnlockScheduleForAccount(ctx, fromStr)
+	if !found {
+		return false
+	}
+
+	// Only the scheduled denom is unlocked
+	if len(amt) != 1 || amt[0].Denom != schedule.Denom || !amt[0].Amount.IsUint64() {
+		return false
+	}
+	amount := amt[0].Amount.Uint64()
+
+	unlocked := schedule.UnlockedAt(uint64(ctx.BlockHeight()))
+	transferred := k.GetUnlockTransferred(ctx, schedule.ScheduleId, fromStr)
+	if transferred > unlocked || amount > unlocked-transferred {
+		return false
+	}
+
+	transferred += amount
+	k.SetUnlockUsage(ctx, types.UnlockUsage{
+		ScheduleId:     schedule.ScheduleId,
+		AccountAddress: fromStr,
+		Transferred:    strconv.FormatUint(transferred, 10),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnlockTransfer,
+			sdk.NewAttribute(types.AttributeKeyScheduleId, schedule.ScheduleId),
+			sdk.NewAttribute(types.AttributeKeyFromAddress, fromStr),
+			sdk.NewAttribute(types.AttributeKeyToAddress, to.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyDenom, schedule.Denom),
+			sdk.NewAttribute(types.AttributeKeyAvailable, strconv.FormatUint(unlocked-transferred, 10)),
+		),
+	)
+
+	return true
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/restrictions/types"
+)
+
+func TestUnlockSchedule_GraduatedAllowance(t *testing.T) {
+	keeper, ctx := keepertest.RestrictionsKeeper(t)
+
+	marketMaker := sdk.AccAddress("market_maker_______")
+	other := sdk.AccAddress("other_user_________")
+	to := sdk.AccAddress("to_address_________")
+
+	params := types.DefaultParams()
+	params.RestrictionEndBlock = 2000000
+	params.UnlockSchedules = []types.UnlockSchedule{
+		{
+			ScheduleId:    "mm-ramp",
+			Category:      "market_makers",
+			Accounts:      []string{marketMaker.String()},
+			Denom:         "ngonka",
+			InitialAmount: "1000",
+			TotalAmount:   "11000",
+			StartBlock:    1000,
+			EndBlock:      2000,
+		},
+	}
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	send := func(from sdk.AccAddress, amount int64) error {
+		_, err := keeper.SendRestrictionFn(ctx, from, to, sdk.NewCoins(sdk.NewCoin("ngonka", math.NewInt(amount))))
+		return err
+	}
+
+	// Nothing is unlocked before the schedule starts
+	ctx = ctx.WithBlockHeight(500)
+	require.ErrorIs(t, send(marketMaker, 1), types.ErrTransferRestricted)
+
+	// Halfway through: 1000 + 10000 * 500 / 1000
+	ctx = ctx.WithBlockHeight(1500)
+	require.NoError(t, send(marketMaker, 4000))
+	require.ErrorIs(t, send(marketMaker, 2001), types.ErrTransferRestricted)
+	require.NoError(t, send(marketMaker, 2000))
+
+	// Other denoms and accounts without a schedule stay restricted
+	_, err := keeper.SendRestrictionFn(ctx, marketMaker, to, sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(1))))
+	require.ErrorIs(t, err, types.ErrTransferRestricted)
+	require.ErrorIs(t, send(other, 1), types.ErrTransferRestricted)
+
+	res, err := keeper.UnlockAllowance(ctx, &types.QueryUnlockAllowanceRequest{AccountAddress: marketMaker.String()})
+	require.NoError(t, err)
+	require.Equal(t, "market_makers", res.Allowance.Category)
+	require.Equal(t, "6000", res.Allowance.Unlocked)
+	require.Equal(t, "6000", res.Allowance.Transferred)
+	require.Equal(t, "0", res.Allowance.Available)
+
+	// The full amount is unlocked once the schedule ends
+	ctx = ctx.WithBlockHeight(2500)
+	require.NoError(t, send(marketMaker, 5000))
+	require.ErrorIs(t, send(marketMaker, 1), types.ErrTransferRestricted)
+
+	_, err = keeper.UnlockAllowance(ctx, &types.QueryUnlockAllowanceRequest{AccountAddress: other.String()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	usages := keeper.GetAllUnlockUsages(ctx)
+	require.Len(t, usages, 1)
+	require.Equal(t, "11000", usages[0].Transferred)
+}
+
+func TestUnlockSchedule_Validation(t *testing.T) {
+	account := sdk.AccAddress("market_maker_______").String()
+	valid := types.UnlockSchedule{
+		ScheduleId:    "mm-ramp",
+		Accounts:      []string{account},
+		Denom:         "ngonka",
+		InitialAmount: "0",
+		TotalAmount:   "1000",
+		StartBlock:    10,
+		EndBlock:      20,
+	}
+
+	params := types.DefaultParams()
+	params.UnlockSchedules = []types.UnlockSchedule{valid}
+	require.NoError(t, params.Validate())
+
+	duplicateAccount := valid
+	duplicateAccount.ScheduleId = "other"
+	params.UnlockSchedules = []types.UnlockSchedule{valid, duplicateAccount}
+	require.Error(t, params.Validate())
+
+	decreasing := valid
+	decreasing.InitialAmount = "2000"
+	params.UnlockSchedules = []types.UnlockSchedule{decreasing}
+	require.Error(t, params.Validate())
+
+	emptyWindow := valid
+	emptyWindow.EndBlock = emptyWindow.StartBlock
+	params.UnlockSchedules = []types.UnlockSchedule{emptyWindow}
+	require.Error(t, params.Validate())
+}
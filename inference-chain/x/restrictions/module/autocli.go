@@ -39,6 +39,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "account_address"},
 					},
 				},
+				{
+					RpcMethod: "UnlockAllowance",
+					Use:       "unlock-allowance [account-address]",
+					Short:     "Query the graduated unlock allowance of an account",
+					Long:      "Query how much of its unlock schedule an account has unlocked, transferred and can still transfer at the current block.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "account_address"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
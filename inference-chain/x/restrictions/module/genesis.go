@@ -13,12 +13,17 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
 	}
+
+	for _, usage := range genState.UnlockUsages {
+		k.SetUnlockUsage(ctx, usage)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.UnlockUsages = k.GetAllUnlockUsages(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -17,6 +19,11 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	for idx, usage := range gs.UnlockUsages {
+		if err := validateUnlockUsage(usage); err != nil {
+			return fmt.Errorf("invalid unlock usage at index %d: %w", idx, err)
+		}
+	}
 
 	return gs.Params.Validate()
 }
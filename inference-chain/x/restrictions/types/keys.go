@@ -14,12 +14,14 @@ const (
 var (
 	ParamsKey                  = []byte("p_restrictions")
 	KeyRestrictionUnregistered = []byte("restriction_unregistered")
+	UnlockUsagePrefix          = []byte("unlock_usage/")
 )
 
 // Event types and attribute keys
 const (
 	EventTypeEmergencyTransfer = "emergency_transfer"
 	EventTypeRestrictionLifted = "restriction_lifted"
+	EventTypeUnlockTransfer    = "unlock_transfer"
 
 	AttributeKeyExemptionId         = "exemption_id"
 	AttributeKeyFromAddress         = "from_address"
@@ -29,8 +31,15 @@ const (
 	AttributeKeyRemainingUses       = "remaining_uses"
 	AttributeKeyCurrentBlock        = "current_block"
 	AttributeKeyRestrictionEndBlock = "restriction_end_block"
+	AttributeKeyScheduleId          = "schedule_id"
+	AttributeKeyAvailable           = "available"
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// UnlockUsageKey generates the key of an account's unlock usage: unlock_usage/{schedule_id}/{account_address}
+func UnlockUsageKey(scheduleID, accountAddress string) []byte {
+	return []byte(string(UnlockUsagePrefix) + scheduleID + "/" + accountAddress)
+}
@@ -15,6 +15,7 @@ var (
 	KeyRestrictionEndBlock         = []byte("RestrictionEndBlock")
 	KeyEmergencyTransferExemptions = []byte("EmergencyTransferExemptions")
 	KeyExemptionUsageTracking      = []byte("ExemptionUsageTracking")
+	KeyUnlockSchedules             = []byte("UnlockSchedules")
 )
 
 // Default parameter values
@@ -35,6 +36,7 @@ func NewParams() Params {
 		RestrictionEndBlock:         DefaultRestrictionEndBlock,
 		EmergencyTransferExemptions: []EmergencyTransferExemption{},
 		ExemptionUsageTracking:      []ExemptionUsage{},
+		UnlockSchedules:             []UnlockSchedule{},
 	}
 }
 
@@ -49,6 +51,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyRestrictionEndBlock, &p.RestrictionEndBlock, validateRestrictionEndBlock),
 		paramtypes.NewParamSetPair(KeyEmergencyTransferExemptions, &p.EmergencyTransferExemptions, validateEmergencyTransferExemptions),
 		paramtypes.NewParamSetPair(KeyExemptionUsageTracking, &p.ExemptionUsageTracking, validateExemptionUsageTracking),
+		paramtypes.NewParamSetPair(KeyUnlockSchedules, &p.UnlockSchedules, validateUnlockSchedules),
 	}
 }
 
@@ -63,6 +66,9 @@ func (p Params) Validate() error {
 	if err := validateExemptionUsageTracking(p.ExemptionUsageTracking); err != nil {
 		return err
 	}
+	if err := validateUnlockSchedules(p.UnlockSchedules); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -178,6 +178,169 @@ func (m *ExemptionUsage) GetUsageCount() uint64 {
 	return 0
 }
 
+// UnlockSchedule lets its accounts make user-to-user transfers while restrictions are active, up to a cumulative
+// allowance that grows linearly from initial_amount at start_block to total_amount at end_block
+type UnlockSchedule struct {
+	ScheduleId    string   `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Category      string   `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Accounts      []string `protobuf:"bytes,3,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Denom         string   `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	InitialAmount string   `protobuf:"bytes,5,opt,name=initial_amount,json=initialAmount,proto3" json:"initial_amount,omitempty"`
+	TotalAmount   string   `protobuf:"bytes,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	StartBlock    uint64   `protobuf:"varint,7,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	EndBlock      uint64   `protobuf:"varint,8,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+}
+
+func (m *UnlockSchedule) Reset()         { *m = UnlockSchedule{} }
+func (m *UnlockSchedule) String() string { return proto.CompactTextString(m) }
+func (*UnlockSchedule) ProtoMessage()    {}
+func (*UnlockSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7bd3f4339216f702, []int{2}
+}
+func (m *UnlockSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnlockSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockSchedule.Merge(m, src)
+}
+func (m *UnlockSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockSchedule proto.InternalMessageInfo
+
+func (m *UnlockSchedule) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *UnlockSchedule) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *UnlockSchedule) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *UnlockSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *UnlockSchedule) GetInitialAmount() string {
+	if m != nil {
+		return m.InitialAmount
+	}
+	return ""
+}
+
+func (m *UnlockSchedule) GetTotalAmount() string {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return ""
+}
+
+func (m *UnlockSchedule) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *UnlockSchedule) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+// UnlockUsage tracks the cumulative amount an account has transferred under its unlock schedule
+type UnlockUsage struct {
+	ScheduleId     string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	AccountAddress string `protobuf:"bytes,2,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+	Transferred    string `protobuf:"bytes,3,opt,name=transferred,proto3" json:"transferred,omitempty"`
+}
+
+func (m *UnlockUsage) Reset()         { *m = UnlockUsage{} }
+func (m *UnlockUsage) String() string { return proto.CompactTextString(m) }
+func (*UnlockUsage) ProtoMessage()    {}
+func (*UnlockUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7bd3f4339216f702, []int{3}
+}
+func (m *UnlockUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnlockUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockUsage.Merge(m, src)
+}
+func (m *UnlockUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockUsage proto.InternalMessageInfo
+
+func (m *UnlockUsage) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *UnlockUsage) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+func (m *UnlockUsage) GetTransferred() string {
+	if m != nil {
+		return m.Transferred
+	}
+	return ""
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// Block height when transfer restrictions end (default: 1,555,000)
@@ -186,13 +349,15 @@ type Params struct {
 	EmergencyTransferExemptions []EmergencyTransferExemption `protobuf:"bytes,2,rep,name=emergency_transfer_exemptions,json=emergencyTransferExemptions,proto3" json:"emergency_transfer_exemptions"`
 	// Usage tracking for emergency exemptions per account
 	ExemptionUsageTracking []ExemptionUsage `protobuf:"bytes,3,rep,name=exemption_usage_tracking,json=exemptionUsageTracking,proto3" json:"exemption_usage_tracking"`
+	// Governance-approved graduated unlock schedules
+	UnlockSchedules []UnlockSchedule `protobuf:"bytes,4,rep,name=unlock_schedules,json=unlockSchedules,proto3" json:"unlock_schedules"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7bd3f4339216f702, []int{2}
+	return fileDescriptor_7bd3f4339216f702, []int{4}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,9 +407,18 @@ func (m *Params) GetExemptionUsageTracking() []ExemptionUsage {
 	return nil
 }
 
+func (m *Params) GetUnlockSchedules() []UnlockSchedule {
+	if m != nil {
+		return m.UnlockSchedules
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EmergencyTransferExemption)(nil), "inference.restrictions.EmergencyTransferExemption")
 	proto.RegisterType((*ExemptionUsage)(nil), "inference.restrictions.ExemptionUsage")
+	proto.RegisterType((*UnlockSchedule)(nil), "inference.restrictions.UnlockSchedule")
+	proto.RegisterType((*UnlockUsage)(nil), "inference.restrictions.UnlockUsage")
 	proto.RegisterType((*Params)(nil), "inference.restrictions.Params")
 }
 
@@ -253,38 +427,49 @@ func init() {
 }
 
 var fileDescriptor_7bd3f4339216f702 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3f, 0x6f, 0x13, 0x31,
-	0x18, 0xc6, 0xe3, 0x24, 0x04, 0xd5, 0x81, 0x22, 0x0e, 0xa8, 0x4e, 0x41, 0xbd, 0x94, 0x82, 0x20,
-	0x62, 0xb8, 0x93, 0xc2, 0x82, 0xba, 0x35, 0x28, 0x03, 0x12, 0x03, 0x8a, 0xca, 0xc2, 0x62, 0x39,
-	0x3e, 0xdf, 0x61, 0x5a, 0xdb, 0x27, 0xdb, 0x27, 0x25, 0x12, 0x1b, 0x1b, 0x53, 0x3f, 0x02, 0x1f,
-	0x81, 0x8f, 0xd1, 0x81, 0xa1, 0x23, 0x13, 0x42, 0xc9, 0x00, 0x1f, 0x03, 0xd9, 0xbe, 0x5c, 0x13,
-	0x44, 0xa4, 0x2e, 0x91, 0xf3, 0xf3, 0x73, 0xef, 0x9f, 0xe7, 0xf5, 0x0b, 0x1f, 0x33, 0x91, 0x51,
-	0x45, 0x05, 0xa1, 0x89, 0xa2, 0xda, 0x28, 0x46, 0x0c, 0x93, 0x42, 0x27, 0x05, 0x56, 0x98, 0xeb,
-	0xb8, 0x50, 0xd2, 0xc8, 0x60, 0xaf, 0x16, 0xc5, 0xeb, 0xa2, 0xde, 0x5d, 0xcc, 0x99, 0x90, 0x89,
-	0xfb, 0xf5, 0xd2, 0xde, 0xfd, 0x5c, 0xe6, 0xd2, 0x1d, 0x13, 0x7b, 0xf2, 0xf4, 0xf0, 0xbc, 0x09,
-	0x7b, 0x63, 0x4e, 0x55, 0x4e, 0x05, 0x99, 0x9f, 0x28, 0x2c, 0x74, 0x46, 0xd5, 0x78, 0x46, 0x79,
-	0x61, 0x03, 0x05, 0x8f, 0xe0, 0x2d, 0xba, 0xfa, 0x83, 0x58, 0x1a, 0x82, 0x03, 0x30, 0xd8, 0x99,
-	0x74, 0x6b, 0xf6, 0x3a, 0xb5, 0x92, 0x4c, 0x49, 0x8e, 0x70, 0x9a, 0x2a, 0xaa, 0x75, 0xd8, 0xf4,
-	0x12, 0xcb, 0x8e, 0x3d, 0x0a, 0xf6, 0x21, 0x34, 0xb2, 0x16, 0xb4, 0x9c, 0x60, 0xc7, 0xc8, 0xb5,
-	0x6b, 0x8e, 0x67, 0x08, 0x73, 0x59, 0x0a, 0x13, 0xb6, 0xfd, 0x35, 0xc7, 0xb3, 0x63, 0x07, 0x82,
-	0x3e, 0xec, 0x96, 0x1a, 0xe7, 0x14, 0x9d, 0x31, 0xce, 0x4c, 0x78, 0xe3, 0x00, 0x0c, 0xda, 0x13,
-	0xe8, 0xd0, 0x1b, 0x4b, 0x7c, 0x91, 0x05, 0x53, 0x73, 0x34, 0x3d, 0x93, 0xe4, 0x34, 0xec, 0x38,
-	0x45, 0xd7, 0xb3, 0x91, 0x45, 0xc1, 0x13, 0x78, 0xfb, 0x63, 0xa9, 0x0d, 0xcb, 0x18, 0xc1, 0xb6,
-	0xee, 0xf0, 0xa6, 0xcb, 0xb2, 0x09, 0x8f, 0xda, 0x7f, 0xbe, 0xf6, 0xc1, 0xe1, 0x67, 0x00, 0x77,
-	0x6b, 0x07, 0xde, 0xd9, 0x34, 0xd7, 0xb1, 0xe1, 0x19, 0xbc, 0x83, 0x09, 0xb1, 0x05, 0xff, 0xe3,
-	0xc4, 0x6e, 0x85, 0x57, 0xdd, 0xd6, 0xed, 0x38, 0xea, 0xdc, 0x58, 0xb5, 0xf3, 0xca, 0x92, 0xaa,
-	0x8a, 0xef, 0x4d, 0xd8, 0x79, 0xeb, 0x46, 0x1d, 0x0c, 0xe1, 0x83, 0xb5, 0xe1, 0x22, 0x2a, 0xd2,
-	0xaa, 0x51, 0xe0, 0xbe, 0xbd, 0xb7, 0x76, 0x39, 0x16, 0xa9, 0x6f, 0xf8, 0x13, 0xdc, 0xa7, 0xab,
-	0xb1, 0x22, 0x53, 0xcd, 0x15, 0xd5, 0x05, 0xdb, 0xe2, 0x5a, 0x83, 0xee, 0x70, 0x18, 0xff, 0xff,
-	0x01, 0xc5, 0xdb, 0xdf, 0xc4, 0xa8, 0x7d, 0xf1, 0xb3, 0xdf, 0x98, 0x3c, 0xa4, 0x5b, 0x15, 0x3a,
-	0xc8, 0x60, 0x78, 0xe5, 0x97, 0xef, 0xd6, 0x28, 0x4c, 0x4e, 0x99, 0xc8, 0xc3, 0x96, 0x4b, 0xfc,
-	0x74, 0x6b, 0xe2, 0x0d, 0xe7, 0xab, 0x64, 0x7b, 0x74, 0x83, 0x9e, 0x54, 0xb1, 0x8e, 0x06, 0xd6,
-	0xaa, 0x2f, 0xbf, 0xbf, 0x3d, 0xef, 0x5f, 0x2d, 0xcb, 0x6c, 0x73, 0x5d, 0xbc, 0x87, 0xa3, 0xc9,
-	0xc5, 0x22, 0x02, 0x97, 0x8b, 0x08, 0xfc, 0x5a, 0x44, 0xe0, 0x7c, 0x19, 0x35, 0x2e, 0x97, 0x51,
-	0xe3, 0xc7, 0x32, 0x6a, 0xbc, 0x7f, 0x99, 0x33, 0xf3, 0xa1, 0x9c, 0xc6, 0x44, 0xf2, 0xa4, 0x50,
-	0x32, 0x2d, 0x89, 0xd1, 0x84, 0xb9, 0x50, 0x5b, 0x83, 0x9a, 0x79, 0x41, 0xf5, 0xb4, 0xe3, 0x56,
-	0xe8, 0xc5, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf7, 0xa5, 0xef, 0x78, 0xaa, 0x03, 0x00, 0x00,
+	// 658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x36, 0x69, 0xda, 0xce, 0xd6, 0x54, 0xc7, 0x5a, 0x96, 0x94, 0x26, 0x6d, 0xfd, 0x15,
+	0x3c, 0x24, 0x10, 0x2f, 0xd2, 0x5b, 0x2b, 0x3d, 0x14, 0x3c, 0x48, 0x6c, 0x11, 0xbc, 0x2c, 0xd3,
+	0xd9, 0xc9, 0x76, 0x6c, 0x76, 0x26, 0xcc, 0xcc, 0x42, 0x02, 0x82, 0x07, 0x6f, 0x82, 0xd0, 0x3f,
+	0xc1, 0x8b, 0x77, 0xff, 0x8c, 0x1e, 0x7b, 0xf4, 0x24, 0xd2, 0x1c, 0xf4, 0xcf, 0x90, 0xf9, 0xb1,
+	0x9b, 0xac, 0x18, 0xed, 0x25, 0xec, 0x7c, 0xef, 0xe5, 0xcd, 0xf7, 0xbe, 0xef, 0xbd, 0x01, 0xf7,
+	0x29, 0xeb, 0x13, 0x41, 0x18, 0x26, 0x1d, 0x41, 0xa4, 0x12, 0x14, 0x2b, 0xca, 0x99, 0xec, 0x0c,
+	0x91, 0x40, 0x89, 0x6c, 0x0f, 0x05, 0x57, 0x1c, 0x6e, 0xe4, 0x49, 0xed, 0xd9, 0xa4, 0xfa, 0x1d,
+	0x94, 0x50, 0xc6, 0x3b, 0xe6, 0xd7, 0xa6, 0xd6, 0xd7, 0x63, 0x1e, 0x73, 0xf3, 0xd9, 0xd1, 0x5f,
+	0x16, 0xdd, 0xbd, 0x58, 0x00, 0xf5, 0xc3, 0x84, 0x88, 0x98, 0x30, 0x3c, 0x3e, 0x16, 0x88, 0xc9,
+	0x3e, 0x11, 0x87, 0x23, 0x92, 0x0c, 0x75, 0x21, 0xb8, 0x03, 0x56, 0x49, 0x76, 0x08, 0x69, 0x14,
+	0x78, 0xdb, 0x5e, 0x6b, 0xa5, 0xe7, 0xe7, 0xd8, 0x51, 0xa4, 0x53, 0xfa, 0x82, 0x27, 0x21, 0x8a,
+	0x22, 0x41, 0xa4, 0x0c, 0x16, 0x6c, 0x8a, 0xc6, 0xf6, 0x2d, 0x04, 0xb7, 0x00, 0x50, 0x3c, 0x4f,
+	0x28, 0x9b, 0x84, 0x15, 0xc5, 0x67, 0xc2, 0x09, 0x1a, 0x85, 0x28, 0xe1, 0x29, 0x53, 0x41, 0xc5,
+	0x86, 0x13, 0x34, 0xda, 0x37, 0x00, 0x6c, 0x02, 0x3f, 0x95, 0x28, 0x26, 0xe1, 0x80, 0x26, 0x54,
+	0x05, 0x8b, 0xdb, 0x5e, 0xab, 0xd2, 0x03, 0x06, 0x7a, 0xa1, 0x11, 0x4b, 0x72, 0x48, 0xc5, 0x38,
+	0x3c, 0x1d, 0x70, 0x7c, 0x1e, 0x54, 0x4d, 0x86, 0x6f, 0xb1, 0x03, 0x0d, 0xc1, 0x07, 0xe0, 0xd6,
+	0xdb, 0x54, 0x2a, 0xda, 0xa7, 0x18, 0x69, 0xde, 0xc1, 0x92, 0xb9, 0xa5, 0x08, 0xee, 0x55, 0x7e,
+	0x7d, 0x6e, 0x7a, 0xbb, 0x1f, 0x3c, 0x50, 0xcb, 0x15, 0x38, 0xd1, 0xd7, 0xdc, 0x44, 0x86, 0xc7,
+	0x60, 0x0d, 0x61, 0xac, 0x09, 0xff, 0xa1, 0x44, 0xcd, 0xc1, 0x59, 0xb7, 0x79, 0x3b, 0x06, 0x35,
+	0x6a, 0x64, 0xed, 0x3c, 0xd7, 0x88, 0x63, 0xf1, 0x69, 0x01, 0xd4, 0x4e, 0x98, 0x26, 0xff, 0x0a,
+	0x9f, 0x91, 0x28, 0x1d, 0x10, 0xfd, 0x4f, 0xe9, 0xbe, 0xa7, 0x24, 0x40, 0x06, 0x1d, 0x45, 0xb0,
+	0x0e, 0x96, 0x31, 0x52, 0x24, 0xe6, 0x62, 0xec, 0x2e, 0xcf, 0xcf, 0x3a, 0xe6, 0x88, 0x68, 0x07,
+	0xca, 0x3a, 0x96, 0x9d, 0xe1, 0x3a, 0x58, 0x8c, 0x08, 0xe3, 0x89, 0xd3, 0xde, 0x1e, 0xe0, 0x43,
+	0x50, 0xa3, 0x8c, 0x2a, 0x8a, 0x06, 0x99, 0x35, 0x8b, 0x56, 0x34, 0x87, 0x3a, 0x7b, 0x76, 0xc0,
+	0xaa, 0xe2, 0x6a, 0x9a, 0x54, 0xb5, 0xda, 0x18, 0x6c, 0xea, 0xa0, 0x54, 0x48, 0x28, 0xe7, 0xcf,
+	0x92, 0x6d, 0xd9, 0x40, 0xd6, 0x9e, 0x4d, 0xb0, 0x42, 0x58, 0xe4, 0xc2, 0xcb, 0x26, 0xbc, 0x4c,
+	0x58, 0x64, 0x82, 0x4e, 0x8f, 0xf7, 0xc0, 0xb7, 0x72, 0x58, 0x47, 0xfe, 0xab, 0xc5, 0x8d, 0xfd,
+	0xd8, 0x06, 0xbe, 0x72, 0x73, 0x2f, 0x48, 0xe4, 0xa6, 0x73, 0x16, 0x72, 0x04, 0xbe, 0x94, 0x41,
+	0xf5, 0xa5, 0xd9, 0x3d, 0xd8, 0x05, 0xf7, 0x66, 0xb6, 0x2d, 0x9c, 0x52, 0xf7, 0x0c, 0xf5, 0xbb,
+	0x33, 0xc1, 0x43, 0xd7, 0x05, 0x7c, 0x07, 0xb6, 0x48, 0xb6, 0x67, 0x61, 0x56, 0x3d, 0xcc, 0x27,
+	0x48, 0xb3, 0x2b, 0xb7, 0xfc, 0x6e, 0xb7, 0xfd, 0xf7, 0x8d, 0x6e, 0xcf, 0x5f, 0xd2, 0x83, 0xca,
+	0xe5, 0xf7, 0x66, 0xa9, 0xb7, 0x49, 0xe6, 0x66, 0x48, 0xd8, 0x07, 0xc1, 0x74, 0x80, 0xed, 0xf8,
+	0x29, 0x81, 0xf0, 0x39, 0x65, 0xb1, 0x99, 0x06, 0xbf, 0xfb, 0x68, 0xee, 0xc5, 0x85, 0x55, 0x70,
+	0x97, 0x6d, 0x90, 0x02, 0x7a, 0xec, 0x6a, 0xc1, 0xd7, 0xe0, 0x76, 0x6a, 0x5c, 0x0a, 0x33, 0x2b,
+	0x64, 0x50, 0xf9, 0x77, 0xfd, 0xe2, 0x90, 0xbb, 0xfa, 0x6b, 0x69, 0x01, 0x95, 0x7b, 0x2d, 0xed,
+	0xc1, 0xc7, 0x9f, 0x5f, 0x9f, 0x34, 0xa7, 0xcf, 0xe2, 0xa8, 0xf8, 0x30, 0x5a, 0x73, 0x0e, 0x7a,
+	0x97, 0xd7, 0x0d, 0xef, 0xea, 0xba, 0xe1, 0xfd, 0xb8, 0x6e, 0x78, 0x17, 0x93, 0x46, 0xe9, 0x6a,
+	0xd2, 0x28, 0x7d, 0x9b, 0x34, 0x4a, 0x6f, 0x9e, 0xc5, 0x54, 0x9d, 0xa5, 0xa7, 0x6d, 0xcc, 0x93,
+	0xce, 0x50, 0xf0, 0x28, 0xc5, 0x4a, 0x62, 0x6a, 0x4a, 0xcd, 0x2d, 0xaa, 0xc6, 0x43, 0x22, 0x4f,
+	0xab, 0xe6, 0xb1, 0x7c, 0xfa, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x44, 0x99, 0xba, 0xda, 0x94, 0x05,
+	0x00, 0x00,
 }
 
 func (this *EmergencyTransferExemption) Equal(that interface{}) bool {
@@ -359,6 +544,86 @@ func (this *ExemptionUsage) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UnlockSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnlockSchedule)
+	if !ok {
+		that2, ok := that.(UnlockSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScheduleId != that1.ScheduleId {
+		return false
+	}
+	if this.Category != that1.Category {
+		return false
+	}
+	if len(this.Accounts) != len(that1.Accounts) {
+		return false
+	}
+	for i := range this.Accounts {
+		if this.Accounts[i] != that1.Accounts[i] {
+			return false
+		}
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.InitialAmount != that1.InitialAmount {
+		return false
+	}
+	if this.TotalAmount != that1.TotalAmount {
+		return false
+	}
+	if this.StartBlock != that1.StartBlock {
+		return false
+	}
+	if this.EndBlock != that1.EndBlock {
+		return false
+	}
+	return true
+}
+func (this *UnlockUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnlockUsage)
+	if !ok {
+		that2, ok := that.(UnlockUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScheduleId != that1.ScheduleId {
+		return false
+	}
+	if this.AccountAddress != that1.AccountAddress {
+		return false
+	}
+	if this.Transferred != that1.Transferred {
+		return false
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -397,6 +662,14 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.UnlockSchedules) != len(that1.UnlockSchedules) {
+		return false
+	}
+	for i := range this.UnlockSchedules {
+		if !this.UnlockSchedules[i].Equal(&that1.UnlockSchedules[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *EmergencyTransferExemption) Marshal() (dAtA []byte, err error) {
@@ -509,7 +782,7 @@ func (m *ExemptionUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *UnlockSchedule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -519,76 +792,211 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnlockSchedule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnlockSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ExemptionUsageTracking) > 0 {
-		for iNdEx := len(m.ExemptionUsageTracking) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExemptionUsageTracking[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintParams(dAtA, i, uint64(size))
-			}
+	if m.EndBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TotalAmount) > 0 {
+		i -= len(m.TotalAmount)
+		copy(dAtA[i:], m.TotalAmount)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TotalAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.InitialAmount) > 0 {
+		i -= len(m.InitialAmount)
+		copy(dAtA[i:], m.InitialAmount)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.InitialAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Accounts[iNdEx])))
 			i--
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.EmergencyTransferExemptions) > 0 {
-		for iNdEx := len(m.EmergencyTransferExemptions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EmergencyTransferExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintParams(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.RestrictionEndBlock != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.RestrictionEndBlock))
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ScheduleId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UnlockUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EmergencyTransferExemption) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UnlockUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ExemptionId)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if len(m.Transferred) > 0 {
+		i -= len(m.Transferred)
+		copy(dAtA[i:], m.Transferred)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Transferred)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnlockSchedules) > 0 {
+		for iNdEx := len(m.UnlockSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnlockSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ExemptionUsageTracking) > 0 {
+		for iNdEx := len(m.ExemptionUsageTracking) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExemptionUsageTracking[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EmergencyTransferExemptions) > 0 {
+		for iNdEx := len(m.EmergencyTransferExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EmergencyTransferExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RestrictionEndBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RestrictionEndBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EmergencyTransferExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExemptionId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
 	}
 	l = len(m.ToAddress)
 	if l > 0 {
@@ -631,6 +1039,68 @@ func (m *ExemptionUsage) Size() (n int) {
 	return n
 }
 
+func (m *UnlockSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.InitialAmount)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.TotalAmount)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovParams(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovParams(uint64(m.EndBlock))
+	}
+	return n
+}
+
+func (m *UnlockUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Transferred)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -652,16 +1122,270 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
-	return n
-}
+	if len(m.UnlockSchedules) > 0 {
+		for _, e := range m.UnlockSchedules {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EmergencyTransferExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmergencyTransferExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmergencyTransferExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageLimit", wireType)
+			}
+			m.UsageLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsageLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryBlock", wireType)
+			}
+			m.ExpiryBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Justification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Justification = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EmergencyTransferExemption) Unmarshal(dAtA []byte) error {
+func (m *ExemptionUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -684,10 +1408,10 @@ func (m *EmergencyTransferExemption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EmergencyTransferExemption: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExemptionUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EmergencyTransferExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExemptionUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -724,7 +1448,7 @@ func (m *EmergencyTransferExemption) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -752,11 +1476,80 @@ func (m *EmergencyTransferExemption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageCount", wireType)
+			}
+			m.UsageCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsageCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnlockSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -784,11 +1577,11 @@ func (m *EmergencyTransferExemption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -816,13 +1609,13 @@ func (m *EmergencyTransferExemption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxAmount = string(dAtA[iNdEx:postIndex])
+			m.Category = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsageLimit", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
-			m.UsageLimit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -832,16 +1625,29 @@ func (m *EmergencyTransferExemption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UsageLimit |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryBlock", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
 			}
-			m.ExpiryBlock = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -851,14 +1657,27 @@ func (m *EmergencyTransferExemption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiryBlock |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Justification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -886,8 +1705,78 @@ func (m *EmergencyTransferExemption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Justification = string(dAtA[iNdEx:postIndex])
+			m.InitialAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -909,7 +1798,7 @@ func (m *EmergencyTransferExemption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExemptionUsage) Unmarshal(dAtA []byte) error {
+func (m *UnlockUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -932,15 +1821,15 @@ func (m *ExemptionUsage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExemptionUsage: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnlockUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExemptionUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnlockUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExemptionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -968,7 +1857,7 @@ func (m *ExemptionUsage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExemptionId = string(dAtA[iNdEx:postIndex])
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1003,10 +1892,10 @@ func (m *ExemptionUsage) Unmarshal(dAtA []byte) error {
 			m.AccountAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsageCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferred", wireType)
 			}
-			m.UsageCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1016,11 +1905,24 @@ func (m *ExemptionUsage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UsageCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transferred = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1158,6 +2060,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockSchedules = append(m.UnlockSchedules, UnlockSchedule{})
+			if err := m.UnlockSchedules[len(m.UnlockSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -455,6 +455,198 @@ func (m *QueryExemptionUsageResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// UnlockAllowance describes how much of its unlock schedule an account can transfer
+type UnlockAllowance struct {
+	// schedule_id is the unlock schedule the account follows
+	ScheduleId string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// category is the category of the schedule
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	// denom is the denomination the allowance applies to
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// unlocked is the cumulative allowance unlocked at the current block
+	Unlocked string `protobuf:"bytes,4,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	// transferred is the cumulative amount transferred under the schedule
+	Transferred string `protobuf:"bytes,5,opt,name=transferred,proto3" json:"transferred,omitempty"`
+	// available is the amount that can still be transferred at the current block
+	Available string `protobuf:"bytes,6,opt,name=available,proto3" json:"available,omitempty"`
+	// end_block is the block height when the full allowance is unlocked
+	EndBlock uint64 `protobuf:"varint,7,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+}
+
+func (m *UnlockAllowance) Reset()         { *m = UnlockAllowance{} }
+func (m *UnlockAllowance) String() string { return proto.CompactTextString(m) }
+func (*UnlockAllowance) ProtoMessage()    {}
+func (*UnlockAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b6008a49286951, []int{8}
+}
+func (m *UnlockAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnlockAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockAllowance.Merge(m, src)
+}
+func (m *UnlockAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockAllowance proto.InternalMessageInfo
+
+func (m *UnlockAllowance) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *UnlockAllowance) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *UnlockAllowance) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *UnlockAllowance) GetUnlocked() string {
+	if m != nil {
+		return m.Unlocked
+	}
+	return ""
+}
+
+func (m *UnlockAllowance) GetTransferred() string {
+	if m != nil {
+		return m.Transferred
+	}
+	return ""
+}
+
+func (m *UnlockAllowance) GetAvailable() string {
+	if m != nil {
+		return m.Available
+	}
+	return ""
+}
+
+func (m *UnlockAllowance) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+// QueryUnlockAllowanceRequest is request type for the Query/UnlockAllowance RPC method.
+type QueryUnlockAllowanceRequest struct {
+	// account_address is the account to query the allowance of
+	AccountAddress string `protobuf:"bytes,1,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+}
+
+func (m *QueryUnlockAllowanceRequest) Reset()         { *m = QueryUnlockAllowanceRequest{} }
+func (m *QueryUnlockAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockAllowanceRequest) ProtoMessage()    {}
+func (*QueryUnlockAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b6008a49286951, []int{9}
+}
+func (m *QueryUnlockAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnlockAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockAllowanceRequest.Merge(m, src)
+}
+func (m *QueryUnlockAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockAllowanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnlockAllowanceRequest proto.InternalMessageInfo
+
+func (m *QueryUnlockAllowanceRequest) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+// QueryUnlockAllowanceResponse is response type for the Query/UnlockAllowance RPC method.
+type QueryUnlockAllowanceResponse struct {
+	// allowance is the current unlock allowance of the account
+	Allowance UnlockAllowance `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance"`
+}
+
+func (m *QueryUnlockAllowanceResponse) Reset()         { *m = QueryUnlockAllowanceResponse{} }
+func (m *QueryUnlockAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockAllowanceResponse) ProtoMessage()    {}
+func (*QueryUnlockAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b6008a49286951, []int{10}
+}
+func (m *QueryUnlockAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnlockAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockAllowanceResponse.Merge(m, src)
+}
+func (m *QueryUnlockAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnlockAllowanceResponse proto.InternalMessageInfo
+
+func (m *QueryUnlockAllowanceResponse) GetAllowance() UnlockAllowance {
+	if m != nil {
+		return m.Allowance
+	}
+	return UnlockAllowance{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "inference.restrictions.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "inference.restrictions.QueryParamsResponse")
@@ -464,6 +656,9 @@ func init() {
 	proto.RegisterType((*QueryTransferExemptionsResponse)(nil), "inference.restrictions.QueryTransferExemptionsResponse")
 	proto.RegisterType((*QueryExemptionUsageRequest)(nil), "inference.restrictions.QueryExemptionUsageRequest")
 	proto.RegisterType((*QueryExemptionUsageResponse)(nil), "inference.restrictions.QueryExemptionUsageResponse")
+	proto.RegisterType((*UnlockAllowance)(nil), "inference.restrictions.UnlockAllowance")
+	proto.RegisterType((*QueryUnlockAllowanceRequest)(nil), "inference.restrictions.QueryUnlockAllowanceRequest")
+	proto.RegisterType((*QueryUnlockAllowanceResponse)(nil), "inference.restrictions.QueryUnlockAllowanceResponse")
 }
 
 func init() {
@@ -471,55 +666,67 @@ func init() {
 }
 
 var fileDescriptor_c0b6008a49286951 = []byte{
-	// 762 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x4f, 0x14, 0x4b,
-	0x14, 0x9d, 0xe2, 0x2b, 0x4c, 0x0d, 0x0f, 0xde, 0x2b, 0x78, 0x2f, 0xf3, 0x86, 0x97, 0x86, 0x37,
-	0x46, 0x40, 0x8c, 0xdd, 0x32, 0x44, 0xc4, 0x85, 0x24, 0x4c, 0x32, 0x7e, 0xec, 0xa0, 0xd5, 0x84,
-	0xb8, 0xe9, 0xd4, 0x74, 0x17, 0x4d, 0x45, 0xa6, 0xaa, 0xa9, 0xaa, 0x26, 0xb0, 0xf5, 0x17, 0x68,
-	0xdc, 0xf8, 0x13, 0x5c, 0xb2, 0x65, 0xe7, 0xc2, 0x05, 0x4b, 0x8c, 0x89, 0x71, 0x65, 0x0c, 0x98,
-	0xf8, 0x0b, 0xdc, 0x9b, 0xa9, 0x2a, 0x87, 0x19, 0x67, 0x86, 0xaf, 0xb8, 0x21, 0xcd, 0xad, 0x73,
-	0x4f, 0x9d, 0x73, 0xb8, 0x75, 0x81, 0x45, 0xca, 0xd6, 0x89, 0x20, 0x2c, 0x24, 0x9e, 0x20, 0x52,
-	0x09, 0x1a, 0x2a, 0xca, 0x99, 0xf4, 0xb6, 0x52, 0x22, 0x76, 0xdd, 0x44, 0x70, 0xc5, 0xd1, 0x3f,
-	0x0d, 0x8c, 0xdb, 0x8c, 0x29, 0xfc, 0x85, 0x6b, 0x94, 0x71, 0x4f, 0xff, 0x34, 0xd0, 0xc2, 0x58,
-	0xcc, 0x63, 0xae, 0x3f, 0xbd, 0xfa, 0x97, 0xad, 0xfe, 0x17, 0x73, 0x1e, 0x6f, 0x12, 0x0f, 0x27,
-	0xd4, 0xc3, 0x8c, 0x71, 0x85, 0x35, 0x81, 0x3d, 0x9d, 0x0d, 0xb9, 0xac, 0x71, 0xe9, 0x55, 0xb1,
-	0x24, 0xe6, 0x5e, 0x6f, 0x7b, 0xae, 0x4a, 0x14, 0x9e, 0xf3, 0x12, 0x1c, 0x53, 0xa6, 0xc1, 0x16,
-	0x7b, 0xa5, 0x8b, 0xdc, 0x04, 0x0b, 0x5c, 0xb3, 0x84, 0xc5, 0x31, 0x88, 0x56, 0xeb, 0x34, 0x2b,
-	0xba, 0xe8, 0x93, 0xad, 0x94, 0x48, 0x55, 0x5c, 0x83, 0xa3, 0x2d, 0x55, 0x99, 0x70, 0x26, 0x09,
-	0x5a, 0x86, 0x03, 0xa6, 0x39, 0x0f, 0x26, 0xc1, 0x4c, 0xae, 0xe4, 0xb8, 0x9d, 0xdd, 0xba, 0xa6,
-	0xaf, 0x9c, 0x3d, 0xf8, 0x3c, 0x91, 0x79, 0xf3, 0x6d, 0x6f, 0x16, 0xf8, 0xb6, 0xb1, 0x38, 0x0d,
-	0xaf, 0x6a, 0xe6, 0xc7, 0x02, 0x33, 0xb9, 0x4e, 0x84, 0x7f, 0xd2, 0xf6, 0x48, 0x61, 0x95, 0x36,
-	0x24, 0x7c, 0x04, 0x70, 0xea, 0x2c, 0xa4, 0x95, 0x35, 0x0e, 0xb3, 0x54, 0x06, 0x38, 0x54, 0x74,
-	0x9b, 0x68, 0x65, 0x83, 0xfe, 0x20, 0x95, 0xcb, 0xfa, 0x77, 0x54, 0x82, 0x7f, 0x37, 0x49, 0x0b,
-	0x08, 0x8b, 0x82, 0xea, 0x26, 0x0f, 0x9f, 0xe5, 0x7b, 0x26, 0xc1, 0x4c, 0x9f, 0x3f, 0xda, 0x74,
-	0x58, 0x61, 0x51, 0xb9, 0x7e, 0x84, 0x6e, 0xc2, 0xb1, 0x30, 0x15, 0x82, 0x30, 0x65, 0xb0, 0xc1,
-	0x06, 0xa1, 0xf1, 0x86, 0xca, 0xf7, 0xea, 0x16, 0x64, 0xcf, 0x34, 0xf6, 0x81, 0x3e, 0x41, 0xd7,
-	0xe0, 0x9f, 0x82, 0xd4, 0x30, 0x65, 0x94, 0xc5, 0xa6, 0x47, 0xe6, 0xfb, 0x34, 0x7a, 0xa4, 0x51,
-	0xd7, 0x78, 0x59, 0x7c, 0x09, 0xa0, 0xd3, 0x62, 0xac, 0xb2, 0x43, 0x6a, 0x89, 0xce, 0xcd, 0x7a,
-	0x47, 0xd3, 0x70, 0x84, 0xb2, 0x70, 0x33, 0x8d, 0x48, 0x40, 0x76, 0x12, 0x2a, 0x48, 0x64, 0x6d,
-	0x0d, 0xdb, 0x72, 0xc5, 0x54, 0xd1, 0x3d, 0x08, 0x4f, 0xfe, 0xec, 0xda, 0x51, 0xae, 0x34, 0xe5,
-	0x9a, 0x19, 0x71, 0xeb, 0x33, 0xe2, 0x9a, 0xd9, 0xb4, 0x33, 0xe2, 0xae, 0xe0, 0x98, 0xd8, 0x4b,
-	0xfc, 0xa6, 0xce, 0xe2, 0x3b, 0x00, 0x27, 0xba, 0x6a, 0xb2, 0x29, 0xaf, 0x41, 0x48, 0x1a, 0xd5,
-	0x3c, 0x98, 0xec, 0x9d, 0xc9, 0x95, 0x4a, 0xdd, 0x06, 0xa0, 0x52, 0x23, 0x22, 0x26, 0x2c, 0x6c,
-	0x27, 0x2c, 0xf7, 0xd5, 0x87, 0xc2, 0x6f, 0xe2, 0x42, 0xf7, 0x3b, 0xb8, 0x98, 0x3e, 0xd3, 0x85,
-	0x91, 0xd5, 0x62, 0x63, 0x0f, 0xc0, 0x82, 0xb6, 0xd1, 0xb8, 0xed, 0x89, 0x3c, 0x71, 0x8c, 0xfe,
-	0x87, 0x43, 0x8d, 0x5b, 0x03, 0x6a, 0x32, 0xcd, 0xfa, 0xb9, 0x46, 0xed, 0x61, 0x54, 0x4f, 0x1e,
-	0x87, 0x21, 0x4f, 0x99, 0x0a, 0x70, 0x14, 0x09, 0x22, 0xa5, 0xd6, 0x93, 0xf5, 0x87, 0x6d, 0x79,
-	0xd9, 0x54, 0x7f, 0x49, 0xbe, 0xf7, 0xd2, 0xc9, 0xef, 0x03, 0x38, 0xde, 0x51, 0xb2, 0x4d, 0x7d,
-	0x15, 0xfe, 0x91, 0xd6, 0x0b, 0x01, 0x61, 0x4a, 0x50, 0xf2, 0x33, 0xf8, 0xa9, 0xae, 0xc1, 0xb7,
-	0xd0, 0xd8, 0xb0, 0x87, 0x34, 0x45, 0xc5, 0x30, 0xfc, 0xb6, 0xb8, 0x4b, 0xdf, 0xfb, 0x61, 0xbf,
-	0xd6, 0x8e, 0x5e, 0x03, 0x38, 0x60, 0xde, 0x3c, 0x9a, 0xed, 0xa6, 0xac, 0x7d, 0xcd, 0x14, 0xae,
-	0x9f, 0x0b, 0x6b, 0x6e, 0x2e, 0xde, 0x7a, 0xfe, 0xe1, 0xeb, 0xab, 0x1e, 0x0f, 0xdd, 0xf0, 0x12,
-	0xc1, 0xa3, 0x34, 0x54, 0x32, 0xa4, 0x7a, 0xb9, 0x9d, 0xba, 0xe6, 0xd0, 0x7b, 0x00, 0xff, 0xed,
-	0xba, 0x42, 0xd0, 0xdd, 0x53, 0x15, 0x9c, 0xb5, 0xa4, 0x0a, 0x4b, 0x97, 0x6d, 0xbf, 0xa4, 0x27,
-	0x69, 0x54, 0xbf, 0x05, 0x10, 0xb5, 0xbf, 0x54, 0xb4, 0x70, 0x2e, 0x35, 0x6d, 0xeb, 0xa6, 0x70,
-	0xfb, 0xc2, 0x7d, 0x56, 0xfe, 0x1d, 0x2d, 0x7f, 0x1e, 0xcd, 0x9d, 0x53, 0x7e, 0xd3, 0x9b, 0xdf,
-	0x07, 0x70, 0xb8, 0x75, 0x56, 0x51, 0xe9, 0x54, 0x19, 0x1d, 0x9f, 0x74, 0x61, 0xfe, 0x42, 0x3d,
-	0x56, 0xf6, 0x92, 0x96, 0xbd, 0x88, 0x16, 0x2e, 0x2a, 0x3b, 0xd0, 0xef, 0xa8, 0xec, 0x1f, 0x1c,
-	0x39, 0xe0, 0xf0, 0xc8, 0x01, 0x5f, 0x8e, 0x1c, 0xf0, 0xe2, 0xd8, 0xc9, 0x1c, 0x1e, 0x3b, 0x99,
-	0x4f, 0xc7, 0x4e, 0xe6, 0xe9, 0x62, 0x4c, 0xd5, 0x46, 0x5a, 0x75, 0x43, 0x5e, 0xeb, 0xce, 0xbd,
-	0xd3, 0xca, 0xae, 0x76, 0x13, 0x22, 0xab, 0x03, 0xfa, 0xdf, 0xf1, 0xfc, 0x8f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x72, 0xd5, 0x81, 0x72, 0x64, 0x08, 0x00, 0x00,
+	// 950 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0x1c, 0x35,
+	0x18, 0x8e, 0x9b, 0x8f, 0x66, 0xbd, 0x25, 0x01, 0x37, 0xa0, 0x65, 0x1a, 0x6d, 0xc2, 0x20, 0x92,
+	0x10, 0xc4, 0x0c, 0xd9, 0x40, 0x29, 0x07, 0x2a, 0x25, 0xd2, 0x16, 0xa2, 0x5e, 0x9a, 0x81, 0x4a,
+	0x15, 0x97, 0x91, 0x77, 0xe6, 0xed, 0xc4, 0xea, 0xae, 0x3d, 0x1d, 0x7b, 0x42, 0x22, 0xc4, 0x85,
+	0x5f, 0x00, 0xe2, 0xc2, 0x4f, 0xe0, 0xd8, 0x6b, 0x6f, 0x1c, 0x90, 0xe8, 0x8d, 0x22, 0x24, 0xc4,
+	0x05, 0x84, 0x12, 0x24, 0xfe, 0x06, 0x1a, 0xdb, 0x99, 0xfd, 0xc8, 0x6e, 0x92, 0x8d, 0x7a, 0x59,
+	0x8d, 0x1f, 0xbf, 0x1f, 0xcf, 0xfb, 0xfa, 0xf5, 0xe3, 0xc5, 0x2e, 0xe3, 0x0f, 0x21, 0x03, 0x1e,
+	0x81, 0x9f, 0x81, 0x54, 0x19, 0x8b, 0x14, 0x13, 0x5c, 0xfa, 0x8f, 0x73, 0xc8, 0x0e, 0xbd, 0x34,
+	0x13, 0x4a, 0x90, 0xd7, 0x4a, 0x1b, 0xaf, 0xd7, 0xc6, 0x79, 0x85, 0x76, 0x18, 0x17, 0xbe, 0xfe,
+	0x35, 0xa6, 0xce, 0x42, 0x22, 0x12, 0xa1, 0x3f, 0xfd, 0xe2, 0xcb, 0xa2, 0x8b, 0x89, 0x10, 0x49,
+	0x1b, 0x7c, 0x9a, 0x32, 0x9f, 0x72, 0x2e, 0x14, 0xd5, 0x01, 0xec, 0xee, 0x7a, 0x24, 0x64, 0x47,
+	0x48, 0xbf, 0x45, 0x25, 0x98, 0xbc, 0xfe, 0xfe, 0x46, 0x0b, 0x14, 0xdd, 0xf0, 0x53, 0x9a, 0x30,
+	0xae, 0x8d, 0xad, 0xed, 0x9b, 0x23, 0xe8, 0xa6, 0x34, 0xa3, 0x1d, 0x1b, 0xd0, 0x5d, 0xc0, 0x64,
+	0xb7, 0x08, 0x73, 0x4f, 0x83, 0x01, 0x3c, 0xce, 0x41, 0x2a, 0xf7, 0x01, 0xbe, 0xde, 0x87, 0xca,
+	0x54, 0x70, 0x09, 0x64, 0x0b, 0xcf, 0x18, 0xe7, 0x1a, 0x5a, 0x46, 0x6b, 0xd5, 0x46, 0xdd, 0x1b,
+	0x5e, 0xad, 0x67, 0xfc, 0xb6, 0x2b, 0xcf, 0xfe, 0x5e, 0x9a, 0xf8, 0xf1, 0xbf, 0x27, 0xeb, 0x28,
+	0xb0, 0x8e, 0xee, 0x2a, 0x7e, 0x4b, 0x47, 0xfe, 0x3c, 0xa3, 0x5c, 0x3e, 0x84, 0x2c, 0xe8, 0xba,
+	0x7d, 0xa6, 0xa8, 0xca, 0x4b, 0x0a, 0x7f, 0x20, 0xbc, 0x72, 0x9e, 0xa5, 0xa5, 0x75, 0x03, 0x57,
+	0x98, 0x0c, 0x69, 0xa4, 0xd8, 0x3e, 0x68, 0x66, 0xb3, 0xc1, 0x2c, 0x93, 0x5b, 0x7a, 0x4d, 0x1a,
+	0xf8, 0xd5, 0x1e, 0x6a, 0x21, 0xf0, 0x38, 0x6c, 0xb5, 0x45, 0xf4, 0xa8, 0x76, 0x65, 0x19, 0xad,
+	0x4d, 0x05, 0xd7, 0x7b, 0x36, 0x9b, 0x3c, 0xde, 0x2e, 0xb6, 0xc8, 0x7b, 0x78, 0x21, 0xca, 0xb3,
+	0x0c, 0xb8, 0x32, 0xb6, 0xe1, 0x1e, 0xb0, 0x64, 0x4f, 0xd5, 0x26, 0xb5, 0x0b, 0xb1, 0x7b, 0xda,
+	0xf6, 0x53, 0xbd, 0x43, 0xde, 0xc6, 0x2f, 0x67, 0xd0, 0xa1, 0x8c, 0x33, 0x9e, 0x18, 0x1f, 0x59,
+	0x9b, 0xd2, 0xd6, 0xf3, 0x25, 0xae, 0xed, 0xa5, 0xfb, 0x1d, 0xc2, 0xf5, 0xbe, 0xc2, 0x9a, 0x07,
+	0xd0, 0x49, 0x75, 0xdf, 0x6c, 0xed, 0x64, 0x15, 0xcf, 0x33, 0x1e, 0xb5, 0xf3, 0x18, 0x42, 0x38,
+	0x48, 0x59, 0x06, 0xb1, 0x2d, 0x6b, 0xce, 0xc2, 0x4d, 0x83, 0x92, 0x3b, 0x18, 0x77, 0x8f, 0x5d,
+	0x57, 0x54, 0x6d, 0xac, 0x78, 0x66, 0x46, 0xbc, 0x62, 0x46, 0x3c, 0x33, 0x9b, 0x76, 0x46, 0xbc,
+	0x7b, 0x34, 0x01, 0x9b, 0x24, 0xe8, 0xf1, 0x74, 0x7f, 0x46, 0x78, 0x69, 0x24, 0x27, 0xdb, 0xe5,
+	0x07, 0x18, 0x43, 0x89, 0xd6, 0xd0, 0xf2, 0xe4, 0x5a, 0xb5, 0xd1, 0x18, 0x35, 0x00, 0xcd, 0x0e,
+	0x64, 0x09, 0xf0, 0xe8, 0x74, 0xc0, 0xed, 0xa9, 0x62, 0x28, 0x82, 0x9e, 0x58, 0xe4, 0x93, 0x21,
+	0x55, 0xac, 0x9e, 0x5b, 0x85, 0xa1, 0xd5, 0x57, 0xc6, 0x13, 0x84, 0x1d, 0x5d, 0x46, 0x99, 0xed,
+	0xbe, 0xec, 0x56, 0x4c, 0xde, 0xc0, 0xd7, 0xca, 0xac, 0x21, 0x33, 0x3d, 0xad, 0x04, 0xd5, 0x12,
+	0xdb, 0x89, 0x8b, 0xce, 0xd3, 0x28, 0x12, 0x39, 0x57, 0x21, 0x8d, 0xe3, 0x0c, 0xa4, 0xd4, 0x7c,
+	0x2a, 0xc1, 0x9c, 0x85, 0xb7, 0x0c, 0x3a, 0xd0, 0xf9, 0xc9, 0x4b, 0x77, 0xfe, 0x29, 0xc2, 0x37,
+	0x86, 0x52, 0xb6, 0x5d, 0xdf, 0xc5, 0x2f, 0xe5, 0x05, 0x10, 0x02, 0x57, 0x19, 0x83, 0x93, 0xc6,
+	0xaf, 0x8c, 0x6c, 0x7c, 0x5f, 0x18, 0xdb, 0xec, 0x6b, 0x3a, 0x44, 0xd3, 0x44, 0x78, 0x71, 0xed,
+	0xfe, 0x0b, 0xe1, 0xf9, 0xfb, 0xbc, 0x98, 0xea, 0xad, 0x76, 0x5b, 0x7c, 0x49, 0x79, 0x04, 0x64,
+	0x09, 0x57, 0x65, 0xb4, 0x07, 0x71, 0xde, 0x86, 0x6e, 0x8b, 0xf1, 0x09, 0xb4, 0x13, 0x13, 0x07,
+	0xcf, 0x46, 0x54, 0x41, 0x22, 0xb2, 0x43, 0xdb, 0xda, 0x72, 0x4d, 0x16, 0xf0, 0x74, 0x0c, 0x5c,
+	0x74, 0x74, 0x3f, 0x2b, 0x81, 0x59, 0x14, 0x1e, 0xb9, 0xce, 0x02, 0xb1, 0xbe, 0x53, 0x95, 0xa0,
+	0x5c, 0x93, 0x65, 0x5c, 0x55, 0x76, 0xc2, 0x8a, 0x5b, 0x32, 0x6d, 0x4e, 0xb4, 0x07, 0x22, 0x8b,
+	0xb8, 0x42, 0xf7, 0x29, 0x6b, 0xd3, 0x56, 0x1b, 0x6a, 0x33, 0x7a, 0xbf, 0x0b, 0x14, 0xd2, 0xd1,
+	0x55, 0x84, 0xab, 0xfa, 0xc2, 0xce, 0x82, 0x95, 0x01, 0xf7, 0x8e, 0x3d, 0x9a, 0x81, 0x1a, 0x7b,
+	0x6e, 0xe9, 0xe0, 0xac, 0xa0, 0x61, 0xb3, 0xe2, 0x3e, 0xc2, 0x8b, 0xc3, 0xe3, 0xd8, 0x33, 0xbe,
+	0x8b, 0x2b, 0xf4, 0x04, 0xb4, 0xca, 0xba, 0x3a, 0xea, 0x7c, 0x07, 0x62, 0xd8, 0x03, 0xee, 0xfa,
+	0x37, 0x7e, 0xb9, 0x8a, 0xa7, 0x75, 0x36, 0xf2, 0x03, 0xc2, 0x33, 0x46, 0x88, 0xc9, 0xfa, 0xa8,
+	0x70, 0xa7, 0xb5, 0xdf, 0x79, 0xe7, 0x42, 0xb6, 0x86, 0xba, 0xfb, 0xc1, 0x37, 0xbf, 0xff, 0xfb,
+	0xfd, 0x15, 0x9f, 0xbc, 0xeb, 0xa7, 0x99, 0x88, 0xf3, 0x48, 0xc9, 0x88, 0xe9, 0x17, 0xe7, 0xcc,
+	0xb7, 0x87, 0xfc, 0x86, 0xf0, 0xeb, 0x23, 0x75, 0x9d, 0x7c, 0x7c, 0x26, 0x83, 0xf3, 0x5e, 0x0e,
+	0xe7, 0xf6, 0x65, 0xdd, 0x2f, 0x59, 0x93, 0x34, 0xac, 0x7f, 0x42, 0x98, 0x9c, 0x96, 0x4f, 0x72,
+	0xf3, 0x42, 0x6c, 0x4e, 0xbd, 0x01, 0xce, 0x87, 0x63, 0xfb, 0x59, 0xfa, 0x1f, 0x69, 0xfa, 0x9b,
+	0x64, 0xe3, 0x82, 0xf4, 0x7b, 0x84, 0xf8, 0x29, 0xc2, 0x73, 0xfd, 0x02, 0x42, 0x1a, 0x67, 0xd2,
+	0x18, 0xaa, 0xb3, 0xce, 0xe6, 0x58, 0x3e, 0x96, 0xf6, 0x6d, 0x4d, 0xfb, 0x16, 0xb9, 0x39, 0x2e,
+	0xed, 0x50, 0x8b, 0x1b, 0xf9, 0x75, 0x88, 0x18, 0x9d, 0x4d, 0x64, 0xf8, 0xb5, 0x76, 0xde, 0x1f,
+	0xcf, 0xc9, 0xd2, 0xdf, 0xd5, 0xf4, 0xef, 0x92, 0x9d, 0x0b, 0xd2, 0x37, 0x0a, 0x16, 0x96, 0xf7,
+	0xd6, 0xff, 0x6a, 0x40, 0x4b, 0xbe, 0xde, 0x0e, 0x9e, 0x1d, 0xd5, 0xd1, 0xf3, 0xa3, 0x3a, 0xfa,
+	0xe7, 0xa8, 0x8e, 0xbe, 0x3d, 0xae, 0x4f, 0x3c, 0x3f, 0xae, 0x4f, 0xfc, 0x79, 0x5c, 0x9f, 0xf8,
+	0xe2, 0x56, 0xc2, 0xd4, 0x5e, 0xde, 0xf2, 0x22, 0xd1, 0x19, 0x9d, 0xee, 0xa0, 0x3f, 0xa1, 0x3a,
+	0x4c, 0x41, 0xb6, 0x66, 0xf4, 0xbf, 0xbe, 0xcd, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x97, 0x6c,
+	0x69, 0x65, 0xcb, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -542,6 +749,8 @@ type QueryClient interface {
 	TransferExemptions(ctx context.Context, in *QueryTransferExemptionsRequest, opts ...grpc.CallOption) (*QueryTransferExemptionsResponse, error)
 	// ExemptionUsage queries usage statistics for emergency exemptions
 	ExemptionUsage(ctx context.Context, in *QueryExemptionUsageRequest, opts ...grpc.CallOption) (*QueryExemptionUsageResponse, error)
+	// UnlockAllowance queries the graduated unlock allowance of an account
+	UnlockAllowance(ctx context.Context, in *QueryUnlockAllowanceRequest, opts ...grpc.CallOption) (*QueryUnlockAllowanceResponse, error)
 }
 
 type queryClient struct {
@@ -588,6 +797,15 @@ func (c *queryClient) ExemptionUsage(ctx context.Context, in *QueryExemptionUsag
 	return out, nil
 }
 
+func (c *queryClient) UnlockAllowance(ctx context.Context, in *QueryUnlockAllowanceRequest, opts ...grpc.CallOption) (*QueryUnlockAllowanceResponse, error) {
+	out := new(QueryUnlockAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/inference.restrictions.Query/UnlockAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -598,6 +816,8 @@ type QueryServer interface {
 	TransferExemptions(context.Context, *QueryTransferExemptionsRequest) (*QueryTransferExemptionsResponse, error)
 	// ExemptionUsage queries usage statistics for emergency exemptions
 	ExemptionUsage(context.Context, *QueryExemptionUsageRequest) (*QueryExemptionUsageResponse, error)
+	// UnlockAllowance queries the graduated unlock allowance of an account
+	UnlockAllowance(context.Context, *QueryUnlockAllowanceRequest) (*QueryUnlockAllowanceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -616,6 +836,9 @@ func (*UnimplementedQueryServer) TransferExemptions(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) ExemptionUsage(ctx context.Context, req *QueryExemptionUsageRequest) (*QueryExemptionUsageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExemptionUsage not implemented")
 }
+func (*UnimplementedQueryServer) UnlockAllowance(ctx context.Context, req *QueryUnlockAllowanceRequest) (*QueryUnlockAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockAllowance not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -693,6 +916,24 @@ func _Query_ExemptionUsage_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UnlockAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnlockAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnlockAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.restrictions.Query/UnlockAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnlockAllowance(ctx, req.(*QueryUnlockAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.restrictions.Query",
@@ -714,6 +955,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExemptionUsage",
 			Handler:    _Query_ExemptionUsage_Handler,
 		},
+		{
+			MethodName: "UnlockAllowance",
+			Handler:    _Query_UnlockAllowance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/restrictions/query.proto",
@@ -1038,6 +1283,139 @@ func (m *QueryExemptionUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *UnlockAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Available) > 0 {
+		i -= len(m.Available)
+		copy(dAtA[i:], m.Available)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Available)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Transferred) > 0 {
+		i -= len(m.Transferred)
+		copy(dAtA[i:], m.Transferred)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Transferred)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Unlocked) > 0 {
+		i -= len(m.Unlocked)
+		copy(dAtA[i:], m.Unlocked)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Unlocked)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlockAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlockAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1174,18 +1552,78 @@ func (m *QueryExemptionUsageResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *UnlockAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Unlocked)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Transferred)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Available)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovQuery(uint64(m.EndBlock))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *QueryUnlockAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnlockAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Allowance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
@@ -1986,6 +2424,432 @@ func (m *QueryExemptionUsageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UnlockAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocked = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferred", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transferred = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Available = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnlockAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnlockAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnlockAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnlockAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnlockAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnlockAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UnlockedAt returns the cumulative amount the schedule allows to be transferred at the given height
+func (s UnlockSchedule) UnlockedAt(height uint64) uint64 {
+	initial, _ := strconv.ParseUint(s.InitialAmount, 10, 64)
+	total, _ := strconv.ParseUint(s.TotalAmount, 10, 64)
+
+	if height < s.StartBlock {
+		return 0
+	}
+	if height >= s.EndBlock {
+		return total
+	}
+
+	// initial + (total - initial) * elapsed / duration, computed without overflow
+	growth := new(big.Int).SetUint64(total - initial)
+	growth.Mul(growth, new(big.Int).SetUint64(height-s.StartBlock))
+	growth.Quo(growth, new(big.Int).SetUint64(s.EndBlock-s.StartBlock))
+	return initial + growth.Uint64()
+}
+
+// HasAccount checks if the schedule applies to the given account
+func (s UnlockSchedule) HasAccount(address string) bool {
+	for _, account := range s.Accounts {
+		if account == address {
+			return true
+		}
+	}
+	return false
+}
+
+// validateUnlockSchedules validates the unlock schedules parameter
+func validateUnlockSchedules(i interface{}) error {
+	schedules, ok := i.([]UnlockSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	scheduleIds := make(map[string]bool, len(schedules))
+	accounts := make(map[string]string)
+	for idx, schedule := range schedules {
+		if err := validateUnlockSchedule(schedule); err != nil {
+			return fmt.Errorf("invalid unlock schedule at index %d: %w", idx, err)
+		}
+		if scheduleIds[schedule.ScheduleId] {
+			return fmt.Errorf("duplicate unlock schedule ID %s", schedule.ScheduleId)
+		}
+		scheduleIds[schedule.ScheduleId] = true
+
+		// An account follows a single schedule, so its allowance is unambiguous
+		for _, account := range schedule.Accounts {
+			if other, found := accounts[account]; found {
+				return fmt.Errorf("account %s is in unlock schedules %s and %s", account, other, schedule.ScheduleId)
+			}
+			accounts[account] = schedule.ScheduleId
+		}
+	}
+
+	return nil
+}
+
+// validateUnlockSchedule validates a single unlock schedule
+func validateUnlockSchedule(schedule UnlockSchedule) error {
+	if schedule.ScheduleId == "" {
+		return fmt.Errorf("schedule ID cannot be empty")
+	}
+
+	if len(schedule.Accounts) == 0 {
+		return fmt.Errorf("schedule must apply to at least one account")
+	}
+	for _, account := range schedule.Accounts {
+		if _, err := sdk.AccAddressFromBech32(account); err != nil {
+			return fmt.Errorf("invalid account address %s: %w", account, err)
+		}
+	}
+
+	if err := sdk.ValidateDenom(schedule.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+
+	initial, err := strconv.ParseUint(schedule.InitialAmount, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid initial amount: %w", err)
+	}
+	total, err := strconv.ParseUint(schedule.TotalAmount, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid total amount: %w", err)
+	}
+	if initial > total {
+		return fmt.Errorf("initial amount %d exceeds total amount %d", initial, total)
+	}
+
+	if schedule.EndBlock <= schedule.StartBlock {
+		return fmt.Errorf("end block %d must be after start block %d", schedule.EndBlock, schedule.StartBlock)
+	}
+
+	return nil
+}
+
+// validateUnlockUsage validates a single unlock usage entry
+func validateUnlockUsage(usage UnlockUsage) error {
+	if usage.ScheduleId == "" {
+		return fmt.Errorf("schedule ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(usage.AccountAddress); err != nil {
+		return fmt.Errorf("invalid account address: %w", err)
+	}
+	if _, err := strconv.ParseUint(usage.Transferred, 10, 64); err != nil {
+		return fmt.Errorf("invalid transferred amount: %w", err)
+	}
+	return nil
+}
@@ -32,6 +32,7 @@ type (
 		Schema           collections.Schema
 		params           collections.Item[types.Params]
 		VestingSchedules collections.Map[sdk.AccAddress, types.VestingSchedule]
+		EpochTiming      collections.Item[types.EpochTiming]
 	}
 )
 
@@ -63,6 +64,7 @@ func NewKeeper(
 	// Wire collections stores
 	k.params = collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
 	k.VestingSchedules = collections.NewMap(sb, types.VestingScheduleKey, "vesting_schedules", sdk.AccAddressKey, codec.CollValue[types.VestingSchedule](cdc))
+	k.EpochTiming = collections.NewItem(sb, types.EpochTimingKey, "epoch_timing", codec.CollValue[types.EpochTiming](cdc))
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -87,8 +89,14 @@ const (
 	HoldingSubAccount = "vesting"
 )
 
-// AddVestedRewards adds vested rewards to a participant's schedule with aggregation logic
+// AddVestedRewards adds vested rewards from an unspecified source to a participant's schedule
 func (k Keeper) AddVestedRewards(ctx context.Context, participantAddress string, fundingModule string, amount sdk.Coins, vestingEpochs *uint64, memo string) error {
+	return k.AddVestedRewardsFromSource(ctx, participantAddress, fundingModule, types.VestingSource_VESTING_SOURCE_UNSPECIFIED, amount, vestingEpochs, memo)
+}
+
+// AddVestedRewardsFromSource adds vested rewards to a participant's schedule with aggregation logic,
+// tagging every tranche with the reward source the coins came from
+func (k Keeper) AddVestedRewardsFromSource(ctx context.Context, participantAddress string, fundingModule string, source types.VestingSource, amount sdk.Coins, vestingEpochs *uint64, memo string) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	err := k.bookkeepingBankKeeper.SendCoinsFromModuleToModule(ctx, fundingModule, types.ModuleName, amount, memo)
@@ -157,7 +165,7 @@ func (k Keeper) AddVestedRewards(ctx context.Context, participantAddress string,
 			}
 
 			// Add to existing amount in this epoch
-			schedule.EpochAmounts[i].Coins = schedule.EpochAmounts[i].Coins.Add(epochCoin)
+			schedule.EpochAmounts[i].AddFromSource(source, epochCoin)
 		}
 	}
 
@@ -171,6 +179,7 @@ func (k Keeper) AddVestedRewards(ctx context.Context, participantAddress string,
 			sdk.NewAttribute(types.AttributeKeyParticipant, participantAddress),
 			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
 			sdk.NewAttribute(types.AttributeKeyVestingEpochs, fmt.Sprintf("%d", epochs)),
+			sdk.NewAttribute(types.AttributeKeySource, source.Label()),
 		),
 	)
 
@@ -182,6 +191,7 @@ func (k Keeper) AddVestedRewards(ctx context.Context, participantAddress string,
 func (k Keeper) AdvanceEpoch(ctx context.Context, completedEpoch uint64) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	k.Logger().Info("Processing epoch advancement for streamvesting", "epoch", completedEpoch)
+	k.recordEpochTiming(sdkCtx, completedEpoch)
 
 	// Process token unlocks for the completed epoch
 	err := k.ProcessEpochUnlocks(sdkCtx)
@@ -198,6 +208,7 @@ func (k Keeper) AdvanceEpoch(ctx context.Context, completedEpoch uint64) error {
 func (k Keeper) ProcessEpochUnlocks(ctx sdk.Context) error {
 	// Get all vesting schedules
 	schedules := k.GetAllVestingSchedules(ctx)
+	timing := k.GetEpochTiming(ctx)
 
 	// Track totals for summary event
 	totalUnlocked := sdk.NewCoins()
@@ -213,7 +224,8 @@ func (k Keeper) ProcessEpochUnlocks(ctx sdk.Context) error {
 		}
 
 		// Get the first epoch's coins to unlock
-		coinsToUnlock := schedule.EpochAmounts[0].Coins
+		unlocked := schedule.EpochAmounts[0]
+		coinsToUnlock := unlocked.Coins
 
 		// Skip if no coins to unlock
 		if coinsToUnlock.IsZero() {
@@ -255,6 +267,17 @@ func (k Keeper) ProcessEpochUnlocks(ctx sdk.Context) error {
 			k.bookkeepingBankKeeper.LogSubAccountTransaction(
 				ctx, schedule.ParticipantAddress, types.ModuleName, HoldingSubAccount, coin, "coins vested for "+schedule.ParticipantAddress)
 		}
+		for _, sourceCoins := range unlocked.SourceBreakdown() {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeVestUnlock,
+					sdk.NewAttribute(types.AttributeKeyParticipant, schedule.ParticipantAddress),
+					sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", timing.LastEpoch)),
+					sdk.NewAttribute(types.AttributeKeySource, sourceCoins.Source.Label()),
+					sdk.NewAttribute(types.AttributeKeyAmount, sourceCoins.Coins.String()),
+				),
+			)
+		}
 
 		// Add to totals
 		totalUnlocked = totalUnlocked.Add(coinsToUnlock...)
@@ -328,3 +351,38 @@ func (k Keeper) GetAllVestingSchedules(ctx sdk.Context) []types.VestingSchedule
 	}
 	return values
 }
+
+// GetEpochTiming returns the timing of the last epoch unlocks, zero if no epoch has completed yet
+func (k Keeper) GetEpochTiming(ctx context.Context) types.EpochTiming {
+	timing, err := k.EpochTiming.Get(ctx)
+	if err != nil {
+		return types.EpochTiming{}
+	}
+	return timing
+}
+
+// SetEpochTiming stores the timing of the last epoch unlocks
+func (k Keeper) SetEpochTiming(ctx context.Context, timing types.EpochTiming) {
+	if err := k.EpochTiming.Set(ctx, timing); err != nil {
+		panic(err)
+	}
+}
+
+// recordEpochTiming stores the height and time of this epoch's unlocks. The epoch length and duration
+// are measured against the previous unlocks, so they are only known from the second completed epoch on.
+func (k Keeper) recordEpochTiming(ctx sdk.Context, completedEpoch uint64) {
+	previous := k.GetEpochTiming(ctx)
+	timing := types.EpochTiming{
+		LastEpoch:        completedEpoch,
+		LastUnlockHeight: ctx.BlockHeight(),
+		LastUnlockTime:   ctx.BlockTime().Unix(),
+		EpochLength:      previous.EpochLength,
+		EpochDuration:    previous.EpochDuration,
+	}
+	if previous.LastUnlockHeight > 0 && completedEpoch > previous.LastEpoch {
+		epochs := int64(completedEpoch - previous.LastEpoch)
+		timing.EpochLength = (timing.LastUnlockHeight - previous.LastUnlockHeight) / epochs
+		timing.EpochDuration = (timing.LastUnlockTime - previous.LastUnlockTime) / epochs
+	}
+	k.SetEpochTiming(ctx, timing)
+}
@@ -441,3 +441,42 @@ func (suite *KeeperTestSuite) TestAddVestedRewards_MultiCoin() {
 		// Note: The specific amounts depend on how multi-coin is handled in implementation
 	}
 }
+
+// Test SlashVestingSchedule takes coins from the furthest epochs first
+func (suite *KeeperTestSuite) TestSlashVestingSchedule_FurthestEpochsFirst() {
+	participant := testutil.Creator
+	suite.keeper.SetVestingSchedule(suite.ctx, types.VestingSchedule{
+		ParticipantAddress: participant,
+		EpochAmounts: []types.EpochCoins{
+			{Coins: sdk.NewCoins(sdk.NewInt64Coin("ngonka", 100))},
+			{Coins: sdk.NewCoins(sdk.NewInt64Coin("ngonka", 100))},
+			{Coins: sdk.NewCoins(sdk.NewInt64Coin("ngonka", 100))},
+		},
+	})
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ngonka", 300)), suite.keeper.GetVestingBalance(suite.ctx, participant))
+
+	slashedCoin := sdk.NewInt64Coin("ngonka", 150)
+	suite.mocks.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, "collateral", sdk.NewCoins(slashedCoin), "slash")
+	suite.mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), "collateral", participant, "vesting", slashedCoin, "slash")
+
+	slashed, err := suite.keeper.SlashVestingSchedule(suite.ctx, participant, slashedCoin, "collateral", "slash")
+	suite.Require().NoError(err)
+	suite.Require().Equal(slashedCoin, slashed)
+
+	// The emptied last epoch is dropped, the next unlock is untouched
+	schedule, found := suite.keeper.GetVestingSchedule(suite.ctx, participant)
+	suite.Require().True(found)
+	suite.Require().Len(schedule.EpochAmounts, 2)
+	suite.Require().Equal(math.NewInt(100), schedule.EpochAmounts[0].Coins.AmountOf("ngonka"))
+	suite.Require().Equal(math.NewInt(50), schedule.EpochAmounts[1].Coins.AmountOf("ngonka"))
+
+	// Slashing more than the balance takes what is left and removes the schedule
+	remaining := sdk.NewInt64Coin("ngonka", 150)
+	suite.mocks.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, "collateral", sdk.NewCoins(remaining), "slash")
+	suite.mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), "collateral", participant, "vesting", remaining, "slash")
+	slashed, err = suite.keeper.SlashVestingSchedule(suite.ctx, participant, sdk.NewInt64Coin("ngonka", 1000), "collateral", "slash")
+	suite.Require().NoError(err)
+	suite.Require().Equal(remaining, slashed)
+	_, found = suite.keeper.GetVestingSchedule(suite.ctx, participant)
+	suite.Require().False(found)
+}
@@ -52,20 +52,66 @@ func (k Keeper) TotalVestingAmount(goCtx context.Context, req *types.QueryTotalV
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	totalAmount := k.GetVestingBalance(ctx, req.ParticipantAddress)
+
+	return &types.QueryTotalVestingAmountResponse{
+		TotalAmount: totalAmount,
+	}, nil
+}
+
+// ProjectedUnlocks projects the date of each of a participant's upcoming unlocks.
+// The i-th tranche unlocks when epoch last_epoch+1+i completes, one epoch duration after the previous one.
+func (k Keeper) ProjectedUnlocks(goCtx context.Context, req *types.QueryProjectedUnlocksRequest) (*types.QueryProjectedUnlocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ParticipantAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "participant address cannot be empty")
+	}
+
+	if req.EndTime != 0 && req.EndTime < req.StartTime {
+		return nil, status.Error(codes.InvalidArgument, "end time cannot be before start time")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	timing := k.GetEpochTiming(ctx)
+	response := &types.QueryProjectedUnlocksResponse{
+		Unlocks:        []types.ProjectedUnlock{},
+		TotalsBySource: []types.SourceCoins{},
+		EpochTiming:    timing,
+	}
+	filtered := req.StartTime != 0 || req.EndTime != 0
+
 	schedule, found := k.GetVestingSchedule(ctx, req.ParticipantAddress)
 	if !found {
-		return &types.QueryTotalVestingAmountResponse{
-			TotalAmount: sdk.NewCoins(),
-		}, nil
+		return response, nil
 	}
 
-	// Calculate total vesting amount across all epochs
-	totalAmount := sdk.NewCoins()
-	for _, epochAmount := range schedule.EpochAmounts {
-		totalAmount = totalAmount.Add(epochAmount.Coins...)
+	for i, epochAmount := range schedule.EpochAmounts {
+		if epochAmount.Coins.IsZero() {
+			continue
+		}
+		unlock := types.ProjectedUnlock{
+			Epoch:   timing.LastEpoch + 1 + uint64(i),
+			Coins:   epochAmount.Coins,
+			Sources: epochAmount.SourceBreakdown(),
+		}
+		if timing.EpochDuration > 0 {
+			unlock.UnlockTime = timing.LastUnlockTime + int64(i+1)*timing.EpochDuration
+		}
+		if filtered {
+			// Without a known epoch duration there is no date to filter on
+			if unlock.UnlockTime == 0 ||
+				(req.StartTime != 0 && unlock.UnlockTime < req.StartTime) ||
+				(req.EndTime != 0 && unlock.UnlockTime > req.EndTime) {
+				continue
+			}
+		}
+		response.Unlocks = append(response.Unlocks, unlock)
+		response.TotalsBySource = types.MergeSourceCoins(response.TotalsBySource, unlock.Sources)
 	}
 
-	return &types.QueryTotalVestingAmountResponse{
-		TotalAmount: totalAmount,
-	}, nil
+	return response, nil
 }
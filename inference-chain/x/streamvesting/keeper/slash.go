@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/streamvesting/types"
+)
+
+// GetVestingBalance returns the total coins still vesting for a participant
+func (k Keeper) GetVestingBalance(ctx context.Context, participantAddress string) sdk.Coins {
+	schedule, found := k.GetVestingSchedule(sdk.UnwrapSDKContext(ctx), participantAddress)
+	if !found {
+		return sdk.NewCoins()
+	}
+	total := sdk.NewCoins()
+	for _, epochAmount := range schedule.EpochAmounts {
+		total = total.Add(epochAmount.Coins...)
+	}
+	return total
+}
+
+// SlashVestingSchedule removes up to amount from a participant's unvested coins and sends it to recipientModule.
+// Coins are taken from the furthest epochs first, so the participant's next unlocks are the last to be affected.
+// Returns the amount actually removed, which is less than requested if the schedule holds less.
+func (k Keeper) SlashVestingSchedule(ctx context.Context, participantAddress string, amount sdk.Coin, recipientModule string, memo string) (sdk.Coin, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	slashed := sdk.NewCoin(amount.Denom, math.ZeroInt())
+	if !amount.IsPositive() {
+		return slashed, nil
+	}
+	schedule, found := k.GetVestingSchedule(sdkCtx, participantAddress)
+	if !found {
+		return slashed, nil
+	}
+
+	remaining := amount.Amount
+	for i := len(schedule.EpochAmounts) - 1; i >= 0 && remaining.IsPositive(); i-- {
+		available := schedule.EpochAmounts[i].Coins.AmountOf(amount.Denom)
+		if available.IsZero() {
+			continue
+		}
+		take := sdk.NewCoin(amount.Denom, math.MinInt(available, remaining))
+		schedule.EpochAmounts[i].SubCoin(take)
+		remaining = remaining.Sub(take.Amount)
+		slashed = slashed.Add(take)
+	}
+	if slashed.IsZero() {
+		return slashed, nil
+	}
+
+	// Trailing epochs emptied by the slash would only produce empty unlocks
+	for len(schedule.EpochAmounts) > 0 && schedule.EpochAmounts[len(schedule.EpochAmounts)-1].Coins.IsZero() {
+		schedule.EpochAmounts = schedule.EpochAmounts[:len(schedule.EpochAmounts)-1]
+	}
+
+	err := k.bookkeepingBankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipientModule, sdk.NewCoins(slashed), memo)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("failed to transfer slashed vesting coins to module %s: %w", recipientModule, err)
+	}
+	k.bookkeepingBankKeeper.LogSubAccountTransaction(ctx, recipientModule, participantAddress, HoldingSubAccount, slashed, memo)
+
+	if len(schedule.EpochAmounts) == 0 {
+		k.RemoveVestingSchedule(sdkCtx, participantAddress)
+	} else {
+		k.SetVestingSchedule(sdkCtx, schedule)
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashVesting,
+			sdk.NewAttribute(types.AttributeKeyParticipant, participantAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, slashed.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipientModule, recipientModule),
+		),
+	)
+	k.Logger().Info("Slashed vesting schedule", "participant", participantAddress, "amount", slashed, "recipient_module", recipientModule)
+
+	return slashed, nil
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/mock/gomock"
+
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/streamvesting/types"
+)
+
+func (suite *KeeperTestSuite) addFromSource(participant string, source types.VestingSource, amount int64, epochs uint64) {
+	suite.mocks.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "inference", types.ModuleName, gomock.Any(), gomock.Any())
+	suite.mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), types.ModuleName, participant, "vesting", gomock.Any(), gomock.Any())
+	err := suite.keeper.AddVestedRewardsFromSource(suite.ctx, participant, "inference", source, sdk.NewCoins(sdk.NewInt64Coin("ngonka", amount)), &epochs, "")
+	suite.Require().NoError(err)
+}
+
+func ngonka(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("ngonka", amount))
+}
+
+func (suite *KeeperTestSuite) TestAddVestedRewardsFromSource_TracksSourcesThroughSlash() {
+	participant := testutil.Creator
+	suite.addFromSource(participant, types.VestingSource_VESTING_SOURCE_TOP_MINER, 200, 4)
+	suite.addFromSource(participant, types.VestingSource_VESTING_SOURCE_WORK, 400, 2)
+	// Untagged coins from the legacy entry point count as unspecified
+	suite.mocks.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "inference", types.ModuleName, gomock.Any(), gomock.Any())
+	suite.mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), types.ModuleName, participant, "vesting", gomock.Any(), gomock.Any())
+	epochs := uint64(1)
+	suite.Require().NoError(suite.keeper.AddVestedRewards(suite.ctx, participant, "inference", ngonka(30), &epochs, ""))
+
+	schedule, found := suite.keeper.GetVestingSchedule(suite.ctx, participant)
+	suite.Require().True(found)
+	suite.Require().Len(schedule.EpochAmounts, 4)
+	suite.Require().Equal(ngonka(280), schedule.EpochAmounts[0].Coins)
+	suite.Require().Equal([]types.SourceCoins{
+		{Source: types.VestingSource_VESTING_SOURCE_UNSPECIFIED, Coins: ngonka(30)},
+		{Source: types.VestingSource_VESTING_SOURCE_WORK, Coins: ngonka(200)},
+		{Source: types.VestingSource_VESTING_SOURCE_TOP_MINER, Coins: ngonka(50)},
+	}, schedule.EpochAmounts[0].SourceBreakdown())
+
+	// 150 is taken from epochs 3 and 2 (50 each), then 50 from epoch 1, taking its top miner share before work
+	suite.mocks.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, "collateral", gomock.Any(), gomock.Any())
+	suite.mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), "collateral", participant, "vesting", gomock.Any(), gomock.Any())
+	_, err := suite.keeper.SlashVestingSchedule(suite.ctx, participant, sdk.NewInt64Coin("ngonka", 150), "collateral", "slash")
+	suite.Require().NoError(err)
+
+	schedule, _ = suite.keeper.GetVestingSchedule(suite.ctx, participant)
+	suite.Require().Len(schedule.EpochAmounts, 2)
+	suite.Require().Equal([]types.SourceCoins{
+		{Source: types.VestingSource_VESTING_SOURCE_WORK, Coins: ngonka(200)},
+	}, schedule.EpochAmounts[1].SourceBreakdown())
+	suite.Require().Equal(ngonka(280), schedule.EpochAmounts[0].Coins)
+}
+
+func (suite *KeeperTestSuite) TestProjectedUnlocks() {
+	participant := testutil.Creator
+	start := time.Unix(1_700_000_000, 0)
+
+	// No projection dates until two epochs have been observed
+	suite.ctx = suite.ctx.WithBlockHeight(100).WithBlockTime(start)
+	suite.Require().NoError(suite.keeper.AdvanceEpoch(suite.ctx, 7))
+	suite.addFromSource(participant, types.VestingSource_VESTING_SOURCE_SUBSIDY, 300, 3)
+	resp, err := suite.keeper.ProjectedUnlocks(suite.ctx, &types.QueryProjectedUnlocksRequest{ParticipantAddress: participant})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Unlocks, 3)
+	suite.Require().Equal(uint64(8), resp.Unlocks[0].Epoch)
+	suite.Require().Zero(resp.Unlocks[0].UnlockTime)
+
+	suite.mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), ngonka(100), "vesting payment").Return(nil)
+	suite.mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), participant, types.ModuleName, "vesting", gomock.Any(), gomock.Any())
+	suite.ctx = suite.ctx.WithBlockHeight(200).WithBlockTime(start.Add(time.Hour))
+	suite.Require().NoError(suite.keeper.AdvanceEpoch(suite.ctx, 8))
+
+	timing := suite.keeper.GetEpochTiming(suite.ctx)
+	suite.Require().Equal(types.EpochTiming{
+		LastEpoch:        8,
+		LastUnlockHeight: 200,
+		LastUnlockTime:   start.Add(time.Hour).Unix(),
+		EpochLength:      100,
+		EpochDuration:    3600,
+	}, timing)
+
+	suite.addFromSource(participant, types.VestingSource_VESTING_SOURCE_WORK, 90, 3)
+	resp, err = suite.keeper.ProjectedUnlocks(suite.ctx, &types.QueryProjectedUnlocksRequest{ParticipantAddress: participant})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Unlocks, 3)
+	suite.Require().Equal(uint64(9), resp.Unlocks[0].Epoch)
+	suite.Require().Equal(start.Add(2*time.Hour).Unix(), resp.Unlocks[0].UnlockTime)
+	suite.Require().Equal(ngonka(130), resp.Unlocks[0].Coins)
+	suite.Require().Equal(start.Add(3*time.Hour).Unix(), resp.Unlocks[1].UnlockTime)
+	suite.Require().Equal(ngonka(30), resp.Unlocks[2].Coins)
+	suite.Require().Equal([]types.SourceCoins{
+		{Source: types.VestingSource_VESTING_SOURCE_WORK, Coins: ngonka(90)},
+		{Source: types.VestingSource_VESTING_SOURCE_SUBSIDY, Coins: ngonka(200)},
+	}, resp.TotalsBySource)
+
+	// Filtering by date keeps only the unlocks projected inside the range
+	resp, err = suite.keeper.ProjectedUnlocks(suite.ctx, &types.QueryProjectedUnlocksRequest{
+		ParticipantAddress: participant,
+		StartTime:          start.Add(150 * time.Minute).Unix(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Unlocks, 2)
+	suite.Require().Equal(uint64(10), resp.Unlocks[0].Epoch)
+
+	_, err = suite.keeper.ProjectedUnlocks(suite.ctx, &types.QueryProjectedUnlocksRequest{ParticipantAddress: participant, StartTime: 10, EndTime: 5})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestProcessEpochUnlocks_EmitsEventPerSource() {
+	participant := testutil.Creator
+	suite.addFromSource(participant, types.VestingSource_VESTING_SOURCE_WORK, 100, 1)
+	suite.addFromSource(participant, types.VestingSource_VESTING_SOURCE_BITCOIN_REWARD, 50, 1)
+
+	suite.mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), ngonka(150), "vesting payment").Return(nil)
+	suite.mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), participant, types.ModuleName, "vesting", gomock.Any(), gomock.Any())
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.keeper.AdvanceEpoch(suite.ctx, 3))
+
+	var unlocks []map[string]string
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeVestUnlock {
+			continue
+		}
+		attributes := map[string]string{}
+		for _, attribute := range event.Attributes {
+			attributes[attribute.Key] = attribute.Value
+		}
+		unlocks = append(unlocks, attributes)
+	}
+	suite.Require().Equal([]map[string]string{
+		{"participant": participant, "epoch": "3", "source": "work", "amount": "100ngonka"},
+		{"participant": participant, "epoch": "3", "source": "bitcoin_reward", "amount": "50ngonka"},
+	}, unlocks)
+}
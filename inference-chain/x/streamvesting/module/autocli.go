@@ -33,6 +33,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "participant_address"},
 					},
 				},
+				{
+					RpcMethod: "ProjectedUnlocks",
+					Use:       "projected-unlocks [participant-address]",
+					Short:     "Shows a participant's upcoming unlocks with projected dates and a per-source breakdown",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "participant_address"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -14,6 +14,8 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetVestingSchedule(ctx, elem)
 	}
 
+	k.SetEpochTiming(ctx, genState.EpochTiming)
+
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -28,6 +30,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// Export all vesting schedules
 	vestingSchedules := k.GetAllVestingSchedules(ctx)
 	genesis.VestingScheduleList = vestingSchedules
+	genesis.EpochTiming = k.GetEpochTiming(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
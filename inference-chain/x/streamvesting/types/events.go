@@ -4,13 +4,17 @@ package types
 const (
 	EventTypeVestReward   = "vest_reward"
 	EventTypeUnlockTokens = "unlock_tokens"
+	EventTypeSlashVesting = "slash_vesting"
+	EventTypeVestUnlock   = "vest_unlock"
 )
 
 // Event attributes
 const (
-	AttributeKeyParticipant    = "participant"
-	AttributeKeyAmount         = "amount"
-	AttributeKeyVestingEpochs  = "vesting_epochs"
-	AttributeKeyUnlockedAmount = "unlocked_amount"
-	AttributeKeyEpoch          = "epoch"
+	AttributeKeyParticipant     = "participant"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyVestingEpochs   = "vesting_epochs"
+	AttributeKeyUnlockedAmount  = "unlocked_amount"
+	AttributeKeyEpoch           = "epoch"
+	AttributeKeyRecipientModule = "recipient_module"
+	AttributeKeySource          = "source"
 )
@@ -17,6 +17,11 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	for _, schedule := range gs.VestingScheduleList {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+	}
 
 	return gs.Params.Validate()
 }
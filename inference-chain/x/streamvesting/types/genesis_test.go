@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/productscience/inference/x/streamvesting/types"
 	"github.com/stretchr/testify/require"
 )
@@ -26,6 +27,22 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "sources exceeding the tranche are invalid",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				VestingScheduleList: []types.VestingSchedule{{
+					ParticipantAddress: sdk.AccAddress("participant_________").String(),
+					EpochAmounts: []types.EpochCoins{{
+						Coins: sdk.NewCoins(sdk.NewInt64Coin("ngonka", 100)),
+						Sources: []types.SourceCoins{
+							{Source: types.VestingSource_VESTING_SOURCE_WORK, Coins: sdk.NewCoins(sdk.NewInt64Coin("ngonka", 150))},
+						},
+					}},
+				}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -19,4 +19,7 @@ var (
 
 	// VestingScheduleKey is the collections prefix for storing vesting schedules (Map)
 	VestingScheduleKey = collections.NewPrefix(1)
+
+	// EpochTimingKey is the collections prefix for the timing of the last epoch unlocks (Item)
+	EpochTimingKey = collections.NewPrefix(2)
 )
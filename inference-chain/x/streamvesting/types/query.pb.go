@@ -296,6 +296,203 @@ func (m *QueryTotalVestingAmountResponse) GetTotalAmount() github_com_cosmos_cos
 	return nil
 }
 
+// QueryProjectedUnlocksRequest is request type for the Query/ProjectedUnlocks RPC method.
+type QueryProjectedUnlocksRequest struct {
+	ParticipantAddress string `protobuf:"bytes,1,opt,name=participant_address,json=participantAddress,proto3" json:"participant_address,omitempty"`
+	// start_time and end_time (unix seconds, inclusive) optionally limit the unlocks returned by projected date.
+	// Zero means unbounded.
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *QueryProjectedUnlocksRequest) Reset()         { *m = QueryProjectedUnlocksRequest{} }
+func (m *QueryProjectedUnlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedUnlocksRequest) ProtoMessage()    {}
+func (*QueryProjectedUnlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f667e3499a650297, []int{6}
+}
+func (m *QueryProjectedUnlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedUnlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedUnlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedUnlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedUnlocksRequest.Merge(m, src)
+}
+func (m *QueryProjectedUnlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedUnlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedUnlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedUnlocksRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedUnlocksRequest) GetParticipantAddress() string {
+	if m != nil {
+		return m.ParticipantAddress
+	}
+	return ""
+}
+
+func (m *QueryProjectedUnlocksRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryProjectedUnlocksRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// ProjectedUnlock is a single upcoming unlock of a vesting schedule
+type ProjectedUnlock struct {
+	// epoch is the epoch whose completion unlocks the coins
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// unlock_time is the projected unix time of the unlock, zero if the epoch length is not yet known
+	UnlockTime int64                                    `protobuf:"varint,2,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+	Coins      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	Sources    []SourceCoins                            `protobuf:"bytes,4,rep,name=sources,proto3" json:"sources"`
+}
+
+func (m *ProjectedUnlock) Reset()         { *m = ProjectedUnlock{} }
+func (m *ProjectedUnlock) String() string { return proto.CompactTextString(m) }
+func (*ProjectedUnlock) ProtoMessage()    {}
+func (*ProjectedUnlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f667e3499a650297, []int{7}
+}
+func (m *ProjectedUnlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectedUnlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectedUnlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectedUnlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectedUnlock.Merge(m, src)
+}
+func (m *ProjectedUnlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectedUnlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectedUnlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectedUnlock proto.InternalMessageInfo
+
+func (m *ProjectedUnlock) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ProjectedUnlock) GetUnlockTime() int64 {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return 0
+}
+
+func (m *ProjectedUnlock) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *ProjectedUnlock) GetSources() []SourceCoins {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+// QueryProjectedUnlocksResponse is response type for the Query/ProjectedUnlocks RPC method.
+type QueryProjectedUnlocksResponse struct {
+	Unlocks []ProjectedUnlock `protobuf:"bytes,1,rep,name=unlocks,proto3" json:"unlocks"`
+	// totals_by_source sums the returned unlocks per source
+	TotalsBySource []SourceCoins `protobuf:"bytes,2,rep,name=totals_by_source,json=totalsBySource,proto3" json:"totals_by_source"`
+	// epoch_timing is the timing the projection is based on
+	EpochTiming EpochTiming `protobuf:"bytes,3,opt,name=epoch_timing,json=epochTiming,proto3" json:"epoch_timing"`
+}
+
+func (m *QueryProjectedUnlocksResponse) Reset()         { *m = QueryProjectedUnlocksResponse{} }
+func (m *QueryProjectedUnlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedUnlocksResponse) ProtoMessage()    {}
+func (*QueryProjectedUnlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f667e3499a650297, []int{8}
+}
+func (m *QueryProjectedUnlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedUnlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedUnlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedUnlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedUnlocksResponse.Merge(m, src)
+}
+func (m *QueryProjectedUnlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedUnlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedUnlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedUnlocksResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedUnlocksResponse) GetUnlocks() []ProjectedUnlock {
+	if m != nil {
+		return m.Unlocks
+	}
+	return nil
+}
+
+func (m *QueryProjectedUnlocksResponse) GetTotalsBySource() []SourceCoins {
+	if m != nil {
+		return m.TotalsBySource
+	}
+	return nil
+}
+
+func (m *QueryProjectedUnlocksResponse) GetEpochTiming() EpochTiming {
+	if m != nil {
+		return m.EpochTiming
+	}
+	return EpochTiming{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "inference.streamvesting.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "inference.streamvesting.QueryParamsResponse")
@@ -303,6 +500,9 @@ func init() {
 	proto.RegisterType((*QueryVestingScheduleResponse)(nil), "inference.streamvesting.QueryVestingScheduleResponse")
 	proto.RegisterType((*QueryTotalVestingAmountRequest)(nil), "inference.streamvesting.QueryTotalVestingAmountRequest")
 	proto.RegisterType((*QueryTotalVestingAmountResponse)(nil), "inference.streamvesting.QueryTotalVestingAmountResponse")
+	proto.RegisterType((*QueryProjectedUnlocksRequest)(nil), "inference.streamvesting.QueryProjectedUnlocksRequest")
+	proto.RegisterType((*ProjectedUnlock)(nil), "inference.streamvesting.ProjectedUnlock")
+	proto.RegisterType((*QueryProjectedUnlocksResponse)(nil), "inference.streamvesting.QueryProjectedUnlocksResponse")
 }
 
 func init() {
@@ -310,44 +510,59 @@ func init() {
 }
 
 var fileDescriptor_f667e3499a650297 = []byte{
-	// 583 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xce, 0xf8, 0x51, 0xe8, 0x44, 0xa8, 0x4e, 0x0b, 0xd6, 0x58, 0x36, 0x65, 0xf5, 0x10, 0xaa,
-	0xce, 0xd8, 0x68, 0xfd, 0x38, 0x36, 0x1e, 0xc5, 0xd2, 0x26, 0x52, 0xd0, 0x4b, 0x98, 0x6c, 0xc6,
-	0xed, 0x60, 0x76, 0x66, 0xbb, 0x33, 0x1b, 0x2c, 0xe2, 0xc5, 0x5f, 0x20, 0x08, 0x5e, 0xfc, 0x03,
-	0xe2, 0xc9, 0x9f, 0xd1, 0x63, 0x41, 0x10, 0x0f, 0x7e, 0x91, 0x08, 0xfe, 0x0d, 0xd9, 0x99, 0x89,
-	0x24, 0x69, 0x36, 0xa4, 0x5e, 0xb2, 0x9b, 0xf7, 0x7d, 0xde, 0xf7, 0x79, 0x9e, 0x99, 0x87, 0x85,
-	0x57, 0xb8, 0x78, 0xc6, 0x12, 0x26, 0x02, 0x46, 0x94, 0x4e, 0x18, 0x8d, 0xba, 0x4c, 0x69, 0x2e,
-	0x42, 0xb2, 0x9f, 0xb2, 0xe4, 0x00, 0xc7, 0x89, 0xd4, 0x12, 0x5d, 0xfc, 0x07, 0xc2, 0x23, 0xa0,
-	0xd2, 0x05, 0x1a, 0x71, 0x21, 0x89, 0xf9, 0xb5, 0xd8, 0xd2, 0x52, 0x28, 0x43, 0x69, 0x5e, 0x49,
-	0xf6, 0xe6, 0xaa, 0x2b, 0xa1, 0x94, 0x61, 0x87, 0x11, 0x1a, 0x73, 0x42, 0x85, 0x90, 0x9a, 0x6a,
-	0x2e, 0x85, 0x72, 0xdd, 0xb5, 0x40, 0xaa, 0x48, 0x2a, 0xd2, 0xa2, 0x8a, 0x59, 0x62, 0xd2, 0x5d,
-	0x6f, 0x31, 0x4d, 0xd7, 0x49, 0x4c, 0x43, 0x2e, 0x0c, 0xd8, 0x61, 0xbd, 0x61, 0xec, 0x00, 0x15,
-	0x48, 0x3e, 0xe8, 0x5f, 0xcd, 0x33, 0x14, 0xd3, 0x84, 0x46, 0x03, 0x46, 0x9c, 0x87, 0x72, 0xcf,
-	0xa6, 0x0a, 0xf6, 0x58, 0x3b, 0xed, 0x30, 0x8b, 0xf7, 0x97, 0x20, 0xda, 0xc9, 0x74, 0x6d, 0x9b,
-	0x25, 0x75, 0xb6, 0x9f, 0x32, 0xa5, 0xfd, 0x27, 0x70, 0x71, 0xa4, 0xaa, 0x62, 0x29, 0x14, 0x43,
-	0x35, 0x38, 0x67, 0xc9, 0x96, 0xc1, 0x2a, 0xa8, 0x14, 0xab, 0x65, 0x9c, 0x73, 0x7e, 0xd8, 0x0e,
-	0xd6, 0xe6, 0x0f, 0x7f, 0x94, 0x0b, 0x1f, 0xfe, 0x7c, 0x5a, 0x03, 0x75, 0x37, 0xe9, 0x6f, 0xc1,
-	0xcb, 0x66, 0xf5, 0xae, 0x45, 0x36, 0x9c, 0x1c, 0xc7, 0x8c, 0x08, 0x5c, 0x8c, 0x69, 0xa2, 0x79,
-	0xc0, 0x63, 0x2a, 0x74, 0x93, 0xb6, 0xdb, 0x09, 0x53, 0x96, 0x6f, 0xbe, 0x8e, 0x86, 0x5a, 0x9b,
-	0xb6, 0xe3, 0x2b, 0xb8, 0x32, 0x79, 0x9f, 0xd3, 0xdc, 0x80, 0xe7, 0xc7, 0xad, 0x3b, 0xf5, 0x95,
-	0x5c, 0xf5, 0xe3, 0xbb, 0x16, 0xba, 0xa3, 0x05, 0x7f, 0x07, 0x7a, 0x86, 0xf4, 0xb1, 0xd4, 0xb4,
-	0xe3, 0xd0, 0x9b, 0x91, 0x4c, 0x85, 0xfe, 0x6f, 0x1f, 0xef, 0x00, 0x2c, 0xe7, 0xee, 0x74, 0x5e,
-	0x14, 0x3c, 0xa7, 0xb3, 0x6e, 0x93, 0x9a, 0xfa, 0x32, 0x58, 0x3d, 0x5d, 0x29, 0x56, 0x2f, 0x61,
-	0x9b, 0x1c, 0x9c, 0x25, 0x07, 0xbb, 0xe4, 0xe0, 0x07, 0x92, 0x8b, 0xda, 0x46, 0x76, 0xfe, 0x1f,
-	0x7f, 0x96, 0x2b, 0x21, 0xd7, 0x7b, 0x69, 0x0b, 0x07, 0x32, 0x22, 0x2e, 0x66, 0xf6, 0x71, 0x43,
-	0xb5, 0x9f, 0x13, 0x7d, 0x10, 0x33, 0x65, 0x06, 0x94, 0xbd, 0xab, 0xa2, 0x61, 0xb1, 0xe4, 0xd5,
-	0xef, 0x67, 0xe0, 0x59, 0x23, 0x0c, 0xbd, 0x07, 0x70, 0xce, 0x5e, 0x2c, 0xba, 0x96, 0x7b, 0x76,
-	0xc7, 0xd3, 0x54, 0xba, 0x3e, 0x1b, 0xd8, 0x9a, 0xf4, 0xef, 0xbc, 0xfe, 0xfc, 0xfb, 0xed, 0xa9,
-	0x9b, 0x08, 0x93, 0x38, 0x91, 0xed, 0x34, 0xd0, 0x2a, 0xe0, 0x26, 0xcf, 0xd3, 0xf3, 0x8f, 0xbe,
-	0x00, 0xb8, 0x30, 0x76, 0x71, 0xe8, 0xf6, 0x74, 0xe6, 0xc9, 0x19, 0x2c, 0x6d, 0x9c, 0x70, 0xca,
-	0x09, 0xdf, 0x35, 0xc2, 0xb7, 0xd1, 0xd6, 0xac, 0xc2, 0xc7, 0x73, 0x49, 0x5e, 0x4e, 0x88, 0xcc,
-	0x2b, 0xf4, 0x0d, 0x40, 0x74, 0x3c, 0x14, 0xe8, 0xee, 0x74, 0x95, 0xb9, 0xd1, 0x2c, 0xdd, 0x3b,
-	0xf9, 0xa0, 0x73, 0xd8, 0x30, 0x0e, 0x1f, 0xa1, 0x87, 0xb3, 0x3a, 0xb4, 0x69, 0x1d, 0xfc, 0x9b,
-	0x68, 0xaf, 0xd6, 0x38, 0xec, 0x79, 0xe0, 0xa8, 0xe7, 0x81, 0x5f, 0x3d, 0x0f, 0xbc, 0xe9, 0x7b,
-	0x85, 0xa3, 0xbe, 0x57, 0xf8, 0xda, 0xf7, 0x0a, 0x4f, 0xef, 0x0f, 0xa5, 0x36, 0x97, 0xf0, 0xc5,
-	0x38, 0x65, 0x16, 0xe6, 0xd6, 0x9c, 0xf9, 0xba, 0xdd, 0xfa, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xbd,
-	0xfa, 0xe6, 0xad, 0x06, 0x06, 0x00, 0x00,
+	// 832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0xda, 0x60, 0xca, 0x18, 0x15, 0x3a, 0x20, 0xd5, 0xb8, 0xb0, 0x46, 0x5b, 0x0e, 0x16,
+	0x6d, 0x77, 0x0b, 0x2d, 0xb4, 0x3d, 0xe2, 0xaa, 0x87, 0xaa, 0x82, 0x82, 0x4d, 0xa9, 0xe8, 0xc5,
+	0x1a, 0xef, 0x0e, 0xcb, 0x16, 0xef, 0xcc, 0xb2, 0x33, 0x8b, 0x62, 0x45, 0xb9, 0xe4, 0x96, 0x5b,
+	0xa4, 0x48, 0xb9, 0x44, 0xb9, 0x47, 0x39, 0xe5, 0x9c, 0x5f, 0xc0, 0x11, 0x29, 0x52, 0x14, 0x45,
+	0x51, 0x12, 0x41, 0xa4, 0xfc, 0x88, 0x5c, 0xa2, 0x9d, 0x19, 0x27, 0xf6, 0xe2, 0x75, 0x0c, 0x52,
+	0x2e, 0xde, 0xdd, 0x79, 0xdf, 0x7c, 0xdf, 0xfb, 0xde, 0xbc, 0x79, 0x32, 0xf8, 0xd6, 0x23, 0xfb,
+	0x38, 0xc4, 0xc4, 0xc6, 0x16, 0xe3, 0x21, 0x46, 0xfe, 0x31, 0x66, 0xdc, 0x23, 0xae, 0x75, 0x14,
+	0xe1, 0xb0, 0x6d, 0x06, 0x21, 0xe5, 0x14, 0x7e, 0xfd, 0x01, 0x64, 0xf6, 0x80, 0x4a, 0x5f, 0x21,
+	0xdf, 0x23, 0xd4, 0x12, 0xbf, 0x12, 0x5b, 0x9a, 0x71, 0xa9, 0x4b, 0xc5, 0xab, 0x15, 0xbf, 0xa9,
+	0xd5, 0x39, 0x97, 0x52, 0xb7, 0x85, 0x2d, 0x14, 0x78, 0x16, 0x22, 0x84, 0x72, 0xc4, 0x3d, 0x4a,
+	0x98, 0x8a, 0x2e, 0xd9, 0x94, 0xf9, 0x94, 0x59, 0x4d, 0xc4, 0xb0, 0x14, 0xb6, 0x8e, 0x97, 0x9b,
+	0x98, 0xa3, 0x65, 0x2b, 0x40, 0xae, 0x47, 0x04, 0x58, 0x61, 0xf5, 0x6e, 0x6c, 0x07, 0x65, 0x53,
+	0xaf, 0x13, 0x5f, 0x4c, 0x33, 0x14, 0xa0, 0x10, 0xf9, 0x1d, 0x45, 0x33, 0x0d, 0xa5, 0x9e, 0x0d,
+	0x66, 0x1f, 0x60, 0x27, 0x6a, 0x61, 0x89, 0x37, 0x66, 0x00, 0xdc, 0x8e, 0xf3, 0xda, 0x12, 0x24,
+	0x35, 0x7c, 0x14, 0x61, 0xc6, 0x8d, 0x3d, 0x30, 0xdd, 0xb3, 0xca, 0x02, 0x4a, 0x18, 0x86, 0x55,
+	0x90, 0x97, 0x62, 0x45, 0x6d, 0x41, 0xab, 0x14, 0x56, 0xca, 0x66, 0x4a, 0xfd, 0x4c, 0xb9, 0xb1,
+	0x3a, 0x7e, 0xf2, 0xb2, 0x9c, 0x79, 0xf0, 0xf6, 0xd1, 0x92, 0x56, 0x53, 0x3b, 0x8d, 0x4d, 0xf0,
+	0x8d, 0xa0, 0xde, 0x95, 0xc8, 0xba, 0x4a, 0x47, 0x29, 0x43, 0x0b, 0x4c, 0x07, 0x28, 0xe4, 0x9e,
+	0xed, 0x05, 0x88, 0xf0, 0x06, 0x72, 0x9c, 0x10, 0x33, 0xa9, 0x37, 0x5e, 0x83, 0x5d, 0xa1, 0x75,
+	0x19, 0x31, 0x18, 0x98, 0xeb, 0xcf, 0xa7, 0x72, 0xae, 0x83, 0xa9, 0xa4, 0x75, 0x95, 0x7d, 0x25,
+	0x35, 0xfb, 0x24, 0xd7, 0xe4, 0x71, 0xef, 0x82, 0xb1, 0x0d, 0x74, 0x21, 0xba, 0x43, 0x39, 0x6a,
+	0x29, 0xf4, 0xba, 0x4f, 0x23, 0xc2, 0xaf, 0xec, 0xe3, 0xae, 0x06, 0xca, 0xa9, 0x9c, 0xca, 0x0b,
+	0x03, 0x13, 0x3c, 0x8e, 0x36, 0x90, 0x58, 0x2f, 0x6a, 0x0b, 0xb9, 0x4a, 0x61, 0x65, 0xd6, 0x94,
+	0x9d, 0x63, 0xc6, 0x9d, 0x63, 0xaa, 0xce, 0x31, 0x7f, 0xa7, 0x1e, 0xa9, 0xae, 0xc6, 0xf5, 0x7f,
+	0xf8, 0xaa, 0x5c, 0x71, 0x3d, 0x7e, 0x10, 0x35, 0x4d, 0x9b, 0xfa, 0x96, 0x6a, 0x33, 0xf9, 0xf8,
+	0x81, 0x39, 0x87, 0x16, 0x6f, 0x07, 0x98, 0x89, 0x0d, 0x4c, 0x9e, 0x55, 0x41, 0xa8, 0x48, 0x71,
+	0xe3, 0x96, 0xa6, 0x2a, 0xbc, 0x15, 0xd2, 0xff, 0xb1, 0xcd, 0xb1, 0xf3, 0x0f, 0x69, 0x51, 0xfb,
+	0x90, 0x5d, 0xd5, 0x2a, 0x9c, 0x07, 0x80, 0x71, 0x14, 0xf2, 0x06, 0xf7, 0x7c, 0x5c, 0xcc, 0x2e,
+	0x68, 0x95, 0x5c, 0x6d, 0x5c, 0xac, 0xec, 0x78, 0x3e, 0x86, 0xb3, 0xe0, 0x0b, 0x4c, 0x1c, 0x19,
+	0xcc, 0x89, 0xe0, 0x18, 0x26, 0x4e, 0x1c, 0x32, 0xde, 0x69, 0x60, 0x32, 0x91, 0x06, 0x9c, 0x01,
+	0xa3, 0x38, 0xa0, 0xf6, 0x81, 0x10, 0x1c, 0xa9, 0xc9, 0x0f, 0x58, 0x06, 0x85, 0x48, 0xc4, 0xbb,
+	0x45, 0x80, 0x5c, 0x12, 0x2a, 0xfb, 0x60, 0x34, 0xbe, 0x5c, 0xac, 0x98, 0xfb, 0x4c, 0x45, 0x94,
+	0xf4, 0xf0, 0x4f, 0x30, 0xc6, 0x68, 0x14, 0xda, 0x98, 0x15, 0x47, 0x84, 0xd2, 0x62, 0x6a, 0xdb,
+	0xd5, 0x05, 0x4e, 0x50, 0x74, 0xdf, 0x9c, 0xce, 0x7e, 0xe3, 0x7e, 0x16, 0xcc, 0xa7, 0x9c, 0x84,
+	0x6a, 0x90, 0x0d, 0x30, 0x26, 0x2d, 0x32, 0xd5, 0x1b, 0xe9, 0x3d, 0x9e, 0xe0, 0xe8, 0x11, 0x54,
+	0x1c, 0x70, 0x0f, 0x4c, 0x89, 0x4e, 0x60, 0x8d, 0x66, 0xbb, 0x21, 0xb3, 0x28, 0x66, 0xaf, 0x66,
+	0xe2, 0x4b, 0x49, 0x54, 0x6d, 0xcb, 0x38, 0xac, 0x81, 0x09, 0x71, 0x50, 0xf1, 0xf1, 0x78, 0xc4,
+	0x15, 0x07, 0x3d, 0x88, 0xf6, 0x8f, 0x18, 0xbc, 0x23, 0xb0, 0xdd, 0xb4, 0x05, 0xfc, 0x71, 0x7d,
+	0xe5, 0x71, 0x1e, 0x8c, 0x8a, 0xfa, 0xc0, 0x7b, 0x1a, 0xc8, 0xcb, 0x11, 0x04, 0xbf, 0x4b, 0xa5,
+	0xbc, 0x38, 0xf7, 0x4a, 0xdf, 0x0f, 0x07, 0x96, 0xd5, 0x36, 0xd6, 0x6e, 0x3e, 0x79, 0x73, 0x27,
+	0xfb, 0x23, 0x34, 0xad, 0x20, 0xa4, 0x4e, 0x64, 0x73, 0x66, 0x7b, 0x62, 0xf2, 0x0e, 0x9e, 0xd4,
+	0xf0, 0xa9, 0x06, 0x26, 0x13, 0x23, 0x06, 0xfe, 0x3c, 0x58, 0xb9, 0xff, 0xb4, 0x2c, 0xad, 0x5e,
+	0x72, 0x97, 0x4a, 0x7c, 0x57, 0x24, 0xbe, 0x05, 0x37, 0x87, 0x4d, 0x3c, 0x39, 0x41, 0xad, 0xeb,
+	0x7d, 0x6e, 0xfc, 0x0d, 0xf8, 0x42, 0x03, 0xf0, 0xe2, 0xf8, 0x82, 0xbf, 0x0c, 0xce, 0x32, 0x75,
+	0x88, 0x96, 0x7e, 0xbd, 0xfc, 0x46, 0xe5, 0xb0, 0x2e, 0x1c, 0x6e, 0xc0, 0xbf, 0x86, 0x75, 0x28,
+	0xe7, 0x6a, 0xe7, 0xab, 0xbf, 0xbd, 0xe7, 0x1a, 0x98, 0x4a, 0x5e, 0x3d, 0xf8, 0x89, 0x23, 0x48,
+	0x19, 0x9a, 0xa5, 0xb5, 0xcb, 0x6e, 0x53, 0xc6, 0xfe, 0x15, 0xc6, 0xb6, 0xe1, 0xdf, 0x43, 0xf7,
+	0x5c, 0x87, 0xa9, 0xa1, 0x6e, 0x75, 0x7f, 0x73, 0xd5, 0xfa, 0xc9, 0x99, 0xae, 0x9d, 0x9e, 0xe9,
+	0xda, 0xeb, 0x33, 0x5d, 0xbb, 0x7d, 0xae, 0x67, 0x4e, 0xcf, 0xf5, 0xcc, 0xb3, 0x73, 0x3d, 0xf3,
+	0xdf, 0x6f, 0x5d, 0x73, 0x2f, 0x55, 0xf4, 0x5a, 0xb2, 0x9e, 0xf1, 0x38, 0x6c, 0xe6, 0xc5, 0x9f,
+	0x8c, 0x9f, 0xde, 0x07, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x6e, 0x2f, 0x45, 0x8d, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -368,6 +583,9 @@ type QueryClient interface {
 	VestingSchedule(ctx context.Context, in *QueryVestingScheduleRequest, opts ...grpc.CallOption) (*QueryVestingScheduleResponse, error)
 	// TotalVestingAmount queries the total vesting amount for a participant.
 	TotalVestingAmount(ctx context.Context, in *QueryTotalVestingAmountRequest, opts ...grpc.CallOption) (*QueryTotalVestingAmountResponse, error)
+	// ProjectedUnlocks projects the date of each of a participant's upcoming unlocks from the observed epoch length,
+	// with a per-source breakdown.
+	ProjectedUnlocks(ctx context.Context, in *QueryProjectedUnlocksRequest, opts ...grpc.CallOption) (*QueryProjectedUnlocksResponse, error)
 }
 
 type queryClient struct {
@@ -405,6 +623,15 @@ func (c *queryClient) TotalVestingAmount(ctx context.Context, in *QueryTotalVest
 	return out, nil
 }
 
+func (c *queryClient) ProjectedUnlocks(ctx context.Context, in *QueryProjectedUnlocksRequest, opts ...grpc.CallOption) (*QueryProjectedUnlocksResponse, error) {
+	out := new(QueryProjectedUnlocksResponse)
+	err := c.cc.Invoke(ctx, "/inference.streamvesting.Query/ProjectedUnlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -413,6 +640,9 @@ type QueryServer interface {
 	VestingSchedule(context.Context, *QueryVestingScheduleRequest) (*QueryVestingScheduleResponse, error)
 	// TotalVestingAmount queries the total vesting amount for a participant.
 	TotalVestingAmount(context.Context, *QueryTotalVestingAmountRequest) (*QueryTotalVestingAmountResponse, error)
+	// ProjectedUnlocks projects the date of each of a participant's upcoming unlocks from the observed epoch length,
+	// with a per-source breakdown.
+	ProjectedUnlocks(context.Context, *QueryProjectedUnlocksRequest) (*QueryProjectedUnlocksResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -428,6 +658,9 @@ func (*UnimplementedQueryServer) VestingSchedule(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) TotalVestingAmount(ctx context.Context, req *QueryTotalVestingAmountRequest) (*QueryTotalVestingAmountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalVestingAmount not implemented")
 }
+func (*UnimplementedQueryServer) ProjectedUnlocks(ctx context.Context, req *QueryProjectedUnlocksRequest) (*QueryProjectedUnlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedUnlocks not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -487,6 +720,24 @@ func _Query_TotalVestingAmount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedUnlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedUnlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedUnlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.streamvesting.Query/ProjectedUnlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedUnlocks(ctx, req.(*QueryProjectedUnlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.streamvesting.Query",
@@ -504,6 +755,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalVestingAmount",
 			Handler:    _Query_TotalVestingAmount_Handler,
 		},
+		{
+			MethodName: "ProjectedUnlocks",
+			Handler:    _Query_ProjectedUnlocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/streamvesting/query.proto",
@@ -697,6 +952,168 @@ func (m *QueryTotalVestingAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProjectedUnlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedUnlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedUnlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ParticipantAddress) > 0 {
+		i -= len(m.ParticipantAddress)
+		copy(dAtA[i:], m.ParticipantAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ParticipantAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectedUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectedUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectedUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.UnlockTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedUnlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedUnlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedUnlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EpochTiming.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TotalsBySource) > 0 {
+		for iNdEx := len(m.TotalsBySource) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalsBySource[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Unlocks) > 0 {
+		for iNdEx := len(m.Unlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -782,6 +1199,75 @@ func (m *QueryTotalVestingAmountResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProjectedUnlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParticipantAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *ProjectedUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.UnlockTime != 0 {
+		n += 1 + sovQuery(uint64(m.UnlockTime))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProjectedUnlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unlocks) > 0 {
+		for _, e := range m.Unlocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalsBySource) > 0 {
+		for _, e := range m.TotalsBySource {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.EpochTiming.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1255,6 +1741,433 @@ func (m *QueryTotalVestingAmountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProjectedUnlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedUnlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedUnlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectedUnlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectedUnlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectedUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, SourceCoins{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedUnlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedUnlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedUnlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocks = append(m.Unlocks, ProjectedUnlock{})
+			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalsBySource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalsBySource = append(m.TotalsBySource, SourceCoins{})
+			if err := m.TotalsBySource[len(m.TotalsBySource)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTiming", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochTiming.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Label returns the short lowercase name of the source used in events, e.g. "top_miner"
+func (s VestingSource) Label() string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "VESTING_SOURCE_"))
+}
+
+// AddFromSource adds coins to the tranche and records them against source.
+// Unspecified coins are left untagged, which keeps schedules funded only through AddVestedRewards unchanged.
+func (e *EpochCoins) AddFromSource(source VestingSource, coins ...sdk.Coin) {
+	e.Coins = e.Coins.Add(coins...)
+	if source == VestingSource_VESTING_SOURCE_UNSPECIFIED {
+		return
+	}
+	e.Sources = addSourceCoins(e.Sources, source, coins)
+}
+
+func addSourceCoins(sources []SourceCoins, source VestingSource, coins sdk.Coins) []SourceCoins {
+	for i := range sources {
+		if sources[i].Source == source {
+			sources[i].Coins = sources[i].Coins.Add(coins...)
+			return sources
+		}
+	}
+	sources = append(sources, SourceCoins{Source: source, Coins: sdk.NewCoins(coins...)})
+	sort.Slice(sources, func(i, j int) bool { return sources[i].Source < sources[j].Source })
+	return sources
+}
+
+// SubCoin removes coin from the tranche. Untagged coins are taken first, then sources from the
+// highest source down, so the breakdown always sums to the tranche total.
+func (e *EpochCoins) SubCoin(coin sdk.Coin) {
+	remaining := coin.Amount.Sub(e.untagged().AmountOf(coin.Denom))
+	e.Coins = e.Coins.Sub(coin)
+	for i := len(e.Sources) - 1; i >= 0 && remaining.IsPositive(); i-- {
+		available := e.Sources[i].Coins.AmountOf(coin.Denom)
+		if available.IsZero() {
+			continue
+		}
+		take := math.MinInt(available, remaining)
+		e.Sources[i].Coins = e.Sources[i].Coins.Sub(sdk.NewCoin(coin.Denom, take))
+		remaining = remaining.Sub(take)
+	}
+	sources := e.Sources[:0]
+	for _, s := range e.Sources {
+		if !s.Coins.IsZero() {
+			sources = append(sources, s)
+		}
+	}
+	e.Sources = sources
+}
+
+// SourceBreakdown returns the tranche split by source, attributing untagged coins to VESTING_SOURCE_UNSPECIFIED
+func (e EpochCoins) SourceBreakdown() []SourceCoins {
+	untagged := e.untagged()
+	if untagged.IsZero() {
+		return e.Sources
+	}
+	return addSourceCoins(append([]SourceCoins(nil), e.Sources...), VestingSource_VESTING_SOURCE_UNSPECIFIED, untagged)
+}
+
+func (e EpochCoins) untagged() sdk.Coins {
+	untagged := e.Coins
+	for _, s := range e.Sources {
+		untagged, _ = untagged.SafeSub(s.Coins...)
+	}
+	return untagged
+}
+
+// MergeSourceCoins adds the coins in add to totals per source, keeping totals ordered by source
+func MergeSourceCoins(totals []SourceCoins, add []SourceCoins) []SourceCoins {
+	for _, s := range add {
+		totals = addSourceCoins(totals, s.Source, s.Coins)
+	}
+	return totals
+}
+
+// Validate checks that the source breakdown of every tranche fits within the tranche
+func (vs VestingSchedule) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(vs.ParticipantAddress); err != nil {
+		return fmt.Errorf("invalid participant address %s: %w", vs.ParticipantAddress, err)
+	}
+	for i, epochAmount := range vs.EpochAmounts {
+		tagged := sdk.NewCoins()
+		for _, s := range epochAmount.Sources {
+			tagged = tagged.Add(s.Coins...)
+		}
+		if !epochAmount.Coins.IsAllGTE(tagged) {
+			return fmt.Errorf("schedule %s epoch %d: sources %s exceed coins %s", vs.ParticipantAddress, i, tagged, epochAmount.Coins)
+		}
+	}
+	return nil
+}
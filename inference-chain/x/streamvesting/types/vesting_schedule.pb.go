@@ -26,16 +26,111 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// VestingSource identifies the reward stream a vesting tranche was funded by
+type VestingSource int32
+
+const (
+	VestingSource_VESTING_SOURCE_UNSPECIFIED VestingSource = 0
+	// Fees paid by requesters for inference work
+	VestingSource_VESTING_SOURCE_WORK VestingSource = 1
+	// Epoch subsidy rewards
+	VestingSource_VESTING_SOURCE_SUBSIDY VestingSource = 2
+	// Top miner payouts from the top reward pool
+	VestingSource_VESTING_SOURCE_TOP_MINER VestingSource = 3
+	// Fixed bitcoin-style epoch rewards
+	VestingSource_VESTING_SOURCE_BITCOIN_REWARD VestingSource = 4
+)
+
+var VestingSource_name = map[int32]string{
+	0: "VESTING_SOURCE_UNSPECIFIED",
+	1: "VESTING_SOURCE_WORK",
+	2: "VESTING_SOURCE_SUBSIDY",
+	3: "VESTING_SOURCE_TOP_MINER",
+	4: "VESTING_SOURCE_BITCOIN_REWARD",
+}
+
+var VestingSource_value = map[string]int32{
+	"VESTING_SOURCE_UNSPECIFIED":    0,
+	"VESTING_SOURCE_WORK":           1,
+	"VESTING_SOURCE_SUBSIDY":        2,
+	"VESTING_SOURCE_TOP_MINER":      3,
+	"VESTING_SOURCE_BITCOIN_REWARD": 4,
+}
+
+func (x VestingSource) String() string {
+	return proto.EnumName(VestingSource_name, int32(x))
+}
+
+func (VestingSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eb8c6db5760cec1, []int{0}
+}
+
+// SourceCoins is the part of a tranche that came from a single reward source
+type SourceCoins struct {
+	Source VestingSource                            `protobuf:"varint,1,opt,name=source,proto3,enum=inference.streamvesting.VestingSource" json:"source,omitempty"`
+	Coins  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *SourceCoins) Reset()         { *m = SourceCoins{} }
+func (m *SourceCoins) String() string { return proto.CompactTextString(m) }
+func (*SourceCoins) ProtoMessage()    {}
+func (*SourceCoins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb8c6db5760cec1, []int{0}
+}
+func (m *SourceCoins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceCoins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceCoins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceCoins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceCoins.Merge(m, src)
+}
+func (m *SourceCoins) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceCoins) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceCoins.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceCoins proto.InternalMessageInfo
+
+func (m *SourceCoins) GetSource() VestingSource {
+	if m != nil {
+		return m.Source
+	}
+	return VestingSource_VESTING_SOURCE_UNSPECIFIED
+}
+
+func (m *SourceCoins) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
 // EpochCoins represents the coins to be unlocked in a specific epoch
 type EpochCoins struct {
 	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// sources breaks coins down by reward source, ordered by source.
+	// Coins not covered by sources (tranches created before sources were tracked) are unspecified.
+	Sources []SourceCoins `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources"`
 }
 
 func (m *EpochCoins) Reset()         { *m = EpochCoins{} }
 func (m *EpochCoins) String() string { return proto.CompactTextString(m) }
 func (*EpochCoins) ProtoMessage()    {}
 func (*EpochCoins) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb8c6db5760cec1, []int{0}
+	return fileDescriptor_9eb8c6db5760cec1, []int{1}
 }
 func (m *EpochCoins) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -71,6 +166,13 @@ func (m *EpochCoins) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *EpochCoins) GetSources() []SourceCoins {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
 // VestingSchedule defines a participant's vesting schedule
 type VestingSchedule struct {
 	// participant_address is the address of the participant
@@ -85,7 +187,7 @@ func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
 func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
 func (*VestingSchedule) ProtoMessage()    {}
 func (*VestingSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb8c6db5760cec1, []int{1}
+	return fileDescriptor_9eb8c6db5760cec1, []int{2}
 }
 func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,9 +230,94 @@ func (m *VestingSchedule) GetEpochAmounts() []EpochCoins {
 	return nil
 }
 
+// EpochTiming records when the last epoch unlocks ran, used to project future unlock dates
+type EpochTiming struct {
+	// last_epoch is the most recently completed epoch
+	LastEpoch uint64 `protobuf:"varint,1,opt,name=last_epoch,json=lastEpoch,proto3" json:"last_epoch,omitempty"`
+	// last_unlock_height is the block height the last unlocks ran at
+	LastUnlockHeight int64 `protobuf:"varint,2,opt,name=last_unlock_height,json=lastUnlockHeight,proto3" json:"last_unlock_height,omitempty"`
+	// last_unlock_time is the block time (unix seconds) the last unlocks ran at
+	LastUnlockTime int64 `protobuf:"varint,3,opt,name=last_unlock_time,json=lastUnlockTime,proto3" json:"last_unlock_time,omitempty"`
+	// epoch_length is the number of blocks between the last two unlocks
+	EpochLength int64 `protobuf:"varint,4,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// epoch_duration is the number of seconds between the last two unlocks
+	EpochDuration int64 `protobuf:"varint,5,opt,name=epoch_duration,json=epochDuration,proto3" json:"epoch_duration,omitempty"`
+}
+
+func (m *EpochTiming) Reset()         { *m = EpochTiming{} }
+func (m *EpochTiming) String() string { return proto.CompactTextString(m) }
+func (*EpochTiming) ProtoMessage()    {}
+func (*EpochTiming) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb8c6db5760cec1, []int{3}
+}
+func (m *EpochTiming) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochTiming) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochTiming.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochTiming) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochTiming.Merge(m, src)
+}
+func (m *EpochTiming) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochTiming) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochTiming.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochTiming proto.InternalMessageInfo
+
+func (m *EpochTiming) GetLastEpoch() uint64 {
+	if m != nil {
+		return m.LastEpoch
+	}
+	return 0
+}
+
+func (m *EpochTiming) GetLastUnlockHeight() int64 {
+	if m != nil {
+		return m.LastUnlockHeight
+	}
+	return 0
+}
+
+func (m *EpochTiming) GetLastUnlockTime() int64 {
+	if m != nil {
+		return m.LastUnlockTime
+	}
+	return 0
+}
+
+func (m *EpochTiming) GetEpochLength() int64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *EpochTiming) GetEpochDuration() int64 {
+	if m != nil {
+		return m.EpochDuration
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("inference.streamvesting.VestingSource", VestingSource_name, VestingSource_value)
+	proto.RegisterType((*SourceCoins)(nil), "inference.streamvesting.SourceCoins")
 	proto.RegisterType((*EpochCoins)(nil), "inference.streamvesting.EpochCoins")
 	proto.RegisterType((*VestingSchedule)(nil), "inference.streamvesting.VestingSchedule")
+	proto.RegisterType((*EpochTiming)(nil), "inference.streamvesting.EpochTiming")
 }
 
 func init() {
@@ -138,32 +325,81 @@ func init() {
 }
 
 var fileDescriptor_9eb8c6db5760cec1 = []byte{
-	// 359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xcd, 0x4a, 0xf3, 0x40,
-	0x14, 0xcd, 0x7c, 0x7f, 0xd0, 0x7c, 0x8a, 0x18, 0x05, 0x6b, 0x17, 0xa9, 0xd4, 0x4d, 0x11, 0x9c,
-	0xa1, 0x8a, 0x0b, 0xdd, 0xb5, 0xe2, 0x0b, 0xb4, 0xe0, 0xc2, 0x4d, 0x99, 0x4c, 0xa6, 0xe9, 0xa0,
-	0x99, 0x09, 0xb9, 0x93, 0xa2, 0x3e, 0x85, 0x2f, 0x20, 0xb8, 0x14, 0x57, 0x3e, 0x46, 0x97, 0x5d,
-	0xba, 0x52, 0x69, 0x17, 0xfa, 0x18, 0x32, 0x33, 0xc1, 0x56, 0xc1, 0x4d, 0xee, 0x25, 0xe7, 0x9c,
-	0x3b, 0xe7, 0xdc, 0xeb, 0x63, 0x21, 0x07, 0x3c, 0xe7, 0x92, 0x71, 0x02, 0x3a, 0xe7, 0x34, 0x1d,
-	0x71, 0xd0, 0x42, 0x26, 0xa4, 0xac, 0x7d, 0x60, 0x43, 0x1e, 0x17, 0x17, 0x1c, 0x67, 0xb9, 0xd2,
-	0x2a, 0xd8, 0xf8, 0xe4, 0xe3, 0x2f, 0xfc, 0xda, 0x2a, 0x4d, 0x85, 0x54, 0xc4, 0x7e, 0x1d, 0xb7,
-	0xb6, 0x9e, 0xa8, 0x44, 0xd9, 0x96, 0x98, 0xae, 0xfc, 0x1b, 0x32, 0x05, 0xa9, 0x02, 0x12, 0x51,
-	0xe0, 0x64, 0xd4, 0x8a, 0xb8, 0xa6, 0x2d, 0xc2, 0x94, 0x90, 0x0e, 0x6f, 0x5c, 0xfb, 0xfe, 0x49,
-	0xa6, 0xd8, 0xf0, 0x58, 0x09, 0x09, 0xc1, 0xc0, 0xff, 0x6b, 0x30, 0xa8, 0xa2, 0xad, 0xdf, 0xcd,
-	0xff, 0x7b, 0x9b, 0xd8, 0xa9, 0xb1, 0x51, 0xe3, 0x52, 0x8d, 0x0d, 0xb5, 0x73, 0x30, 0x7e, 0xae,
-	0x7b, 0x0f, 0x2f, 0xf5, 0x66, 0x22, 0xf4, 0xb0, 0x88, 0x30, 0x53, 0x29, 0x29, 0x9f, 0x72, 0x65,
-	0x17, 0xe2, 0x73, 0xa2, 0xaf, 0x32, 0x0e, 0x56, 0x00, 0xf7, 0x6f, 0x8f, 0x3b, 0xa8, 0xeb, 0xc6,
-	0x1f, 0xfd, 0x79, 0xbf, 0xab, 0xa3, 0xc6, 0x2d, 0xf2, 0x57, 0x4e, 0x5d, 0xa0, 0x5e, 0x99, 0x3b,
-	0x20, 0xfe, 0x5a, 0x46, 0x73, 0x2d, 0x98, 0xc8, 0xa8, 0xd4, 0x7d, 0x1a, 0xc7, 0x39, 0x07, 0xe3,
-	0x07, 0x35, 0x2b, 0xdd, 0x60, 0x01, 0x6a, 0x3b, 0x24, 0xe8, 0xf9, 0xcb, 0xdc, 0x04, 0xe8, 0xd3,
-	0x54, 0x15, 0x52, 0x43, 0xf5, 0x97, 0xb5, 0xbe, 0x8d, 0x7f, 0x58, 0x1d, 0x9e, 0xc7, 0xed, 0x54,
-	0x4c, 0x08, 0x67, 0x6c, 0xc9, 0x0e, 0x69, 0xbb, 0x19, 0xce, 0x5f, 0xa7, 0x37, 0x9e, 0x86, 0x68,
-	0x32, 0x0d, 0xd1, 0xeb, 0x34, 0x44, 0x37, 0xb3, 0xd0, 0x9b, 0xcc, 0x42, 0xef, 0x69, 0x16, 0x7a,
-	0x67, 0x87, 0x0b, 0xa9, 0xb3, 0x5c, 0xc5, 0x05, 0xd3, 0xc0, 0x84, 0xbd, 0xeb, 0xfc, 0xc2, 0x97,
-	0xdf, 0x6e, 0x6c, 0x97, 0x11, 0xfd, 0xb3, 0x7b, 0xdf, 0xff, 0x08, 0x00, 0x00, 0xff, 0xff, 0x33,
-	0x66, 0x54, 0x1c, 0x0b, 0x02, 0x00, 0x00,
+	// 635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xcd, 0x34, 0x69, 0x3f, 0x75, 0xf2, 0xb5, 0x98, 0x29, 0xa2, 0x26, 0xa2, 0xee, 0x0f, 0x3f,
+	0x8a, 0x2a, 0xb0, 0xd5, 0x22, 0x16, 0xb0, 0x40, 0x6a, 0x12, 0x03, 0x16, 0x90, 0x54, 0x76, 0xd2,
+	0x0a, 0x36, 0x96, 0xe3, 0x4c, 0x9d, 0x51, 0xe3, 0x99, 0xc8, 0x33, 0xae, 0xe0, 0x2d, 0x78, 0x01,
+	0x24, 0x36, 0x48, 0x88, 0x15, 0x7b, 0xd6, 0x48, 0x5d, 0xa1, 0x2e, 0x59, 0x01, 0x6a, 0x17, 0xf0,
+	0x18, 0xc8, 0x33, 0x4e, 0x9b, 0x46, 0xea, 0x92, 0x8d, 0x67, 0x7c, 0xcf, 0xb9, 0xf7, 0x9c, 0xb9,
+	0xbe, 0x1e, 0x68, 0x12, 0xba, 0x87, 0x13, 0x4c, 0x43, 0x6c, 0x71, 0x91, 0xe0, 0x20, 0x3e, 0xc0,
+	0x5c, 0x10, 0x1a, 0x59, 0xf9, 0xea, 0xf3, 0xb0, 0x8f, 0x7b, 0xe9, 0x00, 0x9b, 0xc3, 0x84, 0x09,
+	0x86, 0x16, 0x4f, 0xf9, 0xe6, 0x39, 0x7e, 0xe5, 0x72, 0x10, 0x13, 0xca, 0x2c, 0xf9, 0x54, 0xdc,
+	0xca, 0x95, 0x88, 0x45, 0x4c, 0x6e, 0xad, 0x6c, 0x97, 0x47, 0x8d, 0x90, 0xf1, 0x98, 0x71, 0xab,
+	0x1b, 0x70, 0x6c, 0x1d, 0x6c, 0x74, 0xb1, 0x08, 0x36, 0xac, 0x90, 0x11, 0xaa, 0xf0, 0xb5, 0x2f,
+	0x00, 0x96, 0x3d, 0x96, 0x26, 0x21, 0xae, 0x33, 0x42, 0x39, 0x7a, 0x04, 0x67, 0xb8, 0x7c, 0xd5,
+	0xc1, 0x0a, 0xa8, 0xce, 0x6f, 0xde, 0x36, 0x2f, 0xb0, 0x60, 0xee, 0xa8, 0x55, 0x25, 0xbb, 0x79,
+	0x16, 0xda, 0x83, 0xd3, 0x59, 0x75, 0xae, 0x4f, 0xad, 0x14, 0xab, 0xe5, 0xcd, 0x6b, 0xa6, 0xd2,
+	0x37, 0x33, 0x7d, 0x33, 0xd7, 0x37, 0x33, 0xa9, 0xda, 0xfd, 0xc3, 0x1f, 0xcb, 0x85, 0x4f, 0x3f,
+	0x97, 0xab, 0x11, 0x11, 0xfd, 0xb4, 0x6b, 0x86, 0x2c, 0xb6, 0x72, 0xb3, 0x6a, 0xb9, 0xcb, 0x7b,
+	0xfb, 0x96, 0x78, 0x33, 0xc4, 0x5c, 0x26, 0xf0, 0x8f, 0xbf, 0x3f, 0xaf, 0x03, 0x57, 0x95, 0x7f,
+	0x58, 0xfa, 0xf3, 0x7e, 0x19, 0xac, 0x7d, 0x05, 0x10, 0xda, 0x43, 0x16, 0xf6, 0x95, 0xf9, 0x53,
+	0x71, 0xf0, 0x4f, 0xc5, 0x91, 0x03, 0xff, 0x53, 0xc7, 0x1d, 0x1d, 0xf3, 0xe6, 0x85, 0x5d, 0x1a,
+	0xeb, 0x6d, 0x6d, 0x36, 0x13, 0x55, 0x85, 0x46, 0xf9, 0xf9, 0x39, 0xde, 0x01, 0x78, 0x69, 0xd4,
+	0xcf, 0x7c, 0x02, 0x90, 0x05, 0x17, 0x86, 0x41, 0x22, 0x48, 0x48, 0x86, 0x01, 0x15, 0x7e, 0xd0,
+	0xeb, 0x25, 0x98, 0x73, 0xf9, 0x59, 0x66, 0x5d, 0x34, 0x06, 0x6d, 0x29, 0x04, 0x79, 0x70, 0x0e,
+	0x67, 0xbd, 0xf0, 0x83, 0x98, 0xa5, 0x54, 0x8c, 0xbc, 0xdd, 0xb8, 0xd0, 0xdb, 0x59, 0xe7, 0xc6,
+	0xad, 0xfd, 0x2f, 0x8b, 0x6c, 0xa9, 0x1a, 0xb9, 0xbf, 0x6f, 0x00, 0x96, 0x25, 0xbb, 0x4d, 0x62,
+	0x42, 0x23, 0xb4, 0x04, 0xe1, 0x20, 0xe0, 0xc2, 0x97, 0x54, 0x69, 0xa9, 0xe4, 0xce, 0x66, 0x11,
+	0x49, 0x42, 0x77, 0x20, 0x92, 0x70, 0x4a, 0x07, 0x2c, 0xdc, 0xf7, 0xfb, 0x98, 0x44, 0x7d, 0xa1,
+	0x4f, 0xad, 0x80, 0x6a, 0xd1, 0xd5, 0x32, 0xa4, 0x23, 0x81, 0xa7, 0x32, 0x8e, 0xaa, 0x50, 0x1b,
+	0x67, 0x0b, 0x12, 0x63, 0xbd, 0x28, 0xb9, 0xf3, 0x67, 0xdc, 0x36, 0x89, 0x31, 0x5a, 0x85, 0xca,
+	0x9c, 0x3f, 0xc0, 0x34, 0x12, 0x7d, 0xbd, 0x24, 0x59, 0x65, 0x19, 0x7b, 0x2e, 0x43, 0xe8, 0x16,
+	0x9c, 0x57, 0x94, 0x5e, 0x9a, 0x04, 0x82, 0x30, 0xaa, 0x4f, 0x4b, 0x92, 0x6a, 0x4d, 0x23, 0x0f,
+	0xae, 0x7f, 0x00, 0x70, 0xee, 0xdc, 0x00, 0x23, 0x03, 0x56, 0x76, 0x6c, 0xaf, 0xed, 0x34, 0x9f,
+	0xf8, 0x5e, 0xab, 0xe3, 0xd6, 0x6d, 0xbf, 0xd3, 0xf4, 0xb6, 0xed, 0xba, 0xf3, 0xd8, 0xb1, 0x1b,
+	0x5a, 0x01, 0x2d, 0xc2, 0x85, 0x09, 0x7c, 0xb7, 0xe5, 0x3e, 0xd3, 0x00, 0xaa, 0xc0, 0xab, 0x13,
+	0x80, 0xd7, 0xa9, 0x79, 0x4e, 0xe3, 0xa5, 0x36, 0x85, 0xae, 0x43, 0x7d, 0x02, 0x6b, 0xb7, 0xb6,
+	0xfd, 0x17, 0x4e, 0xd3, 0x76, 0xb5, 0x22, 0x5a, 0x85, 0x4b, 0x13, 0x68, 0xcd, 0x69, 0xd7, 0x5b,
+	0x4e, 0xd3, 0x77, 0xed, 0xdd, 0x2d, 0xb7, 0xa1, 0x95, 0x6a, 0xde, 0xe1, 0xb1, 0x01, 0x8e, 0x8e,
+	0x0d, 0xf0, 0xeb, 0xd8, 0x00, 0x6f, 0x4f, 0x8c, 0xc2, 0xd1, 0x89, 0x51, 0xf8, 0x7e, 0x62, 0x14,
+	0x5e, 0x3d, 0x18, 0x9b, 0xdc, 0x61, 0xc2, 0x7a, 0x69, 0x28, 0x78, 0x48, 0xe4, 0xd5, 0x72, 0x76,
+	0xc9, 0xbc, 0x9e, 0xb8, 0x66, 0xe4, 0x40, 0x77, 0x67, 0xe4, 0xaf, 0x7f, 0xef, 0x6f, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x21, 0xd2, 0xdc, 0x93, 0x8e, 0x04, 0x00, 0x00,
 }
 
+func (this *SourceCoins) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SourceCoins)
+	if !ok {
+		that2, ok := that.(SourceCoins)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if len(this.Coins) != len(that1.Coins) {
+		return false
+	}
+	for i := range this.Coins {
+		if !this.Coins[i].Equal(&that1.Coins[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *EpochCoins) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -191,6 +427,14 @@ func (this *EpochCoins) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.Sources) != len(that1.Sources) {
+		return false
+	}
+	for i := range this.Sources {
+		if !this.Sources[i].Equal(&that1.Sources[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *VestingSchedule) Equal(that interface{}) bool {
@@ -225,6 +469,48 @@ func (this *VestingSchedule) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (m *SourceCoins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceCoins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceCoins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Source != 0 {
+		i = encodeVarintVestingSchedule(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EpochCoins) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -245,6 +531,20 @@ func (m *EpochCoins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -306,6 +606,54 @@ func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochTiming) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochTiming) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochTiming) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochDuration != 0 {
+		i = encodeVarintVestingSchedule(dAtA, i, uint64(m.EpochDuration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintVestingSchedule(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastUnlockTime != 0 {
+		i = encodeVarintVestingSchedule(dAtA, i, uint64(m.LastUnlockTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastUnlockHeight != 0 {
+		i = encodeVarintVestingSchedule(dAtA, i, uint64(m.LastUnlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastEpoch != 0 {
+		i = encodeVarintVestingSchedule(dAtA, i, uint64(m.LastEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVestingSchedule(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVestingSchedule(v)
 	base := offset
@@ -317,6 +665,24 @@ func encodeVarintVestingSchedule(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *SourceCoins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Source != 0 {
+		n += 1 + sovVestingSchedule(uint64(m.Source))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovVestingSchedule(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EpochCoins) Size() (n int) {
 	if m == nil {
 		return 0
@@ -329,6 +695,12 @@ func (m *EpochCoins) Size() (n int) {
 			n += 1 + l + sovVestingSchedule(uint64(l))
 		}
 	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovVestingSchedule(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -351,12 +723,139 @@ func (m *VestingSchedule) Size() (n int) {
 	return n
 }
 
+func (m *EpochTiming) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastEpoch != 0 {
+		n += 1 + sovVestingSchedule(uint64(m.LastEpoch))
+	}
+	if m.LastUnlockHeight != 0 {
+		n += 1 + sovVestingSchedule(uint64(m.LastUnlockHeight))
+	}
+	if m.LastUnlockTime != 0 {
+		n += 1 + sovVestingSchedule(uint64(m.LastUnlockTime))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovVestingSchedule(uint64(m.EpochLength))
+	}
+	if m.EpochDuration != 0 {
+		n += 1 + sovVestingSchedule(uint64(m.EpochDuration))
+	}
+	return n
+}
+
 func sovVestingSchedule(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozVestingSchedule(x uint64) (n int) {
 	return sovVestingSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *SourceCoins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVestingSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceCoins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= VestingSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVestingSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVestingSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EpochCoins) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -420,6 +919,40 @@ func (m *EpochCoins) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, SourceCoins{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVestingSchedule(dAtA[iNdEx:])
@@ -557,6 +1090,151 @@ func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EpochTiming) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVestingSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochTiming: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochTiming: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpoch", wireType)
+			}
+			m.LastEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnlockHeight", wireType)
+			}
+			m.LastUnlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUnlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnlockTime", wireType)
+			}
+			m.LastUnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUnlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
+			}
+			m.EpochDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVestingSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVestingSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVestingSchedule(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0